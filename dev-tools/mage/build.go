@@ -75,6 +75,14 @@ func DefaultBuildArgs() BuildArgs {
 		args.ExtraFlags = append(args.ExtraFlags, "-trimpath")
 	}
 
+	if FIPSBuild {
+		// Link against BoringCrypto and restrict crypto/tls to FIPS approved
+		// settings. BoringCrypto requires cgo.
+		args.CGO = true
+		args.Env = map[string]string{"GOEXPERIMENT": "boringcrypto"}
+		args.ExtraFlags = append(args.ExtraFlags, "-tags=requirefips")
+	}
+
 	return args
 }
 
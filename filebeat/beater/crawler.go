@@ -139,14 +139,37 @@ func (c *crawler) startInput(
 	if err != nil {
 		return fmt.Errorf("error while initializing input: %w", err)
 	}
+	runsOnce := false
 	if inputRunner, ok := runner.(*input.Runner); ok {
 		inputRunner.Once = c.once
+	} else if c.once {
+		if onceRunner, ok := runner.(interface{ RunOnce() bool }); ok && onceRunner.RunOnce() {
+			runsOnce = true
+		} else {
+			c.log.Warnf("Input %s does not support -once, it is stopped once the other inputs have completed", runner)
+		}
 	}
 
 	c.inputs[id] = runner
 
 	c.log.Infof("Starting input (ID: %d)", id)
+	if !runsOnce {
+		runner.Start()
+		return nil
+	}
+
+	// Start returns once the input has completed, stop it if the beat is
+	// stopped before.
+	started := make(chan struct{})
+	go func() {
+		select {
+		case <-c.beatDone:
+			runner.Stop()
+		case <-started:
+		}
+	}()
 	runner.Start()
+	close(started)
 
 	return nil
 }
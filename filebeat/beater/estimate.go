@@ -0,0 +1,90 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/filebeat/estimate"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+var (
+	estimateOnly     = flag.Bool("estimate-only", false, "Measure the events and bytes the inputs would publish, without publishing them")
+	estimateDuration = flag.Duration("estimate-duration", 0, "Stop the estimate-only mode after this duration")
+)
+
+// estimateLogInterval is the interval between the reports logged in the
+// estimate-only mode.
+const estimateLogInterval = time.Minute
+
+// EstimateOutput returns the configuration of the output replacing the
+// configured output in the estimate-only mode, or nil if the mode is
+// disabled.
+func EstimateOutput() *conf.C {
+	if !*estimateOnly {
+		return nil
+	}
+	return conf.MustNewConfigFrom(map[string]interface{}{
+		estimate.Name: map[string]interface{}{},
+	})
+}
+
+// setupEstimate prepares the estimate-only mode. The states of the inputs
+// are kept in a temporary registry, so the inputs read their sources as if
+// they were new and the registry of the Beat is left untouched. The returned
+// function writes the report and removes the temporary registry.
+func (fb *Filebeat) setupEstimate() (func(), error) {
+	dir, err := os.MkdirTemp("", "filebeat-estimate-")
+	if err != nil {
+		return nil, fmt.Errorf("could not create the estimate-only registry: %w", err)
+	}
+	fb.config.Registry.Path = dir
+	logp.Info("Running filebeat in estimate-only mode, no events are published. Temporary registry: %s", dir)
+
+	done := make(chan struct{})
+	go func() {
+		t := time.NewTicker(estimateLogInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				var b strings.Builder
+				_ = estimate.Default.WriteReport(&b)
+				logp.Info("%s", b.String())
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		if err := estimate.Default.WriteReport(os.Stdout); err != nil {
+			logp.Err("Failed to write the estimate report: %v", err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			logp.Warn("Failed to remove the estimate-only registry %s: %v", dir, err)
+		}
+	}, nil
+}
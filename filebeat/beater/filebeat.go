@@ -28,6 +28,7 @@ import (
 	"github.com/elastic/beats/v7/filebeat/backup"
 	"github.com/elastic/beats/v7/filebeat/channel"
 	cfg "github.com/elastic/beats/v7/filebeat/config"
+	"github.com/elastic/beats/v7/filebeat/estimate"
 	"github.com/elastic/beats/v7/filebeat/fileset"
 	_ "github.com/elastic/beats/v7/filebeat/include"
 	"github.com/elastic/beats/v7/filebeat/input"
@@ -39,6 +40,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/cfgfile"
 	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/common/dryrun"
 	"github.com/elastic/beats/v7/libbeat/common/reload"
 	"github.com/elastic/beats/v7/libbeat/esleg/eslegclient"
 	"github.com/elastic/beats/v7/libbeat/management"
@@ -55,6 +57,7 @@ import (
 	// Add filebeat level processors
 	_ "github.com/elastic/beats/v7/filebeat/processor/add_kubernetes_metadata"
 	_ "github.com/elastic/beats/v7/libbeat/processors/decode_csv_fields"
+	_ "github.com/elastic/beats/v7/libbeat/processors/decode_logfmt_fields"
 
 	// include all filebeat specific autodiscover features
 	_ "github.com/elastic/beats/v7/filebeat/autodiscover"
@@ -122,7 +125,7 @@ func newBeater(b *beat.Beat, plugins PluginFactory, rawConfig *conf.C) (beat.Bea
 		return nil, err
 	}
 
-	if b.API != nil {
+	if b.API != nil && b.API.InputsEnabled() {
 		if err = inputmon.AttachHandler(b.API.Router()); err != nil {
 			return nil, fmt.Errorf("failed attach inputs api to monitoring endpoint server: %w", err)
 		}
@@ -150,6 +153,15 @@ func newBeater(b *beat.Beat, plugins PluginFactory, rawConfig *conf.C) (beat.Bea
 		return nil, fmt.Errorf("input configs and -once cannot be used together")
 	}
 
+	if *estimateOnly {
+		if b.Manager.Enabled() {
+			return nil, fmt.Errorf("-estimate-only cannot be used with a managed filebeat")
+		}
+		if b.Config.Output.Name() != estimate.Name {
+			return nil, fmt.Errorf("-estimate-only is not supported, the output was not replaced")
+		}
+	}
+
 	if config.IsInputEnabled("stdin") && len(enabledInputs) > 1 {
 		return nil, fmt.Errorf("stdin requires to be run in exclusive mode, configured inputs: %s", strings.Join(enabledInputs, ", "))
 	}
@@ -178,14 +190,18 @@ func (fb *Filebeat) setupPipelineLoaderCallback(b *beat.Beat) error {
 
 	overwritePipelines := true
 	b.OverwritePipelinesCallback = func(esConfig *conf.C) error {
-		esClient, err := eslegclient.NewConnectedClient(esConfig, "Filebeat")
+		conn, err := eslegclient.NewConnectedClient(esConfig, "Filebeat")
 		if err != nil {
 			return err
 		}
+		var esClient fileset.PipelineLoader = conn
+		if b.SetupDryRun != nil {
+			esClient = eslegclient.NewDryRunConnection(conn, b.SetupDryRun)
+		}
 
 		// When running the subcommand setup, configuration from modules.d directories
 		// have to be loaded using cfg.Reloader. Otherwise those configurations are skipped.
-		pipelineLoaderFactory := newPipelineLoaderFactory(b.Config.Output.Config())
+		pipelineLoaderFactory := newPipelineLoaderFactory(b.Config.Output.Config(), b.SetupDryRun)
 		enableAllFilesets, _ := b.BeatConfig.Bool("config.modules.enable_all_filesets", -1)
 		modulesFactory := fileset.NewSetupFactory(b.Info, pipelineLoaderFactory, enableAllFilesets)
 		if fb.config.ConfigModules.Enabled() {
@@ -230,7 +246,7 @@ func (fb *Filebeat) loadModulesPipelines(b *beat.Beat) error {
 }
 
 // Run allows the beater to be run as a beat.
-func (fb *Filebeat) Run(b *beat.Beat) error {
+func (fb *Filebeat) Run(b *beat.Beat) (runErr error) {
 	var err error
 	config := fb.config
 
@@ -252,6 +268,15 @@ func (fb *Filebeat) Run(b *beat.Beat) error {
 	}
 	finishedLogger := newFinishedLogger(wgEvents)
 
+	if *estimateOnly {
+		finishEstimate, err := fb.setupEstimate()
+		if err != nil {
+			return err
+		}
+		// Deferred first to report once all the events are published.
+		defer finishEstimate()
+	}
+
 	registryMigrator := registrar.NewMigrator(config.Registry)
 	if err := registryMigrator.Run(); err != nil {
 		logp.Err("Failed to migrate registry file: %+v", err)
@@ -302,7 +327,7 @@ func (fb *Filebeat) Run(b *beat.Beat) error {
 	// Create a ES connection factory for dynamic modules pipeline loading
 	var pipelineLoaderFactory fileset.PipelineLoaderFactory
 	if b.Config.Output.Name() == "elasticsearch" {
-		pipelineLoaderFactory = newPipelineLoaderFactory(b.Config.Output.Config())
+		pipelineLoaderFactory = newPipelineLoaderFactory(b.Config.Output.Config(), nil)
 	} else {
 		logp.Warn(pipelinesWarning)
 	}
@@ -327,6 +352,10 @@ func (fb *Filebeat) Run(b *beat.Beat) error {
 		compat.RunnerFactory(inputsLogger, b.Info, v2InputLoader),
 		input.NewRunnerFactory(pipelineConnector, registrar, fb.done),
 	))
+	if *estimateOnly {
+		// Report the estimates by input.
+		inputLoader = channel.RunnerFactoryWithInputID(inputLoader)
+	}
 	moduleLoader := fileset.NewFactory(inputLoader, b.Info, pipelineLoaderFactory, config.OverwritePipelines)
 
 	crawler, err := newCrawler(inputLoader, moduleLoader, config.Inputs, fb.done, *once)
@@ -356,6 +385,16 @@ func (fb *Filebeat) Run(b *beat.Beat) error {
 		close(outDone) // finally close all active connections to publisher pipeline
 	}()
 
+	// When running once, the exit status reports if all events were
+	// acknowledged by the outputs. It is checked after waiting for the events.
+	if *once {
+		defer func() {
+			if pending := wgEvents.count.Get(); pending > 0 && runErr == nil {
+				runErr = fmt.Errorf("running once: %d events were not acknowledged by the outputs", pending)
+			}
+		}()
+	}
+
 	// Wait for all events to be processed or timeout
 	defer waitEvents.Wait()
 
@@ -379,6 +418,11 @@ func (fb *Filebeat) Run(b *beat.Beat) error {
 		waitFinished.Add(runOnce)
 	}
 
+	if *estimateOnly && *estimateDuration > 0 {
+		waitFinished.Add(withLog(waitDuration(*estimateDuration),
+			"Estimate duration reached. Shutting down."))
+	}
+
 	// Register reloadable list of inputs and modules
 	inputs := cfgfile.NewRunnerList(management.DebugK, inputLoader, fb.pipeline)
 	reload.RegisterV2.MustRegisterInput(inputs)
@@ -424,7 +468,7 @@ func (fb *Filebeat) Run(b *beat.Beat) error {
 
 	timeout := fb.config.ShutdownTimeout
 	// Checks if on shutdown it should wait for all events to be published
-	waitPublished := fb.config.ShutdownTimeout > 0 || *once
+	waitPublished := fb.config.ShutdownTimeout > 0 || *once || *estimateOnly
 	if waitPublished {
 		// Wait for registrar to finish writing registry
 		waitEvents.Add(withLog(wgEvents.Wait,
@@ -464,12 +508,15 @@ func (fb *Filebeat) Stop() {
 }
 
 // Create a new pipeline loader (es client) factory
-func newPipelineLoaderFactory(esConfig *conf.C) fileset.PipelineLoaderFactory {
+func newPipelineLoaderFactory(esConfig *conf.C, dryRun *dryrun.Reporter) fileset.PipelineLoaderFactory {
 	pipelineLoaderFactory := func() (fileset.PipelineLoader, error) {
 		esClient, err := eslegclient.NewConnectedClient(esConfig, "Filebeat")
 		if err != nil {
 			return nil, fmt.Errorf("Error creating Elasticsearch client: %w", err)
 		}
+		if dryRun != nil {
+			return eslegclient.NewDryRunConnection(esClient, dryRun), nil
+		}
 		return esClient, nil
 	}
 	return pipelineLoaderFactory
@@ -18,13 +18,20 @@
 package beater
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/elastic/beats/v7/filebeat/config"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/backend"
+	"github.com/elastic/beats/v7/libbeat/statestore/backend/boltdb"
 	"github.com/elastic/beats/v7/libbeat/statestore/backend/memlog"
 	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
 	"github.com/elastic/elastic-agent-libs/paths"
 )
 
@@ -35,21 +42,120 @@ type filebeatStore struct {
 }
 
 func openStateStore(info beat.Info, logger *logp.Logger, cfg config.Registry) (*filebeatStore, error) {
-	memlog, err := memlog.New(logger, memlog.Settings{
-		Root:     paths.Resolve(paths.Data, cfg.Path),
-		FileMode: cfg.Permissions,
-	})
+	root := paths.Resolve(paths.Data, cfg.Path)
+
+	var reg backend.Registry
+	var err error
+	switch cfg.Backend {
+	case config.RegistryBackendBbolt:
+		reg, err = openBboltRegistry(info, logger, root, cfg)
+	default:
+		reg, err = memlog.New(logger, memlog.Settings{
+			Root:     root,
+			FileMode: cfg.Permissions,
+		})
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &filebeatStore{
-		registry:      statestore.NewRegistry(memlog),
+		registry:      statestore.NewRegistry(reg),
 		storeName:     info.Beat,
 		cleanInterval: cfg.CleanInterval,
 	}, nil
 }
 
+// openBboltRegistry opens the bbolt registry backend. The states of the
+// memlog store are imported when the bbolt store does not exist yet, so that
+// switching backends does not lose the read offsets.
+func openBboltRegistry(info beat.Info, logger *logp.Logger, root string, cfg config.Registry) (*boltdb.Registry, error) {
+	metrics := monitoring.Default.GetRegistry("filebeat.registry")
+	if metrics == nil {
+		metrics = monitoring.Default.NewRegistry("filebeat.registry")
+	}
+
+	_, err := os.Stat(boltdb.Path(root, info.Beat))
+	create := errors.Is(err, os.ErrNotExist)
+
+	reg, err := boltdb.New(logger, boltdb.Settings{
+		Root:     root,
+		FileMode: cfg.Permissions,
+		Sync:     cfg.Bbolt.Sync,
+		Timeout:  cfg.Bbolt.Timeout,
+		Metrics:  metrics,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if create && isDir(filepath.Join(root, info.Beat)) {
+		if err := importMemlogStore(logger, root, info.Beat, cfg); err != nil {
+			// Import again on the next start.
+			_ = os.Remove(boltdb.Path(root, info.Beat))
+			return nil, fmt.Errorf("failed to import the memlog registry: %w", err)
+		}
+	}
+	return reg, nil
+}
+
+// importMemlogStore copies the states of the named memlog store into the
+// bbolt store of the same name. The memlog store is left unchanged. The
+// updates are not synced one by one to keep the import of large registries
+// short.
+func importMemlogStore(logger *logp.Logger, root, name string, cfg config.Registry) error {
+	memlogReg, err := memlog.New(logger, memlog.Settings{
+		Root:     root,
+		FileMode: cfg.Permissions,
+	})
+	if err != nil {
+		return err
+	}
+	defer memlogReg.Close()
+
+	reg, err := boltdb.New(logger, boltdb.Settings{
+		Root:     root,
+		FileMode: cfg.Permissions,
+		Timeout:  cfg.Bbolt.Timeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer reg.Close()
+
+	from, err := memlogReg.Access(name)
+	if err != nil {
+		return err
+	}
+	defer from.Close()
+
+	to, err := reg.Access(name)
+	if err != nil {
+		return err
+	}
+	defer to.Close()
+
+	var n int
+	err = from.Each(func(key string, dec backend.ValueDecoder) (bool, error) {
+		var state map[string]interface{}
+		if err := dec.Decode(&state); err != nil {
+			return false, err
+		}
+		n++
+		return true, to.Set(key, state)
+	})
+	if err != nil {
+		return err
+	}
+	logger.Infof("Imported %d states from the memlog registry into the bbolt registry", n)
+	return nil
+}
+
+func isDir(path string) bool {
+	fi, err := os.Stat(path)
+	return err == nil && fi.IsDir()
+}
+
 func (s *filebeatStore) Close() {
 	s.registry.Close()
 }
@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/filebeat/config"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+func TestOpenStateStoreImportsMemlog(t *testing.T) {
+	info := beat.Info{Beat: "filebeat"}
+	cfg := config.DefaultConfig.Registry
+	cfg.Path = t.TempDir()
+
+	memlogStore, err := openStateStore(info, logp.NewLogger("test"), cfg)
+	require.NoError(t, err)
+	store, err := memlogStore.Access()
+	require.NoError(t, err)
+	require.NoError(t, store.Set("filestream::id::native::1-2", map[string]interface{}{"cursor": map[string]interface{}{"offset": 42}}))
+	store.Close()
+	memlogStore.Close()
+
+	cfg.Backend = config.RegistryBackendBbolt
+	bboltStore, err := openStateStore(info, logp.NewLogger("test"), cfg)
+	require.NoError(t, err)
+	defer bboltStore.Close()
+	store, err = bboltStore.Access()
+	require.NoError(t, err)
+	defer store.Close()
+
+	var state struct {
+		Cursor struct {
+			Offset int64 `struct:"offset"`
+		} `struct:"cursor"`
+	}
+	require.NoError(t, store.Get("filestream::id::native::1-2", &state))
+	assert.Equal(t, int64(42), state.Cursor.Offset)
+}
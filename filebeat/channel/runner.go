@@ -45,7 +45,8 @@ type commonInputConfig struct {
 	KeepNull             bool                    `config:"keep_null"`
 
 	PublisherPipeline struct {
-		DisableHost bool `config:"disable_host"` // Disable addition of host.name.
+		DisableHost bool   `config:"disable_host"` // Disable addition of host.name.
+		Name        string `config:"name"`         // Named publisher pipeline to publish to.
 	} `config:"publisher_pipeline"`
 
 	// implicit event fields
@@ -81,6 +82,7 @@ func (f *onCreateFactory) Create(pipeline beat.PipelineConnector, cfg *conf.C) (
 //   - *keep_null*: keep or remove 'null' from events to be published
 //   - *_module_name* (hidden setting): Add fields describing the module name
 //   - *_ fileset_name* (hidden setting):
+//   - *publisher_pipeline.name*: select the named publisher pipeline events from this input are published to
 //   - *pipeline*: Configure the ES Ingest Node pipeline name to be used for events from this input
 //   - *index*: Configure the index name for events to be collected from this input
 //   - *type*: implicit event type
@@ -101,6 +103,34 @@ func RunnerFactoryWithCommonInputSettings(info beat.Info, f cfgfile.RunnerFactor
 		})
 }
 
+// RunnerFactoryWithInputID wraps a runner factory, such that the events of
+// the runners created by this factory hold the `id` setting of their input
+// in the `input_id` metadata field.
+func RunnerFactoryWithInputID(f cfgfile.RunnerFactory) cfgfile.RunnerFactory {
+	return wrapRunnerCreate(f,
+		func(
+			f cfgfile.RunnerFactory,
+			pipeline beat.PipelineConnector,
+			cfg *conf.C,
+		) (cfgfile.Runner, error) {
+			config := struct {
+				ID string `config:"id"`
+			}{}
+			if err := cfg.Unpack(&config); err != nil {
+				return nil, err
+			}
+			if config.ID != "" {
+				pipeline = pipetool.WithClientConfigEdit(pipeline, func(clientCfg beat.ClientConfig) (beat.ClientConfig, error) {
+					meta := clientCfg.Processing.Meta.Clone()
+					setOptional(meta, "input_id", config.ID)
+					clientCfg.Processing.Meta = meta
+					return clientCfg, nil
+				})
+			}
+			return f.Create(pipeline, cfg)
+		})
+}
+
 func wrapRunnerCreate(f cfgfile.RunnerFactory, edit onCreateWrapper) cfgfile.RunnerFactory {
 	return &onCreateFactory{factory: f, create: edit}
 }
@@ -185,6 +215,9 @@ func newCommonConfigEditor(
 		clientCfg.Processing.Processor = procs
 		clientCfg.Processing.KeepNull = config.KeepNull
 		clientCfg.Processing.DisableHost = config.PublisherPipeline.DisableHost
+		if config.PublisherPipeline.Name != "" {
+			clientCfg.PublisherPipeline = config.PublisherPipeline.Name
+		}
 
 		return clientCfg, nil
 	}, nil
@@ -42,10 +42,14 @@ func FilebeatSettings() instance.Settings {
 	runFlags := pflag.NewFlagSet(Name, pflag.ExitOnError)
 	runFlags.AddGoFlag(flag.CommandLine.Lookup("once"))
 	runFlags.AddGoFlag(flag.CommandLine.Lookup("modules"))
+	runFlags.AddGoFlag(flag.CommandLine.Lookup("estimate-only"))
+	runFlags.AddGoFlag(flag.CommandLine.Lookup("estimate-duration"))
 	return instance.Settings{
-		RunFlags:      runFlags,
-		Name:          Name,
-		HasDashboards: true,
+		RunFlags:           runFlags,
+		Name:               Name,
+		HasDashboards:      true,
+		PublisherPipelines: true,
+		OutputOverride:     beater.EstimateOutput,
 	}
 }
 
@@ -56,6 +56,32 @@ type Registry struct {
 	FlushTimeout  time.Duration `config:"flush"`
 	CleanInterval time.Duration `config:"cleanup_interval"`
 	MigrateFile   string        `config:"migrate_file"`
+	Backend       string        `config:"backend"`
+	Bbolt         BboltRegistry `config:"bbolt"`
+}
+
+// BboltRegistry configures the bbolt registry backend.
+type BboltRegistry struct {
+	// Sync waits for each update to be written to the disk.
+	Sync bool `config:"sync"`
+	// Timeout is the time to wait for the lock of the registry file.
+	Timeout time.Duration `config:"timeout" validate:"positive"`
+}
+
+// Registry backends.
+const (
+	RegistryBackendMemlog = "memlog"
+	RegistryBackendBbolt  = "bbolt"
+)
+
+// Validate checks that the registry backend is supported.
+func (r *Registry) Validate() error {
+	switch r.Backend {
+	case RegistryBackendMemlog, RegistryBackendBbolt:
+		return nil
+	default:
+		return fmt.Errorf("invalid registry backend %q, must be %q or %q", r.Backend, RegistryBackendMemlog, RegistryBackendBbolt)
+	}
 }
 
 var DefaultConfig = Config{
@@ -65,6 +91,11 @@ var DefaultConfig = Config{
 		MigrateFile:   "",
 		CleanInterval: 5 * time.Minute,
 		FlushTimeout:  time.Second,
+		Backend:       RegistryBackendMemlog,
+		Bbolt: BboltRegistry{
+			Sync:    true,
+			Timeout: time.Second,
+		},
 	},
 	ShutdownTimeout:    0,
 	OverwritePipelines: false,
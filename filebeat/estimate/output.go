@@ -0,0 +1,128 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package estimate
+
+import (
+	"context"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/outputs/codec"
+	"github.com/elastic/beats/v7/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// Name is the name of the output used by the estimate-only mode.
+const Name = "estimate"
+
+type outputConfig struct {
+	Codec     codec.Config `config:"codec"`
+	BatchSize int          `config:"batch_size"`
+}
+
+func init() {
+	outputs.RegisterType(Name, makeEstimate)
+}
+
+// estimate is an output measuring the events and the size of their encoding
+// by input in the Default stats, and dropping them.
+type estimate struct {
+	log      *logp.Logger
+	observer outputs.Observer
+	codec    codec.Codec
+	index    string
+	stats    *Stats
+}
+
+func makeEstimate(
+	_ outputs.IndexManager,
+	beat beat.Info,
+	observer outputs.Observer,
+	cfg *config.C,
+) (outputs.Group, error) {
+	config := outputConfig{BatchSize: 2048}
+	if err := cfg.Unpack(&config); err != nil {
+		return outputs.Fail(err)
+	}
+
+	var enc codec.Codec
+	if config.Codec.Namespace.IsSet() {
+		var err error
+		enc, err = codec.CreateEncoder(beat, config.Codec)
+		if err != nil {
+			return outputs.Fail(err)
+		}
+	} else {
+		enc = json.New(beat.Version, json.Config{EscapeHTML: false})
+	}
+
+	Default.Reset()
+	e := &estimate{
+		log:      logp.NewLogger(Name),
+		observer: observer,
+		codec:    enc,
+		index:    beat.Beat,
+		stats:    Default,
+	}
+	return outputs.Success(config.BatchSize, 0, e)
+}
+
+func (e *estimate) Close() error { return nil }
+
+func (e *estimate) Publish(_ context.Context, batch publisher.Batch) error {
+	events := batch.Events()
+	e.observer.NewBatch(len(events))
+
+	dropped := 0
+	for i := range events {
+		serialized, err := e.codec.Encode(e.index, &events[i].Content)
+		if err != nil {
+			e.log.Errorf("Unable to encode event: %+v", err)
+			dropped++
+			continue
+		}
+		e.stats.add(inputOf(&events[i].Content), len(serialized))
+		e.observer.WriteBytes(len(serialized))
+	}
+	batch.ACK()
+
+	e.observer.Dropped(dropped)
+	e.observer.Acked(len(events) - dropped)
+	return nil
+}
+
+func (e *estimate) String() string {
+	return Name
+}
+
+// inputOf returns the input which published the event.
+func inputOf(event *beat.Event) Input {
+	var in Input
+	if v, err := event.Meta.GetValue("input_id"); err == nil {
+		in.ID, _ = v.(string)
+	}
+	if v, err := event.Fields.GetValue("input.type"); err == nil {
+		in.Type, _ = v.(string)
+	}
+	if v, err := event.Fields.GetValue("event.dataset"); err == nil {
+		in.Dataset, _ = v.(string)
+	}
+	return in
+}
@@ -0,0 +1,140 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package estimate
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dustin/go-humanize"
+)
+
+// Input identifies the input which published events.
+type Input struct {
+	Type    string // input.type field
+	ID      string // input_id metadata field
+	Dataset string // event.dataset field
+}
+
+// InputReport reports the events measured for an input.
+type InputReport struct {
+	Input
+	Events          uint64
+	Bytes           uint64
+	EventsPerSecond float64
+	BytesPerDay     float64
+}
+
+type counts struct {
+	events uint64
+	bytes  uint64
+}
+
+// Stats counts the events and bytes published by each input since the
+// estimation started.
+type Stats struct {
+	now func() time.Time
+
+	mu     sync.Mutex
+	start  time.Time
+	inputs map[Input]*counts
+}
+
+// Default are the stats updated by the estimate output.
+var Default = NewStats()
+
+// NewStats returns empty stats.
+func NewStats() *Stats {
+	s := &Stats{now: time.Now}
+	s.Reset()
+	return s
+}
+
+// Reset clears the stats and restarts the estimation.
+func (s *Stats) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.start = s.now()
+	s.inputs = make(map[Input]*counts)
+}
+
+func (s *Stats) add(in Input, bytes int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.inputs[in]
+	if !ok {
+		c = &counts{}
+		s.inputs[in] = c
+	}
+	c.events++
+	c.bytes += uint64(bytes)
+}
+
+// Report returns the reports of the inputs, sorted by input, and the time
+// elapsed since the estimation started. The rates are extrapolated from the
+// elapsed time.
+func (s *Stats) Report() ([]InputReport, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elapsed := s.now().Sub(s.start)
+	reports := make([]InputReport, 0, len(s.inputs))
+	for in, c := range s.inputs {
+		r := InputReport{Input: in, Events: c.events, Bytes: c.bytes}
+		if secs := elapsed.Seconds(); secs > 0 {
+			r.EventsPerSecond = float64(c.events) / secs
+			r.BytesPerDay = float64(c.bytes) / secs * (24 * time.Hour).Seconds()
+		}
+		reports = append(reports, r)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		a, b := reports[i].Input, reports[j].Input
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.ID != b.ID {
+			return a.ID < b.ID
+		}
+		return a.Dataset < b.Dataset
+	})
+	return reports, elapsed
+}
+
+// WriteReport writes the report of the stats as a table.
+func (s *Stats) WriteReport(w io.Writer) error {
+	reports, elapsed := s.Report()
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Estimated volume over %v:\n", elapsed.Round(time.Second))
+	fmt.Fprintln(tw, "INPUT TYPE\tINPUT ID\tDATASET\tEVENTS\tEVENTS/S\tBYTES\tBYTES/DAY")
+	for _, r := range reports {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%.2f\t%s\t%s\n",
+			orDash(r.Type), orDash(r.ID), orDash(r.Dataset),
+			r.Events, r.EventsPerSecond, humanize.Bytes(r.Bytes), humanize.Bytes(uint64(r.BytesPerDay)))
+	}
+	return tw.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
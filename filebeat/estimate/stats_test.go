@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package estimate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestStatsReport(t *testing.T) {
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	s := &Stats{now: func() time.Time { return now }}
+	s.Reset()
+
+	syslog := Input{Type: "udp", ID: "syslog"}
+	nginx := Input{Type: "log", Dataset: "nginx.access"}
+	for i := 0; i < 20; i++ {
+		s.add(syslog, 100)
+	}
+	s.add(nginx, 1000)
+	now = now.Add(10 * time.Second)
+
+	reports, elapsed := s.Report()
+	assert.Equal(t, 10*time.Second, elapsed)
+	assert.Equal(t, []InputReport{
+		{Input: nginx, Events: 1, Bytes: 1000, EventsPerSecond: 0.1, BytesPerDay: 8640000},
+		{Input: syslog, Events: 20, Bytes: 2000, EventsPerSecond: 2, BytesPerDay: 17280000},
+	}, reports)
+
+	var b strings.Builder
+	require.NoError(t, s.WriteReport(&b))
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, "Estimated volume over 10s:", lines[0])
+	assert.Equal(t, []string{"log", "-", "nginx.access", "1", "0.10", "1.0", "kB", "8.6", "MB"}, strings.Fields(lines[2]))
+	assert.Equal(t, []string{"udp", "syslog", "-", "20", "2.00", "2.0", "kB", "17", "MB"}, strings.Fields(lines[3]))
+
+	s.Reset()
+	reports, _ = s.Report()
+	assert.Empty(t, reports)
+}
+
+func TestInputOf(t *testing.T) {
+	assert.Equal(t, Input{Type: "filestream", ID: "my-logs", Dataset: "app"}, inputOf(&beat.Event{
+		Meta: mapstr.M{"input_id": "my-logs"},
+		Fields: mapstr.M{
+			"input": mapstr.M{"type": "filestream"},
+			"event": mapstr.M{"dataset": "app"},
+		},
+	}))
+	assert.Equal(t, Input{}, inputOf(&beat.Event{Fields: mapstr.M{}}))
+}
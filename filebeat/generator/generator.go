@@ -23,8 +23,11 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"regexp"
 )
 
+var validName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
 // DirExists check that directory exists
 func DirExists(dir string) bool {
 	if _, err := os.Stat(dir); !os.IsNotExist(err) {
@@ -34,6 +37,15 @@ func DirExists(dir string) bool {
 	return false
 }
 
+// ValidateName check that name can be used as a Go package and directory name
+func ValidateName(kind, name string) error {
+	if !validName.MatchString(name) {
+		return fmt.Errorf("invalid %s name %q: only lowercase letters, digits and underscores are allowed", kind, name)
+	}
+
+	return nil
+}
+
 // CreateDirectories create directories in baseDir
 func CreateDirectories(baseDir string, directories ...string) error {
 	for _, d := range directories {
@@ -59,6 +71,11 @@ func CopyTemplates(src, dest string, templates []string, replace map[string]stri
 	return nil
 }
 
+// CopyTemplate copy a single template from src, make replacement in template content and save it to dest
+func CopyTemplate(src, dest string, replace map[string]string) error {
+	return copyTemplate(src, dest, replace)
+}
+
 // AppendTemplate read template, make replacement in content and append it to dest
 func AppendTemplate(template, dest string, replace map[string]string) error {
 	c, err := readTemplate(template, replace)
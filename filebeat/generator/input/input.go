@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package input
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/elastic/beats/v7/filebeat/generator"
+)
+
+// Generate creates the package of a new v2 input with its configuration,
+// registration, fields and tests.
+func Generate(input, beatPath, beatsPath string) error {
+	if err := generator.ValidateName("input", input); err != nil {
+		return err
+	}
+
+	inputPath := path.Join(beatPath, "input", input)
+	if generator.DirExists(inputPath) {
+		return fmt.Errorf("input already exists: %s", input)
+	}
+
+	err := generator.CreateDirectories(inputPath, "_meta")
+	if err != nil {
+		return err
+	}
+
+	replace := map[string]string{"input": input}
+	templatesPath := path.Join(beatsPath, "scripts", "input")
+	err = generator.CopyTemplates(templatesPath, inputPath, []string{path.Join("_meta", "fields.yml")}, replace)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range []string{"input.go", "config.go", "input_test.go"} {
+		err = generator.CopyTemplate(path.Join(templatesPath, f+".tmpl"), path.Join(inputPath, f), replace)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package input
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	beatPath := t.TempDir()
+
+	err := Generate("foo", beatPath, filepath.Join("..", ".."))
+	require.NoError(t, err)
+
+	for _, f := range []string{"input.go", "config.go", "input_test.go", "_meta/fields.yml"} {
+		assert.FileExists(t, filepath.Join(beatPath, "input", "foo", f))
+	}
+
+	content, err := os.ReadFile(filepath.Join(beatPath, "input", "foo", "input.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "package foo\n")
+	assert.NotContains(t, string(content), "{input}")
+
+	err = Generate("foo", beatPath, filepath.Join("..", ".."))
+	assert.ErrorContains(t, err, "input already exists")
+
+	err = Generate("foo-bar", beatPath, filepath.Join("..", ".."))
+	assert.ErrorContains(t, err, `invalid input name "foo-bar"`)
+}
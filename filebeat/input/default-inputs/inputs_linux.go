@@ -19,6 +19,7 @@ package inputs
 
 import (
 	"github.com/elastic/beats/v7/filebeat/input/journald"
+	"github.com/elastic/beats/v7/filebeat/input/kmsg"
 	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
 	cursor "github.com/elastic/beats/v7/filebeat/input/v2/input-cursor"
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -34,6 +35,8 @@ type osComponents interface {
 func osInputs(info beat.Info, log *logp.Logger, components osComponents) []v2.Plugin {
 	var plugins []v2.Plugin
 
+	plugins = append(plugins, kmsg.Plugin(log, components))
+
 	zeroPlugin := v2.Plugin{}
 	if journald := journald.Plugin(log, components); journald != zeroPlugin {
 		plugins = append(plugins, journald)
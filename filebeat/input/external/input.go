@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package external
+
+import (
+	"fmt"
+	"net"
+
+	sc "github.com/elastic/elastic-agent-shipper-client/pkg/proto"
+	"google.golang.org/grpc"
+
+	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
+	stateless "github.com/elastic/beats/v7/filebeat/input/v2/input-stateless"
+	"github.com/elastic/beats/v7/libbeat/feature"
+	plugins "github.com/elastic/beats/v7/libbeat/plugin/external"
+	conf "github.com/elastic/elastic-agent-libs/config"
+)
+
+// Plugins returns an input for every external input plugin loaded by the
+// Beat, using the name of the plugin as input type.
+func Plugins() []v2.Plugin {
+	manifests := plugins.Manifests(plugins.InputType)
+
+	result := make([]v2.Plugin, 0, len(manifests))
+	for _, m := range manifests {
+		m := m
+		result = append(result, v2.Plugin{
+			Name:       m.Name,
+			Stability:  feature.Experimental,
+			Deprecated: false,
+			Info:       "external input plugin " + m.Command,
+			Manager: stateless.NewInputManager(func(cfg *conf.C) (stateless.Input, error) {
+				return &input{manifest: m, config: cfg}, nil
+			}),
+		})
+	}
+	return result
+}
+
+type input struct {
+	manifest plugins.Manifest
+	config   *conf.C
+}
+
+func (in *input) Name() string { return in.manifest.Name }
+
+func (in *input) Test(v2.TestContext) error { return nil }
+
+// Run serves the Producer gRPC service on a unix socket and starts the
+// plugin, which publishes its events to it, until the input is stopped.
+func (in *input) Run(ctx v2.Context, publisher stateless.Publisher) error {
+	log := ctx.Logger.With("plugin", in.manifest.Name)
+
+	socket, err := plugins.SocketPath(in.manifest.Name)
+	if err != nil {
+		return err
+	}
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", socket, err)
+	}
+
+	server := grpc.NewServer()
+	sc.RegisterProducerServer(server, newProducer(log, publisher, ctx.Cancelation.Done()))
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Errorf("Producer server stopped: %v", err)
+		}
+	}()
+	defer server.Stop()
+
+	plugin, err := plugins.StartInput(in.manifest, "unix://"+socket, in.config)
+	if err != nil {
+		return err
+	}
+	defer plugin.Stop()
+
+	log.Infof("External input started, listening on %s", socket)
+	<-ctx.Cancelation.Done()
+	return nil
+}
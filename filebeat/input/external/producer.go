@@ -0,0 +1,197 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sc "github.com/elastic/elastic-agent-shipper-client/pkg/proto"
+	"github.com/elastic/elastic-agent-shipper-client/pkg/proto/messages"
+	"github.com/gofrs/uuid"
+
+	stateless "github.com/elastic/beats/v7/filebeat/input/v2/input-stateless"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const defaultPollingInterval = time.Second
+
+// producer implements the Producer gRPC service input plugins publish their
+// events to. Events are published to the pipeline as soon as they are
+// received, so they are reported as persisted once accepted.
+type producer struct {
+	sc.UnimplementedProducerServer
+
+	log       *logp.Logger
+	uuid      string
+	publisher stateless.Publisher
+	done      <-chan struct{}
+
+	mu    sync.Mutex
+	index uint64
+}
+
+func newProducer(log *logp.Logger, publisher stateless.Publisher, done <-chan struct{}) *producer {
+	return &producer{
+		log:       log,
+		uuid:      uuid.Must(uuid.NewV4()).String(),
+		publisher: publisher,
+		done:      done,
+	}
+}
+
+// PublishEvents publishes the events of the request. Events that cannot be
+// converted are dropped, they are still accepted so that the plugin does not
+// send them again.
+func (p *producer) PublishEvents(_ context.Context, req *messages.PublishRequest) (*messages.PublishReply, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, e := range req.Events {
+		event, err := toBeatEvent(e)
+		if err != nil {
+			p.log.Errorf("Dropping event: %v", err)
+		} else {
+			p.publisher.Publish(event)
+		}
+		p.index++
+	}
+
+	return &messages.PublishReply{
+		Uuid:           p.uuid,
+		AcceptedCount:  uint32(len(req.Events)),
+		AcceptedIndex:  p.index,
+		PersistedIndex: p.index,
+	}, nil
+}
+
+// PersistedIndex reports the index of the last accepted event every polling
+// interval if it changed, until the plugin disconnects or the input stops.
+func (p *producer) PersistedIndex(req *messages.PersistedIndexRequest, stream sc.Producer_PersistedIndexServer) error {
+	interval := req.GetPollingInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultPollingInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last uint64
+	send := func() error {
+		p.mu.Lock()
+		index := p.index
+		p.mu.Unlock()
+
+		last = index
+		return stream.Send(&messages.PersistedIndexReply{
+			Uuid:           p.uuid,
+			PersistedIndex: index,
+		})
+	}
+
+	if err := send(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-p.done:
+			return nil
+		case <-ticker.C:
+			p.mu.Lock()
+			changed := p.index != last
+			p.mu.Unlock()
+			if changed {
+				if err := send(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func toBeatEvent(e *messages.Event) (beat.Event, error) {
+	fields, err := toMap(e.GetFields())
+	if err != nil {
+		return beat.Event{}, fmt.Errorf("invalid fields: %w", err)
+	}
+	meta, err := toMap(e.GetMetadata())
+	if err != nil {
+		return beat.Event{}, fmt.Errorf("invalid metadata: %w", err)
+	}
+
+	timestamp := time.Now()
+	if ts := e.GetTimestamp(); ts != nil {
+		timestamp = ts.AsTime()
+	}
+
+	event := beat.Event{
+		Timestamp: timestamp,
+		Fields:    fields,
+	}
+	if len(meta) > 0 {
+		event.Meta = meta
+	}
+	return event, nil
+}
+
+func toMap(s *messages.Struct) (mapstr.M, error) {
+	m := mapstr.M{}
+	for k, v := range s.GetFields() {
+		value, err := toValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		m[k] = value
+	}
+	return m, nil
+}
+
+func toValue(v *messages.Value) (interface{}, error) {
+	switch kind := v.GetKind().(type) {
+	case nil, *messages.Value_NullValue:
+		return nil, nil
+	case *messages.Value_NumberValue:
+		return kind.NumberValue, nil
+	case *messages.Value_StringValue:
+		return kind.StringValue, nil
+	case *messages.Value_BoolValue:
+		return kind.BoolValue, nil
+	case *messages.Value_TimestampValue:
+		return kind.TimestampValue.AsTime(), nil
+	case *messages.Value_StructValue:
+		return toMap(kind.StructValue)
+	case *messages.Value_ListValue:
+		values := kind.ListValue.GetValues()
+		list := make([]interface{}, 0, len(values))
+		for i, item := range values {
+			value, err := toValue(item)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			list = append(list, value)
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", kind)
+	}
+}
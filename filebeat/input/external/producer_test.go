@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package external
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/elastic/elastic-agent-shipper-client/pkg/proto/messages"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+type publisher struct {
+	events []beat.Event
+}
+
+func (p *publisher) Publish(e beat.Event) { p.events = append(p.events, e) }
+
+func TestPublishEvents(t *testing.T) {
+	pub := &publisher{}
+	p := newProducer(logp.NewLogger("test"), pub, make(chan struct{}))
+
+	ts := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	reply, err := p.PublishEvents(context.Background(), &messages.PublishRequest{
+		Events: []*messages.Event{
+			{
+				Timestamp: timestamppb.New(ts),
+				Fields: &messages.Struct{Fields: map[string]*messages.Value{
+					"message": {Kind: &messages.Value_StringValue{StringValue: "hello"}},
+					"count":   {Kind: &messages.Value_NumberValue{NumberValue: 42}},
+					"tags": {Kind: &messages.Value_ListValue{ListValue: &messages.ListValue{Values: []*messages.Value{
+						{Kind: &messages.Value_StringValue{StringValue: "a"}},
+						{Kind: &messages.Value_BoolValue{BoolValue: true}},
+					}}}},
+					"nested": {Kind: &messages.Value_StructValue{StructValue: &messages.Struct{Fields: map[string]*messages.Value{
+						"empty": {Kind: &messages.Value_NullValue{}},
+					}}}},
+				}},
+				Metadata: &messages.Struct{Fields: map[string]*messages.Value{
+					"_id": {Kind: &messages.Value_StringValue{StringValue: "id-1"}},
+				}},
+			},
+			{
+				Fields: &messages.Struct{Fields: map[string]*messages.Value{
+					"message": {Kind: &messages.Value_StringValue{StringValue: "world"}},
+				}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, p.uuid, reply.Uuid)
+	assert.EqualValues(t, 2, reply.AcceptedCount)
+	assert.EqualValues(t, 2, reply.AcceptedIndex)
+	assert.EqualValues(t, 2, reply.PersistedIndex)
+
+	require.Len(t, pub.events, 2)
+	assert.Equal(t, ts, pub.events[0].Timestamp)
+	assert.Equal(t, mapstr.M{
+		"message": "hello",
+		"count":   float64(42),
+		"tags":    []interface{}{"a", true},
+		"nested":  mapstr.M{"empty": nil},
+	}, pub.events[0].Fields)
+	assert.Equal(t, mapstr.M{"_id": "id-1"}, pub.events[0].Meta)
+
+	assert.Equal(t, mapstr.M{"message": "world"}, pub.events[1].Fields)
+	assert.Nil(t, pub.events[1].Meta)
+	assert.False(t, pub.events[1].Timestamp.IsZero())
+
+	reply, err = p.PublishEvents(context.Background(), &messages.PublishRequest{
+		Uuid:   p.uuid,
+		Events: []*messages.Event{{}},
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, reply.AcceptedIndex)
+}
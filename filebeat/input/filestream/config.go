@@ -44,6 +44,7 @@ type config struct {
 	IgnoreInactive ignoreInactiveType `config:"ignore_inactive"`
 	Rotation       *conf.Namespace    `config:"rotation"`
 	TakeOver       bool               `config:"take_over"`
+	Resources      resourcesConfig    `config:"resources"`
 }
 
 type closerConfig struct {
@@ -203,6 +203,14 @@ func (p *copyTruncateFileProspector) Run(ctx input.Context, s loginp.StateMetada
 
 	defer p.stopHarvesterGroup(log, hg)
 
+	if ctx.Once {
+		ignoreInactiveSince := getIgnoreSince(p.ignoreInactiveSince, ctx.Agent)
+		p.runOnce(log, hg, func(fe loginp.FSEvent, src loginp.Source) {
+			p.onFSEvent(loggerWithEvent(log, fe, src), ctx, fe, src, s, hg, ignoreInactiveSince)
+		})
+		return
+	}
+
 	var tg unison.MultiErrGroup
 
 	tg.Go(func() error {
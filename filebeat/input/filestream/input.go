@@ -61,6 +61,7 @@ type filestream struct {
 	encoding        encoding.Encoding
 	closerConfig    closerConfig
 	parsers         parser.Config
+	budget          *resourceBudget
 }
 
 // Plugin creates a new filestream input plugin for creating a stateful input.
@@ -86,6 +87,13 @@ func configure(cfg *conf.C) (loginp.Prospector, loginp.Harvester, error) {
 		return nil, nil, err
 	}
 
+	var settings struct {
+		ID string `config:"id"`
+	}
+	if err := cfg.Unpack(&settings); err != nil {
+		return nil, nil, err
+	}
+
 	prospector, err := newProspector(config)
 	if err != nil {
 		return nil, nil, fmt.Errorf("cannot create prospector: %w", err)
@@ -101,6 +109,7 @@ func configure(cfg *conf.C) (loginp.Prospector, loginp.Harvester, error) {
 		encodingFactory: encodingFactory,
 		closerConfig:    config.Close,
 		parsers:         config.Reader.Parsers,
+		budget:          newResourceBudget(settings.ID, config.Resources),
 	}
 
 	return prospector, filestream, nil
@@ -108,13 +117,19 @@ func configure(cfg *conf.C) (loginp.Prospector, loginp.Harvester, error) {
 
 func (inp *filestream) Name() string { return pluginName }
 
+// OnInputStart registers the metrics shared by all harvesters of the input.
+func (inp *filestream) OnInputStart() { inp.budget.start() }
+
+// OnInputStop unregisters the metrics shared by all harvesters of the input.
+func (inp *filestream) OnInputStop() { inp.budget.stop() }
+
 func (inp *filestream) Test(src loginp.Source, ctx input.TestContext) error {
 	fs, ok := src.(fileSource)
 	if !ok {
 		return fmt.Errorf("not file source")
 	}
 
-	reader, err := inp.open(ctx.Logger, ctx.Cancelation, fs, 0)
+	reader, err := inp.open(ctx.Logger, ctx.Cancelation, fs, 0, false)
 	if err != nil {
 		return err
 	}
@@ -135,7 +150,16 @@ func (inp *filestream) Run(
 	log := ctx.Logger.With("path", fs.newPath).With("state-id", src.Name())
 	state := initState(log, cursor, fs)
 
-	r, err := inp.open(log, ctx.Cancelation, fs, state.Offset)
+	// Reserve the worst case memory required by the reader pipeline
+	// before opening the file, so files of a busy input wait for their turn.
+	release, err := inp.budget.reserve(ctxtool.FromCanceller(ctx.Cancelation), int64(inp.readerConfig.BufferSize+inp.readerConfig.MaxBytes))
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// When running once, the files are read to EOF and closed.
+	r, err := inp.open(log, ctx.Cancelation, fs, state.Offset, ctx.Once)
 	if err != nil {
 		log.Errorf("File could not be opened for reading: %v", err)
 		return err
@@ -167,7 +191,7 @@ func initState(log *logp.Logger, c loginp.Cursor, s fileSource) state {
 	return state
 }
 
-func (inp *filestream) open(log *logp.Logger, canceler input.Canceler, fs fileSource, offset int64) (reader.Reader, error) {
+func (inp *filestream) open(log *logp.Logger, canceler input.Canceler, fs fileSource, offset int64, closeOnEOF bool) (reader.Reader, error) {
 	f, err := inp.openFile(log, fs.newPath, offset)
 	if err != nil {
 		return nil, err
@@ -178,7 +202,7 @@ func (inp *filestream) open(log *logp.Logger, canceler input.Canceler, fs fileSo
 	// if the file is archived, it means that it is not going to be updated in the future
 	// thus, when EOF is reached, it can be closed
 	closerCfg := inp.closerConfig
-	if fs.archived && !inp.closerConfig.Reader.OnEOF {
+	if (fs.archived || closeOnEOF) && !inp.closerConfig.Reader.OnEOF {
 		closerCfg = closerConfig{
 			Reader: readerCloserConfig{
 				OnEOF:         true,
@@ -329,6 +353,10 @@ func (inp *filestream) readFromSource(
 
 		s.Offset += int64(message.Bytes)
 
+		if err := inp.budget.wait(ctxtool.FromCanceller(ctx.Cancelation), message.Bytes); err != nil {
+			return nil
+		}
+
 		if message.IsEmpty() || inp.isDroppedLine(log, string(message.Content)) {
 			continue
 		}
@@ -50,6 +50,15 @@ type Harvester interface {
 	Run(input.Context, Source, Cursor, Publisher) error
 }
 
+// InputLifecycle can optionally be implemented by a Harvester to manage
+// resources shared by all harvesters of an input. OnInputStart is called
+// before the prospector is started, OnInputStop after all harvesters of the
+// input have been stopped.
+type InputLifecycle interface {
+	OnInputStart()
+	OnInputStop()
+}
+
 type readerGroup struct {
 	mu    sync.Mutex
 	limit uint64
@@ -125,6 +134,8 @@ type HarvesterGroup interface {
 	Stop(Source)
 	// StopGroup cancels all running Harvesters.
 	StopGroup() error
+	// Wait waits for all started Harvesters to stop.
+	Wait()
 }
 
 type defaultHarvesterGroup struct {
@@ -136,6 +147,7 @@ type defaultHarvesterGroup struct {
 	ackCH        *updateChan
 	identifier   *sourceIdentifier
 	tg           unison.TaskGroup
+	wg           sync.WaitGroup
 }
 
 func (hg *defaultHarvesterGroup) Start(ctx input.Context, s Source) {
@@ -144,7 +156,7 @@ func (hg *defaultHarvesterGroup) Start(ctx input.Context, s Source) {
 	ctx.Logger = ctx.Logger.With("source_file", sourceName)
 	ctx.Logger.Debug("Starting harvester for file")
 
-	hg.tg.Go(startHarvester(ctx, hg, s, false))
+	hg.goTracked(startHarvester(ctx, hg, s, false))
 }
 
 // Restart starts the Harvester for a Source if a Harvester is already running it waits for it
@@ -155,7 +167,19 @@ func (hg *defaultHarvesterGroup) Restart(ctx input.Context, s Source) {
 	ctx.Logger = ctx.Logger.With("source_file", sourceName)
 	ctx.Logger.Debug("Restarting harvester for file")
 
-	hg.tg.Go(startHarvester(ctx, hg, s, true))
+	hg.goTracked(startHarvester(ctx, hg, s, true))
+}
+
+// goTracked runs fn in the task group, Wait waits for it to return.
+func (hg *defaultHarvesterGroup) goTracked(fn func(context.Context) error) {
+	hg.wg.Add(1)
+	err := hg.tg.Go(func(canceler context.Context) error {
+		defer hg.wg.Done()
+		return fn(canceler)
+	})
+	if err != nil {
+		hg.wg.Done()
+	}
 }
 
 func startHarvester(ctx input.Context, hg *defaultHarvesterGroup, s Source, restart bool) func(context.Context) error {
@@ -226,7 +250,7 @@ func (hg *defaultHarvesterGroup) Continue(ctx input.Context, previous, next Sour
 	prevID := hg.identifier.ID(previous)
 	nextID := hg.identifier.ID(next)
 
-	hg.tg.Go(func(canceler context.Context) error {
+	hg.goTracked(func(canceler context.Context) error {
 		previousResource, err := lock(ctx, hg.store, prevID)
 		if err != nil {
 			return fmt.Errorf("error while locking previous resource: %v", err)
@@ -263,6 +287,11 @@ func (hg *defaultHarvesterGroup) StopGroup() error {
 	return hg.tg.Stop()
 }
 
+// Wait waits for all started Harvesters to stop.
+func (hg *defaultHarvesterGroup) Wait() {
+	hg.wg.Wait()
+}
+
 // Lock locks a key for exclusive access and returns an resource that can be used to modify
 // the cursor state and unlock the key.
 func lock(ctx input.Context, store *store, key string) (*resource, error) {
@@ -43,6 +43,10 @@ type managedInput struct {
 // Name is required to implement the v2.Input interface
 func (inp *managedInput) Name() string { return inp.harvester.Name() }
 
+// SupportsOnce is required to implement the v2.OnceInput interface, the
+// prospectors stop once they have read the existing sources.
+func (inp *managedInput) SupportsOnce() bool { return true }
+
 // Test runs the Test method for each configured source.
 func (inp *managedInput) Test(ctx input.TestContext) error {
 	return inp.prospector.Test()
@@ -75,6 +79,11 @@ func (inp *managedInput) Run(
 		},
 	}
 
+	if lifecycle, ok := inp.harvester.(InputLifecycle); ok {
+		lifecycle.OnInputStart()
+		defer lifecycle.OnInputStop()
+	}
+
 	prospectorStore := inp.manager.getRetainedStore()
 	defer prospectorStore.Release()
 	sourceStore := newSourceStore(prospectorStore, inp.sourceIdentifier)
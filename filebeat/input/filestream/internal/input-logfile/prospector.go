@@ -29,6 +29,8 @@ type Prospector interface {
 	Init(c, g ProspectorCleaner, newID func(Source) string) error
 	// Run starts the event loop and handles the incoming events
 	// either by starting/stopping a harvester, or updating the statestore.
+	// If the context is set to run once, Run starts the harvesters of the
+	// existing sources and returns once they have all stopped.
 	Run(input.Context, StateMetadataUpdater, HarvesterGroup)
 	// Test checks if the Prospector is able to run the configuration
 	// specified by the user.
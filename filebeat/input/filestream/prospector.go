@@ -133,6 +133,14 @@ func (p *fileProspector) Run(ctx input.Context, s loginp.StateMetadataUpdater, h
 
 	defer p.stopHarvesterGroup(log, hg)
 
+	if ctx.Once {
+		ignoreInactiveSince := getIgnoreSince(p.ignoreInactiveSince, ctx.Agent)
+		p.runOnce(log, hg, func(fe loginp.FSEvent, src loginp.Source) {
+			p.onFSEvent(loggerWithEvent(log, fe, src), ctx, fe, src, s, hg, ignoreInactiveSince)
+		})
+		return
+	}
+
 	var tg unison.MultiErrGroup
 
 	tg.Go(func() error {
@@ -219,6 +227,18 @@ func (p *fileProspector) onFSEvent(
 	}
 }
 
+// runOnce handles the files found by a single scan of the file system as
+// created, then waits for all the harvesters to read their file to EOF.
+func (p *fileProspector) runOnce(log *logp.Logger, hg loginp.HarvesterGroup, onCreate func(loginp.FSEvent, loginp.Source)) {
+	files := p.filewatcher.GetFiles()
+	log.Infof("Running once, reading %d files to EOF", len(files))
+	for path, info := range files {
+		fe := loginp.FSEvent{NewPath: path, Op: loginp.OpCreate, Info: info}
+		onCreate(fe, p.identifier.GetSource(fe))
+	}
+	hg.Wait()
+}
+
 func (p *fileProspector) isFileIgnored(log *logp.Logger, fe loginp.FSEvent, ignoreInactiveSince time.Time) bool {
 	if p.ignoreOlder > 0 {
 		now := time.Now()
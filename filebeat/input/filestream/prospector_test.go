@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	loginp "github.com/elastic/beats/v7/filebeat/input/filestream/internal/input-logfile"
 	input "github.com/elastic/beats/v7/filebeat/input/v2"
@@ -258,6 +259,32 @@ func TestProspectorNewAndUpdatedFiles(t *testing.T) {
 	}
 }
 
+func TestProspectorRunOnce(t *testing.T) {
+	minuteAgo := time.Now().Add(-1 * time.Minute)
+
+	p := fileProspector{
+		filewatcher: newMockFileWatcherWithFiles(map[string]os.FileInfo{
+			"/path/to/file":       testFileInfo{"/path/to/file", 5, time.Now(), nil},
+			"/path/to/other/file": testFileInfo{"/path/to/other/file", 5, time.Now(), nil},
+			"/path/to/old/file":   testFileInfo{"/path/to/old/file", 5, minuteAgo, nil},
+		}),
+		identifier:  mustPathIdentifier(false),
+		ignoreOlder: 10 * time.Second,
+	}
+	ctx := input.Context{Logger: logp.L(), Cancelation: context.Background(), Once: true}
+	hg := newTestHarvesterGroup()
+
+	// Run must return without waiting for file system events.
+	p.Run(ctx, newMockMetadataUpdater(), hg)
+
+	require.Len(t, hg.events, 4)
+	assert.ElementsMatch(t, []harvesterEvent{
+		harvesterStart("path::/path/to/file"),
+		harvesterStart("path::/path/to/other/file"),
+	}, hg.events[:2])
+	assert.Equal(t, []harvesterEvent{harvesterGroupWait{}, harvesterGroupStop{}}, hg.events[2:])
+}
+
 // TestProspectorHarvesterUpdateIgnoredFiles checks if the prospector can
 // save the size of an ignored file to the registry. If the ignored
 // file is updated, and has to be collected, a new harvester is started.
@@ -472,6 +499,10 @@ type harvesterGroupStop struct{}
 
 func (h harvesterGroupStop) String() string { return "stop" }
 
+type harvesterGroupWait struct{}
+
+func (h harvesterGroupWait) String() string { return "wait" }
+
 type testHarvesterGroup struct {
 	events []harvesterEvent
 }
@@ -501,6 +532,10 @@ func (t *testHarvesterGroup) StopGroup() error {
 	return nil
 }
 
+func (t *testHarvesterGroup) Wait() {
+	t.events = append(t.events, harvesterGroupWait{})
+}
+
 type mockFileWatcher struct {
 	events      []loginp.FSEvent
 	filesOnDisk map[string]os.FileInfo
@@ -0,0 +1,250 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package filestream
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// resourcesConfig configures the resource budget shared by all harvesters
+// of a single filestream input.
+type resourcesConfig struct {
+	// MaxBytesPerSec limits the number of bytes read per second across
+	// all files of the input. 0 means no limit.
+	MaxBytesPerSec cfgtype.ByteSize `config:"max_bytes_per_sec" validate:"min=0"`
+	// MaxMemory is an approximate cap of the memory used by the readers of the
+	// input. Each harvester reserves the worst case size of its buffers
+	// (buffer_size + message_max_bytes) before it starts reading. 0 means no limit.
+	MaxMemory cfgtype.ByteSize `config:"max_memory" validate:"min=0"`
+}
+
+func (c *resourcesConfig) enabled() bool {
+	return c.MaxBytesPerSec > 0 || c.MaxMemory > 0
+}
+
+// resourceBudget enforces the resources configuration of an input.
+// The zero value is not usable, use newResourceBudget instead. A nil
+// resourceBudget does not enforce any limits.
+type resourceBudget struct {
+	id      string
+	limiter *rate.Limiter
+	memory  *semaphore.Weighted
+	maxMem  int64
+
+	mu      sync.Mutex
+	metrics *resourceMetrics
+}
+
+type resourceMetrics struct {
+	unregister func()
+
+	bytesRead       *monitoring.Uint // number of bytes read from all files
+	throttled       *monitoring.Uint // number of times reading was delayed by the read budget
+	throttledTime   *monitoring.Uint // total time in nanoseconds reading was delayed by the read budget
+	memoryReserved  *monitoring.Int  // memory currently reserved by running harvesters
+	memoryWaits     *monitoring.Uint // number of harvesters that had to wait for memory
+	activeReservers *monitoring.Uint // number of harvesters holding a memory reservation
+}
+
+// newResourceBudget creates a new budget for the input with the given ID.
+// If no limits are configured nil is returned.
+func newResourceBudget(id string, cfg resourcesConfig) *resourceBudget {
+	if !cfg.enabled() {
+		return nil
+	}
+
+	b := &resourceBudget{id: id}
+	if cfg.MaxBytesPerSec > 0 {
+		b.limiter = rate.NewLimiter(rate.Limit(cfg.MaxBytesPerSec), int(cfg.MaxBytesPerSec))
+	}
+	if cfg.MaxMemory > 0 {
+		b.maxMem = int64(cfg.MaxMemory)
+		b.memory = semaphore.NewWeighted(b.maxMem)
+	}
+	return b
+}
+
+// reserve blocks until the harvester can reserve size bytes of memory.
+// The returned function must be called once the harvester stops.
+func (b *resourceBudget) reserve(ctx context.Context, size int64) (release func(), err error) {
+	if b == nil {
+		return func() {}, nil
+	}
+
+	b.mu.Lock()
+	m := b.metrics
+	b.mu.Unlock()
+
+	if b.memory != nil {
+		if size > b.maxMem {
+			// A single harvester would never fit into the budget, let it run
+			// with the full budget instead of blocking forever.
+			size = b.maxMem
+		}
+		if !b.memory.TryAcquire(size) {
+			m.addMemoryWait()
+			if err := b.memory.Acquire(ctx, size); err != nil {
+				return nil, fmt.Errorf("waiting for memory budget: %w", err)
+			}
+		}
+		m.reserved(size)
+	}
+
+	return func() {
+		if b.memory != nil {
+			b.memory.Release(size)
+			m.reserved(-size)
+		}
+	}, nil
+}
+
+// start registers the budget metrics. The metrics are kept for the lifetime
+// of the input, so the counters are not reset while no harvester is running.
+func (b *resourceBudget) start() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.metrics == nil {
+		b.metrics = newResourceMetrics(b.id)
+	}
+}
+
+// stop unregisters the budget metrics once all harvesters have stopped.
+func (b *resourceBudget) stop() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.metrics.close()
+	b.metrics = nil
+}
+
+// wait blocks until n bytes can be read without exceeding the read budget.
+func (b *resourceBudget) wait(ctx context.Context, n int) error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	m := b.metrics
+	b.mu.Unlock()
+	m.addBytes(n)
+
+	if b.limiter == nil || n == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	throttled := false
+	burst := b.limiter.Burst()
+	for n > 0 {
+		chunk := n
+		if chunk > burst {
+			chunk = burst
+		}
+		r := b.limiter.ReserveN(time.Now(), chunk)
+		if d := r.Delay(); d > 0 {
+			throttled = true
+			t := time.NewTimer(d)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				r.Cancel()
+				return ctx.Err()
+			case <-t.C:
+			}
+		}
+		n -= chunk
+	}
+	if throttled {
+		m.addThrottled(time.Since(start))
+	}
+	return nil
+}
+
+// newResourceMetrics registers the resource metrics of an input. If id is
+// empty a nil resourceMetrics is returned.
+func newResourceMetrics(id string) *resourceMetrics {
+	if id == "" {
+		return nil
+	}
+	reg, unreg := inputmon.NewInputRegistry(pluginName, id, nil)
+	return &resourceMetrics{
+		unregister:      unreg,
+		bytesRead:       monitoring.NewUint(reg, "read_bytes_total"),
+		throttled:       monitoring.NewUint(reg, "read_throttled_total"),
+		throttledTime:   monitoring.NewUint(reg, "read_throttled_ns_total"),
+		memoryReserved:  monitoring.NewInt(reg, "memory_reserved_bytes"),
+		memoryWaits:     monitoring.NewUint(reg, "memory_wait_total"),
+		activeReservers: monitoring.NewUint(reg, "harvesters_reserved"),
+	}
+}
+
+func (m *resourceMetrics) addBytes(n int) {
+	if m == nil {
+		return
+	}
+	m.bytesRead.Add(uint64(n))
+}
+
+func (m *resourceMetrics) addThrottled(d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.throttled.Inc()
+	m.throttledTime.Add(uint64(d))
+}
+
+func (m *resourceMetrics) addMemoryWait() {
+	if m == nil {
+		return
+	}
+	m.memoryWaits.Inc()
+}
+
+func (m *resourceMetrics) reserved(size int64) {
+	if m == nil {
+		return
+	}
+	m.memoryReserved.Add(size)
+	if size > 0 {
+		m.activeReservers.Inc()
+	} else {
+		m.activeReservers.Dec()
+	}
+}
+
+func (m *resourceMetrics) close() {
+	if m == nil {
+		return
+	}
+	m.unregister()
+}
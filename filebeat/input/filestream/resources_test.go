@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package filestream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceBudget(t *testing.T) {
+	t.Run("no limits returns nil budget", func(t *testing.T) {
+		b := newResourceBudget("", resourcesConfig{})
+		require.Nil(t, b)
+
+		release, err := b.reserve(context.Background(), 1024)
+		require.NoError(t, err)
+		release()
+		require.NoError(t, b.wait(context.Background(), 1024))
+	})
+
+	t.Run("memory reservation blocks until released", func(t *testing.T) {
+		b := newResourceBudget("", resourcesConfig{MaxMemory: 100})
+
+		release, err := b.reserve(context.Background(), 60)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = b.reserve(ctx, 60)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		release()
+		release2, err := b.reserve(context.Background(), 60)
+		require.NoError(t, err)
+		release2()
+	})
+
+	t.Run("oversized reservation uses the full budget", func(t *testing.T) {
+		b := newResourceBudget("", resourcesConfig{MaxMemory: 10})
+
+		release, err := b.reserve(context.Background(), 1000)
+		require.NoError(t, err)
+		release()
+	})
+
+	t.Run("read budget throttles", func(t *testing.T) {
+		b := newResourceBudget("", resourcesConfig{MaxBytesPerSec: 1000})
+
+		start := time.Now()
+		// the first 1000 bytes are served from the burst, the next 500 need
+		// to wait for roughly half a second.
+		require.NoError(t, b.wait(context.Background(), 1500))
+		require.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+	})
+
+	t.Run("read budget honours cancellation", func(t *testing.T) {
+		b := newResourceBudget("", resourcesConfig{MaxBytesPerSec: 10})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.Error(t, b.wait(ctx, 100))
+	})
+
+	t.Run("metrics are kept while the input is idle", func(t *testing.T) {
+		b := newResourceBudget("resource-budget-test", resourcesConfig{MaxMemory: 100})
+		b.start()
+		defer b.stop()
+
+		release, err := b.reserve(context.Background(), 10)
+		require.NoError(t, err)
+		require.NoError(t, b.wait(context.Background(), 42))
+		release()
+
+		require.NotNil(t, b.metrics)
+		require.Equal(t, uint64(42), b.metrics.bytesRead.Get())
+		require.Equal(t, int64(0), b.metrics.memoryReserved.Get())
+	})
+}
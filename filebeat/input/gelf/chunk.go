@@ -0,0 +1,160 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gelf
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	chunkHeaderSize = 12
+	maxChunks       = 128
+)
+
+var errTooManyPending = errors.New("too many chunked messages pending")
+
+// isChunk reports whether a datagram is a chunk of a GELF message. Chunks
+// start with the 0x1e 0x0f magic bytes.
+func isChunk(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x1e && data[1] == 0x0f
+}
+
+// chunkKey identifies a chunked message. The message ID is only unique for a
+// sender.
+type chunkKey struct {
+	source string
+	id     [8]byte
+}
+
+// partial is a message of which only some chunks were received.
+type partial struct {
+	chunks   [][]byte
+	received int
+	size     int
+	first    time.Time
+}
+
+// assembler reassembles the chunked GELF messages. A message whose chunks
+// were not all received within the timeout is dropped. It is safe for
+// concurrent use by the workers of the input.
+type assembler struct {
+	timeout    time.Duration
+	maxPending int
+	maxSize    int
+
+	mu      sync.Mutex
+	pending map[chunkKey]*partial
+	expired int // number of messages dropped since the last call to expire
+}
+
+func newAssembler(timeout time.Duration, maxPending, maxSize int) *assembler {
+	return &assembler{
+		timeout:    timeout,
+		maxPending: maxPending,
+		maxSize:    maxSize,
+		pending:    map[chunkKey]*partial{},
+	}
+}
+
+// add adds a chunk received from source. It returns the payload of the
+// message when it is complete, or nil while chunks are missing.
+func (a *assembler) add(source string, data []byte, now time.Time) ([]byte, error) {
+	if len(data) < chunkHeaderSize {
+		return nil, errors.New("chunk header too short")
+	}
+	var key chunkKey
+	key.source = source
+	copy(key.id[:], data[2:10])
+	seq, count := int(data[10]), int(data[11])
+	if count == 0 || count > maxChunks {
+		return nil, fmt.Errorf("invalid chunk count %d", count)
+	}
+	if seq >= count {
+		return nil, fmt.Errorf("chunk sequence number %d out of range of %d chunks", seq, count)
+	}
+	payload := data[chunkHeaderSize:]
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	p, found := a.pending[key]
+	if found && now.Sub(p.first) > a.timeout {
+		delete(a.pending, key)
+		a.expired++
+		found = false
+	}
+	if !found {
+		if len(a.pending) >= a.maxPending {
+			a.expireLocked(now)
+			if len(a.pending) >= a.maxPending {
+				return nil, errTooManyPending
+			}
+		}
+		p = &partial{chunks: make([][]byte, count), first: now}
+		a.pending[key] = p
+	}
+	if len(p.chunks) != count {
+		delete(a.pending, key)
+		return nil, fmt.Errorf("chunk count changed from %d to %d", len(p.chunks), count)
+	}
+	if p.chunks[seq] != nil {
+		// Retransmitted chunk.
+		return nil, nil
+	}
+	p.size += len(payload)
+	if p.size > a.maxSize {
+		delete(a.pending, key)
+		return nil, errTooLarge
+	}
+	// The buffer of the datagram is reused by the reader.
+	p.chunks[seq] = append([]byte(nil), payload...)
+	p.received++
+	if p.received < count {
+		return nil, nil
+	}
+
+	delete(a.pending, key)
+	msg := make([]byte, 0, p.size)
+	for _, c := range p.chunks {
+		msg = append(msg, c...)
+	}
+	return msg, nil
+}
+
+// expire drops the messages whose chunks were not all received within the
+// timeout. It returns the number of messages dropped since its last call.
+func (a *assembler) expire(now time.Time) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.expireLocked(now)
+	n := a.expired
+	a.expired = 0
+	return n
+}
+
+func (a *assembler) expireLocked(now time.Time) {
+	for k, p := range a.pending {
+		if now.Sub(p.first) > a.timeout {
+			delete(a.pending, k)
+			a.expired++
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gelf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func chunk(id byte, seq, count int, payload string) []byte {
+	b := []byte{0x1e, 0x0f, id, 0, 0, 0, 0, 0, 0, 0, byte(seq), byte(count)}
+	return append(b, payload...)
+}
+
+func TestAssembler(t *testing.T) {
+	now := time.Now()
+	a := newAssembler(5*time.Second, 10, 1024)
+
+	assert.True(t, isChunk(chunk(1, 0, 3, "a")))
+	assert.False(t, isChunk([]byte(sample)))
+
+	msg, err := a.add("192.0.2.1:12201", chunk(1, 2, 3, "c"), now)
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+	// Same message ID from another sender.
+	msg, err = a.add("192.0.2.2:12201", chunk(1, 0, 2, "x"), now)
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+	msg, err = a.add("192.0.2.1:12201", chunk(1, 0, 3, "a"), now)
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+	// Retransmitted chunk.
+	msg, err = a.add("192.0.2.1:12201", chunk(1, 0, 3, "a"), now)
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+	msg, err = a.add("192.0.2.1:12201", chunk(1, 1, 3, "b"), now)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", string(msg))
+
+	// The incomplete message of the second sender expires.
+	assert.Equal(t, 0, a.expire(now.Add(time.Second)))
+	assert.Equal(t, 1, a.expire(now.Add(6*time.Second)))
+	assert.Empty(t, a.pending)
+}
+
+func TestAssemblerErrors(t *testing.T) {
+	now := time.Now()
+
+	tests := map[string]struct {
+		chunks [][]byte
+	}{
+		"short header":       {chunks: [][]byte{{0x1e, 0x0f, 1}}},
+		"too many chunks":    {chunks: [][]byte{chunk(1, 0, 129, "a")}},
+		"sequence too large": {chunks: [][]byte{chunk(1, 3, 3, "a")}},
+		"count changed":      {chunks: [][]byte{chunk(1, 0, 3, "a"), chunk(1, 1, 2, "b")}},
+		"too large":          {chunks: [][]byte{chunk(1, 0, 2, "0123456789"), chunk(1, 1, 2, "0123456789")}},
+		"too many pending":   {chunks: [][]byte{chunk(1, 0, 2, "a"), chunk(2, 0, 2, "a"), chunk(3, 0, 2, "a")}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := newAssembler(5*time.Second, 2, 16)
+			var err error
+			for _, c := range test.chunks {
+				_, err = a.add("192.0.2.1:12201", c, now)
+			}
+			assert.Error(t, err)
+		})
+	}
+}
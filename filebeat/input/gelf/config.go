@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gelf
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	conf "github.com/elastic/elastic-agent-libs/config"
+)
+
+type config struct {
+	// Protocol is the udp or tcp server receiving the messages.
+	Protocol conf.Namespace `config:"protocol"`
+	// MaxDecompressedSize is the maximum size of a message once
+	// reassembled and decompressed.
+	MaxDecompressedSize cfgtype.ByteSize `config:"max_decompressed_size" validate:"min=1"`
+	// Chunks configures the reassembly of the chunked messages received
+	// over UDP.
+	Chunks chunksConfig `config:"chunks"`
+}
+
+type chunksConfig struct {
+	// Timeout is the time to receive all the chunks of a message, from
+	// its first chunk. GELF senders expect it to be 5 seconds.
+	Timeout time.Duration `config:"timeout" validate:"positive,nonzero"`
+	// MaxPending is the maximum number of messages being reassembled.
+	MaxPending int `config:"max_pending" validate:"min=1"`
+}
+
+func defaultConfig() config {
+	return config{
+		MaxDecompressedSize: 1 * humanize.MiByte,
+		Chunks: chunksConfig{
+			Timeout:    5 * time.Second,
+			MaxPending: 1000,
+		},
+	}
+}
+
+type tcpConfig struct {
+	tcp.Config `config:",inline"`
+	// Delimiter separates the messages sent over a connection. GELF uses
+	// a null byte.
+	Delimiter string `config:"delimiter" validate:"nonzero"`
+}
+
+func defaultTCP() tcpConfig {
+	return tcpConfig{
+		Config: tcp.Config{
+			Timeout:        time.Minute * 5,
+			MaxMessageSize: 20 * humanize.MiByte,
+		},
+		Delimiter: "\x00",
+	}
+}
+
+func defaultUDP() udp.Config {
+	return udp.Config{
+		// Unchunked messages can use the whole datagram.
+		MaxMessageSize: 64 * humanize.KiByte,
+		Timeout:        time.Minute * 5,
+	}
+}
+
+// Validate checks that the protocol is set and is udp or tcp.
+func (c *config) Validate() error {
+	switch name := c.Protocol.Name(); name {
+	case udp.Name, tcp.Name:
+		return nil
+	case "":
+		return fmt.Errorf("protocol.%s or protocol.%s must be set", udp.Name, tcp.Name)
+	default:
+		return fmt.Errorf("unsupported protocol %q, must be %s or %s", name, udp.Name, tcp.Name)
+	}
+}
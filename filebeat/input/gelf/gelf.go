@@ -0,0 +1,209 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gelf
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// severityLabels are the names of the syslog severity levels used by the
+// level field of GELF messages.
+var severityLabels = []string{
+	"Emergency",
+	"Alert",
+	"Critical",
+	"Error",
+	"Warning",
+	"Notice",
+	"Informational",
+	"Debug",
+}
+
+var (
+	errTooLarge       = errors.New("decompressed message exceeds max_decompressed_size")
+	errNoShortMessage = errors.New("missing short_message field")
+	errNoHost         = errors.New("missing host field")
+)
+
+// decompress returns the payload of a GELF message, inflating it if it is
+// compressed with gzip or zlib. The size of the payload is limited to max
+// bytes.
+func decompress(data []byte, max int) ([]byte, error) {
+	var r io.Reader
+	var err error
+	switch {
+	case len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b:
+		r, err = gzip.NewReader(bytes.NewReader(data))
+	case len(data) >= 2 && data[0]&0x0f == 0x08 && (uint16(data[0])<<8|uint16(data[1]))%31 == 0:
+		r, err = zlib.NewReader(bytes.NewReader(data))
+	default:
+		if len(data) > max {
+			return nil, errTooLarge
+		}
+		return data, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress message: %w", err)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(r, int64(max)+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress message: %w", err)
+	}
+	if len(b) > max {
+		return nil, errTooLarge
+	}
+	return b, nil
+}
+
+// decodeEvent decodes an uncompressed GELF message into an event with ECS
+// fields:
+//
+//	short_message -> message
+//	full_message  -> gelf.full_message
+//	host          -> host.hostname
+//	timestamp     -> @timestamp
+//	level         -> log.syslog.severity.code, log.syslog.severity.name and log.level
+//	facility      -> log.syslog.facility.name
+//	file          -> log.origin.file.name
+//	line          -> log.origin.file.line
+//	version       -> gelf.version
+//	_<name>       -> gelf.<name>
+//
+// The timestamp of the event is zero when the message has none.
+func decodeEvent(data []byte) (beat.Event, error) {
+	var msg map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&msg); err != nil {
+		return beat.Event{}, fmt.Errorf("failed to decode GELF message: %w", err)
+	}
+
+	shortMessage, ok := msg["short_message"].(string)
+	if !ok {
+		return beat.Event{}, errNoShortMessage
+	}
+	host, ok := msg["host"].(string)
+	if !ok {
+		return beat.Event{}, errNoHost
+	}
+
+	evt := beat.Event{
+		Fields: mapstr.M{
+			"message": shortMessage,
+		},
+	}
+	_, _ = evt.PutValue("host.hostname", host)
+	gelf := mapstr.M{}
+
+	for k, v := range msg {
+		switch k {
+		case "short_message", "host":
+		case "full_message":
+			gelf["full_message"] = v
+		case "version":
+			gelf["version"] = v
+		case "timestamp":
+			if ts, ok := toTime(v); ok {
+				evt.Timestamp = ts
+			}
+		case "level":
+			level, ok := toInt(v)
+			if !ok || level < 0 || level >= int64(len(severityLabels)) {
+				continue
+			}
+			_, _ = evt.PutValue("log.syslog.severity.code", level)
+			_, _ = evt.PutValue("log.syslog.severity.name", severityLabels[level])
+			_, _ = evt.PutValue("log.level", strings.ToLower(severityLabels[level]))
+		case "facility":
+			_, _ = evt.PutValue("log.syslog.facility.name", v)
+		case "file":
+			_, _ = evt.PutValue("log.origin.file.name", v)
+		case "line":
+			if line, ok := toInt(v); ok {
+				_, _ = evt.PutValue("log.origin.file.line", line)
+			}
+		default:
+			// Additional fields are prefixed with an underscore, _id is
+			// reserved by the specification.
+			if len(k) < 2 || k[0] != '_' || k == "_id" {
+				continue
+			}
+			gelf[k[1:]] = toValue(v)
+		}
+	}
+	if len(gelf) != 0 {
+		evt.Fields["gelf"] = gelf
+	}
+	return evt, nil
+}
+
+// toTime converts the timestamp of a GELF message, seconds since the epoch
+// with an optional decimal part, to a time.
+func toTime(v interface{}) (time.Time, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	f, err := n.Float64()
+	if err != nil || f < 0 {
+		return time.Time{}, false
+	}
+	sec, frac := math.Modf(f)
+	// Round to the microsecond, the precision of the float is lower than
+	// the nanosecond.
+	usec := math.Round(frac * 1e6)
+	return time.Unix(int64(sec), int64(usec)*int64(time.Microsecond)).UTC(), true
+}
+
+func toInt(v interface{}) (int64, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	i, err := n.Int64()
+	return i, err == nil
+}
+
+// toValue converts the JSON numbers of an additional field to integers or
+// floats.
+func toValue(v interface{}) interface{} {
+	n, ok := v.(json.Number)
+	if !ok {
+		return v
+	}
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return n.String()
+}
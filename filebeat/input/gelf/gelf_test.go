@@ -0,0 +1,122 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gelf
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const sample = `{
+  "version": "1.1",
+  "host": "example.org",
+  "short_message": "A short message that helps you identify what is going on",
+  "full_message": "Backtrace here\n\nmore stuff",
+  "timestamp": 1385053862.3072,
+  "level": 1,
+  "file": "main.go",
+  "line": 42,
+  "_user_id": 9001,
+  "_ratio": 0.5,
+  "_some_info": "foo",
+  "_id": "ignored"
+}`
+
+func TestDecodeEvent(t *testing.T) {
+	evt, err := decodeEvent([]byte(sample))
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2013, 11, 21, 17, 11, 2, 307200000, time.UTC), evt.Timestamp)
+	assert.Equal(t, mapstr.M{
+		"message": "A short message that helps you identify what is going on",
+		"host": mapstr.M{
+			"hostname": "example.org",
+		},
+		"log": mapstr.M{
+			"level": "alert",
+			"syslog": mapstr.M{
+				"severity": mapstr.M{
+					"code": int64(1),
+					"name": "Alert",
+				},
+			},
+			"origin": mapstr.M{
+				"file": mapstr.M{
+					"name": "main.go",
+					"line": int64(42),
+				},
+			},
+		},
+		"gelf": mapstr.M{
+			"version":      "1.1",
+			"full_message": "Backtrace here\n\nmore stuff",
+			"user_id":      int64(9001),
+			"ratio":        0.5,
+			"some_info":    "foo",
+		},
+	}, evt.Fields)
+}
+
+func TestDecodeEventErrors(t *testing.T) {
+	tests := map[string]string{
+		"not json":         `not json`,
+		"no short_message": `{"version":"1.1","host":"example.org"}`,
+		"no host":          `{"version":"1.1","short_message":"msg"}`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := decodeEvent([]byte(data))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	_, _ = w.Write([]byte(sample))
+	require.NoError(t, w.Close())
+
+	var zl bytes.Buffer
+	zw := zlib.NewWriter(&zl)
+	_, _ = zw.Write([]byte(sample))
+	require.NoError(t, zw.Close())
+
+	for name, data := range map[string][]byte{
+		"plain": []byte(sample),
+		"gzip":  gz.Bytes(),
+		"zlib":  zl.Bytes(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := decompress(data, len(sample))
+			require.NoError(t, err)
+			assert.Equal(t, sample, string(got))
+
+			_, err = decompress(data, len(sample)-1)
+			assert.ErrorIs(t, err, errTooLarge)
+		})
+	}
+}
@@ -0,0 +1,295 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gelf
+
+import (
+	"context"
+	"net"
+	"time"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	stateless "github.com/elastic/beats/v7/filebeat/input/v2/input-stateless"
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/streaming"
+	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/feature"
+	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+	"github.com/elastic/go-concert/ctxtool"
+)
+
+func Plugin() input.Plugin {
+	return input.Plugin{
+		Name:       "gelf",
+		Stability:  feature.Beta,
+		Deprecated: false,
+		Info:       "GELF server over udp or tcp",
+		Manager:    stateless.NewInputManager(configure),
+	}
+}
+
+func configure(cfg *conf.C) (stateless.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	return newServer(config)
+}
+
+type server struct {
+	config
+	protocol string
+	host     string
+	udp      udp.Config
+	tcp      tcpConfig
+}
+
+func newServer(config config) (*server, error) {
+	s := &server{config: config, protocol: config.Protocol.Name()}
+	switch s.protocol {
+	case udp.Name:
+		s.udp = defaultUDP()
+		if err := config.Protocol.Config().Unpack(&s.udp); err != nil {
+			return nil, err
+		}
+		s.host = s.udp.Host
+	case tcp.Name:
+		s.tcp = defaultTCP()
+		if err := config.Protocol.Config().Unpack(&s.tcp); err != nil {
+			return nil, err
+		}
+		s.host = s.tcp.Host
+	}
+	return s, nil
+}
+
+func (s *server) Name() string { return "gelf" }
+
+func (s *server) Test(_ input.TestContext) error {
+	if s.protocol == udp.Name {
+		c, err := net.ListenPacket("udp", s.host)
+		if err != nil {
+			return err
+		}
+		return c.Close()
+	}
+	l, err := net.Listen("tcp", s.host)
+	if err != nil {
+		return err
+	}
+	return l.Close()
+}
+
+func (s *server) Run(ctx input.Context, publisher stateless.Publisher) error {
+	log := ctx.Logger.With("host", s.host, "protocol", s.protocol)
+
+	log.Info("starting gelf input")
+	defer log.Info("gelf input stopped")
+
+	metrics := newInputMetrics(ctx.ID, s.host)
+	defer metrics.close()
+
+	maxSize := int(s.MaxDecompressedSize)
+	assembler := newAssembler(s.Chunks.Timeout, s.Chunks.MaxPending, maxSize)
+
+	handle := func(data []byte, metadata inputsource.NetworkMetadata) {
+		now := time.Now()
+		metrics.received(data)
+
+		if s.protocol == udp.Name && isChunk(data) {
+			metrics.chunk()
+			var source string
+			if metadata.RemoteAddr != nil {
+				source = metadata.RemoteAddr.String()
+			}
+			var err error
+			data, err = assembler.add(source, data, now)
+			if err != nil {
+				log.Debugw("Dropped GELF chunk", "error", err)
+				metrics.decodeError()
+				return
+			}
+			if data == nil {
+				return
+			}
+		}
+
+		payload, err := decompress(data, maxSize)
+		if err != nil {
+			log.Debugw("Dropped GELF message", "error", err)
+			metrics.decodeError()
+			return
+		}
+
+		evt, err := decodeEvent(payload)
+		if err != nil {
+			log.Debugw("Failed to decode GELF message", "error", err)
+			metrics.decodeError()
+			evt = beat.Event{
+				Fields: mapstr.M{
+					"message": string(payload),
+					"error": mapstr.M{
+						"message": err.Error(),
+					},
+				},
+			}
+		}
+		if evt.Timestamp.IsZero() {
+			evt.Timestamp = now
+		}
+		evt.Meta = mapstr.M{
+			"truncated": metadata.Truncated,
+		}
+		if metadata.RemoteAddr != nil {
+			_, _ = evt.PutValue("log.source.address", metadata.RemoteAddr.String())
+		}
+
+		publisher.Publish(evt)
+		metrics.published()
+	}
+
+	runCtx, cancel := context.WithCancel(ctxtool.FromCanceller(ctx.Cancelation))
+	defer cancel()
+
+	var srv interface {
+		Run(context.Context) error
+	}
+	var err error
+	switch s.protocol {
+	case udp.Name:
+		srv, err = udp.New(&s.udp, handle)
+
+		// Drop the chunked messages not reassembled in time.
+		go func() {
+			t := time.NewTicker(time.Second)
+			defer t.Stop()
+			for {
+				select {
+				case now := <-t.C:
+					metrics.incomplete(assembler.expire(now))
+				case <-runCtx.Done():
+					return
+				}
+			}
+		}()
+	case tcp.Name:
+		split := streaming.FactoryDelimiter([]byte(s.tcp.Delimiter))
+		srv, err = tcp.New(&s.tcp.Config, streaming.SplitHandlerFactory(
+			inputsource.FamilyTCP, log, tcp.MetadataCallback, handle, split,
+		))
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Debug("gelf input initialized")
+
+	err = srv.Run(runCtx)
+	// Ignore error from 'Run' in case shutdown was signaled.
+	if ctxerr := ctx.Cancelation.Err(); ctxerr != nil {
+		err = ctxerr
+	}
+	return err
+}
+
+// inputMetrics handles the input's metric reporting.
+type inputMetrics struct {
+	unregister func()
+
+	device       *monitoring.String // host/port of the server
+	packets      *monitoring.Uint   // number of datagrams or messages received
+	bytes        *monitoring.Uint   // number of bytes received
+	chunks       *monitoring.Uint   // number of chunks received
+	incompletes  *monitoring.Uint   // number of chunked messages dropped before being reassembled
+	decodeErrors *monitoring.Uint   // number of messages that could not be reassembled, decompressed or decoded
+	events       *monitoring.Uint   // number of events published
+}
+
+// newInputMetrics returns an input metric for the GELF input. If id is empty
+// a nil inputMetric is returned.
+func newInputMetrics(id, device string) *inputMetrics {
+	if id == "" {
+		return nil
+	}
+	reg, unreg := inputmon.NewInputRegistry("gelf", id, nil)
+	out := &inputMetrics{
+		unregister:   unreg,
+		device:       monitoring.NewString(reg, "device"),
+		packets:      monitoring.NewUint(reg, "received_events_total"),
+		bytes:        monitoring.NewUint(reg, "received_bytes_total"),
+		chunks:       monitoring.NewUint(reg, "chunks_total"),
+		incompletes:  monitoring.NewUint(reg, "incomplete_messages_total"),
+		decodeErrors: monitoring.NewUint(reg, "decode_errors_total"),
+		events:       monitoring.NewUint(reg, "published_events_total"),
+	}
+	out.device.Set(device)
+	return out
+}
+
+// received logs metric for a received datagram or message.
+func (m *inputMetrics) received(data []byte) {
+	if m == nil {
+		return
+	}
+	m.packets.Add(1)
+	m.bytes.Add(uint64(len(data)))
+}
+
+// chunk logs metric for a received chunk.
+func (m *inputMetrics) chunk() {
+	if m == nil {
+		return
+	}
+	m.chunks.Add(1)
+}
+
+// incomplete logs metric for n chunked messages dropped after the timeout.
+func (m *inputMetrics) incomplete(n int) {
+	if m == nil {
+		return
+	}
+	m.incompletes.Add(uint64(n))
+}
+
+// decodeError logs metric for a message that could not be decoded.
+func (m *inputMetrics) decodeError() {
+	if m == nil {
+		return
+	}
+	m.decodeErrors.Add(1)
+}
+
+// published logs metric for a published event.
+func (m *inputMetrics) published() {
+	if m == nil {
+		return
+	}
+	m.events.Add(1)
+}
+
+func (m *inputMetrics) close() {
+	if m == nil {
+		return
+	}
+	m.unregister()
+}
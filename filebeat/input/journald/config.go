@@ -70,6 +70,10 @@ type config struct {
 	// SaveRemoteHostname defines if the original source of the entry needs to be saved.
 	SaveRemoteHostname bool `config:"save_remote_hostname"`
 
+	// SequenceGapDetection enables reporting gaps in the sequence numbers
+	// of consecutive entries.
+	SequenceGapDetection bool `config:"sequence_gap_detection"`
+
 	// Parsers configuration
 	Parsers parser.Config `config:",inline"`
 }
@@ -105,11 +109,12 @@ var errInvalidSeekFallback = errors.New("invalid setting for cursor_seek_fallbac
 
 func defaultConfig() config {
 	return config{
-		Backoff:            1 * time.Second,
-		MaxBackoff:         20 * time.Second,
-		Seek:               journalread.SeekCursor,
-		CursorSeekFallback: journalread.SeekHead,
-		SaveRemoteHostname: false,
+		Backoff:              1 * time.Second,
+		MaxBackoff:           20 * time.Second,
+		Seek:                 journalread.SeekCursor,
+		CursorSeekFallback:   journalread.SeekHead,
+		SaveRemoteHostname:   false,
+		SequenceGapDetection: true,
 	}
 }
 
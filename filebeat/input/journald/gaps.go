@@ -0,0 +1,200 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux && cgo && withjournald
+// +build linux,cgo,withjournald
+
+package journald
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/filebeat/input/journald/pkg/journalread"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+const (
+	gapReasonCursorInvalidated = "cursor_invalidated"
+	gapReasonSequence          = "sequence_gap"
+)
+
+// journalGap describes entries that might have been missed between two
+// entries read from the journal.
+type journalGap struct {
+	reason   string
+	previous checkpoint
+	next     checkpoint
+	// missed is the number of missing entries, if it is known.
+	missed uint64
+}
+
+// gapDetector compares the cursors of consecutive entries to detect entries
+// missed because the journal was rotated, vacuumed or corrupted. Detected
+// gaps are collected until they are published by the input.
+type gapDetector struct {
+	log *logp.Logger
+	// checkSequence enables the detection of gaps in the sequence numbers
+	// of consecutive entries. It is only valid if all entries are read.
+	checkSequence bool
+	metrics       *gapMetrics
+
+	mu          sync.Mutex
+	last        checkpoint
+	lastCursor  journalread.Cursor
+	hasLast     bool
+	invalidated bool
+	gaps        []journalGap
+}
+
+type gapMetrics struct {
+	unregister func()
+
+	gaps                *monitoring.Uint // Number of gaps detected.
+	cursorInvalidations *monitoring.Uint // Number of times the checkpoint cursor was no longer found in the journal.
+	missedEntries       *monitoring.Uint // Number of entries known to be missed.
+}
+
+func newGapMetrics(id string) *gapMetrics {
+	reg, unreg := inputmon.NewInputRegistry(pluginName, id, nil)
+	return &gapMetrics{
+		unregister:          unreg,
+		gaps:                monitoring.NewUint(reg, "journal_gaps_total"),
+		cursorInvalidations: monitoring.NewUint(reg, "journal_cursor_invalidations_total"),
+		missedEntries:       monitoring.NewUint(reg, "journal_missed_entries_total"),
+	}
+}
+
+func (m *gapMetrics) close() {
+	if m != nil {
+		m.unregister()
+	}
+}
+
+func newGapDetector(log *logp.Logger, cp checkpoint, checkSequence bool, metrics *gapMetrics) *gapDetector {
+	d := &gapDetector{log: log, checkSequence: checkSequence, metrics: metrics}
+	if cp.Position != "" {
+		if cursor, err := journalread.ParseCursor(cp.Position); err == nil {
+			d.last, d.lastCursor, d.hasLast = cp, cursor, true
+		}
+	}
+	return d
+}
+
+// cursorInvalidated records that the checkpoint cursor was not found in the
+// journal when seeking to it. The gap is reported with the next entry.
+func (d *gapDetector) cursorInvalidated() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.invalidated = true
+	if d.metrics != nil {
+		d.metrics.cursorInvalidations.Inc()
+	}
+}
+
+// observe checks the entry read from the journal against the previous entry.
+func (d *gapDetector) observe(cp checkpoint) {
+	cursor, err := journalread.ParseCursor(cp.Position)
+	if err != nil {
+		d.log.Debugf("Gap detection skipped for entry: %v", err)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	prev, prevCursor, hasLast := d.last, d.lastCursor, d.hasLast
+	d.last, d.lastCursor, d.hasLast = cp, cursor, true
+
+	switch {
+	case d.invalidated:
+		d.invalidated = false
+		gap := journalGap{reason: gapReasonCursorInvalidated, previous: prev, next: cp}
+		if hasLast && cursor.SeqnumID == prevCursor.SeqnumID && cursor.Seqnum > prevCursor.Seqnum {
+			gap.missed = cursor.Seqnum - prevCursor.Seqnum - 1
+		}
+		d.add(gap)
+	case d.checkSequence && hasLast && cursor.SeqnumID == prevCursor.SeqnumID &&
+		cursor.Seqnum > prevCursor.Seqnum && !cursor.Follows(prevCursor):
+		// Entries of different journals, e.g. after the sequence number ID
+		// changed, are merged by time and can't be compared.
+		d.add(journalGap{
+			reason:   gapReasonSequence,
+			previous: prev,
+			next:     cp,
+			missed:   cursor.Seqnum - prevCursor.Seqnum - 1,
+		})
+	}
+}
+
+func (d *gapDetector) add(gap journalGap) {
+	d.log.Warnf("Journal entries might have been missed (%s) between cursor %q and %q, %d entries missed",
+		gap.reason, gap.previous.Position, gap.next.Position, gap.missed)
+	if d.metrics != nil {
+		d.metrics.gaps.Inc()
+		d.metrics.missedEntries.Add(gap.missed)
+	}
+	d.gaps = append(d.gaps, gap)
+}
+
+// takeGaps returns the gaps detected since the last call.
+func (d *gapDetector) takeGaps() []journalGap {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	gaps := d.gaps
+	d.gaps = nil
+	return gaps
+}
+
+// event creates the diagnostic event reporting the gap.
+func (g journalGap) event() beat.Event {
+	fields := mapstr.M{
+		"message": fmt.Sprintf("Journal entries might have been missed: %s", g.reason),
+		"event": mapstr.M{
+			"kind":    "event",
+			"action":  "journal-gap-detected",
+			"created": time.Now(),
+		},
+		"log.level": "warning",
+		"journald.gap": mapstr.M{
+			"reason": g.reason,
+			"next": mapstr.M{
+				"cursor":    g.next.Position,
+				"timestamp": time.UnixMicro(int64(g.next.RealtimeTimestamp)),
+			},
+		},
+	}
+	if g.previous.Position != "" {
+		fields.Put("journald.gap.previous", mapstr.M{
+			"cursor":    g.previous.Position,
+			"timestamp": time.UnixMicro(int64(g.previous.RealtimeTimestamp)),
+		})
+	}
+	if g.missed > 0 {
+		fields.Put("journald.gap.missed_entries", g.missed)
+	}
+
+	return beat.Event{
+		Timestamp: time.UnixMicro(int64(g.next.RealtimeTimestamp)),
+		Fields:    fields,
+	}
+}
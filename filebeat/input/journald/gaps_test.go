@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux && cgo && withjournald
+// +build linux,cgo,withjournald
+
+package journald
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+func testCheckpoint(seqnumID string, seqnum uint64) checkpoint {
+	return checkpoint{
+		Version:           cursorVersion,
+		Position:          fmt.Sprintf("s=%s;i=%x;b=boot;m=1;t=%x;x=0", seqnumID, seqnum, 1000+seqnum),
+		RealtimeTimestamp: 1000 + seqnum,
+	}
+}
+
+func TestGapDetectorSequence(t *testing.T) {
+	d := newGapDetector(logp.NewLogger("test"), checkpoint{}, true, nil)
+
+	d.observe(testCheckpoint("a", 1))
+	d.observe(testCheckpoint("a", 2))
+	assert.Empty(t, d.takeGaps())
+
+	d.observe(testCheckpoint("a", 5))
+	gaps := d.takeGaps()
+	require.Len(t, gaps, 1)
+	assert.Equal(t, gapReasonSequence, gaps[0].reason)
+	assert.Equal(t, uint64(2), gaps[0].missed)
+
+	// entries of another journal are not compared
+	d.observe(testCheckpoint("b", 100))
+	assert.Empty(t, d.takeGaps())
+
+	event := gaps[0].event()
+	missed, err := event.Fields.GetValue("journald.gap.missed_entries")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), missed)
+}
+
+func TestGapDetectorSequenceDisabled(t *testing.T) {
+	d := newGapDetector(logp.NewLogger("test"), checkpoint{}, false, nil)
+	d.observe(testCheckpoint("a", 1))
+	d.observe(testCheckpoint("a", 5))
+	assert.Empty(t, d.takeGaps())
+}
+
+func TestGapDetectorCursorInvalidated(t *testing.T) {
+	d := newGapDetector(logp.NewLogger("test"), testCheckpoint("a", 10), false, nil)
+	d.cursorInvalidated()
+	d.observe(testCheckpoint("a", 20))
+
+	gaps := d.takeGaps()
+	require.Len(t, gaps, 1)
+	assert.Equal(t, gapReasonCursorInvalidated, gaps[0].reason)
+	assert.Equal(t, uint64(9), gaps[0].missed)
+	assert.Equal(t, testCheckpoint("a", 10).Position, gaps[0].previous.Position)
+}
@@ -21,6 +21,7 @@
 package journald
 
 import (
+	"errors"
 	"time"
 
 	"github.com/coreos/go-systemd/v22/sdjournal"
@@ -48,6 +49,7 @@ type journald struct {
 	Transports         []string
 	Identifiers        []string
 	SaveRemoteHostname bool
+	SequenceGaps       bool
 	Parsers            parser.Config
 }
 
@@ -112,6 +114,7 @@ func configure(cfg *conf.C) ([]cursor.Source, cursor.Input, error) {
 		Transports:         config.Transports,
 		Identifiers:        config.Identifiers,
 		SaveRemoteHostname: config.SaveRemoteHostname,
+		SequenceGaps:       config.SequenceGapDetection,
 		Parsers:            config.Parsers,
 	}, nil
 }
@@ -141,8 +144,17 @@ func (inp *journald) Run(
 	}
 	defer reader.Close()
 
+	metrics := newGapMetrics(ctx.ID)
+	defer metrics.close()
+	gaps := newGapDetector(log, currentCheckpoint, inp.SequenceGaps && !inp.hasFilters(), metrics)
+
 	if err := reader.Seek(seekBy(ctx.Logger, currentCheckpoint, inp.Seek, inp.CursorSeekFallback)); err != nil {
-		log.Error("Continue from current position. Seek failed with: %v", err)
+		if errors.Is(err, journalread.ErrCursorInvalidated) {
+			log.Warnf("Last known position %q no longer exists in the journal, continue from the closest entry.", currentCheckpoint.Position)
+			gaps.cursorInvalidated()
+		} else {
+			log.Error("Continue from current position. Seek failed with: %v", err)
+		}
 	}
 
 	parser := inp.Parsers.Create(
@@ -151,6 +163,7 @@ func (inp *journald) Run(
 			converter:          journalfield.NewConverter(ctx.Logger, nil),
 			canceler:           ctx.Cancelation,
 			saveRemoteHostname: inp.SaveRemoteHostname,
+			gaps:               gaps,
 		})
 
 	for {
@@ -159,6 +172,13 @@ func (inp *journald) Run(
 			return err
 		}
 
+		// report gaps before the entries read after them
+		for _, gap := range gaps.takeGaps() {
+			if err := publisher.Publish(gap.event(), nil); err != nil {
+				return err
+			}
+		}
+
 		event := entry.ToEvent()
 		if err := publisher.Publish(event, event.Private); err != nil {
 			return err
@@ -166,6 +186,12 @@ func (inp *journald) Run(
 	}
 }
 
+// hasFilters reports whether only a subset of the journal entries is read.
+func (inp *journald) hasFilters() bool {
+	return len(inp.Units) > 0 || len(inp.Transports) > 0 || len(inp.Identifiers) > 0 ||
+		len(inp.Matches.Matches) > 0 || len(inp.Matches.AND) > 0 || len(inp.Matches.OR) > 0
+}
+
 func (inp *journald) open(log *logp.Logger, canceler input.Canceler, src cursor.Source) (*journalread.Reader, error) {
 	backoff := backoff.NewExpBackoff(canceler.Done(), inp.Backoff, inp.MaxBackoff)
 	reader, err := journalread.Open(log, src.Name(), backoff,
@@ -246,6 +272,7 @@ type readerAdapter struct {
 	canceler           input.Canceler
 	converter          *journalfield.Converter
 	saveRemoteHostname bool
+	gaps               *gapDetector
 }
 
 func (r *readerAdapter) Close() error {
@@ -276,17 +303,22 @@ func (r *readerAdapter) Next() (reader.Message, error) {
 		}
 	}
 
+	cp := checkpoint{
+		Version:            cursorVersion,
+		RealtimeTimestamp:  data.RealtimeTimestamp,
+		MonotonicTimestamp: data.MonotonicTimestamp,
+		Position:           data.Cursor,
+	}
+	if r.gaps != nil {
+		r.gaps.observe(cp)
+	}
+
 	m := reader.Message{
 		Ts:      time.UnixMicro(int64(data.RealtimeTimestamp)),
 		Content: content,
 		Bytes:   len(content),
 		Fields:  fields,
-		Private: checkpoint{
-			Version:            cursorVersion,
-			RealtimeTimestamp:  data.RealtimeTimestamp,
-			MonotonicTimestamp: data.MonotonicTimestamp,
-			Position:           data.Cursor,
-		},
+		Private: cp,
 	}
 
 	return m, nil
@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package journalread
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Cursor holds the fields of a journald cursor. Entries written by the same
+// journal share the sequence number ID, and their sequence numbers increase
+// monotonically by one, similar to the term and index of a replicated log.
+// A change of the sequence number ID indicates that the journal has been
+// recreated, so entries might have been missed.
+type Cursor struct {
+	SeqnumID  string
+	Seqnum    uint64
+	BootID    string
+	Monotonic uint64
+	Realtime  uint64
+}
+
+// ParseCursor parses a cursor as returned by sd_journal_get_cursor, in the
+// format `s=<seqnum id>;i=<seqnum>;b=<boot id>;m=<monotonic>;t=<realtime>;x=<hash>`.
+// Numbers are encoded in hexadecimal.
+func ParseCursor(cursor string) (Cursor, error) {
+	var c Cursor
+	var hasSeqnum bool
+	for _, part := range strings.Split(cursor, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Cursor{}, fmt.Errorf("invalid journal cursor %q", cursor)
+		}
+
+		var err error
+		switch key {
+		case "s":
+			c.SeqnumID = value
+		case "i":
+			c.Seqnum, err = strconv.ParseUint(value, 16, 64)
+			hasSeqnum = true
+		case "b":
+			c.BootID = value
+		case "m":
+			c.Monotonic, err = strconv.ParseUint(value, 16, 64)
+		case "t":
+			c.Realtime, err = strconv.ParseUint(value, 16, 64)
+		}
+		if err != nil {
+			return Cursor{}, fmt.Errorf("invalid field %q in journal cursor: %w", key, err)
+		}
+	}
+
+	if c.SeqnumID == "" || !hasSeqnum {
+		return Cursor{}, fmt.Errorf("journal cursor %q has no sequence number", cursor)
+	}
+	return c, nil
+}
+
+// Follows reports whether c is the entry directly following prev in the same
+// journal.
+func (c Cursor) Follows(prev Cursor) bool {
+	return c.SeqnumID == prev.SeqnumID && c.Seqnum == prev.Seqnum+1
+}
@@ -0,0 +1,47 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package journalread
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCursor(t *testing.T) {
+	c, err := ParseCursor("s=9c5f1d52a2b24ca2b85c4e8fd0e42f5b;i=1a2b;b=4b1b8ad4f7d64a8c9e5b1bd93a4c1a2f;m=3e8;t=5d2a4b6c7e8f0;x=8d9a2c3b4e5f6a7b")
+	require.NoError(t, err)
+	assert.Equal(t, Cursor{
+		SeqnumID:  "9c5f1d52a2b24ca2b85c4e8fd0e42f5b",
+		Seqnum:    0x1a2b,
+		BootID:    "4b1b8ad4f7d64a8c9e5b1bd93a4c1a2f",
+		Monotonic: 0x3e8,
+		Realtime:  0x5d2a4b6c7e8f0,
+	}, c)
+
+	next, err := ParseCursor("s=9c5f1d52a2b24ca2b85c4e8fd0e42f5b;i=1a2c;b=4b1b8ad4f7d64a8c9e5b1bd93a4c1a2f;m=3e9;t=5d2a4b6c7e8f1;x=0")
+	require.NoError(t, err)
+	assert.True(t, next.Follows(c))
+	assert.False(t, c.Follows(next))
+
+	for _, invalid := range []string{"", "garbage", "s=abc;i=zz", "b=abc;m=1"} {
+		_, err := ParseCursor(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
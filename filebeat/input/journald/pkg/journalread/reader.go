@@ -21,6 +21,7 @@
 package journalread
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -51,13 +52,21 @@ type canceler interface {
 type journal interface {
 	Close() error
 	Next() (uint64, error)
+	Previous() (uint64, error)
 	Wait(time.Duration) int
 	GetEntry() (*sdjournal.JournalEntry, error)
 	SeekHead() error
 	SeekTail() error
 	SeekCursor(string) error
+	TestCursor(string) error
 }
 
+// ErrCursorInvalidated is returned by Seek if the entry of the cursor no
+// longer exists in the journal, e.g. because the journal was rotated or
+// vacuumed. The reader continues from the entry closest to the cursor, so
+// entries written after the cursor might have been missed.
+var ErrCursorInvalidated = errors.New("journal cursor invalidated")
+
 // LocalSystemJournalID is the ID of the local system journal.
 const localSystemJournalID = "LOCAL_SYSTEM_JOURNAL"
 
@@ -138,9 +147,21 @@ func (r *Reader) Seek(mode SeekMode, cursor string) (err error) {
 			_, err = r.journal.Next()
 		}
 	case SeekCursor:
-		if err = r.journal.SeekCursor(cursor); err == nil {
-			_, err = r.journal.Next()
+		if err = r.journal.SeekCursor(cursor); err != nil {
+			return err
+		}
+		var n uint64
+		if n, err = r.journal.Next(); err != nil || r.journal.TestCursor(cursor) == nil {
+			return err
+		}
+		// The journal is positioned at the entry closest to the cursor,
+		// which has not been read yet.
+		if n > 0 {
+			if _, err = r.journal.Previous(); err != nil {
+				return err
+			}
 		}
+		return ErrCursorInvalidated
 	default:
 		return fmt.Errorf("invalid seek mode '%v'", mode)
 	}
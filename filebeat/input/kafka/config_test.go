@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestNewSaramaConfigTokenBasedSASL(t *testing.T) {
+	tests := map[string]mapstr.M{
+		"oauthbearer": {
+			"sasl.mechanism":              "OAUTHBEARER",
+			"sasl.oauthbearer.token_file": "/run/secrets/kafka-token",
+		},
+		"aws_msk_iam": {
+			"sasl.mechanism":          "AWS_MSK_IAM",
+			"sasl.aws_msk_iam.region": "us-east-1",
+		},
+	}
+
+	for name, settings := range tests {
+		t.Run(name, func(t *testing.T) {
+			settings := settings.Clone()
+			settings.DeepUpdate(mapstr.M{
+				"hosts":    "localhost:9092",
+				"topics":   "messages",
+				"group_id": "filebeat",
+			})
+
+			config := defaultConfig()
+			require.NoError(t, conf.MustNewConfigFrom(settings).Unpack(&config))
+
+			k, err := newSaramaConfig(config)
+			require.NoError(t, err)
+			assert.True(t, k.Net.SASL.Enable)
+			assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypeOAuth), k.Net.SASL.Mechanism)
+			assert.NotNil(t, k.Net.SASL.TokenProvider)
+			assert.Empty(t, k.Net.SASL.User)
+		})
+	}
+}
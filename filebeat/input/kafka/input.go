@@ -86,11 +86,13 @@ func (input *kafkaInput) Name() string { return pluginName }
 func (input *kafkaInput) Test(ctx input.TestContext) error {
 	client, err := sarama.NewClient(input.config.Hosts, input.saramaConfig)
 	if err != nil {
-		ctx.Logger.Error(err)
+		return fmt.Errorf("connecting to kafka brokers %v: %w", input.config.Hosts, err)
 	}
+	defer client.Close()
+
 	topics, err := client.Topics()
 	if err != nil {
-		ctx.Logger.Error(err)
+		return fmt.Errorf("listing kafka topics: %w", err)
 	}
 
 	var missingTopics []string
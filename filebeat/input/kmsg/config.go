@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kmsg
+
+import (
+	"fmt"
+	"time"
+)
+
+// Seek modes, where to start reading from.
+const (
+	// seekCursor continues from the last message published, or from the
+	// oldest message of the buffer if the system was rebooted since.
+	seekCursor = "cursor"
+	// seekHead reads from the oldest message of the buffer.
+	seekHead = "head"
+	// seekTail reads the messages logged after the input started.
+	seekTail = "tail"
+)
+
+// config stores the options of a kmsg input.
+type config struct {
+	// Path is the path of the kernel log device.
+	Path string `config:"path" validate:"required"`
+
+	// Seek is where to start reading from.
+	Seek string `config:"seek"`
+
+	// ContinuationTimeout is the time to wait for the next fragment of a
+	// line before publishing the fragments received.
+	ContinuationTimeout time.Duration `config:"continuation_timeout" validate:"positive,nonzero"`
+}
+
+func defaultConfig() config {
+	return config{
+		Path:                "/dev/kmsg",
+		Seek:                seekCursor,
+		ContinuationTimeout: time.Second,
+	}
+}
+
+func (c *config) Validate() error {
+	switch c.Seek {
+	case seekCursor, seekHead, seekTail:
+		return nil
+	default:
+		return fmt.Errorf("invalid seek %q, must be one of %q, %q or %q", c.Seek, seekCursor, seekHead, seekTail)
+	}
+}
@@ -0,0 +1,326 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux
+// +build linux
+
+package kmsg
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	cursor "github.com/elastic/beats/v7/filebeat/input/v2/input-cursor"
+	"github.com/elastic/beats/v7/libbeat/feature"
+	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+const pluginName = "kmsg"
+
+// bootIDPath holds a random ID generated by the kernel at boot, the
+// sequence numbers of the messages are only valid for a given boot.
+const bootIDPath = "/proc/sys/kernel/random/boot_id"
+
+// maxRecordSize is the size of the buffer a record is read in, the kernel
+// fails the reads in a smaller buffer than the record.
+const maxRecordSize = 8192
+
+var cursorVersion = 1
+
+type checkpoint struct {
+	Version  int
+	BootID   string
+	Sequence uint64
+}
+
+type kmsgInput struct {
+	config
+}
+
+// Plugin creates a new kmsg input plugin for creating a stateful input.
+func Plugin(log *logp.Logger, store cursor.StateStore) input.Plugin {
+	return input.Plugin{
+		Name:       pluginName,
+		Stability:  feature.Beta,
+		Deprecated: false,
+		Info:       "kernel messages input",
+		Doc:        "The kmsg input reads the kernel messages from /dev/kmsg",
+		Manager: &cursor.InputManager{
+			Logger:     log,
+			StateStore: store,
+			Type:       pluginName,
+			Configure:  configure,
+		},
+	}
+}
+
+type pathSource string
+
+func (p pathSource) Name() string { return string(p) }
+
+func configure(cfg *conf.C) ([]cursor.Source, cursor.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, nil, err
+	}
+	return []cursor.Source{pathSource(config.Path)}, &kmsgInput{config: config}, nil
+}
+
+func (inp *kmsgInput) Name() string { return pluginName }
+
+func (inp *kmsgInput) Test(src cursor.Source, _ input.TestContext) error {
+	f, err := os.Open(src.Name())
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func (inp *kmsgInput) Run(
+	ctx input.Context,
+	src cursor.Source,
+	cursor cursor.Cursor,
+	publisher cursor.Publisher,
+) error {
+	log := ctx.Logger.With("path", src.Name())
+
+	bootID, err := readBootID()
+	if err != nil {
+		log.Warnf("Failed to read the boot ID, the position is not kept across restarts: %v", err)
+	}
+	last := initCheckpoint(log, cursor, bootID)
+
+	// The device is pollable, the reads are unblocked by closing it.
+	f, err := os.Open(src.Name())
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", src.Name(), err)
+	}
+	defer f.Close()
+	go func() {
+		<-ctx.Cancelation.Done()
+		f.Close()
+	}()
+
+	if inp.Seek == seekTail {
+		if _, err := f.Seek(0, io.SeekEnd); err != nil {
+			return fmt.Errorf("failed to seek to the end of %s: %w", src.Name(), err)
+		}
+	}
+	// The messages up to the checkpoint are skipped when resuming.
+	resume := inp.Seek == seekCursor && last.BootID != ""
+
+	metrics := newInputMetrics(ctx.ID, src.Name())
+	defer metrics.close()
+
+	var (
+		joiner  joiner
+		buf     = make([]byte, maxRecordSize)
+		hasLast = resume
+	)
+	publish := func(records []record) error {
+		for _, r := range records {
+			event := r.toEvent(bootTime(), bootID)
+			if _, count, ok := suppressed(r.message); ok {
+				metrics.suppressed(count)
+			}
+			if err := publisher.Publish(event, checkpoint{Version: cursorVersion, BootID: bootID, Sequence: r.sequence}); err != nil {
+				return err
+			}
+			metrics.published()
+		}
+		return nil
+	}
+
+	for {
+		// Wait for the next fragment of a line for a limited time only.
+		var deadline time.Time
+		if joiner.pending != nil {
+			deadline = time.Now().Add(inp.ContinuationTimeout)
+		}
+		if err := f.SetReadDeadline(deadline); err != nil {
+			return err
+		}
+
+		n, err := f.Read(buf)
+		switch {
+		case err == nil:
+		case errors.Is(err, os.ErrDeadlineExceeded):
+			if err := publish(joiner.flush()); err != nil {
+				return err
+			}
+			continue
+		case errors.Is(err, syscall.EPIPE):
+			// The reader was too slow, the next messages were overwritten
+			// in the buffer. The next read returns the oldest message
+			// left, the number missed is found from its sequence number.
+			log.Warn("Kernel messages were overwritten before being read.")
+			continue
+		default:
+			if ctxErr := ctx.Cancelation.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			return fmt.Errorf("failed to read from %s: %w", src.Name(), err)
+		}
+
+		r, err := parseRecord(buf[:n])
+		if err != nil {
+			log.Errorf("Dropped kernel message: %v", err)
+			continue
+		}
+		metrics.received(n)
+
+		if resume && r.sequence <= last.Sequence {
+			continue
+		}
+		resume = false
+		if hasLast && r.sequence > last.Sequence+1 {
+			missed := r.sequence - last.Sequence - 1
+			log.Warnf("Missed %d kernel messages between sequence numbers %d and %d.", missed, last.Sequence, r.sequence)
+			metrics.missed(missed)
+		}
+		last.Sequence, hasLast = r.sequence, true
+
+		if err := publish(joiner.add(r)); err != nil {
+			return err
+		}
+	}
+}
+
+// initCheckpoint returns the last position published for the current boot,
+// the zero checkpoint if there is none.
+func initCheckpoint(log *logp.Logger, c cursor.Cursor, bootID string) checkpoint {
+	if c.IsNew() || bootID == "" {
+		return checkpoint{}
+	}
+
+	var cp checkpoint
+	if err := c.Unpack(&cp); err != nil {
+		log.Errorf("Reset kmsg position. Failed to read checkpoint from registry: %v", err)
+		return checkpoint{}
+	}
+	if cp.Version != cursorVersion {
+		log.Error("Reset kmsg position. invalid kmsg position entry.")
+		return checkpoint{}
+	}
+	if cp.BootID != bootID {
+		log.Info("The system was rebooted since the last message published, reading all the messages.")
+		return checkpoint{}
+	}
+	return cp
+}
+
+func readBootID() (string, error) {
+	b, err := os.ReadFile(bootIDPath)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// bootTime returns the wall clock time at which the monotonic clock used to
+// timestamp the kernel messages started. As the monotonic clock does not
+// advance while the system is suspended, it is computed for every message.
+func bootTime() time.Time {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		return time.Time{}
+	}
+	return time.Now().Add(-time.Duration(ts.Nano()))
+}
+
+// inputMetrics handles the input's metric reporting.
+type inputMetrics struct {
+	unregister func()
+
+	device           *monitoring.String // path of the kernel log device
+	messages         *monitoring.Uint   // number of messages read
+	bytes            *monitoring.Uint   // number of bytes read
+	missedMessages   *monitoring.Uint   // number of messages overwritten before being read
+	suppressedByRate *monitoring.Uint   // number of messages reported as suppressed by the kernel rate limiting
+	events           *monitoring.Uint   // number of events published
+}
+
+// newInputMetrics returns an input metric for the kmsg input. If id is empty
+// a nil inputMetric is returned.
+func newInputMetrics(id, device string) *inputMetrics {
+	if id == "" {
+		return nil
+	}
+	reg, unreg := inputmon.NewInputRegistry(pluginName, id, nil)
+	out := &inputMetrics{
+		unregister:       unreg,
+		device:           monitoring.NewString(reg, "device"),
+		messages:         monitoring.NewUint(reg, "received_events_total"),
+		bytes:            monitoring.NewUint(reg, "received_bytes_total"),
+		missedMessages:   monitoring.NewUint(reg, "missed_messages_total"),
+		suppressedByRate: monitoring.NewUint(reg, "suppressed_messages_total"),
+		events:           monitoring.NewUint(reg, "published_events_total"),
+	}
+	out.device.Set(device)
+	return out
+}
+
+// received logs metric for a message read.
+func (m *inputMetrics) received(n int) {
+	if m == nil {
+		return
+	}
+	m.messages.Add(1)
+	m.bytes.Add(uint64(n))
+}
+
+// missed logs metric for n messages overwritten before being read.
+func (m *inputMetrics) missed(n uint64) {
+	if m == nil {
+		return
+	}
+	m.missedMessages.Add(n)
+}
+
+// suppressed logs metric for n messages suppressed by rate limiting.
+func (m *inputMetrics) suppressed(n uint64) {
+	if m == nil {
+		return
+	}
+	m.suppressedByRate.Add(n)
+}
+
+// published logs metric for a published event.
+func (m *inputMetrics) published() {
+	if m == nil {
+		return
+	}
+	m.events.Add(1)
+}
+
+func (m *inputMetrics) close() {
+	if m == nil {
+		return
+	}
+	m.unregister()
+}
@@ -0,0 +1,232 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kmsg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/reader/syslog"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// Flags of the records marking the fragments of a line printed in several
+// calls, on the kernels which do not merge them before logging.
+const (
+	flagNone         = '-'
+	flagFragment     = 'c'
+	flagContinuation = '+'
+)
+
+var errInvalidRecord = errors.New("invalid kmsg record")
+
+// record is a message read from /dev/kmsg.
+type record struct {
+	priority int
+	sequence uint64
+	// monotonic is the time since boot at which the message was logged.
+	monotonic time.Duration
+	flag      byte
+	message   string
+	// dictionary holds the KEY=VALUE lines following the message, like the
+	// SUBSYSTEM and DEVICE of the messages logged by the drivers.
+	dictionary map[string]string
+}
+
+// parseRecord parses a record in the format described in
+// Documentation/ABI/testing/dev-kmsg of the kernel:
+//
+//	PRIORITY,SEQUENCE,TIMESTAMP_USEC,FLAG[,...];MESSAGE
+//	 KEY=VALUE
+func parseRecord(data []byte) (record, error) {
+	var r record
+
+	data = bytes.TrimSuffix(data, []byte("\n"))
+	header, rest, ok := bytes.Cut(data, []byte(";"))
+	if !ok {
+		return r, fmt.Errorf("%w: missing ';' after the header", errInvalidRecord)
+	}
+	// More fields may be appended to the header by future kernels.
+	fields := strings.Split(string(header), ",")
+	if len(fields) < 4 {
+		return r, fmt.Errorf("%w: header %q has less than 4 fields", errInvalidRecord, header)
+	}
+	var err error
+	if r.priority, err = strconv.Atoi(fields[0]); err != nil {
+		return r, fmt.Errorf("%w: invalid priority: %v", errInvalidRecord, err)
+	}
+	if r.sequence, err = strconv.ParseUint(fields[1], 10, 64); err != nil {
+		return r, fmt.Errorf("%w: invalid sequence number: %v", errInvalidRecord, err)
+	}
+	usec, err := strconv.ParseUint(fields[2], 10, 63)
+	if err != nil {
+		return r, fmt.Errorf("%w: invalid timestamp: %v", errInvalidRecord, err)
+	}
+	r.monotonic = time.Duration(usec) * time.Microsecond
+	r.flag = flagNone
+	if len(fields[3]) > 0 {
+		r.flag = fields[3][0]
+	}
+
+	lines := bytes.Split(rest, []byte("\n"))
+	r.message = unescape(lines[0])
+	for _, line := range lines[1:] {
+		if len(line) == 0 || line[0] != ' ' {
+			continue
+		}
+		k, v, ok := bytes.Cut(line[1:], []byte("="))
+		if !ok {
+			continue
+		}
+		if r.dictionary == nil {
+			r.dictionary = map[string]string{}
+		}
+		r.dictionary[strings.ToLower(string(k))] = unescape(v)
+	}
+	return r, nil
+}
+
+// unescape decodes the \xHH sequences used by the kernel to escape the
+// non-printable bytes and the backslashes of the messages.
+func unescape(b []byte) string {
+	if bytes.IndexByte(b, '\\') < 0 {
+		return string(b)
+	}
+	var sb strings.Builder
+	sb.Grow(len(b))
+	for i := 0; i < len(b); i++ {
+		if b[i] == '\\' && i+3 < len(b) && b[i+1] == 'x' {
+			if v, err := strconv.ParseUint(string(b[i+2:i+4]), 16, 8); err == nil {
+				sb.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		sb.WriteByte(b[i])
+	}
+	return sb.String()
+}
+
+// joiner merges the fragments of a line, the record flagged with c and the
+// records flagged with + following it, into a single record.
+type joiner struct {
+	pending *record
+}
+
+// add adds a record and returns the records which are complete.
+func (j *joiner) add(r record) []record {
+	switch r.flag {
+	case flagContinuation:
+		if j.pending == nil {
+			return []record{r}
+		}
+		j.pending.message += r.message
+		j.pending.sequence = r.sequence
+		return nil
+	case flagFragment:
+		out := j.flush()
+		j.pending = &r
+		return out
+	default:
+		return append(j.flush(), r)
+	}
+}
+
+// flush returns the record being merged, if any, even if more fragments may
+// follow it.
+func (j *joiner) flush() []record {
+	if j.pending == nil {
+		return nil
+	}
+	r := *j.pending
+	j.pending = nil
+	return []record{r}
+}
+
+// The messages logged by the kernel when some messages are suppressed by
+// rate limiting, by the printk_ratelimit of a function or for the writers
+// of /dev/kmsg.
+var (
+	callbacksSuppressed   = regexp.MustCompile(`^(\S+): (\d+) callbacks suppressed$`)
+	outputLinesSuppressed = regexp.MustCompile(`^printk: (\S+): (\d+) output lines suppressed due to ratelimiting$`)
+)
+
+// suppressed returns the source and the number of messages suppressed if the
+// message reports messages suppressed by rate limiting.
+func suppressed(message string) (source string, count uint64, ok bool) {
+	m := callbacksSuppressed.FindStringSubmatch(message)
+	if m == nil {
+		m = outputLinesSuppressed.FindStringSubmatch(message)
+	}
+	if m == nil {
+		return "", 0, false
+	}
+	count, err := strconv.ParseUint(m[2], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return m[1], count, true
+}
+
+// toEvent returns the event of the record, logged at boot plus its monotonic
+// timestamp.
+func (r record) toEvent(boot time.Time, bootID string) beat.Event {
+	kmsg := mapstr.M{
+		"sequence":            r.sequence,
+		"monotonic_timestamp": r.monotonic.Microseconds(),
+	}
+	if bootID != "" {
+		kmsg["boot_id"] = bootID
+	}
+	if len(r.dictionary) > 0 {
+		dictionary := make(mapstr.M, len(r.dictionary))
+		for k, v := range r.dictionary {
+			dictionary[k] = v
+		}
+		kmsg["dictionary"] = dictionary
+	}
+	if source, count, ok := suppressed(r.message); ok {
+		kmsg["ratelimit"] = mapstr.M{
+			"source":     source,
+			"suppressed": count,
+		}
+	}
+
+	priority := syslog.PriorityFields(r.priority)
+	fields := mapstr.M{
+		"message": r.message,
+		"kmsg":    kmsg,
+		"log": mapstr.M{
+			"syslog": priority,
+		},
+	}
+	if v, err := priority.GetValue("severity.name"); err == nil {
+		_, _ = fields.Put("log.level", strings.ToLower(v.(string)))
+	}
+
+	return beat.Event{
+		Timestamp: boot.Add(r.monotonic),
+		Fields:    fields,
+	}
+}
@@ -0,0 +1,179 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kmsg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestParseRecord(t *testing.T) {
+	cases := map[string]struct {
+		data string
+		want record
+		err  bool
+	}{
+		"message": {
+			data: "6,339,5140900,-;NET: Registered protocol family 10\n",
+			want: record{
+				priority:  6,
+				sequence:  339,
+				monotonic: 5140900 * time.Microsecond,
+				flag:      flagNone,
+				message:   "NET: Registered protocol family 10",
+			},
+		},
+		"dictionary": {
+			data: "7,160,424069,-,caller=T1;pci_root PNP0A03:00: host bridge window [io  0x0000-0x0cf7] (ignored)\n" +
+				" SUBSYSTEM=acpi\n DEVICE=+acpi:PNP0A03:00\n",
+			want: record{
+				priority:  7,
+				sequence:  160,
+				monotonic: 424069 * time.Microsecond,
+				flag:      flagNone,
+				message:   "pci_root PNP0A03:00: host bridge window [io  0x0000-0x0cf7] (ignored)",
+				dictionary: map[string]string{
+					"subsystem": "acpi",
+					"device":    "+acpi:PNP0A03:00",
+				},
+			},
+		},
+		"escaped": {
+			data: `12,4,1,c;line\x0anext \x5cx41 \xzz` + "\n",
+			want: record{
+				priority:  12,
+				sequence:  4,
+				monotonic: time.Microsecond,
+				flag:      flagFragment,
+				message:   "line\nnext \\x41 \\xzz",
+			},
+		},
+		"missing message": {
+			data: "6,339,5140900,-\n",
+			err:  true,
+		},
+		"short header": {
+			data: "6,339;message\n",
+			err:  true,
+		},
+		"invalid sequence": {
+			data: "6,-1,5140900,-;message\n",
+			err:  true,
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := parseRecord([]byte(test.data))
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, r)
+		})
+	}
+}
+
+func TestJoiner(t *testing.T) {
+	rec := func(seq uint64, flag byte, message string) record {
+		return record{sequence: seq, flag: flag, message: message}
+	}
+	messages := func(records []record) []string {
+		var out []string
+		for _, r := range records {
+			out = append(out, r.message)
+		}
+		return out
+	}
+
+	var j joiner
+	assert.Equal(t, []string{"a"}, messages(j.add(rec(1, flagNone, "a"))))
+	assert.Empty(t, j.add(rec(2, flagFragment, "b")))
+	assert.Empty(t, j.add(rec(3, flagContinuation, "c")))
+	// A new fragment completes the line being merged.
+	out := j.add(rec(4, flagFragment, "d"))
+	assert.Equal(t, []string{"bc"}, messages(out))
+	assert.Equal(t, uint64(3), out[0].sequence, "the sequence number is the one of the last fragment")
+	assert.Equal(t, []string{"d", "e"}, messages(j.add(rec(5, flagNone, "e"))))
+
+	assert.Equal(t, []string{"f"}, messages(j.add(rec(6, flagContinuation, "f"))), "a continuation without fragment is kept")
+
+	assert.Empty(t, j.add(rec(7, flagFragment, "g")))
+	assert.Equal(t, []string{"g"}, messages(j.flush()))
+	assert.Empty(t, j.flush())
+}
+
+func TestToEvent(t *testing.T) {
+	boot := time.Date(2022, 10, 3, 8, 0, 0, 0, time.UTC)
+	r := record{
+		priority:   4,
+		sequence:   1200,
+		monotonic:  90 * time.Second,
+		message:    "ratelimit_test: 12 callbacks suppressed",
+		dictionary: map[string]string{"subsystem": "net"},
+	}
+
+	evt := r.toEvent(boot, "7d6b2e45-0c6f-4a56-9f2f-8f9ab1e3c2f1")
+	assert.Equal(t, boot.Add(90*time.Second), evt.Timestamp)
+	assert.Equal(t, mapstr.M{
+		"message": "ratelimit_test: 12 callbacks suppressed",
+		"kmsg": mapstr.M{
+			"sequence":            uint64(1200),
+			"monotonic_timestamp": int64(90000000),
+			"boot_id":             "7d6b2e45-0c6f-4a56-9f2f-8f9ab1e3c2f1",
+			"dictionary":          mapstr.M{"subsystem": "net"},
+			"ratelimit": mapstr.M{
+				"source":     "ratelimit_test",
+				"suppressed": uint64(12),
+			},
+		},
+		"log": mapstr.M{
+			"level": "warning",
+			"syslog": mapstr.M{
+				"priority": 4,
+				"facility": mapstr.M{"code": 0, "name": "kernel"},
+				"severity": mapstr.M{"code": 4, "name": "Warning"},
+			},
+		},
+	}, evt.Fields)
+}
+
+func TestSuppressed(t *testing.T) {
+	cases := map[string]struct {
+		source string
+		count  uint64
+		ok     bool
+	}{
+		"__ratelimit: 3 callbacks suppressed":                                     {"__ratelimit", 3, true},
+		"printk: systemd-journal: 42 output lines suppressed due to ratelimiting": {"systemd-journal", 42, true},
+		"usb 1-1: new high-speed USB device number 2 using xhci_hcd":              {},
+	}
+
+	for message, test := range cases {
+		source, count, ok := suppressed(message)
+		assert.Equal(t, test.ok, ok, message)
+		assert.Equal(t, test.source, source, message)
+		assert.Equal(t, test.count, count, message)
+	}
+}
@@ -31,6 +31,10 @@ func createClientOptions(config mqttInputConfig, onConnectHandler func(client li
 		SetConnectRetry(true).
 		SetOnConnectHandler(onConnectHandler)
 
+	if config.ProtocolVersion != 0 {
+		clientOptions.SetProtocolVersion(uint(config.ProtocolVersion))
+	}
+
 	for _, host := range config.Hosts {
 		clientOptions.AddBroker(host)
 	}
@@ -48,7 +52,16 @@ func createClientOptions(config mqttInputConfig, onConnectHandler func(client li
 func createClientSubscriptions(config mqttInputConfig) map[string]byte {
 	subscriptions := map[string]byte{}
 	for _, topic := range config.Topics {
-		subscriptions[topic] = byte(config.QoS)
+		subscriptions[subscriptionTopic(config, topic)] = byte(config.QoS)
 	}
 	return subscriptions
 }
+
+// subscriptionTopic returns the topic filter to subscribe to, which is a shared
+// subscription if a shared subscription group is configured.
+func subscriptionTopic(config mqttInputConfig, topic string) string {
+	if config.SharedSubscriptionGroup == "" {
+		return topic
+	}
+	return "$share/" + config.SharedSubscriptionGroup + "/" + topic
+}
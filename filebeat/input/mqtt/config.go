@@ -19,6 +19,11 @@ package mqtt
 
 import (
 	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
 )
@@ -28,6 +33,19 @@ type mqttInputConfig struct {
 	Topics []string `config:"topics" validate:"required,min=1"`
 	QoS    int      `config:"qos" validate:"min=0,max=2"`
 
+	// SharedSubscriptionGroup subscribes to all topics as a member of the
+	// given shared subscription group ($share/<group>/<topic>).
+	SharedSubscriptionGroup string `config:"shared_subscription_group"`
+
+	// ProtocolVersion selects the MQTT protocol: 3 (3.1), 4 (3.1.1) or 5.
+	// If not set, 3.1.1 is tried first and 3.1 is used as fallback.
+	ProtocolVersion int `config:"protocol_version"`
+
+	// SessionExpiry is the MQTT v5 session expiry interval. If set, the broker
+	// keeps the session, including subscriptions and undelivered messages, for
+	// this long after the connection was lost, and the session is resumed on reconnect.
+	SessionExpiry time.Duration `config:"session_expiry" validate:"min=0"`
+
 	ClientID string `config:"client_id" validate:"nonzero"`
 	Username string `config:"username"`
 	Password string `config:"password"`
@@ -48,5 +66,43 @@ func (mic *mqttInputConfig) Validate() error {
 	if len(mic.ClientID) < 1 || len(mic.ClientID) > 23 {
 		return errors.New("ClientID must be between 1 and 23 characters long")
 	}
+	if strings.ContainsAny(mic.SharedSubscriptionGroup, "/+#") {
+		return errors.New("shared_subscription_group must not contain '/', '+' or '#'")
+	}
+	switch mic.ProtocolVersion {
+	case 0, 3, 4:
+		if mic.SessionExpiry > 0 {
+			return errors.New("session_expiry requires protocol_version 5")
+		}
+	case 5:
+		if mic.SessionExpiry/time.Second > math.MaxUint32 {
+			return fmt.Errorf("session_expiry must not be longer than %v", math.MaxUint32*time.Second)
+		}
+		for _, host := range mic.Hosts {
+			if _, err := parseV5Broker(host); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported protocol_version %d, must be one of 3, 4 or 5", mic.ProtocolVersion)
+	}
 	return nil
 }
+
+// parseV5Broker parses the URL of a broker to connect to with MQTT v5.
+// Only TCP and TLS connections are supported.
+func parseV5Broker(host string) (*url.URL, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid host %q: %w", host, err)
+	}
+	switch u.Scheme {
+	case "tcp", "mqtt", "ssl", "tls", "mqtts":
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q of host %q for protocol_version 5", u.Scheme, host)
+	}
+	if u.Port() == "" {
+		return nil, fmt.Errorf("host %q has no port", host)
+	}
+	return u, nil
+}
@@ -85,6 +85,10 @@ func newInput(
 		return nil, err
 	}
 
+	if config.ProtocolVersion == 5 {
+		return newV5Input(config, out, inputContext, newBackoff)
+	}
+
 	logger := logp.NewLogger("mqtt input").With("hosts", config.Hosts)
 	setupLibraryLogging()
 
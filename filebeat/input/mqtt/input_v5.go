@@ -0,0 +1,320 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mqtt
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eclipse/paho.golang/paho"
+
+	"github.com/elastic/beats/v7/filebeat/channel"
+	"github.com/elastic/beats/v7/filebeat/input"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/backoff"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+const (
+	connectTimeout       = 30 * time.Second
+	connectRetryInterval = 1 * time.Second
+	keepAlive            = 30 // seconds
+)
+
+// mqttV5Input is the mqtt input using the MQTT v5 protocol. Unlike the v3 client,
+// the v5 client does not reconnect on its own, so the input runs its own
+// connection loop, resuming the session on reconnect if session_expiry is set.
+type mqttV5Input struct {
+	once   sync.Once
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	config     mqttInputConfig
+	brokers    []*url.URL
+	tlsConfig  *tlscommon.TLSConfig
+	logger     *logp.Logger
+	newBackoff func(done <-chan struct{}, init, max time.Duration) backoff.Backoff
+
+	onMessage        func(*paho.Publish)
+	running          sync.WaitGroup
+	inflightMessages *sync.WaitGroup
+}
+
+func newV5Input(
+	config mqttInputConfig,
+	out channel.Outleter,
+	inputContext input.Context,
+	newBackoff func(done <-chan struct{}, init, max time.Duration) backoff.Backoff,
+) (input.Input, error) {
+	brokers := make([]*url.URL, 0, len(config.Hosts))
+	for _, host := range config.Hosts {
+		broker, err := parseV5Broker(host)
+		if err != nil {
+			return nil, err
+		}
+		brokers = append(brokers, broker)
+	}
+
+	var tlsConfig *tlscommon.TLSConfig
+	if config.TLS != nil {
+		var err error
+		tlsConfig, err = tlscommon.LoadTLSConfig(config.TLS)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logger := logp.NewLogger("mqtt input").With("hosts", config.Hosts)
+	inflightMessages := new(sync.WaitGroup)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-inputContext.Done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return &mqttV5Input{
+		ctx:              ctx,
+		cancel:           cancel,
+		config:           config,
+		brokers:          brokers,
+		tlsConfig:        tlsConfig,
+		logger:           logger,
+		newBackoff:       newBackoff,
+		onMessage:        createV5OnMessageHandler(logger, out, inflightMessages),
+		inflightMessages: inflightMessages,
+	}, nil
+}
+
+func createV5OnMessageHandler(logger *logp.Logger, outlet channel.Outleter, inflightMessages *sync.WaitGroup) func(*paho.Publish) {
+	return func(message *paho.Publish) {
+		inflightMessages.Add(1)
+
+		logger.Debugf("Received message on topic '%s', messageID: %d, size: %d", message.Topic,
+			message.PacketID, len(message.Payload))
+
+		mqttFields := mapstr.M{
+			"message_id": message.PacketID,
+			"qos":        message.QoS,
+			"retained":   message.Retain,
+			"topic":      message.Topic,
+		}
+		if props := message.Properties; props != nil {
+			if props.ContentType != "" {
+				mqttFields["content_type"] = props.ContentType
+			}
+			if len(props.User) > 0 {
+				mqttFields["user_properties"] = userProperties(props.User)
+			}
+		}
+		outlet.OnEvent(beat.Event{
+			Timestamp: time.Now(),
+			Fields: mapstr.M{
+				"message": string(message.Payload),
+				"mqtt":    mqttFields,
+			},
+		})
+
+		inflightMessages.Done()
+	}
+}
+
+// userProperties converts MQTT v5 user properties to fields. Properties may
+// be repeated, the values of a repeated property are reported as a list.
+func userProperties(props paho.UserProperties) mapstr.M {
+	fields := mapstr.M{}
+	for _, p := range props {
+		switch v := fields[p.Key].(type) {
+		case nil:
+			fields[p.Key] = p.Value
+		case string:
+			fields[p.Key] = []string{v, p.Value}
+		case []string:
+			fields[p.Key] = append(v, p.Value)
+		}
+	}
+	return fields
+}
+
+// Run method starts the mqtt input and processing.
+func (mi *mqttV5Input) Run() {
+	mi.once.Do(func() {
+		mi.logger.Debug("Run the input once.")
+		mi.running.Add(1)
+		go mi.run()
+	})
+}
+
+// run connects to the brokers in turn until the input is stopped, waiting
+// with backoff between failed connection attempts.
+func (mi *mqttV5Input) run() {
+	defer mi.running.Done()
+
+	backoff := mi.newBackoff(mi.ctx.Done(), connectRetryInterval, 8*connectRetryInterval)
+	for i := 0; mi.ctx.Err() == nil; i++ {
+		broker := mi.brokers[i%len(mi.brokers)]
+		connected, err := mi.connectAndReceive(broker)
+		if mi.ctx.Err() != nil {
+			return
+		}
+		if connected {
+			backoff.Reset()
+			mi.logger.Warnf("Connection to %s lost: %v", broker.Redacted(), err)
+		} else {
+			mi.logger.Warnf("Connecting to %s failed: %v", broker.Redacted(), err)
+		}
+		if !backoff.Wait() {
+			return
+		}
+	}
+}
+
+// connectAndReceive connects to the broker, subscribes to the topics unless
+// an existing session was resumed, and receives messages until the connection
+// is lost or the input is stopped.
+func (mi *mqttV5Input) connectAndReceive(broker *url.URL) (connected bool, err error) {
+	conn, err := mi.dial(broker)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	disconnected := make(chan error, 1)
+	notify := func(err error) {
+		select {
+		case disconnected <- err:
+		default:
+		}
+	}
+	client := paho.NewClient(paho.ClientConfig{
+		ClientID:      mi.config.ClientID,
+		Conn:          conn,
+		Router:        paho.NewSingleHandlerRouter(mi.onMessage),
+		OnClientError: notify,
+		OnServerDisconnect: func(d *paho.Disconnect) {
+			notify(fmt.Errorf("disconnected by broker with reason code %d", d.ReasonCode))
+		},
+	})
+
+	connectCtx, cancel := context.WithTimeout(mi.ctx, connectTimeout)
+	defer cancel()
+	connack, err := client.Connect(connectCtx, mi.connectPacket())
+	if err != nil {
+		return false, err
+	}
+
+	if connack.SessionPresent {
+		mi.logger.Debug("Resumed existing session, subscriptions are kept by the broker.")
+	} else if err := mi.subscribe(connectCtx, client); err != nil {
+		_ = client.Disconnect(&paho.Disconnect{ReasonCode: 0})
+		return true, err
+	}
+
+	select {
+	case <-mi.ctx.Done():
+		_ = client.Disconnect(&paho.Disconnect{ReasonCode: 0})
+		return true, nil
+	case err := <-disconnected:
+		return true, err
+	}
+}
+
+func (mi *mqttV5Input) connectPacket() *paho.Connect {
+	cp := &paho.Connect{
+		ClientID:   mi.config.ClientID,
+		KeepAlive:  keepAlive,
+		CleanStart: mi.config.SessionExpiry == 0,
+	}
+	if mi.config.Username != "" {
+		cp.UsernameFlag = true
+		cp.Username = mi.config.Username
+	}
+	if mi.config.Password != "" {
+		cp.PasswordFlag = true
+		cp.Password = []byte(mi.config.Password)
+	}
+	if mi.config.SessionExpiry > 0 {
+		expiry := uint32(mi.config.SessionExpiry / time.Second)
+		cp.Properties = &paho.ConnectProperties{SessionExpiryInterval: &expiry}
+	}
+	return cp
+}
+
+func (mi *mqttV5Input) subscribe(ctx context.Context, client *paho.Client) error {
+	var subscriptions []paho.SubscribeOptions
+	for topic, qos := range createClientSubscriptions(mi.config) {
+		subscriptions = append(subscriptions, paho.SubscribeOptions{Topic: topic, QoS: qos})
+	}
+
+	mi.logger.Debugf("Try subscribe to topics: %v", strings.Join(mi.config.Topics, ", "))
+	suback, err := client.Subscribe(ctx, &paho.Subscribe{Subscriptions: subscriptions})
+	if err != nil {
+		return fmt.Errorf("subscribing to topics failed: %w", err)
+	}
+	for _, reason := range suback.Reasons {
+		// reason codes of 0x80 and above indicate a failure
+		if reason >= 0x80 {
+			return fmt.Errorf("subscribing to topics failed with reason code %d", reason)
+		}
+	}
+	return nil
+}
+
+func (mi *mqttV5Input) dial(broker *url.URL) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(mi.ctx, connectTimeout)
+	defer cancel()
+
+	dialer := &net.Dialer{}
+	switch broker.Scheme {
+	case "ssl", "tls", "mqtts":
+		var tlsConfig *tls.Config
+		if mi.tlsConfig != nil {
+			tlsConfig = mi.tlsConfig.BuildModuleClientConfig(broker.Hostname())
+		}
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: tlsConfig}
+		return tlsDialer.DialContext(ctx, "tcp", broker.Host)
+	default:
+		return dialer.DialContext(ctx, "tcp", broker.Host)
+	}
+}
+
+// Stop method stops the input.
+func (mi *mqttV5Input) Stop() {
+	mi.logger.Debug("Stop the input.")
+	mi.cancel()
+}
+
+// Wait method stops the input and waits until event processing is finished.
+func (mi *mqttV5Input) Wait() {
+	mi.logger.Debug("Wait for the input to finish processing.")
+
+	mi.Stop()
+	mi.running.Wait()
+	mi.inflightMessages.Wait()
+}
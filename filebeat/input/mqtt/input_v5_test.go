@@ -0,0 +1,179 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mqtt
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/eclipse/paho.golang/paho"
+	"github.com/stretchr/testify/require"
+
+	finput "github.com/elastic/beats/v7/filebeat/input"
+	"github.com/elastic/beats/v7/filebeat/input/inputtest"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestNewInput_ProtocolV5(t *testing.T) {
+	config := conf.MustNewConfigFrom(mapstr.M{
+		"hosts":            []string{"tcp://mocked:1883", "ssl://mocked:8883"},
+		"topics":           "#",
+		"protocol_version": 5,
+		"session_expiry":   "1h",
+	})
+	connector := &mockedConnector{
+		outlet: new(mockedOutleter),
+	}
+	var inputContext finput.Context
+
+	input, err := NewInput(config, connector, inputContext)
+	require.NoError(t, err)
+
+	v5Input, ok := input.(*mqttV5Input)
+	require.True(t, ok)
+	require.Len(t, v5Input.brokers, 2)
+
+	cp := v5Input.connectPacket()
+	require.False(t, cp.CleanStart)
+	require.NotNil(t, cp.Properties)
+	require.Equal(t, uint32(3600), *cp.Properties.SessionExpiryInterval)
+
+	v5Input.Stop()
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		config mapstr.M
+		err    string
+	}{
+		"shared subscription": {
+			config: mapstr.M{"shared_subscription_group": "filebeat"},
+		},
+		"invalid shared subscription group": {
+			config: mapstr.M{"shared_subscription_group": "file/beat"},
+			err:    "shared_subscription_group must not contain '/', '+' or '#'",
+		},
+		"session expiry requires v5": {
+			config: mapstr.M{"session_expiry": "1h"},
+			err:    "session_expiry requires protocol_version 5",
+		},
+		"unsupported protocol version": {
+			config: mapstr.M{"protocol_version": 6},
+			err:    "unsupported protocol_version 6, must be one of 3, 4 or 5",
+		},
+		"websockets are not supported by v5": {
+			config: mapstr.M{"protocol_version": 5, "hosts": "ws://mocked:80"},
+			err:    `unsupported scheme "ws" of host "ws://mocked:80" for protocol_version 5`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := mapstr.M{
+				"hosts":  "tcp://mocked:1883",
+				"topics": "#",
+			}
+			config.DeepUpdate(test.config)
+
+			c := defaultConfig()
+			err := conf.MustNewConfigFrom(config).Unpack(&c)
+			if test.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestCreateClientSubscriptions_Shared(t *testing.T) {
+	config := defaultConfig()
+	config.Topics = []string{"sensors/#", "alerts"}
+	config.QoS = 1
+	config.SharedSubscriptionGroup = "filebeat"
+
+	require.Equal(t, map[string]byte{
+		"$share/filebeat/sensors/#": 1,
+		"$share/filebeat/alerts":    1,
+	}, createClientSubscriptions(config))
+}
+
+func TestV5OnMessageHandler(t *testing.T) {
+	var events []beat.Event
+	outlet := &mockedOutleter{
+		onEventHandler: func(event beat.Event) bool {
+			events = append(events, event)
+			return true
+		},
+	}
+	handler := createV5OnMessageHandler(logger, outlet, new(sync.WaitGroup))
+
+	handler(&paho.Publish{
+		PacketID: 7,
+		QoS:      1,
+		Topic:    "sensors/kitchen",
+		Payload:  []byte(`{"temperature": 21.5}`),
+		Properties: &paho.PublishProperties{
+			ContentType: "application/json",
+			User: paho.UserProperties{
+				{Key: "device", Value: "thermostat"},
+				{Key: "tag", Value: "indoor"},
+				{Key: "tag", Value: "ground-floor"},
+			},
+		},
+	})
+	handler(&paho.Publish{
+		Topic:   "alerts",
+		Payload: []byte("no properties"),
+	})
+
+	require.Len(t, events, 2)
+	require.Equal(t, mapstr.M{
+		"message": `{"temperature": 21.5}`,
+		"mqtt": mapstr.M{
+			"message_id":   uint16(7),
+			"qos":          byte(1),
+			"retained":     false,
+			"topic":        "sensors/kitchen",
+			"content_type": "application/json",
+			"user_properties": mapstr.M{
+				"device": "thermostat",
+				"tag":    []string{"indoor", "ground-floor"},
+			},
+		},
+	}, events[0].Fields)
+	require.Equal(t, mapstr.M{
+		"message": "no properties",
+		"mqtt": mapstr.M{
+			"message_id": uint16(0),
+			"qos":        byte(0),
+			"retained":   false,
+			"topic":      "alerts",
+		},
+	}, events[1].Fields)
+}
+
+func TestNewInputDone_ProtocolV5(t *testing.T) {
+	config := mapstr.M{
+		"hosts":            "tcp://:1883",
+		"protocol_version": 5,
+	}
+	inputtest.AssertNotStartedInputCanBeDone(t, NewInput, &config)
+}
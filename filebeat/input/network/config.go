@@ -0,0 +1,144 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource/codec"
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/streaming"
+	"github.com/elastic/beats/v7/filebeat/inputsource/sourcefilter"
+	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
+	"github.com/elastic/beats/v7/filebeat/inputsource/unix"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	conf "github.com/elastic/elastic-agent-libs/config"
+)
+
+// tlsName is the name of the tcp transport requiring TLS.
+const tlsName = "tls"
+
+// maxDatagramSize is the largest payload of a UDP datagram, the shared
+// max_message_size is capped to it for the udp transports.
+const maxDatagramSize = 64 * humanize.KiByte
+
+type config struct {
+	// Transports are the servers receiving the messages. Each of them is
+	// a udp, tcp, tls or unix namespace with the settings of the server,
+	// which can override the shared settings below.
+	Transports []conf.Namespace `config:"transports" validate:"required"`
+
+	Timeout        time.Duration         `config:"timeout" validate:"nonzero,positive"`
+	MaxMessageSize cfgtype.ByteSize      `config:"max_message_size" validate:"nonzero,positive"`
+	Framing        streaming.FramingType `config:"framing"`
+	LineDelimiter  string                `config:"line_delimiter" validate:"nonzero"`
+
+	Codec  codec.Config        `config:",inline"`
+	Filter sourcefilter.Config `config:",inline"`
+}
+
+func defaultConfig() config {
+	return config{
+		Timeout:        time.Minute * 5,
+		MaxMessageSize: 20 * humanize.MiByte,
+		LineDelimiter:  "\n",
+		Codec:          codec.DefaultConfig(),
+		Filter:         sourcefilter.DefaultConfig(),
+	}
+}
+
+// Validate checks that the transports are supported.
+func (c *config) Validate() error {
+	if len(c.Transports) == 0 {
+		return errors.New("at least one transport must be set")
+	}
+	for i, t := range c.Transports {
+		switch name := t.Name(); name {
+		case udp.Name, tcp.Name, tlsName, unix.Name:
+		case "":
+			return fmt.Errorf("transports.%d: transport type must be set", i)
+		default:
+			return fmt.Errorf("transports.%d: unsupported transport %q, must be one of %s, %s, %s or %s",
+				i, name, udp.Name, tcp.Name, tlsName, unix.Name)
+		}
+	}
+	return nil
+}
+
+// streamConfig is the configuration of the tcp and tls transports.
+type streamConfig struct {
+	tcp.Config    `config:",inline"`
+	Framing       streaming.FramingType `config:"framing"`
+	LineDelimiter string                `config:"line_delimiter" validate:"nonzero"`
+}
+
+// udpConfig returns the configuration of the udp transport, from the shared
+// settings and those of the transport.
+func (c *config) udpConfig(cfg *conf.C) (udp.Config, error) {
+	out := udp.Config{
+		Timeout:        c.Timeout,
+		MaxMessageSize: c.MaxMessageSize,
+	}
+	if out.MaxMessageSize > maxDatagramSize {
+		out.MaxMessageSize = maxDatagramSize
+	}
+	if err := cfg.Unpack(&out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+// streamConfig returns the configuration of a tcp or tls transport, from
+// the shared settings and those of the transport.
+func (c *config) streamConfig(name string, cfg *conf.C) (streamConfig, error) {
+	out := streamConfig{
+		Config: tcp.Config{
+			Timeout:        c.Timeout,
+			MaxMessageSize: c.MaxMessageSize,
+		},
+		Framing:       c.Framing,
+		LineDelimiter: c.LineDelimiter,
+	}
+	if err := cfg.Unpack(&out); err != nil {
+		return out, err
+	}
+	if name == tlsName && !out.TLS.IsEnabled() {
+		return out, errors.New("the tls transport requires ssl settings")
+	}
+	return out, nil
+}
+
+// unixConfig returns the configuration of the unix transport, from the shared
+// settings and those of the transport.
+func (c *config) unixConfig(cfg *conf.C) (unix.Config, error) {
+	out := unix.Config{
+		Timeout:        c.Timeout,
+		MaxMessageSize: c.MaxMessageSize,
+		Framing:        c.Framing,
+		LineDelimiter:  c.LineDelimiter,
+		SocketType:     unix.StreamSocket,
+	}
+	if err := cfg.Unpack(&out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
@@ -0,0 +1,272 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package network
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	input "github.com/elastic/beats/v7/filebeat/input/v2"
+	stateless "github.com/elastic/beats/v7/filebeat/input/v2/input-stateless"
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/filebeat/inputsource/codec"
+	"github.com/elastic/beats/v7/filebeat/inputsource/sourcefilter"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/feature"
+	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+	"github.com/elastic/go-concert/ctxtool"
+)
+
+func Plugin() input.Plugin {
+	return input.Plugin{
+		Name:       "network",
+		Stability:  feature.Beta,
+		Deprecated: false,
+		Info:       "udp, tcp, tls and unix socket servers",
+		Manager:    stateless.NewInputManager(configure),
+	}
+}
+
+func configure(cfg *conf.C) (stateless.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	return newServer(config)
+}
+
+type server struct {
+	config
+	transports []transport
+}
+
+func newServer(config config) (*server, error) {
+	transports, err := newTransports(config)
+	if err != nil {
+		return nil, err
+	}
+	return &server{config: config, transports: transports}, nil
+}
+
+func (s *server) Name() string { return "network" }
+
+func (s *server) Test(_ input.TestContext) error {
+	for _, t := range s.transports {
+		if err := t.test(); err != nil {
+			return fmt.Errorf("%s transport %s: %w", t.name, t.address, err)
+		}
+	}
+	return nil
+}
+
+// devices returns the addresses of the transports, prefixed by their type.
+func (s *server) devices() string {
+	devices := make([]string, len(s.transports))
+	for i, t := range s.transports {
+		devices[i] = t.name + "://" + t.address
+	}
+	return strings.Join(devices, ",")
+}
+
+func (s *server) Run(ctx input.Context, publisher stateless.Publisher) error {
+	log := ctx.Logger.With("transports", s.devices())
+
+	log.Info("starting network input")
+	defer log.Info("network input stopped")
+
+	metrics := newInputMetrics(ctx.ID, s.devices())
+	defer metrics.close()
+
+	filter, err := sourcefilter.New(s.Filter)
+	if err != nil {
+		return err
+	}
+
+	decoder, err := codec.New(s.Codec)
+	if err != nil {
+		return err
+	}
+
+	servers := make([]runner, len(s.transports))
+	for i := range s.transports {
+		t := &s.transports[i]
+		servers[i], err = t.server(log.With("transport", t.name), func(data []byte, metadata inputsource.NetworkMetadata) {
+			now := time.Now()
+			metrics.received(data)
+			if allowed, reason := filter.Allow(metadata.RemoteAddr, now); !allowed {
+				metrics.discard(reason)
+				return
+			}
+
+			events, err := s.events(decoder, t, data, metadata, now)
+			if err != nil {
+				log.Debugw("Failed to decode message", "codec", s.Codec.Codec, "transport", t.name, "error", err)
+				metrics.decodeError()
+			}
+			for _, evt := range events {
+				publisher.Publish(evt)
+			}
+			metrics.published(len(events))
+		})
+		if err != nil {
+			return fmt.Errorf("%s transport %s: %w", t.name, t.address, err)
+		}
+	}
+
+	log.Debug("network input initialized")
+
+	// All the servers are stopped when one of them fails.
+	g, runCtx := errgroup.WithContext(ctxtool.FromCanceller(ctx.Cancelation))
+	for i := range servers {
+		srv, t := servers[i], s.transports[i]
+		g.Go(func() error {
+			if err := srv.Run(runCtx); err != nil {
+				return fmt.Errorf("%s transport %s: %w", t.name, t.address, err)
+			}
+			return nil
+		})
+	}
+	err = g.Wait()
+	// Ignore error from 'Run' in case shutdown was signaled.
+	if ctxerr := ctx.Cancelation.Err(); ctxerr != nil {
+		err = ctxerr
+	}
+	return err
+}
+
+// events returns the events of a message received by the transport t. The
+// events decoded before an error are returned, the error is then reported
+// in the last one holding the raw message.
+func (s *server) events(decoder codec.Decoder, t *transport, data []byte, metadata inputsource.NetworkMetadata, now time.Time) ([]beat.Event, error) {
+	events := []beat.Event{{
+		Fields: mapstr.M{
+			"message": string(data),
+		},
+	}}
+	var err error
+	if decoder != nil {
+		events, err = decoder.Decode(data)
+		if err != nil && len(events) != 0 {
+			_, _ = events[len(events)-1].PutValue("error.message", err.Error())
+		}
+	}
+
+	for i := range events {
+		evt := &events[i]
+		if evt.Timestamp.IsZero() {
+			evt.Timestamp = now
+		}
+		evt.Meta = mapstr.M{
+			"truncated": metadata.Truncated,
+		}
+		evt.Fields.DeepUpdate(t.fields.Clone())
+		if metadata.RemoteAddr != nil {
+			_, _ = evt.PutValue("log.source.address", metadata.RemoteAddr.String())
+		}
+	}
+	return events, err
+}
+
+// inputMetrics handles the input's metric reporting.
+type inputMetrics struct {
+	unregister func()
+
+	device       *monitoring.String // addresses of the transports
+	packets      *monitoring.Uint   // number of messages received
+	bytes        *monitoring.Uint   // number of bytes received
+	discarded    *monitoring.Uint   // number of messages discarded from denied or rate limited senders
+	denied       *monitoring.Uint   // number of messages discarded from senders not in allowed_hosts
+	rateLimited  *monitoring.Uint   // number of messages discarded from senders over their rate limit
+	decodeErrors *monitoring.Uint   // number of messages the codec failed to decode
+	events       *monitoring.Uint   // number of events published
+}
+
+// newInputMetrics returns an input metric for the network input. If id is
+// empty a nil inputMetric is returned.
+func newInputMetrics(id, device string) *inputMetrics {
+	if id == "" {
+		return nil
+	}
+	reg, unreg := inputmon.NewInputRegistry("network", id, nil)
+	out := &inputMetrics{
+		unregister:   unreg,
+		device:       monitoring.NewString(reg, "device"),
+		packets:      monitoring.NewUint(reg, "received_events_total"),
+		bytes:        monitoring.NewUint(reg, "received_bytes_total"),
+		discarded:    monitoring.NewUint(reg, "discarded_events_total"),
+		denied:       monitoring.NewUint(reg, "discarded_denied_total"),
+		rateLimited:  monitoring.NewUint(reg, "discarded_rate_limited_total"),
+		decodeErrors: monitoring.NewUint(reg, "decode_errors_total"),
+		events:       monitoring.NewUint(reg, "published_events_total"),
+	}
+	out.device.Set(device)
+	return out
+}
+
+// received logs metric for a received message.
+func (m *inputMetrics) received(data []byte) {
+	if m == nil {
+		return
+	}
+	m.packets.Add(1)
+	m.bytes.Add(uint64(len(data)))
+}
+
+// discard logs metric for a message discarded by the source filter.
+func (m *inputMetrics) discard(reason sourcefilter.Reason) {
+	if m == nil {
+		return
+	}
+	m.discarded.Add(1)
+	switch reason {
+	case sourcefilter.ReasonDenied:
+		m.denied.Add(1)
+	case sourcefilter.ReasonRateLimited:
+		m.rateLimited.Add(1)
+	}
+}
+
+// decodeError logs metric for a message the codec failed to decode.
+func (m *inputMetrics) decodeError() {
+	if m == nil {
+		return
+	}
+	m.decodeErrors.Add(1)
+}
+
+// published logs metric for n published events.
+func (m *inputMetrics) published(n int) {
+	if m == nil {
+		return
+	}
+	m.events.Add(uint64(n))
+}
+
+func (m *inputMetrics) close() {
+	if m == nil {
+		return
+	}
+	m.unregister()
+}
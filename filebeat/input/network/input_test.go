@@ -0,0 +1,134 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package network
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/filebeat/inputsource/codec"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func newTestServer(t *testing.T, cfg map[string]interface{}) (*server, error) {
+	t.Helper()
+	in, err := configure(conf.MustNewConfigFrom(cfg))
+	if err != nil {
+		return nil, err
+	}
+	return in.(*server), nil //nolint:errcheck // configure only returns *server
+}
+
+func TestConfigure(t *testing.T) {
+	s, err := newTestServer(t, map[string]interface{}{
+		"max_message_size": "1MiB",
+		"line_delimiter":   "\r\n",
+		"transports": []map[string]interface{}{
+			{"udp": map[string]interface{}{"host": "localhost:5514"}},
+			{"tcp": map[string]interface{}{"host": "localhost:5514", "line_delimiter": "\n"}},
+			{"unix": map[string]interface{}{"path": "/tmp/network.sock"}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, s.transports, 3)
+	assert.Equal(t, "udp://localhost:5514,tcp://localhost:5514,unix:///tmp/network.sock", s.devices())
+
+	cfg, err := s.config.udpConfig(s.Transports[0].Config())
+	require.NoError(t, err)
+	assert.EqualValues(t, maxDatagramSize, cfg.MaxMessageSize, "udp max_message_size must be capped")
+
+	stream, err := s.config.streamConfig(s.Transports[1].Name(), s.Transports[1].Config())
+	require.NoError(t, err)
+	assert.EqualValues(t, 1024*1024, stream.MaxMessageSize)
+	assert.Equal(t, "\n", stream.LineDelimiter, "transport settings must override the shared settings")
+
+	unix, err := s.config.unixConfig(s.Transports[2].Config())
+	require.NoError(t, err)
+	assert.Equal(t, "\r\n", unix.LineDelimiter)
+	assert.Equal(t, 5*time.Minute, unix.Timeout)
+}
+
+func TestConfigureErrors(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"no transport": {},
+		"unknown transport": {
+			"transports": []map[string]interface{}{
+				{"sctp": map[string]interface{}{"host": "localhost:5514"}},
+			},
+		},
+		"tls without ssl": {
+			"transports": []map[string]interface{}{
+				{"tls": map[string]interface{}{"host": "localhost:6514"}},
+			},
+		},
+		"tcp without host": {
+			"transports": []map[string]interface{}{
+				{"tcp": map[string]interface{}{}},
+			},
+		},
+	}
+	for name, cfg := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := newTestServer(t, cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestEvents(t *testing.T) {
+	s, err := newTestServer(t, map[string]interface{}{
+		"transports": []map[string]interface{}{
+			{"udp": map[string]interface{}{"host": "localhost:5514"}},
+		},
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	metadata := inputsource.NetworkMetadata{
+		RemoteAddr: &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234},
+	}
+
+	events, err := s.events(nil, &s.transports[0], []byte("hello"), metadata, now)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, now, events[0].Timestamp)
+	assert.Equal(t, mapstr.M{
+		"message": "hello",
+		"network": mapstr.M{"transport": "udp"},
+		"log":     mapstr.M{"source": mapstr.M{"address": "127.0.0.1:1234"}},
+	}, events[0].Fields)
+
+	config := codec.DefaultConfig()
+	config.Codec = "json"
+	decoder, err := codec.New(config)
+	require.NoError(t, err)
+
+	events, err = s.events(decoder, &s.transports[0], []byte(`{"a":1}`+"\n"+`{"b"`), metadata, now)
+	assert.Error(t, err)
+	require.Len(t, events, 2)
+	msg, _ := events[1].GetValue("error.message")
+	assert.NotEmpty(t, msg)
+	transport, _ := events[0].GetValue("network.transport")
+	assert.Equal(t, "udp", transport)
+}
@@ -0,0 +1,130 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package network
+
+import (
+	"context"
+	"net"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/streaming"
+	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
+	"github.com/elastic/beats/v7/filebeat/inputsource/unix"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// runner is a server receiving the messages until its context is cancelled.
+type runner interface {
+	Run(context.Context) error
+}
+
+// transport is one of the servers of the input.
+type transport struct {
+	// name is the transport type: udp, tcp, tls or unix.
+	name string
+	// address is the host and port or the socket path of the server.
+	address string
+	// fields are added to the events received by the server.
+	fields mapstr.M
+
+	test   func() error
+	server func(log *logp.Logger, handle inputsource.NetworkFunc) (runner, error)
+}
+
+// newTransports returns the transports of the configuration.
+func newTransports(config config) ([]transport, error) {
+	transports := make([]transport, 0, len(config.Transports))
+	for _, ns := range config.Transports {
+		var t transport
+		switch name := ns.Name(); name {
+		case udp.Name:
+			cfg, err := config.udpConfig(ns.Config())
+			if err != nil {
+				return nil, err
+			}
+			t = transport{
+				name:    name,
+				address: cfg.Host,
+				fields:  mapstr.M{"network": mapstr.M{"transport": udp.Name}},
+				test: func() error {
+					c, err := net.ListenPacket("udp", cfg.Host)
+					if err != nil {
+						return err
+					}
+					return c.Close()
+				},
+				server: func(_ *logp.Logger, handle inputsource.NetworkFunc) (runner, error) {
+					return udp.New(&cfg, handle)
+				},
+			}
+		case tcp.Name, tlsName:
+			cfg, err := config.streamConfig(name, ns.Config())
+			if err != nil {
+				return nil, err
+			}
+			t = transport{
+				name:    name,
+				address: cfg.Host,
+				fields:  mapstr.M{"network": mapstr.M{"transport": tcp.Name}},
+				test: func() error {
+					l, err := net.Listen("tcp", cfg.Host)
+					if err != nil {
+						return err
+					}
+					return l.Close()
+				},
+				server: func(log *logp.Logger, handle inputsource.NetworkFunc) (runner, error) {
+					split, err := streaming.NewSplitFuncFactory(cfg.Framing, []byte(cfg.LineDelimiter), nil)
+					if err != nil {
+						return nil, err
+					}
+					return tcp.New(&cfg.Config, streaming.SplitFactoryHandlerFactory(
+						inputsource.FamilyTCP, log, tcp.MetadataCallback, handle, split,
+					))
+				},
+			}
+			if cfg.TLS.IsEnabled() {
+				t.fields["tls"] = mapstr.M{"established": true}
+			}
+		case unix.Name:
+			cfg, err := config.unixConfig(ns.Config())
+			if err != nil {
+				return nil, err
+			}
+			t = transport{
+				name:    name,
+				address: cfg.Path,
+				fields:  mapstr.M{"network": mapstr.M{"transport": unix.Name}},
+				test: func() error {
+					l, err := net.Listen("unix", cfg.Path)
+					if err != nil {
+						return err
+					}
+					return l.Close()
+				},
+				server: func(log *logp.Logger, handle inputsource.NetworkFunc) (runner, error) {
+					return unix.New(log, &cfg, handle)
+				},
+			}
+		}
+		transports = append(transports, t)
+	}
+	return transports, nil
+}
@@ -36,6 +36,7 @@ import (
 
 type config struct {
 	harvester.ForwarderConfig `config:",inline"`
+	ID                        string            `config:"id"`
 	Format                    syslogFormat      `config:"format"`
 	Protocol                  conf.Namespace    `config:"protocol"`
 	Timezone                  *cfgtype.Timezone `config:"timezone"`
@@ -99,6 +100,7 @@ var defaultUDP = udp.Config{
 func factory(
 	nf inputsource.NetworkFunc,
 	config conf.Namespace,
+	metrics *inputMetrics,
 ) (inputsource.Network, error) {
 	n, cfg := config.Name(), config.Config()
 
@@ -109,13 +111,13 @@ func factory(
 			return nil, err
 		}
 
-		splitFunc, err := streaming.SplitFunc(config.Framing, []byte(config.LineDelimiter))
+		splitFunc, err := streaming.NewSplitFuncFactory(config.Framing, []byte(config.LineDelimiter), metrics.framing)
 		if err != nil {
 			return nil, err
 		}
 
 		logger := logp.NewLogger("input.syslog.tcp").With("address", config.Config.Host)
-		factory := streaming.SplitHandlerFactory(inputsource.FamilyTCP, logger, tcp.MetadataCallback, nf, splitFunc)
+		factory := streaming.SplitFactoryHandlerFactory(inputsource.FamilyTCP, logger, tcp.MetadataCallback, nf, splitFunc)
 
 		return tcp.New(&config.Config, factory)
 	case unix.Name:
@@ -133,7 +135,7 @@ func factory(
 		if err := cfg.Unpack(&config); err != nil {
 			return nil, err
 		}
-		return udp.New(&config, nf), nil
+		return udp.New(&config, nf)
 	default:
 		return nil, fmt.Errorf("you must choose between TCP or UDP")
 	}
@@ -102,6 +102,7 @@ type Input struct {
 	outlet  channel.Outleter
 	server  inputsource.Network
 	config  *config
+	metrics *inputMetrics
 	log     *logp.Logger
 }
 
@@ -123,10 +124,12 @@ func NewInput(
 		return nil, err
 	}
 
+	metrics := newInputMetrics(config.ID)
 	forwarder := harvester.NewForwarder(out)
-	cb := GetCbByConfig(config, forwarder, log)
-	server, err := factory(cb, config.Protocol)
+	cb := GetCbByConfig(config, forwarder, metrics, log)
+	server, err := factory(cb, config.Protocol, metrics)
 	if err != nil {
+		metrics.close()
 		return nil, err
 	}
 
@@ -135,6 +138,7 @@ func NewInput(
 		started: false,
 		server:  server,
 		config:  &config,
+		metrics: metrics,
 		log:     log,
 	}, nil
 }
@@ -158,6 +162,7 @@ func (p *Input) Run() {
 // Stop stops the syslog input.
 func (p *Input) Stop() {
 	defer p.outlet.Close()
+	defer p.metrics.close()
 	p.Lock()
 	defer p.Unlock()
 
@@ -175,31 +180,26 @@ func (p *Input) Wait() {
 	p.Stop()
 }
 
-func GetCbByConfig(cfg config, forwarder *harvester.Forwarder, log *logp.Logger) inputsource.NetworkFunc {
-	switch cfg.Format {
-
-	case syslogFormatRFC5424:
-		return func(data []byte, metadata inputsource.NetworkMetadata) {
-			ev := parseAndCreateEvent5424(data, metadata, cfg.Timezone.Location(), log)
-			forwarder.Send(ev)
-		}
-
-	case syslogFormatAuto:
-		return func(data []byte, metadata inputsource.NetworkMetadata) {
-			var ev beat.Event
+func GetCbByConfig(cfg config, forwarder *harvester.Forwarder, metrics *inputMetrics, log *logp.Logger) inputsource.NetworkFunc {
+	return func(data []byte, metadata inputsource.NetworkMetadata) {
+		format := cfg.Format
+		if format == syslogFormatAuto {
+			format = syslogFormatRFC3164
 			if IsRFC5424Format(data) {
-				ev = parseAndCreateEvent5424(data, metadata, cfg.Timezone.Location(), log)
-			} else {
-				ev = parseAndCreateEvent3164(data, metadata, cfg.Timezone.Location(), log)
+				format = syslogFormatRFC5424
 			}
-			forwarder.Send(ev)
 		}
-	case syslogFormatRFC3164:
-		break
-	}
 
-	return func(data []byte, metadata inputsource.NetworkMetadata) {
-		ev := parseAndCreateEvent3164(data, metadata, cfg.Timezone.Location(), log)
+		var (
+			ev    beat.Event
+			valid bool
+		)
+		if format == syslogFormatRFC5424 {
+			ev, valid = parseAndCreateEvent5424(data, metadata, cfg.Timezone.Location(), log)
+		} else {
+			ev, valid = parseAndCreateEvent3164(data, metadata, cfg.Timezone.Location(), log)
+		}
+		metrics.message(format, valid)
 		forwarder.Send(ev)
 	}
 }
@@ -279,28 +279,32 @@ func createEvent(ev *event, metadata inputsource.NetworkMetadata, timezone *time
 	return newBeatEvent(ev.Timestamp(timezone), metadata, f)
 }
 
-func parseAndCreateEvent3164(data []byte, metadata inputsource.NetworkMetadata, timezone *time.Location, log *logp.Logger) beat.Event {
+// parseAndCreateEvent3164 parses data as a RFC3164 message, the returned
+// event only contains the raw message if it is not valid.
+func parseAndCreateEvent3164(data []byte, metadata inputsource.NetworkMetadata, timezone *time.Location, log *logp.Logger) (beat.Event, bool) {
 	ev := newEvent()
 	ParserRFC3164(data, ev)
 	if !ev.IsValid() {
 		log.Errorw("can't parse event as syslog rfc3164", "message", string(data))
 		return newBeatEvent(time.Now(), metadata, mapstr.M{
 			"message": string(data),
-		})
+		}), false
 	}
-	return createEvent(ev, metadata, timezone, log)
+	return createEvent(ev, metadata, timezone, log), true
 }
 
-func parseAndCreateEvent5424(data []byte, metadata inputsource.NetworkMetadata, timezone *time.Location, log *logp.Logger) beat.Event {
+// parseAndCreateEvent5424 parses data as a RFC5424 message, the returned
+// event only contains the raw message if it is not valid.
+func parseAndCreateEvent5424(data []byte, metadata inputsource.NetworkMetadata, timezone *time.Location, log *logp.Logger) (beat.Event, bool) {
 	ev := newEvent()
 	ParserRFC5424(data, ev)
 	if !ev.IsValid() {
 		log.Errorw("can't parse event as syslog rfc5424", "message", string(data))
 		return newBeatEvent(time.Now(), metadata, mapstr.M{
 			"message": string(data),
-		})
+		}), false
 	}
-	return createEvent(ev, metadata, timezone, log)
+	return createEvent(ev, metadata, timezone, log), true
 }
 
 func newBeatEvent(timestamp time.Time, metadata inputsource.NetworkMetadata, fields mapstr.M) beat.Event {
@@ -239,7 +239,7 @@ func TestParseAndCreateEvent3164(t *testing.T) {
 
 	for title, c := range cases {
 		t.Run(title, func(t *testing.T) {
-			event := parseAndCreateEvent3164(c.data, metadata, tz, log)
+			event, _ := parseAndCreateEvent3164(c.data, metadata, tz, log)
 			assert.Equal(t, c.expected, event.Fields)
 			assert.Equal(t, metadata.Truncated, event.Meta["truncated"])
 		})
@@ -342,7 +342,7 @@ func TestParseAndCreateEvent5424(t *testing.T) {
 
 	for title, c := range cases {
 		t.Run(title, func(t *testing.T) {
-			event := parseAndCreateEvent5424(c.data, metadata, tz, log)
+			event, _ := parseAndCreateEvent5424(c.data, metadata, tz, log)
 			assert.Equal(t, c.expected, event.Fields)
 			assert.Equal(t, metadata.Truncated, event.Meta["truncated"])
 		})
@@ -0,0 +1,89 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/streaming"
+	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// inputMetrics handles the input's metric reporting.
+type inputMetrics struct {
+	unregister func()
+
+	events         *monitoring.Uint // number of messages received
+	rfc3164        *monitoring.Uint // number of messages parsed as RFC3164
+	rfc5424        *monitoring.Uint // number of messages parsed as RFC5424
+	invalid        *monitoring.Uint // number of messages that could not be parsed
+	octetCounted   *monitoring.Uint // number of connections using octet counting framing
+	nonTransparent *monitoring.Uint // number of connections using non-transparent framing
+}
+
+// newInputMetrics returns an input metric for the syslog input. If id is empty
+// a nil inputMetric is returned.
+func newInputMetrics(id string) *inputMetrics {
+	if id == "" {
+		return nil
+	}
+	reg, unreg := inputmon.NewInputRegistry("syslog", id, nil)
+	return &inputMetrics{
+		unregister:     unreg,
+		events:         monitoring.NewUint(reg, "received_events_total"),
+		rfc3164:        monitoring.NewUint(reg, "rfc3164_events_total"),
+		rfc5424:        monitoring.NewUint(reg, "rfc5424_events_total"),
+		invalid:        monitoring.NewUint(reg, "invalid_events_total"),
+		octetCounted:   monitoring.NewUint(reg, "octet_counted_connections_total"),
+		nonTransparent: monitoring.NewUint(reg, "non_transparent_connections_total"),
+	}
+}
+
+// message logs metric for a message parsed with the given format.
+func (m *inputMetrics) message(format syslogFormat, valid bool) {
+	if m == nil {
+		return
+	}
+	m.events.Add(1)
+	switch {
+	case !valid:
+		m.invalid.Add(1)
+	case format == syslogFormatRFC5424:
+		m.rfc5424.Add(1)
+	default:
+		m.rfc3164.Add(1)
+	}
+}
+
+// framing logs metric for the framing detected on a new connection.
+func (m *inputMetrics) framing(framing streaming.FramingType) {
+	if m == nil {
+		return
+	}
+	if framing == streaming.FramingRFC6587 {
+		m.octetCounted.Add(1)
+	} else {
+		m.nonTransparent.Add(1)
+	}
+}
+
+func (m *inputMetrics) close() {
+	if m == nil {
+		return
+	}
+	m.unregister()
+}
@@ -0,0 +1,51 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/streaming"
+)
+
+func TestInputMetrics(t *testing.T) {
+	var nilMetrics *inputMetrics
+	nilMetrics.message(syslogFormatRFC3164, true)
+	nilMetrics.framing(streaming.FramingRFC6587)
+	nilMetrics.close()
+
+	m := newInputMetrics("syslog-metrics-test")
+	defer m.close()
+
+	m.message(syslogFormatRFC3164, true)
+	m.message(syslogFormatRFC5424, true)
+	m.message(syslogFormatRFC5424, true)
+	m.message(syslogFormatRFC5424, false)
+	m.framing(streaming.FramingRFC6587)
+	m.framing(streaming.FramingDelimiter)
+	m.framing(streaming.FramingDelimiter)
+
+	assert.Equal(t, uint64(4), m.events.Get())
+	assert.Equal(t, uint64(1), m.rfc3164.Get())
+	assert.Equal(t, uint64(2), m.rfc5424.Get())
+	assert.Equal(t, uint64(1), m.invalid.Get())
+	assert.Equal(t, uint64(1), m.octetCounted.Get())
+	assert.Equal(t, uint64(2), m.nonTransparent.Get())
+}
@@ -36,6 +36,8 @@ import (
 	stateless "github.com/elastic/beats/v7/filebeat/input/v2/input-stateless"
 	"github.com/elastic/beats/v7/filebeat/inputsource"
 	"github.com/elastic/beats/v7/filebeat/inputsource/common/streaming"
+	"github.com/elastic/beats/v7/filebeat/inputsource/sequence"
+	"github.com/elastic/beats/v7/filebeat/inputsource/sourcefilter"
 	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/feature"
@@ -74,6 +76,8 @@ func defaultConfig() config {
 			MaxMessageSize: 20 * humanize.MiByte,
 		},
 		LineDelimiter: "\n",
+		Sequence:      sequence.DefaultConfig(),
+		Filter:        sourcefilter.DefaultConfig(),
 	}
 }
 
@@ -87,6 +91,8 @@ type config struct {
 
 	LineDelimiter string                `config:"line_delimiter" validate:"nonzero"`
 	Framing       streaming.FramingType `config:"framing"`
+	Sequence      sequence.Config       `config:"sequence"`
+	Filter        sourcefilter.Config   `config:",inline"`
 }
 
 func newServer(config config) (*server, error) {
@@ -113,15 +119,31 @@ func (s *server) Run(ctx input.Context, publisher stateless.Publisher) error {
 	metrics := newInputMetrics(ctx.ID, s.config.Host, pollInterval, log)
 	defer metrics.close()
 
-	split, err := streaming.SplitFunc(s.config.Framing, []byte(s.config.LineDelimiter))
+	split, err := streaming.NewSplitFuncFactory(s.config.Framing, []byte(s.config.LineDelimiter), nil)
 	if err != nil {
 		return err
 	}
 
-	server, err := tcp.New(&s.config.Config, streaming.SplitHandlerFactory(
+	tracker, err := sequence.NewTracker(s.config.Sequence)
+	if err != nil {
+		return err
+	}
+
+	filter, err := sourcefilter.New(s.config.Filter)
+	if err != nil {
+		return err
+	}
+
+	server, err := tcp.New(&s.config.Config, streaming.SplitFactoryHandlerFactory(
 		inputsource.FamilyTCP, log, tcp.MetadataCallback, func(data []byte, metadata inputsource.NetworkMetadata) {
+			now := time.Now()
+			if allowed, reason := filter.Allow(metadata.RemoteAddr, now); !allowed {
+				metrics.discard(reason)
+				return
+			}
+
 			evt := beat.Event{
-				Timestamp: time.Now(),
+				Timestamp: now,
 				Fields: mapstr.M{
 					"message": string(data),
 				},
@@ -134,6 +156,12 @@ func (s *server) Run(ctx input.Context, publisher stateless.Publisher) error {
 				}
 			}
 
+			if gap, found := tracker.Observe(sequence.SourceKey(metadata.RemoteAddr), data, evt.Timestamp); found {
+				log.Debugw("Missed messages", "source", gap.Source, "missed", gap.Missed)
+				publisher.Publish(gap.Event(evt.Timestamp))
+				metrics.gap(gap)
+			}
+
 			publisher.Publish(evt)
 
 			// This must be called after publisher.Publish to measure
@@ -167,6 +195,11 @@ type inputMetrics struct {
 	packets        *monitoring.Uint   // number of packets processed
 	bytes          *monitoring.Uint   // number of bytes processed
 	rxQueue        *monitoring.Uint   // value of the rx_queue field from /proc/net/tcp (only on linux systems)
+	sequenceGaps   *monitoring.Uint   // number of gaps detected in the sequence numbers of the messages
+	missed         *monitoring.Uint   // number of messages missed according to their sequence numbers
+	discarded      *monitoring.Uint   // number of messages discarded from denied or rate limited senders
+	denied         *monitoring.Uint   // number of messages discarded from senders not in allowed_hosts
+	rateLimited    *monitoring.Uint   // number of messages discarded from senders over their rate limit
 	arrivalPeriod  metrics.Sample     // histogram of the elapsed time between packet arrivals
 	processingTime metrics.Sample     // histogram of the elapsed time between packet receipt and publication
 }
@@ -184,6 +217,11 @@ func newInputMetrics(id, device string, poll time.Duration, log *logp.Logger) *i
 		packets:        monitoring.NewUint(reg, "received_events_total"),
 		bytes:          monitoring.NewUint(reg, "received_bytes_total"),
 		rxQueue:        monitoring.NewUint(reg, "receive_queue_length"),
+		sequenceGaps:   monitoring.NewUint(reg, "sequence_gaps_total"),
+		missed:         monitoring.NewUint(reg, "sequence_missed_messages_total"),
+		discarded:      monitoring.NewUint(reg, "discarded_events_total"),
+		denied:         monitoring.NewUint(reg, "discarded_denied_total"),
+		rateLimited:    monitoring.NewUint(reg, "discarded_rate_limited_total"),
 		arrivalPeriod:  metrics.NewUniformSample(1024),
 		processingTime: metrics.NewUniformSample(1024),
 	}
@@ -240,6 +278,29 @@ func (m *inputMetrics) log(data []byte, timestamp time.Time) {
 	m.lastPacket = timestamp
 }
 
+// gap logs metric for the given sequence gap.
+func (m *inputMetrics) gap(g sequence.Gap) {
+	if m == nil {
+		return
+	}
+	m.sequenceGaps.Add(1)
+	m.missed.Add(g.Missed)
+}
+
+// discard logs metric for a message discarded by the source filter.
+func (m *inputMetrics) discard(reason sourcefilter.Reason) {
+	if m == nil {
+		return
+	}
+	m.discarded.Add(1)
+	switch reason {
+	case sourcefilter.ReasonDenied:
+		m.denied.Add(1)
+	case sourcefilter.ReasonRateLimited:
+		m.rateLimited.Add(1)
+	}
+}
+
 // poll periodically gets TCP buffer stats from the OS.
 func (m *inputMetrics) poll(addr []string, each time.Duration, log *logp.Logger) {
 	t := time.NewTicker(each)
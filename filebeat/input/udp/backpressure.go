@@ -0,0 +1,142 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"fmt"
+	"sync"
+
+	stateless "github.com/elastic/beats/v7/filebeat/input/v2/input-stateless"
+	"github.com/elastic/beats/v7/libbeat/beat"
+)
+
+// queueFullPolicy selects what happens to the received events while the
+// publisher pipeline is full.
+type queueFullPolicy uint8
+
+const (
+	// policyBlock blocks the readers of the socket until the pipeline
+	// accepts the events, leaving the OS drop the packets once the socket
+	// buffer is full.
+	policyBlock queueFullPolicy = iota
+	// policyDropNewest drops the received events while the buffer is full.
+	policyDropNewest
+	// policyDropOldest drops the oldest buffered events to make room for
+	// the received ones.
+	policyDropOldest
+)
+
+var queueFullPolicies = map[string]queueFullPolicy{
+	"block":       policyBlock,
+	"drop_newest": policyDropNewest,
+	"drop_oldest": policyDropOldest,
+}
+
+func (p *queueFullPolicy) Unpack(s string) error {
+	policy, ok := queueFullPolicies[s]
+	if !ok {
+		return fmt.Errorf("unknown queue_full_policy %q, expected block, drop_newest or drop_oldest", s)
+	}
+	*p = policy
+	return nil
+}
+
+// dropQueue buffers the events between the readers of the socket and the
+// publisher so that the readers never block on a full pipeline. When the
+// buffer is full, events are dropped according to the policy.
+type dropQueue struct {
+	policy  queueFullPolicy
+	dropped func() // called for each dropped event
+
+	mu   sync.Mutex
+	buf  []beat.Event // ring buffer
+	head int
+	len  int
+
+	notify chan struct{}
+}
+
+// newDropQueue returns a dropQueue holding up to size events, or nil if the
+// policy is policyBlock.
+func newDropQueue(policy queueFullPolicy, size int, dropped func()) *dropQueue {
+	if policy == policyBlock {
+		return nil
+	}
+	return &dropQueue{
+		policy:  policy,
+		dropped: dropped,
+		buf:     make([]beat.Event, size),
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+// push adds the event to the buffer, dropping an event if it is full.
+func (q *dropQueue) push(evt beat.Event) {
+	q.mu.Lock()
+	if q.len == len(q.buf) {
+		if q.policy == policyDropNewest {
+			q.mu.Unlock()
+			q.dropped()
+			return
+		}
+		q.buf[q.head] = beat.Event{}
+		q.head = (q.head + 1) % len(q.buf)
+		q.len--
+		q.dropped()
+	}
+	q.buf[(q.head+q.len)%len(q.buf)] = evt
+	q.len++
+	q.mu.Unlock()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// popAll appends the buffered events to dst and empties the buffer.
+func (q *dropQueue) popAll(dst []beat.Event) []beat.Event {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for ; q.len > 0; q.len-- {
+		dst = append(dst, q.buf[q.head])
+		q.buf[q.head] = beat.Event{}
+		q.head = (q.head + 1) % len(q.buf)
+	}
+	q.head = 0
+	return dst
+}
+
+// run publishes the buffered events until done is closed.
+func (q *dropQueue) run(done <-chan struct{}, publisher stateless.Publisher) {
+	var batch []beat.Event
+	for {
+		select {
+		case <-done:
+			return
+		case <-q.notify:
+		}
+		for {
+			batch = q.popAll(batch[:0])
+			if len(batch) == 0 {
+				break
+			}
+			stateless.PublishAll(publisher, batch)
+		}
+	}
+}
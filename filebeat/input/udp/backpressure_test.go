@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestDropQueue(t *testing.T) {
+	assert.Nil(t, newDropQueue(policyBlock, 2, nil))
+
+	ids := func(events []beat.Event) []int {
+		var out []int
+		for _, e := range events {
+			out = append(out, e.Fields["id"].(int))
+		}
+		return out
+	}
+
+	tests := []struct {
+		policy queueFullPolicy
+		want   []int
+	}{
+		{policy: policyDropNewest, want: []int{0, 1}},
+		{policy: policyDropOldest, want: []int{2, 3}},
+	}
+	for _, test := range tests {
+		var dropped int
+		q := newDropQueue(test.policy, 2, func() { dropped++ })
+		for i := 0; i < 4; i++ {
+			q.push(beat.Event{Fields: mapstr.M{"id": i}})
+		}
+		assert.Equal(t, 2, dropped)
+		assert.Equal(t, test.want, ids(q.popAll(nil)))
+		assert.Empty(t, q.popAll(nil))
+
+		// The buffer is usable again once drained.
+		q.push(beat.Event{Fields: mapstr.M{"id": 4}})
+		assert.Equal(t, []int{4}, ids(q.popAll(nil)))
+	}
+}
+
+func TestQueueFullPolicyUnpack(t *testing.T) {
+	var p queueFullPolicy
+	assert.NoError(t, p.Unpack("drop_oldest"))
+	assert.Equal(t, policyDropOldest, p)
+	assert.Error(t, p.Unpack("drop_all"))
+}
@@ -0,0 +1,119 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// dedupConfig configures the suppression of the packets retransmitted by the
+// senders.
+type dedupConfig struct {
+	Enabled bool `config:"enabled"`
+	// Window is the time during which a payload received again from the
+	// same source is dropped.
+	Window time.Duration `config:"window" validate:"positive,nonzero"`
+	// MaxEntries is the maximum number of payloads remembered. The oldest
+	// ones are forgotten first when it is reached.
+	MaxEntries int `config:"max_entries" validate:"min=1"`
+}
+
+func defaultDedupConfig() dedupConfig {
+	return dedupConfig{
+		Enabled:    false,
+		Window:     5 * time.Second,
+		MaxEntries: 100000,
+	}
+}
+
+// deduplicator remembers the hashes of the payloads received during the
+// window. It is safe for concurrent use by the workers of the input.
+type deduplicator struct {
+	window     time.Duration
+	maxEntries int
+
+	mu    sync.Mutex
+	seen  map[uint64]time.Time
+	queue []dedupEntry // ordered by reception time
+}
+
+type dedupEntry struct {
+	hash uint64
+	seen time.Time
+}
+
+// newDeduplicator returns a deduplicator, or nil if deduplication is disabled.
+func newDeduplicator(config dedupConfig) *deduplicator {
+	if !config.Enabled {
+		return nil
+	}
+	return &deduplicator{
+		window:     config.Window,
+		maxEntries: config.MaxEntries,
+		seen:       map[uint64]time.Time{},
+	}
+}
+
+// duplicate reports whether data has already been received from source
+// during the window preceding now.
+func (d *deduplicator) duplicate(source string, data []byte, now time.Time) bool {
+	if d == nil {
+		return false
+	}
+
+	h := xxhash.New()
+	_, _ = h.WriteString(source)
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write(data)
+	sum := h.Sum64()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.expire(now.Add(-d.window))
+
+	if _, found := d.seen[sum]; found {
+		return true
+	}
+
+	if len(d.queue) >= d.maxEntries {
+		d.remove(d.queue[0])
+		d.queue = d.queue[1:]
+	}
+	d.seen[sum] = now
+	d.queue = append(d.queue, dedupEntry{hash: sum, seen: now})
+	return false
+}
+
+// expire forgets the payloads received before the given time.
+func (d *deduplicator) expire(before time.Time) {
+	n := 0
+	for n < len(d.queue) && d.queue[n].seen.Before(before) {
+		d.remove(d.queue[n])
+		n++
+	}
+	d.queue = d.queue[n:]
+}
+
+func (d *deduplicator) remove(e dedupEntry) {
+	if d.seen[e.hash] == e.seen {
+		delete(d.seen, e.hash)
+	}
+}
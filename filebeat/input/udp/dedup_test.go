@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeduplicator(t *testing.T) {
+	config := defaultDedupConfig()
+	config.Enabled = true
+	config.Window = 5 * time.Second
+	d := newDeduplicator(config)
+
+	now := time.Now()
+	assert.False(t, d.duplicate("10.0.0.1", []byte("message"), now))
+	assert.True(t, d.duplicate("10.0.0.1", []byte("message"), now.Add(time.Second)), "retransmission")
+	assert.False(t, d.duplicate("10.0.0.2", []byte("message"), now.Add(time.Second)), "other source")
+	assert.False(t, d.duplicate("10.0.0.1", []byte("other message"), now.Add(time.Second)), "other payload")
+
+	// The window starts with the first reception, retransmissions do not
+	// extend it.
+	assert.True(t, d.duplicate("10.0.0.1", []byte("message"), now.Add(5*time.Second)))
+	assert.False(t, d.duplicate("10.0.0.1", []byte("message"), now.Add(6*time.Second)), "window elapsed")
+	assert.Len(t, d.seen, 3)
+	assert.Len(t, d.queue, 3)
+}
+
+func TestDeduplicatorMaxEntries(t *testing.T) {
+	config := defaultDedupConfig()
+	config.Enabled = true
+	config.MaxEntries = 2
+	d := newDeduplicator(config)
+
+	now := time.Now()
+	assert.False(t, d.duplicate("src", []byte("a"), now))
+	assert.False(t, d.duplicate("src", []byte("b"), now))
+	assert.False(t, d.duplicate("src", []byte("c"), now))
+	assert.Len(t, d.seen, 2)
+
+	assert.False(t, d.duplicate("src", []byte("a"), now), "oldest entry must be forgotten")
+	assert.True(t, d.duplicate("src", []byte("c"), now))
+}
+
+func TestDeduplicatorDisabled(t *testing.T) {
+	d := newDeduplicator(defaultDedupConfig())
+	assert.Nil(t, d)
+	assert.False(t, d.duplicate("src", []byte("a"), time.Now()))
+	assert.False(t, d.duplicate("src", []byte("a"), time.Now()))
+}
@@ -19,14 +19,17 @@ package udp
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io/fs"
 	"net"
 	"os"
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -35,6 +38,9 @@ import (
 	input "github.com/elastic/beats/v7/filebeat/input/v2"
 	stateless "github.com/elastic/beats/v7/filebeat/input/v2/input-stateless"
 	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/filebeat/inputsource/codec"
+	"github.com/elastic/beats/v7/filebeat/inputsource/sequence"
+	"github.com/elastic/beats/v7/filebeat/inputsource/sourcefilter"
 	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/feature"
@@ -73,6 +79,13 @@ func defaultConfig() config {
 			Host:           "localhost:8080",
 			Timeout:        time.Minute * 5,
 		},
+		Sequence: sequence.DefaultConfig(),
+		Dedup:    defaultDedupConfig(),
+		Filter:   sourcefilter.DefaultConfig(),
+		Codec:    codec.DefaultConfig(),
+
+		QueueFullPolicy:   policyBlock,
+		PublishBufferSize: 4096,
 	}
 }
 
@@ -83,6 +96,18 @@ type server struct {
 
 type config struct {
 	udp.Config `config:",inline"`
+
+	Sequence sequence.Config     `config:"sequence"`
+	Dedup    dedupConfig         `config:"dedup"`
+	Filter   sourcefilter.Config `config:",inline"`
+	Codec    codec.Config        `config:",inline"`
+
+	// QueueFullPolicy selects whether the readers block or events are
+	// dropped while the publisher pipeline is full.
+	QueueFullPolicy queueFullPolicy `config:"queue_full_policy"`
+	// PublishBufferSize is the number of events buffered for the
+	// publisher by the drop policies.
+	PublishBufferSize int `config:"publish_buffer_size" validate:"min=1"`
 }
 
 func newServer(config config) (*server, error) {
@@ -109,34 +134,104 @@ func (s *server) Run(ctx input.Context, publisher stateless.Publisher) error {
 	metrics := newInputMetrics(ctx.ID, s.config.Host, uint64(s.config.ReadBuffer), pollInterval, log)
 	defer metrics.close()
 
-	server := udp.New(&s.config.Config, func(data []byte, metadata inputsource.NetworkMetadata) {
-		evt := beat.Event{
-			Timestamp: time.Now(),
-			Meta: mapstr.M{
-				"truncated": metadata.Truncated,
-			},
+	tracker, err := sequence.NewTracker(s.config.Sequence)
+	if err != nil {
+		return err
+	}
+
+	dedup := newDeduplicator(s.config.Dedup)
+
+	filter, err := sourcefilter.New(s.config.Filter)
+	if err != nil {
+		return err
+	}
+
+	decoder, err := codec.New(s.config.Codec)
+	if err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithCancel(ctxtool.FromCanceller(ctx.Cancelation))
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	publish := publisher.Publish
+	if queue := newDropQueue(s.config.QueueFullPolicy, s.config.PublishBufferSize, metrics.pipelineDrop); queue != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			queue.run(runCtx.Done(), publisher)
+		}()
+		publish = queue.push
+	}
+
+	server, err := udp.New(&s.config.Config, func(data []byte, metadata inputsource.NetworkMetadata) {
+		now := time.Now()
+		if allowed, reason := filter.Allow(metadata.RemoteAddr, now); !allowed {
+			metrics.discard(reason)
+			return
+		}
+		if dedup.duplicate(sequence.SourceKey(metadata.RemoteAddr), data, now) {
+			metrics.duplicate()
+			return
+		}
+
+		events := []beat.Event{{
 			Fields: mapstr.M{
 				"message": string(data),
 			},
+		}}
+		if decoder != nil {
+			var err error
+			events, err = decoder.Decode(data)
+			if err != nil {
+				log.Debugw("Failed to decode message", "codec", s.config.Codec.Codec, "error", err)
+				metrics.decodeError()
+				// The events decoded before the error are kept, the
+				// error is reported on the last one holding the raw
+				// payload.
+				if len(events) != 0 {
+					_, _ = events[len(events)-1].PutValue("error.message", err.Error())
+				}
+			}
 		}
-		if metadata.RemoteAddr != nil {
-			evt.Fields["log"] = mapstr.M{
-				"source": mapstr.M{
-					"address": metadata.RemoteAddr.String(),
-				},
+
+		for i := range events {
+			evt := &events[i]
+			if evt.Timestamp.IsZero() {
+				evt.Timestamp = now
+			}
+			evt.Meta = mapstr.M{
+				"truncated": metadata.Truncated,
 			}
+			if metadata.RemoteAddr != nil {
+				_, _ = evt.PutValue("log.source.address", metadata.RemoteAddr.String())
+			}
+		}
+
+		if gap, found := tracker.Observe(sequence.SourceKey(metadata.RemoteAddr), data, now); found {
+			log.Debugw("Missed messages", "source", gap.Source, "missed", gap.Missed)
+			publish(gap.Event(now))
+			metrics.gap(gap)
 		}
 
-		publisher.Publish(evt)
+		for _, evt := range events {
+			publish(evt)
+		}
 
-		// This must be called after publisher.Publish to measure
+		// This must be called after publish to measure
 		// the processing time metric.
-		metrics.log(data, evt.Timestamp)
+		metrics.log(data, now)
 	})
 
+	if err != nil {
+		return err
+	}
+
 	log.Debug("udp input initialized")
 
-	err := server.Run(ctxtool.FromCanceller(ctx.Cancelation))
+	err = server.Run(runCtx)
 	// Ignore error from 'Run' in case shutdown was signaled.
 	if ctxerr := ctx.Cancelation.Err(); ctxerr != nil {
 		err = ctxerr
@@ -149,6 +244,7 @@ type inputMetrics struct {
 	unregister func()
 	done       chan struct{}
 
+	mu         sync.Mutex // guards lastPacket, packets are logged by all workers
 	lastPacket time.Time
 
 	device         *monitoring.String // name of the device being monitored
@@ -156,7 +252,15 @@ type inputMetrics struct {
 	bytes          *monitoring.Uint   // number of bytes processed
 	bufferLen      *monitoring.Uint   // configured read buffer length
 	rxQueue        *monitoring.Uint   // value of the rx_queue field from /proc/net/udp (only on linux systems)
-	drops          *monitoring.Uint   // number of udp drops noted in /proc/net/udp
+	drops          *monitoring.Uint   // number of udp drops noted in /proc/net/udp, or of the host on windows and darwin
+	sequenceGaps   *monitoring.Uint   // number of gaps detected in the sequence numbers of the messages
+	missed         *monitoring.Uint   // number of messages missed according to their sequence numbers
+	duplicates     *monitoring.Uint   // number of retransmitted packets dropped
+	discarded      *monitoring.Uint   // number of packets discarded from denied or rate limited senders
+	denied         *monitoring.Uint   // number of packets discarded from senders not in allowed_hosts
+	rateLimited    *monitoring.Uint   // number of packets discarded from senders over their rate limit
+	decodeErrors   *monitoring.Uint   // number of packets that could not be decoded by the codec
+	pipelineDrops  *monitoring.Uint   // number of events dropped by the queue_full_policy
 	arrivalPeriod  metrics.Sample     // histogram of the elapsed time between packet arrivals
 	processingTime metrics.Sample     // histogram of the elapsed time between packet receipt and publication
 }
@@ -175,6 +279,14 @@ func newInputMetrics(id, device string, buflen uint64, poll time.Duration, log *
 		packets:        monitoring.NewUint(reg, "received_events_total"),
 		bytes:          monitoring.NewUint(reg, "received_bytes_total"),
 		rxQueue:        monitoring.NewUint(reg, "receive_queue_length"),
+		sequenceGaps:   monitoring.NewUint(reg, "sequence_gaps_total"),
+		missed:         monitoring.NewUint(reg, "sequence_missed_messages_total"),
+		duplicates:     monitoring.NewUint(reg, "duplicates_suppressed_total"),
+		discarded:      monitoring.NewUint(reg, "discarded_events_total"),
+		denied:         monitoring.NewUint(reg, "discarded_denied_total"),
+		rateLimited:    monitoring.NewUint(reg, "discarded_rate_limited_total"),
+		decodeErrors:   monitoring.NewUint(reg, "decode_errors_total"),
+		pipelineDrops:  monitoring.NewUint(reg, "pipeline_dropped_events_total"),
 		drops:          monitoring.NewUint(reg, "system_packet_drops"),
 		arrivalPeriod:  metrics.NewUniformSample(1024),
 		processingTime: metrics.NewUniformSample(1024),
@@ -187,38 +299,69 @@ func newInputMetrics(id, device string, buflen uint64, poll time.Duration, log *
 	out.device.Set(device)
 	out.bufferLen.Set(buflen)
 
-	if poll > 0 && runtime.GOOS == "linux" {
-		host, port, ok := strings.Cut(device, ":")
-		if !ok {
-			log.Warnf("failed to get address for %s: no port separator", device)
-			return out
-		}
-		ip, err := net.LookupIP(host)
+	switch {
+	case poll <= 0:
+	case runtime.GOOS == "linux":
+		addr, err := procNetAddrs(device)
 		if err != nil {
 			log.Warnf("failed to get address for %s: %v", device, err)
 			return out
 		}
-		p, err := strconv.ParseInt(port, 10, 16)
-		if err != nil {
-			log.Warnf("failed to get port for %s: %v", device, err)
-			return out
-		}
-		ph := strconv.FormatInt(p, 16)
-		addr := make([]string, 0, len(ip))
-		for _, p := range ip {
-			p4 := p.To4()
-			if len(p4) != net.IPv4len {
-				continue
+		out.done = make(chan struct{})
+		go out.poll(addr, poll, log)
+	default:
+		if _, err := systemUDPDrops(); err != nil {
+			if !errors.Is(err, errUnsupported) {
+				log.Warnf("failed to get udp stats from the system: %v", err)
 			}
-			addr = append(addr, fmt.Sprintf("%X:%s", binary.LittleEndian.Uint32(p4), ph))
+			return out
 		}
 		out.done = make(chan struct{})
-		go out.poll(addr, poll, log)
+		go out.pollSystem(poll, log)
 	}
 
 	return out
 }
 
+// procNetAddrs returns the addresses of the device formatted as in the
+// /proc/net/udp and /proc/net/udp6 socket tables. A device without host
+// matches the IPv4 and IPv6 wildcard addresses.
+func procNetAddrs(device string) ([]string, error) {
+	host, port, err := net.SplitHostPort(device)
+	if err != nil {
+		return nil, err
+	}
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get port: %w", err)
+	}
+	var ip []net.IP
+	if host == "" {
+		ip = []net.IP{net.IPv4zero, net.IPv6zero}
+	} else {
+		ip, err = net.LookupIP(host)
+		if err != nil {
+			return nil, err
+		}
+	}
+	addr := make([]string, 0, len(ip))
+	for _, a := range ip {
+		if a4 := a.To4(); a4 != nil {
+			addr = append(addr, fmt.Sprintf("%08X:%04X", binary.LittleEndian.Uint32(a4), p))
+			continue
+		}
+		// The kernel prints the IPv6 addresses as four 32 bits words in host
+		// byte order.
+		var b strings.Builder
+		for i := 0; i < net.IPv6len; i += 4 {
+			fmt.Fprintf(&b, "%08X", binary.LittleEndian.Uint32(a[i:i+4]))
+		}
+		fmt.Fprintf(&b, ":%04X", p)
+		addr = append(addr, b.String())
+	}
+	return addr, nil
+}
+
 // log logs metric for the given packet.
 func (m *inputMetrics) log(data []byte, timestamp time.Time) {
 	if m == nil {
@@ -227,10 +370,63 @@ func (m *inputMetrics) log(data []byte, timestamp time.Time) {
 	m.processingTime.Update(time.Since(timestamp).Nanoseconds())
 	m.packets.Add(1)
 	m.bytes.Add(uint64(len(data)))
-	if !m.lastPacket.IsZero() {
-		m.arrivalPeriod.Update(timestamp.Sub(m.lastPacket).Nanoseconds())
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// Packets handled by different workers can be logged out of order.
+	if timestamp.After(m.lastPacket) {
+		if !m.lastPacket.IsZero() {
+			m.arrivalPeriod.Update(timestamp.Sub(m.lastPacket).Nanoseconds())
+		}
+		m.lastPacket = timestamp
+	}
+}
+
+// gap logs metric for the given sequence gap.
+func (m *inputMetrics) gap(g sequence.Gap) {
+	if m == nil {
+		return
+	}
+	m.sequenceGaps.Add(1)
+	m.missed.Add(g.Missed)
+}
+
+// duplicate logs metric for a dropped retransmitted packet.
+func (m *inputMetrics) duplicate() {
+	if m == nil {
+		return
+	}
+	m.duplicates.Add(1)
+}
+
+// discard logs metric for a message discarded by the source filter.
+func (m *inputMetrics) discard(reason sourcefilter.Reason) {
+	if m == nil {
+		return
+	}
+	m.discarded.Add(1)
+	switch reason {
+	case sourcefilter.ReasonDenied:
+		m.denied.Add(1)
+	case sourcefilter.ReasonRateLimited:
+		m.rateLimited.Add(1)
+	}
+}
+
+// decodeError logs metric for a packet the codec failed to decode.
+func (m *inputMetrics) decodeError() {
+	if m == nil {
+		return
+	}
+	m.decodeErrors.Add(1)
+}
+
+// pipelineDrop logs metric for an event dropped by the queue_full_policy.
+func (m *inputMetrics) pipelineDrop() {
+	if m == nil {
+		return
 	}
-	m.lastPacket = timestamp
+	m.pipelineDrops.Add(1)
 }
 
 // poll periodically gets UDP buffer and packet drops stats from the OS.
@@ -239,7 +435,7 @@ func (m *inputMetrics) poll(addr []string, each time.Duration, log *logp.Logger)
 	for {
 		select {
 		case <-t.C:
-			rx, drops, err := procNetUDP("/proc/net/udp", addr)
+			rx, drops, err := procNetUDP([]string{"/proc/net/udp", "/proc/net/udp6"}, addr)
 			if err != nil {
 				log.Warnf("failed to get udp stats from /proc: %v", err)
 				continue
@@ -253,38 +449,82 @@ func (m *inputMetrics) poll(addr []string, each time.Duration, log *logp.Logger)
 	}
 }
 
-// procNetUDP returns the rx_queue and drops field of the UDP socket table
-// for the socket on the provided address formatted in hex, xxxxxxxx:xxxx.
+// errUnsupported is returned by systemUDPDrops on the systems where it is
+// not implemented.
+var errUnsupported = errors.New("not supported on " + runtime.GOOS)
+
+// pollSystem periodically gets the UDP packet drops of the host from the OS,
+// on the systems not reporting the drops of each socket.
+func (m *inputMetrics) pollSystem(each time.Duration, log *logp.Logger) {
+	t := time.NewTicker(each)
+	for {
+		select {
+		case <-t.C:
+			drops, err := systemUDPDrops()
+			if err != nil {
+				log.Warnf("failed to get udp stats from the system: %v", err)
+				continue
+			}
+			m.drops.Set(drops)
+		case <-m.done:
+			t.Stop()
+			return
+		}
+	}
+}
+
+// procNetUDP returns the rx_queue and drops field of the UDP socket tables
+// at paths for the sockets on the provided addresses formatted in hex,
+// xxxxxxxx:xxxx for IPv4 and xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx:xxxx for IPv6.
+// The fields of all the sockets bound to the addresses by the workers of the
+// input are summed across the tables. Missing tables, such as the IPv6 one
+// when IPv6 is disabled, are skipped.
 // This function is only useful on linux due to its dependence on the /proc
 // filesystem, but is kept in this file for simplicity.
-func procNetUDP(path string, addr []string) (rx, drops int64, err error) {
-	b, err := os.ReadFile(path)
-	if err != nil {
-		return 0, 0, err
-	}
-	lines := bytes.Split(b, []byte("\n"))
-	if len(lines) < 2 {
-		return 0, 0, fmt.Errorf("%s entry not found for %s (no line)", path, addr)
-	}
-	for _, l := range lines[1:] {
-		f := bytes.Fields(l)
-		if len(f) > 12 && contains(f[1], addr) {
-			_, r, ok := bytes.Cut(f[4], []byte(":"))
-			if !ok {
-				return 0, 0, errors.New("no rx_queue field " + string(f[4]))
-			}
-			rx, err = strconv.ParseInt(string(r), 16, 64)
-			if err != nil {
-				return 0, 0, fmt.Errorf("failed to parse rx_queue: %w", err)
+func procNetUDP(paths []string, addr []string) (rx, drops int64, err error) {
+	found := false
+	read := 0
+	for _, path := range paths {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
 			}
-			drops, err = strconv.ParseInt(string(f[12]), 16, 64)
-			if err != nil {
-				return 0, 0, fmt.Errorf("failed to parse drops: %w", err)
+			return 0, 0, err
+		}
+		read++
+		lines := bytes.Split(b, []byte("\n"))
+		if len(lines) < 2 {
+			continue
+		}
+		for _, l := range lines[1:] {
+			f := bytes.Fields(l)
+			if len(f) > 12 && contains(f[1], addr) {
+				_, r, ok := bytes.Cut(f[4], []byte(":"))
+				if !ok {
+					return 0, 0, errors.New("no rx_queue field " + string(f[4]))
+				}
+				sockRx, err := strconv.ParseInt(string(r), 16, 64)
+				if err != nil {
+					return 0, 0, fmt.Errorf("failed to parse rx_queue: %w", err)
+				}
+				sockDrops, err := strconv.ParseInt(string(f[12]), 16, 64)
+				if err != nil {
+					return 0, 0, fmt.Errorf("failed to parse drops: %w", err)
+				}
+				rx += sockRx
+				drops += sockDrops
+				found = true
 			}
-			return rx, drops, nil
 		}
 	}
-	return 0, 0, fmt.Errorf("%s entry not found for %s", path, addr)
+	if read == 0 {
+		return 0, 0, fmt.Errorf("none of %s found", strings.Join(paths, ", "))
+	}
+	if !found {
+		return 0, 0, fmt.Errorf("%s entry not found for %s", strings.Join(paths, ", "), addr)
+	}
+	return rx, drops, nil
 }
 
 func contains(b []byte, addr []string) bool {
@@ -18,6 +18,8 @@
 package udp
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,7 +27,7 @@ import (
 
 func TestProcNetUDP(t *testing.T) {
 	t.Run("with_match", func(t *testing.T) {
-		rx, drops, err := procNetUDP("testdata/proc_net_udp.txt", []string{"2508640A:1BBE"})
+		rx, drops, err := procNetUDP([]string{"testdata/proc_net_udp.txt"}, []string{"2508640A:1BBE"})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -33,10 +35,64 @@ func TestProcNetUDP(t *testing.T) {
 		assert.EqualValues(t, 2, drops)
 	})
 
+	t.Run("with_multiple_sockets", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "udp")
+		table := "  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops\n" +
+			" 1325: 2508640A:1BBE 00000000:0000 07 00000000:00000001 00:00000000 00000000     0        0 104836465 2 0000000000000000 2\n" +
+			" 1325: 2508640A:1BBE 00000000:0000 07 00000000:0000000A 00:00000000 00000000     0        0 104836466 2 0000000000000000 3\n"
+		if err := os.WriteFile(path, []byte(table), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		rx, drops, err := procNetUDP([]string{path}, []string{"2508640A:1BBE"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.EqualValues(t, 11, rx)
+		assert.EqualValues(t, 5, drops)
+	})
+
+	t.Run("with_ipv6", func(t *testing.T) {
+		paths := []string{"testdata/proc_net_udp.txt", "testdata/proc_net_udp6.txt", "testdata/missing.txt"}
+		rx, drops, err := procNetUDP(paths, []string{"00000000000000000000000001000000:1BBE"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.EqualValues(t, 4, rx)
+		assert.EqualValues(t, 7, drops)
+
+		// Drops are summed across address families.
+		rx, drops, err = procNetUDP(paths, []string{"2508640A:1BBE", "00000000000000000000000001000000:1BBE"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.EqualValues(t, 5, rx)
+		assert.EqualValues(t, 9, drops)
+	})
+
 	t.Run("without_match", func(t *testing.T) {
-		_, _, err := procNetUDP("testdata/proc_net_udp.txt", []string{"FOO:BAR", "BAR:BAZ"})
+		_, _, err := procNetUDP([]string{"testdata/proc_net_udp.txt"}, []string{"FOO:BAR", "BAR:BAZ"})
 		if assert.Error(t, err) {
 			assert.Contains(t, err.Error(), "entry not found")
 		}
 	})
 }
+
+func TestProcNetAddrs(t *testing.T) {
+	addr, err := procNetAddrs("[::1]:7102")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"00000000000000000000000001000000:1BBE"}, addr)
+	}
+
+	addr, err = procNetAddrs("10.100.8.37:53")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"2508640A:0035"}, addr)
+	}
+
+	addr, err = procNetAddrs(":50000")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"00000000:C350", "00000000000000000000000000000000:C350"}, addr)
+	}
+
+	_, err = procNetAddrs("localhost")
+	assert.Error(t, err)
+}
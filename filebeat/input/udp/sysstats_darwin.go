@@ -0,0 +1,48 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build darwin
+
+package udp
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// udpsFullSockOffset is the offset of the udps_fullsock field, the number of
+// datagrams not delivered because the socket buffer was full, in the udpstat
+// structure returned by the net.inet.udp.stats sysctl.
+const udpsFullSockOffset = 6 * 4
+
+// systemUDPDrops returns the number of UDP datagrams received by the host over
+// IPv4 and IPv6 that were dropped because the socket buffer was full. macOS
+// does not report the drops of each socket.
+func systemUDPDrops() (uint64, error) {
+	b, err := unix.SysctlRaw("net.inet.udp.stats")
+	if err != nil {
+		return 0, err
+	}
+	if len(b) < udpsFullSockOffset+4 {
+		return 0, fmt.Errorf("net.inet.udp.stats is too short: %d bytes", len(b))
+	}
+	// The sysctl returns the structure in host byte order, which is little
+	// endian on all the supported architectures.
+	return uint64(binary.LittleEndian.Uint32(b[udpsFullSockOffset:])), nil
+}
@@ -0,0 +1,58 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build windows
+
+package udp
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var procGetUdpStatisticsEx = windows.NewLazySystemDLL("iphlpapi.dll").NewProc("GetUdpStatisticsEx")
+
+// https://learn.microsoft.com/en-us/windows/win32/api/udpmib/ns-udpmib-mib_udpstats
+type mibUDPStats struct {
+	inDatagrams  uint32
+	noPorts      uint32
+	inErrors     uint32
+	outDatagrams uint32
+	numAddrs     uint32
+}
+
+// systemUDPDrops returns the number of UDP datagrams received by the host over
+// IPv4 and IPv6 that were discarded for reasons other than the lack of a
+// listener, mostly full socket buffers. Windows does not report the drops of
+// each socket.
+func systemUDPDrops() (uint64, error) {
+	if err := procGetUdpStatisticsEx.Find(); err != nil {
+		return 0, err
+	}
+	var drops uint64
+	for _, family := range []uint32{windows.AF_INET, windows.AF_INET6} {
+		var stats mibUDPStats
+		r, _, _ := procGetUdpStatisticsEx.Call(uintptr(unsafe.Pointer(&stats)), uintptr(family))
+		if r != 0 {
+			return 0, fmt.Errorf("GetUdpStatisticsEx failed: %w", windows.Errno(r))
+		}
+		drops += uint64(stats.inErrors)
+	}
+	return drops, nil
+}
@@ -56,6 +56,7 @@ type runner struct {
 	sig       ctxtool.CancelContext
 	input     v2.Input
 	connector beat.PipelineConnector
+	once      bool
 }
 
 // RunnerFactory creates a cfgfile.RunnerFactory from an input Loader that is
@@ -103,11 +104,27 @@ func (f *factory) Create(
 
 func (r *runner) String() string { return r.input.Name() }
 
+// RunOnce configures the runner to run the input once, Start then returns
+// after the input has stopped. It returns false if the input does not
+// support running once.
+func (r *runner) RunOnce() bool {
+	if input, ok := r.input.(v2.OnceInput); !ok || !input.SupportsOnce() {
+		return false
+	}
+	r.once = true
+	return true
+}
+
 func (r *runner) Start() {
 	r.wg.Add(1)
 	log := r.log
 	name := r.input.Name()
 
+	if r.once {
+		// Make sure Start only returns once the input has collected all data.
+		defer r.wg.Wait()
+	}
+
 	go func() {
 		defer r.wg.Done()
 		log.Infof("Input '%s' starting", name)
@@ -117,6 +134,7 @@ func (r *runner) Start() {
 				Agent:       *r.agent,
 				Logger:      log,
 				Cancelation: r.sig,
+				Once:        r.once,
 			},
 			r.connector,
 		)
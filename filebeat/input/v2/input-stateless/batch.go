@@ -0,0 +1,135 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stateless
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+)
+
+// batchConfig configures the micro-batching of the events published by an input.
+type batchConfig struct {
+	// Size is the number of events buffered before they are published
+	// together. Batching is disabled when Size is 0 or 1.
+	Size int `config:"size"`
+	// FlushInterval is the maximum time events stay in the buffer.
+	FlushInterval time.Duration `config:"flush_interval"`
+}
+
+func defaultBatchConfig() batchConfig {
+	return batchConfig{FlushInterval: time.Second}
+}
+
+func (c *batchConfig) Validate() error {
+	if c.Size < 0 {
+		return errors.New("publish_batch.size must not be negative")
+	}
+	if c.FlushInterval <= 0 {
+		return errors.New("publish_batch.flush_interval must be positive")
+	}
+	return nil
+}
+
+func (c *batchConfig) enabled() bool { return c.Size > 1 }
+
+// batcher buffers the events of an input and publishes them with a single
+// PublishAll call once the buffer is full or the flush interval has elapsed,
+// amortizing the cost of the pipeline client per event.
+type batcher struct {
+	client beat.Client
+
+	mu   sync.Mutex
+	size int
+	buf  []beat.Event
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+var _ BatchPublisher = (*batcher)(nil)
+
+func newBatcher(client beat.Client, cfg batchConfig) *batcher {
+	b := &batcher{
+		client: client,
+		size:   cfg.Size,
+		buf:    make([]beat.Event, 0, cfg.Size),
+		done:   make(chan struct{}),
+	}
+	b.wg.Add(1)
+	go b.flushLoop(cfg.FlushInterval)
+	return b
+}
+
+func (b *batcher) flushLoop(interval time.Duration) {
+	defer b.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.done:
+			return
+		case <-ticker.C:
+			b.mu.Lock()
+			b.flushLocked()
+			b.mu.Unlock()
+		}
+	}
+}
+
+// Publish adds the event to the buffer, publishing the buffer if it is full.
+func (b *batcher) Publish(event beat.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buf = append(b.buf, event)
+	if len(b.buf) >= b.size {
+		b.flushLocked()
+	}
+}
+
+// PublishAll adds the events to the buffer, publishing the buffer if it is full.
+func (b *batcher) PublishAll(events []beat.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buf = append(b.buf, events...)
+	if len(b.buf) >= b.size {
+		b.flushLocked()
+	}
+}
+
+func (b *batcher) flushLocked() {
+	if len(b.buf) == 0 {
+		return
+	}
+	b.client.PublishAll(b.buf)
+	for i := range b.buf {
+		b.buf[i] = beat.Event{}
+	}
+	b.buf = b.buf[:0]
+}
+
+// Close stops the flush loop and publishes the buffered events.
+func (b *batcher) Close() {
+	close(b.done)
+	b.wg.Wait()
+	b.mu.Lock()
+	b.flushLocked()
+	b.mu.Unlock()
+}
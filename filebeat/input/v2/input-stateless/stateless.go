@@ -46,8 +46,28 @@ type Publisher interface {
 	Publish(beat.Event)
 }
 
+// BatchPublisher is implemented by the publishers able to emit several events
+// with a single call. The publisher passed to Run implements it.
+type BatchPublisher interface {
+	Publisher
+	PublishAll([]beat.Event)
+}
+
+// PublishAll emits the events with a single call if the publisher implements
+// BatchPublisher, and one by one otherwise.
+func PublishAll(p Publisher, events []beat.Event) {
+	if bp, ok := p.(BatchPublisher); ok {
+		bp.PublishAll(events)
+		return
+	}
+	for _, e := range events {
+		p.Publish(e)
+	}
+}
+
 type configuredInput struct {
 	input Input
+	batch batchConfig
 }
 
 var _ v2.InputManager = InputManager{}
@@ -62,12 +82,23 @@ func (m InputManager) Init(_ unison.Group, _ v2.Mode) error { return nil }
 
 // Create configures a transient input and ensures that the final input can be used with
 // with the filebeat input architecture.
+//
+// The events of the input are published in batches of `publish_batch.size` events,
+// flushed at least every `publish_batch.flush_interval`, when the size is
+// greater than 1.
 func (m InputManager) Create(cfg *conf.C) (v2.Input, error) {
+	settings := struct {
+		Batch batchConfig `config:"publish_batch"`
+	}{Batch: defaultBatchConfig()}
+	if err := cfg.Unpack(&settings); err != nil {
+		return nil, err
+	}
+
 	inp, err := m.Configure(cfg)
 	if err != nil {
 		return nil, err
 	}
-	return configuredInput{inp}, nil
+	return configuredInput{input: inp, batch: settings.Batch}, nil
 }
 
 func (si configuredInput) Name() string { return si.input.Name() }
@@ -94,7 +125,13 @@ func (si configuredInput) Run(ctx v2.Context, pipeline beat.PipelineConnector) (
 	}
 
 	defer client.Close()
-	return si.input.Run(ctx, client)
+
+	if !si.batch.enabled() {
+		return si.input.Run(ctx, client)
+	}
+	batcher := newBatcher(client, si.batch)
+	defer batcher.Close()
+	return si.input.Run(ctx, batcher)
 }
 
 func (si configuredInput) Test(ctx v2.TestContext) error {
@@ -75,6 +75,39 @@ func TestStateless_Run(t *testing.T) {
 		require.Equal(t, numEvents, receivedEvents)
 	})
 
+	t.Run("events are published in batches", func(t *testing.T) {
+		const numEvents = 5
+
+		var (
+			mu      sync.Mutex
+			batches []int
+		)
+		connector := pubtest.FakeConnector{
+			ConnectFunc: func(beat.ClientConfig) (beat.Client, error) {
+				return &batchClient{onPublishAll: func(events []beat.Event) {
+					mu.Lock()
+					batches = append(batches, len(events))
+					mu.Unlock()
+				}}, nil
+			},
+		}
+
+		input := createConfiguredInput(t, constInputManager(&fakeStatelessInput{
+			OnRun: func(ctx v2.Context, publisher stateless.Publisher) error {
+				for i := 0; i < numEvents; i++ {
+					publisher.Publish(beat.Event{Fields: map[string]interface{}{"id": i}})
+				}
+				return nil
+			},
+		}), map[string]interface{}{"publish_batch.size": 2})
+
+		err := input.Run(v2.Context{}, connector)
+		require.NoError(t, err)
+
+		// The last event is flushed when the input stops.
+		require.Equal(t, []int{2, 2, 1}, batches)
+	})
+
 	t.Run("capture panic and return error", func(t *testing.T) {
 		input := createConfiguredInput(t, constInputManager(&fakeStatelessInput{
 			OnRun: func(_ v2.Context, _ stateless.Publisher) error {
@@ -161,6 +194,13 @@ func TestStateless_Run(t *testing.T) {
 	})
 }
 
+type batchClient struct {
+	pubtest.FakeClient
+	onPublishAll func([]beat.Event)
+}
+
+func (c *batchClient) PublishAll(events []beat.Event) { c.onPublishAll(events) }
+
 func (f *fakeStatelessInput) Name() string { return "test" }
 
 func (f *fakeStatelessInput) Test(ctx v2.TestContext) error {
@@ -75,6 +75,16 @@ type Input interface {
 	Run(Context, beat.PipelineConnector) error
 }
 
+// OnceInput is implemented by the inputs that can run once. Their Run method
+// returns on its own when Context.Once is set, after collecting the data that
+// was available when it started.
+type OnceInput interface {
+	Input
+
+	// SupportsOnce reports if the input can run once.
+	SupportsOnce() bool
+}
+
 // Context provides the Input Run function with common environmental
 // information and services.
 type Context struct {
@@ -90,6 +100,10 @@ type Context struct {
 
 	// Cancelation is used by Beats to signal the input to shutdown.
 	Cancelation Canceler
+
+	// Once asks the input to stop after collecting the data available when
+	// it started, it is only set for the inputs implementing OnceInput.
+	Once bool
 }
 
 // TestContext provides the Input Test function with common environmental
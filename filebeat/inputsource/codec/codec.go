@@ -0,0 +1,106 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package codec decodes the payload of the messages received by network
+// inputs into structured events, without going through a processor.
+package codec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+)
+
+// Decoder decodes the payload of a message. It must be safe for concurrent
+// use by the workers of an input.
+type Decoder interface {
+	// Decode returns the events found in data. When the payload cannot be
+	// fully decoded, the events built from what was decoded are returned
+	// with the error, and keep the raw payload in the message field.
+	// The timestamp of the events is zero when it is not part of the
+	// payload.
+	Decode(data []byte) ([]beat.Event, error)
+}
+
+// Factory creates a decoder from the codec settings of an input.
+type Factory func(Config) (Decoder, error)
+
+// Config selects the codec of an input.
+type Config struct {
+	// Codec is the name of the codec decoding the payloads. The payloads
+	// are not decoded when it is empty.
+	Codec string `config:"codec"`
+	// Timezone is used by the codecs parsing timestamps without a time
+	// zone or offset.
+	Timezone *cfgtype.Timezone `config:"timezone"`
+}
+
+// DefaultConfig returns the default codec settings, not decoding the
+// payloads.
+func DefaultConfig() Config {
+	return Config{
+		Timezone: cfgtype.MustNewTimezone("Local"),
+	}
+}
+
+// Validate checks that the codec is registered.
+func (c *Config) Validate() error {
+	if c.Codec == "" {
+		return nil
+	}
+	if _, found := codecs[c.Codec]; !found {
+		return fmt.Errorf("unknown codec %q, must be one of %s", c.Codec, strings.Join(Names(), ", "))
+	}
+	return nil
+}
+
+var codecs = map[string]Factory{}
+
+// Register makes a codec available to the inputs under name. It panics if a
+// codec is already registered under the same name.
+func Register(name string, factory Factory) {
+	if _, exists := codecs[name]; exists {
+		panic(fmt.Sprintf("input codec '%v' already registered", name))
+	}
+	codecs[name] = factory
+}
+
+// Names returns the sorted names of the registered codecs.
+func Names() []string {
+	names := make([]string, 0, len(codecs))
+	for name := range codecs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New returns the decoder of the configured codec, or nil if no codec is
+// configured.
+func New(config Config) (Decoder, error) {
+	if config.Codec == "" {
+		return nil, nil
+	}
+	factory, found := codecs[config.Codec]
+	if !found {
+		return nil, fmt.Errorf("input codec '%v' is not available", config.Codec)
+	}
+	return factory(config)
+}
@@ -0,0 +1,129 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package codec
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestNew(t *testing.T) {
+	d, err := New(DefaultConfig())
+	require.NoError(t, err)
+	assert.Nil(t, d)
+
+	config := DefaultConfig()
+	config.Codec = "unknown"
+	assert.Error(t, config.Validate())
+	_, err = New(config)
+	assert.Error(t, err)
+}
+
+func TestJSON(t *testing.T) {
+	d, err := New(Config{Codec: "json"})
+	require.NoError(t, err)
+
+	events, err := d.Decode([]byte(`{"@timestamp":"2023-01-02T03:04:05Z","a":1,"b":{"c":"d"}}` + "\n\n" + `{"a":2.5}` + "\n"))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC), events[0].Timestamp.UTC())
+	assert.Equal(t, mapstr.M{"a": int64(1), "b": mapstr.M{"c": "d"}}, events[0].Fields)
+	assert.True(t, events[1].Timestamp.IsZero())
+	assert.Equal(t, mapstr.M{"a": 2.5}, events[1].Fields)
+
+	events, err = d.Decode([]byte(`{"a":1}` + "\n" + `not json`))
+	assert.Error(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, mapstr.M{"message": "not json"}, events[1].Fields)
+}
+
+func TestSyslog(t *testing.T) {
+	tests := map[string]struct {
+		codec  string
+		data   string
+		fields mapstr.M
+		err    bool
+	}{
+		"rfc3164": {
+			codec: "syslog-rfc3164",
+			data:  "<13>Oct 11 22:14:15 test-host su[1024]: this is the message",
+			fields: mapstr.M{
+				"log": mapstr.M{
+					"syslog": mapstr.M{
+						"priority": 13,
+						"facility": mapstr.M{"code": 1, "name": "user-level"},
+						"severity": mapstr.M{"code": 5, "name": "Notice"},
+						"hostname": "test-host",
+						"appname":  "su",
+						"procid":   "1024",
+					},
+				},
+				"message": "this is the message",
+			},
+		},
+		"rfc5424": {
+			codec: "syslog-rfc5424",
+			data:  "<165>1 2003-10-11T22:14:15.003Z test-host su 1024 ID47 - this is the message",
+			fields: mapstr.M{
+				"log": mapstr.M{
+					"syslog": mapstr.M{
+						"priority": 165,
+						"facility": mapstr.M{"code": 20, "name": "local4"},
+						"severity": mapstr.M{"code": 5, "name": "Notice"},
+						"hostname": "test-host",
+						"appname":  "su",
+						"procid":   "1024",
+						"msgid":    "ID47",
+						"version":  "1",
+					},
+				},
+				"message": "this is the message",
+			},
+		},
+		"invalid": {
+			codec: "syslog-rfc5424",
+			data:  "not syslog",
+			err:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.Codec = test.codec
+			d, err := New(config)
+			require.NoError(t, err)
+
+			events, err := d.Decode([]byte(test.data))
+			require.Len(t, events, 1)
+			if test.err {
+				assert.Error(t, err)
+				assert.Equal(t, test.data, events[0].Fields["message"])
+				return
+			}
+			require.NoError(t, err)
+			assert.False(t, events[0].Timestamp.IsZero())
+			assert.Equal(t, test.fields, events[0].Fields)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/jsontransform"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func init() {
+	Register("json", newJSON)
+}
+
+// jsonDecoder decodes newline delimited JSON objects, each of them becoming
+// an event. Their keys are written at the root of the events.
+type jsonDecoder struct{}
+
+func newJSON(Config) (Decoder, error) {
+	return jsonDecoder{}, nil
+}
+
+func (jsonDecoder) Decode(data []byte) ([]beat.Event, error) {
+	var events []beat.Event
+	for len(data) > 0 {
+		var line []byte
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			line, data = data[:i], data[i+1:]
+		} else {
+			line, data = data, nil
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		var fields mapstr.M
+		dec := json.NewDecoder(bytes.NewReader(line))
+		dec.UseNumber()
+		if err := dec.Decode(&fields); err != nil {
+			events = append(events, rawEvent(line))
+			return events, fmt.Errorf("failed to decode JSON object: %w", err)
+		}
+		jsontransform.TransformNumbers(fields)
+
+		evt := beat.Event{Fields: mapstr.M{}}
+		jsontransform.WriteJSONKeys(&evt, fields, false, true, true)
+		events = append(events, evt)
+	}
+	return events, nil
+}
+
+// rawEvent returns an event keeping the payload that could not be decoded.
+func rawEvent(data []byte) beat.Event {
+	return beat.Event{
+		Fields: mapstr.M{
+			"message": string(data),
+		},
+	}
+}
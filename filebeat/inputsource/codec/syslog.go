@@ -0,0 +1,56 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package codec
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/reader/syslog"
+)
+
+func init() {
+	Register("syslog", newSyslog(syslog.FormatAuto))
+	Register("syslog-rfc3164", newSyslog(syslog.FormatRFC3164))
+	Register("syslog-rfc5424", newSyslog(syslog.FormatRFC5424))
+}
+
+// syslogDecoder decodes a syslog message into the ECS log.syslog fields.
+type syslogDecoder struct {
+	format syslog.Format
+	loc    *time.Location
+}
+
+func newSyslog(format syslog.Format) Factory {
+	return func(config Config) (Decoder, error) {
+		loc := time.Local
+		if config.Timezone != nil {
+			loc = config.Timezone.Location()
+		}
+		return &syslogDecoder{format: format, loc: loc}, nil
+	}
+}
+
+func (d *syslogDecoder) Decode(data []byte) ([]beat.Event, error) {
+	fields, ts, err := syslog.ParseMessage(string(data), d.format, d.loc)
+	if err != nil {
+		// Keep the raw message, the parsed fields are partial.
+		fields["message"] = string(data)
+	}
+	return []beat.Event{{Timestamp: ts, Fields: fields}}, err
+}
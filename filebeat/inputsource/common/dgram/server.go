@@ -20,6 +20,7 @@ package dgram
 import (
 	"context"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/elastic/go-concert/ctxtool"
@@ -40,6 +41,13 @@ type ListenerFactory func() (net.PacketConn, error)
 type ListenerConfig struct {
 	Timeout        time.Duration
 	MaxMessageSize cfgtype.ByteSize
+	// Workers is the number of goroutines reading and handling datagrams
+	// concurrently, each with its own read buffer. Values below 2 use a
+	// single goroutine.
+	Workers int
+	// SocketPerWorker makes each worker read from its own socket created by
+	// the ListenerFactory, instead of all of them sharing the same socket.
+	SocketPerWorker bool
 }
 
 type Listener struct {
@@ -78,42 +86,90 @@ func (l *Listener) Run(ctx context.Context) error {
 }
 
 func (l *Listener) doRun(ctx context.Context) {
-	conn, err := l.listener()
+	conns, err := l.listen()
 	if err != nil {
 		l.log.Debugw("Cannot connect", "error", err)
 		return
 	}
 
 	connCtx, connCancel := ctxtool.WithFunc(ctx, func() {
-		conn.Close()
+		closeAll(conns)
 	})
 	defer connCancel()
 
-	err = l.connectAndRun(connCtx, conn)
-	if err != nil {
-		l.log.Debugw("Error while processing input", "error", err)
+	// Stop all workers and reopen the sockets as soon as one of them fails.
+	var wg sync.WaitGroup
+	for i := 0; i < l.workers(); i++ {
+		conn := conns[i%len(conns)]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer connCancel()
+
+			err := l.connectAndRun(connCtx, conn)
+			if err != nil {
+				l.log.Debugw("Error while processing input", "error", err)
+			}
+		}()
 	}
+	wg.Wait()
 }
 
 func (l *Listener) Start() error {
 	l.log.Info("Started listening for " + l.family.String() + " connection")
 
-	conn, err := l.listener()
+	conns, err := l.listen()
 	if err != nil {
 		return err
 	}
 
-	l.tg.Go(func(ctx context.Context) error {
-		connCtx, connCancel := ctxtool.WithFunc(ctxtool.FromCanceller(ctx), func() {
-			conn.Close()
-		})
-		defer connCancel()
+	for i := 0; i < l.workers(); i++ {
+		conn := conns[i%len(conns)]
+		l.tg.Go(func(ctx context.Context) error {
+			connCtx, connCancel := ctxtool.WithFunc(ctxtool.FromCanceller(ctx), func() {
+				conn.Close()
+			})
+			defer connCancel()
 
-		return l.connectAndRun(ctxtool.FromCanceller(connCtx), conn)
-	})
+			return l.connectAndRun(ctxtool.FromCanceller(connCtx), conn)
+		})
+	}
 	return nil
 }
 
+func (l *Listener) workers() int {
+	if l.config.Workers < 2 {
+		return 1
+	}
+	return l.config.Workers
+}
+
+// listen creates the socket shared by the workers, or a socket per worker if
+// SocketPerWorker is set.
+func (l *Listener) listen() ([]net.PacketConn, error) {
+	n := 1
+	if l.config.SocketPerWorker {
+		n = l.workers()
+	}
+
+	conns := make([]net.PacketConn, 0, n)
+	for i := 0; i < n; i++ {
+		conn, err := l.listener()
+		if err != nil {
+			closeAll(conns)
+			return nil, err
+		}
+		conns = append(conns, conn)
+	}
+	return conns, nil
+}
+
+func closeAll(conns []net.PacketConn) {
+	for _, conn := range conns {
+		conn.Close()
+	}
+}
+
 func (l *Listener) connectAndRun(ctx context.Context, conn net.PacketConn) error {
 	defer l.log.Recover("Panic handling datagram")
 
@@ -0,0 +1,119 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package sockopt applies the socket options configured on the network
+// inputs to their listening sockets, before the sockets are bound.
+package sockopt
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+)
+
+// Config is the socket options of a listening socket.
+type Config struct {
+	// ReusePort sets SO_REUSEPORT, so that several processes can bind the
+	// same address, the kernel balancing the traffic between them.
+	ReusePort bool `config:"so_reuseport"`
+	// ReceiveBuffer sets SO_RCVBUF. On TCP, it is inherited by the accepted
+	// connections.
+	ReceiveBuffer cfgtype.ByteSize `config:"so_rcvbuf" validate:"min=0"`
+	// FreeBind sets IP_FREEBIND, so that the socket can be bound to an
+	// address that is not assigned to the host yet.
+	FreeBind bool `config:"ip_freebind"`
+	// TOS sets IP_TOS, or IPV6_TCLASS on IPv6 sockets, on the traffic sent
+	// by the socket.
+	TOS *int `config:"tos" validate:"min=0,max=255"`
+}
+
+// Validate checks that the configured options are supported on this
+// platform.
+func (c *Config) Validate() error {
+	var unsupported []string
+	if c.ReusePort && !ReusePortSupported {
+		unsupported = append(unsupported, "so_reuseport")
+	}
+	if c.ReceiveBuffer > 0 && !receiveBufferSupported {
+		unsupported = append(unsupported, "so_rcvbuf")
+	}
+	if c.FreeBind && !freeBindSupported {
+		unsupported = append(unsupported, "ip_freebind")
+	}
+	if c.TOS != nil && !tosSupported {
+		unsupported = append(unsupported, "tos")
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf("socket options not supported on this platform: %s", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
+// IsSet returns true if any socket option is configured.
+func (c *Config) IsSet() bool {
+	return c.ReusePort || c.ReceiveBuffer > 0 || c.FreeBind || c.TOS != nil
+}
+
+// Control sets the configured options on the socket, it is meant to be used
+// as the Control function of a net.ListenConfig.
+func (c Config) Control(network, _ string, conn syscall.RawConn) error {
+	if !c.IsSet() {
+		return nil
+	}
+
+	var sockErr error
+	err := conn.Control(func(fd uintptr) {
+		sockErr = c.apply(fd, strings.HasSuffix(network, "6"))
+	})
+	if err != nil {
+		return err
+	}
+	return sockErr
+}
+
+// ListenConfig returns a net.ListenConfig that sets the configured options on
+// the sockets it creates.
+func (c Config) ListenConfig() net.ListenConfig {
+	return net.ListenConfig{Control: c.Control}
+}
+
+func (c *Config) apply(fd uintptr, ipv6 bool) error {
+	if c.ReusePort {
+		if err := setReusePort(fd); err != nil {
+			return fmt.Errorf("failed to set so_reuseport: %w", err)
+		}
+	}
+	if c.ReceiveBuffer > 0 {
+		if err := setReceiveBuffer(fd, int(c.ReceiveBuffer)); err != nil {
+			return fmt.Errorf("failed to set so_rcvbuf: %w", err)
+		}
+	}
+	if c.FreeBind {
+		if err := setFreeBind(fd); err != nil {
+			return fmt.Errorf("failed to set ip_freebind: %w", err)
+		}
+	}
+	if c.TOS != nil {
+		if err := setTOS(fd, ipv6, *c.TOS); err != nil {
+			return fmt.Errorf("failed to set tos: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sockopt
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+func TestControl(t *testing.T) {
+	tos := 0x10
+	cfg := Config{
+		ReusePort:     true,
+		ReceiveBuffer: 64 * 1024,
+		FreeBind:      true,
+		TOS:           &tos,
+	}
+	require.NoError(t, cfg.Validate())
+
+	lc := cfg.ListenConfig()
+	conn, err := lc.ListenPacket(context.Background(), "udp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	getsockopt := func(level, opt int) int {
+		raw, err := conn.(*net.UDPConn).SyscallConn()
+		require.NoError(t, err)
+		var value int
+		var sockErr error
+		require.NoError(t, raw.Control(func(fd uintptr) {
+			value, sockErr = unix.GetsockoptInt(int(fd), level, opt)
+		}))
+		require.NoError(t, sockErr)
+		return value
+	}
+
+	assert.Equal(t, 1, getsockopt(unix.SOL_SOCKET, unix.SO_REUSEPORT))
+	assert.Equal(t, 1, getsockopt(unix.IPPROTO_IP, unix.IP_FREEBIND))
+	assert.Equal(t, tos, getsockopt(unix.IPPROTO_IP, unix.IP_TOS))
+	// Linux doubles the requested size to account for its bookkeeping.
+	assert.GreaterOrEqual(t, getsockopt(unix.SOL_SOCKET, unix.SO_RCVBUF), 64*1024)
+
+	t.Run("shared port", func(t *testing.T) {
+		other, err := lc.ListenPacket(context.Background(), "udp4", conn.LocalAddr().String())
+		require.NoError(t, err)
+		other.Close()
+	})
+}
+
+func TestControlNoOptions(t *testing.T) {
+	var cfg Config
+	assert.False(t, cfg.IsSet())
+	assert.NoError(t, cfg.Control("udp4", "", nil), "no option must not touch the socket")
+}
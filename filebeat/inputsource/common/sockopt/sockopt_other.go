@@ -0,0 +1,39 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !(linux || darwin || freebsd || netbsd || openbsd)
+// +build !linux,!darwin,!freebsd,!netbsd,!openbsd
+
+package sockopt
+
+import "errors"
+
+// ReusePortSupported is false on the platforms without SO_REUSEPORT.
+const ReusePortSupported = false
+
+const (
+	receiveBufferSupported = false
+	tosSupported           = false
+)
+
+var errUnsupported = errors.New("not supported on this platform")
+
+func setReusePort(_ uintptr) error { return errUnsupported }
+
+func setReceiveBuffer(_ uintptr, _ int) error { return errUnsupported }
+
+func setTOS(_ uintptr, _ bool, _ int) error { return errUnsupported }
@@ -20,6 +20,7 @@ package streaming
 import (
 	"bufio"
 	"context"
+	"io"
 	"net"
 
 	"github.com/pkg/errors"
@@ -39,6 +40,12 @@ type MetadataFunc func(net.Conn) inputsource.NetworkMetadata
 
 // SplitHandlerFactory allows creation of a handler that has splitting capabilities.
 func SplitHandlerFactory(family inputsource.Family, logger *logp.Logger, metadataCallback MetadataFunc, callback inputsource.NetworkFunc, splitFunc bufio.SplitFunc) HandlerFactory {
+	return SplitFactoryHandlerFactory(family, logger, metadataCallback, callback, func() bufio.SplitFunc { return splitFunc })
+}
+
+// SplitFactoryHandlerFactory allows creation of a handler that splits every
+// connection with its own split function.
+func SplitFactoryHandlerFactory(family inputsource.Family, logger *logp.Logger, metadataCallback MetadataFunc, callback inputsource.NetworkFunc, newSplitFunc SplitFuncFactory) HandlerFactory {
 	return func(config ListenerConfig) ConnectionHandler {
 		return ConnectionHandler(func(ctx context.Context, conn net.Conn) error {
 			metadata := metadataCallback(conn)
@@ -55,7 +62,7 @@ func SplitHandlerFactory(family inputsource.Family, logger *logp.Logger, metadat
 			r := NewResetableLimitedReader(NewDeadlineReader(conn, config.Timeout), maxMessageSize)
 			buf := bufio.NewReader(r)
 			scanner := bufio.NewScanner(buf)
-			scanner.Split(splitFunc)
+			scanner.Split(newSplitFunc())
 			// 16 is ratio of MaxScanTokenSize/startBufSize
 			buffer := make([]byte, maxMessageSize/16)
 			scanner.Buffer(buffer, int(maxMessageSize))
@@ -89,3 +96,40 @@ func SplitHandlerFactory(family inputsource.Family, logger *logp.Logger, metadat
 		})
 	}
 }
+
+// PacketHandlerFactory allows creation of a handler reading one message per
+// packet from connections preserving the message boundaries, such as Unix
+// SEQPACKET sockets. The messages larger than the max message size are
+// truncated.
+func PacketHandlerFactory(family inputsource.Family, logger *logp.Logger, metadataCallback MetadataFunc, callback inputsource.NetworkFunc) HandlerFactory {
+	return func(config ListenerConfig) ConnectionHandler {
+		return ConnectionHandler(func(ctx context.Context, conn net.Conn) error {
+			metadata := metadataCallback(conn)
+			maxMessageSize := int(config.MaxMessageSize)
+			log := logger.With("handler", "packet_client")
+
+			r := NewDeadlineReader(conn, config.Timeout)
+			// One more byte than the max message size to detect the truncated messages.
+			buffer := make([]byte, maxMessageSize+1)
+			for ctx.Err() == nil {
+				n, err := r.Read(buffer)
+				if n > 0 {
+					mt := metadata
+					if n > maxMessageSize {
+						log.Warnw("message truncated to max_message_size", "max_message_size", maxMessageSize)
+						n = maxMessageSize
+						mt.Truncated = true
+					}
+					callback(buffer[:n], mt)
+				}
+				if err != nil {
+					if err == io.EOF {
+						return nil
+					}
+					return errors.Wrap(err, string(family)+" packet_client error")
+				}
+			}
+			return nil
+		})
+	}
+}
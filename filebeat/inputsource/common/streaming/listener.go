@@ -56,12 +56,14 @@ type FramingType int
 const (
 	FramingDelimiter = iota
 	FramingRFC6587
+	FramingAuto
 )
 
 var (
 	framingTypes = map[string]FramingType{
 		"delimiter": FramingDelimiter,
 		"rfc6587":   FramingRFC6587,
+		"auto":      FramingAuto,
 	}
 
 	availableFramingTypesErrFormat string
@@ -231,7 +233,33 @@ func SplitFunc(framing FramingType, lineDelimiter []byte) (bufio.SplitFunc, erro
 		return FactoryDelimiter(lineDelimiter), nil
 	case FramingRFC6587:
 		return FactoryRFC6587Framing(lineDelimiter), nil
+	case FramingAuto:
+		return nil, fmt.Errorf("auto framing is detected per connection and needs a SplitFuncFactory")
 	default:
 		return nil, fmt.Errorf("unknown SplitFunc for framing %d and line delimiter %q", framing, lineDelimiter)
 	}
 }
+
+// SplitFuncFactory returns the `bufio.SplitFunc` to use for a new connection.
+type SplitFuncFactory func() bufio.SplitFunc
+
+// NewSplitFuncFactory allows to create a SplitFuncFactory based on a framing
+// and delimiter provided. With the auto framing every connection gets its own
+// split function detecting the framing from its first frame, and detected is
+// called with the framing found, if not nil.
+func NewSplitFuncFactory(framing FramingType, lineDelimiter []byte, detected func(FramingType)) (SplitFuncFactory, error) {
+	if framing == FramingAuto {
+		if len(lineDelimiter) == 0 {
+			return nil, fmt.Errorf("line delimiter required")
+		}
+		return func() bufio.SplitFunc {
+			return FactoryAutoFraming(lineDelimiter, detected)
+		}, nil
+	}
+
+	splitFunc, err := SplitFunc(framing, lineDelimiter)
+	if err != nil {
+		return nil, err
+	}
+	return func() bufio.SplitFunc { return splitFunc }, nil
+}
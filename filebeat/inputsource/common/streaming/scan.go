@@ -87,3 +87,56 @@ func FactoryRFC6587Framing(delimiter []byte) bufio.SplitFunc {
 		return 0, nil, nil
 	}
 }
+
+// maxOctetCountDigits is the number of digits after which a stream starting
+// with digits is not considered as octet-counted anymore.
+const maxOctetCountDigits = 10
+
+// FactoryAutoFraming returns a function that detects from the first frame of a
+// stream whether it uses octet counting or non-transparent framing as defined
+// in RFC6587, and then splits the whole stream accordingly. A stream is
+// octet-counted when it starts with a length followed by a space, otherwise
+// it is split on the delimiter. The returned function keeps the framing
+// detected, so a new one must be used for every stream.
+func FactoryAutoFraming(delimiter []byte, detected func(FramingType)) bufio.SplitFunc {
+	var split bufio.SplitFunc
+	return func(data []byte, eof bool) (int, []byte, error) {
+		if split == nil {
+			if eof && len(data) == 0 {
+				return 0, nil, nil
+			}
+			framing, ok := detectFraming(data, eof)
+			if !ok {
+				// request more data
+				return 0, nil, nil
+			}
+			if framing == FramingRFC6587 {
+				split = FactoryRFC6587Framing(delimiter)
+			} else {
+				split, _ = SplitFunc(FramingDelimiter, delimiter)
+			}
+			if detected != nil {
+				detected(framing)
+			}
+		}
+		return split(data, eof)
+	}
+}
+
+// detectFraming returns the framing of a stream starting with data, it
+// returns false when more data is needed to decide.
+func detectFraming(data []byte, eof bool) (FramingType, bool) {
+	for i, c := range data {
+		if c >= '0' && c <= '9' && i < maxOctetCountDigits {
+			continue
+		}
+		if i > 0 && c == ' ' {
+			return FramingRFC6587, true
+		}
+		return FramingDelimiter, true
+	}
+	if eof {
+		return FramingDelimiter, true
+	}
+	return FramingDelimiter, false
+}
@@ -178,3 +178,53 @@ func TestOctetCounting(t *testing.T) {
 		})
 	}
 }
+
+func TestAutoFraming(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+		framing  FramingType
+	}{
+		{
+			name:     "octet counting",
+			input:    "12 <9> message\n10 <6> msg\n 1",
+			expected: []string{"<9> message\n", "<6> msg\n 1"},
+			framing:  FramingRFC6587,
+		},
+		{
+			name:     "non-transparent",
+			input:    "<9> message 0\n<6> 12 msg\n",
+			expected: []string{"<9> message 0", "<6> 12 msg"},
+			framing:  FramingDelimiter,
+		},
+		{
+			name:     "leading digits without space",
+			input:    "1234message\n12 <6> msg",
+			expected: []string{"1234message", "12 <6> msg"},
+			framing:  FramingDelimiter,
+		},
+		{
+			name:     "only digits",
+			input:    "1234",
+			expected: []string{"1234"},
+			framing:  FramingDelimiter,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var detected []FramingType
+			buf := strings.NewReader(test.input)
+			scanner := bufio.NewScanner(buf)
+			scanner.Split(FactoryAutoFraming([]byte("\n"), func(f FramingType) {
+				detected = append(detected, f)
+			}))
+			var elements []string
+			for scanner.Scan() {
+				elements = append(elements, scanner.Text())
+			}
+			assert.EqualValues(t, test.expected, elements)
+			assert.Equal(t, []FramingType{test.framing}, detected)
+		})
+	}
+}
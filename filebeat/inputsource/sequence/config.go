@@ -0,0 +1,102 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sequence
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// Formats of the sequence numbers known by the tracker.
+const (
+	// FormatCisco matches the sequence number Cisco devices prepend to their
+	// syslog messages when `service sequence-numbers` is enabled.
+	FormatCisco = "cisco"
+	// FormatRFC5424 matches the sequenceId parameter of the meta structured
+	// data element defined by RFC 5424, also used by RFC 5848 signed syslog.
+	FormatRFC5424 = "rfc5424"
+)
+
+var formats = map[string]string{
+	FormatCisco:   `^(?:<\d{1,3}>)?(\d+):`,
+	FormatRFC5424: `\[meta(?:\s[^\]]*)?\ssequenceId="(\d+)"`,
+}
+
+// Config of the sequence number tracking.
+type Config struct {
+	Enabled bool `config:"enabled"`
+	// Format is one of the known formats of sequence numbers.
+	Format string `config:"format"`
+	// Pattern is a regular expression extracting the sequence number from a
+	// message in its first capturing group. Used when Format is not set.
+	Pattern string `config:"pattern"`
+	// MaxValue is the value after which the sequence numbers wrap around to
+	// 0. Wrapping is not expected when it is 0.
+	MaxValue uint64 `config:"max_value"`
+	// MaxSources is the maximum number of sources tracked. The least
+	// recently seen sources are forgotten when it is reached.
+	MaxSources int `config:"max_sources" validate:"min=1"`
+	// SourceTTL is the time after which the state of a source that sent no
+	// message is discarded.
+	SourceTTL time.Duration `config:"source_ttl" validate:"min=0"`
+}
+
+// DefaultConfig returns the default sequence number tracking settings.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:    false,
+		MaxSources: 10000,
+		SourceTTL:  time.Hour,
+	}
+}
+
+// Validate checks that the sequence numbers can be extracted.
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	_, err := c.regexp()
+	return err
+}
+
+func (c *Config) regexp() (*regexp.Regexp, error) {
+	pattern := c.Pattern
+	switch {
+	case c.Format != "" && c.Pattern != "":
+		return nil, errors.New("only one of format and pattern can be set")
+	case c.Format != "":
+		var found bool
+		pattern, found = formats[c.Format]
+		if !found {
+			return nil, fmt.Errorf("unknown sequence format %q, must be %s or %s", c.Format, FormatCisco, FormatRFC5424)
+		}
+	case c.Pattern == "":
+		return nil, errors.New("format or pattern is required to track sequence numbers")
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sequence pattern: %w", err)
+	}
+	if re.NumSubexp() < 1 {
+		return nil, errors.New("the sequence pattern must have a capturing group")
+	}
+	return re, nil
+}
@@ -0,0 +1,188 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package sequence detects the messages missed by network inputs from
+// devices that number the messages they send.
+package sequence
+
+import (
+	"container/list"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// Tracker keeps the last sequence number received from every source and
+// reports the gaps in the sequences. It is safe for concurrent use.
+type Tracker struct {
+	config Config
+	re     *regexp.Regexp
+
+	mu      sync.Mutex
+	sources map[string]*list.Element
+	lru     *list.List // of *source, most recently seen first
+}
+
+type source struct {
+	key  string
+	last uint64
+	seen time.Time
+}
+
+// Gap describes messages missed from a source.
+type Gap struct {
+	// Source is the address of the sender.
+	Source string
+	// Last is the last sequence number received before the gap.
+	Last uint64
+	// Received is the sequence number received after the gap.
+	Received uint64
+	// Missed is the number of messages missed.
+	Missed uint64
+}
+
+// NewTracker returns a Tracker, or nil if tracking is disabled.
+func NewTracker(config Config) (*Tracker, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+
+	re, err := config.regexp()
+	if err != nil {
+		return nil, err
+	}
+	return &Tracker{
+		config:  config,
+		re:      re,
+		sources: map[string]*list.Element{},
+		lru:     list.New(),
+	}, nil
+}
+
+// Observe records the sequence number of a message received from source at
+// the given time. It returns the gap since the previous message of the same
+// source, if any. Messages without sequence number are ignored.
+func (t *Tracker) Observe(key string, msg []byte, now time.Time) (Gap, bool) {
+	if t == nil {
+		return Gap{}, false
+	}
+	seq, ok := t.parse(msg)
+	if !ok {
+		return Gap{}, false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	elem, found := t.sources[key]
+	if !found {
+		t.add(key, seq, now)
+		return Gap{}, false
+	}
+
+	t.lru.MoveToFront(elem)
+	s := elem.Value.(*source) //nolint:errcheck // only *source is stored
+	last, expired := s.last, t.config.SourceTTL > 0 && now.Sub(s.seen) > t.config.SourceTTL
+	s.last, s.seen = seq, now
+	if expired {
+		return Gap{}, false
+	}
+
+	missed, ok := t.missed(last, seq)
+	if !ok || missed == 0 {
+		return Gap{}, false
+	}
+	return Gap{Source: key, Last: last, Received: seq, Missed: missed}, true
+}
+
+func (t *Tracker) parse(msg []byte) (uint64, bool) {
+	match := t.re.FindSubmatch(msg)
+	if match == nil {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(string(match[1]), 10, 64)
+	return seq, err == nil
+}
+
+// missed returns the number of messages between last and seq. It returns
+// false when seq does not follow last, as for duplicated or reordered
+// messages or when the sender restarted its sequence.
+func (t *Tracker) missed(last, seq uint64) (uint64, bool) {
+	switch maxValue := t.config.MaxValue; {
+	case seq > last:
+		return seq - last - 1, true
+	case maxValue > 0 && last <= maxValue && seq < last:
+		// Only consider the sequence wrapped around if less than half of
+		// it was missed, otherwise the sender most likely restarted.
+		missed := maxValue - last + seq
+		return missed, missed < maxValue/2
+	default:
+		return 0, false
+	}
+}
+
+func (t *Tracker) add(key string, seq uint64, now time.Time) {
+	if t.lru.Len() >= t.config.MaxSources {
+		oldest := t.lru.Back()
+		t.lru.Remove(oldest)
+		delete(t.sources, oldest.Value.(*source).key) //nolint:errcheck // only *source is stored
+	}
+	t.sources[key] = t.lru.PushFront(&source{key: key, last: seq, seen: now})
+}
+
+// Event returns the event reporting the gap.
+func (g Gap) Event(timestamp time.Time) beat.Event {
+	return beat.Event{
+		Timestamp: timestamp,
+		Fields: mapstr.M{
+			"message": fmt.Sprintf("missed %d messages from %s between sequence numbers %d and %d",
+				g.Missed, g.Source, g.Last, g.Received),
+			"tags": []string{"sequence_gap"},
+			"log": mapstr.M{
+				"source": mapstr.M{
+					"address": g.Source,
+					"sequence_gap": mapstr.M{
+						"last":     g.Last,
+						"received": g.Received,
+						"missed":   g.Missed,
+					},
+				},
+			},
+		},
+	}
+}
+
+// SourceKey returns the key identifying the sender of a message. The port is
+// ignored as it changes when the sender reconnects.
+func SourceKey(addr net.Addr) string {
+	switch addr := addr.(type) {
+	case nil:
+		return ""
+	case *net.UDPAddr:
+		return addr.IP.String()
+	case *net.TCPAddr:
+		return addr.IP.String()
+	default:
+		return addr.String()
+	}
+}
@@ -0,0 +1,213 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sequence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		config Config
+		err    string
+	}{
+		"disabled":        {config: Config{}},
+		"cisco":           {config: Config{Enabled: true, Format: FormatCisco}},
+		"rfc5424":         {config: Config{Enabled: true, Format: FormatRFC5424}},
+		"pattern":         {config: Config{Enabled: true, Pattern: `seq=(\d+)`}},
+		"missing":         {config: Config{Enabled: true}, err: "format or pattern is required"},
+		"both":            {config: Config{Enabled: true, Format: FormatCisco, Pattern: `(\d+)`}, err: "only one of format and pattern"},
+		"unknown format":  {config: Config{Enabled: true, Format: "juniper"}, err: `unknown sequence format "juniper"`},
+		"invalid pattern": {config: Config{Enabled: true, Pattern: `(\d+`}, err: "invalid sequence pattern"},
+		"no group":        {config: Config{Enabled: true, Pattern: `\d+`}, err: "must have a capturing group"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestFormats(t *testing.T) {
+	tests := []struct {
+		format string
+		msg    string
+		seq    uint64
+		found  bool
+	}{
+		{FormatCisco, "<189>000123: *Mar  1 18:46:11: %SYS-5-CONFIG_I: Configured from console", 123, true},
+		{FormatCisco, "4567: Mar  1 18:46:11: %LINK-3-UPDOWN: Interface Gi0/1, changed state to up", 4567, true},
+		{FormatCisco, "<189>Mar  1 18:46:11: %SYS-5-CONFIG_I: Configured from console", 0, false},
+		{FormatRFC5424, `<165>1 2003-10-11T22:14:15.003Z host app - ID47 [meta sequenceId="29"] message`, 29, true},
+		{FormatRFC5424, `<165>1 2003-10-11T22:14:15.003Z host app - ID47 [meta sysUpTime="37" sequenceId="30"] message`, 30, true},
+		{FormatRFC5424, `<165>1 2003-10-11T22:14:15.003Z host app - ID47 [origin sequenceId="29"] message`, 0, false},
+	}
+
+	for _, test := range tests {
+		tracker, err := NewTracker(Config{Enabled: true, Format: test.format, MaxSources: 1})
+		require.NoError(t, err)
+
+		seq, found := tracker.parse([]byte(test.msg))
+		assert.Equal(t, test.found, found, test.msg)
+		assert.Equal(t, test.seq, seq, test.msg)
+	}
+}
+
+func TestTrackerDisabled(t *testing.T) {
+	tracker, err := NewTracker(Config{Enabled: false})
+	require.NoError(t, err)
+	assert.Nil(t, tracker)
+
+	_, found := tracker.Observe("10.0.0.1", []byte("1: message"), time.Now())
+	assert.False(t, found)
+}
+
+func TestTrackerObserve(t *testing.T) {
+	type message struct {
+		source string
+		seq    string
+		gap    *Gap
+	}
+
+	tests := map[string]struct {
+		config   Config
+		messages []message
+	}{
+		"no gap": {
+			messages: []message{{"a", "1", nil}, {"a", "2", nil}, {"a", "3", nil}},
+		},
+		"gap": {
+			messages: []message{
+				{"a", "1", nil},
+				{"a", "5", &Gap{Source: "a", Last: 1, Received: 5, Missed: 3}},
+				{"a", "6", nil},
+			},
+		},
+		"sources are independent": {
+			messages: []message{
+				{"a", "1", nil},
+				{"b", "10", nil},
+				{"a", "2", nil},
+				{"b", "12", &Gap{Source: "b", Last: 10, Received: 12, Missed: 1}},
+			},
+		},
+		"duplicate and restart": {
+			messages: []message{{"a", "10", nil}, {"a", "10", nil}, {"a", "1", nil}, {"a", "2", nil}},
+		},
+		"no sequence number": {
+			messages: []message{{"a", "1", nil}, {"a", "", nil}, {"a", "2", nil}},
+		},
+		"wrap around": {
+			config: Config{MaxValue: 99},
+			messages: []message{
+				{"a", "98", nil},
+				{"a", "99", nil},
+				{"a", "0", nil},
+				{"a", "1", nil},
+			},
+		},
+		"gap across wrap around": {
+			config: Config{MaxValue: 99},
+			messages: []message{
+				{"a", "97", nil},
+				{"a", "2", &Gap{Source: "a", Last: 97, Received: 2, Missed: 4}},
+			},
+		},
+		"restart with wrap around": {
+			config:   Config{MaxValue: 99},
+			messages: []message{{"a", "50", nil}, {"a", "1", nil}, {"a", "2", nil}},
+		},
+		"evicted source": {
+			config: Config{MaxSources: 1},
+			messages: []message{
+				{"a", "1", nil},
+				{"b", "1", nil},
+				{"a", "5", nil},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.Enabled = true
+			config.Format = FormatCisco
+			config.MaxValue = test.config.MaxValue
+			if test.config.MaxSources > 0 {
+				config.MaxSources = test.config.MaxSources
+			}
+
+			tracker, err := NewTracker(config)
+			require.NoError(t, err)
+
+			now := time.Now()
+			for i, m := range test.messages {
+				msg := "message"
+				if m.seq != "" {
+					msg = m.seq + ": " + msg
+				}
+				gap, found := tracker.Observe(m.source, []byte(msg), now)
+				if m.gap == nil {
+					assert.False(t, found, "message %d", i)
+				} else {
+					assert.True(t, found, "message %d", i)
+					assert.Equal(t, *m.gap, gap, "message %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestTrackerSourceTTL(t *testing.T) {
+	config := DefaultConfig()
+	config.Enabled = true
+	config.Format = FormatCisco
+	config.SourceTTL = time.Minute
+
+	tracker, err := NewTracker(config)
+	require.NoError(t, err)
+
+	now := time.Now()
+	_, found := tracker.Observe("a", []byte("1: message"), now)
+	assert.False(t, found)
+	_, found = tracker.Observe("a", []byte("5: message"), now.Add(2*time.Minute))
+	assert.False(t, found, "expired state must be discarded")
+	_, found = tracker.Observe("a", []byte("7: message"), now.Add(2*time.Minute))
+	assert.True(t, found)
+}
+
+func TestGapEvent(t *testing.T) {
+	now := time.Now()
+	event := Gap{Source: "10.0.0.1", Last: 1, Received: 5, Missed: 3}.Event(now)
+
+	assert.Equal(t, now, event.Timestamp)
+	msg, _ := event.Fields.GetValue("message")
+	assert.Equal(t, "missed 3 messages from 10.0.0.1 between sequence numbers 1 and 5", msg)
+	missed, _ := event.Fields.GetValue("log.source.sequence_gap.missed")
+	assert.EqualValues(t, 3, missed)
+}
@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sourcefilter
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Config of the filtering of the senders.
+type Config struct {
+	// AllowedHosts are the IP addresses and CIDR ranges of the senders
+	// accepted by the input. All senders are accepted when it is empty.
+	AllowedHosts []string `config:"allowed_hosts"`
+	// RateLimit limits the rate of the messages accepted from each sender.
+	RateLimit RateLimitConfig `config:"rate_limit"`
+}
+
+// RateLimitConfig configures the rate limiting of each sender.
+type RateLimitConfig struct {
+	// Limit is the number of messages per second accepted from a sender.
+	// The rate is not limited when it is 0.
+	Limit float64 `config:"limit" validate:"min=0"`
+	// Burst is the number of messages a sender can send at once above the
+	// limit. It defaults to the limit.
+	Burst int `config:"burst" validate:"min=0"`
+	// MaxSources is the maximum number of senders limited at once. The
+	// least recently seen senders are forgotten when it is reached.
+	MaxSources int `config:"max_sources" validate:"min=1"`
+}
+
+// DefaultConfig returns the default filtering settings, accepting all the
+// senders without limit.
+func DefaultConfig() Config {
+	return Config{
+		RateLimit: RateLimitConfig{
+			MaxSources: 10000,
+		},
+	}
+}
+
+// Validate checks that the allowed hosts are valid addresses or ranges.
+func (c *Config) Validate() error {
+	_, err := c.networks()
+	return err
+}
+
+func (c *Config) networks() ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(c.AllowedHosts))
+	for _, host := range c.AllowedHosts {
+		if !strings.Contains(host, "/") {
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid allowed host %q, must be an IP address or a CIDR range", host)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, n, err := net.ParseCIDR(host)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed host %q: %w", host, err)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
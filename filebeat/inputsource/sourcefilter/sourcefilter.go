@@ -0,0 +1,168 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package sourcefilter drops the messages received by network inputs from
+// senders that are not allowed or that exceed their rate limit, before
+// events are built from them.
+package sourcefilter
+
+import (
+	"container/list"
+	"net"
+	"sync"
+	"time"
+)
+
+// Reason tells why a message was discarded.
+type Reason string
+
+const (
+	// ReasonDenied is the reason of the messages from senders that are not
+	// in the allowed hosts.
+	ReasonDenied Reason = "denied"
+	// ReasonRateLimited is the reason of the messages from senders that
+	// exceeded their rate limit.
+	ReasonRateLimited Reason = "rate_limited"
+)
+
+// Filter accepts or discards the messages of the senders. It is safe for
+// concurrent use.
+type Filter struct {
+	nets []*net.IPNet
+
+	limit      float64
+	burst      float64
+	maxSources int
+
+	mu      sync.Mutex
+	buckets map[string]*list.Element
+	lru     *list.List // of *bucket, most recently seen first
+}
+
+// bucket is the token bucket of a sender.
+type bucket struct {
+	key    string
+	tokens float64
+	last   time.Time
+}
+
+// New returns a Filter, or nil if all the senders are accepted without limit.
+func New(config Config) (*Filter, error) {
+	nets, err := config.networks()
+	if err != nil {
+		return nil, err
+	}
+	if len(nets) == 0 && config.RateLimit.Limit == 0 {
+		return nil, nil
+	}
+
+	burst := float64(config.RateLimit.Burst)
+	if burst == 0 {
+		burst = config.RateLimit.Limit
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &Filter{
+		nets:       nets,
+		limit:      config.RateLimit.Limit,
+		burst:      burst,
+		maxSources: config.RateLimit.MaxSources,
+		buckets:    map[string]*list.Element{},
+		lru:        list.New(),
+	}, nil
+}
+
+// Allow reports whether a message received from addr at the given time is
+// accepted, and the reason why it is discarded otherwise. When allowed hosts
+// are configured, the messages without a sender IP address are discarded.
+func (f *Filter) Allow(addr net.Addr, now time.Time) (bool, Reason) {
+	if f == nil {
+		return true, ""
+	}
+
+	ip := senderIP(addr)
+	if len(f.nets) > 0 && !f.allowed(ip) {
+		return false, ReasonDenied
+	}
+	if f.limit > 0 && ip != nil && !f.take(ip.String(), now) {
+		return false, ReasonRateLimited
+	}
+	return true, ""
+}
+
+func (f *Filter) allowed(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, n := range f.nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// take takes a token from the bucket of the sender, refilled at the rate
+// limit since it was last seen.
+func (f *Filter) take(key string, now time.Time) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var b *bucket
+	if elem, found := f.buckets[key]; found {
+		f.lru.MoveToFront(elem)
+		b = elem.Value.(*bucket) //nolint:errcheck // only *bucket is stored
+		if elapsed := now.Sub(b.last); elapsed > 0 {
+			b.tokens += elapsed.Seconds() * f.limit
+			if b.tokens > f.burst {
+				b.tokens = f.burst
+			}
+		}
+	} else {
+		b = &bucket{key: key, tokens: f.burst}
+		f.buckets[key] = f.lru.PushFront(b)
+		f.evict()
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// evict forgets the least recently seen senders above MaxSources.
+func (f *Filter) evict() {
+	for f.maxSources > 0 && f.lru.Len() > f.maxSources {
+		oldest := f.lru.Back()
+		f.lru.Remove(oldest)
+		delete(f.buckets, oldest.Value.(*bucket).key) //nolint:errcheck // only *bucket is stored
+	}
+}
+
+func senderIP(addr net.Addr) net.IP {
+	switch addr := addr.(type) {
+	case *net.UDPAddr:
+		return addr.IP
+	case *net.TCPAddr:
+		return addr.IP
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sourcefilter
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterDisabled(t *testing.T) {
+	f, err := New(DefaultConfig())
+	require.NoError(t, err)
+	assert.Nil(t, f)
+
+	allowed, _ := f.Allow(&net.UDPAddr{IP: net.ParseIP("192.0.2.1")}, time.Now())
+	assert.True(t, allowed)
+}
+
+func TestFilterAllowedHosts(t *testing.T) {
+	config := DefaultConfig()
+	config.AllowedHosts = []string{"192.0.2.0/24", "198.51.100.7", "2001:db8::/32"}
+	f, err := New(config)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		addr    net.Addr
+		allowed bool
+	}{
+		"in range":         {addr: &net.UDPAddr{IP: net.ParseIP("192.0.2.200"), Port: 514}, allowed: true},
+		"single address":   {addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.7"), Port: 514}, allowed: true},
+		"ipv6 range":       {addr: &net.UDPAddr{IP: net.ParseIP("2001:db8::1"), Port: 514}, allowed: true},
+		"out of range":     {addr: &net.UDPAddr{IP: net.ParseIP("198.51.100.8"), Port: 514}},
+		"unknown address":  {addr: nil},
+		"ipv4 mapped ipv6": {addr: &net.UDPAddr{IP: net.ParseIP("::ffff:192.0.2.1"), Port: 514}, allowed: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			allowed, reason := f.Allow(test.addr, time.Now())
+			assert.Equal(t, test.allowed, allowed)
+			if !test.allowed {
+				assert.Equal(t, ReasonDenied, reason)
+			}
+		})
+	}
+}
+
+func TestFilterInvalidAllowedHosts(t *testing.T) {
+	for _, host := range []string{"192.0.2.300", "192.0.2.0/33", "localhost"} {
+		config := Config{AllowedHosts: []string{host}}
+		assert.Error(t, config.Validate(), host)
+	}
+}
+
+func TestFilterRateLimit(t *testing.T) {
+	config := DefaultConfig()
+	config.RateLimit.Limit = 2
+	config.RateLimit.Burst = 3
+	f, err := New(config)
+	require.NoError(t, err)
+
+	flooding := &net.UDPAddr{IP: net.ParseIP("192.0.2.1")}
+	quiet := &net.UDPAddr{IP: net.ParseIP("192.0.2.2")}
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		allowed, _ := f.Allow(flooding, now)
+		assert.True(t, allowed, "burst message %d", i)
+	}
+	allowed, reason := f.Allow(flooding, now)
+	assert.False(t, allowed)
+	assert.Equal(t, ReasonRateLimited, reason)
+
+	allowed, _ = f.Allow(quiet, now)
+	assert.True(t, allowed, "senders are limited independently")
+
+	// One token is refilled every 500ms.
+	allowed, _ = f.Allow(flooding, now.Add(500*time.Millisecond))
+	assert.True(t, allowed)
+	allowed, _ = f.Allow(flooding, now.Add(500*time.Millisecond))
+	assert.False(t, allowed)
+}
+
+func TestFilterMaxSources(t *testing.T) {
+	config := DefaultConfig()
+	config.RateLimit.Limit = 1
+	config.RateLimit.MaxSources = 2
+	f, err := New(config)
+	require.NoError(t, err)
+
+	now := time.Now()
+	for _, ip := range []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"} {
+		allowed, _ := f.Allow(&net.UDPAddr{IP: net.ParseIP(ip)}, now)
+		assert.True(t, allowed)
+	}
+	assert.Len(t, f.buckets, 2)
+	assert.NotContains(t, f.buckets, "192.0.2.1")
+
+	// The forgotten sender gets a full bucket again.
+	allowed, _ := f.Allow(&net.UDPAddr{IP: net.ParseIP("192.0.2.1")}, now)
+	assert.True(t, allowed)
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/sockopt"
 	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
 	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
 )
@@ -35,6 +36,13 @@ type Config struct {
 	MaxMessageSize cfgtype.ByteSize        `config:"max_message_size" validate:"nonzero,positive"`
 	MaxConnections int                     `config:"max_connections"`
 	TLS            *tlscommon.ServerConfig `config:"ssl"`
+
+	// ProxyProtocol requires the clients to send a PROXY protocol header,
+	// the source address of the messages is then the address in the header.
+	ProxyProtocol bool `config:"proxy_protocol"`
+
+	// SocketOptions are set on the listening socket before it is bound.
+	SocketOptions sockopt.Config `config:",inline"`
 }
 
 // Validate validates the Config option for the tcp input.
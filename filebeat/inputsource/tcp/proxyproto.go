@@ -0,0 +1,250 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tcp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PROXY protocol headers sent by load balancers, like HAProxy or AWS NLB,
+// ahead of the data of the clients. See
+// https://www.haproxy.org/download/2.8/doc/proxy-protocol.txt.
+
+const (
+	// proxyV1Prefix starts the human readable headers.
+	proxyV1Prefix = "PROXY "
+	// proxyV1MaxLength is the maximum length of a v1 header, CRLF included.
+	proxyV1MaxLength = 107
+)
+
+// proxyV2Signature starts the binary headers.
+var proxyV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+var errNoProxyHeader = errors.New("missing PROXY protocol header")
+
+// proxyListener accepts the connections of a load balancer sending a PROXY
+// protocol header, the addresses of the connections are those of the header.
+type proxyListener struct {
+	net.Listener
+	timeout time.Duration
+}
+
+func (l *proxyListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &proxyConn{Conn: conn, r: bufio.NewReader(conn), timeout: l.timeout}, nil
+}
+
+// proxyConn is a connection starting with a PROXY protocol header. The header
+// is read on the first use of the connection, not when it is accepted, so
+// that the listener does not wait for it.
+type proxyConn struct {
+	net.Conn
+	r       *bufio.Reader
+	timeout time.Duration
+
+	once   sync.Once
+	remote net.Addr
+	local  net.Addr
+	err    error
+}
+
+func (c *proxyConn) readHeader() {
+	c.once.Do(func() {
+		if c.timeout > 0 {
+			_ = c.Conn.SetReadDeadline(time.Now().Add(c.timeout))
+			defer func() { _ = c.Conn.SetReadDeadline(time.Time{}) }()
+		}
+		c.remote, c.local, c.err = readProxyHeader(c.r)
+		if c.err != nil {
+			c.err = fmt.Errorf("failed to read PROXY protocol header from %s: %w", c.Conn.RemoteAddr(), c.err)
+		}
+	})
+}
+
+func (c *proxyConn) Read(b []byte) (int, error) {
+	c.readHeader()
+	if c.err != nil {
+		return 0, c.err
+	}
+	return c.r.Read(b)
+}
+
+// RemoteAddr returns the address of the client in the header, or the address
+// of the peer if the header has no address.
+func (c *proxyConn) RemoteAddr() net.Addr {
+	c.readHeader()
+	if c.remote != nil {
+		return c.remote
+	}
+	return c.Conn.RemoteAddr()
+}
+
+// LocalAddr returns the address the client connected to in the header, or the
+// local address if the header has no address.
+func (c *proxyConn) LocalAddr() net.Addr {
+	c.readHeader()
+	if c.local != nil {
+		return c.local
+	}
+	return c.Conn.LocalAddr()
+}
+
+// readProxyHeader reads a v1 or v2 PROXY protocol header and returns the
+// source and destination addresses. They are nil if the header has no
+// addresses, like for the health checks of the load balancer.
+func readProxyHeader(r *bufio.Reader) (src, dst net.Addr, err error) {
+	// The prefixes of both versions have the same length.
+	prefix, err := r.Peek(len(proxyV1Prefix))
+	if err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case string(prefix) == proxyV1Prefix:
+		return readProxyV1(r)
+	case bytes.HasPrefix(proxyV2Signature, prefix):
+		return readProxyV2(r)
+	default:
+		return nil, nil, errNoProxyHeader
+	}
+}
+
+// readProxyV1 reads a header like "PROXY TCP4 192.0.2.1 192.0.2.2 56324 443\r\n".
+func readProxyV1(r *bufio.Reader) (src, dst net.Addr, err error) {
+	var line []byte
+	for len(line) < proxyV1MaxLength {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, nil, err
+		}
+		line = append(line, b)
+		if b == '\n' {
+			break
+		}
+	}
+	if !bytes.HasSuffix(line, []byte("\r\n")) {
+		return nil, nil, errors.New("v1 header is not terminated by CRLF")
+	}
+	header := string(line[:len(line)-2])
+
+	fields := strings.Split(header, " ")
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil, nil
+	}
+	if len(fields) != 6 {
+		return nil, nil, fmt.Errorf("invalid v1 header %q", header)
+	}
+	var family int
+	switch fields[1] {
+	case "TCP4":
+		family = net.IPv4len
+	case "TCP6":
+		family = net.IPv6len
+	default:
+		return nil, nil, fmt.Errorf("unsupported v1 protocol %q", fields[1])
+	}
+	srcAddr, err := parseProxyV1Addr(fields[2], fields[4], family)
+	if err != nil {
+		return nil, nil, err
+	}
+	dstAddr, err := parseProxyV1Addr(fields[3], fields[5], family)
+	if err != nil {
+		return nil, nil, err
+	}
+	return srcAddr, dstAddr, nil
+}
+
+func parseProxyV1Addr(ip, port string, family int) (*net.TCPAddr, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil || (family == net.IPv4len) != (addr.To4() != nil) {
+		return nil, fmt.Errorf("invalid v1 address %q", ip)
+	}
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid v1 port %q", port)
+	}
+	return &net.TCPAddr{IP: addr, Port: int(p)}, nil
+}
+
+const (
+	proxyV2CmdLocal = 0x0
+	proxyV2CmdProxy = 0x1
+
+	proxyV2FamilyInet  = 0x1
+	proxyV2FamilyInet6 = 0x2
+)
+
+// readProxyV2 reads a binary header: the signature, the version and command,
+// the address family and protocol, the length of the addresses, and the
+// addresses followed by optional TLVs, which are ignored.
+func readProxyV2(r *bufio.Reader) (src, dst net.Addr, err error) {
+	header := make([]byte, len(proxyV2Signature)+4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(header[:len(proxyV2Signature)], proxyV2Signature) {
+		return nil, nil, errNoProxyHeader
+	}
+	verCmd, famProto := header[12], header[13]
+	if verCmd>>4 != 2 {
+		return nil, nil, fmt.Errorf("unsupported v2 version %d", verCmd>>4)
+	}
+	payload := make([]byte, binary.BigEndian.Uint16(header[14:]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, nil, err
+	}
+
+	switch verCmd & 0xf {
+	case proxyV2CmdLocal:
+		return nil, nil, nil
+	case proxyV2CmdProxy:
+	default:
+		return nil, nil, fmt.Errorf("unsupported v2 command %d", verCmd&0xf)
+	}
+
+	var ipLen int
+	switch famProto >> 4 {
+	case proxyV2FamilyInet:
+		ipLen = net.IPv4len
+	case proxyV2FamilyInet6:
+		ipLen = net.IPv6len
+	default:
+		// Unspecified and unix addresses are not reported.
+		return nil, nil, nil
+	}
+	if len(payload) < 2*ipLen+4 {
+		return nil, nil, fmt.Errorf("v2 addresses are truncated, %d bytes", len(payload))
+	}
+	srcIP := net.IP(payload[:ipLen])
+	dstIP := net.IP(payload[ipLen : 2*ipLen])
+	srcPort := binary.BigEndian.Uint16(payload[2*ipLen:])
+	dstPort := binary.BigEndian.Uint16(payload[2*ipLen+2:])
+	return &net.TCPAddr{IP: srcIP, Port: int(srcPort)}, &net.TCPAddr{IP: dstIP, Port: int(dstPort)}, nil
+}
@@ -0,0 +1,145 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tcp
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/streaming"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+func proxyV2Header(cmd, family byte, addrs []byte) []byte {
+	header := append([]byte{}, proxyV2Signature...)
+	header = append(header, 0x20|cmd, family<<4|0x1, 0, 0)
+	binary.BigEndian.PutUint16(header[14:], uint16(len(addrs)))
+	return append(header, addrs...)
+}
+
+func TestReadProxyHeader(t *testing.T) {
+	ipv4 := []byte{192, 0, 2, 1, 192, 0, 2, 2, 0xdc, 0x04, 0x01, 0xbb}
+	ipv6 := append(append(net.ParseIP("2001:db8::1").To16(), net.ParseIP("2001:db8::2").To16()...), 0xdc, 0x04, 0x01, 0xbb)
+
+	cases := map[string]struct {
+		header  string
+		src     string
+		dst     string
+		wantErr bool
+	}{
+		"v1 tcp4": {
+			header: "PROXY TCP4 192.0.2.1 192.0.2.2 56324 443\r\n",
+			src:    "192.0.2.1:56324",
+			dst:    "192.0.2.2:443",
+		},
+		"v1 tcp6": {
+			header: "PROXY TCP6 2001:db8::1 2001:db8::2 56324 443\r\n",
+			src:    "[2001:db8::1]:56324",
+			dst:    "[2001:db8::2]:443",
+		},
+		"v1 unknown": {
+			header: "PROXY UNKNOWN\r\n",
+		},
+		"v1 family mismatch": {
+			header:  "PROXY TCP4 2001:db8::1 192.0.2.2 56324 443\r\n",
+			wantErr: true,
+		},
+		"v1 without CRLF": {
+			header:  "PROXY TCP4 192.0.2.1 192.0.2.2 56324 443" + strings.Repeat(" ", 100),
+			wantErr: true,
+		},
+		"v2 ipv4": {
+			header: string(proxyV2Header(proxyV2CmdProxy, proxyV2FamilyInet, ipv4)),
+			src:    "192.0.2.1:56324",
+			dst:    "192.0.2.2:443",
+		},
+		"v2 ipv6 with TLV": {
+			header: string(proxyV2Header(proxyV2CmdProxy, proxyV2FamilyInet6, append(ipv6, 0x04, 0x00, 0x01, 0x00))),
+			src:    "[2001:db8::1]:56324",
+			dst:    "[2001:db8::2]:443",
+		},
+		"v2 local": {
+			header: string(proxyV2Header(proxyV2CmdLocal, 0, nil)),
+		},
+		"v2 truncated addresses": {
+			header:  string(proxyV2Header(proxyV2CmdProxy, proxyV2FamilyInet, ipv4[:8])),
+			wantErr: true,
+		},
+		"no header": {
+			header:  "<13>Jan  1 00:00:00 host message\n",
+			wantErr: true,
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := bufio.NewReader(strings.NewReader(test.header + "payload\n"))
+			src, dst, err := readProxyHeader(r)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if test.src == "" {
+				assert.Nil(t, src)
+				assert.Nil(t, dst)
+			} else {
+				assert.Equal(t, test.src, src.String())
+				assert.Equal(t, test.dst, dst.String())
+			}
+			rest, err := r.ReadString('\n')
+			require.NoError(t, err)
+			assert.Equal(t, "payload\n", rest, "the header must be consumed")
+		})
+	}
+}
+
+func TestProxyProtocolServer(t *testing.T) {
+	ch := make(chan *info, 1)
+	to := func(message []byte, mt inputsource.NetworkMetadata) {
+		ch <- &info{message: string(message), mt: mt}
+	}
+	cfg, err := conf.NewConfigFrom(map[string]interface{}{"host": "127.0.0.1:0", "proxy_protocol": true})
+	require.NoError(t, err)
+	config := defaultConfig
+	require.NoError(t, cfg.Unpack(&config))
+
+	factory := streaming.SplitHandlerFactory(inputsource.FamilyTCP, logp.NewLogger("test"), MetadataCallback, to, bufio.ScanLines)
+	server, err := New(&config, factory)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer server.Stop()
+
+	conn, err := net.Dial("tcp", server.Listener.Listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	fmt.Fprint(conn, "PROXY TCP4 192.0.2.1 192.0.2.2 56324 514\r\nhello\n")
+
+	event := <-ch
+	assert.Equal(t, "hello", event.message)
+	assert.Equal(t, "192.0.2.1:56324", event.mt.RemoteAddr.String())
+}
@@ -18,6 +18,7 @@
 package tcp
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net"
@@ -65,19 +66,17 @@ func New(
 }
 
 func (s *Server) createServer() (net.Listener, error) {
-	var l net.Listener
-	var err error
+	lc := s.config.SocketOptions.ListenConfig()
+	l, err := lc.Listen(context.Background(), "tcp", s.config.Host)
+	if err != nil {
+		return nil, err
+	}
+	// The PROXY protocol header is sent before the TLS handshake.
+	if s.config.ProxyProtocol {
+		l = &proxyListener{Listener: l, timeout: s.config.Timeout}
+	}
 	if s.tlsConfig != nil {
-		t := s.tlsConfig.BuildServerConfig(s.config.Host)
-		l, err = tls.Listen("tcp", s.config.Host, t)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		l, err = net.Listen("tcp", s.config.Host)
-		if err != nil {
-			return nil, err
-		}
+		l = tls.NewListener(l, s.tlsConfig.BuildServerConfig(s.config.Host))
 	}
 
 	if s.config.MaxConnections > 0 {
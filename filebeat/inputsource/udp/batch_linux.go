@@ -0,0 +1,158 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"context"
+	"errors"
+	"net"
+	"unsafe"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/sys/unix"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/dgram"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// batchReadSupported is true on Linux, where recvmmsg reads several datagrams
+// per system call.
+const batchReadSupported = true
+
+// maxGROSize is the largest buffer the kernel coalesces datagrams into with
+// UDP_GRO.
+const maxGROSize = 65535
+
+// batchReaderFactory returns a handler reading up to batchSize datagrams per
+// recvmmsg call. With gro, the kernel coalesces the consecutive datagrams of
+// a sender into a single buffer, split back into datagrams by the handler.
+func batchReaderFactory(log *logp.Logger, callback inputsource.NetworkFunc, batchSize int, gro bool) dgram.HandlerFactory {
+	fallback := dgram.DatagramReaderFactory(inputsource.FamilyUDP, log, callback)
+	return func(config dgram.ListenerConfig) dgram.ConnectionHandler {
+		return func(ctx context.Context, conn net.PacketConn) error {
+			udpConn, ok := conn.(*net.UDPConn)
+			if !ok {
+				return fallback(config)(ctx, conn)
+			}
+			maxSize := int(config.MaxMessageSize)
+			withGRO := gro
+			if withGRO {
+				if err := enableGRO(udpConn); err != nil {
+					log.Warnw("Failed to enable UDP GRO, datagrams are read one by one", "error", err)
+					withGRO = false
+				}
+			}
+
+			bufSize := maxSize
+			if withGRO {
+				bufSize = maxGROSize
+			}
+			msgs := make([]ipv4.Message, batchSize)
+			for i := range msgs {
+				msgs[i].Buffers = [][]byte{make([]byte, bufSize)}
+				if withGRO {
+					msgs[i].OOB = make([]byte, unix.CmsgSpace(4))
+				}
+			}
+
+			pc := ipv4.NewPacketConn(udpConn)
+			for ctx.Err() == nil {
+				n, err := pc.ReadBatch(msgs, 0)
+				if err != nil {
+					if errors.Is(err, net.ErrClosed) {
+						log.Info("Connection has been closed")
+						return nil
+					}
+					var netErr net.Error
+					if errors.As(err, &netErr) && netErr.Timeout() {
+						continue
+					}
+					log.Errorf("Error reading from the socket %s", err)
+					continue
+				}
+
+				for i := range msgs[:n] {
+					msg := &msgs[i]
+					data := msg.Buffers[0][:msg.N]
+					truncated := msg.Flags&unix.MSG_TRUNC != 0
+
+					segSize := len(data)
+					if withGRO {
+						if size := groSegmentSize(msg.OOB[:msg.NN]); size > 0 {
+							segSize = size
+						}
+					}
+					for len(data) > 0 {
+						seg := data
+						if len(seg) > segSize {
+							seg = seg[:segSize]
+						}
+						data = data[len(seg):]
+
+						segTruncated := truncated
+						if len(seg) > maxSize {
+							seg, segTruncated = seg[:maxSize], true
+						}
+						// The buffers are reused by the next read, the
+						// callback gets its own copy of the datagram.
+						callback(append([]byte(nil), seg...), inputsource.NetworkMetadata{
+							RemoteAddr: msg.Addr,
+							Truncated:  segTruncated,
+						})
+					}
+					msg.OOB = msg.OOB[:cap(msg.OOB)]
+				}
+			}
+			log.Debug("end of connection handling")
+			return nil
+		}
+	}
+}
+
+// enableGRO enables UDP generic receive offload on the socket.
+func enableGRO(conn *net.UDPConn) error {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var sockErr error
+	err = raw.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptInt(int(fd), unix.IPPROTO_UDP, unix.UDP_GRO, 1)
+	})
+	if err != nil {
+		return err
+	}
+	return sockErr
+}
+
+// groSegmentSize returns the size of the datagrams coalesced in a buffer,
+// from the UDP_GRO control message, or 0 if there is none.
+func groSegmentSize(oob []byte) int {
+	cmsgs, err := unix.ParseSocketControlMessage(oob)
+	if err != nil {
+		return 0
+	}
+	for _, cmsg := range cmsgs {
+		if cmsg.Header.Level == unix.IPPROTO_UDP && cmsg.Header.Type == unix.UDP_GRO && len(cmsg.Data) >= 4 {
+			// The kernel writes the size as an int in host byte order.
+			return int(*(*int32)(unsafe.Pointer(&cmsg.Data[0])))
+		}
+	}
+	return 0
+}
@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+)
+
+func TestBatchRead(t *testing.T) {
+	for _, gro := range []bool{false, true} {
+		t.Run(fmt.Sprintf("gro=%v", gro), func(t *testing.T) {
+			const count = 20
+			ch := make(chan info, count)
+			config := &Config{
+				Host:           "localhost:0",
+				MaxMessageSize: maxMessageSize,
+				Timeout:        timeout,
+				BatchSize:      8,
+				GRO:            gro,
+			}
+			require.NoError(t, config.Validate())
+			s, err := New(config, func(message []byte, metadata inputsource.NetworkMetadata) {
+				ch <- info{message: message, mt: metadata}
+			})
+			require.NoError(t, err)
+			require.NoError(t, s.Start())
+			defer s.Stop()
+
+			conn, err := net.Dial("udp", s.localaddress)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			for i := 0; i < count-1; i++ {
+				_, err = fmt.Fprintf(conn, "message %d", i)
+				require.NoError(t, err)
+			}
+			_, err = conn.Write([]byte("message over the maximum size"))
+			require.NoError(t, err)
+
+			for i := 0; i < count-1; i++ {
+				info := <-ch
+				assert.Equal(t, fmt.Sprintf("message %d", i), string(info.message))
+				assert.NotNil(t, info.mt.RemoteAddr)
+				assert.False(t, info.mt.Truncated)
+			}
+			info := <-ch
+			assert.Equal(t, "message over the max", string(info.message))
+			assert.True(t, info.mt.Truncated)
+		})
+	}
+}
+
+func TestBatchConfig(t *testing.T) {
+	config := Config{GRO: true, BatchSize: 1}
+	assert.Error(t, config.Validate())
+	config.BatchSize = 16
+	assert.NoError(t, config.Validate())
+}
@@ -18,9 +18,12 @@
 package udp
 
 import (
+	"errors"
 	"time"
 
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/sockopt"
 	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
 )
 
 // Config options for the UDPServer
@@ -29,4 +32,39 @@ type Config struct {
 	MaxMessageSize cfgtype.ByteSize `config:"max_message_size" validate:"positive,nonzero"`
 	Timeout        time.Duration    `config:"timeout"`
 	ReadBuffer     cfgtype.ByteSize `config:"read_buffer" validate:"positive"`
+	// Workers is the number of goroutines reading and handling the datagrams.
+	// When it is above 1, the callback is called concurrently, and each
+	// worker reads from its own socket where SO_REUSEPORT is supported.
+	Workers int `config:"workers" validate:"positive"`
+	// BatchSize is the number of datagrams read per system call with
+	// recvmmsg on Linux. Values below 2 read a datagram per call.
+	BatchSize int `config:"batch_size" validate:"positive"`
+	// GRO enables UDP generic receive offload on Linux, the kernel then
+	// coalesces the consecutive datagrams of a sender to read them at
+	// once. It requires a batch_size above 1.
+	GRO bool `config:"gro"`
+
+	// TLS enables DTLS on the listener, the datagrams are then only
+	// accepted from the senders that completed a handshake.
+	TLS *tlscommon.ServerConfig `config:"ssl"`
+
+	// SocketOptions are set on the sockets before they are bound.
+	SocketOptions sockopt.Config `config:",inline"`
+}
+
+// Validate validates the Config option for the udp server.
+func (c *Config) Validate() error {
+	if c.ReadBuffer > 0 && c.SocketOptions.ReceiveBuffer > 0 {
+		return errors.New("read_buffer and so_rcvbuf cannot be used together")
+	}
+	if c.TLS.IsEnabled() && c.SocketOptions.IsSet() {
+		return errors.New("socket options are not supported with ssl")
+	}
+	if c.TLS.IsEnabled() && (c.BatchSize > 1 || c.GRO) {
+		return errors.New("batch_size and gro are not supported with ssl")
+	}
+	if c.GRO && c.BatchSize < 2 {
+		return errors.New("gro requires a batch_size above 1")
+	}
+	return nil
 }
@@ -0,0 +1,233 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pion/dtls/v2"
+
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+// maxRecordSize is large enough to hold any decrypted DTLS record, pion/dtls
+// fails the read when the buffer is smaller than the record.
+const maxRecordSize = 64 * 1024
+
+// buildDTLSConfig converts the loaded TLS server settings to a DTLS 1.2
+// configuration. DTLS 1.3 is not available, so TLSv1.2 must be one of the
+// supported protocols when they are restricted.
+func buildDTLSConfig(cfg *tlscommon.TLSConfig, host string, timeout time.Duration) (*dtls.Config, error) {
+	if len(cfg.Versions) > 0 && !containsVersion(cfg.Versions, tlscommon.TLSVersion12) {
+		return nil, fmt.Errorf("DTLS requires TLSv1.2 to be part of ssl.supported_protocols, got %v", cfg.Versions)
+	}
+
+	t := cfg.BuildServerConfig(host)
+	if len(t.Certificates) == 0 {
+		return nil, errors.New("DTLS requires a certificate and a key in ssl.certificate and ssl.key")
+	}
+
+	suites := make([]dtls.CipherSuiteID, 0, len(t.CipherSuites))
+	for _, s := range t.CipherSuites {
+		suites = append(suites, dtls.CipherSuiteID(s))
+	}
+
+	return &dtls.Config{
+		Certificates:          t.Certificates,
+		CipherSuites:          suites,
+		ClientCAs:             t.ClientCAs,
+		ClientAuth:            dtls.ClientAuthType(t.ClientAuth),
+		InsecureSkipVerify:    t.InsecureSkipVerify,
+		VerifyPeerCertificate: t.VerifyPeerCertificate,
+		ExtendedMasterSecret:  dtls.RequestExtendedMasterSecret,
+		ConnectContextMaker: func() (context.Context, func()) {
+			if timeout <= 0 {
+				return context.WithCancel(context.Background())
+			}
+			return context.WithTimeout(context.Background(), timeout)
+		},
+	}, nil
+}
+
+func containsVersion(versions []tlscommon.TLSVersion, v tlscommon.TLSVersion) bool {
+	for _, version := range versions {
+		if version == v {
+			return true
+		}
+	}
+	return false
+}
+
+// dtlsPacket is a datagram decrypted from a DTLS session.
+type dtlsPacket struct {
+	data []byte
+	addr net.Addr
+}
+
+// dtlsConn exposes the datagrams of all the DTLS sessions accepted on a
+// listener as a single net.PacketConn, so they can be read by the same
+// handlers as the plaintext datagrams.
+type dtlsConn struct {
+	log      *logp.Logger
+	listener net.Listener
+	timeout  time.Duration
+
+	packets chan dtlsPacket
+	done    chan struct{}
+	once    sync.Once
+	wg      sync.WaitGroup
+
+	mu       sync.Mutex
+	sessions map[net.Conn]struct{}
+}
+
+func listenDTLS(host string, config *dtls.Config, timeout time.Duration, log *logp.Logger) (*dtlsConn, error) {
+	addr, err := net.ResolveUDPAddr("udp", host)
+	if err != nil {
+		return nil, err
+	}
+	l, err := dtls.Listen("udp", addr, config)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &dtlsConn{
+		log:      log,
+		listener: l,
+		timeout:  timeout,
+		packets:  make(chan dtlsPacket),
+		done:     make(chan struct{}),
+		sessions: map[net.Conn]struct{}{},
+	}
+	c.wg.Add(1)
+	go c.accept()
+	return c, nil
+}
+
+// accept accepts the DTLS sessions until the listener is closed. The
+// handshake is done by Accept and bounded by the configured timeout.
+func (c *dtlsConn) accept() {
+	defer c.wg.Done()
+	for {
+		session, err := c.listener.Accept()
+		if err != nil {
+			select {
+			case <-c.done:
+				return
+			default:
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			c.log.Debugw("DTLS handshake failed", "error", err)
+			continue
+		}
+
+		c.mu.Lock()
+		c.sessions[session] = struct{}{}
+		c.mu.Unlock()
+
+		c.wg.Add(1)
+		go c.serve(session)
+	}
+}
+
+// serve forwards the datagrams of a session until it is closed by the peer,
+// stays idle longer than the timeout, or the listener is closed.
+func (c *dtlsConn) serve(session net.Conn) {
+	defer c.wg.Done()
+	defer func() {
+		c.mu.Lock()
+		delete(c.sessions, session)
+		c.mu.Unlock()
+		session.Close()
+	}()
+
+	addr := session.RemoteAddr()
+	c.log.Debugw("DTLS session established", "remote_address", addr)
+
+	buf := make([]byte, maxRecordSize)
+	for {
+		if c.timeout > 0 {
+			_ = session.SetReadDeadline(time.Now().Add(c.timeout))
+		}
+		n, err := session.Read(buf)
+		if err != nil {
+			c.log.Debugw("DTLS session ended", "remote_address", addr, "error", err)
+			return
+		}
+		if n == 0 {
+			continue
+		}
+
+		data := make([]byte, n)
+		copy(data, buf[:n])
+		select {
+		case c.packets <- dtlsPacket{data: data, addr: addr}:
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// ReadFrom returns the next datagram received on any session. As with
+// plaintext sockets on Unix, the datagram is truncated to the size of b.
+func (c *dtlsConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	select {
+	case p := <-c.packets:
+		return copy(b, p.data), p.addr, nil
+	case <-c.done:
+		return 0, nil, &net.OpError{Op: "read", Net: "udp", Addr: c.LocalAddr(), Err: net.ErrClosed}
+	}
+}
+
+// WriteTo is not supported, the input never replies to the senders.
+func (c *dtlsConn) WriteTo(_ []byte, _ net.Addr) (int, error) {
+	return 0, errors.New("writing to a DTLS listener is not supported")
+}
+
+// Close stops accepting sessions and closes the established ones.
+func (c *dtlsConn) Close() error {
+	var err error
+	c.once.Do(func() {
+		close(c.done)
+		err = c.listener.Close()
+
+		c.mu.Lock()
+		for session := range c.sessions {
+			session.Close()
+		}
+		c.mu.Unlock()
+
+		c.wg.Wait()
+	})
+	return err
+}
+
+func (c *dtlsConn) LocalAddr() net.Addr { return c.listener.Addr() }
+
+// Deadlines are applied per session from the configured timeout.
+func (c *dtlsConn) SetDeadline(_ time.Time) error      { return nil }
+func (c *dtlsConn) SetReadDeadline(_ time.Time) error  { return nil }
+func (c *dtlsConn) SetWriteDeadline(_ time.Time) error { return nil }
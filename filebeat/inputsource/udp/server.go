@@ -18,13 +18,17 @@
 package udp
 
 import (
+	"context"
 	"net"
 
 	"github.com/dustin/go-humanize"
+	"github.com/pion/dtls/v2"
 
 	"github.com/elastic/beats/v7/filebeat/inputsource"
 	"github.com/elastic/beats/v7/filebeat/inputsource/common/dgram"
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/sockopt"
 	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
 )
 
 // Name is the human readable name and identifier.
@@ -34,30 +38,65 @@ const Name = "udp"
 // event received to the callback method.
 type Server struct {
 	*dgram.Listener
-	config *Config
+	config     *Config
+	dtlsConfig *dtls.Config
+	log        *logp.Logger
 
 	localaddress string
 }
 
-// New returns a new UDPServer instance.
-func New(config *Config, callback inputsource.NetworkFunc) *Server {
-	server := &Server{config: config}
+// New returns a new UDPServer instance. When ssl is enabled in the config,
+// the datagrams are received over DTLS.
+func New(config *Config, callback inputsource.NetworkFunc) (*Server, error) {
+	tlsConfig, err := tlscommon.LoadTLSServerConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
 	log := logp.NewLogger("udp").With("address", config.Host)
+	server := &Server{config: config, log: log}
+	if tlsConfig != nil {
+		server.dtlsConfig, err = buildDTLSConfig(tlsConfig, config.Host, config.Timeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	factory := dgram.DatagramReaderFactory(inputsource.FamilyUDP, log, callback)
+	if config.BatchSize > 1 && server.dtlsConfig == nil {
+		if batchReadSupported {
+			factory = batchReaderFactory(log, callback, config.BatchSize, config.GRO)
+		} else {
+			log.Warn("batch_size and gro are only supported on Linux, datagrams are read one by one")
+		}
+	}
 	server.Listener = dgram.NewListener(inputsource.FamilyUDP, config.Host, factory, server.createConn, &dgram.ListenerConfig{
 		Timeout:        config.Timeout,
 		MaxMessageSize: config.MaxMessageSize,
+		Workers:        config.Workers,
+		// DTLS sessions are bound to the socket they were established on.
+		SocketPerWorker: sockopt.ReusePortSupported && server.dtlsConfig == nil,
 	})
-	return server
+	return server, nil
 }
 
 func (u *Server) createConn() (net.PacketConn, error) {
+	if u.dtlsConfig != nil {
+		conn, err := listenDTLS(u.config.Host, u.dtlsConfig, u.config.Timeout, u.log)
+		if err != nil {
+			return nil, err
+		}
+		u.localaddress = conn.LocalAddr().String()
+		return conn, nil
+	}
+
 	var err error
-	udpAdddr, err := net.ResolveUDPAddr("udp", u.config.Host)
-	if err != nil {
-		return nil, err
+	var listener *net.UDPConn
+	if u.config.Workers > 1 && sockopt.ReusePortSupported {
+		listener, err = u.listenReusePort()
+	} else {
+		listener, err = u.listen(u.config.Host, u.config.SocketOptions)
 	}
-	listener, err := net.ListenUDP("udp", udpAdddr)
 	if err != nil {
 		return nil, err
 	}
@@ -71,3 +110,27 @@ func (u *Server) createConn() (net.PacketConn, error) {
 
 	return listener, err
 }
+
+// listenReusePort creates one of the sockets of the workers. The sockets are
+// bound with SO_REUSEPORT so the kernel balances the datagrams between them.
+// Once a socket is bound, the others are bound to its address, so that they
+// share the port picked by the system when the configured port is 0.
+func (u *Server) listenReusePort() (*net.UDPConn, error) {
+	host := u.config.Host
+	if u.localaddress != "" {
+		host = u.localaddress
+	}
+	opts := u.config.SocketOptions
+	opts.ReusePort = true
+	return u.listen(host, opts)
+}
+
+// listen binds a socket to host with the given socket options.
+func (u *Server) listen(host string, opts sockopt.Config) (*net.UDPConn, error) {
+	lc := opts.ListenConfig()
+	conn, err := lc.ListenPacket(context.Background(), "udp", host)
+	if err != nil {
+		return nil, err
+	}
+	return conn.(*net.UDPConn), nil
+}
@@ -18,14 +18,24 @@
 package udp
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
 	"net"
 	"runtime"
 	"testing"
 	"time"
 
+	"github.com/pion/dtls/v2"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
 )
 
 const (
@@ -68,8 +78,11 @@ func TestReceiveEventFromUDP(t *testing.T) {
 	fn := func(message []byte, metadata inputsource.NetworkMetadata) {
 		ch <- info{message: message, mt: metadata}
 	}
-	s := New(config, fn)
-	err := s.Start()
+	s, err := New(config, fn)
+	if !assert.NoError(t, err) {
+		return
+	}
+	err = s.Start()
 	if !assert.NoError(t, err) {
 		return
 	}
@@ -104,3 +117,156 @@ func TestReceiveEventFromUDP(t *testing.T) {
 		})
 	}
 }
+
+func TestReceiveEventFromUDPWorkers(t *testing.T) {
+	const (
+		workers  = 4
+		messages = 100
+	)
+
+	ch := make(chan info, messages)
+	config := &Config{
+		Host:           "localhost:0",
+		MaxMessageSize: maxMessageSize,
+		Timeout:        timeout,
+		Workers:        workers,
+	}
+	fn := func(message []byte, metadata inputsource.NetworkMetadata) {
+		ch <- info{message: message, mt: metadata}
+	}
+	s, err := New(config, fn)
+	if !assert.NoError(t, err) {
+		return
+	}
+	err = s.Start()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer s.Stop()
+
+	received := map[string]bool{}
+	for i := 0; i < messages; i++ {
+		// Use a new source port for each message, so the messages are
+		// balanced between the sockets of the workers.
+		conn, err := net.Dial("udp", s.localaddress)
+		if !assert.NoError(t, err) {
+			return
+		}
+		msg := fmt.Sprintf("message %d", i)
+		_, err = conn.Write([]byte(msg))
+		conn.Close()
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		select {
+		case info := <-ch:
+			received[string(info.message)] = true
+		case <-time.After(timeout):
+			t.Fatalf("timeout waiting for %q", msg)
+		}
+	}
+	assert.Len(t, received, messages)
+}
+
+func TestReceiveEventFromDTLS(t *testing.T) {
+	cert, key := selfSignedPEM(t)
+
+	ch := make(chan info)
+	config := &Config{
+		Host:           "localhost:0",
+		MaxMessageSize: maxMessageSize,
+		Timeout:        timeout,
+		TLS: &tlscommon.ServerConfig{
+			Certificate: tlscommon.CertificateConfig{
+				Certificate: cert,
+				Key:         key,
+			},
+		},
+	}
+	fn := func(message []byte, metadata inputsource.NetworkMetadata) {
+		ch <- info{message: message, mt: metadata}
+	}
+	s, err := New(config, fn)
+	if !assert.NoError(t, err) {
+		return
+	}
+	err = s.Start()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer s.Stop()
+
+	addr, err := net.ResolveUDPAddr("udp", s.localaddress)
+	if !assert.NoError(t, err) {
+		return
+	}
+	conn, err := dtls.Dial("udp", addr, &dtls.Config{InsecureSkipVerify: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+
+	for _, message := range []string{"Hello world", "Hello world not so nice"} {
+		_, err = conn.Write([]byte(message))
+		if !assert.NoError(t, err) {
+			return
+		}
+	}
+
+	info := <-ch
+	assert.Equal(t, []byte("Hello world"), info.message)
+	assert.Equal(t, conn.LocalAddr().String(), info.mt.RemoteAddr.String())
+
+	info = <-ch
+	assert.Equal(t, []byte("Hello world not so n"), info.message, "message must be truncated to max_message_size")
+}
+
+func TestDTLSRequiresTLSv12(t *testing.T) {
+	cert, key := selfSignedPEM(t)
+
+	config := &Config{
+		Host:           "localhost:0",
+		MaxMessageSize: maxMessageSize,
+		Timeout:        timeout,
+		TLS: &tlscommon.ServerConfig{
+			Versions: []tlscommon.TLSVersion{tlscommon.TLSVersion13},
+			Certificate: tlscommon.CertificateConfig{
+				Certificate: cert,
+				Key:         key,
+			},
+		},
+	}
+	_, err := New(config, func([]byte, inputsource.NetworkMetadata) {})
+	assert.Error(t, err)
+}
+
+// selfSignedPEM returns a PEM encoded certificate for localhost and its key.
+func selfSignedPEM(t *testing.T) (cert, key string) {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	key = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return cert, key
+}
@@ -32,6 +32,8 @@ const (
 	StreamSocket SocketType = iota
 	// DatagramSocket is used when reading from a Unix datagram socket.
 	DatagramSocket
+	// SeqpacketSocket is used when reading from a Unix sequenced-packet socket.
+	SeqpacketSocket
 )
 
 const (
@@ -40,13 +42,15 @@ const (
 )
 
 var socketTypes = map[string]SocketType{
-	"stream":   StreamSocket,
-	"datagram": DatagramSocket,
+	"stream":    StreamSocket,
+	"datagram":  DatagramSocket,
+	"seqpacket": SeqpacketSocket,
 }
 
 // Config exposes the unix configuration.
 type Config struct {
 	Path           string                `config:"path"`
+	User           *string               `config:"user"`
 	Group          *string               `config:"group"`
 	Mode           *string               `config:"mode"`
 	Timeout        time.Duration         `config:"timeout" validate:"nonzero,positive"`
@@ -84,3 +88,12 @@ func (s *SocketType) Unpack(value string) error {
 	*s = setting
 	return nil
 }
+
+func (s SocketType) String() string {
+	for name, t := range socketTypes {
+		if t == s {
+			return name
+		}
+	}
+	return "unknown"
+}
@@ -64,3 +64,16 @@ func TestInvalidSocketType(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "unknown socket type")
 }
+
+func TestNoLineDelimiterWhenSeqpacketSocket(t *testing.T) {
+	c := conf.MustNewConfigFrom(map[string]interface{}{
+		"timeout":          1,
+		"max_message_size": 1,
+		"path":             "my-path",
+		"socket_type":      "seqpacket",
+	})
+	var config Config
+	assert.NoError(t, c.Unpack(&config))
+	assert.Equal(t, SeqpacketSocket, config.SocketType)
+	assert.Equal(t, "seqpacket", config.SocketType.String())
+}
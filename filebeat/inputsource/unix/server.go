@@ -36,7 +36,8 @@ type Server interface {
 	Run(context.Context) error
 }
 
-// streamServer is a server for reading from Unix stream sockets.
+// streamServer is a server for reading from Unix stream and sequenced-packet
+// sockets.
 type streamServer struct {
 	*streaming.Listener
 	config *Config
@@ -52,11 +53,21 @@ type datagramServer struct {
 func New(log *logp.Logger, config *Config, nf inputsource.NetworkFunc) (Server, error) {
 	switch config.SocketType {
 	case StreamSocket:
-		splitFunc, err := streaming.SplitFunc(config.Framing, []byte(config.LineDelimiter))
+		splitFunc, err := streaming.NewSplitFuncFactory(config.Framing, []byte(config.LineDelimiter), nil)
 		if err != nil {
 			return nil, err
 		}
-		factory := streaming.SplitHandlerFactory(inputsource.FamilyUnix, log, MetadataCallback, nf, splitFunc)
+		factory := streaming.SplitFactoryHandlerFactory(inputsource.FamilyUnix, log, MetadataCallback, nf, splitFunc)
+		server := &streamServer{config: config}
+		server.Listener = streaming.NewListener(inputsource.FamilyUnix, config.Path, factory, server.createServer, &streaming.ListenerConfig{
+			Timeout:        config.Timeout,
+			MaxMessageSize: config.MaxMessageSize,
+			MaxConnections: config.MaxConnections,
+		})
+		return server, nil
+
+	case SeqpacketSocket:
+		factory := streaming.PacketHandlerFactory(inputsource.FamilyUnix, log, MetadataCallback, nf)
 		server := &streamServer{config: config}
 		server.Listener = streaming.NewListener(inputsource.FamilyUnix, config.Path, factory, server.createServer, &streaming.ListenerConfig{
 			Timeout:        config.Timeout,
@@ -84,12 +95,16 @@ func (s *streamServer) createServer() (net.Listener, error) {
 		return nil, err
 	}
 
-	l, err := net.Listen("unix", s.config.Path)
+	network := "unix"
+	if s.config.SocketType == SeqpacketSocket {
+		network = "unixpacket"
+	}
+	l, err := net.Listen(network, s.config.Path)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := setSocketOwnership(s.config.Path, s.config.Group); err != nil {
+	if err := setSocketOwnership(s.config.Path, s.config.User, s.config.Group); err != nil {
 		return nil, err
 	}
 
@@ -117,7 +132,7 @@ func (s *datagramServer) createConn() (net.PacketConn, error) {
 		return nil, err
 	}
 
-	if err := setSocketOwnership(s.config.Path, s.config.Group); err != nil {
+	if err := setSocketOwnership(s.config.Path, s.config.User, s.config.Group); err != nil {
 		return nil, err
 	}
 
@@ -231,9 +231,13 @@ func TestSocketOwnershipAndMode(t *testing.T) {
 	group, err := user.LookupGroupId(strconv.Itoa(groups[1]))
 	require.NoError(t, err)
 
+	usr, err := user.Current()
+	require.NoError(t, err)
+
 	path := filepath.Join(os.TempDir(), "test.sock")
 	cfg, _ := conf.NewConfigFrom(map[string]interface{}{
 		"path":           path,
+		"user":           usr.Username,
 		"group":          group.Name,
 		"mode":           "0740",
 		"line_delimiter": "\n",
@@ -255,6 +259,49 @@ func TestSocketOwnershipAndMode(t *testing.T) {
 	gid, err := info.GID()
 	require.NoError(t, err)
 	require.Equal(t, group.Gid, strconv.Itoa(gid))
+	uid, err := info.UID()
+	require.NoError(t, err)
+	require.Equal(t, usr.Uid, strconv.Itoa(uid))
+}
+
+func TestReceiveSeqpacketMessages(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("seqpacket sockets are only supported on linux")
+		return
+	}
+
+	path := filepath.Join(os.TempDir(), "test.sock")
+	ch := make(chan *info, 3)
+	to := func(message []byte, mt inputsource.NetworkMetadata) {
+		ch <- &info{message: string(message), mt: mt}
+	}
+	config := defaultConfig()
+	config.Path = path
+	config.SocketType = SeqpacketSocket
+	config.MaxMessageSize = 10
+
+	server, err := New(logp.L(), &config, to)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer server.Stop()
+
+	// Every packet is a message, whatever its content.
+	samples := []string{"first\nline", "second", "0123456789abc"}
+	sendOverUnixSeqpacket(t, path, samples)
+
+	for _, want := range []info{
+		{message: "first\nline"},
+		{message: "second"},
+		{message: "0123456789", mt: inputsource.NetworkMetadata{Truncated: true}},
+	} {
+		select {
+		case got := <-ch:
+			assert.Equal(t, want.message, got.message)
+			assert.Equal(t, want.mt.Truncated, got.mt.Truncated)
+		case <-time.After(10 * time.Second):
+			t.Fatal("timeout waiting for the messages")
+		}
+	}
 }
 
 func TestSocketCleanup(t *testing.T) {
@@ -311,6 +358,9 @@ func TestReceiveNewEventsConcurrently(t *testing.T) {
 	}
 
 	for socketType := range socketTypes {
+		if runtime.GOOS != "linux" && socketType == "seqpacket" {
+			continue
+		}
 		if runtime.GOOS == "darwin" && socketType == "datagram" {
 			t.Skip("test is only supported on linux. See https://github.com/elastic/beats/issues/22775")
 			return
@@ -355,6 +405,8 @@ func TestReceiveNewEventsConcurrently(t *testing.T) {
 					go sendOverUnixStream(t, path, samples)
 				} else if socketType == "datagram" {
 					go sendOverUnixDatagram(t, path, samples)
+				} else if socketType == "seqpacket" {
+					go sendOverUnixSeqpacket(t, path, samples)
 				}
 			}
 
@@ -393,6 +445,17 @@ func sendOverUnixDatagram(t *testing.T, path string, samples []string) {
 	}
 }
 
+func sendOverUnixSeqpacket(t *testing.T, path string, samples []string) {
+	conn, err := net.Dial("unixpacket", path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+	for _, sample := range samples {
+		fmt.Fprint(conn, sample)
+	}
+}
+
 func randomString(l int) string {
 	charsets := []byte("abcdefghijklmnopqrstuvwzyzABCDEFGHIJKLMNOPQRSTUVWZYZ0123456789")
 	message := make([]byte, l)
@@ -53,23 +53,36 @@ func cleanupStaleSocket(path string) error {
 	return nil
 }
 
-func setSocketOwnership(path string, group *string) error {
-	if group != nil {
-		if runtime.GOOS == "windows" {
-			logp.NewLogger("unix").Warn("windows does not support the 'group' configuration option, ignoring")
-			return nil
+func setSocketOwnership(path string, usr, group *string) error {
+	if usr == nil && group == nil {
+		return nil
+	}
+	if runtime.GOOS == "windows" {
+		logp.NewLogger("unix").Warn("windows does not support the 'user' and 'group' configuration options, ignoring")
+		return nil
+	}
+	uid, gid := -1, -1
+	if usr != nil {
+		u, err := user.Lookup(*usr)
+		if err != nil {
+			return err
 		}
+		uid, err = strconv.Atoi(u.Uid)
+		if err != nil {
+			return err
+		}
+	}
+	if group != nil {
 		g, err := user.LookupGroup(*group)
 		if err != nil {
 			return err
 		}
-		gid, err := strconv.Atoi(g.Gid)
+		gid, err = strconv.Atoi(g.Gid)
 		if err != nil {
 			return err
 		}
-		return os.Chown(path, -1, gid)
 	}
-	return nil
+	return os.Chown(path, uid, gid)
 }
 
 func setSocketMode(path string, mode *string) error {
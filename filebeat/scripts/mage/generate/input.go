@@ -0,0 +1,54 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package generate
+
+import (
+	"fmt"
+	"os"
+
+	devtools "github.com/elastic/beats/v7/dev-tools/mage"
+	geninput "github.com/elastic/beats/v7/filebeat/generator/input"
+)
+
+// Input creates a new Filebeat input.
+// Use INPUT=input to specify the name of the new input
+func Input() error {
+	targetInput := os.Getenv("INPUT")
+	if targetInput == "" {
+		return fmt.Errorf("you must specify the input: INPUT=name mage generate:input")
+	}
+
+	ossDir := devtools.OSSBeatDir()
+	xPackDir := devtools.XPackBeatDir()
+
+	var err error
+	switch devtools.CWD() {
+	case ossDir:
+		err = geninput.Generate(targetInput, ossDir, ossDir)
+	case xPackDir:
+		err = geninput.Generate(targetInput, xPackDir, ossDir)
+	default:
+		return fmt.Errorf("you must be in a filebeat directory")
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Input %s created, add %s.Plugin() to the input/default-inputs package to register it.\n", targetInput, targetInput)
+	return nil
+}
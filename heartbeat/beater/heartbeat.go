@@ -35,6 +35,7 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers/monitorstate"
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
 	_ "github.com/elastic/beats/v7/heartbeat/security"
+	"github.com/elastic/beats/v7/heartbeat/sources"
 	"github.com/elastic/beats/v7/heartbeat/tracer"
 	"github.com/elastic/beats/v7/libbeat/autodiscover"
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -196,6 +197,12 @@ func (bt *Heartbeat) Run(b *beat.Beat) error {
 		defer bt.autodiscover.Stop()
 	}
 
+	stopMonitorSources, err := bt.RunMonitorSources(b)
+	if err != nil {
+		return err
+	}
+	defer stopMonitorSources()
+
 	defer bt.scheduler.Stop()
 
 	<-bt.done
@@ -234,6 +241,30 @@ func (bt *Heartbeat) RunStaticMonitors(b *beat.Beat) (stop func(), err error) {
 	return stop, nil
 }
 
+// RunMonitorSources runs the monitors loaded from the `heartbeat.monitor_sources` portion of the yaml config if present.
+func (bt *Heartbeat) RunMonitorSources(b *beat.Beat) (stop func(), err error) {
+	runners := make([]*sources.Runner, 0, len(bt.config.MonitorSources))
+	for _, cfg := range bt.config.MonitorSources {
+		runner, err := sources.NewRunner(cfg, bt.monitorFactory, b.Publisher)
+		if err != nil {
+			for _, runner := range runners {
+				runner.Stop()
+			}
+			return nil, fmt.Errorf("could not create monitor source: %w", err)
+		}
+
+		runner.Start()
+		runners = append(runners, runner)
+	}
+
+	stop = func() {
+		for _, runner := range runners {
+			runner.Stop()
+		}
+	}
+	return stop, nil
+}
+
 // RunCentralMgmtMonitors loads any central management configured configs.
 func (bt *Heartbeat) RunCentralMgmtMonitors(b *beat.Beat) {
 	// Register output reloader for managed outputs
@@ -87,6 +87,14 @@ func NewCmdTimeoutStatusErr(timeout time.Duration, cmd string) *ECSErr {
 	)
 }
 
+func NewCmdBudgetExceededErr(resource string, limit string, used string) *ECSErr {
+	return NewECSErr(
+		TYPE_IO,
+		"CMD_BUDGET_EXCEEDED",
+		fmt.Sprintf("journey exceeded its %s budget of %s (used %s)", resource, limit, used),
+	)
+}
+
 func NewSyntheticsCmdCouldNotStartErr(reason error) *ECSErr {
 	return NewECSErr(
 		TYPE_IO,
@@ -31,6 +31,7 @@ import (
 
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
 	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/rollup"
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
 	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
@@ -67,6 +68,9 @@ type Monitor struct {
 	// since async clients are a subset of sync clients
 	pubClient pipeline.ISyncClient
 
+	// rollup configures the periodic availability summaries of the monitor
+	rollup rollup.Config
+
 	// stats is the countersRecorder used to record lifecycle events
 	// for global metrics + telemetry
 	stats plugin.RegistryRecorder
@@ -124,6 +128,13 @@ func newMonitorUnsafe(
 		return nil, fmt.Errorf("monitor type %v does not exist, valid types are %v", standardFields.Type, registrar.MonitorNames())
 	}
 
+	rollupConfig := struct {
+		Rollup rollup.Config `config:"rollup"`
+	}{Rollup: rollup.DefaultConfig()}
+	if err := config.Unpack(&rollupConfig); err != nil {
+		return nil, fmt.Errorf("invalid rollup config: %w", err)
+	}
+
 	m := &Monitor{
 		stdFields:      standardFields,
 		pluginName:     pluginFactory.Name,
@@ -134,6 +145,7 @@ func newMonitorUnsafe(
 		config:         config,
 		stats:          pluginFactory.Stats,
 		state:          MON_INIT,
+		rollup:         rollupConfig.Rollup,
 	}
 
 	if m.stdFields.ID == "" {
@@ -226,8 +238,15 @@ func (m *Monitor) Start() {
 	m.internalsMtx.Lock()
 	defer m.internalsMtx.Unlock()
 
+	pubClient := m.pubClient
+	if m.rollup.Enabled {
+		// The client is shared by the jobs of the monitor, closing it
+		// multiple times when they stop is safe.
+		pubClient = rollup.NewClient(pubClient, m.rollup.Interval)
+	}
+
 	for _, t := range m.configuredJobs {
-		t.Start(m.pubClient)
+		t.Start(pubClient)
 	}
 
 	m.stats.StartMonitor(int64(m.endpoints))
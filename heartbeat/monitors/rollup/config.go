@@ -0,0 +1,45 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rollup
+
+import (
+	"errors"
+	"time"
+)
+
+// Config configures the periodic roll-up events of a monitor.
+type Config struct {
+	Enabled  bool          `config:"enabled"`
+	Interval time.Duration `config:"interval"`
+}
+
+// DefaultConfig returns the default roll-up settings, roll-ups are disabled
+// unless explicitly enabled.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:  false,
+		Interval: 15 * time.Minute,
+	}
+}
+
+func (c *Config) Validate() error {
+	if c.Interval < time.Minute {
+		return errors.New("rollup.interval must be at least 1m")
+	}
+	return nil
+}
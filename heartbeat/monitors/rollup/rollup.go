@@ -0,0 +1,288 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package rollup aggregates the summary events of a monitor into periodic
+// availability events, so long term availability reporting does not require
+// keeping every check.
+package rollup
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/publisher/pipeline"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// EventType is the event.type of the roll-up events.
+const EventType = "heartbeat/rollup"
+
+// window accumulates the checks of a single monitor ID.
+type window struct {
+	monitor   mapstr.M
+	checks    int
+	up        int
+	down      int
+	durations []time.Duration
+}
+
+// Recorder accumulates the summary events of a monitor and turns them into
+// one roll-up event per monitor ID for each window. A monitor checking
+// multiple endpoints reports one ID per endpoint, so each of them gets its
+// own roll-up.
+type Recorder struct {
+	mtx     sync.Mutex
+	start   time.Time
+	windows map[string]*window
+	// order keeps the IDs in the order they were first seen, so that the
+	// roll-up events are published in a stable order.
+	order []string
+}
+
+// NewRecorder returns a recorder whose first window starts at start.
+func NewRecorder(start time.Time) *Recorder {
+	return &Recorder{
+		start:   start,
+		windows: map[string]*window{},
+	}
+}
+
+// Record adds the check reported by the event to the current window. Events
+// that are not summary events are ignored.
+func (r *Recorder) Record(event *beat.Event) {
+	up, ok := intValue(event.Fields, "summary.up")
+	if !ok {
+		return
+	}
+	down, _ := intValue(event.Fields, "summary.down")
+
+	id, _ := event.Fields.GetValue("monitor.id")
+	idStr, ok := id.(string)
+	if !ok {
+		return
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	w, ok := r.windows[idStr]
+	if !ok {
+		w = &window{monitor: mapstr.M{"id": idStr}}
+		for _, k := range []string{"name", "type"} {
+			if v, err := event.Fields.GetValue("monitor." + k); err == nil {
+				w.monitor[k] = v
+			}
+		}
+		r.windows[idStr] = w
+		r.order = append(r.order, idStr)
+	}
+
+	w.checks++
+	// A check is up if every endpoint it tested was up.
+	if down == 0 && up > 0 {
+		w.up++
+	} else {
+		w.down++
+	}
+	if us, ok := intValue(event.Fields, "monitor.duration.us"); ok {
+		w.durations = append(w.durations, time.Duration(us)*time.Microsecond)
+	}
+}
+
+// Flush returns the roll-up events of the window ending at end and starts a
+// new window. Monitors without any check in the window are not reported.
+func (r *Recorder) Flush(end time.Time) []beat.Event {
+	r.mtx.Lock()
+	start, windows, order := r.start, r.windows, r.order
+	r.start = end
+	r.windows = map[string]*window{}
+	r.order = nil
+	r.mtx.Unlock()
+
+	events := make([]beat.Event, 0, len(order))
+	for _, id := range order {
+		w := windows[id]
+
+		monitor := w.monitor.Clone()
+		monitor["timespan"] = mapstr.M{
+			"gte": start,
+			"lt":  end,
+		}
+
+		rollup := mapstr.M{
+			"checks": w.checks,
+			"up":     w.up,
+			"down":   w.down,
+			"uptime": mapstr.M{
+				"pct": float64(w.up) / float64(w.checks),
+			},
+		}
+		if len(w.durations) > 0 {
+			rollup["duration"] = durationStats(w.durations)
+		}
+
+		events = append(events, beat.Event{
+			Timestamp: end,
+			Fields: mapstr.M{
+				"event": mapstr.M{
+					"type": EventType,
+				},
+				"monitor": monitor,
+				"rollup":  rollup,
+			},
+		})
+	}
+	return events
+}
+
+func durationStats(durations []time.Duration) mapstr.M {
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	var sum time.Duration
+	for _, d := range durations {
+		sum += d
+	}
+
+	return mapstr.M{
+		"avg": look.RTT(sum / time.Duration(len(durations))),
+		"p95": look.RTT(percentile(durations, 0.95)),
+		"max": look.RTT(durations[len(durations)-1]),
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func intValue(m mapstr.M, key string) (int64, bool) {
+	v, err := m.GetValue(key)
+	if err != nil {
+		return 0, false
+	}
+
+	switch n := v.(type) {
+	case int:
+		return int64(n), true
+	case int64:
+		return n, true
+	case uint16:
+		return int64(n), true
+	case uint64:
+		return int64(n), true
+	case float64:
+		return int64(n), true
+	}
+	return 0, false
+}
+
+// Client wraps the publishing client of a monitor, recording the published
+// events and publishing the roll-up events at the end of each interval.
+// Windows are aligned on multiples of the interval, so the roll-ups of all
+// monitors cover the same time ranges.
+type Client struct {
+	pipeline.ISyncClient
+
+	recorder *Recorder
+	interval time.Duration
+	done     chan struct{}
+	wg       sync.WaitGroup
+	closeMtx sync.Mutex
+	closed   bool
+}
+
+// NewClient starts publishing the roll-up events of the monitor through client
+// every interval until Close is called.
+func NewClient(client pipeline.ISyncClient, interval time.Duration) *Client {
+	c := &Client{
+		ISyncClient: client,
+		recorder:    NewRecorder(time.Now()),
+		interval:    interval,
+		done:        make(chan struct{}),
+	}
+
+	c.wg.Add(1)
+	go c.run()
+
+	return c
+}
+
+func (c *Client) Publish(event beat.Event) error {
+	c.recorder.Record(&event)
+	return c.ISyncClient.Publish(event)
+}
+
+func (c *Client) PublishAll(events []beat.Event) error {
+	for i := range events {
+		c.recorder.Record(&events[i])
+	}
+	return c.ISyncClient.PublishAll(events)
+}
+
+// Close publishes the roll-up of the current, partial, window and closes the
+// wrapped client.
+func (c *Client) Close() error {
+	c.closeMtx.Lock()
+	defer c.closeMtx.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+
+	close(c.done)
+	c.wg.Wait()
+
+	c.publish(time.Now())
+	return c.ISyncClient.Close()
+}
+
+func (c *Client) run() {
+	defer c.wg.Done()
+
+	for {
+		now := time.Now()
+		next := now.Truncate(c.interval).Add(c.interval)
+		timer := time.NewTimer(next.Sub(now))
+
+		select {
+		case <-c.done:
+			timer.Stop()
+			return
+		case <-timer.C:
+			c.publish(next)
+		}
+	}
+}
+
+func (c *Client) publish(end time.Time) {
+	events := c.recorder.Flush(end)
+	if len(events) == 0 {
+		return
+	}
+	if err := c.ISyncClient.PublishAll(events); err != nil {
+		logp.L().Warnf("could not publish the monitor roll-up events: %v", err)
+	}
+}
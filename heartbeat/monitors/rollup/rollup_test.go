@@ -0,0 +1,116 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rollup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func summaryEvent(id string, up, down uint16, duration time.Duration) *beat.Event {
+	return &beat.Event{
+		Fields: mapstr.M{
+			"monitor": mapstr.M{
+				"id":       id,
+				"name":     "My Monitor",
+				"type":     "http",
+				"duration": mapstr.M{"us": duration.Microseconds()},
+			},
+			"summary": mapstr.M{
+				"up":   up,
+				"down": down,
+			},
+		},
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(15 * time.Minute)
+	r := NewRecorder(start)
+
+	for i := 1; i <= 19; i++ {
+		r.Record(summaryEvent("mon", 1, 0, time.Duration(i)*time.Millisecond))
+	}
+	r.Record(summaryEvent("mon", 0, 1, time.Second))
+	// Not a summary event, ignored.
+	r.Record(&beat.Event{Fields: mapstr.M{"monitor": mapstr.M{"id": "mon"}}})
+	r.Record(summaryEvent("other", 1, 1, 0))
+
+	events := r.Flush(end)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, end, events[0].Timestamp)
+	assert.Equal(t, mapstr.M{
+		"event": mapstr.M{"type": EventType},
+		"monitor": mapstr.M{
+			"id":   "mon",
+			"name": "My Monitor",
+			"type": "http",
+			"timespan": mapstr.M{
+				"gte": start,
+				"lt":  end,
+			},
+		},
+		"rollup": mapstr.M{
+			"checks": 20,
+			"up":     19,
+			"down":   1,
+			"uptime": mapstr.M{"pct": 0.95},
+			"duration": mapstr.M{
+				"avg": mapstr.M{"us": int64(59500)},
+				"p95": mapstr.M{"us": int64(19000)},
+				"max": mapstr.M{"us": int64(1000000)},
+			},
+		},
+	}, events[0].Fields)
+
+	down, err := events[1].Fields.GetValue("rollup.down")
+	require.NoError(t, err)
+	assert.Equal(t, 1, down, "a check with a down endpoint is down")
+
+	// The next window starts where the previous one ended.
+	r.Record(summaryEvent("mon", 1, 0, time.Millisecond))
+	events = r.Flush(end.Add(15 * time.Minute))
+	require.Len(t, events, 1)
+	gte, err := events[0].Fields.GetValue("monitor.timespan.gte")
+	require.NoError(t, err)
+	assert.Equal(t, end, gte)
+
+	assert.Empty(t, r.Flush(end.Add(30*time.Minute)))
+}
+
+func TestPercentile(t *testing.T) {
+	durations := []time.Duration{1, 2, 3, 4}
+	assert.Equal(t, time.Duration(4), percentile(durations, 0.95))
+	assert.Equal(t, time.Duration(2), percentile(durations, 0.5))
+	assert.Equal(t, time.Duration(1), percentile(durations[:1], 0.95))
+}
+
+func TestConfigValidate(t *testing.T) {
+	c := DefaultConfig()
+	assert.NoError(t, c.Validate())
+	c.Interval = time.Second
+	assert.Error(t, c.Validate())
+}
@@ -0,0 +1,217 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/elastic/elastic-agent-autodiscover/bus"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/transport/httpcommon"
+)
+
+type consulConfig struct {
+	Address     string                           `config:"address" validate:"required"`
+	Token       string                           `config:"token"`
+	Datacenter  string                           `config:"datacenter"`
+	Services    []string                         `config:"services"`
+	Tags        []string                         `config:"tags"`
+	PassingOnly bool                             `config:"passing_only"`
+	Templates   []*conf.C                        `config:"templates" validate:"required"`
+	Transport   httpcommon.HTTPTransportSettings `config:",inline"`
+}
+
+// consulSource creates monitors for the instances of the services registered
+// in the Consul catalog, by applying its templates to each instance.
+type consulSource struct {
+	config consulConfig
+	client *http.Client
+}
+
+type consulHealthEntry struct {
+	Node struct {
+		Node       string
+		Address    string
+		Datacenter string
+	}
+	Service struct {
+		ID      string
+		Service string
+		Tags    []string
+		Address string
+		Port    int
+		Meta    map[string]string
+	}
+}
+
+func newConsulSource(cfg *conf.C) (Source, error) {
+	config := consulConfig{
+		Address:     "http://127.0.0.1:8500",
+		PassingOnly: true,
+		Transport:   httpcommon.DefaultHTTPTransportSettings(),
+	}
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+	config.Address = strings.TrimSuffix(config.Address, "/")
+
+	client, err := newHTTPClient(config.Transport)
+	if err != nil {
+		return nil, err
+	}
+
+	return &consulSource{config: config, client: client}, nil
+}
+
+func (s *consulSource) String() string {
+	return "consul[" + s.config.Address + "]"
+}
+
+func (s *consulSource) Fetch(ctx context.Context) ([]*conf.C, error) {
+	services, err := s.services(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []bus.Event
+	for _, service := range services {
+		var entries []consulHealthEntry
+		if err := s.get(ctx, "/v1/health/service/"+url.PathEscape(service), s.healthQuery(), &entries); err != nil {
+			return nil, fmt.Errorf("error listing instances of service %s: %w", service, err)
+		}
+		for _, entry := range entries {
+			events = append(events, consulEvent(entry))
+		}
+	}
+	return applyTemplates(events, s.config.Templates), nil
+}
+
+// services returns the names of the services to monitor, which are the
+// configured ones or else all the services in the catalog, in both cases
+// filtered by the configured tags.
+func (s *consulSource) services(ctx context.Context) ([]string, error) {
+	var catalog map[string][]string
+	if err := s.get(ctx, "/v1/catalog/services", s.query(), &catalog); err != nil {
+		return nil, fmt.Errorf("error listing services: %w", err)
+	}
+
+	var services []string
+	for name, tags := range catalog {
+		if len(s.config.Services) > 0 && !contains(s.config.Services, name) {
+			continue
+		}
+		if !containsAll(tags, s.config.Tags) {
+			continue
+		}
+		services = append(services, name)
+	}
+	sort.Strings(services)
+	return services, nil
+}
+
+func (s *consulSource) query() url.Values {
+	query := url.Values{}
+	if s.config.Datacenter != "" {
+		query.Set("dc", s.config.Datacenter)
+	}
+	return query
+}
+
+func (s *consulSource) healthQuery() url.Values {
+	query := s.query()
+	if s.config.PassingOnly {
+		query.Set("passing", "true")
+	}
+	for _, tag := range s.config.Tags {
+		query.Add("tag", tag)
+	}
+	return query
+}
+
+func (s *consulSource) get(ctx context.Context, path string, query url.Values, result interface{}) error {
+	u := s.config.Address + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var headers map[string]string
+	if s.config.Token != "" {
+		headers = map[string]string{"X-Consul-Token": s.config.Token}
+	}
+
+	body, err := httpGet(ctx, s.client, u, headers)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, result)
+}
+
+func consulEvent(entry consulHealthEntry) bus.Event {
+	host := entry.Service.Address
+	if host == "" {
+		host = entry.Node.Address
+	}
+
+	meta := mapstr.M{}
+	for k, v := range entry.Service.Meta {
+		meta[k] = v
+	}
+
+	return bus.Event{
+		"host": host,
+		"port": entry.Service.Port,
+		"consul": mapstr.M{
+			"datacenter": entry.Node.Datacenter,
+			"node": mapstr.M{
+				"name":    entry.Node.Node,
+				"address": entry.Node.Address,
+			},
+			"service": mapstr.M{
+				"id":   entry.Service.ID,
+				"name": entry.Service.Service,
+				"tags": entry.Service.Tags,
+				"meta": meta,
+			},
+		},
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAll(list []string, items []string) bool {
+	for _, item := range items {
+		if !contains(list, item) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestConsulSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Consul-Token") != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		assert.Equal(t, "dc1", r.URL.Query().Get("dc"))
+
+		switch r.URL.Path {
+		case "/v1/catalog/services":
+			_, _ = w.Write([]byte(`{"consul": [], "web": ["public", "v2"], "db": ["internal"]}`))
+		case "/v1/health/service/web":
+			assert.Equal(t, "true", r.URL.Query().Get("passing"))
+			assert.Equal(t, []string{"public"}, r.URL.Query()["tag"])
+			_, _ = w.Write([]byte(`[
+  {
+    "Node": {"Node": "node-1", "Address": "10.0.0.1", "Datacenter": "dc1"},
+    "Service": {"ID": "web-1", "Service": "web", "Tags": ["public", "v2"], "Address": "10.0.1.1", "Port": 8080, "Meta": {"health_path": "/healthz"}}
+  },
+  {
+    "Node": {"Node": "node-2", "Address": "10.0.0.2", "Datacenter": "dc1"},
+    "Service": {"ID": "web-2", "Service": "web", "Tags": ["public"], "Address": "", "Port": 8081}
+  }
+]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	source, err := newConsulSource(conf.MustNewConfigFrom(map[string]interface{}{
+		"address":    server.URL + "/",
+		"token":      "secret",
+		"datacenter": "dc1",
+		"tags":       []string{"public"},
+		"templates": []map[string]interface{}{
+			{
+				"type":     "http",
+				"id":       "${data.consul.service.id}",
+				"urls":     []string{"http://${data.host}:${data.port}"},
+				"schedule": "@every 10s",
+			},
+		},
+	}))
+	require.NoError(t, err)
+
+	monitors, err := source.Fetch(context.Background())
+	require.NoError(t, err)
+	require.Len(t, monitors, 2)
+
+	expected := []struct {
+		id  string
+		url string
+	}{
+		{id: "web-1", url: "http://10.0.1.1:8080"},
+		{id: "web-2", url: "http://10.0.0.2:8081"},
+	}
+	for i, monitor := range monitors {
+		config := struct {
+			ID   string   `config:"id"`
+			URLs []string `config:"urls"`
+		}{}
+		require.NoError(t, monitor.Unpack(&config))
+		assert.Equal(t, expected[i].id, config.ID)
+		assert.Equal(t, []string{expected[i].url}, config.URLs)
+	}
+}
+
+func TestConsulEvent(t *testing.T) {
+	entry := consulHealthEntry{}
+	entry.Node.Node = "node-1"
+	entry.Node.Address = "10.0.0.1"
+	entry.Node.Datacenter = "dc1"
+	entry.Service.ID = "web-1"
+	entry.Service.Service = "web"
+	entry.Service.Port = 8080
+	entry.Service.Meta = map[string]string{"team": "frontend"}
+
+	event := consulEvent(entry)
+	assert.Equal(t, "10.0.0.1", event["host"])
+	assert.Equal(t, 8080, event["port"])
+
+	team, err := event["consul"].(mapstr.M).GetValue("service.meta.team")
+	require.NoError(t, err)
+	assert.Equal(t, "frontend", team)
+}
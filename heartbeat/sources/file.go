@@ -0,0 +1,51 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sources
+
+import (
+	"context"
+
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	conf "github.com/elastic/elastic-agent-libs/config"
+)
+
+type fileConfig struct {
+	Path string `config:"path" validate:"required"`
+}
+
+// fileSource loads the monitors from a YAML file containing a list of
+// monitor definitions, which is read again on every refresh.
+type fileSource struct {
+	path string
+}
+
+func newFileSource(cfg *conf.C) (Source, error) {
+	config := fileConfig{}
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+	return &fileSource{path: config.Path}, nil
+}
+
+func (s *fileSource) String() string {
+	return "file[" + s.path + "]"
+}
+
+func (s *fileSource) Fetch(_ context.Context) ([]*conf.C, error) {
+	return cfgfile.LoadList(s.path)
+}
@@ -0,0 +1,57 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sources
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	conf "github.com/elastic/elastic-agent-libs/config"
+)
+
+func TestFileSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "monitors.yml")
+	source, err := newFileSource(conf.MustNewConfigFrom(map[string]interface{}{
+		"path": path,
+	}))
+	require.NoError(t, err)
+
+	_, err = source.Fetch(context.Background())
+	assert.Error(t, err, "file doesn't exist yet")
+
+	err = os.WriteFile(path, []byte(`
+- type: icmp
+  id: a
+  hosts: ["a.example.com"]
+  schedule: "@every 10s"
+`), 0600)
+	require.NoError(t, err)
+
+	monitors, err := source.Fetch(context.Background())
+	require.NoError(t, err)
+	require.Len(t, monitors, 1)
+
+	id, err := monitors[0].String("id", -1)
+	require.NoError(t, err)
+	assert.Equal(t, "a", id)
+}
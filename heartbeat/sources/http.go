@@ -0,0 +1,115 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sources
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/elastic/beats/v7/libbeat/version"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/transport/httpcommon"
+	"github.com/elastic/elastic-agent-libs/useragent"
+)
+
+var userAgent = useragent.UserAgent("Heartbeat", version.GetDefaultVersion(), version.Commit(), version.BuildTime().String())
+
+type httpConfig struct {
+	URL       string                           `config:"url" validate:"required"`
+	Headers   map[string]string                `config:"headers"`
+	Transport httpcommon.HTTPTransportSettings `config:",inline"`
+}
+
+// httpSource loads the monitors from a YAML or JSON document served over
+// HTTP, which must contain a list of monitor definitions.
+type httpSource struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newHTTPSource(cfg *conf.C) (Source, error) {
+	config := httpConfig{Transport: httpcommon.DefaultHTTPTransportSettings()}
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	client, err := newHTTPClient(config.Transport)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpSource{
+		url:     config.URL,
+		headers: config.Headers,
+		client:  client,
+	}, nil
+}
+
+func (s *httpSource) String() string {
+	return "http[" + s.url + "]"
+}
+
+func (s *httpSource) Fetch(ctx context.Context) ([]*conf.C, error) {
+	body, err := httpGet(ctx, s.client, s.url, s.headers)
+	if err != nil {
+		return nil, err
+	}
+
+	rawConfig, err := conf.NewConfigWithYAML(body, s.url)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing monitors from %s: %w", s.url, err)
+	}
+	var monitors []*conf.C
+	if err := rawConfig.Unpack(&monitors); err != nil {
+		return nil, fmt.Errorf("error reading monitors from %s: %w", s.url, err)
+	}
+	return monitors, nil
+}
+
+func newHTTPClient(settings httpcommon.HTTPTransportSettings) (*http.Client, error) {
+	return settings.Client(
+		httpcommon.WithAPMHTTPInstrumentation(),
+		httpcommon.WithHeaderRoundTripper(map[string]string{"User-Agent": userAgent}),
+	)
+}
+
+// httpGet returns the body of the response to a GET request, failing on any
+// non-2xx response.
+func httpGet(ctx context.Context, client *http.Client, url string, headers map[string]string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+	return io.ReadAll(resp.Body)
+}
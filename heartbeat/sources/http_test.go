@@ -0,0 +1,112 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	conf "github.com/elastic/elastic-agent-libs/config"
+)
+
+func TestHTTPSource(t *testing.T) {
+	tests := map[string]struct {
+		contentType string
+		body        string
+	}{
+		"yaml": {
+			contentType: "application/yaml",
+			body: `
+- type: http
+  id: a
+  urls: ["http://a.example.com"]
+  schedule: "@every 10s"
+- type: tcp
+  id: b
+  hosts: ["b.example.com:443"]
+  schedule: "@every 10s"
+`,
+		},
+		"json": {
+			contentType: "application/json",
+			body: `[
+  {"type": "http", "id": "a", "urls": ["http://a.example.com"], "schedule": "@every 10s"},
+  {"type": "tcp", "id": "b", "hosts": ["b.example.com:443"], "schedule": "@every 10s"}
+]`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer secret" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.Header().Set("Content-Type", test.contentType)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			source, err := newHTTPSource(conf.MustNewConfigFrom(map[string]interface{}{
+				"url":     server.URL,
+				"headers": map[string]interface{}{"Authorization": "Bearer secret"},
+			}))
+			require.NoError(t, err)
+
+			monitors, err := source.Fetch(context.Background())
+			require.NoError(t, err)
+			require.Len(t, monitors, 2)
+
+			var ids []string
+			for _, monitor := range monitors {
+				id, err := monitor.String("id", -1)
+				require.NoError(t, err)
+				ids = append(ids, id)
+			}
+			assert.Equal(t, []string{"a", "b"}, ids)
+		})
+	}
+}
+
+func TestHTTPSourceErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/invalid":
+			_, _ = w.Write([]byte(`- [unclosed`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	for _, path := range []string{"/invalid", "/error"} {
+		source, err := newHTTPSource(conf.MustNewConfigFrom(map[string]interface{}{
+			"url": server.URL + path,
+		}))
+		require.NoError(t, err)
+
+		_, err = source.Fetch(context.Background())
+		assert.Error(t, err, path)
+	}
+}
@@ -0,0 +1,238 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	k8s "k8s.io/client-go/kubernetes"
+
+	"github.com/elastic/elastic-agent-autodiscover/bus"
+	"github.com/elastic/elastic-agent-autodiscover/kubernetes"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const (
+	resourceIngress   = "ingress"
+	resourceHTTPRoute = "httproute"
+)
+
+var httpRouteResource = schema.GroupVersionResource{
+	Group:    "gateway.networking.k8s.io",
+	Version:  "v1beta1",
+	Resource: "httproutes",
+}
+
+type kubernetesConfig struct {
+	KubeConfig        string                       `config:"kube_config"`
+	KubeClientOptions kubernetes.KubeClientOptions `config:"kube_client_options"`
+	Namespace         string                       `config:"namespace"`
+	LabelSelector     string                       `config:"label_selector"`
+	Resources         []string                     `config:"resources"`
+	Templates         []*conf.C                    `config:"templates" validate:"required"`
+}
+
+func (c *kubernetesConfig) Validate() error {
+	for _, resource := range c.Resources {
+		if resource != resourceIngress && resource != resourceHTTPRoute {
+			return fmt.Errorf("unsupported resource '%s', must be one of %s or %s", resource, resourceIngress, resourceHTTPRoute)
+		}
+	}
+	return nil
+}
+
+// kubernetesSource creates monitors for the hosts exposed by Ingress and
+// Gateway API HTTPRoute objects, by applying its templates to each host and
+// path.
+type kubernetesSource struct {
+	config  kubernetesConfig
+	client  k8s.Interface
+	dynamic dynamic.Interface
+}
+
+func newKubernetesSource(cfg *conf.C) (Source, error) {
+	config := kubernetesConfig{
+		Resources: []string{resourceIngress},
+	}
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	client, err := kubernetes.GetKubernetesClient(config.KubeConfig, config.KubeClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var dynamicClient dynamic.Interface
+	if contains(config.Resources, resourceHTTPRoute) {
+		kubeConfig := config.KubeConfig
+		if kubeConfig == "" {
+			kubeConfig = kubernetes.GetKubeConfigEnvironmentVariable()
+		}
+		restConfig, err := kubernetes.BuildConfig(kubeConfig)
+		if err != nil {
+			return nil, err
+		}
+		dynamicClient, err = dynamic.NewForConfig(restConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &kubernetesSource{config: config, client: client, dynamic: dynamicClient}, nil
+}
+
+func (s *kubernetesSource) String() string {
+	namespace := s.config.Namespace
+	if namespace == "" {
+		namespace = "*"
+	}
+	return "kubernetes[" + namespace + "]"
+}
+
+func (s *kubernetesSource) Fetch(ctx context.Context) ([]*conf.C, error) {
+	options := metav1.ListOptions{LabelSelector: s.config.LabelSelector}
+
+	var events []bus.Event
+	for _, resource := range s.config.Resources {
+		switch resource {
+		case resourceIngress:
+			ingresses, err := s.client.NetworkingV1().Ingresses(s.config.Namespace).List(ctx, options)
+			if err != nil {
+				return nil, fmt.Errorf("error listing ingresses: %w", err)
+			}
+			for i := range ingresses.Items {
+				events = append(events, ingressEvents(&ingresses.Items[i])...)
+			}
+		case resourceHTTPRoute:
+			routes, err := s.dynamic.Resource(httpRouteResource).Namespace(s.config.Namespace).List(ctx, options)
+			if err != nil {
+				return nil, fmt.Errorf("error listing HTTP routes: %w", err)
+			}
+			for i := range routes.Items {
+				events = append(events, httpRouteEvents(&routes.Items[i])...)
+			}
+		}
+	}
+	return applyTemplates(events, s.config.Templates), nil
+}
+
+// ingressEvents returns an event for each host and path of the ingress
+// rules. Rules without a host are ignored, as they don't expose an address
+// that can be monitored.
+func ingressEvents(ingress *networkingv1.Ingress) []bus.Event {
+	tlsHosts := map[string]bool{}
+	for _, tls := range ingress.Spec.TLS {
+		for _, host := range tls.Hosts {
+			tlsHosts[host] = true
+		}
+	}
+
+	var events []bus.Event
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host == "" {
+			continue
+		}
+		paths := []string{"/"}
+		if rule.HTTP != nil && len(rule.HTTP.Paths) > 0 {
+			paths = paths[:0]
+			for _, path := range rule.HTTP.Paths {
+				paths = append(paths, path.Path)
+			}
+		}
+		for _, path := range paths {
+			events = append(events, kubernetesEvent(rule.Host, path, tlsHosts[rule.Host], mapstr.M{
+				"namespace": ingress.Namespace,
+				"ingress":   mapstr.M{"name": ingress.Name},
+			}))
+		}
+	}
+	return events
+}
+
+// httpRouteEvents returns an event for each hostname and path prefix or
+// exact path matched by the route. HTTP routes don't know if their parent
+// gateway terminates TLS, so their URLs use https.
+func httpRouteEvents(route *unstructured.Unstructured) []bus.Event {
+	hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+
+	var paths []string
+	for _, rule := range rules {
+		rule, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		matches, _, _ := unstructured.NestedSlice(rule, "matches")
+		for _, match := range matches {
+			match, ok := match.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			matchType, _, _ := unstructured.NestedString(match, "path", "type")
+			if matchType == "RegularExpression" {
+				continue
+			}
+			if value, found, _ := unstructured.NestedString(match, "path", "value"); found {
+				paths = append(paths, value)
+			}
+		}
+	}
+	if len(paths) == 0 {
+		paths = []string{"/"}
+	}
+
+	var events []bus.Event
+	for _, hostname := range hostnames {
+		// Wildcard hostnames can't be requested.
+		if strings.HasPrefix(hostname, "*") {
+			continue
+		}
+		for _, path := range paths {
+			events = append(events, kubernetesEvent(hostname, path, true, mapstr.M{
+				"namespace": route.GetNamespace(),
+				"httproute": mapstr.M{"name": route.GetName()},
+			}))
+		}
+	}
+	return events
+}
+
+func kubernetesEvent(host, path string, tls bool, meta mapstr.M) bus.Event {
+	scheme := "http"
+	if tls {
+		scheme = "https"
+	}
+	if path == "" {
+		path = "/"
+	}
+	return bus.Event{
+		"host":       host,
+		"path":       path,
+		"url":        scheme + "://" + host + path,
+		"kubernetes": meta,
+	}
+}
@@ -0,0 +1,133 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	conf "github.com/elastic/elastic-agent-libs/config"
+)
+
+func TestKubernetesSource(t *testing.T) {
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shop",
+			Namespace: "default",
+			Labels:    map[string]string{"monitor": "true"},
+		},
+		Spec: networkingv1.IngressSpec{
+			TLS: []networkingv1.IngressTLS{{Hosts: []string{"shop.example.com"}}},
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "shop.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{{Path: "/"}, {Path: "/api"}},
+						},
+					},
+				},
+				{Host: "admin.example.com"},
+				// Rules without host are ignored.
+				{},
+			},
+		},
+	}
+	ignored := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal", Namespace: "default"},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{Host: "internal.example.com"}},
+		},
+	}
+
+	route := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "gateway.networking.k8s.io/v1beta1",
+		"kind":       "HTTPRoute",
+		"metadata": map[string]interface{}{
+			"name":      "blog",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"monitor": "true"},
+		},
+		"spec": map[string]interface{}{
+			"hostnames": []interface{}{"blog.example.com", "*.example.com"},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"matches": []interface{}{
+						map[string]interface{}{"path": map[string]interface{}{"type": "PathPrefix", "value": "/posts"}},
+						map[string]interface{}{"path": map[string]interface{}{"type": "RegularExpression", "value": "/p/[0-9]+"}},
+					},
+				},
+			},
+		},
+	}}
+
+	source := &kubernetesSource{
+		config: kubernetesConfig{
+			LabelSelector: "monitor=true",
+			Resources:     []string{resourceIngress, resourceHTTPRoute},
+			Templates: []*conf.C{conf.MustNewConfigFrom(map[string]interface{}{
+				"type":     "http",
+				"urls":     []string{"${data.url}"},
+				"schedule": "@every 10s",
+			})},
+		},
+		client: k8sfake.NewSimpleClientset(ingress, ignored),
+		dynamic: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+			runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{httpRouteResource: "HTTPRouteList"},
+			route,
+		),
+	}
+
+	monitors, err := source.Fetch(context.Background())
+	require.NoError(t, err)
+
+	var urls []string
+	for _, monitor := range monitors {
+		config := struct {
+			URLs []string `config:"urls"`
+		}{}
+		require.NoError(t, monitor.Unpack(&config))
+		urls = append(urls, config.URLs...)
+	}
+	assert.Equal(t, []string{
+		"https://shop.example.com/",
+		"https://shop.example.com/api",
+		"http://admin.example.com/",
+		"https://blog.example.com/posts",
+	}, urls)
+}
+
+func TestKubernetesConfigValidate(t *testing.T) {
+	config := kubernetesConfig{}
+	err := conf.MustNewConfigFrom(map[string]interface{}{
+		"resources": []string{"service"},
+		"templates": []map[string]interface{}{{"type": "http"}},
+	}).Unpack(&config)
+	assert.Error(t, err)
+}
@@ -0,0 +1,171 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package sources implements dynamic monitor sources, which periodically
+// load the monitor definitions from an external inventory, such as an HTTP
+// endpoint, a file, the Consul service catalog or Kubernetes Ingress and
+// Gateway objects.
+package sources
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/beats/v7/libbeat/common/reload"
+	"github.com/elastic/elastic-agent-autodiscover/bus"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// Source loads the current list of monitor definitions from an inventory.
+type Source interface {
+	Fetch(ctx context.Context) ([]*conf.C, error)
+	String() string
+}
+
+// Factory creates a Source from its configuration.
+type Factory func(cfg *conf.C) (Source, error)
+
+var registry = map[string]Factory{
+	"consul":     newConsulSource,
+	"file":       newFileSource,
+	"http":       newHTTPSource,
+	"kubernetes": newKubernetesSource,
+}
+
+// Config contains the settings common to all monitor sources.
+type Config struct {
+	Type    string        `config:"type" validate:"required"`
+	Refresh time.Duration `config:"refresh" validate:"positive,nonzero"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		Refresh: 5 * time.Minute,
+	}
+}
+
+// Runner keeps the monitors of a source up to date, starting the monitors
+// added to the inventory and stopping the removed ones after each refresh.
+type Runner struct {
+	source  Source
+	refresh time.Duration
+	list    *cfgfile.RunnerList
+	log     *logp.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRunner creates a Runner for the monitor source configured in cfg. The
+// monitors are created with the given factory.
+func NewRunner(cfg *conf.C, factory cfgfile.RunnerFactory, pipeline beat.PipelineConnector) (*Runner, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	newSource, found := registry[config.Type]
+	if !found {
+		return nil, fmt.Errorf("unknown monitor source type '%s'", config.Type)
+	}
+	source, err := newSource(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s monitor source: %w", config.Type, err)
+	}
+
+	return newRunner(source, config.Refresh, cfgfile.NewRunnerList("monitor_sources", factory, pipeline)), nil
+}
+
+func newRunner(source Source, refresh time.Duration, list *cfgfile.RunnerList) *Runner {
+	return &Runner{
+		source:  source,
+		refresh: refresh,
+		list:    list,
+		log:     logp.NewLogger("monitor_sources").With("source", source.String()),
+	}
+}
+
+// Start loads the monitors of the source and refreshes them periodically
+// until the runner is stopped.
+func (r *Runner) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.refresh)
+		defer ticker.Stop()
+		for {
+			r.reload(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops refreshing the source and stops all its monitors.
+func (r *Runner) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	r.list.Stop()
+}
+
+// reload replaces the running monitors with the ones currently defined by
+// the source. The running monitors are kept when the source cannot be read,
+// so that an outage of the inventory doesn't stop the monitoring.
+func (r *Runner) reload(ctx context.Context) {
+	monitors, err := r.source.Fetch(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.log.Errorf("Error loading monitors, keeping the current ones: %v", err)
+		}
+		return
+	}
+
+	configs := make([]*reload.ConfigWithMeta, 0, len(monitors))
+	for _, monitor := range monitors {
+		configs = append(configs, &reload.ConfigWithMeta{Config: monitor})
+	}
+	r.log.Debugf("Loaded %d monitors", len(configs))
+	if err := r.list.Reload(configs); err != nil {
+		r.log.Errorf("Error reloading monitors: %v", err)
+	}
+}
+
+// applyTemplates creates the monitors of the discovered endpoints from the
+// templates of a source. Each event is made available to the templates under
+// the `data` key, as done by autodiscover.
+func applyTemplates(events []bus.Event, templates []*conf.C) []*conf.C {
+	var monitors []*conf.C
+	for _, event := range events {
+		monitors = append(monitors, template.ApplyConfigTemplate(event, templates)...)
+	}
+	return monitors
+}
@@ -0,0 +1,163 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sources
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	pubtest "github.com/elastic/beats/v7/libbeat/publisher/testing"
+	conf "github.com/elastic/elastic-agent-libs/config"
+)
+
+type fakeSource struct {
+	monitors []*conf.C
+	err      error
+}
+
+func (s *fakeSource) Fetch(_ context.Context) ([]*conf.C, error) {
+	return s.monitors, s.err
+}
+
+func (s *fakeSource) String() string {
+	return "fake"
+}
+
+type fakeMonitor struct {
+	name    string
+	factory *fakeFactory
+}
+
+func (m *fakeMonitor) String() string {
+	return m.name
+}
+
+func (m *fakeMonitor) Start() {
+	m.factory.mu.Lock()
+	defer m.factory.mu.Unlock()
+	m.factory.running[m.name] = true
+}
+
+func (m *fakeMonitor) Stop() {
+	m.factory.mu.Lock()
+	defer m.factory.mu.Unlock()
+	delete(m.factory.running, m.name)
+}
+
+type fakeFactory struct {
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+func (f *fakeFactory) Create(_ beat.PipelineConnector, c *conf.C) (cfgfile.Runner, error) {
+	name, err := c.String("name", -1)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeMonitor{name: name, factory: f}, nil
+}
+
+func (f *fakeFactory) CheckConfig(_ *conf.C) error {
+	return nil
+}
+
+func (f *fakeFactory) runningMonitors() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var names []string
+	for name := range f.running {
+		names = append(names, name)
+	}
+	return names
+}
+
+func monitorConfigs(names ...string) []*conf.C {
+	var configs []*conf.C
+	for _, name := range names {
+		configs = append(configs, conf.MustNewConfigFrom(map[string]interface{}{"name": name}))
+	}
+	return configs
+}
+
+func TestRunnerReload(t *testing.T) {
+	factory := &fakeFactory{running: map[string]bool{}}
+	source := &fakeSource{monitors: monitorConfigs("a", "b")}
+	runner := newRunner(source, time.Hour, cfgfile.NewRunnerList("test", factory, &pubtest.FakeConnector{}))
+
+	runner.reload(context.Background())
+	assert.ElementsMatch(t, []string{"a", "b"}, factory.runningMonitors())
+
+	source.monitors = monitorConfigs("b", "c")
+	runner.reload(context.Background())
+	assert.ElementsMatch(t, []string{"b", "c"}, factory.runningMonitors())
+
+	// Monitors are kept when the source fails.
+	source.monitors, source.err = nil, errors.New("unavailable")
+	runner.reload(context.Background())
+	assert.ElementsMatch(t, []string{"b", "c"}, factory.runningMonitors())
+
+	// An empty inventory stops all the monitors.
+	source.err = nil
+	runner.reload(context.Background())
+	assert.Empty(t, factory.runningMonitors())
+}
+
+func TestRunnerStartStop(t *testing.T) {
+	factory := &fakeFactory{running: map[string]bool{}}
+	source := &fakeSource{monitors: monitorConfigs("a")}
+	runner := newRunner(source, time.Hour, cfgfile.NewRunnerList("test", factory, &pubtest.FakeConnector{}))
+
+	runner.Start()
+	require.Eventually(t, func() bool {
+		return len(factory.runningMonitors()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	runner.Stop()
+	assert.Empty(t, factory.runningMonitors())
+}
+
+func TestNewRunnerConfig(t *testing.T) {
+	factory := &fakeFactory{running: map[string]bool{}}
+
+	_, err := NewRunner(conf.MustNewConfigFrom(map[string]interface{}{
+		"type": "unknown",
+	}), factory, &pubtest.FakeConnector{})
+	assert.Error(t, err)
+
+	_, err = NewRunner(conf.MustNewConfigFrom(map[string]interface{}{
+		"type": "file",
+	}), factory, &pubtest.FakeConnector{})
+	assert.Error(t, err, "path is required")
+
+	runner, err := NewRunner(conf.MustNewConfigFrom(map[string]interface{}{
+		"type":    "file",
+		"path":    "monitors.yml",
+		"refresh": "1m",
+	}), factory, &pubtest.FakeConnector{})
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, runner.refresh)
+	assert.Equal(t, "file[monitors.yml]", runner.source.String())
+}
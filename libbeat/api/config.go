@@ -17,15 +17,36 @@
 
 package api
 
-import "os"
+import (
+	"os"
+
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
 
 // Config is the configuration for the API endpoint.
 type Config struct {
-	Enabled            bool   `config:"enabled"`
-	Host               string `config:"host"`
-	Port               int    `config:"port"`
-	User               string `config:"named_pipe.user"`
-	SecurityDescriptor string `config:"named_pipe.security_descriptor"`
+	Enabled            bool                    `config:"enabled"`
+	Host               string                  `config:"host"`
+	Port               int                     `config:"port"`
+	User               string                  `config:"named_pipe.user"`
+	SecurityDescriptor string                  `config:"named_pipe.security_descriptor"`
+	Auth               AuthConfig              `config:"auth"`
+	TLS                *tlscommon.ServerConfig `config:"ssl"`
+	Stats              EndpointConfig          `config:"stats"`
+	Inputs             EndpointConfig          `config:"inputs"`
+	Config             EndpointConfig          `config:"config"`
+}
+
+// AuthConfig configures the authentication required by the API endpoint.
+type AuthConfig struct {
+	// Token is a shared secret that clients must send as a bearer token in
+	// the Authorization header.
+	Token string `config:"token"`
+}
+
+// EndpointConfig enables or disables a group of routes of the API endpoint.
+type EndpointConfig struct {
+	Enabled bool `config:"enabled"`
 }
 
 // DefaultConfig is the default configuration used by the API endpoint.
@@ -33,6 +54,8 @@ var DefaultConfig = Config{
 	Enabled: false,
 	Host:    "localhost",
 	Port:    5066,
+	Stats:   EndpointConfig{Enabled: true},
+	Inputs:  EndpointConfig{Enabled: true},
 }
 
 // File mode for the socket file, owner of the process can do everything, member of the group can read.
@@ -39,6 +39,10 @@ func NewWithDefaultRoutes(log *logp.Logger, config *config.C, ns lookupFunc) (*S
 		return nil, err
 	}
 
+	if !api.config.Stats.Enabled {
+		return api, nil
+	}
+
 	err = multierr.Combine(
 		api.AttachHandler("/", makeRootAPIHandler(makeAPIHandler(ns("info")))),
 		api.AttachHandler("/state", makeAPIHandler(ns("state"))),
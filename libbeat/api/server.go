@@ -18,6 +18,8 @@
 package api
 
 import (
+	"crypto/subtle"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -28,6 +30,7 @@ import (
 
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
 )
 
 // Server takes care of correctly starting the HTTP component of the API
@@ -51,16 +54,31 @@ func New(log *logp.Logger, config *config.C) (*Server, error) {
 		return nil, err
 	}
 
+	tlsConfig, err := tlscommon.LoadTLSServerConfig(cfg.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS configuration: %w", err)
+	}
+
 	l, err := makeListener(cfg)
 	if err != nil {
 		return nil, err
 	}
+	if tlsConfig != nil {
+		l = tls.NewListener(l, tlsConfig.BuildServerConfig(cfg.Host))
+	}
+
+	log = log.Named("api")
+	if cfg.Auth.Token != "" && tlsConfig == nil {
+		if network, _, err := parse(cfg.Host, cfg.Port); err == nil && network == "tcp" {
+			log.Warn("HTTP endpoint token authentication is enabled without TLS, the token is sent in clear text")
+		}
+	}
 
 	return &Server{
 		mux:    mux.NewRouter().StrictSlash(true),
 		l:      l,
 		config: cfg,
-		log:    log.Named("api"),
+		log:    log,
 	}, nil
 }
 
@@ -69,7 +87,7 @@ func (s *Server) Start() {
 	s.log.Info("Starting stats endpoint")
 	go func(l net.Listener) {
 		s.log.Infof("Metrics endpoint listening on: %s (configured: %s)", l.Addr().String(), s.config.Host)
-		err := http.Serve(l, s.mux)
+		err := http.Serve(l, s.handler())
 		s.log.Infof("Stats endpoint (%s) finished: %v", l.Addr().String(), err)
 	}(s.l)
 }
@@ -94,6 +112,35 @@ func (s *Server) Router() *mux.Router {
 	return s.mux
 }
 
+// InputsEnabled returns true if the /inputs routes are enabled.
+func (s *Server) InputsEnabled() bool {
+	return s.config.Inputs.Enabled
+}
+
+// ConfigEnabled returns true if the /config routes are enabled.
+func (s *Server) ConfigEnabled() bool {
+	return s.config.Config.Enabled
+}
+
+// handler returns the handler serving all requests, requiring the configured
+// token if any.
+func (s *Server) handler() http.Handler {
+	if s.config.Auth.Token == "" {
+		return s.mux
+	}
+
+	expected := []byte("Bearer " + s.config.Auth.Token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(auth, expected) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		s.mux.ServeHTTP(w, r)
+	})
+}
+
 func parse(host string, port int) (string, string, error) {
 	url, err := url.Parse(host)
 	if err != nil {
@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/monitoring"
 )
 
 func TestConfiguration(t *testing.T) {
@@ -185,6 +186,103 @@ func TestHTTP(t *testing.T) {
 	assert.Equal(t, "ehlo!", string(body))
 }
 
+func TestHTTPToken(t *testing.T) {
+	cfg := config.MustNewConfigFrom(map[string]interface{}{
+		"host":       "http://localhost:0",
+		"auth.token": "secret",
+	})
+
+	s, err := New(nil, cfg)
+	require.NoError(t, err)
+	attachEchoHelloHandler(t, s)
+	go s.Start()
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	tests := map[string]struct {
+		header string
+		status int
+	}{
+		"no token":    {status: http.StatusUnauthorized},
+		"wrong token": {header: "Bearer wrong", status: http.StatusUnauthorized},
+		"no scheme":   {header: "secret", status: http.StatusUnauthorized},
+		"valid token": {header: "Bearer secret", status: http.StatusOK},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "http://"+s.l.Addr().String()+"/echo-hello", nil)
+			require.NoError(t, err)
+			if test.header != "" {
+				req.Header.Set("Authorization", test.header)
+			}
+
+			r, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer r.Body.Close()
+
+			assert.Equal(t, test.status, r.StatusCode)
+		})
+	}
+}
+
+func TestDefaultRoutesEndpoints(t *testing.T) {
+	ns := func(name string) *monitoring.Namespace {
+		return monitoring.GetNamespace("test_api_" + name)
+	}
+
+	tests := map[string]struct {
+		settings map[string]interface{}
+		stats    int
+		inputs   bool
+		config   bool
+	}{
+		"defaults": {
+			stats:  http.StatusOK,
+			inputs: true,
+		},
+		"stats disabled": {
+			settings: map[string]interface{}{"stats.enabled": false},
+			stats:    http.StatusNotFound,
+			inputs:   true,
+		},
+		"inputs disabled": {
+			settings: map[string]interface{}{"inputs.enabled": false},
+			stats:    http.StatusOK,
+			inputs:   false,
+		},
+		"config enabled": {
+			settings: map[string]interface{}{"config.enabled": true},
+			stats:    http.StatusOK,
+			inputs:   true,
+			config:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := config.MustNewConfigFrom(map[string]interface{}{
+				"host": "http://localhost:0",
+			})
+			if test.settings != nil {
+				require.NoError(t, cfg.Merge(test.settings))
+			}
+
+			s, err := NewWithDefaultRoutes(nil, cfg, ns)
+			require.NoError(t, err)
+			defer s.Stop()
+
+			req := httptest.NewRequest(http.MethodGet, "http://"+s.l.Addr().String()+"/stats", nil)
+			resp := httptest.NewRecorder()
+			s.handler().ServeHTTP(resp, req)
+			assert.Equal(t, test.stats, resp.Code)
+			assert.Equal(t, test.inputs, s.InputsEnabled())
+			assert.Equal(t, test.config, s.ConfigEnabled())
+		})
+	}
+}
+
 func attachEchoHelloHandler(t *testing.T, s *Server) {
 	t.Helper()
 
@@ -48,6 +48,11 @@ type Config struct {
 	// Unique identifies if this provider enables its templates only when it is elected as leader in a k8s cluster
 	Unique      bool   `config:"unique"`
 	LeaderLease string `config:"leader_lease"`
+	// Timings of the leader election. The lease is taken over by another
+	// instance when the leader doesn't renew it within the lease duration.
+	LeaderLeaseDuration time.Duration `config:"leader_leaseduration" validate:"positive"`
+	LeaderRenewDeadline time.Duration `config:"leader_renewdeadline" validate:"positive"`
+	LeaderRetryPeriod   time.Duration `config:"leader_retryperiod" validate:"positive"`
 
 	Prefix    string                  `config:"prefix"`
 	Hints     *config.C               `config:"hints"`
@@ -68,6 +73,9 @@ func defaultConfig() *Config {
 		CleanupTimeout:      DefaultCleanupTimeout,
 		Prefix:              "co.elastic",
 		Unique:              false,
+		LeaderLeaseDuration: 15 * time.Second,
+		LeaderRenewDeadline: 10 * time.Second,
+		LeaderRetryPeriod:   2 * time.Second,
 		AddResourceMetadata: metadata.GetDefaultResourceMetadataConfig(),
 	}
 }
@@ -87,6 +95,9 @@ func (c *Config) Validate() error {
 	// Default the scope to "cluster" for everything else.
 	switch c.Resource {
 	case "node", "pod":
+		if c.Scope == "" && c.Unique {
+			c.Scope = "cluster"
+		}
 		if c.Scope == "" {
 			c.Scope = "node"
 		}
@@ -104,6 +115,14 @@ func (c *Config) Validate() error {
 	if c.Unique && c.Scope != "cluster" {
 		logp.L().Warnf("can only set `unique` when scope is `cluster`")
 	}
+	if c.Unique {
+		if c.LeaderLeaseDuration <= c.LeaderRenewDeadline {
+			return fmt.Errorf("`leader_leaseduration` must be greater than `leader_renewdeadline`")
+		}
+		if c.LeaderRenewDeadline <= c.LeaderRetryPeriod {
+			return fmt.Errorf("`leader_renewdeadline` must be greater than `leader_retryperiod`")
+		}
+	}
 
 	return nil
 }
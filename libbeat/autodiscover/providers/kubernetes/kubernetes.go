@@ -23,6 +23,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -87,6 +88,11 @@ type leaderElectionManager struct {
 	leaderElection       leaderelection.LeaderElectionConfig
 	cancelLeaderElection context.CancelFunc
 	logger               *logp.Logger
+
+	// eventID identifies the configs started while holding the lease, so
+	// they are stopped when the lease is lost.
+	mu      sync.Mutex
+	eventID string
 }
 
 // AutodiscoverBuilder builds and returns an autodiscover provider
@@ -290,22 +296,40 @@ func NewLeaderElectionManager(
 			},
 		},
 		ReleaseOnCancel: true,
-		LeaseDuration:   15 * time.Second,
-		RenewDeadline:   10 * time.Second,
-		RetryPeriod:     2 * time.Second,
+		LeaseDuration:   cfg.LeaderLeaseDuration,
+		RenewDeadline:   cfg.LeaderRenewDeadline,
+		RetryPeriod:     cfg.LeaderRetryPeriod,
 		Callbacks: leaderelection.LeaderCallbacks{
 			OnStartedLeading: func(ctx context.Context) {
-				logger.Debugf("leader election lock GAINED, id %v", id)
-				eventID := fmt.Sprintf("%v-%v", metaUID, time.Now().UnixNano())
-				startLeading(uuid.String(), eventID)
+				lem.mu.Lock()
+				defer lem.mu.Unlock()
+				if ctx.Err() != nil {
+					// the lease was lost already
+					return
+				}
+				lem.eventID = fmt.Sprintf("%v-%v", metaUID, time.Now().UnixNano())
+				logger.Debugf("leader election lock GAINED, id %v, event id %v", id, lem.eventID)
+				startLeading(uuid.String(), lem.eventID)
 			},
 			OnStoppedLeading: func() {
-				logger.Debugf("leader election lock LOST, id %v", id)
-				eventID := fmt.Sprintf("%v-%v", metaUID, time.Now().UnixNano())
-				stopLeading(uuid.String(), eventID)
+				// The elector calls this every time it stops, also when the
+				// lease was never acquired.
+				lem.mu.Lock()
+				defer lem.mu.Unlock()
+				if lem.eventID == "" {
+					return
+				}
+				logger.Debugf("leader election lock LOST, id %v, event id %v", id, lem.eventID)
+				stopLeading(uuid.String(), lem.eventID)
+				lem.eventID = ""
 			},
 		},
 	}
+
+	// Check the settings of the leader election early
+	if _, err := leaderelection.NewLeaderElector(lem.leaderElection); err != nil {
+		return nil, fmt.Errorf("invalid leader election settings: %w", err)
+	}
 	return lem, nil
 }
 
@@ -330,7 +354,7 @@ func (p *eventerManager) GenerateHints(event bus.Event) bus.Event {
 func (p *leaderElectionManager) Start() {
 	ctx, cancel := context.WithCancel(context.TODO())
 	p.cancelLeaderElection = cancel
-	p.startLeaderElector(ctx, p.leaderElection)
+	go p.runLeaderElection(ctx)
 }
 
 // Stop signals the stop channel to force the leader election loop routine to stop.
@@ -345,14 +369,19 @@ func (p *leaderElectionManager) GenerateHints(event bus.Event) bus.Event {
 	return event
 }
 
-// startLeaderElector starts a Leader Elector in the background with the provided config
-func (p *leaderElectionManager) startLeaderElector(ctx context.Context, lec leaderelection.LeaderElectionConfig) {
-	le, err := leaderelection.NewLeaderElector(lec)
-	if err != nil {
-		p.logger.Errorf("error while creating Leader Elector: %w", err)
+// runLeaderElection runs a Leader Elector until the manager is stopped. The
+// elector returns when the lease is lost, so it is started again to compete
+// for the lease when the current leader goes away.
+func (p *leaderElectionManager) runLeaderElection(ctx context.Context) {
+	for ctx.Err() == nil {
+		le, err := leaderelection.NewLeaderElector(p.leaderElection)
+		if err != nil {
+			p.logger.Errorf("error while creating Leader Elector: %v", err)
+			return
+		}
+		p.logger.Debugf("Starting Leader Elector")
+		le.Run(ctx)
 	}
-	p.logger.Debugf("Starting Leader Elector")
-	go le.Run(ctx)
 }
 
 func ShouldPut(event mapstr.M, field string, value interface{}, logger *logp.Logger) {
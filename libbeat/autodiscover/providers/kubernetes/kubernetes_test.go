@@ -0,0 +1,103 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux || darwin || windows
+// +build linux darwin windows
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+type leaderEvent struct {
+	start   bool
+	uuid    string
+	eventID string
+}
+
+func TestLeaderElectionFailover(t *testing.T) {
+	client := k8sfake.NewSimpleClientset()
+	cfg := defaultConfig()
+	cfg.LeaderLease = "metricbeat-cluster-leader"
+	cfg.LeaderLeaseDuration = 1 * time.Second
+	cfg.LeaderRenewDeadline = 500 * time.Millisecond
+	cfg.LeaderRetryPeriod = 100 * time.Millisecond
+
+	events := make(chan leaderEvent, 10)
+	newManager := func(node string) (uuid.UUID, EventManager) {
+		id, err := uuid.NewV4()
+		require.NoError(t, err)
+		cfg.Node = node
+		m, err := NewLeaderElectionManager(id, cfg, client,
+			func(uuid, eventID string) { events <- leaderEvent{start: true, uuid: uuid, eventID: eventID} },
+			func(uuid, eventID string) { events <- leaderEvent{start: false, uuid: uuid, eventID: eventID} },
+			logp.NewLogger("test"),
+		)
+		require.NoError(t, err)
+		return id, m
+	}
+
+	firstID, first := newManager("node-1")
+	first.Start()
+	started := waitLeaderEvent(t, events)
+	assert.True(t, started.start)
+	assert.Equal(t, firstID.String(), started.uuid)
+
+	secondID, second := newManager("node-2")
+	second.Start()
+	defer second.Stop()
+
+	// The configs started by the leader are stopped with the same ID.
+	first.Stop()
+	stopped := waitLeaderEvent(t, events)
+	assert.False(t, stopped.start)
+	assert.Equal(t, started, leaderEvent{start: true, uuid: stopped.uuid, eventID: stopped.eventID})
+
+	// The other instance takes over.
+	started = waitLeaderEvent(t, events)
+	assert.True(t, started.start)
+	assert.Equal(t, secondID.String(), started.uuid)
+}
+
+func TestLeaderElectionInvalidSettings(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.LeaderRenewDeadline = cfg.LeaderRetryPeriod
+
+	_, err := NewLeaderElectionManager(uuid.Must(uuid.NewV4()), cfg, k8sfake.NewSimpleClientset(),
+		func(string, string) {}, func(string, string) {}, logp.NewLogger("test"))
+	assert.Error(t, err)
+}
+
+func waitLeaderEvent(t *testing.T, events <-chan leaderEvent) leaderEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for leader election event")
+		return leaderEvent{}
+	}
+}
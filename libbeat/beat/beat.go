@@ -19,6 +19,7 @@ package beat
 
 import (
 	"github.com/elastic/beats/v7/libbeat/api"
+	"github.com/elastic/beats/v7/libbeat/common/dryrun"
 	"github.com/elastic/beats/v7/libbeat/common/reload"
 	"github.com/elastic/beats/v7/libbeat/instrumentation"
 	"github.com/elastic/beats/v7/libbeat/management"
@@ -59,6 +60,11 @@ type Beat struct {
 
 	InSetupCmd bool // this is set to true when the `setup` command is called
 
+	// SetupDryRun is set when the `setup` command only reports the changes it
+	// would apply. Setup callbacks report their changes to it instead of
+	// applying them.
+	SetupDryRun *dryrun.Reporter
+
 	OverwritePipelinesCallback OverwritePipelinesCallback // ingest pipeline loader callback
 	// XXX: remove Config from public interface.
 	//      It's currently used by filebeat modules to setup the Ingest Node
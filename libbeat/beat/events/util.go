@@ -38,6 +38,20 @@ const (
 	// FieldMetaPipeline defines the ingest node pipeline to use for this event.
 	FieldMetaPipeline = "pipeline"
 
+	// FieldMetaTopic defines the destination of the event for outputs publishing to
+	// named topics, lists or channels, like the Kafka topic or the Redis key. If set,
+	// it takes precedence over the topic or key configured in the output.
+	FieldMetaTopic = "topic"
+
+	// FieldMetaPartitionKey defines the key used to partition events, like the Kafka
+	// message key. If set, it takes precedence over the key configured in the output.
+	FieldMetaPartitionKey = "partition_key"
+
+	// FieldMetaIdempotencyKey defines the key identifying the event across the
+	// retried deliveries, set when `idempotency_key.enabled` is true. Outputs
+	// forward it so that downstream systems can drop the duplicates.
+	FieldMetaIdempotencyKey = "idempotency_key"
+
 	// FieldMetaOpType defines the metadata key name for event operation type to use with the Elasticsearch
 	// Bulk API encoding of the event. The key's value can be an empty string, `create`, `index`, or `delete`.
 	// If empty, `create` will be used if FieldMetaID is set; otherwise `index` will be used.
@@ -59,6 +59,11 @@ type ClientConfig struct {
 
 	// Events configures callbacks for common client callbacks
 	Events ClientEventer
+
+	// PublisherPipeline selects the named publisher pipeline the client
+	// connects to, if the Beat has been configured with additional pipelines.
+	// The default pipeline is used if empty.
+	PublisherPipeline string
 }
 
 // ACKer can be registered with a Client when connecting to the pipeline.
@@ -136,9 +136,25 @@ func Read(out interface{}, path string) error {
 // line flag.
 // This function cares about the underlying fleet setting, and if beats is running with
 // the management.enabled flag, Load() will bypass reading a config file, and merely merge any overrides.
+// The sources of the settings of the configuration are recorded, to be
+// reported by Provenance.
 func Load(path string, beatOverrides []ConditionalOverride) (*config.C, error) {
+	c, layers, err := load(path, beatOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	common.PrintConfigDebugf(c, "Complete configuration loaded:")
+	recordLoad(path, beatOverrides, layers)
+	return c, nil
+}
+
+// load reads and merges the configuration, and returns the layers merged
+// into it by order of precedence.
+func load(path string, beatOverrides []ConditionalOverride) (*config.C, []layer, error) {
 	var c *config.C
 	var err error
+	var files []string
 
 	cfgpath := GetPathConfig()
 
@@ -152,51 +168,47 @@ func Load(path string, beatOverrides []ConditionalOverride) (*config.C, error) {
 					list = append(list, cfg)
 				}
 			}
+			files = list
 			c, err = common.LoadFiles(list...)
 		} else {
 			if !filepath.IsAbs(path) {
 				path = filepath.Join(cfgpath, path)
 			}
+			files = []string{path}
 			c, err = common.LoadFile(path)
 		}
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	} else {
 		c = config.NewConfig()
 	}
 
-	if beatOverrides != nil {
-		merged := defaults
-		for _, o := range beatOverrides {
-			if o.Check(c) {
-				merged, err = config.MergeConfigs(merged, o.Config)
-				if err != nil {
-					return nil, err
-				}
+	layers := []layer{{source: SourceDefault, config: defaults}}
+	merged := defaults
+	for _, o := range beatOverrides {
+		if o.Check(c) {
+			merged, err = config.MergeConfigs(merged, o.Config)
+			if err != nil {
+				return nil, nil, err
 			}
+			layers = append(layers, layer{source: SourceDefault, config: o.Config})
 		}
-		c, err = config.MergeConfigs(
-			merged,
-			c,
-			overwrites,
-		)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		c, err = config.MergeConfigs(
-			defaults,
-			c,
-			overwrites,
-		)
-		if err != nil {
-			return nil, err
-		}
 	}
+	for _, f := range files {
+		layers = append(layers, fileLayer(f))
+	}
+	layers = append(layers, layer{source: SourceFlag, config: overwrites})
 
-	common.PrintConfigDebugf(c, "Complete configuration loaded:")
-	return c, nil
+	c, err = config.MergeConfigs(
+		merged,
+		c,
+		overwrites,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, layers, nil
 }
 
 // LoadList loads a list of configs data from the given file.
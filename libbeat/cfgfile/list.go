@@ -26,6 +26,7 @@ import (
 
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/fips"
 	"github.com/elastic/beats/v7/libbeat/common/reload"
 	"github.com/elastic/beats/v7/libbeat/publisher/pipetool"
 	"github.com/elastic/elastic-agent-libs/config"
@@ -34,7 +35,9 @@ import (
 
 // RunnerList implements a reloadable.List of Runners
 type RunnerList struct {
+	name     string
 	runners  map[uint64]Runner
+	configs  map[uint64]*config.C
 	mutex    sync.RWMutex
 	factory  RunnerFactory
 	pipeline beat.PipelineConnector
@@ -43,12 +46,16 @@ type RunnerList struct {
 
 // NewRunnerList builds and returns a RunnerList
 func NewRunnerList(name string, factory RunnerFactory, pipeline beat.PipelineConnector) *RunnerList {
-	return &RunnerList{
+	l := &RunnerList{
+		name:     name,
 		runners:  map[uint64]Runner{},
+		configs:  map[uint64]*config.C{},
 		factory:  factory,
 		pipeline: pipeline,
 		logger:   logp.NewLogger(name),
 	}
+	registerRunnerList(l)
+	return l
 }
 
 // Reload the list of runners to match the given state
@@ -87,6 +94,7 @@ func (r *RunnerList) Reload(configs []*reload.ConfigWithMeta) error {
 		wg.Add(1)
 		r.logger.Debugf("Stopping runner: %s", runner)
 		delete(r.runners, hash)
+		delete(r.configs, hash)
 		go func(runner Runner) {
 			defer wg.Done()
 			runner.Stop()
@@ -114,6 +122,7 @@ func (r *RunnerList) Reload(configs []*reload.ConfigWithMeta) error {
 
 		r.logger.Debugf("Starting runner: %s", runner)
 		r.runners[hash] = runner
+		r.configs[hash] = config.Config
 		runner.Start()
 		moduleStarts.Add(1)
 	}
@@ -143,6 +152,7 @@ func (r *RunnerList) Stop() {
 		wg.Add(1)
 
 		delete(r.runners, hash)
+		delete(r.configs, hash)
 
 		// Stop modules in parallel
 		go func(h uint64, run Runner) {
@@ -164,6 +174,17 @@ func (r *RunnerList) Has(hash uint64) bool {
 	return ok
 }
 
+// runningConfigs returns the configurations of the runners currently started.
+func (r *RunnerList) runningConfigs() []*config.C {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	configs := make([]*config.C, 0, len(r.configs))
+	for _, c := range r.configs {
+		configs = append(configs, c)
+	}
+	return configs
+}
+
 // HashConfig hashes a given config.C
 func HashConfig(c *config.C) (uint64, error) {
 	var config map[string]interface{}
@@ -182,6 +203,9 @@ func (r *RunnerList) copyRunnerList() map[uint64]Runner {
 }
 
 func createRunner(factory RunnerFactory, pipeline beat.PipelineConnector, cfg *reload.ConfigWithMeta) (Runner, error) {
+	if err := fips.Validate(cfg.Config); err != nil {
+		return nil, err
+	}
 	// Pass a copy of the config to the factory, this way if the factory modifies it,
 	// that doesn't affect the hash of the original one.
 	c, _ := config.NewConfigFrom(cfg.Config)
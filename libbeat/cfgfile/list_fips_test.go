@@ -0,0 +1,44 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build requirefips
+
+package cfgfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common/reload"
+)
+
+func TestReloadRejectsNonFIPSConfig(t *testing.T) {
+	factory := &runnerFactory{}
+	list := NewRunnerList("", factory, nil)
+
+	approved := createConfig(1)
+	require.NoError(t, approved.Config.SetString("ssl.supported_protocols", 0, "TLSv1.2"))
+	rejected := createConfig(2)
+	require.NoError(t, rejected.Config.SetString("ssl.supported_protocols", 0, "TLSv1.1"))
+
+	err := list.Reload([]*reload.ConfigWithMeta{approved, rejected})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"TLSv1.1" is not an approved TLS protocol`)
+	assert.Len(t, list.copyRunnerList(), 1, "only the FIPS compliant config should be started")
+}
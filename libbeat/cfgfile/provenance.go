@@ -0,0 +1,378 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cfgfile
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/management"
+	"github.com/elastic/elastic-agent-libs/config"
+)
+
+// Sources of the settings of the running configuration.
+const (
+	// SourceDefault is the source of the settings defaulted by the beat.
+	SourceDefault = "default"
+	// SourceFlag is the source of the settings overwritten with the -E flag.
+	SourceFlag = "flag"
+	// SourceRuntime is the source of the settings not found in any of the
+	// loaded configurations, they were set by the beat after loading them.
+	SourceRuntime = "runtime"
+	// SourceManagement is the source of the configurations received from
+	// central management.
+	SourceManagement = "central_management"
+	// SourceFilePrefix prefixes the path of the configuration files.
+	SourceFilePrefix = "file:"
+)
+
+// layer is a configuration merged into the running configuration.
+type layer struct {
+	source string
+	config *config.C
+}
+
+// fileLayer returns the layer of a configuration file. The file is read
+// again, without resolving its variables, only to know its settings.
+func fileLayer(path string) layer {
+	c, err := common.LoadFile(path)
+	if err != nil {
+		c = config.NewConfig()
+	}
+	return layer{source: SourceFilePrefix + path, config: c}
+}
+
+// lastLoad is the last configuration loaded by Load.
+var lastLoad struct {
+	sync.Mutex
+	path      string
+	overrides []ConditionalOverride
+	layers    []layer
+}
+
+func recordLoad(path string, overrides []ConditionalOverride, layers []layer) {
+	lastLoad.Lock()
+	defer lastLoad.Unlock()
+	lastLoad.path = path
+	lastLoad.overrides = overrides
+	lastLoad.layers = layers
+}
+
+// Setting is a setting of the running configuration.
+type Setting struct {
+	Value  interface{} `json:"value"`
+	Source string      `json:"source"`
+}
+
+// Provenance returns the settings of the running configuration, keyed by
+// their flattened name, with their source. The source of a setting is the
+// configuration with the highest precedence that sets it, among the ones
+// merged by the last call to Load.
+func Provenance(running *config.C) (map[string]Setting, error) {
+	lastLoad.Lock()
+	layers := lastLoad.layers
+	lastLoad.Unlock()
+
+	values, err := flatten(running)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := map[string]string{}
+	for _, l := range layers {
+		for _, key := range l.config.FlattenedKeys() {
+			sources[key] = l.source
+		}
+	}
+
+	settings := make(map[string]Setting, len(values))
+	for key, value := range values {
+		source, found := sources[key]
+		if !found {
+			source = SourceRuntime
+		}
+		settings[key] = Setting{Value: value, Source: source}
+	}
+	return settings, nil
+}
+
+// Change is a difference between the running configuration and the
+// configuration files.
+type Change struct {
+	Setting string      `json:"setting"`
+	Running interface{} `json:"running,omitempty"`
+	OnDisk  interface{} `json:"on_disk,omitempty"`
+}
+
+// Diff loads the configuration again like the last call to Load did, and
+// returns the settings that differ from the running configuration, sorted by
+// name. The settings set by the beat at runtime are ignored.
+func Diff(running *config.C) ([]Change, error) {
+	lastLoad.Lock()
+	path, overrides := lastLoad.path, lastLoad.overrides
+	lastLoad.Unlock()
+
+	onDisk, _, err := load(path, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the configuration: %w", err)
+	}
+
+	runningSettings, err := Provenance(running)
+	if err != nil {
+		return nil, err
+	}
+	onDiskValues, err := flatten(onDisk)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	for key, setting := range runningSettings {
+		if setting.Source == SourceRuntime {
+			continue
+		}
+		value, found := onDiskValues[key]
+		if !found || !reflect.DeepEqual(value, setting.Value) {
+			changes = append(changes, Change{Setting: key, Running: setting.Value, OnDisk: value})
+		}
+	}
+	for key, value := range onDiskValues {
+		if _, found := runningSettings[key]; !found {
+			changes = append(changes, Change{Setting: key, OnDisk: value})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Setting < changes[j].Setting })
+	return changes, nil
+}
+
+// RunningConfig is a configuration started at runtime by a RunnerList, for
+// example by autodiscover, config reloading or central management.
+type RunningConfig struct {
+	Source string                 `json:"source"`
+	Config map[string]interface{} `json:"config"`
+}
+
+// runnerLists are all the RunnerLists created, their running configurations
+// are reported by RunningConfigs.
+var runnerLists struct {
+	sync.Mutex
+	lists []*RunnerList
+}
+
+func registerRunnerList(l *RunnerList) {
+	runnerLists.Lock()
+	defer runnerLists.Unlock()
+	runnerLists.lists = append(runnerLists.lists, l)
+}
+
+// RunningConfigs returns the configurations of the runners currently started
+// by all the RunnerLists, redacted like RedactSettings.
+func RunningConfigs(secrets []string) []RunningConfig {
+	runnerLists.Lock()
+	lists := append([]*RunnerList(nil), runnerLists.lists...)
+	runnerLists.Unlock()
+
+	var configs []RunningConfig
+	for _, l := range lists {
+		source := runnerListSource(l.name)
+		for _, c := range l.runningConfigs() {
+			var m map[string]interface{}
+			if err := c.Unpack(&m); err != nil {
+				m = map[string]interface{}{"error": err.Error()}
+			}
+			redactMap("", m, secrets)
+			configs = append(configs, RunningConfig{Source: source, Config: m})
+		}
+	}
+	return configs
+}
+
+// runnerListSource returns the source reported for the configurations of a
+// RunnerList from its name.
+func runnerListSource(name string) string {
+	switch name {
+	case management.DebugK:
+		return SourceManagement
+	case "reload", "load":
+		return "config_reload"
+	default:
+		return name
+	}
+}
+
+// Redacted replaces the values of the redacted settings.
+const Redacted = "[redacted]"
+
+// sensitiveNameParts are the parts of the names of the settings always
+// redacted, whatever the value, in addition to the settings masked when
+// logging the configuration. Names are compared case-insensitively.
+var sensitiveNameParts = []string{
+	"password",
+	"passphrase",
+	"secret",
+	"token",
+	"credential",
+	"authorization",
+	"api_key",
+	"apikey",
+	"access_key",
+	"private_key",
+}
+
+// RedactSettings redacts in place the settings with a sensitive name or in a
+// group with a sensitive name, the string settings containing a private key
+// or any of the secrets, usually the values of the keystore, and removes the
+// user info of URLs.
+func RedactSettings(settings map[string]Setting, secrets []string) {
+	for key, setting := range settings {
+		setting.Value = redact(key, setting.Value, secrets)
+		settings[key] = setting
+	}
+}
+
+// RedactChanges redacts in place the changes like RedactSettings.
+func RedactChanges(changes []Change, secrets []string) {
+	for i, c := range changes {
+		if c.Running != nil {
+			changes[i].Running = redact(c.Setting, c.Running, secrets)
+		}
+		if c.OnDisk != nil {
+			changes[i].OnDisk = redact(c.Setting, c.OnDisk, secrets)
+		}
+	}
+}
+
+// redact returns the redacted value of a setting.
+func redact(key string, value interface{}, secrets []string) interface{} {
+	if isSensitiveKey(key) {
+		return Redacted
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	if strings.Contains(s, "PRIVATE KEY-----") {
+		return Redacted
+	}
+	for _, secret := range secrets {
+		if secret != "" && strings.Contains(s, secret) {
+			return Redacted
+		}
+	}
+	if u, err := url.Parse(s); err == nil && u.User != nil {
+		u.User = nil
+		return u.String()
+	}
+	return value
+}
+
+// isSensitiveKey reports whether a flattened setting name, or the name of
+// one of the groups containing the setting, is sensitive.
+func isSensitiveKey(key string) bool {
+	for _, name := range strings.Split(key, ".") {
+		if isSensitiveName(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSensitiveName(name string) bool {
+	name = strings.ToLower(name)
+	// key is the private key of the ssl settings
+	if name == "key" || maskedInLogs(name) {
+		return true
+	}
+	for _, part := range sensitiveNameParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskedInLogs reports whether the setting is masked when logging the
+// configuration, such as passwords, hosts and URLs.
+func maskedInLogs(name string) bool {
+	m := map[string]interface{}{name: nil}
+	config.ApplyLoggingMask(m)
+	return m[name] != nil
+}
+
+func redactMap(prefix string, m map[string]interface{}, secrets []string) {
+	for k, v := range m {
+		key := join(prefix, k)
+		switch v := v.(type) {
+		case map[string]interface{}:
+			redactMap(key, v, secrets)
+		case []interface{}:
+			for i, child := range v {
+				if cm, ok := child.(map[string]interface{}); ok {
+					redactMap(key, cm, secrets)
+				} else {
+					v[i] = redact(key, child, secrets)
+				}
+			}
+		default:
+			m[k] = redact(key, v, secrets)
+		}
+	}
+}
+
+// flatten returns the values of the settings of c keyed by their flattened
+// name, as returned by FlattenedKeys.
+func flatten(c *config.C) (map[string]interface{}, error) {
+	var m map[string]interface{}
+	if err := c.Unpack(&m); err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	var walk func(prefix string, v interface{})
+	walk = func(prefix string, v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, child := range v {
+				walk(join(prefix, k), child)
+			}
+		case []interface{}:
+			for i, child := range v {
+				walk(join(prefix, strconv.Itoa(i)), child)
+			}
+		default:
+			values[prefix] = v
+		}
+	}
+	walk("", m)
+	return values, nil
+}
+
+func join(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return strings.Join([]string{prefix, key}, ".")
+}
@@ -47,6 +47,8 @@ import (
 	"github.com/elastic/beats/v7/libbeat/cloudid"
 	"github.com/elastic/beats/v7/libbeat/cmd/instance/locks"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/dryrun"
+	"github.com/elastic/beats/v7/libbeat/common/fips"
 	"github.com/elastic/beats/v7/libbeat/common/fleetmode"
 	"github.com/elastic/beats/v7/libbeat/common/reload"
 	"github.com/elastic/beats/v7/libbeat/common/seccomp"
@@ -62,6 +64,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/outputs"
 	"github.com/elastic/beats/v7/libbeat/outputs/elasticsearch"
 	"github.com/elastic/beats/v7/libbeat/plugin"
+	"github.com/elastic/beats/v7/libbeat/plugin/external"
 	"github.com/elastic/beats/v7/libbeat/pprof"
 	"github.com/elastic/beats/v7/libbeat/publisher/pipeline"
 	"github.com/elastic/beats/v7/libbeat/publisher/processing"
@@ -100,6 +103,8 @@ type Beat struct {
 
 	InputQueueSize int // Size of the producer queue used by most queues.
 
+	publisherPipelines bool // Whether named publisher pipelines are supported.
+
 	// shouldReexec is a flag to indicate the Beat should restart
 	shouldReexec bool
 }
@@ -126,6 +131,7 @@ type beatConfig struct {
 	MetricLogging   *config.C              `config:"logging.metrics"`
 	Keystore        *config.C              `config:"keystore"`
 	Instrumentation instrumentation.Config `config:"instrumentation"`
+	Plugins         *config.C              `config:"plugins"`
 
 	// output/publishing related configurations
 	Pipeline pipeline.Config `config:",inline"`
@@ -397,14 +403,97 @@ func (b *Beat) createBeater(bt beat.Creator) (beat.Beater, error) {
 	// defer pipeline.Close()
 
 	b.Publisher = publisher
+	if len(b.Config.Pipeline.Pipelines) > 0 {
+		named, err := b.createNamedPipelines(monitors, settings)
+		if err != nil {
+			_ = publisher.Close()
+			return nil, fmt.Errorf("error initializing publisher pipelines: %w", err)
+		}
+		b.Publisher = pipeline.NewRouter(publisher, named)
+	}
 	beater, err := bt(&b.Beat, sub)
 	if err != nil {
+		if router, ok := b.Publisher.(*pipeline.Router); ok {
+			_ = router.Close()
+		}
 		return nil, err
 	}
 
 	return beater, nil
 }
 
+// createNamedPipelines creates the additional publisher pipelines configured
+// in `publisher_pipelines`. Each pipeline reports its metrics in its own
+// `pipelines.<name>` namespace of the libbeat registry.
+func (b *Beat) createNamedPipelines(monitors pipeline.Monitors, settings pipeline.Settings) (map[string]*pipeline.Pipeline, error) {
+	if !b.publisherPipelines {
+		return nil, fmt.Errorf("publisher_pipelines is not supported by %v", b.Info.Beat)
+	}
+
+	metrics := monitors.Metrics.GetRegistry("pipelines")
+	if metrics == nil {
+		metrics = monitors.Metrics.NewRegistry("pipelines")
+	}
+	telemetry := monitors.Telemetry.GetRegistry("pipelines")
+	if telemetry == nil {
+		telemetry = monitors.Telemetry.NewRegistry("pipelines")
+	}
+
+	named := make(map[string]*pipeline.Pipeline, len(b.Config.Pipeline.Pipelines))
+	closeNamed := func() {
+		for _, created := range named {
+			_ = created.Close()
+		}
+	}
+	for _, cfg := range b.Config.Pipeline.Pipelines {
+		m := monitors
+		m.Logger = monitors.Logger.With("publisher_pipeline", cfg.Name)
+		if m.Metrics = metrics.GetRegistry(cfg.Name); m.Metrics == nil {
+			m.Metrics = metrics.NewRegistry(cfg.Name)
+		}
+		if m.Telemetry = telemetry.GetRegistry(cfg.Name); m.Telemetry == nil {
+			m.Telemetry = telemetry.NewRegistry(cfg.Name)
+		}
+
+		// Named pipelines must not share the segments of the default disk queue.
+		if cfg.Queue.Name() == "disk" && !cfg.Queue.Config().HasField("path") {
+			if err := cfg.Queue.Config().SetString("path", -1, paths.Resolve(paths.Data, "diskqueue-"+cfg.Name)); err != nil {
+				closeNamed()
+				return nil, fmt.Errorf("publisher pipeline '%v': %w", cfg.Name, err)
+			}
+		}
+
+		p, err := pipeline.LoadWithSettings(b.Info, m, pipeline.Config{Queue: cfg.Queue, Ordering: b.Config.Pipeline.Ordering, Throttle: b.Config.Pipeline.Throttle}, b.makeOutputFactory(cfg.Output), settings)
+		if err != nil {
+			closeNamed()
+			return nil, fmt.Errorf("publisher pipeline '%v': %w", cfg.Name, err)
+		}
+		named[cfg.Name] = p
+	}
+	return named, nil
+}
+
+// drainPublisher waits for the outputs to acknowledge the events pending in
+// the publisher pipelines once the beater has stopped, and closes them. Each
+// pipeline logs a summary of its pending events. It does nothing unless
+// shutdown.drain_timeout is set.
+func (b *Beat) drainPublisher() {
+	timeout := b.Config.Pipeline.Shutdown.DrainTimeout
+	if timeout <= 0 {
+		return
+	}
+
+	p, ok := b.Publisher.(interface {
+		Shutdown(time.Duration) pipeline.ShutdownReport
+	})
+	if !ok {
+		logp.Warn("shutdown.drain_timeout is not supported by the publisher pipeline of %s", b.Info.Beat)
+		return
+	}
+
+	p.Shutdown(timeout)
+}
+
 func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 	defer func() {
 		_ = logp.Sync()
@@ -416,6 +505,7 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 			logp.Warn("Failed to close global processing: %v", err)
 		}
 	}()
+	defer external.Stop()
 
 	// Windows: Mark service as stopped.
 	// After this is run, a Beat service is considered by the OS to be stopped
@@ -463,6 +553,11 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 				return fmt.Errorf("failed to attach http handlers for pprof: %w", err)
 			}
 		}
+		if b.API.ConfigEnabled() {
+			if err := b.attachConfigHandlers(); err != nil {
+				return fmt.Errorf("failed to attach http handlers for the configuration: %w", err)
+			}
+		}
 	}
 
 	// Do not load seccomp for osquerybeat, it was disabled before V2 in the configuration file
@@ -514,7 +609,7 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 	}
 	svc.HandleSignals(stopBeat, cancel)
 
-	err = b.loadDashboards(ctx, false)
+	err = b.loadDashboards(ctx, false, nil)
 	if err != nil {
 		return err
 	}
@@ -525,6 +620,7 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 	b.Manager.SetStopCallback(beater.Stop)
 
 	err = beater.Run(&b.Beat)
+	b.drainPublisher()
 	if b.shouldReexec {
 		if err := b.reexec(); err != nil {
 			return fmt.Errorf("could not restart %s: %w", b.Info.Beat, err)
@@ -623,6 +719,9 @@ type SetupSettings struct {
 	// Deprecated: use IndexManagementKey instead
 	ILMPolicy         bool
 	EnableAllFilesets bool
+	// DryRun reports the changes to templates, ILM policies, ingest
+	// pipelines and dashboards without applying them.
+	DryRun bool
 }
 
 // Setup registers ES index template, kibana dashboards, ml jobs and pipelines.
@@ -637,6 +736,9 @@ func (b *Beat) Setup(settings Settings, bt beat.Creator, setup SetupSettings) er
 
 		// Tell the beat that we're in the setup command
 		b.InSetupCmd = true
+		if setup.DryRun {
+			b.SetupDryRun = dryrun.NewReporter(os.Stdout)
+		}
 
 		// Create beater to give it the opportunity to set loading callbacks
 		_, err = b.createBeater(bt)
@@ -649,10 +751,14 @@ func (b *Beat) Setup(settings Settings, bt beat.Creator, setup SetupSettings) er
 			if !isElasticsearchOutput(outCfg.Name()) {
 				return fmt.Errorf("index management requested but the Elasticsearch output is not configured/enabled")
 			}
-			esClient, err := eslegclient.NewConnectedClient(outCfg.Config(), b.Info.Beat)
+			conn, err := eslegclient.NewConnectedClient(outCfg.Config(), b.Info.Beat)
 			if err != nil {
 				return err
 			}
+			var esClient idxmgmt.ESClient = conn
+			if b.SetupDryRun != nil {
+				esClient = eslegclient.NewDryRunConnection(conn, b.SetupDryRun)
+			}
 
 			var loadTemplate, loadILM = idxmgmt.LoadModeUnset, idxmgmt.LoadModeUnset
 			if setup.IndexManagement || setup.Template {
@@ -673,7 +779,7 @@ func (b *Beat) Setup(settings Settings, bt beat.Creator, setup SetupSettings) er
 
 		if setup.Dashboard && settings.HasDashboards {
 			fmt.Println("Loading dashboards (Kibana must be running and reachable)")
-			err = b.loadDashboards(context.Background(), true)
+			err = b.loadDashboards(context.Background(), true, b.SetupDryRun)
 
 			if err != nil {
 				var notFoundErr *dashboards.ErrNotFound
@@ -682,7 +788,7 @@ func (b *Beat) Setup(settings Settings, bt beat.Creator, setup SetupSettings) er
 				} else {
 					return err
 				}
-			} else {
+			} else if b.SetupDryRun == nil {
 				fmt.Println("Loaded dashboards")
 			}
 		}
@@ -698,9 +804,14 @@ func (b *Beat) Setup(settings Settings, bt beat.Creator, setup SetupSettings) er
 			if err != nil {
 				return err
 			}
-			fmt.Println("Loaded Ingest pipelines")
+			if b.SetupDryRun == nil {
+				fmt.Println("Loaded Ingest pipelines")
+			}
 		}
 
+		if b.SetupDryRun != nil {
+			fmt.Printf("Dry run finished, %d changes would be applied.\n", b.SetupDryRun.Changes())
+		}
 		return nil
 	}())
 }
@@ -719,6 +830,7 @@ func (b *Beat) configure(settings Settings) error {
 	var err error
 
 	b.InputQueueSize = settings.InputQueueSize
+	b.publisherPipelines = settings.PublisherPipelines
 
 	cfg, err := cfgfile.Load("", settings.ConfigOverrides)
 	if err != nil {
@@ -762,12 +874,28 @@ func (b *Beat) configure(settings Settings) error {
 		return fmt.Errorf("error unpacking config data: %w", err)
 	}
 
+	if err := fips.Check(); err != nil {
+		return err
+	}
+	if err := fips.Validate(b.RawConfig); err != nil {
+		return err
+	}
+
 	if err := features.UpdateFromConfig(b.RawConfig); err != nil {
 		return fmt.Errorf("could not parse features: %w", err)
 	}
 	b.RegisterHostname(features.FQDN())
 
 	b.Beat.Config = &b.Config.BeatConfig
+	if settings.OutputOverride != nil {
+		if out := settings.OutputOverride(); out != nil {
+			var ns config.Namespace
+			if err := ns.Unpack(out); err != nil {
+				return fmt.Errorf("error unpacking output override: %w", err)
+			}
+			b.Config.Output = ns
+		}
+	}
 
 	if name := b.Config.Name; name != "" {
 		b.Info.Name = name
@@ -784,6 +912,10 @@ func (b *Beat) configure(settings Settings) error {
 	// log paths values to help with troubleshooting
 	logp.Info(paths.Paths.String())
 
+	if err := external.Load(b.Config.Plugins); err != nil {
+		return fmt.Errorf("error loading external plugins: %w", err)
+	}
+
 	metaPath := paths.Resolve(paths.Data, "meta.json")
 	err = b.loadMeta(metaPath)
 	if err != nil {
@@ -954,7 +1086,9 @@ func openRegular(filename string) (*os.File, error) {
 	return f, nil
 }
 
-func (b *Beat) loadDashboards(ctx context.Context, force bool) error {
+// loadDashboards imports the dashboards into Kibana. If dryRun is set, the
+// changes are reported to it instead.
+func (b *Beat) loadDashboards(ctx context.Context, force bool, dryRun *dryrun.Reporter) error {
 	if force {
 		// force implies dashboards.enabled=true
 		if b.Config.Dashboards == nil {
@@ -990,6 +1124,10 @@ func (b *Beat) loadDashboards(ctx context.Context, force bool) error {
 			return fmt.Errorf("error generating index pattern: %w", err)
 		}
 
+		if dryRun != nil {
+			return dashboards.DiffDashboards(ctx, b.Info, paths.Resolve(paths.Home, ""),
+				kibanaConfig, b.Config.Dashboards, nil, pattern, dryRun)
+		}
 		err = dashboards.ImportDashboards(ctx, b.Info, paths.Resolve(paths.Home, ""),
 			kibanaConfig, b.Config.Dashboards, nil, pattern)
 		if err != nil {
@@ -1065,6 +1203,10 @@ func (b *Beat) makeOutputReloader(outReloader pipeline.OutputReloader) reload.Re
 			return nil
 		}
 
+		if err := fips.Validate(update.Config); err != nil {
+			return err
+		}
+
 		if b.OutputConfigReloader != nil {
 			if err := b.OutputConfigReloader.Reload(update); err != nil {
 				return err
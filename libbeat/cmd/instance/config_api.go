@@ -0,0 +1,111 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package instance
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/multierr"
+
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/elastic-agent-libs/keystore"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// attachConfigHandlers attaches the /config routes to the API, they report
+// the running configuration with the source of every setting, and how it
+// differs from the configuration files.
+func (b *Beat) attachConfigHandlers() error {
+	return multierr.Combine(
+		b.API.AttachHandler("/config", http.HandlerFunc(b.serveConfig)),
+		b.API.AttachHandler("/config/diff", http.HandlerFunc(b.serveConfigDiff)),
+	)
+}
+
+func (b *Beat) serveConfig(w http.ResponseWriter, r *http.Request) {
+	secrets := b.keystoreSecrets()
+
+	settings, err := cfgfile.Provenance(b.RawConfig)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	cfgfile.RedactSettings(settings, secrets)
+
+	writeJSON(w, r, map[string]interface{}{
+		"settings": settings,
+		"dynamic":  cfgfile.RunningConfigs(secrets),
+	})
+}
+
+func (b *Beat) serveConfigDiff(w http.ResponseWriter, r *http.Request) {
+	changes, err := cfgfile.Diff(b.RawConfig)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	cfgfile.RedactChanges(changes, b.keystoreSecrets())
+
+	writeJSON(w, r, map[string]interface{}{
+		"changed": len(changes) > 0,
+		"changes": changes,
+	})
+}
+
+// keystoreSecrets returns the values stored in the keystore, they are
+// redacted from the configuration reported by the API.
+func (b *Beat) keystoreSecrets() []string {
+	if b.keystore == nil {
+		return nil
+	}
+	store, err := keystore.AsListingKeystore(b.keystore)
+	if err != nil {
+		return nil
+	}
+	keys, err := store.List()
+	if err != nil {
+		logp.Warn("Failed to list the keystore keys, the configuration may not be fully redacted: %v", err)
+		return nil
+	}
+
+	var secrets []string
+	for _, key := range keys {
+		s, err := b.keystore.Retrieve(key)
+		if err != nil {
+			continue
+		}
+		value, err := s.Get()
+		if err != nil || len(value) == 0 {
+			continue
+		}
+		secrets = append(secrets, string(value))
+	}
+	return secrets
+}
+
+func writeJSON(w http.ResponseWriter, r *http.Request, data interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	enc := json.NewEncoder(w)
+	if _, ok := r.URL.Query()["pretty"]; ok {
+		enc.SetIndent("", "  ")
+	}
+	if err := enc.Encode(data); err != nil {
+		logp.Warn("Failed to write the configuration: %v", err)
+	}
+}
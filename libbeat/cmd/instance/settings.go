@@ -25,6 +25,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/idxmgmt/ilm"
 	"github.com/elastic/beats/v7/libbeat/monitoring/report"
 	"github.com/elastic/beats/v7/libbeat/publisher/processing"
+	"github.com/elastic/elastic-agent-libs/config"
 )
 
 // Settings contains basic settings for any beat to pass into GenRootCmd
@@ -50,4 +51,13 @@ type Settings struct {
 	// publisher pipeline. This is only useful when the Beat plans to use
 	// beat.DropIfFull PublishMode. Leave as zero for default.
 	InputQueueSize int
+
+	// PublisherPipelines enables the `publisher_pipelines` setting. Only Beats
+	// whose inputs can select a named pipeline should enable it.
+	PublisherPipelines bool
+
+	// OutputOverride, if set, returns the output configuration replacing the
+	// configured output, or nil to keep it. It is called once the configuration
+	// is loaded, after the command line flags are parsed.
+	OutputOverride func() *config.C
 }
@@ -36,6 +36,8 @@ const (
 	IndexManagementKey = "index-management"
 	//EnableAllFilesetsKey enables all modules and filesets regardless of config
 	EnableAllFilesetsKey = "enable-all-filesets"
+	//DryRunKey reports the changes of the setup cmd without applying them
+	DryRunKey = "dry-run"
 )
 
 func genSetupCmd(settings instance.Settings, beatCreator beat.Creator) *cobra.Command {
@@ -97,6 +99,8 @@ func genSetupCmd(settings instance.Settings, beatCreator beat.Creator) *cobra.Co
 				}
 			}
 
+			s.DryRun, _ = cmd.Flags().GetBool(DryRunKey)
+
 			if err = beat.Setup(settings, beatCreator, s); err != nil {
 				os.Exit(1)
 			}
@@ -108,6 +112,7 @@ func genSetupCmd(settings instance.Settings, beatCreator beat.Creator) *cobra.Co
 	setup.Flags().Bool(IndexManagementKey, false,
 		"Setup all components related to Elasticsearch index management, including template, ilm policy and rollover alias")
 	setup.Flags().Bool("enable-all-filesets", false, "Behave as if all modules and filesets had been enabled")
+	setup.Flags().Bool(DryRunKey, false, "Print the changes to templates, ILM policies, ingest pipelines and dashboards without applying them")
 
 	return &setup
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/cmd/instance"
 	"github.com/elastic/beats/v7/libbeat/idxmgmt"
 	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/plugin/external"
 	"github.com/elastic/elastic-agent-libs/testing"
 )
 
@@ -46,11 +47,13 @@ func GenTestOutputCmd(settings instance.Settings) *cobra.Command {
 				fmt.Fprintf(os.Stderr, "Error initializing output: %s\n", err)
 				os.Exit(1)
 			}
+			defer external.Stop()
 
 			for _, client := range output.Clients {
 				tClient, ok := client.(testing.Testable)
 				if !ok {
 					fmt.Printf("%s output doesn't support testing\n", b.Config.Output.Name())
+					external.Stop()
 					os.Exit(1)
 				}
 
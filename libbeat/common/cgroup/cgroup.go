@@ -0,0 +1,51 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package cgroup reads the resource usage and limits of the cgroup the beat
+// is running in.
+package cgroup
+
+import "errors"
+
+// ErrUnsupported is returned on the platforms without cgroups.
+var ErrUnsupported = errors.New("cgroups are not supported on this platform")
+
+// Stats are the memory usage and limit and the CPU throttling counters of a
+// cgroup.
+type Stats struct {
+	// MemoryUsage is the working set of the cgroup, that is its memory usage
+	// without the inactive page cache, in bytes.
+	MemoryUsage uint64
+	// MemoryLimit is the memory limit of the cgroup in bytes, 0 when it is
+	// unlimited.
+	MemoryLimit uint64
+
+	// CPUPeriods is the number of CPU enforcement periods elapsed.
+	CPUPeriods uint64
+	// CPUThrottledPeriods is the number of periods the cgroup was throttled
+	// in because it used its whole CPU quota.
+	CPUThrottledPeriods uint64
+}
+
+// MemoryRatio returns the fraction of the memory limit in use, 0 when the
+// memory is not limited.
+func (s Stats) MemoryRatio() float64 {
+	if s.MemoryLimit == 0 {
+		return 0
+	}
+	return float64(s.MemoryUsage) / float64(s.MemoryLimit)
+}
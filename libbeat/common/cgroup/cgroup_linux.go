@@ -0,0 +1,225 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cgroup
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// unlimitedV1 is the smallest value considered as no limit in the cgroup v1
+// memory.limit_in_bytes file, which holds the largest page-aligned int64 when
+// the memory is not limited.
+const unlimitedV1 = 1 << 62
+
+// Reader reads the stats of the cgroup of the current process.
+type Reader struct {
+	v2        bool
+	memoryDir string
+	cpuDir    string
+}
+
+// NewReader locates the cgroup of the current process. rootfs is the
+// directory the /proc and /sys filesystems of the host are mounted in, "/"
+// unless the beat is monitoring a host from a container.
+func NewReader(rootfs string) (*Reader, error) {
+	if rootfs == "" {
+		rootfs = "/"
+	}
+	paths, err := readProcCgroup(filepath.Join(rootfs, "proc/self/cgroup"))
+	if err != nil {
+		return nil, err
+	}
+
+	mount := filepath.Join(rootfs, "sys/fs/cgroup")
+	if _, err := os.Stat(filepath.Join(mount, "cgroup.controllers")); err == nil {
+		path, ok := paths[""]
+		if !ok {
+			return nil, fmt.Errorf("no cgroup v2 hierarchy in /proc/self/cgroup")
+		}
+		dir := cgroupDir(mount, path)
+		return &Reader{v2: true, memoryDir: dir, cpuDir: dir}, nil
+	}
+
+	r := &Reader{}
+	for controllers, path := range paths {
+		for _, c := range strings.Split(controllers, ",") {
+			switch c {
+			case "memory":
+				r.memoryDir = cgroupDir(filepath.Join(mount, controllers), path)
+			case "cpu":
+				r.cpuDir = cgroupDir(filepath.Join(mount, controllers), path)
+			}
+		}
+	}
+	if r.memoryDir == "" && r.cpuDir == "" {
+		return nil, fmt.Errorf("no cgroup v1 memory or cpu controller in /proc/self/cgroup")
+	}
+	return r, nil
+}
+
+// readProcCgroup returns the cgroup paths of the process indexed by their
+// controllers, the cgroup v2 hierarchy has no controllers.
+func readProcCgroup(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	paths := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// hierarchy-ID:controller-list:cgroup-path
+		fields := strings.SplitN(scanner.Text(), ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		paths[fields[1]] = fields[2]
+	}
+	return paths, scanner.Err()
+}
+
+// cgroupDir returns the directory of a cgroup. Inside a cgroup namespace the
+// path of the cgroup is not found in the mount, which is the cgroup itself.
+func cgroupDir(mount, path string) string {
+	dir := filepath.Join(mount, path)
+	if _, err := os.Stat(dir); err != nil {
+		return mount
+	}
+	return dir
+}
+
+// Read returns the current stats of the cgroup.
+func (r *Reader) Read() (Stats, error) {
+	var (
+		stats Stats
+		err   error
+	)
+	if r.memoryDir != "" {
+		if r.v2 {
+			err = r.readMemoryV2(&stats)
+		} else {
+			err = r.readMemoryV1(&stats)
+		}
+		if err != nil {
+			return Stats{}, err
+		}
+	}
+	if r.cpuDir != "" {
+		cpu, err := readFlatKeyed(filepath.Join(r.cpuDir, "cpu.stat"))
+		if err != nil && !os.IsNotExist(err) {
+			return Stats{}, err
+		}
+		stats.CPUPeriods = cpu["nr_periods"]
+		stats.CPUThrottledPeriods = cpu["nr_throttled"]
+	}
+	return stats, nil
+}
+
+func (r *Reader) readMemoryV2(stats *Stats) error {
+	usage, err := readUint(filepath.Join(r.memoryDir, "memory.current"))
+	if err != nil {
+		return err
+	}
+	limit, err := readUint(filepath.Join(r.memoryDir, "memory.max"))
+	if err != nil {
+		return err
+	}
+	memory, err := readFlatKeyed(filepath.Join(r.memoryDir, "memory.stat"))
+	if err != nil {
+		return err
+	}
+	stats.MemoryUsage = workingSet(usage, memory["inactive_file"])
+	stats.MemoryLimit = limit
+	return nil
+}
+
+func (r *Reader) readMemoryV1(stats *Stats) error {
+	usage, err := readUint(filepath.Join(r.memoryDir, "memory.usage_in_bytes"))
+	if err != nil {
+		return err
+	}
+	limit, err := readUint(filepath.Join(r.memoryDir, "memory.limit_in_bytes"))
+	if err != nil {
+		return err
+	}
+	memory, err := readFlatKeyed(filepath.Join(r.memoryDir, "memory.stat"))
+	if err != nil {
+		return err
+	}
+	stats.MemoryUsage = workingSet(usage, memory["total_inactive_file"])
+	if limit < unlimitedV1 {
+		stats.MemoryLimit = limit
+	}
+	return nil
+}
+
+func workingSet(usage, inactiveFile uint64) uint64 {
+	if inactiveFile > usage {
+		return 0
+	}
+	return usage - inactiveFile
+}
+
+// readUint reads a file holding a single number, or "max" for no limit
+// which is returned as 0.
+func readUint(path string) (uint64, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	s := string(bytes.TrimSpace(b))
+	if s == "max" {
+		return 0, nil
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return v, nil
+}
+
+// readFlatKeyed reads a file made of "key value" lines.
+func readFlatKeyed(path string) (map[string]uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := map[string]uint64{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		values[key] = v
+	}
+	return values, scanner.Err()
+}
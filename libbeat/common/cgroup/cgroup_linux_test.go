@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cgroup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+func TestReaderV2(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"proc/self/cgroup":                                           "0::/system.slice/filebeat.service\n",
+		"sys/fs/cgroup/cgroup.controllers":                           "cpu memory\n",
+		"sys/fs/cgroup/system.slice/filebeat.service/memory.current": "600\n",
+		"sys/fs/cgroup/system.slice/filebeat.service/memory.max":     "1000\n",
+		"sys/fs/cgroup/system.slice/filebeat.service/memory.stat":    "anon 400\ninactive_file 100\n",
+		"sys/fs/cgroup/system.slice/filebeat.service/cpu.stat":       "usage_usec 10\nnr_periods 20\nnr_throttled 5\n",
+	})
+
+	r, err := NewReader(root)
+	require.NoError(t, err)
+	stats, err := r.Read()
+	require.NoError(t, err)
+	assert.Equal(t, Stats{
+		MemoryUsage:         500,
+		MemoryLimit:         1000,
+		CPUPeriods:          20,
+		CPUThrottledPeriods: 5,
+	}, stats)
+	assert.Equal(t, 0.5, stats.MemoryRatio())
+
+	writeFiles(t, root, map[string]string{
+		"sys/fs/cgroup/system.slice/filebeat.service/memory.max": "max\n",
+	})
+	stats, err = r.Read()
+	require.NoError(t, err)
+	assert.Zero(t, stats.MemoryLimit)
+	assert.Zero(t, stats.MemoryRatio())
+}
+
+func TestReaderV1Namespaced(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"proc/self/cgroup": "12:memory:/docker/abc\n" +
+			"5:cpu,cpuacct:/docker/abc\n" +
+			"1:name=systemd:/docker/abc\n",
+		"sys/fs/cgroup/memory/memory.usage_in_bytes": "800\n",
+		"sys/fs/cgroup/memory/memory.limit_in_bytes": "9223372036854771712\n",
+		"sys/fs/cgroup/memory/memory.stat":           "cache 300\ntotal_inactive_file 200\n",
+		"sys/fs/cgroup/cpu,cpuacct/cpu.stat":         "nr_periods 10\nnr_throttled 1\nthrottled_time 3\n",
+	})
+
+	r, err := NewReader(root)
+	require.NoError(t, err)
+	stats, err := r.Read()
+	require.NoError(t, err)
+	assert.Equal(t, Stats{
+		MemoryUsage:         600,
+		CPUPeriods:          10,
+		CPUThrottledPeriods: 1,
+	}, stats)
+}
@@ -0,0 +1,157 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package dryrun reports the changes the setup command would apply to
+// Elasticsearch and Kibana, without applying them.
+package dryrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Reporter writes the changes to resources as unified diffs of their JSON
+// representation.
+type Reporter struct {
+	mu      sync.Mutex
+	out     io.Writer
+	changes int
+}
+
+// NewReporter creates a Reporter writing to out.
+func NewReporter(out io.Writer) *Reporter {
+	return &Reporter{out: out}
+}
+
+// Update reports the differences between the current and the desired
+// definition of a resource. A nil current definition reports that the
+// resource would be created.
+func (r *Reporter) Update(resource string, current, desired interface{}) error {
+	from, err := format(current)
+	if err != nil {
+		return fmt.Errorf("failed to format current definition of %s: %w", resource, err)
+	}
+	to, err := format(desired)
+	if err != nil {
+		return fmt.Errorf("failed to format desired definition of %s: %w", resource, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if current != nil && from == to {
+		fmt.Fprintf(r.out, "unchanged %s\n", resource)
+		return nil
+	}
+	r.changes++
+
+	if current == nil && desired == nil {
+		fmt.Fprintf(r.out, "create %s\n", resource)
+		return nil
+	}
+
+	// a resource that does not exist yet is diffed against no lines at all
+	fromFile, fromLines := "/dev/null", []string(nil)
+	if current != nil {
+		fromFile, fromLines = resource+" (current)", difflib.SplitLines(from)
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        fromLines,
+		B:        difflib.SplitLines(to),
+		FromFile: fromFile,
+		ToFile:   resource + " (desired)",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to compute changes of %s: %w", resource, err)
+	}
+	_, err = io.WriteString(r.out, diff)
+	return err
+}
+
+// Delete reports that a resource would be deleted.
+func (r *Reporter) Delete(resource string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.changes++
+	fmt.Fprintf(r.out, "delete %s\n", resource)
+}
+
+// Changes returns the number of resources that would be created, updated
+// or deleted.
+func (r *Reporter) Changes() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.changes
+}
+
+// format returns the indented JSON of v with sorted keys. Scalar values
+// are formatted as strings, as Elasticsearch returns most settings as
+// strings independent of the type they were set with.
+func format(v interface{}) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+
+	var raw []byte
+	switch v := v.(type) {
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		var err error
+		raw, err = json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(normalize(doc), "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = normalize(e)
+		}
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = normalize(e)
+		}
+		return v
+	case float64, bool:
+		return fmt.Sprint(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,89 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dryrun
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReporter(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewReporter(&buf)
+
+	t.Run("create", func(t *testing.T) {
+		buf.Reset()
+		err := r.Update("PUT /_ilm/policy/test", nil, map[string]interface{}{"policy": map[string]interface{}{"phases": map[string]interface{}{}}})
+		require.NoError(t, err)
+		assert.Equal(t, `--- /dev/null
++++ PUT /_ilm/policy/test (desired)
+@@ -0,0 +1,5 @@
++{
++  "policy": {
++    "phases": {}
++  }
++}
+`, buf.String())
+	})
+
+	t.Run("create without body", func(t *testing.T) {
+		buf.Reset()
+		err := r.Update("PUT /_data_stream/test", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "create PUT /_data_stream/test\n", buf.String())
+	})
+
+	t.Run("unchanged with settings as strings", func(t *testing.T) {
+		buf.Reset()
+		err := r.Update("PUT /_index_template/test",
+			[]byte(`{"priority":"150","settings":{"limit":"10000","hidden":"true"}}`),
+			map[string]interface{}{"priority": 150, "settings": map[string]interface{}{"limit": 10000, "hidden": true}},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "unchanged PUT /_index_template/test\n", buf.String())
+	})
+
+	t.Run("update", func(t *testing.T) {
+		buf.Reset()
+		err := r.Update("PUT /_ingest/pipeline/test",
+			map[string]interface{}{"description": "old", "processors": []interface{}{}},
+			map[string]interface{}{"description": "new", "processors": []interface{}{}},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, `--- PUT /_ingest/pipeline/test (current)
++++ PUT /_ingest/pipeline/test (desired)
+@@ -1,4 +1,4 @@
+ {
+-  "description": "old",
++  "description": "new",
+   "processors": []
+ }
+`, buf.String())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		buf.Reset()
+		r.Delete("DELETE /_ingest/pipeline/test")
+		assert.Equal(t, "delete DELETE /_ingest/pipeline/test\n", buf.String())
+	})
+
+	assert.Equal(t, 4, r.Changes())
+}
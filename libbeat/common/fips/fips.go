@@ -0,0 +1,128 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package fips provides the runtime checks for Beats built in FIPS mode.
+//
+// A FIPS build is produced with the requirefips build tag and a FIPS 140
+// validated crypto provider (GOEXPERIMENT=boringcrypto). In such a build the
+// Beat refuses to start unless the provider is active, and rejects TLS
+// settings that are not approved for use in FIPS mode.
+package fips
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/elastic-agent-libs/config"
+)
+
+// approvedCipherSuites lists the tlscommon cipher suite names allowed in FIPS
+// mode. This matches the suites crypto/tls/fipsonly restricts Go to.
+var approvedCipherSuites = map[string]bool{
+	"ECDHE-ECDSA-AES-128-GCM-SHA256": true,
+	"ECDHE-ECDSA-AES-256-GCM-SHA384": true,
+	"ECDHE-RSA-AES-128-GCM-SHA256":   true,
+	"ECDHE-RSA-AES-256-GCM-SHA384":   true,
+	"RSA-AES-128-GCM-SHA256":         true,
+	"RSA-AES-256-GCM-SHA384":         true,
+	"TLS-AES-128-GCM-SHA256":         true,
+	"TLS-AES-256-GCM-SHA384":         true,
+}
+
+// approvedCurveTypes lists the tlscommon curve names allowed in FIPS mode.
+var approvedCurveTypes = map[string]bool{
+	"P-256": true,
+	"P-384": true,
+}
+
+// approvedProtocols lists the tlscommon protocol versions allowed in FIPS mode.
+var approvedProtocols = map[string]bool{
+	"TLSv1.2": true,
+	"TLSv1.3": true,
+}
+
+// Check returns an error if the Beat was built in FIPS mode but the FIPS
+// validated crypto provider is not in use.
+func Check() error {
+	if !Enabled {
+		return nil
+	}
+	if !providerEnabled() {
+		return fmt.Errorf("beat was built with FIPS mode required, but the %s crypto provider is not enabled", provider)
+	}
+	return nil
+}
+
+// Validate returns an error listing all TLS settings in cfg that are not
+// approved in FIPS mode. It does nothing unless the Beat was built in FIPS
+// mode. It is called on the configuration loaded at startup, and on the
+// configurations of the inputs, modules and outputs loaded at runtime.
+func Validate(cfg *config.C) error {
+	if !Enabled {
+		return nil
+	}
+	return validateConfig(cfg)
+}
+
+func validateConfig(cfg *config.C) error {
+	if cfg == nil {
+		return nil
+	}
+
+	var errs []string
+	for _, key := range cfg.FlattenedKeys() {
+		setting, approved := settingFor(key)
+		if approved == nil {
+			continue
+		}
+
+		value, err := cfg.String(key, -1)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", key, err)
+		}
+		if !approved[value] {
+			errs = append(errs, fmt.Sprintf("%s: %q is not an approved %s", key, value, setting))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New("configuration is not FIPS compliant: " + strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// settingFor returns the name of the TLS setting a flattened configuration
+// key belongs to and the values approved for it. It returns nil if the key is
+// not a restricted TLS setting.
+func settingFor(key string) (string, map[string]bool) {
+	parts := strings.Split(key, ".")
+	for i := len(parts) - 1; i > 0; i-- {
+		if parts[i-1] != "ssl" {
+			continue
+		}
+		switch parts[i] {
+		case "cipher_suites":
+			return "cipher suite", approvedCipherSuites
+		case "curve_types":
+			return "curve type", approvedCurveTypes
+		case "supported_protocols":
+			return "TLS protocol", approvedProtocols
+		}
+	}
+	return "", nil
+}
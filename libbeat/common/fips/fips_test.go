@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fips
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/config"
+)
+
+func TestValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		cfg  map[string]interface{}
+		errs []string
+	}{
+		"no tls settings": {
+			cfg: map[string]interface{}{
+				"output.elasticsearch.hosts": []string{"localhost:9200"},
+			},
+		},
+		"approved settings": {
+			cfg: map[string]interface{}{
+				"output.elasticsearch.ssl.cipher_suites":       []string{"ECDHE-RSA-AES-256-GCM-SHA384", "TLS-AES-128-GCM-SHA256"},
+				"output.elasticsearch.ssl.curve_types":         []string{"P-256"},
+				"output.elasticsearch.ssl.supported_protocols": []string{"TLSv1.2", "TLSv1.3"},
+			},
+		},
+		"non-approved settings": {
+			cfg: map[string]interface{}{
+				"filebeat.inputs": []map[string]interface{}{{
+					"type":              "tcp",
+					"ssl.cipher_suites": []string{"ECDHE-RSA-AES-128-GCM-SHA256", "ECDHE-RSA-CHACHA20-POLY1205"},
+				}},
+				"output.elasticsearch.ssl.curve_types":         "X25519",
+				"output.elasticsearch.ssl.supported_protocols": []string{"TLSv1.1"},
+			},
+			errs: []string{
+				`filebeat.inputs.0.ssl.cipher_suites.1: "ECDHE-RSA-CHACHA20-POLY1205" is not an approved cipher suite`,
+				`output.elasticsearch.ssl.curve_types: "X25519" is not an approved curve type`,
+				`output.elasticsearch.ssl.supported_protocols.0: "TLSv1.1" is not an approved TLS protocol`,
+			},
+		},
+		"setting not under ssl": {
+			cfg: map[string]interface{}{
+				"processors.0.add_fields.fields.cipher_suites": "RSA-RC4-128-SHA",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateConfig(config.MustNewConfigFrom(test.cfg))
+			if len(test.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, msg := range test.errs {
+				assert.Contains(t, err.Error(), msg)
+			}
+		})
+	}
+}
+
+func TestValidateNotEnabled(t *testing.T) {
+	if Enabled {
+		t.Skip("beat built in FIPS mode")
+	}
+	cfg := config.MustNewConfigFrom(map[string]interface{}{
+		"output.elasticsearch.ssl.cipher_suites": []string{"RSA-RC4-128-SHA"},
+	})
+	assert.NoError(t, Validate(cfg))
+	assert.NoError(t, Check())
+}
@@ -0,0 +1,283 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/elastic/elastic-agent-libs/transport/httpcommon"
+)
+
+// OAuthBearerConfig configures the token provider used by the OAUTHBEARER
+// SASL mechanism. Tokens are either requested from an OAuth2 token endpoint
+// using the client credentials flow, or read from a file that is refreshed
+// by an external process. The transport settings (ssl, timeout and proxy)
+// apply to the requests to the token endpoint.
+type OAuthBearerConfig struct {
+	TokenURL       string              `config:"token_url"`
+	ClientID       string              `config:"client_id"`
+	ClientSecret   string              `config:"client_secret"`
+	Scopes         []string            `config:"scopes"`
+	EndpointParams map[string][]string `config:"endpoint_params"`
+	TokenFile      string              `config:"token_file"`
+	Extensions     map[string]string   `config:"extensions"`
+
+	Transport httpcommon.HTTPTransportSettings `config:",inline"`
+}
+
+func (c *OAuthBearerConfig) Validate() error {
+	switch {
+	case c.TokenURL != "" && c.TokenFile != "":
+		return errors.New("only one of token_url and token_file can be set")
+	case c.TokenURL == "" && c.TokenFile == "":
+		return errors.New("one of token_url or token_file must be set")
+	case c.TokenURL != "" && c.ClientID == "":
+		return errors.New("client_id must be set when token_url is configured")
+	}
+	return nil
+}
+
+// AWSMSKIAMConfig configures the AWS credentials used to sign the
+// authentication tokens for Amazon MSK clusters with IAM access control.
+// If no static credentials or profile are configured, the default AWS
+// credentials chain is used.
+type AWSMSKIAMConfig struct {
+	Region                string `config:"region"`
+	AccessKeyID           string `config:"access_key_id"`
+	SecretAccessKey       string `config:"secret_access_key"`
+	SessionToken          string `config:"session_token"`
+	CredentialProfileName string `config:"credential_profile_name"`
+}
+
+func (c *AWSMSKIAMConfig) Validate() error {
+	if c.Region == "" {
+		return errors.New("region must be set")
+	}
+	if (c.AccessKeyID == "") != (c.SecretAccessKey == "") {
+		return errors.New("access_key_id and secret_access_key must be set together")
+	}
+	return nil
+}
+
+// newOAuthBearerTokenProvider returns the token provider for the configuration.
+func newOAuthBearerTokenProvider(c *OAuthBearerConfig) sarama.AccessTokenProvider {
+	if c.TokenFile != "" {
+		return &fileTokenProvider{path: c.TokenFile, extensions: c.Extensions}
+	}
+
+	return &clientCredentialsTokenProvider{config: *c}
+}
+
+type clientCredentialsTokenProvider struct {
+	config OAuthBearerConfig
+
+	mu     sync.Mutex
+	source oauth2.TokenSource
+}
+
+// tokenSource creates the token source on first use. The token source caches
+// the token and refreshes it once it expires.
+func (p *clientCredentialsTokenProvider) tokenSource() (oauth2.TokenSource, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.source != nil {
+		return p.source, nil
+	}
+
+	transport := p.config.Transport
+	if transport.Timeout <= 0 {
+		transport.Timeout = httpcommon.DefaultHTTPTransportSettings().Timeout
+	}
+	client, err := transport.Client()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OAUTHBEARER token endpoint client: %w", err)
+	}
+
+	cc := clientcredentials.Config{
+		ClientID:       p.config.ClientID,
+		ClientSecret:   p.config.ClientSecret,
+		TokenURL:       p.config.TokenURL,
+		Scopes:         p.config.Scopes,
+		EndpointParams: url.Values(p.config.EndpointParams),
+	}
+	// The oauth2 package sends the token requests with the HTTP client
+	// stored in the context.
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, client)
+	p.source = cc.TokenSource(ctx)
+	return p.source, nil
+}
+
+func (p *clientCredentialsTokenProvider) Token() (*sarama.AccessToken, error) {
+	source, err := p.tokenSource()
+	if err != nil {
+		return nil, err
+	}
+	t, err := source.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to request OAUTHBEARER token: %w", err)
+	}
+	return &sarama.AccessToken{Token: t.AccessToken, Extensions: p.config.Extensions}, nil
+}
+
+// fileTokenProvider reads the token from a file on every authentication, such
+// that the token can be rotated without restarting the Beat.
+type fileTokenProvider struct {
+	path       string
+	extensions map[string]string
+}
+
+func (p *fileTokenProvider) Token() (*sarama.AccessToken, error) {
+	b, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OAUTHBEARER token file: %w", err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return nil, fmt.Errorf("OAUTHBEARER token file %s is empty", p.path)
+	}
+	return &sarama.AccessToken{Token: token, Extensions: p.extensions}, nil
+}
+
+const (
+	mskSigningName   = "kafka-cluster"
+	mskAction        = "kafka-cluster:Connect"
+	mskTokenLifetime = 15 * time.Minute
+	mskUserAgent     = "beats-kafka-msk-iam"
+)
+
+// mskIAMTokenProvider creates the SigV4 signed authentication tokens
+// accepted by Amazon MSK when authenticating with OAUTHBEARER. Tokens are
+// cached and renewed before they expire.
+type mskIAMTokenProvider struct {
+	config AWSMSKIAMConfig
+	now    func() time.Time
+
+	mu          sync.Mutex
+	credentials aws.CredentialsProvider
+	token       string
+	expires     time.Time
+}
+
+func newMSKIAMTokenProvider(c *AWSMSKIAMConfig) *mskIAMTokenProvider {
+	return &mskIAMTokenProvider{config: *c, now: time.Now}
+}
+
+func (p *mskIAMTokenProvider) Token() (*sarama.AccessToken, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	// renew the token one minute before it expires
+	if p.token != "" && now.Before(p.expires.Add(-time.Minute)) {
+		return &sarama.AccessToken{Token: p.token}, nil
+	}
+
+	ctx := context.Background()
+	if p.credentials == nil {
+		provider, err := p.loadCredentials(ctx)
+		if err != nil {
+			return nil, err
+		}
+		p.credentials = provider
+	}
+
+	creds, err := p.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+
+	token, err := signMSKToken(ctx, creds, p.config.Region, now)
+	if err != nil {
+		return nil, err
+	}
+	p.token = token
+	p.expires = now.Add(mskTokenLifetime)
+	return &sarama.AccessToken{Token: token}, nil
+}
+
+func (p *mskIAMTokenProvider) loadCredentials(ctx context.Context) (aws.CredentialsProvider, error) {
+	if p.config.AccessKeyID != "" {
+		return aws.NewCredentialsCache(credentials.NewStaticCredentialsProvider(
+			p.config.AccessKeyID, p.config.SecretAccessKey, p.config.SessionToken)), nil
+	}
+
+	opts := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(p.config.Region)}
+	if p.config.CredentialProfileName != "" {
+		opts = append(opts, awsconfig.WithSharedConfigProfile(p.config.CredentialProfileName))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	return cfg.Credentials, nil
+}
+
+// signMSKToken creates a presigned kafka-cluster:Connect request for the
+// MSK endpoint of the region, encoded as base64 URL without padding.
+func signMSKToken(ctx context.Context, creds aws.Credentials, region string, now time.Time) (string, error) {
+	endpoint := url.URL{
+		Scheme: "https",
+		Host:   fmt.Sprintf("kafka.%s.amazonaws.com", region),
+		Path:   "/",
+	}
+	query := url.Values{}
+	query.Set("Action", mskAction)
+	query.Set("X-Amz-Expires", fmt.Sprint(int(mskTokenLifetime.Seconds())))
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	emptyPayload := sha256.Sum256(nil)
+	signed, _, err := v4.NewSigner().PresignHTTP(ctx, creds, req, hex.EncodeToString(emptyPayload[:]), mskSigningName, region, now.UTC())
+	if err != nil {
+		return "", fmt.Errorf("failed to sign MSK authentication token: %w", err)
+	}
+
+	signedURL, err := url.Parse(signed)
+	if err != nil {
+		return "", err
+	}
+	query = signedURL.Query()
+	query.Set("User-Agent", mskUserAgent)
+	signedURL.RawQuery = query.Encode()
+
+	return base64.RawURLEncoding.EncodeToString([]byte(signedURL.String())), nil
+}
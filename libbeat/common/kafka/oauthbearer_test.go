@@ -0,0 +1,117 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/transport/httpcommon"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+func TestClientCredentialsTokenProvider(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%s","token_type":"bearer","expires_in":3600}`, r.Form.Get("client_id"))
+	}))
+	defer srv.Close()
+
+	config := OAuthBearerConfig{
+		TokenURL:   srv.URL,
+		ClientID:   "beats",
+		Extensions: map[string]string{"logicalCluster": "lkc-1"},
+	}
+
+	// the self-signed certificate of the token endpoint is rejected
+	_, err := newOAuthBearerTokenProvider(&config).Token()
+	require.Error(t, err)
+
+	config.Transport = httpcommon.HTTPTransportSettings{
+		TLS: &tlscommon.Config{VerificationMode: tlscommon.VerifyNone},
+	}
+	p := newOAuthBearerTokenProvider(&config)
+	token, err := p.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-beats", token.Token)
+	assert.Equal(t, config.Extensions, token.Extensions)
+}
+
+func TestFileTokenProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+
+	p := newOAuthBearerTokenProvider(&OAuthBearerConfig{TokenFile: path})
+	token, err := p.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "first", token.Token)
+
+	// tokens rotated by an external process are picked up
+	require.NoError(t, os.WriteFile(path, []byte("second"), 0o600))
+	token, err = p.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "second", token.Token)
+}
+
+func TestMSKIAMTokenProvider(t *testing.T) {
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	p := newMSKIAMTokenProvider(&AWSMSKIAMConfig{
+		Region:          "eu-west-1",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+	})
+	p.now = func() time.Time { return now }
+
+	token, err := p.Token()
+	require.NoError(t, err)
+
+	raw, err := base64.RawURLEncoding.DecodeString(token.Token)
+	require.NoError(t, err)
+	u, err := url.Parse(string(raw))
+	require.NoError(t, err)
+
+	assert.Equal(t, "kafka.eu-west-1.amazonaws.com", u.Host)
+	q := u.Query()
+	assert.Equal(t, mskAction, q.Get("Action"))
+	assert.Equal(t, "20230301T120000Z", q.Get("X-Amz-Date"))
+	assert.Contains(t, q.Get("X-Amz-Credential"), "AKIDEXAMPLE/20230301/eu-west-1/kafka-cluster/aws4_request")
+	assert.NotEmpty(t, q.Get("X-Amz-Signature"))
+	assert.Equal(t, mskUserAgent, q.Get("User-Agent"))
+
+	// the token is cached until shortly before it expires
+	now = now.Add(10 * time.Minute)
+	cached, err := p.Token()
+	require.NoError(t, err)
+	assert.Equal(t, token.Token, cached.Token)
+
+	now = now.Add(5 * time.Minute)
+	renewed, err := p.Token()
+	require.NoError(t, err)
+	assert.NotEqual(t, token.Token, renewed.Token)
+}
@@ -18,6 +18,7 @@
 package kafka
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -25,15 +26,29 @@ import (
 )
 
 type SaslConfig struct {
-	SaslMechanism string `config:"mechanism"`
+	SaslMechanism string             `config:"mechanism"`
+	OAuthBearer   *OAuthBearerConfig `config:"oauthbearer"`
+	AWSMSKIAM     *AWSMSKIAMConfig   `config:"aws_msk_iam"`
 }
 
 const (
 	saslTypePlaintext   = sarama.SASLTypePlaintext
 	saslTypeSCRAMSHA256 = sarama.SASLTypeSCRAMSHA256
 	saslTypeSCRAMSHA512 = sarama.SASLTypeSCRAMSHA512
+	saslTypeOAuthBearer = sarama.SASLTypeOAuth
+	saslTypeAWSMSKIAM   = "AWS_MSK_IAM"
 )
 
+// IsTokenBased returns true if the configured mechanism authenticates with
+// tokens instead of a username and password.
+func (c *SaslConfig) IsTokenBased() bool {
+	switch strings.ToUpper(c.SaslMechanism) {
+	case saslTypeOAuthBearer, saslTypeAWSMSKIAM:
+		return true
+	}
+	return false
+}
+
 func (c *SaslConfig) ConfigureSarama(config *sarama.Config) {
 	switch strings.ToUpper(c.SaslMechanism) { // try not to force users to use all upper case
 	case "":
@@ -53,17 +68,34 @@ func (c *SaslConfig) ConfigureSarama(config *sarama.Config) {
 		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
 			return &XDGSCRAMClient{HashGeneratorFcn: SHA512}
 		}
+	case saslTypeOAuthBearer:
+		config.Net.SASL.Handshake = true
+		config.Net.SASL.Mechanism = sarama.SASLMechanism(sarama.SASLTypeOAuth)
+		config.Net.SASL.TokenProvider = newOAuthBearerTokenProvider(c.OAuthBearer)
+	case saslTypeAWSMSKIAM:
+		// Amazon MSK accepts SigV4 signed tokens using the OAUTHBEARER mechanism.
+		config.Net.SASL.Handshake = true
+		config.Net.SASL.Mechanism = sarama.SASLMechanism(sarama.SASLTypeOAuth)
+		config.Net.SASL.TokenProvider = newMSKIAMTokenProvider(c.AWSMSKIAM)
 	default:
 		// This should never happen because `SaslMechanism` is checked on `Validate()`, keeping a panic to detect it earlier if it happens.
-		panic(fmt.Sprintf("not valid SASL mechanism '%v', only supported with PLAIN|SCRAM-SHA-512|SCRAM-SHA-256", c.SaslMechanism))
+		panic(fmt.Sprintf("not valid SASL mechanism '%v', only supported with PLAIN|SCRAM-SHA-512|SCRAM-SHA-256|OAUTHBEARER|AWS_MSK_IAM", c.SaslMechanism))
 	}
 }
 
 func (c *SaslConfig) Validate() error {
 	switch strings.ToUpper(c.SaslMechanism) { // try not to force users to use all upper case
 	case "", saslTypePlaintext, saslTypeSCRAMSHA256, saslTypeSCRAMSHA512:
+	case saslTypeOAuthBearer:
+		if c.OAuthBearer == nil {
+			return errors.New("SASL mechanism OAUTHBEARER requires the oauthbearer settings")
+		}
+	case saslTypeAWSMSKIAM:
+		if c.AWSMSKIAM == nil {
+			return errors.New("SASL mechanism AWS_MSK_IAM requires the aws_msk_iam settings")
+		}
 	default:
-		return fmt.Errorf("not valid SASL mechanism '%v', only supported with PLAIN|SCRAM-SHA-512|SCRAM-SHA-256", c.SaslMechanism)
+		return fmt.Errorf("not valid SASL mechanism '%v', only supported with PLAIN|SCRAM-SHA-512|SCRAM-SHA-256|OAUTHBEARER|AWS_MSK_IAM", c.SaslMechanism)
 	}
 	return nil
 }
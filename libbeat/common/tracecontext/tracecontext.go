@@ -0,0 +1,144 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package tracecontext parses the W3C Trace Context traceparent header, see
+// https://www.w3.org/TR/trace-context/.
+package tracecontext
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// Header is the name of the HTTP header carrying the trace context.
+const Header = "traceparent"
+
+const (
+	versionLen  = 2
+	traceIDLen  = 32
+	parentIDLen = 16
+	flagsLen    = 2
+
+	// length of a version 00 traceparent value
+	traceparentLen = versionLen + 1 + traceIDLen + 1 + parentIDLen + 1 + flagsLen
+
+	flagSampled = 0x01
+)
+
+var (
+	errInvalidFormat  = errors.New("invalid traceparent format")
+	errInvalidVersion = errors.New("invalid traceparent version")
+	errZeroID         = errors.New("traceparent contains an all zero ID")
+
+	traceparentPattern = regexp.MustCompile(`[0-9a-f]{2}-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2}`)
+)
+
+// TraceContext is the parsed value of a traceparent header.
+type TraceContext struct {
+	Version  string
+	TraceID  string
+	ParentID string
+	Flags    byte
+}
+
+// Parse parses a traceparent header value. Values of future versions are
+// accepted as long as they start with the fields defined by version 00.
+func Parse(value string) (TraceContext, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < traceparentLen {
+		return TraceContext{}, errInvalidFormat
+	}
+
+	version := value[:versionLen]
+	if !isHex(version) {
+		return TraceContext{}, errInvalidFormat
+	}
+	switch {
+	case version == "ff":
+		return TraceContext{}, errInvalidVersion
+	case version == "00" && len(value) != traceparentLen:
+		return TraceContext{}, errInvalidFormat
+	case len(value) > traceparentLen && value[traceparentLen] != '-':
+		return TraceContext{}, errInvalidFormat
+	}
+
+	parts := strings.SplitN(value[:traceparentLen], "-", 4)
+	if len(parts) != 4 ||
+		len(parts[1]) != traceIDLen || len(parts[2]) != parentIDLen || len(parts[3]) != flagsLen ||
+		!isHex(parts[1]) || !isHex(parts[2]) || !isHex(parts[3]) {
+		return TraceContext{}, errInvalidFormat
+	}
+	if isZero(parts[1]) || isZero(parts[2]) {
+		return TraceContext{}, errZeroID
+	}
+
+	flags, err := strconv.ParseUint(parts[3], 16, 8)
+	if err != nil {
+		return TraceContext{}, errInvalidFormat
+	}
+
+	return TraceContext{
+		Version:  version,
+		TraceID:  parts[1],
+		ParentID: parts[2],
+		Flags:    byte(flags),
+	}, nil
+}
+
+// Find searches text, for example a log line or a serialized set of
+// headers, for the first valid traceparent value.
+func Find(text string) (TraceContext, bool) {
+	for _, match := range traceparentPattern.FindAllString(text, -1) {
+		if tc, err := Parse(match); err == nil {
+			return tc, true
+		}
+	}
+	return TraceContext{}, false
+}
+
+// Sampled returns true if the caller may have recorded the trace.
+func (tc TraceContext) Sampled() bool {
+	return tc.Flags&flagSampled != 0
+}
+
+// Fields returns the ECS fields of the trace context. The parent ID of the
+// caller is reported as span.id.
+func (tc TraceContext) Fields() mapstr.M {
+	return mapstr.M{
+		"trace": mapstr.M{"id": tc.TraceID},
+		"span":  mapstr.M{"id": tc.ParentID},
+	}
+}
+
+// isHex returns true if s only contains lowercase hexadecimal digits.
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func isZero(s string) bool {
+	return strings.Trim(s, "0") == ""
+}
@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tracecontext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		expected TraceContext
+		err      bool
+	}{
+		"valid": {
+			value: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			expected: TraceContext{
+				Version:  "00",
+				TraceID:  "4bf92f3577b34da6a3ce929d0e0e4736",
+				ParentID: "00f067aa0ba902b7",
+				Flags:    1,
+			},
+		},
+		"future version with extra fields": {
+			value: "cc-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00-extra",
+			expected: TraceContext{
+				Version:  "cc",
+				TraceID:  "4bf92f3577b34da6a3ce929d0e0e4736",
+				ParentID: "00f067aa0ba902b7",
+			},
+		},
+		"version 00 with extra fields": {value: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", err: true},
+		"invalid version ff":           {value: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", err: true},
+		"uppercase":                    {value: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00F067AA0BA902B7-01", err: true},
+		"zero trace id":                {value: "00-00000000000000000000000000000000-00f067aa0ba902b7-01", err: true},
+		"zero parent id":               {value: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01", err: true},
+		"short trace id":               {value: "00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-011", err: true},
+		"too short":                    {value: "00-4bf92f3577b34da6a3ce929d0e0e4736", err: true},
+		"future version bad delimiter": {value: "cc-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00x", err: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc, err := Parse(test.value)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, tc)
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	tc, found := Find(`level=info traceparent=00-00000000000000000000000000000000-00f067aa0ba902b7-01 ` +
+		`traceparent=00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01 msg="done"`)
+	require.True(t, found)
+	assert.True(t, tc.Sampled())
+	assert.Equal(t, mapstr.M{
+		"trace": mapstr.M{"id": "4bf92f3577b34da6a3ce929d0e0e4736"},
+		"span":  mapstr.M{"id": "00f067aa0ba902b7"},
+	}, tc.Fields())
+
+	_, found = Find("no trace context here")
+	assert.False(t, found)
+}
@@ -33,6 +33,10 @@ type Config struct {
 	AlwaysKibana       bool              `config:"always_kibana"`
 	Retry              *Retry            `config:"retry"`
 	StringReplacements map[string]string `config:"string_replacements"`
+	SpaceID            string            `config:"space.id"`
+	Tags               []string          `config:"tags"`
+	TitlePrefix        string            `config:"title_prefix"`
+	Cleanup            bool              `config:"cleanup"`
 }
 
 // Retry handles query retries
@@ -26,6 +26,7 @@ import (
 	errw "github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/dryrun"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/mapstr"
 	"github.com/elastic/elastic-agent-libs/version"
@@ -38,6 +39,30 @@ func ImportDashboards(
 	kibanaConfig, dashboardsConfig *config.C,
 	msgOutputter MessageOutputter,
 	pattern mapstr.M,
+) error {
+	return importDashboards(ctx, beatInfo, homePath, kibanaConfig, dashboardsConfig, msgOutputter, pattern, nil)
+}
+
+// DiffDashboards reports the changes importing the kibana dashboards would
+// apply to the saved objects in Kibana, without importing them.
+func DiffDashboards(
+	ctx context.Context,
+	beatInfo beat.Info, homePath string,
+	kibanaConfig, dashboardsConfig *config.C,
+	msgOutputter MessageOutputter,
+	pattern mapstr.M,
+	reporter *dryrun.Reporter,
+) error {
+	return importDashboards(ctx, beatInfo, homePath, kibanaConfig, dashboardsConfig, msgOutputter, pattern, reporter)
+}
+
+func importDashboards(
+	ctx context.Context,
+	beatInfo beat.Info, homePath string,
+	kibanaConfig, dashboardsConfig *config.C,
+	msgOutputter MessageOutputter,
+	pattern mapstr.M,
+	dryRun *dryrun.Reporter,
 ) error {
 	if dashboardsConfig == nil || !dashboardsConfig.Enabled() {
 		return nil
@@ -56,16 +81,17 @@ func ImportDashboards(
 		return errors.New("kibana configuration missing for loading dashboards")
 	}
 
-	return setupAndImportDashboardsViaKibana(ctx, beatInfo.Hostname, beatInfo.Beat, kibanaConfig, &dashConfig, msgOutputter, pattern)
+	return setupAndImportDashboardsViaKibana(ctx, beatInfo.Hostname, beatInfo.Beat, kibanaConfig, &dashConfig, msgOutputter, pattern, dryRun)
 }
 
 func setupAndImportDashboardsViaKibana(ctx context.Context, hostname, beatname string, kibanaConfig *config.C,
-	dashboardsConfig *Config, msgOutputter MessageOutputter, fields mapstr.M) error {
+	dashboardsConfig *Config, msgOutputter MessageOutputter, fields mapstr.M, dryRun *dryrun.Reporter) error {
 
 	kibanaLoader, err := NewKibanaLoader(ctx, kibanaConfig, dashboardsConfig, hostname, msgOutputter, beatname)
 	if err != nil {
 		return fmt.Errorf("fail to create the Kibana loader: %v", err)
 	}
+	kibanaLoader.dryRun = dryRun
 
 	defer kibanaLoader.Close()
 
@@ -94,6 +120,12 @@ func ImportDashboardsViaKibana(kibanaLoader *KibanaLoader, fields mapstr.M) erro
 		return errw.Wrap(err, "fail to import the dashboards in Kibana")
 	}
 
+	if kibanaLoader.config.Cleanup {
+		if err := kibanaLoader.cleanup(); err != nil {
+			return errw.Wrap(err, "fail to clean up the dashboards of previous versions in Kibana")
+		}
+	}
+
 	return nil
 }
 
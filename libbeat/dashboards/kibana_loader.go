@@ -22,13 +22,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"net/url"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/joeshaw/multierror"
 	"github.com/pkg/errors"
 
+	"github.com/elastic/beats/v7/libbeat/common/dryrun"
 	beatversion "github.com/elastic/beats/v7/libbeat/version"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/kibana"
@@ -51,10 +54,16 @@ type KibanaLoader struct {
 	config        *Config
 	version       version.V
 	hostname      string
+	beatname      string
+	beatVersion   string
 	msgOutputter  MessageOutputter
 	defaultLogger *logp.Logger
 
 	loadedAssets map[string]bool
+
+	// dryRun reports the changes to saved objects instead of importing
+	// them, if set.
+	dryRun *dryrun.Reporter
 }
 
 // NewKibanaLoader creates a new loader to load Kibana files
@@ -64,6 +73,19 @@ func NewKibanaLoader(ctx context.Context, cfg *config.C, dashboardsConfig *Confi
 		return nil, fmt.Errorf("Kibana is not configured or enabled")
 	}
 
+	if dashboardsConfig.SpaceID != "" {
+		// Load the dashboards into the configured space, independent of the
+		// space used by other Kibana setup steps.
+		spaceCfg := config.NewConfig()
+		if err := spaceCfg.Merge(cfg); err != nil {
+			return nil, fmt.Errorf("fail to copy the Kibana configuration: %v", err)
+		}
+		if err := spaceCfg.SetString("space.id", -1, dashboardsConfig.SpaceID); err != nil {
+			return nil, fmt.Errorf("fail to set the Kibana space: %v", err)
+		}
+		cfg = spaceCfg
+	}
+
 	client, err := getKibanaClient(ctx, cfg, dashboardsConfig.Retry, 0, beatname)
 	if err != nil {
 		return nil, fmt.Errorf("Error creating Kibana client: %v", err)
@@ -74,6 +96,8 @@ func NewKibanaLoader(ctx context.Context, cfg *config.C, dashboardsConfig *Confi
 		config:        dashboardsConfig,
 		version:       client.GetVersion(),
 		hostname:      hostname,
+		beatname:      beatname,
+		beatVersion:   beatversion.GetDefaultVersion(),
 		msgOutputter:  msgOutputter,
 		defaultLogger: logp.NewLogger("dashboards"),
 		loadedAssets:  make(map[string]bool, 0),
@@ -139,7 +163,11 @@ func (loader KibanaLoader) ImportIndex(pattern mapstr.M) error {
 		errs = append(errs, errors.Wrapf(err, "error setting index '%s' in index pattern", loader.config.Index))
 	}
 
-	if err := loader.client.ImportMultiPartFormFile(importAPI, params, "index-template.ndjson", pattern.String()); err != nil {
+	if loader.dryRun != nil {
+		if err := loader.diffSavedObjects(pattern.String()); err != nil {
+			errs = append(errs, errors.Wrap(err, "error comparing index pattern"))
+		}
+	} else if err := loader.client.ImportMultiPartFormFile(importAPI, params, "index-template.ndjson", pattern.String()); err != nil {
 		errs = append(errs, errors.Wrap(err, "error loading index pattern"))
 	}
 	return errs.Err()
@@ -169,7 +197,16 @@ func (loader KibanaLoader) ImportDashboard(file string) error {
 		return fmt.Errorf("error getting references of dashboard: %+v", err)
 	}
 
-	if err := loader.client.ImportMultiPartFormFile(importAPI, params, correctExtension(file), dashboardWithReferences); err != nil {
+	dashboardWithReferences, err = loader.decorateSavedObjects(dashboardWithReferences)
+	if err != nil {
+		return fmt.Errorf("error applying title prefix and tags to dashboard: %+v", err)
+	}
+
+	if loader.dryRun != nil {
+		if err := loader.diffSavedObjects(dashboardWithReferences); err != nil {
+			return fmt.Errorf("error comparing dashboard asset: %+v", err)
+		}
+	} else if err := loader.client.ImportMultiPartFormFile(importAPI, params, correctExtension(file), dashboardWithReferences); err != nil {
 		return fmt.Errorf("error dashboard asset: %+v", err)
 	}
 
@@ -242,6 +279,57 @@ func (loader KibanaLoader) formatDashboardAssets(content []byte) []byte {
 	return content
 }
 
+// diffSavedObjects reports the changes importing the ndjson encoded saved
+// objects in content would apply to Kibana.
+func (loader KibanaLoader) diffSavedObjects(content string) error {
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var obj savedObject
+		if err := json.Unmarshal([]byte(line), &obj); err != nil {
+			return fmt.Errorf("fail to decode saved object: %v", err)
+		}
+
+		var current interface{}
+		path := "/api/saved_objects/" + obj.Type + "/" + obj.ID
+		status, body, err := loader.client.Request("GET", path, nil, nil, nil)
+		switch {
+		case status == http.StatusNotFound:
+		case err != nil:
+			return fmt.Errorf("fail to get saved object %s/%s: %v", obj.Type, obj.ID, err)
+		default:
+			var cur savedObject
+			if err := json.Unmarshal(body, &cur); err != nil {
+				return fmt.Errorf("fail to decode saved object %s/%s: %v", obj.Type, obj.ID, err)
+			}
+			current = cur.content()
+		}
+
+		err = loader.dryRun.Update("saved object "+obj.Type+"/"+obj.ID, current, obj.content())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type savedObject struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Attributes json.RawMessage `json:"attributes"`
+	References json.RawMessage `json:"references"`
+}
+
+// content returns the parts of a saved object set by an import.
+func (o savedObject) content() map[string]json.RawMessage {
+	return map[string]json.RawMessage{
+		"attributes": o.Attributes,
+		"references": o.References,
+	}
+}
+
 func correctExtension(file string) string {
 	return filepath.Base(file[:len(file)-len("json")]) + "ndjson"
 }
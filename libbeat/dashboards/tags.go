@@ -0,0 +1,235 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dashboards
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const (
+	// managedTagPrefix prefixes the ID of the tag marking the saved objects
+	// loaded by a beat version, if cleanup is enabled.
+	managedTagPrefix = "beats-managed-"
+
+	tagColor = "#54B399"
+)
+
+// decoratedTypes are the saved object types that get the configured title
+// prefix and tags.
+var decoratedTypes = map[string]bool{
+	"dashboard":     true,
+	"visualization": true,
+	"search":        true,
+	"lens":          true,
+	"map":           true,
+}
+
+type tag struct {
+	id, name string
+}
+
+// tags returns the tags to assign to the loaded saved objects.
+func (loader KibanaLoader) tags() []tag {
+	var tags []tag
+	for _, name := range loader.config.Tags {
+		tags = append(tags, tag{id: "beats-tag-" + slug(name), name: name})
+	}
+	if loader.config.Cleanup {
+		tags = append(tags, loader.managedTag())
+	}
+	return tags
+}
+
+// managedTag returns the tag marking the saved objects loaded by this version
+// of the beat.
+func (loader KibanaLoader) managedTag() tag {
+	return tag{
+		id:   managedTagPrefix + slug(loader.beatname) + "-" + slug(loader.beatVersion),
+		name: loader.beatname + " " + loader.beatVersion,
+	}
+}
+
+// decorateSavedObjects applies the configured title prefix and tags to the
+// ndjson encoded saved objects in content. Tag saved objects not yet loaded
+// are added to the result.
+func (loader KibanaLoader) decorateSavedObjects(content string) (string, error) {
+	tags := loader.tags()
+	if len(tags) == 0 && loader.config.TitlePrefix == "" {
+		return content, nil
+	}
+
+	var result strings.Builder
+	for _, t := range tags {
+		key := "tag:" + t.id
+		if loader.loadedAssets[key] {
+			continue
+		}
+		obj := mapstr.M{
+			"id":   t.id,
+			"type": "tag",
+			"attributes": mapstr.M{
+				"name":        t.name,
+				"description": "",
+				"color":       tagColor,
+			},
+		}
+		result.WriteString(obj.String() + "\n")
+		loader.loadedAssets[key] = true
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var obj mapstr.M
+		if err := json.Unmarshal([]byte(line), &obj); err != nil {
+			return "", fmt.Errorf("fail to decode saved object: %v", err)
+		}
+		if typ, _ := obj["type"].(string); decoratedTypes[typ] {
+			if err := decorateSavedObject(obj, loader.config.TitlePrefix, tags); err != nil {
+				return "", err
+			}
+		}
+		result.WriteString(obj.String() + "\n")
+	}
+	return result.String(), nil
+}
+
+func decorateSavedObject(obj mapstr.M, prefix string, tags []tag) error {
+	if prefix != "" {
+		title, err := obj.GetValue("attributes.title")
+		if s, ok := title.(string); err == nil && ok && !strings.HasPrefix(s, prefix) {
+			if _, err := obj.Put("attributes.title", prefix+s); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+	refs, _ := obj["references"].([]interface{})
+	for _, t := range tags {
+		refs = append(refs, map[string]interface{}{
+			"id":   t.id,
+			"name": "tag-ref-" + t.id,
+			"type": "tag",
+		})
+	}
+	obj["references"] = refs
+	return nil
+}
+
+// cleanup deletes the saved objects loaded by other versions of the beat,
+// together with their managed tags.
+func (loader KibanaLoader) cleanup() error {
+	current := loader.managedTag()
+	prefix := managedTagPrefix + slug(loader.beatname) + "-"
+
+	tags, err := loader.findSavedObjects(url.Values{"type": {"tag"}})
+	if err != nil {
+		return fmt.Errorf("fail to find tags: %v", err)
+	}
+	for _, t := range tags {
+		if !strings.HasPrefix(t.ID, prefix) || t.ID == current.id {
+			continue
+		}
+
+		params := url.Values{"has_reference": {fmt.Sprintf(`{"type":"tag","id":%q}`, t.ID)}}
+		for typ := range decoratedTypes {
+			params.Add("type", typ)
+		}
+		objs, err := loader.findSavedObjects(params)
+		if err != nil {
+			return fmt.Errorf("fail to find saved objects tagged %s: %v", t.ID, err)
+		}
+		for _, obj := range append(objs, t) {
+			if err := loader.deleteSavedObject(obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type savedObjectRef struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+func (loader KibanaLoader) findSavedObjects(params url.Values) ([]savedObjectRef, error) {
+	const perPage = 1000
+
+	var objs []savedObjectRef
+	params.Set("per_page", fmt.Sprint(perPage))
+	params.Set("fields", "title")
+	for page := 1; ; page++ {
+		params.Set("page", fmt.Sprint(page))
+		_, body, err := loader.client.Request("GET", "/api/saved_objects/_find", params, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		var resp struct {
+			SavedObjects []savedObjectRef `json:"saved_objects"`
+			Total        int              `json:"total"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, err
+		}
+		objs = append(objs, resp.SavedObjects...)
+		if len(resp.SavedObjects) < perPage || len(objs) >= resp.Total {
+			return objs, nil
+		}
+	}
+}
+
+func (loader KibanaLoader) deleteSavedObject(obj savedObjectRef) error {
+	path := "/api/saved_objects/" + obj.Type + "/" + url.PathEscape(obj.ID)
+	if loader.dryRun != nil {
+		loader.dryRun.Delete("saved object " + obj.Type + "/" + obj.ID)
+		return nil
+	}
+
+	loader.statusMsg("Deleting saved object %s/%s of a previous version", obj.Type, obj.ID)
+	status, _, err := loader.client.Request("DELETE", path, nil, nil, nil)
+	if err != nil && status != http.StatusNotFound {
+		return fmt.Errorf("fail to delete saved object %s/%s: %v", obj.Type, obj.ID, err)
+	}
+	return nil
+}
+
+// slug returns s in lower case, with all characters other than letters and
+// digits replaced by dashes.
+func slug(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return '-'
+		}
+	}, s)
+}
@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dashboards
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecorateSavedObjects(t *testing.T) {
+	loader := KibanaLoader{
+		config: &Config{
+			Tags:        []string{"Team A"},
+			TitlePrefix: "[A] ",
+			Cleanup:     true,
+		},
+		beatname:     "testbeat",
+		beatVersion:  "8.8.0",
+		loadedAssets: map[string]bool{},
+	}
+
+	content := `{"id":"d1","type":"dashboard","attributes":{"title":"Overview"},"references":[{"id":"v1","name":"panel_0","type":"visualization"}]}
+{"id":"p1","type":"index-pattern","attributes":{"title":"testbeat-*"}}
+`
+	got, err := loader.decorateSavedObjects(content)
+	require.NoError(t, err)
+
+	var objs []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(got), "\n") {
+		var obj map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &obj))
+		objs = append(objs, obj)
+	}
+	require.Len(t, objs, 4)
+
+	assert.Equal(t, "beats-tag-team-a", objs[0]["id"])
+	assert.Equal(t, "tag", objs[0]["type"])
+	assert.Equal(t, "beats-managed-testbeat-8-8-0", objs[1]["id"])
+	assert.Equal(t, "testbeat 8.8.0", objs[1]["attributes"].(map[string]interface{})["name"])
+
+	assert.Equal(t, "[A] Overview", objs[2]["attributes"].(map[string]interface{})["title"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": "v1", "name": "panel_0", "type": "visualization"},
+		map[string]interface{}{"id": "beats-tag-team-a", "name": "tag-ref-beats-tag-team-a", "type": "tag"},
+		map[string]interface{}{"id": "beats-managed-testbeat-8-8-0", "name": "tag-ref-beats-managed-testbeat-8-8-0", "type": "tag"},
+	}, objs[2]["references"])
+
+	// index patterns are not decorated
+	assert.Equal(t, "testbeat-*", objs[3]["attributes"].(map[string]interface{})["title"])
+	assert.Nil(t, objs[3]["references"])
+
+	// tags are only added once, titles are only prefixed once
+	got, err = loader.decorateSavedObjects(`{"id":"d1","type":"dashboard","attributes":{"title":"[A] Overview"}}`)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(got, "\n"))
+	assert.Contains(t, got, `"title":"[A] Overview"`)
+}
+
+func TestDecorateSavedObjectsDisabled(t *testing.T) {
+	loader := KibanaLoader{config: &Config{}, loadedAssets: map[string]bool{}}
+
+	content := `{"id":"d1","type":"dashboard","attributes":{"title":"Overview"}}` + "\n"
+	got, err := loader.decorateSavedObjects(content)
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+}
@@ -0,0 +1,124 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package eslegclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/common/dryrun"
+)
+
+// DryRunConnection wraps a Connection so that requests modifying
+// Elasticsearch are not executed. The changes they would apply are reported
+// instead, compared to the current definition of the resource.
+//
+// Only requests made through Request and LoadJSON are intercepted.
+type DryRunConnection struct {
+	*Connection
+	reporter *dryrun.Reporter
+}
+
+var dryRunResponse = []byte(`{"acknowledged":true}`)
+
+// NewDryRunConnection returns a DryRunConnection reporting changes to reporter.
+func NewDryRunConnection(conn *Connection, reporter *dryrun.Reporter) *DryRunConnection {
+	return &DryRunConnection{Connection: conn, reporter: reporter}
+}
+
+// Request executes GET and HEAD requests. Requests using other methods are
+// reported and not executed.
+func (c *DryRunConnection) Request(
+	method, path string,
+	pipeline string,
+	params map[string]string,
+	body interface{},
+) (int, []byte, error) {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return c.Connection.Request(method, path, pipeline, params, body)
+	}
+	return c.report(method, path, body)
+}
+
+// LoadJSON reports the creation or update of the resource at path.
+func (c *DryRunConnection) LoadJSON(path string, json map[string]interface{}) ([]byte, error) {
+	_, body, err := c.report(http.MethodPut, path, json)
+	return body, err
+}
+
+func (c *DryRunConnection) report(method, path string, body interface{}) (int, []byte, error) {
+	resource := method + " " + path
+	if method == http.MethodDelete {
+		c.reporter.Delete(resource)
+		return http.StatusOK, dryRunResponse, nil
+	}
+
+	var current interface{}
+	status, resp, err := c.Connection.Request(http.MethodGet, path, "", nil, nil)
+	switch {
+	case status == http.StatusNotFound:
+	case err != nil:
+		return status, resp, fmt.Errorf("failed to get current definition of %s: %w", path, err)
+	default:
+		current, err = currentResource(path, resp)
+		if err != nil {
+			return status, resp, fmt.Errorf("failed to decode current definition of %s: %w", path, err)
+		}
+	}
+
+	if err := c.reporter.Update(resource, current, body); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, dryRunResponse, nil
+}
+
+// currentResource extracts the definition of a resource from the response to
+// a GET request, so it can be compared to the body of a PUT request to the
+// same path.
+func currentResource(path string, resp []byte) (interface{}, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(resp, &doc); err != nil {
+		return nil, err
+	}
+
+	name := path[strings.LastIndex(path, "/")+1:]
+	switch {
+	case strings.HasPrefix(path, "/_index_template/"):
+		// {"index_templates":[{"name":"<name>","index_template":{...}}]}
+		templates, _ := doc["index_templates"].([]interface{})
+		if len(templates) == 1 {
+			if t, ok := templates[0].(map[string]interface{}); ok {
+				return t["index_template"], nil
+			}
+		}
+	case strings.HasPrefix(path, "/_ilm/policy/"):
+		// {"<name>":{"version":1,"modified_date":"...","policy":{...}}}
+		if p, ok := doc[name].(map[string]interface{}); ok {
+			return map[string]interface{}{"policy": p["policy"]}, nil
+		}
+	case strings.HasPrefix(path, "/_ingest/pipeline/"):
+		// {"<id>":{...}}
+		if p, ok := doc[name]; ok {
+			return p, nil
+		}
+	}
+	return doc, nil
+}
@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package eslegclient
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common/dryrun"
+)
+
+func TestDryRunConnection(t *testing.T) {
+	var methods []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/_ilm/policy/test":
+			w.Write([]byte(`{"test":{"version":1,"modified_date":"2023-01-01T00:00:00.000Z","policy":{"phases":{}}}}`))
+		case "/_ingest/pipeline/test":
+			w.Write([]byte(`{"test":{"description":"old"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer srv.Close()
+
+	conn, err := NewConnection(ConnectionSettings{URL: srv.URL})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	reporter := dryrun.NewReporter(&buf)
+	client := NewDryRunConnection(conn, reporter)
+
+	status, _, err := client.Request("PUT", "/_ilm/policy/test", "", nil, map[string]interface{}{
+		"policy": map[string]interface{}{"phases": map[string]interface{}{}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+
+	_, err = client.LoadJSON("/_ingest/pipeline/test", map[string]interface{}{"description": "new"})
+	require.NoError(t, err)
+
+	_, _, err = client.Request("PUT", "/_data_stream/test", "", nil, nil)
+	require.NoError(t, err)
+
+	_, _, err = client.Request("DELETE", "/_ingest/pipeline/test", "", nil, nil)
+	require.NoError(t, err)
+
+	status, _, _ = client.Request("HEAD", "/_index_template/test", "", nil, nil)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	assert.Equal(t, []string{
+		"GET /_ilm/policy/test",
+		"GET /_ingest/pipeline/test",
+		"GET /_data_stream/test",
+		"HEAD /_index_template/test",
+	}, methods)
+	assert.Equal(t, 3, reporter.Changes())
+	assert.Contains(t, buf.String(), "unchanged PUT /_ilm/policy/test\n")
+	assert.Contains(t, buf.String(), "-  \"description\": \"old\"\n+  \"description\": \"new\"\n")
+	assert.Contains(t, buf.String(), "create PUT /_data_stream/test\n")
+	assert.Contains(t, buf.String(), "delete DELETE /_ingest/pipeline/test\n")
+}
+
+func TestCurrentResource(t *testing.T) {
+	cases := map[string]struct {
+		path string
+		resp string
+		want interface{}
+	}{
+		"index template": {
+			path: "/_index_template/test",
+			resp: `{"index_templates":[{"name":"test","index_template":{"priority":150}}]}`,
+			want: map[string]interface{}{"priority": float64(150)},
+		},
+		"ilm policy": {
+			path: "/_ilm/policy/test",
+			resp: `{"test":{"version":1,"policy":{"phases":{}}}}`,
+			want: map[string]interface{}{"policy": map[string]interface{}{"phases": map[string]interface{}{}}},
+		},
+		"ingest pipeline": {
+			path: "/_ingest/pipeline/test",
+			resp: `{"test":{"processors":[]}}`,
+			want: map[string]interface{}{"processors": []interface{}{}},
+		},
+		"other": {
+			path: "/_data_stream/test",
+			resp: `{"data_streams":[]}`,
+			want: map[string]interface{}{"data_streams": []interface{}{}},
+		},
+	}
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := currentResource(test.path, []byte(test.resp))
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
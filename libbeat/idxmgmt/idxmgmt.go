@@ -109,6 +109,7 @@ func MakeDefaultSupport(ilmSupport ilm.SupportFactory) SupportFactory {
 
 		cfg := struct {
 			ILM       *config.C        `config:"setup.ilm"`
+			Lifecycle *config.C        `config:"setup.lifecycle"`
 			Template  *config.C        `config:"setup.template"`
 			Output    config.Namespace `config:"output"`
 			Migration *config.C        `config:"migration.6_to_7"`
@@ -129,7 +130,7 @@ func MakeDefaultSupport(ilmSupport ilm.SupportFactory) SupportFactory {
 			return nil, err
 		}
 
-		return newIndexSupport(log, info, ilmSupport, cfg.Template, cfg.ILM, cfg.Migration.Enabled())
+		return newIndexSupport(log, info, ilmSupport, cfg.Template, cfg.ILM, cfg.Lifecycle, cfg.Migration.Enabled())
 	}
 }
 
@@ -106,22 +106,30 @@ func StdSupport(log *logp.Logger, info beat.Info, c *config.C) (Supporter, error
 		Body: DefaultPolicy,
 	}
 	if path := cfg.PolicyFile; path != "" {
-		contents, err := ioutil.ReadFile(path)
+		body, err := LoadPolicyFile(path)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to read policy file '%v'", path)
-		}
-
-		var body map[string]interface{}
-		if err := json.Unmarshal(contents, &body); err != nil {
-			return nil, errors.Wrapf(err, "failed to decode policy file '%v'", path)
+			return nil, err
 		}
-
 		policy.Body = body
 	}
 
 	return NewStdSupport(log, cfg.Enabled, policy, cfg.Overwrite, cfg.CheckExists), nil
 }
 
+// LoadPolicyFile reads and decodes the lifecycle policy in the JSON file at path.
+func LoadPolicyFile(path string) (mapstr.M, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read policy file '%v'", path)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(contents, &body); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode policy file '%v'", path)
+	}
+	return body, nil
+}
+
 // NoopSupport configures a new noop ILM support implementation,
 // should be used when ILM is disabled
 func NoopSupport(_ *logp.Logger, info beat.Info, c *config.C) (Supporter, error) {
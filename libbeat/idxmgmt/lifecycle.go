@@ -0,0 +1,176 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package idxmgmt
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/elastic/beats/v7/libbeat/idxmgmt/ilm"
+	"github.com/elastic/beats/v7/libbeat/template"
+	"github.com/elastic/elastic-agent-libs/config"
+)
+
+// lifecycleConfig holds the per data stream lifecycle policies configured
+// in the setup.lifecycle section.
+type lifecycleConfig struct {
+	Policies []lifecyclePolicyConfig `config:"policies"`
+}
+
+// lifecyclePolicyConfig assigns a lifecycle to a single data stream, for
+// example the data stream an input or module publishes to. The lifecycle is
+// either an ILM policy, optionally loaded from PolicyFile, or a data stream
+// lifecycle with the configured DataRetention.
+type lifecyclePolicyConfig struct {
+	DataStream    string `config:"data_stream" validate:"required"`
+	PolicyName    string `config:"policy_name"`
+	PolicyFile    string `config:"policy_file"`
+	DataRetention string `config:"data_retention"`
+}
+
+// lifecyclePolicy is a validated per data stream lifecycle.
+type lifecyclePolicy struct {
+	dataStream string
+
+	// policy is the ILM policy to apply. A nil Body references an
+	// existing policy which is not loaded by the beat.
+	policy *ilm.Policy
+
+	// dataRetention is the data stream lifecycle retention, only set
+	// if policy is nil.
+	dataRetention string
+}
+
+func (c *lifecycleConfig) Validate() error {
+	seen := make(map[string]bool, len(c.Policies))
+	for _, p := range c.Policies {
+		if seen[p.DataStream] {
+			return fmt.Errorf("duplicate lifecycle policy for data stream %q", p.DataStream)
+		}
+		seen[p.DataStream] = true
+	}
+	return nil
+}
+
+func (c *lifecyclePolicyConfig) Validate() error {
+	switch {
+	case c.PolicyName != "" && c.DataRetention != "":
+		return errors.New("policy_name and data_retention can not be used together")
+	case c.PolicyName == "" && c.DataRetention == "":
+		return errors.New("one of policy_name or data_retention is required")
+	case c.PolicyFile != "" && c.PolicyName == "":
+		return errors.New("policy_file requires policy_name to be set")
+	}
+	return nil
+}
+
+func unpackLifecyclePolicies(cfg *config.C) ([]lifecyclePolicy, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	var lifecycleCfg lifecycleConfig
+	if err := cfg.Unpack(&lifecycleCfg); err != nil {
+		return nil, err
+	}
+
+	policies := make([]lifecyclePolicy, 0, len(lifecycleCfg.Policies))
+	for _, c := range lifecycleCfg.Policies {
+		p := lifecyclePolicy{dataStream: c.DataStream}
+		if c.PolicyName == "" {
+			p.dataRetention = c.DataRetention
+			policies = append(policies, p)
+			continue
+		}
+
+		p.policy = &ilm.Policy{Name: c.PolicyName}
+		if c.PolicyFile != "" {
+			body, err := ilm.LoadPolicyFile(c.PolicyFile)
+			if err != nil {
+				return nil, err
+			}
+			p.policy.Body = body
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+// ensureLifecyclePolicy installs the ILM policy of a data stream. Policies
+// without a body are only checked for existence. The created flag is set if
+// the policy has been written.
+func (m *indexManager) ensureLifecyclePolicy(policy ilm.Policy, overwrite bool) (created bool, err error) {
+	log := m.support.log
+
+	if policy.Body == nil || !overwrite {
+		exists, err := m.clientHandler.HasILMPolicy(policy.Name)
+		if err != nil {
+			return false, err
+		}
+		if policy.Body == nil {
+			if !exists {
+				log.Warnf("ILM policy %v is not loaded by the beat and does not exist yet.", policy.Name)
+			}
+			return false, nil
+		}
+		if exists {
+			log.Infof("ILM policy %v exists already.", policy.Name)
+			return false, nil
+		}
+	}
+
+	if err := m.clientHandler.CreateILMPolicy(policy); err != nil {
+		return false, fmt.Errorf("error loading ILM policy %v: %w", policy.Name, err)
+	}
+	log.Infof("ILM policy %v successfully created.", policy.Name)
+	return true, nil
+}
+
+// lifecycleTemplateConfig derives the template for a data stream with its
+// own lifecycle from the beat's template. The template only matches the
+// data stream and takes precedence over the beat's template.
+func lifecycleTemplateConfig(tmpl template.TemplateConfig, p lifecyclePolicy) template.TemplateConfig {
+	tmpl.Name = p.dataStream
+	tmpl.Pattern = p.dataStream
+	tmpl.Priority++
+
+	idxSettings := make(map[string]interface{}, len(tmpl.Settings.Index)+1)
+	for k, v := range tmpl.Settings.Index {
+		idxSettings[k] = v
+	}
+	tmpl.Settings.Index = idxSettings
+
+	if p.policy == nil {
+		// An ILM policy takes precedence over the data stream lifecycle,
+		// so do not carry over any policy from the beat's template.
+		delete(idxSettings, "lifecycle")
+		tmpl.Lifecycle = map[string]interface{}{"data_retention": p.dataRetention}
+		return tmpl
+	}
+
+	lifecycle := map[string]interface{}{}
+	if m, ok := idxSettings["lifecycle"].(map[string]interface{}); ok {
+		for k, v := range m {
+			lifecycle[k] = v
+		}
+	}
+	lifecycle["name"] = p.policy.Name
+	idxSettings["lifecycle"] = lifecycle
+	tmpl.Lifecycle = nil
+	return tmpl
+}
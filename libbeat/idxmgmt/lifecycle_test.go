@@ -0,0 +1,145 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package idxmgmt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/idxmgmt/ilm"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestIndexManager_SetupLifecyclePolicies(t *testing.T) {
+	policyFile := filepath.Join(t.TempDir(), "nginx.json")
+	err := os.WriteFile(policyFile, []byte(`{"policy":{"phases":{"delete":{"min_age":"30d","actions":{"delete":{}}}}}}`), 0o600)
+	require.NoError(t, err)
+
+	info := beat.Info{Beat: "test", Version: "9.9.9"}
+
+	cases := map[string]struct {
+		policy mapstr.M
+
+		policies     []string
+		idxLifecycle interface{}
+		dsLifecycle  map[string]interface{}
+	}{
+		"ilm policy from file": {
+			policy: mapstr.M{
+				"data_stream": "test-9.9.9-nginx",
+				"policy_name": "nginx",
+				"policy_file": policyFile,
+			},
+			policies:     []string{"test", "nginx"},
+			idxLifecycle: map[string]interface{}{"name": "nginx"},
+		},
+		"existing ilm policy": {
+			policy: mapstr.M{
+				"data_stream": "test-9.9.9-nginx",
+				"policy_name": "nginx",
+			},
+			policies:     []string{"test"},
+			idxLifecycle: map[string]interface{}{"name": "nginx"},
+		},
+		"data stream lifecycle": {
+			policy: mapstr.M{
+				"data_stream":    "test-9.9.9-nginx",
+				"data_retention": "7d",
+			},
+			policies:    []string{"test"},
+			dsLifecycle: map[string]interface{}{"data_retention": "7d"},
+		},
+	}
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := config.MustNewConfigFrom(mapstr.M{
+				"setup.lifecycle.policies": []mapstr.M{test.policy},
+			})
+			im, err := MakeDefaultSupport(ilm.StdSupport)(nil, info, cfg)
+			require.NoError(t, err)
+
+			clientHandler := newMockClientHandler()
+			manager := im.Manager(clientHandler, BeatsAssets([]byte("testbeat fields")))
+			err = manager.Setup(LoadModeUnset, LoadModeUnset)
+			require.NoError(t, err)
+			clientHandler.assertInvariants(t)
+
+			assert.Equal(t, test.policies, clientHandler.policies)
+			require.Len(t, clientHandler.templates, 2)
+
+			base, stream := clientHandler.templates[0], clientHandler.templates[1]
+			assert.Equal(t, "test-9.9.9-nginx", stream.Name)
+			assert.Equal(t, "test-9.9.9-nginx", stream.Pattern)
+			assert.Equal(t, base.Priority+1, stream.Priority)
+			assert.True(t, stream.Overwrite)
+			assert.Equal(t, test.idxLifecycle, stream.Settings.Index["lifecycle"])
+			assert.Equal(t, test.dsLifecycle, stream.Lifecycle)
+		})
+	}
+}
+
+func TestIndexManager_LifecyclePoliciesConfig(t *testing.T) {
+	info := beat.Info{Beat: "test", Version: "9.9.9"}
+
+	cases := map[string]mapstr.M{
+		"missing data stream": {
+			"setup.lifecycle.policies": []mapstr.M{{"policy_name": "nginx"}},
+		},
+		"missing lifecycle": {
+			"setup.lifecycle.policies": []mapstr.M{{"data_stream": "nginx"}},
+		},
+		"policy name and data retention": {
+			"setup.lifecycle.policies": []mapstr.M{{
+				"data_stream":    "nginx",
+				"policy_name":    "nginx",
+				"data_retention": "7d",
+			}},
+		},
+		"policy file without policy name": {
+			"setup.lifecycle.policies": []mapstr.M{{
+				"data_stream":    "nginx",
+				"data_retention": "7d",
+				"policy_file":    "nginx.json",
+			}},
+		},
+		"duplicate data stream": {
+			"setup.lifecycle.policies": []mapstr.M{
+				{"data_stream": "nginx", "data_retention": "7d"},
+				{"data_stream": "nginx", "policy_name": "nginx"},
+			},
+		},
+		"json template": {
+			"setup.template.json.enabled": true,
+			"setup.lifecycle.policies": []mapstr.M{
+				{"data_stream": "nginx", "data_retention": "7d"},
+			},
+		},
+	}
+	for name, cfg := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := MakeDefaultSupport(ilm.StdSupport)(nil, info, config.MustNewConfigFrom(cfg))
+			assert.Error(t, err)
+		})
+	}
+}
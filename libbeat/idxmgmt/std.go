@@ -39,6 +39,7 @@ type indexSupport struct {
 	info         beat.Info
 	migration    bool
 	templateCfg  template.TemplateConfig
+	lifecycle    []lifecyclePolicy
 	defaultIndex string
 
 	st indexState
@@ -94,6 +95,7 @@ func newIndexSupport(
 	ilmFactory ilm.SupportFactory,
 	tmplConfig *config.C,
 	ilmConfig *config.C,
+	lifecycleConfig *config.C,
 	migration bool,
 ) (*indexSupport, error) {
 	if ilmFactory == nil {
@@ -110,11 +112,20 @@ func newIndexSupport(
 		return nil, err
 	}
 
+	lifecycle, err := unpackLifecyclePolicies(lifecycleConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(lifecycle) != 0 && tmplCfg.JSON.Enabled {
+		return nil, errors.New("setup.lifecycle.policies can not be used with setup.template.json")
+	}
+
 	return &indexSupport{
 		log:          log,
 		ilm:          ilmSupporter,
 		info:         info,
 		templateCfg:  tmplCfg,
+		lifecycle:    lifecycle,
 		migration:    migration,
 		defaultIndex: fmt.Sprintf("%v-%v", info.IndexPrefix, info.Version),
 	}, nil
@@ -245,6 +256,19 @@ func (m *indexManager) Setup(loadTemplate, loadILM LoadMode) error {
 		if policyCreated && templateComponent.enabled {
 			templateComponent.overwrite = true
 		}
+
+		for _, p := range m.support.lifecycle {
+			if p.policy == nil {
+				continue
+			}
+			policyCreated, err := m.ensureLifecyclePolicy(*p.policy, ilmComponent.overwrite)
+			if err != nil {
+				return err
+			}
+			if policyCreated && templateComponent.enabled {
+				templateComponent.overwrite = true
+			}
+		}
 	}
 
 	if templateComponent.load {
@@ -264,6 +288,16 @@ func (m *indexManager) Setup(loadTemplate, loadILM LoadMode) error {
 		}
 
 		log.Info("Loaded index template.")
+
+		for _, p := range m.support.lifecycle {
+			tmplCfg := lifecycleTemplateConfig(m.support.templateCfg, p)
+			tmplCfg.Overwrite, tmplCfg.Enabled = templateComponent.overwrite, templateComponent.enabled
+			err = m.clientHandler.Load(tmplCfg, m.support.info, fields, m.support.migration)
+			if err != nil {
+				return fmt.Errorf("error loading template for data stream %v: %w", p.dataStream, err)
+			}
+			log.Infof("Loaded index template for data stream %v.", p.dataStream)
+		}
 	}
 
 	return nil
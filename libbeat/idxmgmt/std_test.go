@@ -39,6 +39,10 @@ type mockClientHandler struct {
 	tmplCfg   *template.TemplateConfig
 	tmplForce bool
 
+	// all loaded policies and templates in order
+	policies  []string
+	templates []template.TemplateConfig
+
 	operations []mockCreateOp
 }
 
@@ -453,6 +457,7 @@ func (h *mockClientHandler) Load(config template.TemplateConfig, _ beat.Info, fi
 	h.recordOp(mockCreateTemplate)
 	h.tmplForce = config.Overwrite
 	h.tmplCfg = &config
+	h.templates = append(h.templates, config)
 	return nil
 }
 
@@ -467,6 +472,7 @@ func (h *mockClientHandler) HasILMPolicy(name string) (bool, error) {
 func (h *mockClientHandler) CreateILMPolicy(policy ilm.Policy) error {
 	h.recordOp(mockCreatePolicy)
 	h.policy = policy.Name
+	h.policies = append(h.policies, policy.Name)
 	return nil
 }
 
@@ -0,0 +1,154 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mime
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+)
+
+const (
+	mimeZip  = "application/zip"
+	mimeTar  = "application/x-tar"
+	mimeGzip = "application/gzip"
+)
+
+// IsArchive reports whether entries of archives of the given mime type
+// can be inspected with DetectArchiveBytes.
+func IsArchive(mimeType string) bool {
+	switch mimeType {
+	case mimeZip, mimeTar, mimeGzip:
+		return true
+	}
+	return false
+}
+
+// DetectArchiveBytes detects the mime types of the entries of a zip, tar or
+// gzip archive of the given mime type. Every mime type is reported once, in
+// the order it was first seen. At most maxEntries entries are inspected and
+// at most maxSize bytes are decompressed, so that compression bombs do not
+// exhaust memory. Entries that are archives themselves are not inspected.
+func DetectArchiveBytes(mimeType string, data []byte, maxSize int64, maxEntries int) ([]string, error) {
+	d := &archiveDetector{maxEntries: maxEntries, seen: map[string]bool{}}
+	switch mimeType {
+	case mimeZip:
+		return d.types, d.zip(data)
+	case mimeTar:
+		return d.types, d.tar(io.LimitReader(bytes.NewReader(data), maxSize))
+	case mimeGzip:
+		return d.types, d.gzip(data, maxSize)
+	}
+	return nil, nil
+}
+
+type archiveDetector struct {
+	maxEntries int
+	entries    int
+	seen       map[string]bool
+	types      []string
+}
+
+func (d *archiveDetector) zip(data []byte) error {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return err
+	}
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		if d.entries >= d.maxEntries {
+			return nil
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		err = d.entry(rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *archiveDetector) tar(r io.Reader) error {
+	tr := tar.NewReader(r)
+	for d.entries < d.maxEntries {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := d.entry(tr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *archiveDetector) gzip(data []byte, maxSize int64) error {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	// A gzip stream holds a single file, which commonly is a tar archive.
+	r := io.LimitReader(zr, maxSize)
+	header := make([]byte, maxHeaderSize)
+	n, err := io.ReadFull(r, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return err
+	}
+	header = header[:n]
+	if DetectBytes(header) == mimeTar {
+		return d.tar(io.MultiReader(bytes.NewReader(header), r))
+	}
+	d.add(header)
+	return nil
+}
+
+func (d *archiveDetector) entry(r io.Reader) error {
+	header, err := io.ReadAll(io.LimitReader(r, maxHeaderSize))
+	if err != nil {
+		return err
+	}
+	d.add(header)
+	return nil
+}
+
+func (d *archiveDetector) add(header []byte) {
+	d.entries++
+	mimeType := DetectBytes(header)
+	if mimeType == "" || d.seen[mimeType] {
+		return
+	}
+	d.seen[mimeType] = true
+	d.types = append(d.types, mimeType)
+}
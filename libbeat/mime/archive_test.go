@@ -0,0 +1,153 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mime
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type archiveEntry struct {
+	name, body string
+}
+
+var archiveEntries = []archiveEntry{
+	{"index.html", "<html>Test</html>"},
+	{"data.json", `{"hello": "world"}`},
+	{"other.json", `[]`},
+	{"readme.txt", "Hello world!"},
+}
+
+func TestDetectArchiveBytes(t *testing.T) {
+	tarball := makeTar(t, archiveEntries)
+	tests := []struct {
+		name         string
+		data         []byte
+		maxSize      int64
+		maxEntries   int
+		expectedType string
+		innerTypes   []string
+	}{
+		{
+			name:         "zip",
+			data:         makeZip(t, archiveEntries),
+			maxSize:      1 << 20,
+			maxEntries:   10,
+			expectedType: "application/zip",
+			innerTypes:   []string{"text/html; charset=utf-8", "application/json", "text/plain; charset=utf-8"},
+		},
+		{
+			name:         "tar",
+			data:         tarball,
+			maxSize:      1 << 20,
+			maxEntries:   10,
+			expectedType: "application/x-tar",
+			innerTypes:   []string{"text/html; charset=utf-8", "application/json", "text/plain; charset=utf-8"},
+		},
+		{
+			name:         "tar.gz",
+			data:         makeGzip(t, tarball),
+			maxSize:      1 << 20,
+			maxEntries:   10,
+			expectedType: "application/gzip",
+			innerTypes:   []string{"text/html; charset=utf-8", "application/json", "text/plain; charset=utf-8"},
+		},
+		{
+			name:         "gzip",
+			data:         makeGzip(t, []byte(`{"hello": "world"}`)),
+			maxSize:      1 << 20,
+			maxEntries:   10,
+			expectedType: "application/gzip",
+			innerTypes:   []string{"application/json"},
+		},
+		{
+			name:         "max entries",
+			data:         makeZip(t, archiveEntries),
+			maxSize:      1 << 20,
+			maxEntries:   2,
+			expectedType: "application/zip",
+			innerTypes:   []string{"text/html; charset=utf-8", "application/json"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mimeType := DetectBytes(test.data)
+			require.Equal(t, test.expectedType, mimeType)
+			require.True(t, IsArchive(mimeType))
+
+			innerTypes, err := DetectArchiveBytes(mimeType, test.data, test.maxSize, test.maxEntries)
+			require.NoError(t, err)
+			require.Equal(t, test.innerTypes, innerTypes)
+		})
+	}
+}
+
+func TestDetectArchiveBytesLimitsDecompressedSize(t *testing.T) {
+	bomb := makeGzip(t, makeTar(t, []archiveEntry{
+		{"zeros", string(make([]byte, 1<<20))},
+		{"readme.txt", "Hello world!"},
+	}))
+
+	_, err := DetectArchiveBytes("application/gzip", bomb, 64*1024, 10)
+	require.Error(t, err)
+}
+
+func TestDetectArchiveBytesCorrupt(t *testing.T) {
+	data := makeZip(t, archiveEntries)
+	_, err := DetectArchiveBytes("application/zip", data[:len(data)/2], 1<<20, 10)
+	require.Error(t, err)
+}
+
+func makeZip(t *testing.T, entries []archiveEntry) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, e := range entries {
+		f, err := w.Create(e.name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(e.body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func makeTar(t *testing.T, entries []archiveEntry) []byte {
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for _, e := range entries {
+		require.NoError(t, w.WriteHeader(&tar.Header{Name: e.name, Mode: 0o644, Size: int64(len(e.body)), Typeflag: tar.TypeReg}))
+		_, err := w.Write([]byte(e.body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func makeGzip(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
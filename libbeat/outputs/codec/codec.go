@@ -22,3 +22,17 @@ import "github.com/elastic/beats/v7/libbeat/beat"
 type Codec interface {
 	Encode(index string, event *beat.Event) ([]byte, error)
 }
+
+// BinaryCodec is implemented by codecs producing self-delimiting binary
+// encodings. Outputs writing the encoded events to a stream must not separate
+// them with newlines.
+type BinaryCodec interface {
+	Codec
+	IsBinary() bool
+}
+
+// IsBinary reports whether the codec produces a binary encoding.
+func IsBinary(c Codec) bool {
+	bc, ok := c.(BinaryCodec)
+	return ok && bc.IsBinary()
+}
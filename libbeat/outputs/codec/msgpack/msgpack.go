@@ -0,0 +1,113 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package msgpack
+
+import (
+	ugorjicodec "github.com/ugorji/go/codec"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs/codec"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/go-structform/gotype"
+)
+
+// Encoder for serializing a beat.Event to MessagePack.
+type Encoder struct {
+	buf      []byte
+	unfolder *gotype.Unfolder
+	folder   *gotype.Iterator
+	handle   ugorjicodec.MsgpackHandle
+
+	version string
+	config  Config
+}
+
+// Config is used to pass encoding parameters to New.
+type Config struct {
+	LocalTime bool `config:"local_time"`
+}
+
+var defaultConfig = Config{
+	LocalTime: false,
+}
+
+func init() {
+	codec.RegisterType("msgpack", func(info beat.Info, cfg *config.C) (codec.Codec, error) {
+		config := defaultConfig
+		if cfg != nil {
+			if err := cfg.Unpack(&config); err != nil {
+				return nil, err
+			}
+		}
+
+		return New(info.Version, config), nil
+	})
+}
+
+// New creates a new MessagePack Encoder.
+func New(version string, config Config) *Encoder {
+	e := &Encoder{version: version, config: config}
+	// use the str8 and bin types of the current MessagePack spec
+	e.handle.WriteExt = true
+	e.reset()
+	return e
+}
+
+func (e *Encoder) reset() {
+	var err error
+	e.unfolder, err = gotype.NewUnfolder(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	// Events are normalized into generic maps first, such that timestamps
+	// are encoded the same way as by the json codec.
+	e.folder, err = gotype.NewIterator(e.unfolder,
+		gotype.Folders(
+			codec.MakeUTCOrLocalTimestampEncoder(e.config.LocalTime),
+			codec.MakeBCTimestampEncoder(),
+		),
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Encode serializes a beat event to MessagePack. It adds additional metadata
+// in the `@metadata` namespace.
+func (e *Encoder) Encode(index string, event *beat.Event) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := e.unfolder.SetTarget(&doc); err != nil {
+		return nil, err
+	}
+	if err := e.folder.Fold(codec.MakeEvent(index, e.version, event)); err != nil {
+		e.reset()
+		return nil, err
+	}
+
+	e.buf = e.buf[:0]
+	if err := ugorjicodec.NewEncoderBytes(&e.buf, &e.handle).Encode(doc); err != nil {
+		return nil, err
+	}
+	return e.buf, nil
+}
+
+// IsBinary implements codec.BinaryCodec.
+func (e *Encoder) IsBinary() bool {
+	return true
+}
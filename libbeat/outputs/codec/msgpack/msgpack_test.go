@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package msgpack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ugorjicodec "github.com/ugorji/go/codec"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestMsgpackCodec(t *testing.T) {
+	encoder := New("1.2.3", defaultConfig)
+	ts := time.Date(2022, 1, 2, 3, 4, 5, 6000000, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		out, err := encoder.Encode("test", &beat.Event{
+			Timestamp: ts,
+			Meta:      mapstr.M{"pipeline": "p"},
+			Fields:    mapstr.M{"msg": "message", "count": 42, "nested": mapstr.M{"ok": true}},
+		})
+		require.NoError(t, err)
+
+		var decoded map[string]interface{}
+		handle := &ugorjicodec.MsgpackHandle{}
+		handle.RawToString = true
+		require.NoError(t, ugorjicodec.NewDecoderBytes(out, handle).Decode(&decoded), "MessagePack decoding failed")
+
+		assert.EqualValues(t, "2022-01-02T03:04:05.006Z", decoded["@timestamp"])
+		assert.EqualValues(t, "message", decoded["msg"])
+		assert.EqualValues(t, 42, decoded["count"])
+
+		metadata, ok := decoded["@metadata"].(map[interface{}]interface{})
+		require.True(t, ok)
+		assert.EqualValues(t, "test", metadata["beat"])
+		assert.EqualValues(t, "1.2.3", metadata["version"])
+		assert.EqualValues(t, "p", metadata["pipeline"])
+	}
+}
@@ -145,13 +145,20 @@ func (c *console) publishEvent(event *publisher.Event) bool {
 		return false
 	}
 
-	if err := c.writeBuffer(nl); err != nil {
-		c.observer.WriteError(err)
-		c.log.Errorf("Error when appending newline to event: %+v", err)
-		return false
+	written := len(serializedEvent)
+
+	// binary codecs are self-delimiting, only text encodings are separated
+	// by newlines
+	if !codec.IsBinary(c.codec) {
+		if err := c.writeBuffer(nl); err != nil {
+			c.observer.WriteError(err)
+			c.log.Errorf("Error when appending newline to event: %+v", err)
+			return false
+		}
+		written++
 	}
 
-	c.observer.WriteBytes(len(serializedEvent) + 1)
+	c.observer.WriteBytes(written)
 	return true
 }
 
@@ -35,6 +35,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/outputs/codec"
 	"github.com/elastic/beats/v7/libbeat/outputs/codec/format"
 	"github.com/elastic/beats/v7/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/v7/libbeat/outputs/codec/msgpack"
 	"github.com/elastic/beats/v7/libbeat/outputs/outest"
 	"github.com/elastic/beats/v7/libbeat/publisher"
 	"github.com/elastic/elastic-agent-libs/mapstr"
@@ -128,6 +129,18 @@ func TestConsoleOutput(t *testing.T) {
 	}
 }
 
+func TestConsoleOutputBinaryCodec(t *testing.T) {
+	evt := beat.Event{Fields: event("field", "value")}
+	encoded, err := msgpack.New("1.2.3", msgpack.Config{}).Encode("test", &evt)
+	assert.NoError(t, err)
+
+	// binary codecs are self-delimiting, no newline is appended
+	batch := outest.NewBatch(evt)
+	out, err := run(msgpack.New("1.2.3", msgpack.Config{}), batch)
+	assert.NoError(t, err)
+	assert.Equal(t, string(encoded), out)
+}
+
 func run(codec codec.Codec, batches ...publisher.Batch) (string, error) {
 	return withStdout(func() {
 		c, _ := newConsole("test", outputs.NewNilObserver(), codec)
@@ -54,6 +54,7 @@ type Client struct {
 
 	observer           outputs.Observer
 	NonIndexableAction string
+	IdempotencyKeyAsID bool
 
 	log *logp.Logger
 }
@@ -65,6 +66,10 @@ type ClientSettings struct {
 	Pipeline           *outil.Selector
 	Observer           outputs.Observer
 	NonIndexableAction string
+
+	// IdempotencyKeyAsID uses the idempotency key of the events as document
+	// ID, if the event has no explicit ID.
+	IdempotencyKeyAsID bool
 }
 
 type bulkResultStats struct {
@@ -138,6 +143,7 @@ func NewClient(
 		pipeline:           pipeline,
 		observer:           s.Observer,
 		NonIndexableAction: s.NonIndexableAction,
+		IdempotencyKeyAsID: s.IdempotencyKeyAsID,
 
 		log: logp.NewLogger("elasticsearch"),
 	}
@@ -178,6 +184,7 @@ func (client *Client) Clone() *Client {
 			Index:              client.index,
 			Pipeline:           client.pipeline,
 			NonIndexableAction: client.NonIndexableAction,
+			IdempotencyKeyAsID: client.IdempotencyKeyAsID,
 		},
 		nil, // XXX: do not pass connection callback?
 	)
@@ -340,6 +347,11 @@ func (client *Client) createEventBulkMeta(version version.V, event *beat.Event)
 	}
 
 	id, _ := events.GetMetaStringValue(*event, events.FieldMetaID)
+	if id == "" && client.IdempotencyKeyAsID {
+		// Documents are created with the key as ID, so that events published
+		// again after a failure are reported as duplicates by Elasticsearch.
+		id, _ = events.GetMetaStringValue(*event, events.FieldMetaIdempotencyKey)
+	}
 	opType := events.GetOpType(*event)
 
 	meta := eslegclient.BulkMeta{
@@ -777,6 +777,46 @@ func TestBulkEncodeEventsWithOpType(t *testing.T) {
 
 }
 
+func TestBulkEncodeEventsWithIdempotencyKey(t *testing.T) {
+	cfg := c.MustNewConfigFrom(mapstr.M{})
+	info := beat.Info{
+		IndexPrefix: "test",
+		Version:     version.GetDefaultVersion(),
+	}
+
+	im, err := idxmgmt.DefaultSupport(nil, info, c.NewConfig())
+	require.NoError(t, err)
+
+	index, pipeline, err := buildSelectors(im, info, cfg)
+	require.NoError(t, err)
+
+	events := []publisher.Event{
+		{Content: beat.Event{Meta: mapstr.M{e.FieldMetaIdempotencyKey: "key-1"}, Fields: mapstr.M{"message": "test 1"}}},
+		{Content: beat.Event{Meta: mapstr.M{e.FieldMetaIdempotencyKey: "key-2", e.FieldMetaID: "112"}, Fields: mapstr.M{"message": "test 2"}}},
+		{Content: beat.Event{Fields: mapstr.M{"message": "test 3"}}},
+	}
+
+	client, _ := NewClient(
+		ClientSettings{
+			Observer:           outputs.NewNilObserver(),
+			Index:              index,
+			Pipeline:           pipeline,
+			IdempotencyKeyAsID: true,
+		},
+		nil,
+	)
+
+	encoded, bulkItems := client.bulkEncodePublishRequest(*libversion.MustNew(version.GetDefaultVersion()), events)
+	require.Equal(t, len(events), len(encoded), "all events should have been encoded")
+	require.Equal(t, 6, len(bulkItems), "incomplete bulk")
+
+	for i, id := range []string{"key-1", "112", ""} {
+		action, ok := bulkItems[i*2].(eslegclient.BulkCreateAction)
+		require.True(t, ok, "event %d is not created", i)
+		assert.Equal(t, id, action.Create.ID)
+	}
+}
+
 func TestClientWithAPIKey(t *testing.T) {
 	var headers http.Header
 
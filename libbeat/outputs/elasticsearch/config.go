@@ -43,10 +43,28 @@ type elasticsearchConfig struct {
 	Backoff            Backoff           `config:"backoff"`
 	NonIndexablePolicy *config.Namespace `config:"non_indexable_policy"`
 	AllowOlderVersion  bool              `config:"allow_older_versions"`
+	IdempotencyKeyAsID bool              `config:"idempotency_key_as_id"`
+	Standby            standbyConfig     `config:"standby"`
 
 	Transport httpcommon.HTTPTransportSettings `config:",inline"`
 }
 
+// standbyConfig configures the standby host group the output fails over to
+// when none of the primary hosts is available. Credentials default to the
+// credentials of the primary hosts.
+type standbyConfig struct {
+	Hosts            []string      `config:"hosts"`
+	Username         string        `config:"username"`
+	Password         string        `config:"password"`
+	APIKey           string        `config:"api_key"`
+	FallbackInterval time.Duration `config:"fallback_interval" validate:"nonzero,positive"`
+}
+
+// enabled returns true if a standby host group is configured.
+func (c standbyConfig) enabled() bool {
+	return len(c.Hosts) > 0
+}
+
 type Backoff struct {
 	Init time.Duration
 	Max  time.Duration
@@ -73,6 +91,9 @@ var (
 			Init: 1 * time.Second,
 			Max:  60 * time.Second,
 		},
+		Standby: standbyConfig{
+			FallbackInterval: 5 * time.Minute,
+		},
 		Transport: httpcommon.DefaultHTTPTransportSettings(),
 	}
 )
@@ -81,6 +102,9 @@ func (c *elasticsearchConfig) Validate() error {
 	if c.APIKey != "" && (c.Username != "" || c.Password != "") {
 		return fmt.Errorf("cannot set both api_key and username/password")
 	}
+	if c.Standby.APIKey != "" && (c.Standby.Username != "" || c.Standby.Password != "") {
+		return fmt.Errorf("cannot set both standby.api_key and standby.username/password")
+	}
 
 	return nil
 }
@@ -19,6 +19,7 @@ package elasticsearch
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -104,3 +105,27 @@ func readConfig(cfg *conf.C) (*elasticsearchConfig, error) {
 	}
 	return &c, nil
 }
+
+func TestStandbyConfig(t *testing.T) {
+	c := conf.MustNewConfigFrom(`
+standby.hosts: ["standby:9200"]
+`)
+	config, err := readConfig(c)
+	if err != nil {
+		t.Fatalf("Can't create test configuration from valid input")
+	}
+	assert.True(t, config.Standby.enabled())
+	assert.Equal(t, 5*time.Minute, config.Standby.FallbackInterval)
+
+	config, err = readConfig(conf.MustNewConfigFrom(`hosts: ["localhost:9200"]`))
+	if err != nil {
+		t.Fatalf("Can't create test configuration from valid input")
+	}
+	assert.False(t, config.Standby.enabled())
+
+	_, err = readConfig(conf.MustNewConfigFrom(`
+standby.hosts: ["standby:9200"]
+standby.fallback_interval: 0
+`))
+	assert.Error(t, err)
+}
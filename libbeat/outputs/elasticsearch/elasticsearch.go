@@ -82,46 +82,114 @@ func makeES(
 		}
 	}
 
-	clients := make([]outputs.NetworkClient, len(hosts))
-	for i, host := range hosts {
-		esURL, err := common.MakeURL(config.Protocol, config.Path, host, 9200)
-		if err != nil {
-			log.Errorf("Invalid host param set: %s, Error: %+v", host, err)
-			return outputs.Fail(err)
+	makeClients := func(hosts []string, username, password, apiKey string) ([]outputs.NetworkClient, error) {
+		clients := make([]outputs.NetworkClient, len(hosts))
+		for i, host := range hosts {
+			esURL, err := common.MakeURL(config.Protocol, config.Path, host, 9200)
+			if err != nil {
+				log.Errorf("Invalid host param set: %s, Error: %+v", host, err)
+				return nil, err
+			}
+
+			client, err := NewClient(ClientSettings{
+				ConnectionSettings: eslegclient.ConnectionSettings{
+					URL:              esURL,
+					Beatname:         beat.Beat,
+					Kerberos:         config.Kerberos,
+					Username:         username,
+					Password:         password,
+					APIKey:           apiKey,
+					Parameters:       params,
+					Headers:          config.Headers,
+					CompressionLevel: config.CompressionLevel,
+					Observer:         observer,
+					EscapeHTML:       config.EscapeHTML,
+					Transport:        config.Transport,
+				},
+				Index:              index,
+				Pipeline:           pipeline,
+				Observer:           observer,
+				NonIndexableAction: policy.action(),
+				IdempotencyKeyAsID: config.IdempotencyKeyAsID,
+			}, &connectCallbackRegistry)
+			if err != nil {
+				return nil, err
+			}
+			clients[i] = client
 		}
+		return clients, nil
+	}
 
-		var client outputs.NetworkClient
-		client, err = NewClient(ClientSettings{
-			ConnectionSettings: eslegclient.ConnectionSettings{
-				URL:              esURL,
-				Beatname:         beat.Beat,
-				Kerberos:         config.Kerberos,
-				Username:         config.Username,
-				Password:         config.Password,
-				APIKey:           config.APIKey,
-				Parameters:       params,
-				Headers:          config.Headers,
-				CompressionLevel: config.CompressionLevel,
-				Observer:         observer,
-				EscapeHTML:       config.EscapeHTML,
-				Transport:        config.Transport,
-			},
-			Index:              index,
-			Pipeline:           pipeline,
-			Observer:           observer,
-			NonIndexableAction: policy.action(),
-		}, &connectCallbackRegistry)
+	if config.Standby.enabled() {
+		clients, err := makeHostGroupClients(log, observer, config, hosts, makeClients)
 		if err != nil {
 			return outputs.Fail(err)
 		}
+		return outputs.SuccessNet(config.LoadBalance, config.BulkMaxSize, config.MaxRetries, clients)
+	}
 
-		client = outputs.WithBackoff(client, config.Backoff.Init, config.Backoff.Max)
-		clients[i] = client
+	clients, err := makeClients(hosts, config.Username, config.Password, config.APIKey)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+	for i, client := range clients {
+		clients[i] = outputs.WithBackoff(client, config.Backoff.Init, config.Backoff.Max)
 	}
 
 	return outputs.SuccessNet(config.LoadBalance, config.BulkMaxSize, config.MaxRetries, clients)
 }
 
+// makeHostGroupClients creates the clients for an output with a standby host
+// group. With loadbalance enabled one client per primary host is created,
+// each preferring a different primary host. Otherwise a single client
+// failing over between all hosts is created.
+//
+// The Elasticsearch clients are not safe for concurrent use, so every worker
+// owns a client for each primary and standby host. With N primary and M
+// standby hosts, N*(N+M) clients are created when loadbalance is enabled, but
+// every worker keeps only a single connection open at a time, plus a
+// short-lived connection while probing the primary hosts.
+func makeHostGroupClients(
+	log *logp.Logger,
+	observer outputs.Observer,
+	config elasticsearchConfig,
+	hosts []string,
+	makeClients func(hosts []string, username, password, apiKey string) ([]outputs.NetworkClient, error),
+) ([]outputs.NetworkClient, error) {
+	standby := config.Standby
+	username, password, apiKey := config.Username, config.Password, config.APIKey
+	if standby.Username != "" || standby.Password != "" || standby.APIKey != "" {
+		username, password, apiKey = standby.Username, standby.Password, standby.APIKey
+	}
+
+	if obs, ok := observer.(outputs.HostGroupObserver); ok {
+		obs.RegisterHostGroups(primaryHostGroup, standbyHostGroup)
+	}
+
+	workers := 1
+	if config.LoadBalance {
+		workers = len(hosts)
+	}
+
+	clients := make([]outputs.NetworkClient, workers)
+	for i := range clients {
+		// rotate the primary hosts, such that each worker prefers another host
+		rotated := append(append([]string{}, hosts[i:]...), hosts[:i]...)
+		primary, err := makeClients(rotated, config.Username, config.Password, config.APIKey)
+		if err != nil {
+			return nil, err
+		}
+		secondary, err := makeClients(standby.Hosts, username, password, apiKey)
+		if err != nil {
+			return nil, err
+		}
+
+		client := newHostGroupClient(log, observer, primary, secondary, standby.FallbackInterval)
+		clients[i] = outputs.WithBackoff(client, config.Backoff.Init, config.Backoff.Max)
+	}
+	return clients, nil
+}
+
 func buildSelectors(
 	im outputs.IndexManager,
 	beat beat.Info,
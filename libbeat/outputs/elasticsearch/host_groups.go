@@ -0,0 +1,283 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joeshaw/multierror"
+
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/testing"
+)
+
+const (
+	primaryHostGroup = "primary"
+	standbyHostGroup = "standby"
+)
+
+var errNoActiveHostGroup = errors.New("no active host group")
+
+// hostGroup is an ordered list of clients connecting to the hosts of one
+// cluster.
+type hostGroup struct {
+	name    string
+	clients []outputs.NetworkClient
+	next    int
+}
+
+// hostGroupClient publishes events to the primary host group and fails over
+// to the standby host group if none of the primary hosts can be connected to.
+// While the standby group is active, the client periodically tries to connect
+// to the primary group in the background and falls back to it once it is
+// healthy again.
+type hostGroupClient struct {
+	log              *logp.Logger
+	observer         outputs.HostGroupObserver
+	groups           []*hostGroup
+	fallbackInterval time.Duration
+
+	active      outputs.NetworkClient
+	activeGroup int
+	probe       *fallbackProbe
+}
+
+// fallbackProbe connects to the primary host group in the background.
+type fallbackProbe struct {
+	connected chan outputs.NetworkClient
+	done      chan struct{}
+	wg        sync.WaitGroup
+}
+
+func newHostGroupClient(
+	log *logp.Logger,
+	observer outputs.Observer,
+	primary, standby []outputs.NetworkClient,
+	fallbackInterval time.Duration,
+) *hostGroupClient {
+	obs, _ := observer.(outputs.HostGroupObserver)
+	return &hostGroupClient{
+		log:      log,
+		observer: obs,
+		groups: []*hostGroup{
+			{name: primaryHostGroup, clients: primary},
+			{name: standbyHostGroup, clients: standby},
+		},
+		fallbackInterval: fallbackInterval,
+		activeGroup:      -1,
+	}
+}
+
+// Connect connects to the first healthy host, trying all hosts of the primary
+// group before failing over to the standby group.
+func (c *hostGroupClient) Connect() error {
+	var errs multierror.Errors
+	for i := range c.groups {
+		client, err := c.connectGroup(i)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		c.activate(i, client)
+		return nil
+	}
+	return errs.Err()
+}
+
+func (c *hostGroupClient) connectGroup(idx int) (outputs.NetworkClient, error) {
+	group := c.groups[idx]
+	if len(group.clients) == 0 {
+		return nil, fmt.Errorf("%s host group: %w", group.name, outputs.ErrNoConnectionConfigured)
+	}
+
+	var errs multierror.Errors
+	for range group.clients {
+		client := group.clients[group.next]
+		group.next = (group.next + 1) % len(group.clients)
+
+		if err := client.Connect(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return client, nil
+	}
+	return nil, fmt.Errorf("all hosts of the %s host group failed: %w", group.name, errs.Err())
+}
+
+func (c *hostGroupClient) activate(idx int, client outputs.NetworkClient) {
+	previous := c.activeGroup
+	c.active = client
+	c.activeGroup = idx
+
+	name := c.groups[idx].name
+	if c.observer != nil {
+		c.observer.HostGroupConnected(name)
+	}
+	if idx != 0 {
+		c.startProbe()
+	}
+	if previous == idx {
+		return
+	}
+
+	switch {
+	case idx != 0:
+		c.log.Warnf("All hosts of the %s host group are unavailable, failed over to the %s host group (%s)",
+			c.groups[0].name, name, client)
+		if c.observer != nil {
+			c.observer.HostGroupFailover()
+		}
+	case previous > 0:
+		c.log.Infof("Fell back to the %s host group (%s)", name, client)
+		if c.observer != nil {
+			c.observer.HostGroupFallback()
+		}
+	}
+}
+
+func (c *hostGroupClient) Close() error {
+	if c.active == nil {
+		return errNoActiveHostGroup
+	}
+	return c.closeActive()
+}
+
+func (c *hostGroupClient) closeActive() error {
+	c.stopProbe()
+	err := c.active.Close()
+	c.active = nil
+	if c.observer != nil {
+		c.observer.HostGroupDisconnected(c.groups[c.activeGroup].name)
+	}
+	return err
+}
+
+func (c *hostGroupClient) Publish(ctx context.Context, batch publisher.Batch) error {
+	if c.active == nil {
+		batch.Retry()
+		return errNoActiveHostGroup
+	}
+
+	if c.probe != nil {
+		select {
+		case client := <-c.probe.connected:
+			c.fallback(client)
+		default:
+		}
+	}
+	return c.active.Publish(ctx, batch)
+}
+
+// fallback switches to a client of the primary host group the probe has
+// connected to.
+func (c *hostGroupClient) fallback(client outputs.NetworkClient) {
+	if err := c.closeActive(); err != nil {
+		c.log.Debugf("Failed to close connection to the %s host group: %v", c.groups[c.activeGroup].name, err)
+	}
+	c.activate(0, client)
+}
+
+// startProbe starts trying to connect to the primary host group every
+// fallback interval. The probe stops after the first successful connection,
+// which is picked up by the next call to Publish.
+func (c *hostGroupClient) startProbe() {
+	if c.probe != nil || c.fallbackInterval <= 0 {
+		return
+	}
+
+	probe := &fallbackProbe{
+		connected: make(chan outputs.NetworkClient, 1),
+		done:      make(chan struct{}),
+	}
+	probe.wg.Add(1)
+	go func() {
+		defer probe.wg.Done()
+
+		ticker := time.NewTicker(c.fallbackInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-probe.done:
+				return
+			case <-ticker.C:
+			}
+
+			client, err := c.connectGroup(0)
+			if err != nil {
+				c.log.Debugf("Primary host group still unavailable: %v", err)
+				continue
+			}
+			probe.connected <- client
+			return
+		}
+	}()
+	c.probe = probe
+}
+
+// stopProbe stops the probe and closes the connection to the primary host
+// group it has established, if it has not been picked up yet.
+func (c *hostGroupClient) stopProbe() {
+	probe := c.probe
+	if probe == nil {
+		return
+	}
+	c.probe = nil
+
+	close(probe.done)
+	probe.wg.Wait()
+	select {
+	case client := <-probe.connected:
+		if err := client.Close(); err != nil {
+			c.log.Debugf("Failed to close connection to the %s host group: %v", c.groups[0].name, err)
+		}
+	default:
+	}
+}
+
+func (c *hostGroupClient) Test(d testing.Driver) {
+	for _, group := range c.groups {
+		for i, client := range group.clients {
+			tc, ok := client.(testing.Testable)
+			d.Run(fmt.Sprintf("%s host group client %d", group.name, i), func(d testing.Driver) {
+				if !ok {
+					d.Fatal("output", errors.New("client doesn't support testing"))
+				}
+				tc.Test(d)
+			})
+		}
+	}
+}
+
+func (c *hostGroupClient) String() string {
+	groups := make([]string, len(c.groups))
+	for i, group := range c.groups {
+		names := make([]string, len(group.clients))
+		for j, client := range group.clients {
+			names[j] = client.String()
+		}
+		groups[i] = group.name + "(" + strings.Join(names, ",") + ")"
+	}
+	return "host_groups(" + strings.Join(groups, ",") + ")"
+}
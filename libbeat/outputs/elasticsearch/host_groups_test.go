@@ -0,0 +1,129 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+type hostGroupTestClient struct {
+	name      string
+	healthy   atomic.Bool
+	published int
+}
+
+func newHostGroupTestClient(name string, healthy bool) *hostGroupTestClient {
+	c := &hostGroupTestClient{name: name}
+	c.healthy.Store(healthy)
+	return c
+}
+
+func (c *hostGroupTestClient) Connect() error {
+	if !c.healthy.Load() {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func (c *hostGroupTestClient) Close() error { return nil }
+
+func (c *hostGroupTestClient) Publish(_ context.Context, batch publisher.Batch) error {
+	c.published++
+	return nil
+}
+
+func (c *hostGroupTestClient) String() string { return c.name }
+
+func TestHostGroupClient(t *testing.T) {
+	reg := monitoring.NewRegistry()
+	stats := outputs.NewStats(reg)
+	assert.Nil(t, reg.Get("host_group.failovers"))
+	stats.RegisterHostGroups(primaryHostGroup, standbyHostGroup)
+
+	connections := func(group string) int64 {
+		return reg.Get("host_group." + group + ".connections").(*monitoring.Int).Get()
+	}
+	counter := func(name string) uint64 {
+		return reg.Get("host_group." + name).(*monitoring.Uint).Get()
+	}
+
+	primary1 := newHostGroupTestClient("p1", false)
+	primary2 := newHostGroupTestClient("p2", true)
+	standby := newHostGroupTestClient("s1", true)
+
+	client := newHostGroupClient(logp.NewLogger(logSelector), stats,
+		[]outputs.NetworkClient{primary1, primary2},
+		[]outputs.NetworkClient{standby},
+		time.Millisecond)
+
+	// the second primary host is healthy, no failover
+	require.NoError(t, client.Connect())
+	require.NoError(t, client.Publish(context.Background(), nil))
+	assert.Equal(t, 1, primary2.published)
+	assert.Equal(t, int64(1), connections(primaryHostGroup))
+
+	// all primary hosts fail, fail over to standby
+	primary2.healthy.Store(false)
+	require.NoError(t, client.Close())
+	require.NoError(t, client.Connect())
+	assert.Equal(t, int64(0), connections(primaryHostGroup))
+	assert.Equal(t, int64(1), connections(standbyHostGroup))
+	assert.Equal(t, uint64(1), counter("failovers"))
+
+	// primary is still down, keep publishing to standby
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, client.Publish(context.Background(), nil))
+	assert.Equal(t, 1, standby.published)
+
+	// primary recovers, fall back once the background probe has connected
+	primary1.healthy.Store(true)
+	probe := client.probe
+	require.NotNil(t, probe)
+	require.Eventually(t, func() bool { return len(probe.connected) == 1 }, time.Second, time.Millisecond)
+	require.NoError(t, client.Publish(context.Background(), nil))
+	assert.Equal(t, 1, primary1.published)
+	assert.Equal(t, 1, standby.published)
+	assert.Equal(t, int64(1), connections(primaryHostGroup))
+	assert.Equal(t, int64(0), connections(standbyHostGroup))
+	assert.Equal(t, uint64(1), counter("fallbacks"))
+	assert.Nil(t, client.probe)
+
+	require.NoError(t, client.Close())
+}
+
+func TestHostGroupClientAllUnavailable(t *testing.T) {
+	client := newHostGroupClient(logp.NewLogger(logSelector), nil,
+		[]outputs.NetworkClient{newHostGroupTestClient("p1", false)},
+		[]outputs.NetworkClient{newHostGroupTestClient("s1", false)},
+		time.Minute)
+
+	require.Error(t, client.Connect())
+	require.Error(t, client.Close())
+}
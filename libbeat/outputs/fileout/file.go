@@ -135,7 +135,13 @@ func (out *fileOutput) Publish(_ context.Context, batch publisher.Batch) error {
 			continue
 		}
 
-		if _, err = out.rotator.Write(append(serializedEvent, '\n')); err != nil {
+		// binary codecs are self-delimiting, only text encodings are
+		// separated by newlines
+		if !codec.IsBinary(out.codec) {
+			serializedEvent = append(serializedEvent, '\n')
+		}
+
+		if _, err = out.rotator.Write(serializedEvent); err != nil {
 			st.WriteError(err)
 
 			if event.Guaranteed() {
@@ -148,7 +154,7 @@ func (out *fileOutput) Publish(_ context.Context, batch publisher.Batch) error {
 			continue
 		}
 
-		st.WriteBytes(len(serializedEvent) + 1)
+		st.WriteBytes(len(serializedEvent))
 	}
 
 	st.Dropped(dropped)
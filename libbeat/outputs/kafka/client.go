@@ -29,6 +29,7 @@ import (
 	"github.com/Shopify/sarama"
 	"github.com/eapache/go-resiliency/breaker"
 
+	"github.com/elastic/beats/v7/libbeat/beat/events"
 	"github.com/elastic/beats/v7/libbeat/common/fmtstr"
 	"github.com/elastic/beats/v7/libbeat/outputs"
 	"github.com/elastic/beats/v7/libbeat/outputs/codec"
@@ -58,6 +59,10 @@ type client struct {
 	wg sync.WaitGroup
 }
 
+// idempotencyKeyHeader is the record header set to the idempotency key of the
+// events, if the key is enabled.
+const idempotencyKeyHeader = "idempotency-key"
+
 type msgRef struct {
 	client *client
 	count  int32
@@ -212,9 +217,12 @@ func (c *client) getEventMessage(data *publisher.Event) (*message, error) {
 	}
 
 	if msg.topic == "" {
-		topic, err := c.topic.Select(event)
-		if err != nil {
-			return nil, fmt.Errorf("setting kafka topic failed with %v", err)
+		topic, err := events.GetMetaStringValue(*event, events.FieldMetaTopic)
+		if err != nil || topic == "" {
+			topic, err = c.topic.Select(event)
+			if err != nil {
+				return nil, fmt.Errorf("setting kafka topic failed with %v", err)
+			}
 		}
 		if topic == "" {
 			return nil, errNoTopicsSelected
@@ -242,12 +250,25 @@ func (c *client) getEventMessage(data *publisher.Event) (*message, error) {
 		msg.ts = event.Timestamp
 	}
 
-	if c.key != nil {
+	if key, err := events.GetMetaStringValue(*event, events.FieldMetaPartitionKey); err == nil && key != "" {
+		msg.key = []byte(key)
+	} else if c.key != nil {
 		if key, err := c.key.RunBytes(event); err == nil {
 			msg.key = key
 		}
 	}
 
+	// record headers have been added to kafka with version 0.11.0.0
+	if key, err := events.GetMetaStringValue(*event, events.FieldMetaIdempotencyKey); err == nil && key != "" &&
+		c.config.Version.IsAtLeast(sarama.V0_11_0_0) {
+		headers := make([]sarama.RecordHeader, 0, len(c.recordHeaders)+1)
+		headers = append(headers, c.recordHeaders...)
+		msg.headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(idempotencyKeyHeader),
+			Value: []byte(key),
+		})
+	}
+
 	return msg, nil
 }
 
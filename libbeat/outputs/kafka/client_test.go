@@ -0,0 +1,133 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/fmtstr"
+	"github.com/elastic/beats/v7/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestGetEventMessageMetadata(t *testing.T) {
+	cases := map[string]struct {
+		meta      mapstr.M
+		wantTopic string
+		wantKey   string
+	}{
+		"configured": {
+			wantTopic: "logs-from-event",
+			wantKey:   "host-1",
+		},
+		"topic from metadata": {
+			meta:      mapstr.M{"topic": "audit"},
+			wantTopic: "audit",
+			wantKey:   "host-1",
+		},
+		"partition key from metadata": {
+			meta:      mapstr.M{"partition_key": "tenant-a"},
+			wantTopic: "logs-from-event",
+			wantKey:   "tenant-a",
+		},
+		"empty metadata values are ignored": {
+			meta:      mapstr.M{"topic": "", "partition_key": ""},
+			wantTopic: "logs-from-event",
+			wantKey:   "host-1",
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			topic, err := buildTopicSelector(config.MustNewConfigFrom(map[string]interface{}{
+				"topic": "logs-%{[field]}",
+			}))
+			require.NoError(t, err)
+
+			c := &client{
+				log:    logp.NewLogger(logSelector),
+				topic:  topic,
+				key:    fmtstr.MustCompileEvent("%{[host.name]}"),
+				codec:  json.New("1.2.3", json.Config{}),
+				config: *sarama.NewConfig(),
+			}
+			msg, err := c.getEventMessage(&publisher.Event{
+				Content: beat.Event{
+					Meta: test.meta,
+					Fields: mapstr.M{
+						"field": "from-event",
+						"host":  mapstr.M{"name": "host-1"},
+					},
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, test.wantTopic, msg.topic)
+			assert.Equal(t, test.wantKey, string(msg.key))
+		})
+	}
+}
+
+func TestGetEventMessageIdempotencyKey(t *testing.T) {
+	topic, err := buildTopicSelector(config.MustNewConfigFrom(map[string]interface{}{
+		"topic": "logs",
+	}))
+	require.NoError(t, err)
+
+	sc := sarama.NewConfig()
+	sc.Version = sarama.V1_0_0_0
+	c := &client{
+		log:           logp.NewLogger(logSelector),
+		topic:         topic,
+		codec:         json.New("1.2.3", json.Config{}),
+		config:        *sc,
+		recordHeaders: []sarama.RecordHeader{{Key: []byte("k"), Value: []byte("v")}},
+	}
+
+	msg, err := c.getEventMessage(&publisher.Event{
+		Content: beat.Event{Meta: mapstr.M{"idempotency_key": "abc-1"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []sarama.RecordHeader{
+		{Key: []byte("k"), Value: []byte("v")},
+		{Key: []byte("idempotency-key"), Value: []byte("abc-1")},
+	}, msg.headers)
+	assert.Len(t, c.recordHeaders, 1)
+
+	msg, err = c.getEventMessage(&publisher.Event{Content: beat.Event{}})
+	require.NoError(t, err)
+	assert.Nil(t, msg.headers)
+
+	// Record headers require Kafka 0.11.
+	c.config.Version = sarama.V0_10_2_0
+	msg, err = c.getEventMessage(&publisher.Event{
+		Content: beat.Event{Meta: mapstr.M{"idempotency_key": "abc-2"}},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, msg.headers)
+}
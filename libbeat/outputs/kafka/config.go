@@ -228,6 +228,10 @@ func newSaramaConfig(log *logp.Logger, config *kafkaConfig) (*sarama.Config, err
 			DisablePAFXFAST:    !enableFAST,
 		}
 
+	case config.Sasl.IsTokenBased():
+		k.Net.SASL.Enable = true
+		config.Sasl.ConfigureSarama(k)
+
 	case config.Username != "":
 		k.Net.SASL.Enable = true
 		k.Net.SASL.User = config.Username
@@ -61,6 +61,28 @@ func TestConfigAcceptValid(t *testing.T) {
 				"realm":        "ELASTIC",
 			},
 		},
+		"OAUTHBEARER with client credentials": mapstr.M{
+			"sasl": mapstr.M{
+				"mechanism": "OAUTHBEARER",
+				"oauthbearer": mapstr.M{
+					"token_url":     "https://idp.example.com/token",
+					"client_id":     "beats",
+					"client_secret": "secret",
+				},
+			},
+		},
+		"OAUTHBEARER with token file": mapstr.M{
+			"sasl": mapstr.M{
+				"mechanism":   "OAUTHBEARER",
+				"oauthbearer": mapstr.M{"token_file": "/run/secrets/kafka-token"},
+			},
+		},
+		"AWS MSK IAM": mapstr.M{
+			"sasl": mapstr.M{
+				"mechanism":   "AWS_MSK_IAM",
+				"aws_msk_iam": mapstr.M{"region": "us-east-1"},
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -89,6 +111,25 @@ func TestConfigInvalid(t *testing.T) {
 				"realm":        "ELASTIC",
 			},
 		},
+		"OAUTHBEARER without token provider": mapstr.M{
+			"sasl": mapstr.M{"mechanism": "OAUTHBEARER"},
+		},
+		"OAUTHBEARER with token_url and token_file": mapstr.M{
+			"sasl": mapstr.M{
+				"mechanism": "OAUTHBEARER",
+				"oauthbearer": mapstr.M{
+					"token_url":  "https://idp.example.com/token",
+					"client_id":  "beats",
+					"token_file": "/run/secrets/kafka-token",
+				},
+			},
+		},
+		"AWS MSK IAM without region": mapstr.M{
+			"sasl": mapstr.M{
+				"mechanism":   "AWS_MSK_IAM",
+				"aws_msk_iam": mapstr.M{"access_key_id": "id", "secret_access_key": "secret"},
+			},
+		},
 	}
 
 	for name, test := range tests {
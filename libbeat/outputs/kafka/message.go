@@ -34,6 +34,9 @@ type message struct {
 	ref   *msgRef
 	ts    time.Time
 
+	// headers overwrites the record headers of the client, if set
+	headers []sarama.RecordHeader
+
 	hash      uint32
 	partition int32
 
@@ -51,7 +54,9 @@ func (m *message) initProducerMessage() {
 		Timestamp: m.ts,
 	}
 
-	if m.ref != nil {
+	if m.headers != nil {
+		m.msg.Headers = m.headers
+	} else if m.ref != nil {
 		m.msg.Headers = m.ref.client.recordHeaders
 	}
 }
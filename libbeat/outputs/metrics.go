@@ -46,6 +46,15 @@ type Stats struct {
 
 	readBytes  *monitoring.Uint // total amount of bytes read
 	readErrors *monitoring.Uint // total number of errors while waiting for response on output
+
+	//
+	// Output host group stats, only registered by outputs configured with
+	// multiple host groups
+	//
+	reg                *monitoring.Registry
+	hostGroupConns     map[string]*monitoring.Int // number of connections per host group
+	hostGroupFailovers *monitoring.Uint           // total number of failovers from the primary host group
+	hostGroupFallbacks *monitoring.Uint           // total number of fallbacks to the primary host group
 }
 
 // NewStats creates a new Stats instance using a backing monitoring registry.
@@ -69,6 +78,8 @@ func NewStats(reg *monitoring.Registry) *Stats {
 
 		readBytes:  monitoring.NewUint(reg, "read.bytes"),
 		readErrors: monitoring.NewUint(reg, "read.errors"),
+
+		reg: reg,
 	}
 }
 
@@ -164,3 +175,46 @@ func (s *Stats) ReadBytes(n int) {
 		s.readBytes.Add(uint64(n))
 	}
 }
+
+// RegisterHostGroups registers the host group metrics. It must be called
+// once, before the output clients are started.
+func (s *Stats) RegisterHostGroups(names ...string) {
+	if s == nil || s.hostGroupConns != nil {
+		return
+	}
+
+	s.hostGroupConns = make(map[string]*monitoring.Int, len(names))
+	for _, name := range names {
+		s.hostGroupConns[name] = monitoring.NewInt(s.reg, "host_group."+name+".connections")
+	}
+	s.hostGroupFailovers = monitoring.NewUint(s.reg, "host_group.failovers")
+	s.hostGroupFallbacks = monitoring.NewUint(s.reg, "host_group.fallbacks")
+}
+
+// HostGroupConnected increases the number of connections to a host group.
+func (s *Stats) HostGroupConnected(name string) {
+	if s != nil && s.hostGroupConns[name] != nil {
+		s.hostGroupConns[name].Inc()
+	}
+}
+
+// HostGroupDisconnected decreases the number of connections to a host group.
+func (s *Stats) HostGroupDisconnected(name string) {
+	if s != nil && s.hostGroupConns[name] != nil {
+		s.hostGroupConns[name].Dec()
+	}
+}
+
+// HostGroupFailover increases the host group failover metrics.
+func (s *Stats) HostGroupFailover() {
+	if s != nil && s.hostGroupFailovers != nil {
+		s.hostGroupFailovers.Inc()
+	}
+}
+
+// HostGroupFallback increases the host group fallback metrics.
+func (s *Stats) HostGroupFallback() {
+	if s != nil && s.hostGroupFallbacks != nil {
+		s.hostGroupFallbacks.Inc()
+	}
+}
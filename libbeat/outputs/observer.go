@@ -34,6 +34,17 @@ type Observer interface {
 	ErrTooMany(int)   // report too many requests response
 }
 
+// HostGroupObserver is an optional interface an Observer can implement to
+// report the connections of outputs supporting failover between groups of
+// hosts.
+type HostGroupObserver interface {
+	RegisterHostGroups(names ...string) // register the host groups of the output
+	HostGroupConnected(name string)     // report a connection to a host group has been established
+	HostGroupDisconnected(name string)  // report a connection to a host group has been closed
+	HostGroupFailover()                 // report a connection failed over from the primary to another host group
+	HostGroupFallback()                 // report a connection fell back to the primary host group
+}
+
 type emptyObserver struct{}
 
 var nilObserver = (*emptyObserver)(nil)
@@ -84,5 +84,14 @@ func Load(
 	if stats == nil {
 		stats = NewNilObserver()
 	}
-	return factory(im, info, stats, config)
+
+	group, err := factory(im, info, stats, config)
+	if err != nil {
+		return group, err
+	}
+	group, err = withOutputProcessors(config, group, stats)
+	if err != nil {
+		return group, err
+	}
+	return withOutputPacing(config, group)
 }
@@ -0,0 +1,178 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outputs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/testing"
+)
+
+// PacingConfig limits the rate at which an output publishes events. Up to
+// Events events are published per Window, and up to Burst events can be
+// published at once after the output has been idle.
+type PacingConfig struct {
+	Events int           `config:"events" validate:"min=0"`
+	Window time.Duration `config:"window" validate:"nonzero,positive"`
+	Burst  int           `config:"burst" validate:"min=0"`
+}
+
+func defaultPacingConfig() PacingConfig {
+	return PacingConfig{
+		Window: time.Second,
+	}
+}
+
+// withOutputPacing wraps the clients of the group such that they share the
+// event rate configured in the `pacing` setting of the output, if any.
+func withOutputPacing(cfg *config.C, group Group) (Group, error) {
+	if !cfg.HasField("pacing") {
+		return group, nil
+	}
+
+	settings := struct {
+		Pacing PacingConfig `config:"pacing"`
+	}{Pacing: defaultPacingConfig()}
+	if err := cfg.Unpack(&settings); err != nil {
+		return Group{}, err
+	}
+	if settings.Pacing.Events == 0 {
+		return group, nil
+	}
+
+	burst := settings.Pacing.Burst
+	if burst == 0 {
+		burst = group.BatchSize
+	}
+	if burst == 0 {
+		burst = settings.Pacing.Events
+	}
+	limit := rate.Limit(float64(settings.Pacing.Events) / settings.Pacing.Window.Seconds())
+	pacer := newPacer(rate.NewLimiter(limit, burst))
+
+	log := logp.NewLogger("output.pacing")
+	log.Infof("Pacing output to %d events per %v with a burst of %d events",
+		settings.Pacing.Events, settings.Pacing.Window, burst)
+	for i, client := range group.Clients {
+		group.Clients[i] = newPacingClient(client, pacer)
+	}
+	return group, nil
+}
+
+// pacer delays the publication of batches, such that the clients sharing it
+// do not exceed the rate of its limiter. Waiting is aborted once the first
+// client sharing the pacer is closed.
+type pacer struct {
+	limiter   *rate.Limiter
+	ctx       context.Context
+	cancel    context.CancelFunc
+	closeOnce sync.Once
+}
+
+func newPacer(limiter *rate.Limiter) *pacer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &pacer{limiter: limiter, ctx: ctx, cancel: cancel}
+}
+
+// wait blocks until n events can be published. Batches larger than the
+// burst of the limiter are published once enough tokens for the whole
+// batch have accumulated, one burst at a time.
+func (p *pacer) wait(ctx context.Context, n int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-p.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	burst := p.limiter.Burst()
+	for n > 0 {
+		tokens := n
+		if tokens > burst {
+			tokens = burst
+		}
+		if err := p.limiter.WaitN(ctx, tokens); err != nil {
+			return err
+		}
+		n -= tokens
+	}
+	return nil
+}
+
+func (p *pacer) close() {
+	p.closeOnce.Do(p.cancel)
+}
+
+func newPacingClient(client Client, pacer *pacer) Client {
+	pc := &pacingClient{client: client, pacer: pacer}
+	if nc, ok := client.(NetworkClient); ok {
+		return &pacingNetworkClient{pacingClient: pc, client: nc}
+	}
+	return pc
+}
+
+type pacingClient struct {
+	client Client
+	pacer  *pacer
+}
+
+type pacingNetworkClient struct {
+	*pacingClient
+	client NetworkClient
+}
+
+func (c *pacingNetworkClient) Connect() error {
+	return c.client.Connect()
+}
+
+func (c *pacingClient) Close() error {
+	c.pacer.close()
+	return c.client.Close()
+}
+
+func (c *pacingClient) Publish(ctx context.Context, batch publisher.Batch) error {
+	if err := c.pacer.wait(ctx, len(batch.Events())); err != nil {
+		// The output is shutting down, return the batch to the queue.
+		batch.Cancelled()
+		return err
+	}
+	return c.client.Publish(ctx, batch)
+}
+
+func (c *pacingClient) Test(d testing.Driver) {
+	tc, ok := c.client.(testing.Testable)
+	if !ok {
+		d.Fatal("output", errors.New("client doesn't support testing"))
+	}
+	tc.Test(d)
+}
+
+func (c *pacingClient) String() string {
+	return "pacing(" + c.client.String() + ")"
+}
@@ -0,0 +1,167 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outputs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs/outest"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+type countingClient struct {
+	published int
+}
+
+func (c *countingClient) Close() error   { return nil }
+func (c *countingClient) String() string { return "counting" }
+
+func (c *countingClient) Publish(_ context.Context, batch publisher.Batch) error {
+	c.published += len(batch.Events())
+	batch.ACK()
+	return nil
+}
+
+func newPacingBatch(n int) *outest.Batch {
+	events := make([]beat.Event, n)
+	for i := range events {
+		events[i] = beat.Event{Fields: mapstr.M{"message": i}}
+	}
+	return outest.NewBatch(events...)
+}
+
+func TestWithOutputPacing(t *testing.T) {
+	tests := map[string]struct {
+		config    map[string]interface{}
+		batchSize int
+		paced     bool
+		burst     int
+	}{
+		"no pacing": {
+			config: map[string]interface{}{},
+		},
+		"disabled": {
+			config: map[string]interface{}{"pacing.events": 0},
+		},
+		"batch size burst": {
+			config:    map[string]interface{}{"pacing.events": 1000, "pacing.window": "1m"},
+			batchSize: 50,
+			paced:     true,
+			burst:     50,
+		},
+		"events burst": {
+			config: map[string]interface{}{"pacing.events": 1000},
+			paced:  true,
+			burst:  1000,
+		},
+		"configured burst": {
+			config:    map[string]interface{}{"pacing.events": 1000, "pacing.burst": 10},
+			batchSize: 50,
+			paced:     true,
+			burst:     10,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			group := Group{Clients: []Client{&countingClient{}, &countingClient{}}, BatchSize: test.batchSize}
+			group, err := withOutputPacing(config.MustNewConfigFrom(test.config), group)
+			require.NoError(t, err)
+
+			first, paced := group.Clients[0].(*pacingClient)
+			assert.Equal(t, test.paced, paced)
+			if !paced {
+				return
+			}
+			second := group.Clients[1].(*pacingClient)
+			assert.Same(t, first.pacer, second.pacer, "clients of a group must share the pacer")
+			assert.Equal(t, test.burst, first.pacer.limiter.Burst())
+		})
+	}
+}
+
+func TestWithOutputPacingInvalid(t *testing.T) {
+	for name, cfg := range map[string]map[string]interface{}{
+		"negative events": {"pacing.events": -1},
+		"zero window":     {"pacing.events": 10, "pacing.window": 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := withOutputPacing(config.MustNewConfigFrom(cfg), Group{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPacingClient(t *testing.T) {
+	inner := &countingClient{}
+	group, err := withOutputPacing(config.MustNewConfigFrom(map[string]interface{}{
+		"pacing.events": 100,
+		"pacing.window": "1s",
+		"pacing.burst":  10,
+	}), Group{Clients: []Client{inner}})
+	require.NoError(t, err)
+	client := group.Clients[0]
+
+	// The first burst is published immediately, the remaining 10 events
+	// of the batch are delayed by about 100ms.
+	start := time.Now()
+	batch := newPacingBatch(20)
+	require.NoError(t, client.Publish(context.Background(), batch))
+	assert.GreaterOrEqual(t, time.Since(start), 80*time.Millisecond)
+	assert.Equal(t, 20, inner.published)
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+}
+
+func TestPacingClientClose(t *testing.T) {
+	inner := &countingClient{}
+	group, err := withOutputPacing(config.MustNewConfigFrom(map[string]interface{}{
+		"pacing.events": 1,
+		"pacing.window": "1h",
+	}), Group{Clients: []Client{inner}})
+	require.NoError(t, err)
+	client := group.Clients[0]
+
+	// Exhaust the burst.
+	require.NoError(t, client.Publish(context.Background(), newPacingBatch(1)))
+
+	done := make(chan error)
+	batch := newPacingBatch(1)
+	go func() {
+		done <- client.Publish(context.Background(), batch)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, client.Close())
+	select {
+	case err := <-done:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("publish was not aborted by close")
+	}
+	assert.Equal(t, 1, inner.published)
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchCancelled, batch.Signals[0].Tag)
+}
@@ -0,0 +1,209 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outputs
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/testing"
+)
+
+// processingCacheKey stores the index of the source event in the cache of
+// an event processed by the output processors.
+const processingCacheKey = "output_processors.source"
+
+// withOutputProcessors wraps the clients of the group with the processors
+// configured in the `processors` setting of the output, if any.
+func withOutputProcessors(cfg *config.C, group Group, observer Observer) (Group, error) {
+	if !cfg.HasField("processors") {
+		return group, nil
+	}
+
+	var settings struct {
+		Processors processors.PluginConfig `config:"processors"`
+	}
+	if err := cfg.Unpack(&settings); err != nil {
+		return Group{}, err
+	}
+
+	procs, err := processors.New(settings.Processors)
+	if err != nil {
+		return Group{}, err
+	}
+	if len(procs.List) == 0 {
+		return group, nil
+	}
+
+	// The processors are shared by all clients of the group and are closed
+	// when the last client is closed.
+	shared := &sharedProcessors{Processor: procs}
+	log := logp.NewLogger("output.processors")
+	for i, client := range group.Clients {
+		group.Clients[i] = newProcessingClient(client, shared, observer, log)
+	}
+	return group, nil
+}
+
+// WithProcessors wraps a client such that all events are passed through the
+// processors before being published. Processors are applied to a copy of the
+// events, such that retried events are processed from their original state
+// and the events are not modified for other outputs. Events dropped by the
+// processors are reported to the observer. The processors are closed when
+// the client is closed.
+func WithProcessors(client Client, procs beat.Processor, observer Observer, log *logp.Logger) Client {
+	return newProcessingClient(client, &sharedProcessors{Processor: procs}, observer, log)
+}
+
+func newProcessingClient(client Client, procs *sharedProcessors, observer Observer, log *logp.Logger) Client {
+	if observer == nil {
+		observer = NewNilObserver()
+	}
+	procs.acquire()
+	pc := &processingClient{client: client, processors: procs, observer: observer, log: log}
+	if nc, ok := client.(NetworkClient); ok {
+		return &processingNetworkClient{processingClient: pc, client: nc}
+	}
+	return pc
+}
+
+type processingClient struct {
+	client     Client
+	processors *sharedProcessors
+	observer   Observer
+	log        *logp.Logger
+	closeOnce  sync.Once
+}
+
+// sharedProcessors counts the clients using the wrapped processors and
+// closes the processors once the last client releases them.
+type sharedProcessors struct {
+	beat.Processor
+	mu   sync.Mutex
+	refs int
+}
+
+func (p *sharedProcessors) acquire() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.refs++
+}
+
+func (p *sharedProcessors) release() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.refs--
+	if p.refs > 0 {
+		return nil
+	}
+	return processors.Close(p.Processor)
+}
+
+type processingNetworkClient struct {
+	*processingClient
+	client NetworkClient
+}
+
+func (c *processingNetworkClient) Connect() error {
+	return c.client.Connect()
+}
+
+func (c *processingClient) Close() error {
+	err := c.client.Close()
+	c.closeOnce.Do(func() {
+		if perr := c.processors.release(); perr != nil {
+			c.log.Errorf("Failed to close processors of output %v: %v", c.client, perr)
+		}
+	})
+	return err
+}
+
+func (c *processingClient) Publish(ctx context.Context, batch publisher.Batch) error {
+	events := batch.Events()
+	processed := make([]publisher.Event, 0, len(events))
+	for i := range events {
+		event := events[i]
+		content, err := c.processors.Run(event.Content.Clone())
+		if err != nil {
+			c.log.Errorf("Failed to process event for output %v: %v", c.client, err)
+		}
+		if content == nil {
+			continue
+		}
+
+		event.Content = *content
+		if _, err := event.Cache.Put(processingCacheKey, i); err != nil {
+			return err
+		}
+		processed = append(processed, event)
+	}
+
+	if dropped := len(events) - len(processed); dropped > 0 {
+		c.observer.Dropped(dropped)
+	}
+	if len(processed) == 0 {
+		batch.ACK()
+		return nil
+	}
+	return c.client.Publish(ctx, &processedBatch{Batch: batch, events: processed, source: events})
+}
+
+func (c *processingClient) Test(d testing.Driver) {
+	tc, ok := c.client.(testing.Testable)
+	if !ok {
+		d.Fatal("output", errors.New("client doesn't support testing"))
+	}
+	tc.Test(d)
+}
+
+func (c *processingClient) String() string {
+	return "processors(" + c.client.String() + ")"
+}
+
+// processedBatch presents the processed events to the output. Events passed
+// to RetryEvents are mapped back to the original events of the batch. The
+// cache of the processed event, which outputs use to keep state between
+// retries, is carried over to the original event.
+type processedBatch struct {
+	publisher.Batch
+	events []publisher.Event
+	source []publisher.Event
+}
+
+func (b *processedBatch) Events() []publisher.Event {
+	return b.events
+}
+
+func (b *processedBatch) RetryEvents(events []publisher.Event) {
+	retry := make([]publisher.Event, 0, len(events))
+	for _, event := range events {
+		v, err := event.Cache.GetValue(processingCacheKey)
+		if idx, ok := v.(int); err == nil && ok && idx < len(b.source) {
+			source := b.source[idx]
+			source.Cache = event.Cache
+			retry = append(retry, source)
+		}
+	}
+	b.Batch.RetryEvents(retry)
+}
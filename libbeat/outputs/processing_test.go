@@ -0,0 +1,143 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outputs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs/outest"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// dropVerbose removes the `verbose` field and drops events with `drop: true`.
+type dropVerbose struct{}
+
+func (dropVerbose) Run(event *beat.Event) (*beat.Event, error) {
+	if v, _ := event.Fields.GetValue("drop"); v == true {
+		return nil, nil
+	}
+	_ = event.Fields.Delete("verbose")
+	return event, nil
+}
+
+func (dropVerbose) String() string { return "drop_verbose" }
+
+// closingProcessor counts how often it has been closed.
+type closingProcessor struct {
+	dropVerbose
+	closed int
+}
+
+func (p *closingProcessor) Close() error {
+	p.closed++
+	return nil
+}
+
+// droppedObserver counts the events reported as dropped.
+type droppedObserver struct {
+	emptyObserver
+	dropped int
+}
+
+func (o *droppedObserver) Dropped(n int) { o.dropped += n }
+
+type retryingClient struct {
+	published []publisher.Event
+}
+
+func (c *retryingClient) Close() error   { return nil }
+func (c *retryingClient) String() string { return "retrying" }
+
+func (c *retryingClient) Publish(_ context.Context, batch publisher.Batch) error {
+	c.published = batch.Events()
+	for i := range c.published {
+		_, _ = c.published[i].Cache.Put("partition", i)
+	}
+	// retry the last event only
+	batch.RetryEvents(c.published[len(c.published)-1:])
+	return nil
+}
+
+func TestProcessingClient(t *testing.T) {
+	inner := &retryingClient{}
+	observer := &droppedObserver{}
+	client := WithProcessors(inner, dropVerbose{}, observer, logp.NewLogger("test"))
+
+	batch := outest.NewBatch(
+		beat.Event{Fields: mapstr.M{"message": "a", "verbose": "x"}},
+		beat.Event{Fields: mapstr.M{"message": "b", "drop": true}},
+		beat.Event{Fields: mapstr.M{"message": "c", "verbose": "y"}},
+	)
+	require.NoError(t, client.Publish(context.Background(), batch))
+
+	require.Len(t, inner.published, 2)
+	for _, event := range inner.published {
+		assert.NotContains(t, event.Content.Fields, "verbose")
+	}
+
+	// the original events are unchanged and retried unprocessed
+	assert.Equal(t, "x", batch.Events()[0].Content.Fields["verbose"])
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchRetryEvents, batch.Signals[0].Tag)
+	require.Len(t, batch.Signals[0].Events, 1)
+	assert.Equal(t, mapstr.M{"message": "c", "verbose": "y"}, batch.Signals[0].Events[0].Content.Fields)
+
+	// the output state of the processed event is kept for the retry
+	partition, err := batch.Signals[0].Events[0].Cache.GetValue("partition")
+	require.NoError(t, err)
+	assert.Equal(t, 1, partition)
+
+	assert.Equal(t, 1, observer.dropped)
+}
+
+func TestProcessingClientAllDropped(t *testing.T) {
+	inner := &retryingClient{}
+	observer := &droppedObserver{}
+	client := WithProcessors(inner, dropVerbose{}, observer, logp.NewLogger("test"))
+
+	batch := outest.NewBatch(beat.Event{Fields: mapstr.M{"drop": true}})
+	require.NoError(t, client.Publish(context.Background(), batch))
+
+	assert.Nil(t, inner.published)
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+	assert.Equal(t, 1, observer.dropped)
+}
+
+func TestProcessingClientClosesProcessorsWithLastClient(t *testing.T) {
+	procs := &closingProcessor{}
+	shared := &sharedProcessors{Processor: procs}
+	log := logp.NewLogger("test")
+	first := newProcessingClient(&retryingClient{}, shared, nil, log)
+	second := newProcessingClient(&retryingClient{}, shared, nil, log)
+
+	// closing a client twice does not release the processors of the other
+	require.NoError(t, first.Close())
+	require.NoError(t, first.Close())
+	assert.Equal(t, 0, procs.closed)
+
+	require.NoError(t, second.Close())
+	assert.Equal(t, 1, procs.closed)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/gomodule/redigo/redis"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/beat/events"
 	"github.com/elastic/beats/v7/libbeat/outputs"
 	"github.com/elastic/beats/v7/libbeat/outputs/codec"
 	"github.com/elastic/beats/v7/libbeat/outputs/outil"
@@ -223,7 +224,13 @@ func (c *client) makePublishPUBLISH(conn redis.Conn) (publishFn, error) {
 func (c *client) publishEventsBulk(conn redis.Conn, command string) publishFn {
 	// XXX: requires key.IsConst() == true
 	dest, _ := c.key.Select(&beat.Event{Fields: mapstr.M{}})
-	return func(_ outil.Selector, data []publisher.Event) ([]publisher.Event, error) {
+	pipeline := c.publishEventsPipeline(conn, command)
+	return func(key outil.Selector, data []publisher.Event) ([]publisher.Event, error) {
+		if hasMetaTopic(data) {
+			// events overriding the key can not be sent in a single RPUSH
+			return pipeline(key, data)
+		}
+
 		args := make([]interface{}, 1, len(data)+1)
 		args[0] = dest
 
@@ -259,7 +266,7 @@ func (c *client) publishEventsPipeline(conn redis.Conn, command string) publishF
 		data = okEvents[:0]
 		dropped := 0
 		for i, serializedEvent := range serialized {
-			eventKey, err := key.Select(&okEvents[i].Content)
+			eventKey, err := selectKey(key, &okEvents[i].Content)
 			if err != nil {
 				c.log.Errorf("Failed to set redis key: %+v", err)
 				dropped++
@@ -303,6 +310,24 @@ func (c *client) publishEventsPipeline(conn redis.Conn, command string) publishF
 	}
 }
 
+// selectKey returns the key or channel of an event. The topic set in the event
+// metadata takes precedence over the configured key.
+func selectKey(key outil.Selector, event *beat.Event) (string, error) {
+	if topic, err := events.GetMetaStringValue(*event, events.FieldMetaTopic); err == nil && topic != "" {
+		return topic, nil
+	}
+	return key.Select(event)
+}
+
+func hasMetaTopic(data []publisher.Event) bool {
+	for i := range data {
+		if topic, err := events.GetMetaStringValue(data[i].Content, events.FieldMetaTopic); err == nil && topic != "" {
+			return true
+		}
+	}
+	return false
+}
+
 func serializeEvents(
 	log *logp.Logger,
 	to []interface{},
@@ -25,6 +25,8 @@ import (
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/outputs"
 	_ "github.com/elastic/beats/v7/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/v7/libbeat/outputs/outil"
+	"github.com/elastic/beats/v7/libbeat/publisher"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/mapstr"
 )
@@ -180,3 +182,25 @@ func TestKeySelection(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectKeyFromMetadata(t *testing.T) {
+	key := outil.MakeSelector(outil.ConstSelectorExpr("filebeat", outil.SelectorKeepCase))
+	cases := map[string]struct {
+		meta mapstr.M
+		want string
+	}{
+		"configured key":    {want: "filebeat"},
+		"empty topic":       {meta: mapstr.M{"topic": ""}, want: "filebeat"},
+		"topic in metadata": {meta: mapstr.M{"topic": "audit"}, want: "audit"},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			event := publisher.Event{Content: beat.Event{Meta: test.meta, Fields: mapstr.M{}}}
+			got, err := selectKey(key, &event.Content)
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.want != "filebeat", hasMetaTopic([]publisher.Event{event}))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package external
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+const (
+	// InputType is the type of the plugins implementing an input.
+	InputType = "input"
+	// OutputType is the type of the plugins implementing an output.
+	OutputType = "output"
+)
+
+var validName = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// Manifest describes an external plugin. Manifests are YAML files stored in
+// the plugins directory, one per plugin.
+type Manifest struct {
+	// Name of the plugin, used as input or output type in the configuration.
+	Name string `config:"name" validate:"required"`
+	// Type of the plugin, either input or output.
+	Type string `config:"type" validate:"required"`
+	// Command is the executable of the plugin. Relative paths are resolved
+	// against the plugins directory.
+	Command string `config:"command" validate:"required"`
+	// Args are passed to the executable.
+	Args []string `config:"args"`
+	// Env holds additional environment variables set for the plugin process.
+	Env map[string]string `config:"env"`
+	// HandshakeTimeout is the time the Beat waits for an output plugin to
+	// be ready to accept connections.
+	HandshakeTimeout time.Duration `config:"handshake_timeout" validate:"positive"`
+	// StopTimeout is the time the plugin is given to exit once it has been
+	// asked to stop, after which it is killed.
+	StopTimeout time.Duration `config:"stop_timeout" validate:"positive"`
+}
+
+func defaultManifest() Manifest {
+	return Manifest{
+		HandshakeTimeout: 10 * time.Second,
+		StopTimeout:      5 * time.Second,
+	}
+}
+
+// Validate checks the name and type of the plugin.
+func (m *Manifest) Validate() error {
+	if !validName.MatchString(m.Name) {
+		return fmt.Errorf("invalid plugin name %q: only lowercase letters, digits, '_' and '-' are allowed", m.Name)
+	}
+	switch m.Type {
+	case InputType, OutputType:
+		return nil
+	default:
+		return fmt.Errorf("invalid type %q for plugin %s, must be %s or %s", m.Type, m.Name, InputType, OutputType)
+	}
+}
+
+// Discover reads the manifests of all plugins in dir. Manifests are the
+// files with the .yml extension, they are returned sorted by file name.
+func Discover(dir string) ([]Manifest, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yml"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	manifests := make([]Manifest, 0, len(files))
+	seen := make(map[string]string, len(files))
+	for _, file := range files {
+		m, err := readManifest(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read plugin manifest %s: %w", file, err)
+		}
+		if other, exists := seen[m.Name]; exists {
+			return nil, fmt.Errorf("plugin %s is defined in both %s and %s", m.Name, other, file)
+		}
+		seen[m.Name] = file
+
+		if !filepath.IsAbs(m.Command) {
+			m.Command = filepath.Join(dir, m.Command)
+		}
+		if err := checkExecutable(m.Command); err != nil {
+			return nil, fmt.Errorf("invalid command for plugin %s: %w", m.Name, err)
+		}
+		manifests = append(manifests, m)
+	}
+	return manifests, nil
+}
+
+func readManifest(path string) (Manifest, error) {
+	cfg, err := common.LoadFile(path)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	m := defaultManifest()
+	if err := cfg.Unpack(&m); err != nil {
+		return Manifest{}, err
+	}
+	return m, nil
+}
+
+// checkExecutable ensures that the plugin command is a regular file. When
+// strict permissions are enabled, the same ownership and permission rules as
+// for configuration files apply, as the command is run by the Beat.
+func checkExecutable(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", path)
+	}
+	if common.IsStrictPerms() {
+		return common.OwnerHasExclusiveWritePerms(path)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package external
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscover(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "bin", "myoutput"), "", 0o755)
+	writeFile(t, filepath.Join(dir, "myoutput.yml"), `
+name: myoutput
+type: output
+command: bin/myoutput
+args: ["--verbose"]
+`, 0o644)
+	writeFile(t, filepath.Join(dir, "myinput.yml"), `
+name: myinput
+type: input
+command: bin/myoutput
+stop_timeout: 1s
+`, 0o644)
+	writeFile(t, filepath.Join(dir, "README.md"), "not a manifest", 0o644)
+
+	manifests, err := Discover(dir)
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+
+	assert.Equal(t, "myinput", manifests[0].Name)
+	assert.Equal(t, InputType, manifests[0].Type)
+	assert.Equal(t, filepath.Join(dir, "bin", "myoutput"), manifests[0].Command)
+	assert.Equal(t, defaultManifest().HandshakeTimeout, manifests[0].HandshakeTimeout)
+
+	assert.Equal(t, "myoutput", manifests[1].Name)
+	assert.Equal(t, OutputType, manifests[1].Type)
+	assert.Equal(t, []string{"--verbose"}, manifests[1].Args)
+}
+
+func TestDiscoverErrors(t *testing.T) {
+	tests := map[string]struct {
+		manifests map[string]string
+		err       string
+	}{
+		"invalid type": {
+			manifests: map[string]string{"a.yml": "name: a\ntype: processor\ncommand: plugin"},
+			err:       `invalid type "processor"`,
+		},
+		"invalid name": {
+			manifests: map[string]string{"a.yml": "name: My.Plugin\ntype: input\ncommand: plugin"},
+			err:       `invalid plugin name "My.Plugin"`,
+		},
+		"missing command": {
+			manifests: map[string]string{"a.yml": "name: a\ntype: input"},
+			err:       "missing required field",
+		},
+		"command not found": {
+			manifests: map[string]string{"a.yml": "name: a\ntype: input\ncommand: missing"},
+			err:       "invalid command for plugin a",
+		},
+		"duplicate name": {
+			manifests: map[string]string{
+				"a.yml": "name: a\ntype: input\ncommand: plugin",
+				"b.yml": "name: a\ntype: output\ncommand: plugin",
+			},
+			err: "plugin a is defined in both",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFile(t, filepath.Join(dir, "plugin"), "", 0o755)
+			for file, content := range test.manifests {
+				writeFile(t, filepath.Join(dir, file), content, 0o644)
+			}
+
+			_, err := Discover(dir)
+			assert.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func writeFile(t *testing.T, path, content string, mode os.FileMode) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), mode))
+}
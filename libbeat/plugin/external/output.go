@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package external
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	_ "github.com/elastic/beats/v7/libbeat/outputs/shipper" // output plugins are driven by the shipper output
+	conf "github.com/elastic/elastic-agent-libs/config"
+)
+
+// registerOutput registers the output plugin m as output type. Events are
+// sent to the plugin by the shipper output, the plugin implementing the
+// Producer gRPC service.
+func registerOutput(m Manifest) error {
+	if outputs.FindFactory(m.Name) != nil {
+		return fmt.Errorf("output plugin %s conflicts with an existing output type", m.Name)
+	}
+
+	o := &outputPlugin{manifest: m}
+	outputs.RegisterType(m.Name, o.make)
+	return nil
+}
+
+type outputPlugin struct {
+	manifest Manifest
+
+	mu      sync.Mutex
+	running *supervisor
+}
+
+// make starts the plugin and creates the shipper output connected to it.
+// The plugin is restarted every time the output is created again, so that it
+// gets the latest configuration.
+func (o *outputPlugin) make(
+	im outputs.IndexManager,
+	info beat.Info,
+	observer outputs.Observer,
+	cfg *conf.C,
+) (outputs.Group, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.running != nil {
+		stop(o.running)
+		o.running = nil
+	}
+
+	encoded, err := encodeConfig(cfg)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+	socket, err := SocketPath(o.manifest.Name)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	s := newSupervisor(o.manifest, map[string]string{
+		AddrKey:   socket,
+		ConfigKey: encoded,
+	}, true)
+	addr, err := start(s)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+	o.running = s
+
+	shipperCfg, err := conf.MergeConfigs(cfg, conf.MustNewConfigFrom(map[string]interface{}{
+		"server": addr,
+	}))
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	group, err := outputs.FindFactory("shipper")(im, info, observer, shipperCfg)
+	if err != nil {
+		stop(s)
+		o.running = nil
+	}
+	return group, err
+}
@@ -0,0 +1,209 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package external runs inputs and outputs implemented by plugins executed in
+// their own process. Plugins are discovered from the manifests stored in the
+// plugins directory and exchange events with the Beat over gRPC using the
+// Elastic Agent shipper protocol, so they can be implemented in any language
+// and added without recompiling the Beat.
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+type config struct {
+	// Directory holding the plugin manifests. External plugins are
+	// disabled when it is not set.
+	Directory string `config:"directory"`
+}
+
+var (
+	mu          sync.Mutex
+	manifests   []Manifest
+	supervisors = map[*supervisor]struct{}{}
+	socketDir   string
+	socketCount int
+)
+
+// Load discovers the plugins in the directory set in cfg and registers the
+// output plugins as output types. It does nothing if no directory is set.
+func Load(cfg *conf.C) error {
+	if cfg == nil {
+		return nil
+	}
+
+	var c config
+	if err := cfg.Unpack(&c); err != nil {
+		return fmt.Errorf("invalid plugins configuration: %w", err)
+	}
+	if c.Directory == "" {
+		return nil
+	}
+
+	cfgwarn.Experimental("External plugins are experimental.")
+	dir := paths.Resolve(paths.Home, c.Directory)
+	found, err := Discover(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range found {
+		logp.NewLogger("plugin").Infof("Found %s plugin %s in %s", m.Type, m.Name, dir)
+		if m.Type == OutputType {
+			if err := registerOutput(m); err != nil {
+				return err
+			}
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	manifests = found
+	return nil
+}
+
+// Manifests returns the manifests of the loaded plugins of the given type.
+func Manifests(typ string) []Manifest {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var result []Manifest
+	for _, m := range manifests {
+		if m.Type == typ {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// Plugin is a running plugin process.
+type Plugin struct {
+	s *supervisor
+}
+
+// StartInput runs the input plugin m. The plugin publishes its events to the
+// Producer gRPC service listening on producerAddr. cfg is the configuration
+// of the input, passed to the plugin as JSON.
+func StartInput(m Manifest, producerAddr string, cfg *conf.C) (*Plugin, error) {
+	encoded, err := encodeConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s := newSupervisor(m, map[string]string{
+		ProducerAddrKey: producerAddr,
+		ConfigKey:       encoded,
+	}, false)
+	if _, err := start(s); err != nil {
+		return nil, err
+	}
+	return &Plugin{s: s}, nil
+}
+
+// Stop stops the plugin process.
+func (p *Plugin) Stop() {
+	stop(p.s)
+}
+
+// Stop stops all the plugins started by the Beat.
+func Stop() {
+	mu.Lock()
+	running := make([]*supervisor, 0, len(supervisors))
+	for s := range supervisors {
+		running = append(running, s)
+	}
+	mu.Unlock()
+
+	for _, s := range running {
+		stop(s)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if socketDir != "" {
+		_ = os.RemoveAll(socketDir)
+		socketDir = ""
+	}
+}
+
+func start(s *supervisor) (string, error) {
+	addr, err := s.start()
+	if err != nil {
+		return "", err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	supervisors[s] = struct{}{}
+	return addr, nil
+}
+
+func stop(s *supervisor) {
+	mu.Lock()
+	_, running := supervisors[s]
+	delete(supervisors, s)
+	mu.Unlock()
+
+	if running {
+		s.stop()
+	}
+}
+
+// SocketPath returns a new path to use for a unix socket. Sockets are
+// created in a temporary directory only accessible by the Beat user, as the
+// data path can exceed the maximum length of a socket path.
+func SocketPath(name string) (string, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if socketDir == "" {
+		dir, err := os.MkdirTemp("", "beat-plugins-")
+		if err != nil {
+			return "", fmt.Errorf("failed to create plugins socket directory: %w", err)
+		}
+		socketDir = dir
+	}
+
+	socketCount++
+	return filepath.Join(socketDir, name+"-"+strconv.Itoa(socketCount)+".sock"), nil
+}
+
+func encodeConfig(cfg *conf.C) (string, error) {
+	fields := map[string]interface{}{}
+	if cfg != nil {
+		if err := cfg.Unpack(&fields); err != nil {
+			return "", err
+		}
+	}
+
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode plugin configuration: %w", err)
+	}
+	return string(encoded), nil
+}
@@ -0,0 +1,308 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package external
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/backoff"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// Environment variables set for the plugin processes.
+const (
+	// MagicCookieKey and MagicCookieValue are set for every plugin. Plugins
+	// should refuse to start when they are missing, as they are not meant to
+	// be run directly.
+	MagicCookieKey   = "BEATS_PLUGIN_MAGIC_COOKIE"
+	MagicCookieValue = "9c0d5ab2f6e44b7e8c1f3a7d2e5b6c40"
+
+	// ProtocolVersionKey is set to the version of the protocol spoken by the
+	// Beat.
+	ProtocolVersionKey = "BEATS_PLUGIN_PROTOCOL_VERSION"
+
+	// AddrKey is set to the address output plugins must listen on.
+	AddrKey = "BEATS_PLUGIN_ADDR"
+
+	// ProducerAddrKey is set to the address input plugins publish their
+	// events to.
+	ProducerAddrKey = "BEATS_PLUGIN_PRODUCER_ADDR"
+
+	// ConfigKey is set to the JSON encoded configuration of the input or
+	// output.
+	ConfigKey = "BEATS_PLUGIN_CONFIG"
+)
+
+const (
+	coreProtocolVersion = "1"
+	protocolVersion     = "1"
+)
+
+// process is a running plugin.
+type process struct {
+	cmd  *exec.Cmd
+	done chan struct{}
+	err  error
+}
+
+// startProcess runs the plugin described by m. If handshake is true, it waits
+// for the plugin to print the handshake line on its standard output and
+// returns the address the plugin listens on.
+//
+// The handshake line has the same format as the one of the HashiCorp plugin
+// system: CORE-PROTOCOL-VERSION|APP-PROTOCOL-VERSION|NETWORK|ADDRESS|PROTOCOL,
+// for example "1|1|unix|/tmp/plugin.sock|grpc". All other lines printed by the
+// plugin are logged.
+func startProcess(log *logp.Logger, m Manifest, env map[string]string, handshake bool) (*process, string, error) {
+	cmd := exec.Command(m.Command, m.Args...)
+	cmd.Dir = filepath.Dir(m.Command)
+	cmd.Env = append(os.Environ(),
+		MagicCookieKey+"="+MagicCookieValue,
+		ProtocolVersionKey+"="+protocolVersion,
+	)
+	for k, v := range m.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	for k, v := range env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, "", err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, "", fmt.Errorf("failed to start plugin %s: %w", m.Name, err)
+	}
+	log.Infow("Plugin started", "pid", cmd.Process.Pid)
+
+	p := &process{cmd: cmd, done: make(chan struct{})}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	handshakeCh := make(chan string, 1)
+	go func() {
+		defer wg.Done()
+		scanner := bufio.NewScanner(stdout)
+		if handshake && scanner.Scan() {
+			handshakeCh <- scanner.Text()
+		}
+		logLines(scanner, log.Info)
+	}()
+	go func() {
+		defer wg.Done()
+		logLines(bufio.NewScanner(stderr), log.Warn)
+	}()
+	go func() {
+		// All output must be read before calling Wait.
+		wg.Wait()
+		p.err = cmd.Wait()
+		close(p.done)
+	}()
+
+	if !handshake {
+		return p, "", nil
+	}
+
+	timer := time.NewTimer(m.HandshakeTimeout)
+	defer timer.Stop()
+	select {
+	case line := <-handshakeCh:
+		addr, err := parseHandshake(line)
+		if err != nil {
+			p.stop(m.StopTimeout)
+			return nil, "", fmt.Errorf("plugin %s: %w", m.Name, err)
+		}
+		return p, addr, nil
+	case <-p.done:
+		return nil, "", fmt.Errorf("plugin %s exited before completing the handshake: %v", m.Name, p.err)
+	case <-timer.C:
+		p.stop(m.StopTimeout)
+		return nil, "", fmt.Errorf("plugin %s did not complete the handshake in %v", m.Name, m.HandshakeTimeout)
+	}
+}
+
+func logLines(scanner *bufio.Scanner, logf func(args ...interface{})) {
+	for scanner.Scan() {
+		logf(scanner.Text())
+	}
+}
+
+// stop asks the process to exit and kills it if it is still running after
+// timeout.
+func (p *process) stop(timeout time.Duration) {
+	select {
+	case <-p.done:
+		return
+	default:
+	}
+
+	if runtime.GOOS == "windows" {
+		_ = p.cmd.Process.Kill()
+	} else {
+		_ = p.cmd.Process.Signal(os.Interrupt)
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-p.done:
+	case <-timer.C:
+		_ = p.cmd.Process.Kill()
+		<-p.done
+	}
+}
+
+// parseHandshake returns the gRPC target of the address in the handshake
+// line printed by an output plugin.
+func parseHandshake(line string) (string, error) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 5 {
+		return "", fmt.Errorf("invalid handshake %q", line)
+	}
+	if parts[0] != coreProtocolVersion {
+		return "", fmt.Errorf("unsupported core protocol version %q, expected %s", parts[0], coreProtocolVersion)
+	}
+	if parts[1] != protocolVersion {
+		return "", fmt.Errorf("unsupported protocol version %q, expected %s", parts[1], protocolVersion)
+	}
+	if parts[4] != "grpc" {
+		return "", fmt.Errorf("unsupported protocol %q, only grpc is supported", parts[4])
+	}
+
+	if parts[3] == "" {
+		return "", fmt.Errorf("missing address in handshake %q", line)
+	}
+
+	switch network, addr := parts[2], parts[3]; network {
+	case "unix":
+		return "unix://" + addr, nil
+	case "tcp":
+		return addr, nil
+	default:
+		return "", fmt.Errorf("unsupported network %q", network)
+	}
+}
+
+// supervisor runs a plugin and restarts it with a backoff when it exits,
+// until it is stopped.
+type supervisor struct {
+	log       *logp.Logger
+	manifest  Manifest
+	env       map[string]string
+	handshake bool
+
+	mu      sync.Mutex
+	proc    *process
+	stopped chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newSupervisor(m Manifest, env map[string]string, handshake bool) *supervisor {
+	return &supervisor{
+		log:       logp.NewLogger("plugin").With("plugin", m.Name),
+		manifest:  m,
+		env:       env,
+		handshake: handshake,
+		stopped:   make(chan struct{}),
+	}
+}
+
+// start runs the plugin. It returns the address reported by the plugin
+// during the handshake, if any. Once started, the plugin is restarted every
+// time it exits.
+func (s *supervisor) start() (string, error) {
+	p, addr, err := startProcess(s.log, s.manifest, s.env, s.handshake)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	s.proc = p
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.run(p)
+	}()
+	return addr, nil
+}
+
+func (s *supervisor) run(p *process) {
+	const maxBackoff = time.Minute
+	b := backoff.NewEqualJitterBackoff(s.stopped, time.Second, maxBackoff)
+
+	started := time.Now()
+	for {
+		select {
+		case <-s.stopped:
+			return
+		case <-p.done:
+		}
+		s.log.Errorf("Plugin exited unexpectedly: %v", p.err)
+
+		// Only keep backing off for plugins exiting right after their start.
+		if time.Since(started) > maxBackoff {
+			b.Reset()
+		}
+
+		for {
+			if !b.Wait() {
+				return
+			}
+
+			var err error
+			p, _, err = startProcess(s.log, s.manifest, s.env, s.handshake)
+			if err == nil {
+				break
+			}
+			s.log.Errorf("Failed to restart plugin: %v", err)
+		}
+		started = time.Now()
+
+		s.mu.Lock()
+		s.proc = p
+		s.mu.Unlock()
+	}
+}
+
+// stop stops the supervision and the plugin.
+func (s *supervisor) stop() {
+	close(s.stopped)
+	s.wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.proc != nil {
+		s.proc.stop(s.manifest.StopTimeout)
+	}
+}
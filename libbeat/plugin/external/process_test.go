@@ -0,0 +1,129 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package external
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHandshake(t *testing.T) {
+	tests := map[string]struct {
+		line string
+		addr string
+		err  string
+	}{
+		"unix":          {line: "1|1|unix|/tmp/plugin.sock|grpc\n", addr: "unix:///tmp/plugin.sock"},
+		"tcp":           {line: "1|1|tcp|127.0.0.1:4242|grpc", addr: "127.0.0.1:4242"},
+		"invalid":       {line: "listening on 4242", err: "invalid handshake"},
+		"core version":  {line: "2|1|tcp|127.0.0.1:4242|grpc", err: "unsupported core protocol version"},
+		"version":       {line: "1|2|tcp|127.0.0.1:4242|grpc", err: "unsupported protocol version"},
+		"protocol":      {line: "1|1|tcp|127.0.0.1:4242|netrpc", err: "unsupported protocol"},
+		"network":       {line: "1|1|udp|127.0.0.1:4242|grpc", err: "unsupported network"},
+		"empty address": {line: "1|1|tcp||grpc", err: "missing address"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			addr, err := parseHandshake(test.line)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.addr, addr)
+		})
+	}
+}
+
+func TestSupervisor(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are killed on Windows")
+	}
+
+	m := helperManifest("serve")
+	s := newSupervisor(m, map[string]string{AddrKey: "/tmp/test.sock"}, true)
+	addr, err := s.start()
+	require.NoError(t, err)
+	assert.Equal(t, "unix:///tmp/test.sock", addr)
+
+	p := s.proc
+	s.stop()
+
+	select {
+	case <-p.done:
+	default:
+		t.Fatal("plugin is still running")
+	}
+	assert.NoError(t, p.err, "plugin must exit gracefully")
+}
+
+func TestStartProcessHandshakeErrors(t *testing.T) {
+	log := newSupervisor(helperManifest(""), nil, true).log
+
+	_, _, err := startProcess(log, helperManifest("exit"), nil, true)
+	assert.ErrorContains(t, err, "exited before completing the handshake")
+
+	m := helperManifest("silent")
+	m.HandshakeTimeout = 100 * time.Millisecond
+	_, _, err = startProcess(log, m, nil, true)
+	assert.ErrorContains(t, err, "did not complete the handshake")
+}
+
+// helperManifest runs the test binary as plugin, see TestHelperProcess.
+func helperManifest(mode string) Manifest {
+	m := defaultManifest()
+	m.Name = "helper"
+	m.Type = OutputType
+	m.Command = os.Args[0]
+	m.Args = []string{"-test.run=TestHelperProcess", "--", mode}
+	m.Env = map[string]string{"GO_WANT_HELPER_PROCESS": "1"}
+	m.StopTimeout = 5 * time.Second
+	return m
+}
+
+// TestHelperProcess is not a real test, it is run as plugin process by the
+// other tests.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		os.Exit(2)
+	}
+
+	switch mode := os.Args[len(os.Args)-1]; mode {
+	case "serve":
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt)
+		fmt.Printf("%s|%s|unix|%s|grpc\n", coreProtocolVersion, os.Getenv(ProtocolVersionKey), os.Getenv(AddrKey))
+		fmt.Println("serving")
+		<-signals
+		os.Exit(0)
+	case "silent":
+		time.Sleep(time.Minute)
+	}
+	os.Exit(1)
+}
@@ -34,8 +34,9 @@ import (
 func init() {
 	processors.RegisterPlugin("add_network_direction",
 		checks.ConfigChecked(NewAddNetworkDirection,
-			checks.RequireFields("source", "destination", "target", "internal_networks"),
-			checks.AllowedFields("source", "destination", "target", "internal_networks")))
+			checks.RequireFields("source", "destination", "target"),
+			checks.AllowedFields("source", "destination", "target", "internal_networks",
+				"zones", "source_zone_target", "destination_zone_target")))
 	jsprocessor.RegisterPlugin("AddNetworkDirection", NewAddNetworkDirection)
 }
 
@@ -47,15 +48,41 @@ const (
 )
 
 type networkDirectionProcessor struct {
-	Source           string   `config:"source"`
-	Destination      string   `config:"destination"`
-	Target           string   `config:"target"`
-	InternalNetworks []string `config:"internal_networks"`
+	Source                string        `config:"source"`
+	Destination           string        `config:"destination"`
+	Target                string        `config:"target"`
+	InternalNetworks      []string      `config:"internal_networks"`
+	Zones                 []networkZone `config:"zones"`
+	SourceZoneTarget      string        `config:"source_zone_target"`
+	DestinationZoneTarget string        `config:"destination_zone_target"`
+}
+
+// networkZone is a named group of internal networks, e.g. dmz or corp.
+type networkZone struct {
+	Name     string   `config:"name" validate:"required"`
+	Networks []string `config:"networks" validate:"required"`
+}
+
+func (m *networkDirectionProcessor) Validate() error {
+	if len(m.InternalNetworks) == 0 && len(m.Zones) == 0 {
+		return errors.New("at least one of internal_networks or zones must be set")
+	}
+	names := make(map[string]struct{}, len(m.Zones))
+	for _, zone := range m.Zones {
+		if _, found := names[zone.Name]; found {
+			return fmt.Errorf("zone %q is defined more than once", zone.Name)
+		}
+		names[zone.Name] = struct{}{}
+	}
+	return nil
 }
 
 // NewAddNetworkDirection constructs a new network direction processor.
 func NewAddNetworkDirection(cfg *conf.C) (processors.Processor, error) {
-	networkDirection := &networkDirectionProcessor{}
+	networkDirection := &networkDirectionProcessor{
+		SourceZoneTarget:      "source.zone",
+		DestinationZoneTarget: "destination.zone",
+	}
 	if err := cfg.Unpack(networkDirection); err != nil {
 		return nil, errors.Wrapf(err, "fail to unpack the add_network_direction configuration")
 	}
@@ -91,19 +118,55 @@ func (m *networkDirectionProcessor) Run(event *beat.Event) (*beat.Event, error)
 		return event, nil
 	}
 
-	internalSource, err := conditions.NetworkContains(sourceIP, m.InternalNetworks...)
+	sourceZone, err := m.zone(sourceIP)
 	if err != nil {
 		return event, err
 	}
-	internalDestination, err := conditions.NetworkContains(destinationIP, m.InternalNetworks...)
+	destinationZone, err := m.zone(destinationIP)
 	if err != nil {
 		return event, err
 	}
 
+	internalSource := sourceZone != ""
+	if !internalSource {
+		internalSource, err = conditions.NetworkContains(sourceIP, m.InternalNetworks...)
+		if err != nil {
+			return event, err
+		}
+	}
+	internalDestination := destinationZone != ""
+	if !internalDestination {
+		internalDestination, err = conditions.NetworkContains(destinationIP, m.InternalNetworks...)
+		if err != nil {
+			return event, err
+		}
+	}
+
 	event.PutValue(m.Target, networkDirection(internalSource, internalDestination))
+	if sourceZone != "" {
+		event.PutValue(m.SourceZoneTarget, sourceZone)
+	}
+	if destinationZone != "" {
+		event.PutValue(m.DestinationZoneTarget, destinationZone)
+	}
 	return event, nil
 }
 
+// zone returns the name of the first zone containing ip, or an empty string
+// if ip is not part of any zone. Networks of a zone are always internal.
+func (m *networkDirectionProcessor) zone(ip net.IP) (string, error) {
+	for _, zone := range m.Zones {
+		contains, err := conditions.NetworkContains(ip, zone.Networks...)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid networks in zone %q", zone.Name)
+		}
+		if contains {
+			return zone.Name, nil
+		}
+	}
+	return "", nil
+}
+
 func networkDirection(internalSource, internalDestination bool) string {
 	if internalSource && internalDestination {
 		return directionInternal
@@ -104,4 +104,105 @@ func TestNetworkDirection(t *testing.T) {
 		require.Equal(t, expectedMeta, observed.Meta)
 		require.Equal(t, evt.Fields, observed.Fields)
 	})
+
+	t.Run("supports zones", func(t *testing.T) {
+		zones := []map[string]interface{}{
+			{"name": "dmz", "networks": []string{"10.0.0.0/24"}},
+			{"name": "corp", "networks": []string{"10.0.0.0/8", "192.168.0.0/16"}},
+			{"name": "guest", "networks": []string{"172.16.0.0/12"}},
+		}
+		tests := []struct {
+			Source          string
+			Destination     string
+			Direction       string
+			SourceZone      string
+			DestinationZone string
+		}{
+			{"10.0.0.5", "10.1.0.5", "internal", "dmz", "corp"},
+			{"172.16.1.1", "8.8.8.8", "outbound", "guest", ""},
+			{"8.8.8.8", "192.168.1.218", "inbound", "", "corp"},
+			{"1.1.1.1", "8.8.8.8", "external", "", ""},
+		}
+
+		for _, tt := range tests {
+			evt := beat.Event{
+				Fields: mapstr.M{
+					"source":      mapstr.M{"ip": tt.Source},
+					"destination": mapstr.M{"ip": tt.Destination},
+				},
+			}
+			p, err := NewAddNetworkDirection(conf.MustNewConfigFrom(map[string]interface{}{
+				"source":      "source.ip",
+				"destination": "destination.ip",
+				"target":      "network.direction",
+				"zones":       zones,
+			}))
+			require.NoError(t, err)
+
+			observed, err := p.Run(&evt)
+			require.NoError(t, err)
+
+			direction, err := observed.Fields.GetValue("network.direction")
+			require.NoError(t, err)
+			require.Equal(t, tt.Direction, direction)
+			for field, zone := range map[string]string{"source.zone": tt.SourceZone, "destination.zone": tt.DestinationZone} {
+				value, err := observed.Fields.GetValue(field)
+				if zone == "" {
+					require.Error(t, err, field)
+				} else {
+					require.NoError(t, err, field)
+					require.Equal(t, zone, value, field)
+				}
+			}
+		}
+	})
+
+	t.Run("zones are internal in addition to internal networks", func(t *testing.T) {
+		evt := beat.Event{
+			Fields: mapstr.M{
+				"source":      "192.168.1.218",
+				"destination": "100.64.0.1",
+			},
+		}
+		p, err := NewAddNetworkDirection(conf.MustNewConfigFrom(map[string]interface{}{
+			"source":                  "source",
+			"destination":             "destination",
+			"target":                  "direction",
+			"internal_networks":       "private",
+			"zones":                   []map[string]interface{}{{"name": "cgnat", "networks": "100.64.0.0/10"}},
+			"source_zone_target":      "src_zone",
+			"destination_zone_target": "dst_zone",
+		}))
+		require.NoError(t, err)
+
+		observed, err := p.Run(&evt)
+		require.NoError(t, err)
+		require.Equal(t, mapstr.M{
+			"source":      "192.168.1.218",
+			"destination": "100.64.0.1",
+			"direction":   "internal",
+			"dst_zone":    "cgnat",
+		}, observed.Fields)
+	})
+
+	t.Run("invalid configurations", func(t *testing.T) {
+		for name, cfg := range map[string]map[string]interface{}{
+			"no networks": {},
+			"duplicate zone": {
+				"zones": []map[string]interface{}{
+					{"name": "dmz", "networks": "10.0.0.0/24"},
+					{"name": "dmz", "networks": "10.1.0.0/24"},
+				},
+			},
+			"zone without networks": {
+				"zones": []map[string]interface{}{{"name": "dmz"}},
+			},
+		} {
+			cfg["source"] = "source"
+			cfg["destination"] = "destination"
+			cfg["target"] = "direction"
+			_, err := NewAddNetworkDirection(conf.MustNewConfigFrom(cfg))
+			require.Error(t, err, name)
+		}
+	})
 }
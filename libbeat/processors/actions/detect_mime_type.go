@@ -18,11 +18,15 @@
 package actions
 
 import (
+	"encoding/base64"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
 	"github.com/elastic/beats/v7/libbeat/mime"
 	"github.com/elastic/beats/v7/libbeat/processors"
 	"github.com/elastic/beats/v7/libbeat/processors/checks"
@@ -33,17 +37,24 @@ func init() {
 	processors.RegisterPlugin("detect_mime_type",
 		checks.ConfigChecked(NewDetectMimeType,
 			checks.RequireFields("field", "target"),
-			checks.AllowedFields("field", "target")))
+			checks.AllowedFields("field", "target", "base64", "inner_target", "max_archive_size", "max_archive_entries")))
 }
 
 type mimeTypeProcessor struct {
-	Field  string `config:"field"`
-	Target string `config:"target"`
+	Field             string           `config:"field"`
+	Target            string           `config:"target"`
+	Base64            bool             `config:"base64"`
+	InnerTarget       string           `config:"inner_target"`
+	MaxArchiveSize    cfgtype.ByteSize `config:"max_archive_size" validate:"min=1"`
+	MaxArchiveEntries int              `config:"max_archive_entries" validate:"min=1"`
 }
 
 // NewDetectMimeType constructs a new mime processor.
 func NewDetectMimeType(cfg *conf.C) (processors.Processor, error) {
-	mimeType := &mimeTypeProcessor{}
+	mimeType := &mimeTypeProcessor{
+		MaxArchiveSize:    10 * 1024 * 1024,
+		MaxArchiveEntries: 100,
+	}
 	if err := cfg.Unpack(mimeType); err != nil {
 		return nil, errors.Wrapf(err, "fail to unpack the detect_mime_type configuration")
 	}
@@ -62,12 +73,49 @@ func (m *mimeTypeProcessor) Run(event *beat.Event) (*beat.Event, error) {
 		// wrong type or not set
 		return event, nil
 	}
-	if mimeType := mime.Detect(val); mimeType != "" {
-		_, err = event.PutValue(m.Target, mimeType)
+	data := []byte(val)
+	truncated := int64(len(data)) > int64(m.MaxArchiveSize)
+	if m.Base64 {
+		data, truncated, err = m.decodeBase64(val)
+		if err != nil {
+			// not base64 encoded, nothing to detect
+			return event, nil
+		}
+	}
+	mimeType := mime.DetectBytes(data)
+	if mimeType == "" {
+		return event, nil
+	}
+	if _, err = event.PutValue(m.Target, mimeType); err != nil {
+		return event, err
 	}
+	if m.InnerTarget == "" || truncated || !mime.IsArchive(mimeType) {
+		return event, nil
+	}
+	innerTypes, err := mime.DetectArchiveBytes(mimeType, data, int64(m.MaxArchiveSize), m.MaxArchiveEntries)
+	if err != nil || len(innerTypes) == 0 {
+		// corrupt archive or no detectable entries
+		return event, nil
+	}
+	_, err = event.PutValue(m.InnerTarget, innerTypes)
 	return event, err
 }
 
+// decodeBase64 decodes at most max_archive_size bytes of a base64 encoded
+// value. Line breaks, as found in MIME encoded attachments, are ignored. The
+// returned flag is set when the value was larger and got truncated.
+func (m *mimeTypeProcessor) decodeBase64(val string) ([]byte, bool, error) {
+	decoder := base64.NewDecoder(base64.StdEncoding, strings.NewReader(val))
+	data, err := io.ReadAll(io.LimitReader(decoder, int64(m.MaxArchiveSize)+1))
+	if err != nil {
+		return nil, false, err
+	}
+	if int64(len(data)) > int64(m.MaxArchiveSize) {
+		return data[:m.MaxArchiveSize], true, nil
+	}
+	return data, false, nil
+}
+
 func (m *mimeTypeProcessor) String() string {
 	return fmt.Sprintf("detect_mime_type=%+v->%+v", m.Field, m.Target)
 }
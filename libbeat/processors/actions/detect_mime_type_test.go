@@ -18,6 +18,9 @@
 package actions
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -83,3 +86,95 @@ func TestMimeTypeTestNoMatch(t *testing.T) {
 	hasKey, _ := observed.Fields.HasKey("bar.baz.zoiks")
 	require.False(t, hasKey)
 }
+
+func TestMimeTypeBase64(t *testing.T) {
+	evt := beat.Event{
+		Fields: mapstr.M{
+			"email.attachments.content": base64.StdEncoding.EncodeToString([]byte(`{"hello": "world"}`)),
+		},
+	}
+	p, err := NewDetectMimeType(conf.MustNewConfigFrom(map[string]interface{}{
+		"field":  "email.attachments.content",
+		"target": "email.attachments.file.mime_type",
+		"base64": true,
+	}))
+	require.NoError(t, err)
+	observed, err := p.Run(&evt)
+	require.NoError(t, err)
+	enriched, err := observed.Fields.GetValue("email.attachments.file.mime_type")
+	require.NoError(t, err)
+	require.Equal(t, "application/json", enriched)
+}
+
+func TestMimeTypeBase64Invalid(t *testing.T) {
+	evt := beat.Event{
+		Fields: mapstr.M{
+			"foo.bar.baz": "hello world!",
+		},
+	}
+	p, err := NewDetectMimeType(conf.MustNewConfigFrom(map[string]interface{}{
+		"field":  "foo.bar.baz",
+		"target": "bar.baz.zoiks",
+		"base64": true,
+	}))
+	require.NoError(t, err)
+	observed, err := p.Run(&evt)
+	require.NoError(t, err)
+	hasKey, _ := observed.Fields.HasKey("bar.baz.zoiks")
+	require.False(t, hasKey)
+}
+
+func TestMimeTypeArchive(t *testing.T) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	f, err := w.Create("index.html")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("<html>Test</html>"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	// wrap lines like MIME encoded attachments
+	encoded := base64.StdEncoding.EncodeToString(buf.Bytes())
+	var wrapped string
+	for len(encoded) > 76 {
+		wrapped += encoded[:76] + "\r\n"
+		encoded = encoded[76:]
+	}
+	wrapped += encoded
+
+	tests := []struct {
+		name           string
+		maxArchiveSize string
+		innerTypes     interface{}
+	}{
+		{"inspected", "1MiB", []string{"text/html; charset=utf-8"}},
+		{"too large", "64", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evt := beat.Event{
+				Fields: mapstr.M{
+					"file.content": wrapped,
+				},
+			}
+			p, err := NewDetectMimeType(conf.MustNewConfigFrom(map[string]interface{}{
+				"field":            "file.content",
+				"target":           "file.mime_type",
+				"inner_target":     "file.inner_mime_types",
+				"base64":           true,
+				"max_archive_size": tt.maxArchiveSize,
+			}))
+			require.NoError(t, err)
+			observed, err := p.Run(&evt)
+			require.NoError(t, err)
+			enriched, err := observed.Fields.GetValue("file.mime_type")
+			require.NoError(t, err)
+			require.Equal(t, "application/zip", enriched)
+			inner, _ := observed.Fields.GetValue("file.inner_mime_types")
+			if tt.innerTypes == nil {
+				require.Nil(t, inner)
+			} else {
+				require.Equal(t, tt.innerTypes, inner)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@
 package add_docker_metadata
 
 import (
+	"context"
 	"fmt"
 	"io/fs"
 	"os"
@@ -47,6 +48,11 @@ const (
 	processorName         = "add_docker_metadata"
 	dockerContainerIDKey  = "container.id"
 	cgroupCacheExpiration = 5 * time.Minute
+
+	// runtimeCacheExpiration is the time the metadata resolved from CRI
+	// runtimes and the image digests of Docker containers are cached.
+	runtimeCacheExpiration = 5 * time.Minute
+	dockerInspectTimeout   = 5 * time.Second
 )
 
 // processGroupPaths returns the cgroups associated with a process. This enables
@@ -68,6 +74,12 @@ type addDockerMetadata struct {
 	hostFS          resolve.Resolver // Directory where /proc is found
 	dedot           bool             // If set to true, replace dots in labels with `_`.
 	dockerAvailable bool             // If Docker exists in env, then it is set to true
+
+	inspector      dockerInspector  // Docker client used to resolve image digests, nil if disabled.
+	imageDigests   *common.Cache    // Cache of container ID to image digests (*containerMeta).
+	runtime        containerRuntime // CRI runtime used for containers unknown to Docker, nil if not available.
+	runtimeTimeout time.Duration    // Timeout of requests to the CRI runtime.
+	runtimeCache   *common.Cache    // Cache of container ID to metadata resolved from the CRI runtime (*containerMeta).
 }
 
 const selector = "add_docker_metadata"
@@ -97,6 +109,31 @@ func buildDockerMetadataProcessor(log *logp.Logger, cfg *conf.C, watcherConstruc
 		}
 	}
 
+	var inspector dockerInspector
+	var imageDigests *common.Cache
+	if dockerAvailable && config.ImageDigest {
+		inspector, err = newDockerInspector(config.Host, config.TLS)
+		if err != nil {
+			log.Debugf("%v: failed to create Docker client to resolve image digests: %+v", processorName, err)
+		} else {
+			imageDigests = common.NewCache(runtimeCacheExpiration, 100)
+			imageDigests.StartJanitor(5 * time.Second)
+		}
+	}
+
+	var runtime containerRuntime
+	var runtimeCache *common.Cache
+	if config.CRI.Enabled {
+		runtime, err = newContainerRuntime(config.CRI)
+		if err != nil {
+			log.Debugf("%v: CRI runtime not detected: %+v", processorName, err)
+		} else {
+			log.Debugf("%v: CRI runtime detected", processorName)
+			runtimeCache = common.NewCache(runtimeCacheExpiration, 100)
+			runtimeCache.StartJanitor(5 * time.Second)
+		}
+	}
+
 	// Use extract_field processor to get container ID from source file path.
 	var sourceProcessor processors.Processor
 	if config.MatchSource {
@@ -121,6 +158,11 @@ func buildDockerMetadataProcessor(log *logp.Logger, cfg *conf.C, watcherConstruc
 		hostFS:          resolve.NewTestResolver(config.HostFS),
 		dedot:           config.DeDot,
 		dockerAvailable: dockerAvailable,
+		inspector:       inspector,
+		imageDigests:    imageDigests,
+		runtime:         runtime,
+		runtimeTimeout:  config.CRI.Timeout,
+		runtimeCache:    runtimeCache,
 	}, nil
 }
 
@@ -136,7 +178,7 @@ func lazyCgroupCacheInit(d *addDockerMetadata) {
 }
 
 func (d *addDockerMetadata) Run(event *beat.Event) (*beat.Event, error) {
-	if !d.dockerAvailable {
+	if !d.dockerAvailable && d.runtime == nil {
 		return event, nil
 	}
 	var cid string
@@ -189,13 +231,13 @@ func (d *addDockerMetadata) Run(event *beat.Event) (*beat.Event, error) {
 		return event, nil
 	}
 
-	container := d.watcher.Container(cid)
+	container := d.lookupContainer(cid)
 	if container != nil {
 		meta := mapstr.M{}
 
-		if len(container.Labels) > 0 {
+		if len(container.labels) > 0 {
 			labels := mapstr.M{}
-			for k, v := range container.Labels {
+			for k, v := range container.labels {
 				if d.dedot {
 					label := common.DeDot(k)
 					labels.Put(label, v)
@@ -206,9 +248,18 @@ func (d *addDockerMetadata) Run(event *beat.Event) (*beat.Event, error) {
 			meta.Put("container.labels", labels)
 		}
 
-		meta.Put("container.id", container.ID)
-		meta.Put("container.image.name", container.Image)
-		meta.Put("container.name", container.Name)
+		meta.Put("container.id", container.id)
+		meta.Put("container.image.name", container.image)
+		meta.Put("container.name", container.name)
+		if len(container.imageDigests) > 0 {
+			meta.Put("container.image.hash.all", container.imageDigests)
+		}
+		if container.imageRegistry != "" {
+			meta.Put("container.image.registry", container.imageRegistry)
+		}
+		if container.runtime != "" {
+			meta.Put("container.runtime", container.runtime)
+		}
 		event.Fields.DeepUpdate(meta.Clone())
 	} else {
 		d.log.Debugf("Container not found: cid=%s", cid)
@@ -217,14 +268,97 @@ func (d *addDockerMetadata) Run(event *beat.Event) (*beat.Event, error) {
 	return event, nil
 }
 
+// lookupContainer returns the metadata of a container known to Docker or to
+// the CRI runtime, or nil if the container is not found.
+func (d *addDockerMetadata) lookupContainer(cid string) *containerMeta {
+	if d.dockerAvailable {
+		if container := d.watcher.Container(cid); container != nil {
+			meta := &containerMeta{
+				id:     container.ID,
+				name:   container.Name,
+				image:  container.Image,
+				labels: container.Labels,
+			}
+			if d.inspector != nil {
+				images := d.dockerImages(container.ID)
+				meta.imageDigests = images.imageDigests
+				meta.imageRegistry = images.imageRegistry
+			}
+			return meta
+		}
+	}
+	if d.runtime != nil {
+		return d.runtimeContainer(cid)
+	}
+	return nil
+}
+
+// dockerImages returns the image digests of a Docker container. Failed
+// lookups are cached too, so Docker is not queried for every event.
+func (d *addDockerMetadata) dockerImages(cid string) *containerMeta {
+	if images, ok := d.imageDigests.Get(cid).(*containerMeta); ok {
+		return images
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dockerInspectTimeout)
+	defer cancel()
+	digests, registry, err := dockerImageDigests(ctx, d.inspector, cid)
+	if err != nil {
+		d.log.Debugf("Failed to resolve image digests of container %s: %v", cid, err)
+	}
+	images := &containerMeta{imageDigests: digests, imageRegistry: registry}
+	d.imageDigests.Put(cid, images)
+	return images
+}
+
+// runtimeContainer returns the metadata of a container from the CRI runtime.
+// Containers that are not found are cached too, so the runtime is not queried
+// for every event.
+func (d *addDockerMetadata) runtimeContainer(cid string) *containerMeta {
+	if container, ok := d.runtimeCache.Get(cid).(*containerMeta); ok {
+		if container.id == "" {
+			return nil
+		}
+		return container
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.runtimeTimeout)
+	defer cancel()
+	container, err := d.runtime.Container(ctx, cid)
+	if err != nil {
+		if !errors.Is(err, errContainerNotFound) {
+			d.log.Debugf("Failed to get container %s from the CRI runtime: %v", cid, err)
+			return nil
+		}
+		container = &containerMeta{}
+	}
+	d.runtimeCache.Put(cid, container)
+	if container.id == "" {
+		return nil
+	}
+	return container
+}
+
 func (d *addDockerMetadata) Close() error {
 	if d.cgroups != nil {
 		d.cgroups.StopJanitor()
 	}
+	if d.imageDigests != nil {
+		d.imageDigests.StopJanitor()
+	}
+	if d.runtimeCache != nil {
+		d.runtimeCache.StopJanitor()
+	}
 	// Watcher can be nil if processor failed on creation
 	if d.watcher != nil {
 		d.watcher.Stop()
 	}
+	if d.inspector != nil {
+		d.inspector.Close()
+	}
+	if d.runtime != nil {
+		d.runtime.Close()
+	}
 	err := processors.Close(d.sourceProcessor)
 	if err != nil {
 		return errors.Wrap(err, "closing source processor of add_docker_metadata")
@@ -22,11 +22,14 @@
 package add_docker_metadata
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"runtime"
 	"testing"
 
+	"github.com/docker/docker/api/types"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -40,6 +43,14 @@ import (
 )
 
 func init() {
+	// Stub out the container runtimes.
+	newContainerRuntime = func(criConfig) (containerRuntime, error) {
+		return nil, errors.New("no CRI runtime")
+	}
+	newDockerInspector = func(string, *docker.TLSConfig) (dockerInspector, error) {
+		return &mockInspector{}, nil
+	}
+
 	// Stub out the procfs.
 	processCgroupPaths = func(_ resolve.Resolver, pid int) (cgroup.PathList, error) {
 
@@ -440,3 +451,153 @@ func (m *mockWatcher) ListenStart() bus.Listener {
 func (m *mockWatcher) ListenStop() bus.Listener {
 	return nil
 }
+
+func TestMatchContainerImageDigest(t *testing.T) {
+	defer func(f func(string, *docker.TLSConfig) (dockerInspector, error)) { newDockerInspector = f }(newDockerInspector)
+	newDockerInspector = func(string, *docker.TLSConfig) (dockerInspector, error) {
+		return &mockInspector{
+			containers: map[string]string{"container_id": "sha256:0123"},
+			images: map[string][]string{"sha256:0123": {
+				"registry.example.com:5000/team/app@sha256:4567",
+				"registry.example.com:5000/mirror/app@sha256:89ab",
+			}},
+		}, nil
+	}
+
+	testConfig, err := config.NewConfigFrom(map[string]interface{}{
+		"match_fields": []string{"foo"},
+	})
+	assert.NoError(t, err)
+
+	p, err := buildDockerMetadataProcessor(logp.L(), testConfig, MockWatcherFactory(
+		map[string]*docker.Container{
+			"container_id": {
+				ID:    "container_id",
+				Image: "registry.example.com:5000/team/app:1.0",
+				Name:  "name",
+			},
+		}))
+	assert.NoError(t, err, "initializing add_docker_metadata processor")
+
+	result, err := p.Run(&beat.Event{Fields: mapstr.M{"foo": "container_id"}})
+	assert.NoError(t, err, "processing an event")
+
+	assert.EqualValues(t, mapstr.M{
+		"container": mapstr.M{
+			"id": "container_id",
+			"image": mapstr.M{
+				"name":     "registry.example.com:5000/team/app:1.0",
+				"hash":     mapstr.M{"all": []string{"sha256:4567", "sha256:89ab"}},
+				"registry": "registry.example.com:5000",
+			},
+			"name": "name",
+		},
+		"foo": "container_id",
+	}, result.Fields)
+}
+
+func TestMatchCRIContainer(t *testing.T) {
+	runtime := &mockRuntime{
+		containers: map[string]*containerMeta{
+			"8c147fdfab5a2608fe513d10294bf77cb502a231da9725093a155bd25cd1f14b": {
+				id:            "8c147fdfab5a2608fe513d10294bf77cb502a231da9725093a155bd25cd1f14b",
+				name:          "nginx",
+				image:         "docker.io/library/nginx:1.23",
+				imageDigests:  []string{"sha256:0123"},
+				imageRegistry: "docker.io",
+				runtime:       "containerd",
+				labels:        map[string]string{"io.kubernetes.pod.name": "web"},
+			},
+		},
+	}
+	defer func(f func(criConfig) (containerRuntime, error)) { newContainerRuntime = f }(newContainerRuntime)
+	newContainerRuntime = func(criConfig) (containerRuntime, error) {
+		return runtime, nil
+	}
+	noDocker := func(*logp.Logger, string, *docker.TLSConfig, bool) (docker.Watcher, error) {
+		return nil, errors.New("no docker")
+	}
+
+	testConfig, err := config.NewConfigFrom(map[string]interface{}{
+		"match_fields": []string{"container.id"},
+	})
+	assert.NoError(t, err)
+
+	p, err := buildDockerMetadataProcessor(logp.L(), testConfig, noDocker)
+	assert.NoError(t, err, "initializing add_docker_metadata processor")
+
+	expected := mapstr.M{
+		"container": mapstr.M{
+			"id": "8c147fdfab5a2608fe513d10294bf77cb502a231da9725093a155bd25cd1f14b",
+			"image": mapstr.M{
+				"name":     "docker.io/library/nginx:1.23",
+				"hash":     mapstr.M{"all": []string{"sha256:0123"}},
+				"registry": "docker.io",
+			},
+			"labels": mapstr.M{
+				"io_kubernetes_pod_name": "web",
+			},
+			"name":    "nginx",
+			"runtime": "containerd",
+		},
+		"process": mapstr.M{"pid": 1000},
+	}
+	for i := 0; i < 2; i++ {
+		result, err := p.Run(&beat.Event{Fields: mapstr.M{"process": mapstr.M{"pid": 1000}}})
+		assert.NoError(t, err, "processing an event")
+		assert.EqualValues(t, expected, result.Fields)
+	}
+
+	// The container is not found, but this is cached as well.
+	for i := 0; i < 2; i++ {
+		result, err := p.Run(&beat.Event{Fields: mapstr.M{"container": mapstr.M{"id": "unknown"}}})
+		assert.NoError(t, err, "processing an event")
+		assert.EqualValues(t, mapstr.M{"container": mapstr.M{"id": "unknown"}}, result.Fields)
+	}
+	assert.Equal(t, 2, runtime.calls)
+
+	assert.NoError(t, p.(*addDockerMetadata).Close())
+	assert.True(t, runtime.closed)
+}
+
+type mockInspector struct {
+	containers map[string]string   // container ID to image ID
+	images     map[string][]string // image ID to repository digests
+}
+
+func (m *mockInspector) ContainerInspect(_ context.Context, container string) (types.ContainerJSON, error) {
+	image, ok := m.containers[container]
+	if !ok {
+		return types.ContainerJSON{}, errors.New("no such container")
+	}
+	return types.ContainerJSON{ContainerJSONBase: &types.ContainerJSONBase{ID: container, Image: image}}, nil
+}
+
+func (m *mockInspector) ImageInspectWithRaw(_ context.Context, image string) (types.ImageInspect, []byte, error) {
+	digests, ok := m.images[image]
+	if !ok {
+		return types.ImageInspect{}, nil, errors.New("no such image")
+	}
+	return types.ImageInspect{ID: image, RepoDigests: digests}, nil, nil
+}
+
+func (m *mockInspector) Close() error { return nil }
+
+type mockRuntime struct {
+	containers map[string]*containerMeta
+	calls      int
+	closed     bool
+}
+
+func (m *mockRuntime) Container(_ context.Context, id string) (*containerMeta, error) {
+	m.calls++
+	if container, ok := m.containers[id]; ok {
+		return container, nil
+	}
+	return nil, errContainerNotFound
+}
+
+func (m *mockRuntime) Close() error {
+	m.closed = true
+	return nil
+}
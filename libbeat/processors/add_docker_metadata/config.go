@@ -37,12 +37,22 @@ type Config struct {
 	MatchPIDs    []string          `config:"match_pids"`         // A list of fields containing process IDs (PIDs).
 	HostFS       string            `config:"hostfs"`             // Specifies the mount point of the host’s filesystem for use in monitoring a host from within a container.
 	DeDot        bool              `config:"labels.dedot"`       // If set to true, replace dots in labels with `_`.
+	ImageDigest  bool              `config:"image_digest"`       // Add the digests and registry of container images.
+	CRI          criConfig         `config:"cri"`                // Settings to resolve containers of containerd and CRI-O.
 
 	// Annotations are kept after container is killed, until they haven't been
 	// accessed for a full `cleanup_timeout`:
 	CleanupTimeout time.Duration `config:"cleanup_timeout"`
 }
 
+// criConfig contains the settings of the CRI runtimes used to resolve
+// containers unknown to Docker.
+type criConfig struct {
+	Enabled   bool          `config:"enabled"`
+	Endpoints []string      `config:"endpoints"`
+	Timeout   time.Duration `config:"timeout" validate:"positive"`
+}
+
 func defaultConfig() Config {
 	return Config{
 		Host:        "unix:///var/run/docker.sock",
@@ -50,5 +60,14 @@ func defaultConfig() Config {
 		SourceIndex: 4, // Use 4 to match the CID in /var/lib/docker/containers/<container_id>/*.log.
 		MatchPIDs:   []string{"process.pid", "process.parent.pid"},
 		DeDot:       true,
+		ImageDigest: true,
+		CRI: criConfig{
+			Enabled: true,
+			Endpoints: []string{
+				"unix:///run/containerd/containerd.sock",
+				"unix:///var/run/crio/crio.sock",
+			},
+			Timeout: 5 * time.Second,
+		},
 	}
 }
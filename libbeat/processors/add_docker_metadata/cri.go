@@ -0,0 +1,176 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux || darwin || windows
+// +build linux darwin windows
+
+package add_docker_metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/multierr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+	criv1alpha2 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+)
+
+// errContainerNotFound is returned by a container runtime if it does not know
+// the container.
+var errContainerNotFound = errors.New("container not found")
+
+// containerRuntime resolves the metadata of containers managed by a runtime
+// other than Docker.
+type containerRuntime interface {
+	Container(ctx context.Context, id string) (*containerMeta, error)
+	Close() error
+}
+
+// newContainerRuntime connects to the first reachable CRI endpoint. It enables
+// unit testing by allowing us to stub the runtime.
+var newContainerRuntime = dialCRI
+
+// criClient talks to containerd or CRI-O using the CRI gRPC API.
+type criClient struct {
+	conn    *grpc.ClientConn
+	runtime string
+
+	// containerStatus returns the status of a container with the version of
+	// the CRI API served by the runtime.
+	containerStatus func(ctx context.Context, id string) (*containerMeta, error)
+}
+
+func dialCRI(config criConfig) (containerRuntime, error) {
+	var errs []error
+	for _, endpoint := range config.Endpoints {
+		client, err := dialCRIEndpoint(endpoint, config.Timeout)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", endpoint, err))
+			continue
+		}
+		return client, nil
+	}
+	return nil, fmt.Errorf("no CRI runtime available: %w", multierr.Combine(errs...))
+}
+
+func dialCRIEndpoint(endpoint string, timeout time.Duration) (*criClient, error) {
+	if path := strings.TrimPrefix(endpoint, "unix://"); path != endpoint {
+		if _, err := os.Stat(path); err != nil {
+			return nil, err
+		}
+	}
+
+	conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra check to confirm that the runtime is available
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client := &criClient{conn: conn}
+	if err := client.negotiate(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+// negotiate selects the version of the CRI API served by the runtime. The v1
+// API is preferred, runtimes released before Kubernetes 1.23 only serve
+// v1alpha2.
+func (c *criClient) negotiate(ctx context.Context) error {
+	v1 := criv1.NewRuntimeServiceClient(c.conn)
+	version, err := v1.Version(ctx, &criv1.VersionRequest{Version: "v1"})
+	if err == nil {
+		c.runtime = version.GetRuntimeName()
+		c.containerStatus = func(ctx context.Context, id string) (*containerMeta, error) {
+			resp, err := v1.ContainerStatus(ctx, &criv1.ContainerStatusRequest{ContainerId: id})
+			if err != nil {
+				return nil, err
+			}
+			s := resp.GetStatus()
+			return &containerMeta{
+				id:       s.GetId(),
+				name:     s.GetMetadata().GetName(),
+				image:    s.GetImage().GetImage(),
+				imageRef: s.GetImageRef(),
+				labels:   s.GetLabels(),
+			}, nil
+		}
+		return nil
+	}
+	if status.Code(err) != codes.Unimplemented {
+		return err
+	}
+
+	v1alpha2 := criv1alpha2.NewRuntimeServiceClient(c.conn)
+	alphaVersion, err := v1alpha2.Version(ctx, &criv1alpha2.VersionRequest{Version: "v1alpha2"})
+	if err != nil {
+		return err
+	}
+	c.runtime = alphaVersion.GetRuntimeName()
+	c.containerStatus = func(ctx context.Context, id string) (*containerMeta, error) {
+		resp, err := v1alpha2.ContainerStatus(ctx, &criv1alpha2.ContainerStatusRequest{ContainerId: id})
+		if err != nil {
+			return nil, err
+		}
+		s := resp.GetStatus()
+		return &containerMeta{
+			id:       s.GetId(),
+			name:     s.GetMetadata().GetName(),
+			image:    s.GetImage().GetImage(),
+			imageRef: s.GetImageRef(),
+			labels:   s.GetLabels(),
+		}, nil
+	}
+	return nil
+}
+
+// Container returns the metadata of the container with the given ID. CRI
+// runtimes also accept unique prefixes of container IDs.
+func (c *criClient) Container(ctx context.Context, id string) (*containerMeta, error) {
+	meta, err := c.containerStatus(ctx, id)
+	if status.Code(err) == codes.NotFound {
+		return nil, errContainerNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	meta.runtime = c.runtime
+	if digest := imageDigest(meta.imageRef); digest != "" {
+		meta.imageDigests = []string{digest}
+	}
+	meta.imageRegistry = imageRegistry(meta.image)
+	if meta.imageRegistry == "" {
+		meta.imageRegistry = imageRegistry(meta.imageRef)
+	}
+	return meta, nil
+}
+
+func (c *criClient) Close() error {
+	return c.conn.Close()
+}
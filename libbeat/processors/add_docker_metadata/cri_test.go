@@ -0,0 +1,183 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build (linux || darwin || windows) && !integration
+// +build linux darwin windows
+// +build !integration
+
+package add_docker_metadata
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+	criv1alpha2 "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+)
+
+const testContainerID = "8c147fdfab5a2608fe513d10294bf77cb502a231da9725093a155bd25cd1f14b"
+
+// fakeRuntimeV1 serves a container with the v1 CRI API.
+type fakeRuntimeV1 struct {
+	criv1.UnimplementedRuntimeServiceServer
+}
+
+func (*fakeRuntimeV1) Version(context.Context, *criv1.VersionRequest) (*criv1.VersionResponse, error) {
+	return &criv1.VersionResponse{RuntimeName: "containerd"}, nil
+}
+
+func (*fakeRuntimeV1) ContainerStatus(_ context.Context, req *criv1.ContainerStatusRequest) (*criv1.ContainerStatusResponse, error) {
+	if req.ContainerId != testContainerID {
+		return nil, status.Error(codes.NotFound, "container not found")
+	}
+	return &criv1.ContainerStatusResponse{Status: &criv1.ContainerStatus{
+		Id:       testContainerID,
+		Metadata: &criv1.ContainerMetadata{Name: "nginx"},
+		Image:    &criv1.ImageSpec{Image: "docker.io/library/nginx:1.23"},
+		ImageRef: "docker.io/library/nginx@sha256:0123",
+		Labels:   map[string]string{"io.kubernetes.pod.name": "web"},
+	}}, nil
+}
+
+// fakeRuntimeV1alpha2 serves a container with the v1alpha2 CRI API only.
+type fakeRuntimeV1alpha2 struct {
+	criv1alpha2.UnimplementedRuntimeServiceServer
+}
+
+func (*fakeRuntimeV1alpha2) Version(context.Context, *criv1alpha2.VersionRequest) (*criv1alpha2.VersionResponse, error) {
+	return &criv1alpha2.VersionResponse{RuntimeName: "cri-o"}, nil
+}
+
+func (*fakeRuntimeV1alpha2) ContainerStatus(_ context.Context, req *criv1alpha2.ContainerStatusRequest) (*criv1alpha2.ContainerStatusResponse, error) {
+	if req.ContainerId != testContainerID {
+		return nil, status.Error(codes.NotFound, "container not found")
+	}
+	return &criv1alpha2.ContainerStatusResponse{Status: &criv1alpha2.ContainerStatus{
+		Id:       testContainerID,
+		Metadata: &criv1alpha2.ContainerMetadata{Name: "nginx"},
+		Image:    &criv1alpha2.ImageSpec{Image: "quay.io/team/app:1.0"},
+		ImageRef: "quay.io/team/app@sha256:4567",
+	}}, nil
+}
+
+// serveCRI starts a gRPC server on a unix socket and returns its endpoint.
+func serveCRI(t *testing.T, register func(*grpc.Server)) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("unix sockets are not used by CRI runtimes on windows")
+	}
+
+	// Socket paths are limited in length, t.TempDir is too long on macOS.
+	dir, err := os.MkdirTemp("", "cri")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "cri.sock")
+
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	register(server)
+	go server.Serve(l) //nolint:errcheck // Stopped by the test cleanup.
+	t.Cleanup(server.Stop)
+	return "unix://" + path
+}
+
+func TestCRIClient(t *testing.T) {
+	tests := map[string]struct {
+		register func(*grpc.Server)
+		expected containerMeta
+	}{
+		"v1": {
+			register: func(s *grpc.Server) { criv1.RegisterRuntimeServiceServer(s, &fakeRuntimeV1{}) },
+			expected: containerMeta{
+				id:            testContainerID,
+				name:          "nginx",
+				image:         "docker.io/library/nginx:1.23",
+				imageRef:      "docker.io/library/nginx@sha256:0123",
+				imageDigests:  []string{"sha256:0123"},
+				imageRegistry: "docker.io",
+				runtime:       "containerd",
+				labels:        map[string]string{"io.kubernetes.pod.name": "web"},
+			},
+		},
+		"v1alpha2 fallback": {
+			register: func(s *grpc.Server) { criv1alpha2.RegisterRuntimeServiceServer(s, &fakeRuntimeV1alpha2{}) },
+			expected: containerMeta{
+				id:            testContainerID,
+				name:          "nginx",
+				image:         "quay.io/team/app:1.0",
+				imageRef:      "quay.io/team/app@sha256:4567",
+				imageDigests:  []string{"sha256:4567"},
+				imageRegistry: "quay.io",
+				runtime:       "cri-o",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			endpoint := serveCRI(t, test.register)
+			client, err := dialCRI(criConfig{
+				Endpoints: []string{"unix:///nonexistent/cri.sock", endpoint},
+				Timeout:   5 * time.Second,
+			})
+			require.NoError(t, err)
+			defer client.Close()
+
+			meta, err := client.Container(context.Background(), testContainerID)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, *meta)
+
+			_, err = client.Container(context.Background(), "unknown")
+			assert.ErrorIs(t, err, errContainerNotFound)
+		})
+	}
+}
+
+func TestImageReference(t *testing.T) {
+	tests := []struct {
+		ref      string
+		digest   string
+		registry string
+	}{
+		{ref: "nginx", registry: "docker.io"},
+		{ref: "nginx:1.23", registry: "docker.io"},
+		{ref: "library/nginx@sha256:0123", digest: "sha256:0123", registry: "docker.io"},
+		{ref: "docker.io/library/nginx:1.23", registry: "docker.io"},
+		{ref: "index.docker.io/library/nginx:1.23", registry: "docker.io"},
+		{ref: "localhost/app:1.0", registry: "localhost"},
+		{ref: "localhost:5000/app@sha256:0123", digest: "sha256:0123", registry: "localhost:5000"},
+		{ref: "quay.io/team/app@sha256:0123", digest: "sha256:0123", registry: "quay.io"},
+		{ref: "sha256:0123", digest: "sha256:0123"},
+		{ref: ""},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.digest, imageDigest(test.ref), "digest of %q", test.ref)
+		assert.Equal(t, test.registry, imageRegistry(test.ref), "registry of %q", test.ref)
+	}
+}
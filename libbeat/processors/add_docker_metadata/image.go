@@ -0,0 +1,139 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux || darwin || windows
+// +build linux darwin windows
+
+package add_docker_metadata
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/go-connections/tlsconfig"
+
+	"github.com/elastic/elastic-agent-autodiscover/docker"
+)
+
+// containerMeta is the metadata of a container resolved from Docker or a CRI
+// runtime.
+type containerMeta struct {
+	id            string
+	name          string
+	image         string
+	imageRef      string
+	imageDigests  []string
+	imageRegistry string
+	runtime       string
+	labels        map[string]string
+}
+
+// dockerInspector is the part of the Docker client used to resolve the
+// digests of container images.
+type dockerInspector interface {
+	ContainerInspect(ctx context.Context, container string) (types.ContainerJSON, error)
+	ImageInspectWithRaw(ctx context.Context, image string) (types.ImageInspect, []byte, error)
+	Close() error
+}
+
+// newDockerInspector returns a Docker client for the given settings. It
+// enables unit testing by allowing us to stub the client.
+var newDockerInspector = func(host string, tls *docker.TLSConfig) (dockerInspector, error) {
+	var httpClient *http.Client
+	if tls != nil {
+		tlsc, err := tlsconfig.Client(tlsconfig.Options{
+			CAFile:   tls.CA,
+			CertFile: tls.Certificate,
+			KeyFile:  tls.Key,
+		})
+		if err != nil {
+			return nil, err
+		}
+		httpClient = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: tlsc,
+			},
+		}
+	}
+	client, err := docker.NewClient(host, httpClient, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// dockerImageDigests returns the repository digests of the image a Docker
+// container was created from, along with the registry the image was pulled
+// from.
+func dockerImageDigests(ctx context.Context, client dockerInspector, containerID string) (digests []string, registry string, err error) {
+	container, err := client.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return nil, "", err
+	}
+	if container.ContainerJSONBase == nil {
+		return nil, "", nil
+	}
+	image, _, err := client.ImageInspectWithRaw(ctx, container.Image)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, ref := range image.RepoDigests {
+		if digest := imageDigest(ref); digest != "" {
+			digests = append(digests, digest)
+		}
+		if registry == "" {
+			registry = imageRegistry(ref)
+		}
+	}
+	return digests, registry, nil
+}
+
+// imageDigest returns the digest of an image reference in the
+// `algorithm:value` format, or an empty string if the reference doesn't
+// contain a digest.
+func imageDigest(ref string) string {
+	if i := strings.LastIndexByte(ref, '@'); i >= 0 {
+		return ref[i+1:]
+	}
+	if strings.HasPrefix(ref, "sha256:") {
+		return ref
+	}
+	return ""
+}
+
+// imageRegistry returns the registry of an image reference. References
+// without a registry, like `nginx:1.23`, refer to Docker Hub. An empty string
+// is returned for image IDs.
+func imageRegistry(ref string) string {
+	if ref == "" || strings.HasPrefix(ref, "sha256:") {
+		return ""
+	}
+	i := strings.IndexByte(ref, '/')
+	if i < 0 {
+		return "docker.io"
+	}
+	domain := ref[:i]
+	if domain == "localhost" || strings.ContainsAny(domain, ".:") {
+		if domain == "index.docker.io" {
+			return "docker.io"
+		}
+		return domain
+	}
+	return "docker.io"
+}
@@ -0,0 +1,200 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cardinality_guard
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/jonboulle/clockwork"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	c "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// instanceID is used to assign each instance a unique monitoring namespace.
+var instanceID = atomic.MakeUint32(0)
+
+const processorName = "cardinality_guard"
+const logName = "processor." + processorName
+
+func init() {
+	processors.RegisterPlugin(processorName, new)
+}
+
+type metrics struct {
+	Tagged        *monitoring.Int
+	Dropped       *monitoring.Int
+	FieldsDropped *monitoring.Int
+}
+
+// guard is the state of a configured field for the current window.
+type guard struct {
+	sketch   *sketch
+	exceeded bool
+}
+
+type cardinalityGuard struct {
+	config config
+	fields []string
+	clock  clockwork.Clock
+
+	mu          sync.Mutex
+	windowStart time.Time
+	guards      map[string]*guard
+
+	logger  *logp.Logger
+	metrics metrics
+}
+
+// new constructs a new cardinality_guard processor.
+func new(cfg *c.C) (processors.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, errors.Wrap(err, "could not unpack processor configuration")
+	}
+
+	// Logging and metrics (each processor instance has a unique ID).
+	var (
+		id  = int(instanceID.Inc())
+		log = logp.NewLogger(logName).With("instance_id", id)
+		reg = monitoring.Default.NewRegistry(logName+"."+strconv.Itoa(id), monitoring.DoNotReport)
+	)
+
+	p := &cardinalityGuard{
+		config: config,
+		fields: common.MakeStringSet(config.Fields...).ToSlice(),
+		clock:  clockwork.NewRealClock(),
+		guards: map[string]*guard{},
+		logger: log,
+		metrics: metrics{
+			Tagged:        monitoring.NewInt(reg, "tagged"),
+			Dropped:       monitoring.NewInt(reg, "dropped"),
+			FieldsDropped: monitoring.NewInt(reg, "fields_dropped"),
+		},
+	}
+	for _, f := range p.fields {
+		p.guards[f] = &guard{sketch: newSketch(config.Precision)}
+	}
+	return p, nil
+}
+
+// Run counts the values of the configured fields and applies the action to
+// the event if one of the fields it holds has exceeded the maximum
+// cardinality in the current window. Otherwise it returns the event as-is.
+func (p *cardinalityGuard) Run(event *beat.Event) (*beat.Event, error) {
+	var exceeded []string
+
+	p.mu.Lock()
+	if now := p.clock.Now(); now.Sub(p.windowStart) >= p.config.Window {
+		p.resetWindow(now)
+	}
+	for _, k := range p.fields {
+		v, err := event.GetValue(k)
+		if err != nil {
+			continue
+		}
+		g := p.guards[k]
+		if !g.exceeded {
+			p.addValue(g, v)
+			if g.sketch.estimate() > uint64(p.config.MaxCardinality) {
+				g.exceeded = true
+				p.logger.Warnf("Field %v exceeded the maximum cardinality of %v, "+
+					"the action %v is applied to its events until %v.",
+					k, p.config.MaxCardinality, p.config.Action, p.windowStart.Add(p.config.Window))
+			}
+		}
+		if g.exceeded {
+			exceeded = append(exceeded, k)
+		}
+	}
+	p.mu.Unlock()
+
+	if len(exceeded) == 0 {
+		return event, nil
+	}
+
+	switch p.config.Action {
+	case actionDropEvent:
+		p.logger.Debugf("event [%v] dropped by cardinality_guard processor", event)
+		p.metrics.Dropped.Inc()
+		return nil, nil
+	case actionDropField:
+		for _, k := range exceeded {
+			if err := event.Delete(k); err != nil {
+				return event, errors.Wrapf(err, "could not drop field %v", k)
+			}
+			p.metrics.FieldsDropped.Inc()
+		}
+	default:
+		p.metrics.Tagged.Inc()
+		if err := mapstr.AddTags(event.Fields, []string{p.config.Tag}); err != nil {
+			return event, errors.Wrap(err, "could not tag event")
+		}
+	}
+	return event, nil
+}
+
+func (p *cardinalityGuard) String() string {
+	return fmt.Sprintf(
+		"%v=[fields=[%v],max_cardinality=[%v],window=[%v],action=[%v]]",
+		processorName, p.config.Fields, p.config.MaxCardinality, p.config.Window, p.config.Action,
+	)
+}
+
+// resetWindow starts a new window, forgetting the values counted so far.
+func (p *cardinalityGuard) resetWindow(now time.Time) {
+	p.windowStart = now
+	for _, g := range p.guards {
+		g.sketch.reset()
+		g.exceeded = false
+	}
+}
+
+// addValue adds the value of a field to its sketch, or each of its values
+// when it is an array. Objects are not counted.
+func (p *cardinalityGuard) addValue(g *guard, v interface{}) {
+	switch vv := v.(type) {
+	case map[string]interface{}, mapstr.M:
+	case []interface{}:
+		for _, e := range vv {
+			p.addValue(g, e)
+		}
+	case []string:
+		for _, e := range vv {
+			g.sketch.add(xxhash.Sum64String(e))
+		}
+	case string:
+		g.sketch.add(xxhash.Sum64String(vv))
+	case time.Time:
+		// Ensure we consistently hash times in UTC.
+		g.sketch.add(xxhash.Sum64String(vv.UTC().String()))
+	default:
+		g.sketch.add(xxhash.Sum64String(fmt.Sprint(vv)))
+	}
+}
@@ -0,0 +1,166 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cardinality_guard
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestNew(t *testing.T) {
+	cases := map[string]struct {
+		config mapstr.M
+		err    bool
+	}{
+		"default": {
+			config: mapstr.M{"fields": []string{"user.id"}, "max_cardinality": 100},
+		},
+		"missing fields": {
+			config: mapstr.M{"max_cardinality": 100},
+			err:    true,
+		},
+		"missing max_cardinality": {
+			config: mapstr.M{"fields": []string{"user.id"}},
+			err:    true,
+		},
+		"invalid precision": {
+			config: mapstr.M{"fields": []string{"user.id"}, "max_cardinality": 100, "precision": 20},
+			err:    true,
+		},
+		"invalid action": {
+			config: mapstr.M{"fields": []string{"user.id"}, "max_cardinality": 100, "action": "ignore"},
+			err:    true,
+		},
+		"tag action without tag": {
+			config: mapstr.M{"fields": []string{"user.id"}, "max_cardinality": 100, "tag": ""},
+			err:    true,
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := new(conf.MustNewConfigFrom(test.config))
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSketch(t *testing.T) {
+	for _, n := range []int{0, 10, 1000, 100000} {
+		s := newSketch(14)
+		for i := 0; i < n; i++ {
+			// Each value is added twice, duplicates are not counted.
+			s.add(xxhash.Sum64String(strconv.Itoa(i)))
+			s.add(xxhash.Sum64String(strconv.Itoa(i)))
+		}
+		assert.InDelta(t, n, s.estimate(), 0.03*float64(n), "cardinality %v", n)
+	}
+
+	s := newSketch(4)
+	s.add(xxhash.Sum64String("a"))
+	s.reset()
+	assert.Equal(t, uint64(0), s.estimate())
+}
+
+func TestCardinalityGuard(t *testing.T) {
+	cases := map[string]struct {
+		action string
+		check  func(t *testing.T, event *beat.Event)
+	}{
+		actionTag: {
+			check: func(t *testing.T, event *beat.Event) {
+				require.NotNil(t, event)
+				tags, _ := event.GetValue("tags")
+				assert.Equal(t, []string{"cardinality_exceeded"}, tags)
+			},
+		},
+		actionDropField: {
+			check: func(t *testing.T, event *beat.Event) {
+				require.NotNil(t, event)
+				_, err := event.GetValue("user.id")
+				assert.Error(t, err)
+				v, _ := event.GetValue("host.name")
+				assert.Equal(t, "a", v)
+			},
+		},
+		actionDropEvent: {
+			check: func(t *testing.T, event *beat.Event) {
+				assert.Nil(t, event)
+			},
+		},
+	}
+
+	for action, test := range cases {
+		t.Run(action, func(t *testing.T) {
+			p, err := new(conf.MustNewConfigFrom(mapstr.M{
+				"fields":          []string{"user.id", "host.name"},
+				"max_cardinality": 3,
+				"window":          "1m",
+				"action":          action,
+			}))
+			require.NoError(t, err)
+			g := p.(*cardinalityGuard)
+			clock := clockwork.NewFakeClock()
+			g.clock = clock
+
+			run := func(user string) *beat.Event {
+				t.Helper()
+				fields := mapstr.M{"host": mapstr.M{"name": "a"}}
+				if user != "" {
+					fields["user"] = mapstr.M{"id": user}
+				}
+				out, err := g.Run(&beat.Event{Fields: fields})
+				require.NoError(t, err)
+				return out
+			}
+			untouched := func(event *beat.Event) {
+				t.Helper()
+				require.NotNil(t, event)
+				assert.NotContains(t, event.Fields, "tags")
+				v, _ := event.GetValue("user.id")
+				assert.NotNil(t, v)
+			}
+
+			for _, user := range []string{"1", "2", "3", "3", "1"} {
+				untouched(run(user))
+			}
+			test.check(t, run("4"))
+			// Once exceeded, the action applies to all the values of the field
+			// until the end of the window.
+			test.check(t, run("1"))
+			assert.NotNil(t, run(""), "events without the field are not guarded")
+
+			clock.Advance(time.Minute)
+			untouched(run("5"))
+		})
+	}
+}
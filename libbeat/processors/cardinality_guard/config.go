@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cardinality_guard
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// config for the cardinality_guard processor.
+type config struct {
+	Fields         []string      `config:"fields" validate:"required"`
+	MaxCardinality int           `config:"max_cardinality" validate:"required, positive, nonzero"`
+	Window         time.Duration `config:"window" validate:"positive,nonzero"`
+	Precision      uint8         `config:"precision" validate:"min=4, max=16"`
+
+	// Action is what happens to the events holding a field over the limit,
+	// they are tagged with Tag, the field is removed or the event is dropped.
+	Action string `config:"action"`
+	Tag    string `config:"tag"`
+}
+
+const (
+	actionTag       = "tag"
+	actionDropField = "drop_field"
+	actionDropEvent = "drop_event"
+)
+
+func defaultConfig() config {
+	return config{
+		Window:    time.Hour,
+		Precision: 14,
+		Action:    actionTag,
+		Tag:       "cardinality_exceeded",
+	}
+}
+
+func (c *config) Validate() error {
+	switch c.Action {
+	case actionDropField, actionDropEvent:
+	case actionTag:
+		if c.Tag == "" {
+			return errors.New("tag is required when action is tag")
+		}
+	default:
+		return fmt.Errorf("invalid action %q, must be one of %q, %q or %q",
+			c.Action, actionTag, actionDropField, actionDropEvent)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cardinality_guard
+
+import (
+	"math"
+	"math/bits"
+)
+
+// sketch is a HyperLogLog estimating the number of distinct hashes added to
+// it with a standard error of about 1.04/sqrt(2^precision), using 2^precision
+// bytes whatever the number of hashes.
+type sketch struct {
+	precision uint8
+	registers []uint8
+
+	// sum of 2^-register and number of zero registers, maintained on add
+	// so the estimate is computed in constant time.
+	sum   float64
+	zeros int
+}
+
+func newSketch(precision uint8) *sketch {
+	m := 1 << precision
+	return &sketch{
+		precision: precision,
+		registers: make([]uint8, m),
+		sum:       float64(m),
+		zeros:     m,
+	}
+}
+
+// add records the 64 bits hash h.
+func (s *sketch) add(h uint64) {
+	idx := h >> (64 - s.precision)
+	// The rank is the position of the first set bit in the remaining bits,
+	// the guard bit caps it when they are all zeros.
+	rank := uint8(bits.LeadingZeros64(h<<s.precision|1<<(s.precision-1))) + 1
+
+	old := s.registers[idx]
+	if rank <= old {
+		return
+	}
+	if old == 0 {
+		s.zeros--
+	}
+	s.sum += math.Ldexp(1, -int(rank)) - math.Ldexp(1, -int(old))
+	s.registers[idx] = rank
+}
+
+// estimate returns the approximate number of distinct hashes added.
+func (s *sketch) estimate() uint64 {
+	m := float64(len(s.registers))
+	e := alpha(len(s.registers)) * m * m / s.sum
+	if e <= 2.5*m && s.zeros > 0 {
+		// Linear counting is more accurate for small cardinalities.
+		e = m * math.Log(m/float64(s.zeros))
+	}
+	return uint64(e + 0.5)
+}
+
+// reset forgets all the hashes added.
+func (s *sketch) reset() {
+	for i := range s.registers {
+		s.registers[i] = 0
+	}
+	s.sum = float64(len(s.registers))
+	s.zeros = len(s.registers)
+}
+
+func alpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/float64(m))
+}
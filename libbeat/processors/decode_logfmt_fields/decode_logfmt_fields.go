@@ -0,0 +1,130 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_logfmt_fields
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/processors/checks"
+	"github.com/elastic/beats/v7/libbeat/reader/logfmt"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+type decodeLogfmtFields struct {
+	logfmtConfig
+	fields map[string]string
+}
+
+type logfmtConfig struct {
+	logfmt.DecodeConfig `config:",inline"`
+	Fields              mapstr.M `config:"fields"`
+	IgnoreMissing       bool     `config:"ignore_missing"`
+	OverwriteKeys       bool     `config:"overwrite_keys"`
+	FailOnError         bool     `config:"fail_on_error"`
+}
+
+var defaultLogfmtConfig = logfmtConfig{
+	DecodeConfig: logfmt.DefaultDecodeConfig(),
+	FailOnError:  true,
+}
+
+func init() {
+	processors.RegisterPlugin("decode_logfmt_fields",
+		checks.ConfigChecked(NewDecodeLogfmtFields,
+			checks.RequireFields("fields"),
+			checks.AllowedFields("fields", "ignore_missing", "overwrite_keys", "infer_types", "duplicate_keys", "fail_on_error", "when")))
+}
+
+// NewDecodeLogfmtFields constructs a new decode_logfmt_fields processor.
+func NewDecodeLogfmtFields(c *config.C) (processors.Processor, error) {
+	config := defaultLogfmtConfig
+
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack the decode_logfmt_fields configuration: %w", err)
+	}
+	if len(config.Fields) == 0 {
+		return nil, errors.New("no fields to decode configured")
+	}
+
+	f := &decodeLogfmtFields{logfmtConfig: config}
+	f.fields = make(map[string]string, len(config.Fields))
+	for src, dstIf := range config.Fields.Flatten() {
+		dst, ok := dstIf.(string)
+		if !ok {
+			return nil, fmt.Errorf("bad destination mapping for %s: destination field must be string, not %T (got %v)", src, dstIf, dstIf)
+		}
+		f.fields[src] = dst
+	}
+	return f, nil
+}
+
+// Run applies the decode_logfmt_fields processor to an event.
+func (f *decodeLogfmtFields) Run(event *beat.Event) (*beat.Event, error) {
+	var saved *beat.Event
+	if f.FailOnError {
+		saved = event.Clone()
+	}
+	for src, dest := range f.fields {
+		if err := f.decodeField(src, dest, event); err != nil && f.FailOnError {
+			return saved, err
+		}
+	}
+	return event, nil
+}
+
+func (f *decodeLogfmtFields) decodeField(src, dest string, event *beat.Event) error {
+	data, err := event.GetValue(src)
+	if err != nil {
+		if f.IgnoreMissing && errors.Is(err, mapstr.ErrKeyNotFound) {
+			return nil
+		}
+		return fmt.Errorf("could not fetch value for field %s: %w", src, err)
+	}
+
+	text, ok := data.(string)
+	if !ok {
+		return fmt.Errorf("field %s is not of string type", src)
+	}
+
+	fields, err := logfmt.Decode([]byte(text), f.DecodeConfig)
+	if err != nil {
+		return fmt.Errorf("error decoding logfmt from field %s: %w", src, err)
+	}
+
+	if src != dest && !f.OverwriteKeys {
+		if _, err = event.GetValue(dest); err == nil {
+			return fmt.Errorf("target field %s already has a value. Set the overwrite_keys flag or drop/rename the field first", dest)
+		}
+	}
+	if _, err = event.PutValue(dest, fields); err != nil {
+		return fmt.Errorf("failed setting field %s: %w", dest, err)
+	}
+	return nil
+}
+
+// String returns a string representation of this processor.
+func (f decodeLogfmtFields) String() string {
+	json, _ := json.Marshal(f.logfmtConfig)
+	return "decode_logfmt_fields=" + string(json)
+}
@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_logfmt_fields
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	cfg "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestDecodeLogfmtFields(t *testing.T) {
+	tests := map[string]struct {
+		config   mapstr.M
+		input    beat.Event
+		expected beat.Event
+		fail     bool
+	}{
+		"decode to target": {
+			config: mapstr.M{
+				"fields": mapstr.M{"message": "app"},
+			},
+			input: beat.Event{
+				Fields: mapstr.M{"message": `level=warn msg="disk almost full" used=93.5`},
+			},
+			expected: beat.Event{
+				Fields: mapstr.M{
+					"message": `level=warn msg="disk almost full" used=93.5`,
+					"app":     mapstr.M{"level": "warn", "msg": "disk almost full", "used": 93.5},
+				},
+			},
+		},
+		"duplicate keys as array": {
+			config: mapstr.M{
+				"fields":         mapstr.M{"message": "app"},
+				"duplicate_keys": "array",
+				"infer_types":    false,
+			},
+			input: beat.Event{
+				Fields: mapstr.M{"message": `tag=1 tag=2`},
+			},
+			expected: beat.Event{
+				Fields: mapstr.M{
+					"message": `tag=1 tag=2`,
+					"app":     mapstr.M{"tag": []interface{}{"1", "2"}},
+				},
+			},
+		},
+		"target exists": {
+			config: mapstr.M{
+				"fields": mapstr.M{"message": "app"},
+			},
+			input: beat.Event{
+				Fields: mapstr.M{"message": `a=b`, "app": "exists"},
+			},
+			expected: beat.Event{
+				Fields: mapstr.M{"message": `a=b`, "app": "exists"},
+			},
+			fail: true,
+		},
+		"missing field ignored": {
+			config: mapstr.M{
+				"fields":         mapstr.M{"missing": "app"},
+				"ignore_missing": true,
+			},
+			input: beat.Event{
+				Fields: mapstr.M{"message": `a=b`},
+			},
+			expected: beat.Event{
+				Fields: mapstr.M{"message": `a=b`},
+			},
+		},
+		"invalid logfmt": {
+			config: mapstr.M{
+				"fields": mapstr.M{"message": "app"},
+			},
+			input: beat.Event{
+				Fields: mapstr.M{"message": `msg="unterminated`},
+			},
+			expected: beat.Event{
+				Fields: mapstr.M{"message": `msg="unterminated`},
+			},
+			fail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			processor, err := NewDecodeLogfmtFields(cfg.MustNewConfigFrom(test.config))
+			if err != nil {
+				t.Fatal(err)
+			}
+			result, err := processor.Run(&test.input)
+			if test.fail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected.Fields, result.Fields)
+		})
+	}
+}
@@ -0,0 +1,281 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_user_agent
+
+import (
+	"strconv"
+	"strings"
+)
+
+// clientHints holds the User-Agent Client Hints request headers.
+// See https://wicg.github.io/ua-client-hints/.
+type clientHints struct {
+	brands          []brand // Sec-CH-UA or Sec-CH-UA-Full-Version-List
+	fullVersion     string  // Sec-CH-UA-Full-Version
+	mobile          bool    // Sec-CH-UA-Mobile
+	model           string  // Sec-CH-UA-Model
+	platform        string  // Sec-CH-UA-Platform
+	platformVersion string  // Sec-CH-UA-Platform-Version
+}
+
+type brand struct {
+	name    string
+	version string
+}
+
+// newClientHints returns the client hints found in the headers, whose names
+// are matched case-insensitively and with '_' matching '-'. It returns false
+// when the headers hold no client hints.
+func newClientHints(headers map[string]interface{}) (clientHints, bool) {
+	var (
+		h     clientHints
+		found bool
+	)
+	var brands, fullVersionList string
+	for k, v := range headers {
+		value, ok := headerValue(v)
+		if !ok {
+			continue
+		}
+		switch strings.ReplaceAll(strings.ToLower(k), "_", "-") {
+		case "sec-ch-ua":
+			brands = value
+		case "sec-ch-ua-full-version-list":
+			fullVersionList = value
+		case "sec-ch-ua-full-version":
+			h.fullVersion = parseString(value)
+		case "sec-ch-ua-mobile":
+			h.mobile = strings.TrimSpace(value) == "?1"
+		case "sec-ch-ua-model":
+			h.model = parseString(value)
+		case "sec-ch-ua-platform":
+			h.platform = parseString(value)
+		case "sec-ch-ua-platform-version":
+			h.platformVersion = parseString(value)
+		default:
+			continue
+		}
+		found = true
+	}
+	if fullVersionList != "" {
+		h.brands = parseBrands(fullVersionList)
+	} else {
+		h.brands = parseBrands(brands)
+	}
+	return h, found
+}
+
+// headerValue returns the value of a header held as a string or as a list of
+// strings, as done for the headers holding several values.
+func headerValue(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case []string:
+		return strings.Join(v, ", "), len(v) != 0
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return strings.Join(values, ", "), len(values) != 0
+	}
+	return "", false
+}
+
+// parseBrands parses a brand list like
+//
+//	"Chromium";v="112", "Google Chrome";v="112", "Not:A-Brand";v="99"
+//
+// skipping the invalid entries.
+func parseBrands(s string) []brand {
+	var brands []brand
+	for s != "" {
+		s = strings.TrimLeft(s, " \t")
+		name, rest, ok := cutString(s)
+		if !ok {
+			return brands
+		}
+		b := brand{name: name}
+		// Parameters until the next member.
+		for {
+			rest = strings.TrimLeft(rest, " \t")
+			if !strings.HasPrefix(rest, ";") {
+				break
+			}
+			rest = strings.TrimLeft(rest[1:], " \t")
+			key, value, _ := strings.Cut(rest, "=")
+			key = strings.TrimSpace(key)
+			if v, r, ok := cutString(value); ok {
+				if key == "v" {
+					b.version = v
+				}
+				rest = r
+			} else {
+				// Skip the unquoted values.
+				end := strings.IndexAny(value, ";,")
+				if end < 0 {
+					end = len(value)
+				}
+				rest = value[end:]
+			}
+		}
+		brands = append(brands, b)
+		_, s, ok = strings.Cut(rest, ",")
+		if !ok {
+			break
+		}
+	}
+	return brands
+}
+
+// cutString returns the content of the structured field string starting s,
+// and what follows it.
+func cutString(s string) (value, rest string, ok bool) {
+	if !strings.HasPrefix(s, `"`) {
+		return "", s, false
+	}
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			i++
+			if i == len(s) {
+				return "", s, false
+			}
+			b.WriteByte(s[i])
+		case '"':
+			return b.String(), s[i+1:], true
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", s, false
+}
+
+// parseString returns the content of a structured field string, or the
+// trimmed value if it is not quoted.
+func parseString(s string) string {
+	s = strings.TrimSpace(s)
+	if v, _, ok := cutString(s); ok {
+		return v
+	}
+	return s
+}
+
+// isGrease reports whether the brand is one of the fake brands added by the
+// browsers to prevent the servers from relying on exact brand lists, such
+// as "Not:A-Brand" or "Not_A Brand".
+func isGrease(name string) bool {
+	return strings.Contains(name, "Not") && strings.Contains(name, "Brand")
+}
+
+// brandNames maps the brand names of the client hints to the names of the
+// uap-core rules.
+var brandNames = map[string]string{
+	"Google Chrome":  "Chrome",
+	"Microsoft Edge": "Edge",
+	"Opera":          "Opera",
+	"Yandex":         "Yandex Browser",
+}
+
+// platformNames maps the platform names of the client hints to the names of
+// the uap-core rules.
+var platformNames = map[string]string{
+	"macOS":       "Mac OS X",
+	"Chrome OS":   "Chrome OS",
+	"Chromium OS": "Chrome OS",
+}
+
+// apply overrides the parts of the user agent parsed from the string, frozen
+// by the browsers supporting the client hints, with the hints.
+func (h clientHints) apply(ua *userAgent) {
+	var selected *brand
+	for i, b := range h.brands {
+		if isGrease(b.name) {
+			continue
+		}
+		// Prefer the vendor brand over the engine.
+		if selected == nil || selected.name == "Chromium" {
+			selected = &h.brands[i]
+		}
+	}
+	if selected != nil {
+		name, ok := brandNames[selected.name]
+		if !ok {
+			name = selected.name
+		}
+		if name == "Chrome" && h.mobile {
+			name = "Chrome Mobile"
+		}
+		ua.name = name
+		version := selected.version
+		if h.fullVersion != "" && !strings.Contains(version, ".") {
+			version = h.fullVersion
+		}
+		if version != "" {
+			ua.version = strings.Split(version, ".")
+		}
+	}
+
+	if h.platform != "" {
+		name, ok := platformNames[h.platform]
+		if !ok {
+			name = h.platform
+		}
+		if name != ua.osName {
+			ua.osVersion = nil
+		}
+		ua.osName = name
+		if version := platformVersion(name, h.platformVersion); version != nil {
+			ua.osVersion = version
+		}
+	}
+
+	if h.model != "" {
+		ua.device = h.model
+	}
+}
+
+// platformVersion returns the parts of the platform version. The Windows
+// versions are the ones of the Universal API Contract, mapped to the Windows
+// releases.
+func platformVersion(platform, version string) []string {
+	if version == "" {
+		return nil
+	}
+	parts := strings.Split(version, ".")
+	if platform != "Windows" {
+		return parts
+	}
+	major, err := strconv.Atoi(parts[0])
+	switch {
+	case err != nil:
+		return nil
+	case major >= 13:
+		return []string{"11"}
+	case major > 0:
+		return []string{"10"}
+	default:
+		// Windows 7, 8 and 8.1 report 0.1, 0.2 and 0.3, the version
+		// parsed from the user agent string is more accurate.
+		return nil
+	}
+}
@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_user_agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBrands(t *testing.T) {
+	var testCases = []struct {
+		Header string
+		Want   []brand
+	}{
+		{
+			`"Chromium";v="112", "Google Chrome";v="112", "Not:A-Brand";v="99"`,
+			[]brand{{"Chromium", "112"}, {"Google Chrome", "112"}, {"Not:A-Brand", "99"}},
+		},
+		{
+			`"Not.A/Brand";v="8.0.0.0", "Chromium";v="114.0.5735.91";x=1, "Microsoft Edge";v="114.0.1823.37"`,
+			[]brand{{"Not.A/Brand", "8.0.0.0"}, {"Chromium", "114.0.5735.91"}, {"Microsoft Edge", "114.0.1823.37"}},
+		},
+		{
+			`"Quoted \"Brand\"";v="1", "NoVersion"`,
+			[]brand{{`Quoted "Brand"`, "1"}, {"NoVersion", ""}},
+		},
+		{
+			`"Chromium";v="112", invalid`,
+			[]brand{{"Chromium", "112"}},
+		},
+		{``, nil},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.Want, parseBrands(tc.Header), tc.Header)
+	}
+}
+
+func TestClientHintsApply(t *testing.T) {
+	frozen := func() userAgent {
+		return userAgent{"Chrome Mobile", []string{"112", "0", "0", "0"}, "Android", []string{"10"}, "K"}
+	}
+
+	var testCases = []struct {
+		Name    string
+		Headers map[string]interface{}
+		Want    userAgent
+		Found   bool
+	}{
+		{
+			Name: "full",
+			Headers: map[string]interface{}{
+				"Sec-CH-UA":                   `"Chromium";v="112", "Google Chrome";v="112", "Not:A-Brand";v="99"`,
+				"Sec-CH-UA-Full-Version-List": `"Chromium";v="112.0.5615.136", "Google Chrome";v="112.0.5615.136", "Not:A-Brand";v="99.0.0.0"`,
+				"Sec-CH-UA-Mobile":            "?1",
+				"Sec-CH-UA-Model":             `"Pixel 7"`,
+				"Sec-CH-UA-Platform":          `"Android"`,
+				"Sec-CH-UA-Platform-Version":  `"13.0.0"`,
+			},
+			Want:  userAgent{"Chrome Mobile", []string{"112", "0", "5615", "136"}, "Android", []string{"13", "0", "0"}, "Pixel 7"},
+			Found: true,
+		},
+		{
+			Name: "low entropy",
+			Headers: map[string]interface{}{
+				"sec_ch_ua":          []interface{}{`"Chromium";v="112"`, `"Microsoft Edge";v="112"`, `"Not:A-Brand";v="99"`},
+				"sec_ch_ua_mobile":   "?0",
+				"sec_ch_ua_platform": []string{`"Windows"`},
+			},
+			Want:  userAgent{"Edge", []string{"112"}, "Windows", nil, "K"},
+			Found: true,
+		},
+		{
+			Name: "windows 11",
+			Headers: map[string]interface{}{
+				"sec-ch-ua":                  `"Chromium";v="112", "Not:A-Brand";v="99"`,
+				"sec-ch-ua-full-version":     `"112.0.5615.138"`,
+				"sec-ch-ua-platform":         `"Windows"`,
+				"sec-ch-ua-platform-version": `"15.0.0"`,
+			},
+			Want:  userAgent{"Chromium", []string{"112", "0", "5615", "138"}, "Windows", []string{"11"}, "K"},
+			Found: true,
+		},
+		{
+			Name: "no hints",
+			Headers: map[string]interface{}{
+				"user-agent": "Mozilla/5.0",
+			},
+			Want: frozen(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ua := frozen()
+			hints, found := newClientHints(tc.Headers)
+			assert.Equal(t, tc.Found, found)
+			if found {
+				hints.apply(&ua)
+			}
+			assert.Equal(t, tc.Want, ua)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_user_agent
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	jsprocessor "github.com/elastic/beats/v7/libbeat/processors/script/javascript/module/processor"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+const (
+	procName = "decode_user_agent"
+	logName  = "processor." + procName
+)
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+	jsprocessor.RegisterPlugin("DecodeUserAgent", New)
+}
+
+type processor struct {
+	config
+	parser *parser
+	cache  *lru.Cache // user agent strings to their userAgent, nil if disabled.
+	log    *logp.Logger
+}
+
+// New constructs a new decode_user_agent processor built from ucfg config.
+func New(cfg *conf.C) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, fmt.Errorf("fail to unpack the "+procName+" processor configuration: %w", err)
+	}
+
+	return newDecodeUserAgent(c)
+}
+
+func newDecodeUserAgent(c config) (*processor, error) {
+	cfgwarn.Beta("The " + procName + " processor is beta.")
+
+	log := logp.NewLogger(logName)
+	if c.ID != "" {
+		log = log.With("instance_id", c.ID)
+	}
+
+	var (
+		p   *parser
+		err error
+	)
+	if c.RegexesFile != "" {
+		p, err = newParserFromFile(paths.Resolve(paths.Config, c.RegexesFile))
+	} else {
+		p, err = newParser(defaultRegexes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if p.skipped > 0 {
+		log.Warnf("Skipped %d rules of the regexes file using regular expressions not supported by Go.", p.skipped)
+	}
+
+	proc := &processor{config: c, parser: p, log: log}
+	if c.CacheSize > 0 {
+		proc.cache, err = lru.New(c.CacheSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return proc, nil
+}
+
+func (p *processor) String() string {
+	json, _ := json.Marshal(p.config)
+	return procName + "=" + string(json)
+}
+
+// Run parses the user agent held by the field, refined with the client hints
+// if configured, into the ECS user_agent fields under the target.
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	v, err := event.GetValue(p.Field)
+	if err != nil {
+		if p.IgnoreMissing && errors.Is(err, mapstr.ErrKeyNotFound) || p.IgnoreFailure {
+			return event, nil
+		}
+		return event, fmt.Errorf(procName+" source field [%v] not found: %w", p.Field, err)
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		if p.IgnoreFailure {
+			return event, nil
+		}
+		return event, fmt.Errorf(procName+" source field [%v] is not a string", p.Field)
+	}
+
+	ua := p.parse(s)
+	if p.ClientHintsField != "" {
+		if hints, ok := p.clientHints(event); ok {
+			hints.apply(&ua)
+		}
+	}
+
+	for k, v := range ua.fields() {
+		key := k
+		if p.Target != "" {
+			key = p.Target + "." + k
+		}
+		if !p.OverwriteKeys {
+			if _, err := event.GetValue(key); err == nil {
+				continue
+			}
+		}
+		if _, err := event.PutValue(key, v); err != nil {
+			if p.IgnoreFailure {
+				return event, nil
+			}
+			return event, fmt.Errorf(procName+" failed to write target field [%v]: %w", key, err)
+		}
+	}
+	return event, nil
+}
+
+func (p *processor) parse(s string) userAgent {
+	if p.cache == nil {
+		return p.parser.parse(s)
+	}
+	if v, ok := p.cache.Get(s); ok {
+		return v.(userAgent)
+	}
+	ua := p.parser.parse(s)
+	p.cache.Add(s, ua)
+	return ua
+}
+
+// clientHints returns the client hints of the headers held by the client
+// hints field, or false if there are none.
+func (p *processor) clientHints(event *beat.Event) (clientHints, bool) {
+	v, err := event.GetValue(p.ClientHintsField)
+	if err != nil {
+		return clientHints{}, false
+	}
+	switch headers := v.(type) {
+	case mapstr.M:
+		return newClientHints(headers)
+	case map[string]interface{}:
+		return newClientHints(headers)
+	}
+	return clientHints{}, false
+}
+
+// fields returns the ECS fields of the user agent.
+func (ua userAgent) fields() mapstr.M {
+	fields := mapstr.M{
+		"name":        ua.name,
+		"device.name": ua.device,
+		"os.name":     ua.osName,
+	}
+	if len(ua.version) != 0 {
+		fields["version"] = strings.Join(ua.version, ".")
+	}
+	full := ua.osName
+	if len(ua.osVersion) != 0 {
+		version := strings.Join(ua.osVersion, ".")
+		fields["os.version"] = version
+		full += " " + version
+	}
+	if ua.osName != other {
+		fields["os.full"] = full
+	}
+	return fields
+}
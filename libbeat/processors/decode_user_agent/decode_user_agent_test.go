@@ -0,0 +1,129 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_user_agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestProcessorRun(t *testing.T) {
+	const chromeAndroid = "Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/112.0.0.0 Mobile Safari/537.36"
+
+	c := defaultConfig()
+	c.ClientHintsField = "http.request.headers"
+	p, err := newDecodeUserAgent(c)
+	require.NoError(t, err)
+
+	evt, err := p.Run(&beat.Event{
+		Fields: mapstr.M{
+			"user_agent": mapstr.M{"original": chromeAndroid},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, mapstr.M{
+		"original": chromeAndroid,
+		"name":     "Chrome Mobile",
+		"version":  "112.0.0.0",
+		"os": mapstr.M{
+			"name":    "Android",
+			"version": "10",
+			"full":    "Android 10",
+		},
+		"device": mapstr.M{"name": "K"},
+	}, evt.Fields["user_agent"])
+
+	evt, err = p.Run(&beat.Event{
+		Fields: mapstr.M{
+			"user_agent": mapstr.M{"original": chromeAndroid},
+			"http": mapstr.M{
+				"request": mapstr.M{
+					"headers": mapstr.M{
+						"sec-ch-ua-full-version-list": `"Chromium";v="112.0.5615.136", "Google Chrome";v="112.0.5615.136", "Not:A-Brand";v="99.0.0.0"`,
+						"sec-ch-ua-mobile":            "?1",
+						"sec-ch-ua-model":             `"Pixel 7"`,
+						"sec-ch-ua-platform":          `"Android"`,
+						"sec-ch-ua-platform-version":  `"13.0.0"`,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, mapstr.M{
+		"original": chromeAndroid,
+		"name":     "Chrome Mobile",
+		"version":  "112.0.5615.136",
+		"os": mapstr.M{
+			"name":    "Android",
+			"version": "13.0.0",
+			"full":    "Android 13.0.0",
+		},
+		"device": mapstr.M{"name": "Pixel 7"},
+	}, evt.Fields["user_agent"])
+	// The hints must not alter the cached user agent.
+	ua, ok := p.cache.Get(chromeAndroid)
+	require.True(t, ok)
+	assert.Equal(t, "K", ua.(userAgent).device)
+
+	t.Run("regexes_file", func(t *testing.T) {
+		c := defaultConfig()
+		c.Field = "ua"
+		c.Target = ""
+		c.RegexesFile = "testdata/regexes.yaml"
+		c.CacheSize = 0
+		p, err := newDecodeUserAgent(c)
+		require.NoError(t, err)
+		assert.Nil(t, p.cache)
+
+		evt, err := p.Run(&beat.Event{
+			Fields: mapstr.M{"ua": "MyBrowser/3.2.1 (MyPhone 5)"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, mapstr.M{
+			"ua":      "MyBrowser/3.2.1 (MyPhone 5)",
+			"name":    "My Browser",
+			"version": "3.2.1",
+			"os":      mapstr.M{"name": "Other"},
+			"device":  mapstr.M{"name": "Acme MyPhone 5"},
+		}, evt.Fields)
+
+		c.RegexesFile = "testdata/missing.yaml"
+		_, err = newDecodeUserAgent(c)
+		assert.Error(t, err)
+	})
+
+	t.Run("ignore_missing", func(t *testing.T) {
+		c := defaultConfig()
+		p, err := newDecodeUserAgent(c)
+		require.NoError(t, err)
+		_, err = p.Run(&beat.Event{Fields: mapstr.M{}})
+		assert.Error(t, err)
+
+		c.IgnoreMissing = true
+		p, err = newDecodeUserAgent(c)
+		require.NoError(t, err)
+		_, err = p.Run(&beat.Event{Fields: mapstr.M{}})
+		assert.NoError(t, err)
+	})
+}
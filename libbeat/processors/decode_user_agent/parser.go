@@ -0,0 +1,206 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_user_agent
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// defaultRegexes holds a compact set of rules in the uap-core format covering
+// the most common browsers, operating systems and devices. The complete and
+// updated uap-core regexes.yaml can be loaded with the regexes_file option.
+//
+//go:embed regexes.yaml
+var defaultRegexes []byte
+
+// other is the name reported when no rule matches, as done by uap-core.
+const other = "Other"
+
+// regexesFile is the uap-core regexes.yaml format.
+// See https://github.com/ua-parser/uap-core/blob/master/docs/specification.md.
+type regexesFile struct {
+	UserAgentParsers []struct {
+		Regex             string `yaml:"regex"`
+		RegexFlag         string `yaml:"regex_flag"`
+		FamilyReplacement string `yaml:"family_replacement"`
+		V1Replacement     string `yaml:"v1_replacement"`
+		V2Replacement     string `yaml:"v2_replacement"`
+		V3Replacement     string `yaml:"v3_replacement"`
+	} `yaml:"user_agent_parsers"`
+	OSParsers []struct {
+		Regex           string `yaml:"regex"`
+		RegexFlag       string `yaml:"regex_flag"`
+		OSReplacement   string `yaml:"os_replacement"`
+		OSV1Replacement string `yaml:"os_v1_replacement"`
+		OSV2Replacement string `yaml:"os_v2_replacement"`
+		OSV3Replacement string `yaml:"os_v3_replacement"`
+		OSV4Replacement string `yaml:"os_v4_replacement"`
+	} `yaml:"os_parsers"`
+	DeviceParsers []struct {
+		Regex             string `yaml:"regex"`
+		RegexFlag         string `yaml:"regex_flag"`
+		DeviceReplacement string `yaml:"device_replacement"`
+	} `yaml:"device_parsers"`
+}
+
+// rule is a compiled rule. The replacements are in the order of the groups
+// they default to, starting with the group 1.
+type rule struct {
+	re           *regexp.Regexp
+	replacements []string
+}
+
+// parser parses user agent strings with the rules of a uap-core regexes file.
+type parser struct {
+	userAgents []rule
+	oses       []rule
+	devices    []rule
+	// skipped is the number of rules whose regex is not supported by the
+	// Go regexp package.
+	skipped int
+}
+
+// userAgent holds the parts of a user agent.
+type userAgent struct {
+	name      string
+	version   []string
+	osName    string
+	osVersion []string
+	device    string
+}
+
+func newParserFromFile(path string) (*parser, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read regexes file: %w", err)
+	}
+	return newParser(data)
+}
+
+func newParser(data []byte) (*parser, error) {
+	var f regexesFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse regexes file: %w", err)
+	}
+	if len(f.UserAgentParsers) == 0 && len(f.OSParsers) == 0 && len(f.DeviceParsers) == 0 {
+		return nil, fmt.Errorf("regexes file has no parsers")
+	}
+
+	var p parser
+	for _, r := range f.UserAgentParsers {
+		p.add(&p.userAgents, r.Regex, r.RegexFlag, r.FamilyReplacement, r.V1Replacement, r.V2Replacement, r.V3Replacement)
+	}
+	for _, r := range f.OSParsers {
+		p.add(&p.oses, r.Regex, r.RegexFlag, r.OSReplacement, r.OSV1Replacement, r.OSV2Replacement, r.OSV3Replacement, r.OSV4Replacement)
+	}
+	for _, r := range f.DeviceParsers {
+		p.add(&p.devices, r.Regex, r.RegexFlag, r.DeviceReplacement)
+	}
+	return &p, nil
+}
+
+func (p *parser) add(rules *[]rule, expr, flag string, replacements ...string) {
+	if flag == "i" {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		p.skipped++
+		return
+	}
+	*rules = append(*rules, rule{re: re, replacements: replacements})
+}
+
+// parse returns the parts of the user agent string.
+func (p *parser) parse(s string) userAgent {
+	ua := userAgent{name: other, osName: other, device: other}
+	if v, ok := match(p.userAgents, s, 5); ok && v[0] != "" {
+		ua.name, ua.version = v[0], versionParts(v[1:])
+	}
+	if v, ok := match(p.oses, s, 5); ok && v[0] != "" {
+		ua.osName, ua.osVersion = v[0], versionParts(v[1:])
+	}
+	if v, ok := match(p.devices, s, 1); ok && v[0] != "" {
+		ua.device = v[0]
+	}
+	return ua
+}
+
+// match returns the values of the n first replacements of the first rule
+// matching s. The values without a replacement are the ones of the
+// corresponding groups.
+func match(rules []rule, s string, n int) ([]string, bool) {
+	for _, r := range rules {
+		m := r.re.FindStringSubmatch(s)
+		if m == nil {
+			continue
+		}
+		values := make([]string, n)
+		for i := range values {
+			var repl string
+			if i < len(r.replacements) {
+				repl = r.replacements[i]
+			}
+			switch {
+			case repl != "":
+				values[i] = expand(repl, m)
+			case i+1 < len(m):
+				values[i] = m[i+1]
+			}
+			values[i] = strings.TrimSpace(values[i])
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+// expand replaces the $1 to $9 references of repl with the matched groups.
+func expand(repl string, m []string) string {
+	if !strings.Contains(repl, "$") {
+		return repl
+	}
+	var b strings.Builder
+	for i := 0; i < len(repl); i++ {
+		c := repl[i]
+		if c == '$' && i+1 < len(repl) && '1' <= repl[i+1] && repl[i+1] <= '9' {
+			if g := int(repl[i+1] - '0'); g < len(m) {
+				b.WriteString(m[g])
+			}
+			i++
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// versionParts returns the version parts up to the first missing one.
+func versionParts(parts []string) []string {
+	for i, v := range parts {
+		if v == "" {
+			return parts[:i]
+		}
+	}
+	return parts
+}
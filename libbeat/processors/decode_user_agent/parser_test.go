@@ -0,0 +1,110 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decode_user_agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParserDefaultRegexes(t *testing.T) {
+	p, err := newParser(defaultRegexes)
+	require.NoError(t, err)
+	assert.Zero(t, p.skipped)
+
+	var testCases = []struct {
+		UserAgent string
+		Want      userAgent
+	}{
+		{
+			"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/112.0.5615.138 Safari/537.36",
+			userAgent{"Chrome", []string{"112", "0", "5615", "138"}, "Windows", []string{"10"}, "Other"},
+		},
+		{
+			"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/112.0.0.0 Safari/537.36 Edg/112.0.1722.58",
+			userAgent{"Edge", []string{"112", "0", "1722", "58"}, "Windows", []string{"10"}, "Other"},
+		},
+		{
+			"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/16.4 Safari/605.1.15",
+			userAgent{"Safari", []string{"16", "4"}, "Mac OS X", []string{"10", "15", "7"}, "Mac"},
+		},
+		{
+			"Mozilla/5.0 (iPhone; CPU iPhone OS 16_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/16.4 Mobile/15E148 Safari/604.1",
+			userAgent{"Mobile Safari", []string{"16", "4"}, "iOS", []string{"16", "4"}, "iPhone"},
+		},
+		{
+			"Mozilla/5.0 (Linux; Android 13; SM-S908B) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/112.0.0.0 Mobile Safari/537.36",
+			userAgent{"Chrome Mobile", []string{"112", "0", "0", "0"}, "Android", []string{"13"}, "Samsung SM-S908B"},
+		},
+		{
+			"Mozilla/5.0 (Linux; Android 13; Pixel 7 Build/TQ2A.230405.003) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/112.0.5615.136 Mobile Safari/537.36",
+			userAgent{"Chrome Mobile", []string{"112", "0", "5615", "136"}, "Android", []string{"13"}, "Pixel 7"},
+		},
+		{
+			"Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:109.0) Gecko/20100101 Firefox/112.0",
+			userAgent{"Firefox", []string{"112", "0"}, "Ubuntu", nil, "Other"},
+		},
+		{
+			"Mozilla/5.0 (Windows NT 6.1; Trident/7.0; rv:11.0) like Gecko",
+			userAgent{"IE", []string{"11", "0"}, "Windows", []string{"7"}, "Other"},
+		},
+		{
+			"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			userAgent{"Googlebot", []string{"2", "1"}, "Other", nil, "Spider"},
+		},
+		{
+			"curl/7.88.1",
+			userAgent{"curl", []string{"7", "88", "1"}, "Other", nil, "Other"},
+		},
+		{
+			"",
+			userAgent{"Other", nil, "Other", nil, "Other"},
+		},
+	}
+
+	for _, tc := range testCases {
+		got := p.parse(tc.UserAgent)
+		if len(got.version) == 0 {
+			got.version = nil
+		}
+		if len(got.osVersion) == 0 {
+			got.osVersion = nil
+		}
+		assert.Equal(t, tc.Want, got, tc.UserAgent)
+	}
+}
+
+func TestParserRegexesFile(t *testing.T) {
+	p, err := newParserFromFile("testdata/regexes.yaml")
+	require.NoError(t, err)
+	// The rule using a lookahead is not supported by the Go regexp package.
+	assert.Equal(t, 1, p.skipped)
+
+	ua := p.parse("Mozilla/5.0 (X11; Linux x86_64) MyBrowser/3.2.1 (MyPhone 5)")
+	assert.Equal(t, "My Browser", ua.name)
+	assert.Equal(t, []string{"3", "2", "1"}, ua.version)
+	assert.Equal(t, "Linux", ua.osName)
+	assert.Equal(t, "Acme MyPhone 5", ua.device)
+
+	_, err = newParserFromFile("testdata/missing.yaml")
+	assert.Error(t, err)
+	_, err = newParser([]byte("foo: bar"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import "time"
+
+// config for the dedupe processor.
+type config struct {
+	Fields        []string      `config:"fields" validate:"required"`
+	Window        time.Duration `config:"window" validate:"positive,nonzero"`
+	MaxEntries    int           `config:"max_entries" validate:"positive,nonzero"`
+	IgnoreMissing bool          `config:"ignore_missing"`
+}
+
+func defaultConfig() config {
+	return config{
+		Window:     time.Minute,
+		MaxEntries: 10000,
+	}
+}
@@ -0,0 +1,160 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/jonboulle/clockwork"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	c "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// instanceID is used to assign each instance a unique monitoring namespace.
+var instanceID = atomic.MakeUint32(0)
+
+const processorName = "dedupe"
+const logName = "processor." + processorName
+
+func init() {
+	processors.RegisterPlugin(processorName, new)
+}
+
+type metrics struct {
+	Dropped *monitoring.Int
+	Evicted *monitoring.Int
+}
+
+type dedupe struct {
+	config config
+	fields []string
+	clock  clockwork.Clock
+
+	mu   sync.Mutex
+	seen *simplelru.LRU // fingerprints to the time their last event was forwarded.
+
+	logger  *logp.Logger
+	metrics metrics
+}
+
+// new constructs a new dedupe processor.
+func new(cfg *c.C) (processors.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, errors.Wrap(err, "could not unpack processor configuration")
+	}
+
+	// Logging and metrics (each processor instance has a unique ID).
+	var (
+		id  = int(instanceID.Inc())
+		log = logp.NewLogger(logName).With("instance_id", id)
+		reg = monitoring.Default.NewRegistry(logName+"."+strconv.Itoa(id), monitoring.DoNotReport)
+	)
+
+	p := &dedupe{
+		config: config,
+		// The fields are sorted to get the same fingerprint whatever
+		// their configured order.
+		fields: common.MakeStringSet(config.Fields...).ToSlice(),
+		clock:  clockwork.NewRealClock(),
+		logger: log,
+		metrics: metrics{
+			Dropped: monitoring.NewInt(reg, "dropped"),
+			Evicted: monitoring.NewInt(reg, "evicted"),
+		},
+	}
+	seen, err := simplelru.NewLRU(config.MaxEntries, func(interface{}, interface{}) {
+		p.metrics.Evicted.Inc()
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create the fingerprints cache")
+	}
+	p.seen = seen
+
+	return p, nil
+}
+
+// Run drops the event if an event with the same values of the configured
+// fields was forwarded less than the window ago. Otherwise it returns the
+// event as-is.
+func (p *dedupe) Run(event *beat.Event) (*beat.Event, error) {
+	h := xxhash.New()
+	if err := p.writeFields(h, event); err != nil {
+		// Events which cannot be fingerprinted are never duplicates.
+		p.logger.Debugf("event not deduplicated: %v", err)
+		return event, nil
+	}
+	key := h.Sum64()
+
+	now := p.clock.Now()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if v, ok := p.seen.Get(key); ok && now.Sub(v.(time.Time)) < p.config.Window {
+		p.logger.Debugf("event [%v] dropped by dedupe processor", event)
+		p.metrics.Dropped.Inc()
+		return nil, nil
+	}
+	p.seen.Add(key, now)
+	return event, nil
+}
+
+func (p *dedupe) String() string {
+	return fmt.Sprintf(
+		"%v=[fields=[%v],window=[%v],max_entries=[%v]]",
+		processorName, p.config.Fields, p.config.Window, p.config.MaxEntries,
+	)
+}
+
+func (p *dedupe) writeFields(to io.Writer, event *beat.Event) error {
+	for _, k := range p.fields {
+		v, err := event.GetValue(k)
+		if err != nil {
+			if p.config.IgnoreMissing {
+				continue
+			}
+			return errors.Wrapf(err, "error getting value of field: %v", k)
+		}
+
+		switch vv := v.(type) {
+		case map[string]interface{}, []interface{}, mapstr.M:
+			return fmt.Errorf("cannot compute the fingerprint of the non-scalar field: %v", k)
+		case time.Time:
+			// Ensure we consistently hash times in UTC.
+			v = vv.UTC()
+		}
+
+		fmt.Fprintf(to, "|%v|%v", k, v)
+	}
+
+	io.WriteString(to, "|")
+	return nil
+}
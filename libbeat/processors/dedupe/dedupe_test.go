@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestNew(t *testing.T) {
+	cases := map[string]struct {
+		config mapstr.M
+		err    bool
+	}{
+		"default": {
+			config: mapstr.M{"fields": []string{"message"}},
+		},
+		"missing fields": {
+			config: mapstr.M{},
+			err:    true,
+		},
+		"invalid window": {
+			config: mapstr.M{"fields": []string{"message"}, "window": "-1s"},
+			err:    true,
+		},
+		"invalid max_entries": {
+			config: mapstr.M{"fields": []string{"message"}, "max_entries": 0},
+			err:    true,
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := new(conf.MustNewConfigFrom(test.config))
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	p, err := new(conf.MustNewConfigFrom(mapstr.M{
+		"fields":      []string{"message", "host.name"},
+		"window":      "10s",
+		"max_entries": 2,
+	}))
+	require.NoError(t, err)
+	d := p.(*dedupe)
+	clock := clockwork.NewFakeClock()
+	d.clock = clock
+
+	event := func(message, host string) *beat.Event {
+		return &beat.Event{
+			Timestamp: clock.Now(),
+			Fields: mapstr.M{
+				"message": message,
+				"host":    mapstr.M{"name": host},
+			},
+		}
+	}
+	forwarded := func(e *beat.Event) bool {
+		t.Helper()
+		out, err := d.Run(e)
+		require.NoError(t, err)
+		return out != nil
+	}
+
+	assert.True(t, forwarded(event("link down", "a")))
+	assert.False(t, forwarded(event("link down", "a")))
+	assert.True(t, forwarded(event("link down", "b")), "a different host is not a duplicate")
+
+	clock.Advance(5 * time.Second)
+	assert.False(t, forwarded(event("link down", "a")))
+
+	// The window starts when the event is forwarded.
+	clock.Advance(5 * time.Second)
+	assert.True(t, forwarded(event("link down", "a")))
+	assert.False(t, forwarded(event("link down", "a")))
+
+	// The least recently forwarded fingerprint is evicted once max_entries
+	// is reached, its next event is not a duplicate anymore.
+	assert.True(t, forwarded(event("link up", "a")))
+	assert.Equal(t, int64(1), d.metrics.Evicted.Get())
+	assert.True(t, forwarded(event("link down", "b")))
+	assert.Equal(t, int64(2), d.metrics.Evicted.Get())
+	assert.Equal(t, int64(3), d.metrics.Dropped.Get())
+
+	// Events which cannot be fingerprinted are forwarded.
+	assert.True(t, forwarded(&beat.Event{Fields: mapstr.M{"message": "link down"}}))
+	assert.True(t, forwarded(&beat.Event{Fields: mapstr.M{"message": "link down"}}))
+}
+
+func TestDedupeIgnoreMissing(t *testing.T) {
+	p, err := new(conf.MustNewConfigFrom(mapstr.M{
+		"fields":         []string{"message", "host.name"},
+		"ignore_missing": true,
+	}))
+	require.NoError(t, err)
+
+	out, err := p.Run(&beat.Event{Fields: mapstr.M{"message": "link down"}})
+	require.NoError(t, err)
+	assert.NotNil(t, out)
+	out, err = p.Run(&beat.Event{Fields: mapstr.M{"message": "link down"}})
+	require.NoError(t, err)
+	assert.Nil(t, out)
+}
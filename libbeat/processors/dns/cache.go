@@ -38,6 +38,7 @@ type ptrCache struct {
 	data          map[string]ptrRecord
 	maxSize       int
 	minSuccessTTL time.Duration
+	maxSuccessTTL time.Duration
 }
 
 func (c *ptrCache) set(now time.Time, key string, ptr *PTR) {
@@ -158,6 +159,7 @@ func NewPTRLookupCache(reg *monitoring.Registry, conf CacheConfig, resolver PTRR
 			data:          make(map[string]ptrRecord, conf.SuccessCache.InitialCapacity),
 			maxSize:       conf.SuccessCache.MaxCapacity,
 			minSuccessTTL: conf.SuccessCache.MinTTL,
+			maxSuccessTTL: conf.SuccessCache.MaxTTL,
 		},
 		failure: &failureCache{
 			data:       make(map[string]failureRecord, conf.FailureCache.InitialCapacity),
@@ -195,12 +197,20 @@ func (c PTRLookupCache) LookupPTR(ip string) (*PTR, error) {
 
 	ptr, err = c.resolver.LookupPTR(ip)
 	if err != nil {
-		c.failure.set(now, ip, &cachedError{err})
+		// Skipped lookups are not failures of the DNS server, so they are
+		// retried on the next event.
+		if err != errLookupLimit {
+			c.failure.set(now, ip, &cachedError{err})
+		}
 		return nil, err
 	}
 
 	// We set the ptr.TTL to the minimum TTL in case it is less than that.
 	ptr.TTL = max(ptr.TTL, uint32(c.success.minSuccessTTL/time.Second))
+	// And bound it to the maximum TTL if one is configured.
+	if c.success.maxSuccessTTL > 0 {
+		ptr.TTL = min(ptr.TTL, uint32(c.success.maxSuccessTTL/time.Second))
+	}
 
 	c.success.set(now, ip, ptr)
 	return ptr, nil
@@ -212,3 +222,10 @@ func max(a, b uint32) uint32 {
 	}
 	return b
 }
+
+func min(a, b uint32) uint32 {
+	if a <= b {
+		return a
+	}
+	return b
+}
@@ -126,3 +126,51 @@ func TestCache(t *testing.T) {
 		assert.EqualValues(t, 4, c.stats.Miss.Get())
 	}
 }
+
+func TestCacheMaxTTL(t *testing.T) {
+	conf := defaultConfig.CacheConfig
+	conf.SuccessCache.MinTTL = time.Second
+	conf.SuccessCache.MaxTTL = 30 * time.Second
+	c, err := NewPTRLookupCache(monitoring.NewRegistry(), conf, &stubResolver{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ptr, err := c.LookupPTR(gatewayIP)
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, gatewayName, ptr.Host)
+		assert.EqualValues(t, 30, ptr.TTL)
+	}
+
+	// The maximum TTL can't be lower than the minimum TTL.
+	conf.SuccessCache.MaxTTL = time.Millisecond
+	_, err = NewPTRLookupCache(monitoring.NewRegistry(), conf, &stubResolver{})
+	assert.Error(t, err)
+}
+
+func TestCacheLookupLimitNotCached(t *testing.T) {
+	block := make(chan struct{})
+	limited := NewLimitedResolver(monitoring.NewRegistry(), 1, &blockingResolver{block: block})
+	c, err := NewPTRLookupCache(monitoring.NewRegistry(), defaultConfig.CacheConfig, limited)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = c.LookupPTR(gatewayIP + "0")
+	}()
+	waitForSlots(t, limited, 1)
+
+	_, err = c.LookupPTR(gatewayIP)
+	assert.Equal(t, errLookupLimit, err)
+	close(block)
+	<-done
+
+	// The skipped lookup is retried once a slot is available.
+	ptr, err := c.LookupPTR(gatewayIP)
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, gatewayName, ptr.Host)
+	}
+}
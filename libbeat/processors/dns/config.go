@@ -37,6 +37,7 @@ type Config struct {
 	TagOnFailure []string      `config:"tag_on_failure"`           // Tags to append when a failure occurs.
 	Fields       mapstr.M      `config:"fields"`                   // Mapping of source fields to target fields.
 	Transport    string        `config:"transport"`                // Can be tls or udp.
+	MaxLookups   int           `config:"max_concurrent_lookups"`   // Maximum number of lookups in flight (0 means unlimited).
 	reverseFlat  map[string]string
 }
 
@@ -91,6 +92,10 @@ type CacheSettings struct {
 	// Minimum TTL value for successful DNS responses.
 	MinTTL time.Duration `config:"min_ttl" validate:"min=1ns"`
 
+	// Maximum TTL value for successful DNS responses. Zero means the TTL
+	// from the DNS record is not bounded.
+	MaxTTL time.Duration `config:"max_ttl" validate:"min=0"`
+
 	// Initial capacity. How much space is allocated at initialization.
 	InitialCapacity int `config:"capacity.initial" validate:"min=0"`
 
@@ -129,6 +134,10 @@ func (c *Config) Validate() error {
 		return errors.Errorf("invalid transport method type '%v' specified in "+
 			"config (valid value is: tls or udp)", c.Transport)
 	}
+
+	if c.MaxLookups < 0 {
+		return errors.Errorf("max_concurrent_lookups must be >= 0")
+	}
 	return nil
 }
 
@@ -137,6 +146,9 @@ func (c *CacheConfig) Validate() error {
 	if c.SuccessCache.MinTTL <= 0 {
 		return errors.Errorf("success_cache.min_ttl must be > 0")
 	}
+	if c.SuccessCache.MaxTTL != 0 && c.SuccessCache.MaxTTL < c.SuccessCache.MinTTL {
+		return errors.Errorf("success_cache.max_ttl must be >= success_cache.min_ttl")
+	}
 	if c.FailureCache.TTL <= 0 {
 		return errors.Errorf("failure_cache.ttl must be > 0")
 	}
@@ -66,10 +66,14 @@ func New(cfg *config.C) (processors.Processor, error) {
 	)
 
 	log.Debugf("DNS processor config: %+v", c)
+	var resolver PTRResolver
 	resolver, err := NewMiekgResolver(metrics, c.Timeout, c.Transport, c.Nameservers...)
 	if err != nil {
 		return nil, err
 	}
+	if c.MaxLookups > 0 {
+		resolver = NewLimitedResolver(metrics.NewRegistry("limit"), c.MaxLookups, resolver)
+	}
 
 	cache, err := NewPTRLookupCache(metrics.NewRegistry("cache"), c.CacheConfig, resolver)
 	if err != nil {
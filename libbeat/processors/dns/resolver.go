@@ -116,6 +116,42 @@ func NewMiekgResolver(reg *monitoring.Registry, timeout time.Duration, transport
 	}, nil
 }
 
+// errLookupLimit is returned when a lookup is skipped because the maximum
+// number of concurrent lookups is reached.
+var errLookupLimit = errors.New("dns: maximum number of concurrent lookups reached")
+
+// LimitedResolver is a PTRResolver that bounds the number of lookups in
+// flight. Lookups exceeding the limit fail immediately rather than waiting
+// for a slot, so that a slow DNS server doesn't stall the pipeline.
+type LimitedResolver struct {
+	resolver PTRResolver
+	slots    chan struct{}
+	skipped  *monitoring.Int
+}
+
+// NewLimitedResolver returns a new LimitedResolver allowing up to max
+// concurrent lookups to the given resolver.
+func NewLimitedResolver(reg *monitoring.Registry, max int, resolver PTRResolver) *LimitedResolver {
+	return &LimitedResolver{
+		resolver: resolver,
+		slots:    make(chan struct{}, max),
+		skipped:  monitoring.NewInt(reg, "skipped"),
+	}
+}
+
+// LookupPTR performs a reverse lookup on the given IP address if the
+// maximum number of concurrent lookups is not reached.
+func (res *LimitedResolver) LookupPTR(ip string) (*PTR, error) {
+	select {
+	case res.slots <- struct{}{}:
+		defer func() { <-res.slots }()
+		return res.resolver.LookupPTR(ip)
+	default:
+		res.skipped.Inc()
+		return nil, errLookupLimit
+	}
+}
+
 // dnsError represents a failure response from the DNS server (like NXDOMAIN),
 // but not a communication failure to the server. The response is cacheable.
 type dnsError struct {
@@ -21,7 +21,9 @@ import (
 	"crypto/tls"
 	"net"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
@@ -29,7 +31,10 @@ import (
 	"github.com/elastic/elastic-agent-libs/monitoring"
 )
 
-var _ PTRResolver = (*MiekgResolver)(nil)
+var (
+	_ PTRResolver = (*MiekgResolver)(nil)
+	_ PTRResolver = (*LimitedResolver)(nil)
+)
 
 func TestMiekgResolverLookupPTR(t *testing.T) {
 	stop, addr, err := ServeDNS(FakeDNSHandler)
@@ -218,3 +223,56 @@ waodyGzG03YJbY3l2zSt3lG3jv9Tj+Ic0kRyEzzxk8exyi6nWXue/6a884kgAjiL
 bXmdL6wkIJz1U+XtuQ==
 -----END CERTIFICATE-----`)
 )
+
+// blockingResolver is a stubResolver whose lookups wait until block is closed.
+type blockingResolver struct {
+	stubResolver
+	block chan struct{}
+}
+
+func (r *blockingResolver) LookupPTR(ip string) (*PTR, error) {
+	<-r.block
+	return r.stubResolver.LookupPTR(ip)
+}
+
+func waitForSlots(t *testing.T, res *LimitedResolver, n int) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); len(res.slots) < n; {
+		if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for %d lookups in flight", n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestLimitedResolverLookupPTR(t *testing.T) {
+	block := make(chan struct{})
+	res := NewLimitedResolver(monitoring.NewRegistry(), 2, &blockingResolver{block: block})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ptr, err := res.LookupPTR(gatewayIP)
+			if assert.NoError(t, err) {
+				assert.EqualValues(t, gatewayName, ptr.Host)
+			}
+		}()
+	}
+	waitForSlots(t, res, 2)
+
+	// Limit reached, the lookup fails without waiting.
+	_, err := res.LookupPTR(gatewayIP)
+	assert.Equal(t, errLookupLimit, err)
+	assert.EqualValues(t, 1, res.skipped.Get())
+
+	close(block)
+	wg.Wait()
+
+	ptr, err := res.LookupPTR(gatewayIP)
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, gatewayName, ptr.Host)
+	}
+	assert.EqualValues(t, 1, res.skipped.Get())
+}
@@ -0,0 +1,75 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extract_regex
+
+import (
+	"fmt"
+	"regexp"
+)
+
+type config struct {
+	Field         string            `config:"field" validate:"required"`
+	Patterns      []string          `config:"patterns" validate:"required"`
+	Target        string            `config:"target"`
+	Fields        map[string]string `config:"fields"`
+	Types         map[string]string `config:"types"`
+	OverwriteKeys bool              `config:"overwrite_keys"`
+	IgnoreMissing bool              `config:"ignore_missing"`
+	IgnoreFailure bool              `config:"ignore_failure"`
+	ID            string            `config:"id"`
+}
+
+func defaultConfig() config {
+	return config{
+		Field: "message",
+	}
+}
+
+// Validate checks that the patterns compile and that every capture named in
+// fields and types is defined by at least one of the patterns.
+func (c *config) Validate() error {
+	names := map[string]bool{}
+	for _, p := range c.Patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+		for _, name := range re.SubexpNames() {
+			if name != "" {
+				names[name] = true
+			}
+		}
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("patterns do not define any named capture group")
+	}
+	for name := range c.Fields {
+		if !names[name] {
+			return fmt.Errorf("fields refers to unknown capture group %q", name)
+		}
+	}
+	for name, typ := range c.Types {
+		if !names[name] {
+			return fmt.Errorf("types refers to unknown capture group %q", name)
+		}
+		if _, ok := dataTypeNames[typ]; !ok {
+			return fmt.Errorf("unsupported type %q for capture group %q", typ, name)
+		}
+	}
+	return nil
+}
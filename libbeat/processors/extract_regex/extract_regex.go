@@ -0,0 +1,247 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extract_regex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	jsprocessor "github.com/elastic/beats/v7/libbeat/processors/script/javascript/module/processor"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const (
+	procName = "extract_regex"
+	logName  = "processor." + procName
+
+	flagParsingError = "extract_regex_parsing_error"
+)
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+	jsprocessor.RegisterPlugin("ExtractRegex", New)
+}
+
+type dataType uint8
+
+const (
+	String dataType = iota
+	Integer
+	Long
+	Float
+	Double
+	Boolean
+	IP
+)
+
+var dataTypeNames = map[string]dataType{
+	"string":  String,
+	"integer": Integer,
+	"long":    Long,
+	"float":   Float,
+	"double":  Double,
+	"boolean": Boolean,
+	"ip":      IP,
+}
+
+// capture maps a named capture group of a pattern to its target field.
+type capture struct {
+	index  int
+	name   string
+	target string
+	typ    dataType
+}
+
+type pattern struct {
+	re       *regexp.Regexp
+	captures []capture
+}
+
+type processor struct {
+	config
+	patterns []pattern
+	log      *logp.Logger
+}
+
+// New constructs a new extract_regex processor built from ucfg config.
+func New(cfg *conf.C) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, fmt.Errorf("fail to unpack the "+procName+" processor configuration: %w", err)
+	}
+
+	return newExtractRegex(c)
+}
+
+func newExtractRegex(c config) (*processor, error) {
+	cfgwarn.Beta("The " + procName + " processor is beta.")
+
+	log := logp.NewLogger(logName)
+	if c.ID != "" {
+		log = log.With("instance_id", c.ID)
+	}
+
+	patterns := make([]pattern, 0, len(c.Patterns))
+	for _, p := range c.Patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf(procName+" invalid pattern %q: %w", p, err)
+		}
+		var captures []capture
+		for i, name := range re.SubexpNames() {
+			if name == "" {
+				continue
+			}
+			target, ok := c.Fields[name]
+			if !ok {
+				target = name
+				if c.Target != "" {
+					target = c.Target + "." + name
+				}
+			}
+			captures = append(captures, capture{
+				index:  i,
+				name:   name,
+				target: target,
+				typ:    dataTypeNames[c.Types[name]],
+			})
+		}
+		patterns = append(patterns, pattern{re: re, captures: captures})
+	}
+
+	return &processor{config: c, patterns: patterns, log: log}, nil
+}
+
+func (p *processor) String() string {
+	json, _ := json.Marshal(p.config)
+	return procName + "=" + string(json)
+}
+
+// Run matches the source field against the patterns in order and writes the
+// named captures of the first matching pattern to their target fields.
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	v, err := event.GetValue(p.Field)
+	if err != nil {
+		if p.IgnoreMissing && errors.Is(err, mapstr.ErrKeyNotFound) || p.IgnoreFailure {
+			return event, nil
+		}
+		return event, fmt.Errorf(procName+" source field [%v] not found: %w", p.Field, err)
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return p.fail(event, fmt.Errorf(procName+" source field [%v] is not a string", p.Field))
+	}
+
+	fields, err := p.extract(s)
+	if err != nil {
+		return p.fail(event, fmt.Errorf(procName+" failed to parse field [%v]: %w", p.Field, err))
+	}
+
+	backup := event.Clone()
+	for key, v := range fields {
+		if !p.OverwriteKeys {
+			if _, err := event.GetValue(key); err == nil {
+				return p.fail(backup, fmt.Errorf(procName+" target field [%v] already exists", key))
+			}
+		}
+		if _, err := event.PutValue(key, v); err != nil {
+			return p.fail(backup, fmt.Errorf(procName+" failed to write target field [%v]: %w", key, err))
+		}
+	}
+	return event, nil
+}
+
+// extract returns the converted captures of the first pattern matching s,
+// keyed by their target field. Optional groups which did not participate in
+// the match are left out.
+func (p *processor) extract(s string) (map[string]interface{}, error) {
+	for _, pat := range p.patterns {
+		m := pat.re.FindStringSubmatchIndex(s)
+		if m == nil {
+			continue
+		}
+		fields := make(map[string]interface{}, len(pat.captures))
+		for _, c := range pat.captures {
+			start, end := m[2*c.index], m[2*c.index+1]
+			if start < 0 {
+				continue
+			}
+			v, err := transformType(c.typ, s[start:end])
+			if err != nil {
+				return nil, fmt.Errorf("capture [%v] cannot be converted: %w", c.name, err)
+			}
+			fields[c.target] = v
+		}
+		return fields, nil
+	}
+	return nil, errors.New("no pattern matched")
+}
+
+func (p *processor) fail(event *beat.Event, err error) (*beat.Event, error) {
+	if p.IgnoreFailure {
+		return event, nil
+	}
+	if err := mapstr.AddTagsWithKey(event.Fields, beat.FlagField, []string{flagParsingError}); err != nil {
+		p.log.Debugw("Failed to flag the event.", "error", err)
+	}
+	return event, err
+}
+
+func strToInt(s string, bitSize int) (int64, error) {
+	base := 10
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		// strconv.ParseInt will accept the '0x' or '0X` prefix only when base is 0.
+		base = 0
+	}
+	return strconv.ParseInt(s, base, bitSize)
+}
+
+func transformType(typ dataType, value string) (interface{}, error) {
+	switch typ {
+	case Integer:
+		i, err := strToInt(value, 32)
+		return int32(i), err
+	case Long:
+		return strToInt(value, 64)
+	case Float:
+		f, err := strconv.ParseFloat(value, 32)
+		return float32(f), err
+	case Double:
+		return strconv.ParseFloat(value, 64)
+	case Boolean:
+		return strconv.ParseBool(value)
+	case IP:
+		if net.ParseIP(value) != nil {
+			return value, nil
+		}
+		return nil, errors.New("value is not a valid IP address")
+	default:
+		return value, nil
+	}
+}
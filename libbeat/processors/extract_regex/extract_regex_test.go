@@ -0,0 +1,217 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extract_regex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestExtractRegex(t *testing.T) {
+	var testCases = []struct {
+		name    string
+		config  mapstr.M
+		input   mapstr.M
+		want    mapstr.M
+		wantErr bool
+	}{
+		{
+			name: "first matching pattern wins",
+			config: mapstr.M{
+				"patterns": []string{
+					`^(?P<method>[A-Z]+) (?P<path>\S+) (?P<status>\d+)$`,
+					`^(?P<method>[A-Z]+) (?P<path>\S+)$`,
+				},
+				"target": "http",
+				"types":  mapstr.M{"status": "long"},
+			},
+			input: mapstr.M{"message": "GET /index.html 200"},
+			want: mapstr.M{
+				"message": "GET /index.html 200",
+				"http": mapstr.M{
+					"method": "GET",
+					"path":   "/index.html",
+					"status": int64(200),
+				},
+			},
+		},
+		{
+			name: "fallback pattern",
+			config: mapstr.M{
+				"patterns": []string{
+					`^(?P<method>[A-Z]+) (?P<path>\S+) (?P<status>\d+)$`,
+					`^(?P<method>[A-Z]+) (?P<path>\S+)$`,
+				},
+			},
+			input: mapstr.M{"message": "GET /"},
+			want: mapstr.M{
+				"message": "GET /",
+				"method":  "GET",
+				"path":    "/",
+			},
+		},
+		{
+			name: "fields and conversions",
+			config: mapstr.M{
+				"field":    "log",
+				"patterns": []string{`from (?P<ip>\S+) port (?P<port>\S+)(?: (?P<secure>true|false))?`},
+				"fields":   mapstr.M{"ip": "source.ip", "port": "source.port"},
+				"types":    mapstr.M{"ip": "ip", "port": "integer", "secure": "boolean"},
+			},
+			input: mapstr.M{"log": "connection from 10.0.0.1 port 0x50"},
+			want: mapstr.M{
+				"log": "connection from 10.0.0.1 port 0x50",
+				"source": mapstr.M{
+					"ip":   "10.0.0.1",
+					"port": int32(80),
+				},
+			},
+		},
+		{
+			name: "no match",
+			config: mapstr.M{
+				"patterns": []string{`^(?P<word>\w+)$`},
+			},
+			input: mapstr.M{"message": "two words"},
+			want: mapstr.M{
+				"message": "two words",
+				"log":     mapstr.M{"flags": []string{flagParsingError}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "conversion failure",
+			config: mapstr.M{
+				"patterns": []string{`^(?P<a>\w+) (?P<b>\w+)$`},
+				"types":    mapstr.M{"b": "ip"},
+			},
+			input: mapstr.M{"message": "two words"},
+			want: mapstr.M{
+				"message": "two words",
+				"log":     mapstr.M{"flags": []string{flagParsingError}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ignore failure",
+			config: mapstr.M{
+				"patterns":       []string{`^(?P<word>\w+)$`},
+				"ignore_failure": true,
+			},
+			input: mapstr.M{"message": "two words"},
+			want:  mapstr.M{"message": "two words"},
+		},
+		{
+			name: "missing field",
+			config: mapstr.M{
+				"patterns":       []string{`^(?P<word>\w+)$`},
+				"ignore_missing": true,
+			},
+			input: mapstr.M{"other": "value"},
+			want:  mapstr.M{"other": "value"},
+		},
+		{
+			name: "existing key is kept",
+			config: mapstr.M{
+				"patterns": []string{`^(?P<a>\w+) (?P<b>\w+)$`},
+			},
+			input: mapstr.M{"message": "two words", "b": "old"},
+			want: mapstr.M{
+				"message": "two words",
+				"b":       "old",
+				"log":     mapstr.M{"flags": []string{flagParsingError}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing key is overwritten",
+			config: mapstr.M{
+				"patterns":       []string{`^(?P<a>\w+) (?P<b>\w+)$`},
+				"overwrite_keys": true,
+			},
+			input: mapstr.M{"message": "two words", "b": "old"},
+			want: mapstr.M{
+				"message": "two words",
+				"a":       "two",
+				"b":       "words",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := New(conf.MustNewConfigFrom(tc.config))
+			require.NoError(t, err)
+
+			event, err := p.Run(&beat.Event{Fields: tc.input})
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, event.Fields)
+		})
+	}
+}
+
+func TestConfigValidation(t *testing.T) {
+	var testCases = []struct {
+		name   string
+		config mapstr.M
+	}{
+		{
+			name:   "missing patterns",
+			config: mapstr.M{},
+		},
+		{
+			name:   "invalid pattern",
+			config: mapstr.M{"patterns": []string{`(?P<a>`}},
+		},
+		{
+			name:   "no named capture",
+			config: mapstr.M{"patterns": []string{`^(\w+)$`}},
+		},
+		{
+			name: "unknown capture in fields",
+			config: mapstr.M{
+				"patterns": []string{`^(?P<a>\w+)$`},
+				"fields":   mapstr.M{"b": "target"},
+			},
+		},
+		{
+			name: "unsupported type",
+			config: mapstr.M{
+				"patterns": []string{`^(?P<a>\w+)$`},
+				"types":    mapstr.M{"a": "date"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := New(conf.MustNewConfigFrom(tc.config))
+			assert.Error(t, err)
+		})
+	}
+}
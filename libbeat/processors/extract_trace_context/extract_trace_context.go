@@ -0,0 +1,123 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extract_trace_context
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/tracecontext"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/processors/checks"
+	jsprocessor "github.com/elastic/beats/v7/libbeat/processors/script/javascript/module/processor"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+type extractTraceContext struct {
+	traceContextConfig
+}
+
+type traceContextConfig struct {
+	Field         string `config:"field" validate:"required"`
+	Search        bool   `config:"search"`
+	IgnoreMissing bool   `config:"ignore_missing"`
+	OverwriteKeys bool   `config:"overwrite_keys"`
+	FailOnError   bool   `config:"fail_on_error"`
+}
+
+var defaultTraceContextConfig = traceContextConfig{
+	Field:         "traceparent",
+	IgnoreMissing: true,
+}
+
+func init() {
+	processors.RegisterPlugin("extract_trace_context",
+		checks.ConfigChecked(New,
+			checks.AllowedFields("field", "search", "ignore_missing", "overwrite_keys", "fail_on_error", "when")))
+	jsprocessor.RegisterPlugin("ExtractTraceContext", New)
+}
+
+// New constructs a new extract_trace_context processor.
+func New(c *config.C) (processors.Processor, error) {
+	config := defaultTraceContextConfig
+	if err := c.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("failed to unpack the extract_trace_context configuration: %w", err)
+	}
+	return &extractTraceContext{traceContextConfig: config}, nil
+}
+
+// Run sets the trace.id and span.id fields from the W3C traceparent value
+// found in the configured field.
+func (p *extractTraceContext) Run(event *beat.Event) (*beat.Event, error) {
+	if err := p.extract(event); err != nil && p.FailOnError {
+		return event, err
+	}
+	return event, nil
+}
+
+func (p *extractTraceContext) extract(event *beat.Event) error {
+	value, err := event.GetValue(p.Field)
+	if err != nil {
+		if p.IgnoreMissing && errors.Is(err, mapstr.ErrKeyNotFound) {
+			return nil
+		}
+		return fmt.Errorf("could not fetch value for field %s: %w", p.Field, err)
+	}
+
+	// headers captured by inputs are lists of values
+	if values, ok := value.([]string); ok && len(values) > 0 {
+		value = values[0]
+	}
+	if values, ok := value.([]interface{}); ok && len(values) > 0 {
+		value = values[0]
+	}
+	text, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("field %s is not of string type", p.Field)
+	}
+
+	var tc tracecontext.TraceContext
+	if p.Search {
+		var found bool
+		if tc, found = tracecontext.Find(text); !found {
+			return nil
+		}
+	} else if tc, err = tracecontext.Parse(text); err != nil {
+		return fmt.Errorf("failed to parse trace context from field %s: %w", p.Field, err)
+	}
+
+	if !p.OverwriteKeys {
+		if _, err := event.GetValue("trace.id"); err == nil {
+			return nil
+		}
+	}
+	for key, value := range tc.Fields().Flatten() {
+		if _, err := event.PutValue(key, value); err != nil {
+			return fmt.Errorf("failed setting field %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func (p *extractTraceContext) String() string {
+	json, _ := json.Marshal(p.traceContextConfig)
+	return "extract_trace_context=" + string(json)
+}
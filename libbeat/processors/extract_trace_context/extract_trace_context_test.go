@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package extract_trace_context
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestExtractTraceContext(t *testing.T) {
+	traceFields := mapstr.M{
+		"trace": mapstr.M{"id": "4bf92f3577b34da6a3ce929d0e0e4736"},
+		"span":  mapstr.M{"id": "00f067aa0ba902b7"},
+	}
+
+	tests := map[string]struct {
+		config   mapstr.M
+		input    mapstr.M
+		expected mapstr.M
+		err      bool
+	}{
+		"header value": {
+			config: mapstr.M{"field": "headers.Traceparent"},
+			input:  mapstr.M{"headers": mapstr.M{"Traceparent": []string{traceparent}}},
+			expected: mapstr.M{
+				"headers": mapstr.M{"Traceparent": []string{traceparent}},
+				"trace":   traceFields["trace"],
+				"span":    traceFields["span"],
+			},
+		},
+		"search in message": {
+			config: mapstr.M{"field": "message", "search": true},
+			input:  mapstr.M{"message": "GET /api traceparent=" + traceparent + " 200"},
+			expected: mapstr.M{
+				"message": "GET /api traceparent=" + traceparent + " 200",
+				"trace":   traceFields["trace"],
+				"span":    traceFields["span"],
+			},
+		},
+		"missing field": {
+			config:   mapstr.M{},
+			input:    mapstr.M{"message": "hello"},
+			expected: mapstr.M{"message": "hello"},
+		},
+		"existing trace kept": {
+			config:   mapstr.M{},
+			input:    mapstr.M{"traceparent": traceparent, "trace": mapstr.M{"id": "abc"}},
+			expected: mapstr.M{"traceparent": traceparent, "trace": mapstr.M{"id": "abc"}},
+		},
+		"existing trace overwritten": {
+			config: mapstr.M{"overwrite_keys": true},
+			input:  mapstr.M{"traceparent": traceparent, "trace": mapstr.M{"id": "abc"}},
+			expected: mapstr.M{
+				"traceparent": traceparent,
+				"trace":       traceFields["trace"],
+				"span":        traceFields["span"],
+			},
+		},
+		"invalid value": {
+			config:   mapstr.M{"fail_on_error": true},
+			input:    mapstr.M{"traceparent": "00-invalid"},
+			expected: mapstr.M{"traceparent": "00-invalid"},
+			err:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := New(config.MustNewConfigFrom(test.config))
+			require.NoError(t, err)
+
+			event, err := p.Run(&beat.Event{Fields: test.input})
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, event.Fields)
+		})
+	}
+}
@@ -0,0 +1,328 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package geoip
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oschwald/maxminddb-golang"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+const (
+	procName = "geoip"
+	logName  = "processor." + procName
+)
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+}
+
+// database is a MaxMind DB file, reloaded when it changes on disk.
+type database struct {
+	path    string
+	reader  *maxminddb.Reader
+	modTime time.Time
+	size    int64
+}
+
+func loadDatabase(path string) (*database, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	// The file is read in memory rather than mapped, it can be rewritten
+	// in place while it is in use.
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := maxminddb.FromBytes(buf)
+	if err != nil {
+		return nil, fmt.Errorf("could not load %s: %w", path, err)
+	}
+	return &database{path: path, reader: r, modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+// changed reports whether the file of the database was modified since it
+// was loaded.
+func (d *database) changed() bool {
+	info, err := os.Stat(d.path)
+	if err != nil {
+		return false
+	}
+	return !info.ModTime().Equal(d.modTime) || info.Size() != d.size
+}
+
+type processor struct {
+	config
+	target string
+	log    *logp.Logger
+
+	mu         sync.RWMutex
+	databases  []*database
+	lastReload time.Time
+}
+
+// New constructs a new geoip processor built from ucfg config.
+func New(cfg *conf.C) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, fmt.Errorf("fail to unpack the "+procName+" processor configuration: %w", err)
+	}
+
+	return newGeoIP(c)
+}
+
+func newGeoIP(c config) (*processor, error) {
+	cfgwarn.Beta("The " + procName + " processor is beta.")
+
+	log := logp.NewLogger(logName)
+	if c.ID != "" {
+		log = log.With("instance_id", c.ID)
+	}
+
+	// The geo and as fields are written next to the IP field by default,
+	// source.geo and source.as for source.ip.
+	var target string
+	if c.Target != nil {
+		target = *c.Target
+	} else if i := strings.LastIndexByte(c.Field, '.'); i != -1 {
+		target = c.Field[:i]
+	}
+
+	p := &processor{config: c, target: target, log: log, lastReload: time.Now()}
+	for _, f := range c.DatabaseFiles {
+		db, err := loadDatabase(paths.Resolve(paths.Config, f))
+		if err != nil {
+			return nil, fmt.Errorf(procName+" failed to load database: %w", err)
+		}
+		log.Debugf("Loaded %s database from %s", db.reader.Metadata.DatabaseType, db.path)
+		p.databases = append(p.databases, db)
+	}
+
+	return p, nil
+}
+
+func (p *processor) String() string {
+	json, _ := json.Marshal(p.config)
+	return procName + "=" + string(json)
+}
+
+// Run looks up the IP address held by the field in the databases and adds
+// the geo and as fields of the records found under the target.
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	v, err := event.GetValue(p.Field)
+	if err != nil {
+		if p.IgnoreMissing && errors.Is(err, mapstr.ErrKeyNotFound) || p.IgnoreFailure {
+			return event, nil
+		}
+		return event, fmt.Errorf(procName+" source field [%v] not found: %w", p.Field, err)
+	}
+
+	var ip net.IP
+	switch v := v.(type) {
+	case string:
+		ip = net.ParseIP(strings.TrimSpace(v))
+	case net.IP:
+		ip = v
+	}
+	if ip == nil {
+		if p.IgnoreFailure {
+			return event, nil
+		}
+		return event, fmt.Errorf(procName+" source field [%v] is not an IP address: %v", p.Field, v)
+	}
+
+	fields, err := p.lookup(ip)
+	if err != nil {
+		if p.IgnoreFailure {
+			return event, nil
+		}
+		return event, fmt.Errorf(procName+" failed to look up [%v]: %w", ip, err)
+	}
+
+	for k, v := range fields.Flatten() {
+		key := k
+		if p.target != "" {
+			key = p.target + "." + k
+		}
+		if !p.OverwriteKeys {
+			if _, err := event.GetValue(key); err == nil {
+				continue
+			}
+		}
+		if _, err := event.PutValue(key, v); err != nil {
+			if p.IgnoreFailure {
+				return event, nil
+			}
+			return event, fmt.Errorf(procName+" failed to write target field [%v]: %w", key, err)
+		}
+	}
+	return event, nil
+}
+
+// lookup returns the geo and as fields of the records of ip in all the
+// databases.
+func (p *processor) lookup(ip net.IP) (mapstr.M, error) {
+	p.reloadIfChanged()
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	fields := mapstr.M{}
+	for _, db := range p.databases {
+		var record map[string]interface{}
+		if err := db.reader.Lookup(ip, &record); err != nil {
+			return nil, err
+		}
+		if record == nil {
+			continue
+		}
+		if geo := geoFields(record, p.Language); len(geo) > 0 {
+			fields["geo"] = geo
+		}
+		if as := asFields(record); len(as) > 0 {
+			fields["as"] = as
+		}
+	}
+	return fields, nil
+}
+
+// reloadIfChanged reloads the databases whose file changed, at most every
+// reload interval. A database failing to load is kept in its previous
+// version, for instance when the file is still being written.
+func (p *processor) reloadIfChanged() {
+	if p.ReloadInterval <= 0 {
+		return
+	}
+
+	p.mu.RLock()
+	due := time.Since(p.lastReload) >= p.ReloadInterval
+	p.mu.RUnlock()
+	if !due {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if time.Since(p.lastReload) < p.ReloadInterval {
+		// Another goroutine reloaded in the meantime.
+		return
+	}
+	p.lastReload = time.Now()
+
+	for i, db := range p.databases {
+		if !db.changed() {
+			continue
+		}
+		updated, err := loadDatabase(db.path)
+		if err != nil {
+			p.log.Warnf("Failed to reload the database %s, keeping the previous version: %v", db.path, err)
+			continue
+		}
+		p.log.Infof("Reloaded the %s database from %s", updated.reader.Metadata.DatabaseType, db.path)
+		p.databases[i] = updated
+	}
+}
+
+// geoFields maps the record of a City or Country database to the ECS geo
+// fields.
+func geoFields(record map[string]interface{}, language string) mapstr.M {
+	geo := mapstr.M{}
+	put := func(key, value string) {
+		if value != "" {
+			geo[key] = value
+		}
+	}
+
+	continent, _ := record["continent"].(map[string]interface{})
+	put("continent_code", stringValue(continent, "code"))
+	put("continent_name", name(continent, language))
+
+	country, _ := record["country"].(map[string]interface{})
+	countryCode := stringValue(country, "iso_code")
+	put("country_iso_code", countryCode)
+	put("country_name", name(country, language))
+
+	if subdivisions, ok := record["subdivisions"].([]interface{}); ok && len(subdivisions) > 0 {
+		region, _ := subdivisions[0].(map[string]interface{})
+		if code := stringValue(region, "iso_code"); code != "" && countryCode != "" {
+			geo["region_iso_code"] = countryCode + "-" + code
+		}
+		put("region_name", name(region, language))
+	}
+
+	city, _ := record["city"].(map[string]interface{})
+	put("city_name", name(city, language))
+
+	postal, _ := record["postal"].(map[string]interface{})
+	put("postal_code", stringValue(postal, "code"))
+
+	if location, ok := record["location"].(map[string]interface{}); ok {
+		lat, hasLat := location["latitude"].(float64)
+		lon, hasLon := location["longitude"].(float64)
+		if hasLat && hasLon {
+			geo["location"] = mapstr.M{"lat": lat, "lon": lon}
+		}
+		put("timezone", stringValue(location, "time_zone"))
+	}
+
+	return geo
+}
+
+// asFields maps the record of an ASN or ISP database to the ECS as fields.
+func asFields(record map[string]interface{}) mapstr.M {
+	as := mapstr.M{}
+	if number, ok := record["autonomous_system_number"].(uint64); ok {
+		as["number"] = number
+	}
+	if org := stringValue(record, "autonomous_system_organization"); org != "" {
+		as["organization"] = mapstr.M{"name": org}
+	}
+	return as
+}
+
+func stringValue(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+// name returns the name of a place in the language, falling back to
+// English.
+func name(m map[string]interface{}, language string) string {
+	names, _ := m["names"].(map[string]interface{})
+	if s := stringValue(names, language); s != "" {
+		return s
+	}
+	return stringValue(names, "en")
+}
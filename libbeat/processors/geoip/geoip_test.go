@@ -0,0 +1,216 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package geoip
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func testDatabase(t *testing.T, name string) string {
+	t.Helper()
+	path, err := filepath.Abs(filepath.Join("..", "..", "..", "testing", "environments", "GeoLite2-"+name+".mmdb"))
+	require.NoError(t, err)
+	return path
+}
+
+func TestGeoIP(t *testing.T) {
+	city, asn := testDatabase(t, "City"), testDatabase(t, "ASN")
+
+	cases := map[string]struct {
+		config map[string]interface{}
+		input  mapstr.M
+		output mapstr.M
+		error  bool
+	}{
+		"city and asn": {
+			config: map[string]interface{}{
+				"field":          "source.ip",
+				"database_files": []string{city, asn},
+			},
+			input: mapstr.M{"source": mapstr.M{"ip": "89.160.20.128"}},
+			output: mapstr.M{"source": mapstr.M{
+				"ip": "89.160.20.128",
+				"geo": mapstr.M{
+					"continent_code":   "EU",
+					"continent_name":   "Europe",
+					"country_iso_code": "SE",
+					"country_name":     "Sweden",
+					"region_iso_code":  "SE-E",
+					"region_name":      "Östergötland County",
+					"city_name":        "Linköping",
+					"location":         mapstr.M{"lat": 58.4167, "lon": 15.6167},
+					"timezone":         "Europe/Stockholm",
+				},
+				"as": mapstr.M{
+					"number":       uint64(29518),
+					"organization": mapstr.M{"name": "Bredband2 AB"},
+				},
+			}},
+		},
+		"language and target": {
+			config: map[string]interface{}{
+				"field":          "ip",
+				"target":         "client",
+				"database_files": []string{city},
+				"language":       "fr",
+			},
+			input: mapstr.M{"ip": "81.2.69.142"},
+			output: mapstr.M{
+				"ip": "81.2.69.142",
+				"client": mapstr.M{"geo": mapstr.M{
+					"continent_code":   "EU",
+					"continent_name":   "Europe",
+					"country_iso_code": "GB",
+					"country_name":     "Royaume-Uni",
+					"region_iso_code":  "GB-ENG",
+					"region_name":      "Angleterre",
+					"city_name":        "Londres",
+					"location":         mapstr.M{"lat": 51.5142, "lon": -0.0931},
+					"timezone":         "Europe/London",
+				}},
+			},
+		},
+		"not found": {
+			config: map[string]interface{}{
+				"field":          "source.ip",
+				"database_files": []string{city, asn},
+			},
+			input:  mapstr.M{"source": mapstr.M{"ip": "10.0.0.1"}},
+			output: mapstr.M{"source": mapstr.M{"ip": "10.0.0.1"}},
+		},
+		"missing field": {
+			config: map[string]interface{}{
+				"field":          "source.ip",
+				"database_files": []string{asn},
+			},
+			input:  mapstr.M{},
+			output: mapstr.M{},
+			error:  true,
+		},
+		"ignore missing": {
+			config: map[string]interface{}{
+				"field":          "source.ip",
+				"database_files": []string{asn},
+				"ignore_missing": true,
+			},
+			input:  mapstr.M{},
+			output: mapstr.M{},
+		},
+		"invalid ip": {
+			config: map[string]interface{}{
+				"field":          "source.ip",
+				"database_files": []string{asn},
+			},
+			input:  mapstr.M{"source": mapstr.M{"ip": "example.com"}},
+			output: mapstr.M{"source": mapstr.M{"ip": "example.com"}},
+			error:  true,
+		},
+		"keep existing keys": {
+			config: map[string]interface{}{
+				"field":          "source.ip",
+				"database_files": []string{asn},
+				"overwrite_keys": false,
+			},
+			input: mapstr.M{"source": mapstr.M{"ip": "89.160.20.128", "as": mapstr.M{"number": 1}}},
+			output: mapstr.M{"source": mapstr.M{
+				"ip": "89.160.20.128",
+				"as": mapstr.M{
+					"number":       1,
+					"organization": mapstr.M{"name": "Bredband2 AB"},
+				},
+			}},
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			p, err := New(conf.MustNewConfigFrom(c.config))
+			require.NoError(t, err)
+
+			event, err := p.Run(&beat.Event{Fields: c.input})
+			if c.error {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, c.output, event.Fields)
+		})
+	}
+}
+
+func TestGeoIPConfig(t *testing.T) {
+	_, err := New(conf.MustNewConfigFrom(map[string]interface{}{"field": "source.ip"}))
+	assert.Error(t, err, "database_files is required")
+
+	_, err = New(conf.MustNewConfigFrom(map[string]interface{}{
+		"field":          "source.ip",
+		"database_files": []string{"missing.mmdb"},
+	}))
+	assert.Error(t, err)
+}
+
+func TestGeoIPReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "geoip.mmdb")
+	copyFile := func(src string) {
+		buf, err := os.ReadFile(src)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, buf, 0o600))
+	}
+	copyFile(testDatabase(t, "Country"))
+
+	p, err := newGeoIP(config{
+		Field:          "source.ip",
+		DatabaseFiles:  []string{path},
+		ReloadInterval: time.Nanosecond,
+		Language:       "en",
+		OverwriteKeys:  true,
+	})
+	require.NoError(t, err)
+
+	event, err := p.Run(&beat.Event{Fields: mapstr.M{"source": mapstr.M{"ip": "81.2.69.142"}}})
+	require.NoError(t, err)
+	_, err = event.GetValue("source.geo.city_name")
+	assert.Error(t, err, "the country database has no city")
+
+	copyFile(testDatabase(t, "City"))
+
+	event, err = p.Run(&beat.Event{Fields: mapstr.M{"source": mapstr.M{"ip": "81.2.69.142"}}})
+	require.NoError(t, err)
+	cityName, err := event.GetValue("source.geo.city_name")
+	require.NoError(t, err)
+	assert.Equal(t, "London", cityName)
+
+	// A corrupted update keeps the previous version.
+	require.NoError(t, os.WriteFile(path, []byte("truncated"), 0o600))
+	event, err = p.Run(&beat.Event{Fields: mapstr.M{"source": mapstr.M{"ip": "81.2.69.142"}}})
+	require.NoError(t, err)
+	cityName, err = event.GetValue("source.geo.city_name")
+	require.NoError(t, err)
+	assert.Equal(t, "London", cityName)
+}
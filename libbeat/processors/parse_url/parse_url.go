@@ -0,0 +1,273 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parse_url
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	jsprocessor "github.com/elastic/beats/v7/libbeat/processors/script/javascript/module/processor"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const (
+	procName = "parse_url"
+	logName  = "processor." + procName
+)
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+	jsprocessor.RegisterPlugin("ParseURL", New)
+}
+
+type processor struct {
+	config
+	log *logp.Logger
+}
+
+// New constructs a new parse_url processor built from ucfg config.
+func New(cfg *conf.C) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, fmt.Errorf("fail to unpack the "+procName+" processor configuration: %w", err)
+	}
+
+	return newParseURL(c)
+}
+
+func newParseURL(c config) (*processor, error) {
+	cfgwarn.Beta("The " + procName + " processor is beta.")
+
+	log := logp.NewLogger(logName)
+	if c.ID != "" {
+		log = log.With("instance_id", c.ID)
+	}
+
+	return &processor{config: c, log: log}, nil
+}
+
+func (p *processor) String() string {
+	json, _ := json.Marshal(p.config)
+	return procName + "=" + string(json)
+}
+
+// Run decomposes the URL held by the field into the ECS url fields under the
+// target.
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	v, err := event.GetValue(p.Field)
+	if err != nil {
+		if p.IgnoreMissing && errors.Is(err, mapstr.ErrKeyNotFound) || p.IgnoreFailure {
+			return event, nil
+		}
+		return event, fmt.Errorf(procName+" source field [%v] not found: %w", p.Field, err)
+	}
+
+	raw, ok := v.(string)
+	if !ok {
+		if p.IgnoreFailure {
+			return event, nil
+		}
+		return event, fmt.Errorf(procName+" source field [%v] is not a string", p.Field)
+	}
+
+	fields, err := parseURL(raw)
+	if err != nil {
+		if p.IgnoreFailure {
+			return event, nil
+		}
+		return event, fmt.Errorf(procName+" failed to parse field [%v]: %w", p.Field, err)
+	}
+	if p.KeepOriginal {
+		fields["original"] = raw
+	}
+
+	for k, v := range fields {
+		key := k
+		if p.Target != "" {
+			key = p.Target + "." + k
+		}
+		if key == p.Field {
+			continue
+		}
+		if !p.OverwriteKeys {
+			if _, err := event.GetValue(key); err == nil {
+				continue
+			}
+		}
+		if _, err := event.PutValue(key, v); err != nil {
+			if p.IgnoreFailure {
+				return event, nil
+			}
+			return event, fmt.Errorf(procName+" failed to write target field [%v]: %w", key, err)
+		}
+	}
+	return event, nil
+}
+
+// defaultPorts holds the ports dropped from the normalized URL of the
+// schemes using them.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+	"ftp":   "21",
+}
+
+// parseURL decomposes the raw URL into the ECS url fields. The scheme and the
+// domain are lowercased, internationalized domain names are decoded from
+// punycode and the path and fragment are percent-decoded. The full URL is
+// rebuilt from the normalized parts, with the domain in punycode and without
+// the default port of the scheme. The registered
+// domain, top level domain and subdomain are determined with the public
+// suffix list.
+func parseURL(raw string) (mapstr.M, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, errors.New("empty url")
+	}
+	if !strings.Contains(raw, "://") && !strings.HasPrefix(raw, "/") {
+		// Parse the URLs without a scheme, such as example.com/path,
+		// as network-path references instead of paths.
+		raw = "//" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+
+	fields := mapstr.M{}
+	if u.Scheme != "" {
+		fields["scheme"] = u.Scheme
+	}
+	if u.User != nil {
+		if name := u.User.Username(); name != "" {
+			fields["username"] = name
+		}
+		if pass, ok := u.User.Password(); ok {
+			fields["password"] = pass
+		}
+	}
+
+	host := u.Hostname()
+	port := u.Port()
+	if host != "" {
+		ascii, domain := normalizeHost(host)
+		fields["domain"] = domain
+		if net.ParseIP(ascii) == nil {
+			addDomainParts(fields, ascii)
+		}
+		if strings.Contains(ascii, ":") {
+			host = "[" + ascii + "]"
+		} else {
+			host = ascii
+		}
+	}
+	if port != "" {
+		n, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q: %w", port, err)
+		}
+		fields["port"] = n
+		if defaultPorts[u.Scheme] != port {
+			host = net.JoinHostPort(strings.Trim(host, "[]"), port)
+		}
+	}
+	u.Host = host
+
+	if u.Path != "" {
+		fields["path"] = u.Path
+		if ext := path.Ext(u.Path); len(ext) > 1 && !strings.HasSuffix(u.Path, "/") {
+			fields["extension"] = strings.ToLower(ext[1:])
+		}
+	}
+	if u.RawQuery != "" {
+		fields["query"] = u.RawQuery
+	}
+	if u.Fragment != "" {
+		fields["fragment"] = u.Fragment
+	}
+
+	full := u.String()
+	if u.Scheme == "" {
+		full = strings.TrimPrefix(full, "//")
+	}
+	fields["full"] = full
+	return fields, nil
+}
+
+// normalizeHost returns the host lowercased and without its trailing dot, in
+// its ASCII form used in the full URL and its Unicode form with the punycode
+// labels decoded. The hosts which are not valid domain names, such as the
+// ones holding underscores, are kept as they are.
+func normalizeHost(host string) (ascii, unicode string) {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if net.ParseIP(host) != nil {
+		return host, host
+	}
+	ascii, err := idna.Punycode.ToASCII(host)
+	if err != nil {
+		return host, host
+	}
+	unicode, err = idna.Punycode.ToUnicode(ascii)
+	if err != nil {
+		return ascii, ascii
+	}
+	return ascii, unicode
+}
+
+// addDomainParts adds the registered domain, top level domain and subdomain
+// of the ASCII domain to the fields when the public suffix list knows its
+// suffix.
+func addDomainParts(fields mapstr.M, ascii string) {
+	rd, err := publicsuffix.EffectiveTLDPlusOne(ascii)
+	if err != nil {
+		return
+	}
+	tld, _ := publicsuffix.PublicSuffix(ascii)
+	subdomain := strings.TrimSuffix(strings.TrimSuffix(ascii, rd), ".")
+
+	for k, v := range map[string]string{
+		"registered_domain": rd,
+		"top_level_domain":  tld,
+		"subdomain":         subdomain,
+	} {
+		if v == "" {
+			continue
+		}
+		if s, err := idna.Punycode.ToUnicode(v); err == nil {
+			v = s
+		}
+		fields[k] = v
+	}
+}
@@ -18,6 +18,7 @@
 package ratelimit
 
 import (
+	"errors"
 	"fmt"
 
 	cfg "github.com/elastic/elastic-agent-libs/config"
@@ -28,6 +29,36 @@ type config struct {
 	Limit     rate          `config:"limit" validate:"required"`
 	Fields    []string      `config:"fields"`
 	Algorithm cfg.Namespace `config:"algorithm"`
+
+	// Action is what happens to the events over the limit, they are either
+	// dropped or tagged with Tag and kept.
+	Action string `config:"action"`
+	Tag    string `config:"tag"`
+}
+
+const (
+	actionDrop = "drop"
+	actionTag  = "tag"
+)
+
+func defaultConfig() config {
+	return config{
+		Action: actionDrop,
+		Tag:    "rate_limited",
+	}
+}
+
+func (c *config) Validate() error {
+	switch c.Action {
+	case actionDrop:
+	case actionTag:
+		if c.Tag == "" {
+			return errors.New("tag is required when action is tag")
+		}
+	default:
+		return fmt.Errorf("invalid action %q, must be one of %q or %q", c.Action, actionDrop, actionTag)
+	}
+	return nil
 }
 
 func (c *config) setDefaults() error {
@@ -47,6 +47,7 @@ func init() {
 
 type metrics struct {
 	Dropped *monitoring.Int
+	Tagged  *monitoring.Int
 }
 
 type rateLimit struct {
@@ -59,11 +60,14 @@ type rateLimit struct {
 
 // new constructs a new rate limit processor.
 func new(cfg *c.C) (processors.Processor, error) {
-	var config config
+	config := defaultConfig()
 	if err := cfg.Unpack(&config); err != nil {
 		return nil, errors.Wrap(err, "could not unpack processor configuration")
 	}
 
+	// The key is derived from the values of the fields in a stable order.
+	sort.Strings(config.Fields)
+
 	if err := config.setDefaults(); err != nil {
 		return nil, errors.Wrap(err, "could not set default configuration")
 	}
@@ -90,6 +94,7 @@ func new(cfg *c.C) (processors.Processor, error) {
 		logger:    log,
 		metrics: metrics{
 			Dropped: monitoring.NewInt(reg, "dropped"),
+			Tagged:  monitoring.NewInt(reg, "tagged"),
 		},
 	}
 
@@ -99,7 +104,8 @@ func new(cfg *c.C) (processors.Processor, error) {
 }
 
 // Run applies the configured rate limit to the given event. If the event is within the
-// configured rate limit, it is returned as-is. If not, nil is returned.
+// configured rate limit, it is returned as-is. If not, nil is returned, or the
+// event is returned tagged when the action is tag.
 func (p *rateLimit) Run(event *beat.Event) (*beat.Event, error) {
 	key, err := p.makeKey(event)
 	if err != nil {
@@ -110,6 +116,15 @@ func (p *rateLimit) Run(event *beat.Event) (*beat.Event, error) {
 		return event, nil
 	}
 
+	if p.config.Action == actionTag {
+		p.logger.Debugf("event [%v] tagged by rate_limit processor", event)
+		p.metrics.Tagged.Inc()
+		if err := mapstr.AddTags(event.Fields, []string{p.config.Tag}); err != nil {
+			return event, errors.Wrap(err, "could not tag event")
+		}
+		return event, nil
+	}
+
 	p.logger.Debugf("event [%v] dropped by rate_limit processor", event)
 	p.metrics.Dropped.Inc()
 	return nil, nil
@@ -117,8 +132,8 @@ func (p *rateLimit) Run(event *beat.Event) (*beat.Event, error) {
 
 func (p *rateLimit) String() string {
 	return fmt.Sprintf(
-		"%v=[limit=[%v],fields=[%v],algorithm=[%v]]",
-		processorName, p.config.Limit, p.config.Fields, p.config.Algorithm.Name(),
+		"%v=[limit=[%v],fields=[%v],algorithm=[%v],action=[%v]]",
+		processorName, p.config.Limit, p.config.Fields, p.config.Algorithm.Name(), p.config.Action,
 	)
 }
 
@@ -127,7 +142,6 @@ func (p *rateLimit) makeKey(event *beat.Event) (uint64, error) {
 		return 0, nil
 	}
 
-	sort.Strings(p.config.Fields)
 	values := make([]string, len(p.config.Fields))
 	for _, field := range p.config.Fields {
 		value, err := event.GetValue(field)
@@ -38,6 +38,19 @@ func TestNew(t *testing.T) {
 			mapstr.M{},
 			"",
 		},
+		"unknown_action": {
+			mapstr.M{
+				"action": "foobar",
+			},
+			"invalid action",
+		},
+		"empty_tag": {
+			mapstr.M{
+				"action": "tag",
+				"tag":    "",
+			},
+			"tag is required",
+		},
 		"unknown_algo": {
 			mapstr.M{
 				"algorithm": mapstr.M{
@@ -139,6 +152,36 @@ func TestRateLimit(t *testing.T) {
 				withField(inEvents[3], "foo", "seger"),
 			},
 		},
+		"tag_action": {
+			config: mapstr.M{
+				"limit":  "2/m",
+				"action": "tag",
+			},
+			inEvents: inEvents[0:3],
+			outEvents: []beat.Event{
+				inEvents[0],
+				inEvents[1],
+				withField(inEvents[2], "tags", []string{"rate_limited"}),
+			},
+		},
+		"tag_action_custom_tag": {
+			config: mapstr.M{
+				"limit":  "1/m",
+				"fields": []string{"foo"},
+				"action": "tag",
+				"tag":    "storm",
+			},
+			inEvents: []beat.Event{
+				withField(inEvents[0], "foo", "bar"),
+				withField(inEvents[1], "foo", "bar"),
+				withField(inEvents[2], "foo", "seger"),
+			},
+			outEvents: []beat.Event{
+				withField(inEvents[0], "foo", "bar"),
+				withField(withField(inEvents[1], "foo", "bar"), "tags", []string{"storm"}),
+				withField(inEvents[2], "foo", "seger"),
+			},
+		},
 		"with_burst": {
 			config: mapstr.M{
 				"limit":            "2/s",
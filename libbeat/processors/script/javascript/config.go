@@ -33,6 +33,14 @@ type Config struct {
 	Timeout           time.Duration          `config:"timeout" validate:"min=0"`             // Execution timeout.
 	TagOnException    string                 `config:"tag_on_exception"`                     // Tag to add to events when an exception happens.
 	MaxCachedSessions int                    `config:"max_cached_sessions" validate:"min=0"` // Max. number of cached VM sessions.
+	ModulePaths       []string               `config:"module_paths"`                         // Directories from which modules can be loaded with require().
+	State             StateConfig            `config:"state"`                                // State shared by the sessions.
+}
+
+// StateConfig defines the bounds of the state shared between the sessions.
+type StateConfig struct {
+	MaxEntries int           `config:"max_entries" validate:"min=1"` // Max. number of entries, the least recently used are evicted.
+	TTL        time.Duration `config:"ttl" validate:"min=0"`         // Time after which an entry expires if not updated, zero for never.
 }
 
 // Validate returns an error if one (and only one) option is not set.
@@ -60,5 +68,8 @@ func defaultConfig() Config {
 	return Config{
 		TagOnException:    "_js_exception",
 		MaxCachedSessions: 4,
+		State: StateConfig{
+			MaxEntries: 10000,
+		},
 	}
 }
@@ -87,7 +87,15 @@ func NewFromConfig(c Config, reg *monitoring.Registry) (processors.Processor, er
 		return nil, err
 	}
 
-	pool, err := newSessionPool(prog, c)
+	if len(c.ModulePaths) > 0 {
+		modulePaths := make([]string, len(c.ModulePaths))
+		for i, dir := range c.ModulePaths {
+			modulePaths[i] = paths.Resolve(paths.Config, dir)
+		}
+		c.ModulePaths = modulePaths
+	}
+
+	pool, err := newSessionPool(prog, c, newStateStore(c.State))
 	if err != nil {
 		return nil, annotateError(c.Tag, err)
 	}
@@ -18,20 +18,61 @@
 package require
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/dop251/goja_nodejs/require"
 	"github.com/pkg/errors"
 
+	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/processors/script/javascript"
 )
 
 func init() {
 	javascript.AddSessionHook("require", func(s javascript.Session) {
-		reg := require.NewRegistryWithLoader(loadSource)
+		reg := require.NewRegistryWithLoader(sourceLoader(s.ModulePaths()))
 		reg.Enable(s.Runtime())
 	})
 }
 
-// loadSource disallows loading custom modules from file.
-func loadSource(path string) ([]byte, error) {
-	return nil, errors.Errorf("cannot load %v, only built-in modules are supported", path)
+// sourceLoader returns a loader of the modules found in the given
+// directories. Loading custom modules from file is disallowed if there are
+// no directories.
+func sourceLoader(dirs []string) func(path string) ([]byte, error) {
+	return func(path string) ([]byte, error) {
+		if len(dirs) == 0 {
+			return nil, errors.Errorf("cannot load %v, only built-in modules are supported", path)
+		}
+
+		// Modules are named relative to the module paths and can't escape them.
+		name := filepath.Clean(filepath.FromSlash(path))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return nil, errors.Errorf("cannot load %v, modules must be relative to the module paths", path)
+		}
+		if filepath.Ext(name) == "" {
+			name += ".js"
+		}
+
+		for _, dir := range dirs {
+			file := filepath.Join(dir, name)
+			if common.IsStrictPerms() {
+				if err := common.OwnerHasExclusiveWritePerms(file); err != nil {
+					if os.IsNotExist(err) {
+						continue
+					}
+					return nil, err
+				}
+			}
+			source, err := os.ReadFile(file)
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return nil, errors.Wrapf(err, "failed to read module %v", path)
+			}
+			return source, nil
+		}
+		return nil, errors.Errorf("module %v not found in %v", path, strings.Join(dirs, ", "))
+	}
 }
@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package require_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/processors/script/javascript"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+
+	_ "github.com/elastic/beats/v7/libbeat/processors/script/javascript/module/require"
+)
+
+func TestRequireFromModulePaths(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "lib"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "lib", "helpers.js"), []byte(`
+exports.greet = function(name) {
+    return "hello " + name;
+};
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	const script = `
+var helpers = require('./lib/helpers');
+
+function process(evt) {
+    evt.Put("greeting", helpers.greet(evt.Get("user.name")));
+}
+`
+
+	t.Run("module paths", func(t *testing.T) {
+		p, err := javascript.NewFromConfig(javascript.Config{
+			Source:      script,
+			ModulePaths: []string{t.TempDir(), dir},
+		}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		evt, err := p.Run(&beat.Event{Fields: mapstr.M{"user": mapstr.M{"name": "alice"}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		greeting, _ := evt.GetValue("greeting")
+		assert.Equal(t, "hello alice", greeting)
+	})
+
+	t.Run("no module paths", func(t *testing.T) {
+		_, err := javascript.NewFromConfig(javascript.Config{Source: script}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("outside module paths", func(t *testing.T) {
+		_, err := javascript.NewFromConfig(javascript.Config{
+			Source:      `var helpers = require('../lib/helpers'); function process(evt) {}`,
+			ModulePaths: []string{filepath.Join(dir, "lib")},
+		}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := javascript.NewFromConfig(javascript.Config{
+			Source:      `var helpers = require('missing'); function process(evt) {}`,
+			ModulePaths: []string{dir},
+		}, nil)
+		assert.Error(t, err)
+	})
+}
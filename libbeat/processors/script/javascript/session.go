@@ -47,6 +47,10 @@ type Session interface {
 
 	// Event returns a pointer to the current event being processed.
 	Event() Event
+
+	// ModulePaths returns the directories from which modules can be loaded
+	// with require().
+	ModulePaths() []string
 }
 
 // Event is the event being processed by the processor.
@@ -79,9 +83,10 @@ type session struct {
 	processFunc    goja.Callable
 	timeout        time.Duration
 	tagOnException string
+	modulePaths    []string
 }
 
-func newSession(p *goja.Program, conf Config, test bool) (*session, error) {
+func newSession(p *goja.Program, conf Config, state *stateStore, test bool) (*session, error) {
 	// Create a logger
 	logger := logp.NewLogger(logName)
 	if conf.Tag != "" {
@@ -100,6 +105,7 @@ func newSession(p *goja.Program, conf Config, test bool) (*session, error) {
 		makeEvent:      newBeatEventV0,
 		timeout:        conf.Timeout,
 		tagOnException: conf.TagOnException,
+		modulePaths:    conf.ModulePaths,
 	}
 
 	// Register modules.
@@ -110,6 +116,9 @@ func newSession(p *goja.Program, conf Config, test bool) (*session, error) {
 	// Register constructor for 'new Event' to enable test() to create events.
 	s.vm.Set("Event", newBeatEventV0Constructor(s))
 
+	// Expose the state shared with the other sessions of the processor.
+	s.vm.Set("state", newStateObject(s.vm, state))
+
 	_, err := s.vm.RunProgram(p)
 	if err != nil {
 		return nil, err
@@ -260,6 +269,12 @@ func (s *session) Event() Event {
 	return s.evt
 }
 
+// ModulePaths returns the directories from which modules can be loaded
+// with require().
+func (s *session) ModulePaths() []string {
+	return s.modulePaths
+}
+
 func init() {
 	// Register mapstr.M as being a simple map[string]interface{} for
 	// treatment within the JS VM.
@@ -277,15 +292,15 @@ type sessionPool struct {
 	C   chan *session
 }
 
-func newSessionPool(p *goja.Program, c Config) (*sessionPool, error) {
-	s, err := newSession(p, c, true)
+func newSessionPool(p *goja.Program, c Config, state *stateStore) (*sessionPool, error) {
+	s, err := newSession(p, c, state, true)
 	if err != nil {
 		return nil, err
 	}
 
 	pool := sessionPool{
 		New: func() *session {
-			s, _ := newSession(p, c, false)
+			s, _ := newSession(p, c, state, false)
 			return s
 		},
 		C: make(chan *session, c.MaxCachedSessions),
@@ -224,3 +224,49 @@ func TestSessionParallel(t *testing.T) {
 	time.AfterFunc(time.Second, cancel)
 	wg.Wait()
 }
+
+func TestSessionState(t *testing.T) {
+	const script = `
+		var n = state.incr("count");
+		evt.Put("count", n);
+		if (state.get("first") === undefined) {
+			state.put("first", {host: evt.Get("host.name")});
+		}
+		evt.Put("first", state.get("first").host);
+		evt.Put("missing", state.get("missing") === undefined);
+    `
+
+	p, err := NewFromConfig(Config{
+		Source:            header + script + footer,
+		MaxCachedSessions: 1,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const goroutines, events = 4, 50
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < events; j++ {
+				evt, err := p.Run(&beat.Event{Fields: mapstr.M{"host": mapstr.M{"name": "computer"}}})
+				if assert.NoError(t, err) {
+					first, _ := evt.GetValue("first")
+					assert.Equal(t, "computer", first)
+					missing, _ := evt.GetValue("missing")
+					assert.Equal(t, true, missing)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The counter is shared by all the sessions.
+	evt, err := p.Run(testEvent())
+	if assert.NoError(t, err) {
+		count, _ := evt.GetValue("count")
+		assert.EqualValues(t, goroutines*events+1, count)
+	}
+}
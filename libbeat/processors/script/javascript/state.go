@@ -0,0 +1,202 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package javascript
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+)
+
+// stateStore is a bounded key-value store shared by all the sessions of a
+// processor so that scripts can keep state across events. When the store is
+// full the least recently used entry is evicted. Values are exported from the
+// runtime that sets them so that they can be used safely by other sessions.
+type stateStore struct {
+	mu         sync.Mutex
+	maxEntries int
+	ttl        time.Duration
+	entries    map[string]*list.Element
+	lru        *list.List // Front is the most recently used entry.
+
+	// Decouple time.Now()
+	clock func() time.Time
+}
+
+type stateEntry struct {
+	key     string
+	value   interface{}
+	expires time.Time // Zero if the entry doesn't expire.
+}
+
+func newStateStore(c StateConfig) *stateStore {
+	if c.MaxEntries <= 0 {
+		c.MaxEntries = defaultConfig().State.MaxEntries
+	}
+	return &stateStore{
+		maxEntries: c.MaxEntries,
+		ttl:        c.TTL,
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+		clock:      time.Now,
+	}
+}
+
+// Get returns the value of key.
+func (s *stateStore) Get(key string) (value interface{}, found bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.lookup(key)
+	if e == nil {
+		return nil, false
+	}
+	return e.value, true
+}
+
+// Put sets the value of key.
+func (s *stateStore) Put(key string, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(key, value)
+}
+
+// Incr adds delta to the integer value of key and returns the new value. A
+// missing or non integer value is considered to be zero.
+func (s *stateStore) Incr(key string, delta int64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int64
+	if e := s.lookup(key); e != nil {
+		n, _ = e.value.(int64)
+	}
+	n += delta
+	s.put(key, n)
+	return n
+}
+
+// Delete removes key.
+func (s *stateStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, found := s.entries[key]; found {
+		s.remove(elem)
+	}
+}
+
+// Len returns the number of entries, including the expired entries that
+// haven't been evicted yet.
+func (s *stateStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lru.Len()
+}
+
+// lookup returns the unexpired entry of key and marks it as recently used.
+func (s *stateStore) lookup(key string) *stateEntry {
+	elem, found := s.entries[key]
+	if !found {
+		return nil
+	}
+	e := elem.Value.(*stateEntry)
+	if !e.expires.IsZero() && !s.clock().Before(e.expires) {
+		s.remove(elem)
+		return nil
+	}
+	s.lru.MoveToFront(elem)
+	return e
+}
+
+func (s *stateStore) put(key string, value interface{}) {
+	var expires time.Time
+	if s.ttl > 0 {
+		expires = s.clock().Add(s.ttl)
+	}
+
+	if elem, found := s.entries[key]; found {
+		e := elem.Value.(*stateEntry)
+		e.value, e.expires = value, expires
+		s.lru.MoveToFront(elem)
+		return
+	}
+
+	for s.lru.Len() >= s.maxEntries {
+		s.remove(s.lru.Back())
+	}
+	s.entries[key] = s.lru.PushFront(&stateEntry{key: key, value: value, expires: expires})
+}
+
+func (s *stateStore) remove(elem *list.Element) {
+	s.lru.Remove(elem)
+	delete(s.entries, elem.Value.(*stateEntry).key)
+}
+
+// newStateObject returns the object exposing the store to the runtime as
+// the global state variable.
+func newStateObject(vm *goja.Runtime, s *stateStore) *goja.Object {
+	o := vm.NewObject()
+	o.Set("get", func(call goja.FunctionCall) goja.Value {
+		if v, found := s.Get(call.Argument(0).String()); found {
+			// Objects are copied as the runtime would otherwise modify the
+			// value shared with other sessions.
+			return vm.ToValue(cloneStateValue(v))
+		}
+		return goja.Undefined()
+	})
+	o.Set("put", func(call goja.FunctionCall) goja.Value {
+		s.Put(call.Argument(0).String(), call.Argument(1).Export())
+		return goja.Undefined()
+	})
+	o.Set("incr", func(call goja.FunctionCall) goja.Value {
+		delta := int64(1)
+		if arg := call.Argument(1); !goja.IsUndefined(arg) {
+			delta = arg.ToInteger()
+		}
+		return vm.ToValue(s.Incr(call.Argument(0).String(), delta))
+	})
+	o.Set("delete", func(call goja.FunctionCall) goja.Value {
+		s.Delete(call.Argument(0).String())
+		return goja.Undefined()
+	})
+	return o
+}
+
+func cloneStateValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, x := range v {
+			m[k] = cloneStateValue(x)
+		}
+		return m
+	case []interface{}:
+		a := make([]interface{}, len(v))
+		for i, x := range v {
+			a[i] = cloneStateValue(x)
+		}
+		return a
+	default:
+		return v
+	}
+}
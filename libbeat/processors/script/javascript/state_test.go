@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package javascript
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateStore(t *testing.T) {
+	t.Run("least recently used entries are evicted", func(t *testing.T) {
+		s := newStateStore(StateConfig{MaxEntries: 2})
+		s.Put("a", "1")
+		s.Put("b", "2")
+		_, found := s.Get("a")
+		assert.True(t, found)
+
+		s.Put("c", "3")
+		assert.Equal(t, 2, s.Len())
+		_, found = s.Get("b")
+		assert.False(t, found)
+		v, _ := s.Get("a")
+		assert.Equal(t, "1", v)
+		v, _ = s.Get("c")
+		assert.Equal(t, "3", v)
+	})
+
+	t.Run("entries expire", func(t *testing.T) {
+		now := time.Now()
+		s := newStateStore(StateConfig{MaxEntries: 10, TTL: time.Minute})
+		s.clock = func() time.Time { return now }
+		s.Put("a", "1")
+		assert.EqualValues(t, 1, s.Incr("n", 1))
+
+		now = now.Add(30 * time.Second)
+		assert.EqualValues(t, 3, s.Incr("n", 2))
+
+		now = now.Add(45 * time.Second)
+		_, found := s.Get("a")
+		assert.False(t, found)
+		v, found := s.Get("n")
+		assert.True(t, found)
+		assert.EqualValues(t, 3, v)
+	})
+
+	t.Run("incr", func(t *testing.T) {
+		s := newStateStore(StateConfig{})
+		assert.EqualValues(t, 1, s.Incr("n", 1))
+		assert.EqualValues(t, -4, s.Incr("n", -5))
+		s.Put("n", "text")
+		assert.EqualValues(t, 1, s.Incr("n", 1))
+		s.Delete("n")
+		_, found := s.Get("n")
+		assert.False(t, found)
+	})
+
+	t.Run("values are copied", func(t *testing.T) {
+		value := map[string]interface{}{"list": []interface{}{"a"}}
+		clone := cloneStateValue(value).(map[string]interface{})
+		clone["list"].([]interface{})[0] = "b"
+		clone["other"] = true
+		assert.Equal(t, map[string]interface{}{"list": []interface{}{"a"}}, value)
+	})
+}
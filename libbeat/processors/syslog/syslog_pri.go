@@ -0,0 +1,167 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/processors/checks"
+	jsprocessor "github.com/elastic/beats/v7/libbeat/processors/script/javascript/module/processor"
+	"github.com/elastic/beats/v7/libbeat/reader/syslog"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const priProcName = "syslog_pri"
+
+// priConfig defines the configuration for the syslog_pri processor.
+type priConfig struct {
+	Field         string `config:"field" validate:"required"`
+	Target        string `config:"target"`
+	OverwriteKeys bool   `config:"overwrite_keys"`
+	IgnoreMissing bool   `config:"ignore_missing"`
+	IgnoreFailure bool   `config:"ignore_failure"`
+	Tag           string `config:"tag"`
+}
+
+// priProcessor decodes syslog priorities into their facility and severity.
+type priProcessor struct {
+	priConfig
+}
+
+func init() {
+	processors.RegisterPlugin(priProcName,
+		checks.ConfigChecked(NewPriority,
+			checks.RequireFields(
+				"field",
+			),
+			checks.AllowedFields(
+				"field",
+				"target",
+				"overwrite_keys",
+				"ignore_missing",
+				"ignore_failure",
+				"tag",
+			),
+		),
+	)
+	jsprocessor.RegisterPlugin("SyslogPri", NewPriority)
+}
+
+// defaultPriConfig will return a config with default values.
+func defaultPriConfig() priConfig {
+	return priConfig{
+		Field:         "message",
+		Target:        "log.syslog",
+		OverwriteKeys: true,
+	}
+}
+
+// NewPriority creates a new syslog_pri processor from the provided
+// configuration, or an error if the configuration is invalid.
+func NewPriority(c *conf.C) (processors.Processor, error) {
+	cfg := defaultPriConfig()
+	if err := c.Unpack(&cfg); err != nil {
+		return nil, fmt.Errorf("fail to unpack the "+priProcName+" processor configuration: %w", err)
+	}
+	return &priProcessor{priConfig: cfg}, nil
+}
+
+// Run decodes the priority held by the field into the priority, facility and
+// severity fields under the target. If an error occurs and the configuration
+// is set to not ignore errors, the 'error.message' field will be set with
+// error that was encountered.
+func (p *priProcessor) Run(event *beat.Event) (*beat.Event, error) {
+	if err := p.run(event); err != nil && !p.IgnoreFailure {
+		err = fmt.Errorf(priProcName+" failed to process field %q: %w", p.Field, err)
+		appendStringField(event.Fields, "error.message", err.Error())
+		return event, err
+	}
+	return event, nil
+}
+
+func (p *priProcessor) run(event *beat.Event) error {
+	value, err := event.GetValue(p.Field)
+	if err != nil {
+		if errors.Is(err, mapstr.ErrKeyNotFound) && p.IgnoreMissing {
+			return nil
+		}
+		return err
+	}
+
+	var priority int
+	switch v := value.(type) {
+	case string:
+		priority, err = syslog.ParsePriority(v)
+	case int:
+		priority, err = checkPriority(int64(v))
+	case int64:
+		priority, err = checkPriority(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			err = syslog.ErrPriority
+		} else {
+			priority, err = checkPriority(int64(v))
+		}
+	case float64:
+		if v != math.Trunc(v) {
+			err = fmt.Errorf("invalid priority: %v", v)
+		} else {
+			priority, err = checkPriority(int64(v))
+		}
+	default:
+		err = fmt.Errorf("type %T of field %q is not a string or a number", value, p.Field)
+	}
+	if err != nil {
+		return err
+	}
+
+	for k, v := range syslog.PriorityFields(priority).Flatten() {
+		key := k
+		if p.Target != "" {
+			key = p.Target + "." + k
+		}
+		if !p.OverwriteKeys {
+			if _, err := event.GetValue(key); err == nil {
+				continue
+			}
+		}
+		if _, err := event.PutValue(key, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkPriority(v int64) (int, error) {
+	if v < 0 || 191 < v {
+		return 0, syslog.ErrPriority
+	}
+	return int(v), nil
+}
+
+// String will return a string representation of this processor (the configuration).
+func (p *priProcessor) String() string {
+	data, _ := json.Marshal(p.priConfig)
+	return priProcName + "=" + string(data)
+}
@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestSyslogPri(t *testing.T) {
+	priority34 := mapstr.M{
+		"priority": 34,
+		"facility": mapstr.M{"code": 4, "name": "security/authorization"},
+		"severity": mapstr.M{"code": 2, "name": "Critical"},
+	}
+
+	tests := map[string]struct {
+		cfg     mapstr.M
+		in      mapstr.M
+		want    mapstr.M
+		wantErr bool
+	}{
+		"pri-value": {
+			cfg:  mapstr.M{"field": "pri"},
+			in:   mapstr.M{"pri": "<34>"},
+			want: mapstr.M{"pri": "<34>", "log": mapstr.M{"syslog": priority34}},
+		},
+		"embedded": {
+			cfg:  mapstr.M{},
+			in:   mapstr.M{"message": "<34>Oct 11 22:14:15 mymachine su: 'su root' failed"},
+			want: mapstr.M{"message": "<34>Oct 11 22:14:15 mymachine su: 'su root' failed", "log": mapstr.M{"syslog": priority34}},
+		},
+		"number": {
+			cfg:  mapstr.M{"field": "pri", "target": "syslog"},
+			in:   mapstr.M{"pri": float64(34)},
+			want: mapstr.M{"pri": float64(34), "syslog": priority34},
+		},
+		"no-overwrite": {
+			cfg: mapstr.M{"field": "pri", "target": "syslog", "overwrite_keys": false},
+			in:  mapstr.M{"pri": 34, "syslog": mapstr.M{"severity": mapstr.M{"name": "crit"}}},
+			want: mapstr.M{"pri": 34, "syslog": mapstr.M{
+				"priority": 34,
+				"facility": mapstr.M{"code": 4, "name": "security/authorization"},
+				"severity": mapstr.M{"code": 2, "name": "crit"},
+			}},
+		},
+		"out-of-range": {
+			cfg:     mapstr.M{"field": "pri"},
+			in:      mapstr.M{"pri": "<192>"},
+			want:    mapstr.M{"pri": "<192>", "error": mapstr.M{"message": `syslog_pri failed to process field "pri": priority value out of range (expected 0..191)`}},
+			wantErr: true,
+		},
+		"missing": {
+			cfg:  mapstr.M{"field": "pri", "ignore_missing": true},
+			in:   mapstr.M{"message": "hello"},
+			want: mapstr.M{"message": "hello"},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			p, err := NewPriority(conf.MustNewConfigFrom(tc.cfg))
+			require.NoError(t, err)
+
+			got, err := p.Run(&beat.Event{Fields: tc.in})
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, got.Fields)
+		})
+	}
+}
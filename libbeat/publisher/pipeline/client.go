@@ -91,6 +91,11 @@ func (c *client) publish(e beat.Event) {
 		return
 	}
 
+	if !c.canDrop {
+		// slow down the input while the beat is close to its resource limits
+		c.pipeline.throttle.wait(c.done)
+	}
+
 	if c.processors != nil {
 		var err error
 
@@ -215,6 +220,7 @@ func (c *client) onNewEvent() {
 
 func (c *client) onPublished() {
 	c.pipeline.observer.publishedEvent()
+	c.pipeline.publishedEvents.Inc()
 	if c.eventer != nil {
 		c.eventer.Published()
 	}
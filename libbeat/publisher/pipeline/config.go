@@ -35,6 +35,61 @@ type Config struct {
 
 	// Event queue
 	Queue config.Namespace `config:"queue"`
+
+	// Additional named pipelines with independent queues and outputs.
+	Pipelines NamedConfigs `config:"publisher_pipelines"`
+
+	// Drain of the pipelines when the beat stops.
+	Shutdown ShutdownConfig `config:"shutdown"`
+
+	// Delivery order of the events sharing a source key.
+	Ordering OrderingConfig `config:"ordering"`
+
+	// Throttling of the clients when the cgroup of the beat is under pressure.
+	Throttle ThrottleConfig `config:"throttle"`
+}
+
+// NamedConfig configures an additional publisher pipeline with its own queue
+// and output. Inputs select the pipeline by name.
+type NamedConfig struct {
+	Name   string           `config:"name" validate:"required"`
+	Queue  config.Namespace `config:"queue"`
+	Output config.Namespace `config:"output"`
+}
+
+// NamedConfigs is the list of additional publisher pipelines.
+type NamedConfigs []NamedConfig
+
+// Validate checks that the output is configured and the pipeline names and
+// disk queue paths are unique.
+func (c NamedConfigs) Validate() error {
+	names := make(map[string]struct{}, len(c))
+	diskPaths := map[string]string{}
+	for _, p := range c {
+		if p.Name == DefaultPipelineName {
+			return fmt.Errorf("publisher pipeline name '%v' is reserved", DefaultPipelineName)
+		}
+		if _, exists := names[p.Name]; exists {
+			return fmt.Errorf("publisher pipeline '%v' is defined multiple times", p.Name)
+		}
+		names[p.Name] = struct{}{}
+
+		if !p.Output.IsSet() {
+			return fmt.Errorf("no output configured for publisher pipeline '%v'", p.Name)
+		}
+
+		if p.Queue.Name() == "disk" {
+			path, _ := p.Queue.Config().String("path", -1)
+			if path == "" {
+				continue
+			}
+			if other, exists := diskPaths[path]; exists {
+				return fmt.Errorf("publisher pipelines '%v' and '%v' use the same disk queue path '%v'", other, p.Name, path)
+			}
+			diskPaths[path] = p.Name
+		}
+	}
+	return nil
 }
 
 // validateClientConfig checks a ClientConfig can be used with (*Pipeline).ConnectWith.
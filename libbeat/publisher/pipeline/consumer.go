@@ -42,6 +42,14 @@ type eventConsumer struct {
 	// eventConsumer.retry().
 	retryChan chan retryRequest
 
+	// The source keys of the batches done by the outputs are released on
+	// this channel. Clients should call eventConsumer.release().
+	releaseChan chan *reservation
+
+	// ordering holds back the batches sharing source keys with a batch in
+	// flight, it is nil unless ordering is enabled.
+	ordering *orderer
+
 	// Closing this channel signals consumer shutdown. Clients should call
 	// eventConsumer.close().
 	done chan struct{}
@@ -64,6 +72,7 @@ type consumerTarget struct {
 	ch         chan publisher.Batch
 	timeToLive int
 	batchSize  int
+	lag        *deliveryLag
 }
 
 // retryRequest is used by ttlBatch to add itself back to the eventConsumer
@@ -77,16 +86,19 @@ func newEventConsumer(
 	log *logp.Logger,
 	queue queue.Queue,
 	observer outputObserver,
+	ordering OrderingConfig,
 ) *eventConsumer {
 	c := &eventConsumer{
 		logger:      log,
 		observer:    observer,
 		queue:       queue,
 		queueReader: makeQueueReader(),
+		ordering:    newOrderer(ordering),
 
-		targetChan: make(chan consumerTarget),
-		retryChan:  make(chan retryRequest),
-		done:       make(chan struct{}),
+		targetChan:  make(chan consumerTarget),
+		retryChan:   make(chan retryRequest),
+		releaseChan: make(chan *reservation),
+		done:        make(chan struct{}),
 	}
 
 	c.wg.Add(1)
@@ -140,6 +152,7 @@ outerLoop:
 				retryer:    c,
 				batchSize:  target.batchSize,
 				timeToLive: target.timeToLive,
+				lag:        target.lag,
 			}
 		}
 
@@ -155,16 +168,25 @@ outerLoop:
 		// If we have a batch, we'll point the output channel at the target
 		// and try to send to it. Otherwise, it will remain nil, and sends
 		// to it will always block, so the output case of the select below
-		// will be ignored.
+		// will be ignored. With ordering, the batch also waits for the
+		// batches in flight sharing its source keys.
 		var outputChan chan publisher.Batch
+		var pending *reservation
 		if active != nil {
-			outputChan = target.ch
+			var ready bool
+			if pending, ready = c.ordering.prepare(active); ready {
+				outputChan = target.ch
+				// The reservation is set before the batch is sent, an
+				// output worker may ACK it before the send returns.
+				active.reservation = pending
+			}
 		}
 
 		// Now we can block until the next state change.
 		select {
 		case outputChan <- active:
 			// Successfully sent a batch to the output workers
+			c.ordering.hold(pending)
 			if len(retryBatches) > 0 {
 				// This was a retry, report it to the observer
 				c.observer.eventsRetry(len(active.Events()))
@@ -179,8 +201,18 @@ outerLoop:
 
 		case queueBatch = <-c.queueReader.resp:
 			pendingRead = false
+			c.ordering.assign(queueBatch)
+
+		case r := <-c.releaseChan:
+			c.ordering.release(r)
 
 		case req := <-c.retryChan:
+			// The batch is not in flight anymore. Its keys are released
+			// at the same time it is added to the retry queue, so that a
+			// later batch sharing them can't be sent before it.
+			c.ordering.release(req.batch.reservation)
+			req.batch.reservation = nil
+
 			if req.decreaseTTL {
 				countFailed := len(req.batch.Events())
 
@@ -195,7 +227,7 @@ outerLoop:
 					continue
 				}
 			}
-			retryBatches = append(retryBatches, req.batch)
+			retryBatches = c.ordering.insert(retryBatches, req.batch)
 
 		case <-c.done:
 			break outerLoop
@@ -223,6 +255,13 @@ func (c *eventConsumer) retry(batch *ttlBatch, decreaseTTL bool) {
 	}
 }
 
+func (c *eventConsumer) release(r *reservation) {
+	select {
+	case c.releaseChan <- r:
+	case <-c.done:
+	}
+}
+
 func (c *eventConsumer) close() {
 	close(c.done)
 	c.wg.Wait()
@@ -53,13 +53,14 @@ func newOutputController(
 	monitors Monitors,
 	observer outputObserver,
 	queue queue.Queue,
+	ordering OrderingConfig,
 ) *outputController {
 	return &outputController{
 		beat:      beat,
 		monitors:  monitors,
 		observer:  observer,
 		workQueue: make(chan publisher.Batch),
-		consumer:  newEventConsumer(monitors.Logger, queue, observer),
+		consumer:  newEventConsumer(monitors.Logger, queue, observer, ordering),
 	}
 }
 
@@ -108,6 +109,7 @@ func (c *outputController) Set(outGrp outputs.Group) {
 			ch:         targetChan,
 			batchSize:  outGrp.BatchSize,
 			timeToLive: outGrp.Retry + 1,
+			lag:        newDeliveryLag(c.monitors.Metrics),
 		})
 }
 
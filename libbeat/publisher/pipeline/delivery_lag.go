@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+	"github.com/elastic/elastic-agent-libs/monitoring/adapter"
+)
+
+// deliveryLag records the time elapsed between the timestamp of the events
+// and their acknowledgement by the output, in milliseconds. The histogram is
+// biased towards the last five minutes so that it reflects the current lag.
+type deliveryLag struct {
+	sample metrics.Sample
+}
+
+// newDeliveryLag registers the delivery lag histogram of the output in the
+// output registry of metrics. It returns nil if there is no output registry.
+func newDeliveryLag(metricsReg *monitoring.Registry) *deliveryLag {
+	if metricsReg == nil {
+		return nil
+	}
+	reg := metricsReg.GetRegistry("output")
+	if reg == nil {
+		return nil
+	}
+	// The registry is only cleared when the output is reloaded.
+	if reg.GetRegistry("delivery_lag") != nil {
+		reg.Remove("delivery_lag")
+	}
+
+	lag := &deliveryLag{sample: metrics.NewExpDecaySample(1028, 0.015)}
+	_ = adapter.NewGoMetrics(reg, "delivery_lag", adapter.Accept).
+		Register("histogram", metrics.NewHistogram(lag.sample))
+	return lag
+}
+
+// observe records the lag of the events acknowledged at now.
+func (l *deliveryLag) observe(events []publisher.Event, now time.Time) {
+	if l == nil {
+		return
+	}
+	for _, e := range events {
+		ts := e.Content.Timestamp
+		if ts.IsZero() {
+			continue
+		}
+		l.sample.Update(now.Sub(ts).Milliseconds())
+	}
+}
@@ -0,0 +1,63 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+func TestDeliveryLag(t *testing.T) {
+	assert.Nil(t, newDeliveryLag(nil))
+
+	metrics := monitoring.NewRegistry()
+	assert.Nil(t, newDeliveryLag(metrics), "no output registry")
+
+	metrics.NewRegistry("output")
+	_ = newDeliveryLag(metrics)
+	// Registering again when the output is set again must not panic.
+	lag := newDeliveryLag(metrics)
+	require.NotNil(t, lag)
+
+	now := time.Now()
+	var done bool
+	batch := &ttlBatch{
+		done: func() { done = true },
+		events: []publisher.Event{
+			{Content: beat.Event{Timestamp: now.Add(-2 * time.Second)}},
+			{Content: beat.Event{Timestamp: now.Add(-4 * time.Second)}},
+			{Content: beat.Event{}},
+		},
+		lag: lag,
+	}
+	batch.ACK()
+	assert.True(t, done)
+
+	// Events without timestamp are ignored.
+	assert.Equal(t, int64(2), lag.sample.Count())
+	assert.GreaterOrEqual(t, lag.sample.Min(), int64(2000))
+	assert.GreaterOrEqual(t, lag.sample.Max(), int64(4000))
+	assert.NotNil(t, metrics.GetRegistry("output").GetRegistry("delivery_lag"))
+}
@@ -107,10 +107,18 @@ func LoadWithSettings(
 		return nil, err
 	}
 
+	if config.Ordering.Enabled {
+		settings.Ordering = config.Ordering
+	}
+	if config.Throttle.Enabled {
+		settings.Throttle = config.Throttle
+	}
+
 	p, err := New(beatInfo, monitors, queueFactory, out, settings)
 	if err != nil {
 		return nil, err
 	}
+	p.persistentQueue = config.Queue.Name() == diskqueue.QueueType
 
 	log.Infof("Beat name: %s", name)
 	return p, err
@@ -202,6 +210,11 @@ func createQueueFactory(
 		if err != nil {
 			return nil, err
 		}
+		if monitors.Metrics != nil {
+			if settings.Registry = monitors.Metrics.GetRegistry("pipeline"); settings.Registry == nil {
+				settings.Registry = monitors.Metrics.NewRegistry("pipeline")
+			}
+		}
 		return diskQueueFactory(monitors.Logger, settings), nil
 	default:
 		return nil, fmt.Errorf("'%v' is not a valid queue type", queueType)
@@ -0,0 +1,196 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/publisher"
+)
+
+// OrderingConfig configures the delivery order of the events sharing a
+// source key, such as the lines of a file or the messages of a connection.
+type OrderingConfig struct {
+	// Enabled guarantees that the events with the same source key are sent
+	// to the outputs in order, even when batches are retried or split. A
+	// batch is held back while an earlier batch with one of its source keys
+	// is in flight, which lowers the throughput of outputs with several
+	// workers.
+	Enabled bool `config:"enabled"`
+	// KeyFields are the fields making up the source key of an event. Events
+	// without any of these fields are not ordered.
+	KeyFields []string `config:"key_fields"`
+}
+
+// defaultOrderingKeyFields identify the files, connections and Kafka
+// partitions the events are read from.
+var defaultOrderingKeyFields = []string{
+	"log.file.path",
+	"log.source.address",
+	"kafka.topic",
+	"kafka.partition",
+}
+
+// reservation holds the source keys of a batch in flight to the outputs.
+type reservation struct {
+	keys []string
+}
+
+// orderer constrains the batches sent by the eventConsumer so that the
+// events sharing a source key are delivered in order. Its methods are only
+// called from the eventConsumer goroutine. A nil orderer does not constrain
+// the batches.
+type orderer struct {
+	keyFields []string
+
+	// The source keys of the batches in flight.
+	inflight map[string]struct{}
+
+	// The position assigned to the next batch read from the queue.
+	nextSeq uint64
+}
+
+func newOrderer(config OrderingConfig) *orderer {
+	if !config.Enabled {
+		return nil
+	}
+	keyFields := config.KeyFields
+	if len(keyFields) == 0 {
+		keyFields = defaultOrderingKeyFields
+	}
+	return &orderer{
+		keyFields: keyFields,
+		inflight:  make(map[string]struct{}),
+	}
+}
+
+// assign sets the position of a batch read from the queue.
+func (o *orderer) assign(b *ttlBatch) {
+	if o == nil || b == nil {
+		return
+	}
+	b.order = []uint64{o.nextSeq}
+	o.nextSeq++
+}
+
+// prepare returns the reservation of the source keys of the batch, or false
+// if one of them is held by a batch in flight.
+func (o *orderer) prepare(b *ttlBatch) (*reservation, bool) {
+	if o == nil {
+		return nil, true
+	}
+	if b.keys == nil {
+		b.keys = sourceKeys(b.events, o.keyFields)
+	}
+	for _, key := range b.keys {
+		if _, busy := o.inflight[key]; busy {
+			return nil, false
+		}
+	}
+	return &reservation{keys: b.keys}, true
+}
+
+// hold marks the keys of a batch sent to the outputs as in flight.
+func (o *orderer) hold(r *reservation) {
+	if o == nil || r == nil {
+		return
+	}
+	for _, key := range r.keys {
+		o.inflight[key] = struct{}{}
+	}
+}
+
+// release marks the keys of a reservation as no longer in flight. Releasing
+// a reservation more than once has no effect.
+func (o *orderer) release(r *reservation) {
+	if o == nil || r == nil {
+		return
+	}
+	for _, key := range r.keys {
+		delete(o.inflight, key)
+	}
+	r.keys = nil
+}
+
+// insert adds a batch to the batches waiting to be retried. Without
+// ordering the batch is appended, otherwise the batches are kept in the
+// order they were read from the queue, so that a retried batch is sent
+// again before the later batches sharing its keys.
+func (o *orderer) insert(batches []*ttlBatch, b *ttlBatch) []*ttlBatch {
+	if o == nil {
+		return append(batches, b)
+	}
+	i := sort.Search(len(batches), func(i int) bool {
+		return orderLess(b.order, batches[i].order)
+	})
+	batches = append(batches, nil)
+	copy(batches[i+1:], batches[i:])
+	batches[i] = b
+	return batches
+}
+
+// sourceKeys returns the distinct source keys of the events.
+func sourceKeys(events []publisher.Event, fields []string) []string {
+	keys := []string{}
+	seen := make(map[string]struct{})
+	var sb strings.Builder
+	for i := range events {
+		sb.Reset()
+		for _, field := range fields {
+			v, err := events[i].Content.GetValue(field)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(&sb, "%s=%v\x00", field, v)
+		}
+		if sb.Len() == 0 {
+			continue
+		}
+		key := sb.String()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// orderLess compares the positions of two batches. The position of a batch
+// split from another one extends the position of the original batch.
+func orderLess(a, b []uint64) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+// childOrder returns the position of the i-th batch split from a batch at
+// the given position.
+func childOrder(order []uint64, i uint64) []uint64 {
+	if order == nil {
+		return nil
+	}
+	child := make([]uint64, len(order), len(order)+1)
+	copy(child, order)
+	return append(child, i)
+}
@@ -0,0 +1,244 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func fileEvent(path string) publisher.Event {
+	return publisher.Event{Content: beat.Event{
+		Fields: mapstr.M{"log": mapstr.M{"file": mapstr.M{"path": path}}},
+	}}
+}
+
+func TestSourceKeys(t *testing.T) {
+	events := []publisher.Event{
+		fileEvent("/var/log/a.log"),
+		{Content: beat.Event{Fields: mapstr.M{"message": "no key"}}},
+		fileEvent("/var/log/b.log"),
+		fileEvent("/var/log/a.log"),
+		{Content: beat.Event{Fields: mapstr.M{"kafka": mapstr.M{"topic": "logs", "partition": 3}}}},
+	}
+
+	keys := sourceKeys(events, defaultOrderingKeyFields)
+	assert.Equal(t, []string{
+		"log.file.path=/var/log/a.log\x00",
+		"log.file.path=/var/log/b.log\x00",
+		"kafka.topic=logs\x00kafka.partition=3\x00",
+	}, keys)
+}
+
+func TestOrdererDisabled(t *testing.T) {
+	o := newOrderer(OrderingConfig{})
+	require.Nil(t, o)
+
+	b := &ttlBatch{events: []publisher.Event{fileEvent("a")}}
+	o.assign(b)
+	assert.Nil(t, b.order)
+
+	r, ready := o.prepare(b)
+	assert.True(t, ready)
+	assert.Nil(t, r)
+
+	batches := o.insert([]*ttlBatch{{order: []uint64{1}}}, &ttlBatch{order: []uint64{0}})
+	assert.Equal(t, []uint64{1}, batches[0].order, "retries should be appended without ordering")
+}
+
+func TestOrdererHoldsBackSharedKeys(t *testing.T) {
+	o := newOrderer(OrderingConfig{Enabled: true})
+
+	first := &ttlBatch{events: []publisher.Event{fileEvent("a"), fileEvent("b")}}
+	second := &ttlBatch{events: []publisher.Event{fileEvent("b")}}
+	other := &ttlBatch{events: []publisher.Event{fileEvent("c")}}
+	keyless := &ttlBatch{events: []publisher.Event{{}}}
+
+	r, ready := o.prepare(first)
+	require.True(t, ready)
+	o.hold(r)
+
+	_, ready = o.prepare(second)
+	assert.False(t, ready, "a batch sharing a key with a batch in flight must wait")
+	_, ready = o.prepare(other)
+	assert.True(t, ready, "a batch with other keys can be sent")
+	_, ready = o.prepare(keyless)
+	assert.True(t, ready, "a batch without keys can be sent")
+
+	o.release(r)
+	o.release(r)
+	_, ready = o.prepare(second)
+	assert.True(t, ready, "the keys should be free once the first batch is done")
+}
+
+func TestOrdererInsert(t *testing.T) {
+	o := newOrderer(OrderingConfig{Enabled: true})
+
+	var batches []*ttlBatch
+	for _, order := range [][]uint64{{3}, {1, 1}, {5}, {1, 0}, {1, 0, 1}} {
+		batches = o.insert(batches, &ttlBatch{order: order})
+	}
+
+	var orders [][]uint64
+	for _, b := range batches {
+		orders = append(orders, b.order)
+	}
+	assert.Equal(t, [][]uint64{{1, 0}, {1, 0, 1}, {1, 1}, {3}, {5}}, orders)
+}
+
+func TestSplitRetryKeepsOrder(t *testing.T) {
+	retryer := &mockRetryer{}
+	res := &reservation{keys: []string{"a"}}
+	b := &ttlBatch{
+		events:      []publisher.Event{fileEvent("a"), fileEvent("a")},
+		retryer:     retryer,
+		done:        func() {},
+		order:       []uint64{7},
+		reservation: res,
+	}
+
+	require.True(t, b.SplitRetry())
+	require.Len(t, retryer.batches, 2)
+	assert.Equal(t, []uint64{7, 0}, retryer.batches[0].order)
+	assert.Equal(t, []uint64{7, 1}, retryer.batches[1].order)
+	assert.Same(t, res, retryer.batches[0].reservation)
+	assert.Same(t, res, retryer.batches[1].reservation)
+
+	retryer.batches[0].reservation = &reservation{}
+	retryer.batches[0].ACK()
+	assert.Len(t, retryer.released, 1, "the keys should be released once the batch is done")
+}
+
+// orderingConsumer runs an eventConsumer with ordering enabled, reading the
+// batches passed to read instead of a queue.
+type orderingConsumer struct {
+	*eventConsumer
+	work chan publisher.Batch
+}
+
+func newOrderingConsumer(t *testing.T) *orderingConsumer {
+	c := &eventConsumer{
+		logger:   logp.NewLogger("eventConsumer test"),
+		observer: nilObserver,
+		ordering: newOrderer(OrderingConfig{Enabled: true}),
+		queueReader: queueReader{
+			req:  make(chan queueReaderRequest, 1),
+			resp: make(chan *ttlBatch),
+		},
+		targetChan:  make(chan consumerTarget),
+		retryChan:   make(chan retryRequest),
+		releaseChan: make(chan *reservation),
+		done:        make(chan struct{}),
+	}
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.run()
+	}()
+	t.Cleanup(c.close)
+
+	work := make(chan publisher.Batch)
+	c.setTarget(consumerTarget{ch: work, batchSize: 10, timeToLive: -1})
+	return &orderingConsumer{eventConsumer: c, work: work}
+}
+
+func (c *orderingConsumer) newBatch(path string) *ttlBatch {
+	return &ttlBatch{
+		events:  []publisher.Event{fileEvent(path)},
+		done:    func() {},
+		retryer: c.eventConsumer,
+		ttl:     -1,
+	}
+}
+
+func (c *orderingConsumer) read(b *ttlBatch) {
+	<-c.queueReader.req
+	c.queueReader.resp <- b
+}
+
+func (c *orderingConsumer) expect(t *testing.T, want *ttlBatch) {
+	t.Helper()
+	select {
+	case got := <-c.work:
+		require.Same(t, want, got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for a batch")
+	}
+}
+
+func (c *orderingConsumer) expectNone(t *testing.T) {
+	t.Helper()
+	select {
+	case got := <-c.work:
+		t.Fatalf("unexpected batch %v", got.Events())
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestConsumerOrdering(t *testing.T) {
+	c := newOrderingConsumer(t)
+
+	first := c.newBatch("a")
+	c.read(first)
+	c.expect(t, first)
+
+	second := c.newBatch("a")
+	c.read(second)
+	c.expectNone(t)
+
+	// The first batch is retried before the second one is sent.
+	first.Retry()
+	c.expect(t, first)
+	c.expectNone(t)
+
+	first.ACK()
+	c.expect(t, second)
+	second.ACK()
+}
+
+func TestConsumerReleasesKeysOnACK(t *testing.T) {
+	c := newOrderingConsumer(t)
+
+	first := c.newBatch("a")
+	c.read(first)
+	c.expect(t, first)
+	require.NotNil(t, first.reservation, "a batch sent to the outputs should hold its keys")
+
+	second := c.newBatch("a")
+	c.read(second)
+	c.expectNone(t)
+
+	first.ACK()
+	c.expect(t, second)
+
+	third := c.newBatch("a")
+	c.read(third)
+	c.expectNone(t)
+
+	second.Drop()
+	c.expect(t, third)
+	third.ACK()
+}
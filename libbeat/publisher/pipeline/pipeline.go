@@ -54,6 +54,10 @@ import (
 // For client connecting to this pipeline, the default PublishMode is
 // OutputChooses.
 type Pipeline struct {
+	// events counters, kept first for 64-bit alignment on 32-bit platforms
+	publishedEvents atomic.Uint64
+	ackedEvents     atomic.Uint64
+
 	beatInfo beat.Info
 
 	monitors Monitors
@@ -68,11 +72,17 @@ type Pipeline struct {
 	waitCloseTimeout time.Duration
 	waitCloseGroup   sync.WaitGroup
 
+	// shutdown drain support
+	persistentQueue bool
+
 	// closeRef signal propagation support
 	guardStartSigPropagation sync.Once
 	sigNewClient             chan *client
 
 	processors processing.Supporter
+
+	// pauses the clients while the cgroup of the beat is under pressure
+	throttle *throttle
 }
 
 // Settings is used to pass additional settings to a newly created pipeline instance.
@@ -86,6 +96,14 @@ type Settings struct {
 	Processors processing.Supporter
 
 	InputQueueSize int
+
+	// Ordering guarantees the delivery order of the events sharing a source
+	// key.
+	Ordering OrderingConfig
+
+	// Throttle pauses the clients while the cgroup of the beat is under
+	// memory or CPU pressure.
+	Throttle ThrottleConfig
 }
 
 // WaitCloseMode enumerates the possible behaviors of WaitClose in a pipeline.
@@ -155,9 +173,11 @@ func New(
 	}
 	p.observer.queueMaxEvents(maxEvents)
 
-	p.output = newOutputController(beat, monitors, p.observer, p.queue)
+	p.output = newOutputController(beat, monitors, p.observer, p.queue, settings.Ordering)
 	p.output.Set(out)
 
+	p.throttle = newThrottle(settings.Throttle, monitors)
+
 	return p, nil
 }
 
@@ -165,6 +185,7 @@ func New(
 // Pipeline when events are acknowledged.
 func (p *Pipeline) OnACK(n int) {
 	p.observer.queueACKed(n)
+	p.ackedEvents.Add(uint64(n))
 
 	if p.waitOnClose {
 		p.waitCloseGroup.Add(-n)
@@ -198,6 +219,9 @@ func (p *Pipeline) Close() error {
 
 	// Note: active clients are not closed / disconnected.
 
+	// Resume the clients paused by the throttle.
+	p.throttle.close()
+
 	// Closing the queue stops ACKs from propagating, so we close the output first
 	// to give it a chance to wait for any outstanding events to be acknowledged.
 	p.output.Close()
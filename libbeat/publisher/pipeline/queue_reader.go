@@ -35,6 +35,7 @@ type queueReaderRequest struct {
 	retryer    retryer
 	batchSize  int
 	timeToLive int
+	lag        *deliveryLag
 }
 
 func makeQueueReader() queueReader {
@@ -57,7 +58,7 @@ func (qr *queueReader) run(logger *logp.Logger) {
 		queueBatch, _ := req.queue.Get(req.batchSize)
 		var batch *ttlBatch
 		if queueBatch != nil {
-			batch = newBatch(req.retryer, queueBatch, req.timeToLive)
+			batch = newBatch(req.retryer, queueBatch, req.timeToLive, req.lag)
 		}
 		select {
 		case qr.resp <- batch:
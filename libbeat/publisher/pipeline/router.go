@@ -0,0 +1,84 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"fmt"
+
+	"github.com/joeshaw/multierror"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+)
+
+// DefaultPipelineName is the name of the pipeline configured by the
+// top-level queue and output settings.
+const DefaultPipelineName = "default"
+
+// Router connects clients to one of multiple independent pipelines. Each
+// pipeline owns its queue and output, such that events published to one
+// pipeline do not share buffering with the others.
+// Clients are routed based on beat.ClientConfig.PublisherPipeline. Clients
+// not selecting a pipeline are connected to the default pipeline.
+type Router struct {
+	defaultPipeline *Pipeline
+	pipelines       map[string]*Pipeline
+}
+
+// NewRouter creates a Router. The router takes ownership of all pipelines.
+func NewRouter(defaultPipeline *Pipeline, pipelines map[string]*Pipeline) *Router {
+	return &Router{defaultPipeline: defaultPipeline, pipelines: pipelines}
+}
+
+// Connect connects a client with default settings to the default pipeline.
+func (r *Router) Connect() (beat.Client, error) {
+	return r.ConnectWith(beat.ClientConfig{})
+}
+
+// ConnectWith connects a client to the pipeline selected in cfg.
+func (r *Router) ConnectWith(cfg beat.ClientConfig) (beat.Client, error) {
+	name := cfg.PublisherPipeline
+	if name == "" || name == DefaultPipelineName {
+		return r.defaultPipeline.ConnectWith(cfg)
+	}
+
+	p, exists := r.pipelines[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown publisher pipeline '%v'", name)
+	}
+	return p.ConnectWith(cfg)
+}
+
+// OutputReloader returns the output reloader of the default pipeline. The
+// outputs of named pipelines can not be reloaded.
+func (r *Router) OutputReloader() OutputReloader {
+	return r.defaultPipeline.OutputReloader()
+}
+
+// Close closes all pipelines.
+func (r *Router) Close() error {
+	var errs multierror.Errors
+	for _, p := range r.pipelines {
+		if err := p.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := r.defaultPipeline.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	return errs.Err()
+}
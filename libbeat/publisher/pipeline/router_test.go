@@ -0,0 +1,140 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/publisher/queue"
+	conf "github.com/elastic/elastic-agent-libs/config"
+)
+
+func TestRouter(t *testing.T) {
+	makePipeline := func() *Pipeline {
+		p, err := New(beat.Info{},
+			Monitors{},
+			func(_ queue.ACKListener) (queue.Queue, error) {
+				return makeTestQueue(), nil
+			},
+			outputs.Group{},
+			Settings{},
+		)
+		require.NoError(t, err)
+		return p
+	}
+
+	defaultPipeline := makePipeline()
+	security := makePipeline()
+	router := NewRouter(defaultPipeline, map[string]*Pipeline{"security": security})
+	defer router.Close()
+
+	cases := map[string]struct {
+		name     string
+		expected *Pipeline
+	}{
+		"empty name uses default pipeline":   {name: "", expected: defaultPipeline},
+		"default name uses default pipeline": {name: DefaultPipelineName, expected: defaultPipeline},
+		"named pipeline":                     {name: "security", expected: security},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := router.ConnectWith(beat.ClientConfig{PublisherPipeline: test.name})
+			require.NoError(t, err)
+			defer c.Close()
+
+			assert.Same(t, test.expected, c.(*client).pipeline)
+		})
+	}
+
+	t.Run("unknown pipeline fails", func(t *testing.T) {
+		_, err := router.ConnectWith(beat.ClientConfig{PublisherPipeline: "unknown"})
+		require.Error(t, err)
+	})
+}
+
+func TestNamedConfigsValidate(t *testing.T) {
+	cases := map[string]struct {
+		yaml string
+		fail bool
+	}{
+		"valid": {
+			yaml: `
+publisher_pipelines:
+  - name: logs
+    output.console: ~
+  - name: security
+    queue.mem.events: 128
+    output.console: ~`,
+		},
+		"duplicate names": {
+			yaml: `
+publisher_pipelines:
+  - name: logs
+    output.console: ~
+  - name: logs
+    output.console: ~`,
+			fail: true,
+		},
+		"reserved name": {
+			yaml: `
+publisher_pipelines:
+  - name: default
+    output.console: ~`,
+			fail: true,
+		},
+		"duplicate disk queue path": {
+			yaml: `
+publisher_pipelines:
+  - name: logs
+    queue.disk.path: /var/lib/beat/queue
+    output.console: ~
+  - name: security
+    queue.disk.path: /var/lib/beat/queue
+    output.console: ~`,
+			fail: true,
+		},
+		"missing output": {
+			yaml: `
+publisher_pipelines:
+  - name: logs`,
+			fail: true,
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := conf.NewConfigWithYAML([]byte(test.yaml), "test")
+			require.NoError(t, err)
+
+			var c Config
+			err = cfg.Unpack(&c)
+			if test.fail {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, c.Pipelines, 2)
+		})
+	}
+}
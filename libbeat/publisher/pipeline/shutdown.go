@@ -0,0 +1,144 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"sync"
+	"time"
+)
+
+// shutdownPollInterval is how often the pending events are checked while
+// draining the pipeline.
+const shutdownPollInterval = 100 * time.Millisecond
+
+// ShutdownConfig configures how the pipeline is drained once the beat has
+// stopped its inputs.
+type ShutdownConfig struct {
+	// DrainTimeout is the maximum duration to wait for the outputs to
+	// acknowledge the pending events before closing the pipeline. The
+	// pipeline is not drained nor closed if it is zero.
+	DrainTimeout time.Duration `config:"drain_timeout" validate:"min=0"`
+}
+
+// ShutdownReport summarizes what happened to the events pending in the
+// pipeline when it was shut down.
+type ShutdownReport struct {
+	// Drained is the number of events acknowledged by the outputs before
+	// the deadline.
+	Drained uint64
+	// Persisted is the number of events left in a disk queue. They are
+	// sent once the beat is restarted.
+	Persisted uint64
+	// Dropped is the number of events left in a memory queue, they are lost.
+	Dropped uint64
+}
+
+func (r *ShutdownReport) add(other ShutdownReport) {
+	r.Drained += other.Drained
+	r.Persisted += other.Persisted
+	r.Dropped += other.Dropped
+}
+
+// Shutdown waits up to timeout for the outputs to acknowledge the events
+// pending in the pipeline, then closes the outputs and the queue. The disk
+// queue writes the events still pending to disk when it is closed.
+// Shutdown logs and returns a summary of the pending events.
+// Note: clients must be closed before calling Shutdown.
+func (p *Pipeline) Shutdown(timeout time.Duration) ShutdownReport {
+	log := p.monitors.Logger
+
+	pending := p.pendingEvents()
+	log.Infof("Draining %d pending events from the publisher pipeline (timeout: %v)", pending, timeout)
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(shutdownPollInterval)
+	defer ticker.Stop()
+
+	remaining := pending
+wait:
+	for remaining > 0 {
+		select {
+		case <-ticker.C:
+			remaining = p.pendingEvents()
+		case <-deadline.C:
+			remaining = p.pendingEvents()
+			break wait
+		}
+	}
+
+	if err := p.Close(); err != nil {
+		log.Errorf("Error closing the publisher pipeline: %v", err)
+	}
+
+	var report ShutdownReport
+	if remaining < pending {
+		report.Drained = pending - remaining
+	}
+	if p.persistentQueue {
+		report.Persisted = remaining
+	} else {
+		report.Dropped = remaining
+	}
+
+	log.Infow("Publisher pipeline shut down",
+		"events.drained", report.Drained,
+		"events.persisted", report.Persisted,
+		"events.dropped", report.Dropped)
+	return report
+}
+
+// pendingEvents returns the number of events published to the queue and not
+// acknowledged yet. The events replayed by a disk queue from a previous run
+// are acknowledged without having been published, so they can make the
+// count fall below zero, in which case no event is pending.
+func (p *Pipeline) pendingEvents() uint64 {
+	published, acked := p.publishedEvents.Load(), p.ackedEvents.Load()
+	if acked >= published {
+		return 0
+	}
+	return published - acked
+}
+
+// Shutdown shuts down all pipelines concurrently with the same timeout, and
+// returns the sum of their reports.
+func (r *Router) Shutdown(timeout time.Duration) ShutdownReport {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		report ShutdownReport
+	)
+
+	shutdown := func(p *Pipeline) {
+		defer wg.Done()
+		pr := p.Shutdown(timeout)
+
+		mu.Lock()
+		defer mu.Unlock()
+		report.add(pr)
+	}
+
+	wg.Add(len(r.pipelines) + 1)
+	for _, p := range r.pipelines {
+		go shutdown(p)
+	}
+	go shutdown(r.defaultPipeline)
+	wg.Wait()
+
+	return report
+}
@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/publisher/queue"
+)
+
+func TestPipelineShutdown(t *testing.T) {
+	makePipeline := func(persistent bool, published uint64) *Pipeline {
+		p, err := New(beat.Info{},
+			Monitors{},
+			func(_ queue.ACKListener) (queue.Queue, error) {
+				return makeTestQueue(), nil
+			},
+			outputs.Group{},
+			Settings{},
+		)
+		require.NoError(t, err)
+		p.persistentQueue = persistent
+		p.publishedEvents.Add(published)
+		return p
+	}
+
+	t.Run("all events drained", func(t *testing.T) {
+		p := makePipeline(false, 10)
+		p.OnACK(4)
+		go func() {
+			time.Sleep(2 * shutdownPollInterval)
+			p.OnACK(6)
+		}()
+
+		report := p.Shutdown(time.Minute)
+		assert.Equal(t, ShutdownReport{Drained: 10}, report)
+	})
+
+	t.Run("memory queue drops the pending events", func(t *testing.T) {
+		p := makePipeline(false, 10)
+		p.OnACK(3)
+
+		report := p.Shutdown(3 * shutdownPollInterval)
+		assert.Equal(t, ShutdownReport{Dropped: 7}, report)
+	})
+
+	t.Run("disk queue persists the pending events", func(t *testing.T) {
+		p := makePipeline(true, 10)
+		go func() {
+			time.Sleep(shutdownPollInterval)
+			p.OnACK(2)
+		}()
+
+		report := p.Shutdown(3 * shutdownPollInterval)
+		assert.Equal(t, ShutdownReport{Drained: 2, Persisted: 8}, report)
+	})
+
+	t.Run("events replayed from a previous run", func(t *testing.T) {
+		p := makePipeline(true, 2)
+		p.OnACK(5)
+
+		report := p.Shutdown(time.Minute)
+		assert.Equal(t, ShutdownReport{}, report)
+	})
+
+	t.Run("router sums the reports", func(t *testing.T) {
+		defaultPipeline := makePipeline(false, 5)
+		security := makePipeline(true, 4)
+		security.OnACK(1)
+		router := NewRouter(defaultPipeline, map[string]*Pipeline{"security": security})
+
+		report := router.Shutdown(2 * shutdownPollInterval)
+		assert.Equal(t, ShutdownReport{Persisted: 3, Dropped: 5}, report)
+	})
+}
@@ -0,0 +1,271 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/cgroup"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// ThrottleConfig configures the throttling of the clients when the beat
+// approaches the memory limit of its cgroup or is heavily CPU throttled, so
+// that the inputs slow down instead of the beat being OOM killed.
+type ThrottleConfig struct {
+	// Enabled pauses the clients publishing events while the cgroup of the
+	// beat is under pressure.
+	Enabled bool `config:"enabled"`
+	// Period is the interval the cgroup stats are read at.
+	Period time.Duration `config:"period"`
+	// MaxPause bounds the time a single event is held back, so that the
+	// inputs keep progressing if the pressure never goes away.
+	MaxPause time.Duration `config:"max_pause"`
+	Memory   struct {
+		// HighWatermark is the fraction of the memory limit in use above
+		// which the clients are paused.
+		HighWatermark float64 `config:"high_watermark"`
+		// LowWatermark is the fraction of the memory limit in use below
+		// which the clients are resumed.
+		LowWatermark float64 `config:"low_watermark"`
+	} `config:"memory"`
+	CPU struct {
+		// ThrottledRatio is the fraction of the CPU periods the cgroup was
+		// throttled in during the last period above which the clients are
+		// paused.
+		ThrottledRatio float64 `config:"throttled_ratio"`
+	} `config:"cpu"`
+}
+
+const (
+	defaultThrottlePeriod         = time.Second
+	defaultThrottleMaxPause       = 30 * time.Second
+	defaultThrottleHighWatermark  = 0.9
+	defaultThrottleLowWatermark   = 0.8
+	defaultThrottleThrottledRatio = 0.5
+)
+
+// Validate checks the watermarks and ratios are fractions and the low
+// watermark is not above the high one.
+func (c *ThrottleConfig) Validate() error {
+	if c.Period < 0 || c.MaxPause < 0 {
+		return errors.New("throttle period and max_pause must not be negative")
+	}
+	for _, v := range []float64{c.Memory.HighWatermark, c.Memory.LowWatermark, c.CPU.ThrottledRatio} {
+		if v < 0 || v > 1 {
+			return errors.New("throttle watermarks and ratios must be between 0 and 1")
+		}
+	}
+	if c.Memory.HighWatermark != 0 && c.Memory.LowWatermark > c.Memory.HighWatermark {
+		return errors.New("throttle memory.low_watermark must not be above memory.high_watermark")
+	}
+	return nil
+}
+
+// withDefaults returns the config with the unset settings replaced by their
+// default value.
+func (c ThrottleConfig) withDefaults() ThrottleConfig {
+	if c.Period == 0 {
+		c.Period = defaultThrottlePeriod
+	}
+	if c.MaxPause == 0 {
+		c.MaxPause = defaultThrottleMaxPause
+	}
+	if c.Memory.HighWatermark == 0 {
+		c.Memory.HighWatermark = defaultThrottleHighWatermark
+	}
+	if c.Memory.LowWatermark == 0 {
+		c.Memory.LowWatermark = defaultThrottleLowWatermark
+		if c.Memory.LowWatermark > c.Memory.HighWatermark {
+			c.Memory.LowWatermark = c.Memory.HighWatermark
+		}
+	}
+	if c.CPU.ThrottledRatio == 0 {
+		c.CPU.ThrottledRatio = defaultThrottleThrottledRatio
+	}
+	return c
+}
+
+// statsReader reads the stats of a cgroup.
+type statsReader interface {
+	Read() (cgroup.Stats, error)
+}
+
+// throttle pauses the clients while the cgroup of the beat is under memory or
+// CPU pressure. A nil throttle never pauses.
+type throttle struct {
+	config ThrottleConfig
+	reader statsReader
+	log    *logp.Logger
+
+	mu       sync.Mutex
+	active   bool
+	released chan struct{} // closed when the active throttle is released
+
+	// Stats of the previous read, to compute the CPU throttling over a period.
+	last   cgroup.Stats
+	primed bool
+
+	done chan struct{}
+	wg   sync.WaitGroup
+
+	activeGauge *monitoring.Bool
+	activations *monitoring.Uint
+	paused      *monitoring.Uint
+}
+
+// newThrottle starts a throttle reading the stats of the cgroup of the beat.
+// It returns nil if the throttle is disabled or the cgroup stats are not
+// available.
+func newThrottle(config ThrottleConfig, monitors Monitors) *throttle {
+	if !config.Enabled {
+		return nil
+	}
+	reader, err := cgroup.NewReader("/")
+	if err != nil {
+		monitors.Logger.Warnf("Throttle disabled, the cgroup stats are not available: %v", err)
+		return nil
+	}
+	return startThrottle(config, reader, monitors)
+}
+
+func startThrottle(config ThrottleConfig, reader statsReader, monitors Monitors) *throttle {
+	t := &throttle{
+		config: config.withDefaults(),
+		reader: reader,
+		log:    monitors.Logger,
+		done:   make(chan struct{}),
+	}
+
+	reg := monitoring.NewRegistry()
+	if monitors.Metrics != nil {
+		if reg = monitors.Metrics.GetRegistry("pipeline"); reg == nil {
+			reg = monitors.Metrics.NewRegistry("pipeline")
+		}
+	}
+	t.activeGauge = monitoring.NewBool(reg, "throttle.active")
+	t.activations = monitoring.NewUint(reg, "throttle.activations")
+	t.paused = monitoring.NewUint(reg, "throttle.paused_events")
+
+	t.update()
+	t.wg.Add(1)
+	go t.run()
+	return t
+}
+
+func (t *throttle) run() {
+	defer t.wg.Done()
+
+	ticker := time.NewTicker(t.config.Period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.done:
+			return
+		case <-ticker.C:
+			t.update()
+		}
+	}
+}
+
+// update reads the cgroup stats and pauses or resumes the clients.
+func (t *throttle) update() {
+	stats, err := t.reader.Read()
+	if err != nil {
+		t.log.Debugf("Failed to read the cgroup stats: %v", err)
+		return
+	}
+
+	var cpu float64
+	if t.primed && stats.CPUPeriods > t.last.CPUPeriods && stats.CPUThrottledPeriods >= t.last.CPUThrottledPeriods {
+		cpu = float64(stats.CPUThrottledPeriods-t.last.CPUThrottledPeriods) / float64(stats.CPUPeriods-t.last.CPUPeriods)
+	}
+	t.last, t.primed = stats, true
+	memory := stats.MemoryRatio()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.active {
+		if memory < t.config.Memory.HighWatermark && cpu < t.config.CPU.ThrottledRatio {
+			return
+		}
+		t.log.Warnf("Throttling the inputs, the beat uses %.0f%% of its memory limit and was CPU throttled in %.0f%% of the last periods", memory*100, cpu*100)
+		t.active = true
+		t.released = make(chan struct{})
+		t.activeGauge.Set(true)
+		t.activations.Inc()
+		return
+	}
+
+	if memory >= t.config.Memory.LowWatermark || cpu >= t.config.CPU.ThrottledRatio {
+		return
+	}
+	t.log.Info("Stopped throttling the inputs")
+	t.release()
+}
+
+// release resumes the paused clients, it must be called with the lock held.
+func (t *throttle) release() {
+	if !t.active {
+		return
+	}
+	t.active = false
+	close(t.released)
+	t.activeGauge.Set(false)
+}
+
+// wait blocks while the throttle is active, for at most MaxPause or until
+// done is closed.
+func (t *throttle) wait(done <-chan struct{}) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	active, released := t.active, t.released
+	t.mu.Unlock()
+	if !active {
+		return
+	}
+
+	t.paused.Inc()
+	timer := time.NewTimer(t.config.MaxPause)
+	defer timer.Stop()
+	select {
+	case <-released:
+	case <-done:
+	case <-timer.C:
+	}
+}
+
+// close stops reading the cgroup stats and resumes the paused clients.
+func (t *throttle) close() {
+	if t == nil {
+		return
+	}
+	close(t.done)
+	t.wg.Wait()
+
+	t.mu.Lock()
+	t.release()
+	t.mu.Unlock()
+}
@@ -0,0 +1,151 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common/cgroup"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+type fakeStatsReader struct {
+	mu    sync.Mutex
+	stats cgroup.Stats
+}
+
+func (r *fakeStatsReader) Read() (cgroup.Stats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stats, nil
+}
+
+func (r *fakeStatsReader) set(stats cgroup.Stats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stats = stats
+}
+
+func newTestThrottle(reader statsReader) *throttle {
+	config := ThrottleConfig{Enabled: true, Period: time.Hour, MaxPause: time.Hour}
+	return startThrottle(config, reader, Monitors{Logger: logp.NewLogger("test")})
+}
+
+func TestThrottleMemory(t *testing.T) {
+	reader := &fakeStatsReader{stats: cgroup.Stats{MemoryUsage: 50, MemoryLimit: 100}}
+	th := newTestThrottle(reader)
+	defer th.close()
+	assert.False(t, th.active)
+
+	reader.set(cgroup.Stats{MemoryUsage: 95, MemoryLimit: 100})
+	th.update()
+	require.True(t, th.active)
+	assert.Equal(t, uint64(1), th.activations.Get())
+
+	waited := make(chan struct{})
+	go func() {
+		th.wait(nil)
+		close(waited)
+	}()
+
+	// Between the watermarks the clients stay paused.
+	reader.set(cgroup.Stats{MemoryUsage: 85, MemoryLimit: 100})
+	th.update()
+	assert.True(t, th.active)
+	select {
+	case <-waited:
+		t.Fatal("the client was resumed above the low watermark")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	reader.set(cgroup.Stats{MemoryUsage: 70, MemoryLimit: 100})
+	th.update()
+	assert.False(t, th.active)
+	select {
+	case <-waited:
+	case <-time.After(time.Second):
+		t.Fatal("the client was not resumed")
+	}
+	assert.Equal(t, uint64(1), th.paused.Get())
+}
+
+func TestThrottleCPU(t *testing.T) {
+	reader := &fakeStatsReader{stats: cgroup.Stats{CPUPeriods: 100, CPUThrottledPeriods: 90}}
+	th := newTestThrottle(reader)
+	defer th.close()
+	// The periods throttled before the first read are not considered.
+	assert.False(t, th.active)
+
+	reader.set(cgroup.Stats{CPUPeriods: 110, CPUThrottledPeriods: 96})
+	th.update()
+	assert.True(t, th.active)
+
+	reader.set(cgroup.Stats{CPUPeriods: 120, CPUThrottledPeriods: 97})
+	th.update()
+	assert.False(t, th.active)
+}
+
+func TestThrottleWait(t *testing.T) {
+	var nilThrottle *throttle
+	nilThrottle.wait(nil)
+	nilThrottle.close()
+
+	reader := &fakeStatsReader{stats: cgroup.Stats{MemoryUsage: 95, MemoryLimit: 100}}
+	th := newTestThrottle(reader)
+	require.True(t, th.active)
+
+	// Closing the client resumes it.
+	done := make(chan struct{})
+	close(done)
+	th.wait(done)
+
+	// Closing the throttle resumes all clients.
+	waited := make(chan struct{})
+	go func() {
+		th.wait(nil)
+		close(waited)
+	}()
+	th.close()
+	select {
+	case <-waited:
+	case <-time.After(time.Second):
+		t.Fatal("the client was not resumed")
+	}
+}
+
+func TestThrottleConfigValidate(t *testing.T) {
+	config := ThrottleConfig{}
+	assert.NoError(t, config.Validate())
+
+	config.Memory.HighWatermark = 1.5
+	assert.Error(t, config.Validate())
+
+	config.Memory.HighWatermark = 0.5
+	config.Memory.LowWatermark = 0.6
+	assert.Error(t, config.Validate())
+
+	defaults := ThrottleConfig{}.withDefaults()
+	assert.Equal(t, 0.9, defaults.Memory.HighWatermark)
+	assert.Equal(t, 0.8, defaults.Memory.LowWatermark)
+	assert.Equal(t, 0.5, defaults.CPU.ThrottledRatio)
+}
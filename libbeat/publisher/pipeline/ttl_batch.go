@@ -19,6 +19,7 @@ package pipeline
 
 import (
 	"sync/atomic"
+	"time"
 
 	"github.com/elastic/beats/v7/libbeat/publisher"
 	"github.com/elastic/beats/v7/libbeat/publisher/queue"
@@ -26,6 +27,7 @@ import (
 
 type retryer interface {
 	retry(batch *ttlBatch, decreaseTTL bool)
+	release(r *reservation)
 }
 
 type ttlBatch struct {
@@ -49,6 +51,17 @@ type ttlBatch struct {
 	// all split batches descending from the same original batch will
 	// point to the same metadata.
 	split *batchSplitData
+
+	// When ordering is enabled, the position of the batch in the queue, the
+	// source keys of its events and the reservation of these keys while the
+	// batch is in flight.
+	order       []uint64
+	keys        []string
+	reservation *reservation
+
+	// lag records the delivery lag of the events when the batch is ACKed,
+	// it is nil when the pipeline has no metrics.
+	lag *deliveryLag
 }
 
 type batchSplitData struct {
@@ -61,7 +74,7 @@ type batchSplitData struct {
 	outstandingEvents atomic.Int64
 }
 
-func newBatch(retryer retryer, original queue.Batch, ttl int) *ttlBatch {
+func newBatch(retryer retryer, original queue.Batch, ttl int, lag *deliveryLag) *ttlBatch {
 	if original == nil {
 		panic("empty batch")
 	}
@@ -84,6 +97,7 @@ func newBatch(retryer retryer, original queue.Batch, ttl int) *ttlBatch {
 		retryer: retryer,
 		ttl:     ttl,
 		events:  events,
+		lag:     lag,
 	}
 	return b
 }
@@ -93,11 +107,23 @@ func (b *ttlBatch) Events() []publisher.Event {
 }
 
 func (b *ttlBatch) ACK() {
+	b.lag.observe(b.events, time.Now())
 	b.done()
+	b.releaseKeys()
 }
 
 func (b *ttlBatch) Drop() {
 	b.done()
+	b.releaseKeys()
+}
+
+// releaseKeys releases the source keys of a batch in flight once it is
+// done, so that the later batches sharing them can be sent.
+func (b *ttlBatch) releaseKeys() {
+	if r := b.reservation; r != nil {
+		b.reservation = nil
+		b.retryer.release(r)
+	}
 }
 
 // SplitRetry is called by the output to report that the batch is
@@ -121,19 +147,27 @@ func (b *ttlBatch) SplitRetry() bool {
 	splitIndex := len(b.events) / 2
 	events1 := b.events[:splitIndex]
 	events2 := b.events[splitIndex:]
+	// Both halves carry the reservation of the original batch, it is
+	// released when the first of them is back in the retry queue.
 	b.retryer.retry(&ttlBatch{
-		events:  events1,
-		done:    splitData.doneCallback(len(events1)),
-		retryer: b.retryer,
-		ttl:     b.ttl,
-		split:   splitData,
+		events:      events1,
+		done:        splitData.doneCallback(len(events1)),
+		retryer:     b.retryer,
+		ttl:         b.ttl,
+		split:       splitData,
+		order:       childOrder(b.order, 0),
+		reservation: b.reservation,
+		lag:         b.lag,
 	}, false)
 	b.retryer.retry(&ttlBatch{
-		events:  events2,
-		done:    splitData.doneCallback(len(events2)),
-		retryer: b.retryer,
-		ttl:     b.ttl,
-		split:   splitData,
+		events:      events2,
+		done:        splitData.doneCallback(len(events2)),
+		retryer:     b.retryer,
+		ttl:         b.ttl,
+		split:       splitData,
+		order:       childOrder(b.order, 1),
+		reservation: b.reservation,
+		lag:         b.lag,
 	}, false)
 	return true
 }
@@ -233,3 +267,5 @@ type testingRetryer struct {
 func (tr testingRetryer) retry(batch *ttlBatch, _ bool) {
 	tr.retryCallback(batch)
 }
+
+func (tr testingRetryer) release(_ *reservation) {}
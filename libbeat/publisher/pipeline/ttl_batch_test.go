@@ -92,9 +92,14 @@ func TestNestedBatchSplit(t *testing.T) {
 }
 
 type mockRetryer struct {
-	batches []*ttlBatch
+	batches  []*ttlBatch
+	released []*reservation
 }
 
 func (r *mockRetryer) retry(batch *ttlBatch, decreaseTTL bool) {
 	r.batches = append(r.batches, batch)
 }
+
+func (r *mockRetryer) release(res *reservation) {
+	r.released = append(r.released, res)
+}
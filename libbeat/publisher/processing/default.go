@@ -20,6 +20,8 @@ package processing
 import (
 	"fmt"
 
+	"go.uber.org/atomic"
+
 	"github.com/elastic/beats/v7/libbeat/asset"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common/fleetmode"
@@ -57,6 +59,10 @@ type builder struct {
 	timeSeries       bool
 	timeseriesFields mapping.Fields
 
+	// Sequence of the idempotency keys set in the metadata of the events, if
+	// enabled (disabled by default)
+	idempotencySeq *atomic.Uint64
+
 	// global pipeline processors
 	processors *group
 
@@ -107,6 +113,7 @@ func MakeDefaultSupport(
 			mapstr.EventMetadata `config:",inline"`      // Fields and tags to add to each event.
 			Processors           processors.PluginConfig `config:"processors"`
 			TimeSeries           bool                    `config:"timeseries.enabled"`
+			IdempotencyKey       bool                    `config:"idempotency_key.enabled"`
 		}{}
 		if err := beatCfg.Unpack(&cfg); err != nil {
 			return nil, err
@@ -128,7 +135,14 @@ func MakeDefaultSupport(
 			return nil, fmt.Errorf("error initializing processors: %w", err)
 		}
 
-		return newBuilder(info, log, processors, cfg.EventMetadata, modifiers, !normalize, cfg.TimeSeries)
+		b, err := newBuilder(info, log, processors, cfg.EventMetadata, modifiers, !normalize, cfg.TimeSeries)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.IdempotencyKey {
+			b.idempotencySeq = atomic.NewUint64(0)
+		}
+		return b, nil
 	}
 }
 
@@ -280,8 +294,9 @@ func (b *builder) Processors() []string {
 //  7. (P) add builtins
 //  8. (P) pipeline processors list
 //  9. (P) timeseries mangling
-//  10. (P) (if publish/debug enabled) log event
-//  11. (P) (if output disabled) dropEvent
+//  10. (P) idempotency key
+//  11. (P) (if publish/debug enabled) log event
+//  12. (P) (if output disabled) dropEvent
 func (b *builder) Create(cfg beat.ProcessingConfig, drop bool) (beat.Processor, error) {
 	var (
 		// pipeline processors
@@ -383,12 +398,17 @@ func (b *builder) Create(cfg beat.ProcessingConfig, drop bool) (beat.Processor,
 		processors.add(timeseries.NewTimeSeriesProcessor(b.timeseriesFields))
 	}
 
-	// setup 10: debug print final event (P)
+	// setup 10: idempotency key (P)
+	if b.idempotencySeq != nil {
+		processors.add(newIdempotencyKeyProcessor(b.info, b.idempotencySeq))
+	}
+
+	// setup 11: debug print final event (P)
 	if b.log.IsDebug() || publisher.UnderAgent() {
 		processors.add(debugPrintProcessor(b.info, b.log))
 	}
 
-	// setup 11: drop all events if outputs are disabled (P)
+	// setup 12: drop all events if outputs are disabled (P)
 	if drop {
 		processors.add(dropDisabledProcessor)
 	}
@@ -437,6 +437,35 @@ func TestDynamicFields(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestIdempotencyKey(t *testing.T) {
+	info := beat.Info{EphemeralID: uuid.Must(uuid.FromString("123e4567-e89b-12d3-a456-426655440000"))}
+	cfg := config.MustNewConfigFrom(map[string]interface{}{"idempotency_key.enabled": true})
+	factory, err := MakeDefaultSupport(true, nil)(info, logp.L(), cfg)
+	require.NoError(t, err)
+	defer factory.Close()
+
+	// Client metadata is shared by the events and must not be modified.
+	clientMeta := mapstr.M{"pipeline": "test"}
+	prog1, err := factory.Create(beat.ProcessingConfig{Meta: clientMeta}, false)
+	require.NoError(t, err)
+	prog2, err := factory.Create(beat.ProcessingConfig{}, false)
+	require.NoError(t, err)
+
+	actual, err := prog1.Run(&beat.Event{Fields: mapstr.M{"hello": "world"}})
+	require.NoError(t, err)
+	assert.Equal(t, mapstr.M{"pipeline": "test", "idempotency_key": "123e4567-e89b-12d3-a456-426655440000-1"}, actual.Meta)
+	assert.Equal(t, mapstr.M{"pipeline": "test"}, clientMeta)
+
+	actual, err = prog2.Run(&beat.Event{Fields: mapstr.M{"hello": "world"}})
+	require.NoError(t, err)
+	assert.Equal(t, mapstr.M{"idempotency_key": "123e4567-e89b-12d3-a456-426655440000-2"}, actual.Meta)
+
+	// Keys set by the inputs are kept.
+	actual, err = prog2.Run(&beat.Event{Meta: mapstr.M{"idempotency_key": "input-key"}})
+	require.NoError(t, err)
+	assert.Equal(t, mapstr.M{"idempotency_key": "input-key"}, actual.Meta)
+}
+
 func TestProcessingClose(t *testing.T) {
 	factory, err := MakeDefaultSupport(true, nil)(beat.Info{}, logp.L(), config.NewConfig())
 	require.NoError(t, err)
@@ -19,13 +19,16 @@ package processing
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/joeshaw/multierror"
+	"go.uber.org/atomic"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/beat/events"
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/outputs/codec/json"
 	"github.com/elastic/beats/v7/libbeat/processors"
@@ -175,6 +178,22 @@ func addMeta(event *beat.Event, meta mapstr.M) {
 	}
 }
 
+// newIdempotencyKeyProcessor sets a key unique to the event in its metadata,
+// made of the ID of the beat process and a sequence number shared by all the
+// clients. The metadata of the event is copied, as it can be shared with
+// other events.
+func newIdempotencyKeyProcessor(info beat.Info, seq *atomic.Uint64) *processorFn {
+	prefix := info.EphemeralID.String() + "-"
+	return newAnnotateProcessor("idempotencyKey", func(event *beat.Event) {
+		if _, err := event.Meta.GetValue(events.FieldMetaIdempotencyKey); err == nil {
+			return
+		}
+		meta := event.Meta.Clone()
+		meta[events.FieldMetaIdempotencyKey] = prefix + strconv.FormatUint(seq.Inc(), 10)
+		event.Meta = meta
+	})
+}
+
 func makeAddDynMetaProcessor(
 	name string,
 	meta *mapstr.Pointer,
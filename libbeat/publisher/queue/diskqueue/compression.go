@@ -18,15 +18,43 @@
 package diskqueue
 
 import (
+	"fmt"
 	"io"
 
+	"github.com/klauspost/compress/zstd"
 	lz4V4 "github.com/pierrec/lz4/v4"
 )
 
-// CompressionReader allows reading a stream compressed with LZ4
+// CompressionCodec selects the algorithm used to compress the segments.
+type CompressionCodec string
+
+const (
+	CompressionLZ4  CompressionCodec = "lz4"
+	CompressionZSTD CompressionCodec = "zstd"
+)
+
+// lz4Levels maps the compression levels 1 to 9 to the LZ4 ones, level 0
+// being the fast default.
+var lz4Levels = []lz4V4.CompressionLevel{
+	lz4V4.Fast,
+	lz4V4.Level1, lz4V4.Level2, lz4V4.Level3,
+	lz4V4.Level4, lz4V4.Level5, lz4V4.Level6,
+	lz4V4.Level7, lz4V4.Level8, lz4V4.Level9,
+}
+
+// maxCompressionLevel returns the highest level supported by the codec.
+func (c CompressionCodec) maxCompressionLevel() int {
+	if c == CompressionZSTD {
+		return 22
+	}
+	return len(lz4Levels) - 1
+}
+
+// CompressionReader allows reading a stream compressed with LZ4 or zstd
 type CompressionReader struct {
-	src        io.ReadCloser
-	pLZ4Reader *lz4V4.Reader
+	src         io.ReadCloser
+	pLZ4Reader  *lz4V4.Reader
+	pZSTDReader *zstd.Decoder
 }
 
 // NewCompressionReader returns a new LZ4 frame decoder
@@ -38,25 +66,53 @@ func NewCompressionReader(r io.ReadCloser) *CompressionReader {
 	}
 }
 
+// newCompressionReaderForCodec returns a decoder of the streams compressed
+// with the given codec.
+func newCompressionReaderForCodec(r io.ReadCloser, codec CompressionCodec) (*CompressionReader, error) {
+	if codec != CompressionZSTD {
+		return NewCompressionReader(r), nil
+	}
+	// A single goroutine decodes the stream synchronously, segments are
+	// read one at a time.
+	zr, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create zstd decoder: %w", err)
+	}
+	return &CompressionReader{
+		src:         r,
+		pZSTDReader: zr,
+	}, nil
+}
+
 func (r *CompressionReader) Read(buf []byte) (int, error) {
+	if r.pZSTDReader != nil {
+		return r.pZSTDReader.Read(buf)
+	}
 	return r.pLZ4Reader.Read(buf)
 }
 
 func (r *CompressionReader) Close() error {
+	if r.pZSTDReader != nil {
+		r.pZSTDReader.Close()
+	}
 	return r.src.Close()
 }
 
 // Reset Sets up compression again, assumes that caller has already set
 // the src to the correct position
 func (r *CompressionReader) Reset() error {
+	if r.pZSTDReader != nil {
+		return r.pZSTDReader.Reset(r.src)
+	}
 	r.pLZ4Reader.Reset(r.src)
 	return nil
 }
 
-// CompressionWriter allows writing an LZ4 stream
+// CompressionWriter allows writing an LZ4 or zstd stream
 type CompressionWriter struct {
-	dst        WriteCloseSyncer
-	pLZ4Writer *lz4V4.Writer
+	dst         WriteCloseSyncer
+	pLZ4Writer  *lz4V4.Writer
+	pZSTDWriter *zstd.Encoder
 }
 
 // NewCompressionWriter returns a new LZ4 frame encoder
@@ -68,12 +124,48 @@ func NewCompressionWriter(w WriteCloseSyncer) *CompressionWriter {
 	}
 }
 
+// newCompressionWriterForCodec returns an encoder compressing with the
+// given codec and level. Level 0 selects the default level of the codec.
+func newCompressionWriterForCodec(w WriteCloseSyncer, codec CompressionCodec, level int) (*CompressionWriter, error) {
+	if level < 0 || level > codec.maxCompressionLevel() {
+		return nil, fmt.Errorf("unsupported %v compression level %d", codec, level)
+	}
+	if codec != CompressionZSTD {
+		cw := NewCompressionWriter(w)
+		if err := cw.pLZ4Writer.Apply(lz4V4.CompressionLevelOption(lz4Levels[level])); err != nil {
+			return nil, fmt.Errorf("couldn't set lz4 compression level: %w", err)
+		}
+		return cw, nil
+	}
+
+	opts := []zstd.EOption{zstd.WithEncoderConcurrency(1)}
+	if level > 0 {
+		opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	}
+	zw, err := zstd.NewWriter(w, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create zstd encoder: %w", err)
+	}
+	return &CompressionWriter{
+		dst:         w,
+		pZSTDWriter: zw,
+	}, nil
+}
+
 func (w *CompressionWriter) Write(p []byte) (int, error) {
+	if w.pZSTDWriter != nil {
+		return w.pZSTDWriter.Write(p)
+	}
 	return w.pLZ4Writer.Write(p)
 }
 
 func (w *CompressionWriter) Close() error {
-	err := w.pLZ4Writer.Close()
+	var err error
+	if w.pZSTDWriter != nil {
+		err = w.pZSTDWriter.Close()
+	} else {
+		err = w.pLZ4Writer.Close()
+	}
 	if err != nil {
 		return err
 	}
@@ -81,6 +173,12 @@ func (w *CompressionWriter) Close() error {
 }
 
 func (w *CompressionWriter) Sync() error {
-	w.pLZ4Writer.Flush()
+	if w.pZSTDWriter != nil {
+		if err := w.pZSTDWriter.Flush(); err != nil {
+			return err
+		}
+	} else {
+		w.pLZ4Writer.Flush()
+	}
 	return w.dst.Sync()
 }
@@ -158,3 +158,44 @@ func TestCompressionSync(t *testing.T) {
 		assert.Equal(t, tc.plaintext, dst.Bytes()[len(tc.plaintext):], name)
 	}
 }
+
+func TestCompressionCodecRoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		codec CompressionCodec
+		level int
+	}{
+		"lz4 default":   {codec: CompressionLZ4},
+		"lz4 level 9":   {codec: CompressionLZ4, level: 9},
+		"zstd default":  {codec: CompressionZSTD},
+		"zstd level 19": {codec: CompressionZSTD, level: 19},
+	}
+	plaintext := bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog "), 64)
+	for name, tc := range tests {
+		pr, pw := io.Pipe()
+		var dst bytes.Buffer
+		go func() {
+			cw, err := newCompressionWriterForCodec(NopWriteCloseSyncer(pw), tc.codec, tc.level)
+			assert.Nil(t, err, name)
+			_, err = cw.Write(plaintext)
+			assert.Nil(t, err, name)
+			// Data written after a Sync must be readable as well.
+			assert.Nil(t, cw.Sync(), name)
+			_, err = cw.Write(plaintext)
+			assert.Nil(t, err, name)
+			cw.Close()
+		}()
+		cr, err := newCompressionReaderForCodec(pr, tc.codec)
+		assert.Nil(t, err, name)
+		_, err = io.Copy(&dst, cr)
+		assert.Nil(t, err, name)
+		assert.Equal(t, append(plaintext, plaintext...), dst.Bytes(), name)
+		cr.Close()
+	}
+}
+
+func TestCompressionLevelRange(t *testing.T) {
+	_, err := newCompressionWriterForCodec(NopWriteCloseSyncer(NopWriteCloser(io.Discard)), CompressionLZ4, 10)
+	assert.Error(t, err)
+	_, err = newCompressionWriterForCodec(NopWriteCloseSyncer(NopWriteCloser(io.Discard)), CompressionZSTD, 23)
+	assert.Error(t, err)
+}
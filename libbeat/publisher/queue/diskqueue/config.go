@@ -26,6 +26,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
 	"github.com/elastic/beats/v7/libbeat/publisher/queue"
 	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/monitoring"
 	"github.com/elastic/elastic-agent-libs/paths"
 )
 
@@ -72,9 +73,19 @@ type Settings struct {
 	// EncryptionKey is used to encrypt data if SchemaVersion 2 is used.
 	EncryptionKey []byte
 
-	// UseCompression enables or disables LZ4 compression
+	// UseCompression enables or disables compression
 	UseCompression bool
 
+	// CompressionCodec selects the compression algorithm, LZ4 by default.
+	CompressionCodec CompressionCodec
+
+	// CompressionLevel is the level of the compression codec. 0 selects
+	// the default level of the codec.
+	CompressionLevel int
+
+	// Registry receives the compression metrics of the queue, if it is set.
+	Registry *monitoring.Registry
+
 	// UseProtobuf enables protobuf serialization instead of CBOR
 	UseProtobuf bool
 }
@@ -91,6 +102,9 @@ type userConfig struct {
 
 	RetryInterval    *time.Duration `config:"retry_interval" validate:"positive"`
 	MaxRetryInterval *time.Duration `config:"max_retry_interval" validate:"positive"`
+
+	Compression      string `config:"compression"`
+	CompressionLevel int    `config:"compression_level"`
 }
 
 func (c *userConfig) Validate() error {
@@ -123,6 +137,23 @@ func (c *userConfig) Validate() error {
 			*c.MaxRetryInterval, *c.RetryInterval)
 	}
 
+	switch codec := CompressionCodec(c.Compression); codec {
+	case "", "none":
+		if c.CompressionLevel != 0 {
+			return errors.New(
+				"disk queue compression_level requires compression to be enabled")
+		}
+	case CompressionLZ4, CompressionZSTD:
+		if c.CompressionLevel < 0 || c.CompressionLevel > codec.maxCompressionLevel() {
+			return fmt.Errorf(
+				"disk queue compression_level (%d) must be between 0 and %d for %v",
+				c.CompressionLevel, codec.maxCompressionLevel(), codec)
+		}
+	default:
+		return fmt.Errorf(
+			"disk queue compression (%v) must be one of none, lz4 or zstd", c.Compression)
+	}
+
 	return nil
 }
 
@@ -174,6 +205,12 @@ func SettingsForUserConfig(config *config.C) (Settings, error) {
 		settings.MaxRetryInterval = *userConfig.RetryInterval
 	}
 
+	if userConfig.Compression != "" && userConfig.Compression != "none" {
+		settings.UseCompression = true
+		settings.CompressionCodec = CompressionCodec(userConfig.Compression)
+		settings.CompressionLevel = userConfig.CompressionLevel
+	}
+
 	return settings, nil
 }
 
@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package diskqueue
+
+import (
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// compressionMetrics reports how much the compression of the segments
+// reduces the data written to disk.
+type compressionMetrics struct {
+	// The bytes written to the segments before and after compression.
+	inputBytes  *monitoring.Uint
+	outputBytes *monitoring.Uint
+
+	// The ratio of the input bytes to the output bytes.
+	ratio *monitoring.Float
+}
+
+// newCompressionMetrics registers the compression metrics in the
+// queue.compression namespace of the registry. The variables already
+// registered by a previous queue are reused. It returns nil when there is no
+// registry.
+func newCompressionMetrics(reg *monitoring.Registry) *compressionMetrics {
+	if reg == nil {
+		return nil
+	}
+	return &compressionMetrics{
+		inputBytes:  uintVar(reg, "queue.compression.input_bytes"),
+		outputBytes: uintVar(reg, "queue.compression.output_bytes"),
+		ratio:       floatVar(reg, "queue.compression.ratio"),
+	}
+}
+
+func (m *compressionMetrics) add(in, out uint64) {
+	m.inputBytes.Add(in)
+	m.outputBytes.Add(out)
+	if total := m.outputBytes.Get(); total > 0 {
+		m.ratio.Set(float64(m.inputBytes.Get()) / float64(total))
+	}
+}
+
+func uintVar(reg *monitoring.Registry, name string) *monitoring.Uint {
+	if v, ok := reg.Get(name).(*monitoring.Uint); ok {
+		return v
+	}
+	return monitoring.NewUint(reg, name)
+}
+
+func floatVar(reg *monitoring.Registry, name string) *monitoring.Float {
+	if v, ok := reg.Get(name).(*monitoring.Float); ok {
+		return v
+	}
+	return monitoring.NewFloat(reg, name)
+}
@@ -153,7 +153,7 @@ func NewQueue(logger *logp.Logger, settings Settings) (*diskQueue, error) {
 
 	// Index any existing data segments to be placed in segments.reading.
 	initialSegments, err :=
-		scanExistingSegments(logger, settings.directoryPath())
+		scanExistingSegments(logger, settings)
 	if err != nil {
 		return nil, err
 	}
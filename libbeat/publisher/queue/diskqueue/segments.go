@@ -158,8 +158,14 @@ const (
 	ENABLE_ENCRYPTION  uint32 = 1 << iota // 0x1
 	ENABLE_COMPRESSION                    // 0x2
 	ENABLE_PROTOBUF                       // 0x4
+	ENABLE_ZSTD                           // 0x8
 )
 
+// encodedOptions are the options for which the data region of the segment
+// is not stored as it is, so positions in the segment are offsets in the
+// decoded data.
+const encodedOptions = ENABLE_ENCRYPTION | ENABLE_COMPRESSION | ENABLE_ZSTD
+
 // Sort order: we store loaded segments in ascending order by their id.
 type bySegmentID []*queueSegment
 
@@ -167,9 +173,10 @@ func (s bySegmentID) Len() int           { return len(s) }
 func (s bySegmentID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 func (s bySegmentID) Less(i, j int) bool { return s[i].id < s[j].id }
 
-// Scan the given path for segment files, and return them in a list
+// Scan the queue directory for segment files, and return them in a list
 // ordered by segment id.
-func scanExistingSegments(logger *logp.Logger, pathStr string) ([]*queueSegment, error) {
+func scanExistingSegments(logger *logp.Logger, settings Settings) ([]*queueSegment, error) {
+	pathStr := settings.directoryPath()
 	files, err := ioutil.ReadDir(pathStr)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't read queue directory '%s': %w", pathStr, err)
@@ -196,12 +203,25 @@ func scanExistingSegments(logger *logp.Logger, pathStr string) ([]*queueSegment,
 						"error loading segment file '%v', data may be incomplete: %v",
 						fullPath, err)
 				}
-				segments = append(segments, &queueSegment{
+				segment := &queueSegment{
 					id:            segmentID(id),
 					schemaVersion: &header.version,
 					frameCount:    header.frameCount,
 					byteCount:     uint64(file.Size()),
-				})
+				}
+				if header.options&encodedOptions != 0 {
+					err := segment.scanEncodedData(settings)
+					if segment.frameCount == 0 {
+						logger.Errorf("couldn't load segment file '%v': %v", fullPath, err)
+						continue
+					}
+					if err != nil {
+						logger.Warnf(
+							"error loading segment file '%v', data may be incomplete: %v",
+							fullPath, err)
+					}
+				}
+				segments = append(segments, segment)
 			}
 		}
 	}
@@ -265,11 +285,15 @@ func (segment *queueSegment) getReader(queueSettings Settings) (*segmentReader,
 			return nil, fmt.Errorf("couldn't create encryption reader: %w", err)
 		}
 	}
-	if (header.options & ENABLE_COMPRESSION) == ENABLE_COMPRESSION {
+	if codec, ok := header.compressionCodec(); ok {
+		var src io.ReadCloser = sr.src
 		if sr.er != nil {
-			sr.cr = NewCompressionReader(sr.er)
-		} else {
-			sr.cr = NewCompressionReader(sr.src)
+			src = sr.er
+		}
+		sr.cr, err = newCompressionReaderForCodec(src, codec)
+		if err != nil {
+			src.Close()
+			return nil, fmt.Errorf("couldn't create compression reader: %w", err)
 		}
 	}
 	return sr, nil
@@ -294,7 +318,11 @@ func (segment *queueSegment) getWriter(queueSettings Settings) (*segmentWriter,
 	}
 
 	if queueSettings.UseCompression {
-		options = options | ENABLE_COMPRESSION
+		if queueSettings.CompressionCodec == CompressionZSTD {
+			options = options | ENABLE_ZSTD
+		} else {
+			options = options | ENABLE_COMPRESSION
+		}
 	}
 
 	if queueSettings.UseProtobuf {
@@ -305,6 +333,7 @@ func (segment *queueSegment) getWriter(queueSettings Settings) (*segmentWriter,
 	sw.dst = file
 
 	if err := sw.WriteHeader(options); err != nil {
+		sw.dst.Close()
 		return nil, err
 	}
 
@@ -316,11 +345,18 @@ func (segment *queueSegment) getWriter(queueSettings Settings) (*segmentWriter,
 		}
 	}
 
-	if (options & ENABLE_COMPRESSION) == ENABLE_COMPRESSION {
+	if queueSettings.UseCompression {
+		var dst WriteCloseSyncer = sw.dst
 		if sw.ew != nil {
-			sw.cw = NewCompressionWriter(sw.ew)
-		} else {
-			sw.cw = NewCompressionWriter(sw.dst)
+			dst = sw.ew
+		}
+		// Count the compressed bytes for the compression ratio.
+		sw.compressed = &countingWriter{WriteCloseSyncer: dst}
+		sw.cw, err = newCompressionWriterForCodec(
+			sw.compressed, queueSettings.CompressionCodec, queueSettings.CompressionLevel)
+		if err != nil {
+			dst.Close()
+			return nil, fmt.Errorf("couldn't create compression writer: %w", err)
 		}
 	}
 
@@ -368,8 +404,10 @@ func readSegmentHeaderWithFrameCount(path string) (*segmentHeader, error) {
 		return nil, err
 	}
 	// If the header has a positive frame count then there is
-	// no more work to do, so return immediately.
-	if header.frameCount > 0 {
+	// no more work to do, so return immediately. The frames of
+	// compressed or encrypted segments can't be scanned from the raw
+	// file, they are counted by scanEncodedData instead.
+	if header.frameCount > 0 || header.options&encodedOptions != 0 {
 		return header, nil
 	}
 	// If we made it here, we loaded a valid header but the frame count is
@@ -422,6 +460,62 @@ func readSegmentHeaderWithFrameCount(path string) (*segmentHeader, error) {
 	return nil, err
 }
 
+// scanEncodedData reads the frames of a compressed or encrypted segment
+// through its decoder. It sets the byte count of the segment to the size of
+// the decoded data, which the read positions refer to, and its frame count
+// if the header doesn't have it. As with readSegmentHeaderWithFrameCount,
+// the frames scanned before an error are kept.
+func (segment *queueSegment) scanEncodedData(settings Settings) error {
+	handle, err := segment.getReader(settings)
+	if err != nil {
+		return err
+	}
+	defer handle.Close()
+
+	reader := autoRetryReader{handle}
+	byteCount := segment.headerSize()
+	frameCount := uint32(0)
+	for {
+		var frameLength uint32
+		err = binary.Read(reader, binary.LittleEndian, &frameLength)
+		if err != nil {
+			// EOF at a frame boundary means we successfully scanned all frames.
+			if errors.Is(err, io.EOF) && frameCount > 0 {
+				err = nil
+			}
+			break
+		}
+		if frameLength <= frameMetadataSize {
+			err = fmt.Errorf("invalid frame length %v", frameLength)
+			break
+		}
+		_, err = io.CopyN(io.Discard, reader, int64(frameLength-frameMetadataSize))
+		if err != nil {
+			break
+		}
+		var checksum, duplicateLength uint32
+		err = binary.Read(reader, binary.LittleEndian, &checksum)
+		if err == nil {
+			err = binary.Read(reader, binary.LittleEndian, &duplicateLength)
+		}
+		if err != nil {
+			break
+		}
+		if frameLength != duplicateLength {
+			err = fmt.Errorf(
+				"mismatched frame length: %v vs %v", frameLength, duplicateLength)
+			break
+		}
+		frameCount++
+		byteCount += uint64(frameLength)
+	}
+	segment.byteCount = byteCount
+	if segment.frameCount == 0 {
+		segment.frameCount = frameCount
+	}
+	return err
+}
+
 // readSegmentHeader decodes a raw header from the given reader and
 // returns it as a struct.
 func readSegmentHeader(in io.Reader) (*segmentHeader, error) {
@@ -451,6 +545,18 @@ func readSegmentHeader(in io.Reader) (*segmentHeader, error) {
 	return header, nil
 }
 
+// compressionCodec returns the codec the data of the segment is compressed
+// with, if it is compressed.
+func (header *segmentHeader) compressionCodec() (CompressionCodec, bool) {
+	switch {
+	case header.options&ENABLE_ZSTD == ENABLE_ZSTD:
+		return CompressionZSTD, true
+	case header.options&ENABLE_COMPRESSION == ENABLE_COMPRESSION:
+		return CompressionLZ4, true
+	}
+	return "", false
+}
+
 // The number of bytes occupied by all the queue's segment files. This
 // should only be called from the core loop.
 func (segments *diskQueueSegments) sizeOnDisk() uint64 {
@@ -553,11 +659,21 @@ type segmentWriter struct {
 	dst *os.File
 	ew  *EncryptionWriter
 	cw  *CompressionWriter
+
+	// compressed counts the bytes output by the CompressionWriter and
+	// uncompressed the bytes written to it. The difference since the
+	// last report is added to metrics when the segment is synced.
+	compressed   *countingWriter
+	uncompressed uint64
+	reported     struct{ in, out uint64 }
+	metrics      *compressionMetrics
 }
 
 func (w *segmentWriter) Write(p []byte) (int, error) {
 	if w.cw != nil {
-		return w.cw.Write(p)
+		n, err := w.cw.Write(p)
+		w.uncompressed += uint64(n)
+		return n, err
 	}
 	if w.ew != nil {
 		return w.ew.Write(p)
@@ -567,7 +683,9 @@ func (w *segmentWriter) Write(p []byte) (int, error) {
 
 func (w *segmentWriter) Close() error {
 	if w.cw != nil {
-		return w.cw.Close()
+		err := w.cw.Close()
+		w.reportCompression()
+		return err
 	}
 	if w.ew != nil {
 		return w.ew.Close()
@@ -577,7 +695,9 @@ func (w *segmentWriter) Close() error {
 
 func (w *segmentWriter) Sync() error {
 	if w.cw != nil {
-		return w.cw.Sync()
+		err := w.cw.Sync()
+		w.reportCompression()
+		return err
 	}
 	if w.ew != nil {
 		return w.ew.Sync()
@@ -585,6 +705,17 @@ func (w *segmentWriter) Sync() error {
 	return w.dst.Sync()
 }
 
+// reportCompression adds the bytes compressed since the last report to the
+// compression metrics. The compressed count is only accurate once the
+// CompressionWriter has been flushed.
+func (w *segmentWriter) reportCompression() {
+	if w.metrics == nil || w.compressed == nil {
+		return
+	}
+	w.metrics.add(w.uncompressed-w.reported.in, w.compressed.count-w.reported.out)
+	w.reported.in, w.reported.out = w.uncompressed, w.compressed.count
+}
+
 func (w *segmentWriter) WriteHeader(options uint32) error {
 	_, err := w.dst.Seek(0, io.SeekStart)
 	if err != nil {
@@ -612,6 +743,18 @@ func (w *segmentWriter) WriteHeader(options uint32) error {
 	return nil
 }
 
+// countingWriter counts the bytes written to the wrapped writer.
+type countingWriter struct {
+	WriteCloseSyncer
+	count uint64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.WriteCloseSyncer.Write(p)
+	w.count += uint64(n)
+	return n, err
+}
+
 func (w *segmentWriter) UpdateCount(count uint32) error {
 	//get current offset
 	offset, err := w.dst.Seek(0, io.SeekCurrent)
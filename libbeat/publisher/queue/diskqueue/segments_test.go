@@ -18,10 +18,16 @@
 package diskqueue
 
 import (
+	"bytes"
+	"encoding/binary"
 	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
 )
 
 func TestSegmentsRoundTrip(t *testing.T) {
@@ -231,3 +237,69 @@ func TestSegmentReaderSeekLocations(t *testing.T) {
 		assert.NotNil(t, err, name)
 	}
 }
+
+func TestScanEncodedSegments(t *testing.T) {
+	tests := map[string]struct {
+		encrypt bool
+		codec   CompressionCodec
+		closed  bool
+	}{
+		"LZ4":                           {codec: CompressionLZ4, closed: true},
+		"ZSTD":                          {codec: CompressionZSTD, closed: true},
+		"ZSTD not closed":               {codec: CompressionZSTD},
+		"Encryption and ZSTD":           {encrypt: true, codec: CompressionZSTD, closed: true},
+		"Encryption only not closed":    {encrypt: true},
+		"Encryption and LZ4 not closed": {encrypt: true, codec: CompressionLZ4},
+	}
+	frames := [][]byte{
+		bytes.Repeat([]byte("a"), 100),
+		bytes.Repeat([]byte("b"), 200),
+	}
+	for name, tc := range tests {
+		settings := DefaultSettings()
+		settings.Path = t.TempDir()
+		if tc.encrypt {
+			settings.EncryptionKey = []byte("keykeykeykeykeyk")
+		}
+		settings.UseCompression = tc.codec != ""
+		settings.CompressionCodec = tc.codec
+		settings.Registry = monitoring.NewRegistry()
+
+		qs := &queueSegment{id: 0}
+		sw, err := qs.getWriter(settings)
+		require.NoError(t, err, name)
+		sw.metrics = newCompressionMetrics(settings.Registry)
+		expectedSize := uint64(segmentHeaderSize)
+		for _, frame := range frames {
+			frameSize := uint32(len(frame) + frameMetadataSize)
+			require.NoError(t, binary.Write(sw, binary.LittleEndian, frameSize), name)
+			_, err = sw.Write(frame)
+			require.NoError(t, err, name)
+			require.NoError(t, binary.Write(sw, binary.LittleEndian, computeChecksum(frame)), name)
+			require.NoError(t, binary.Write(sw, binary.LittleEndian, frameSize), name)
+			expectedSize += uint64(frameSize)
+		}
+		require.NoError(t, sw.Sync(), name)
+		if tc.closed {
+			require.NoError(t, sw.UpdateCount(uint32(len(frames))), name)
+			require.NoError(t, sw.Close(), name)
+		} else {
+			// Simulate a crash: the data was synced but the streams were
+			// not terminated and the frame count was not written.
+			require.NoError(t, sw.dst.Close(), name)
+		}
+
+		segments, err := scanExistingSegments(logp.NewLogger("test"), settings)
+		require.NoError(t, err, name)
+		require.Len(t, segments, 1, name)
+		assert.Equal(t, uint32(len(frames)), segments[0].frameCount, name)
+		assert.Equal(t, expectedSize, segments[0].byteCount, name)
+
+		if settings.UseCompression {
+			input := settings.Registry.Get("queue.compression.input_bytes").(*monitoring.Uint).Get()
+			assert.Equal(t, expectedSize-segmentHeaderSize, input, name)
+			ratio := settings.Registry.Get("queue.compression.ratio").(*monitoring.Float).Get()
+			assert.Greater(t, ratio, 1.0, name)
+		}
+	}
+}
@@ -94,11 +94,15 @@ type writerLoop struct {
 
 	// buffer Used to gather write information so there is only one write syscall
 	buffer *bytes.Buffer
+
+	// compressionMetrics is set when the segments are compressed and the
+	// queue has a metrics registry.
+	compressionMetrics *compressionMetrics
 }
 
 func newWriterLoop(logger *logp.Logger, settings Settings) *writerLoop {
 	buffer := &bytes.Buffer{}
-	return &writerLoop{
+	wl := &writerLoop{
 		logger:   logger,
 		settings: settings,
 
@@ -108,6 +112,10 @@ func newWriterLoop(logger *logp.Logger, settings Settings) *writerLoop {
 		currentRetryInterval: settings.RetryInterval,
 		buffer:               buffer,
 	}
+	if settings.UseCompression {
+		wl.compressionMetrics = newCompressionMetrics(settings.Registry)
+	}
+	return wl
 }
 
 func (wl *writerLoop) run() {
@@ -180,6 +188,7 @@ outerLoop:
 			// We're creating a new segment file, set the initial bytes written
 			// to the header size.
 			curSegmentResponse.bytesWritten = wl.currentSegment.headerSize()
+			file.metrics = wl.compressionMetrics
 			wl.outputFile = file
 		}
 		// Make sure our writer points to the current file handle.
@@ -0,0 +1,225 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package logfmt decodes logfmt formatted messages, as emitted by many Go
+// services and Heroku, into event fields.
+package logfmt
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// DuplicateKeys selects how keys that appear more than once in a message are
+// handled.
+type DuplicateKeys int
+
+const (
+	// DuplicateKeysLast keeps the last value of a key.
+	DuplicateKeysLast DuplicateKeys = iota
+	// DuplicateKeysFirst keeps the first value of a key.
+	DuplicateKeysFirst
+	// DuplicateKeysArray collects all values of a key into an array.
+	DuplicateKeysArray
+)
+
+// Unpack will unpack value into a DuplicateKeys mode.
+func (d *DuplicateKeys) Unpack(value string) error {
+	switch value {
+	case "last":
+		*d = DuplicateKeysLast
+	case "first":
+		*d = DuplicateKeysFirst
+	case "array":
+		*d = DuplicateKeysArray
+	default:
+		return fmt.Errorf("invalid duplicate_keys mode: %q", value)
+	}
+	return nil
+}
+
+// DecodeConfig stores the options used to decode logfmt messages.
+type DecodeConfig struct {
+	// If true, unquoted numbers and booleans are converted to their types.
+	InferTypes bool `config:"infer_types"`
+	// How to handle keys that appear more than once.
+	DuplicateKeys DuplicateKeys `config:"duplicate_keys"`
+}
+
+// DefaultDecodeConfig returns a DecodeConfig with default values.
+func DefaultDecodeConfig() DecodeConfig {
+	return DecodeConfig{
+		InferTypes:    true,
+		DuplicateKeys: DuplicateKeysLast,
+	}
+}
+
+// Decode decodes a logfmt message into fields. Keys without a value are set
+// to true. Even if an error is returned, fields contains the pairs decoded
+// before the error was encountered.
+func Decode(data []byte, cfg DecodeConfig) (mapstr.M, error) {
+	fields, _, err := decode(data, cfg, "")
+	return fields, err
+}
+
+// decode decodes a logfmt message into fields. If rawKey is set, the value of
+// the key is also returned as it appears in the message, before type
+// inference, respecting the duplicate keys mode.
+func decode(data []byte, cfg DecodeConfig, rawKey string) (fields mapstr.M, raw *string, err error) {
+	fields = mapstr.M{}
+	pos := 0
+	for {
+		for pos < len(data) && isSpace(data[pos]) {
+			pos++
+		}
+		if pos == len(data) {
+			return fields, raw, nil
+		}
+
+		start := pos
+		for pos < len(data) && isKeyChar(data[pos]) {
+			pos++
+		}
+		if pos == start {
+			return fields, raw, fmt.Errorf("unexpected %q at position %d", data[pos], pos)
+		}
+		key := string(data[start:pos])
+
+		if pos == len(data) || data[pos] != '=' {
+			if pos < len(data) && !isSpace(data[pos]) {
+				return fields, raw, fmt.Errorf("unexpected %q at position %d", data[pos], pos)
+			}
+			addValue(fields, key, true, cfg.DuplicateKeys)
+			continue
+		}
+		pos++
+
+		var value interface{}
+		if pos < len(data) && data[pos] == '"' {
+			s, n, err := readQuoted(data[pos:])
+			if err != nil {
+				return fields, raw, fmt.Errorf("invalid value of key %q: %w", key, err)
+			}
+			value = s
+			pos += n
+			if key == rawKey && (raw == nil || cfg.DuplicateKeys != DuplicateKeysFirst) {
+				raw = &s
+			}
+		} else {
+			start = pos
+			for pos < len(data) && !isSpace(data[pos]) {
+				if data[pos] == '"' || data[pos] == '=' {
+					return fields, raw, fmt.Errorf("unexpected %q at position %d", data[pos], pos)
+				}
+				pos++
+			}
+			text := string(data[start:pos])
+			if key == rawKey && (raw == nil || cfg.DuplicateKeys != DuplicateKeysFirst) {
+				raw = &text
+			}
+			value = text
+			if cfg.InferTypes {
+				value = inferType(text)
+			}
+		}
+		addValue(fields, key, value, cfg.DuplicateKeys)
+	}
+}
+
+// readQuoted reads the quoted string at the start of data and returns the
+// unquoted value and the number of bytes consumed.
+func readQuoted(data []byte) (string, int, error) {
+	escaped := false
+	for i := 1; i < len(data); i++ {
+		switch {
+		case escaped:
+			escaped = false
+		case data[i] == '\\':
+			escaped = true
+		case data[i] == '"':
+			s, err := strconv.Unquote(string(data[:i+1]))
+			if err != nil {
+				return "", 0, err
+			}
+			return s, i + 1, nil
+		}
+	}
+	return "", 0, errors.New("unterminated quoted string")
+}
+
+func inferType(s string) interface{} {
+	switch s {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "":
+		return s
+	}
+
+	// only consider values that look like numbers, as strconv also accepts
+	// values such as 'inf' or 'nan'.
+	c := s[0]
+	if c == '-' || c == '+' {
+		if len(s) == 1 {
+			return s
+		}
+		c = s[1]
+	}
+	if c < '0' || c > '9' {
+		return s
+	}
+
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return s
+}
+
+func addValue(fields mapstr.M, key string, value interface{}, mode DuplicateKeys) {
+	current, exists := fields[key]
+	if !exists {
+		fields[key] = value
+		return
+	}
+
+	switch mode {
+	case DuplicateKeysFirst:
+	case DuplicateKeysArray:
+		if values, ok := current.([]interface{}); ok {
+			fields[key] = append(values, value)
+		} else {
+			fields[key] = []interface{}{current, value}
+		}
+	default:
+		fields[key] = value
+	}
+}
+
+func isSpace(c byte) bool {
+	return c <= ' '
+}
+
+func isKeyChar(c byte) bool {
+	return c > ' ' && c != '=' && c != '"'
+}
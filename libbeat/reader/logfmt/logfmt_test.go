@@ -0,0 +1,177 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logfmt
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/reader"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestDecode(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		config   DecodeConfig
+		expected mapstr.M
+		err      bool
+	}{
+		"simple pairs with types": {
+			input:  `level=info msg="Request served" status=200 took=1.5 cached=false path=/api`,
+			config: DefaultDecodeConfig(),
+			expected: mapstr.M{
+				"level":  "info",
+				"msg":    "Request served",
+				"status": int64(200),
+				"took":   1.5,
+				"cached": false,
+				"path":   "/api",
+			},
+		},
+		"types not inferred": {
+			input:    `status=200 cached=false`,
+			config:   DecodeConfig{InferTypes: false},
+			expected: mapstr.M{"status": "200", "cached": "false"},
+		},
+		"quoted values are strings": {
+			input:    `id="42" msg="say \"hi\"\n" empty= nan=NaN`,
+			config:   DefaultDecodeConfig(),
+			expected: mapstr.M{"id": "42", "msg": "say \"hi\"\n", "empty": "", "nan": "NaN"},
+		},
+		"bare keys": {
+			input:    `debug at=request`,
+			config:   DefaultDecodeConfig(),
+			expected: mapstr.M{"debug": true, "at": "request"},
+		},
+		"duplicate keys keep last": {
+			input:    `tag=a tag=b`,
+			config:   DecodeConfig{DuplicateKeys: DuplicateKeysLast},
+			expected: mapstr.M{"tag": "b"},
+		},
+		"duplicate keys keep first": {
+			input:    `tag=a tag=b`,
+			config:   DecodeConfig{DuplicateKeys: DuplicateKeysFirst},
+			expected: mapstr.M{"tag": "a"},
+		},
+		"duplicate keys as array": {
+			input:    `tag=a tag=b tag=c`,
+			config:   DecodeConfig{DuplicateKeys: DuplicateKeysArray},
+			expected: mapstr.M{"tag": []interface{}{"a", "b", "c"}},
+		},
+		"unterminated quote": {
+			input:    `level=info msg="oops`,
+			config:   DefaultDecodeConfig(),
+			expected: mapstr.M{"level": "info"},
+			err:      true,
+		},
+		"unexpected equal sign": {
+			input:    `=value`,
+			config:   DefaultDecodeConfig(),
+			expected: mapstr.M{},
+			err:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			fields, err := Decode([]byte(test.input), test.config)
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, fields)
+		})
+	}
+}
+
+type testReader struct {
+	messages [][]byte
+}
+
+func (*testReader) Close() error {
+	return nil
+}
+
+func (t *testReader) Next() (reader.Message, error) {
+	if len(t.messages) == 0 {
+		return reader.Message{}, io.EOF
+	}
+
+	m := reader.Message{Content: t.messages[0], Bytes: len(t.messages[0])}
+	t.messages = t.messages[1:]
+	return m, nil
+}
+
+func TestParserMessageKey(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		config    DecodeConfig
+		expected  string
+		fieldsMsg interface{}
+	}{
+		"string value": {
+			input:     `level=info msg="Request served"`,
+			config:    DefaultDecodeConfig(),
+			expected:  "Request served",
+			fieldsMsg: "Request served",
+		},
+		"inferred number keeps its text": {
+			input:     `level=info msg=1.50`,
+			config:    DefaultDecodeConfig(),
+			expected:  "1.50",
+			fieldsMsg: 1.5,
+		},
+		"inferred boolean keeps its text": {
+			input:     `level=info msg=true`,
+			config:    DefaultDecodeConfig(),
+			expected:  "true",
+			fieldsMsg: true,
+		},
+		"first duplicate key": {
+			input:     `msg=200 msg=201`,
+			config:    DecodeConfig{InferTypes: true, DuplicateKeys: DuplicateKeysFirst},
+			expected:  "200",
+			fieldsMsg: int64(200),
+		},
+		"bare key keeps the content": {
+			input:     `level=info msg`,
+			config:    DefaultDecodeConfig(),
+			expected:  `level=info msg`,
+			fieldsMsg: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.DecodeConfig = test.config
+			cfg.MessageKey = "msg"
+
+			p := NewParser(&testReader{messages: [][]byte{[]byte(test.input)}}, &cfg)
+			msg, err := p.Next()
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, string(msg.Content))
+			assert.Equal(t, test.fieldsMsg, msg.Fields["msg"])
+		})
+	}
+}
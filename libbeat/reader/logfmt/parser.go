@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logfmt
+
+import (
+	"github.com/elastic/beats/v7/libbeat/reader"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// Config stores the configuration for the Parser.
+type Config struct {
+	DecodeConfig `config:",inline"`
+	// The field the decoded keys are written to. The keys are added to the
+	// root of the event if empty.
+	Target string `config:"target"`
+	// The decoded key whose value replaces the message content. The value is
+	// used as it appears in the message, independent of type inference.
+	MessageKey string `config:"message_key"`
+	// If true, errors will be logged.
+	LogErrors bool `config:"log_errors"`
+	// If true, errors will be added to the message fields under the error.message field.
+	AddErrorKey bool `config:"add_error_key"`
+}
+
+// DefaultConfig will return a Config with default values.
+func DefaultConfig() Config {
+	return Config{
+		DecodeConfig: DefaultDecodeConfig(),
+		AddErrorKey:  true,
+	}
+}
+
+// Parser is a logfmt parser that implements parser.Parser.
+type Parser struct {
+	cfg    *Config
+	reader reader.Reader
+	logger *logp.Logger
+}
+
+// NewParser creates a new logfmt parser.
+func NewParser(r reader.Reader, cfg *Config) *Parser {
+	return &Parser{
+		cfg:    cfg,
+		reader: r,
+		logger: logp.NewLogger("reader_logfmt"),
+	}
+}
+
+// Close closes this Parser.
+func (p *Parser) Close() error {
+	return p.reader.Close()
+}
+
+// Next reads the next message and decodes the logfmt pairs.
+func (p *Parser) Next() (reader.Message, error) {
+	msg, err := p.reader.Next()
+	if err != nil {
+		return msg, err
+	}
+
+	fields, text, err := decode(msg.Content, p.cfg.DecodeConfig, p.cfg.MessageKey)
+	if err != nil {
+		if p.cfg.LogErrors {
+			p.logger.Errorf("Error decoding logfmt message: %v", err)
+		}
+		if p.cfg.AddErrorKey {
+			msg.AddFields(mapstr.M{"error": mapstr.M{"message": "Error decoding logfmt message: " + err.Error()}})
+		}
+		// keep the original content if the message can't be decoded
+		return msg, nil
+	}
+
+	if text != nil {
+		msg.Content = []byte(*text)
+	}
+
+	if p.cfg.Target != "" {
+		msg.AddFields(mapstr.M{p.cfg.Target: fields})
+	} else {
+		msg.AddFields(fields)
+	}
+	return msg, nil
+}
@@ -0,0 +1,129 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parser
+
+import (
+	"errors"
+
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	"github.com/elastic/beats/v7/libbeat/reader"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// errBypass is returned by the source of a conditional parser chain if a
+// message does not match the condition. The parsers of the chain return
+// buffered messages and pass the error on, such that the message can be
+// forwarded without being parsed.
+var errBypass = errors.New("message bypasses conditional parsers")
+
+type conditionalConfig struct {
+	When    *conditions.Config `config:"when" validate:"required"`
+	Parsers []config.Namespace `config:"parsers" validate:"required"`
+}
+
+// conditionalParser applies a nested chain of parsers to the messages
+// matching a condition. All other messages are passed on unchanged.
+type conditionalParser struct {
+	source *conditionalSource
+	chain  Parser
+}
+
+// conditionalSource is the reader of the nested parser chain.
+type conditionalSource struct {
+	reader    reader.Reader
+	condition conditions.Condition
+
+	// bypassed holds the last message not matching the condition. It is
+	// set before errBypass is passed through the chain, and is read only
+	// once the error is returned by the chain.
+	bypassed reader.Message
+}
+
+func newConditionalConfig(pCfg CommonConfig, cfg *config.C) (conditions.Condition, *Config, error) {
+	var c conditionalConfig
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, nil, err
+	}
+
+	condition, err := conditions.NewCondition(c.When)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chain, err := NewConfig(pCfg, c.Parsers)
+	if err != nil {
+		return nil, nil, err
+	}
+	return condition, chain, nil
+}
+
+func newConditionalParser(in reader.Reader, condition conditions.Condition, chain *Config) *conditionalParser {
+	source := &conditionalSource{reader: in, condition: condition}
+	return &conditionalParser{
+		source: source,
+		chain:  chain.Create(source),
+	}
+}
+
+func (p *conditionalParser) Next() (reader.Message, error) {
+	message, err := p.chain.Next()
+	if errors.Is(err, errBypass) {
+		message = p.source.bypassed
+		p.source.bypassed = reader.Message{}
+		return message, nil
+	}
+	return message, err
+}
+
+func (p *conditionalParser) Close() error {
+	return p.chain.Close()
+}
+
+func (s *conditionalSource) Next() (reader.Message, error) {
+	message, err := s.reader.Next()
+	if err != nil {
+		return message, err
+	}
+
+	if s.condition.Check(messageValues{&message}) {
+		return message, nil
+	}
+	s.bypassed = message
+	return reader.Message{}, errBypass
+}
+
+func (s *conditionalSource) Close() error {
+	return s.reader.Close()
+}
+
+// messageValues exposes the message content as the `message` field to the
+// conditions, next to the fields added by previous parsers.
+type messageValues struct {
+	message *reader.Message
+}
+
+func (v messageValues) GetValue(key string) (interface{}, error) {
+	if key == "message" {
+		return string(v.message.Content), nil
+	}
+	if v.message.Fields == nil {
+		return nil, mapstr.ErrKeyNotFound
+	}
+	return v.message.Fields.GetValue(key)
+}
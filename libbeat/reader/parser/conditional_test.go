@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parser
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/reader/readfile"
+	"github.com/elastic/elastic-agent-libs/config"
+)
+
+func TestConditionalParser(t *testing.T) {
+	cfg := config.MustNewConfigFrom(map[string]interface{}{
+		"parsers": []map[string]interface{}{
+			{
+				"conditional": map[string]interface{}{
+					"when.not.regexp.message": "^{",
+					"parsers": []map[string]interface{}{
+						{
+							"multiline": map[string]interface{}{
+								"type":    "pattern",
+								"pattern": "^[[:space:]]",
+								"match":   "after",
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	var parsersConfig testParsersConfig
+	require.NoError(t, cfg.Unpack(&parsersConfig))
+	c, err := NewConfig(CommonConfig{MaxBytes: 1024, LineTerminator: readfile.AutoLineTerminator}, parsersConfig.Parsers)
+	require.NoError(t, err)
+
+	// filestream strips the line endings before the parsers
+	lines := readfile.NewStripNewline(testReader("{\"id\":1}\nException in thread\n  at foo\n  at bar\n{\"id\":2}\n"), readfile.AutoLineTerminator)
+	p := c.Create(lines)
+	expected := []string{
+		"{\"id\":1}",
+		"Exception in thread\n  at foo\n  at bar",
+		"{\"id\":2}",
+	}
+	for _, e := range expected {
+		msg, err := p.Next()
+		require.NoError(t, err)
+		require.Equal(t, e, string(msg.Content))
+	}
+	_, err = p.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestConditionalParserConfig(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"missing condition": {
+			"parsers": []map[string]interface{}{{"ndjson": nil}},
+		},
+		"missing parsers": {
+			"when.regexp.message": "^{",
+		},
+		"unknown nested parser": {
+			"when.regexp.message": "^{",
+			"parsers":             []map[string]interface{}{{"no_such_parser": nil}},
+		},
+	}
+
+	for name, conditional := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := config.MustNewConfigFrom(map[string]interface{}{
+				"parsers": []map[string]interface{}{{"conditional": conditional}},
+			})
+			var parsersConfig testParsersConfig
+			require.NoError(t, cfg.Unpack(&parsersConfig))
+			_, err := NewConfig(CommonConfig{MaxBytes: 1024, LineTerminator: readfile.AutoLineTerminator}, parsersConfig.Parsers)
+			require.Error(t, err)
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
 	"github.com/elastic/beats/v7/libbeat/reader"
 	"github.com/elastic/beats/v7/libbeat/reader/filter"
+	"github.com/elastic/beats/v7/libbeat/reader/logfmt"
 	"github.com/elastic/beats/v7/libbeat/reader/multiline"
 	"github.com/elastic/beats/v7/libbeat/reader/readfile"
 	"github.com/elastic/beats/v7/libbeat/reader/readjson"
@@ -101,6 +102,13 @@ func NewConfig(pCfg CommonConfig, parsers []config.Namespace) (*Config, error) {
 			if err != nil {
 				return nil, fmt.Errorf("error while parsing ndjson parser config: %w", err)
 			}
+		case "concatenated_json":
+			var config readjson.ConcatenatedConfig
+			cfg := ns.Config()
+			err := cfg.Unpack(&config)
+			if err != nil {
+				return nil, fmt.Errorf("error while parsing concatenated_json parser config: %w", err)
+			}
 		case "container":
 			config := readjson.DefaultContainerConfig()
 			cfg := ns.Config()
@@ -121,6 +129,24 @@ func NewConfig(pCfg CommonConfig, parsers []config.Namespace) (*Config, error) {
 			if err != nil {
 				return nil, fmt.Errorf("error while parsing syslog parser config: %w", err)
 			}
+		case "logfmt":
+			config := logfmt.DefaultConfig()
+			cfg := ns.Config()
+			err := cfg.Unpack(&config)
+			if err != nil {
+				return nil, fmt.Errorf("error while parsing logfmt parser config: %w", err)
+			}
+		case "conditional":
+			_, chain, err := newConditionalConfig(pCfg, ns.Config())
+			if err != nil {
+				return nil, fmt.Errorf("error while parsing conditional parser config: %w", err)
+			}
+			if chain.Suffix != "" {
+				if suffix != "" {
+					return nil, fmt.Errorf("only one stream selection is allowed")
+				}
+				suffix = chain.Suffix
+			}
 		default:
 			return nil, fmt.Errorf("%s: %w", name, ErrNoSuchParser)
 		}
@@ -158,6 +184,14 @@ func (c *Config) Create(in reader.Reader) Parser {
 				return p
 			}
 			p = readjson.NewJSONParser(p, &config)
+		case "concatenated_json":
+			var config readjson.ConcatenatedConfig
+			cfg := ns.Config()
+			err := cfg.Unpack(&config)
+			if err != nil {
+				return p
+			}
+			p = readjson.NewConcatenatedParser(p, &config, int(c.pCfg.MaxBytes))
 		case "container":
 			config := readjson.DefaultContainerConfig()
 			cfg := ns.Config()
@@ -174,6 +208,20 @@ func (c *Config) Create(in reader.Reader) Parser {
 				return p
 			}
 			p = syslog.NewParser(p, &config)
+		case "logfmt":
+			config := logfmt.DefaultConfig()
+			cfg := ns.Config()
+			err := cfg.Unpack(&config)
+			if err != nil {
+				return p
+			}
+			p = logfmt.NewParser(p, &config)
+		case "conditional":
+			condition, chain, err := newConditionalConfig(c.pCfg, ns.Config())
+			if err != nil {
+				return p
+			}
+			p = newConditionalParser(p, condition, chain)
 		case "include_message":
 			config := filter.DefaultConfig()
 			cfg := ns.Config()
@@ -209,6 +209,45 @@ func TestParsersConfigAndReading(t *testing.T) {
 				"Not a valid message",
 			},
 		},
+		"logfmt with message key": {
+			parsers: map[string]interface{}{
+				"parsers": []map[string]interface{}{
+					{
+						"logfmt": map[string]interface{}{
+							"message_key": "msg",
+						},
+					},
+				},
+			},
+			lines: `level=info msg="Starting server" port=8080
+level=error msg="Connection refused" retry=true
+not a "valid" logfmt line`,
+			expectedMessages: []string{
+				"Starting server",
+				"Connection refused",
+				"not a \"valid\" logfmt line",
+			},
+		},
+		"concatenated json": {
+			parsers: map[string]interface{}{
+				"parsers": []map[string]interface{}{
+					{
+						"concatenated_json": map[string]interface{}{
+							"skip_invalid": false,
+						},
+					},
+				},
+			},
+			lines: `{
+  "a": "{"
+}{"b": 2}
+not json`,
+			expectedMessages: []string{
+				"{\n  \"a\": \"{\"\n}",
+				`{"b": 2}`,
+				"not json",
+			},
+		},
 		"multiline syslog": {
 			parsers: map[string]interface{}{
 				"parsers": []map[string]interface{}{
@@ -0,0 +1,227 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package readjson
+
+import (
+	"bytes"
+
+	"github.com/elastic/beats/v7/libbeat/reader"
+)
+
+// ConcatenatedConfig holds the options of the concatenated JSON parser.
+type ConcatenatedConfig struct {
+	// SkipInvalid drops the text found between the objects instead of
+	// publishing it as a message.
+	SkipInvalid bool `config:"skip_invalid"`
+}
+
+// ConcatenatedParser splits a stream of JSON objects concatenated without
+// delimiters, pretty-printed over several lines or following each other on
+// the same line, into one message per object. The objects are found by
+// matching their braces, ignoring the braces in strings, and are not
+// decoded. The text found between the objects is published as is.
+type ConcatenatedParser struct {
+	reader   reader.Reader
+	cfg      *ConcatenatedConfig
+	maxBytes int
+
+	// The messages split from the lines read and not returned yet.
+	ready []reader.Message
+	// The error returned by the reader, returned once the ready messages
+	// are consumed.
+	err error
+
+	// The object or text being accumulated.
+	buf   []byte
+	first reader.Message // the line the accumulated message started in
+	// The bytes read and not accounted to a message yet.
+	pending int
+
+	inObject bool // buf holds an object
+	discard  bool // the rest of an object over maxBytes is skipped
+	depth    int
+	inStr    bool
+	escaped  bool
+}
+
+// NewConcatenatedParser creates a new parser splitting concatenated JSON
+// objects, truncated to maxBytes.
+func NewConcatenatedParser(r reader.Reader, cfg *ConcatenatedConfig, maxBytes int) *ConcatenatedParser {
+	return &ConcatenatedParser{
+		reader:   r,
+		cfg:      cfg,
+		maxBytes: maxBytes,
+	}
+}
+
+// Close closes this Parser.
+func (p *ConcatenatedParser) Close() error {
+	return p.reader.Close()
+}
+
+// Next returns the next JSON object.
+func (p *ConcatenatedParser) Next() (reader.Message, error) {
+	for len(p.ready) == 0 {
+		if p.err != nil {
+			return reader.Message{}, p.err
+		}
+
+		msg, err := p.reader.Next()
+		if err != nil {
+			// Publish the incomplete object or text read so far.
+			p.err = err
+			p.emit(0)
+			continue
+		}
+		p.split(msg)
+	}
+
+	msg := p.ready[0]
+	p.ready[0] = reader.Message{}
+	p.ready = p.ready[1:]
+	return msg, nil
+}
+
+// split feeds a line to the brace matching. The lines of an object are joined
+// with a newline, and the bytes read are accounted to the messages ending in
+// the line.
+func (p *ConcatenatedParser) split(line reader.Message) {
+	start := 0 // the first byte of the line not accounted to a message
+	emitted := len(p.ready)
+	if p.inObject && !p.discard && p.buf[len(p.buf)-1] != '\n' {
+		p.buf = append(p.buf, '\n')
+	}
+
+	for i, c := range line.Content {
+		if !p.inObject {
+			if c == '{' {
+				if len(p.buf) > 0 {
+					p.emit(i - start)
+					start = i
+				}
+				p.first = line
+				p.inObject = true
+				p.depth = 0
+			} else {
+				if len(p.buf) == 0 {
+					if isSpace(c) {
+						continue
+					}
+					p.first = line
+				}
+				p.buf = append(p.buf, c)
+				continue
+			}
+		}
+
+		if !p.discard {
+			p.buf = append(p.buf, c)
+		}
+		switch {
+		case p.escaped:
+			p.escaped = false
+		case p.inStr:
+			if c == '\\' {
+				p.escaped = true
+			} else if c == '"' {
+				p.inStr = false
+			}
+		case c == '"':
+			p.inStr = true
+		case c == '{' || c == '[':
+			p.depth++
+		case c == '}' || c == ']':
+			p.depth--
+		}
+
+		switch {
+		case p.depth == 0 && p.discard:
+			p.pending += i + 1 - start
+			start = i + 1
+			p.reset()
+		case p.depth == 0:
+			p.emit(i + 1 - start)
+			start = i + 1
+		case !p.discard && p.maxBytes > 0 && len(p.buf) >= p.maxBytes:
+			depth, inStr, escaped := p.depth, p.inStr, p.escaped
+			p.emit(i + 1 - start)
+			start = i + 1
+			// Skip the rest of the object.
+			p.inObject, p.discard = true, true
+			p.depth, p.inStr, p.escaped = depth, inStr, escaped
+		}
+	}
+
+	rest := line.Bytes - start
+	switch {
+	case p.inObject:
+		p.pending += rest
+	case len(p.buf) > 0:
+		// The text outside of the objects doesn't span lines.
+		p.emit(rest)
+	case len(p.ready) > emitted:
+		// The trailing bytes of the line, such as the newline, are
+		// accounted to the last object ending in it.
+		p.ready[len(p.ready)-1].Bytes += rest
+	default:
+		p.pending += rest
+	}
+}
+
+// emit adds the object or text accumulated to the ready messages, n is the
+// number of bytes of the current line it ends with.
+func (p *ConcatenatedParser) emit(n int) {
+	p.pending += n
+	content := bytes.TrimSpace(p.buf)
+	if len(content) == 0 || (!p.inObject && p.cfg.SkipInvalid) {
+		// Nothing to publish, the bytes are accounted to the next message.
+		p.reset()
+		return
+	}
+
+	msg := p.first
+	msg.Content = append([]byte(nil), content...)
+	if msg.Fields != nil {
+		// The messages split from a line must not share its fields.
+		msg.Fields = msg.Fields.Clone()
+	}
+	msg.Bytes = p.pending
+	if p.inObject && p.depth > 0 {
+		// The object is incomplete, either too large or at the end of the
+		// stream.
+		_ = msg.AddFlagsWithKey("log.flags", "truncated")
+	}
+	p.ready = append(p.ready, msg)
+	p.pending = 0
+	p.reset()
+}
+
+// reset starts accumulating a new message.
+func (p *ConcatenatedParser) reset() {
+	p.buf = p.buf[:0]
+	p.first = reader.Message{}
+	p.inObject = false
+	p.discard = false
+	p.depth = 0
+	p.inStr = false
+	p.escaped = false
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
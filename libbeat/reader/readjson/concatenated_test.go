@@ -0,0 +1,115 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package readjson
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcatenatedParser(t *testing.T) {
+	tests := map[string]struct {
+		lines       []string
+		skipInvalid bool
+		maxBytes    int
+		expected    []string
+		truncated   []bool
+	}{
+		"pretty-printed objects": {
+			lines: []string{
+				`{`,
+				`  "a": 1,`,
+				`  "b": {"c": "}"}`,
+				`}{`,
+				`  "d": [1, {"e": "\"{"}]`,
+				`}`,
+			},
+			expected: []string{
+				"{\n  \"a\": 1,\n  \"b\": {\"c\": \"}\"}\n}",
+				"{\n  \"d\": [1, {\"e\": \"\\\"{\"}]\n}",
+			},
+		},
+		"objects on one line": {
+			lines:    []string{`{"a":1}{"b":2} {"c":3}`},
+			expected: []string{`{"a":1}`, `{"b":2}`, `{"c":3}`},
+		},
+		"text between objects": {
+			lines:    []string{`{"a":1} garbage {"b":2}`, `  `, `trailing text`},
+			expected: []string{`{"a":1}`, `garbage`, `{"b":2}`, `trailing text`},
+		},
+		"skip invalid text": {
+			lines:       []string{`{"a":1} garbage {"b":2}`, `trailing text`},
+			skipInvalid: true,
+			expected:    []string{`{"a":1}`, `{"b":2}`},
+		},
+		"incomplete object at the end": {
+			lines:     []string{`{"a":1}{"b":`},
+			expected:  []string{`{"a":1}`, `{"b":`},
+			truncated: []bool{false, true},
+		},
+		"object over max bytes": {
+			lines:     []string{`{"a":"0123456789"}{"b":2}`},
+			maxBytes:  10,
+			expected:  []string{`{"a":"0123`, `{"b":2}`},
+			truncated: []bool{true, false},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			in := &mockReader{}
+			total := 0
+			for _, line := range test.lines {
+				in.messages = append(in.messages, []byte(line))
+				total += len(line)
+			}
+			p := NewConcatenatedParser(in, &ConcatenatedConfig{SkipInvalid: test.skipInvalid}, test.maxBytes)
+
+			var (
+				contents []string
+				bytes    int
+			)
+			for {
+				msg, err := p.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				contents = append(contents, string(msg.Content))
+				bytes += msg.Bytes
+				assert.Greater(t, msg.Bytes, 0)
+
+				if test.truncated != nil {
+					flags, _ := msg.Fields.GetValue("log.flags")
+					if test.truncated[len(contents)-1] {
+						assert.Equal(t, []string{"truncated"}, flags)
+					} else {
+						assert.Nil(t, flags)
+					}
+				}
+			}
+			assert.Equal(t, test.expected, contents)
+			if !test.skipInvalid {
+				assert.Equal(t, total, bytes)
+			}
+		})
+	}
+}
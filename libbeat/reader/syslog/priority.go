@@ -0,0 +1,77 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// ParsePriority returns the priority held by s, which can be a bare number,
+// a "<PRI>" value or a message starting with one.
+func ParsePriority(s string) (int, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), utf8BOM)
+	if strings.HasPrefix(s, "<") {
+		end := strings.IndexByte(s, '>')
+		if end < 0 {
+			return 0, fmt.Errorf("invalid priority: missing '>' in %q", truncate(s, 8))
+		}
+		s = s[1:end]
+	}
+	if s == "" || len(s) > 3 {
+		return 0, fmt.Errorf("invalid priority: %q", truncate(s, 8))
+	}
+	priority, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid priority: %w", err)
+	}
+	if priority < 0 || 191 < priority {
+		return 0, ErrPriority
+	}
+	return priority, nil
+}
+
+// PriorityFields returns the priority, facility.code, facility.name,
+// severity.code and severity.name fields of the priority, as set under
+// log.syslog for the parsed messages.
+func PriorityFields(priority int) mapstr.M {
+	facility := priority >> facilityShift
+	severity := priority & severityMask
+	f := mapstr.M{
+		"priority": priority,
+		"facility": mapstr.M{"code": facility},
+		"severity": mapstr.M{"code": severity},
+	}
+	if v, ok := mapIndexToString(facility, facilityLabels); ok {
+		_, _ = f.Put("facility.name", v)
+	}
+	if v, ok := mapIndexToString(severity, severityLabels); ok {
+		_, _ = f.Put("severity.name", v)
+	}
+	return f
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n] + "..."
+	}
+	return s
+}
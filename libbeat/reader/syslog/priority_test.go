@@ -0,0 +1,65 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestParsePriority(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		"number":       {in: "13", want: 13},
+		"pri":          {in: "<13>", want: 13},
+		"zero":         {in: "<0>", want: 0},
+		"message":      {in: "<165>1 2003-10-11T22:14:15.003Z host app - - - msg", want: 165},
+		"bom":          {in: "\ufeff<13>msg", want: 13},
+		"out-of-range": {in: "<192>", wantErr: true},
+		"unterminated": {in: "<13", wantErr: true},
+		"empty":        {in: "<>", wantErr: true},
+		"not-number":   {in: "<ab>", wantErr: true},
+		"too-long":     {in: "<0013>", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got, err := ParsePriority(tc.in)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPriorityFields(t *testing.T) {
+	got := PriorityFields(13)
+	assert.Equal(t, 13, got["priority"])
+	assert.Equal(t, mapstr.M{"code": 1, "name": "user-level"}, got["facility"])
+	assert.Equal(t, mapstr.M{"code": 5, "name": "Notice"}, got["severity"])
+}
@@ -0,0 +1,151 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package boltdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+
+	"github.com/elastic/beats/v7/libbeat/statestore/backend"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// Registry configures access to bbolt based stores.
+type Registry struct {
+	log *logp.Logger
+
+	mu     sync.Mutex
+	active bool
+
+	settings Settings
+}
+
+// Settings configures a new Registry.
+type Settings struct {
+	// Registry root directory. Each store is a database file in it.
+	Root string
+
+	// FileMode is used to configure the file mode for the database files.
+	// File mode 0600 will be used if this field is not set.
+	FileMode os.FileMode
+
+	// Sync makes each update wait for the transaction to be written to the
+	// disk. Updates are faster when it is disabled, but may be lost if the
+	// host crashes.
+	Sync bool
+
+	// Timeout is the time to wait for the lock of a database file held by
+	// another process. Opening a store fails after it. Defaults to 1 second.
+	Timeout time.Duration
+
+	// Metrics is the registry the metrics of the stores are reported under,
+	// in a sub-registry per store. No metric is reported if it is nil.
+	Metrics *monitoring.Registry
+}
+
+const (
+	defaultFileMode os.FileMode = 0600
+	defaultTimeout              = time.Second
+
+	fileExtension = ".db"
+)
+
+var (
+	errRegClosed  = errors.New("registry has been closed")
+	errKeyUnknown = errors.New("key unknown")
+)
+
+// New configures a bbolt Registry that can be used to open stores.
+func New(log *logp.Logger, settings Settings) (*Registry, error) {
+	if settings.FileMode == 0 {
+		settings.FileMode = defaultFileMode
+	}
+	if settings.Timeout <= 0 {
+		settings.Timeout = defaultTimeout
+	}
+
+	root, err := filepath.Abs(settings.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	settings.Root = root
+	return &Registry{
+		log:      log,
+		active:   true,
+		settings: settings,
+	}, nil
+}
+
+// Path returns the path of the database file of the named store.
+func Path(root, name string) string {
+	return filepath.Join(root, name+fileExtension)
+}
+
+// Access creates or opens the database file of a store.
+// Returns an error if the file can not be opened or is locked by another
+// process.
+func (r *Registry) Access(name string) (backend.Store, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.active {
+		return nil, errRegClosed
+	}
+
+	if err := os.MkdirAll(r.settings.Root, os.ModeDir|0770); err != nil {
+		return nil, err
+	}
+
+	path := Path(r.settings.Root, name)
+	db, err := bbolt.Open(path, r.settings.FileMode, &bbolt.Options{
+		Timeout: r.settings.Timeout,
+		NoSync:  !r.settings.Sync,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open store '%v': %w", path, err)
+	}
+	if err := os.Chmod(path, r.settings.FileMode); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to update store file permissions: %w", err)
+	}
+
+	store, err := openStore(db, name, r.settings.Metrics)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	r.log.With("store", name).Infof("Opened bbolt store %v", path)
+	return store, nil
+}
+
+// Close closes the registry. No new store can be accessed after close.
+func (r *Registry) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.active = false
+	return nil
+}
@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package boltdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/statestore/backend"
+	"github.com/elastic/beats/v7/libbeat/statestore/internal/storecompliance"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+func init() {
+	logp.DevelopmentSetup()
+}
+
+func TestCompliance_Default(t *testing.T) {
+	storecompliance.TestBackendCompliance(t, func(testPath string) (backend.Registry, error) {
+		return New(logp.NewLogger("test"), Settings{Root: testPath})
+	})
+}
+
+func TestCompliance_Sync(t *testing.T) {
+	storecompliance.TestBackendCompliance(t, func(testPath string) (backend.Registry, error) {
+		return New(logp.NewLogger("test"), Settings{Root: testPath, Sync: true})
+	})
+}
+
+func TestReopen(t *testing.T) {
+	type state struct {
+		Offset int64  `struct:"offset"`
+		Source string `struct:"source"`
+	}
+
+	root := t.TempDir()
+	metrics := monitoring.NewRegistry()
+	reg, err := New(logp.NewLogger("test"), Settings{Root: root, Metrics: metrics})
+	require.NoError(t, err)
+
+	store, err := reg.Access("test")
+	require.NoError(t, err)
+	require.NoError(t, store.Set("a", state{Offset: 42, Source: "/var/log/a.log"}))
+	require.NoError(t, store.Set("b", state{Offset: 1, Source: "/var/log/b.log"}))
+	require.NoError(t, store.Set("a", state{Offset: 43, Source: "/var/log/a.log"}))
+	require.NoError(t, store.Remove("b"))
+	require.NoError(t, store.Remove("unknown"))
+
+	snapshot := monitoring.CollectFlatSnapshot(metrics, monitoring.Full, false)
+	assert.Equal(t, int64(1), snapshot.Ints["test.keys"])
+	assert.Equal(t, int64(5), snapshot.Ints["test.writes"])
+	assert.Greater(t, snapshot.Ints["test.file_size_bytes"], int64(0))
+
+	require.NoError(t, store.Close())
+	require.NoError(t, reg.Close())
+	assert.Nil(t, metrics.Get("test"))
+
+	reg, err = New(logp.NewLogger("test"), Settings{Root: root})
+	require.NoError(t, err)
+	defer reg.Close()
+	store, err = reg.Access("test")
+	require.NoError(t, err)
+	defer store.Close()
+
+	var got state
+	require.NoError(t, store.Get("a", &got))
+	assert.Equal(t, state{Offset: 43, Source: "/var/log/a.log"}, got)
+
+	found, err := store.Has("b")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Error(t, store.Get("b", &got))
+}
@@ -0,0 +1,32 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package boltdb implements a statestore backend keeping the key-value
+// pairs in a bbolt database file per store.
+//
+// Unlike memlog, the pairs are not held in memory: they are read from the
+// memory mapped database file on access, and every update is written in its
+// own transaction. This keeps the memory usage and the startup time of the
+// beat independent of the number of pairs, at the cost of slower updates.
+//
+// The values are serialized as JSON documents, using the same encoding as
+// the memlog backend.
+//
+// By default each transaction is fsync'ed before the update returns. When
+// Sync is disabled in the settings, the updates are written to the file
+// without waiting for the disk, and may be lost if the host crashes.
+package boltdb
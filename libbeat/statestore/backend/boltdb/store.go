@@ -0,0 +1,213 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package boltdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync/atomic"
+
+	"go.etcd.io/bbolt"
+
+	"github.com/elastic/beats/v7/libbeat/common/transform/typeconv"
+	"github.com/elastic/beats/v7/libbeat/statestore/backend"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+	"github.com/elastic/go-structform/gotype"
+	sfjson "github.com/elastic/go-structform/json"
+)
+
+// bucketName is the bucket holding the key-value pairs of a store.
+var bucketName = []byte("kv")
+
+// store implements a bbolt based store. Updates are serialized by bbolt,
+// which allows one writer and multiple concurrent readers.
+type store struct {
+	db *bbolt.DB
+
+	name    string
+	metrics *monitoring.Registry
+
+	keys   atomic.Int64  // number of pairs in the store
+	writes atomic.Uint64 // number of update transactions committed
+}
+
+// openStore creates the bucket of the store if needed, and registers its
+// metrics if metrics is not nil.
+func openStore(db *bbolt.DB, name string, metrics *monitoring.Registry) (*store, error) {
+	s := &store{db: db, name: name, metrics: metrics}
+
+	err := db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(bucketName)
+		if err != nil {
+			return err
+		}
+		s.keys.Store(int64(b.Stats().KeyN))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if metrics != nil {
+		metrics.Remove(name)
+		monitoring.NewFunc(metrics, name, s.report, monitoring.Report)
+	}
+	return s, nil
+}
+
+// Close unregisters the metrics of the store and closes its database file.
+func (s *store) Close() error {
+	if s.metrics != nil {
+		s.metrics.Remove(s.name)
+	}
+	return s.db.Close()
+}
+
+// Has checks if the key exists in the store.
+func (s *store) Has(key string) (bool, error) {
+	var found bool
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		found = tx.Bucket(bucketName).Get([]byte(key)) != nil
+		return nil
+	})
+	return found, err
+}
+
+// Get retrieves and decodes the key-value pair into to.
+func (s *store) Get(key string, to interface{}) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(bucketName).Get([]byte(key))
+		if v == nil {
+			return errKeyUnknown
+		}
+		return entry(v).Decode(to)
+	})
+}
+
+// Set inserts or overwrites a key-value pair. The value is serialized before
+// the transaction is started.
+func (s *store) Set(key string, value interface{}) error {
+	var tmp mapstr.M
+	if err := typeconv.Convert(&tmp, value); err != nil {
+		return err
+	}
+	data, err := encode(tmp)
+	if err != nil {
+		return err
+	}
+
+	return s.update(func(b *bbolt.Bucket) (int64, error) {
+		k := []byte(key)
+		var added int64
+		if b.Get(k) == nil {
+			added = 1
+		}
+		return added, b.Put(k, data)
+	})
+}
+
+// Remove removes a key from the store. The operation does not check if the
+// key exists.
+func (s *store) Remove(key string) error {
+	return s.update(func(b *bbolt.Bucket) (int64, error) {
+		k := []byte(key)
+		var removed int64
+		if b.Get(k) != nil {
+			removed = 1
+		}
+		return -removed, b.Delete(k)
+	})
+}
+
+// update runs fn in a write transaction. fn returns the change of the number
+// of keys in the store, applied once the transaction is committed.
+func (s *store) update(fn func(*bbolt.Bucket) (int64, error)) error {
+	var delta int64
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		var err error
+		delta, err = fn(tx.Bucket(bucketName))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	s.keys.Add(delta)
+	s.writes.Add(1)
+	return nil
+}
+
+// Each iterates over all key-value pairs in the store in a single read
+// transaction. fn must not update the store.
+func (s *store) Each(fn func(string, backend.ValueDecoder) (bool, error)) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket(bucketName).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			cont, err := fn(string(k), entry(v))
+			if !cont || err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// report reports the size and the activity of the store.
+func (s *store) report(_ monitoring.Mode, V monitoring.Visitor) {
+	V.OnRegistryStart()
+	defer V.OnRegistryFinished()
+
+	var size int64
+	_ = s.db.View(func(tx *bbolt.Tx) error {
+		size = tx.Size()
+		return nil
+	})
+	stats := s.db.Stats()
+
+	monitoring.ReportInt(V, "keys", s.keys.Load())
+	monitoring.ReportInt(V, "file_size_bytes", size)
+	monitoring.ReportInt(V, "free_pages", int64(stats.FreePageN))
+	monitoring.ReportInt(V, "writes", int64(s.writes.Load()))
+}
+
+// entry is a serialized value, only valid during the transaction it was
+// read in.
+type entry []byte
+
+func (e entry) Decode(to interface{}) error {
+	var tmp map[string]interface{}
+	if err := json.Unmarshal(e, &tmp); err != nil {
+		return err
+	}
+	return typeconv.Convert(to, tmp)
+}
+
+func encode(v mapstr.M) ([]byte, error) {
+	var buf bytes.Buffer
+	visitor := sfjson.NewVisitor(&buf)
+	visitor.SetEscapeHTML(false)
+
+	folder, err := gotype.NewIterator(visitor)
+	if err != nil {
+		return nil, err
+	}
+	if err := folder.Fold(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -38,6 +38,10 @@ type TemplateConfig struct {
 	Overwrite    bool             `config:"overwrite"`
 	Settings     TemplateSettings `config:"settings"`
 	Priority     int              `config:"priority"`
+
+	// Lifecycle holds the data stream lifecycle, e.g. data_retention, to
+	// apply to data streams created from the template.
+	Lifecycle map[string]interface{} `config:"lifecycle"`
 }
 
 // TemplateSettings are part of the Elasticsearch template and hold index and source specific information.
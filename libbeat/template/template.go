@@ -201,6 +201,9 @@ func (t *Template) LoadMinimal() mapstr.M {
 	templ["settings"] = mapstr.M{
 		"index": t.config.Settings.Index,
 	}
+	if len(t.config.Lifecycle) != 0 {
+		templ["lifecycle"] = mapstr.M(t.config.Lifecycle)
+	}
 	return mapstr.M{
 		"template":       templ,
 		"data_stream":    struct{}{},
@@ -249,6 +252,9 @@ func (t *Template) generateComponent(properties, analyzers mapstr.M, dynamicTemp
 	if len(analyzers) != 0 {
 		m.Put("template.settings.analysis.analyzer", analyzers)
 	}
+	if len(t.config.Lifecycle) != 0 {
+		m.Put("template.lifecycle", mapstr.M(t.config.Lifecycle))
+	}
 	return m
 }
 
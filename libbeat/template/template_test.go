@@ -108,6 +108,18 @@ func TestTemplate(t *testing.T) {
 		template.Assert("template.mappings._meta", mapstr.M{"beat": "testbeat", "version": currentVersion})
 		template.Assert("template.settings.index.max_docvalue_fields_search", 200)
 	})
+
+	t.Run("with data stream lifecycle", func(t *testing.T) {
+		config := DefaultConfig(info)
+		config.Lifecycle = map[string]interface{}{"data_retention": "30d"}
+		template := createTestTemplate(t, currentVersion, "8.11.0", config)
+		template.Assert("template.lifecycle.data_retention", "30d")
+	})
+
+	t.Run("without data stream lifecycle", func(t *testing.T) {
+		template := createTestTemplate(t, currentVersion, "8.11.0", DefaultConfig(info))
+		template.AssertMissing("template.lifecycle")
+	})
 }
 
 func createTestTemplate(t *testing.T, beatVersion, esVersion string, config TemplateConfig) *testTemplate {
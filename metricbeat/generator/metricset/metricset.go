@@ -0,0 +1,95 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricset
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/elastic/beats/v7/filebeat/generator"
+)
+
+// Generate creates a new metricset with all the files required to build, test
+// and document it. The module of the metricset is created first if it does not
+// exist yet.
+func Generate(module, metricset, beatPath, metricbeatPath string) error {
+	if err := generator.ValidateName("module", module); err != nil {
+		return err
+	}
+	if err := generator.ValidateName("metricset", metricset); err != nil {
+		return err
+	}
+
+	templatesPath := path.Join(metricbeatPath, "scripts", "module")
+	if err := generateModule(module, metricset, beatPath, templatesPath); err != nil {
+		return err
+	}
+
+	metricsetPath := path.Join(beatPath, "module", module, metricset)
+	if generator.DirExists(metricsetPath) {
+		return fmt.Errorf("metricset already exists: %s", metricset)
+	}
+
+	err := generator.CreateDirectories(metricsetPath, "_meta")
+	if err != nil {
+		return err
+	}
+
+	replace := map[string]string{"module": module, "metricset": metricset}
+	templatesPath = path.Join(templatesPath, "metricset")
+	filesToCopy := []string{
+		path.Join("_meta", "fields.yml"),
+		path.Join("_meta", "docs.asciidoc"),
+		path.Join("_meta", "data.json"),
+	}
+	for _, f := range filesToCopy {
+		err = generator.CopyTemplate(path.Join(templatesPath, path.Base(f)), path.Join(metricsetPath, f), replace)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = generator.CopyTemplate(path.Join(templatesPath, "metricset.go.tmpl"), path.Join(metricsetPath, metricset+".go"), replace)
+	if err != nil {
+		return err
+	}
+	return generator.CopyTemplate(path.Join(templatesPath, "metricset_test.go.tmpl"), path.Join(metricsetPath, metricset+"_test.go"), replace)
+}
+
+// generateModule creates the module directory and its meta files. It is a
+// no-op if the module already exists.
+func generateModule(module, metricset, beatPath, templatesPath string) error {
+	modulePath := path.Join(beatPath, "module", module)
+	if generator.DirExists(modulePath) {
+		return nil
+	}
+
+	err := generator.CreateDirectories(modulePath, "_meta")
+	if err != nil {
+		return err
+	}
+
+	replace := map[string]string{"module": module, "metricset": metricset}
+	filesToCopy := []string{"fields.yml", "docs.asciidoc", "config.yml"}
+	err = generator.CopyTemplates(templatesPath, path.Join(modulePath, "_meta"), filesToCopy, replace)
+	if err != nil {
+		return err
+	}
+
+	return generator.CopyTemplate(path.Join(templatesPath, "doc.go.tmpl"), path.Join(modulePath, "doc.go"), replace)
+}
@@ -0,0 +1,72 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricset
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	beatPath := t.TempDir()
+
+	err := Generate("foo", "bar", beatPath, filepath.Join("..", ".."))
+	require.NoError(t, err)
+
+	for _, f := range []string{
+		"module/foo/doc.go",
+		"module/foo/_meta/config.yml",
+		"module/foo/_meta/docs.asciidoc",
+		"module/foo/_meta/fields.yml",
+		"module/foo/bar/bar.go",
+		"module/foo/bar/bar_test.go",
+		"module/foo/bar/_meta/data.json",
+		"module/foo/bar/_meta/docs.asciidoc",
+		"module/foo/bar/_meta/fields.yml",
+	} {
+		assert.FileExists(t, filepath.Join(beatPath, f))
+	}
+
+	content, err := os.ReadFile(filepath.Join(beatPath, "module", "foo", "bar", "bar.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "package bar\n")
+	assert.Contains(t, string(content), `mb.Registry.MustAddMetricSet("foo", "bar", New)`)
+	assert.NotContains(t, string(content), "{metricset}")
+
+	// A second metricset reuses the existing module.
+	err = Generate("foo", "baz", beatPath, filepath.Join("..", ".."))
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(beatPath, "module", "foo", "baz", "baz.go"))
+
+	err = Generate("foo", "bar", beatPath, filepath.Join("..", ".."))
+	assert.ErrorContains(t, err, "metricset already exists")
+}
+
+func TestGenerateInvalidName(t *testing.T) {
+	beatPath := t.TempDir()
+
+	err := Generate("Foo", "bar", beatPath, filepath.Join("..", ".."))
+	assert.ErrorContains(t, err, `invalid module name "Foo"`)
+
+	err = Generate("foo", "bar-baz", beatPath, filepath.Join("..", ".."))
+	assert.ErrorContains(t, err, `invalid metricset name "bar-baz"`)
+}
@@ -59,6 +59,17 @@ func NewHTTP(base mb.BaseMetricSet) (*HTTP, error) {
 	return NewHTTPFromConfig(config, base.HostData())
 }
 
+// NewHTTPForHost creates new http helper for the given host, using the HTTP
+// settings of the module of the metricset.
+func NewHTTPForHost(base mb.BaseMetricSet, hostData mb.HostData) (*HTTP, error) {
+	config := defaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	return NewHTTPFromConfig(config, hostData)
+}
+
 // newHTTPWithConfig creates a new http helper from some configuration
 func NewHTTPFromConfig(config Config, hostData mb.HostData) (*HTTP, error) {
 	headers := http.Header{}
@@ -23,6 +23,7 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/elastic/beats/v7/metricbeat/helper"
@@ -31,7 +32,13 @@ import (
 	"github.com/elastic/elastic-agent-libs/mapstr"
 )
 
-const acceptHeader = `text/plain;version=0.0.4;q=0.5,*/*;q=0.1`
+const (
+	acceptHeader = `text/plain;version=0.0.4;q=0.5,*/*;q=0.1`
+
+	// scrapeTimeoutHeader tells the exporters how long they have to answer,
+	// as the Prometheus server does.
+	scrapeTimeoutHeader = "X-Prometheus-Scrape-Timeout-Seconds"
+)
 
 // Prometheus helper retrieves prometheus formatted metrics
 type Prometheus interface {
@@ -63,6 +70,9 @@ func NewPrometheusClient(base mb.BaseMetricSet) (Prometheus, error) {
 
 	http.SetHeaderDefault("Accept", acceptHeader)
 	http.SetHeaderDefault("Accept-Encoding", "gzip")
+	if timeout := base.Module().Config().Timeout; timeout > 0 {
+		http.SetHeaderDefault(scrapeTimeoutHeader, strconv.FormatFloat(timeout.Seconds(), 'f', -1, 64))
+	}
 	return &prometheus{http, base.Logger()}, nil
 }
 
@@ -0,0 +1,186 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/relabel"
+)
+
+// Relabeling actions supported by RelabelConfig.
+const (
+	RelabelReplace   = string(relabel.Replace)
+	RelabelKeep      = string(relabel.Keep)
+	RelabelDrop      = string(relabel.Drop)
+	RelabelLabelDrop = string(relabel.LabelDrop)
+	RelabelLabelKeep = string(relabel.LabelKeep)
+)
+
+// RelabelConfig is a Prometheus style relabeling rule applied to the metrics
+// of a scrape before they are converted to events. The metric name can be
+// matched with the __name__ source label, but it cannot be changed.
+type RelabelConfig struct {
+	SourceLabels []string `config:"source_labels" yaml:"source_labels,omitempty"`
+	Separator    string   `config:"separator" yaml:"separator,omitempty"`
+	Regex        string   `config:"regex" yaml:"regex,omitempty"`
+	TargetLabel  string   `config:"target_label" yaml:"target_label,omitempty"`
+	Replacement  *string  `config:"replacement" yaml:"replacement,omitempty"`
+	Action       string   `config:"action" yaml:"action,omitempty"`
+}
+
+// Validate checks that the action is supported and has the settings it needs.
+func (c *RelabelConfig) Validate() error {
+	action := c.Action
+	if action == "" {
+		action = RelabelReplace
+	}
+	switch action {
+	case RelabelReplace:
+		if c.TargetLabel == "" {
+			return errors.New("target_label is required by the replace action")
+		}
+		if c.TargetLabel == labels.MetricName {
+			return fmt.Errorf("the metric name cannot be relabeled with target_label %v", labels.MetricName)
+		}
+		fallthrough
+	case RelabelKeep, RelabelDrop:
+		if len(c.SourceLabels) == 0 {
+			return fmt.Errorf("source_labels are required by the %v action", action)
+		}
+	case RelabelLabelDrop, RelabelLabelKeep:
+		if len(c.SourceLabels) != 0 || c.TargetLabel != "" {
+			return fmt.Errorf("the %v action only accepts a regex", action)
+		}
+	default:
+		return fmt.Errorf("unsupported relabel action %q", action)
+	}
+	if c.Regex != "" {
+		if _, err := relabel.NewRegexp(c.Regex); err != nil {
+			return fmt.Errorf("invalid relabel regex %q: %w", c.Regex, err)
+		}
+	}
+	return nil
+}
+
+// Relabeler applies a list of relabeling rules to scraped metric families.
+type Relabeler struct {
+	configs []*relabel.Config
+}
+
+// NewRelabeler compiles the relabeling rules. It returns nil when there are no
+// rules to apply.
+func NewRelabeler(configs []RelabelConfig) (*Relabeler, error) {
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	rules := make([]*relabel.Config, 0, len(configs))
+	for i, c := range configs {
+		if err := c.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid relabel config %d: %w", i, err)
+		}
+		rule := relabel.DefaultRelabelConfig
+		for _, n := range c.SourceLabels {
+			rule.SourceLabels = append(rule.SourceLabels, model.LabelName(n))
+		}
+		if c.Separator != "" {
+			rule.Separator = c.Separator
+		}
+		if c.Regex != "" {
+			re, err := relabel.NewRegexp(c.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("invalid relabel regex %q: %w", c.Regex, err)
+			}
+			rule.Regex = re
+		}
+		rule.TargetLabel = c.TargetLabel
+		if c.Replacement != nil {
+			rule.Replacement = *c.Replacement
+		}
+		if c.Action != "" {
+			rule.Action = relabel.Action(c.Action)
+		}
+		rules = append(rules, &rule)
+	}
+	return &Relabeler{configs: rules}, nil
+}
+
+// Relabel applies the rules to the metrics of the families. The metrics
+// dropped by the rules are removed, as are the families left without metrics.
+func (r *Relabeler) Relabel(families []*MetricFamily) []*MetricFamily {
+	if r == nil {
+		return families
+	}
+
+	kept := families[:0]
+	for _, family := range families {
+		if family == nil {
+			continue
+		}
+		name := family.GetName()
+		metrics := family.Metric[:0]
+		for _, metric := range family.Metric {
+			if metric == nil {
+				continue
+			}
+			lset, keep := r.relabel(name, metric.Label)
+			if !keep {
+				continue
+			}
+			metric.Label = lset
+			metrics = append(metrics, metric)
+		}
+		family.Metric = metrics
+		if len(family.Metric) > 0 {
+			kept = append(kept, family)
+		}
+	}
+	return kept
+}
+
+// relabel applies the rules to the labels of a metric. It returns the new
+// labels, sorted by name, and whether the metric must be kept.
+func (r *Relabeler) relabel(name string, lset []*labels.Label) ([]*labels.Label, bool) {
+	b := labels.NewBuilder(nil)
+	for _, l := range lset {
+		if l != nil {
+			b.Set(l.Name, l.Value)
+		}
+	}
+	b.Set(labels.MetricName, name)
+
+	relabeled := relabel.Process(b.Labels(), r.configs...)
+	if relabeled == nil {
+		return nil, false
+	}
+
+	res := make([]*labels.Label, 0, len(relabeled))
+	for _, l := range relabeled {
+		// Labels starting with __ are only available during relabeling.
+		if strings.HasPrefix(l.Name, model.ReservedLabelPrefix) {
+			continue
+		}
+		res = append(res, &labels.Label{Name: l.Name, Value: l.Value})
+	}
+	return res, true
+}
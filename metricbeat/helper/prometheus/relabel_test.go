@@ -0,0 +1,146 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelabel(t *testing.T) {
+	empty := ""
+	testCases := []struct {
+		name    string
+		configs []RelabelConfig
+		labels  map[string]string
+		want    map[string]string
+		dropped bool
+	}{
+		{
+			name: "keep by metric name",
+			configs: []RelabelConfig{
+				{SourceLabels: []string{"__name__"}, Regex: "node_.*", Action: RelabelKeep},
+			},
+			labels: map[string]string{"device": "sda"},
+			want:   map[string]string{"device": "sda"},
+		},
+		{
+			name: "keep is anchored",
+			configs: []RelabelConfig{
+				{SourceLabels: []string{"__name__"}, Regex: "node", Action: RelabelKeep},
+			},
+			labels:  map[string]string{"device": "sda"},
+			dropped: true,
+		},
+		{
+			name: "drop by joined labels",
+			configs: []RelabelConfig{
+				{SourceLabels: []string{"__name__", "device"}, Regex: "node_disk_.*;loop[0-9]+", Action: RelabelDrop},
+			},
+			labels:  map[string]string{"device": "loop3"},
+			dropped: true,
+		},
+		{
+			name: "replace with capture group",
+			configs: []RelabelConfig{
+				{SourceLabels: []string{"path"}, Regex: "/api/([^/]+)/.*", TargetLabel: "endpoint"},
+			},
+			labels: map[string]string{"path": "/api/users/42"},
+			want:   map[string]string{"path": "/api/users/42", "endpoint": "users"},
+		},
+		{
+			name: "replace with empty value removes the label",
+			configs: []RelabelConfig{
+				{SourceLabels: []string{"path"}, TargetLabel: "path", Replacement: &empty},
+			},
+			labels: map[string]string{"path": "/api/users/42", "code": "200"},
+			want:   map[string]string{"code": "200"},
+		},
+		{
+			name: "labeldrop",
+			configs: []RelabelConfig{
+				{Regex: "request_id|session_.*", Action: RelabelLabelDrop},
+			},
+			labels: map[string]string{"request_id": "1", "session_id": "2", "code": "200"},
+			want:   map[string]string{"code": "200"},
+		},
+		{
+			name: "labelkeep",
+			configs: []RelabelConfig{
+				{Regex: "code", Action: RelabelLabelKeep},
+			},
+			labels: map[string]string{"request_id": "1", "code": "200"},
+			want:   map[string]string{"code": "200"},
+		},
+		{
+			name: "temporary labels are removed",
+			configs: []RelabelConfig{
+				{SourceLabels: []string{"code"}, Regex: "(.)..", TargetLabel: "__class"},
+				{SourceLabels: []string{"__class"}, Regex: "5", Action: RelabelDrop},
+			},
+			labels: map[string]string{"code": "200"},
+			want:   map[string]string{"code": "200"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := NewRelabeler(tc.configs)
+			require.NoError(t, err)
+
+			name := "node_disk_reads"
+			var lset []*labels.Label
+			for n, v := range tc.labels {
+				lset = append(lset, &labels.Label{Name: n, Value: v})
+			}
+			families := r.Relabel([]*MetricFamily{
+				{Name: &name, Metric: []*OpenMetric{{Label: lset}}},
+			})
+			if tc.dropped {
+				assert.Empty(t, families)
+				return
+			}
+			require.Len(t, families, 1)
+			require.Len(t, families[0].Metric, 1)
+
+			got := map[string]string{}
+			for _, l := range families[0].Metric[0].Label {
+				got[l.Name] = l.Value
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRelabelConfigValidate(t *testing.T) {
+	for name, c := range map[string]RelabelConfig{
+		"unknown action":         {SourceLabels: []string{"a"}, Action: "hashmod"},
+		"replace without target": {SourceLabels: []string{"a"}},
+		"replace metric name":    {SourceLabels: []string{"a"}, TargetLabel: "__name__"},
+		"keep without source":    {Action: RelabelKeep},
+		"labeldrop with source":  {SourceLabels: []string{"a"}, Action: RelabelLabelDrop},
+		"invalid regex":          {SourceLabels: []string{"a"}, Regex: "(", Action: RelabelDrop},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, c.Validate())
+		})
+	}
+}
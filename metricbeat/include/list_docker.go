@@ -38,12 +38,15 @@ import (
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/container"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/controllermanager"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/event"
+	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/kubelet"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/node"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/pod"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/proxy"
+	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/resource"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/scheduler"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/state_container"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/state_cronjob"
+	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/state_customresource"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/state_daemonset"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/state_deployment"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/state_job"
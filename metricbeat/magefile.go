@@ -53,6 +53,8 @@ import (
 	_ "github.com/elastic/beats/v7/dev-tools/mage/target/integtest/docker"
 	//mage:import
 	_ "github.com/elastic/beats/v7/metricbeat/scripts/mage/target/metricset"
+	//mage:import generate
+	_ "github.com/elastic/beats/v7/metricbeat/scripts/mage/target/generate"
 )
 
 func init() {
@@ -0,0 +1,107 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package module
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// Error types reported in the `error.type` field of the events of failed
+// fetches.
+const (
+	errorTypeTimeout           = "timeout"
+	errorTypeConnectionRefused = "connection_refused"
+	errorTypeConnectionReset   = "connection_reset"
+	errorTypeDNS               = "dns"
+	errorTypeTLS               = "tls"
+	errorTypeUnknown           = "unknown"
+)
+
+// successRateWindow is the number of reported outcomes the success rate of a
+// metricset is computed over.
+const successRateWindow = 100
+
+// errorType classifies the error of a failed fetch. Many metricsets don't wrap
+// the errors they return, so the error message is checked as well.
+func errorType(err error) string {
+	var (
+		dnsErr          *net.DNSError
+		netErr          net.Error
+		authorityErr    x509.UnknownAuthorityError
+		hostnameErr     x509.HostnameError
+		certificateErr  x509.CertificateInvalidError
+		recordHeaderErr tls.RecordHeaderError
+	)
+	msg := err.Error()
+
+	switch {
+	case errors.As(err, &dnsErr) || strings.Contains(msg, "no such host"):
+		return errorTypeDNS
+	case errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) ||
+		strings.Contains(msg, "i/o timeout") || strings.Contains(msg, "deadline exceeded"):
+		return errorTypeTimeout
+	case errors.Is(err, syscall.ECONNREFUSED) || strings.Contains(msg, "connection refused"):
+		return errorTypeConnectionRefused
+	case errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		strings.Contains(msg, "connection reset"):
+		return errorTypeConnectionReset
+	case errors.As(err, &authorityErr) || errors.As(err, &hostnameErr) || errors.As(err, &certificateErr) ||
+		errors.As(err, &recordHeaderErr) || strings.Contains(msg, "x509: ") || strings.Contains(msg, "tls: "):
+		return errorTypeTLS
+	}
+	return errorTypeUnknown
+}
+
+// outcomeWindow keeps the outcomes of the last reported events of a
+// metricset to compute its success rate.
+type outcomeWindow struct {
+	mu       sync.Mutex
+	failed   [successRateWindow]bool
+	next     int
+	count    int
+	failures int
+}
+
+// add records an outcome and returns the success rate of the window.
+func (w *outcomeWindow) add(failed bool) float64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.count == len(w.failed) {
+		if w.failed[w.next] {
+			w.failures--
+		}
+	} else {
+		w.count++
+	}
+	w.failed[w.next] = failed
+	if failed {
+		w.failures++
+	}
+	w.next = (w.next + 1) % len(w.failed)
+
+	return float64(w.count-w.failures) / float64(w.count)
+}
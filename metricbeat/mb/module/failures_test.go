@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package module
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorType(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{err: &net.DNSError{Err: "no such host", Name: "example.invalid", IsNotFound: true}, expected: errorTypeDNS},
+		{err: fmt.Errorf("fetch failed: %w", context.DeadlineExceeded), expected: errorTypeTimeout},
+		{err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, expected: errorTypeConnectionRefused},
+		{err: errors.New("dial tcp 127.0.0.1:6379: connect: connection refused"), expected: errorTypeConnectionRefused},
+		{err: fmt.Errorf("reading response: %w", io.EOF), expected: errorTypeConnectionReset},
+		{err: fmt.Errorf("request failed: %w", x509.UnknownAuthorityError{}), expected: errorTypeTLS},
+		{err: errors.New("unexpected status code 500"), expected: errorTypeUnknown},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, errorType(test.err), "error: %v", test.err)
+	}
+}
+
+func TestOutcomeWindow(t *testing.T) {
+	var w outcomeWindow
+	assert.Equal(t, 1.0, w.add(false))
+	assert.Equal(t, 0.5, w.add(true))
+
+	for i := 0; i < successRateWindow; i++ {
+		w.add(true)
+	}
+	assert.Equal(t, 0.0, w.add(true))
+
+	for i := 0; i < successRateWindow/2; i++ {
+		w.add(false)
+	}
+	assert.Equal(t, 0.5, w.add(true))
+}
@@ -36,9 +36,10 @@ import (
 
 // Expvar metric names.
 const (
-	successesKey = "success"
-	failuresKey  = "failures"
-	eventsKey    = "events"
+	successesKey   = "success"
+	failuresKey    = "failures"
+	eventsKey      = "events"
+	successRateKey = "success_rate"
 )
 
 var (
@@ -68,6 +69,10 @@ type metricSetWrapper struct {
 	module *Wrapper // Parent Module.
 	stats  *stats   // stats for this MetricSet.
 
+	consecutiveFailures *monitoring.Int       // Failures since the last successful event of this MetricSet and host.
+	lastFailureType     *monitoring.String    // Error type of the last failure.
+	lastFailureTime     *monitoring.Timestamp // Time of the last failure.
+
 	periodic bool // Set to true if this metricset is a periodic fetcher
 }
 
@@ -78,6 +83,9 @@ type stats struct {
 	success  *monitoring.Int // Total success events.
 	failures *monitoring.Int // Total error events.
 	events   *monitoring.Int // Total events published.
+
+	successRate *monitoring.Float // Success rate of the last reported events.
+	outcomes    outcomeWindow
 }
 
 // NewWrapper creates a new module and its associated metricsets based on the given configuration.
@@ -105,10 +113,14 @@ func createWrapper(module mb.Module, metricSets []mb.MetricSet, options ...Optio
 	}
 
 	for i, metricSet := range metricSets {
+		metrics := metricSet.Metrics()
 		wrapper.metricSets[i] = &metricSetWrapper{
-			MetricSet: metricSet,
-			module:    wrapper,
-			stats:     getMetricSetStats(wrapper.Name(), metricSet.Name()),
+			MetricSet:           metricSet,
+			module:              wrapper,
+			stats:               getMetricSetStats(wrapper.Name(), metricSet.Name()),
+			consecutiveFailures: monitoring.NewInt(metrics, "failures.consecutive"),
+			lastFailureType:     monitoring.NewString(metrics, "failures.last.type"),
+			lastFailureTime:     monitoring.NewTimestamp(metrics, "failures.last.time"),
 		}
 	}
 	return wrapper, nil
@@ -267,6 +279,25 @@ func (msw *metricSetWrapper) fetch(ctx context.Context, reporter reporter) {
 	}
 }
 
+// succeeded updates the stats of the MetricSet after a successful event.
+func (msw *metricSetWrapper) succeeded() {
+	msw.stats.success.Add(1)
+	msw.stats.successRate.Set(msw.stats.outcomes.add(false))
+	msw.consecutiveFailures.Set(0)
+}
+
+// failed updates the stats of the MetricSet after an error was reported. It
+// returns the type of the error and the number of consecutive failures.
+func (msw *metricSetWrapper) failed(err error) (errType string, consecutive int64) {
+	errType = errorType(err)
+	msw.stats.failures.Add(1)
+	msw.stats.successRate.Set(msw.stats.outcomes.add(true))
+	msw.consecutiveFailures.Inc()
+	msw.lastFailureType.Set(errType)
+	msw.lastFailureTime.Set(time.Now())
+	return errType, msw.consecutiveFailures.Get()
+}
+
 // close closes the underlying MetricSet if it implements the mb.Closer
 // interface.
 func (msw *metricSetWrapper) close() error {
@@ -374,16 +405,22 @@ func (r reporterV2) Event(event mb.Event) bool {
 		event.Host = r.msw.HostData().SanitizedURI
 	}
 
+	var errType string
+	var consecutiveFailures int64
 	if event.Error == nil {
-		r.msw.stats.success.Add(1)
+		r.msw.succeeded()
 	} else {
-		r.msw.stats.failures.Add(1)
+		errType, consecutiveFailures = r.msw.failed(event.Error)
 	}
 
 	if event.Namespace == "" {
 		event.Namespace = r.msw.Registration().Namespace
 	}
 	beatEvent := event.BeatEvent(r.msw.module.Name(), r.msw.MetricSet.Name(), r.msw.module.eventModifiers...)
+	if event.Error != nil {
+		beatEvent.Fields.Put("error.type", errType)
+		beatEvent.Fields.Put("metricset.consecutive_failures", consecutiveFailures)
+	}
 	if !writeEvent(r.done, r.out, beatEvent) {
 		return false
 	}
@@ -416,12 +453,14 @@ func getMetricSetStats(module, name string) *stats {
 
 	reg := monitoring.Default.NewRegistry(key)
 	s := &stats{
-		key:      key,
-		ref:      1,
-		success:  monitoring.NewInt(reg, successesKey),
-		failures: monitoring.NewInt(reg, failuresKey),
-		events:   monitoring.NewInt(reg, eventsKey),
+		key:         key,
+		ref:         1,
+		success:     monitoring.NewInt(reg, successesKey),
+		failures:    monitoring.NewInt(reg, failuresKey),
+		events:      monitoring.NewInt(reg, eventsKey),
+		successRate: monitoring.NewFloat(reg, successRateKey),
 	}
+	s.successRate.Set(1)
 
 	fetches[key] = s
 	return s
@@ -21,6 +21,8 @@
 package module_test
 
 import (
+	"fmt"
+	"syscall"
 	"testing"
 	"time"
 
@@ -32,12 +34,14 @@ import (
 	"github.com/elastic/beats/v7/metricbeat/mb/module"
 	conf "github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
 )
 
 const (
 	moduleName           = "fake"
 	reportingFetcherName = "ReportingFetcher"
 	pushMetricSetName    = "PushMetricSet"
+	failingFetcherName   = "FailingFetcher"
 )
 
 // fakeMetricSet
@@ -63,6 +67,21 @@ func newFakeReportingFetcher(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	return r, nil
 }
 
+// FailingFetcher
+
+type fakeFailingFetcher struct {
+	mb.BaseMetricSet
+}
+
+func (ms *fakeFailingFetcher) Fetch(r mb.ReporterV2) error {
+	return fmt.Errorf("error fetching data: %w", syscall.ECONNREFUSED)
+}
+
+func newFakeFailingFetcher(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	var r mb.ReportingMetricSetV2Error = &fakeFailingFetcher{BaseMetricSet: base}
+	return r, nil
+}
+
 // PushMetricSet
 
 type fakePushMetricSet struct {
@@ -90,6 +109,8 @@ func newTestRegistry(t testing.TB) *mb.Register {
 	require.NoError(t, err)
 	err = r.AddMetricSet(moduleName, pushMetricSetName, newFakePushMetricSet)
 	require.NoError(t, err)
+	err = r.AddMetricSet(moduleName, failingFetcherName, newFakeFailingFetcher)
+	require.NoError(t, err)
 	return r
 }
 
@@ -232,3 +253,33 @@ func TestNewWrapperForMetricSet(t *testing.T) {
 		assert.Fail(t, "received unexpected event")
 	}
 }
+
+func TestFailureEvents(t *testing.T) {
+	c := newConfig(t, map[string]interface{}{
+		"module":     moduleName,
+		"metricsets": []string{failingFetcherName},
+		"hosts":      []string{"alpha"},
+		"period":     "10ms",
+	})
+
+	m, err := module.NewWrapper(c, newTestRegistry(t), module.WithMetricSetInfo())
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	defer close(done)
+	output := m.Start(done)
+
+	for i := int64(1); i <= 3; i++ {
+		event := <-output
+		assert.Equal(t, "error fetching data: connection refused", event.Fields["error"].(mapstr.M)["message"])
+		assert.Equal(t, "connection_refused", event.Fields["error"].(mapstr.M)["type"])
+		assert.Equal(t, i, event.Fields["metricset"].(mapstr.M)["consecutive_failures"])
+	}
+
+	metrics := monitoring.CollectFlatSnapshot(m.MetricSets()[0].Metrics(), monitoring.Full, false)
+	assert.GreaterOrEqual(t, metrics.Ints["failures.consecutive"], int64(3))
+	assert.Equal(t, "connection_refused", metrics.Strings["failures.last.type"])
+
+	stats := monitoring.CollectFlatSnapshot(monitoring.Default, monitoring.Full, false)
+	assert.Equal(t, 0.0, stats.Floats["metricbeat."+moduleName+"."+m.MetricSets()[0].Name()+".success_rate"])
+}
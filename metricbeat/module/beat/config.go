@@ -19,12 +19,16 @@ package beat
 
 // Config defines the structure for the Beat module configuration options
 type Config struct {
-	XPackEnabled bool `config:"xpack.enabled"`
+	XPackEnabled bool            `config:"xpack.enabled"`
+	Discovery    DiscoveryConfig `config:"discovery"`
 }
 
 // DefaultConfig returns the default configuration for the Beat module
 func DefaultConfig() Config {
 	return Config{
 		XPackEnabled: false,
+		Discovery: DiscoveryConfig{
+			Paths: defaultDiscoveryPaths(),
+		},
 	}
 }
@@ -0,0 +1,186 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beat
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+
+	"github.com/elastic/beats/v7/metricbeat/helper"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/mb/parse"
+)
+
+// DiscoveryConfig configures the discovery of the beats running on the host
+// through their monitoring unix sockets or named pipes.
+type DiscoveryConfig struct {
+	Enabled bool     `config:"enabled"`
+	Paths   []string `config:"paths"`
+}
+
+// defaultDiscoveryPaths returns the patterns matching the places where the
+// beats usually expose their HTTP endpoint when `http.host` is a unix socket
+// or a named pipe.
+func defaultDiscoveryPaths() []string {
+	if runtime.GOOS == "windows" {
+		return []string{`\\.\pipe\*beat*`}
+	}
+	return []string{
+		"/var/run/*beat*.sock",
+		"/run/*beat*.sock",
+	}
+}
+
+// discovery keeps the HTTP helpers of the beats found on the host, indexed by
+// the path of their socket, so that the connections are reused between fetches.
+type discovery struct {
+	base    mb.BaseMetricSet
+	paths   []string
+	targets map[string]*MetricSet
+}
+
+func newDiscovery(base mb.BaseMetricSet, config DiscoveryConfig) *discovery {
+	return &discovery{
+		base:    base,
+		paths:   config.Paths,
+		targets: map[string]*MetricSet{},
+	}
+}
+
+// discover returns a metricset for every beat whose socket currently matches
+// one of the configured patterns. The helpers of the beats that went away are
+// dropped.
+func (d *discovery) discover(xpack bool) ([]*MetricSet, error) {
+	sockets, err := globSockets(d.paths)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make(map[string]*MetricSet, len(sockets))
+	list := make([]*MetricSet, 0, len(sockets))
+	for _, socket := range sockets {
+		ms, ok := d.targets[socket]
+		if !ok {
+			ms, err = d.newTarget(socket, xpack)
+			if err != nil {
+				d.base.Logger().Debugf("Ignoring discovered beat socket %s: %v", socket, err)
+				continue
+			}
+		}
+		targets[socket] = ms
+		list = append(list, ms)
+	}
+	d.targets = targets
+
+	return list, nil
+}
+
+func (d *discovery) newTarget(socket string, xpack bool) (*MetricSet, error) {
+	hostData, err := parse.ParseURL(socketURL(socket), "http", d.base.HostData().User, d.base.HostData().Password, d.base.Name(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	http, err := helper.NewHTTPForHost(d.base, hostData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MetricSet{
+		BaseMetricSet: d.base,
+		HTTP:          http,
+		XPackEnabled:  xpack,
+		host:          socket,
+	}, nil
+}
+
+// socketURL returns the URL to reach the HTTP endpoint of a beat listening on
+// the given unix socket or named pipe.
+func socketURL(socket string) string {
+	if runtime.GOOS == "windows" {
+		return "http+npipe:///" + filepath.Base(socket)
+	}
+	return "http+unix://" + socket
+}
+
+// globSockets returns the sorted and deduplicated list of the sockets matching
+// the patterns. On Windows every match is a named pipe, elsewhere the files
+// that are not unix sockets are skipped.
+func globSockets(patterns []string) ([]string, error) {
+	seen := map[string]struct{}{}
+	var sockets []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid discovery path %q: %w", pattern, err)
+		}
+		for _, match := range matches {
+			if _, ok := seen[match]; ok {
+				continue
+			}
+			if runtime.GOOS != "windows" {
+				fi, err := os.Stat(match)
+				if err != nil || fi.Mode()&os.ModeSocket == 0 {
+					continue
+				}
+			}
+			seen[match] = struct{}{}
+			sockets = append(sockets, match)
+		}
+	}
+	sort.Strings(sockets)
+	return sockets, nil
+}
+
+// hostReporter reports the events of a discovered beat with the path of its
+// socket as the service address.
+type hostReporter struct {
+	mb.ReporterV2
+	host string
+}
+
+// Event implements mb.ReporterV2.
+func (r hostReporter) Event(event mb.Event) bool {
+	if event.Host == "" {
+		event.Host = r.host
+	}
+	return r.ReporterV2.Event(event)
+}
+
+// FetchTargets calls fetch once for the configured host or, when the discovery is
+// enabled, once for every beat found on the host. Errors of the discovered
+// beats are logged so that one unreachable beat doesn't hide the others.
+func (m *MetricSet) FetchTargets(r mb.ReporterV2, fetch func(*MetricSet, mb.ReporterV2) error) error {
+	if m.discovery == nil {
+		return fetch(m, r)
+	}
+
+	targets, err := m.discovery.discover(m.XPackEnabled)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		if err := fetch(target, hostReporter{ReporterV2: r, host: target.host}); err != nil {
+			m.Logger().Debugf("Failed to fetch discovered beat on %s: %v", target.host, err)
+		}
+	}
+	return nil
+}
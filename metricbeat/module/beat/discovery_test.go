@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !windows
+// +build !windows
+
+package beat
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobSockets(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, name := range []string{"filebeat.sock", "packetbeat.sock"} {
+		l, err := net.Listen("unix", filepath.Join(dir, name))
+		require.NoError(t, err)
+		defer l.Close()
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "heartbeat.sock"), nil, 0o600))
+
+	sockets, err := globSockets([]string{
+		filepath.Join(dir, "*beat*.sock"),
+		filepath.Join(dir, "filebeat.sock"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "filebeat.sock"),
+		filepath.Join(dir, "packetbeat.sock"),
+	}, sockets)
+
+	_, err = globSockets([]string{"["})
+	assert.Error(t, err)
+}
+
+func TestSocketURL(t *testing.T) {
+	assert.Equal(t, "http+unix:///var/run/filebeat.sock", socketURL("/var/run/filebeat.sock"))
+}
@@ -27,6 +27,9 @@ type MetricSet struct {
 	mb.BaseMetricSet
 	*helper.HTTP
 	XPackEnabled bool
+
+	host      string     // Socket of a discovered beat.
+	discovery *discovery // Set when the discovery of the local beats is enabled.
 }
 
 // NewMetricSet creates a metricset that can be used to build other metricsets
@@ -43,9 +46,12 @@ func NewMetricSet(base mb.BaseMetricSet) (*MetricSet, error) {
 	}
 
 	ms := &MetricSet{
-		base,
-		http,
-		config.XPackEnabled,
+		BaseMetricSet: base,
+		HTTP:          http,
+		XPackEnabled:  config.XPackEnabled,
+	}
+	if config.Discovery.Enabled {
+		ms.discovery = newDiscovery(base, config.Discovery)
 	}
 
 	return ms, nil
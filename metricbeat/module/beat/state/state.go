@@ -56,15 +56,19 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 
 // Fetch methods implements the data gathering and data conversion to the right format
 func (m *MetricSet) Fetch(r mb.ReporterV2) error {
-	content, err := m.HTTP.FetchContent()
+	return m.FetchTargets(r, fetch)
+}
+
+func fetch(ms *beat.MetricSet, r mb.ReporterV2) error {
+	content, err := ms.HTTP.FetchContent()
 	if err != nil {
 		return err
 	}
 
-	info, err := beat.GetInfo(m.MetricSet)
+	info, err := beat.GetInfo(ms)
 	if err != nil {
 		return err
 	}
 
-	return eventMapping(r, info, content, m.XPackEnabled)
+	return eventMapping(r, info, content, ms.XPackEnabled)
 }
@@ -61,17 +61,21 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 
 // Fetch methods implements the data gathering and data conversion to the right format
 func (m *MetricSet) Fetch(r mb.ReporterV2) error {
-	content, err := m.HTTP.FetchContent()
+	return m.FetchTargets(r, m.fetch)
+}
+
+func (m *MetricSet) fetch(ms *beat.MetricSet, r mb.ReporterV2) error {
+	content, err := ms.HTTP.FetchContent()
 	if err != nil {
 		return err
 	}
 
-	info, err := beat.GetInfo(m.MetricSet)
+	info, err := beat.GetInfo(ms)
 	if err != nil {
 		return err
 	}
 
-	clusterUUID, err := m.getClusterUUID()
+	clusterUUID, err := getClusterUUID(ms)
 	if err != nil {
 		if errors.Is(err, beat.ErrClusterUUID) {
 			if time.Since(m.lastClusterUUIDMessageTimestamp) > 5*time.Minute {
@@ -84,11 +88,11 @@ func (m *MetricSet) Fetch(r mb.ReporterV2) error {
 		return err
 	}
 
-	return eventMapping(r, info, clusterUUID, content, m.XPackEnabled)
+	return eventMapping(r, info, clusterUUID, content, ms.XPackEnabled)
 }
 
-func (m *MetricSet) getClusterUUID() (string, error) {
-	state, err := beat.GetState(m.MetricSet)
+func getClusterUUID(ms *beat.MetricSet) (string, error) {
+	state, err := beat.GetState(ms)
 	if err != nil {
 		return "", fmt.Errorf("could not get state information: %w", err)
 	}
@@ -32,5 +32,5 @@ func init() {
 // AssetKubernetes returns asset data.
 // This is the base64 encoded zlib format compressed contents of module/kubernetes.
 func AssetKubernetes() string {
-	return "eJzsXU9z47aSv8+nQPmyzpbj2vPU1qtKPC8bb5KJ155JDltbGogEJcQkwACgPXqffgt/CEIkwD8iKGts6ZDK2Fb3rxsNoLvRaHwPHtHuPXis1ogRJBB/B4DAIkfvwcUv9ocX7wBIEU8YLgWm5D34xzsAAGj+ABRIMJzIbzOUI8jRe7CB7wDgSAhMNvw9+N8LzvOLK3CxFaK8+D/5uy1lYpVQkuHNe5DBnKN3AGQY5Sl/rxh8DwgsUAue/IhdKTkwWpXmJx548nNLMsoKKH8MIEkBF1BgLnDCAc1ASVMOCkjgBqVgvXP4XBsKNRpLsIYES8wRe0LM/saHqgdZS4E/3N0CTdDRZf3Z12n9cTXVhsfQ3xXi4pohTiuWoL0/qpE+ot0zZWnrdz145edeU0Yp8NJuA+DVekkMIfIdGAkt4wMAiiy4TPKKC8SuFFNewgRdWe1814vrCbF1PFg/f/p0Bzok2zwTmkZUheLZIdnlSQQiYiUZxR8Gg0GxAB0WbSwp261YReLB+BOJLWJAbFHNA1QccZCyHWgzaoN5xKTNbQaSXzBJ5cJmqA8MSVFSgoiIx/6mJgm2kKQ5JhtXKb1o2qvmTCRyOVUkQUbrkRmxTDwhxjGNaBqGoEXRFbMNQWlub1uZCaGeJD7CbeYFElsa0R7VxPQQ7QhNeUQztBK3qdZsS0YTxLmXo88QfTutSy8pq2uOks7va5oprdZ5e93rCHJz9xlwlFCStpE1nApUULaT2zpOERHX613jFHX55pRsPL/ULtF7EPryHqof5R8BTEDN02AYgviEmahgfkyEhuUQwCzl17RE5DqhVWf1G4S2x/pjVawRkyuuJAgynCP7B5SFh5ELyARKIxjNgzYYwDFJkFpijHHXPLwT4BmKZBvN/NETIoJfc/wvpIf7el0lj0hc/3tQOLr+CyU+3etfrMYPwZ9SFA0BSAQgxVwwvK6Uz49JwIbC2HlVLGquD1UhDea5wc0VcH4I2Jgm7CIaguBxW8DAot3h3F24gVq89T4NHo0vI23agRbYR3hJCfe7loeY9MvYclAjSrge81b+BYLJVgt7Vfsd6n/WTTBy5QZMVyZ8kXGxdQavx6jkSFOkHtXR82OhidHgELR2WbjXDpMcSx02LnYXRBBAkLnmByA35Ps8qXguTVT9eVTmMksrplI019VBtrXHtnb+aprS5AtpOgVOGB3yr1wk81XQxUJch0H/cByYI65Cdr3JoUAk2e0tOVdgi7mgGwYLoDGF8ScVY3I6zFfkLclyvNmKYVOS1FhFCCabyGtAPQ0TgZ+Q+jYwjPrXBCSS9FoPQpQFoUlSmqHlAArFxcseVikW12rrjMJe0fN5CfsMGZLQUBqRZ02yzbxZsoiAmMxLyTratfSiZGSVO74SuPA7KSkU7V8MuAYPkiDoEHRi0tGbwVBa5+4zqDjcII8iQmK7UNR3g/PQB6iP6p6QlPkIDxMfYuAy8azQbTbBWK3+jHDz6s+NNTup9xvKkFE+gSS4f+3hhYRKxYRgj4A8Eq42DJQOsLTAaIquS+8m1eDiCcxRuspyCkN/WPuSJWJJN395kAxSv5ADWNOU/zaxh6AC5go7gHlOEyjgOkfye73C5rjA4tuTNkUZJijV8G3aslkKL+VPghoBOAMVUd9F6XfX4DZrfV3+jfo1B5AhUGDO5QYqQxD5h18k0S/qn1+4gAKt9A/MuoPM19ZUbKVXItmmgBIgtlAoQFdAbHF9HAmecZ6DdcMGEYEZynfX3hUzp5vxKcEBff9KNzJeyejEpRI+QZxD/8Scv1yGgi8wblUYiuHAeDtU+rHCggSWMMFiNxzi1X/5FvSj59l43cil+C3oRW0549WC5cIQTkHP8z/8EQaIus1+UnbQzJagQE6Om6F+xygeLslqDKSAdS4BSRmIB9L+EUW0XMlbWbTbdjhwzrKc239qKtGKCAp84h7wbw76iU5wwALAyfvBY2Se4Qobgxjyhg2KU3OI3eFjnWNy8Mqm8P3DQ/8EtoemlD1isuEonFN8HRr5UwsKOBLjl7bTnOchUY4054O2VMINymCVezLZ087//aI3qVPJCAQ4WXcI/kXZ0RApbkFcdt2hVGQRK3TeRth4T6lQVSh8xwUqJkeQb8WT9evJjbDOobZfRya0ermQ+yhh5GdPAOkeMzGa54jpmwOzjptuLDFzDyHOYdMxy8qPWU5+7PrUuHWpiluwKFX+Nx6vj7BA42qf/0VJRL63JGOQC1YlomKoS/y0S3Bt+ojp20J65bu5+6zOWQEvERFy9TuX6c5A+O2U6UoIBfwaAcGvNp54sUJhWy5Qxzq2UFiVDlcEfwWopMk2ZOD7RW7RZm5fsdw0JTfDrFZaW7IjqHO97gqsGX1EBKT0Wbox6kpgxdWec2X2AjX5PWu/5y5RxMo1W/VoYNflV63atY4Acmc+DPECBVK2aM0pcBsYgMPxH7UorjUq7TrcTlncTDmPWG5rZVMFxqZcUn0zngxL1OLFQ/cShuSt5o5nRbqGc7khMCXREfEtb+ZuOXkcO+9K8QKFugvYkpvz/rtCVbybFVIwJAMEXaM737H5mT7L2HlX+yxgC7nybwwnW6Vr/B3KwBohUv+4oxQrsi8ic/IQJMME820U56wjA80UDiVLSgnSBzpY+c8loxsmHTc1cpCTfxNaooQS6fsznaHpVcGhUsM0jbGQ/Gm5wTTVVRSHIkpRKbZRIZmydU35UFgMCYajLLoNMOIsv4r6FHB7R9AJzXOUCOq/ZnzYHUCcKLcs5i6jzlhqyl1pPZkLd35uEczFdhcVkaWqsE2EFFs1E9lr5IEjuvEY7vYOzSaqw+4CCKaIXWO+KiAXgevua0pzBNu38YeaMWybbgxJN7uKCRdQxruYGxCWwrs2yPZ9w8nZ3U9b5PZ4MZc97dk9MNPQ/kYt8pAhsEFEBk+6K019y8Ms4nscsFrx5UD80u6RAyZkjsMWGrCL3kG4kVQ0F8BQQlmqN+Rm/RK4QPpnJWQCJ1UOmbnDK3c8mqg1OPUgVN8UsCg9KLvrVl+OPMOMi5VhRQLtaaZfy/hUA5RyKh6g4SF/Fr7FmsPFAUkWA3iaBCHvHPprDAJ9FeOt4TdNx1gCSpteGPgJEY86ElruVoL6EDS7K+StNh3hnHUvuntFaSw4a4XtHjMHcv+0K206rp+jJ4EfMvp+jmpZrNu0MFRSJnSfFsw9Y9E3gRZtIJMxWoDnLU62Sjl6bcC8WRm9kOKe13yU7ockDCgZi8U5qYIpFHD+iP1mKAHIOU2w2hWesdj2zqG+cfMvodOdP2sHDHUGBPQtWCOOYfcWLcVApRT7ZkoDqB6XVdwjtf8yZI1JZI0x+B3t+Od5o3iq/mNxGSuSMqbUk0BPgGc4NBvrY8dV9LZKf5i2Sq5C+k85Kxzx2PgzwX9XCKhzNpxh6W1SB4gnw2OXcZRnqxyTx4hg7n+V6zhDXKIxLbdC2wgmTzR/QunKg3Gp1anm6dNL3zoFSxzfcn64u7VNuYz19AxX3O5skrfb1aSHcdzFw12wepguN19ryhNUH3fCfr79MMDbzY/MifmcC+QqfXG+O36+Ox74xL87rjzWb/3a+PmWlv9vzre0Op94t7TO9106kM/3XULQz7c3Bm5vECSk9URbu9nXV26C9yhB+Enl+1VjJ1LfRpI6BpgIxDKYIHWHsPNTgKWvKerLS1f2mp9O78mNlgrp7zCUCPAE8wqBL//xpVc1iDFfXeJo3YyV+6vh9EIi25zYazewTwwSXmAh3p6NfXpBG7OHTeerbPVn5Kj9dL7FNllF5wts7qejnrdxd80pVgm0QWnDOk4XmwbXqfSvaRCFethY/7MiwezbIWs4LqS/vlBPovD+MMxgiAkYOdPB+PTWmBkPpqXBbgsVn0zfQcDIXQS8bUWO2GfAlGXvTSrRvxvZFMPejdQ5pxAlTb/JQ4hzDqH+HJRDOIXIy0b357hbnNK4fPKOy5s6/TuZ064OsFNsETelSfKbaowsN1fbHYq320OZjsiUIEAZKChD7h/X17Bpqmq+W53ihr+EefOd0+igHPnE9nw02QF+kqvDuYFkvCVjVBfJb2ltAG8wMbs3qcNCtw7aV6//pF0r5rlz3v4NLgBxOkvOWwzCse6rr2LRlmSbHclRUJfgB6qdSrhBqwULJTSs0WUbq+PgCRdtOO24vu7mJJyca46KVszH7M8d5iZxCzZzOGZXwHN/NxC1ecm5v1sP63N/t3N/t3N/t3N/t+Zz7u927u927u82ZwjO/d3eQn83viNtx+Pgw77Hao107GciwB1JDjz3Y1WOeNzNd0eSOwnrXpK2q/yUt8G7oGLOuADAEc+Ed3Ed0UYDsAdtNSiNKftQTe5gUWCyWcIJM1yAwybkjx2KNGq3rx64IyxkAOkRzeVjjyDjbcauXskWpVU+7wkGJ3Nl6X1zjzAcM430mtNx4V6nB7F70AYlrbxL2Rb9dNq5xOIZbhRTMprhTlIhDlcfbcejqvKIwv4gBCpKYejKQLSew60KykX78ox6Z+M4LQ+bJayn0yE4J2q92M6JWh/Ac6L2nKidpuRzovacqN0X4ZyoPSdqx6A7J2rPidpzotYj4fkhjpAc54c4AhKfH+IoTvkhDm4zJtGmdIlIKudySaMYR7PL1CNhGADJoCOztHMts2i3R98DyRAqFIdr9bJHcdwEdNNby+IABseMUwuPUHOjkLsuPh/JHgQxLaCrrTFIoE7PxYBiKmsbGDXteolN8ooLxACnIIPt7JqzcNaQXurArEmUGigzIhmTalUbkDe1OiB3pEiZh2UaipnnShDTxrtiTMLbnI+Yev53bTiHXeC39KKcSh3cVrmjxxuLC/sz7TqhE29320IevjnkF6AtRN8NUCuOYgQurV/6DLFQ/yMQKzCB/VcvEEzDvbn8+f2RKBuEiolfv3tBkYCs52IBJgJtOgcRB4DRfAJ9hBzFtN9sccHMGr9P6vBFveRSv/tkLJPXvkuu1iIzlODSwr9Rj0vI0b1hkG9/pbT8ESaPNMuuwD8ZU8077qo8v/Iytr823/kOUOaYieRTlDkSKL1qNHYDCaHiviKKg4wBfv/9t19wnqP0OzWoKHyFTr0R1DBYLa1V9WCQV7XPUG+9jbAK+jiRpZoaof23iaf0IRgMdtUdstDte003dHVs0mS4ufusOotzzXJEsv0okAw7lIKj9dvWKl/+OGtIfHNxTt+7G+z+Vo/Ly+Nuhqy++hfqFZQwSv6i61juhqYWxdmYc9Z+Y3AMBdCzGXjpOM6ceSzNPzPG8GlIgJLmuEXJureJwE/I69AGjS7gyGpSKkq3tQldI3F8Qr7iFZfBfadR1+iyhL13F41msQxZfXQby1VPR3kSdz1Juz22/1QHpXW2Tp+guhDkLuV7oGpvP60tIBoOqXS1c9YwWEX8EwR9XYi9pDzIPkUwzTEJcx6yuQ+GgGUNMxWD10U4EkmdDZV+VwZx7ozEmP/p/2f3f6xsEBWU7N+pn1Mc+EHRe1DXvY+8MjabU5njBI4PqQY2HK90hsmBldvDLR7GxORhf7RJvTZNCGu1gBKxRpAgxBRxzHq6384DaKjv5YgnwesPIGNpT0eQE6FV5HjD6/AagumcQOR0V8x8tNdxhRqCUeZ8CT2dMGfU/f3iRaq5+JIDR1hGHBx20DDJ6MRVZGiKzspcfGgwNuZWT1uL+pKXKAmneYYnQiyM3Wkwa37GguWbn2FgZep9IjQ6KM2nC6gGMiZM8k/scU6CP3BaKkTyPPa83X+eVDl/fVXJMkaItQ7cNZ1mbdjRDjlGeBAqeFlgf/HGR0EYLU91CRiaRT8MXiUJQt24LC4SxYXzrMq7aGokkxqgj98xpIXaF4mnepuhp4dt4OR7fHi0M6JqdKFwnn7uiyj3cNUp0KMi83NtYfJcFIs1jA0HwJEQmGymjueyrnlCSYY3FVNZUAtVJVzc9QpcPnS2/saFYzDPUY55++A0lhIdDievRRers+H06I8+k8DNl/maU7SVt8kKdT48UW3eMqJoBynurmyfw1XFZPSZcN1YsbtZNug8TxFHRFc/S9yDDFyizTW4uGGU/DddX4RdY8xXCSWC0Tz3unQRIP/+XB/uWUbg8kKwCl1cgYsM5lz+D2Xg4j8JJegfF35rnHhAPc0czXWGw+2xXs6XUaGbr93bPAKKrMgjoc+kZ9wHPKaoaI3rNBaqneKn+bhzzCqJ/vTRrEFQrwu7pQfgUqr/CijlS9UbzYdtpCImNdwbo86qklAo9/gMFUro87VVyRDnlfdR6FjK012D7wyjg7WYYv54DLgfMH+cDZZWYkWzlcS8INTfK/F7JvEejLPE6TF0enf7YbZKTT+H1Zh8z3zEpmfDZyfhMwX4EuUcTmPc5SoopF/kduBtSjtoBqDeAJSbBBlysnQyklAnr8ECb0cn5kWqASFmhPj1MwT27avjvbfujNFLVVO0h9BUUjTjV9dU2GsdM8fxpQT9qL0RJV3/M2dO4m+RoVbtq5eyZHcse9NmdkAWhaO6d7ef22suYzCOuUBEPNG8KmL5nQ1ZoOk2KVdGC/WX36tCvO9fuoDnDw1PkggU+PXNmnH1B4aH983DvrrhqULokmCYJJSpCzCCOmMScOspgxu0SnIY6K4xgvuDJgIUEZuj6tgTGFMgEbLLJIe4WMw4kxyesIne/XHTY59ahNUcBj9ikqK0VkaYlSn2WxmrmTEj7ptKz3p6xZ8VUm+KgJ82VKcJq2JGo6gfFAkgSfh5LDi/7v64aRdgd2fRyb3PuKVcrHB76zbnq4ensiQ8SRrc3sVIX0xjbOKMw0qRFrwv0oJpLozc1xdG7sw9zOvrQ++JxEQ3L2NTl/ItlNhrD3jNzYf3qou2XdIyr9rPmZV1FRyPVO+3YM2NCzVc/HNKpXt79Vz3+h8vV7F3OK4XK9UbgY2uVZefpZS2QQTV/YUMp/oOeAMO9JwDNQXYa7RMocW+FrMqz3c1t0FtOnfo1HnY3xUVMNrS4tCM80DOYmX09wbr/yisQ8X0bS1NQaA56NMylILLLWSp2qA4Sr8b12FxjqO+L2jwzomns8FoFq6EeubIr16BL1LUL1LWL1LYL4H9wyP4AfLpc2jdqkHCgWWZY8SBoN2Isf+f4QhTLgc4iZXwMNRe/D7Sg8HRk8/QHQhCTvgIHrdEIEZgDm7vrMkb+f0s0Vf9hVlBai1ZTQx8+PgQngKW5eFidhgGYoucwnS1hjkkySy1/kphCn40dKxBBZjOmeK1YB0atjaCqI5Bs0xE9xwKoK8ZyJBtjk3UbH720ekvvwsWuHlVpWjIxXDvC250ibIqj+fY1xSjefZ9ShhK1vgLeK1KbF0kuFQNQfU++GAkaHt/Rwg19pRnfaiDoo2F/dPG8bPu6Z7PF1IieIGwo3P3YCzAF4s/hkywiQ6WNkInDjm80mphW7QW6IA9DRusLW8EsFbetZ12nbcku1nYF/fz9tCEvb2S0SfMMQ0VtE44XGooNV6fiyJ0ZqCOblaeO+WTAgNFxdxMV/zTHYEFTqAMmM3uZk4w/Edd5pxkjVXWc1ba/zea6pP3FKmXahvdqK5MJAWGS3x/ZG/YB7wS1Y4+lvXr3vbOK9Bx3sztNqGaNBLdRaNpTRF0CFUjoFXwpsjQGHielbDN0v1STqgpGpLu7rN5wPuw0wb13eAyPefR7YR6C9KGiQ8xcJl4Xg9qs+l92huMb7QFjLZvKENG5QSS4HNLeyghoaFSqZFAx3YDU+aA0gDLhWqmljOiU3u53X0yP+zfvvpn7O8fHsap4pmyR0w23OMqvi6N/KkFNeH5CM2UcIMWfEHfaYEqV6oAJ7sewL8oOxoixc2La981i+Sp7NebvZiD3tWKU2nm5ZZFfN7IV2k2RLSPMDjJWfgTzpFxTFUF5UBRKZh0jPxKVdSUDg/qyNMHBLx29ShvKqgZV4jS22m5EYEnMEfpKlTa7wpSIpZ030iaKMqdJiKXWZoBU2ChosXgAGNC055C8DlDHLAdENX9/awHKyCEs7Iy1B+bxADzE0NoDJhQL+i4aLT/OgLON2DGRor/DwAA///rmoGd"
+	return "eNrtXVtz2ziyfs+vQPllk1Me1XlObW3VjDOz481lfGxn8nDqlAyRkIUxRXIJ0o731x80bgRFgBcRlO1YeUglstX9odFoNLobjZ/QHXl8j+6qFSlSUhL2BqGSlgl5j04+mg9P+KcxYVFB85Jm6Xv0D/4BQvUvoC0pCxrBtwuSEMz4928x/x8jZUnTW/Ye/e8JY8nJKTrZlGV+8n/ws01WlMsoS9f09j1a44QR/umakiRm7wWDn1CKt2QHHvwpH3PgUGRVrj5xwIM/5+k6K7YYPkY4jREr+b9ZyaGibI3yLObQcYpvSYxWjxafhaKg0RiCGhLOKSPFPSnMT1yoOpDtCPDni3MkCVqy1H+aMtV/bEntwivIvyvCykVBWFYVEWn8kkbKp/4hK+Kdn3XghT+XkjIXmJP2LgBWrebE4CPfghFleXgASJBFb6Ok4miKU8GU5Tgip0Y67zpx8flehYP1+/X1BWqR3OUZZTEJzLNFss0zLUlaLoFR+GlQGAQL1GKxiyUuHpdFlYaD8Y2UG75u+V+aB6oYX9OcEdpltAvmjqZxOCQfOTUwbIp6z5Rs8yzlEgvH/kyTRBtubBNu+G2hdKLZtZoTkYA5FSQRt/8KxAAzwdcO4xTD4VAEDYr2MHchCMk1tpVAi8RFeJc533o2WRzYODiItgadsXKGEWcepcuLLCKMOTm6FNG109r0orxaMBK1fq5pxlm1Sojjx42BnF185S4AN2Mx83Lakm1WPMK2TmO+zharx9opavNNsvTW8UPpEr1Hvi83UP0Cv4RoijRPhaEP4j0tygonh0SoWPYBXMdswbftlFvAqmX9eqE1WH+pttyDA4sLBLmeJMT8Qlb4p5G7oQV3XwIozZVUGMRoGhFhYpRyax7OBfCAy2gTTP3JPVcLtmD0P0RO92JVRXekXPyXd3DZ6i8SuWQvf7AcPgXfYCgSAgIEKOb+fUFXlfD5uVa4dciPnVXbWdX1qtqCwjzUuJkAzvYBG1KFbUR9EBxuS5/RHmC4pfGW+zS6U74M6LQFzbOPMO54MBJMpZ9Gl70SEYPrUG/hXxDMZSQGe6r9DvGPVX0YObUPTKfq+ALnYuMMLoaI5EBLRM/q4PUx08KocZSZdlmYUw+jhIIMaxe78rjXDgBe5pIfwkyR7/Kkwrk0QeXnEJnNLK4KEaJZVHvpVoOtdv40TVD5LajOlkZF1udf2Uimi6CNJbUdBvnhMDAHtELG3iSYH6ijx4bJOUUb/r/stsBbJDH58UdVUcBymC7I83Sd0NtN2a9KQI2fuVN+9lzMocMIRyW9J+LbSDHqtgmkjOKFnIQgBqEOUqqp5ZhKwcXJHlcxLRdi6wzCXtBzeQlNhgUBaNzFDcdTk9xlXpustMQ0nRaStaRr6AWJyAp3fFnSrdtJiflaG3fKvQKCqEXQOpMO3gz6wjr8YFoxfEscghiyl4jvetehC1AX1eaUF8T5C33E+xjYTFLm/ZXes9oIN68Oomm1A7mf8REq4ac49e5fDbz890Aw7M3ekAfClYrBV2Q3SwMsi8kid25SNS4W4YTEy3WSYd8val+S76ARScsQYwD5cgcLa5rwf3X2KLMSJwI7wkmSRbjEXHDwvc7BJnTLzeCLG21M1lz3YgnfhC1rU/gWPvFKBNE1qlLxXRK/W6Dz9c7X4XfEjzl3rthbyhhsoHAEgV+8AaI34r83kKojS/mBsjtEfW2VlRvwSoAtPyOm/Lt8C4RfPeX/pDodiR5okqBVzYYPlXLL/bh443Ydblkok/mJ0+JO5zobaSrxPaYJdi/M6ebSd/gaaBX6znAj9FDIxwwWRTjHES0f+494+jdfg3zkOhsuGzDFr0EuYssZLhYKhoHN5H9UaTn7Nnst9KBeLd4BWTHugpAD4QJWQyB5tHMOSEJBHJCaKYpgsZLXYrR39bAnzzKf2//cRCIF4R3wM/eAP1voRzrBHg14/n7wkDFPcIWVQvR5wwrFc3OIG3Flxn7wJXx5ddW9gE3SNCvuoJaRlD+4RL7JgULx5nDT9jzXuW8oB1rzXl3KOc81rpKSTcz/u4deh06BEfJwMu4Q/isrDoZIcPPiMnYny8p1wAqd13FsvORSE1Uo7JGVZDv6BPlaPFm3nOwT1vGo7ZaROlo93ZH7IMfIr44DpJ1mKrIkIYW8OTAp3XRmiKl7CGGSTYcsKz9kOfmh61PD1qUKbt6iVPg7HK8vnNqw2uf/ZGlAvufpusCsLKqorPjBpEX8eZfgmvBRIW8LScsHSQvIsyKWQ5Uht37HMt0JCF9OmS5A2OLvARB8MueJJysUNuUC+qxjCoVF6XCV0u+I5Fm08Sl4s8gt2MrtKpbbd5qFpTUlO2VmXa87Rasiu+NzEGcP4MaIK4EVE3vOqdoLxOJ32H7HXaKAlWum6lHB1uVXO7VrrQHAzrwf4hkKpEzRmlXg1jMB++M/aFHczqzs1uG2yuImjvOA5bZmbKLAWJVLim+GG8MctXjh0D2FIjmrucNpkazhXMxdEh0Q3/xqbpeTh9Hz9iieoFB3Bl2yY95cX6twNytgYAQOCLJGd7pj83v2AGfnR+2zoA1mwr9RnEyVrvJ3uA+2IlwI6uOWUMyQXScyKw6RrmlK2SaIc9YaA1zH4TjEWGJ+hpIJHSr8Zz6O2wIcNzFzmKV/K+WI+FfB9y9khKZTBPuOGsdxCEPyzXDjBGUVxb6IYpKXm6CQVNm6pLwvrAKiOCSsrFLL/ArqY8A1UtARhJwifvIIeAeQRsItC7nLiByLptwerSNyYa/PDcFJuXkMishQFdhGQgotmpHsJXJPim44hotG0mykOMwuQDA3VAvKllsMrTOcerjKMv576chmDJu6G0PUjq7SlJ/34LzLbakEYSi8aet085w7Orp7zTFYPV7UZU+Tu0dqGZqfCCMP+fxbksLhSXal0bc8lBFvcKDC4sNEfNztkTMmcuzXUI9edE7CGVCRXDiCKCtiuSHX9gvCEPKzHBcljaoEF+oOL+x4WSRscOxAKL5Z4m3+Zojd6oqRr2nByqVilUahrmVca4AwTsED1TzgM/8t1gTPDghY9OCpA4SslfSXGEryvRyuDZ8lHaUJXKVNLwzKOTvEEWX547LMXAjq3RWznTYd/ph1J7pLQWkoOKOFuz1m9uR+zb+mw3HdHB0BfJ/Sd3MUZlG3aSlInhWl7NNCmWMuuhbQrA1k1kW2RQ8bGm2EcKRt4BCNZTxAvuYLuB9AGCqqBmKxMlWYr1U8fcY+K0oIc8c9omJXeKDlpnMNdc2b24SOd/6MHvDVWDqSsl6DNSAN2zBagoEIKa47B60B6XlZhk2p/VORVSqxrpXhUPm8QTxF/7GwjAVJOFPKRSAXwAPuW4067bgM3lbpT9VWyRZId5azogHTxl9Tyk9ZSOTZKF8cUBNmAXFEeIwZJ8l6mdD0LiCYy09gx/noAY1queXbRmh6nyX3JF46MM5lnTRPl1y67BTOaXjNgTzEfVN7OqYrbHc24G13NelgHNZ42Aarg+l861VTHiH6sAv26/mHHt52r9GElFPbenIScGAT+VaI0+UZFJWuSfQYJdpOKOvJPc63F59+/ee7rtKfFSmHFv+YatWlqBgPJ8UrIKfFZ7h46jfl2BcmCxjSSdTVuFrAhom/+eOx3uRYb/KK6k0+4+90W22b/WZeeN1JnpDbgFUnCX26aoIPpkhirZCI7Ay0o3Yn7ob2L3IMK1BZirgd0Ql7X4Bh89JAvpc9TUtS3HMzdNhpP1dsYTd/EDlICTfMpNuDCjjpmixzod4XX/g5r3H6U88QX2V8BCF2bjBowpp1OnZwSBYxV2CrekedWoauZQQHgMe3ZJ7GoaNH0pNq8t+2cWd2Bhbo2xkegUgpAMQjudutDqAqA15u4Hr6lqZVKTIO3F3ZZIm8XKlcfdmsW7T2g2joAFlwUfhGaNLSlEW4iJeqX2ZIfVekifNQ4dk6uYledLVFG9uRS9h8ka7a4Ht+wqkrDDgkxn3ZVfa9+3DCguCoyTnrOsmwswl7nm0Td0837TF2HHwaicyiyIrARrd19JJM9oX4HByxlryH7cshhjyXk+YcUmjwIfUK4MYTVN8ucwnUB1BUoRxbAB5bAKIuNiFbAIrE40vv/ndstjNJusdmOx6RDOrVcWxbcmxb0iuZYxOOniYc3P8B7QkXdP3+oy9KEhF6L8o2RX/uVDeVARnLCNUaR0S0gmp9CiVFaVbqHjSnpluTjEvARptBlKMo+BkH3eOkIujmv286RSMPZbO7ApffFacnGrIpbfrRFey6wCnb0rJ8fTp2/YQ6ZhJ0x45EI2ftt2MzotEiOvYh6hTP62hBZN058nSzHRp7ngvXc2lDXCPytSLeCfwHs+F0C/76TK2l/ftD2NhW1xIdEd4asuJHhsHOt+J8Mn4HGbqLvHJBDthnRpm9VylE925kJV4DZSEgsfoSkxDHGMKkGMKzOt0fz93lc5qXa+e8vKrs3/Gtq/FjGNbm/1W9bwWba11KtNvlWz1sBW1Y+A+2kJO1fllXNUPbOMjWNhv+93+Jsvo7z+MhrMAZ22Nq8mVYh+M7IOFMxqDHQF6SbXi9JRhq+vyD3km0L3/8TLsUzEMr3/5qHwiZZgxecRWL1CRzhxBmQfQy7Kl2gsKF5YyFEhLW4LKN5WHwDCvaIPmGbEmBkyUrs6Ltiex9CvxVE0aKsDwPrR71EoDK6CipYt2EoNAvSKiLrbJjITyzKtIHJbPXDPwIPiLbvHz8QAt0nyXVlow/apL4AJdL3dPUK6H+LfUZYa/Dw6q7RdcAdFD+yfBfN3MDylxb2ofg3o/pj7E72p48YoAa9y7Ykklw0J7021TMv9VptuCQOxPa+0M+l2h9y9HqEvD9cWrrBx2wB1qq53mQyP3xoZVR3Lw9jQ/5OM6x7URY239sO9HB+vjMyfGZk+MzJ8dnTuo/x2dOjs+cHJ85mTIFx2dOXsMzJ+wxjUKFyaBmS5791AmQ096zbqKoEi7qoJsvB3MBsC6BtLHySkmGdfNpgQq54jwAva06unAdUEc9sHt11TsaVTYn3nrB2y30bZnBCVNckMXG54/tizTooxcdcAdoSA/SA6rLl46BDNeZ3b6tgQJXpllro+TrFDJi5HueWSE03UDJfEP9LiJpnGe0Me/7dDJNfRGvfUMnYkQLqN5apGySUp5V24rPGL0nddwE3l+qtrV4ZK6dpqJHxU9dDRx2ohVWJvkAEYsz9d6Rnc9VZ2fHBFib5gPOlUQPBxJvxeMiHB+wbwR0U18wM/dEMl+GFqnE2stVoq600XPTodwTnzaJyhesSGYML1udfFPxfJVqAGJzKWOWEKgBMDoIysninCxFmfm+b3TZ/Rq1x7DGFKrSyky/gYVY5sK7e26qcW1IzF3tIpDPY+gFydQdMsN0yMzZj5yB9L9yul+vfKlQYLvalH0PzIXj6X8ihh85IC0zC1cXbeuQUiUBB/tzWUKpkKILlkSvYe8mPsOLPLoAsPPtmcM8dlibsI43Do+56WNu+pibPuamj7npY276mJs+5qaPueljbvqYm37WuWkIgfkPp/ts9zAwrv/6PYjpjs3v2QM8sP5oXjWBR7zF8xySU/06hPR3uA+2Eg/JyI9bQjFDdh3ZrLse6ZqmlG2COGetMfAlJnJ0MJYYrmqK+5NU+M8ibweOm5g5zNK/lXJEEHPlvn8he8J0imDfUeM4DmFIvhlunKDsHbYvopjk5SYopEgFVQXlfWEVEMkjYWWVWuZXUB8Dbid4SdNwz5nxEw3cuViIh15C7jJ6JhQD8fBLa8yg53LMnW9G5AWBkA14jvc04uegw+bc63bMBgdSOCYUajgGNfUUctHG5yLZgSCkBrSlNQQJluG5EFDUZcwahqatTWyUVBBWQyxDa+xPshhIT1UjVAdKFZQJJxkVahUbkDO02jPuQCdl5h9T35l56ghC6nh7GKPwdmWJp/R88+fB9slK7f2gcscLvDT2NZkrq3CR5Zy7WP6LjO4BDIiet4cjGKG3xi99wLQU/+DmZUtT3H1bn+B4pifvrCePgYlbvo1DEYQa/cFkaI5968hHjwYj+Xhaz1qCYVk6z/xdi+QL0N/JHHNHXfkuibBFairRWwP/jH9Pzu5ZgdnmU5blv+DoLluvT9GvRSH6PV5USXLqvlGqf6y+8w5audRqAnzUc4SntcTOcJpm5WWVCg5wBvjjj88faZJwvZIP1i26H4CsGSznlqp6WtEhWngKErbeerAC+rAhg5jqQS8mt67rPeyKtiO+hm2Srq/byKjFACkqwUayHBBsPwgkxY7E6GBPNEmRP1UjgDNr0xS9VmSrlt6G4Xpenh53PWW6W4yvvSx3r9K/slUod0NSC+JsTMm1nykcfQfoyQycdCxnTm4g0ePefGoS/OCc0B1Kxr0Vj+kGeQ9VkpLPs+rahLaSWD4hW7IKcuoxiUPUd2kVonBkddGtNZdgd1a1I2jXYPurSJSy1jPGGgLsUorLG+9+qjUgGA4Qutg5NQzuS3r6In+fiT1Q7mUfcz+Sn3f8nPt07oMiYFjjtTiD6yIcQKKjoeB3yYo/Q3HIP7r/2/6H0a2Kn563gW9FSKKtqw6naF3wj4Xi7fyGdIr0lQi+OMHU/iQ+/anDxI64H+HanKeUVF2cS5KmDrM5Il+tI6MtP3QiCkV0DI47mgasFvzIqY3hPkNpmZu7Kx5VW7QVSVgrltURx2rEsPaC+knw1GDtu0J88a/pbaVSITcS3I2v1hO+tjfyfivZBv65Xpd+MbsGosDetMxOjLm3lja7P06xOB8EvSvRmPDADlntE+fca8Es1N1Y5+gUkz3vyPY3I53QnGtjZ3zqfmhaLNAfsh5IR36M0WKWtlbXoiOloN5ITY2C1x23CiU9GbgaCa1KDze9Fq8+mFbiM8ket81SvCknsJpgkDWfY0c7tQnlxh+dSCUXV0zyAGbEwmEmjabrbKQV6VuikwKmH2qMtbrpZWtQv+XHpejdBDsXCmN7GUxan6FgudanH1getw5+s4CSfNqANJAh0Rn3wh7mJLjjNXNFZtryEDbUclfFmbPLU4bQRCg7cFG/iWSiHbuRjgEehIiZzLC/OMMy/kr35gF5Dhjq1l0nDFZFESHxzEgEF8bWVdJGYx4VGvNU3/AdAzQUSPP9apuP9TZdAaVGvKb9fNYIZ0RcDcAletjQaGOWky+Q1QxWqszLQZG5ue5gcrTkCDWNNQe4IAy5PBZ435/mXFonRwuqiPPa9gq9vWpt/bULV+AkIQll25mEaHF49lK0sVobTof8socxl/bHSU7QFt4mJH/aQbA+sTmrF4Plb+1d2YQ1RA0rx83kEyDtzbIzoBYQ3Z0KsHUgQ2/J7QKdQLLmX9nqxO8aU7aE5HQBN8mLeSD/8aBrCgwj9PakLCpycopO1jhh8A++pE/+nmYp+cfJuxB1MePUUd2i2l8ftTmfR4R2mqixeXgEWaV3KdeHjnnv8ZiColWu01Cong5GU2ID4imRQC+AhizO6g4fTZqELzBku+IJvQXxnyIhfBC9kvy7jhOqykh1nlEnFWcJlA0+ffVZMq2/zOEKAd/XZhSefN/qQjHaW4oxZXeHgPuB85kMNqvKZbZeAuYZof5RlX+sAe/eOHMaH0KmF+cfJotUdc5bDon3TEesuuN9tQI+Y4DPUUVmPeE0X+EW+EX2W1F1RRmEEOQGINwkeN6tlg2cJETBh/deieOZlu5BTDji6wczzUssBys7s+foyV6h2Tif+6rnT5dymdtkE+fxqQb6RXojYnRmqp01dnN04bOnWjy0Npcm23PZGTYzEzIrHPHOnOLUfrwGiiZYSdJSPp0V6uF5Q1Y9yVWHXKHyZVhRy0HqBv+U8ICEp664a9UMK3tSPFqT0JgIx3WFsYOQNxFwxK2nfEwps+bE49aLB5aWUYI9TX0GcL9Sb38JIiZG1dKnQXVZPr3ktOl2NuXk1J+xil78edahn3IIyykMfqFQcqmF4Welaoz1G4ETVsRlXWCul1f4VQFyEwQWngpayCYstxP60/0sSCAgsTj0+uKjW/iWk3v7nLRm6qzZpHUAHQuW1F1/SPcPZQE8II3OLw4bQwPG6pyxXynSjNfUdmCqe2qX+p7ahbr+vVi8e4o4zQ66aREbXUEcHwSr4ebCe9pGu1vSQkKV/ugqOBao3m/Gmhsbqr/45zmV7jXquS7lf56uYm9/XE9WqjcAW7YSzcXmEtotSYlua6Y46dYTNTjUkQdqVEkfYG7XVZI8am690tx9y+HfVda4BjDNtFg0wzxFOtvtnUuF9X8E1r47PLtSGoNAcpDZMsjsbHARiw2KwWXcQY1dpzjqzYF6r7o5GqoMZmGPUK4c+OopuoGh3sBYb2CwN75+ve2B7zE+mYeWHWIADs657kP8Kwt34QfMAY1CBTwUtSe/BnmlcHTEM2TjE58TPoDHecq/n+KEO9tG5dX43SzJd/mFZYiRaWLow5cr/xIwLPcfZouh52yRZDhernAC3ZOniPUTp4N+UXSMQnmYTlniemAtGvWz2qJR2SQVka3OPOg1AziyTdEJzeZ3F53u8jtvgZtTVIIGGMPGF+zTJeFbdzjHXlMM5tl3CaEvWOMu4DUiMXWR6K3oQyz3wSs1gl3v7wBHjYbwjA+112ljZv+0dvyMe9rw+XxCfIpjR+vuwVCAT3b+6FPB+nQwtxJa55D9K61m1kWjgRbY56GDWvMGANuJu+6GXaeZZDsK++R+XgON39vLi+yewrVoT0HriORSTan2+mwUvpyBSN0sHa0sRh0MBBXVEEPwjx85A77W4cCsdjeVwWBdeZIVFVHPSWH/z5BWFRjIGsNDIkY2ohlcGiPFJbw/0pj2Hq9EvIIRSvvlkxqmn1OYFdD9Qtped4vqjjj+TjDm4ay95sDxmo15o2FyTVHf6C6+Nl/YHLmHiO96zbQLUBfV5lQ6C9L6ifcxaFhM5v2V3sbNIzYdW9pnfFxK5F0P3zXtepr5SqUGAh3ahFCoA9zjdrKcqWZqPiXy1RYNlFpfidIIycr+sapWyT1gs8Mx9oML4/Lqapgo1MuLjJQ/uES+WU9MDpJMzn8ofAU2seV83yVJYIQ8nIw9wH9lxcEQCW5OXE3XLJCn0qw3ezIHvS0Vq9LMyW0d8FU1V6XZj7cKf6MJUY6pqKDsKSodl0b+QUVUlw73ysjRB+SHF4/wprySaVh0Z4P3egiMH01JvPSV9tsDyUkRkbScNpQLSQTMLDSmkQUW4rTovwMJ1eXzOC8e3Qnr/n6Vk+UZhGVZC0JmB/MbZzIEjK8FfVg00n8dAOcFqLEaxf8Deb2HIA=="
 }
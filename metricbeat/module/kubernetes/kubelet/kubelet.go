@@ -0,0 +1,148 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubelet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/mb/parse"
+	"github.com/elastic/beats/v7/metricbeat/module/kubernetes/util"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const (
+	defaultScheme = "http"
+	defaultPath   = "/metrics"
+
+	// plegRelistThreshold is the time after which the kubelet considers the
+	// pod lifecycle event generator unhealthy if no relist completed.
+	plegRelistThreshold = 3 * time.Minute
+)
+
+var (
+	hostParser = parse.URLHostParserBuilder{
+		DefaultScheme: defaultScheme,
+		DefaultPath:   defaultPath,
+	}.Build()
+)
+
+var mapping = &prometheus.MetricsMapping{
+	Metrics: map[string]prometheus.MetricMap{
+		"process_cpu_seconds_total":     prometheus.Metric("process.cpu.sec"),
+		"process_resident_memory_bytes": prometheus.Metric("process.memory.resident.bytes"),
+		"process_virtual_memory_bytes":  prometheus.Metric("process.memory.virtual.bytes"),
+		"process_open_fds":              prometheus.Metric("process.fds.open.count"),
+		"process_start_time_seconds":    prometheus.Metric("process.started.sec"),
+		"process_max_fds":               prometheus.Metric("process.fds.max.count"),
+
+		"kubelet_pleg_relist_duration_seconds": prometheus.Metric("pleg.relist.duration.us",
+			prometheus.OpMultiplyBuckets(1000000)),
+		"kubelet_pleg_relist_interval_seconds": prometheus.Metric("pleg.relist.interval.us",
+			prometheus.OpMultiplyBuckets(1000000)),
+		"kubelet_pleg_last_seen_seconds": prometheus.Metric("pleg.last_seen.sec"),
+		"kubelet_pleg_discard_events":    prometheus.Metric("pleg.discard_events.count"),
+
+		"kubelet_running_pods":       prometheus.Metric("pods.running.count"),
+		"kubelet_running_containers": prometheus.Metric("containers.count"),
+
+		"kubelet_runtime_operations_total":        prometheus.Metric("runtime.operations.count"),
+		"kubelet_runtime_operations_errors_total": prometheus.Metric("runtime.operations.errors.count"),
+		"kubelet_runtime_operations_duration_seconds": prometheus.Metric("runtime.operations.duration.us",
+			prometheus.OpMultiplyBuckets(1000000)),
+	},
+
+	Labels: map[string]prometheus.LabelMap{
+		"container_state": prometheus.KeyLabel("container_state"),
+		"operation_type":  prometheus.KeyLabel("runtime.operation"),
+	},
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("kubernetes", "kubelet", New,
+		mb.WithHostParser(hostParser))
+}
+
+// MetricSet type defines all fields of the MetricSet
+type MetricSet struct {
+	mb.BaseMetricSet
+	prometheusClient   prometheus.Prometheus
+	prometheusMappings *prometheus.MetricsMapping
+	clusterMeta        mapstr.M
+}
+
+// New create a new instance of the MetricSet
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	pc, err := prometheus.NewPrometheusClient(base)
+	if err != nil {
+		return nil, err
+	}
+	ms := &MetricSet{
+		BaseMetricSet:      base,
+		prometheusClient:   pc,
+		prometheusMappings: mapping,
+		clusterMeta:        util.AddClusterECSMeta(base),
+	}
+	return ms, nil
+}
+
+// Fetch gathers information from the kubelet and reports events with this information.
+func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
+	events, err := m.prometheusClient.GetProcessedMetrics(m.prometheusMappings)
+	if err != nil {
+		return fmt.Errorf("error getting metrics: %w", err)
+	}
+
+	now := time.Now()
+	for _, e := range events {
+		addPLEGHealth(e, now)
+
+		event := mb.TransformMapStrToEvent("kubernetes", e, nil)
+		if len(m.clusterMeta) != 0 {
+			event.RootFields.DeepUpdate(m.clusterMeta)
+		}
+		isOpen := reporter.Event(event)
+		if !isOpen {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// addPLEGHealth reports the time since the last completed relist of the pod
+// lifecycle event generator and whether it is within the threshold the kubelet
+// uses to report itself as healthy.
+func addPLEGHealth(event mapstr.M, now time.Time) {
+	v, err := event.GetValue("pleg.last_seen.sec")
+	if err != nil {
+		return
+	}
+	lastSeen, ok := v.(float64)
+	if !ok || lastSeen <= 0 {
+		return
+	}
+	age := now.Sub(time.Unix(0, int64(lastSeen*float64(time.Second))))
+	if age < 0 {
+		age = 0
+	}
+	_, _ = event.Put("pleg.last_seen.age.sec", age.Seconds())
+	_, _ = event.Put("pleg.healthy", age < plegRelistThreshold)
+}
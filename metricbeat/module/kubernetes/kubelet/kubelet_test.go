@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package kubelet
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestEventMapping(t *testing.T) {
+	body, err := os.ReadFile("./_meta/test/metrics.1.27")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, defaultPath, r.URL.Path)
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	f := mbtest.NewFetcher(t, map[string]interface{}{
+		"module":     "kubernetes",
+		"metricsets": []string{"kubelet"},
+		"hosts":      []string{server.URL},
+	})
+	events, errs := f.FetchEvents()
+	require.Empty(t, errs)
+
+	unlabeled := findEvent(t, events, "pods.running.count")
+	assert.Equal(t, float64(9), mustGet(t, unlabeled, "pods.running.count"))
+	assert.Equal(t, int64(0), mustGet(t, unlabeled, "pleg.discard_events.count"))
+	assert.Equal(t, uint64(3623), mustGet(t, unlabeled, "pleg.relist.duration.us.count"))
+	// The sample was taken long ago, so the relist is stale now.
+	assert.Equal(t, false, mustGet(t, unlabeled, "pleg.healthy"))
+
+	var running, pullErrors interface{}
+	for _, e := range events {
+		if state, _ := e.MetricSetFields.GetValue("container_state"); state == "running" {
+			running, _ = e.MetricSetFields.GetValue("containers.count")
+		}
+		if op, _ := e.MetricSetFields.GetValue("runtime.operation"); op == "pull_image" {
+			pullErrors, _ = e.MetricSetFields.GetValue("runtime.operations.errors.count")
+		}
+	}
+	assert.Equal(t, float64(11), running)
+	assert.Equal(t, int64(1), pullErrors)
+}
+
+func TestAddPLEGHealth(t *testing.T) {
+	lastSeen := time.Unix(1690892343, 0)
+
+	for _, test := range []struct {
+		name    string
+		now     time.Time
+		healthy bool
+		age     float64
+	}{
+		{name: "recent relist", now: lastSeen.Add(2 * time.Second), healthy: true, age: 2},
+		{name: "stale relist", now: lastSeen.Add(5 * time.Minute), healthy: false, age: 300},
+		{name: "clock skew", now: lastSeen.Add(-time.Second), healthy: true, age: 0},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			event := mapstr.M{"pleg": mapstr.M{"last_seen": mapstr.M{"sec": float64(lastSeen.Unix())}}}
+			addPLEGHealth(event, test.now)
+			assert.Equal(t, test.healthy, mustGet(t, event, "pleg.healthy"))
+			assert.InDelta(t, test.age, mustGet(t, event, "pleg.last_seen.age.sec"), 0.001)
+		})
+	}
+
+	event := mapstr.M{"pods": mapstr.M{"running": mapstr.M{"count": float64(1)}}}
+	addPLEGHealth(event, time.Now())
+	assert.False(t, event.HasKey("pleg"))
+}
+
+func findEvent(t *testing.T, events []mb.Event, key string) mapstr.M {
+	t.Helper()
+	for _, e := range events {
+		if ok, _ := e.MetricSetFields.HasKey(key); ok {
+			return e.MetricSetFields
+		}
+	}
+	t.Fatalf("no event with %s found", key)
+	return nil
+}
+
+func mustGet(t *testing.T, m mapstr.M, key string) interface{} {
+	t.Helper()
+	v, err := m.GetValue(key)
+	require.NoError(t, err, key)
+	return v
+}
@@ -132,6 +132,29 @@ func eventMapping(content []byte, metricsRepo *util.MetricsRepo, logger *logp.Lo
 			kubernetes2.ShouldPut(podEvent, "start_time", pod.StartTime, logger)
 		}
 
+		// The ephemeral storage of a pod includes the root file systems
+		// and logs of its containers and its emptyDir volumes. It is
+		// only reported by kubelets with the LocalStorageCapacityIsolation
+		// feature.
+		if storage := pod.EphemeralStorage; storage != nil {
+			kubernetes2.ShouldPut(podEvent, "ephemeral_storage", mapstr.M{
+				"used": mapstr.M{
+					"bytes": storage.UsedBytes,
+				},
+				"available": mapstr.M{
+					"bytes": storage.AvailableBytes,
+				},
+				"capacity": mapstr.M{
+					"bytes": storage.CapacityBytes,
+				},
+				"inodes": mapstr.M{
+					"count": storage.Inodes,
+					"free":  storage.InodesFree,
+					"used":  storage.InodesUsed,
+				},
+			}, logger)
+		}
+
 		// NOTE:
 		// - `podCoreLimit > `nodeCores` is possible if a pod has more than one container
 		// and at least one of them doesn't have a limit set. The container without limits
@@ -207,6 +207,28 @@ func (s *PodTestSuite) TestEventMappingWithMultipleContainersWithAllMemLimits()
 	s.RunMetricsTests(events[0], cpuMemoryTestCases)
 }
 
+func (s *PodTestSuite) TestEventMappingEphemeralStorage() {
+	s.MetricsRepo.DeleteAllNodeStore()
+
+	body := s.ReadTestFile(testFile)
+	events, err := eventMapping(body, s.MetricsRepo, s.Logger)
+	s.basicTests(events, err)
+
+	s.RunMetricsTests(events[0], map[string]interface{}{
+		"ephemeral_storage.used.bytes":      102400,
+		"ephemeral_storage.available.bytes": 98727014400,
+		"ephemeral_storage.capacity.bytes":  101258067968,
+		"ephemeral_storage.inodes.used":     159,
+	})
+
+	// Older kubelets don't report the ephemeral storage of pods.
+	body = s.ReadTestFile(testFileWithMultipleContainers)
+	events, err = eventMapping(body, s.MetricsRepo, s.Logger)
+	s.NoError(err)
+	s.Len(events, 1)
+	s.NotContains(events[0], "ephemeral_storage")
+}
+
 func (s *PodTestSuite) testValue(event mapstr.M, field string, expected interface{}) {
 	data, err := event.GetValue(field)
 	s.NoError(err, "Could not read field "+field)
@@ -0,0 +1,149 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resource
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/mb/parse"
+	"github.com/elastic/beats/v7/metricbeat/module/kubernetes/util"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const (
+	defaultScheme = "http"
+	defaultPath   = "/metrics/resource"
+)
+
+var (
+	hostParser = parse.URLHostParserBuilder{
+		DefaultScheme: defaultScheme,
+		DefaultPath:   defaultPath,
+	}.Build()
+)
+
+// mapping of the kubelet resource metrics endpoint, the same source the
+// metrics-server uses to serve the resource metrics API.
+var mapping = &prometheus.MetricsMapping{
+	Metrics: map[string]prometheus.MetricMap{
+		"node_cpu_usage_seconds_total":  cpuUsageMetric("node.cpu.usage.core.ns"),
+		"node_memory_working_set_bytes": prometheus.Metric("node.memory.working_set.bytes"),
+		"node_swap_usage_bytes":         prometheus.Metric("node.swap.usage.bytes"),
+
+		"pod_cpu_usage_seconds_total":  cpuUsageMetric("pod.cpu.usage.core.ns"),
+		"pod_memory_working_set_bytes": prometheus.Metric("pod.memory.working_set.bytes"),
+		"pod_swap_usage_bytes":         prometheus.Metric("pod.swap.usage.bytes"),
+
+		"container_cpu_usage_seconds_total":  cpuUsageMetric("container.cpu.usage.core.ns"),
+		"container_memory_working_set_bytes": prometheus.Metric("container.memory.working_set.bytes"),
+		"container_swap_usage_bytes":         prometheus.Metric("container.swap.usage.bytes"),
+		"container_start_time_seconds":       prometheus.Metric("container.start_time.sec"),
+
+		"scrape_error":          prometheus.BooleanMetric("scrape_error"),
+		"resource_scrape_error": prometheus.BooleanMetric("scrape_error"),
+	},
+
+	Labels: map[string]prometheus.LabelMap{
+		"namespace": prometheus.KeyLabel(mb.ModuleDataKey + ".namespace"),
+		"pod":       prometheus.KeyLabel(mb.ModuleDataKey + ".pod.name"),
+		"container": prometheus.KeyLabel(mb.ModuleDataKey + ".container.name"),
+	},
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("kubernetes", "resource", New,
+		mb.WithHostParser(hostParser))
+}
+
+// MetricSet type defines all fields of the MetricSet
+type MetricSet struct {
+	mb.BaseMetricSet
+	prometheusClient   prometheus.Prometheus
+	prometheusMappings *prometheus.MetricsMapping
+	clusterMeta        mapstr.M
+}
+
+// New create a new instance of the MetricSet
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	pc, err := prometheus.NewPrometheusClient(base)
+	if err != nil {
+		return nil, err
+	}
+	ms := &MetricSet{
+		BaseMetricSet:      base,
+		prometheusClient:   pc,
+		prometheusMappings: mapping,
+		clusterMeta:        util.AddClusterECSMeta(base),
+	}
+	return ms, nil
+}
+
+// Fetch gathers information from the kubelet resource metrics endpoint and
+// reports one event per node, pod and container.
+func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
+	events, err := m.prometheusClient.GetProcessedMetrics(m.prometheusMappings)
+	if err != nil {
+		return fmt.Errorf("error getting metrics: %w", err)
+	}
+
+	for _, e := range events {
+		event := mb.TransformMapStrToEvent("kubernetes", e, nil)
+		if len(m.clusterMeta) != 0 {
+			event.RootFields.DeepUpdate(m.clusterMeta)
+		}
+		isOpen := reporter.Event(event)
+		if !isOpen {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// cpuUsageMetric maps a cumulative CPU usage counter in core-seconds to
+// core-nanoseconds, as reported by the node and pod metricsets. The generic
+// counter mapping truncates to whole seconds, which is too coarse to derive
+// usage rates between fetches.
+func cpuUsageMetric(field string) prometheus.MetricMap {
+	return cpuMetric{field: field}
+}
+
+type cpuMetric struct {
+	field string
+}
+
+func (m cpuMetric) GetOptions() []prometheus.MetricOption { return nil }
+
+func (m cpuMetric) GetField() string { return m.field }
+
+func (m cpuMetric) GetConfiguration() prometheus.Configuration { return prometheus.Configuration{} }
+
+func (m cpuMetric) GetValue(metric *prometheus.OpenMetric) interface{} {
+	counter := metric.GetCounter()
+	if counter == nil {
+		return nil
+	}
+	value := counter.GetValue()
+	if math.IsNaN(value) || math.IsInf(value, 0) || value < 0 {
+		return nil
+	}
+	return uint64(math.Round(value * 1e9))
+}
@@ -0,0 +1,95 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package resource
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestEventMapping(t *testing.T) {
+	body, err := os.ReadFile("./_meta/test/metrics.1.27")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, defaultPath, r.URL.Path)
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	f := mbtest.NewFetcher(t, map[string]interface{}{
+		"module":     "kubernetes",
+		"metricsets": []string{"resource"},
+		"hosts":      []string{server.URL},
+	})
+	events, errs := f.FetchEvents()
+	require.Empty(t, errs)
+	// One event for the node, two for the pods and two for the containers.
+	require.Len(t, events, 5)
+
+	node := findEvent(t, events, "", "", "")
+	assert.Equal(t, uint64(322549214000), mustGet(t, node.MetricSetFields, "node.cpu.usage.core.ns"))
+	assert.Equal(t, 6.63384064e+08, mustGet(t, node.MetricSetFields, "node.memory.working_set.bytes"))
+	assert.Equal(t, false, mustGet(t, node.MetricSetFields, "scrape_error"))
+
+	pod := findEvent(t, events, "kube-system", "etcd-kind-control-plane", "")
+	assert.Equal(t, uint64(31528127000), mustGet(t, pod.MetricSetFields, "pod.cpu.usage.core.ns"))
+	assert.Equal(t, 3.3308672e+07, mustGet(t, pod.MetricSetFields, "pod.memory.working_set.bytes"))
+
+	container := findEvent(t, events, "kube-system", "coredns-5d78c9869d-gskzq", "coredns")
+	assert.Equal(t, uint64(4710563000), mustGet(t, container.MetricSetFields, "container.cpu.usage.core.ns"))
+	assert.Equal(t, 1.3107200e+07, mustGet(t, container.MetricSetFields, "container.memory.working_set.bytes"))
+	assert.Equal(t, 1.6908883e+09, mustGet(t, container.MetricSetFields, "container.start_time.sec"))
+}
+
+func findEvent(t *testing.T, events []mb.Event, namespace, pod, container string) mb.Event {
+	t.Helper()
+	for _, e := range events {
+		ns, _ := e.ModuleFields.GetValue("namespace")
+		p, _ := e.ModuleFields.GetValue("pod.name")
+		c, _ := e.ModuleFields.GetValue("container.name")
+		if (namespace == "" && ns == nil || ns == namespace) &&
+			(pod == "" && p == nil || p == pod) &&
+			(container == "" && c == nil || c == container) {
+			return e
+		}
+	}
+	t.Fatalf("no event found for namespace=%q pod=%q container=%q", namespace, pod, container)
+	return mb.Event{}
+}
+
+func mustGet(t *testing.T, m mapstr.M, key string) interface{} {
+	t.Helper()
+	v, err := m.GetValue(key)
+	require.NoError(t, err, key)
+	return v
+}
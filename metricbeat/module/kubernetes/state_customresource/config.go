@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state_customresource
+
+import (
+	"errors"
+	"fmt"
+)
+
+const defaultMetricPrefix = "kube_customresource_"
+
+type customResourcesConfig struct {
+	Resources []resourceConfig `config:"custom_resources"`
+}
+
+// resourceConfig maps the metrics that kube-state-metrics generates for the
+// objects of a custom resource, as configured in its custom resource state
+// configuration, to fields of the event.
+type resourceConfig struct {
+	Group   string `config:"group"`
+	Version string `config:"version"`
+	Kind    string `config:"kind"`
+
+	// MetricPrefix is the metric name prefix kube-state-metrics uses for
+	// this resource, it is `kube_customresource_` unless overridden with
+	// `metricNamePrefix` in the custom resource state configuration.
+	MetricPrefix string `config:"metric_prefix"`
+
+	// Metrics maps metric names to fields under `metrics`. When empty, all
+	// metrics with the prefix are collected, named after the metric name
+	// without the prefix.
+	Metrics map[string]string `config:"metrics"`
+
+	// Labels lists the metric labels to store under `labels`, in addition
+	// to the group, version, kind, name and namespace.
+	Labels []string `config:"labels"`
+}
+
+func (c *customResourcesConfig) Validate() error {
+	if len(c.Resources) == 0 {
+		return errors.New("at least one custom resource must be configured in custom_resources")
+	}
+	for i, r := range c.Resources {
+		if r.Group == "" || r.Kind == "" {
+			return fmt.Errorf("custom_resources.%d: group and kind are required", i)
+		}
+	}
+	return nil
+}
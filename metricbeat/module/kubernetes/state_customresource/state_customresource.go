@@ -0,0 +1,194 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state_customresource
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	k8smod "github.com/elastic/beats/v7/metricbeat/module/kubernetes"
+	"github.com/elastic/beats/v7/metricbeat/module/kubernetes/util"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// Labels that kube-state-metrics adds to all custom resource state metrics.
+const (
+	groupLabel   = "customresource_group"
+	versionLabel = "customresource_version"
+	kindLabel    = "customresource_kind"
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("kubernetes", "state_customresource", New,
+		mb.WithHostParser(prometheus.HostParser))
+}
+
+// MetricSet collects the custom resource state metrics of kube-state-metrics.
+type MetricSet struct {
+	mb.BaseMetricSet
+	prometheusClient prometheus.Prometheus
+	mod              k8smod.Module
+	resources        []resource
+	clusterMeta      mapstr.M
+}
+
+type resource struct {
+	resourceConfig
+	mapping *prometheus.MetricsMapping
+}
+
+// New create a new instance of the MetricSet
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	config := customResourcesConfig{}
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+	prometheusClient, err := prometheus.NewPrometheusClient(base)
+	if err != nil {
+		return nil, err
+	}
+	mod, ok := base.Module().(k8smod.Module)
+	if !ok {
+		return nil, fmt.Errorf("must be child of kubernetes module")
+	}
+
+	resources := make([]resource, 0, len(config.Resources))
+	for _, c := range config.Resources {
+		if c.MetricPrefix == "" {
+			c.MetricPrefix = defaultMetricPrefix
+		}
+		resources = append(resources, resource{resourceConfig: c, mapping: newMapping(c)})
+	}
+
+	return &MetricSet{
+		BaseMetricSet:    base,
+		prometheusClient: prometheusClient,
+		mod:              mod,
+		resources:        resources,
+		clusterMeta:      util.AddClusterECSMeta(base),
+	}, nil
+}
+
+func newMapping(c resourceConfig) *prometheus.MetricsMapping {
+	mapping := &prometheus.MetricsMapping{
+		Metrics: map[string]prometheus.MetricMap{},
+		Labels: map[string]prometheus.LabelMap{
+			groupLabel:   prometheus.KeyLabel("group"),
+			versionLabel: prometheus.KeyLabel("version"),
+			kindLabel:    prometheus.KeyLabel("kind"),
+			"name":       prometheus.KeyLabel("name"),
+			"namespace":  prometheus.KeyLabel(mb.ModuleDataKey + ".namespace"),
+		},
+	}
+	for metric, field := range c.Metrics {
+		mapping.Metrics[metric] = prometheus.Metric("metrics." + field)
+	}
+	for _, label := range c.Labels {
+		mapping.Labels[label] = prometheus.KeyLabel("labels." + label)
+	}
+	return mapping
+}
+
+// Fetch methods implements the data gathering and data conversion to the right
+// format. It publishes the event which is then forwarded to the output. In case
+// of an error set the Error field of mb.Event or simply call report.Error().
+func (m *MetricSet) Fetch(reporter mb.ReporterV2) {
+	families, err := m.mod.GetStateMetricsFamilies(m.prometheusClient)
+	if err != nil {
+		m.Logger().Error(err)
+		reporter.Error(err)
+		return
+	}
+
+	for _, r := range m.resources {
+		events, err := m.prometheusClient.ProcessMetrics(r.filter(families), r.mapping)
+		if err != nil {
+			m.Logger().Error(err)
+			reporter.Error(err)
+			return
+		}
+
+		for _, event := range events {
+			e, err := util.CreateEvent(event, "kubernetes.customresource")
+			if err != nil {
+				m.Logger().Error(err)
+			}
+			if len(m.clusterMeta) != 0 {
+				if e.RootFields == nil {
+					e.RootFields = mapstr.M{}
+				}
+				e.RootFields.DeepUpdate(m.clusterMeta)
+			}
+
+			if reported := reporter.Event(e); !reported {
+				m.Logger().Debug("error trying to emit event")
+				return
+			}
+		}
+	}
+}
+
+// filter returns the metrics of the families that belong to the resource. When
+// no metrics are explicitly mapped, the mapping is extended with every metric
+// carrying the resource prefix.
+func (r resource) filter(families []*prometheus.MetricFamily) []*prometheus.MetricFamily {
+	var filtered []*prometheus.MetricFamily
+	for _, family := range families {
+		name := family.GetName()
+		if !strings.HasPrefix(name, r.MetricPrefix) {
+			continue
+		}
+		if _, ok := r.mapping.Metrics[name]; !ok {
+			if len(r.Metrics) != 0 {
+				continue
+			}
+			r.mapping.Metrics[name] = prometheus.Metric("metrics." + strings.TrimPrefix(name, r.MetricPrefix))
+		}
+
+		var metrics []*prometheus.OpenMetric
+		for _, metric := range family.GetMetric() {
+			if r.matches(metric) {
+				metrics = append(metrics, metric)
+			}
+		}
+		if len(metrics) == 0 {
+			continue
+		}
+		f := *family
+		f.Metric = metrics
+		filtered = append(filtered, &f)
+	}
+	return filtered
+}
+
+func (r resource) matches(metric *prometheus.OpenMetric) bool {
+	var group, version, kind string
+	for _, l := range metric.GetLabel() {
+		switch l.Name {
+		case groupLabel:
+			group = l.Value
+		case versionLabel:
+			version = l.Value
+		case kindLabel:
+			kind = l.Value
+		}
+	}
+	return group == r.Group && kind == r.Kind && (r.Version == "" || version == r.Version)
+}
@@ -0,0 +1,124 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package state_customresource
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestEventMapping(t *testing.T) {
+	body, err := os.ReadFile("./_meta/test/ksm.crs.plain")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	f := mbtest.NewFetcher(t, map[string]interface{}{
+		"module":     "kubernetes",
+		"metricsets": []string{"state_customresource"},
+		"hosts":      []string{server.URL},
+		"custom_resources": []map[string]interface{}{
+			{
+				"group":   "kafka.strimzi.io",
+				"version": "v1beta2",
+				"kind":    "Kafka",
+				"metrics": map[string]string{
+					"kube_customresource_kafka_ready": "ready",
+				},
+			},
+			{
+				"group":   "elasticsearch.k8s.elastic.co",
+				"version": "v1",
+				"kind":    "Elasticsearch",
+				"labels":  []string{"health"},
+			},
+		},
+	})
+	events, errs := f.FetchEvents()
+	require.Empty(t, errs)
+	// Two Kafka clusters, and the health and node count of one Elasticsearch
+	// cluster, the v1beta1 one is filtered out by version.
+	require.Len(t, events, 4)
+
+	kafka := findEvent(t, events, "Kafka", "my-cluster")
+	assert.Equal(t, "kafka.strimzi.io", mustGet(t, kafka.MetricSetFields, "group"))
+	assert.Equal(t, "v1beta2", mustGet(t, kafka.MetricSetFields, "version"))
+	assert.Equal(t, float64(1), mustGet(t, kafka.MetricSetFields, "metrics.ready"))
+	assert.Equal(t, mapstr.M{"ready": float64(1)}, mustGet(t, kafka.MetricSetFields, "metrics"),
+		"only the configured metrics are collected")
+	assert.Equal(t, "kafka", mustGet(t, kafka.ModuleFields, "namespace"))
+	assert.Equal(t, "kubernetes.customresource", kafka.Namespace)
+
+	var health, nodes mb.Event
+	for _, e := range events {
+		if ok, _ := e.MetricSetFields.HasKey("labels.health"); ok {
+			health = e
+		} else if kind, _ := e.MetricSetFields.GetValue("kind"); kind == "Elasticsearch" {
+			nodes = e
+		}
+	}
+	require.NotNil(t, health.MetricSetFields)
+	assert.Equal(t, "green", mustGet(t, health.MetricSetFields, "labels.health"))
+	assert.Equal(t, float64(1), mustGet(t, health.MetricSetFields, "metrics.elasticsearch_health"))
+	require.NotNil(t, nodes.MetricSetFields)
+	assert.Equal(t, "quickstart", mustGet(t, nodes.MetricSetFields, "name"))
+	assert.Equal(t, float64(3), mustGet(t, nodes.MetricSetFields, "metrics.elasticsearch_nodes"))
+}
+
+func TestConfigValidation(t *testing.T) {
+	assert.Error(t, (&customResourcesConfig{}).Validate())
+	assert.Error(t, (&customResourcesConfig{Resources: []resourceConfig{{Group: "kafka.strimzi.io"}}}).Validate())
+	assert.NoError(t, (&customResourcesConfig{Resources: []resourceConfig{{Group: "kafka.strimzi.io", Kind: "Kafka"}}}).Validate())
+}
+
+func findEvent(t *testing.T, events []mb.Event, kind, name string) mb.Event {
+	t.Helper()
+	for _, e := range events {
+		k, _ := e.MetricSetFields.GetValue("kind")
+		n, _ := e.MetricSetFields.GetValue("name")
+		if k == kind && n == name {
+			return e
+		}
+	}
+	t.Fatalf("no event found for %s %s", kind, name)
+	return mb.Event{}
+}
+
+func mustGet(t *testing.T, m mapstr.M, key string) interface{} {
+	t.Helper()
+	v, err := m.GetValue(key)
+	require.NoError(t, err, key)
+	return v
+}
@@ -132,5 +132,13 @@ type Summary struct {
 			Name           string `json:"name"`
 			UsedBytes      uint64 `json:"usedBytes"`
 		} `json:"volume"`
+		EphemeralStorage *struct {
+			AvailableBytes uint64 `json:"availableBytes"`
+			CapacityBytes  uint64 `json:"capacityBytes"`
+			Inodes         uint64 `json:"inodes"`
+			InodesFree     uint64 `json:"inodesFree"`
+			InodesUsed     uint64 `json:"inodesUsed"`
+			UsedBytes      uint64 `json:"usedBytes"`
+		} `json:"ephemeral-storage"`
 	} `json:"pods"`
 }
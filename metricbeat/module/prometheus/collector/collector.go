@@ -79,6 +79,7 @@ type MetricSet struct {
 	prometheus      p.Prometheus
 	includeMetrics  []*regexp.Regexp
 	excludeMetrics  []*regexp.Regexp
+	relabeler       *p.Relabeler
 	namespace       string
 	promEventsGen   PromEventsGenerator
 	host            string
@@ -120,6 +121,10 @@ func MetricSetBuilder(namespace string, genFactory PromEventsGeneratorFactory) f
 		if err != nil {
 			return nil, fmt.Errorf("unable to compile include patterns: %w", err)
 		}
+		ms.relabeler, err = p.NewRelabeler(config.MetricRelabelConfigs)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compile metric relabel configs: %w", err)
+		}
 
 		return ms, nil
 	}
@@ -141,6 +146,9 @@ func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
 		// set the error to report it after sending the up event
 		err = fmt.Errorf("unable to decode response from prometheus endpoint: %w", err)
 	} else {
+		// prune the scraped series before they are converted to events
+		families = m.relabeler.Relabel(families)
+
 		// add up event to the list
 		families = append(families, m.upMetricFamily(1.0))
 	}
@@ -17,8 +17,13 @@
 
 package collector
 
+import (
+	p "github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+)
+
 type metricsetConfig struct {
-	MetricsFilters MetricFilters `config:"metrics_filters" yaml:"metrics_filters,omitempty"`
+	MetricsFilters       MetricFilters     `config:"metrics_filters" yaml:"metrics_filters,omitempty"`
+	MetricRelabelConfigs []p.RelabelConfig `config:"metric_relabel_configs" yaml:"metric_relabel_configs,omitempty"`
 }
 
 type MetricFilters struct {
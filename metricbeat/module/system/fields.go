@@ -32,5 +32,5 @@ func init() {
 // AssetSystem returns asset data.
 // This is the base64 encoded zlib format compressed contents of module/system.
 func AssetSystem() string {
-	return "eJzsfXtvG7my5//5FEQWB+PclRU7M5M7138skEnO7BqbjI04OecAi4VMdZckHrPJHpItWfPpL/joN/sltWR5YONi7oktFX9VLBarisXiOXqA7RWSW6kgeoWQIorCFXp9Z37x+hVCIchAkFgRzq7Q/3qFEEL2j0gqrBKJIlCCBHKCKHkA9PH2O8IsRBFEXGxRIvESJkitsEJYAAo4pRAoCNFC8AipFSAeg8CKsKVDMX2FkFxxoWYBZwuyvEJKJPAKIQEUsIQrtMSvEFoQoKG8MoDOEcMRXKFY8ACkNL9DSG1j/WHBk9j9xsOL/rm1X0s5mbo/FEcojqL5huy36TgPsN1wERZ+3zCa/vm2ghSsJTdFv3GB4BFHsZG/SBgjbPl6Whs9iJNpHKja+DLAFMLZgnJc/OOCiwirKxSDCICpAfDsF/ASEF+YaVUkAiRjYArNt2bqMhYIC8D8hmKpEKyBqWmFIpFojWkCiEjENChK/oQwpcSSaA4iHSngAqRRI6KQwGwJskTN6M4FUhxd+gUkFRZqpgHX5BSWJ69DCobnzQpYid8NNtMmFIT18a3mP8EcuSVXBMqDIIkJhIgwFGH9H/uZs68fvryZltZOZgLQkKVzb792jwLOFCZMIsoDTB21vitKz3dNWMXRO2ThUJxrOgUoWpUcAi1jhLWiLimY8bTEMIoSqoj5XsH6pD9lg5PNVoWJIiMkLP06ZYVytqz8oYUb/aOhf9So7MLIUZU++T/QbaYB0gtIcYVpRRdRlz6iVp3sgf6bHhXhQJE1eMxGabq9sBMJ4viou6weYQYYkjEOoGFKShwoEjzIcTRCg8MRT5jaE5hT81MU7gMIBnQIFyMKuFPCA9AxEsDpSZgzRPnmPBaEC6K26SYBsg83R5P0rihJSE9Q5gZVD+DHU+QegPgGE3WCsmRIA0NnnKGQyIc3/fg4po0Yhk/8cXpCliDWJNDRmHa/V5iFVP9jhUW40QEcYQqESGLVuR7FH8cT/WioJV+o5zQvGu9uHD713OyAXMFT+LI9zBJha04TprDYWhPgHN01ESrB1HxjsyLUxsirbaxFIrmoDWYCy4K8uFqBSLdALqa1L3xYY0LxnALijG715vmdkcdegjymXXxmAop4CHRmQy+vhOrJnh5CMuGoppwGdehGQ8IWoQaIPhOWPPbChkupjfGQ4Qh2xLX60wvItyB7wDEheZAIoTUooDx42A2WpjNrCMt3llW82koSYGpRXn/S0FxWISLLlXL/hUcIEgU2yRAb5RaAQzlBK2ABoEh/Qa0wqw3CGaRJjakmOyXhPQowQ3KFhV0kEkeVzzhW73cTVMrT6MIyYskkZoXVCq+QyHtVRTEkKRTESS0vpQFJhYt7zS55HpOgHNF2+tKfsQDpAiJjFLlUU/thzs7zDGqNXr5ZSbQhlKIVXgPCKMKPJEoil4XlC3R/eXHxN/Qfdrh7Q7tGrJCpLdLFVCvyFin8oLUxz+0yxREOArMT2O1+XSfqwaKh7Jzkeg7ZInTD6slGOamR3fLELHQzaUWRZ0coSwFYgTCGw8qteHYwQWSBfqyRdRl1AQgr9P7ibxraROuVVa7MjsTJNJXmvdWeOaDLXxon56+VVfpr5W2eb0bkr5KAeEaB5Euo7OHwJeAcJ556olOoHoI0h+8SWbbNjnodUjCKc33zT22FmpyS33PPqJd/oj2pkxTB0JOjk2Vk6EZ/mozstdufJkv9t/wTxb/Dvn+anIy++T8rNnf1AE6TyefqBpyaNPt4AZM0ESJ9JWsmuPbwXvEYvtUS7s+lWOSUyyyeR2HCCZ7vn/S5+FOfTu6+Iz418l03uZfjwKJMtJ4S/qoqiiHHD5pE4fxB/xNd32QFqT0r4dOf4WcUA48Is9pzGeLL4dNt2DOnhx3KBoLg8c9WUwg/SDdCdsZqKs8jvEWMKzQ3pdFrEtptHFOaC71G0+XoOxgSgMOpOfAYcfEYT6ngYZhjQxRwPUNaZWQSaA1fJJRuO/BtBFFwcIBmlB0RGgnOt6r/iVrqCvq+tAN4Q8bAKMNGN+5o0h5xkepQqOIHSggUF46SO/QlTtMYwlImkZaM+RSS5E/jh/58+a7XDD69gDQOBWwcGaXEeoqpRrVbbEatKldAWoW2g2AiQnVMEHAWyrzGXZsVs2J7TeyTQbRrttNZPDRAP8aQ633w+u2NLG/iTSB5PKbzUsWocWjHJRZ8KUDKusMATAkeb/fxGHLfxN2eqdMc7gXglOhsTtSo/l2GVhPWQqrDLVSXPHm8n+N1OCdamtheUeH21hTnNLPLP1381/vaLC8IhdJFKbSTa5iTqRWo5H8ao04lY/pIG4fxAk3oXpC34tquJywWZE0oLCG0CQjC7DBTL/QQ1iSAkQvdyp5q+c7l/dsQ1m/1Xy/vvYj0uAeAomlUocCj+ul+iq4ZkjwCFGAJ5gbbPwkL+UaimzsbL5nymbRM4z5hmdDvEZYIm33aarfem5mdXcKZvYSp9DbANxCiM3icInhUIBimBpZ8458WE9XNYk4a3MmdZWEIa5tvaNfmxj8lZrWMZdQqNp8wHoJMy6/smpzosDRYZSLH2uOdE2aFyhcW0AQtOA1ByAmS24gS9iAnJki3Ot2g8Nwg83Ozs1Qd0WoxWcHKGLn7ES0EPKnhaDcQGt1sVAUoOaqafKoDJJeaJzSvJLiP6qMX5NYurUTCcYMsPeBAeE/vJFRAt+X0MzWUep/eyx1wKmUpFTyC4ip1WSO8XApY4ixtpP14s4IrhaD5V/eudN01cfB7vpTydSPRgicsbFk+eyzpI1pw9Ds3xtRtyG38aIdywNLyq09duiCNEuSiRSEPZDXj6Zta1G7iW+Xdhb6Gs77qULbXGhNbWWhVgHpFPhlAYw46APps/vEQWtt6ZoDGNJFGpm/q4SnlONzHRn28/W5oILwGgZewp1V5ffl6qKXXfyJsOVvgQHFxhS4vLoZZ+88F+MaZzvqVRIQlCvxr+PXPp4T0Z4e1weC8vjwptJceuH7cpibgqXTCowsoJNkhQb+z/jo7TzUVfoUZg6Mn064GrdqTJ/MhP0eHvcVTs862K89ePqQlUUsnuX4/I6SSjhbbmH3NNVFqn8GjxjTf9RbbC9YRw2YbBOZH7cajcnOeBVzF5lshB5v5ISygSZh9OODMHrvMt6k7GeBgZbtw1YaeJ4sFCInOJGQBsRMNDlSC6bTihvhDCz1A74ZJe6vURzNcyjBntUj+5APTXtpnJ2C3oKKO5IOhlnfzg9CEQXp6u0KNVpfeu2zREdxmx1BBnoWFcq2QAGexpbHXIdGabm7rzkFtwN2Xc+uOheZfecbKzZD3KqX+qX4ShRADC7MY9ebOJj7NzeAQFCZUTlBsbDUKVhA8ZNmCwkK7b1AJ9PSBnhO33y5dK0QkCjANEmpSGnOsp6Ugi/Lhcjnd/QWi/MTMJEPexoIHbyOICFvwSV0W+oeL4oDma0VwJobKLV9m6ciiTD1LoKcI6gGi/rlh6ObuX4gYRjGSSVS10qkOEebapaUqdJMlFybu+/BHfWG7WeSZWriv91WLBvOG+pg41GnmUM9Qtn5YV1ulXWVFG1y1bM02LxawII9X6PX/M2z9/6oPWE4qac0zVHLfSrtTRCoSSHuGCGF6hKhxlHqflrNPQ9MzT5xcyJnpq0pPZdaNdzYM71NZxPyUf7h4T2+lJo2Cz+oQQW24eNgrunE0CuGN+02xorLU4jP9u6mCXVQyeMcrpgS1GhjwmkCyCr5PZSVP1FEPeKrlYbLU9NcLkbAnRSggALIu9uxtFGSMgwcYtV4mB+No9xTY4ZCIDElPwRA2BSG4OIxYLGlX920REbbsMVfHwiSBhd2ICJuGgsdx/8h2ECLCAh6ZMgk3d6YvyAZ0vGCH7SGxQwLkiVrydoCVbuCYbvC2vpteaNfpExYbwoyX/OvdJzSHACcSnEOsHXABMRcqzwE219BX9qOZTKII98i6ZZvFHBTut199cTuSLTBjS+0dLimfY5qZduPtE7Xtuf+QePof3uni839DzSnomLDrW3usC6KhaXQw5mjfPnYMl4RjDvf9U/dwM0oUjDzmZ6KgfWASRKPO4scvHk6zgsvSUwhoJ6/L0Sh4XXH+UgIOscKTYo/1SfHhh0rndzSu14UpwVWLEWO1yvieer4akaXAllH3okR9xOrbDmj/EqyB7zwU0cQNvdi62a9/sw/38R4DYqHdmR3HXe4+bv2rfUYMopASNvJMLxJKUcCjCLPwXJO30ZHi9rGI4ssME5eAN5uDJ1mIxTKJTBZSQowFdjuctxaBLBkXMMNzvoYr9O7ip1/8dk+C2GFB2eYlu62mYLPrtOo9krDlLCTC3APZ7jA6sHV/Y2t/OdtTA4CtieBMzxxaY0F0nC+btcD289OG1HdxBhfuKqLfBMCvd58mNh9qTe3NHfqX33CUWyei8bJhH2+/n8sYArIgQTENFufXLofmuBovv6OubEzPhIXnJmrp8Zq2W/FVsLaFgXFdD4Q2a4mowdoUon1ex2iPsxdNsu5uuYSeOF806CGhJA7NnnmtCuGCJBGhWLiMq3fYv+lRMkEWBwiJjCne5vGC4nFqstPbwPWLn37hNjSyeFYS9jzVlFMe88mmjOn86aZSy8+qiFs6T6Aj2wV/R4oqYKsTh8RrDx1bp7dFnr4XscrowrrrOwRd/5eyUFvpC+p/XP3FfHfoftS133XtV556XnSMI5lMA9IuCZ73vlZYFk8O7bFp5Uj7I48iotDHFRZLQGfKU0aSUcbWXUljOszwEoQexdhMoswZqkm7u0AmRfImawjscq+2hIvIbk0VUj7ZMZIW8leQJNRL6w4UuiN/wrRiLQa/s9Y1I2mbc+f0IgnKaNmk4dJEVVqntwft9hSdjz/T8PyJNMGMHfqYSWTjrSR/xLND6c1vhEL2GS6cL5jmVez2rDXFiNvmG4ksBA0LT78UW0yhd2kXT4xtHHkM9W72Q7a/yiWssgykod97z6MkImoq+aJ5eey3I2vSdpT0mL8DeuY9eUmWosIC7QAzNAcUrLRbFVY9OqwQZluz/3aJYoVrQe1YotCkDyWKAm0tCtO5Zw5I4LQdm+C84Qpj4Ft4Oy/JNK+vF5DBI/PmPXYk0wvFXDAy+yqWD7byJ4LyM47pj/tWdr1LQH6iUXOm9L5rCckVibUhxTWCjLNzLQ5H2QhQQmkAXnh+gebvLwyN22t5N9SRQeshYOS06fqTcTC0JnFzx81yIxGWkgfEpMM2RK3sdqrF7I9hrk30J3QEw35Q9rKXpnr9ySZlXCeMlLqhZvhOq8y8VPG85ei2KKIYq9XhhKSpp3VHTo+qHQ3cr2Uyt/HUD9LeFrR3rAeJzIx2DKE5urM1CEn4/ruJo2OuBTjI2RJLLxZUT0uawdUSa6jVoNQQNU9nECf5RCEZrCBMKNhXbN1NfgMXy4fsRrJb5F6aH+x30s2DMyU4pc7sbniWWM6GEnKCPv52Z6zb129+ovrvUmEWWjBpvye6RQtMRE7KGcFYcC1pwhmmtBrlOemYOxwuNElj27TWNp2wrDB0A2S5UlP09VsBhpeuAExdoFwBJUHJwhsk3jSA11lGec/H8gQYIbsSehQmwjSjQ0uyBqYdY8LDhrXGbJaPhSAyXv/xblIkTbTNF8vU4EV6r3dJLWfUm/MsQZzgIFCmkQQOQ6InYqIRnecyKe4MS85MyPaPpt5KjnbDzoC6dgfUw/ih6oq5/pTyW9X2VgANtncnCP5Fq39ud7HBjdR8trmVSXOvp5XLJqNU49HCXL9rpdk286iU08dLmNaaWdThNRjxFoQSrd+5WIgwxDDjrnFUT1D+YLmMqila7gWrr3Cas8fjgClkbMu4egAEccDJ0xudOWtJ2y8Pm0TvW091cLuKrYSup6iOOJV+eJ0407cbDjapzm3ZZUob346qg9tVgAV0vUV1xEn1wWu1+8FCTp1jkbTb/5bp7LvFmXHMHuwmNyKBSGfXuIErvkEClgnFQoeWjaQs9z8UO1Np/0eA5IkIQCK54gkNTXAPmFIeYNVY0OiTyR8JV/jwIvlWyZc3CsY6nJj6r+0YSKk7j4u+pEhY6kdql8xONTrDEoWwIDZ30izlonI0XcL0Sc/kOw8tuw/MlLUvQbgjAnPK4M5wQDvmmQNlH7ssOOaNREvN5tLMS0ms08LhejpY6Lz4ZknGiROKzWFFiTQ9hN8hrfRkuSqmdFrFK9QJr9dsXTbLt2G9mrOMoQtVqKlImMlXnoIwzFk4Z0uQykTJhCU8kW7NNRImrJLnKy9i++amX2pDfHunNYcWU96ryZkac/Nkjak0Rqe0YPSiKJuYZuOml7YRBVAcy94aYllXK8GVohAeXQhaV2TTrM7tJWCHDZ0ZJonnKc/0p9hoUXFj29M6drWCrRPQ4wonpqWTaUq+aLVLBXOntbo0QzapTgQye2Ff81+V+OG30OzY2bAQJracD51Vluibwj6aT0iHh+GbqIkWU/G2gHPF1u8GCabRgz6GYApe9Why8Wem8jJykDLxnsCi4TmGW0cNnaXG0FhcYPrPb+pdSoo/fVMPkrf4CX0Q11DfpW5IuoU4r04hClgq26LVzoH+oFTYpFk5y3KAKbctI3axV2Tx8qIjMEG9gxPUqwag+OOfUL4GgS4vUFfMV2Tj/Ymy8X4YGz9enCgfP14MY6TpsnmdjY4cwZ5cWOt3p1dVan76Re6LpCXQGnXpU2oOWklIe658JEmUUIUZ8EQ2nIQ4wb0YglNh48UQdLHRwxB4lvZvCaUNS7tGrHDwioOWOrdeh6/uKDXtNO65o5b+vBxxZT9PfMTlau2Pk9IrPgpZcLZL7dG08S8ewnf43IPYbDYfvao5B/CbcVpSPPNqQKWWU5sQzXP6jWHz1p5FPx2uJpUbDvZOQ8KUq7QkEi0SFqSlDOalItfzNHUPbEE+1rGr1g1T2OBesxuW82k/fxum8ZlEiiqcqa85SYp42HdSC/jGntTs/kVqTOrXsia+iSzfivCO1Fvkh1DWcfhqVs8hE9d1Cji6ap3ZuzNvhutY56HgbrPxrWk2ipd8hujZAKkfQrvG4mdf/dK0vaVxqP3abfpTun67/26eqWYta5bpKmcIcLAyH63s6i35bCK7t/XWq01omMdqrzilxca2v8KL03ogp3W4cxpBNLXlPE1XTVAfs9p1X2UA48X2ya5Mar5trFs8Sy8Y9T0nyBmO8OPpML2CrJyz2Kp2bM7tLYpT5DqvRbAuXbnVquY27TaxILXX4PIf0/n0jbv4Vy80NlmuwlFWIvtu6lp6C0xocvgCg/INIneUV7nJaC+TnFXm9A3a1NpS5D8CTAPpIerCN0dWlpQ9vkFqJUCuVpz6LWkR54osV08DVI88BOnxjU529fWxC2cneHPXfqRq3bqCF0r8BVKCxDGEWRrabgQU1tCU1+t7vEb5piXhN7yEMFfVJrkWR9f6MurwBQXsM36EH0cdPlerPqNzHo06OjdX1waMPnsgPU5YhkLQRLXO9kaiN5JRUWiCekerIeht6+Xm1PzAFaTtxtPr5SWn0DQ6N3177RNSzl9qpDemH5UJ61R9yNpdfx141YVVEkpL0LivsE7e7UzL6JzCVYXWfi3iIM6mltrz8L7k5vn4X3Jzch5YD8gnFHdUra5Z2Y0Uz2rL30Qo9UikmfcXn9PDyIvP+eJzPgOf0wfj4VQzju684WCJx4fTzzxWRdCZgGykOlwyJ+8i8kVFPm1uXyPhndzBh9NMPlbnbczso6Y9U0F8kqaiZCN06PDt423+0Fat49kQRk/VNBRtQpVjj41opLmP9TRieg52wgmrKqeaweiS0s7xYyat0zQa1Ylsvn/lDRZaWbcVC9VHCsoMtx7kl1i2LxjmLfSub1q6S1Qg9FDSEYH0QGTf55thxv2vGJWxHWcBfWCcbSOeyDzZYo7wTAm6fU/Q3FE5FxAAU3R7bkzQ2eev35u1hhKpSv3po3gh0ZlcRRC98fWk7C+8BaHH3o1+IxTO5zh4KL396oTz+ev3jN0duDKyPjI/t3rXNAOPPUcrAgKLYEUCTGdWVLPT2i+K1TBZ0jGF7VzK7K2SgvG0G0LzDc1RxCU3pymtPOfUW26NJMvy3E1u6Uunz8eSZm+zFs1FaeU1p/CqK3InST2B2WyWlN+gemW0g3ZEOI4hPC2O78ifha7G5xYicv9PI5XNpnhcmxPjJcwWOKEdOd0DXIbXNgJnrUjLIbsSZLkEYZK/cdtZj4E+UB/+zcXsGfBtgHYwjl5/0Z96bf8p0UqrEMsbvboMiX39mG5Nw1fF23IC9vFo84KM6fgXkmIr1J4aJWeNuagDNJjQA5r/mi4TvPBmur1mgdNHzXbggyft5dWHYoQnhch1X1baGuD3YuUY26KrKNQrRGAm7fNfaJUswcjlzQQx3nyyNa7jKqSc6ZFPRmq/Vx6e5QuEM0F65TXsbsQGxyfD6112wr/j7CUM1iRQ5hH9U2HqSyFHHWDGuLI9yQKKSQRhL05TLuf0gfhs+IBbAL9SHhSfun4p/h+7+H+H2n97wfFUNNam1qsvXhtbswAhbApU23DjJmBK0dwoVagXX+PgqOUEa5CYCD/OBd5cANdvb9LHgDkz7by0tF13Bkr3YNz0W4L8HQrXSse8fsMpCbbNzzDsawgcgH+808bgS/rsqoCY4kCPb2zNi3U4jnXwsTBu/jwGcW71VM+3ewywdwJ9ZxQ1QqPm4vdkqtOUHBrJ82uJdby+OC8tsV464bx0wumDa+cmV8frb5fdiH5Zwi9LeF8+/hqLMvcB7PNkMokiXLrjr4iioDm0+eK7+ge8C7TFd3UksodaKm8Wgcze3nQPr624LHmpAvSmrAct9gf1Lc02CTdKtMPtrsZlOWwDl8ga3kKnaMvYSEjyrFzlsdJeWEhYuze9PxDTQHAICkkB4kOIJCU8DI3icQz+Fy/3AmPpDsLyJ4/mZPwZsmQHIQkBjy8STbQJBbpWP0i0BrFFCaPkAahLXRJln2TDcQxYoHliWsMY59w0jMYUSaISlyIhCkV46w6l/Kxt8AN46vH3Zy8lfK4DnkZxoxtGt2jBE/tYO6chCPem6f+1R2fusbeGx1n1KAeBfljYMRYPB1hlluxY0CeIi/QlMxAKE/Mimn1zrIGvhD0wvqkePY7AWMZLoUf2ysb2OrxOaMh+cBl9JQistV8rEJEpIj9ctRKAaz5KZcP/5v9Qo1de7qjFE6bMcVGhfZwb1nrerpdUhUjXU53+fbRV2H5shevEdJttow5hc9rTpJprGrzj+Kk8HFE036LrtzfTmo8mcCmhOdjvqnx/uBel/+vVA38as0u9cd7W0CatmvZkrGrd+/cY15T1ELV1dqIHAt+Vvz0AfFuZp35DS7cMwC+BLQtm2LRqHA/FR3eJXhNHlvgEEYvl64frTwgLgbf2QZQwYSFmCvm9AyIf0nrYkSxfwfS5Iiw7SMv4h/TwzQiFSTI914g0hq0NkzkUH18khmzYLRJ7IW/88d1Fv87xzfqqnx20JLVLTfN20moUYfNktcAbg8JukdKL0tjbcTWnUCdhiBeVZsVpaOrq0OXFu5/O51sFKYQ2eHp9HsBXcvhchO0g2towYXJmetwOtJl9AlGxXf69Kdtx5qBwvz2rmCNIHxK2o8kjbFrmHkthm6ozWrzTjcOZ0bZ9RtNUUGljahtz7+HSvXDAkMl8fy5lMj8fxuRMElZLzNoxwzqY2oCm9FHhKE4HpOZQx7rPK8yWMHXvhKdQTLWb3XswC1PPcGIjCf1/SqIkLrePLqKGRwhmAQ/3ktPd9f/++H8+f0KaTv4wv0P4g0SRDkqqT32jUkBClC093n/OivOl6dYb79VHXQMLuZjFAiRUjf2g0UMwFaZDUGTPk+0Wujhrk70mXXun36+13bFLECfTlueEBwQQt9/7vRtc7t/acYW2//ila6+16wDVwc3Jy9SUj+41auFeoT3L6T0xhT0B1IaLh0YcvUpXHJH8RZSmrkC9SlWOfXHEllM1lEkXUMU4eIDdi9QH43LjdSHjidoXmnfYpnrr4rh7TZRnEopj1n0rroHtE/Z/+3jrqMjcwbNb236HKiER0ByUYkpqt/9jrFbZwpk2fT8iS1vkdoWUSBo6k+dtTCJSe36oLwL9uX0GpzzAdEqqpsIOX/s1POIopnCFLv/r3fRi+m56ibhA7y4uLq8uPv36y9WHX//+6eqXn398f3V1Ocyt/6xxoOtbhMNQgJSuYD/ADM21/4Kub9c/6cGub9fvsw/14S3mwr9ve1Q84+9d9QXCXvD1UB2YBERcwQkI/KsBMrLEHXdHEbljoL/MV1wOceAyYP/5/vzd5eX55eV/nv/4fso2U/eXacBrWd8OzLffviIBARehd9MX6ZxM0bXSLjqfK2yell4TjASsQcj69nx9iyjnD40lnRUxgKLhLKaJnHE2xJ3O5LEz+9oLhsUCAlfXGZ/b9GHITRRwBt8+f3qTesZOFnrS7HVZzgBFvF7jR/Ec6BT9xkWKbGIIaGr/89KE3a8XnE/nWEyXnGK2nHKxnL7W8n1d/EUtV/4tu8bFBQpBgYiIS65b8ijgEUhXvs0QRHMIQwhRwONtlhTFqvYEqPnCSqn46u3bOJlTEshksSCPBkdvXZ6BELXrbXsknv6uybkPzVM27Zu42ZwYDXTqhlwrjg7EaV1GXKtl7trjmr85aItLyQQ8ijDbFYQnCbMbiiikZNDC65g283Kb4w2VSLfigEc/hm5JwCMEibkbtI88zOs+g1XC/63hAzem1DqGXiSUzgaoQtkHbq5NujN/R56/71uaxBeIx8Ay/5nkBUkuQbCXB40bCg86kxN1Rf5g9Jgx61FXJ6EzJ9EalhOmYAm+u8A9bihoYEaGzegKTieRClpPdvfGkg1hnB9/2kwFfg9zx3nREdjuc9Px6k6zQLpi7x4C+1Ju/VcMJdOEzwTNsbR1tHlqBlMdPmj3zF4qNndtbUItNpcSyJ8wRR+5ECBj8yCh4umbQBJMUc9bbTHfyq18y0C9JfH6p7cqiGcRRK6GI38znjNbwTFtvrNYX2loWLanWaHaZxf1TABxEa9w+6X15pnuidYgtmvdTZIbFkKt8unUNsu3lYMmGzI2A6k96ZZ7P7tyAHwaWpudqcIDqT0CIlfekpKRAeZngIVhB0kzoFzCbIMbOz0fBG0FobYRsxzJzHsYVsatSHQasDMgfVDLLZvJ5kr5o4FOcfTFLCBYnwJmjaMP5gVhZk6qqaCjg86ADEFdzf88Gep3fVBTLNUMB74TmKOCTnH0waxtzVF2kG6TR9jShzgL0sJR3dfvn/4i7qtm5And1yQ8Rfe1fXZRT/f12M5fE+qW/5GtjrhyLXFwluDekrgvt2Z0vRnYMlUV+ymXS9jzqC2xCZJp5K9m8BwNpMsn/Wrlz4TFiZqlH4oIpcRfPtCjmPXmLuWVsBKpeqlYIkHITtnvUCj2mS+XEJ5nz1CDlISzagK5TcYN6bSdS3zznhEOjHdUCbWbhnuM+4EVj0YoXxJtuapDtLSn2JPnT78m0lVxGup9JOA5hN0Thf56ViNU0IaGCfDViuwzB5ny9S1NKR9PeJHMOadQyw90ItFfM1cvAmuZcHoy1CqRfUrF/DOSvthWKfprwRDwsbWiMBvWQIeeUfKSfxzWNqud68lXgATnCt32swl2jmYDj1w7t9APpWNBdyadP3VWAZT/j/8OAAD//+0wZoU="
+	return "eNrtXW1zG7mR/p5fMeWr1Eo5inrZXSfxh1R57eyd6uy1a+VNUnV1RYEzIIloOJgMZkRzf/11NzDvmDdySFEbue4cr0QCTzcaje5Gd+PCeeDbN47aqpivf+c4sYh9/sZ5dUc/eAU/8bhyIxHGQgZvnL/ADxxH/9JRMYsT5ax5HAlXTRxfPHDn3edfHBZ48NO1jLZOotiST5x4xWKHRdxxpe9zN+aes4jkGn7OHRnyiMUiWBoUU5hDrWQUz1wZLMTyjRNHCYcfRtznTAG6JYP/Wgjue+oNAbpwAraGX4SRdLlS9DOgZRvihyOZhOYnFlrwz2f9tZSSqflFcYbiLEg3z36azgN83MjIK/y8YTb88wXoNmD1cFPnRxk5/Ctbh8T/KAkCYMmraW12N0ymoRvX5lcu87k3W/iSFX+5kNGaxcAaHrk8iAfA01+A5XPkgpY1FmvuqBB+6My3tHQZCSJwOf3EZyp2+CN8ZloZUSjnkfkJd+AfAYLyxa8gBmakIFnPeZTO5MqIKxIjETsRC5ZclUYj2blyYulc2xkEPAX5QcA1PnnlxevgAtG8WfGgRO+G0bJFIMf1+bXkP8EamS1XBCpdNwkFsFkEzprhX/ozZz+//Xg+Le2dTAUM2jr3+mv3sGSAQwTK8SUQaUbru6NwvWvMKs7ewQuD4gLHKUBBUTIIkMcOQ0Fd+pzmQ44xZ534saDvFbRP+qescLLVqhBRJER4pR+npPgyWFZ+0UIN/kHo7xCV3hg5qtIn/8P5nEmAsgKKZcz8iix2ymO7TPZA/wVndZgbi0duURul5bbCThTQfHTUXVoP9g8Cg/9iLp/2oCAW7oMaRyIQHFvLBGDsB8yI+Sky94FHAfeHUDEigzs5PABdIOAjJ8dhGQBXNhdhJGQk4m16SHDVh5qjcXpXlMLzT5DnhKoH8OMJcg9AcsNEfJIaAoE5ZyAinlAP5/3oOKaOGIYv+tfpMRmOkUfhojeG5vcK/vLxP1Ys8jbowIkg5lGUhLHqQd3RWD8aaiUX8XNaF8S7G4VPvTY7II/5U9iyPdSSCB6lD9QzcK1IBRhD91FEcQKWMH5jsxK+9pFXgBdYomRUm4wcywK/JHw+So9AGU1rX3j7yITP5jCyDPwtHp6/BOJrL0YeUy8+Mwatpcf9mXa9rByqB3t6+nQ0curUOZ8QEtMIEaDzQQTJ117YWCm0MR4yGHdHXKtfrYBsG7IHHHLJ3SSKUIJcX7oPu8HCcWYNbvnOvApXWyUwzEEob98jNBNVWIvlKjZ/86/cTWKugwwhCXfEmacmzopjwGyNX4hBI9YmkTCcCWpMcdip8O4dlwWOWrFIbxIF5JU/Y0i9341RKU2jM4vYknFMM6sVXiGQt1dQCL5fi0shIAy4qv3iPBSgHFF32sKfIfxlHCJSilLFU/1hGVzkEdTaePlhpZyN8H04ch85hrrYV7FO1iYKC1PdX19d/d75g57unsauDVaI1BbHZT4KMuhw9oDSmMd2g1g6zHXpJNDH/WN9UAsWhLJzkOs5RItA6OvBRjWpDbuVCW10WrQiy7MrlCWwHiwmUhyab8W7g4kjFs63tWFNRB2+DqO8hmUHaHgtYwLbmR4Jk2nKzXstPbBDr//UuDi/rajSbytu83wjIr+VAMRv2sH/N3CVXxzOcfypJ7qF6sFIunxXjiabTtRb0E8kOLef/o5aqMko+Sm3jHrZJ2hJPe8rLXPGnywhQw/60yRkr9P+RNem95F/srI1+Nw/TUpGP/yfFZm7WgAnK5XP0gw4NW72sQImaSBE2VLWyLm20F6xGL7UAu7PJVnklNMsnkdiwgne75/0vfjzvTl+rveqL9eBRZ6gnAq51/UDDlG4f8D/hNMkS0jtmQm/+x3FwCvCLPdceex6+HITeXR72CFsPBJs/LvVFMI3ysyQ3bFS5vmabeEEjzGYDcLxKDx9jDPfz5leG9PE6DsIwouQKV14jLh5yFIqWBh0bQgigyuEIqMSFyV8kfj+tgPfBg4rfnCANMuOCImD823c/0YtNQVtX9oBPA1DMMqw8c6Grib1FZdQtdHKdqCC/SwjM5K59BVG0gKHKZWskTP0KUeJX8kO/f76ptcKPj2DEEfMg3F4lA7Wk021UbvZRmJVKQEZW+zXwkefAJQ8bM8sxx3VCu3YXgv7ZBD1nu00Fg8N0I7Rk3gO3l5+UuVDvAmkDNUBMSIONFzg8FiCr6fqBgNgjmS43cdiyG0TUz1TH3O4FcDSQWdzEY9q32VocWBkUh1uIbvkyf39HK/BOUFuMl2iInXVlJR+ppe/u/rz69oqL8AmLRVK7WYa5sPUElTyX42Rp5IRfaSDg6xAct0L/MaUkMBJAvCcH4G6JSh3CkDAfqJpplboHgerno+c6Fa2VMs1l/eXMOUl/vb63h6+gnkPAAXHqELhX+Pv7qfOLRy2EhwnF1adKtj+LgJPbpTz6U77S5Q+k6Zp3CdBxvR7OEcxEQeLAEm68WwO9OoCIF2EGeMxIDewHmf86xSmB4cxABlEWOp82pB+CIbkLATtHI/LCxoYdT6NXVsb+5LQbhlLqVV0vgikh0FBnSGj9+QE3VJ3lbGcocU7F4FmKnyJAE1grXyPR/APtV2DV/4A/0InXct0g8BLQqbG5aoZtJpMVtAyxHc7okXEn1RxtCsIRDcbVQBKhioOn8qAyLlmcc0rAe6j2ugFvrVzCzTucZ0snHAgvKc3Eiqg22L6mRgqPKdHqF6e6pEKFkFxl5qoEVuC/blkWdgI7XjawZVE0Pyre2e67ho4+CnfSvm+wULsJPBats8eW/qIGtz5SZIyNQdyGz1oUA7YWnbxqXOXKxKCnLXgMrmqGvG0LW2Him/ldxf6HrsuP2tJxVY2mk0xPBlAUgcdAG06/3gItW49I6Chnyji6XndPQXV6e2jozAij2PA6cwjTHTaT6u8un41VNPjr8A8nC0YxoXeYObzMG3/oQCfjOmsX8laBEnM7Xv41fenhPR7g7VB4by6Pim01xa4dtyUE/BUMmGRBbABskuCfnf9dXK+PwVyshUYg6LrkyDpeiya6EOvnqCKp6Fl0F42pB6iFk4y/X5GCCUdzbehc800UerssXI8n+YXPGJ7wTqi26ydwPyqnSwqs+aZw1VsvuVJriM/InD9xMs+DGKjr11gKxlz0mXuSnfhqk09TxYLMI6dM8Uzh9iwBvZ5wvxpxQyxuxY4gXc0kXpH06UEw36sevIn75j2kj69ALs5FXUkb2m0vJsfoEA3CJe3y9VoNemnTZbvoc1mQ1CBn4WNchuDYjQaW5G+9gRKOlXrznm84aZezuw74ERcugoxK2QtpcQ/1U8C4pDjpZc5Hj7d6cAnVQZ7HDS5D45nSLraAfF1H7JoQWGj3U+7mf5Ejp5ht10vAbtBMcEechOfQhpzhstS4EX5crkc7v7I1/mNGQVDLjHx5xKmwUj3xLECAuYWJqSvFcGRD5VrvkzTiUV59CyAniKYWmcD/J/u/oFdDOHzzAEVW9XSqQyJwLRLS0XoUxZcmJjv838lVk/TwQj/fVkN9xWLBvXWS8V1q7m+rmz9sq62S7vSijYs7K3zYIcvxFewAP+XyPq/V61BJZQ8GiW3rdCcEirGgBzdIQJKc4WIOEq9T8vRp6HhmScOLuTETE9crZN1NgzvU2nE/JZ/OHtPb6cmjYzP8hDh3JTRw17ejRmj4N6YnxQzKkstPtPfUxbsohLBO14yJY9XVzs4klXwfTIrZRIf9YKnmh6mSk1/7Xk6wZMijLjL4Yj1ejAyZO4Djw9zs2jG7smwwyGJMiQ9GQNoeBTJ6DBs0UObvG+NCLZyj7U6FiZYL68bETDJi2QYcu8giMBvl2tKkzBrR31BNhz9BT1tD44dEiCMv5TtACvdwJm/Ydv6aXqFptN7Fm3AYUAr+Ye792D2uwxOHGMQowEO/pqM4jwG2JxDXzmPZmCMr1mPqFt2WIADyPqdVx/NiaQTzAALWIdLX87xdtKodrL2Rbzt21g6nP7Bulxy/k/uDgwv3H7W17o8amga7Y4525d3HdMl3pjT/fK+e7qZj4m34875AYZsn1i461FX8d1HC6X2pxB2s7rMGAWrK8xfSmAei9mk2GN9Unz4odL5fWSri/mCVTVGyOJVRvfU8tW1WEZME2pelLD4kZW3HUZIwRr4zkNpERt6sXWTX/9mH+rDPSZk2Klv13mXu8+73GlGd43VciOvNFZEYDrJGvbBBQ6vvSNQ/vRYRPFlhokJwNPhYAkWsmiZrCkKqTiwlpkTzpqLIJYBdv5jc/kI6G+uvvtTY5HxDhtKNy/ZbTe5m12XFc9IvDf0RER1INsdZufBY39lq38421MCYEoRyQBXDuybSKCfr5qlQPfzQ0VqK5xhhVpF58eIc7CAJjoeqlXtpzvnH3bFUW6dOGI0DNT9hQq5KxbCLYbBwrzscmiMq7H4vTMa0zNgYalELT1e01YVbw3ITcl0PRDarCUigtUhRP28DkmP0RdNvO5uufTU8aJBDwkloUdn5m1ccBeUWMO2iEzE1Trt73GWjJHFCTyhQp9tc38hlmGqstNqYMeSOGljbkMji2fFYctTTfnIYz7ZlBGdP91UavlZM8SaO08cWy/YO1LYZeKQePWlY+vytvDT9iJWGZ1XN32HoOv/UlZr6suA6+qP9N2h51HXedd1XlnyeY9yJZNJQNolwfLe14qp4s2hvjatXGm/A20He/TdCmxM7pzFljSSbGSmzZXUp2MB6JgIZyGdiRW8ngm7G0cmRXKeNQQ2sVedwiVUt6RG8MunukZCJv/MlfBwa91x+H9Y7GlFWwx+Z61rRdI258boBZM/JimbNBRNVLl1emfQbk/RWXcbNjx/IkmguT0bMYlqrEryxkq9+RFjzulnYPdoWzCNq+jjGSWF2K3jjRhMzVMiLP1SdDIFntLGnxhbOUo4lPY6/ipFWGUeKBq/95nng6UYT7EhzoFOZBxaz5Je83dAz6wn65Alr7AwNvYDn2P+D5pVXtWiw7bgwZbO3y5WYFejA7EChz4UKwpjIyuocw/wI2JpO7ZIyoYSRte28XbekmlcHzcQ4VF58x49E/VCoQIjOleZetCZP6BDSs84pn/Mt7LyrojnNxo1YwrPXT2QWokQFSmzNOwPLpAdZmRioOKlCWTh+QU/f39hqN8umkXJHj8ZIE2378nAQEmSVOOmqVHYHUS6gsJhGxGv9HGKbLb7MLfk/WEzLBV8E+tiLxwVxk9U3gMoHZ1GI7rTLDN7Ft+85eq2FNdk8epwTMLR07wjI0fVjgbmxyqZa3/qG6WrBXWN9SCW0WzHYJoZd/YIYg4c2Ps0MeNQWYCBnG2xtLCgelvSDK4WWGtXKAOWE4bOFwrsthX3Eowe0ovaupKf4IKwZxXJZpM3pF7Sd9LDQ2LXC983ancjs8ByNhVWO7778Y60289f7IPi78HNwpRVBJP2ewKFsmAiyocyShD4ipwGykFittYRdQ2HcU1S3zbNtU0XLEsM3XB8WWcK+AowrONGHFwa7ShXQCm8lc7fILGGAazGspP3fCwvADHZpNA7XhJRMzpnCRsxQMNYSK9hrwU6yhd4AC6l9W83k+LQ2L2MR8tU4a3xrDdBLaPUm+MsIFIgOTE1kmCeJ3AhJojoIudJ8WRY4rtDQNnfblr2afPJ0Hk69FF+tR0DutrQW5X2VgANuncnCH9p+JDjfN5FBzeOZtPNrURSXU8rlU1KqUajhvl40zpm28qXY/rgq0yDZko7lHgLQuUARu0LgaQGLJCmcVRPUHZnuYyqyVvuBasvc5qjx+OAKURsy7h6AMQrv4MtHh50dNeStl8etojWt57GY1sJXU9WHXEp7fA6caZvNxxsUY3ZssuSNr4dNR4DC+h6s+qIi2qD16r33YWaGsMiadf/LcvZ94ijeegMNou7Fm6Uri6ZgSu5gWNvmfgsQteycShN/TfFzlRo/0RcySRyMYd4JRPwI9C559ibymVxY0KjjSf/SmTMDs+SL5V4eSNjtMEJlDQOlZnzrGhLRkmQ2pFokumlds7A//f4QujYSTOXi8JxPoB7FO88NO/eBpTWjvcI+oqAbhnMHQ5eIeYGlH7ssmCYNw5aajaXRl5KbJ0WLtfTyTxjxTdzMkwMU3QMa50o6iF8g8nTK/BEiiGdVvZG8Qnv12xfNvO3Yb/SXcbQjQq8iLDX25qfBDPoLlzihXVMXrIIEpkos+caB8am9qU4X3kT6zc37VwbYtsbqTk0m/JeTUbVUOUJ7BZFSqe0YXBTlFVMs3LDrU2sgC0dqt4SokmPV5GMYx/Lno7MBJQV1bSqc10EbLDhBSrDbPRJ47jFRosgC6jb0zx2EJ6tYdDXFfwEY1LUlHzRqpcK6g6lurRCOqguIofOwvMdOX74IzS7diYS4LDTHbjOKlv0vHCO5gvSYWHYFmqCbCpWCxhT7PFmEGOCp2RMwaoejS/T9igKdjtOIj5OjOGzGc05S5UhaVwe4K/P611Kdgk9YMPUTpu9DXEN9V1qhqRHiLHqYgfz2WPdolWvAX4QqKAwqwyyGGBKbcuMXeQVSby+6nBM+jsn/XIAuhdUPoLmvL5yuny+IhmvT5SM18PI+PbqROkAYIMIaSo2Hxwj2JMKrf3ucFel6qef547598fZ+nBE40UrPrbUb+dj1mrixyzgYFv62xdF8KII/k0UgWVr/4h1Mvat3XbxildZ+12+mqvUtNO4pUbt5Yrr1K64TK79cUJ6xUchC8Z2qT0aKv/iJXyHzT2IzGb10SubcwC9GaUlwaNXAyq5nKhCkOb0G8PWrT2KfjpUTSoVDrqmAVvj60xLzHFJAjdNZaCXikzP09Q80An5DH1XlA1KbDCv2Q2L+bTfvw2T+IwjRRHOxJduktbS48Pxjb2oWf1FqkzqZVkT20KWqyKsM/Vm+SGEdRy6msVzyMJ13QKOLlpnunbmfLiMdV4K7rYaX5pWo1jkM0TOBnD9ENI1Fj37yheObU2Ny0mzlt2mf0rlt/uf5plo1qJmmayCk8YZ+HL40cqp3hLPxn6FXcd6a2nTQItVlzilyca6v8KL0Xogo3W4cQpLPNXpPE2lJr3Uale9ymA7ttSBcL5tzFs8SwuMzgcTvGZfT4doTIY06ZzFVrVjU66rKE6R6jwXQZt05VarSG3abQIvsptdFux8em4K/+qJxhTlKlxlwVwDuLdgwk8On2BQriAyV3mVSkZdTHJWWdNzLFdtHDfi1EB6iLjIzZGFJSVPbvCCh6vVSvpeJ07MZHgaoDjzEKTHVzpZ6evXLpyd4KnWfqRs3bqAF1L8IwdMBOy0loWh9UHgAwJ/z+s1EK1RUwhzUW3ia3F2lJdRpy8IYJ/5QQxGnT4Xqz6zS7kedXZJpWsDZp89iB43LEMh4KAos72R4EEyKgocEE+0GoLeul5tTs0OxBJS3W48LS8vGYXU6Jz69uonpIy91DjemHZUxqxTtSFrtf7oeNWZVWJKi9O4L7NO3uxM0+iMwFWZ1l4WcRBjE7n2PKwvQPps7C/AemoWWA/IJ+R3VLUu7ezGEc9q2588lLon0kz7i835YnO+2JzP0+a0wXg41YijuW84WODx4fQjj1UWdAYgG0cdzpnnEJks86fN7GtOfN/FHHw4zeDjwwGjjzj2DNu4n/zlBLoO2CE+e2ir1vFsCKHP4VKiSrFFR3Sl8e+kPYlNz0FPGGZV+VRTGF1c2tl/zLh1mkqjupDN9VdWZ6FHxsIfdr/IL5GsXzDMW+jdfmrpLlGB0ENIRwTSA5F+n2/GAhmczAZ6C2C2ayxXzIItdIVHKej6PUGqUbnA93SC2N9ekAo6+/DzL81S4wsVl/rTr8MFvvW6AuadT4Zq6BLz8PLxyMzDNooXc3yLpvj2q2EO8CEjdweqiNdHpucznpo08dhrtBI8YpG7Ei7zZ5pVs9M6L4rZMFnQMYVtTMrsrZKC8tQHwqStmHd/dqnNaXIrjzn15ltz9naJn7vxLX3p9Plo0uxt1qK6KO285hBedUfuxKknUJvNnLIrVCuPdpCONcMg4mlRjE2gc8P0QkN0zP8gUtWsisfVOSEo/9mCJX589GJ41BEsa0VadtnjSCyXPKLgb9h210PQB8rDP2U0ewZ0E9AOwp1XH/FTr/R/YkPXEPsZZ41eTYREv37sb6nhayzbYgL68Wh6QYY6/nmi2Aq1p0SpWWMs6gANJnBC+pu6TMjCm+m6zIKlj5rtQIdM4ichBObNFcS+pLQ1wD+ZY9FkFOIOiVig9PNfzipZcuLLOSaUxy3Kb0zDFRg2w5lPhms/VR6exRfNM0Za+TWsNgKW4XQOx+yGf8fVSwL+KNwY25mcmumsDWMWYAcX6knm+gxOBa8XpSmVc/9ByP2qAH7wpVt86vol+X/s5H+1a2HqaYXWqy9ek65Z8CjSIVDU4WQmYLXLnITKw83HVctVVNMN1iA2CXmcAt6cAbeXn9LHgGVA7byQ26Y7A5C/O+HUb4nn71CYVjr0+o30hbttfoZhX0VgAPztBpXBx/TZ1YiHPnNxftI1L9rhONrh8PFz7NSt5RTX2zwG2DuAvjOK2kCjxuL3JKpTlRwayfNriXW8vjgvLbFeOuG8dMLpg2vnJlfH62+XVUS/bOGXLbwvHb+NTZnbAPp5MpWs16xU4x+L2OdIoY4X39U/YN2gLbarGSJ7qKXyZhF4J+nbm+bhtZVUJSsVrFs4lHHSYn9Q29Zs43AjR7ueRa/4ZTlsgitUDW+hU7QmbCQkeVSu8lhpLyzY6290INRAcAgK5XMeHoIl6cDD0MQS78DGB6PHHYTlV7mei/FXSA87CInH2fgswUGbUDi38TcK3znbOkngiwd8jkt3pY71k2x4zcQiZ55QaxgyzqlhNGxMJeLEhEjg02u2NZdSdtI27IFb8vH3Jy8d+AIdnkZ2O5/w6cIF+OJkiEgf383Sb5r+j746M4+9qWb4B4F+WNghix4OsMv0sGNBn+CbCOYlMx5hKgk6svrNsQa6kuAhkJtgfMIyWgo9slfat0f3OvE9fNqQIvpwpvJHtGsjvLAziOxwsVSD1WyUyoH/xf6hfi+OvsMoE10XFdrHmWm15W16SQ18qtN+jnbaSXVshXJiEIv0GDUIpy1XINKtS/CO86f8MINiFPb28tO0ZqNFrBTQHGx3Vb4/3IrCv3/XP4zZJd4sb2uog1ZNZzKLEzXevJTWI+Kt0RM9ENhK/vYAgNnRuBZ63DIAOwe2gTtj1KpxPBTvTBE9Du7owSeO0Fh+fnv7HpzkCA5PehDFAzXI4LN260CAS23yYUfSfAXVZ5Kw9CQt8x/SwqcZCotEPdeEIsXWhokuxcdnCQ3rdbNEF+SNP78p9Oucn/ZX/e6gJahdapq3k1RjEhs5RGxDKPQRqX7XqMTHlZxCngQNXhQarImkvDrn+urmuwu8gUghtMHD/XkAW8ngMx62gahzwyKKmeG8HWgz/cSjiu6yn03ZiTPnMet3ZhVjBOlDwno2dYRDi+pYCsdUndBiTTfzZiRt+8yGozilg6ltzr2nS8/CAVMm8/2phEEuhhE5UyJw7XN6dTC1CSn1MWbrMJ3Qp0sdbT6vWLDkU/NOeAqFst302YNJBsYynGhPAv8PtH8SlttHF1Hzr9ydudLbi093t//17r8/4NPxHtd5DgWE2N4RnZLqU99lh0TEOvV4/zUrrheOW2+8V5/1kQceZrtGXPF4n9k9ThmmQ1Bkz5Pt5roYbZO9Jq2UdAU+CqefrW6S2m7fBZ8ba3lOeIADgS9t93k3uNy/taOEtv/8pbLXWjlAzdnEm5cppY/uNWuhrlDf5fRemMKZwGMQuof9UlfMIPmLKE1dgXqlqhy7cESnUzWkSRdQhVgXER8Pl5mvC5lM4n2hWadtyrcuzrvXQlkWoThn3bbCmEC8j9uPJcd6FJUbePpo2+9SxRMRb3ZKmS9q1f+YD5VtnGnT99diqZPcQGlESUNn8ryNyVrUnh/qiwA/t8/k+EyoPxWhdfraj/lXMEAwtHb955vp1fRmeo1Bupurq+s3V+9/+NObtz/89f2bP33/7es3b66HmfUfEIdz+9lhnofXfCZhHx/enaP9Ar96/A4ng/99nX2oD234umBf3yOj7+ZmF/g4VQemCE6cmJ8Aw38mICNz3FB3FJYbAvrzHIPnAwy4DNgfX1/cXF9fXF//8eLb19NgMzW/AUugFvXtwPz5y8+YsQ6TWQ/9KF0TvD5CE13OMWgPH3kU+AYzxvXrxzMsoS/lQ2NKZ4UNPPa9GRagzmTAd+HHzuSjFcwXC+6avM7wQocPPUlewBn/8uH9eWoZG17goulyWXx0G6y1Gv0+m3N/6vwIkmqQTWgAHO0/r8ntfrWQcjpn0XQpffCUpjJaTl8hf18VfzC14dVlXDCGx2MerYUJruvhwavBejSdvh04HMw6z4PVcmW4zYKiLK49AUpfWMVx+ObyMkzmPljnyWIhvhKO3rI841FUK2/bI/D0VxzOfGiekqnfxM3WhCTQiJtjWnF0IE7zMkLhDTzjmr856IhLh4HFWoMjvCMISxBmNxRrzxfBiJcQ9HKboc0pDd2KA7z7HTmBcYGEaoP24Qe97jNYJOzfGj5xY0itY2pM250NEIWyDdycm3RHv3fU+KlJ+K4WFrSm9rPIE5JMgGAvC5o1JB50BifqgvyW5DgItEWthsYkWt1yfDt9yaMuh9heoYDAiIfN6ApGpwCett7s7o0lm4KMH3vYLHbDMdcFPbDd16bj1Z1mhnT53j0Y9rHc+q/oSqYBn4kzZ0rn0eahGexS5ZJ5pouKqdZWB9RCKkoQv/Kp805GYBaH9CBhLNM3gRSnpJ5L1JiX8KVL8NYuRfj43SUsC/YlMTkc+ZvxMDVlcEybaxaZtWBgQLSnWaDaV7dvAEhG4Yq1F603r3RPtIRY73WzSGZaLKZzw3Rpm/nbSkGTDhmbgFSfdPO9n145AD6E1qZnapcFCi0CoVbcOzzA/A6wMO0gbrq+VHy2YSI+JtoKQtQRsxzJzHoZVlHrYn0asDMgfVCrbTBTPHhy0CmOvpjBVX88BcyIow/mhQhoTa6fGnQGZAjqm1NBfdMHNV6/zpj78NSgUxx9MKOuOcoJ0q3yAIYNceakeaOar7+8/42Yr0jIE5qvsCwnaL62r25f8/XYxl8X6krA5hDMuzOShPLi4JbMK1/MEkccTT+MUWM/rHstejMTpphmSd1THqAcePcYVAXx2bXdAQjJ9NjroHImFEq42q0wavZ7NHu8bIvviPeoBvpfm83yIbhB3RxLHD6lW3IH9mZrMzW3ZB1366O0zHibTlWE6TDX5SGgrzE9IuLwMmIZsTXepkzSUCCeUs69CN/gVr/UrbDgX/ctL67V4ps6j2yfuOW9HuK+3CzWdItBsdeHl/6UiW7uefmf6JDtdK36XlamB3r61cqvRRAm8Sz90Fr4IBnWhKYe6fWf7lJaYZmKQ9WTVzEyrQ6RuvpBLpfcu0hfjgdhw7Ki6pVWG48bAvw7Fx3kXWwMGHu+JWfxePO+DYqXtb5cCrSlqlO0NMzZk+b3P+DtJ+WV0+h9OGBJC9kTBX49y1osSEPDAtiy1/ZZg0z4+ibLlS9MrUjmUsKWCIYiwa9RMZirNRNL76pbObJP8qp9RdI3JCtpyC0YXDm2VBRWQytozzJLXoTEPB6NVeGC99FSxs7nfjpBr9FsYBJI52H8tpSoYM7//PHFCqD8H/8P4o+Nug=="
 }
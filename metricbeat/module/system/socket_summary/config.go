@@ -0,0 +1,39 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package socket_summary
+
+// Config is the configuration specific to the socket_summary MetricSet.
+type Config struct {
+	Processes ProcessesConfig `config:"socket_summary.processes"`
+}
+
+// ProcessesConfig controls the reporting of the sockets of each process.
+type ProcessesConfig struct {
+	// Enabled enables one additional event per process with sockets.
+	Enabled bool `config:"enabled"`
+	// IncludeTopN limits the events to the processes with the most
+	// sockets. All processes are reported when it is 0.
+	IncludeTopN int `config:"include_top_n" validate:"min=0"`
+}
+
+var defaultConfig = Config{
+	Processes: ProcessesConfig{
+		Enabled:     false,
+		IncludeTopN: 20,
+	},
+}
@@ -0,0 +1,117 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package socket_summary
+
+import (
+	"net"
+	"sort"
+	"strconv"
+	"syscall"
+
+	gonet "github.com/shirou/gopsutil/v3/net"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// processSockets holds the sockets of a single process.
+type processSockets struct {
+	pid            int32
+	all            int
+	tcpListening   int
+	tcpEstablished int
+	udp            int
+	listening      map[string]struct{}
+}
+
+func (p *processSockets) toMapStr() mapstr.M {
+	listening := make([]string, 0, len(p.listening))
+	for addr := range p.listening {
+		listening = append(listening, addr)
+	}
+	sort.Strings(listening)
+
+	return mapstr.M{
+		"all": mapstr.M{
+			"count": p.all,
+		},
+		"tcp": mapstr.M{
+			"listening":   p.tcpListening,
+			"established": p.tcpEstablished,
+		},
+		"udp": mapstr.M{
+			"count": p.udp,
+		},
+		"listening": mapstr.M{
+			"addresses": listening,
+		},
+	}
+}
+
+// calculateProcessStats groups the connections by the process owning them.
+// Connections that can not be attributed to a process are ignored. The
+// processes are sorted by their number of sockets, only the topN processes
+// with the most sockets are returned, unless topN is 0.
+func calculateProcessStats(conns []gonet.ConnectionStat, topN int) []*processSockets {
+	byPID := map[int32]*processSockets{}
+	for _, conn := range conns {
+		if conn.Pid <= 0 {
+			continue
+		}
+		p, found := byPID[conn.Pid]
+		if !found {
+			p = &processSockets{pid: conn.Pid, listening: map[string]struct{}{}}
+			byPID[conn.Pid] = p
+		}
+		p.all++
+		switch conn.Type {
+		case syscall.SOCK_STREAM:
+			switch conn.Status {
+			case "LISTEN":
+				p.tcpListening++
+				p.listening[listenAddress(conn.Laddr, "tcp")] = struct{}{}
+			case "ESTABLISHED":
+				p.tcpEstablished++
+			}
+		case syscall.SOCK_DGRAM:
+			p.udp++
+			// Unconnected UDP sockets receive datagrams from any peer.
+			if conn.Raddr.IP == "" || conn.Raddr.Port == 0 {
+				p.listening[listenAddress(conn.Laddr, "udp")] = struct{}{}
+			}
+		}
+	}
+
+	procs := make([]*processSockets, 0, len(byPID))
+	for _, p := range byPID {
+		procs = append(procs, p)
+	}
+	sort.Slice(procs, func(i, j int) bool {
+		if procs[i].all != procs[j].all {
+			return procs[i].all > procs[j].all
+		}
+		return procs[i].pid < procs[j].pid
+	})
+	if topN > 0 && len(procs) > topN {
+		procs = procs[:topN]
+	}
+	return procs
+}
+
+func listenAddress(addr gonet.Addr, transport string) string {
+	return net.JoinHostPort(addr.IP, strconv.FormatUint(uint64(addr.Port), 10)) + "/" + transport
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/shirou/gopsutil/v3/net"
+	"github.com/shirou/gopsutil/v3/process"
 
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/elastic-agent-libs/mapstr"
@@ -47,15 +48,21 @@ type MetricSet struct {
 	mb.BaseMetricSet
 	sockstat string
 	mod      resolve.Resolver
+	config   Config
 }
 
 // New creates a new instance of the MetricSet. New is responsible for unpacking
 // any MetricSet specific configuration options if there are any.
 func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	sys := base.Module().(resolve.Resolver)
+	config := defaultConfig
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
 	return &MetricSet{
 		mod:           sys,
 		BaseMetricSet: base,
+		config:        config,
 	}, nil
 }
 
@@ -169,5 +176,26 @@ func (m *MetricSet) Fetch(report mb.ReporterV2) error {
 		MetricSetFields: newStats,
 	})
 
+	if m.config.Processes.Enabled {
+		for _, p := range calculateProcessStats(conns, m.config.Processes.IncludeTopN) {
+			rootFields := mapstr.M{
+				"process": mapstr.M{
+					"pid": p.pid,
+				},
+			}
+			if proc, err := process.NewProcess(p.pid); err == nil {
+				if name, err := proc.Name(); err == nil {
+					rootFields.Put("process.name", name)
+				}
+			}
+			if !report.Event(mb.Event{
+				RootFields:      rootFields,
+				MetricSetFields: mapstr.M{"process": p.toMapStr()},
+			}) {
+				return nil
+			}
+		}
+	}
+
 	return nil
 }
@@ -23,6 +23,8 @@ import (
 
 	"github.com/shirou/gopsutil/v3/net"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
 )
 
 func getMockedConns() []net.ConnectionStat {
@@ -248,3 +250,45 @@ func TestCalculateConnStats(t *testing.T) {
 	assert.Equal(t, tcpLastacks, 3)
 	assert.Equal(t, tcpClosings, 2)
 }
+
+func getMockedProcessConns() []net.ConnectionStat {
+	return []net.ConnectionStat{
+		{Type: syscall.SOCK_STREAM, Status: "LISTEN", Pid: 10, Laddr: net.Addr{IP: "0.0.0.0", Port: 22}},
+		{Type: syscall.SOCK_STREAM, Status: "LISTEN", Pid: 10, Laddr: net.Addr{IP: "::", Port: 22}},
+		{Type: syscall.SOCK_STREAM, Status: "ESTABLISHED", Pid: 10, Laddr: net.Addr{IP: "10.0.0.1", Port: 22}, Raddr: net.Addr{IP: "10.0.0.2", Port: 50000}},
+		{Type: syscall.SOCK_DGRAM, Pid: 20, Laddr: net.Addr{IP: "127.0.0.1", Port: 53}},
+		{Type: syscall.SOCK_DGRAM, Pid: 20, Laddr: net.Addr{IP: "10.0.0.1", Port: 40000}, Raddr: net.Addr{IP: "10.0.0.53", Port: 53}},
+		{Type: syscall.SOCK_STREAM, Status: "ESTABLISHED", Pid: 30, Laddr: net.Addr{IP: "10.0.0.1", Port: 40001}, Raddr: net.Addr{IP: "10.0.0.3", Port: 443}},
+		// Not attributed to any process.
+		{Type: syscall.SOCK_STREAM, Status: "TIME_WAIT"},
+	}
+}
+
+func TestCalculateProcessStats(t *testing.T) {
+	procs := calculateProcessStats(getMockedProcessConns(), 0)
+	if assert.Len(t, procs, 3) {
+		assert.Equal(t, int32(10), procs[0].pid)
+		assert.Equal(t, mapstr.M{
+			"all":       mapstr.M{"count": 3},
+			"tcp":       mapstr.M{"listening": 2, "established": 1},
+			"udp":       mapstr.M{"count": 0},
+			"listening": mapstr.M{"addresses": []string{"0.0.0.0:22/tcp", "[::]:22/tcp"}},
+		}, procs[0].toMapStr())
+
+		assert.Equal(t, int32(20), procs[1].pid)
+		assert.Equal(t, mapstr.M{
+			"all":       mapstr.M{"count": 2},
+			"tcp":       mapstr.M{"listening": 0, "established": 0},
+			"udp":       mapstr.M{"count": 2},
+			"listening": mapstr.M{"addresses": []string{"127.0.0.1:53/udp"}},
+		}, procs[1].toMapStr())
+
+		assert.Equal(t, int32(30), procs[2].pid)
+	}
+
+	procs = calculateProcessStats(getMockedProcessConns(), 2)
+	if assert.Len(t, procs, 2) {
+		assert.Equal(t, int32(10), procs[0].pid)
+		assert.Equal(t, int32(20), procs[1].pid)
+	}
+}
@@ -0,0 +1,52 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package generate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	devtools "github.com/elastic/beats/v7/dev-tools/mage"
+	genmetricset "github.com/elastic/beats/v7/metricbeat/generator/metricset"
+)
+
+// Metricset creates a new metricset, and its module if it does not exist yet.
+// Use MODULE=module to specify the name of the module
+// Use METRICSET=metricset to specify the name of the new metricset
+func Metricset() error {
+	targetModule := os.Getenv("MODULE")
+	targetMetricset := os.Getenv("METRICSET")
+
+	if targetModule == "" || targetMetricset == "" {
+		return fmt.Errorf("you must specify the module and metricset: MODULE=module METRICSET=metricset mage generate:metricset")
+	}
+
+	beatsDir, err := devtools.ElasticBeatsDir()
+	if err != nil {
+		return err
+	}
+
+	err = genmetricset.Generate(targetModule, targetMetricset, devtools.CWD(), filepath.Join(beatsDir, "metricbeat"))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Metricset %s/%s created, run 'mage update' to register it.\n", targetModule, targetMetricset)
+	return nil
+}
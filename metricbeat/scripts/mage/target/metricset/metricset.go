@@ -18,38 +18,16 @@
 package metricset
 
 import (
-	"os"
-	"path/filepath"
-
-	"github.com/magefile/mage/sh"
-
-	devtools "github.com/elastic/beats/v7/dev-tools/mage"
+	"github.com/elastic/beats/v7/metricbeat/scripts/mage/target/generate"
 )
 
 // CreateMetricset creates a new metricset.
 //
+// Deprecated: use generate:metricset instead.
+//
 // Required ENV variables:
 // * MODULE: Name of the module
 // * METRICSET: Name of the metricset
 func CreateMetricset() error {
-	ve, err := devtools.PythonVirtualenv(false)
-	if err != nil {
-		return err
-	}
-	python, err := devtools.LookVirtualenvPath(ve, "python")
-	if err != nil {
-		return err
-	}
-	beatsDir, err := devtools.ElasticBeatsDir()
-	if err != nil {
-		return err
-	}
-	scriptPath := filepath.Join(beatsDir, "metricbeat", "scripts", "create_metricset.py")
-
-	_, err = sh.Exec(
-		map[string]string{}, os.Stdout, os.Stderr, python, scriptPath,
-		"--path", devtools.CWD(), "--es_beats", beatsDir,
-		"--module", os.Getenv("MODULE"), "--metricset", os.Getenv("METRICSET"),
-	)
-	return err
+	return generate.Metricset()
 }
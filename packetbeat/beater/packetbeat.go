@@ -73,6 +73,9 @@ func initialConfig() config.Config {
 			OneAtATime: *cmdLineArgs.oneAtAtime,
 			Dumpfile:   *cmdLineArgs.dumpfile,
 		}},
+		ProtocolDetection: config.ProtocolDetection{
+			MinConfidence: 0.8,
+		},
 	}
 	c.Interface = &c.Interfaces[0]
 	return c
@@ -126,7 +129,7 @@ func (pb *packetbeat) Run(b *beat.Beat) error {
 		}
 	}()
 
-	if b.API != nil {
+	if b.API != nil && b.API.InputsEnabled() {
 		err := inputmon.AttachHandler(b.API.Router())
 		if err != nil {
 			return fmt.Errorf("failed attach inputs api to monitoring endpoint server: %w", err)
@@ -18,7 +18,9 @@
 package beater
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"sync"
 	"time"
 
@@ -45,8 +47,14 @@ type processor struct {
 	publisher       *publish.TransactionPublisher
 	flows           *flows.Flows
 	sniffer         *sniffer.Sniffer
+	watcher         *procs.ProcessesWatcher
 	shutdownTimeout time.Duration
 	err             chan error
+
+	// config and protocols are used to apply new
+	// interface settings to the running sniffer.
+	config    config.Config
+	protocols *protos.ProtocolsStruct
 }
 
 func newProcessor(shutdownTimeout time.Duration, publisher *publish.TransactionPublisher, flows *flows.Flows, sniffer *sniffer.Sniffer, err chan error) *processor {
@@ -59,6 +67,27 @@ func newProcessor(shutdownTimeout time.Duration, publisher *publish.TransactionP
 	}
 }
 
+// reloadInterfaces applies the interface settings of cfg to the running
+// sniffer. It fails if the change requires the processor to be restarted.
+func (p *processor) reloadInterfaces(cfg config.Config) error {
+	// The publisher and the process watcher are set up
+	// from the settings of the first interface.
+	old, next := p.config.Interfaces[0], cfg.Interfaces[0]
+	if old.File != next.File || !reflect.DeepEqual(old.InternalNetworks, next.InternalNetworks) {
+		return errors.New("the file or internal_networks settings of the first interface changed")
+	}
+
+	interfaces, err := captureInterfaces(cfg, p.protocols)
+	if err != nil {
+		return err
+	}
+	if err := p.sniffer.Reload(interfaces); err != nil {
+		return err
+	}
+	p.config.Interfaces = cfg.Interfaces
+	return nil
+}
+
 func (p *processor) String() string {
 	return "packetbeat.processor"
 }
@@ -86,6 +115,9 @@ func (p *processor) Stop() {
 		p.flows.Stop()
 	}
 	p.wg.Wait()
+	if p.watcher != nil {
+		p.watcher.Close()
+	}
 	// wait for shutdownTimeout to let the publisher flush
 	// whatever pending events
 	if p.shutdownTimeout > 0 {
@@ -143,6 +175,11 @@ func (p *processorFactory) Create(pipeline beat.PipelineConnector, cfg *conf.C)
 			logp.Critical(err.Error())
 			return nil, err
 		}
+		defer func() {
+			if err != nil {
+				watcher.Close()
+			}
+		}()
 	} else {
 		logp.Info("Process watcher disabled when file input is used")
 	}
@@ -157,12 +194,16 @@ func (p *processorFactory) Create(pipeline beat.PipelineConnector, cfg *conf.C)
 	if err != nil {
 		return nil, err
 	}
-	sniffer, err := setupSniffer(config, protocols, sniffer.DecodersFor(id, publisher, protocols, watcher, flows, config))
+	sniffer, err := setupSniffer(id, config, protocols, sniffer.DecodersFor(id, publisher, protocols, watcher, flows, config))
 	if err != nil {
 		return nil, err
 	}
 
-	return newProcessor(config.ShutdownTimeout, publisher, flows, sniffer, p.err), nil
+	proc := newProcessor(config.ShutdownTimeout, publisher, flows, sniffer, p.err)
+	proc.watcher = watcher
+	proc.config = config
+	proc.protocols = protocols
+	return proc, nil
 }
 
 // setupFlows returns a *flows.Flows that will publish to the provided pipeline,
@@ -196,20 +237,32 @@ func setupFlows(pipeline beat.Pipeline, watcher *procs.ProcessesWatcher, cfg con
 	return flows.NewFlows(client.PublishAll, watcher, cfg.Flows)
 }
 
-func setupSniffer(cfg config.Config, protocols *protos.ProtocolsStruct, decoders sniffer.Decoders) (*sniffer.Sniffer, error) {
+func setupSniffer(id string, cfg config.Config, protocols *protos.ProtocolsStruct, decoders sniffer.Decoders) (*sniffer.Sniffer, error) {
+	interfaces, err := captureInterfaces(cfg, protocols)
+	if err != nil {
+		return nil, err
+	}
+	return sniffer.New(false, id, decoders, interfaces)
+}
+
+// captureInterfaces returns the interfaces of cfg. Interfaces without a BPF
+// filter capture the ports of the configured protocols, unless flows or
+// protocol detection are enabled.
+func captureInterfaces(cfg config.Config, protocols *protos.ProtocolsStruct) ([]config.InterfaceConfig, error) {
 	icmp, err := cfg.ICMP()
 	if err != nil {
 		return nil, err
 	}
 
-	for i, iface := range cfg.Interfaces {
-		if iface.BpfFilter != "" || cfg.Flows.IsEnabled() {
+	interfaces := make([]config.InterfaceConfig, len(cfg.Interfaces))
+	copy(interfaces, cfg.Interfaces)
+	for i, iface := range interfaces {
+		if iface.BpfFilter != "" || cfg.Flows.IsEnabled() || cfg.ProtocolDetection.Enabled {
 			continue
 		}
-		cfg.Interfaces[i].BpfFilter = protocols.BpfFilter(iface.WithVlans, icmp.Enabled())
+		interfaces[i].BpfFilter = protocols.BpfFilter(iface.WithVlans, icmp.Enabled())
 	}
-
-	return sniffer.New(false, "", decoders, cfg.Interfaces)
+	return interfaces, nil
 }
 
 // CheckConfig performs a dry-run creation of a Packetbeat pipeline based
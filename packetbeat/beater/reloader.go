@@ -19,19 +19,44 @@ package beater
 
 import (
 	"fmt"
+	"sync"
+
+	"github.com/joeshaw/multierror"
+	"github.com/mitchellh/hashstructure"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/cfgfile"
 	"github.com/elastic/beats/v7/libbeat/common/reload"
+	"github.com/elastic/beats/v7/libbeat/publisher/pipetool"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
 )
 
+// reloader runs a processor for each configuration. Processors whose
+// configuration only changed in its interface settings keep running and
+// the new interfaces are applied to their sniffer, so capture is not
+// interrupted. Any other change restarts the processor.
 type reloader struct {
-	*cfgfile.RunnerList
+	mu       sync.Mutex
+	factory  *processorFactory
+	pipeline beat.PipelineConnector
+	runners  []*runner
+	logger   *logp.Logger
+}
+
+// runner is a processor started by the reloader.
+type runner struct {
+	cfgfile.Runner
+
+	hash uint64 // hash of the complete configuration
+	key  uint64 // hash of the configuration without the interface settings
 }
 
 func newReloader(name string, factory *processorFactory, pipeline beat.PipelineConnector) *reloader {
 	return &reloader{
-		RunnerList: cfgfile.NewRunnerList(name, factory, pipeline),
+		factory:  factory,
+		pipeline: pipeline,
+		logger:   logp.NewLogger(name),
 	}
 }
 
@@ -39,5 +64,170 @@ func (r *reloader) Reload(configs []*reload.ConfigWithMeta) error {
 	if len(configs) > maxSniffers {
 		return fmt.Errorf("only %d inputs are currently supported", maxSniffers)
 	}
-	return r.RunnerList.Reload(configs)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var errs multierror.Errors
+
+	type desired struct {
+		config    *reload.ConfigWithMeta
+		hash, key uint64
+	}
+	wanted := make([]desired, 0, len(configs))
+	for _, c := range configs {
+		hash, err := cfgfile.HashConfig(c.Config)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to hash given config: %w", err))
+			continue
+		}
+		key, err := captureKey(c.Config)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to hash given config: %w", err))
+			continue
+		}
+		wanted = append(wanted, desired{config: c, hash: hash, key: key})
+	}
+
+	// Keep the runners with an unchanged configuration.
+	current := r.runners
+	var (
+		keep    []*runner
+		pending []desired
+	)
+	for _, d := range wanted {
+		if i := findRunner(current, func(run *runner) bool { return run.hash == d.hash }); i >= 0 {
+			keep = append(keep, current[i])
+			current = append(current[:i:i], current[i+1:]...)
+			continue
+		}
+		pending = append(pending, d)
+	}
+
+	// Apply changed interface settings to the running processors.
+	var start []desired
+	for _, d := range pending {
+		i := findRunner(current, func(run *runner) bool { return run.key == d.key })
+		if i < 0 {
+			start = append(start, d)
+			continue
+		}
+		run := current[i]
+		if err := r.reloadInterfaces(run, d.config); err != nil {
+			r.logger.Infof("Restarting runner %s, its interfaces can not be reloaded: %v", run, err)
+			start = append(start, d)
+			continue
+		}
+		r.logger.Infof("Reloaded the interfaces of runner %s", run)
+		run.hash = d.hash
+		keep = append(keep, run)
+		current = append(current[:i:i], current[i+1:]...)
+	}
+
+	// Stop removed runners before starting new ones.
+	var wg sync.WaitGroup
+	for _, run := range current {
+		wg.Add(1)
+		r.logger.Debugf("Stopping runner: %s", run)
+		go func(run *runner) {
+			defer wg.Done()
+			run.Stop()
+			r.logger.Debugf("Runner: '%s' has stopped", run)
+		}(run)
+	}
+	wg.Wait()
+
+	for _, d := range start {
+		// Pass a copy of the config to the factory, this way if the factory
+		// modifies it, that doesn't affect the hash of the original one.
+		c, _ := conf.NewConfigFrom(d.config.Config)
+		proc, err := r.factory.Create(pipetool.WithDynamicFields(r.pipeline, d.config.Meta), c)
+		if err != nil {
+			r.logger.Errorf("Error creating runner from config: %s", err)
+			errs = append(errs, fmt.Errorf("error creating runner from config: %w", err))
+			continue
+		}
+		r.logger.Debugf("Starting runner: %s", proc)
+		proc.Start()
+		keep = append(keep, &runner{Runner: proc, hash: d.hash, key: d.key})
+	}
+
+	r.runners = keep
+	return errs.Err()
+}
+
+// reloadInterfaces applies the interface settings of cfg to the processor
+// of run.
+func (r *reloader) reloadInterfaces(run *runner, cfg *reload.ConfigWithMeta) error {
+	proc, ok := run.Runner.(*processor)
+	if !ok {
+		return fmt.Errorf("unexpected runner type %T", run.Runner)
+	}
+	c, err := conf.NewConfigFrom(cfg.Config)
+	if err != nil {
+		return err
+	}
+	config, err := r.factory.configurator(c)
+	if err != nil {
+		return err
+	}
+	return proc.reloadInterfaces(config)
+}
+
+// Stop stops all runners.
+func (r *reloader) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, run := range r.runners {
+		wg.Add(1)
+		go func(run *runner) {
+			defer wg.Done()
+			r.logger.Debugf("Stopping runner: %s", run)
+			run.Stop()
+			r.logger.Debugf("Stopped runner: %s", run)
+		}(run)
+	}
+	wg.Wait()
+	r.runners = nil
+}
+
+func findRunner(runners []*runner, match func(*runner) bool) int {
+	for i, run := range runners {
+		if match(run) {
+			return i
+		}
+	}
+	return -1
+}
+
+// captureKey hashes the configuration without the interface settings. The
+// interfaces are set in the interfaces section of a standalone configuration
+// and in the interface section of the streams of an agent configuration.
+func captureKey(c *conf.C) (uint64, error) {
+	var config map[string]interface{}
+	if err := c.Unpack(&config); err != nil {
+		return 0, err
+	}
+	delete(config, "interfaces")
+	if streams, ok := config["streams"].([]interface{}); ok {
+		stripped := make([]interface{}, len(streams))
+		for i, s := range streams {
+			stream, ok := s.(map[string]interface{})
+			if !ok {
+				stripped[i] = s
+				continue
+			}
+			clone := make(map[string]interface{}, len(stream))
+			for k, v := range stream {
+				if k != "interface" {
+					clone[k] = v
+				}
+			}
+			stripped[i] = clone
+		}
+		config["streams"] = stripped
+	}
+	return hashstructure.Hash(config, nil)
 }
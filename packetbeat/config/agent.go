@@ -107,6 +107,7 @@ func mergeProcsConfig(one, two procs.ProcsConfig) procs.ProcsConfig {
 		MaxProcReadFreq: maxProcReadFreq,
 		RefreshPidsFreq: refreshPidsFreq,
 		Monitored:       append(one.Monitored, two.Monitored...),
+		EBPF:            one.EBPF || two.EBPF,
 	}
 }
 
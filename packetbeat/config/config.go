@@ -31,14 +31,15 @@ import (
 )
 
 type Config struct {
-	Interface       *InterfaceConfig   `config:"interfaces"`
-	Interfaces      []InterfaceConfig  `config:"interfaces"`
-	Flows           *Flows             `config:"flows"`
-	Protocols       map[string]*conf.C `config:"protocols"`
-	ProtocolsList   []*conf.C          `config:"protocols"`
-	Procs           procs.ProcsConfig  `config:"procs"`
-	IgnoreOutgoing  bool               `config:"ignore_outgoing"`
-	ShutdownTimeout time.Duration      `config:"shutdown_timeout"`
+	Interface         *InterfaceConfig   `config:"interfaces"`
+	Interfaces        []InterfaceConfig  `config:"interfaces"`
+	Flows             *Flows             `config:"flows"`
+	Protocols         map[string]*conf.C `config:"protocols"`
+	ProtocolsList     []*conf.C          `config:"protocols"`
+	ProtocolDetection ProtocolDetection  `config:"protocol_detection"`
+	Procs             procs.ProcsConfig  `config:"procs"`
+	IgnoreOutgoing    bool               `config:"ignore_outgoing"`
+	ShutdownTimeout   time.Duration      `config:"shutdown_timeout"`
 }
 
 // FromStatic initializes a configuration given a config.C
@@ -130,6 +131,16 @@ type InterfaceConfig struct {
 	Loop                  int
 }
 
+// ProtocolDetection configures the detection by content of the protocol of
+// TCP connections on ports that are not configured for any protocol.
+type ProtocolDetection struct {
+	Enabled bool `config:"enabled"`
+	// Protocols is the list of protocols to try. All the enabled protocols
+	// supporting detection are tried if empty.
+	Protocols     []string `config:"protocols"`
+	MinConfidence float64  `config:"min_confidence" validate:"min=0, max=1"`
+}
+
 type Flows struct {
 	Enabled       *bool                   `config:"enabled"`
 	Timeout       string                  `config:"timeout"`
@@ -139,6 +150,26 @@ type Flows struct {
 	KeepNull      bool                    `config:"keep_null"`
 	// Index is used to overwrite the index where flows are published
 	Index string `config:"index"`
+	// Export sends the flows to NetFlow v9 or IPFIX collectors
+	Export *FlowsExport `config:"export"`
+}
+
+// FlowsExport configures the export of flow records to NetFlow v9 or IPFIX
+// collectors.
+type FlowsExport struct {
+	Hosts               []string      `config:"hosts" validate:"required"`
+	Protocol            string        `config:"protocol"`
+	TemplateRefresh     time.Duration `config:"template_refresh" validate:"positive"`
+	ObservationDomainID uint32        `config:"observation_domain_id"`
+}
+
+func (e *FlowsExport) Validate() error {
+	switch e.Protocol {
+	case "", "ipfix", "netflow9":
+		return nil
+	default:
+		return fmt.Errorf("invalid flows export protocol %q, must be ipfix or netflow9", e.Protocol)
+	}
 }
 
 type ProtocolCommon struct {
@@ -146,6 +177,32 @@ type ProtocolCommon struct {
 	SendRequest        bool          `config:"send_request"`
 	SendResponse       bool          `config:"send_response"`
 	TransactionTimeout time.Duration `config:"transaction_timeout"`
+	PayloadSample      PayloadSample `config:"payload_sample"`
+}
+
+// PayloadSample configures the attachment of truncated request and response
+// payloads to a sampled fraction of the transactions of a protocol.
+type PayloadSample struct {
+	// Rate is the fraction of transactions, between 0 and 1, that get a
+	// payload sample attached. Sampling is disabled when zero.
+	Rate     float64 `config:"rate"`
+	MaxBytes int     `config:"max_bytes"`
+	Encoding string  `config:"encoding"`
+}
+
+func (s *PayloadSample) Validate() error {
+	if s.Rate < 0 || s.Rate > 1 {
+		return fmt.Errorf("invalid payload_sample.rate %v, must be between 0 and 1", s.Rate)
+	}
+	if s.MaxBytes < 0 {
+		return fmt.Errorf("invalid payload_sample.max_bytes %d, must not be negative", s.MaxBytes)
+	}
+	switch s.Encoding {
+	case "", "utf-8", "hex":
+		return nil
+	default:
+		return fmt.Errorf("invalid payload_sample.encoding %q, must be utf-8 or hex", s.Encoding)
+	}
 }
 
 func (f *Flows) IsEnabled() bool {
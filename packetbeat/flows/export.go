@@ -0,0 +1,484 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flows
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/packetbeat/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const (
+	ipfixVersion    = 10
+	netflow9Version = 9
+
+	ipfixHeaderSize    = 16
+	netflow9HeaderSize = 20
+	setHeaderSize      = 4
+
+	ipfixTemplateSetID    = 2
+	netflow9TemplateSetID = 0
+	templateIDIPv4        = 256
+	templateIDIPv6        = 257
+
+	// maxExportMessageSize keeps the export messages within the MTU of
+	// common networks.
+	maxExportMessageSize   = 1400
+	defaultTemplateRefresh = time.Minute
+)
+
+// Information elements of the exported records, see
+// https://www.iana.org/assignments/ipfix/ipfix.xhtml. NetFlow v9 uses the
+// same numbers, but reports timestamps as system uptime.
+const (
+	ieOctetDeltaCount          = 1
+	iePacketDeltaCount         = 2
+	ieProtocolIdentifier       = 4
+	ieSourceTransportPort      = 7
+	ieSourceIPv4Address        = 8
+	ieDestinationTransportPort = 11
+	ieDestinationIPv4Address   = 12
+	ieFlowEndSysUpTime         = 21
+	ieFlowStartSysUpTime       = 22
+	ieSourceIPv6Address        = 27
+	ieDestinationIPv6Address   = 28
+	ieFlowStartMilliseconds    = 152
+	ieFlowEndMilliseconds      = 153
+)
+
+type templateField struct {
+	id, length uint16
+}
+
+type template struct {
+	id     uint16
+	fields []templateField
+	size   int // size of a data record
+}
+
+func newTemplate(id uint16, ipLen uint16, netflow9 bool) template {
+	src, dst := uint16(ieSourceIPv4Address), uint16(ieDestinationIPv4Address)
+	if ipLen == net.IPv6len {
+		src, dst = ieSourceIPv6Address, ieDestinationIPv6Address
+	}
+	fields := []templateField{
+		{src, ipLen},
+		{dst, ipLen},
+		{ieSourceTransportPort, 2},
+		{ieDestinationTransportPort, 2},
+		{ieProtocolIdentifier, 1},
+		{ieOctetDeltaCount, 8},
+		{iePacketDeltaCount, 8},
+	}
+	if netflow9 {
+		fields = append(fields, templateField{ieFlowStartSysUpTime, 4}, templateField{ieFlowEndSysUpTime, 4})
+	} else {
+		fields = append(fields, templateField{ieFlowStartMilliseconds, 8}, templateField{ieFlowEndMilliseconds, 8})
+	}
+
+	t := template{id: id, fields: fields}
+	for _, f := range fields {
+		t.size += int(f.length)
+	}
+	return t
+}
+
+// exportRecord is a unidirectional flow record.
+type exportRecord struct {
+	src, dst         net.IP
+	srcPort, dstPort uint16
+	proto            uint8
+	bytes, packets   uint64
+	start, end       time.Time
+}
+
+// flowExportState holds the counters of a flow at its last export, as the
+// records contain the traffic since the last report.
+type flowExportState struct {
+	bytes, packets [2]uint64 // source and destination counters
+	end            time.Time
+}
+
+// exporter sends the reported flows to NetFlow v9 or IPFIX collectors over
+// UDP. Each biflow is exported as up to two unidirectional records.
+type exporter struct {
+	log             *logp.Logger
+	conns           []net.Conn
+	netflow9        bool
+	domainID        uint32
+	templateRefresh time.Duration
+	templates       [2]template // IPv4 and IPv6 templates
+
+	boot          time.Time // start of the exporter, used for system uptime
+	templatesSent time.Time
+	sequence      uint32
+	flows         map[string]*flowExportState
+	now           func() time.Time
+}
+
+func newExporter(cfg *config.FlowsExport) (*exporter, error) {
+	e := &exporter{
+		log:             logp.NewLogger("flows.export"),
+		netflow9:        cfg.Protocol == "netflow9",
+		domainID:        cfg.ObservationDomainID,
+		templateRefresh: cfg.TemplateRefresh,
+		boot:            time.Now(),
+		flows:           map[string]*flowExportState{},
+		now:             time.Now,
+	}
+	if e.templateRefresh <= 0 {
+		e.templateRefresh = defaultTemplateRefresh
+	}
+	e.templates[0] = newTemplate(templateIDIPv4, net.IPv4len, e.netflow9)
+	e.templates[1] = newTemplate(templateIDIPv6, net.IPv6len, e.netflow9)
+
+	for _, host := range cfg.Hosts {
+		conn, err := net.Dial("udp", host)
+		if err != nil {
+			e.close()
+			return nil, fmt.Errorf("failed to connect to flow collector %s: %w", host, err)
+		}
+		e.conns = append(e.conns, conn)
+	}
+	return e, nil
+}
+
+// reporter returns a Reporter that exports the flows before passing them on
+// to pub.
+func (e *exporter) reporter(pub Reporter) Reporter {
+	return func(events []beat.Event) {
+		e.export(events)
+		pub(events)
+	}
+}
+
+func (e *exporter) close() {
+	for _, conn := range e.conns {
+		conn.Close()
+	}
+}
+
+func (e *exporter) export(events []beat.Event) {
+	var records [2][]exportRecord
+	for _, event := range events {
+		for _, r := range e.records(event) {
+			if r.src.To4() != nil {
+				records[0] = append(records[0], r)
+			} else {
+				records[1] = append(records[1], r)
+			}
+		}
+	}
+
+	for _, msg := range e.encode(records) {
+		for _, conn := range e.conns {
+			if _, err := conn.Write(msg); err != nil {
+				e.log.Debugw("Failed to export flows.", "collector", conn.RemoteAddr().String(), "error", err)
+			}
+		}
+	}
+}
+
+// records returns the unidirectional records of a flow event with the
+// traffic since the last report of the flow.
+func (e *exporter) records(event beat.Event) []exportRecord {
+	fields := event.Fields
+	v, _ := fields.GetValue("flow.id")
+	id, ok := v.(common.NetString)
+	if !ok {
+		return nil
+	}
+	src, dst := flowIP(fields, "source.ip"), flowIP(fields, "destination.ip")
+	if src == nil || dst == nil {
+		return nil
+	}
+	start, _ := fields.GetValue("event.start")
+	end, _ := fields.GetValue("event.end")
+	startTime, _ := start.(common.Time)
+	endTime, _ := end.(common.Time)
+
+	state, found := e.flows[string(id)]
+	if !found {
+		state = &flowExportState{end: time.Time(startTime)}
+		e.flows[string(id)] = state
+	}
+	if final, _ := fields.GetValue("flow.final"); final == true {
+		delete(e.flows, string(id))
+	}
+
+	srcPort, dstPort := flowUint(fields, "source.port"), flowUint(fields, "destination.port")
+	transport, _ := fields.GetValue("network.transport")
+	var records []exportRecord
+	for dir, prefix := range []string{"source", "destination"} {
+		bytes, packets := flowUint(fields, prefix+".bytes"), flowUint(fields, prefix+".packets")
+		deltaBytes, deltaPackets := bytes-state.bytes[dir], packets-state.packets[dir]
+		if packets < state.packets[dir] || bytes < state.bytes[dir] {
+			deltaBytes, deltaPackets = bytes, packets
+		}
+		state.bytes[dir], state.packets[dir] = bytes, packets
+		if deltaPackets == 0 {
+			continue
+		}
+
+		r := exportRecord{
+			src:     src,
+			dst:     dst,
+			srcPort: uint16(srcPort),
+			dstPort: uint16(dstPort),
+			proto:   transportProtocol(transport),
+			bytes:   deltaBytes,
+			packets: deltaPackets,
+			start:   state.end,
+			end:     time.Time(endTime),
+		}
+		if dir == 1 {
+			r.src, r.dst = r.dst, r.src
+			r.srcPort, r.dstPort = r.dstPort, r.srcPort
+		}
+		records = append(records, r)
+	}
+	state.end = time.Time(endTime)
+	return records
+}
+
+// encode returns the export messages for the records. Templates are sent
+// periodically, as collectors may have missed them.
+func (e *exporter) encode(records [2][]exportRecord) [][]byte {
+	now := e.now()
+	sendTemplates := now.Sub(e.templatesSent) >= e.templateRefresh
+	if !sendTemplates && len(records[0]) == 0 && len(records[1]) == 0 {
+		return nil
+	}
+
+	w := messageWriter{exporter: e, now: now}
+	w.startMessage()
+	if sendTemplates {
+		w.writeTemplates()
+		e.templatesSent = now
+	}
+	for i, t := range e.templates {
+		for _, r := range records[i] {
+			w.writeRecord(t, r)
+		}
+		w.closeSet()
+	}
+	w.finishMessage()
+	return w.msgs
+}
+
+// messageWriter splits sets of records into export messages.
+type messageWriter struct {
+	*exporter
+	now      time.Time
+	msgs     [][]byte
+	buf      []byte
+	setStart int // offset of the open set, 0 if no set is open
+	setID    uint16
+	records  int // number of records in the message
+	data     int // number of data records in the message
+}
+
+func (w *messageWriter) headerSize() int {
+	if w.netflow9 {
+		return netflow9HeaderSize
+	}
+	return ipfixHeaderSize
+}
+
+func (w *messageWriter) startMessage() {
+	w.buf = make([]byte, w.headerSize(), maxExportMessageSize)
+	w.records, w.data = 0, 0
+}
+
+func (w *messageWriter) writeTemplates() {
+	setID := uint16(ipfixTemplateSetID)
+	if w.netflow9 {
+		setID = netflow9TemplateSetID
+	}
+	w.openSet(setID)
+	for _, t := range w.templates {
+		w.buf = binary.BigEndian.AppendUint16(w.buf, t.id)
+		w.buf = binary.BigEndian.AppendUint16(w.buf, uint16(len(t.fields)))
+		for _, f := range t.fields {
+			w.buf = binary.BigEndian.AppendUint16(w.buf, f.id)
+			w.buf = binary.BigEndian.AppendUint16(w.buf, f.length)
+		}
+		w.records++
+	}
+	w.closeSet()
+}
+
+func (w *messageWriter) writeRecord(t template, r exportRecord) {
+	needed := t.size
+	if w.setStart == 0 || w.setID != t.id {
+		w.closeSet()
+		needed += setHeaderSize
+	}
+	// reserve space for the padding of NetFlow v9 flowsets
+	if len(w.buf)+needed+3 > maxExportMessageSize {
+		w.closeSet()
+		w.finishMessage()
+		w.startMessage()
+	}
+	if w.setStart == 0 {
+		w.openSet(t.id)
+	}
+
+	for _, f := range t.fields {
+		switch f.id {
+		case ieSourceIPv4Address:
+			w.buf = append(w.buf, r.src.To4()...)
+		case ieDestinationIPv4Address:
+			w.buf = append(w.buf, r.dst.To4()...)
+		case ieSourceIPv6Address:
+			w.buf = append(w.buf, r.src.To16()...)
+		case ieDestinationIPv6Address:
+			w.buf = append(w.buf, r.dst.To16()...)
+		case ieSourceTransportPort:
+			w.buf = binary.BigEndian.AppendUint16(w.buf, r.srcPort)
+		case ieDestinationTransportPort:
+			w.buf = binary.BigEndian.AppendUint16(w.buf, r.dstPort)
+		case ieProtocolIdentifier:
+			w.buf = append(w.buf, r.proto)
+		case ieOctetDeltaCount:
+			w.buf = binary.BigEndian.AppendUint64(w.buf, r.bytes)
+		case iePacketDeltaCount:
+			w.buf = binary.BigEndian.AppendUint64(w.buf, r.packets)
+		case ieFlowStartMilliseconds:
+			w.buf = binary.BigEndian.AppendUint64(w.buf, uint64(r.start.UnixMilli()))
+		case ieFlowEndMilliseconds:
+			w.buf = binary.BigEndian.AppendUint64(w.buf, uint64(r.end.UnixMilli()))
+		case ieFlowStartSysUpTime:
+			w.buf = binary.BigEndian.AppendUint32(w.buf, w.uptime(r.start))
+		case ieFlowEndSysUpTime:
+			w.buf = binary.BigEndian.AppendUint32(w.buf, w.uptime(r.end))
+		}
+	}
+	w.records++
+	w.data++
+}
+
+func (w *messageWriter) openSet(id uint16) {
+	w.setStart = len(w.buf)
+	w.setID = id
+	w.buf = append(w.buf, 0, 0, 0, 0)
+}
+
+// closeSet writes the header of the open set. NetFlow v9 requires the
+// flowsets to be padded to 32 bits.
+func (w *messageWriter) closeSet() {
+	if w.setStart == 0 {
+		return
+	}
+	if w.netflow9 {
+		for (len(w.buf)-w.setStart)%4 != 0 {
+			w.buf = append(w.buf, 0)
+		}
+	}
+	binary.BigEndian.PutUint16(w.buf[w.setStart:], w.setID)
+	binary.BigEndian.PutUint16(w.buf[w.setStart+2:], uint16(len(w.buf)-w.setStart))
+	w.setStart = 0
+}
+
+func (w *messageWriter) finishMessage() {
+	if w.records == 0 {
+		return
+	}
+
+	h := w.buf
+	if w.netflow9 {
+		// The sequence number counts the export packets.
+		binary.BigEndian.PutUint16(h[0:], netflow9Version)
+		binary.BigEndian.PutUint16(h[2:], uint16(w.records))
+		binary.BigEndian.PutUint32(h[4:], w.uptime(w.now))
+		binary.BigEndian.PutUint32(h[8:], uint32(w.now.Unix()))
+		binary.BigEndian.PutUint32(h[12:], w.sequence)
+		binary.BigEndian.PutUint32(h[16:], w.domainID)
+		w.sequence++
+	} else {
+		// The sequence number counts the data records sent before this
+		// message.
+		binary.BigEndian.PutUint16(h[0:], ipfixVersion)
+		binary.BigEndian.PutUint16(h[2:], uint16(len(h)))
+		binary.BigEndian.PutUint32(h[4:], uint32(w.now.Unix()))
+		binary.BigEndian.PutUint32(h[8:], w.sequence)
+		binary.BigEndian.PutUint32(h[12:], w.domainID)
+		w.sequence += uint32(w.data)
+	}
+	w.msgs = append(w.msgs, h)
+}
+
+// uptime returns the time in milliseconds between the start of the exporter
+// and t, as used by NetFlow v9.
+func (w *messageWriter) uptime(t time.Time) uint32 {
+	if t.Before(w.boot) {
+		return 0
+	}
+	return uint32(t.Sub(w.boot).Milliseconds())
+}
+
+// flowIP returns the IP address of a flow event field. Tunneled flows list
+// the outer and inner addresses, the inner address is used.
+func flowIP(fields mapstr.M, key string) net.IP {
+	v, _ := fields.GetValue(key)
+	switch ip := v.(type) {
+	case string:
+		return net.ParseIP(ip)
+	case []string:
+		if len(ip) > 0 {
+			return net.ParseIP(ip[len(ip)-1])
+		}
+	}
+	return nil
+}
+
+func flowUint(fields mapstr.M, key string) uint64 {
+	v, _ := fields.GetValue(key)
+	switch n := v.(type) {
+	case uint64:
+		return n
+	case uint16:
+		return uint64(n)
+	case uint32:
+		return uint64(n)
+	case int:
+		return uint64(n)
+	}
+	return 0
+}
+
+func transportProtocol(transport interface{}) uint8 {
+	switch transport {
+	case "tcp":
+		return 6
+	case "udp":
+		return 17
+	case "icmp":
+		return 1
+	case "ipv6-icmp":
+		return 58
+	}
+	return 0
+}
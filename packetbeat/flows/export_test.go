@@ -0,0 +1,221 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package flows
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/packetbeat/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+type exportSet struct {
+	id   uint16
+	body []byte
+}
+
+func parseSets(t *testing.T, msg []byte, headerSize int) []exportSet {
+	t.Helper()
+	var sets []exportSet
+	for b := msg[headerSize:]; len(b) > 0; {
+		require.GreaterOrEqual(t, len(b), setHeaderSize)
+		length := int(binary.BigEndian.Uint16(b[2:]))
+		require.LessOrEqual(t, length, len(b))
+		sets = append(sets, exportSet{id: binary.BigEndian.Uint16(b), body: b[setHeaderSize:length]})
+		b = b[length:]
+	}
+	return sets
+}
+
+func flowEvent(id string, final bool, srcBytes, srcPackets, dstBytes, dstPackets uint64, start, end time.Time) beat.Event {
+	return beat.Event{
+		Timestamp: end,
+		Fields: mapstr.M{
+			"event": mapstr.M{
+				"start": common.Time(start),
+				"end":   common.Time(end),
+			},
+			"flow": mapstr.M{
+				"id":    common.NetString(id),
+				"final": final,
+			},
+			"network": mapstr.M{"transport": "tcp"},
+			"source": mapstr.M{
+				"ip":      "10.0.0.1",
+				"port":    uint16(40000),
+				"bytes":   srcBytes,
+				"packets": srcPackets,
+			},
+			"destination": mapstr.M{
+				"ip":      "10.0.0.2",
+				"port":    uint16(80),
+				"bytes":   dstBytes,
+				"packets": dstPackets,
+			},
+		},
+	}
+}
+
+func TestExportIPFIX(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	e, err := newExporter(&config.FlowsExport{
+		Hosts:               []string{conn.LocalAddr().String()},
+		ObservationDomainID: 7,
+	})
+	require.NoError(t, err)
+	defer e.close()
+
+	var published []beat.Event
+	pub := e.reporter(func(events []beat.Event) { published = append(published, events...) })
+
+	start := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	pub([]beat.Event{flowEvent("flow-1", false, 100, 2, 300, 3, start, start.Add(10*time.Second))})
+	require.Len(t, published, 1)
+
+	buf := make([]byte, 2048)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	msg := buf[:n]
+
+	assert.Equal(t, uint16(ipfixVersion), binary.BigEndian.Uint16(msg[0:]))
+	assert.Equal(t, uint16(n), binary.BigEndian.Uint16(msg[2:]))
+	assert.Equal(t, uint32(0), binary.BigEndian.Uint32(msg[8:]), "sequence")
+	assert.Equal(t, uint32(7), binary.BigEndian.Uint32(msg[12:]), "observation domain")
+
+	sets := parseSets(t, msg, ipfixHeaderSize)
+	require.Len(t, sets, 2)
+	assert.Equal(t, uint16(ipfixTemplateSetID), sets[0].id)
+	assert.Equal(t, uint16(templateIDIPv4), binary.BigEndian.Uint16(sets[0].body[0:]))
+	assert.Equal(t, uint16(9), binary.BigEndian.Uint16(sets[0].body[2:]), "field count")
+
+	assert.Equal(t, uint16(templateIDIPv4), sets[1].id)
+	records := sets[1].body
+	require.Len(t, records, 2*45)
+	assert.Equal(t, net.IPv4(10, 0, 0, 1).To4(), net.IP(records[0:4]))
+	assert.Equal(t, net.IPv4(10, 0, 0, 2).To4(), net.IP(records[4:8]))
+	assert.Equal(t, uint16(40000), binary.BigEndian.Uint16(records[8:]))
+	assert.Equal(t, uint16(80), binary.BigEndian.Uint16(records[10:]))
+	assert.Equal(t, uint8(6), records[12])
+	assert.Equal(t, uint64(100), binary.BigEndian.Uint64(records[13:]))
+	assert.Equal(t, uint64(2), binary.BigEndian.Uint64(records[21:]))
+	assert.Equal(t, uint64(start.UnixMilli()), binary.BigEndian.Uint64(records[29:]))
+	assert.Equal(t, uint64(start.Add(10*time.Second).UnixMilli()), binary.BigEndian.Uint64(records[37:]))
+
+	reverse := records[45:]
+	assert.Equal(t, net.IPv4(10, 0, 0, 2).To4(), net.IP(reverse[0:4]))
+	assert.Equal(t, uint16(80), binary.BigEndian.Uint16(reverse[8:]))
+	assert.Equal(t, uint64(300), binary.BigEndian.Uint64(reverse[13:]))
+	assert.Equal(t, uint64(3), binary.BigEndian.Uint64(reverse[21:]))
+
+	// The next report contains the traffic since the last report only.
+	pub([]beat.Event{flowEvent("flow-1", true, 150, 3, 300, 3, start, start.Add(20*time.Second))})
+	n, _, err = conn.ReadFrom(buf)
+	require.NoError(t, err)
+	msg = buf[:n]
+
+	assert.Equal(t, uint32(2), binary.BigEndian.Uint32(msg[8:]), "sequence")
+	sets = parseSets(t, msg, ipfixHeaderSize)
+	require.Len(t, sets, 1)
+	require.Len(t, sets[0].body, 45)
+	assert.Equal(t, uint64(50), binary.BigEndian.Uint64(sets[0].body[13:]))
+	assert.Equal(t, uint64(1), binary.BigEndian.Uint64(sets[0].body[21:]))
+	assert.Equal(t, uint64(start.Add(10*time.Second).UnixMilli()), binary.BigEndian.Uint64(sets[0].body[29:]))
+
+	// Final flows are forgotten.
+	assert.Empty(t, e.flows)
+}
+
+func TestExportNetFlow9(t *testing.T) {
+	e, err := newExporter(&config.FlowsExport{Protocol: "netflow9"})
+	require.NoError(t, err)
+
+	now := time.Now()
+	e.now = func() time.Time { return now }
+	ev := flowEvent("flow-1", false, 100, 2, 300, 3, now.Add(-5*time.Second), now)
+	ev.Fields.Put("source.ip", "2001:db8::1")
+	ev.Fields.Put("destination.ip", "2001:db8::2")
+
+	msgs := e.encode([2][]exportRecord{nil, e.records(ev)})
+	require.Len(t, msgs, 1)
+	msg := msgs[0]
+
+	assert.Equal(t, uint16(netflow9Version), binary.BigEndian.Uint16(msg[0:]))
+	assert.Equal(t, uint16(4), binary.BigEndian.Uint16(msg[2:]), "2 templates and 2 data records")
+	assert.Equal(t, uint32(0), binary.BigEndian.Uint32(msg[12:]), "sequence")
+
+	sets := parseSets(t, msg, netflow9HeaderSize)
+	require.Len(t, sets, 2)
+	assert.Equal(t, uint16(netflow9TemplateSetID), sets[0].id)
+	assert.Equal(t, uint16(templateIDIPv6), sets[1].id)
+	for _, set := range sets {
+		assert.Zero(t, (len(set.body)+setHeaderSize)%4, "flowsets are padded")
+	}
+	assert.Equal(t, net.ParseIP("2001:db8::1"), net.IP(sets[1].body[0:16]))
+
+	// Templates are not sent again before the refresh interval.
+	ev = flowEvent("flow-1", false, 100, 2, 400, 4, now, now)
+	ev.Fields.Put("source.ip", "2001:db8::1")
+	ev.Fields.Put("destination.ip", "2001:db8::2")
+	msgs = e.encode([2][]exportRecord{nil, e.records(ev)})
+	require.Len(t, msgs, 1)
+	assert.Equal(t, uint16(1), binary.BigEndian.Uint16(msgs[0][2:]))
+	assert.Equal(t, uint32(1), binary.BigEndian.Uint32(msgs[0][12:]), "sequence")
+}
+
+func TestExportSplitsMessages(t *testing.T) {
+	e, err := newExporter(&config.FlowsExport{})
+	require.NoError(t, err)
+
+	now := time.Now()
+	var records []exportRecord
+	for i := 0; i < 100; i++ {
+		records = append(records, exportRecord{
+			src: net.IPv4(10, 0, 0, 1), dst: net.IPv4(10, 0, 0, 2),
+			bytes: 1, packets: 1, start: now, end: now,
+		})
+	}
+
+	msgs := e.encode([2][]exportRecord{records, nil})
+	require.Greater(t, len(msgs), 1)
+
+	var count int
+	for _, msg := range msgs {
+		assert.LessOrEqual(t, len(msg), maxExportMessageSize)
+		for _, set := range parseSets(t, msg, ipfixHeaderSize) {
+			if set.id == templateIDIPv4 {
+				count += len(set.body) / 45
+			}
+		}
+	}
+	assert.Equal(t, 100, count)
+}
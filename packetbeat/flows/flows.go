@@ -41,6 +41,7 @@ type Flows struct {
 	worker     *worker
 	table      *flowMetaTable
 	counterReg *counterReg
+	exporter   *exporter
 }
 
 // NewFlows returns a Flows publishing to pub after enrichment by the given
@@ -71,8 +72,21 @@ func NewFlows(pub Reporter, watcher *procs.ProcessesWatcher, config *config.Flow
 
 	counter := &counterReg{}
 
+	var exporter *exporter
+	if config.Export != nil {
+		exporter, err = newExporter(config.Export)
+		if err != nil {
+			logp.Err("failed to configure flows export: %v", err)
+			return nil, err
+		}
+		pub = exporter.reporter(pub)
+	}
+
 	worker, err := newFlowsWorker(pub, watcher, table, counter, timeout, period)
 	if err != nil {
+		if exporter != nil {
+			exporter.close()
+		}
 		logp.Err("failed to configure flows processing intervals: %v", err)
 		return nil, err
 	}
@@ -81,6 +95,7 @@ func NewFlows(pub Reporter, watcher *procs.ProcessesWatcher, config *config.Flow
 		table:      table,
 		worker:     worker,
 		counterReg: counter,
+		exporter:   exporter,
 	}, nil
 }
 
@@ -109,6 +124,9 @@ func (f *Flows) Start() {
 
 func (f *Flows) Stop() {
 	f.worker.stop()
+	if f.exporter != nil {
+		f.exporter.close()
+	}
 }
 
 func (f *Flows) NewInt(name string) (*Int, error) {
@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded zlib format compressed contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzsvft7GzeyKPh7/gqsZr+VlEO2SL0sa+/sXkWSE33HD40lT+Yknk8Eu0ESoybQAdCSmbPnf98PVQAa/ZBMyaJjZ3xvjociu4GqQqFQVajHX8jPR29fn73+8f8gJ5IIaQjLuCFmxjWZ8JyRjCuWmnzRI9yQW6rJlAmmqGEZGS+ImTFyenxBCiX/xVLT++4vZEw1y4gU8P0NU5pLQQ6SQTLoZ+wm+e4v5DxnVDNywzU3ZGZMoQ+3tqbczMpxksr5FsupNjzdYqkmRhJdTqdMG5LOqJgy+MoOPeEsz3Ty3Xd9cs0Wh4Sl+jtCDDc5O7QPfEdIxnSqeGG4FPAVeeHeIe7tw+8I6RNB5+yQrP9vw+dMGzov1r8jhJCc3bD8kKRSMfhbsd9Krlh2SIwq8SuzKNghyajBP2vzrZ9Qw7bsmOR2xgSQit0wYYhUfMqFJWHyHbxHyKWlN9fwUBbeYx+Moqkl9UTJeTVCz07MU5rnC6JYoZhmwnAxhYnciNV0nYumZalSFuY/m0Qv4G9kRjUR0kObk0CeHrLHDc1LBkAHYApZlLmdxg3rJptwpQ283wBLsZTxmwqqghcs56KC662jOa4XmUhFaJ7jCDrBdWIf6Lywi76+PRju9wd7/e2dy8HB4WDvcGc3Odjb+WXdrc6Elrm5gqHCIvrlz+mY5bpz4XGV5dhyOHyBH6/w+2u2uJUq62CA41IbObcPbCGtCsqVDrgdU0HGjJR2uxhJaJaROTOUcDGRak7tIPZ7hyu5mMkyz2CLplIYygURTNslRXCAre3/O8pzXBtNqGJEG2kJSLWHNABw6gk3ymR6zdSIUJGR0fWBHjlytCj832u0KHKeAnRrh2RtImV/TNVaj6wxcWO/KZTMyhR+/59lCD9nWtMpu4fyc2rS2ZUU+eLKsA+mg9IvpCK5nDpaASu5YR3jOIrhT/ZJ93OPyMLwOf89sKxlsRvObu124oJQeNp+wVQgnJ1OG1WmprSkzeVUk1tuZrI0hIpqx9Rg6BFpZkw5yUNSXP1UipQaJqJNY6QFYk4omZVzKvqK0YyOc0Z0OZ9TtSAy2qzxDp6XueFFHnDXhH3g2kqLGVtUE87HXLCMcGEkkSI83Vzrn1ieS/KzVHm2xCoaOr1v88SbhE+FVOyKjuUNOyTDwfZue0Vfcm0snu49HXaJoVPCaDrz2NfZ89eY+5Alt9f+uQwX0ikTyFnuBDkKX0yVLItDst3Bd5czhm+GVXU708lxSujYMgVK3Im5tRvSympjz9OJWzoqFnaNqN3YeW63co9kzOAHqYgca6Zu7HIie0vLljNpV1YqYug102TOqC4Vm9sH3LDhseaG14SLNC8zRn5g1IoWwFWTOV0QmmtJVCns225epRM4PAHR5HuHqhtSz6w8HrNK9MNOsPBTnmvPq0gkVQph95VEAlnYIvyUG/J2xlR8UMxoUTDLsRZZ2NkBVThELAGE496JlEZIY3nBI3tIznC61CodcoJIwz63G7dXwZdYViBO8RkzapJovx+dvwIVyB3SdYTcitOi2LKo8JQlpOKNWKBnknnSgSQHnYbwCXIL18Qe5cTMlCynM/JbyUo7vl5ow+aa5Pyakf+kk2vaI29ZxpE/CiVTpjUXU78o7nFdpjMr+F/KqTZUzwjiQS6A3I5kuEGBye/ZJ7HGVO2accnzLPHyzs3elABdMuBOKdDcYacfDBOZ1RDsVDVSThw/4Np5Hne6FIp9q1QJN4CRYXdSsegYD3YgxYVAFSgMaXdGoeQNz1jP6kS6YCmf8JTg26B7cR00REfZSDLNmVE8tTwVVOJnyX4yIBt0nu3vbvZIzsfwM3796z7d3mEHk4PJzmCyNxgMx3Rnd5ftsr3d7CB7no4PttPxcPAsDSBafAzZHmwP+oPt/mCPbO8cDgeHwwH5j8FgMCDvLo//GShcW+EJzTWrLSsrZmzOFM2veFZfVOaW4wkW1s9BeGYl4oQzhdKCa7dvNvgEDig4xfRmc4m5VYbUHBRPbxvQVEltF0Ibqqz4HJeGjJBDeDaC7Wc3XnuFDuiuJfSkRogm+k/D0+8E/81qzg/HO2hsViKhHIP3bkE1HDMCUot3MKBDL6uhZ/9dBYJO8QVxGh8ArRXUhOJTePqhhjLlNww0Xyrca/i0+3nG8mJS5lZmWgngMAwDm1tJXjj5TbjQhorUacKN40fbieEMskzitC1SaVusoAokQxibayIYy9C8vZ3xdNaeKgjyVM7tZNZyi/A+m1j54Q8aQBVPIP+VnBgmSM4mhrB5YRbtpZxIWVtFu1CrWMXLRXHP8vnDzU5AaH5LF5poY/8NtLXWhJ551sRldYYevmuVuqQijQhHdKBq9SyyuJtozKpHQGPhk9rCVyvWZIDa4s9pOrPWZpvE8Tiezk5wr4DUf3dHQp3YDZj2wYWi0u1Ya9U1lbU0Usi5LDW5AA3gI+rrkSC0egWVBrJxdLGJG9Mpow6wVArBwBdxJgxTghlyrqSRqfTn/sbZ+SZRsoTTsFBswj8wTUqRMTyn7emrZG4Hs9JNKjKXihHBzK1U10QWTFEjldVvvfuAzWg+sS9QYtWbnBGazbng2tideeN1aTtWJueoeFNDnEcEkZjPpeiRNGdU5YvqBAQbKEArc54uwL6YMVAZLILJJ+tHopyPg1573xGay6C81ZbIHRU4DqF5LlPQsR2kreVzamf4OmwEt7puoI2ji9ebpITB80V1Emm0rcKS4F45q9EjYsnh3nD/eQ1hqaZU8N9BbCbt4+VT1Aewbq9iKkci0LsFyL1Og47lq5SfBuXfRJjALC3sf5TScuTLl8fRjkxz3jAkj6tv7rEkj9ybdut57qTasSM33O4M3Ah+cdyGdJqwBw4tRMWmVGVgOVjDQArdi55Hq2HM0bXLpaA5meTyliiWWmO75ue4PD53o+I5VYHZgs1+YR+PIIPtqJkI9qJ95uK/XpOCptfMbOjNBGZB10jhBEprKnRfWkWvNqk3dBVo3kxbOJwp5qlkFBWaAjAJuZBzFoyjUqORaZiakzXvk5VqrXLDKDbxssuBIhoIatxw7mfnBMCVHbNgBIMTICKA24wWLDH1y1xNEcOPbg7HRH4Ce5aVurQEcaNW1jcXFrx/lQIXAIxxNK+9x7xjsIq+QprWkFbNwvXqwz72LsngyMTxtvw8wSUNmwcVN5plRLM5FYancBKwD8bpeOwDau89VKm8HNBB0zOS3HCLLv+dVZ4ViyhTYM9pbkrqluNsQhayVGGOCc1zz3z+fLAydCrVomcf9SqKNjzPCRO6VE4fdX5wq8ZkTBvLHpaklmATnudBjNGiULJQnBqWL57AqqZZppjWq7K8YBega8XxnJvQaUlB/MzHfFrKUucL5HJ4JwjSW0suLecM7gVIzjU4P8/Oe9aIxtNYKkLtMfOBaGn5JyHkvyqKB62x0qFwfyh662Hy+2GUuC9GSLK6LioIN5GqmZXoo8aDcpTwYmRBGSUI1qhHMlYwkTljADV5KSogwM/jVrLStZJ/u+Oc6uTf9kSPvFwLw/RH1P5oxdEnVH+tBsgP9gd09IV7PbcTHSOgIG0v0MFuDTBk55XYflbK4h6OrXgHpWPOhjWPd1xzurBbED3P8LKVB5PSHi6/WRk+4SyLxwZlhArUAOxLYVRB0YIGeuJWqObImLIGQCBguHTxd6wARZa5y9MwKBOKp7O5PVW7LOvE/ZGk7h1P6ymTScrN4mpFTpNja8d0cuUrazcx516tgSOF4YIJc5XKbBUwXd7Kfs6MYfY4zVj9rjnMvq674X599N1HNmg3Misi8OuYj/1kbaClMjNyNGeKp7QDyFIYtbjiWq6K5sc4BTm7eANEb0F4fHQnWKtiTQdS5yofU0GzNqXgZPu4t2TK5FUheVAr6peAUky5KTNUwXJq4I8WBOv/TdZyuI3uP9tJ9oe7BzuDHlnLqVk7JLt7yd5g7/nwgPzPegvIpz3OGk5ezVTfq1LRT2jEefL0iHNyoWItJ2SqqChzqrhZxDrRgqRWNwNLIhK8x17lCS5E5HCuUElOmT3snT01yaVUTmfogctsxitrpVIuELycFLOF5vaDv7FMvYzSEQivpYkiQOCelqNjaQ66zZRJj21b4o6lNlL0s7S1NoXUhuar2mXr5zA8ijWqtUx5dXeJMQIO5ArRv7uYikrbd1dQ4bopXKCOGbkW8lZY244SiwpMJBX55eycRDgRYG1QpW+oWpBbnlkNDk41t6vx4go+tun3fHewO3iImFVsyqVYpQB7CzPcJ7/6fzu+C64VSTAHU6cA+1vJxqzNf9aq+b2yCZ70WJ0xDIb6HfygkxrD9cKt7dnR66PouU7g3UG1daSmcCzTrR9KJqS+OuIqUj4/whi8+AiW4YEaHmfnwUqr64cbZ+c3u5bbz85v9jeT2lxzmq5iP786Ou4GpnFpIaQJt8dz6hTwty+OybPB7jbcv2O0IcsOyak1nmRqmCEb4BDgukcO+mNeqahWx9/Eq1+nGrlgtltJfi2LgqmUavZPMmMfaMZSPqc5yfiUG7j7sWqU8VptGNOBjxNbASJIKTSfuqAdNmUqIRdlCnf+N+5BF+uFd1YIAw0jzhbFjHVI38GgPxj0907h353+9k5tpQQ1SZMzOs/Hbu5Yv1RUaPQgnZ1brJw/BQNEXx9dBuck2WDJNHF+dyuVK5cpQU+cd8nXLoHDoRP544hRFC5qxJTkkmZkTHMqUjgDJ1yxW5rn6P9UsrRHY8PKt0gXUpmHGfne5NNG8W7LP6aGHf9roQf6/R5g/dawPse3H2XrbtfhaK3JMib43etx7tYgFhTxfPY80oYpll11WdlPpydaoTTj0xnTJprU0wjn7gEiRcEyD7Iux/hTtP4vqttw1Pei4Zy9bfWVtYaVu2bF11r8Rbdh767fM2aYmoNWWyiWcm31FVCbKPoAIUYJgnnLcc5TosvJhH8II8IzGzNjisOtLXwEn0ikmm4m5FItQCxKVLQ+cKtFopI1XhDN50W+IIZeV+uKPsOcagNiFyNXUacS0hBwfd2yPAfsL1+eVHFRa6lMyuu1tmC8ywkQyL5KbgiTANMHk+EeF4qP54tU+Dz3rAL6OmEfUlaYKuwOXqvuZlvsnsB9PCUFVYZHFw2kBQEID45z2f9zv6M2U9k1YICUdk3szCkV1U0DqfNVL6JAiNttITRmubztZvPuPVHfNzFt125vbxNGtUnmCzcCMgbuDKrNWhSlgEC4UWZUV2G3gCuoH2GaSptb0+V4O9HleFjbfL0aE1fgoUHhXNo+bq0aY62He05IK+B5DpfYTHHZEfpjEVhWEzSyuAI0PoPUY5OJPaRumJ3VMYrDfoNdvjzZ7KExFSypiu6BaCg6ev46EoSAZVnPK9EmSdoCsjlvGDYKLLKrBHzwdUtGkIp3CcVqJZYTj/B9jW9KzVSyWpaJ/Xd4cy0V3gfbyTFkZc7gPkRO7joWqSAvT47OIRAWMT4JQ8W8st7Gjs0pz1eE3DuLAUzgjZikDYCVnh0G8ld0A2PRXNfVMQBOKHpDeU7HeYdxm4+ZMuSUC22YY6waReB69Q9jO5h99XyHSK4sELcdjOrjqhE/Hy8HVz5bRU6NVa472BPhXKFLNV4JnKwNxIzq2ao4wVEKpI2dBx1zSjFr1bUi06kTS4JQIcUiTjFC+yRilXeauYjWEWDBM7yvhj8sdqOgAqRSTHCtaF6bk4qsQ6uCCMsOplpJYPMdcc1IstbuvugP+3v97WF/e7C9u737fLj97OBZf3v/+fbu9vPdwW5/e2dv+Hxv/9nBfn84GAzaSDyds/Azy8GLmbU+0V0PWShc3EsqmrA7ZaCSefNy+slY/kgpCulmwMowk7+vAL9kPRGtAfT6r2vXfEwFvYKYzbUeWVMMtG4xvbID+sSsO+lWxdTJEgEPIXX+i7sj6jDVl+DuDBEWMBQYLGKiaMjhq9BAPxrGbntnAkRwkzuziybkVZXdwXUcZk4FOT3eRovLbtAJM+mMabibiUYn3GiX0FUBaTd3PW+xllDGdQhfroPgxlWlcJliis2lCcHORJZG84xFMzUhQ5gocalMHiHPOqJ61d0r1VMscdBqIMjZcpN7h48dlusKVEewKB/aA+ei1FxYgWb5pO/SXtF6hadcClLyPYpB+MpQNWUm+Z4QI2vMPfbBApg9Z5/yMK2v64j6XrR6jF1EmZxYItRYRCpL1qm0WLhQRN0jiukC9ep8kZCf5C27YSoimWZGkw4E3KANNOalNdulcVmjE7hpC/dVSkrjQA+DE+e0hlPACwNZUaHigAg1iENKTUnzsFCO0pimh7didoE8A/vZGojYFbMiMuQ4OzLGk3kyBqJV9PSpvNInXsVRHgZDW8OatRcNw0U8bHdQdAkIW8tawXYHRdsc1QHdEwQJpnApuDrFcL3ag24uYPM4iIpnIS/XHfoLkvHJhKnYXQ23xxyyTq2qbI/avmGCCkOYuOFKinn9nqaSrUc/X4TJedbzAVog/8mbtz+SswwzZCF4qGzqH23LdX9//9mzZwcHB8+fP+8k5ypDAtoE9SoAzTnV99Ay0DDQ6NNoicZXi5oZ10VOF7EpEvuRsCxHP2M3y7qTnG3Hc24WV+3b1KdTVKJ58LaU+7BOOCnxbFUMb1yAZapTiLgozJYGU+o+o9r0h/XbYZ9TtLqtd+Zzyc5OvEgGFcIf+E1AeX+4vbNrVeXnAzpOMzYZdEO8Qu4OMMfxgm2oo2tg+LKdvPZkEL3yOkeUx3YvGc12MmcZL+s+f3egfZO3TyJvlxAaDYJ/k8hPKZE9cf9Mgnl5tL8e0f0InP544b480F+++F8eF1f77LOcDG6uWOZ2SZaaHDkP7/TI0e+lYtE3HZUqFn03ySPJ8HnktScERsUtSwKUsnUidIvW+YI8mgzWWl0mS+iTo9g9JWDCxCMfF/+it7pHqMW3R6ZpUd02S4VxaDSXKaOi7XK8XTp60CGOEZwrQtsFcD7p4fFA/Hxhn8/D3x4RXxYiLmOTcW24mJZcz/xzuuGkg+pPlbLir22wTBloKp5teoRNQRM5Pd4mN5q8pPNxRnvkx+Nz8uPxKbmpNJyjoiCnYspF2EN/f2Vfsd+7kkJdO5EWBWHuNfvZgdxzmKpS9MiEqik1rEdymL69H/H7ZZfs310k/7vL4j+ZEI6DEr8+ERuC574J0K9GgDof+Tenx+dyejQI/s3p8ZROD0/cfzOnh0P7T+X0aOL0VTg9HNB/CqeHw+XfXcNukOHfVdGuyPBn0reXR/zr1MiXx++bzv6l6+whSE5m7ErzqaCm9KXXXbSczBi5qP1yd9jc5Yxp1qxmXoszhfizMRdULTB9PkyqP71gYsanTJsrmk+l4mY2XyXPzaieQf01P1nQfC1GmKiBlbXvTvuocWWgAzb8oNhAhWvikndDohBUzApD+o4clunhSQUFaV3mSMXPSJsK3Da/6Bnd3ttfdotjeeE6hVsBtGMpc0ZFFxF/wJ8gDJoWEEbJsVKno4NF3WVFt6NDLRt8JP4zch3wqd3nKyxHbRkiClxelhN4h7nkKsH7LhlkTkU5oa5XxHhhKeRbAdwwkUmVRGOyqnK5Yjm7oZgoe1RYvvn+zQUErHVl5MwTOydLPhSpPY4/LJamraGmXFmxuaMs467EZFuKwHnOlMF0QeZA6abxpMx9zf4plB9Si8LIqaLFjKeEKSWVrsIh41FvaM6zuJyKVFYIaePnIy8ZvWGkFFEVxYlPzIdXq1e8FlKNH4a9tbazSGcsve4qAX/69u2bt1fvXl++fXdxeXpy9fbNm8ul16jEjjMrKo9xgcPXS3150R60uqogFU+VtDxMjqUqZK1I9scVC0bnK97Hdoqn3MwwnlRut7pyxH4Lu4YjUbxp5Rx52B4+/dtP//jl4NXB0d+XpqXvyLQENbOKVWsUO7FbhIqM1DtV1U/2Rg8pKOwNZ1pbrm8Ptof9gf3vcrh9OBwc7gx+WVrOwx5jyzDHPefS+oWR9hCGpYv2ecfeJemsni/8d7vhMby4ev2u93xQeirnvt5kD0k549XxXsvk9eHGlaSxp7+UuXbtJ1y4OAExgnoBCqkWuzzsBAVJ9ol07T7wMTEOrKr60X/DFOaJ0ynlIqrrZ98ICqRV8WNPYacspjXif0TQLkOYSmsGDdfJuKAwx1/eU7Q5PFgvzOtK5raaeUW9gFz/EAdkgCJE7JvQog3D5KvI8e+8wIr09BnLiygVDVIvsKpIGFm7pA6xsLaH3etPEIOeFmVShuZd9zOWTmnOsqtJLmlnsbf1c6ZSq+Yen79DGqLRy7Xr8sF/r/rEubqncgJP2zMwKn0gMsINUdgQBLAeWJYdJuQipZApb7UxqewpMhgE/tH441X847K7K+P6OlGMZklHrdAHVYiF80vavVThCGOSjSktp2wTGlQQjeV/sCbEBp1OFZtGLcRcWhHNcwBNbxLNRcqqdHDsRxOV+F/alwmo3ipu2GfA1c5jmPgD0V1lomS17XlWj47mczpdqdMl9qjBZCHDCQGyIhY7Cnla1UEzdLoiyCqZ6uCi00YyfNSp8f7po46N9/RsbHr9YVbX/rA275zNpVo8ncB7BeMRGI8UKP3sx+UFWGD/JxNkK2S5amFFqGQXpsUK1QmbQu2DpxAsd4kUKEVlz2F7IOd5KI4NFbUmNG07Zqpd8WRSxePLxeoQDr1VPeZ/JMJO51gRa73F0cmcCjpF3Z3rCo2WkYLtTiM10GpMV9ooRuexInhiFamL6uuPdIKMRvGamaHXDAvScIGF9b1pIditazVXjR9KXut0xqIrnjPR9Ur94aqQYKhWET0aHLrQ/NMTXDbLxvrMz/hVlxQ5kXkuoSvqnArB1CEZ/XeEMFxq/k+/9pX9rJlpfAvlmwqasv8ZVcoshw6WLs856pAK9lKofTCj0A5ZeWNJOQ8NodpX/anoyMDgizDRCXklVaMrh2MVrOAzkaVwWaBch87UUB0Kgw6SVG6NczndoqLPhQm9RvtG9s2M9UNsAjW0j7P2cZX6uEq/2rcdjIXU5p9hjY8EOcW3NaMqndXWIJVCc0g+rfdOGtP0GvtPZjxlGq3PcGFQZxWoVjvXtfJIjfddbV9yUjJkDtxFN0xAZdL2uBqzkqFMEzKIHYp98KypmLYSw2BrjlpFlE7eZ9rVsAgdSkfvRz0y2rL/fG//+X/tP2v2n/9l//l/7D//n/2HjMgGsFXFJpse4lFvBBdlo7+MEt99XDPcMnWiQ8cXZoUe1PConJd3MMO05BnbYsL3LMdhtsIwW2mpFBNmy1G4nypGDesDlZKZmed/afxCC94vqJn1C6roXP8ak/CfT2CzuU25hCS2TGeoMFf3aEtrlcfa7qGowaaZoaSjhsyhQ61mQjPvhnOutffh6HkfmbteeCXvRatj7UhMufiQULAH7LoXSs6ZmbES/mIig3Lio3hkZlJkvhrnAmgQ03XLwZQ22C0Svs+wF/6M3jBPMaKZiUe9ZaGFEIrd92vgIePp+7VQQ8e/C08kZISlMty3I+cVikeFGcN1EA5MNRl1yNVR8l78wBYSHE4NRo6H7DgyUmu/KU4tkiwjcLxiYYJRgA3nnlEdbYN42JgxD98LQr4nr3yJAs8Ho/4If3ktQXdBD4ewKmkkzdea53O8xg/RXGH/PxVnH2HVE1/CPYyfgPEEH4PDx3UpoiABYV9yMY2J5U6i5L14RQVUSVea0Nza8gsf7shc4XYvjLGzJ114nyJyU23LdOkBQt76e243xphpQwpLbJ4yLEnuyJkQC048JEIGdeK8By6ucA4XLCP39ihx7SyRVZz7HLokQ9eleFx70kAznvDu3cxbP0PqvBqP6dh2FJYmZlrQZir5fQ+3xkN+IuNW3ceXZdslY8GW4dj1I0HkDVOWhCB7FwWrCSLHL3H7ADyd8gWyLsviMJq1XE71GjDfGmr+ei0hPzPCPhQsxe5d9uCnWUbWjLL7Ya3mhVvTC2FmzK7rWtXXjCoyKU2pOuKP7ITL+W2jflw1hb3x9T0Ke/RopXCiU7upIDJvS9U78AXosX3ZFpZKTuquWbgiqjqpYWGdWnO3nquxbDUU76uASGPXogs50i5o1FOddja48wvbgVvUMu5jzeLwHHbN4ip3813t0KIJQE3NoBIrapea5VzUGsFizy836tg3UgMfv6hjrO+a0JOhTky/XeJ+f2dRfSrk1TC2vx50d/T1+vUAb9ySHkpfwQEpup/zVAhYYhOIQOmvqitcrdndUq3hwrbHAZ6qNVwYFlrE4U781hruW2u4f6/WcPF29DXzQTJ+ef3hYlC/NYl7erp/axL3rUnctyZx35rEfWsS961J3Lcmcd+axH2VTeJiJfHL6BQXQfStXdwX0C6OF+Awj/jkIz3SWK05WqH4jRW8J69+2exqj1ZVTv6iOsRBS7Io8NNhCuGgFW2MtItlKXHCIDXv6TFcRc+3Bxixn6/xW23fky+o+1vN3fmtBdy3FnDfWsB9awH3rQXctxZw31rAfWsB91XftHxrAfetBdy3FnDfWsB9awH3rQXcA1rAZTmeuz7O6+VL+PP+hIxlCtmAyz3nY0UVZ5pkC0Hn6ETxBJU0Q0+a9HUD4GbD/QzhnLJgyvWkAhmpMY7cSoc1PaPQz702zxoqhVVtFzBovCEw9mkJzgJgBsfTLsY02FI+JePQQ/M9OUEE+jkX126+BdkYJVmejzZJKudzSKkAB5EU5GcuMnmrq/cvENw3WBBiY5Ro2fXeO8E/9EGZbeHegqUGxiLn464B5zR9c/EEGcm1KkjJt3JCn6+cUIP0X1F1oQbk34oNra7YUJPU32oPffG1h5pL9ucpRdTA7FtloqerTNQk7Z+tUFETv291i1ZUt6hB6G9ljO6gk9U+k3m2tyLp9epkD6d4EDx6RocrAujip6Ph4yCqVNoVwLS9t/84qPbctfdKoNobbj8GKp0xtozEfhRUFyenp+cPg2pFKkfNv+ts1eYBjEdKni/InBa6q3ICGGdQf1hftzfzNVOC5TvbiXdkLIFuQc2qHJkvyjxHiO0kLdwbwB8fvnd+gvcXYOPvbL9/FEIsgdxEw9JQiXgFdWbO35F4Gt+Q2/u0LdotFD/s7z4AC3twUrFYEQKYhANxpzBNi816Pr83I9TAUzxnfajp9qT6ccGSCLBVY9sIf34Esuc0jhH/OHJ2+KsbpvRnwM5N80jM9pOd5Pn+YJAMn+0O9x6AIp8Xq7wPOcJbkFBIrJDKuBY856e408iRIA4K0u9DoAg8RiK4iP3FXaF7O2fCxZSpQnHhqo1DztoNE4RODFNEMaSYy9/07XmsvtgHPCs9TVGhg/mvscSCTKEyR9ZzKX63GGUBmbxYW8UoWlX/sNBjanRdx1MCH6amViFkwhVjCxAUWC/GzBSjpq+YKxCyPRjubg2GW0ZhBZb+nObWaOsjcfrOmQgVQjoCMdP9g8FOusueb28P7YcspXvP93cozXb2s2zyAAbxGVFXsBlWeHUXdsKnSLOL86Oz15fJ6T9OH4Cis4NXjZeb5lPwWwvi+v2Ho1PvnIfPb4KbHY/gtfsJEO5NBBp0/t7k9QX8ec+9yQu8MXEJH3bCk9cX5LeSwQaE+kJC3zJVbQT7O9z/hPRnxmEvhiBncNuKac7CWAtSKC7hhmTKDODlhnWDbowyoaGo1CE8P9okeH4v/CTx6BBO4BPx8R7U3fiYkJyM04bcfo2xL7QWV+ZgQJv2lqETBdcuZHHAOG0o8dXR5lNketcosXSFw1YxCAp3d1EBASrcGxjyQ9OZm4torOdGFDOlEtE1tb9NaHa6uJwxAjEL12zh6FUlWfuFQfpr5mat55CPF+T0+KJyR79lqVSZGwtkNEjW2HM7r9DBH/3kgtzat06PL9zwzdwju8aW97AMBgQeQ0g9w6KhtYIP9jnP4+TIkDkXfF7Oe+7LMK5HCkpgRfyGNXRGFjgoQdBCg+sq4qVnDYowJIQSpnCgcvDMWYyoJoXUmo8xiiSDghtWL4zKm/hyczJi4xagVJO01Eb6cnDNLHaHc5rTlZUZwF4vFFMvwoL4Sn1V7TXf3waOedX23p297gTdjrYqXcdX+ItFI8ae+kD2+uZgFPac9Bl0+GrBRKZ9RA1UaAFp5UkSD+hxbx3/w0Hi/+ukwiozFpuJ30bGzYkaoJOCKYjdjWhzBm4wcEPKCTl+ffTqlECNIlcvTuY3ViuLhNP6usYaP6NIxJio6IQUDKUGhOLoQloSh+uYaBDYlwk5C7JKSOOjJptj+kzx0W8l06HCwcgeOyyq6BEtC4QQ3xE17pfGmGXiB+8tmMwh2NswdQP3WlZ0A8JAgc5V8O5ems5iyc4mIJhq1TG4TqnKWJaQX5iSvhrQHNylMxf3gTK0IuC4ohpO0VGXoJtRV9gI73JWNcF7pIwB3qzBPWM0Y+pqktPp6i4tfcDNNnFZ9VZM4swEZq71mypYamplmw7J0VGPXB73yNuTHnl71CNHJz1yfNIjJ286nMy/rr09WeuRtbdHPhbnrsrXT7o0FidMM4qvw6h2oQ1O6yiUnCo6R9YLtzqVYQepBkxhDZp4IKhbWfCqfAqKBd1hWW8Ph/U2xbLoSHp9cuRd2IwUeIGFChR2BXBXQNdcQK4P6q01VZaQOdOaTlkSB5BwDaFCjnZOgBl/LYjDoGoMlIGIpnjMO2n0t3enb/+rRqMgEz+brqCcdojnBJojH1ULaqJ7lSciHIUN0OITLziLXalMn9IipOiDi8OqgnF92w3MbdnZhronFgIy3N7fjFNFpK69UQnxOLeUasJ0Sgu7p6hmZDjwOaGabLw/OTnZrBTwH2h6TXRO9cwZer+VEqrRhJHdUAm5pGPdIylVitMpc1aDKz+b86ha0oSxLB4Bqskql8f43vTIe4VvvRfAf8zdIz7sdA3r/Ifn7X3L1fuScvUCX3zmpD1ecyo4DO/LtGsJi68ot+z29rab6N8SyVAEfkske1giWcVAn8c8cFbS/ZrF0dFRvaSSN1WvPqXmwVHLQ5fn5OzcKnIMGv+OYs/GqOFi8D+OvKfP8Q6fTHha5uBAKjXrkTFLaamDV/qGKs7MwptGMafOqdHWJIyKeSfk9IOB4sEBvqgqpAfUzJhiWOBX6CQizqjSWaEMODfBmwXhbFDq18zYHKqZREOjXoAvwe+Mag5B9WHEG65LaAzl1BWr4U6k6jRzIqeJtXeqP4dNw8frwZ/DDPBzdVfBef0GAjdr0K1wU6zHuyJ49X2QVNZzFIZKfJbx6sfWQpYqKuIe3QpA8NiU3zBtH4rvE3rwRRxjhlXww7iZ0GGUCcLWvBhYFooKAO/ld3cANSAa80vhi6IWTDn8N2SBXtd8YYfQUoYTxdlquC02E3IkMkKdhyaM2arrazfV3bcT3o9vrTgnDFr8HRy+obdvWrv3OT3+2L3PK2ZoP3ZS+xZ1zgv96a2dOy/aowAexX4ruWLxMJ/EzKfHF+HWHQ62QHfsg2FkQkYs1Yl7aIR5nB6MSiqCqgSyqNQGuybDFXfuykjGDpmfZ0zgWsLCpkrqSIPzld37fec0dRcaFiAIA875dGbyRZWlUXl6Kmzg/Sg/KGcGW6VPlbvhptm/LKi+zko6Y3PaoD+pZW51sNQwGSSDmKPySY2jXr4gP4FT6iOM1ZmH9ZKL8gM5/cDSEk3fl1xcw4cXWGdp4/Tli03ooAhl8z+Z+T5D3NErms6g2HUce+SIbKnVHXd0sN9fPvRovDDsSqpsqULDj8Hhh4VhRLPfSmiBIid3A/6SG5MzcioyTpcPuC/KqxWeX8fn78LxdS/Vz4RhS0etwYnApbiKAtMfE7/utChobMlEpQSFEkkW1HVdMT05s+KCGpcAFjYuN3F7PuVDCjK42LCKm68uOKHX6Et1wSWIilR66YhL9gEiepbAepJTY1h1c1yv0ckxGh2HYxlhOZuHtEcMPV8UbHm40B2e0DFfcfzW3+thW5ajjqJsqx8w/PvMt1IjG0c/nG0+FI1VOlFRRtcvGJv7Ylk4V3i7Cp3W8CiIgHTzPhBMJoxaxPVin6xEmyNmNcGnUtQ1pVwtD6+vDT4MsSVRuNX0AFcH/9Ig6ys65isC9eN7y1McNYg3Fw+l+AqPH8cd951Ay0L52YXaA3eai/R8qnMBh3uCc8GFMS0DmGBRxtajQqd8zFRrrcNJbe3pT4mP0uW4j9psGBK8yIJRMyMjlk8Sj3Hy/Wj5rRxeSmd8mbSTDiFZ63dR18JmvK9/K10G4piOec7NAlLbFR+XMcn0A7uIBritBJbFMgH4DwL9YkaFkIK44UlK87R0EcZBTXs00KsMG7DMd+H4EXaVixR4KIwrvChtgRjXKl4eQl9v/EpOJsv1MXwSYHG2TwBX89+XoexDmoW0gAy12O1kD4d1hWdjC1Q71MMhvOHKlDS/Wr4X0oP0uxaUbr56RbbHAPz41X8EtA9c/ak9cj/XkQmT/dFHJmL8wCPTvfQAFeOxG8VRzRMrMNODYV3xhm7A+bAtDXWGrkJFpBWB6TVMV4WpKvQEaUYQKsV1hMzS8BuWT1aYWeWHJ3oxH0uXgGS30ZIWRXDgKOW6Cnq/bfhi6dpZVES5Fq7YCVxGLCBGLWzed9gNd47bHZ9zwfxFwaCXWs7IhBlsT+mvdaBAXko1urlUHIaLHntuNMsnUR1ggaM/QabFirpbAJExsK8RLI6A122pbAUQ3F3SsQMCF0z4ETC6K9514O1jE+v73dD0+gq6hC6xZW55nqU04PyZa/NdYvWKFJpr+pbUXCPpLLcWOaR6sA+mjuRnClgIy9iLg0uw1gf4+eIUNKz6HRkswQv+L3pDk5yKafK6zPNzCUHlp/7xWIjc+JsoL0TCF/cLEbeBay1IXSoVVMz4YO4ozFQ1yQd+MoqnNWFQdc23jxJoUOQ6U+pWI9FG61ToS1k1J0fhVEV8vJRBNMF9n288HioeUhMyHiBiRkyrMUjoVy4nERJuPD8U9WV+LJdBMURisYeq7L2otasLkMbAlNBOwY3p05gghiduGICt8sIgqRTCKYljZm4ZVJKL+pfSeqdTnIwLbrDXkV2qXGqL25FfiY+TG1rW+CEh/0mU2IQmJ3NGdanAz6NDZ+s2ZaPH4LrD0GsWeDgmc8weFY3nbC4hy5BpO4wfLqso7frK3vAgkQybQ1R2qVhCLhiuuWvZbk+6EaLNMYnL3Sp7LxAUfA0JWWELx4llDlIoSmSoady9ftL1ZtrO0H+6Ro84eogD8RHmruZnpLrHjcIwIzzOehPRW+TMWDYC1qgiDWZUeHqn1LCphPAOP35YdCtIRkCoPs2yUY+M3H7qw35i8JVVkvoYzZGN4r6QUYkjYYHL80VsQLhEdnREso5YolIz1S+o1paYfUw5rS/GlAlzxbOrFVe3m+IOspvL4+HCifBeUSpfrslrHyMALeFZFZSFIQRAmdAv2XWQxabXkarGoUW2v6S5qZecqjclwn49Elq8zawOknomqJdINlUzZddcOYQ1YDRbZcW5ugCKTXLoPj5jRJYmlf6ooyaAJO/q/+DqPAEZ1td1LBy5jmH17XPmly8vvJAKIzqAU6aiZtV23LOTkEg8ZVharRJo8LiVZFzrEjtkV3e69dXxnCo85V1kn6sV5StRNat72QWsjejTt6w+hPR1u6vBooehyBUGwUBDVBc9gj3Kw7BQZeGWWwO8akuGpRcaLeQr2x0qUNeitISM1goKeTJlGcORxV2HRyHKY0bknBvDGt2dO/rWH1YPjCq0+i5iMpA4YnwkEHRIidOpiBy7jLFab1jLJVFkSjXZnGsY6COTZZJpiJsNy9KYt6J1PP+982oupm5aVwNPyPb8sQS2y+uWIHa/jOwsV36Wq7uGrsECJhyytns+3uYV3YJ2h5vj7KQtW/16LWuF+1NiNScfFl50fD6RpYIorGOc03eLxnoJGKzKQ8BGLC4w/M8Fh7s1sAN54MmMM0VVOourTjWPwcoER1GzNuZTMi6h1dYaROpUI3Km6wHqkbTPDVNO4WxMcegO0RFZOH09BLgRKHDvAsbdY9W6pobfcLNwuWihoiyojXAmhcZlbka7KCNfeMWXtqRxa1Fdjj1YTQUjjO8DI928EI4O0sBCWDAVqPF7aPGvQ497HclJaixnwdKESL2Iku1gy9qR9hF/wtOd92fOlk+jtMFQlAKltD3fIGIVai9HlIua+/viB6VmQW/PmK6VFnUWvCaliDr994hiU6qyPF59UMDhaWJNydJ+kIpY9MAHDJGIqOvLG6ZA0YeaQP5I9sY117Wjy9U+QVOzU1bs7u8e1ImPyt5HZMFd4VnrbjfgIPVz3b6zVS87iqSzMm/CVVQUUjGKdZcFijmwxsYLjEsueMFyLtidPI31v1PXN+9/h7KpKDaoib+q2uk6WGv0A2hZCDm7owN6fCoLMrdWkeamxDDSnvO0m1tJwrRuo41ZR7Aqatn+zzROC6+VdvLXqmhgZSyH/HS0TeP4bZfx6+4SGopIzXKEZYFX8WyBNQnl+jPCjZMSDUjmUnAjq0oZ1RBWO5TVitk//U22keSasYKUBeqI8FK8uepUTal23oM6Ha3ijjsupXkvXtmG5tTOZtgeDPf7g73+9s7l4OBwsHe4s5sc7D37pZ7HYM/m1g3p01dMdNM0SjyIGkUwSwkSS7G2lrX0oGyDc2nlcmrJ7Y4bbO1J09o5k8tpz7ngcjnd7MWTxwWS0ZxcuOMFa0NUoi6ulG83RQw2LDrUFZuDzIa6+VZT8zHhMLw1MWtzg7ctlJuYy6zMK9bHHkfYqcFXZM+k6VV6bjxMx2FT0HTGkogWYXlLtUzz9I4rxcabXBSluQrREVRIV1LCu+BKEz9A9Sue57zzGcxVAx4ZdjLOiZu6Fn1OIKsuTFvnJJRTSHW75/FvJjLYQJjPZ6r8uVqFkC5Z5AUNzC4y742xa8pb3ZeYWKYIwl1HSgVq6zRpHiTIb/bg9N97tSoAbs8aSL+TY/DYZXXf8wovo36iekY2CqZmtNB282kD11FVhT4Iy1P01p1kBsKPKaZ4Re73uRTaKIs+eG0hZcFqjk2mH27v7O7tPzt4Puj6dPTD8UkN9VXeoJydWGy8Vyv2ezVgPqC7k73BIKtDJqasXRh8eZ3kMpwJ2ALES1WqFL9hwaJLmTCK5q4yi5GqpWGAbuE7f4AyMKoOnFgXb/ClVxfyRaiYmDhJWZ3EuZat0WvaVDzBnLmi8772Ntr69ry2AEXnuzvLNb3tdDeeCef3srsL/a7WDNO6nFuNQUhicQNrpxc0BXf2+mSvmZJC5nJa6/hjjxp57TNsuT6s0Yr8ryZy1Td+uUdLndl7yXAwXL7k/DVvCqMvzM719RAeZeiifx1z9OxAfT9K83oICr15tSH+OQaldiGhMZndvuyuUqLUNmwhANXbdb2ZVbcF7fxM3mpBeRe37aE5U8YrMrAXahcUDfeVczRN2o7PquEDpofNsNWtxsIwAEGt6GJ0wJEZFRkkhFzO2AKSzG6tqQxNf/w2VcziDPdF1ZeoZgBBlMwrrLmBUWCnz1heYEyNNpYZbmcM3H+hNFQq5+gDItRAQt20zKkKNasq01FZ5apD5bEUrLF+TadamSKLs0TV2qCKEODS1BRdnqkzH8BAQVlVFlgC17EVNFy2JjIMjRZFXk5BE2h7UqpEVwo7QXjtGfXhI1AF4fzd7Pl9gyOPGqUcaqZgdRsMNy72+bv0zBrVvex/EN3r5H1rZTf7YIKPwHKtMFyFTfbOcfmdykHMLiE+BAt+2uf8wBuunJkuco71RLmxFlrs1CmoMnrTcnK8Wbxy3yNA5YlURDFIS7/TTLc2ATzhWoxkMr2qHNBWHFjdJyRkYZE0gqV/WVZtK2tfuGR7AMQozm68tT66wtUfwb1MqRn0GMKek/KGKcUzx6w0Si72+fQe3B4pcmYtUM0YGb1AcQXJNouC6ZEX06NTq1ryFGEkb5lTmztOsgtWkOFzMjg43N4/HA7wLvX49MXh4P/6y3B79/++YGlpFw7/Ilj5eE4FnTKF3w0T9+hw4D5USq4VdboEMYTdzrWRRcEy/wL+r1bpX4eDxP7/Icm0+et2Mky2k21dmL8Ot3e2v4uI0Qj0CEvVdca6C6Uv+pi1huRjT1mH38hX+MiYkC6/MMhwPDsjdzP1CwKBBZX1THlu9bfgWiqY8gWcwkkqDHhM7JmN9ZHxhqelzL2WxhVBc73uXL1gqN1Nww2d18Oz2r5GuYk1IxsqgD21fAuW6JyrTvEGYXr2CHS+S9QOeOUdihCMQD+yh6II8HuVnGK9DTgOC1l6y5VsBNzcPQwWrkRNJQxaFf1B5dThCF6PqjFkFR0buswEPwRqFnb0SNjpUM0BjygrR2iexwu81LLexKnpbmHjchAvSgX8VJFFuCK87owDJyIU+bV6vtYydeEmuA53KF+mJoWrnhx28IoEk0bMkOUMPyvEAIdLiEOrW4168RFDxSIob3DicKhDGq6ao9u762p1NBO641B1ZK2JGFdQelUZ3OsXofZF1z5DdzrsKlRUfH2ei4V2Pri29/2lnEbe5jmqjTUVoyq44U3UkIzsjOY4JC10KLunrqPbLHAkXyz03OqpM2OKbBM86tjprBy7UAV/D93oRRpG3MB2Jb2qH0bfodj3x1X/qLRGpJhu3tW9pbaMilG9uozNtzA6uZ0t4tIVPsysLaTajueOYBw7GtDN6kE8BaXciVZLUcfgIcqnFq8Txv0ZVDAfRgBvj+oyxQ0Z5Ie7mnKvIN1GFWjV0T9bVL3ELPIh6KvRR53csjGBrpOuIpZowBMNaXdvxgR3x47V9awQDMZMOBsa4AUxWltnBBKZcjTOJQRjaG7YqINpLqGAl2tDR0oRLvnrav9H7X7F6i7MFTCbm4C8e/uS5Fxc+9Jg9/fP9HzZ5Do/CrYrhlA3nsahcyGeFgXFUWQx94LSUytBHzkJDsE8tAe1Yni6zqWA20w4csONKNCzvSq+SwcKiLhW3hbMsfWXwQB8jUsvD9fXVzrSEe/SGie5pJ1R02+5viYwAtiHikvFsTpXUxBqJ6uIljkkUuqofOc7zdztGaAG91furg91AbtzkztgvxJSLdMd+U4k1l+DL47/zjIY9iMI9TAOU6cUroADEgPLM8PBoMN/OafcNYx2jfIXsoR1r98ouRMBJQnUE9YRQLp+gWiHuHX+SGsgUedSBDSQaq6GD2hJ2OC6cUfgy6UsQb0HpXetX/g6LJiweteRDtHqjUehkhHC72/eMDuqFQfQg2tQel2vfs4+0NQQqDTjatg7nSgKCIjDATxs1R1muAlqUeuGRWb9A26t7qEUlODFAOMwQX3/1A7M+y5sfw5VzoOxEEaMq6FHtfbwKX+v5OMrYqPcSyeduEvGsvAHdxRqGlYCApbdrNz5FFIpNNcm1rsdZ8auRhMaf3e1JHA6XsBnzCyZoV/TKJfTRMPvif89SWXGRokXvv7r6niNvflVhhDmSLspWopK7VYYpdqEK3ZL88jdeHZysRmiUWtvBPXbsTXhRhN5K8KMWMzNnu9VlbYwbioLDPC9G90oTCkg3D5FntV52lC1TCLy/feEeAn50ZtCF+Ic3xVGHIF3hlVcyh2XhXaf/i7FCgsJ3m+k1lCyG6ISHHaFA0LoaHMJGA7mui6SK0Yzr5O5w9ozenXhEx2TuAE9c1TxrLFFn6aswGI0YVJfGxMq7FO7/aUA0+/sxE2+dloqWbCto7k2TGV0vhaV66bjsWI3aOP6xy8u1zbR5CQ//XQ4n1fChNPcP9Uf7B0OBmubDTHazjT6wrxUZsbVI2MeITyw7oBqhPKt6XLcx+DHNTjpe8hSGEgYnR2kUuRbAZVRTK7uESbseusoQtLJ1QwCDGTk+EKkoG5uoeySgtLpnDq+JGkzCv0zxi46vxIUTqlzTamW6T7yKMZpmg4CxobGaF4jkyDcuIDI9humDZ967OoeniWsCoEh525ovBfgop+xwsxao+OR5C79KmcP3meLOMHP1TsVYHiSIqcpu9M+ucMuqbb8J9kn80WHhQJTbO1tPxtmLBv3J3vjQX93e3jQP3g2GfR3abp78GxAdw4m7H7rxfPDhNJamdAXlH6sTqjVI0rNlE/qC5ER3Yl8k1KgNU+1yzSL0q3AXVrvRN/wOHxabm+eLXsy39Mu3HcL9ykZsPpw4wczuNgh8Kt4ZB9QXo+lZTuG60mTRsMcUXYKMr6pVic81AproZPn2R6lu326f7DX3033Jn26vT3u7+7uTg4G45003T5YFl2j+HS6lOfz7koTJ7WMuhqLueGXT+F3zzun0NVKG95UxHfTBl9Uz99h9rxpzEx6d0jUQ7FbYU7y2mWETmiv3Oap96KrT9F78T7IyveEfA+i772wn4pyrMsxfobwSFD+8W+rkSn8CGfAWpcEXVL8cRdU4MWf//uerOYjbJvdSIGFxjuteBTILtZkbM3CenC6y9K1v0Ksvs9LhZJ8KPf98fcC+oq7YifO6owuTEC/gStYf0D5xF//NxXZllQVsqQWZdtznWTC7dx4gVOe+Qt48qqKcvj1xdmrf/pOp7pK8XWCXW8m+LI7HNxdRyMNFpzE0CWAZUjNBj7hfKii0NyFzpOkymJM+CfYa+svqYtWc8FrOSZG+aE77zX9BVi1xBrDyKEFMBwgeAfXEYZKDZZOW1mZlKrrGK5HmC+2isKXrjwfaK03VC0szxQ5NZb3E/ITUxguD92N2IcZLTVcHuauFgvKgLoSa5Wl4CDncR6oq918w3pwkwq9AbIeybhiqZFqYVX3VC0KEwdWoOxhPTLjWcZED9Iy8F8p8kXPKY49cqu46bi4W/91zT+71iNr+LTvE7BMXprM2JXmU4HJ5Bmf2gOG5lalN7NlHK2P70qEnaNJmKwKjOdTNMTcBcTdDUjieLaAhfZX814Aul5twe4AczsM6RvHgjfKPqkg3MX1MKn8ZkibCtyOW9QZ3d7bfyTpMRXqI6byEupfFLDK4e7RzwDZq2iptg7tdSuJHss09hMX09WpJeuNpnnL8kmUaxEyxkCmR8Vb51SUE5qGegG0uvS9YSKTKql5JoNhHNsCR4Xlqu/fXEBniK7OMfPEzsmSD0WawIXgY0m92kT9+6/RaincBEHpJvmkxBY7uZxO7RYHsSenihYznvqKS8HhEY8Kmb6NYDqjSm38fOQlozeMlKJy0nHfLAZfrV7xRkQ1fuVtoZqUwqWpt1cMuplcvXt9+fbdxeXpydXbN28uH7tkJZZObhesfBJH2AUOXwtbgIxLFGVNxEJYATmWqpC19JqHYmYYna9409spnnLnw3hSua3tgjP8fnfaYlJt9DDoAzf86d9++scvB68Ojv7+WNJ6h/AnKH8ndj9B8mEtHzQwBx4KdiOEwBbMMYLTsn1EbA+2h/2B/e9yuH04HBzuDJbPCWjiZ/fnUqrtPSfe+oWRPpYjlhEd+x77OEdc8vd6TZC75IXr/+z7Ess5HhwQ2QJpnVEycO0WAVoE1a4SrJohZa6r0JEbli+wUgYqICjg2irep5zNIBQ/kczdmgVePU65gTqekY7hSyP44h+R/szIGGulu0SGaEE6xTqtrcVHZPYD6dSVg/0w4woMSN90A62hZe0pSH1CZqu9X7em0ijP6KnMv8picsYqVsbA6kDdBiH+Fnr2wzBuAdG0Kgu4/xvN7VQjd1XA7V5hmowAiyjUyWVlY8K9ZRNT6d/20R7RXKRhOH8L4eH2uxRqSzbyiOMaWU/e+AEGD77gejBhAKhlEmS0DqK3BlcFpR8/TkFwZlAuQXTFbeXjmnGZ4jdR8Da09HbXVdEVUgvDrZmcsy2ae8oHTO1wVzjMpyLbydwnCmx1bD1+D7b1Cy0QzP4sr7RM4SNJO9Oeojz3omAqpZrhAVC79oXDNQ+BJHGD9mWlEssnyZ+jA5TF5GvvAmVx+Co7QQHg/87doPJJ8qV2hLKw/Um6QkWofPGdoSJYv/TuUBGoX0OHqAjcr6lLVAz2V9opKkLhC+8WFUH6pXeMsqB+qV2j4j5KSwD379w5qvbiV9Y9qgb719RBqgb4F9xFqgbnF9tJqgbl19FNqhvkL7ejVA3eL7arVA3Kr6WzVCfQX253qbjf0mc6Wr/WDlO1F7+CLlM1eL/gTlMA51febcri8IV3nIqjmg0Tq7RU4YYozNIj7EOal5m/dMwZhc+ZvKfASHBpwwX/jOoofcIPrMmGD743VCXT3zd74OcOY8JsUJFRxM7skEG/sTb9fa0H3uw1HGGtI0+8cPI3RKVKdd0R1vCE8SgwhSv07yNT4LqqGVcaB6QGlg3ovxFoW/egyJe7tfFDh5ACuJJrTtQaPQzqZiEuzpbmt3ShYYGosUvrqA3T+JBjGNLagsAN0NSm2YgFTrxrDVfOEBJWx+P15YuLnq9DTaiguZzK0qWakKMcMlkMQ0fUhVGMzsnG0cnFZi/UIXbbIozqajHCo9AbJlyh/KuEMix5zjLyf54cXR4l5BcpWHJWBWRg5bG5dAnPtVx4X5vDSBc6GsrXZfJW5JJmcb1ncIoIZqDm9tHJBVyy+VoeFdXdXZtU80MyOj58X1Aze2/kewszaNdhVxxqOWdXgUlHSIFR49swsrvTq6rR+I1SVV6o3kqwbUt9wlGzwF30phVLMRStl5oPVw9APErFHWWeE4u06xyT2M+jHl6rxldRwHjdpXrjRYysw49Iy+nKQn3OFZ9TtcA4achT/PHsZPPee9X14WAwrN/+VlHWq4YwjrXqhK59G2oPqWSe7a0IvlcnezhFe1I9o8MVzXrx09HwnmmrWNgVTLy9t3/P1HvDZfw9j5x6b7h959Q6Y2xVTHhxcXJ6eh5NvcSm5WJ1jR7O7NhV+qtXa/D0qDQXnybS3MHbe/s7Bzv1PTznc7bK69ZXZ69O0ZPtAyDi6EC0NeOdTaTyR6Oc1LwRhJTQQManQd7e3iacCppINd3Cch5gcGzNWcZpH/y88efkw8zM81/Pjl4fRYfbhKec5ugV/mfPRTX4K9eE/Gw1wo669FYVwGuGcc56tfRmbJUQ6shGqId+R0uy0nx1nPTKMlJMdi6ITA3NK+6inUl/64P93UGDhT4xaKojZioEO1EoSwrRbfXNv0It+HXjsHGHfOjTWlkXvnYwRua5OKAWybyl0NTm5a1YWZwGpobZCdZB4VaxH/SeU9PqNk8H0mduzvrCa2px4FyvsXzBtOuIyqqZb1kU7fSwqKytu1a8YJ8j1uj4/F09zshQNWWmSsPsjDVaPtCogIzzgopVhdShYQLV22GalvrX8+mDEMvowlr6GA/awOuTwu8LlkSArRrb6NtHIntOq7iFZZCzw684diBgd1O/J34gZvvJTvJ8fzBIhs92h3sPQJHPixV6xtaP0BnmkHK32FDfnJyf4k6z1rWDgvT70BEPHovbchD7S6O4e9RDA4O4OcMyFIRODCSJI8VcKQvlWi2mMmNYIb+SZooKHbKLNBZX9T0bfP+FW9f2gIqpr5umaHDNAPSYnVkPIVdOPaKmpphNuGJsgaUpxrmcbmGt575VLaxs2toeDHe3BsMt8FNwMe270LM+EqfvchUTq7O17elBun8w2El32fPt7aH9kKV07/n+DqXZzn6WTR7AID6i5Qo2wwrVirATPkWaXZwfnb2+TE7/cfoAFF2azarxctN8Cn5rQVy//3B06v1Z8PlNKOB6gSm3yxLg4TdgHS5lO4jd1mCQ1ByEUXAzKgnoJMJKRVyTNfvnWpuFh/s7B7s1QPGYvvqqVbBLVDVACYPSR4s5VOb5bM3wYbXA6NpA3su4goIKDpLNFs+F6gehFNJKq31AhZyzE7LxDjxuqqrcGWXdbVw03HGoyy/jlPuwN3ieUOeW5jco0lZ+q+VyIqN5XcjVxsXR680EbSowskNZgK4kUVqaGVYEpSKrpSLBko5LUzm/3WUvOTv3N+VM98jJ6wsSY0zIBnQi4XmWUpVp55Znc8rz6r02Yb9PGLY9SFK59D0t0B56OKsE4VzlgeKJ7+pIgdjdOH4NfGOBgDzgiISBuC1sXft08PKRn/h0Ro60LhUVKSMXTN0wRY6PHkeEUpiVpd5UBIBZyMbxJnYsbeL37uIxwEelDli2yoU8iSdy63jymHU8/uu7ix5581e/nmci7ZE37/5qNbKoWFiPHL/+6z1rHrbOJ619LlOat8q5Pvni+2m8vHm52VKaLHtYSfF3zm4fg4lUUypcvb0VYxNPpcnGm0/YzGci/VRkaX5VCr4qxbELZ5oTO6NF/d0jcG8w+mPw14ZCDtUVKK2rq60ejk47HxbDxvnCwXnZIxegupy3WPqY5nwileD0QSgKaa7AeFwCp7u8tZd8DtYeWo3N7G3ogAS6NJiiQvOMKSzuxdsZ7tuD7UF/8Kw/3CeDncPh3uHO8/8YDA4HgwdjhS2eVokW1sxdAqXh8/7gAFAaHu4ODrf3HoESlDBOr67ZYuWVgY5axYB8cQIs9wCQ2JFbqL69eNi5ECGVlupmVRvrEqsY3rAotIoRluf2gdT9VKEVlReCxNVw+HEdFUry9zktIgiuTbG3PXwsJdiHQgr20GyjRr4gDhEWMGPgum4sX6jTsQRW+3t7O8881ZftlPUI7D/RNof69tYyd5ZStKq6oCla7Ny01fvtwe7SpSkBZs0Up/lVLbr/qRnXtZXFqapy/bqsuLj7FIQmKKEKfLqImjNO4gbIsPbFjLp6+D3C4yBXdBD6AC8JplZutRBrL4Us7DB0OqOQpara1N3be/HDD8+Pn52c/vBi8Pxg8PxkuH18fPQwaREqXKxcAkbBVRNLyLjkUiizEUmJn1nVCRzvpANR8OieQE8vLsiPkrykYkqOoRqTC/pcJOSCseAtnXIzK8fgKJ3KnIrp1lRujXM53prKYTLc3dIq3cJyTluWMPBPMpV/ebmz86z/cmdvp0V/DNboP1Q+OyP+j7FcdTBdPRhNrDByNpnmckzzoOUJtvSFRwPJP8Iy/UTD1AP/JVimrepkzgWEff3uME0vLv9aqa498vKvF1SQF9bo5DqVkenas+ZLAobq0677F2OV1jB/FCp/tFl610atLeEnY/YF2KANRB+Gy5/ZnnR3uqtVi6IEYzup01NaXLdzP+QhZpXhZnN1nX90f95T1vlHJn3R4hS6+yi1cDHxUKaRVsFeUAHHwqoYVtSCIHEPaa11ASjjUybDK3H9R99BiGErf4zYZukMFMSqMaOF7Ozca3tSudtj1ddlUeQ8lOz6pFL53CxWVUnx2AvI9j2nFEYxWu+riC0imDBXaSsw7kngubyVfVfdKG0FWobZ13U3zK+X1rYqRFZE2Ne10pRusjbAUpkZOQJbgDYABLXlimu5KlofO83o7OINELutMBx1grQqVnTgdK7sMRW0UVXMb9uPgDJl8iouJlKX2FJMuSkzrBmZUwN/tK+i/pus5VKsHZL+s51kf7h7sDPokbWcmrVDsruX7A32ng8PyP/UrwFXmSX0zsoYn/bYiFqigTQ9X2cOm+LICZkqKsqc1lq3mxlbWJnKUJpGV+vH3jBt9IjlCqVvCp3RdA/vSHMplbOZe8HsbXcSRfDyKnkZ1dUeyDk8KeuZYVVGDLpXuLCGt5yDeI/kd/uCfyy1kaKfpbV1KaQ2NF/Vrlo/h+FRfDVTtmAtPLi1wpzQd6HRtChqqBxaoo4ZuRby1rVssajARFKRX87OYwMHWyhWVeBvecbyBR5k3iaCpj/wsU2757uD3aU9popNrRKyQmH1Fma4T1b1/3bcBdOKpJWDp1NY/a1kY1bnue6Wbk9zZLrOjuR31xYsZrJe0FTOjl4fRc91Au4Ooq0jNYUjl279UDIh9dURV+wjLXHbGUlevwtf3N+3CNOMnJpnpVFH90J4RldNCRo1DZ+2RVEm55SvLE02VhBC4Dr8hYSAJqFz5nqLxt3ba+2WBXl5cnRu9/8RNoGvimEi/HE6XEiQWVV0jfOf8ro7r0JKYoYMZsdsha4Un+vYjGkOACXf1XOZYr79yf99j2HiWzp4tq04NWo9ys0t1+654MOMW5DiidoI7YQmfsGbqbyjzo7CXHcY8upkrwcJaZsES/IwpxIk5CjLPFCT0AgGw1PdEOMFyeUtuJR9YH4dRDzxqfewYh0FbBysWUEVlCd0I9P66bWhBb3Gnmo9gs2RZ3Tnam+4vRkQrHK+q3NOMxPSk9tIw8NRWeoSOvPcBLOXEgWhs1bPYQL6zWKwIDkFFaMfrEQ3oJeN/6I7LigYKRCkMvSYy6rELgQRsnvDLeXCmZpkw+ToqS9YjyhmJ8N615tPYAR+7jTKz59B+cckT/4xeZNfSMpkEH3SVSz3os//fW+rLehr1Wy1hTfXudufVmxwoQ0VUbvj0+MLeDf53kuozi60Vl9ut6aCSaWotp/XYaAV1YwWBRMsAx8bqLpVMMGcUV0qrEV3SzU0kRQJ4OrCIuspSDOqsluqWC/U1pljBWHdIycyvcboCkO5ABPIbvz/LMeQzg9dkLNQmPFT9v3dyUpPojxWId2ujkQ8X1c15Kv9esR0WpRJqel0mSMb+slnV3d3qT9nypqUkD4FZwCuHkS0hMbv7h62artun4bu8TXLhxs8DVzvbWxdH6lRFynNLd4TarUlS6FaX/tIyzqEPygxtxLmAR7sxbe6Ff9Cp50bpujUKxqVme1e173QjXwAkA6D8aURpqsYpmVNsIzr60QxmiVxxu5jr/SNNNUFuM8CJhtTWk7ZJnTqsodnyrSelNYw36DTqWLTqLsAQbrTPAfQ9KarcB/KsmDPOJLKPH9gxT1AFXuDrR5XO49h4o9E9/PZIWgxyEkkNr2U98bIXZZIVU0ed0Yabwk7yPq6vstGCSNKRV4z88PZm4ua9QIzYaXY9tgV0NFMYUSwjlzyi+ooUv/m9eWbizfLLsWUyeQLcscDOH8Wl3wdmS/ULY9AfnGu+RisL8Q9b0H64l30Fshvbvov001v1+abq/7JXfWWrF+iuz6C68tw2VuA/vxu+7oTYEWUX//JjR1radGmOjPOwKtyCjW5nTmpOPKQjcAfaPeKYqZUQnt/Muiozjr/iKv7afBxfm7UjeMGYkc60BHNVuOLJJbwSs/KRt9nPVxjzBkVXEwnZW6l5kKWijBxw5WEckrR8Kd+yV2EvcKYc2dtjsaMGqy416RC8REq8KILT/CN8KKZpBl8kjRdFbOQV0fH8bSBAhZxIY2r2Y61q0BQvn1xTJ4Ndreh93E5nUKt4kNyStMZkalhhmy4NmY9ctAf8yqx2tp7m9jt0mm2zstwK8mvIer6n2TGPtCMpXxOc2wCqMmU33jfOaxpZcggn+PEFJq5lcK1ZObCsClTCblAk5LfuAfx2sv51l1n3jDibFHMWMfhuf7r2mDQHwz6e6fw705/e2etR1pf7voG2XffszzN8r2+d59D/JZLG4YdHu3uaFe/E/yDc0l5vQUM799KmkMpqjBmZCeC14+iBuRc/ZW/qNSW5JCuYJU7RexSZtCvyZq69eUz0j7f2ESudX/CplAT/ClcD3c5HeAKSZbg6aR57qcG1oEmKq1O3iCKnszl0EC1oOk1W6pE+HLIuvG+OHS5WN3SKpYyCCX0SH8huK56bQPefxC+UicTOuf5qsLN31wQHJ9seJ1NsWxGTY9kbMyp6JGJYmyssx65RQdZuwAGPtmCu8zzp4P6M5chad0soISuV4ILFamcb6nb9UVTS+VX8l/0prW210wJ9oRUuh8HnC2ADYadoreuUUML8t1kNxn0h8PtvruPbkL/tL6HL2OF44qMjlB3Lek/mvTwESGfaz39fG7vpkwYqXukHJfClPftV6pueWu/rrCmzvo7jdJw5OYZOW8D9Kc2bCoV/x2fkE0kuTCyUkwrY3OsJM3ApGIKKrCCHOON4kr+cc3IROa5vLUjOwOmXlSVbPh4ErZ5SHIsPj+nKVBU8A9VTuRtq+3sGYL05sJaP+vr0NMD7+fAGeNMKReHkXO8f2P19uP2iXGlw4Wr5ISc54xqKCRJSg1OGXvWyIL5PiWQ4olTnR5f9CxVCyULqRnhJvKJucL1bS0c0HzAkbTain8tPl9WYA0HyXA3GdagbXP109gJl663XsNGeCEVOc5lmYVbG3+hhBkZcJXvWvlCRaKcXzMyMtvJnGW8nI8Sy0w384rb2ldG4d6+h61pwh2Wr+AXZ4JUxnkYsctIr9sKZbFkRd67lKoLlkqR6UohmlFNxowJglFr9WXb2d6LwzmMqUVg/nR5eQ5/3x3O8cLHr4WkGfsSduyH/OYgf0qVe9mjmQlNJDxS1tJSuRcxiv1WMv0EsZh+oLHMFo9Rzz/aW+siri7XAJ/ArE2iHxw8uxtEVz15CSB9Waw/5gy/dJY1Lve9+P7E8lySW6lcs4cW3itYlUu4mtf3rc2GBRYc6NjzsuO0Hu7udC/VyuJg14+cv68ZCgtdsGq0Bse+ciGEuZxqHx0S1jLNOTQQsThqKAcF5U2hpCj1baHC03ZFeVaFSaKkw+sYIqToa0NFRlWGYCDRKn/z6B/9twhZ/+ykahQilf3l2AHKpbC/dlRU3N5hu3v7z/rs4Pm4P9zOdvp0d2+/v7u9vz/cHT7bfUBAi1+kOTMzubKFqq0FTnVfN3zFwHPFjT2PICo29HIJfXkx/LweHTH68fRyVB1JoykzvivKj+xyBI4/ax03i9t4Tat+x9Qm/Pmbi8tu6q24ucD6K+7KCga10u7HpvyPKBqipuYlhPLli3rg35hq9Bb4kzpqGBcLqIqaVvFzz4+O8YX+JejIrg0uOZbzgipvdc5jkGkY1Kp/kdIQZltf1yQe1o3qFZIZywvnuc+YYanrCaEYNZqE0GtC5lynUkz4FLpPuU3dXkk+p1O2NeVLF9D1NFZswpRaWQ7wWzd8xYrx1mnJXF9bY5zLaVwPbKsBuy6k0Oyzn+s47bIHewzk13qy34fx3Ue7x/xzn+0O2scd7g7oP1r0OTCeTvZFS/iEws+N2iH98JfHiL+arAujOuXlSWSeI6421JS6I4rh01us1vcNTtQdzLA7qMdEr9a4B7jucqwNwXivGiE5H3tsDZ7Vvrw/Jy8MEOfl+RpniqVSWcUSLhKwpi9+rM9LauY0FOhWzDWHHy+wTSyyhktrmnDFbmme94iSJbT+yCW1myOnImVqM4xabZMPYZuEsWZUZOBBouHOIZVCuOsDQs7c61TbreDGpMQqdXk0TEUCBM6PpZnQUsGtBtEFFdDicBP3dAyHv2jpIEVH2sOnW8o053RVtaYD6+AseNdRrWSV2tfrCAbzq1p5USzLzn2bI9RxgcQcdOAekaVxHxTJ5r9b6wgi0aolEXTe5cZyLy4rTVZmBlb0OjtpEqvG9hW1Ll6/Om/tH0LOTjpOvqVNqRWGOJ7Fa8Hu5oh2SyYz+wj8VaGHaSy/Xro/78lNOmmlDYFNZk+yXE6ncEKxdEYF13PLXP5LMKkt9FGNGDDKq1QlKwCr1fpoulJrOjeul6GpNRMgtHXLqtV+/iiNuW5H6oXO5TRMNGbRkQb5mWRkwcXHku9HNUT8W1VnNOn8mJA85XrE1jG06oVFgmXx+N8HW3ZcGqKoc5qSEcL8/QjSKYXzpp4eXzjyPUFCVOj/uSqtrtXCyhIcGgCA1YO0Si2zW9O/caMZNux9Pa6W6m1Vbf1JxQ3klmqxvm4wAwczTgJ8PZJJWK/QVfU+j8HWDVVbuZxuTUoBnUB04jfaEhIl7m7zpLcCb7wXxWIV4qH9MtQLOAXaOM6VMaXc7YF2BHJDKTC1oFE+u2EKAppNo+QsnN7C5SZPJSQGItvDIHjBAPvGzZtJhquCG2th364U9IUswRtXlCbebWGvW6nkgSHQ+xAVjQvc6v6nzTh3Tc6ZX0kUSaNbqsSoR0ZMKfs/HP6pdA2ad3jrmFLOPxGJ2mnTg/Bk0a5xAChO5E56exa6Vo6om/kyuaUuQQjFGyseJc2p9gFcXHDDXYpgNQPoDs5SoSQttZHz7igDqaa+3wT2R0rGUhptFC2SH/ynGrHQ4QcdvJKcN4OEHxxCY4eI4mhqjRgpF95+czwH4RKIuXM8xrlojf3SQHV3+048VpkM0eSBp8IufN9VUcBfHYdiIq7/e02yY1wguOFTg+9Vk3W/YscFmVD1o25vsMA3yb/oDe0keinSFRaPbJHcTWd3BTq7W1T+CO9wXwgypLKDmFoCfuwdfxeUTtrNmaGQsxLLcpemEp2BKD3n3MRsccOpGybc+GvGyNsXx5rs7W7vWqR3hvu7SQf8yYSmPOdmkazClbAeYegqPxM/Yet4A2zpDeU5HeexInCUWnsbdoqMsLJ2t0XrjoxkKnzAcZV2HIa0727vtBl3e+deGq1QSkSUsid1Hz1iSxOrgQekMD3rwqVQXKrlitA+bKkby+znaTP0I5eYVUNyTQ7I9xVx/iMoC0kYEY7SUMjcvq+gTwNhHwqWurt+H5BNHfc08tOfDztu+nb2usgaAHj4NvrojglK0tI7pqY6u6MFCtRDY8NIYMTaYlW5pzlxJWmASk1n1dnJxWYvVgytZtcC3u3MqbSEd/aS/3GU3Au61TPhMPN6pgVWGy5SE6mzVt+0Go8sUPHLK7hTWaBN3tAtO0FpLXmnTAgLvmrN4Y9mhjBhPVNgKSYA/+QdHBDZFX/g4kdQtNb91JkJjQjy2CfzOvrqI+WyQvx3rXAMOnLn81I4IwAtcHnDlNNQaFWlBsIR/Dhx4Rddc3f4SPfHlJnxo/sAKDdsM0mUCqcmPUGhl8oAWtU2gk76Ua3kaErsgqhueAqabYhacU6GeMmRl7wjPdBuA/l0K2PakLNz3QOHuO7FFeo1mGO3XPlWF5uNKD1U2Z2+jVwRAWm3UgXneig5EMaoucraMRkVWrq2yBE2Y+ZjOiodqSLYLRtXVLKkHME196hjpJ6LS5zQlI2lvB7FoQAjc2tVVzVqhJog+tEN4ZjFlW+MDBXbMOv4t5KpBRfT9p6lfF7jro4L3JZd/5DL23W8vcVbW2xuzJRCR/9Y2i0FJT4asUlnEzJCNsEb5RFGwFiWscaHtf3998olT/fIyO9j9xNqMbyipi7nHYfV/kGNAE64mMXVKoO+fCdq70oVkPXvkbPbAgvE4c6gmtyyPHfyL+BTKGlkKvMqjb4uGqM2QsRImffpVEht7KHoQ7uM9Lxeyf9JXg+57u4cHfVCsQyS8+nMbAXi9XkGRfQ69MHD2Zv/0K93f/qPVz/uvfqvrYPZmfrH+W/p7i9/+33w19pSBNZYgZ9p7cQP7hUDvzWNopMJT5P34q3vHMNCeBVV7PC9IO8Dcd6T7/3F5ntByPfuZhM/czGWpcjwD1ma6C/uOjG7lz74v+KRyfekFMDc78V7AUJ5TovCCh4QU9o7du2B5wyguRTcSOVLrrAPphcP2eHxrQLToCSOJlBhw1LlhrPbnqvpGDJXNXm/5hFei4eWirxfc9ivJffC60ktFSmY4nNmmGrBH4/tUbkf/hrgzWUNE9Xo0YkcLtNaj7xfC4sGf4VFW3PY+mWLCJG8F5V7qfaKczClSmqYNUBEYApoGo+hflyjGyqGFHqFYe2NhgLkjTBzK2EJNagc7tI7TJKg14vmWtaGRTArTMLktRndpuiYy+emx4P60fzFSATEZRVjH0XUu8yQSZnDt2cX5/YAj4f8+/nrcKKGeP9kre11AlrWxMhEqluqMpZdfUp2e9W6GO9gIidk9JO7FSiU/NCOnho+306GyTCpe1U5FXS1XTmgNMS5Pyxeo42/4QX57e1tYmFIpJpuUa35FPIU9JY/XvoIXPuL5MPMzPPNyhy5cMcKKCG5a3Li39Ju8WnOp8IdaKAbv2bmRS5vMWwZPrnsgjAuRDujdl+69IIunNqt8eqEFmIpEt/tf3wdUmIFU/FFL80ydwK7xB/L+V4ducmpcA/HzuJqb0H8jGBqbvns7y+PXiOH/dbnov8bfmEoXg9zTVzpgYQc5VbJi+rKITz+7tBOm/AMyAqf3SUjwB7B1LjHtbpEGBLg0Exk7tIbZAAsGgQp2p17MNhOhr8RJlJa6DJ3IRpGRmIeI2AalvAvjF33yM9cMT2j6jrZDAT/WBCGRSBx2K1oxwDN26EYtXCd1u5eOsoiwmCFzpA3zrJHZO4KurgTnQeGxqwQEagTMeU3TLhkPCwoDRlFznSo6lj5TddE50eI3P6ZT3gN7M6k9vsMni7jxmeyP8a8ce92GDjVLx0mjv8xDOmNnW4jZ7sed+hF8gr06nUXLffm4v9n7+2b28iRPOH/71MgtBcnux+yROrNliIm7mhJbitGlmVR7u7t0QYFVoEkRkWADaAksTf2uz+BxEuhWCWZIllu2c25vRlZIoHMBJDITGT+8hR95AlJjaV2H1bVdYJwwhmeEuEJiqo9dPCebxroJmHyBkIDN1KO6nOKfV2cT7t2b/B1SKxrj6YHXMgNggakzVAmFcFJYKz+08wTnjoPJZPnBqUg21dZMmkgFU8aiE7u9ps0Hk8aiKg4el2X/FQ8I76aKkPn2Gv2yjb7LNIS2DVyCBqvTiSJG2hCxyCWuoSipy5I5Xu+wn6Ey8u/sNtR4NM2Tv0p/N1T4OZBcuYswjlEA7EHMmno2yoz0XcuKsK4CQHvJu8MrUisGm58k6BisgC/OmKzaEFb71tfMQYfRRZ72/maZ5/54jDNzaCYxcRAJllWwenzhcalDH7FkcjY/AJAkg+Uni5ywGWzGOvu3UQ2ILaq/SvwlilTIpM2vGwu2q2JAH5hXAcM5UzRPLxgBza2qR02JCmYEfIMUi7B9i4NraXaufjoixb+V648/P4MXhZwmj7xsGB1uEuOpgOEma/hAKkbPqXfF9LlhJq9IXO7+wl5Axd2VJPhIWgcoY9EAkTcHxnJzMDo5OoMIPqha7n0kcaJ4IBIlod2/DC+04cgJt6RVyo6eUCi3MlRdwWvISTMmV/Mq3Nn3YJYoRE3blSefw8R+iCZ3HjQWjyAZOIvDK0VzYYADMZwCMVNEhodTF3xgws0ItQ1pQRYjAsRMD+uq7mddalmigrcuxWUFmhHeba0AAX4HiEYiCVkXuVvwb68QKJ1KcGz/aWSDH/42oISx99nsUGJoe/ZjAtZ+M6tuRJTZTje1UUkrBZ2iLzulcCHyJ7g7jEdDGUX+QsgFgRyG4t3he2RdWofFRroxEba8zvo+OPvDfThsoHOyFB/Qjt6swK9yPopjXtmmPmbs6+bGqybGqybGqybGqybGqybGqybGqybGqybGsyHwzDT06Bo5+aPgiuMZDh/v/ZQhg8sfK+xDIduvQ5mLIOLUBLiDx/NKLP8vYczHEffczyjwMMPE9BwXH3DiAZlMR+HST+LRTRypAhsRp25Lay2KkUzIIrhB/1KNOP44+9zS3KxBMA8wS+HHqu+xWvqdFNoclOmwEtq3fRmZm+ssOnN6vzioxxd4Mm1dLn78EFYHgtQYJKhFA9vPJdaWwRbC3Jsc7NhkGfv+VdO//Ko5xpDMoWH8NK+LENcDDGjf866hKcDxHgImAB5zoQkJAmh1y1dKRkoRMYTVeHItXuQNtv9ubAQ67Yc9g8vo2nDui3Hui3Hui3Hui3Hui3H99KWYyJ4ksXz4BAvGsizMzxi0MyQKLctsrvHByCC4rTeChgXGLOT2bBX0XSvrX3JqIj9m7tNI2JeKCADb+zqiYvmvLDtQdFEEPeU4ipr8pGmEyKjKrQvV/skQkx5Z/QB9Fci4X8m8D9ggMEPPE0JAISZ6Jz+KU9yq6j9LwSncnxblvA6UMJ+gYHn23Dd6RgzNRPerjy/KyHNb7Xg7syxnOIRkQrqF+C7Ltt09vdf7b9iUwGDCiJBjLPtCqV865rCrE7HjTGDXrAC4VihbOLu+OWACWivXr3YuTj1qtAU4Ht4TCzEFDmzBYdVw34j3LX7ROH2vFo+TjOpiKgzjFR4j7fTPZe8TNRlPX+5PHPEacE7UdutswzN9e6Sq9AxWUy48MA7yaPFdSw8jI+gu60+r/m+hVoefAslwimOyw7VbdYnTWMZzG2yBM57XXdsMAXCg4EBjbKxQgOr8WrAhfFymoowzFRZIyLIyVbZpMJqt6iL8/LsNOK3OsFuPquaMPCfq9awPoZI1ZzwpBkn8f3cZqfnp0Y/xfVam58XW5Q9LxM10h7eou7EG8vLVJzqYyMYAXQZxsc4gbBFyrNkwDOWiGl5x+VfeZq/R4+Zv+Bnfv8V7KNwHFc6QJjQegIsRuNUBW+yOSBRzMcTzFy0jAubuVKwNmeyPUIwJOkR/EcknQBMFBYCM2MsDGiqhQrjQDc3FxykLCEP4JQx+KALMHoycn5Wge1c2/PUl8pmXGpmSaLSZbE6cr51ECjcaS58lzu6hU3svdJufvc83Rbik8Pz9siB1Zt2NuaxvDH6XcaM1wHjrwSMv+No8fetJVYcKv6O48TrIPE6SDxXhfRLjxCHEBh4SMKb/iL41ZMXfG4tPn6/g3UoFU5TkvhCXzero+9UuREkAu0JWWqlodzX8gxRo4iCa0XSP8NRIRfZD20JMWPamtt8LEg0hS4vcWDmLRUSE/GIKhKrTNSlNOxaFaYqrfrD2/3efhF9oJ/RNKk5XrfZsWepcjVBPWkqZqM1frvkx9ztFv+bAB/EY0JpLUcV6n7omAoDZgrhCQDLuSEqACAHu4M35O1Bkuy3+62Dt2/77W1CWq1W/+Dtwf7+2/03b9qtOJn34McjEt/KrK677cgOXxKW4xA8ljsiDJRq1ZW8/7a/s32Q4IO3BztkZ7d1cBC/Sd7iZC/uH8QHu8XnmWDymjg6LlaGAH5aUTt4yj9NCPOQzIIPBR7Du0mK2TCDqCS3W0pCcuyWICnF/ZRskcGAxjSvekc55kDRszTi7MmY13bPn7IEloYN0YjfhwxDywK/orbaL5NENKEcpYGGKe/jtCQX8+sqRsg8nnKCVaXZd6UVImCAVdJXlFxKY8JkbbbRmRneNpfKY6YhZe6wB3pCm1RYGw9C2bsCZGosDDNi6OwLPkbdi+PfkJvujEqlRSBCm0NK2k9JDqEnJ8kDwOfZIeXW67Ke6UxwPCJ+4O2oVaN/UHlFBFPkO4cXDfP6+mVdYDUykiysGy1tqLD3VCbFFmz9rSOSplhsDflWO2pvRwez/YEBfb22gP0HPtYkmyiYnyx8IvGWDdivVOamiu9Wip5oQOFhdbnWZXozzXvfaINnDq6f1ZzC7ZhC093yPbK9vdP+Zs6RC02XbQFIfLT+gbNDwy1merJNJ6ThOtCpES5+xDxq5U8QEJfwYDSHSEzGDZRMbocN1BfkvoGY/sWQjBuIZfDrf2NRPvNiMvcLTb2WmFvQ4ixhN9jt6CB0Cor+wAn6AL18F/EIfjV+ILrgQumtj04eSJyZH19dnLz2rXy+C3P76OJLYRqksBgS5cPE0OWpZH7v785tPRbC97UUkjAo+oRpChkUpjWgBddNEFbwKZoS6PpXDuzQWHCt9dARFxMuilBWX2GzfqvSs5qUzctncnqBw+rsr3Cmx67ZrfKszfhNz2RrP9qJDvZbraj9Zre9Ny9/dDwZYVlbU80cGh+cmzEg4Bts+4sT24OtwxwVqNmEBqLwMRTQhfRfbM65S1wYUDYkYiIoU6hPGeBtw8M0wgNFBLSp1uLyfS5MU9KYJ6QZtrFEFujTubMSjTAUKcSZENpqN8apgSGMR/B2Buj5SmDvDgP1JsL2Vaj9+/v7aEAFIVMCePv9lA+31EgQrJqCmCaEW9ut9u5Wq72lBI5vKRs2xzjV9kjTCKepJ6RsGI3UOC1fVK14/21rJ94lB9vbbf1DEuO9g/0djJOd/SSZu9+666PRg2NQd0mcFuQyGqx70Tk9v4pOfjuZl796kyk9U1UZlc9kbsPr5+uHzom7heHn2Ye8jae5D3iPXYWyMwyCXz39pD1XpNBNUf0grY+zf5SGHozQCcCizhV7x0PPHTccoslWsBWDbrhjA3gXmcqpGzf9hCY3iA8UYUgqPJUuJm2mQlRJkg4QZn51NVcTatSM/qDxx11/AnjsMuTmceXl7JxhXRXKmx0h8NTitoPwsBhmAAjf0MIQysfrIWOpL3maKeL6IOcqckQQ8YZeoOI+4qlWyiaTwEhsIri2pqAQnCp6Vyg3r6zpAr+wT9mWlKONBtpopvq/M0mE/t92K9L/r70/W9Sl5dYDIInnOUwzkQjChspfUW7P6LEhVWI665kUCqCCLgcOKta2wdAc63/1s/iWKIQZTqeSSsQZGvF7P+RYm21+TdC99qe9UlDcrFFwlNBHuE38F8ZG/pj5EakNRxlDQmZyQmPKM+l7WpWX4BnmbEJ6kg4Zhrh0QodEqh5Oh1xQNaorRgo5NPbCQ34ybwhoegprN7NgBu1XL5hpnDbMqBwhz4VtAIF9e1dbueiL6qBJth/SVWhrVQ6fFADqbFVU3jfOyCYntyx1OcLbe/sLip48UPlVoPM+5ynBrEqm78yfwja3dIBwLpawN0HpyGp1trkg5fonyoY19mPS2yWIAs67T6j0H3VNlGY7kenjmg0wuIqmZDUPFJns+LADJ8lbEQuSkjvbhaUz0bvqp09dQMMo74uYjyM9J4keJnEEOfyLilphldV3tXztNSjoAIcMKdUidxo05aYrRiymE8WHAk9GNDbdymV+R4Wj3uGUJiGulHbbRSaVm0+b4HcEZSwH6LU9hd1X86+45OJ8fD/sPZYoY/AURCp66p9cXn667H05v7r80r06Oe5dfvp0teiSZQAHUxdsUNcMX7BEIXPHqLKVBgVmOFMEj2s+9HqKVZ58GA+e36A+BV4h8ydvY9RH+UHPr+DnHfiTzx9++/3tx7edXxYVrb6hFB5P5hDuY49Dx/o8YZaYZ3PfE8lvDnMp6INgHvT1UYIWP3Bblq+I7dZ2u9nS/3fV3j5stw53Wr8vemXA+Zzr6euJG2+zq7hrLhnqiIpzr918OgOURhPjY+Vff+x7zibT/hxcHCQx0EtqRHM7opAGA7BIBRhxbWZwnrqGXNp0I+nUvEYbA8QouLI5vczdDEpxSTFXWxaQr0yHVOG0aGOYp229mYaYMqkKLgfEdaamX1yh5X+lWseFtfiKzn6unMZjzJJeSufCbLmnaRJjr8JWkt5XlPL7LE0dVUhTZTYKuAu2mb9VdrM5m87H85NaX2/GxzNbFqdp7mwE8ofaxJIXsoQXGLqAqAk9MAXynt+8y0TSQfQNXg0+4nikRV54ObDq4OTs/SOvBm/3m/M/HGhO+lNFelwktdXRvptqy4z8kcHrJx88TvwZVSol6IQlFM9tAGge4knWq/EZ8ejiS6Ea91EGTpnyEd/5CBcEtmovuDAXuVdPHpQw9TYm88JlG/g2l5rcTZk/m7l8EuuY5kaFApXWz2iqTMI1hAuTCLLtMPM4nQN8ay4QGzI2rSR43stiLv7JA8Tq5+B8kGKlCCNJFftntrjZDEcSRAzGnanJM9cilDs/h7YRvF5GuF93heovxRcZvbvCblfvzPWU47m96rw7fb0IK4DAWBMT5q3XgDw+dk6eQ6vepTWReowVRuYtOSDUzrsAqYQpMQ3RmFeWUmGFmk+wCskaKNC6szg3Wg/tDXC4fZt0R3RuTz6LbNnDfVoTuV8/b07yZ5RlD+hTdxHJ13hF2Z3y1C31HEq/ucJb4PTZd95V3R1muBXdHa7H5BzEMRJ4naVGYfA44J6/bE2COcAGpg3HI9fQsrzuQfyB6IWyfl5enGFDr/apCgBz9dAGB8Qhh/SnSGb95kwzWkipZERb9Tchx9FPN8873v6L8YhuL6ZACzAwM4nPtCn/yGwkpY/7NKVqCiF6QftZKDZLx0LLDFcAn8yTgfMs8rsjzBhnyA6PYpzGtl1ubtotRfggxbU9C+qN2LV7E04ZTLYYnTVmBZTIDOsLn0elQ/7v8cFAkrpe/EsEm9mWJHnxlFBI9JyLUN8ZISy4eR69Nd6hJXL1UItReUeFynDas/irq7YLS5Ta+Rze63JE15Ec/CjFC+yEIYSDvtHVCpP91Ver4XiBq9V+8RnmyKIHx0rOCcxvqoXorfmQz9D6/GM+woIkvZT2BSQE1kSqs0zNdMhPZ56RIEWAyoChZ/GgSDqoMSfTDY/kdNznNnVRH6vneyVsqWok1zMkiFUj+HrVY1J7v9naa27vXLXeHrb2Dnd2o7d7O/M/KBnUlBqfHx9HGql6cyQzzR6MFjMvkdBmF15E+gHMi6mSMw3EZQgM6EflA3QPoNKF+m4o0IE+S46QZv5+9+XL6XEDdadyzJlL/kM/fzk9lnndN/QJdkm8MHMGrKZT/1Zqeqf5prLwTFrm+ogzqUQWwysatjl16dQOF0oOULJjPtZUTQSOFY2hFHBMFR2Gz/IXp8dIkEwCXP89SVMo+w0ecbGTZux3GAeERTomDYRjwaWcBbdBrs2Jlh6XquKNLd6Od/f2koPBwcHOm725S0nzx5XV7cJvjBzRmUkQLB7eIEFwRmLh886MTGhVs7/npfBdwcsVVea1upjJl7cFg22liBi7poyAYhZVNYjPjQXcN7YCjOnRO/PJ3Cm3iGhQjRhm1ur/wKNcRQlhe+fNvFtHH8BonOzVpL4+Hu+ZKcqTypHHWFz1rN0PnfYT0+bJcTVMvL23/8TUe+154h0LTr3X3n50apkQMk8Wx0JTd49PTi6CqefYd981zM2mu9JM2MB/v8vHBFJmUGxL7U3tuc2KEkjSMU2rCgBntdcEC61C1gncz0vgnqcyI5fsOsX7W6Z4W8GvM73/skzv6hX4jhK+qxlY533Xl/f9iMTX6d8vPv37kZX7cbLAqxlcJ4OvLhn8EQn/aDnhj7C5Tg2vKTW8Wt7rDPGviWudKP4dJIrb1fpx8sUDhr73tPGAle8yezyk/2+cRB6I4aXmkgck/iAp5WWOXnxmeZnkl55gXqb4e8gzL1P9PaWbV1D/nWadlzl54cnnZYJfeg56QPFLTUUPSFxnpC8qse8tMb2Khe8pP72K/hecpl5F7ovNVq8i9vtIWn+S8pebu15F9otNYa8i9nvJZH+K9peb0F6gep3XvpjEvof09iqyX3CWe0jud57sHrDy3eS8O5q/n9R3T/E6A36dAf8XZ8C7vfhSE+HryXV/jmDW2fDzS+ubJsU/k6xvlzb/fMK+YWL984n7hqn3zyXupSXnW+JeYI7+N0rDn19GE/It3vnr7iaTM/M36SuTM/zjdpjJefzRe83knK67zqy7zsyzT374/jOe079jJ5qyHIZzhSeeFQ0+zb1qyy80aQkq6mzir/Ps+kSPr73o5xpik1nqS8n6z+va6NvdlNZgd3t3+5nEgds1h3CfFbOyWaT1Ra1AQSXR6rfFFQyMTo9XIVtLZY36yZIbvih6gs3szdZziabqZcdfvN8AlM5EJvQOhN83TEjOOBK+Xg9Lv0dBZugoyG30pXuHfshB0H8co77g95IIJIkCbUaVJcJFge5J37SPhduaqXSK+ISwIIt83lXIJpry5+3uouNIYs6SogobYa3GCEPZpLRb2jvbzzXY7rnQxkAvoYLEiosVuh2r3zV6c1iCkSd4tvR3VihbIz4mWzilMZlbNj+GR/n3cSV/aB/yb+A8rr1GtPYan94gP7y7+Lf3E1+ig+iJ+/bun5v6JTl33nz7C123GRpegmPmSXqBbtcTJ+/H8cmcVP46j8tR8NL9qfm3wwqcLUedIEMqlZWF7Ud9Gf7u8YbU74FdZBpIg71lLxs/gN4Jxl2w5Bj2F2vXDAWWYXbyyi3RT65QCmZB94IqRWwb7D6WZH8XERbzRBtV+RF8z4VnXJQZbyCZxSN9CrtE/aLNv5MHKFy5JMPPGRFT+7tGEYwAWl3LidnxPE/HgmI0k6J1k056+nc3kUfQ4BNrbPYz5UyGAFmJKGf13hHhKiwAOSLPZvV1+1oPXJ783Ht3et65/E/DOUmcBVuyJ3///C7rHLU6v3x+d9XpdDrwb/Off8xrZ8ASmxvoa5BLMxX8xYU8MrAEpmpXL6M+KGZcVy/khXLhGcYSYZcsXPVNkL9dC7fQESy/pGwYpHHZz/vNAFOiV1qY3d8bINST3y4658e97u+vzbqHyT6eBqpy54YzYse1U9o6cMh6sxPCRtWjf/xydnUKc8HYbrg0Rf2cyjssKFRkpgDTZoZl2ZgIGgOv+c7VYx7/+uny2Gzck597n/W/CqQHuyzYRB4/KCExHeMUCWLzpY3P9YpEQ3Sz0d64qUhN2vzXxtHhtVD4WpCkp9Tkuk/Z9XiKJ5OIPJBnwNrBxipnF68G1UdhlmCRFNfbXKNWWzicDDnLodkS83Ixond1MNDp9wW5o7Be4Hi4KJeer3SNfPjn2cd5Cb4l0xro/UDvSBNuHXpnswT5AFLzS8R2P72/+rVzeXKdO0VOVZ9fXR8Zi8UWPl6fjrUZ856mBJ1AmqHeoJ9gUnl9T5kmVO+7ub0mrEY1sA/IInrsEDhEL1VDDwcnFHR01cJdLy0Qf8wrBHN9TPrZcBhUxn1FQiGdqxTReeA+G3BCe5eXNsh8FOfGEmi1oq2U/+pxU2kzwLeUROmrekwsMtUAx/oixoqgCb3jJktZ8IwlCKMJJYD14ejTeszdXYDxAh+ASyBEg7NxMKlNY4A/YlM0SbH+JGX6hjk56trMU3QVkmCHNhEmTYnVBeMGkgqCVO524gMAn4EpjE1g70YqAuMl9yUtNh9DN1aK0Y3npKMVZCyI8tnlWkKnF67miUgXYnMBPpYQAUnSDcT7kog7IhouVT3fEcom2TZQnFLCVAO5j+pTwojSRnQ04OIei4QkPTqJ0OkATXmG8GRCLL7O6YXT24rn1NPJTQM+qUlS2lwwQgOJYTSkd4RpFpSgdxSn6bSBGNeWvzbB7kfEb3OqYDIMgcT+NEc7DaY6bB9sR61oO2rvucqgZUzpGsO5nTQ1dweWIyLN9uBMC0q4DWctLoN35I5FA2TotUsmjbMJyHG5XO2oWuQjkk70dpJUZTYoC1LVU20KvUUkoKSNcBG5CsKwOcYpleiVQQIjggw4fENvNK1K4TL0BMyPBgLJ+zXKV49vCuB9yFr/KqhkqBb8iTlbXhzh581VQtD7z8fnsoESPsaUmTL7Bvia0lps9ld6k6cUy2fU3tN5knj9h0pcW31+elHJXDHWIGsDYXL7G/CvZhYBfle1CD43/yuy8vdMZlfJXTLu30/cMPoz9rBD2Y17A3FQbxAPsjUpplSGTb3uRNzJCw+146QJsIWOrmgH4ZQIFXDLuAF0AcZyj8puMpgiKCCyo5knEucfGFcqINzuwkOnmx1RyZhKePrShrTgqb7MlL7uZMN9VBMGp+D0uLt1etHN/zCggtzjNNUbmfTdkAHSSPCBTKQWJU02EGGJwX5JiLKFrVpVmKtNEvTq5PjyNZIQS/eFS0TFK9DQOFMjXtce1uaRPsFDzOif9oLkAk0kyRLOpmN31AwRcNThJ61huUGkIklBqcIauh3ndwxo98K+D127rsKiecZF8gw/LsaKDFcauSsebjeBFYs1Hu1QQeElsZ1t7D3lROBlou+qfNO4GrtqUXSUIuOJdr5OAwvujODbub3b2h/Yr8CDL72tw7Lb5XZyqGbyXcrjWyTIHxmRCizFSdZPaYyOz7umMu7D1dVFF22hq7MuYBPymKdy7qulrvLKjuHx9NioLypd1eA9VSNT8Y1kzA3Mj7aNh2Bmeps0j984tVm5cZ61Ydqt9rxySWlMmKzrESZ0s+xM1jI3NtTTmsGLxlStaZcIJwThO0zTygK/zgTHI4K2o7lT7mp9gCKFV1rgE0KBDkJ1vnNx9unon73j825PH4Le1Vl3Xt4EgYebuC4GNy/dBOjL5ZlePfw15PFwrf3qVt4G/q9ajHp4bdGbu9YGWA3c8+amRAmPs7xeuTgbuGv6ZG5u5vuJcZXvooZ2IkKERYxSym6BH5N2YQhMzSOWEUHf+Sb5JWeRvMAIKkcqXd4GYdE9vaUTklAccTHc0v/aWmh5tQVWG/bM+czOlUQ10ISnNJ42jMViLAJIRPS3rna34GQ/6+43Ja9jMu7nUGJ5gM4GT3sXVuX33hvra145ZdkL0f0Q1+HCZzF4GcGVIPM7wThPwWVgMB2+fh0UFWb5Wmi3Wub/zyu7etPWruAUm4y1LSTIHZWzpkOfaK5h70DUxHZyKbMWfYUnn5ABEg5dp27+myecp479nF5kB9mCpX3pgUCW/htD2DsVMWfMLs/AG+rGFUKCDLGAsKwk4LbIRvB5s/59ah5ujT4dpPwe3uVEkntS77lAV0cXdtSGhQ5zZBraYkLv8gwayqiiOEXd/zxHExzfEvVKOsREO6geMKfFPPqYveiNrtmZrIJMpyV5/K9cCzi5QKIctoNDhNL6RwjHKjO4C5JYZH8xRht+vA2tP+BWC4Z1VLAZwqWB/Ld/tt6jVd5aiytMU5lfFnZEQwpgt7OhW+B8ipAPGzLpFiYwfjVwYUcMYM7BOf13xsymgIcvE3W0364aLBct46o05ABUsF5Gk40462ofmeG3HAvFtzUTJsNJgiQZY6ZobJ6hHuCOxQyRB5Oq2CgodSohtDbIUv2xO6rZpX+S/AVaM0qEwoXYm4ubCj/HQDvUbkxmVKi7SEzg1D55SkXTFBETrjPYShAxAF87COKCwAY0Tb1uwpOJ4BNBsSK+pcNSTvfciF4LGVRwGsyVaBfMh7cLWFF43KfDjGcynZpdDt/x2h/ecaUv4k6pVHo1Ty8aCLu4HYSgM0YfkOR6/0QI/WcucZze46k0Af3iVY7vHU3uPNxE9hc3RmRF241p6yp/uk4y114BQuURndxoUm4iQ9ZNAyVkQuBVAHFrSyDOgoikvmZnEoewjAp4i4vkDlmQHDMOwmnKPZU20MEZH/NMWhVh5J7/2hNoNYgd6FWne/66BEsDScY4HuWRKSNKk+VJKm7uvfb+wSzPYXjmJWINzJ+t9CngpDpj72fOhylBZ2dHBSlUJPvMk9v5KILhO0jrASiVAIsTTqLdCEZhlxfo7W4x4ALb+SuULdxBJ4i954inQKXdnJXt11y7OhMEZRDiw2iQ6cvlD63DAdkpGNvE8Fn+fpA7UHhsn/7G2PbWyedIiKB3AQS+R5c2mk2bNkQrEX1/CT7OjVYmaDzSjlzZfRpwHtl/RLH9jpP1kPAopmpaV1OmI6qm1bvyI2dKEJyWyeFMUUZYFWbQSmi6uufN1CRrAb6Pv9hdLgnMvimr6T7vzOsAFJmpScDnhW5XdrIy0VyoEepA9hGuIDJjSkx7VPK6ZH5kpkCn3U8g9BKFR51Hyapra1qSKlf5CDOclCUFN1vJqSuRMyS8FwLnF5EHORtSlSXGBEuxgn+UY/j/jTZSzjYOUfPNTrTf3n2702qgjRSrjUO0uxfttfYO2m/R/2yWiKwxLrf5RRLRdKbUTMwaIyeeBsImimQMaz5AQ4FZlmIR9rVTIzJFMYDYaU+igClnTR5VjANSYYzkmDDzhgQVHCk3qXV9InIkMOet5MaFIS/NgXVNrLiBYqejwsTFcw4QlvqDxqkCH0TbLGOwbYaEO27LGrfPpeKsmcSltZlwqXBa1ynbvIDhjVrDUvKYFvMEPcmFVl+ZNpFza99mpfiUGn0vufjeLeP3DHI5kWbFgLEJ9PvpBQp4QrC1wZS+w2KK7mmiLTi41eyphsdT82NZfge7rd25w9BarIIMKWd1KrBLmOEp/dX8fPQYXTVpMEtTpQL7nJE+Ke8/7dX8yWe7eK3mWnXlNnp8/3DhNYLLdj3tnHeCz1USby+qrY4YwrWMt95lhHHZ61BB5n+2mnyFy+psiDwxasY+fHV6cberd/vpxd3+62JOxBjHdZznj52jamJmgvyM2wC+sSrNSbt8f4TetHa3AX00Gw4BxfkQnWjniceKKPTKhl4b6G2zT3MTVdv4r02PR2sa2afZe47+lU0mRMRYkv9CI/KAXeoxdLmTaEjvXKw1zD9EjnwzsUkGz5jtVUyZIkMiItTN4phISe/sB43rLskEC9clEPsRR9PJiFRo31ar2Wo1907gv3ea2zuFlWJYRUvkymxeCcykDUpBPV0YROljfVGcd658bNLiRVLrneaXH0cTQe+0uj3++PvrYDmLlw6o7pTjBPVxilkM116QUsEFEjzTt+GMY6/5nPC5CuieVagWCgCqhF+uCEx07xk+brFU0Xx7IY+2WLBXXoYliyit2EN1gNBs1RERJOlV+dIrbmxOhyMiVTCpk5GZuwGMTCYk8SRnffOnmTIfK75GUAICw1mvWlslGzO+7IZWUhvhLx7vnm4i14ANC7CMJKZSWyW29TlE+lJ6a8tFTf6EzAYD+uBHhM+8Gik1OdzaMh8xn4i4GL6O0JVJLVXcmFMPdOwf6/pTJOl4kk6Rwrf5uprIYIqlAuWa4j5JpbGcGFeQGmgQkDX3V2fH0t+jGzGPstuNsvp7zNX3Yq9zN/hJYNN7x+CJQInLSgsM9Twd0aQAkoeYTIxD4cMvNhWiuFXsdo8QOmXaQsVC0eA5AZUoAOVh27Tq/2//bjPXvPcCbkaW2sr4GLP8PQEV91UjkIBtiSDLDPVJyu+rt3n1mSiem1C2G/f39xHBUkXjqR3BbAxzMrBUG3kX91PbkNaMMsI5SLbh1ZQ7uWlym21DZv3tSGb9duHwNQqbOCevALBspRCMsdEwZ45xpASmqT4yEyIor2hXqxmY195TfNIDNr6B1iODAYEexXpWu1Es96/I1dnx64Zxmby/lMvdC82ojoZ7bgQloLes2yvBIYnKCnJ2Xj9sUGGsVwn2wfetGUErPqYU85WYTz3C7wv7JpNERPVumTBKl5cU+0znIIcD8cFj1yJm6Oy4c6FVVsdwfOyHCvfKZpk7MsY0rYm5L5oDmKDYRKdAgNaeK8Yu+cbvLJrNTZlfAxBqeiKdLu0TodAJZVIRu7EKEoFH1L9s25k8mtr3nWGythyix7tz2Dwhm0YEDztbLqu9YnsaOmsMnIYrYSYrE1EnDJSVFGgbqNGB8JswNVCFhENTYGXUEkOYcTYd0z+DjHQjQv/PL5IMslQfhhvggibmVRr+obm78SZAzNnArNVskiNLKqwq7fxVbaqvItKsZivZ1YIpZ093t9lu7jW3283t1vbu9u5Be/vN2zfN7f2D7d3tg93WbnN7Z699sLf/5u1+s91qtcpMrC4k+I31YHekvU9m0exTPqTsSVHhiDyqAwVPa8Ob6Lg6StjKMJN7lYDoo6X50QKKW9rHDPdwMqZso4E2BAGrmw17esCvVlWEOXMOgDFImnO/erKolbhvl1KwVPg3U0QCEYo8MzxoN32PJYp5mpIYgI/sb6+gm5odGMr9pjxDA8oScxy9ckj5UFqt4LvuuLmhHNpkIdqTOuBcMa7IIaqg376iS5IOmqapnHXj7Ocs1ln0k4HNsL806JDRTyhPfPaCMF/Q/FsMSfsln2HjEqaghDfm8PSuBZapITcwEqZoooEg986YkunU7YgP/J5ASatyjWOkiQnNQ+84084qV5pnrfu0QnNrok1r4wFyrmbkRFSeE2szfwsrb3L9ClvLdruzabDmjSdvdiNzweQk68XQasBD+hUlZocN5eYLTLjw2ZiztNmEBYPsYblySanm61G+AcvU5Wv9FfL0oKVFdDSLx6izm+RJyl5wjlvHPgWYUlx4CnVJmNRvyuJ5B70w6/oFcCjoy+VpXszn3hpe0cnd7qEJ7wr0Lzq52/8v+Odrk/wmiMki9MMCTsQrkw4nq/ogvdmOtvejVrR9uLe7MzcUNWF3VHA2JnP1oF9Ipqd5WpmpPPMzWjGHupZKJDLGihBFNqoCcGDugyJjoII8Alg4sESvLBSheRlTeEjZsIE+dxrBdXxHUj4ZQ8ETUXH0ulGiT/vuvieayTTSVy12wDWOqjziYE9ZDlJm7GSvNLUaC2o3ZRD31twFk5eXOGdp7tWdjMiYCJzW2MDvxM1RMu2CE/OKDgACiDxQqbfvzHGhCWLaVk3TqcUfla7JnCAAKChNB78bJ2BtBCecSK39y5J6i3cHe63WoCCMWqzaiv6FvkIAtnG+JU4Hszs95uOJoDIw/fnAgF0wnhCbfVFgOdcrfsuA4QCBm4TICsHar5SaD4bEWASuMb7V97pCEy4l7RuQPG+n5KEoba/ojTwmStDY2C4A8DRjvRQhI7ThBAHjOEuxAHr9kGRMFSS05gaj/9s5VzaxmhpsC0bMlS0Jyb9gT1KBDIhl84LY8/MfpHCbimnjzGKFbvT3rGelHS34p5Y+mNm4Ioia7Lwhe6Q/IC1M9uPdgzfbSZ8cDFrtN7u4vb/zpt9/u737ZrBf2I815SwUYhJus5nM9ydvLVLK2LS71J9MsPMBGMTuF5ym/N4sv+9zH2xmr/RAqiIDLAEfDweUiaKXbCwFV4/g9Cy8d+YnhPkwf3hDWNMFS+DgJMVS0dgibxROkXOYw8i5eWjMpPJJ2igICr8jWMmqQUxo1Sph6Lw58SiG/qN6IW9y196gyAz0wTBvM0Hf0orgfMhH0x634ibiCak1H83tJuy3BEw5o2eCnaDuudFF4QUZXtvMps/7v8ExDUouQ1xPSK8CQ9vAmzSCRXCse7WYp5P1XbdVP6i9TjxlDuLGjTbfXppRyQEJ5R01Q4D+rFnzoP6uuFHtHow0CXp6WWEg6UuPbW7mYQWA8rZ2O7ziAXN+tsbMqx4XjkgLABKCjueRDA4nmrJhRuXIr1p+KOFI6/sCZZPCVW/vOS41qSgMOFk8RysXBlYw5C14lVC2rSp3Ta5g3O55jZpGK3gZW6bGmJmiLUkqzAQ3X7Nl/9Muamjrya19jtX6HFasa9fjR3Y93CKvPZDniGvto6x9lJfgo8y/Y9dezNqLWdiLecY2W/s5az9n7ees1s+Z//jJAFx3pSWCBrEZwfgz0pgbmNPSWBM+OjwTO/ykZ99G8MWZtl7GLq94Ay7YK95SKDxCGk6CSU7cIp8OzCBc+DGwIDPUzZ7yRxT8vbPgbgq6++Yruv2ZC1aJ37OaNful2GrOLZl7t5/Dm7aaXnGUcn6LsL4aDR4oUebZdObFPuhu5++Qsrx2ou1o7jaLf92p8+kt5rV4HQFZbQTEinUdAfmRIyBukdcRkOeIax0BWUdAvpMIiN2x6wjIOgJSZwTEbbN1BGQdAVlHQL55BMQevxcdAbE0riMg30sExC7YOgLyNTmtd/RL2NFPIm7/TfarD8rlESJXbpT/5olqI/MpV6jj2hqVoJ6RJKbOZBCgdTuo4y0DuBIUeMhC06QAe1lvDFyEg25YvJYQwB1AL62ZEIKhGKoKRITQ2HkPv5CpAF/6K8jSIZ6NhZfOeXoMQDmYJeZM0gRQHbTMtGuRUkbCZsAGJdiO2nfQy1BTzIp8y8cmdGIoCtPt9RAp/DSodTOhJz+2izlYyAuHJGmwr11Vk3dYoIzONJOr/pyTgufSwMZ5cX9fONJW7msc6TWO9BpH+i/FkTYn0bVqz5XgCwSTNqSuwaRXL/I1mPQaTHoNJr0Gk16DSa/BpNdg0msw6e8TTNrYhy8ETBqIWYNJvxgwabs7vgKirLUyRF7y6497fOVKIOWgtxtSAkNskQ1fPLD0o+KIlpTHCwSWnt/F/Ybo0lY/oJeELm0EtUaXXqNLr9Gl1+jSa3TpNbr0Gl16jS69Rpdeo0uv0aXX6NJrdOk1uvTfBl1ajQTBRso22+sq/83j2V4b702Wjj6mKZaSDqauAAaK0FIi9I9xzEXiDCs7F1L4gTM+nl5bCq+9UaQZ/nh6dXmCOldX/+fon9cPnRM0EHhMtE0VXbNSQpjWBprfAiX5wJYOk9/kvRwqbAjAxcROj7sNdP7z+19trZ7La8co5uOx1tKW5CgfGuLLwFCkcKxoHP0UEjYmmEEjf5cIp2wswhrFrtU+4oN8TOXHtIRdb9DxBMfqeuN1VJiRxCNQCE9Pmo9sUnBuKYMoB9i4OB55fOj+1D1TKZN/aOZpwLrFMR9PUirhzSYfcshx6skkLIEXRpQQprWn9tNMwqEmfeN/oSVTtvKEgnnOo0EGrzx1xhPQMsjfoyhLtEvNhUS8/28SK2nnc6Fjm12IWVIw/gMgaYhcuyEpZ1tBBsS8/mHAY+RJmoNbQ3MVt5/gL0H64yNcV3C7HNXR3ymZaxkhvfTsr+USvBaWjLPE4rrs3Y28bvy6p9Va746whIsmI5kS8GbtKLjuCQCjv+5lEv4n0IJaD55zRrbO+P3WR5LQbLz1gQ5H1z0Z4zRP9aQMdSaQEfmAOu5q716d/oa2o3Z4wwXj/mII8tncOUUIBvfNEEz+FZYozqTiY5dvfM1OHiagzcNR7+z7uiCH1wyhnyCzoOsq+9yvGDE/nfF784PhzfysGdyYXXnzgeVWPVihmpb92KVhmKvWFkiYJGct4CSL3dtiftnOsnp6gR4i+H+QtG8aJkD+SUrviHBqtMOGKRHo5J9LqlIIXdSMUBGgKHgzzVNgn0cLIRT0ioq824XfX3xg/XjK2euS4CYjKkf/bzb+v7BgBjQlEYYXWjKPB5/kBW5F5/MMnje00Q2BdJoaLA43dGUROOMKLEr9aXO/SHRLyAQpgeNbs6v012Hc6CvG+HycKlu4XB9MiXNJQAT5fIEAOv6X9qkgz6rpT5GPYKAPRJDNTQnlm6xJHkY4kxCaceaX0UOBOaXtJ0EQsTrL5KW7pJ9DhEU8onekYdAKYF0aecyogQiLxXSiSJIH/ckDiTNFGmhEk4SwBhIEJ+a/9TXXsHZBA90LqioKYzb/teE+qx0s8+mvOlZzrWXME9KTdMiwNtOjhA6JVD2cDrmgajSua4HhPRRLsPT8ZL7ES9NjQuiCxxAm9aKcCciHBbWeCyjx1T4T7B4qkc2m8E84UMeQB1CmQUm1/qQA8A0b08+BV4xscnLL6yRHeHtv7nLX+VfF4Ih8JQjS5zwlmFWJ+535U+gEUqjEcjNoIdmElrKHr92hGraa/omyYY1YLXqTBWGqeXdX0KqogA0S4NlADfQAx5oRk3yDJB+oe603jPkWBWMSB2IgkSApubOucWei9+JPn7pQMlf1/jKO9JwkepjE0UTwh2kNq6CwyuqEm6L6x9kopi/Bi4mwFVsEGVKqV2OQmTeslA+HkI2uVSwfCjwZ0RgRIbTz6LM5w1HvcEqTMLuWC+3gS+XmQ2cE3xGUsaCObuDytOCr+VdcPnk+vh9W39MZi0ckvq3CpTi5vPx02ftyfnX5pXt1cty7/PTpqobVzMDPriuvsmuGL9SIQKarUZslS5zGgutzgY64mHCBn9VQZ26mFcHjmrWInmKVqgTG48LqClsW6xSIxb8Kep75QZ+pQU4+f/jt97cf33Z+qUHq+g5VeDxPxuBj9u6xPqCYJcbkvfeVmG5LmbtJn6whYcRkOkJ9Jtzn5Ztqu7Xdbrb0/121tw/brcOd1u813FygC+Yy9J+4kze7igvn/gX6qELHoHhUzHf5RSsm05cu//pj33POuYmdQuCqYYQ+orkRVMhEcb3qco2obSTOU4tRgm1nQQTqzlhPRpmWTdearAfQzUuuQLVZZJ6Ah1ThtGggaY8aMqLwEFMWFK5BXTRl2vWAQGwBravydsGFZfrK1bE6EWpvejkH9b22qGEcfY+DRzmvTwrFv2aLFr6/Es70SCt2vHOv04JCYFNiA/AA1a64A/OzcWEYxu4I455mE00BuhnrqW4sJhjV55JIdANcBDAS+hsQVfs36Bv3KAeOiv5oA0nKYj8cJCmwnG6fj6cltAopJySA0lh9QMyiUJhmoUEacchGyeNK8CoYc957Xbz5LJAce8r5pvYtIUKnvq7d5nkaTSTCqJapzmxY/zavTCrJZWvEx2QLp/l6LSUfTUTPTL6siCqP3zEU99ra0ydkdJUXX1FprilnD+X2PUO/UpbwezlTC2KiBjl2gK/XMNeh1lmh5GOeVpWwLfmeAPIk6SCCMJIi4ELWtO0+4ngEUB3BVO40nZy9rz5RD2/3m/tzowZ9hcn+VJEeF8ny2+YRFt9NteNI/sjgNYIPHufrjCqVEnTCEopX4YRo9uJJ1ivjJq2MuaOLLwXopEd5O2WKpKviyV7OvaSEwvasK/XkQQkM8VNQVh5B1ReJ6Bk3pb9YI3RqiLHhu9znUWAg9TOaKoO0N57Q1GrM2OEP9wka4FtjqY5xCo4UsMKFXPLZw4uGPEy4mOvZf5BipQirfvk/s4FnMxxJEEkJwDCbODaY5tMJWRnZI4ITIiLcp71KVKmV7dcZYCm9XTtBzOydMZHhHXuAY4Jedd6dvl4xl5B5UxN/H2AKk9zz2JlcERtBy/uVGyPailVYn8WABzvvarkgTIlpWO+/sgI7uxT5BDWvh8lsqfn8bG60HtobBj3awZs4fuRKLPiQI9nDfVoTJ18/9m69zijLHtCn7orXq8Zb2W69py7mFTHxzbX1apUAHa/0ujTD1X9dSltbPAfdj2f3bXYYwvCIHaa8MZshZ8pxcDxCdrLyRip4LrdkaiNvufdin+ssapokPmEwBHbrT5HM+k2Th+OHhIJARrAaoZuQ4+inm5VpGT9mPKLbi2n/Qtpe0TAe0ab8I7PB8j7u05SqaY6oXkC3jMPC45WxpW8ePpknYPAszrojzBhnyA6PYpzGWWrrDXx5dU08DVI8rMt20ju7azc7nGiYbOUs1FiYUuIgzDtcGQMOhKfHBwNJ6mpWU+LFzFYfN5L+Oc+6PAdbr8SDxy/Sk62clRotihInBqR4xQzcUaEynPbmz997lkVeYsLOVyx1rIGfxbfWAsysdmsNly8jmN/QgMn+akPDcLxaQ8OO+Qy7bdFDaoXqZOm34qpZqVnXzLCxUm0zwoIkvZT2BRa0tgRR5xOY6ZCfrlhDlPO6KvYUSQc1Fp+64ZGcjvs8NfWn+giv1MHU1NYZxYA3Yj9LA5GHOM0Sl6yQEgw/J7y6p5XPAoHHH8g0KsBv+IEleuUSuBUW0fDP1w14EfJjelQE6APg9Zl96k/Qq43hnxsNePfZMCNsVPQdnAQ3xOJCH3Bxu+Kq/plrQO+TW3gCznPuTH/HGbhDHLzK++PhhfaJmZBMI+jR6If2aU/wlD87UWl0P6idBXXsy7pBA9fLipXeEHaNYBpXlw5DWvgW80CXz+cH1t8w1SeQIlvk4/zqfbdhNAG8jOOUD3kGr/OYoU4KaFuKmIBoVwmCx+hV57j72qXHELfkflTT5MB81JR/uyfKf2dSaW8tJQn638edq06EfueMRKd50phpcDeGV8tCY6D+1L1YQuYApO3btAGJEn7PUo4dsHGxFAx1GOocd+HB3OGB5VK37+ZcjA/RzdHh9QSr0bXi15pmcF78WTqUfEx6fpPeGAnczPzWj2zf582DamguuCQXdJN/K0I35Qldeyk/ZPBNfV5CKkpfmv1w/gHImct3R5am0Fe0YT+of75pmMSK8KkXNl6AzBAc/3ARA5d9cU0wrC1T8ULQMRZTi+hweoxe/Xx6/PrJ9InNdqvVXoUlltfS1s1XmHtaydOqkh705RuNk72auPp4vAf3+yqudBhHjnC7Jlq7HzrtlRObV0rUQO723v7KCd5rzxPIXJDgvfb2igmWCSF1Hclu9/jk5GJlBFNWwiZeXUEhK0HZ56lFuaXpehzMKsjtvf2dtzurUJFjOiZ1Zot8PP14Yl6lXBpZmHFu8W8DxYm4cKYMHxTCbAhBfTEaKTWRh1tb9/f3EcUMR1wMt7CUdGi6T2+NSUJxE15fwp+jh5Eap/867fiWANoYGdCY4tS81fxXw2Z5ubSQCP2q7f6xSRHFDOxBYIZKV3jTt4BufswxlyrvMRWy7jBxVrFs9W3NjwASPijsRx4rnObbtRo1dbO1v9tayZ5cMm+2Im3W57tqp40npk3rCkj9RpBTdh1CjzVwZbWL40qXfNJoaXmcW7oa15Hfs9rS58BVhwk2wbsTlVgKq7KotPm9OkZWjyrx3vkLYQJ3Y2Y3+LBERcZuIfSQBDmtz8vY3VrtBpqQb5FsenTxpZhoahpven++Otl0JZmmEyjhn2BWV/72qUfmNtOUnJCGa8QLSfo2Q7FpyiNmWK6rKG5CvgFqhRfETIeTBeRwgcM2MEvxrf+35tQsz/hdMWvmmUzvRzvRwX6rFbXf7Lb3VsM9HU/qxEPsmCi05dfm9ADgIbo4MacadRiyVKBmE4Ci4WMooAvpv8x0ch1QNiRiIihTpn4KoI/utKIcKCKQIEaYtp2j66QZ84Q0gc9c3wrMpK8vlqZ/N4/jTAiSNCzmmGnLamp0rKUpsA9hAvUGC6JYvSWsWYpVwSAeUEHIFDTPVj/lwy0DVtLUdpvWg1vbrfbuVqu9BfE8yoZNm5bcNMJpWviDSNvKFb0a4v23rZ14lxxsb7f1D0mM9w72dzBOdvaTZLCavePSDHtwhGo0sfz5WUZzdi86p+dX0clvJ6vh3hba1s2ynWYZ1jf8rQFAhDZaDD9/mhCDCoW6BhZkBbJ5/pN6xQuQHkTrCfAsC5H5oGrH2EUmOqsHhSK2Df3PCkDq9v7O21XYC8Yy6b10c/TKGFBgkGorSk7HKWW3K3lurjEOAYsPzvgrs8sTKqB7gKW/jMakP7YCnrLaIutXDn789Bi9+gJBdYEkiTNB1TTEBXjVnYm4G+eqvrj7w17rIML2KYveGR1e+6u7hYEI5rXJuK+6nfPXkXGoIdDjAZmqIDNwpkYcRAiwxkFFNGyffqbyBzOHjJw3oSKygY7PuyjkGKFXtsFaEmORSPuUVwAMyzOF8+X4KbKd2KOYr2xZqJQZEZFhoc7L1a2LhYiFe+bV0TlsRE0EAKYE0vVyLwnCtsOHCDqg66GOlJnALCaoa5piH83d5nNu+UCzu9plY7rrvTp6DQaknGX9S3fFfAXQVCSpc/mPw4ns6h8vsvpH//jSbaBP/3C74JTFDfTpyz+gcUqOlddAR+f/eGKn+LNY146BdpU5pE9dW8ZN43Tb2euSRao3ldZKv1Byv2ImQzzWmhkNp5Lo1aclFMcpi2uUA057GaN1GexV4sAp0jNqqXxZQCwzJ2fFopEKQ3l1D/yI+iBq/b2v59Omj5vP3/pXDdQFG++idEaOcEoHXDC6CqAG4J5x1YMAwRzsPvZwcUXH4NGbyMAsbg6ViHFwbyDcwCRNoPEUgM6UFnm7td1qtt402/uotXPY3jvcOfj/Wq3D1tyNWudhuE8GfK7Y7cIcD6iQah5u2wfN1lvgtn242zrc3lstt6YnUu+WTGuHoeyUkCcdzlTYwOmWlA/2ZXdll1rAb5yJu7oOsfZhYPwgR5Ygkqb6A7H9U85xAHMJqBv+UqcyAOx0j68l+TAq1WRvu12DkMjDhDPy3JLiGWwCM4Rf9oTAY83MonsMtzkY3t/b23njFoQl5GGmMQiPeya4ONswZHWCWTJqA00J6Z8+RBXsBTnBsYnlUFX2nrZbu29XxY4kguK0Nzdi/xINbMxUDosfrlR/LKpvd2j2BApSKsLiaQ7w65qrm4xI2DGTEWYZtGtuIBrWU5gwtUvH5eDkptrw0p6qx6TxQ8cjDDgaoiz4vb33794dHL05Pnn3vnXwtnVw3N4+OuqsTDN59LPaFfFpsS9VARXUQ7AFGulXYh4HxkTLTIa98IxJMuAZA+Tmnzk6w2yIjgAw1FYKTCPUJcSH84dUjbI+RPKHPMVsuDXkW/2U97eGvB21d7ekiLcM4uiWFgz8VzTk/3G2s/Omebazt1NuewQpaM0VXhM26PLXhBOkjyc4MmYZNpUY0TDlfZx6m5eRVTzxzfD/V4QL6osWOL5eQrighMhrA336cD4aL+he/SO38Rvo7B9dzNB7gVlMZcyDeEJDe4cRRA++2W55MaGCglBWzeVfHSt4TCkUFr5Opl9AYGBGBitj82/q5Ns8i3otwQACRU9qTbPSNt5ZlikhVU8SMs/ZfNR9NyVRs3DJlCntwg1NMx5brQWOvOnCRwAseaSK3YK1V+PJm+V2E+IZ7XaztXfVfnO4vXe4+yZqtZZGUh4SHsVUTesCJz9y+recOMCZEgQviZAH9HOmqPYce3EpLXglTFzd86aFwYxLeet+9k1Zzej5ktZmkcWa1um8AB5vJyuzwoUaoQ44UEsqFcMV2HM9KnldS3dkTcbT7idYu7K5tJLVMXzUdYYsD5W76wgzvCzcruYB0FxLlkqJ/iHhvRA7rnhbcTakKkuMPkyxgn+Uddl/o42Us41D1HyzE+23d9/utBpoI8Vq4xDt7kV7rb2D9lv0PytQbXUW936RRDQd1MJM6iVGTp4NBxBt+nryARoKzLIUi7D9hRqRKYqx9qf7PAtLZo9chEKPESQrUWHqXmPCFBHSNNUfpJwLGzxp+PhH4rqa+UENeWkOzmJ8iQaKvblcLAPPC1lNdI4yaKQ4hgLmIeGO23J6UJ9LxVkzWfIFSi/mhEuF07o0xeYFDG80/Gx5Niyg47EA+Q/d8vIMcptZ7QGRx3jqoVBvGb9n0C0NaVZgIi7Q76cXoTeLkE2WsH2z7mlC0qkpM3YOMHS/hh/LAj/Ybe0uGfbXwhZkqC29GlXzJczwlGZufl4S1zlgpCbdbJmoVM2fM9InK9j32rD8k7NaLBvXTlqP7+7/XHW5xsunnfNO8LlKbq1VsNURQ7CM8Na7jDAuex0qAqyohaRA5ymZ9B96duvCPH2p2LcQ8YE/lE/0LWxH29FOtGQOZIq/qTsCEBwvzhsZY3FL2TBSaV01shtXAg8GNEZnmmV0IbjiMU8hUqqteUuBjNClC2WbR9e8K2rYLhX9hH79cHp1Yjqf/nx5cnJufux8fHdyaX68PDkutUP9dUTVkq9Nroyvh+eJsKxqy7hZw85hXh7+7vv2O0dv5DnE8Ngz29e1BKCe+B7RC2iJ3d0lIxY2L72usMtV0b7M2d+ULiW+vJyp+LOXiXSEsyU1vCDQz7G2QNqlGx99uTxDKWW3UDrIQ7ycqmZ4T25m91Rly9SDnKIt/6WtVqvV3t5Z8nbQRozU5h6A8odl4St3az45kGuYBZqfKsKMGdzHkuzvIsJinmg9nVvC77nwcDmOWMRNFQRnMjclukSB1XzyACrnkgw/Z0RM7e8axf5XMYdzxlni2/dYBCKtoOBh+Cad9PTvbvLEAD6xC9rPlNu6QWNRE8gRJOZ3RDiUWmhglqPr+bZQWqVdnvzce3d63rn8T8O5vxAqgGk+v8s6R63OL5/fXXU6nQ782/znH6vcAQYk8WvNR13GdeU6H7mScK3v9SrrA2HGddDNXmYXXh7GYDLYhlXfhOWxS+VJht0hKRumuZNjP+/3iklMf6Vl3f29ATI/+e2ic37c6/7+2gJG5QuU00BVXswGkGIwrp3StiCRJoQOE8I+1qN//HJ2dQpzwdhuOOju5Ee8w4ICKH9K2FCNzLA2gwB4zTe2HvP410+Xx2Zfn/zc+6z/VSA92ITBHvMeZkJiOi4BDaBXJBqim432xk0FEtrmvzaODq+FwteCJD2lJtd9yq7HUzyZROSBLN2PuLjvyhUqq2lhqTBLsEiK28HgZFpd47G3ZgVgdsyKmBzR2VSvlfDX6fcFuTPxBLhjXf21nq90rXz459nHFfFzS6Y1sPOB3pGmIKkpMoFSKD4AmNJyysWn91e/di5PrvNaOXdNnF9dH2VCEKbsi8/16RgPiSlVOoHm3Hr3f4JJ5fU9ZZpQvalXJJxyqdlKpPM+BzjLQQpMc3JI4WP2+qha9uul5eVVTIXcro9JPxsOl0VM8wIM2ajrMcIkb1krpLS9VsOQjDFjRPSkwnMB/z7mRUCgXhPe+WXr5PjSNpJ12L0ZNPwfZGk6RQlRpo39GKc0pjyTYb0dtEP+cnlW9iGW5NO6+cvweG48IL06dAydUkMTGQBmeF8ScUcSrbKTLLboTuBTQY/SqqyV7SVDljWWTm5c2aYiBYfQ39HGDD2aaql8As7BYKAMda9Of0PbUSsKIwblsMKhCRPgTHHGxzyTTeNP2F8LRQc4VuZfHt6mFIZI+BhT1tQyMh+F8romThJh/q33l/mJTu52gz/Qyd2+/efMmGMcB58bZ4o8mB+1L2x/Mi2WzT9cs2Tzr0yk1wyFQ/4E9ZpNHEPw2nzq3mi3plMqzVsyNX952GsdNIPEp1IQxfOx3NbJRBoZ6dXlUh3D6E6jZSLNrcYNKEbxZY4bgdF9ypDkY4JiLCEsoR3XMZ4icJUtXurphb5Ptrgw4QmzPdJpjomEUYE15EBymDm4ptEioJfmMVADyTDkzo6/MUPcmCeNkELDkCbMVoJqOlOqiMApOr242/djEhan3Ka43/zrxmCI/tcNenV6cvUeXb4/8oNuv9nZfm1oCj+YZ9w6N8A9q3joYAsc58jNw1JAdslyLkp++T1UO4Sz7zyeS9tXNPvJczxa361KaDco6IwMG3Ae/Oer8uiuwackCtEBospg/MqG3syMK0TuiJjqKQyS8Mz3ZwZ3006IoDxB40yaLsV9h/ZFEuNyEZezlpsE8OE+QRsTNtzIk74BODrSv/t7AFnrnTcQGEDj69p4FwbwOVBgFgsGjtt/3ATqTPHJxswi3/zHjandUmiCRY52aIletucACCBL0zmYn4mHrB4c4nRg4I2/XJ6ZTgwGVQYzpXXplGdC34C51p0GGwfgu/MIAWXoxrF2A0hlALOjCr12BYk5k0pkYEtCemDYFgJge3I2TBz/0ShmUR8e7u7ubBnQnP/7xz/s782//0PxyfJr5tTTS1i3zS/MP2x4tQnbXCJJ4D0kl6eXY4V6oQwxou65uEVjzqjigrKh0VreKnb3eJ9o9Wi3i4XexDLcABg8BpTyoc3v0F/VGnigCDPg5qEZap4psBoVDmC4X8bEbkX/NT8slq6nqyO0Aeg3KTGJp4yrsvZaaOvo0R758/K7aoKlDBTcylGv7fBOidmrdcn8YkP4XLg3T4V0L2wgJiAs0MxW5BuroPXZj21fb+6j75hHid/dLRfzLP2epjn5IyO1Va2AvQYT2APo87CASfMXG5WuYtyfYb16MweldMf+X7hjjVEXNiUIZ4n0/YSL5jrj+rugXUQefjCl1gHtkbX1hamjg/n6mfKfagSTGWaNWehHNG0oGCLjicrpAdLNJ2/st2fQ6BI6gIc7BclVfaLuSdCFWk+q7rlxkVZhSBgvlAiS9Op1Ca8g+DscEdDhblK4c8zEDRDSZEK8rpFZ3/xp5tmtYBsHY5kPQ6h5Y8B5+Dy5AYBe4S9mrwxjY9vFSIgiYgx5jhNBYipJOnWNRVIqFUrpbaFOV2aDAX3wI8JnXunL4nBry3zEfCLiYvg6Qldi6l5jJxPBH+jYFHtTCS2s6HiSTpHCt8UMFWt+6/VPcZ+k0rzeaDsTLuB7kqbA/dXZscz1YMyj7LYCx2xliBB6H8l4ROrLPO3C6I+reriWZ/0f88J/c1hpjBt6H7ngVyAOt3XrPE5+EtegxiTimoDrHxlOjX1nPwOun3Uyg8TYNHUiMQU25CEmE2MljbhtL2k6qM0cN6svIojbYBAuLTR+maUAinaomcsoT/i77c/ss4XBVdJGIswcY8Z4buAWzmYjkEAejpllqE9Sfl+tKqr1SlH3hLI1sSssVTSe2hHM4TLaBUvljQ0fK7KjFHxu4FXaci2v/dxmlll/W2+gdkGBNQqKICfPXD7Wq3LwZPkYGyYypu8tJTBN8+BDhULAcsnKR731FZ/0gMFvcKGQwcCmJGkz22whK5dX5Ors+HXDBM18InK+IrmTCIq54boJgYoNNUVwfCpCM7Pz5jG4/JN6/WCHfN/3Dtw5j105+UrMd/nA75ffbA4svqZN9sUOv1rvZ42W+NLQEtdAiXOK5ofASFzDI9YAj/ijIyOuQRHXeIhflcrfCiXh74SC+LcBQPwbYR+uYQ+fJ5814mFZJt8v2OEa5/Bl4ByuIQ5fBsTh3xjd8AcENlxjGta5R16Mf79COMO/G5Lhjw9i+PfFL/wxoAshjZEkEVZ8TOO67SHz+mfmCgpfjIkJqY6WHo8lRdgdFZyNw4xTwhIo1YYEQpsXCTmUJZH0cdL0b8gLhaCcfODD30Y85rHZXmclWVVJKZCMl9YzpARInNDfX47w9t7+MnKqrS/bjJB6NClBSmg/NLO8a8p8U+9qrgcHO5iQvebbfdxu7uL9drPfIvvNVpzEO+2dpNXuL9S11UtC+8nfShh6rmXlQVPSJ1g130atqNXcbm23o9ZetL3TbLVarfZCcQ4nixrr6GZEoWxVHcxsDkuMTSDEZ1orKPAxdAa58b5gfkjvIDnbinFWUP4PPZMDLrL5WxoOBB4TfRRrkkZY42qLI/2UeS/eTJjmulgR7YP+aXB64hRLSQfFWhSFY0Vjg+ND4pEJM/iHegv0ZGaKtJVop7Jj0bgI5WMzMHwv1L6rWQWsB6hPlA1E9H1gcCEUomxIpIKyULjQBVGCO5CcsHAGD4eGPVjkcmTh4+nV5QnqXF39n6N/FtZkKHg2iXBKcV3pARtXWpPrCV4R6T0VmBcCBBgKn/gAQfU71JwpkcGd70pbw4JH2NXY9DSOb40YceGh2xYW+LaV+m/a+ptG1+zXEdQocRUOKcgfGYX+yVOewTJlkiBcEBq0oDVEe16iUvnk5r/Qxkc8JDEWCv0Mn97fQPPjRZjVqO0agaXI745nrQEIPpTa8muQCz4cd641oElZ+D+3Wj5OO6eka/TUN2bBn77jHQ+ZaSV5vz89n9taMuKuG5EKZC4KsFTPEH5hc4e7fnHh50NWbPr5ZF9gp7wILu6JlcLxbTSmShDtbG/Bt+UWHIqteZcpd/CwjOZ26x57e7BxeRuQxymAYJprCqx7X51vbz9TvJD/2gvP/NkjxXW656+1P/9HRtKgK7FEBMcjb+5zsy6mOzkpm3ztvfb+wUKCCSMLK1Yhq6/D+xQQW40y+jPnw5Sgs7O5kWFzacScDfQC1HekcxP3uqdG5LpnEECajGRKALawo+C6Z/rDX/fgFcF8PDjT55yRrTN+v/WRJDQbb32gw9F1T8Y4JchhTlOGOpMJYQl9QB2nPCzoRDu3JgvwE78Ygtz7YUARgsGlL01MElNSGGdS8bHxdWV0zU4eJgBdEo5awMdE6CeAjO6aOUjifsUsMMQZvzc/GN7Mz5rBksIwH3j+SgerUtNSHxdquZwvgwH70eGe5C961uyeZe/0Aj1E8P+KuCkJSekdEc4L7LBhSgQ6+efcEcBcEpA2Gtm00fodu0chdgvpq0X8TL+P+OAp/MzJiMrR/1sgT2mmkYkpqiXzmK2PJa1snmHr6ORQAnoB3dCVpcH6OsVpCp+2DhS6JWRiLmuze6DcV487fy3bLHdKCdrPasSH7ABQHh8YtvP5AqY7/pc2zJujmfenaJJiNeBiHKEPRJDNTb1rGGdN8jDCmYRM7NSGjY1eCdxca98Qq4OMPeMqnA8RFrEB2or5eAKJ0kkjx8xpIMLgNZUk+eM5AeAs0kAjmiSENZAgODH/ra+qhr3PGwBBVVEhtfmvDffZjQbaMJ9+BurezPrFPCE9//QcJVT71LU/g5tyRCwh8IRLOUiaHpOQYdB+Hi/aCN4W8wd0aVA3LBYIlfAXkuQFQIAk5Ye0OlNqexU+KSCN3tZ9+IJQZGQTvM6X1uZ5QdKnV6JQz/oY2Fmf85RgViXid+ZPAN1vIEIQHWgD36cZUOngG8rJ/UpkZEVbSv9E2bBXm+O+aRx3n1gx7y6i0n/UfttsBDyZpK4sboxZNsCxQVgB78ZhTlmwtSgYk4RAGSm5s9gHnYnecz996gKeS1UtzjjSc5LoYRJHE8EfpiuSvMIqq08vPw1kPPPeDqRUr8AgMzVMKR8OIYkYElCGAk9GNEZECC5kHoINR4XUzLCtCRdI+6vKzYfOCL4jKGM5+Cn1MH/w1fwr5SwBP6y+azMWj4h2cssLeHJ5+emy9+X86vJL9+rkuHf56dPVilbQPJ/W1Uyia7MgWKEZU+LUYMk6dhCR6IiLCRdhBHdJRhXB45o1hJ5ilWoCxuPC6gFT++uVw0RwbZFHuVbwgz5TO5x8/vDb728/vu38siJJ67tP4fE8DQ8es0ePywD+ha1j7hd9alw2cWIAr+EersTobzdb+v+u2tuH7dbhzjPw+b/Crj7bcxnfT9ylm13FhXO9Av1SlVkUj4qVzb9oRYOV860e0zXme84ZBiAc+H1iqvEKKOWFmmOo9CvAO2t7hvPUwoBi+xyCQH0ZS8cox7JpucJbH/TrklKvNmGgKJgOqcJp0ZjRHizUu+MhpizAn9Pf6FOm3QEL6hysT+UNgQtL8xX1v5zYtPe6nHMIeLwwjr5/wZub1x8EDDCzFQvfX5gb/e0VO7q5x6e17ZgobPqQjTAbPuL6mr95CH8YJnbw89o1zCbQg+RmrKfySP76zBGJboCLHM7RYrQjnPwbdIlrsAYOg3l9lJBiZIeDjGiW0+1RFbSEFpVsQu5obXHEYxjcIooZNWd7sISkl7yd+dsAzjLjPOS6+HHjG5CzfBNQiWycPUKnPk3donIYzSLCCJFpwtmw/mTeda0ki60RH5MtnOZr9GyZ6Il7ZsJlxVJ5tI6h1MO2FX1CLkUEN7henL2S29nMNd+YwYUynnme/u8z/M01pnVQKG2oBShr1AVi7SBDkg4iCMkoAi5bTdvrI45HlBEUTuVOysnZ++rT8vB2v7k/N552BWP9KWRQ1teQ591UO2q2hYXm51FezqhSKUEnLKF4UQdAsxRPsl6NCUBHF198pPzJtTlliszdF7iKD3t59oJ7bZHr7+RBQfVLYhTOhEtJ+ynJ0dX0jJvSX4IROjXE2JBX7mMYZMV+RlPI8tP2JE2t1ov15WSxjAfYPtuOcQqOC7DC8/KbRcRBHiZczAVsP0ixUoT531bm8prhSIJISiBnzcR4wSw2fWMWJ3VEcEJEhPu0ZxN2a9qLQaau24qdIM70zpin8B47wDFBrzrvTl+vgDNIt6qJpw8whcnoeuyMLUG63uF1GQjQNQhw8wO67bzLU06YEtOwa/HKUCutyPMJapA7NzGnes/D5kbrob0BcT8PgOt4eAay4hNcyB7u05qo//rRdetyRln2gD51V7AuNd6Udls9dVkuQfg316zLH2SDo72yK8wMV88VJs0D9jy0MhLEwmai+a7BmQWNgoc5howuMBBjOB4hO1l5kxS8gFsydU1o8hZ3BaRwSezQBrbcZZj0p0hm/abJ/fBDAvwhI1iN0E3IcfTTzVKawo8Tj+j2Ypq6kAZWND5HtCn/yGwwuO/6/iVUmrBAIEVLxyo2AVw9fDKPY/0sbrojzBhnyA6PYpzGWWoUoLdOV8nHIMVztQ1aRGPoXdu1GxlOKEy2ErJrzJktUR3mqy1FtOvK3+ODgSR1lR6W6DezrZaD5wNCuL0f9jh9mm43GUBHrIT8Gm/2EvV6qJUQfUeFynDamz/X61mWbolwO5/L6VopD4tvmwUYWH7bQB+Tb3Xhw2R/9YVvOF7+wrfjPMNmWvTQWUE6+fkttwrya9YXM6QvrTEAEqFnEElo/UirMB3y0wWQT1QG/C3DkiLpYITlPA00FmHFDY/kdNzn0NlqBEdyaaes7pZh7wvdt+br+vVDNMuaEfSAi9s6oU42O3o/3MJTY56HBQ+jWEoe07zRMw5efP3W94L6xEyIooEwEsS++rmhfYoMPBPPTlQa3Q9qZ0Ed+4Kb3uOpNP3ZlN4Edl1gGteZ2PZZB7B381iUz+cH1t8wFQOQHlnk4/zqfdd2v4YXWJzyIc+k7VzYscDLxAT/ukoQPEavOsfd1y7Fgrhl9qMCUdJ81NRHuecyaIYU4zQlCfrfx52rToR+54xEAcATlTkUcyaDcuL+1LeuV9ymY7tu0yjh9yzluNBUxZfpoA5DneMuPNJOJ9oIDfa8e6vlYnyIbo4OrydYja4Vv9Y0g7Pgz8+h5GPS85v0xkjgZua3fmT7JhyAMdgj5ZIm0E3+rQjdlCe8mW1IHnxTn5eQitKXZj+cfwDyq/LdkTdCNh/UP980zAN++OwIGw8ILDfUDxZx/ja/M6d/WFsm24WgYyymtvzt9Bi9+vn0+PWTz/Sb7VarvajVBPN8E17CHMRKPpZ5XAcIi3GyVxMnH4/34I5e9Fp2CBvtmujrfui0V0Jgnt1eA4nbe/srIXKvPU8wb0Ei99rbKyBSJoTUday63eOTk4uliKQsx4RbefGWHjvvB+hMQ3Ov5tafLXotKbPtvf2dtzuLqrMxHZM6Mw4+nn48Ma8oLrUozBg2MYNQySEunHnBB4UQFDJoj4WOhxQzDOXLWEo6BCwfuTUmCcVNeEUIf44eRmqc/uu0c94JDIQBjSlOzZvDf9kWkD7NIEK/avt7bFICMQMbDZjR5pR58erbxit+zDGXyqPDFli3TdUW3YPj+rbgR70Dw1WgDPFYQediuy1xGMjOd19rf7e18N5bMjeyIjXS5zRqJ8n2E11U3jU6K+czV7u1xTzoUO4uOoxYk+Nrk/hKy+Bcv8XdM37PakujAhcYJtgED0pU1pUvY83M2brzL6uqf+/s8DABtzGz6t7Fr8jELLjxSZC3+LxMzK3lN8qEfIuEwqOLL8VkQoXFkCjvG1cnFC6cTTiBcuYJZnXl3xp/FfAHYJqScd/IgZ6wctlpTZO6PsPmKouOJuQbVOp75oPfLsj7Bc5TiBbgVf9vzWk8ntm7YhbGMxndj3aig/1WK2q/2W3Pja1X5piOJzWGZTc7JhLr0PRMjgg0zkYXJ+aUog5DlgrUbELLRfgYCuhC+i8zfWcHlA2JmAjKlKlVAciWO63soPM9oIBPqH1y5wJxA9TGE9IEPnOdKTCTvh5TohG+I4jHcSYAV8c0vrg3/QqhTsJafAL78B5Qb2rhi5UywpqHWBUM0wEVhExBq2z1Uz7cMqAMTW1Lab22td1q72612lsQ66Js2LTppk0jnKYtBY+0zVqOybTi/betnXiXHGxvt/UPSYz3DvZ3ME529pNksPh+calnPTgqNZpA/pwsowm7F53T86vo5LeTxTm2hYl1s2mnWYbdDa/5AfDJRkzh508TYtBsUNfAHywojyXbR5jXDmggQZnx3goR6aBywtgtJiqpB4UioQ39z4rmjO39nbeL3u3Gcui9dBPxyhg4YCRqK0dOxylltws/j9bo08Mig2P7yuzghArAm7Q0l5Fj9McW5COrLXJ8NYLLRkDQ+AsEjUWOyxbURb/qzkSUjTOz2rjyugcnYn9VD86qpfi7N998UibfWdfNJ3h5Ca04vrN2m09I88X04Vioz+YTjP3V/Tdqb7A5J+8voBfHN+ms+YQ4ftDGHVUc/3C9NB9j8sdpolnF4Y/WPfMrPP5922Z+RTB/r36ZXxHG99Aos4qFdYfMb9ghs3IB1q0xv11rzMoF+MF7Yj7N8/fVDPMpXl6C6/39dMF8SpIvxu1eqP3lU5z91X73SvtezsvoC3Cyn9vw8inW/kYO83fZ4jJkREjVk4TMc9YedYVN6cosHCplSrtJQ9PowlbVgFPsW6NJOhyp0GoxlSuevFkONyEe0G43W3tX7TeH23uHu2+iVmshpNQh4VFM1bQuYOEjpzfLj9icKUHwAmhbQDNnimqPrBeX0kNXQvjVPW9aiLy4lKfsZ9+U1cydL2DpFdmqaT3OCwDPdrIy+VyoEeqAk7KAYjCcgC3Vo5LXtURH1lw77X6CNSqbLQuvgqG9rjNh6a7cOUeY4UXgNDXdgNxYshhKNA8J74WYVMXbhLMhVVli9FiKFfyjrIP+G22knG0couabnWi/vft2p9VAGylWG4dody/aa+0dtN+i/1lQJdVZMPlFEtF0ZeczqXmmWa6tPFS2VPWOpPpvQ4FZlmIRwsyrEZmiGGt/tM+zsAzxyHn1qtjgjgpTSxgTpl33hnlcTzkXNuDQ8DGDxHX68YO6jsceeMLY6w0Ue/O0WE6bFweayBVl0DhsDEWhQ8Idt+XUkz6XirNmssBLil7ACZcKp3Wd/M0LGN5o5tkyV1g0x1cBfhs6ReUZwzar1oOcjvHUwyHeMn7PoIMQ0qzARFyg308vQs8QIftYb3vM3NOEpFNTrumcScXdj2UhH+y2dhcIcWsBCzLUFleN6vUSZnhKuzY/L4DPGhBfk361hFeq188Z6ZMF97Q26v7krBZrY2QApJEe393PuSqSWTzSm/G0c94JPlfJob21tzpiCNYK3nqXEcZlr0NFgGszN+d0nvI0/6Fnt+fK02GKvbkQH/hD9kRvrna0He1EC+TIpfibmvwAQfAiLP4xFreUDSOV1lV3uHEl8GBAY3Sm2UQXgise8xQihNp6thTI6JpduriteRnMW/mFPf7QT+jXD6dXJ6Zd38+XJyfn5sfOx3cnl+bHy5PjUg8/+NICArL1Uz08TyhiVXvDzRq20PEy8BfVt9kiepfOwfpjb0RfP/YA7+AblS5w7Hd3F3DzbcJxXfGJ2U7GfuJN6XKdy8uWij97mUhHOFtANdfdKPiy0CM4pewWarZ4CABS1fHpyY3q3llsXW+QpLLlv7TVarXa2zsLqHVtWUhtawEqdlhHu3I/4pNDq4VZoEufIszYoH0syf4uIizmiVa2uRn6nguP+eGIRdyksXMm87u+SxSYrCcPoEIuyfBzRsTU/q5RbAQTczhDnCW+14WFUdEKB14vb9JJT//uJn+l5hO7iP1MuS0adMMz0Q9BYn5HhIOthO49ObSX75WiVdTlyc+9d6fnncv/NJx7pV6BrvH5XdY5anV++fzuqtPpdODf5j//WHbVDRLb1zrmuRTbyrU9cvW0WmfrldUb34zrcFq9nC68DIxFY8DUqr4JS2KXx5MMO0JSNkxzr8J+3u8Pk4n8Ssu3+3sD5Hzy20Xn/LjX/f21RbrJFyWngaq80gjwj2BcO6XF95cmdgwTwt7Vo3/8cnZ1CnPB2G44aH/iR7zDggJydkrYUI3MsPZpG3jNN7Me8/jXT5fHZi+f/Nz7rP9VID3YeMG+8i5dQmI6LlVmo1ckGqKbjfbGTQVs0+a/No4Or4XC14IkPaUm133KrsdTPJlE5IEs1CyzuNfK5Qar6cemMEuwSIpbwIDxWZ3igYJmmTa7ZAnGRnQ2j2glPHX6fUHujKMO96QratXzla6JD/88+7gED7dkWgMLH+gdaUJHfG2qQM0KHwDmYfnN/9P7q187lyfXefGSU/vnV9dHmRCEKfukcX06xkNi6ktOoCus3tmfYFJ5fU+ZJlRv2CUEUq4DWolE3udIS3lVt+mEC3lgzF4BVct7vbSMvMqokNX1Melnw+Ei0E1eaCHpdUXhTWaQtR5K22hxJmSMGSOiJxWeCyH0MWseotWa2M4vWyfHl7bLoQP5zKBz9CBL0ylKiDK9kcc4pTHlmQwLoKAn55fLs7ItvwBv1n9ehq9z433oVaBjaOkXmq3Ftui2rbpBLAB/BprpVaVKbC8Qz6uxZm3jyiL5Fxwwf4ca0/BoqiXxCbiFC50y182/Ff3/7L1tc9s48iD+fj4FylP1j71/iZbk51zNTTm2s+NbJ/HGzszezW/LhkhIwpoiGAK0o3l1X+O+3n2SK3QDIPggm7Kt2HEytZW1JLLR3Wg0uoF+8H3xurtuuu7TXIlETEUuu2jXm68zxUc0VPjJ1fGoufeRmFKedDVf8FHId+rSKMrws5Yj/Iun15veDzy93jYfKzCnNPSem+aKfcE/te9p/sI+n/jBduzET3kW/1dCfJB/g6S5Lg3hNBefukFt1bUKo3vFZvjLl63eXteLsKkdTjg6FheXPIsD5NiyXJtDgG41VJ7FhSW3ApkFLtdsxTOEjxMixZSRkEpw/bXTOKUzAm6qKb54fKr3hHWR4REAikQ8Kwq+UFIijdjKIKbdOnYQg1KIxcEh5rCPhbWtLxHEJZ7r+xgiQRoxk46n8Yy5YhmNyfHp9baDyZIwFibW+fLPSyxI+O9Lsnp8dP6WfHx74IAOdjYGa4iT/2ARnmlNc3u34GqPmipXFt3iuAfQrlmzZc7fT26WXve1aH7tOOzSR93gRUFL1/Il0+6I16oThK5N0djzOnTbrU4yRfiIcIVFQmVHC3AiFGHXLJvpIbAUaeX9CnA7bMoyLiIyzSW20Bza8kUsQteH2eCoYluHh4eMrKTJeKWICoZqs4H+7uVWv9XSNsooVI5elrCdYpVYT1GZIhmwrH6+9NSWEulKZWIvf77ERBxFUpoV5dgM0vcpMA5E53HcguDK+cPjZ9Yfj7AO6qePJ1hqHUtsmG7TM5FD5+tCo848AYE6v4VHzhNyaUm7hNJLUHNElRpEZiwUiVRZDjYgxKH5dd+hhklBBp59zz0dLOu615ubG+tYQeTXz7+Y7/Hzz0qk95snq3qew1y9+pS4CwCnEkGcJZEM7g0KHjreNagOnpCEqRuRXZGpSLgSGU/GqJGcNWv35SHTqs+IiKkBSKU/6RSsexKLsQlU0K9q7TpSLMHKx74piUf7VE2qndOdjEyZET/3mgNLpW1WaBHtYPdrhlGNiVB1zXQvcdHQ5vx8P0lKqZSe8nr08rgGvFVQZqu8R5AqItuqIMhtx6On5uDDQ8bTtIa1K/fFb+HLp7s7cOh9Yi7Cm5v1jI173S9p7D/nbGlpCmBbwQBmQbkAISAMfzEnuU3EujWpZ6ki+LW98VfYG9EA8yuQ+6MEeo+hZXM6Efpd0BZZ4fpjvquHe2BscWzYTmG8Ya7cUx1vMCQWTTgHEWvOJ4RNU1XgA6jjk5fm7Up5rYiP4FJLQQTQkKkb5rVLhcb8NwJdmPsaAOgNsoxFF8t1087h8HQ8YaCH7aCwb+DAHWBMmjKnO2Q+xJ8qV1Il29WDhQ/DUe3KSAj/um4FKhf5X1TVPtrAZgIiplg2haC7NGMhlyye2c4BMZeKxPyqlFAp89GIf3EQ4ZlVrfBfr6/jI/hEILLxWkDOs5m9nUzTTHzhU8y+5RJ6y/BpGs+IolflsAtjHus5j+mQxRJvObRNCJvoDYtjoP785FAWOi4UQX7VULDpQSn3WnZkOGHLC308A+jzVTdsp1WfBG+2L183GsuI75yN+Z4ssCK6zGXjBrGdJjD6Ew8yP+c0RlvMPAMumHH2vGjMOLZswEwL9iVkKVo0E2H6smELo8qyMnohgDMTCgzlpQ4OVQwge4PjWKgY4XfTeNSFqIL7og06GDmkSSIKY7S0BjseB4qjkCpBQxaLm2aV0Kw/yjrG5y2eG1GpgunMQMBFhFqESuUMBndOY6CUfF+gVZpcHaflrADLfDjQAtQvKapOacEX6OHGYrweW5+pgLGCp1J6T1IZ5XFxCNCw8Km8R0qbFncl0gsg6itsFmw0MmE22gxGsTG8WGXnJ4drHTykctGvxSwUjhso3Y5tBQLq09cI3pJpOBapjluceRVP6jkDqfi29xTYT+ZtJ8VMtNtY4Pv7CZitPL0kwfpkwD/cO/lRCu45lIL7UQXuFnZ8swXgftR+e6Taby+x7Nt3WvHtR7G3Jk68+LT1l17i7UVXd3vhhd1+1HS7myffazm3b7uS248ibk9XxO1H/banq9/2nZVueyFV234UbHtsWXg2vvIDa7V9D2XaXmaFtu+rONu3W5fNBuIHNOZ0Wcf/K9r8hQFWmXQa1g5tWvxLBnMHCV8Qrq2yHEi12R9+fgCE31HsnxZeobFJS+faJobPtdrRv2nhngX/lfwxgVBfoXyQGfucc+jVNhM53HnlkhFK3h2ffzwi++fn/9/BP6ANllcCx5HgkRvUsg9e/UlW/tXdH7NErZD2WZBuapbWnAjmhUe1CSlcVLh3hBwcqKwDiTlkyCb0movM5567bpmKiMXMmJY15vnMb+a4D7SB+Q5HHtUZfdbb2hoszN4l2hgr1TIF3xSL4Va5xuT96C1PooW5nMZUaWW1VB3jBvm6/PYztX73M7X2/zirpUrt/5XbvCf4k+wfmlorB6f4xwlPcpM+NaXhhzP88z1GGsMHH+SH0YiHjGxsb+FzZ5SaN2zvvuROibBi4MNtkgjH32YlZwa8l5JbdnkNkI+sVGNjMRnxeWPF5eFrsiRw8/cch2OJgvosWL+cKkXDq2DKVcagd70FsA46cn3h6Vlq1uTE3Ntr023BBetmwGfkQgsWDwpxxbzD/qj44VyIuLR6E9JyGTXOnKawPmF6kLaTobQlFH4dcwCGapqEgPzPWwkuWWkApVMKNyKr7EswX07xFbl+vt/r9QbrZK3OMfiliTHL3Mj9JHIrq62Z5POkJiAPZ1KdR+Wc/QqbvrKmzbP4OTHLB19nXFsoZb6ycALn4F9nadrRHrw6LaDF2Gnfkuvn/d7WXoP0wfdzOPS4a/RRcsNu0by3mvMLz8Mc62pp83AgplOaRHAZcoZUJGNsFp1mzF7H1+foiRREa37e4b8sjZ/t353DWJkPv5augMB0VBj+qA/Vvz6sh7G31+vPUx1Br9f65noOc5+hmpmvSRacoNtdtSVP0Km4YdnZhMXtrdbmGXoaJdOa1T5751n2S2b1Yu/fPh1uMmI8f1FcwXI7weu6cSby9DVBq7rShl0LvTuVVYJQ/Zb2wxKsFwp5gNLUoZBkJMJcEoGnrxY+IamtS8uVZPEI9iQOJdXg3iGeEXoteCQJT7oRSyHdkMYzyWUR6o4ofAm2ensGqn9JN+KxDdA2lfc1UT81MEVlps6Uv6Ith0KeTpZ2en+G+aLm4sCW2sAhURyjPHNfY0kun9U1dXlydnF0cPjb0cXHs/2LP47Pf7vYPzq76A92Lw7eHFzgVXrbhRrGnCUqqMfbP3qK9dG7ri1ZKRVNoi6NRVK+chWQOFoEkSButVioXOYgPNNcwR9dyKGVWNuWXNZJuggnUKxGwrVQEWjigEJKDia14h0CVZC5Um+pcnwcBK1vxuZhsiQW70MNSTEq8dob3FQUm9IrRvK0euHtmAEo3jYX95qDovaOnQWqTLhPEdqDFVkg4tEPg0S9AnjVkzH+XMFJWekQ+1f7k0iD54TKSTCNtpY0MQcljZWMtSnOITbOLvt3h1sk4mOGV5mHRx/d/JkLRsc9MWqzZCqBVpixJaCkiKbVnH/5WXsu+Kop0ArLrrrYKoBRm4ne253tg523g4OtrTdvD3cOd4923+y+3Xzz9s3b3sHeUetGBv6cyAntP9mknP223//mZ2XvaGNv43Bvo7+xu7u7ezjY3R1sbx8MDvf6W4P+5mH/sH9wcPRm0DruqjI7xVbzJPMz2NpuniHHw+vi7vzhM1RAxZl6nHWzvbvzdnt7e7+3tXn0tr+z39s9Grwd9LcHR/tvNg/eHPQOB9tbR/3Dnd2drTdHO5tv3m4c7PQHB/t7g8P9t61DvA2NmISwpElriK/yMgBt2XbAwH4C065xIypVUPRmqXbkUaQkfRRCkYN9SF06TkYZxWpJecbIOaPTDjk8+MVlyx4e/LJALocZ/D90Y1nbNyoBLDJUFPjHcSUUPI+0jT3BhPEZSVmmRU2L2NnZyXphdxMyoUkkJ/SqXv4p2mRbw/5utD3c2gp3+oOdwe7exmDQD/e2h3TQvleOYcdjZHkcUsXWIRPCs5GhQhsO0ibpw1+ZDfkRrwa9Qb/b0/87h7yI173eYr0bPHofnPWxKMHVJJC7iO3v7fQeg1goEpUtMx5zXxveIY1jrSwTcvb+2OhUxeJYmmAeyCTEDJmJkAq0ihL4jbdXWv0A4eNKsSkefeL9oXamiBIB+QMr/5Viza8pj+lQqwQXaO7gjpnmfMrRD76MmFZw2PnKFJVsThZbuIqk5TnqyqfUzzWNXGhix5Y7NfJ0hr+BKj4UYT51BeUfSRPLPMVmPxfoSy8ryMS5VWaYZtuh5MTjNxMWx6LJYZnjwQ+2ti/+fvBOe/Abu5vanykePDo4vO1RNy8r9/J/ftQFeLq6AP4UfO9FARp58Y1VBGig4TmkN3xj5QAauPhs8hvuVQuggaCnzm1YeiGAO2h+BrkOX6UKQAMbXmhyhE/pi8v/rxL3cpL/fcpeWub/HNq+37T/OQz5vnL+5zDhW0j491H/ke3/FbP9S4z/ker/9VL9S4x/4Xn+zbR+W0n+TTQ8Bxf428nwb+Lgs3F/75Xe30TRU/u/j5rbfxeBz8DZXTSxv4mk78Bx/SZT+pfpz8wJYCw8HNtmdsyvWWKuSTp4oUnTNOYhHcb1m2jJwnSwtZ219lyYVHQYg2JvQelQiJjRpImgN/gTGcW0RJYp/35+ckYSNhaK433VDZVeG05teDqTSmU0kdCo3cTJJoQlYA/pz3mSsLj1ckvYF3VhQ2a/6lS6ON0hg68AbxYF5NTU1Ucfi/ByG4/j/ff7RfvkVb9TEKcJhbBlKrWVOmWJkusqll3XWE3T0EW4c38IvkzUNP6ZxmnStTh2eSTXKiFSpiNL4TTE4oZl0GKksf3Vej9oLXQZk/l0qQLHZSW4GgTOjAttYRy1Wry+oIFTldLWYob36c8z4tfgtmjEb52kp4r4nYfJkli8zIhffy7uNQfPM+LX4PliIn7tNH3LEb/+nLyMiN+nnJXHjvitzM4LifhtOUMF1G8w4tfQuNSI37OFYntrMb3FHoG41ly5rxLbawb/D91YWhBZc3AvDvxowb0be5ubm3063N7a2dpkg0FvZ9hn/eHm1s5wY3uz376AE/Ljsa5wpaLTtBbragI7n0Nwr0fvo9zqLkLwVw/uNcQuN9D0rHVIaUUhNyiAWtDR0hTAjzjIp4uD9Kfge4+DbOTFNxYH2UDDc7gE+sbiIBu4+Gwugu4VB9lA0FPfAy09DvIOmp/B1dBXiYNsYMMLvU7yKX1xcZBV4l5OHKRP2UuLg5xD2/cbBzmHId9XHOQcJnwLcZA+6j/iIL9iHGSJ8T/iIL9eHGSJ8S88DrKZ1m8rDrKJhufgAn87cZBNHHw27u+94iCbKHpq//dR4yDvIvAZOLuLxkE2kfQdOK7fZBxk+Zr+sbF9j6YZSWnmrjbsdXNKM2niteB7kfEx18KH0WkNFznBoPXhuJ2LJYcHvtfcj/lfLMIQOrjCdtGBsIn4ZN5Foi08OpdAJ3YpTWxt5Caa6hTNoadEzStjsvPCdLTdP1KagB1tG0aFAqv7azWhMhqy4CeD+T4+nDFzYQX3+yLV7jmE6iEQipGgFOL3OkTm4QRCAaBlBJMKY0MhrMDA1SuNhwxWLiURVXSomf05Z9ksQLkopH802qO7e7v94U4YRlvUr+0KyH5F1lW5A5+x7KrEmslpzAi7BlbF/Ir5nDHxaEOmPUeixJhpjqCHZG/uDGSqvefM8W9CkyhGT8sNwhPFsq6Jm2SRZamssm9zONobjDa2dnaGG5sR3aYbIdsb7EU91mObOxvbPzVIqCkX67HZ0vCVmW2HbS2u/jscSyhN+HiimQgo6/duRHZFpozKPDMOJciwk0kjv24qfCm2e0SFyb3eqLe9Q2lvSPd6g+FOC6bmGeoxU5f408cT+Di/LvGnjye24jBsg5G2XaEIEPqEQqNitkmaKe2nf/p4IvHW0jxpidJ8GWaMXvFkTCJxk2hxEkSGEzZlHYK1nTokpWpi3hfERtk+pNQwAl6S/n51CNCt+ORZXOiilXJZqhUnMoQcJ0SKKYOAaa20NJ+ndIaVtE1Y+/Gp5sK6Zq3md8QzFqp41nHHEbRMGrrZgYYNZxwadgfDxt2dM7mB042x0GPony5NSS3knI8hEqQRM1fXGs+YK5bRmByfXm87mCwJY2HOGy//vIS5u/z3JVk9Pjp/Sz6+PXBABzsbgzXEyX+wODqxxy8QLDzU/EkVrBizDi26DiKi/aq6DzYUBHM5DTbsfVkSAX0BNFoF4zDmVmtpO3iD1WKWvCMNZAlCfiMbjRczGuHqUd5Undehc0kg6kAyRbjWWibyuqPlMhFKbxfZDMq1T2DXLL9fAW6HTVnGRUSmuVQAZKh3BI0fi8o7SpHCgA8PGVlJk7FXNUu/vhLo77yx3gtlgpZvsGacoQvMII1nsdtZTCVZtV6uolkw/mutA5Q7mMA2qk36xI8fdIK1ujL+a6WD+CCElbW6PKXmMMsK0Sij42m7M+t7ydCpyJQx0o1aIXCjhYvg50tPySiRrlTm6/LnS7yiUiW72SJtyHO05HEb69bGpJgvv3JPmOMR9trQuwt0JOVTrRVpAlvkTORQ2L3QeTNvrqUSfpQXT8hlnsWBhncJSVMQewo6E9ctl3CSmWC0E4vQCwRj1CoiMLccSCnyLGzOfLH5OYU2er25ubEuGc3Cya+ffzHf4+eflUhLc2OVw7Ofn1efkqmItCkVFRoNxFYSyVhS4pvjV8PK5wlJsAUjmYqEK6H9HFQoYgiGUOR2yyHTmsuIBcxkxqj0J5pCDhmJxVh23H4GzQ4US8h/tG5yfoaJJQYDpLSgfLmYMiNy7jUHlkqtZ2+odIh2SgZSIlRdsdxLRDS0OT+XpCelUnq659HTjQz4onUEbGBBBQc1WVx6K+OoSWUMT/8ZRqxUhhXZgheKeA7y2njWjXiIQpfW8NjcrF84bG5ulJACV3OZZgcMYIQVfx0ytD7wF5O210SDk3fN04pQ1faXX2F/QdvEP4HxRwm0zqZlAzIR+l1YiVlxc4bRFB7ugbE+M7yig/GGuXJPdbzBkFi0bhxESCmgCWHTVBX4AOr45KV5O6SJ1iLu2phDykKiOFWMDJm6YaycgaluBBrtlU0UkzJZxqKL5fob5553WQwKqtZ6UJreNGVFw+l8iD9501iz1jxY+DA4eCsjIfzAoxU9ISv+F1VNiVaf4WvEFMumPGGR3j9DLlls8j0o5P6Z44riwlrmoxH/4iDCM5Dm+np9HR/BJwKRjdcCcp7NTMFhmqaZ+MKnGMLBpfZFJJ+m8Ywo8DjrBqGeypgOWSy19onBXIJ954bFMVB/fnIoC0UTiiC/Wqmr8GpcljtiA8d2WXJwBtDnq0XYWKrGNQYKXL5uNA8R3zlbVJkyK1DLFHI3COhyYwzjdj8jn3Mao7FhnkmwGT0opEIP0Di21OHhPfsSshS37InQXox+LU8iY1nXVnEArjq1hxueX1HFAI4VTTo7aif4PcRDS3cOpGzTOBg5pEkiCmOrtGI6HgcKD7xK0JDFmL9SX8DNq72sEXze4nEFlSqYzgwEFHlc81SqlaB6PGCglHwzoFWaax+nk6xcynw4CGQ+7JfUSqe0PAv0ULsbU96G0BcwVvAwRG8MKqM8LpzUhmVKZetbUCXSCyDjKyhzNhqxEFIQtGWHgmKoX2XnJ4drHTwNuUrETaJZWPC98D9AKXbs6SOoN39pe4ukwVGvjlscrnjN1kIxBTn4tnU+6Pt56r6YiXaKH74vyU0uWbbECINPBnyDwe1jgCem5ojXfp5/xgtSCEf/5qTXWo6EJ2gUawVBhyJHxQmPoq8GHevYNXWusDlVBC/PSYlpbqflY0KvGZzEMIj4EJl3pJOojDNpzEYYBNSKyMAzTOA1HllNYY+jaUIo5OQb7xF3AE9RTs3EPahb3YQmYyaD5WoDv/k1nvaKbFawHEzhKYMoODGaZ8vRhJwc7p9q1u6jMB86UL4aaF8t3dAOOUhLFOxyklP7kkkGPb2pPnJ0z+P3I9V0vpKFAdDRFoNrhlHzH/fjIcsUOeKJVIwni7IEZP3JZBZGf2qhRRYsrQdw/RrRFWYC6k1/TjmTik3X05gqrVAXlm2kYokbiz+LONiiKHqZ+48uY59cH1lTwwEazGTYqbS0SY3gah+1ZUJoIpLZlP/lnf0i+93HT5KN8lgvwkv9UsCjSy2D+EETeOmMzlAkI5xnGpc3xiRqsONzyaLFxbUqqGGR5vGYQmpvFWRD9u9Zt9/d6g763UFvsDnY3OsPdnZ3uoPtvcHmYG+zt9kdbGz197a2d3a3u/3eAhWvDYl1Kb4vkY+vns8mIjM+ochILMbexW4Tr2jA7qmaMxEvLcvZlSjCcA49EqFouilerHNjo1VIevXnyhUf0oRe0GjKk5UOWckYOInJ+EIDXKDwz4uzltwVsnUUvkuDsKD+mZqEBYI/jMIGpnzHZmGVCd+qYVil41mahgWSP4zDhxiHBR9fsHlYEPl9G4gFH74LE/EpLAg/7uk5Ggftg24ewXKw2L1Uo6BM37Pc78sofv2t3I7/Y5eeu0tbFn2rG7AreP689tb2mu6BG6+L0vke9lRFszFT3+XRhCH9mZ5LGOyeq93xBIcShiMv1fhYlAPP0jxZlIhneRZhMPxh4jzkIMIw8Vs1gtpT+MzMpK98BGGY8IJtJT9Y6oKObSaPFzJFim9bBE4hDBs+lUBKP5T8nTKMjadkmIkbL7vare7zCZuZbBQ5ETdE70QJuWFDmxoMuSsaFE/GRaC9qQmQO1RtkPvDY50ipof9WmrcjFadY346EQm7w3dZCkIFS+tah45oxktILZCf9XSmXOJJy0VJWqoUvhN/8Tim61tBj6ziHPw3cnD6ycwH+XBG+oOLPoZwvqOh/uJfa2Q/TWP2Bxv+g6v17d5W0A/6Ww7P1X/8dv7upIPv/J2FV2LN1iBZ7w+CHnknhjxm6/2to/7mrmHy+nZv03SMcqyWwYhOebysBJoPZwThk1Ub+ZmxaEJVh0RsyGnSIaOMsaGMOuSGJ5G4kWs1BuKTNbzbZVg+T9f7A1beSMbGPLTuQOInJrsOIBlU8EIjuCZdKDDvxH/oNavy6IplCVuW01ajAUdzaGPhEHozb11sBptBr9vvD7pQJ5SHVeyfoTv34Bm2ZQa8+Z03pf+q8sO6EF9rPu14Zu2GLFFCdkg+zBOV37ZeaXbDa+tVI7Y0N0Fi8PulGcdUXgBvgSo2Fhn/C58QVSJ5ooSbXK2OzZY1zASNoFogy0Jt+IMe40x6PsQH97hkZCTiWNxoyKbNYJErDZlwq64U0dprEvMk/9IhUxoCRxP+pUjWMHytl434cEZmIn/1KtM7PIW8DEgBMGlHJhk45lJ1TJq/l+eBpQUcyFSkufahooCcxoxKRmKmSC4hI4IMZ5pRiR6BJlgdFIc6OjjraK6mmUiFZIR7+YE0iqCFZD2mH8hsaykLGSy3+lVNztsqrH4v6Fc30OWi6pUVu8OM0pu+Z4Rfx2bDNOb37yf779sY3vo5a3LTrMjhNC7kjOz2BkH/M1F0vCrXMHkspeEVU67gkcTcDyoJT8ZQygSabeCfAJ9KKUJuivdpEIlN7gbfHZx7TbVbmNRVFDaD4ZZoG026lfIec9wDTX0TFRkLRRZpcDwZx4ZaRceQZgbaIYdyENDd0k7eBAsgaEQ/d3nS/UxYEtJU5oil7JijhybMSClvXc1SHnr5bibbAkq8UJegL1kiRUZWWTAOyP9i7KpD/uAZkxOaXa1B9jm/ZvGMOPcMDpoyOoKCyxVO8CRh2dxZRRAEHzLEFRMsyarNIzFQzW9l+tfmEHk7eUifgbsolbeQh9ruJ6vO45nTvzxxGkrTnjTIihZ0bHbELDsUHY9BFxiQH4a2G5kn3FZ6A1/KzS7QIH/2cQPSybZ/tAS1WtyqMHXI7IFUxGWYMTgAq64wAxMw8ODNm5cRz9gNjWPZIRkIv+zgCQiNyJDGNAlZJh/B/13aISwQenyIjoUWlaKMtZuVuh5vuxct0T3+kJqinkABHD0tQoPIleTRHQXS3W6QxwnL6JC7grN2W6j9MH9/0NtDCVCLzDbaMDSppbnZjtPFwdSD0srQ4FtqSQjoRCVG1oDQ+j8LJ1wxbOMFBKoavyiEIcki3/ccDEdTdMVa212nD1ZH/i3JIXjBeqyzT2dHa/oP7K8Qw4MOaPGCLcYoMvLWrPO1UqZq0ez6c07jmRznNIsC/BuKhH++YcMJi9P1kbiAykDxurYPYxaNmQa9XiLwwtraTAYTNf3znwDIIVZmRvHsv9ca68LYGlc2F7FuVr76c8XStcBNbhjrzcUmkS9JSqBnRGkgV2e1xAUZiqywREuTU5z1+OVsoIcItCQPr6Vcr9fK/f2sdWFvD+Nn5mbXeOl90cxIWHJmZ5Nuo6cx7Jn+sE1vz1kU4TULplxlDFvAa422PqKfQbjjn8NrdgEJtxcecvIizJh2q/48gDrzblhf03KGO/bRl1RIrS8Ofj/yKfx3bVaPE+1DfTgj2KSGDIL+INju+OVcyuwwvuDH04MFun4zaNmw7GVhdad3KwX2EV6ecnnL1NSXRNMUNayJo7YsWJqdoim3FBuFsHp8uGaLC5g+HKWiHE1bJ8Ec74Ac+2nZJC9f9JkBDFB7K13na3XPaCv6NxOqLri80EuAR2tG1qsyXhwMVGX9+PDfDXPUxcZHvV6vdfMbqOzJlle2fJ9kDMuqzVcwJSvbaBsstTrlio/RSXK8sJPhpD+qzEuVMc0zEo55d8gT/S2cCodj/qv+4xfHx+1+fwE2asG7WKrwG19TZESGNGkW1cZWWP1efzdYRCg0/IRlwTVLIrGsgu/npljMvG0dUCCIQo2sc5bQYdy+u1EoMhYMi744txEzigVt3EZfnWkwWDEio8nY3KL2gp62v/u9oGfqvug/yZDZW4ipkIpIds0yv7bgG21YSgNRaB9V22lSMimncG0LWjuNBVeWKVOmMh5KskqVouEVuYYQn+LcE8v6feFq1iFpxq95zMbMVD02cR2KZVgSeq1D+DSloSqg+lEaGoaDq18bZwBWgzLxVoCT6QQLhajnGAENRpc10EF0u5EIc03yWs0+3Qq2FptillzzTCQaWqvbz68010c+WndNOk1mxBWtBCkxM9Qh95khuNvnGdPw5TOYIsWmqcie0+ycG4zumhi4QpxSlSOjNUsj7hXS6pT2aztX4eOti5YcXu6JOrjv721DldL5R+Ewr77//XCt2Oyh6piCjtaORzANIJ80ueLJGA6yV07EzUqHrLxjEc+nKyjNK7/x8WQFpkA7Z+R6oCfVqU8HESRBVo8pIYKwGEvBUAWsjaBnqlfN4KQxYiOelMvyagjFw6U58qQInuCSiJuERWi90ISO8STq7fHHs/PgQzbGHjpkFb7QypN8Outi0/9EJN00EyPuuVpe95oOuZkIrQy4tLW0lSATFqeg9+HcXbIQhFNbtqAntPWVisTrB6cYnUpCw0xINJxvRBZHc0Q0uY6ChEsVjMU1nFR0jSoCca0rA7xCaSeqZkqWaF24WW+0MKDuk+YeKAq7CVJoBQf912PHszTjIuPKTATJ2JhmEGPgqYD7cbBmxOthQjf0HaeSX7Z6e/5hJDTOOah0hL/1vopLbQXEuDngTQ16Inph2eNJvVi+VNr2y1JrTv/ckmO3j3hGYjEem64S5PzkjGhlivc9ER9z2Altw76iC5/jCAtzpW08MuQJzbi2Y87W3x2/OyqPlpio96GI4BnYQGk8k1BOGQq1WywFnPtfuTX7h63m7vdAw8BYiR0u9NsdqODtboMhIvBS/wDNkS4DAGMgTqicMGnl7fDoY5cletcod+HXasbFrJu2A/rNS+j+AsXxS5cwQ1ZcNrvbQbzdQkT0y4Gc0MHW9uWaI+/o2kwqVUUgrt9Pt3bYbG+Yius32SmjYlmBLZqQH36dSnMcrWfbHGCRSxXLwGsndWnaRxiI8HMYc5Yow9CH35XQGBaw3m4go2FZ8aKuJ5fpm+eNa+pgrp7tv18LMJJPjyPJNc1mekcIK8sUzAbbKhQNCG+u4MhnCL0+9fKEKE6c0aKJhpb+w/dnxKeYkFUNypaxlsZcLyWKsHpn0Fd/86p+t7Y+TCvvJ+lE6RpR3q+He0Or/sVb9Dv6n6I7payS1r49pcH7OXSkXGz2sCGlazipTasO+fDpl0pbemhBectMu7Vy3xl/Np0o32mh0Frhd85uFiTiqZtP3m/hHifhA+h8Bj0oFyO7ItkLkv5Ce1UmQl1AG5oW5ETFflv2F/iUEejww8NJzSjEVgCxSMbMdPCOoKL1NY151HDmOuh1ezvd/jbpbbzub73e2Pv/e73X7fN9NEF4T7VMiuDsoQ01/b1ubxeo6b/e7L0ebC1GjddOftm9wfddA30bMIQX/KrWc79K5QLdtz16wjy7XtYiggtwDR9pMeEsLI71A6H5yWuo77U89zwzgt3kLVvs4UWNfu2jpluD1lcEHhPYl1Qk7ZpOeX1NSrQeGRBFxwuWQenx8qRhcEM7gra3tjZ2nHsasS+VSHMRXmB8WTUCvT3hkv/VZvLnEQ1HFPwvdwHizaVMaagdNDLkqm6dD3qbu+2PWTJO4+W27jVJkjiUvTOFLceJbfPuBkcmoICkYknon2ePzE02lHCHGU8nNMGuux3ClRcbjl6sMicNApykWBsWcO2Rphgy7kAXXf1qjN3aevvmzd7BzuHRm7e9vd3e3mF/cHCw374vvz3OWLqiOy6nTJeauFskfI3wB4PQyemUwVWQX4Qet2R7/EL+LsgJTcbkIJulSpCYDzOazQJyxpi7SR1zNcmHEN80FjFNxutjsT6MxXB9LPpBf3NdZuF6CADWtU8P/wRj8fPJxsZO92Rjq96TSJvlW9vdBdSwbcD9JO6mdP7mvJ7pD2957+h7Cnfy/t6kxfs5uJNV1WMPavTimetPnp3/UtigHXLyS6m/v+dv4lk+eJePNtvPxpUsEb0oFU/tS85blKWJewhRz8BxrNDYmowX6gTaxvhLtXS8bCI8AQfToyZmG7ch3dUjvyZDBlfbNAknIsOP3dBGPJr7nDf4TAmF/w6wD2znJbMn6dfd/YS9WoCb0Dg2zS3h+Fmj2nhiDilREyGVp6iRTzTmrnllStXEPuw92ICg/u+QpRkL4daiCzcHxYtwTQOfeDk7iiY2PauEn6YvUHzK/rL59/PRwyj4ysNTPsa4THN1UIKOHCmBFbBYzFf44aJJbuaQ7uYHwm4gFGCcZzApOFgTfS1Yr2fIf+5WsgDofef0VsiaudrcZzLgiVTeIeqdPIJjCXyX2HcJj+yyCGORR8UKONAfbRxBRqZM0Ygq2rwo3plfMRgkLL0KAYeFP0Kj6AIeuLAg9ZMhkxKDzfw1UqIcXgr4lI69urfz7qb8eidT3qXDMOoPNho1SyE6xxo2OT50gY5IiOWVEZyfyb6eQ3hIxJEvwhZVTVmA+Fou3InvPPFoBHOriHijW9QvWjDsdgQcExykhXEoqa0HYtF2uXh4TGk44Qm78HK574uGAeWnhbfFwo8Pu/C05H1RmQevLT5pJkDDPlhADKDF5SNj48JWve/oJSCNI1s1F4nwCtaR0XOH9nODUsDfwI7S+30cM2j+DUoOf9MaS05Epi5wpynsI2te4Hhdp+PmmAEOrTZcKO7my8BK6hL3QagO5n5sYqPHyuZXGtk5ZyitQRcfDXS6t6QXHLXyZrtB7z+caRFLfibnHw4/vCa/iRttSE1pitUUfq3hUjJpyO1mDZm/PxG3RyEKgZVpbWn8NE9sjJz/Zp+pgT5ORsKXbrP5QTtUq+k8gdbfN4qz2R2PDs78fG3bs1MGLJTBbBoH5jlMIKQZnjUnIukWb1bqEIt5jTpbrYz5U1mqsWdBDIWIGU1aTseo4BWkMhViUh9XyGCY87g+ZF0CnPWy0t897Pf2Vtqh8+GMwAh+hFEzIqGIWOO6uQ0XqTKmwkl7ZOwoWCw0mTmJvcqHLEuYguAJI6H/8L9rgFv87qzRsmlZACW+fN6un4uX7tTRJaTvK43VuUhF1KzAFlILHm9SgUdx9WnXQ+UNu8F9RzoVEfl0fNg8EE9r45S+aj/E8Wl9BDjISGn4eGwrINYHE1Fte3rgYLYk1pzBKq7jwwe0AJvy9PWI//d//x9pamDVUTK7zd8evK95P19MaZryZGyeXflbS6Xi0WT24SlN6yhDYVM8mXx2eHu4NSMvWQzpRc8PdYdZM+IZS2MeUlmumEoeLL0F3DmLJmJpLGbTykHKwwcu4M4ZGI5YR3n86CR7gOcMfYf9e9+BHVhznxPxEeSpKuy5bBvNF5VHszxRfMrW7NZudtFiXz91XzRgYH4sdnR3nNK0AxewySNtv+xLW9fBjB0U8fG3uA/VYcRNwrLaQD6CtRmynIFXywZd8UaVLNKUD36XYJDbTuEbcWtVnLmMTUVIH4xPU62G6pil4gnlURt/SkQ2rUSlNJLfsuSy/a84ioSi+D/ZlfIfEYsrTrs0VyLiEpLfimXzP/BXcmh+mRH/OeKdCN55INsAyrebDR4O5LyrCvNcgCfW5Vy3u9Ziq7N7e8ljAlnEyKHmFY5rxqb1+VUrRI5oODFllCe0VKTABPWFNCFDRhhXk2IuIhLlWBFF0UzlqZUJBMShzvsU6yO4ewnIAUlpRqdMaZIzkzMJc80UuOTYAR++0B87JgkfUINMKxprEEpiZNPxKT5hFBbhUQfSYyCJsoQSpFwpCZxpZq7JHkkzEeVhWye8FYshyM7tNWYA7SY6qm9DaAnCV0LolXSVFVc9nNbuQMpL2n80nBCqi6lwLPMkS+pNGCpe8qQZwzybk9h2f7w+fTwhE3GD0WKIiFkVgONtUxjmGWu7XsvHMXPw+WPCYCEWPLmh0i0yc6hFczXR+5WtaZSRRCh3IlG9AXY1cBqHxMMrc8zlStvBicTQlsj1MuKhhLuNBrbkZTSRWBFA4zKKxQ1iTVOlcZ6nhUv3F3Nnx1aqgQr63kjluly/nZ+fdsi72dk/TzrkI4s4pvF9/PRujXglVlY0ciuaCFtNUn/hovhMmnvUdNBfKBAwnu6+uq4Wp7BaDJLpsFBjlajg1iFpNpZtVv50SpOoG/Pk8YaumYpzENgfShHnioGlWWROZ2afByQKWLePeSOyK+3kuZY2d9NuXvG64BgGlFG4fVzY81rIJZ+yJvLgdS30pTFMduIjSQ9PuOIQFHH7LFZGfSQBuufoD5IhhHW3DFXGfEwZKqNw+7iLylCFvLIMmXt6Vr6azxiNL9xB5LxLRVtgdSSyG5pFLCpeafb05kUUlNaRkQBbEsNXy3B3kGbiy6yDGypofgcntEEtUFfTtJiw5Bd5qIXj8VZkRrmX9L0C89A+TtgXBQHZkW1K4ofLOFgaDpkwGrGso10Gs2OTy39131r+6L8u/Q5FSYyC70oickkiLjXgCKLIaXxDZ9KY1xBs3jH2KNbV8xJpIU0bib3g6SWQlGizWfPLcKEqWcDcVDhxumumq88vNM3ndjahTFSaCSVCEful4coLXstFIpRNoTAmfXHlpEJsPyZlPgV5NjYK7LoXEGVVGCorb7XlACnnK83mShtjBcwPriSLR/MMD/1IMPKamyxgoB2b0sRSW/gYFcclialU9lOC8DVrYAzIk9KOeKfo/OPA4RG2hALviWIZ1oIwVJiwxySeVWQCBuB3mLbHpgQFwjo+JFgiBAozuNLYU6ao4WASFauvPpqrZX5XSornBEP5Yy/LwB60mQrWI+0rBuS4MMYoHoZ0FYWqD/CAt3jcErzhcexXJcX6EKXa4a9kbfyYS+W3IoO6ObepWE14EU8yb+GVJ+OOIMGqzM0B5z94K8TatMwB6D13KzysswcBvjJImLoYzhSTF0qoOxE3r8IL9xoKa7cvNJh5pc1wEZPqXnTpF3mCB5atiauMtghp/nh30Ge0KWjkmjY993blhyrV0g5/q27VNOctrMwJH08wttq80uDjBWiQ0Rk2HIQCRaAGHCTM7YpYypJI2l6PdtvqQLULLCoi9T6PW/SU0cRPXuIJvq+Vd2HuAoQ5fqGZLSElH8bM5gA6gF3y4R/eh6Ms8zzQrq0QUP36AG0g/LrE0ilTE9HqzAcM9/Vrlg3X8aVGphYmlTK5kIUvT8xo5s7k70fnHXL64Uz/++nc1OkTBEr/aXvg7J8nPhCih3aQVs+OTo4Ozjvk0+nh/vlRhxwenRzp/y+gVHYaW+fzblpjMeYhjSuVQQEVX1ahNKkkSjRQXbLKPn08QX8jT63LAXu6jKmckNX1csnuDiYX4msepMv1XLJMrvcvO1buEDsu7W+XCCgyVc5k7cECLdcrAGYQAr8T2DHPXVsZ03RmxOPYng3Fsc8BHxqrbuya4Nsk/Bb+o29W0Qy3cduyq2zZa/kpsaJ41idYP3rFZl1c7lKJzD5drGJ8C/KyS0R+zplz+xY8GIRXIQOCTPIp1QTSCAPwMWnDI5MrtEqKWfP6/EihV5V2l6BV3OXfj86JEZULyWgWTrBurGJSGQExR1lc+SJRhYMLjHDj9gBEcgPlqD141UnP6LScqDCv5neZG6a/Y3HGL8vT7AfBaZVBREY0od7zpbk/n2R8pLofTw+qbxdveJezpe6SXpUDJu/YT5nWqMGUSVmEdM4h8x0+ZIY9hc0X0mLMnudXxs9lblphGo+WlRT61IESpmZqmjHnMWf0BuTepqN7ZbTM0fOExekoL+rHgfeViXwYMzkRQmE7FGMAZPSm2Pg/wodqmnh9i7d4+CsYcJqzs5sZWFBy9Ezrp9yeWlnmVqrgBogldg+/4V5RxlWaQtgEoBjTGcvAKTI6GWq7zQr4DrzIM9/PyphkiSq1AWgWqkq9w8ejFME+Naklo3HKqMxNYVfPdnznfU1WPUtSri1iRfrQTVW8yG6vpXPDssQ1e2NosfO73J0GN+S2I1Z3QQsvVLlF3gvl2xZQa6FiOtRnzKx9ErNkrCbldoP4nR3n+NS/nTg/sMdTtdwuoF3kdx0CzfNV7sMBlNanZMH/CwAA///Df481"
+	return "eNrsvXl720a2N/h/fwqMep6RlUtCJLVY1rw98yqSkui5XtSWnO6bdj8iSBRJtEGAAUDJyp373edstWCRRC1w7LS6HVsigVpOVZ066+/82fvbwfu3J29//D+8o9RL0sJTYVR4xSzKvUkUKy+MMjUu4uuOBx9fBbk3VYnKgkKF3uganlPe8eGZt8jSf8FjnT/92RsFOXyXJvT5pcryCH7e83t+rxuqSx+eOI0VPONdRjk0OSuKRb6/uTmNitly5I/T+aaKg7yIxptqnHtF6uXL6VTlhTeeBQn8gB9h05NIxWHu/+lPXe+Tut734Ok/eV4RFbHaxwfgl1Dl4yxaFDAC+sj7Qd7x5O19+KnrJcEcXln/30U0h36C+WIdPva8WF2qeN8bp5mi3zP16xKIEe57Rbbkj4rrBbwZAjXo11J/60fw8Sa26V3NVEKkghaTwkuzaBolSEIYvUf/O0d6wx98KDTvqc9FFoyR1JMsndsWOthxNA7i+BpGtchUDh9GyZQ6khZtd42LlqfLbKxM/ycT5wX+zpvBe0mqRxt7hjwd3h6XQbxUNGgzmEW6WMbYjTQrnU2iDNaPplQeFmwtFV3aUS2ihYqjxI7rvdCc18ubpJkHHXELuc/rpD7DmHDR1we9/m63t9MdbJ339vZ7O/tb2/7eztYv67I6k2AZFxfUlFlEvfxxMFJx3rjwvMrpCHc4fcA/XvDnsPmu0ixs2ACHy7yAZYMHNplWiwAIYeZ2GCTeSHlLPC6wp4Mw9OaqCLwogWnOA2wEP5e5emezdAkkwCM6TpMiiBIvgfXAs0bDoW2N/zsAAlF/uRdksNJFigQEastIzQCONeGGYTr+pLKhFyShN/y0lw+FHDUK//dasFjEsNo4urV9b22Spt1RkK11vDWVXOInwArC5Zi+/59VCA+bKg+m6hbKAy3Gs4s0ia8vCjgSDZT+AfZFnE6FVrSVpFnZOEIx/gqflK87XgptzKPfzJbFLXYZqSs8TkDigJ7GD1RmCIfd5TCNcbFE0sITuXcF/CtdFkBCe2JKY4CuoPNMOI835tWHgQExVeIcGlhz3ADQ9Ww5D5JupoIwGAErzpfzeZBde6lzWN0TPAciR7BOut8cFi7KkVvM1LXtcD6CExbC5KCjNDFPV9f6JxXHqfe3NIvDFVaxCKa3HR73kETTBL68CEbpJXzT7w226yv6GsaN85T3cnNKoB9PBeOZnn15e/7D3X28JQdr/1xlFwIBEt5ZcoMcmA+mWbpc7HuDhn13DstAb5pVlZMpfDzwYJbLQjjupLjCA4m8usD7dCJLFyTXuEYBHuw4xqPcgX4K/gG2WjrKVXaJy8nbO8VtOUtxZeHbIvgEX83hSoXNOMcHpFnzWPXAw02TjONlqLzvVYCsheYKbQTXwF3z1MuWCb4t/QLLosuTJup/J1OVJvMZ8mPYV4b100nA8QdRnOu9ykSCdhM8VykTCMfmzC+TJuESy9yLYgYsR+GOxcnSyTZTpUsECZDI7gV2VACHxL2gJ7vvnXB3YxQ6YDw0aTrneHA7dnw+bgVPBJ8RPOU75/3g9A2JQHJJlyckKw4D3cSpRHCzenZvuAw9TJUmHXFykmlgK/BugcbxKofGYM9NZ96vS7XE9vNrYPTz3IujT8r7z2DyKejA1RhGvD9gz4/hDMODelHk8XwJBwUo9BrmWQT5zON5eGdEbiEZH1Da5LecE1disqdmtIzi0Nf8TnqvcoAmHnAjF6iesOPPwBxDlBCwqxIpJ7IfeO30HhdZitk+ClWJNADMQ59OOHAN7dEJDHghWAQyTeLJACpfRiHcGCAT5Qs1jibR2OO3SfaKciMhCmUdzgQXexaNcU8Zkfilv+v3vBfBPNzd3ujA0o7oa/74H7vBYEvtTfYmW73JTq/XHwVb29tqW+1sh3vhq/FobzAe9Xsvx2aIOJ/CG/QGvW5vAEKQN9ja7/fgj/cfPfif9+H88J+GwqUVnsCZV6VlVYsZHK8siC+isLyoSpbjCRZW9+EBUYEjwmAy5hZASD43L+BY4AVFt1i+UV3iCIUhID8Knlo3CMZZmuNCwH7PkH2OgPsOeYdE4ZCOHx68+grtBdtI6EmJENXpP82e/pBEv6LkfP95G4kNORLzMXrvikRD4MLEtaLwxumFpenh321MUARfYqfuBVBbQZgxP8W3H0soU9AISPKFX/k1flq+nql4MVnGyDORA8gMTcPFVQpqHvNvON6wD5KxSMKV6yfHjukOwk0i0pZnpS21CDLiDKZtGESiVMjq7dUsAu5a68owchC0sDPU3Jx5g5gG/ENfNDRVvoH0RyAlwOxjNQFNfL4orutLCXdcaRVxodpYxXN49ebl05cbdgBSw1VwDfdNgX8b2qI2kc/01uRlFUWP30WhzrekScwVbahqn+UtLh1Bc+YRklhgM7gLb1esugFKiz8HQRK1zTqJ3XY0nYVxt0Dqn+VKKBO7MqZdMqFk44ErteYlkXVZpEk6T5e5d0YSwB3i6wGcL/sKCw3ei4OzDT6YIozKwODuTBTZIk7gRs0SVXinWVqk8JSM9MXJ6YYHndFtuMjUJPoMdF/CdcH3NN6+WRpjY8jd4OzOgTZwomDlsk+giKGFIs1QvtXmAwXS5QRfgBsZWoVTGYRwqoAt4sm81LI0thWmcxa8YUuIRYQnMZ+ncMTGsQqy+NregKQDmdGmoNNek34BA41kgv6j5aNkOR8Zufa2KzROjfBWWiK5KrgdNH2kY5KxZaS15ROx03xsDoKsrjQEi/x2A5YGG4fb09xEOetWZkn4rJyU6OFsyf5Of/dVacJpNg2S6Ddim379enmM+EDa7YVLZYcFarPA7UaDW4WfCuXfOTOhXmqz/zFNcUe+fn3onMhxHFUUyUP7yS2a5IG8iUdP784gl+0YFRGeDD4IenHkQIokrAfHGmKmpkEWkuaAikGagCRkn2etYRSxaRc+ACFsEqdXaI5DZbtk5zg/PJVW+Z6yw6yNDT/Ax52R0XGEs2j0RXzm7L/eeotg/EkVL0C6oV7YNLIQhlLris2XKOiVOtWKbkaSt0JLl1bFNJWAUSR5QIPxvbMUmL5WjuDyoSdhb8+9NW2TTbM1a4YBHqZ5lwwlqUww5wMnX4sRgFcW7iitBJMRwCGAHEYcFiyRLLPtwh0/mzlkE+kO8C5b5kskiLRqtW94H4b3r2XCC0DKOKvX2mLe0JilL8jGtSZRzOL16tI51iZJY8jk9jZ1P8YkTYeHBTe0buYKBKwCtCa8CeBMioynPrP03mGR6k9G1tKSHjx2GeF0o9+UtazgRFVG+lweFctAlgMErGtQ3kwfcMBjvfn0/YA8dJpm1x18VIsoeRGhZTlB24LsW7aDoxgDS1rg9kCSIsFAQIgNGwO1P0sXGWxJYKZPoFUDrYB+eVuaF50CNq3InpMORUoy7Gc+iqZLuERgUrTL6R3DSK+QXDm0RX4B0F9zMn6enHZQiebbGM31eM18hgdx//ie91+W4kZqtDIUn48suNJj0udh6MsHQyZZWRZN0DJjRc1wyTZqviiHfrQY4lCGPg9riOa1BVBTlAGW5EEmsWIjch1ZSStr+f921znM+d/2RnesXNeFyu8Q+50VZ5tQ+bXSQL7HL9jQZ/x6chJlIzAjrS/Q3nZpYLydW9H9kMvyGXa1eBmlbM6KNs8+LjSVwRFkyzO9jPwA2DhszF+Rh8MyhW7bJIwECUsA+JJpFb4lDZroyUfB9gFaBSoAhoDG6aJ9rDSKMBTnqWlUJRmcdjQeN2rWvvyCjugSracq9cega1y0ZDQ5RD2mcVe+Qb1JiXm1NJwUvb4wk4txGrYxpvOrFG78AtiFhz2Ufc2m9/W8edxvD1a1N5Yn0xKB37r7WHdWH3SagcB0MIfdNQ4aBrmEtbi+iPK0LZofchfeydk7InpthIcHNw6rra0pQ2pc5cMgCcI6pehmu9taAo9eLNLIiBVlJyDwVJDsQhbBQBalX2ojWP9vby0mb3T35Za/29/e2+p14KOggI+2d/yd3s6r/p73P+u1QT7tdVYx8gIP72pRyvmKlThNHuCNbORiwRq+m4LCAnJ3BuzAlYnQfwuyGWkSDuM91CKPMSHyDo8yFpLHCi970adAxwO2yDJDh0xms8hqK1a44OHF3mJ2nWNgh/FYjjWPyp0hvE0LJwKE/LQRG5bmJNsAofVs6xx3BPw+TbrhuLY2C/gmiNs6Zeun1DyztSAH6TSyvkuOEZAh24n+LDEVVtoXF5RxNxkHKizIpyS9SlC3CzycCnUET/9ycuo5c/Joa5MofYmu/SuQ3YCKdKvJqWbHFf1Yp9+r7d527z5sNlNTmFWbDOw99XAb/+r+9fCmcbXEwWRMjQzsr0s1UvX9h1rNb1YneNJrFa0OGAz1G9lBJ6UN1zFe25ODtwfOc42Dl4tq8yCb0rUcbH6/VEmaXxxEmSN83rExosUdszQPlOYBW1lraWX58MXJ6eU27nb4d3fDL/U1D8ZtnOc3B4fNg6k4LUDONN5j4E980t7/cOi97G0PyP/O0YYY5neMylM6LhTMiAwC6G/f644iK6KijL/Brl8RjSSY7Sr1/rFcLFSGLo1/ejNYsVCNoznwnBAE1YJ8PyhGFVqqNW3K8LljZCAJqHY50IGDdtQUNAPvbDkmn/+lPCixXuyz4jEEpsXZ9WKmGrhvr9eFPzvH9PdWd7BV8Q4WfnVnNN6Pzbtj/RxNb2xBgp0CsxJ7CgeIvj04N8ZJ74Xyp77Y3ZErW5OpWOK0Sb7kBDaXjmOPQ4MfOWqAtHEaACGCGB1AeAdO4ExcoTmIVA60/WNsy3pt0guQAe+n5GuVLy+yqFnzd6mB7X8r9GC73z2039KsT/ntB+m6g/I4amuyigp+83qcyhq4jMLtD+8j4AKZCi+atOynkxORKc2i6QwjnG2nmkbcd4cmAtwk1EPOlyP+yln/H6w3nOU9pznRt1FeWatouWvIvtbcD5oVe3G/Y2BaNiepFjjeOMpRXiGxKWAbIMUoUTDvchRHYxjqZBJ9Ni3SMy8w6nt/c5Mf4SfQ0rQBWn52TWwxZUHrc4RSJAtZwNPyCEZ5jSFfdl3ZZogx48R2OXKVZSoMsSLT15WC33D256+PbFzU2jj1l5/W6ozxJiOAIXubu8F0QpveqAy3mFB0PJ8jwsex3iokr6ORXS0KG3ZHr1nfbG27++SPB9k1gCE4jgavNgJiHhH3hf/J9yzNWL2GFJAlrgn2DJvJehq88r7qOBQwcbu1CcEip1fN27z5TJTPjUvbtaurK1/B/vHn19ICbww+GfDFmu8GcBTWOIYx8ibsluZK4ofpxkpza/DZwIe/+qXD1yltYjs8VijEpK3j1mwbax0+c0mKDD6KyYkNomDaEPqDE1hVEizSxQVN4wtwPTWZ4CUFEgz0KhtFZv9CwTnd6LAyZTQpS3drByfW0dHuSGICuGX1XnEOiV9nkNV+TbNOYBGuEu2Db5szEle8iSnalViNPdLnpX0Dan/mt7tlXPsde67TjP3B2DmHrMwV+UPSyU3XIqzU66ODUwqE5RkfmabcvbJen52Cb+OWJofGIo860EqMXx8Acs+Lb9oDg9Ncz+01QEao4BKmjRF3deU2hsUsvGMM4lKysUoUIffq77btqPf29x1PsrVA3Howqo6r5vnpeDly+WwuQINB4dq/aZwtmlTdleDO6oOA+3jWmkWXKUXcBvthw1yWKdTqapHpgbAljDZPk2s3xYj1E2erwLmQiNYhzQIjldFfTb/g7IZGBIB/J7xWGDnt9Il2xLpURRGWDZuqlcDmG+KamWS1033W7Xd3uoN+dwDNDbZf9Qcv9152B7uv4Dc0a3YHWzv9Vzu7L/d2u/1er1efxNMZC78wHzybofbJ5nrKQomSW0kV+OpGHpilsWorVOQgywJKN6OtTD1pfwXZJcuJaFW3zD/WPkWjIAkuKGYT07EyRVJ3Mr3ABnVi1o10szF16TIsh9TpD26OqONUX49Pp4mwoKZIYUkmWWBy+Ow02I7GsdvamEAR3DdnF028Nza7A02BNsw8wCzoAWtceEAnCjYOEBF9M07rHtoDOaHLDhIPdzlvsZRQFuUmfLk8BGkXRiGZYpmaw5j10x68nsMRdXqqjozHFHiSyqQn5AZRyaviVyqnWHKjtiHK2ZLOtcEHm41yO1QhmJMPrQcnUWoSVgAy9aQraa+svdJTkoLkf8dskD4qgmyqMF8N1r60uUc6WICz5/ApPab19dyhvmatesYSUQa7HhVud4tgBOOymKY4CwlFzDuYhbJguRojqH5Kr2CHZQ7JcjSNNUxAGq1MY75EtR1kd84anZCnzfirshSzyWjopnFPjNZ0C9ioRkMFuwOcqVEcEhwKuF70QgmlOU2PvWK4QHoD694qE8EVQxZpcpyFjG5nmoyGaJaeOpU31YlXbpRHwaGtZs3qi8bhInpsN1B0hRHWltWO7QaK1ndUw+ieIEhwTE7B9gTDdXsGpS/a5m4QVRSavFy59EGajUCZzFxzNXmPI8o6RVEZr9ouZjxDgyq5jLI0mZf9NJa3HvztzHQeAbeRAC3i/9679z96JyFnyFLw0LIqf9Q1193d3ZcvX+7t7b169aqRnG2GBNQJqkWAII6C/BZaGho6iS2PoSUrXzVqhlEOAv61q4q4diSG5UAUj1XNSaLbRTEGTtW9qU8nqDj9sLc00mGddFPy3Qr8mDwutGXsLeRJFGZNglnmXbSddftl77DOKWrv6J3oXLKTI82SSYTQF351oFG3P9jaRlH5VS8YjWFZes0jbnF3mzG78YL1UTtuYPqwnrz2ZCN6o2UOJ4/tVjIWA3+uwmg5r6S00IX2zG+fhN+uwDQqBH/myE/JkTVx/0iMefVpfzus+wFz+v2Z++qD/vrZ/+pzEeyzL3IzSF8uz23iLCU+cmre6XgHv6G1w37SgFRx3ZVOHkiGL8OvNSE4Km5VEjCXLROhmbXOr70HkwG11ewLGMENJahDX0/eBf8KrvKOF+B8O950vLDeZsyYxji0AHisCpK6yfEqv+fEOYKzpWlLAOeTXh73nJ8G9vky+1tPRMNCuDA2IBSgAWQZ5TP9XF4x0hH6kxVWtNuGYcpIUtHbpuOpKUkiaKi8zL3XwXwUBiCKHJ7Cf8fwkWnyYLHwjhOghTlDP7/BV/BzgRRqOokBfK3kNfxZhtyRmcLAO0B1kHML+Cym7uvnkT9fdcn+3Vnyvzsv/oMxYTco8dtjsSZ47pmBfjMMVGzkz0aPL2X0qBD82ejxlEYPTdx/M6OHTPsPZfSozumbMHrIoP8QRg+Zy7+7hF0hw7+roG3J8G9m9JCJ/2GNHjK/Z5n9a5fZTZBcGqoLzBINMKCtFC2HqednpW9uDpuDxchVFc28FGdK8WejKMF0dcpqN53mjwdMhOOp8uIiiKdpBos2bzXrLshnhL+mOzOSL86IEzUYWfvmtI/SrjR04IIfARdQwZxiTt41iUKEmOXkEFtEUnoyI0BayRyx+5lpY4db3y/5LBjs7K6M8kzwwncE0I7SNAZW2QhjxF9RGHSwoDDKiJE6hQ44dcmKrkeH4jZYX3Wo2CSGjbYrbTiBy6vuhKhBXRIkeF0lA0SvZDkJpFYE5mXbUgCXMPo080sZ6ga5PFMwy4ATZYHbwL757t0ZBaw1ZeTMfexT+Z8XY7yOP1+vTNsCFqs1sLmDMIwEYrLOReg+V1nB6YJKhtJMYwyQF8z+KcEPZdeLIp1mwQLuRk9lGSLHmnBIt1U4rVHowqlg2GeG0ZTSn/daBZeYDemgKE50Yj69al/RUoht3wLioe6cjGdq/KkJAv74/ft37y8+vD1//+Hs/Pjo4v27d+crr9GSK860BI9xxs2Xob40azdSnQWkihBlHfYwXC3ZIi2BZN8tWKhg3vI5xi6e8jBTe1gWhk6rwBHrIywFR5x4Uxcu/D5n+PivP/39l703ewc/r0xLXZFpBWqGdquWKIZVqihqulypqnyzV2pIEbA33Wl1vj7oDfrdHv457w+wAsFW75eV+TydMbXK5rjlXlo/Q0BJDpl3z3nD2cW6AVEF4ycKObzYvn7TezooHZivxpvsMClnkb3eS5m8OtzYchq8/WEuuZSfkHBxj9gIywXMpNYfd4MSJ3skXZsvfE6MI62qfPWDaMx54sEU8wKKUlKqESBRxHcthY28OCgR/w5GuwphrNRMEq7wOCMwux/eAtpsHiwD8wpkbq2Yl1MLSOqH6Lh0046O2Lcl2jhM3kaO2wQJK6cjUK6TikapF4wqYlrOJakjuUbdA8/6E8SgjxdLf2mKd92+sfJxEKvwYhKnQdGMyKUyRCvzDk8/MA1Z6cWaC1TlAxPotKwsuKdAGnwa78C8BKgVFV7GBUFo1j3csn3fO4MxEKJkgJmXeIv0emb/5PzlhfvlqqcL1IBPPlaO8BuwQu+FEEv3V4pnyc6R2vReTIPlVG1QgQpM0cYbjDEhXgTTKSJ+F26GIa12HNPQ8g2Ezx8rmw7O9WgciH//XlO9AgVEfYG5Yj9YDuP3m26biZL22EdhOTo6mmNBti+US0udmQwnHhCyWK4opGlVHloRTFsameWpMq5gWkmGdyo13t69U7HxlpqNVas/9SrlD8sh62qeZtdPx/DeUHsetYfgHcj98MfVGZjZ/k/GyFrccnZhLZKd6ZYRqn01JeyDp2AsN7EUgqLCexgvZMQhEHBsQtQCktUNM/ZUPBlX0fOFZWptwqa2qp757zlhkTnaMpRz62hpCaYsu0e5nUZNSeFyp44YiBLTRY4oy3NXEDxCQerMfnxHJUinFS2ZYW1CBqQBdYHQYLVqkagrKTVn2zeQ1/kYi6PZ2p5J0yvlhy2QoEGrcB41Bl0q/mkzFyuwsTrz031VkiInsOgpVUUFKgNR973hfzsTJqfm/3RLH+HPuSoqnxJ80wL23f8Mfbf68RxUi9xJjiX7KulLBvtgFlA55EwrS5lYaBCsRlB/LB0VKXzOTHLfe5NmlaocslUYwWeSLhPJAkVPi1SmJnQoDjrwx+nmKE6nm0HSjbCakdQa7RZpF0beNbEJ0GuXe+3yKnV5lf6Bb5sCG3nxT7PGB4l3zG/nKshA8HXXACiQR5R8Wq6dNArGn7j+ZIj+ENY+jcOgvFUIrXael+CRKu8Ltq93tFS8OfgUYbYrIpPW2805K5lgmniDYFPqs96aWIcPOIaU5ighojTufZULhoWpUDr8OOx4w0386zv86//Fv9bwr/+Ff/0/+Nf/h3/B/1/QtrLbZEOPeNgZkqNs+Oehr6uP54qPTJnoVPFFIdMjDA9rvLxhM0yXUag2VaJrlnMzm6aZzfEyQ+P9plC4O4YJF6pLVPJnxTz+c+WbYBF1F0Ex6yKS5zz/h0vCfz6BziaHcgVOjJuuCJLi4hZpac1arPEMOQU2ixlzugBjDz5xvYNcaTOcmNY+mqvno6Puaublf0xqFWuH6FP7jHFSWAsE1n2RYQmSmVrSbzALghMfui2rYsybr7RzaWgU03UVkSpdcLVI+jxMKdV+hrYIoRhMoHBbvVKmhBCz3Y9rZCGLxh/XDIaOfpee8GF8BJUhnw7FKuS2Sj0adxA3DNxt2MBXh0Cf79V1SganykZ2m2y4Msaov2VRgJNEAw1erwxMMDRj474R4M4eA7dZd2Puf0w87zvvjYYo0Ptg2B3yN29Tkl3YwpGgSOpw87Xq/eyu8X0kVzr/T7WzDxj1REO4m/Z9Up7oR2PwkSpFAXFAOpdAZZdYchPBir1BEwy2jJiGqMtf63BHJcDtmhlzZc/gWtsUeTeVjkyTHJDAEMTPLW2M0G24QGKjc50gyYWccCPCcNwmeWSEE6ctcC7COTlYhvL20JdylrxVxHxOVZKp6pLbLt40VIzHvHvz5i3fIeW96rYp23ZolsbdtCTNWP59y251m3zkxrXVx1e2eK8WC7bKjl0HEQIIkiEJifdiDJjLiGS/uOUD+HaKr3nrwjZ3wmjWsDz2Gm2+NZb88zXf+xvWpUVYE97wcPFjaa+1IsPzsFaywq3l1wn0jeu6ZuuaBZk3WaKNuCHeIZ3mq9ltnXpcJYG98vEtArvzqBU42ahdFRCV1qXKFfjM6Ll82SZDJftl0yy5iGwlNQbWKRV36wjGMkoo2lZBkcZSoot3JC6oU1M9aCxwpxe2YW5Oybi7isXxPSzF4hw8nhvKoTkdkJgaEhIrS5e5ijEa0SkEyzW/TH1PKaRGNv6kPOP8pg41GcrE1MfFrfd34uBT8V41bWv3oPjoy/j1NF63JD1BX9EFmTQ/p6lgZslFIAylv6mqcKVidyuVhrNh+NTAU5WGszCv6NF7Lg33XBru37E0nHscNWY+ccavrz6cO9TnInHPReKei8Q9F4l7LhL3XCTuuUjcc5G45yJxz0XiakLi11EpzhnRc7m4r6BcXLQgg7mzT+6okaZKxdGA714i4z1688tGU3k0i5z8VVWIo5JkTuCnzJTCQS1tYH6wWEiJI0WpeU8/wzZqvt1Dif1yhd9K5/5rqv5WMnc+l4B7LgH3XALuuQTccwm45xJwzyXgnkvAPZeAey4B91wC7rkE3HMJuOcScP8uJeDCOC7Feb1+vUJCxipANmRyj6NRFmQYFBFeoz957Gg7aEBhS1qqcQPIsyFfUzhnusDEeIrBJB6Zcxw5coe1fBZQPfdSP2ssFFpsF1JotCIw0mkJogGogtvLJcbU6FI6JWNfj+Y774gn0AV14JP0d+29GPpAwOEG5n/MKaWCDERAhL9FSZhe5fb9Mx7uOwaEgBfztOk9OOefuyTM1uZeG0tpGNfwS1OD82D87uwJMpJLKEj+M5zQl4MTqpD+G0IXqoz8GWyoPbChKqmfsYe+euyh6pL9caCIKjN7RiZ6OmSiKmn/aEBF1fk94xa1hFtUIfQzjNENdELp05+HO23hSh/tcBf3Gg8IkP2WBnT200H/YSOyIm0LY4LGHzaqHXF7tzIqaPwho8pDpRZtjers6Pj49H6jaknkKNl3RVetXsB8paD3dR4s8ibkBFLOCH84/1Q/zJ8wSCXeGvjakLFKEZugaMuQ+QOa32nE2Elt7tVgvP2PYif4eEY6/tbg44MmpHzKTSzU2CARt4Azc/rBc7vRBbm1TRunXZvi593te8wCL84guW6t7gCGNFHcKXVT22Ydnd8boikOn4IPu4Tp9qTyMczUGVjbs62EPz9gsqeBGyN+9+Sw+Qu43vMvMDvp5oEz2/W3/Fe7vZ7ff7nd37nHFKP5ok1/yAF7QQyQGNp2pQTP6TGfNAQ2kVF43S4FitBjnjMuD78RF7rWcyYRZl0vMlhSlrIoZ+0SDWETzDXJFFNM8jd1eR6UF7s0TyunYfCcUf9zhlhIx4TMAYIvp/hdcZQFZfIytgq8ZtE/cPScGl2W8bKEH4YFdBFCMMpOXROjYLyYYoboHl00VxFAyKDX397s9TcxbRq99d05ZjxmqsvE6YoxkRBCGgIxx7t7va3xtno1GPTxh3Ac7Lza3QqCcGs3DCf32CA6I+qCDkOr1VTkJDyGm52dHpy8PfeP/358jymKHtz2vKSbx8xvzbDrj58PjrVxnn5+Z8zsfAWvreg3SfKS3+Tt2V1+kx/YYyIJH9ghvOT9ulR0AAlfKMmvMGjUZJvD9+T/MenPKqKzaIKcyWybYP6kbgsLjUQpeUjgbqZ5SbPS6IshDJ1Apfbp+eGGx/f3te7EbZ3CCXQiPvtBxeNTmORk7tbk9ucc+xKU4spkDKzTwk/Krp3J4qB26qPkV4cbT5HpXaLEygiHNTCIgHx3DoAAAmqxeY1CfjBenfvycsZzA6KCyJQ4bmrtTahWukCbEsUswJkRetkka70wTP9cSa/lHHJo+fjwzJqj3wNDz0Jpi3g0cVbXcju30+EvdeeYqA1vQXvSfDX3CNcY9x7DYFDgMYXUKwYNLQE+4HN6j3sHhTcH4s2X8458aE25MimCwHL2G2PoDHFwBEFQmwbGi+iIlw4qFDZmE1sb04UakWUOZ4TgZmmeRyOOIgkJcAPlQgfeRMPNpc42rg0UGhrDWFMNB1fNYpc5j+OgNZgBrvUScOqFWRCN1Gex13R9G7rms7r17uRt49CxtTbttzRahzVy7KkOZC8fDhXQmUt1Bh2/itgCuY6oIYQW4laaJG6Deu61678PcqD8aaRC28V63LBj2HFOcaLK0DEelWJ3HdqckBmMzJAI3/z24M2xRxhFgheXxpcolTnMaX09Z4yfocNiCgd0AhOfiGtQKE6+SJHExh3jNELnEs6k4VUYBylRk9U2dab4EPh9bhAOhnjtKAfRw1kWCiG+IWpcL01RxI8FTI4o2BuEjkvyayHrpgkTBRpXQZt7gaQuZ1cTYkwldIwoHwcZDMf3flFZqtGA5mQunUncB/NQS8CRpRp30YBL0LxRWyyEdz6zRfAeyGNob5bNYioIVXYxiYNpe05LHXAz8CSrHtkk9+xRz6V6U4jEVIJt2vcODjre+WHHe38E/8HPB/DvIfx39K7ByPyPtfdHGJfz/kDH4tyEfP2kS4Nz4jQj1x0GTJBDG0TqAOFrmgVz3nrGq1MywJM0xi4upyHCrVxEFj6F2ULeoFkP+v1ymeJ00ZD0+uSTl7CZNGEHFgtQXBVAXECgFlKuD8utJVHWgxOZI2y07waQYGQI0FNoJwys0G5BboZFY6IMRTS5bd5Io79+OH7/XyUaGZ74xWSFTKRDvidYHblTLCix7jZvRLoKK0OrposwBCtDZeqUliRNumTiQFHQxbd9wbktWwPCPcEReP3B7oabKpLmpTcsE3dzS0Hkg8EGmDaGKZQwC50TCn18PDo62rAC+PcI0ZcDwWei6P26TAmNxrQsTcGuC0Z5B8EGsggRy1lrEPjZOHLQkiZKhW4LhCabSR7jx6Ljfcz4rY8J7T8lfsT73a5mnX/3vL3nXL2vKVfP7IsvnLQXlYwKMsPbMu3+9A3nlsHBbCb6cyLZcyLZQxLJ7Ab6MuqBaEm3SxYH8L9KijirqhePwTw4qFnogIonpyjIKSr8O3QtG8OKiUF/OdSWPtk7ESz0GLgGLu8yh/05UuMAsapTHVkIUyyutWpUSkkJ0H9TuGDeWGK5IPBgMz4HFVIPFA2+igF+Qd13iDO0MivBgEPj2ppF4WwE9QtvzwnNxGma5QJ+ib5XQR5RUL1p8TJCRFNETmZxBSVcmESjmuMYTVDfsb/2q4qPloO/hBqg+2pGwXn7jgI3HwIY/PAQRTkVxqqvg6TCjlCYkPhw45WvrWtQph0Qd8crQMFjaGzN8SHXn9ChD9wYM0bBt+k0SW5amfDYqo6BVUdhB6Ct/OIDKA2i0j+alpgKcP3J/F+kC7a6ImwyotSm5kYRXY2PxQb6PkP0fZCFpoKRWjn0N3sntB0ftThhBrX9bQy+prbvuOT3OT68y+/zBnruukZqXaJOrNCPL+3c6Gh3Angy9esyypTbzKM2M5rOtdedLjZDd66DUaSIxz/OfXloyHmcehiVOEjiRWjop7wGcnHHAiPpGmT+hmGptJa0sOjNcyQ4jeze7YrRVBwaOCAKA45BpUA0bpOlYS09djb0vpMfFKuCS6VPM/FwB+G/cKgaZwVx4YMK/b1S5lbDlur7Pb/n7qh4UtpRr3/wfiKj1EMKir+OkuVnuFfUeMmqL3zwiX74gXGWXkD7G1RBkWDzH+9Saz/u6E2AsOuqHHskREZqNccd7e12Vw89QvioCxjXSkDDD5kDws8Cu4INnDD/vHHgr6MC9oF3DDs6WD3gfrG8aPH+wsgvfX3dSnX02a8ctUY3AsLOOYHpD4lfFymKCluqxApBBiIJh7qe200PwwwpUpgTwFwEaqc8X6ZDCkJybKDgptEFJyA7kS1Vgkt4KmmW+6uXiKeInhVmPYkDrNSoGisTvI44Gp2bgzGpWM1N2iOHnkMrq4+LzeF+MIpajt/6uRy2hTvqwMm2+p7Dv090KTXvxcH3Jxv3nUabRlTm0WUHY/VcrDrOFr2rVGmNrwJnkNLvPYepEAXTxYt9Mog2Iabt4LEUlaKU7e7h9bXe5z6XJDJeTT1ge/GvPOQc+ovayja/82xpirME8e7svhRv8fqR3XHbDbTqKL84U7vnSZNIz6e6F7i5J7gXJIxplYElysnYelDolI6Zqq11yUjzmPiofDnqsjRrmiQrcqIwan8IIrmvZ+x/N1z9KJuXQFIdPIxJlupdlKWwWdTNf11KBuIImEWMWayY2p5Fo6VLsvyeVUTNuJEDp4vrpx762QzRUxJPmgdhKh4vJcLYiGkPHnSbYQO4+c5kP9KpkkiB+46xRUdpbYguVvHqI9R44xfpZLJaHcMnGSz39ojh5tFv6mH79cZiIbVBGix27Oz+Y23xbqwNFZu6/wgvowzr016sXgvpXvJdbZTSXxmR7SEDfvjqP2C091z9KV65X+rKpM5+7yuTZ3zPK1NeuoeI8dCDIlTTxDKb6d5jbflAV8Z5vyNNOEMXBhGppWFqCVNQmCzQE6UZUahUlDuTWXn8BeykFjOrdPNefj0fpZKAhMdoRY3CGHAQm6RktzUfrIydFSROroWAnZAz4ppi1Mzh/cDVcOd83Pk5CeZfLBTVUkNzlyq4PKV26xBAHsLek5krc8Nw2WIfFTlQw8EBTrh1/2ngolrIKCYic2BfJVicB35rnGVLOHw6/bVhBBJMeMcwmhHvGuatYxPL570Ixp8uqEroCkfmKopDDET+fbD5zhm9YkzFNXVJanQg0fQQODqmVA9o2/9SvlnXNWuWseMGl0hhSrTzuSlojPrtKCzGCv6v4DLw4yCZ+m+XcXyaUlD5sX7cZSKX2hOlmYj54HYmIge4VIJUUqkIMeNzcQMwU5xqiYf2E9aeLTEDw3MO8FGPChRJZcq8Vki0UjqV6lLa4uTMnGzEx+vUsCby9+nC4wbxEDPDJOOBImagIdOGZ+qVQ0d2EtKebirQMD+4ywgMERNCuBhexyntKgHSHJhiyin8SddfEi83xvC4BQO4VJ5pBIiQiJA4go8UIck59UuDcqVT7gyIVnCtI1yqOEUIUaC1rMTd5KaSNbpJyn8CpZuK0MSYTJAvM7Lz5KaydZ2yzmPk7iiCT8rsYZfM7vawNJ6reUpZhkBmdMRJc6GltNSVxRoa+nSqOUVlI9AMSNa85lKyHW+6IU874iQu8SprKxABvpqELHOE3cQyGSmBEhUYb1XyvT7KvTleoYzag0NCuHUTB6IjzAXzsxzFZgqFcUa4m/WWOG+hqwqepq1hIw0wkU/ojX6vaUrhHTbZQRYdGcmQCNUFig873lDOU5fOk6KPUEjqcjRHOHTrQjoQRwkODsM4HAVCEtnZEKkaYokQt7S7ADaLxOxyyml5MbDK0kUUXrSMbjflE4SHS89DwonYrwgbUtCHtPQxpKEhtnAluoEoY+olSwVZLnrtiGoRlcjWTprLMuRUuSgR1+tJqcTbDGWQsd4EZYhkp5iyFFc2YQ0czWa1OMEFyNQkpurjmOOwLMapvuqAe+ghpTfVfxCcJyIDZoQ5zBGDP+xYdfmc+fnrM82kbJYTDxixnKxUSuVzj0wi8VQxtJplaPQ4crIIOBJXyE5KsZV2dfROTTTlJbJPsKI0ElUV3QsXsNSiTt9CeYjpK6erskX3DcgVB8FQQVSJHuEa5TZqBAP9riJUwG1ZMoZeqJSQt7o7IVCXorSS1FkrAvJUGW4MIYu4w50QZWglnUdFoSrVnRvq1u/bB4Z2Wl2JmDQkdjY+E4gqpLjpVKASSsZYqTYs7hInMsV2No9yauiOzsJU5RQ3a5al0q+Tt+v0f2u/mF0v3QoGHlC41r/LgXF5ZQlc88sQe7nQvVzc1HRpLKTC8daW591jbulmpDs+HCdHdd6q12vl2Ay5Jdq5+Rh4Ufb5BAMLMQrrkPvU1aIZL4GDVSMTsOGyCw7/k+BwWQNsSA/em4F+hlFDLupU9Rq0KjizmrVRNPVGSyq1tUaROrbFSOXlAHWH28dwp4nAWeliXy7RoXct8roJcPMI4F4CxuUxu64Y7R0V15KLZhBlSWykO8kULpMecVGGGnhFQ1sGbmnRfDnSw6oKGKZ9HRgp/VI4OnEDHCFGa47LCyWiv0zJ4ZNY1g/niqACOlLPoWQ92LJ0pd1hT3i6+/5EdPmxkzZoQCmYS+P9RhGrhL3sUO7MTNyAH2A8uJbbQ5WXoEVFg8eclWAOG22ZLvP4uoOJK6CUx+7qkwBOT3uoSi7xBxgUTo9swBSJyLI+TC8jQZ8wgfSVrJXrKC9dXYJ9wqpmI6/Y3t3eqwdqqbusKTeFZ63LaeBGyvc6vrNZhh1l0iHPm0SZAwoJrzPucsJsjrQx+J3ikhfRQmF63I17mvG/x1I3738b2FRmG7BRnY9sOV0Za4l+NFplQs5uqIDu3soIqYGYo1Gx5DDSjljaMUvLdCsHDe7jerAqS9n617GbFl6CdtJuVSVF+GLKT2fd1I3floxf8SVUBJGS5kjLQq/y3UJrYuD6gbSFcInKSOYpaHOpRcqwTaB0mNoVw1+1Jxve+6TUwlsuWEakl9zDVaYqmjZ5pGU6ouDOJw7o0XFXtiI5rTdA1/Z3u72d7mDrvLe339vZ39r293Ze/lLOY8C7ueYhfXrEROmmAvGQlCjCWUqUWMrYWqjpEWyDmLTQipNm+rrh0p7BuHTPwCMdMcHBjxsdt3MXIJnVyWu5XhgbwrI6FykfD4U7bFp0whWbE88m3HyU1HRMODWPKmapb7K2GbiJeRouY7v1ucYRV2rQiOxhWnSsnOs203DZLBBSwXdoYZZ3ma1SPL3BpVh5M0oWS5CxdHREkKQCKaFNcMvCfSDI3wAHiBqf4Vw12iP9xo1zJF2Xos89yqoz3ZZ3EvMppjqeef5doeUq0wW0C5s/V0IIaeJFmtFQ70morTG4plGt+pJKwkdE/Nqh1m6T6kXC+w0vTv25FqtKEO+UfpeOyGIX+jXI1rYC29D59AJEqhlin8LhA3qhO8oi9FFYXhZcyU1WUPhxwClejvkd2G1eIIYDW20pZQElx3oJ2K1trPrzqtf008H3h0dfzIMCKj3MRlu1bql1sxdsT3Z6vbA8smSq8kfIJOfmTuASIJqrYuL9pTIa3RgDlhBCk5BZEEW8AUDeVv4gYWBoLxxXFq/sSy0uwDWvERN94ZROodE8rbVekqbcDhBLpnCxt1nXx/saB+Tc73KX58FVo7kRrla2e+HpYrsrqmF5vpyjxICpywHac+GXjpEU5O7VyV6zLE1SLL40LtU3jdP0k86wjfL9Eq28/1WdnP1EL/dwpTt7x+/3+qtDzqMF+qvWczUewoMUXbavc44eNtTVrVTdQwT0psUG92t3KCWHRM7J7PiyuFKc1DYuIUDo7Xm5mFWzBi12Jq21ML9zy/YEMdr5RJChs1ByUFTMV2JomtQNn7bgA6eHzbjUbc7AMDSCEuiiW/INpKuQEkLOES8Rk8yuUFWmoj/6mGYK50z+IvshixlEkCyNO27KCbZCJ32m4gXH1MCBSCibhcx/BhoK5Ce2AaFDBxPqpiDsZwazyi2FAsJVg8iDFCw7qF2ZqjVBlntx0NoIRYjmUpUUJc9U1AdSUJhXLRcMgSvbigouo4pMTbNGES+nJAnULSk20TWgk5Bo6Znl4QMSBen+BelXzg23PKxAOZRUQesNJo8LPn+TnFkGQhLefy+6l8n7Hnk3unm1jQB3Ley6zByyD7LLbxQO3O1i4kMY8BOf0w2/EDizfAE6GqmMsGeXi5JRB8Ec8g3cye5h0cI9KBdIZTSpZIrS0m9U01EnoCekxEiYji+sARrZAco+JiGLQdI8hv6Fjmzvy0In29NAgE+oS62tDy949Yfkl0GvE+reXHMSTSlZFMpmDZzkYp1Pr4fbgQ2nUAPNlfKGPzC7omQbDKwfajY9PEbRMhrzGL33SsTmhpvsDFTf/isPLrHB7n6/x77Uw+Mf9nv/15/7g+3/+0yBnAALx795jHw8DxJYpYw/6/vyaL8nP1ghF1ldviQ2xNXOQZxBJB39Av+bZ+O/9HuYoer3vTAv/jLw+/7AH+SL4i8gKA7+5BCjEuhhlqrpjhWH0ld9zaIi+dBbVuY31AgfoUpSyS80PJzvTsfcHOgFocACqz0HUYzymzEtgaylAZzMTQpnHS0meGczPjJ7eGrC3Nu0EBA0qXUneMGE3R0YD52Ww8PSuWa+yZiRFREAby1dgsW55+wtXiFMB69AsV2ydBBZ65AzQWfoB3gpJmb8WiQPGG+DrsNFutSaq/fCzE38MAxcyZKKxcowoD8snMocyephC0Pa6FhTZcbYIViywNYdZpcbNAe+opCPoOnbWeCVlvXSTU2XhXXhIH5YZrSfLFkSAeGVO46MiATyi3J+nqdjCTfhdbhB+CpKXNjW5MDGLQkmlZgh3Bm6V4oBNk6IfZSthh33isGoRi280Y0TEQ6pcTU73rtPdnXgoOQNl6qQtQxIzoDSbWVwr58Z7Iumc8bmdDpVLKhofJ6z61xscHXrO4ZEWWvznMXGMrCU6VSrqCYZWZRmNyTNVCi7BddRDgtdyTC2OcqpCGcUbpBFnSudLUcSqqD90JVapKbFF1yupGPrYXRlil19XXUPlqhEJtONm6q3VEBigry9jM331DrsvmsXukKHmdWZlNcAQVwLxsHWiG4oByFAEAjlwlqRorLBTZRPKV7HBbjITFAUvz0s8xRp0vAPcU3JK0y3oR2avfpn17aWGE7eBH1V6qh7V2rkUdVJQcRKKuNxmsTTC5dcJNcOynrIBI0yY+6GyvAMGy2tMw+SN+VwFKcUjJFHhRo2bJpzAvCSMnSgMBknf1nsv1Pvz1TZhNnCZpMOvA/vXyN84ycNDXZ7/Uy9L6u7TrfC5Yop1A1YsRM6Z+JpmVEcOBpzxwg9JQh6x0iwT+ohXtRYdkMKCZM3k65c4xEletZXRVfpYAbhYuVtUh+bf+71yNa48vJE+aeL3JERb5IaJ3EaNEZNv4cWPGqB9EOsixAxOleVEebCq+C4x5RImTvwnRh+z94zmhr5r8TXx7IAnlz/hrFfoCltpXTeGyax/pZscVjinpq9Y0IdjsPM4UrBa8FMood7BtSCBvslgupxwWgsxxqRCk7rXvYoyY3AnITwhHNnQHnZgYhNXIk9EhWkQEyKNA2mmmD4kJTEBa4rPgINl/LU6V3rZxqHhRNWb7rSKVq98ighGfH4teeNs6NqcQAdcoMGn8ro53Asxmj8DSVYxNianIAANxxAj836MI0nqEYtjPEpru/vtbqFUgTBywHGpoPy+SldmLc5bP9mUM6NsmBadNHQHaw9fkr7lXR8hauUa+6U++JkXC70xe2EmpqVoIBl6TUSm8IYwzrzwpW7ZWe6pkZb+LupJIHIeGY+iDGcTKle0xC+8HP63tff+xgYMvQ189UfD90qssaabzOEOEdauqgJKiWvMHM1LJZ0BefLHs2To7MNE41aesOI37KtMR/AQxeg7pHB3PB+tyhtNg4Bk7rJ3HHjdJ0wJTPh+i3ysprbkhWP9hOyE/JOT6GEOLu+wloolo1LucFZiOf0tzRpEUjwdiW1NCU8EJZx4Ao7AdpOAoaMuSyLxBhwo2Uyuaz1RrcOH+ea5AOoN4eNZ3U1+jHabdl9qTvV2JiEsB/g8U8TUv1OjqTzteMlxp1tHswR2zcM5msOXHcwGmXqknVc/fjZ+doGq5zeTz/tz+eWmUTQrjzV7e3s93prGxU2Ws80+sqsVCCDZQ+MeaTwwLIBqhLKh0DFXQ5+XKObvsNbigMJnbvDs4J8LaDSiclFC0GC6507EZLCV0MKMEgdwxdPinBzQbbBI4pCpxh1NCRpNQr9C8Yuil2JgFPKu2aZxW2d+KrqkFDbVBhNS2QpMbcoocj2S0xYmurZlS08K2gVCYecS9PsF4iSbgjndlZrna8kcfpZYw/7sxM3wU/wThNSPDHNb6xu1E9u0EvskX+UfjK/btBQqIvNncHLPqzZqDvZGfW624P+Xnfv5QR+Csbbey97wdbeRK2WHTwJghJM6A9BcHY3nCP5InRSn4mMaE7kmywT1uaDXDLNnHQrMpeWK9FXLA6Py+2Fj1a8mW8pF66rheuUDFp98vhRDxI7RHYVPdl7wOshAGZ7QE+Ugqn7cLJTeOMXdnXMQ7WwlmDyKtwJgu1usLu3090e70y6wWAw6m5vb0/2eqOt8Xiwt3LOPOij05UsnzcjTRyVMupKW0ya9+85HF+MQhetFryxxJdujS2qo32YHa0aq2J8c0jUfWfXYk7y2rkzHVNeub6nPiZNdYo+Jh8Nr/zoed8R6/uY4E8g3MMNzz9TeCQJ//w7SmQZ/0h3wFoTB12R/UUSVKDZn/79lqzmAy6bXUmBpcI7tXgUyi7GkjUgmpSD0yVLF7+lWH2dl0qQfMz39fX3A9UVF7AT0TodhwnJN+SC1ReUTvzVv4OMtol+nShW5XRkkjA7UknGeOfgVqMuT7QD3ntjoxz+8cPJm3/qSqe5TfEVxg5yNr8sl4P4OippsGQkpioB6KzBxyvz+VPVDWAcOk+SKssx4Y/Q19ZfBxKtZiu/o8alm270a2oHmF3inMPIqQQwXSDsg2sIQw0Khk5T7Vcd4/Uw/blakflQ4PlIar1EjEbYMyArFbj3fe8nkMUoXJ6qG6nPs2CZk/MwFiwW5gFlIRaFJWMgj9w8UMFuvgT2iJ5Uqg0QYsxSBg+DII+i+zi7XhRuYAXzHnhlFoWhSjqUlsF/IyxERwRHUBtgh6vGegH6WSwWwE/rOgGr5KWlobpA2yMnk4cRxgVeBDGK9MVs3mpVIq4c7ZnObGA8tKLLqo0JzeqmAiRuPJuZRa5d85oBSq02o3eQul0rHEvWKHwyo3AXqWFi7WZMGzvcBi/qLBjs7D6Q9JwKdYeqvIL45wSsRuR71D1Q9iprqrVLex050UM3Df4Ei9CeWLJeKZq36j5xci1MxhjxdAe8dR4ky0kwNngBgXX6AkcP08wvWSaNYuzqAggGq7zv3p1RZYimyjFzH/tU/ufF2CeH4ENJ3W6i/u1utFIKt8dDaSY5+j0mnP4yxSNObA8rJC5mGOvAiEvG4OG2Spm+lWA62Jt5ofvDWvbAopeJNdJFulgMv2pf0UqEbb+UowDyH6ep11eMqplcfHh7/v7D2fnx0cX7d+/OH7pkS4ZOrgNWPokh7IybL4UtUMYls7JaYXodVuAdptkiLaXX3HdmhQrmLR967OIpTz61B/uDj7YEZ+jzLtKibw+660C4z4E//utPf/9l783ewc8PJa02CD9C+DvC80TJh6V8ULM5+FLAg2ACWzjHiG5LvyEvYNDv9vDPeX+w3+/tb/V+eeiVQedzJdH2lhtv/QwTSHLRVS2PaDj3XMfZ2SU/lzFBbuIXUv9Z1yUGsS5mm1pH0jqdZOCSF4FKBJVcCShmwFxyGzqC5SMZKYMFEGZw6096NxNTfCSZmyULdj1Oo4JwPB0ZQ0MjaPCPUrTUiLHSJZHBWZBGth6U1uIOnn1POjXlYN9PuSIFUhfdYG1oVX2KUp94s5XeL2tTYyfP6KnUP6sxibLKyBiMDtSsEPJ3ubZ6UjOygKxaLRfk/xvOsauhuArQX4w+4SHNwgl1kqxsTrjHbVJY+Rsf7aCHYmya014IPW59SglbspJH7GJkPXnhB2rc2ILLwYRmQDWVIAzKQ9TaYFuj1O27KQiiBsUpsS63rLyLGRdipp7jO8KS3uKuclxItRluztK52gQ2My4fMGrugpt57GSbM3Qz0tW59Pgtsy07tIgx67vcqZ6mI0kb056cPPfFQmXoH+ELoOT2pcs1NoEkboH2le3s8cT/Y1SAwpl861WgcA7fZCUoGvi/czUomP/XWhEKx/YHqQrlTOWrrwzljPVrrw7lDPVbqBDlDPdbqhLlDvsbrRTlTOErrxbljPRrrxiFQ/1aq0a5dZSeK0etTKpvrXpUaezfUgWp0sC/4ipSpXF+tZWkSqP8NqpJNQ/5660oVRrvV1tVqjTKb6WyVOOgv97qUm69pecKUyuT6luoMlUa71dcaYrG+Y1Xm8I5fOUVp9yoZhhSm5oqeYhMLx0scRMvQ+10jEExoZIv6S0AI8akTQ5+jMO06RO6YYyQlOD7Isj86W8IPZA46WTUGyEyJq4x22TQv1ib/rbWIWv2Grew1pAnvhD+a6JS0+zTRYvSE4awYnwoA/3ryBRyV1XjSt2AVLNlzfTfJaxbdwjkS7w2umkTUkAuuWpHtdYdzHvqxZM4WzRVAqvGBQoQ80ZTm7rRIcfUJOqCtBuoqE21EAvdeJ9ycjlTSFh5Hm/PfzjraBxqaCGI02m6lFQT7yCmTBbYQGSIOisyjHd4cXB0ttExOMRyLCzaJWMx0qNUG8a4UNBBh4pBDBT6P48Ozg987xeYk39iAzIYeWyeSsJzKRdeY3Og35BCRw18HaYqxWkQunjPZBRJVEGY2zBicrJpLA8XxZtOBEgF+97wcP/jAu6yj0X6EcdM0rU5FfuYiHRhNumQKTCsfOqGeJTRaPRBscgL9i2fy7aUOxxWAe6cN5EtuaOovVR92D5A8Sh2dyCeGE5aKsf4+DPCVkaUXGVdUbTxmqF63UV0tMM7uOW0tVCf0yyao12M4qQpT/HHk6ONW/2q6/1er1/2/too67ZH6MZaNY6u7g3FS8qfhzttOfSOdriLeqdwxfdb6vXsp4P+Ld3aWNgWOobGb+l6pz9or2to/Mau81Cptjbh2dnR8fGp0/UKhzZK2iv0cIJt2/RXLdbw7WElF50mUosn29nd2tsqn+F5BMy5RRH9zcmbY7Zk6wAINzqQdU33ZKOfUq5G+NS1RiBWAlXUkzTIq6srP4L72E+z6SbDeZDCsTlXYRR0yc7r/ux/nhXz+B8nB28PnMttEo0xpZqe+GdHohq0y9VHTKOkCZceRQF2M4wQeMhNb+ZSCQZH1pm6qXe04laat7eT3uBGcskO2ycdY2SZ2V1BY9Lfem93u1fZQo8MmmqImTLBTgHBklJ0W/nwtygFv61cNnLJmzqtVrvQ2MEcmSdxQDWSaU2hKs2DSNZanAanhmEH6yRwZ64d9JZbE2WbpxvSFy7O+oOW1NzAuU5l+Yxq1xCVVVLfQifa6X5RWZs3rfhCfYlYI4xwKcUZgYI5VYVNw2yMNVo90GhBGeeLILlu7ZoTeADppib+dXT6IMUySlhLl+NBnzL8HmbqDKzt2TqfPnCyp4GNW1hlcth8y7EDZnaXZT/xPWe262/5r3Z7Pb//cru/c48pRvNFi5ax9QM2hsmkxItN+Obe6TGfNNSuZRRet0sV8egxtyyHh99UwN2dGhocxI3WQkx69YJJQUniTLHAwDQS7gWGozNCvuVmiOlpsotyBlfVNRt0/YUrKXuAkcci8WSBMc3Q6Dk7sxxCnol4hAnNjmCGwE3qmqEpRnE63WSs5y6KFsibNge9/vZmr79JdgqYaVdCz7pMnK7kKvoos9X16d54d6+3Nd5WrwaDPv4QjoOdV7tbQRBu7Ybh5B4bREe0XNBhaFGsMCfhMdzs7PTg5O25f/z343tMUdJs2p6XdPOY+a0Zdv3x88GxtmfRz+8MgOsZp9yuSoD7e8AaTMrYCB5rUkhKBkInuJmFBDYSMVIRqAlr+OtafQv3QRfbLsMy0DV98U2LYOcsapAQRtBH13NC5vHvgKh6WsGXlK4XvPdALidABRnJRm3PGfQDA4XUKtoHIeSgxe0DWdwyi9zpZN29OKuY41iWX8Uo93mn98oPxCyNCQEU+tS2V0tyIp1+JeTqxdnB2w2fdSpSsg0sQFOSKNbPZERQtNm6qUi0pJglbozf4uz1Tk61pxzBuo7ennnujD3vBVUiiWK4IrIwF7O8mgdRbN+rE/Y7X3HZA7jCVvbTEu2phnPm8zjbvFA08QVHitjui8O3tG9wEJQH7JDQELc2WymfTlY+76doOvMO4H2QGsbwGWIEZt7hwcOIsMRw19YJQL3A5De4Yml1fh/OHjJ4B+pAhW0u5JHbkazj0UPW8fAvH8463ru/6PU8Scbw64e/oETmgIV1vMO3f7llzW1t2MesPaYDxTU41ydffN2N5jevN2pCE24P5BQ/R+rqITNJs2mQCN5ey7Nxu4LJvHvEYYbFf+xkQSpeJlHxBecMYiT2iFP/8IC5Vzb6Q+aPScGYQ3VBQmt72Orm6sT+GAyb+zMX53nHOyPR5bS2pQ9hy4N4lUTBvaaYpMUFKY+PsNZi3UGPYiWicR1NgiogkSxNqmiSYz0LBveK6hnug96g1+297PZ3vd7Wfn9nf+vVf/R6+73evWfFJZ7anBZj5q4wpf6rbm+PptTf3+7tD3YeMCWCMB5fwL5qHRnooAYGpMEJGO6BRoIt16b6/uzgoZMCifdStShkU/tOaJXyVBzjA2P5yk7LgReixFU3PMECJWl/To0ICRzjxc6g/1BKYJZbou6bbVTJF+QmzAIiNPtlbfkMTscKs9rd2dl6ed9KWQ+Y/SN1c8K3R81cNCVnVfMFZvugxh4VecMp3d6715hBaYvgNixF9z/1xpWystyVheuHW8ns4uZbkIqgGBT48bVTnHHiFkCmtV/MAsHD72CCqHVNsoFQB3ilpGrFKIWgvmSysC1g+iygLNWsTt2dnR++//7V4cuj4+9/6L3a67066g8ODw/uxy0MwkXrHNAJrkKTRQlyycBsOFzib8pWAmefdKmkN1aHWhL4tfdj6r0OQHg5JDQmCfqE9TpTylhLp9DockSG0mkaw9PwD5pMR/Bv3+9vb+bZeJPhnDaRMPSXP03//Hpr62X39dbOVo3+HKzRvS9/FiX+99Fcc6O66mE0YL1hieEpkCaIjZSXqOKBk/w9NNNHKqZ68F+DZlpDJxMTENf1u0E1PTv/ixVdO97rv5wFifcDKp1RPk4d1bWD6otPiurTrvtXo5WWZv6gqfzeaulNB7W0hI+e2Vegg1Ymer+5/JH1SfHptisWOQnG2KnIKbVdt7UasPNUpS6u84/y6y2wzvCIBi0eU3WfDBg6x8QTTGNgg70IAQfHmilG1KIgcRda2biOSBiHwZhXXPxHXUGI5Hgdsa3GMxIQbWFGHNnJqZb2YGHZe5x1sdZ0HBnIrkdB5QMTawtJ8VAzyLqfE6uMqyCuAK1hyCMQ8WJcC4x7kvGcX6VdQTca1wItTe/refOY3x6sjhinJ9ISYd+WoCmls/qA06yYeQekCwSVAZLYchHlaVu0PhTJ6OTsHRG7LjAcNA6pra0ow2lc2cMgCSqoYvrY3jEUOOAXLphImWODVhsVmD2AGhbGceAvdVfUf3troIet7Xvdl1v+bn97b6vXgY+CAj7a3vF3ejuv+nve/6x/qfjI9Q/IY3TaYyVqKTCk6WicOS6KA99NQdzB0qIu2iw8gKnwqGEhN3Vc64daMa3UiI0y5r5jqoyWd9hHGqfA//ge6hi1t15JlIcX2+RlFlc7xOf4pixnhjnVbcm8gqE9yyKdE3t3+HfdwT9K8yJNuuG4HI4Bn4JI09KpWj+l5pl9VVO2aC30cEvAnFR3oVK0yCmobEqiwmJ8SrDoERU2wKlQR/D0LyenroLDJRQtCvxVFCL0Jl1kWieioj/0Y512r7Z7273Vq6BOUQhpkVm9px5u41Xdvx42jaklbiXjaWRWf12qkRqvUNLtaa5Mqezo/SZlwdxN1jGSCgbdO881Dlwuos2DbEpXbrD5/VIlaX5xEGUqX1G6MzKOlu/MB7fXLeI0IxHzkBs1VC+kZ3JblKCCafi0JYrCFIuatsXBXQHBBK7Tb0wIKhI6V1Jb1K3eXiq3nHivjw5O8fwfcBF4C4bJ43fT4UyCTNiu/TQqm/PspFLOkOHsmE1TleL3SCugAfl/Kucyufv2J/37LYqJLumgt63dqU7p0ajACob8nLFhuiVI+UathHZSET9jzcy0oQ5bUVIdBjPgOpSQtuExJI8SkcD3DsJQD2piCsFweKo0MboGLnFFJmUdmF8eIt/4gbawMo4CFw7O1SLICJ5QWg7Kt9eLPMESbYwZysWRZ8HWxU5/sGEmaHO+7T2HhXp0enJ90vSwA0u9pMo8l0btDbyMQmdRzkEHGqiDHCzoHZOI0TVaojSoeeO/gi0JCmYKGK5MNeZCm9jFQ6TsXuOlvBZV03tRxGypXygsZoKdMd71xhMogV86jfLLZ1D+PsmTv0/e5FeSMmlYXyqI5Zr16d9vLbVFda2qpbbYcx3L+US2gXDoGNJkDj5WGsV3/e80h2qsQovycr00FXUKLMEcP9e0A3NeLBCYjmxsJOraYAIQJ7B081wntWERycSnuUpYZDkFaRZkIYbDdwy2zpwRhDHEMR1/4ugKjGoiFQgP/n8uR5TOT1WQQwPM+KiCWDcmKz2J8GhDugVHwu2vCQ35YrccMT1eLP0l1sdbYTNTPfnw4uYq9adwG+HyYPoU3QG8ehTRYgq/ix/Wll3Hp6l6fEnziQq+DaT2Npeud8SoM/QOwLyxDk6aIYVKde0dKWuffgm84iqlfmgPdlyvrt2/VGkHbqRgqgUNq2bL67BXdDXyHo20b5SvnMd04Y5pVRUsjPJPPpbF8t2M3Ye69Iu0sA5wnQXsvZgGy6naoEpdeHliFRLEZrj2XgTTKRacLxw0DKI7FgHAoeUbgnBvYFm4Zhx6uON7Iu7RVLk2WPtzxX4KrBz/+033y+khrDHAb5Ztai6vlZGbNBGLJs8nY+weCWwEq87doKO4hY/equL7k3dnJe2FemKk2HrbdtBOTzY/CrUjSX7JGkDq3709f3f2bmU4EJX6X5E5nobzRzHJlyfzlZrleZBfnWneHdZXYp7HIX31Jnoc5LOZ/us00+PaPJvqn9xUj2T9Gs31zri+DpM9DuiPb7YvGwFaovz6T9K2K6U5h+qkEAXP5hRiHRXhikM9siHZA/GsZAr00yTX9mSSUUU7v8PU/TTzETs3y8ZuAbGD3NCR1dZCgyQu6ZUO8kZdZ924MdDTAdIUVhAFrnmdLrFGxWWUpQSn5DR/rJdcIuwzjjkXbXM4UkHBiHtVKizuoEK0aKyCQPNbVJM0jU0yGLe1Wbw3B4dutyV8YKCqYLYzdhUxyvc/HHove9sDqn28nE4Jq3jfO0bY4XRcqMJ7IWXMOt5edxQVDmpjAXpe5FjvxcoACvs/TNT1P70ZHLhQjaM5AoVgEcDcm0aX2nZOa1qFbOSOAyrmtkykJHOEtdtBGfHOWKWMLuVBdnuJbV0q85oWZ9eLmUoai2H3el34s3NMf291B1tYFbv64bYukH2zn+Vplu/treec4rckbZhOuHO6nVMNB+yzmKS03EKK969LDJOLHLXb0RPJ6hewBCSmfmsvwqgsrnKao3AHohE8EFK9JlR1y8sHty08XzlEiYIdkX3y1ZQwwZ/C9HCT0YFcSGjyxErEIBZI17R1qIhKrZI3saInMzlUproIxp9U8XSTlfa+uunCWW5taTM1VhRKqCf9lcy17bU18/6d5pvm/iSYR3Fb4ebvzjxu33uhZbZMhcDZOvDcKApAlptkSo1yhPlhA1kdAIOfrI0bWN43C0NS8ywwhy4jwRlEKrEtNZu+gjFS+U36r+Cytraf0OESt7W21Tlwb2bYpNhlwZUUaqiNfNvf9nvdfn/QFX90dfRPa3v4OlbYRWQUQt20pH+v0kNHhHyp9dT9ydlFQ0kK8sZyBJr28rbzGmRXUe28toipg5YkVoakn6FYG6g+NciTaYZJmCRzVicJnDZ1ir4a4WyUpUFIKhVIuJjvSHwsqoAr6cdzNHTEcXqFLYsCUwZV9V7oeBK1AbcFg8+DjkAUBXmu45RDrpadPeEhwZ4A7Wd9nWp6sH+OjDGiSkkcRhyx/02Vy4/jEyNVAyIPfe8UoekJSBIGSkYZvGuAULpOCaV4clfHh4g1n6LPYJHCS1Hh2MQEuL4uhdM073EltYv4V9vnqzKsfs/vb/v9O5CinkZPOJfaehUdAV09h3G6DI3XRjuUOCODXPlSypcQieLoExyLYuAj0PByPvRxM13OO47htOoyMn77DpemMT4sjeDnZoJY5dzm9DYo6WVdYblYEZH3JqHqTMEgw9wKRFhkYYROY45aKy/b1mDHDecoilIE5k/n56d3hHP8oOPXTNIMvuQF6K+j/GbDf5ZZrHkPRmvpIhI2lM2DJzSLybBMcf4EsZi6oVEaXj9EPL+zttaZiy5XGb5HvVaJvrf38uYhCnryCoPUsFi/E1icaNa83LfO9ycF3N+Dcy7FHmrzbmFVzsk1n9+2Ni9wsGRA55qXDbd1f3urealai4NdPxB7XzUUlqpglWhNhv1MQgjjdJrr6BCb5g93MhYQwTnmBAdF8KYEKRroslAlA1kU2jBJ5nTsjoErMuliNBbILyEPg4lm7c3Dv3ff88i6J0dD1zEP3xzKQKFL/LYBUXGwpbZ3dl921d6rUbc/CLe6Afze3R7s7va3+y+37xHQohcJVL9Z2tpCldaCu6oQsxTikymyXKHtT+JmTS0XU5eXw8/L0RHDH4/Ph/ZKGk5Voaui/KjOh2T4Q+24Cm6jJa2yj6lO+NN3Z+fN1Gu5uMD6m0hgBY1Yieexyv8dipqoqfmSQvni63Lg3yjI2Vqgb2qnYJzLoCw1UfCT54eH/EL3nGRkKYPrHSKKdaa1zrk75MA0iuJfCX5cvsDAFbdZaVULJDMVL8RyHyqMnONuEGsXmFVmixJglnkyiaZUfUoOdX0lo3kwVZvTaHLfc5KpiYLj2VYO8Htp3m5F9+jUeK7G1kDkYRcPbLMy9nwBO1598Xudu131YncH+a3e7LfN+OarXc/8S9/tMtqHXe4y6N+b9ckwno73OUv4hMxPWm3gfvzNQ9hfideZVkV4eRKeJ8RFqIVl3hDF8PgSq+Vzwx01BzNs98ox0e0q9zSumwxrfVLebSEksbG72uBJ6cPbc/JMA25ensY4QwSmDAVLciQwpi//WO7XK6nTBNCdKSkOjy5OLBPLW0PSmhC5/gqkoY4Hg6PSH1g3Dw5HjHkE2YZp1R6Tz+aYmLZmMCCyIAXG5wCPJuI+8IAO/DpahtKJSSFCoS52mrEk4MHptnKV5GgvSAh9AiaBM9rgM+2OQztaGkjRkPbweE0ZpMYgbxNnnmhBvbCvw66kTe3rNASD6VW1VhTcsnNd5ohlXCJxRDJwBz2C8kPmhfPfUDuiSDS7JDDlJjOWvLhyvbCwdXqdHFWJVdr2llpnb9+c1s4PbNajhptvZVWq7ZIKei3UzTuiXpKpmPVWy06CZXf512v59ZbcpKNa2hDpZHiTQWNTuqEU1tmI8jluLv0hqdQ4egcjhpRym6qEDNCu1p3pSrXu/qTrqDEPHaOaQKGtmyhW6/6dNOayHgnEhCZNRyPlXGmUn+kNcbj8GBa4diei37KV0VKxY1LylNSILc8QxQucBAzXaf87o8siHnwWiNHUG/KYvxtSOmUi1lRM+GLyPUFClKn/2Vr94moJKyQ4FQAgrYdpNcbNjqp/xaNps0FuqXG1Um2rqmuE+70K8mR9veAMHM44MePreGFK62Wqqt5mMdi8DLJNLAszWSZUCST39UFbgaO41W2e1CvwTltRcFYmHlovQxnAydBGdm7qUkq8B7kQSJrKSNXCZL4cPsgooLmoQM7S7Z1IbvI0pcRA3vbUCDsY6NxIvyE8Q6vCB+sa37YCOjxJ1rgFnBTntJmzjlxJD8aj2ocsaJzxUddfbbi5a+lc6ZVkljS8CrJk2IEDmGX4T0R/WVkjiBusdfCs2CccVjutWhCeLNrVDQDljuSmx7tQSjmybKZhcpf5kpiQe7DcVsZxkOsAriiJikhSBG0PJDuIphJ4Y9C60nlzlEGaTXW9Ca6PBOp+WoB6ECz87/VPZTcebVWq4OWDoPlYFF1swomjKRViDKJE62+y5yhcgmcuhkc3F61yXqr6yuDGebSZDFHdA081u4Yq8k44vriODZiI1H8vcXaOCyQz/Ljg99xQwKZXsF3iCbYedf2AmX3j/yu4DBqJvkzGLYJH1kgu3eGpYGN3jcp37J1IA0GaVHZiUyuMn2vH3zRK4XYwqIByVlxeLmkqzh3I3HOOmZl2pS6jQJoxHv9cKQwMzr2d7cE2Tnqrv7vtN4zfBxE2QrRQvw1TwrozQ0F+9nSHteuNZgvbJYqxsK0bWz5GfZtOSurMCvVunNYNGckY3cABxzbtuJR3Mtiqb9zB1q00apFLOJTCm7rLFrGViVWZB6UwvWyaC4hZabYaCO39lrqyzLqf+oZ+4BIr2ySs9p73nSXOfxhhwS/bHA2QOb6fUZ0GmCrik4gWIgHZgeyeSn76q36Dp29rp4msZgD3P0Z3nhgjJK18Ykqis1wtBFBPhQ0dhuFKixa5p9qx5TREpaqx6uTobKPjCoYo2dUGLydzmiLhRV/SXw79W4eOciZdZlrOxMEiOjRcWlacRXkTJZ50wYJfbMc9Thesk1dky8ahrDfDu9604G1LDr/3ZjAdljMFVtoEZJ+8YQc4esXvuPjOKGrrfixqQiWC3LXJvHU+ugMuy8R/l4Bj2JA7ny8TUQJYA0dwJ5FQAotSQ+EIuh0X+CUvmTt0pPtDYGZ06zoAysBclZNEgdJ8bJ4A6MUqQG0do7+RkcGaf5wuuQpidhmNSbI1UStiZHCXnPeSNqQb2r3gfboZoiv95DTvkEEc/nEQ6nNSx67gsMhNulGJ0mORXeRt3hXOIPEo2XGuG8iBZkCKekyGnVZeWmRnNiOlYzqcRGhDsCs1slRCUg7JzT1saKkjcYloGgVF8tPQDQUYFlcoumbDSqgJT9/xEI6Ui3yD9St0hC1Zy39dquwaDeC1MxtE89LuanDg1vT6+zhv19l7y15bLm6M0QFk6B+leKQI4qMSmwR8bcjbhD3KQ46AwS2Dygfq/vrzTJKnO95Qn2P5iqWYyFIzX84bLqvdvQp4MTGX4vqizaAvXYlam1ITyvrXk8NjwQBxfDKA712pOBb+ZwOXs7RIx2ls0+jLrNEpIwRbIo27AQwP7RaeCe2CnSJ73fL/SVwOuW6uHO3UQsENEkfTWbFpiNeNQgLRa5AH92fv/iN/u/3Tf7z5cefNf23uzU6yv5/+Ot7+5a+/9f5Sge+RrdGCnWntSDeuBQN9NGGTTrDO0sfkva4co0x4FWy3/Y+J99EQ5yPI1eK4g8/hF+X8HCUjLC/Dv8CN4fwWSSVmeemz/s1tGb5YJrS54fOEmPIcuBwyHmJTuTbs4oUnCtA8BfKnmYZcgYY7bpMNFl8bmEaQOLlHCBtIlctIXXUE09FkrubexzU94TW3aWAzH9dk9mv+rePVpMbKByBagC6vstr43bb1VG4ff2ng1WU1HZXo0Tg5Xqa1DvxiFo1+M4u2JrPVy+YQAmZuzUulV8TAhEWzqVczIo+6oKLxHOoHT5IZyh0p1Qpj7I2KAKSVMExDxiXMSeQQp7fpxGerFwJOlJrlYdqZmM5LPcqhaOhL56a7jerWtGPEGcS5jbF3IuolMwTDQ/DTk7NTvMDdJn8+fWtuVBPv76/VrU5Ey7IjKM2ugNep8OIx2e22dDH7YBwjpPOVeAVghJ/r0VP9VwO/D/8vW1WjIAnarcpB0BCn+rJ4yzr+C83IsQ4njgEruWyC3Aptoq8939TXS5cHV//A/zwr5vGGVUfO5FohISSWIif6rVwWH2SUaSIXGsnGoBP8AFcOhy3TT5JdYNqlaGeW7peSXtA0p3ppvDKhQeTKHmV/fGtSYhOMb7SOXlh+uYEl8Qd3vhZHLuMgkYddY7E9WxQ/Ay3OcZ/9/PrgLe+wX7tR0v2VPygCdg8D/QR6wPcOMMnKxZXj8WjfIXbrRyGRlX4WJyON3RlTxY+LsoTNv8JxYBq8OL2JB9CiUZAinty9HmzqXz2VgDCeL2MJ0cBiKpUImIom/ItSnzre3xB4ZBYAlTZWDsLACfgyu7bq2CDN66EYpXCdh0dZODNo0RjyTjR7nsxNQRc3TueeoTFtg3FPI5AgJBmPAaUpo0hUB4tjpQ9ddTo/UuT230A7K5d4aEpqv03haVJudCb7Q9QbebdBwbHfNKg4+suKOxoI0KjkDMpxh5olt+GXl2i5d2cn3ps0VDFLalduVt2BY054HVyrzAzIb9bQSXtGR3WY5EMyDQzzfNaeUmzy4kzYtfbBt0GxMzmaBnDBCgQdCptBYGQM47DC6n9yP+6pM1AyNjYoJtq+WIaLjleM4a9ocbnbjcZz+FEVY2C5LdEPOvsimaEr7DW5snmf+UiBbaaDU3h1AWwEVItoTmRpiyjYdTn69xu+wv4Il5fxsEsrrp36nfvZbeDmTnBmFeGcrIGBATLp4G21ZOs7wg/VzLihIu3GVobGIPmObp8DVDgK8M4Wu2UJWrRvvGIYHyUv17YzOc8m8kVjmnOjGE3NkEkyVVL6TKJxLYIfY76XyeoEAEVqUmB3vgYuq2Ksa78JKMhoW0X9irRlUK+zZS7mZb5oQU2h+VK7GhhKi6LWvKCRtEk2lWbdITk9UpxBDFq719Q0UvXg9I1JWnAcC2Z/Op4FTN672bEgPFwHR6OHKjE5HER1nmdu9kWuY0J5b+RW7r6F3jQL6yLhTeF7b0CFRYg4YGJLbtg7Pn9NEP1UtTw3lkZYAEIks6Yd04yp9JEptnfYTEVNDwqUOz48ewJviHJj5h+m1emzLiBW3ixlNcrG35OF3gkmZw0ayUNIJtbdGyOKD35OGIxuE+g8xyA0dMhJP9rQCHozpxIE2bxkAXMM15xzG9yeVKD9VpRagIpyNbXAc/A9XDAQGciqzF/AvgxB/OdUgnvrSzUa/uFzC2oz/jaTDWoT+pbFOHcKfxhThEyqDsf7dBYJ4cIakVd7CYyJ7JbZ3cSDKe3CegDh9qTYxvJdITWyTsSp0AFxSX4ybR69+aXj/fS+471WU3wCFb0aCABGs4wvuJnVi7M/FzV4LmrwXNTguajBc1GD56IGz0UNnosaPBc1WA2HoVLToCznWqfgE1oytL7fuinDGBa+VVuGRrd+NmY8Bhch+bezZtSn/K2bM/SMvu3Iij+iQUPP6gtaNKJknM7doJ+HWTQsUkTArVZuC+FWNWsGWTFMo3dYM+DZlSn5sABAG+Bnoceab/GWKt2UitzUR/Bc9OYLFL15Or340KIL3LqWOnafHqTlEYACDobCGDt74+nQ2jLYmhNja8WGiY3eM15O43nEvuYUTGEgvFCXxcSfaZBEv1VVwpMJ7DgXMIHinJUKsbi2hV6XccVqUnhqvigaFLn+BYXNnv34XJbjuSzHc1mO57Icz2U5nstyPJfleGxZDiBEuBwXLcJwSA/ezUYuZ4j5QJDdDT4AMNYgbjcDRhvGpDMxe/lfpnzJrIz9a9UmdPmih4Ii8OY6n7gszmdSHhRDz7QrRWfW2Jag+dxvQvvSuU+ZiymvhT6C/gpz+mdB/5AARj9g/TQCCGPrHP5kg9wacv9LximLbwt8og2UsJ+p4dU23Nn1PACdYnw38u6TDM1sNefutFhOY2DEBeUvlKJNq5/fWX9FQgGdDCLQzUjZ1olSpnRNqVfN44AkVAs2Q+ACb7nQd/zjgAmii3b54sHpiWGFnIBv4DGDLLv2tNgSuFnDZiNc9keqCPqrcnmQc3NMWWw7s8VA1HF39x3eMmtLev7w/rUeHBJek1q2zmPG3O4uOXcVk4cRlxy8C2stbmPhqX2PqtviebX7lnJ5gk+UIhyjZbm6kT8tR6rLksHKIoujvLd1xzpdeMFkwqBRYitkWI0XaI4gLacLnWKOcY0jckx2sVw0SO2CungP7HfOsfpCJ1j3J6wpoPlb1urmx2DVnEUadsfh+Cq/93xa1FN0rbXV5yJJ2atOosWxu7eoPvEseXHGKR4bDHfDvIEknYPuRM+ly3CCwXvZ9UbjSeNXVkwlqR4zc8FXPr8D+8htR6cOqCRDPkESIytVjk/WAhJhsH6QXNvgfo5cKUmblWgPFwwpNwj+aEIkmCi4WoOEhYVJFCNRqR2q5qaNg6AQqs+klCX0oDYwmmHY+TwFtnNr7qkPjcW4isqS+C2air+0Ecjdadp8ZxXd0iY2WumZvXtuLwvxTuN5G+TA5k1btXk8Xhj9Jm3GzwbjOwzG37C1+NlU7Nqkvl078bOR+NlIvFKG9NduIXYhMEChd2/6U+ejWy94Ky3efL+TdIiRxjHsHp3oq3vV4zspdAu5R9yTotRqTenXbIQoMyLnWsE6cU6rFItsmpaBBC4gpW2LAk2pysvYEfMeZRIDlSTCfN1l1hbTkLUqdVVb9c97uxe7ZfSB0TKKw5btdesHcpYaV5PYE46iaq0x28Uec71bmvBBDCYUcrmo8M5+OuAMg4QT4RUBy+kmGgAgJ9uTl2rvVRju9ke9V3t7o/5AqV6vN3q192p3d2/35ct+bxyubOSaqfGnfNnW3XYozdeIpWdIGgsi9k4idxu7sFh7o63BqzCA6W2pre3eq1fjl+FeEO6MR6/Gr7bL7hmn85ZmdFTODCH8tDJ3MCMHtpcYSOYsnWbBnPwmMSioS7JKprKlcgqO3URgSoR33lQYcBbZrHfPYg5UYu2InBf5OF20F84Z0tLAwGdwSzkTppIFZkUl22+JaTSUjtLxpnE6CuIaXfjjpomoVTRl0OebS4MiQyQMsMbxlSkXRyBW5a3JRq+5eSkuZW2m7sj0YXf4BIpUAQoPWSF3BdGUJQxu0VX2MVbu7PTo757u7jX62q7QD+fIHHkewZ6yEHr5IvxM8HnSZL65UeczBzDSmTIND/zelwo401eE04XdOWkFD6u1elmniNNNlCytW1TbUG7tqWWOtadg628eKri3s81putn3+wP/VbU+MKGvt2aw/wldqwtxmZnOXBeJkWxIfkWcPi2qmGql3i0FKAysboq8DDfTqvcNCjxPXZxC75hS0d36PTIYbPW/mHKkTdN1WYACH0U/0HKou8W4Jhu83tEV6IpZUH6EnVpZqXiUBaOB/bWYd7xw8WnaAY0KUXET/GCKiRrJkj7+V5DVzzy89nXoC3pBy7241WDhSLlKQVkfOPZ+olq+D9EI/sZ6oHcKLBi3PlBVjZf844vT4w1TyuebELcPTz+UuvGKIJuCyqnNxFTlqSZ+726vLD2WzPetJJIklPRJ3dTKbHU0uG6IWbn4FHxIVf/qhp0IkYyB63mHabZIszKU1R3TbF+qNFMN6+LlPWd6GrjZ2XfMDNtuWa0yU6voTfec1q6/5b/a7fX8/svt/s7KKRTzBYLXtw+NT8rNnBDwGdseuBDXYDtI9Ci8bpcKiNJjnjMuD7+RmHMduDCBy05liwxBwkdRQnjb5Jj2ggl6tbBMNZLL1LngoqRop+66ZSw9AfrU6mwOHVOSwniJ0J8dEU4ZhhCrueL9guj58JpRh2n0bGG7E2ofEZoxvkpdK8LbH2E1zmKGeF9dTMlAfrQ56PW3N3v9TehkjLED3XkQozzSZeJ0sUM0CCFkc/2i6o1393pb4231ajDo4w/hONh5tbsVBOHWbhhOVo8z4DoaF3QM2k6JQ0I+hoOdnR6cvD33j/9+vOr82g2mNJNqiqi85+TWDH/++PngWN/C9HPVkbe2mnNbMN9KgoHz0e0u7ZUshbqLZoc0HmfjlKYajFQJQFDnyrXjqeaObg40vE1nKzrVcOcMeOdz5tRQd7+IwiGQvUBjQxFc59omzV2htVjFiMxnVhdntYiYzeCDrI/r+gTk7OLhWrvy4+Scad4aA86y4Fpw24l40NmSAOE7SIysMPZ6ilga5Wm8LJSug1wqvamMoOewuDfQ+khJJAFTDNF/FVWnSnLgj5eldPPGnC7SC4GHb+b5DNO5ujH+jYYS/Lff8/H//d1qUhfS7YKAJB5Tce61SqaFuaL0nsG2KVTiuqqZlBKgnCoHGipWymDgjPG30RKhnWF3BfF1Dq/Ddp6lV6bJOYptZk28K9SnDVNASGxcI+coeW/oNjEvzJn+QWLzwcQcxYJEvswX0ThKl7mpaVVfgnuIs6G6wMy+gOzSYYSZhxdBjK6sYjZvsw6cXHie6cwIAjie0tpVFozRfnHBuHDadBnBZW1mIQUgAlPeVTIXTVIdFcl2EihtYh09mRGos7AoWzeOaWOHW6d6PgsGO7sPJL36DHO5C+h8lKaxCpImmn7PX7llbhGd1JLFrU1QO7LIztYfOHL8CRahxXpMuF0cK+Cq+yTKzaO6iFK1Ehke1+UkIFWRU1atoYij4/0GuOmITZmXUoUF8dKV9927M0LDqO8LkAN87FP5nxdjn2L4H0rqAv7NfzdvkFMBzuOhNJNcc1As3U04bNn1okBvwAIkb65Wnts7ym0VjnYUurhSqLZniOst/aEIfolWZgvQKzWF9av2FR1cbNu3zu8ABpqQK0iFDZUS379/9/7iw9vz9x/Ozo+PLt6/e3f+0CVbEhxMW7BBZ9x8uZo6Ru4wK3tSo0BlZoUK5i0feuziKU8+tUfuN8pPIS+kdXmzUO/bg+5Wb7jPgT/+609//2Xvzd7Bzw8lLd5QBbT2COfQEZ4nBAgqjJeotDn4UsCDwA59PEpU4oduy/oVMegN+t0e/jnvD/b7vf2t3i8PvTLofK7k+rrlxls/wwjXXIoqWB7RcO5RzY8qQGlRyDqWff2m97RMhvocXRxoRCDKwl4rl/cpwSKVYMRRzIC56IJcKLohjBqxIBZAmMGtP+ndTEzxkWRuliwoXhlxJOC2KMkY7NrGzTTFmI6ipHKQXeea68UlRdmyUmfrQWkt7uDZ96XTHK7+8CKOVsJsuYricBwYFvYk4X1lKv+wjGM9KozbEkQJUheQk1lmV43Z1DqeE41Bul5Fx+Mti/D2Rtlw6E+5iTUt5BFaoKsCel2qgZl5RvNbGSs4nvhfwGvwJoAuknKQjmYHx69/uMFrsLfbXd1xgDNBN91FmoWt5dF+f42SmQLNlSpCTG4e/OuoKNDXA7plkNxnDuPF8qJFNyL6b9xs3BsngLa4+F4DR3ssAjg6F+ZD7tXjz2jCpdwMirzQ0QamzCUOdz23bjMdTyKKqRUqCmJpGA9TcMA1mQtDn6LtgOFqnM5J8IkvEDEZcymJ1NayWGn+6jPZ6leY+QQYMHyswkbEW0lu5uZgXIox7jgnj69FSne+z9hm5L30g1HbGao/lz0yuLvcalff8/Vk8dxeHHx/svGQqRACY1vBDtQFgzzedE7uM1bcpW3FjmEmEvuSnYFKvw8YqkKMWReN+clCKoSotoOnoCxDgbYdxbnW+9xfI4XblEnXg7by5L2GnUOfUVtJ4neeN0351xi97r07ewjlW7yiZKfcdkvdZ6RfnOE94PSJn/ep7g5u7onuDl1jcoXBJcrROmuFwsg5oN1fkpPAB5hh2hCLLy/XJLfrXrISwkKJnmeTM8T0Kq4qAszFphkHRCOHwB2fL0fdSjFaCqlMFEr1Q3fG/nfD+x1v8yJIuYOHMdASDEwl8Dnq5r8uxZIyAgYSozUOTfRZNFq6ZJNxPGiZ6QpIF9dPPfyzWZAkWHqLmwcBLB5LuVwr2j1q4HA0WnML4kY8k71Jp4w6e9g4W4wKqA3TzS+83yg18v9FOpnkqvhSA+beHjnkh4eEUqDnSgM1lRHchJv7jbfFO7Q2XGzqYaO8jLJiCUQV/NWnlgtrI5X+NN7r4wbdRnDwjSN+wE6YkjnoC12t1NnvfbXyjB9wtcqL9xBHHnpwhHKaYGZTPWi8LR/yyljvf8xnsIhoph1lFBDY0lC1ZMrdeaY7diNRiECUOxO61xwK+KvFmEzdvJdfz0ephC7isbq/VpI8KhtJ1wxxbNUevd7kTOrvdns73cHWeW9vv7ezv7Xt7+1sre5QYtSUFt2PNyONNPkcVaXYA3Mx9kRSmV3yiIwcmBfOkuMC4rkLDOiicF8RqHQpv5sSdKjOkh5I1/rvPnw4Oepg1OAchiDBf96P8GHecT3qgQnipZ6XNNX42vhKuXaaKSpLbtL6rA+B5xTZckxetEBi6rAeRo1yhJKNvo0UK9P+/+y9e3PiSLI3/P9+CgX7xLE9L8iA7/3GxD60Tc841u32GPfMnFlvYAECay0kRhK+zInz3Z+81E1I2BiQ23bTO9vNRVRVZlVlZWZl/hLvvrqUCjj0Em9gXsufHR9ZkYv5og6GTuFdRZy6xHUkN7tqhYWEsOhh6piDl9vxJLiNJcucIPfCOMm5Y+vWu9s7O72D/sHB1t7OzKmk+nLlzSJHNCYCBNOb1wgQfOR6Z4InXl6xv+eF8F3QzZWX8G11OpJPlwWjZZW4GJZnlE228wrEa2XB6bCuQG0q9E7dmdzlAhGNshHNyFpKTcVLuZwUwtrW3sz1jGAD2sPeTlEXWkc73EW2UzjiagX12vq5UXukWx0cV0DH0PgjXe/U6sV1DY1P7Truue6oqK5bR83mmdH1jMiJb1ZYrckjjd0GqctrCplBrw2l2nPuuYiKQtCJoefnJQBOSq+Rg7Ge9iqA+3kB3LNkZmjOrkK8XzLEWzB+Fen9zSK982fgDQV85xOwivsuLu57CsdX4d+vPvx7ysy9nyjwfAJXweDLCwafwuH3FhM+hcxVaHhBoeH5/F5FiD/FrlWg+BsIFBez9X7ixQ2C3nrYuEHKm4weN8f/HQeRG2x4rbHkxhDfSUh5lqJXH1meHfJrDzDPjvgtxJlnR/2Wws1zRv9Go86zlLzy4PPsgF97DLox4tcaim4McRWRPi/H3lpgeh4Jbyk+PW/8rzhMPW+4rzZaPW+wbyNo/dGRv97Y9bxhv9oQ9rzBvpVI9sfG/noD2lOjXsW1z8extxDenjfsVxzlbg73jQe7G6S8mZh3Oea3E/quRryKgF9FwH/jCHi5Fl9rIHwxse7PYcwqGn52br1oUPwzh/VyYfPPH9gLBtY/f3AvGHr/3MG9tuB8MbhXGKP/QmH4s/MIFOx3UE1GE/Od1JXRBL/fCjOaxvdea0ZTuqo6s6o6M8s6eff1ZxSl32MlmiwfBjO5J57lDT7WVrWgl4q0GBl1IvBXWnYdF9tHK/q5ithocvSZYP3nVW1U5W4yc7Bd364/c3Bkdi3bZyWiSIvzWpGA6tnLXxYX1LB1fLQM3opRFiifxHDNG0U1YO69Un3uoLHm05uwG2ikE54JXIH0eZldcmxIqHw9J1ZrlGt/HhqxjSp174MOuTfqjztWJwKJBgIjdhOSZl4iBiG9QHduh8vH0mkdJP6DFY4wduTZtyXjEY58AXdPCxSOoJcWYdcY4umCTjIe2Vk3S/25ChtMPyoD7R6oD90kjB5e86rBxSEGbKkBT6b+Zlx217CGNh2s9zwzb96HRfn9mJLv2ob8DozHldW4shqfWCDv3lz87u3E12ggqsG9vPknu35Nxp1S376h6TYxhtdgmKkhvUKzaw5Ipbdnk0mufDuLS47gtdtTsy+HJRhbcnSRO8AI+wezHvW5+dn0gtSfuKA0F5AmfUscNqoBXAlsLojhLFKumRIszejkpWuiX2SiFPVi3UUeZqYwBkrHid3dbcsNQDlEpUpvwU+wXyThUZZw2JRj0A1hobbc5FdU/5r3lLgCTPoFga3EZ+U0GAGVuo5HvOJDHY5FyWgconXljzDB1bmyFYJGOBLKJkZrCZXBQFaCPSy0XjAlZIYFIUfoaFaVt49y4Lz5U/vj8Wnj/L+ZcvhYaLAZffKPXz6OG4fVxq+/fLxowB96z39+nFXPoCnmE+gpyKWJDP70RB4yLAFn7eI04kbhdmW+kA7lUgQ7mFAkgoXzfkn8F3MhJ9qm6Y/hayOMSzyvFgN1aa0jM1t/lImpzd/PGqdH8HaD590M9lFjgHWno+ECV7QruhR54BT1JjqkhYqtf/56cnFMfVHbsjkf1oUe5S2YQ5SR6RNMGzcbjIcuFmdHWvXKxTaPfvtyfsQLF979gu9SQzdWmbGIFH5Qz+16YJ8AU0S8NNtcGKtkXZVqpauNXIS7ww+XUeJcYrwtmEaXYMRdDh+c0Qhj2Z4Ba0cLKxtdvBxUnwSYAisxPd98jAppIXEy4kkKeUnMjEbq3RZBQKPTidxbj+aLDA/p5cL+MsfIz/88+TzrgGE0BYz3ZxhWhU4dRK2gKEHYAxianxls68uni98a581LbRRJUX16cXnIGotIfLw8HqIa8wlB6poUZogL9At1Gl/eeQEOFNfd7G7c5LoA8glZBNs2gUNwqsrYHO1QktF5E3e5MEPUNs9hzOWR2xkPBkZm3BMcMse5TBadGuYzgxOKszyzQGYbsVaWSKqldSX90XRVac3AtwRNGo/qoSuQqfpOFw9iRMYZebchRylDS4juAp+4hPUhx4dyTJ5dhPFCD9AhYKLBCT9YjKoxwR8FD9bId/BJdKwFVvOwJSJPrQtzCKJp9jDhSIQsGCLqKTmp5OmEwdawEKkL1gnE2ehFhvKibUmBzRdYV4KL9pWipIECshu5iYouRw4dn8mcJzeWLjbp4MPEGQqSLlthB+wT0GfKMlRdr4hEBNmWra7vAbHwiHgUd0ngJqhE20DiHchvOGK8kW0d962HcIwohK7A1zk+k3IbCFSj90ZXZXoSh5SgusBMI4451sBDNyOQAEczyFbffyhjzO7QIRXsDsN4/6awQbAzhxyJoNYptFOjqw+1g7pdtet2bUdmBi2iShfozm0AwxKBJgpLgpYHLEhgVCQXnNC4GO9Ibosy8VBnRsVsbBJynOaraBVZfu36I1xOsZeMhVOWuIpdrUW4RGJCSbt20shV5IbVGKfQ/zojgcFv+yH9AhcailI6DNUAZkcDoeD9AvmL7XMCvHJZ40dGJkM+45u8tzQqjfE8HyUg7X85OoXd1guHiJtGvZTJ1oyFxiY+wkXue2AUzZ7x5c0SxKseylAt5Dnsxjzi0r6GuDAQJrm+Cf9qYhLos7xJULH59oznzFjMkjxk5PtHThh8Rmx2SruRdyAS6o38QSInhVNl4EyQshNoECODEx+OJRyASHSUSTsw3S5ezChqg5ABXYgwbVGJRUZdGAlEojW+IpH2AZtSxsDFKvwgZbMcVG/oxXT1hYp0FPp4mCV43MFKFI/iwGgXHB+1No/PWvoLvEi5AwmMC9ntyCYNpBHjgXHkC5Q0eAMrhrFfem4iEltRVPDRFrvWevPofMOKyZeuEpfcpLsECe2Mk+uwqDWM6hHu4IETeH+JAxKhtWN33AuDh6HcajwI2ur0CiVsyIhUbi8lVGkO5YpTK4ake2rdm6Yd2E1R5QTG/gw7DqG1Bkv13KU3t+xAsEUoj6IpI/HSFZVtxDklWZDyoepFI3Ps8lnRAI1gOELj69jQ4E5c52Zm67bwC/YLsuAzd+s07WK6JR/yifzoh90b2Dmg3MQJaYqjcQc2oHV02uLMuJ8vLs5a1qZ1cdIibMKwG/rxzEdLr7AVQTQeH7H4QpBazhpExwZnfFtxN2SYH9SNB6RmKp3UzGZksZm7cJ61YGrV2qx8QWdvELsFyhG5FERPQjNnHepxyaBYw1lraBI5oIA4t47n5yb4NUZOF1oERfjblNHI7AjzlpboJFeghFCdbV+cfDn8Zxs2QRs3QRsW/6y0gbaKFzfdoghcO5cdWF/PT3D2nKeQx825VrObexqk7q+wedTo+awVDlaGe15bi0EV7Y51vnK6NzLXcGfCg6pNUEz0KiqjEWEiLDqIxnpD9HDYBQ/Q50ssZkFH2iapexs2eUEJynoqZdyGG9h33o03cnueY8MBu4nvNueaXtTACsOeOZ1YudBTGfgPmxjMVNJYWCOgQER16qK5RTv7WWc/p7wOXYRMyjrohPO0fSZEfvsTa1+z8mk8fiWyn/w6wDMZxaB4REdCrM8ENp6Mw4AxHZ4+DtICM3ss1KpV/v/M7rdCw9YuaBdzxNqmhR7meFJ16LhINa0d8pqISi5Z0uzabIYTc9g0nVr6k0eMp4Z4DidZQrY4sbjpIUcWfochadKoAEMkENPTV4o6m0J4EQBLmm6OXDJbYMr18zz/HY8vblme9v3wju7lop62pPA+5uLwTLRaFtBhcpg8tq7r3eoIGi+A1QTNtf77FPYuVsdZjyViomgUG9Rj4UsfXotK6ZrsSQhI/yHDj79pKSD5QoFyjmicPJTCPkLE9DHjLsSuQPaPhlZJtVdC+UGnmtGsHEUwMfCYIf/F18J6FMIbpXgC4j/Wh4V0a9JQCLsdnazxRBcmHcJl0kp1wHY1USFaNGDOyTj9zzjgRUEXX+x1FL/Oa0yzFg6tTJN9EsE4jRyNOGlqH3Lzm5KE9N0au8ng0Iavhw4Imi5fQ93TGQtfufccqlhOCXWYcHStYXUQeAw2LZDr/eXqG2gkFA5BJ+V7k37TSPXRR4NathmwCJUHCTtOxZVnnODFpMvuOsZWIo8B2dqGE5cYBra5r2QT2PBROIrw5kqVdFjI6J4Z0WsuhYp2Ax+JYsKUezuFFeUMO95gHI5jIIpWOf1GV0VBdsUqidtHmCAHXc1lLI/EfjtyQcNpdQ8P4vqxLeu/NccxTPQhZod++ih37uSY5H64ssUHV8yytO4WoHalr657Y1legVzl6CPHoVzZPKyrMtCIbnWq5SV0CbzZNnGyvXgicMiJ7RTe4jyxQwIkh9tBp36oRikcHWEQDrGUF4sI5rv+WF/tswQRDa03WqcbGVgaCjJGTDHlmWJWcpSnm3Ny79R2DyZpNt0z9puuB/jFoCQ/Yu+nMByAOnBycpjiQk6wzyyxnVMRDD9SWA9BqRhYnLQTxUJggZ2doP3ttMOFlnMBF5cEImP43jXiKY1SLM7c8muyXB07QQNy8TkWiHEfr5RAhhOyk9E2+/ADfX+gDShnKK7+gJ+8FXQfcFLjtbtioEKXZsmGqo2LQgTPL3hGK61BBLsdDbms+dQPQ1u8wXD3FK8Hbmh3EUKroKJMh+iNyV2Vn9HX7Dp+djjwhYeQUzmYQUsZ08VdCCc+3RQSvo862GUsCfW+FueP+7QxqwGQJqYgBp+mql2JzrKDDiNQmBoUfeTkDHKMSOxtLw6L4vkhd2Edt74Q0zMjPGxMHVZRS1MMKXeWD8Fa6mU5RSdbxqjLDAcebZvA+WnkQZCpoNn1WAXDq1p8k/Xh/49VAvFb+mBV9rbs3dr2/la1DB85CXy0vWPvVHcOavvW/65lBlmgX27tK8jKilSlJnzWjiXZU8Y8CvJbkmIN3w3AcAG9OzLr2sEDCOGMYQ9oSaQw5YTKk6T9gF7ESnLXDfgOiTI4/JBD6zoYeiCRwKS1opULHp6vgXXZVwzGo5RRZuDiaUgQlvggG1Vkg6DOMiTdBhgtqc1K3A7I+zCo9LqZuRnBN45f1C5bO6PmWaw5MWinXjpOUA05VeoLo0sMbV9EpaiQGjyXpH/vJgjvAorlxHotCYOxRdYfx2eWQZNFS5tU6VusMnAHuhtwkU41savp8pRfZvl3sF3drj5HzGJwYBgUKcDOqYfH5Ffll8Np4ypIgokx5QqwX8Zux82uP7Rq/gqDQo5VmW6D7auLCyURZLTrceO0YTyXO3hxUG02ogEdy87mx7EbhHG74UVuPG9ERIbK/GgIHRg1oR+uH5/dbuNqh393N9IxEUOnW8R+/tw4zB/MhJMfowWktwzkE++080+H1l51u07ooxiHhyjOH6wmGk9hN3ETa124XsvWfqXjJUaR4MTd4BqPQjUSV7N3ofWv8WjkRl0ndv9tXcOMydBjqnIXY2SW9LWa8YeWHD53zMHg40DUKsag+wEQZbXGXcyu8G7Fg2y6x+7IiWSVQEe1eP0wAhs5J/eyWoH/dpr091alvjVxa5XYC8TKrF2gC044pSifznSiYB5DD1TEC+WbFHiRnrBO9eGHNeK8WxS3R5//2DCmM33okOj2Qwdod3wn6NKxZ4RUwHKM4PCEjycMe6QTE1WXnahmMoCyhF8vC9i79wwbN52qyL+ey6JNJ+xlp2HBJErBdlMcpC8vMVQeSxG282zpJRc29wbXWClcdyp5xH2XiRCQGT015HGHv5pI8xHsKxspINScsKpRKylN2LIlFFIl84Pp1dPZc03YsATLCHIrRq1ElD4nT5/v3Yh0UY6fiMf9vnevWqRn1vE68sPmJj/CT6A/aQNseQ4tRQcwqlP33lBd1iFEPYJDgxB1bvS8smfQd7B0AghX3+m4fsyaE160koOLEJCR+ouTo1ido6VuaI9vSlnxN83UV2wvcjWoTmjRK8PgEUeJjEozFHUdjsghgO591x2xQaHcLyIUIr1UxHK3LesYb5Pg1Eg84zrByoyAhIco04r/F9+LyDVlvZCZgYkB1HMXi9AkZkaOXldlgwOiJEKcJQiTre/yl3n+nkjvG5O3JQQzcGH92MMH0QIvDN4Z8EVJV3E/FgVpuRVM75Qg2UwrpzvJbrTOVoLP6li/u5bafOXUItbDSwEsCy4YbZTKvOeCEO+zPB+3DKgUXphTrhYJmFXfS8JRm8h4Aann9vsu1SjGXsVCEdSvu7BPN8psMil7SfNde7tJdJTldSMJAVyycq0Ym8TOCsjJfvMyjHGWaB28bclIUnGaUNQzMZt4pM8z8cx2sUvG9NLplGIV6WzEcMBT045FmKmTo8YZiqwGU3ykmjLXylqWOhe+9QsiDl1CFnWQLqKTGgBKz/abvmdBMtdifQyQq+mRcDofJjOxmljb2xULK8URukT9ZsuO42gKX3dMZGExRNOrc4g4IRFGRBc7mzKq3Z42zgIdp+ZMcGfZQRQJAyU4RdKGcnTI/RZxDlQq4JATrFgsYUQ+hqx7fxkR6cxC9Rb2BUYzwGa4Iiq8Ht9K0xuk7kqpAPBvn+dqMsgx6OVoVWj85S2qJxFplrOUxGxRl5nAukqtslOp1yp1aK6+fVCr7+3vVeq7B/AOnZeV+tZO7WBnd29/t1KrVqtZIpbnEnxhOdi6RutTotn74cALHmWVY7tTZWAU+oXhTTRkHiUtZepJ3kqQ91GMeWoCxY3XcQKnjckxAWispcglrTsYtLHBJ7MqzJg5CcBoBM3Jjx5NanXlrzMhWIn5HSeRkIdCR4Yb5aaxNno39H23S8BHf1Nuw1g1TOl+GDXU9zAtE7ejEg4wxbGQCqrqjuyb0qE5ClHsVDDQE9jH7gcrZ/ziFh2Uy36Fi8oJM048J7DO7B8YNkN8yOiQ8KEOfFaM4B8g/QJDUvxIRdjIgClK4QU1mIJjgGHjZBAyjAQnTZQtir1jVdJ/kCvi5/DOpZTWRBaOidknNMt4h2M0VkFj7dA4+ijQ5Jygas0WIDBsgk9uomNiReRvauY51i+1tES1OxEGy3c8uthNrBmjh4yTgWJAQfqlOfY35bZVfFMJJsBAGY05OTYRsMDIHoIqGZTKP7f/NlGKxxydnusnhoeNZibxbyobZMroxCJ5dGSvOMatIa4COBWXrkJlEKanFmV6v5NcmDT9DDgU6+v5sU7mk3cN697odvsDu3cj61/wbvff9HaDg9/gIYoiVM0STsQ6h8PFeXWQ9up2fReTrD/sbG/NDEXtBrdeFAZDNygqVn/tWIeVceaZ6lGw2ZS1eCUyDoI0RJHwqhAcmHwQniIRpBDAzIaBvwKKkG/GEge2zKBs/dIoG8cx0BmOhpTwhFmPG+XM+NB2VzXRONIIj1pHAtfIUWmPg9hlGqSM9WQlNFGMGbmbseH3RuqMzrNTrEmaeXZH1+4QI3QLLODXlH1kVDtjx6yD9oqXke69F+PyndguXg+kOOiq6MPkoN5YFpmDjYCAgjFX8LuSDEYluBe6WEgsh1P7znZ/p1rtP5pkt5wLxKlqrVjGekkc9ydXOgJhRl5sqP7wWwK7CMKeK6IvUiRruaKWDCkO5LjpuXEOY8VPMsUHzcEIBK6hc4PneoKRB7HXYZA8padoVxTqK7iQh24SYTY2KUiBm9Fe0pARqDiRwxgrikc0Xh1dN0R8k56pMJqBIiKw2mNsi8DlIzt2Xf0DsZNSwyBfdphiu97/Rgg3Z0yzMQvdXOHvhGWFhha9Re6Tmu3kOFF7W3vujtvpu1XH3e1uH+zVex33oF+t7W07td2tvU5nv7691999IrtvOSvydCLwEhcbR74/emq5mYhNsUrVziQ9n4BBxHrBQOQ7nn5V595YzEroEVdhPyCHlT+cUCbSVjJrCjIfQcpZuu80gMSUm988IYTq4sREQRO9mrAyGXkjtYukwWx6zvmicYzXBGYQqXAKf3SdJM5rhF2rQghT5c2RQjFUj+JEXmnTnlFk+rgx+G7GqFua45w36aiI7ZZeRBgqU2Q8mlxNjloS1OWEnDFWAt6ykSwyD0jz2A5E+Lz6jrapkXJp4npSeBUp2gxvUjYmQZKuxKIOJ+vIaqv6zouPEzUyCXEjW5ttLU2IZGMI2RU1MQB8lufcyL9LL1SxBm0cAnYf5yhIeOgFa2varUBQ3kJvp1s8Ik71Vp641QsjOUgBAGKCjpuRA7ijYabGXnytZk1vStrSeF5YoN2YR70458IYh2qZDieB5yj4EpAWTHELSiRkdavcVaMFjFw9G7AfSCooHgui4DDlpC0wgbLbS/ZXqYo/tbSEFpbcyuZYrs0h2LoyPd6z6SEneWWBPIddKxtlZaO8Bhtl9hW7smJWVszcVswzltnKzlnZOSs7Z7l2zuzbLzbAdZeaIsiIzRa1P8EN+5ljLAgfna6JJX7Ss08j+uFEWS/Wy3PugFP6itIUUpeQTInRSVNOMixHagQWvmwDUzvSo5vc5VME/J3U4K5SsvvqCdn+zAnLxe9Zzpz9mi41J6dMgYA8bU0LSQ/T5YfhDQY2OAIPFHGS8Np04sbeqG6nzpAsv7bsuj1zmcVvt+tUeAvfFq88IMv1gAi2rjwg79kDIid55QF5DrtWHpCVB+SNeEDEil15QFYekCI9IHKZrTwgKw/IygPy4h4Qsf1etQdEjHHlAXkrHhAxYSsPyFN8Wq3o17CiH0Xc/k7Wq3LKaQ+RTDfSnzySbcRPyUQdWdYoA/WMaCg9tk81WreEOt5kwBU7L8MIF4aBvYwLw0nDQZcFXosJ4E6gl0JNMMFQeFSpQZjQ2LqGn0mUgS/9BLK0iWcj4KWNpJUpAMpGL9BgDBpGJIxRNC180HPMYsCMEvw3pWgy9DLlFAdpuuNpHUo2pJkp17qJFH5s5Lqx60m1LX0OAvJCIkky9rXMalIGC6XRcTG5/OckFxSVDBun2P22cKQF31c40isc6RWO9DfFkeadKEu1ayH4CsGkeagrMOkVmPQKTHoFJr0Ck16BSa/ApFdg0isw6RWYtKkfvhIwaRrMCkz61YBJi9XxBIgySmXyvJj3ZBJfORdI2ajthq4h8i0CZ187sPRUdtgL8uMVAkvPbuK+ILq0kA+vCl2aGbVCl16hS6/QpVfo0it06RW69ApdeoUuvUKXXqFLr9ClV+jSK3TpFbr0d4MunVxHrpOY0V4X+pPp0V6lTxylg9sUdKAYM3lEAgwloflwuGB6FSyanlSsRF+gRt1juMXDpRjhpVKKkODPxxfnTatxcfFfh/+8vG80rX4EA0Wdyr4MMgFhKA2Q3tRIdMNiHBzfpKwcLxIuAOkTOz5qla3Tnz79JnL1ZFw7pkoMhyilxZBt3TT5l4kgO8Eoqa79gzmwoesECQ1KBMIlwhchlGKMSxngCRX2dZuJalMM7LIEmie0flnasFM9ut1rEgiPd6pb5hCcG8TmRoMXdVz0u0p8aHSm8jVVwvGH3E+Z5q2LCWI+Bs/D+HSTgxCkpBwm7Am6YYQWApSeaKdxwCEOvbRwyJYOKJhlPzIyeO6uY0sAeaDvo4AraFKHsIrCzn+AAbHoT7qORXQhrOyU8m8ASZPnWjYJPW0aERCzQxUrGm01pBmo5THnRuTQN0b44xSqc6hdbNT29xTMtQiTXnv012IBXnNzRmpi3aL03ZLOG79so1hrw6LvhVElcMdJRHfWcgSX7YjA6C/hYKV/DCmIcvA0DNzNk/Bu87Pb88bDzZ9ByF+24y6cPSrUE86SxogiIu9BBImjvXVx/LtVt2vmCWe0+ysPSEVz6xFZ1LgqhsDxVxg+Po4TOOhEvPFl0LwfkTQ3W70V9+uR++EysKwfKLKgJTP75EeBy6+ALH7BtPFrJLCUiZ+iBxabdWOGCpr2IxmGwUetSJDgIGdkMGbAy+NQHbaTpB6fWfc2/Y+C9rlgAsWf+GB8RlKMNgLYCpHV/OeCopRcFwUjVBgoCkpNUyMQ16MpF4q17kW62oV5q8p2PLS6kWHcCFSM6/876f+fmzF9D1px6IbWncWC7+kEt7TxeULXG6h0c40On7E4ZNO5SeDo6EONEp/m8yUGzrsjdBl3b3hV4c+pXXttGZQmInG5OJgSaZIQC3R/BgMa6kNxVaCjamDTKA+G9bMbuWtrMaVvBhX3/toB2YSuGal+sRwy1CnUnyIEb2CZxXHpMugHzLQIFJRbt8xoBTQvZe0zAr096EYPI/ihdvq7924XRloGtRskZFC2YFn3+G885spCLyhbd5GX5CTGgJEln0UDi59+0rCaaS4xZqeN0QYOqul2z8NoiLbjg0UAlsew0PtQJyZNT3WmUrxwPOxCj8IuuUmnOeTNhFpFBaX4os1EqweDPDiaQl3hUB6DEdRhpFTjkxGBbwifvgZeYd7o4WbnKb526ju7y58VxhF5wgnSCcFyd4LcMHT+yjQCPcrEkj0gk0RAS9bCR3OogKWGr9BJUBxWCy4yw0016+oyShWlsEEMPBvKge5j7aJIBN+AHd9P7lBusPpmp8KQBIgBxg0BlcI0Bj0M1uIPX1qUMpd3/zK0sU/Xvh91bbwLeihgFhL4t0i4KQ9fTnoxVQpe141ExpZr8VDyZwMdp332og0oGh1FbDiIHDjJu5YbRWg8qmhOs1WQFV7PjK5Fv3eE1axEf9aJi9n+48DIo+vLOC36qf6JjCfX7eskKTinx0H32u3e5OFSNM/Pv5y3v55enH9tXTSP2udfvlwUMJtjsrOLiqtscfOpHBGKdGWxmdHEPcSHgH1hHYbRKIycZxXUmZnoxHWGBUsR7GKZooTag1XFskKkxUoBIvCvjJpnOlvteRKk+cvPv/+x/3m/8WsBXMczNIGOFtB3j3CDopeWVN47lYkplxSfTbizBi6sL9qblJ9J53n2pKpX67VKFf+7qNU/1Koftqp/FHBykSyYSdF/5Exea2EypDD/DHmUI2MQJMybCE33elyXTv982u+kcc6+U3JclZnpsEInYHZEJIqsVaclIupIQIvAKHFEZUGLxB1rTyxM115KeyDZvOAM5KtFfAU88DBfIKUgoUVNEVHOAIOVkpQHtuMFaHqQIzaF1pV7ujipaXri6FgeC9GaXsxA/YQaNbWD5zhZlLPapJT8y0s09fulUIYtLdnw1lanAIVwOMWG4AHyTXEJ5if8wtSMWBFsno5HOALraohdXQlMMAzpQX/HFVFxlU67Jq/af0jeyEs5MlTwUbAbPXTCieYoSCHQ41bxeMihZXC55xpQGst3iAkUCi4WaoQRm2RkLK6eswzCpPVeFG0qCkRjT0nbVNwl2NaxymsXcZ4siSLTq8XZmWVh3+rMpAxfNq/DobsJMrLrLoM/OIg2d74oi3K33xEl94rc00d4dKGTr+AFHVNSHzLh7n6D8Yd38UQuCHsNNHaAytfg4xBllsn5bujnpbAdLoGfrt+3yY2Ed5zjqKgt9dnBqzTXMruSu6l58il/R93v71Z2t5dEJObLtGHYiy+bKSRigr0VY8nbgCXFVLpOvCSB1dSEQTrBksjrjsbtLG7S0og7PPuagk6aShteDfrLokkczu1eBoXtWUdq8x79z5RkicJKIaiqJBHscS1WBysC9NFghPvOROhABakz9vyEkfaGI/gpS8yuxB9G2BnnhjXVIcKNRELPCKPYXhJr3HsMbJnFWdL3EeQ1yL/5PxGOZ24OhgwSjGCY2Y9Nqjm0srRhX7sObEDb6XjtXFSppa3XCWApXK4Nw2f2kVVkusfuO5g32Ph4vLFkKinypiD6fqYuOLhn2p5cEhlGyfulKyOoxSYYimvSIPpdLhUuZjGb+f5LS7ATU6E7KHg+OLKl4P2zVqre10qMHi3hTSQ98VI0eJOiGIbjFRVS+OS2l/N14gXje+tLa8nzVeCpLJbeYwfzkoh4cWm9XCEAdu0yj0turvjjMha5xQtF9601QC+hS2wz5C0QEXKcjoMxXqKz7EJKWS4wvcLzpq0XcV0nUNMQVlkEDJrAbpgxNO5UOA5HNUkJgYGLBTeuTIrtH66WJmVUm2B61OeT/qmwvbRifO1V4j/HwlneATnm4zWNQlRPoVt2zcTjpZGFJ084elg2Za1rDO4PLNE86Lc+VgfgfAOVXl0QTbANB0XpTriyW2Kx046mzpZOQoGJKRkKzLjDpREgQXjaYb8fu8lL0cK9FUdN7P3lzrdXpmLrZWhQ+EXY2dJJKVCjyFDCIMVLJuDWi5IxTMXs8XvP0sgzRIj+0qmOBdAz/9Kag5jlLq3B4mkEsysa1Nm3VjSY4uUqGqLNZ+ht825SwVTJS7UUl01KwbJmgoylSptrB9ElfK8TOZFXWICotAm4O0t1l84h0rQui7wE/iow+VQ2b8UPw07oc/4pbuGlGpg42iK9GHRHrHopIyiJP+7JYAUfbEx83Qvza1qpKBC6/KFIoxT8hmoYU+ZEAHfiRPbgr40y3QhlURGoDoCSZ+Kqv2etlwZ/lcp071PiFko5dQdHxgkxP9NhEm/aReJPNnCd3NAVsI654/qOE3CHjnErr7aHYtqXgF0yZaNGo2pahT3RVf5kR5nW9ZUc92I1xM06o4HjtMKE+66cI+pG5qVTkwK+hS/odH9mhvUNZ59QiGyajtOLT60ySwK6GXf8cIA5WphJGFgNn9C2YNmRQ7SFmLtDa71x1NqQ4TGunHKd+ktFDvhRTv+WV5R4RY/WGgLf/5+jxkXDtv4AmuxjHTTGBe6GdGuZKgwE8krcWFLkAIXti7CBGPbJXYC4XrLSQCoVzILTH0ZMF+YSD2wSWA61nQ/W1eGHSyyxeZmElzhmMl7UXvqA6O5ttUivmANXE5+aYWgKxy6lLsggF+tK/8qGN5kOZXkpXchI/xL3izmKzI8mH9YPUMycXh2IQIFEl8WD+PqqzIEV5lUvLTwDmcHY/uYkGib7/JJgUFik4lnkDdHTyogOx0fW+k/HRxuPhk+s1arV2jI0MZ1LWzRdZuxpLk3LCnrAw9ce9naKupw/2qHz3V7WUEEXqhU01tbPjdrSB6szJQoYLjS+9AHv1OrFDRgaX/KA457rFrUlW62jZvNsaQP2ggw28fISCoMMlL0OLdKapqxxkAkp3tnd2t9ahogcenCYFmjZfT7+3ORbKRlGZkacC/xbQ3BiLIVQZeBT080GhjshqiJIWfxhcxOB8zzQnzBHfBOBLQZcfXpz6PY8p0K3L+Zr+/46Gfr/QkBjQxnpe13EY6An/l0WUV4yLMS2fkO9f8ghoui/CERWrBfLxJuOAHRTbQ4R4lBlJZukS0ycZUxbcUvzM4GE91PrMexi3LFarvmoqWvV3e3qUtbkgnGzOWGzKt4Vjbawx2ValzDUF4KcEvNgWqyGKYsmjkxdUkGj9jRc0eWYjqD/FxY+R6Y6dbBG1l2Ui6WwLI0K1e/XjCrxSdoLZgB3eWI1KLdETsRuyvXQM2Janxexu7ncBTRyXyLYFIMVU4GmXHhT2fP5waZLiTQdUQr/yAkeCtMhJDI3d5MxQsqyEC8F6YsIxQqnR7xQUtzIfQHUCsWIiQonc/DhzDHLwCxEN/5bcGiWIvw2HTXzTKJ37S37YLdatWt727Wd5VDvDUdF4iE22Ast6BUxPQR4aJ01eVejF0qMwqpUCCiaHrOMcVn4zUQl1z7IVDcCeQjqAuVPEfQRFhB3+pgIAOcrMVOUc5SVNDETrEJ0anmLcPsqvzjm+t1ht4sVn3tlgTnGZVk5R0dompGjXJg0esaCSGdvRUIthbk1FWLE1ncfSPJsdvxwsMlgJRXU21AObtarte3Nam2T/HlAaUWEJVeYORUBf2CjrpxTq6G7u1/d6m67B/V6DV/0us7Owe6W4/S2dnu9/nLWjgwzbNMWKlDFUvtnEcnZOmscn17Yzd+by6FeJNoWTbLoZhHSS+rUICBC4S2m119GLqNCWS2GBVkCb55/pZ5zA4SNoJwgyzLlmTeydlgvYu8sFVEmEG58mwNIXQNTfBn6Amsm7deujl6wAkUKKWpR8cPQ94KbpVw3F+iHoMknY3ydVzkYSlQ9QIw/i8aEjy2BpnFhnvULCT+OTvWv5FSP8PZ/jGXbTFyA9daEx52Nq+L87vc71QPbEVdZmKRHQbFF37oLGAijXxGMu95qnG7YbFCTo0cBMuVBZjhjsKaJhQRrbGRE0/JBEB51YSaRkXURKizefHTaskyKLWtdFFiD4zLqxeIqLwUYpiOF9XT8YItK7HCcL21avDgeY0YKkVDk4SrnRUDE0jmzfnhKCxEHQYApBncV3zOMaIoiGuhBJ3Q9qwG/j7CekNXiotiHjaXzh4rdFc4brq63frhBCmQ8SfrX1pLpMqCp3F6R039kdiRm/2ie2T/88WurbH35Ua6C46ALb7/+SIVTNFZe2To8/fGRlaL2YlErhspVakifopaM7EbKtpONbKneMUulXz33bslEmnisBRNqdgV0fllAcMCSKZAPYKiMAy95QXaA+o49Ile+zsGWiZ2zZNYg5gqmV7fJjigOolad+9gfqj6yP3XqX5StFul4ZxvZgsG+B9pr4DnLoj4IkzY5CBa4uLjAOwuKNkPPwCRuDtggQUjmDbkbghhUPIwYI9CZzCTXq/VqpbpXqe1a1a0PtZ0PWwf/X7X6oVpdJsEdtx9GbpEU970oTmahtnZQqe4TtbUP29UP9Z3lUss1kdqwUAuHoWxkkCclzpRZwAlaznDhvNUogF4wKG7dAm0Yat+IkXUt1/fxga74SlNswFwS6oYZ+6UBO+Xla4Y/AYiM0U69VgCTMG0+cJ+bUjyBTcBNqGmHBU8VytKTrjDcZiB4d2dna09OCAzkfqIwSNhts3NxsmDI8hizoNeGihKiz0aYr8ZaiEeYt4u+HDgKcyTC9v6yyAEj24NTfmbE/gUK2HBXEoufjlS1LfJPdyr2RAIyhqF0HzTAryyuzhGRtGJG1w40jeWaywhhoQMT2E0tw3FDMnJ9VLzQUlWYNKrp7rVDOBpRlvE7O58+fjw43DtqfvxUPdivHhzV6oeHjaVJJoV+VrggPk7XpUqhgioINkMi/eby5QCMvcfBKopfrJL0QTMn5OafQuvEAX3tkABDRaYATGXLdZU7fwCNjjvkyR+EPjwN/6BPvwP/1uza9mYcdTcZcXQTGUN/2YPw7ydbW3uVk62drWzZIwpBqyzxmBBOl2/jToiVP0EOIwf6GHQGewBcc3yl8wZusnz6v4W7oDhvgaTrNbgLMoi8wtGHm3Oqv6B18aPW8cvWyY8tJ7A+oSfAi7uh4U8oo3Vok/fgxVbLq3EVpJiybCq/ta9gmlBITXyRRL8Cx8AED5ZG5ndq5Is4i2I1QQMCBTsVqllmGW8tShQY1u3YdYNFzHdOiZqES/YQ+c33BlyMR2RrkSHPVfhcAku+TtLVgtGqUcPLQ1GuVmq1SnXnorYH5v2H7T27Wl0YSXnghnYXZGRR4OSHUv5mAwfCAOOc/SWMP8QIcvii3c2EBS+FiIu7sCJgMLuZuHXV+1qcT+hpY5kkFjRPpynweNFZlhRYvNdWgwwoZxlUkT7X9uKwqKk7FCrjcesLzV1WXWosj46i9pCgIXd1HTqB01vCXBCaa0ZTyYwfHm2b2HHp0yoMwGrD/D2Uhxghhm+ysux/rBLY1qUPVmVvy96tbe9vVcvwkZPAR9s79k5156C2b/3vEkRbkcm9WFG7IqEWJkIvHUvysywBormuJ3w3AIVz7DuRWf4CHkBYIbSnsQqHEZhzKD0U2IYRrORFnPfadTGiKS5zcIMfgibAp3NZ+T96sqqZvuNn3ViDs7AtUba6Sl1Op4HrRFb2zmGk4TgJh5TADIyW1GbDgzphnIRBpdddfDJH0BTolAVJirUzap4l/GR6Nk2gpDEF+U/V8nQEuYisVoDImAUtoVBvgvAuoGppmGGTUEfw9B/HZ6Y1a1kiWELUzbrzegjqT2nG0gCm6tf0MstwrN9cXZzZkTtATa9A0XxOPTwmmSu/HC6NkIJksyAiVzT/MnY77hLWPSqWf4VBIZqNLCeN7cvzX4suWXgZk8aM53KpFVrBZiMakGbkbH4cu0EYtxteZGBFzcUFb5aUSfXQs0sX6vCldN1CYIgGGZhet7Bm1+0te8EYSIx0ekFzhCA4Xp01MnQiDMq2E7+oHNnSReRg0iNY1kCydRaFSdgNffKUojYvRhDbsLOFK5svXXVVVLNcqvWD9dvPxxdNrnz603mzecovG58/Ns/55XnzKFMO9TfMyFmQVyKNr+0kL7hkZK9m5TAT+ONbrRxcyAtcsz0tJQj1RNWInkNKbG8v6LEQcelRgVfRZjC86ngtliHx2en0o7/a48jH8p2LERe5VM+xMEfauWzf+np+YmHEM6UOhiZeTl4xvEcXs7yqEmnqRkzRpvrRJizuWn1re1H2DBBG9YEgyG0zLXzpZs0XCXJNvVDxU2ia1eCOE7u721hMNcTUS0MTxktYCZcjB2uFnAURBrFWJVpuQlpz855EDuhPoCZFD+Kzcrr+Feg4uM/CoKfK9wgEIhRQdDF85Y+wKIJzpQMDwpGYUIxVFkvXKCzKjhy8qbyF4QmUWipgptH1VFkoFGnnzZ/aH49PG+f/zZSrAyEHmOaXj+PGYbXx6y8fLxrwh97znx+XuQIYJPGp4qMy4jp3ng9lSjjKe5xl3BDcroRuVjw7U/xghYmxDfN+SdMjpkoNmVYH3gf72sgRz6u1woHp68jr1h9l4nnz97PG6RG83RCAUXqC9Bi85NooMuOKdkWXogRJzC506pDWMbb++evJxTH1RW3L5qi6k2oRLDCPQPl9Nxgk19ysiCAgWvXCxjaPfvtyfsTrGt79gu9SQzcWobHGlIXZc7veMAM0YK279sC6KtVKVxu5VYgPP1xGiXOJEH8gkC47XnA5fACbFASSu3A94vS6y2aoLKeEZQI8g4WaXg6MkylkjcLeygR90YpZEpHX3m0R9DU6nci9ZX8CnbEy/xr7yxwrP//z5POS6IHBFkDOzzDqSuT6nGRCqVCwwRCmNBty8eXTxW+N8+alzpWTx8TpxeUhpoIGibjxuTweOgOXU5WaVJwbV/8X6jS+vPMCHCgu6iUxJ5tqthTufNIAZxqkgIuTUwhfII6PvGm/XJhfOqMvy7fLI7czHgwWRUxTDDTJKOoygoO3hBaSWV7LISjuOkHgRm28ZY0XsCLIUY8Db/y62Tw6F4VkJXbvuIvFaBH+7gF+lnAZ+yFiS3gIgGjk21E5ZNBTszbEgnQKM38RGk/ZAsLZwVqzEyoyAcyEnRhzTHoosjHHlkE6yKaiGqV5USv1BV2WBaZOli5EUZGUQajOaFZDDx+QK1+IclIYvMBqXRz/btXtqm16DLJuhQ/sJkB/ehAOYSlU2J4QH2PgD7CN3yl4m4wbohcOHS+oII/4UUqvq6Bfi9/j+uJX3uh22/gC3u6KtxNtDp2u8dxwnLj3/BJtYfGKSyzzG1ksmd+BXQgvzCZ/oHzNitMl5zU/dcfSrSKFSgWmiL/By/+KEfiUcaIoOhZbOjBOm7lXlEl1RK1LiQb9aa2xRMkoKs2xZCjdx4GFUJ8WBp6iAwINV7xOIFNZ4KWi5zLobWJgCLoneHn4DxoTybFSpFkSJCfgjcuFFgm9VPtAGZJhEEo9/oqbuOIrDXOETBAOTGSC4jjBngK7yocWb3dVm6DG+aEIcb/61xVjiP77ylo/bl58ss4/HapG63tb9Q0ek/mgjriVZoC8VlHQwQI4Tg43XUU5qzmnOb/4GiocwllVHtfcVhnNqnONR6uqVUVoBhmVkWkBzoL/fJFtXRb4jN0E7xe9hDF+wcB3YgJtBqJBfYcuGEl44vcTjctuR2BPhXAYIlwvNtKRaF9YUBBNLlfGrGmVgB6GB0ujYFDSQd8EHI0YTaXvA8gaV14/cgg0vqiFd8aAz4YAE1gwtN3+fmWIsyQclSYmGR7g3C1ETYg02qEY9KI1B4gBoFTNQPyEP2T54BAgEAneGCQiV2JgVBmE1ABZ+hCOIzwBtdR9MBYOwXdrDwEIsytJ2hUhlRHMTpKqtYtOrABOzTHpkhQeaJaFINgeTQb78ad6MdPy8MP29tYmg+b8488fxef8/u8wyYvPmRRPr2He1r4G6mJDiU1a5nDauHQfovmp+JgjXuC5wE3uELl6GAYe0ArijaWW0orlOY5uLLVcBPSmE5sLwCGLAVTxgYjvwJ+iBO6jR5bAzU01lK8pwPBMbUBzvQxdsRTVz1Sz8GNR01UOtEzoN77LgaewfbPSa66lg61N+XrxVTVy4tgQcEtHvRbNSyEmjlZ7GQOfCffmMZfumXDEGAMzJLNgeWkZY332ZdvTxX3wjJk6eFhQy79PQ0r+xLuHIvU16kBsQBWHRUTyN8IrnUe4jgeC2ZvYKJkz9h90xrJSZxYlMHux8Xxy0up6EOJvSbpErplOCl8YY7eFrh9xHh31h7cr8qmy0RkTy2qhapHLUARglY4SPR4aOj95JX49gUYHQpku7hIKruqAbHWNKtTYKUhbNpGWoUiwFYqJ1u1iTcILcv4Orl2S4bJTOnO44zIxaTRylayJxx3+auLaLaUbG23xw+RqLvXD0LyeLBGgl/nB5JHBOraYDPRWRUOKc4Rjo+vFGJAmCov4mKHgezepPN143O9796pFemYdDws4K/gRfgLTRTZgGUQP8jYWI+nuvSEne8M4sIQVKFHQX+LcpCNUhPqN8+87HdeP+fYG9Uw6gO9ceIfUX5wcxVoOdkN7fJODY7Y0RAhcR3H32i0u8rRFrU8X9XQsT9o/fMN/9SFXGefxTjngl8AOuXSL3E6qE1mghgNx2eH65xiTYTy9Lcj0E0amERjr+5IlnGADEtUdsZZ0HYryklxBbWK7CXlhk9/GIeZ6qcIvkyOgpB2P+2LhSd+L+swqWphMJVQSqWd0VIeJeY2o92bZ4IB2x0wSBBslvMsXFflyJS17TN6y7wr2oD18EC3w5mLpAl8oZUP5ikQrKZubaI1FupaSfnIxw2d1XEC1lAArpwSBHh4fPsKqkvBkuo0Se8bw3Eoix/O18yFHIDhxsvjSB4W3TQS+wIHiwlnJIUmoZvMSEnxZd0EKIvAaOs1UILKeEW0kkmAuy2pCJGJNSWFsnxzXzGS/qdAwY/5ohbztc4fOnGlHjp6J2Q4f+nzxxSbB4ovCaBDNL9f6WaElvja0xBVQ4oyseRcYiSt4xALgEd87MuIKFHGFh/gkV74rlITvCQXxuwFA/I6wD1ewh8/jzwrxMHpHYIcrnMPXgXO4gjh8HRCH3zG64TsENlxhGha5Rt4jnOH3hmT4/kEMv1/8wvcBXUhhjCDKgKSh1y1aH+LbP+7LSHxhFZNCHcV4FJaUG9x6URgMzYhTGBSlalMAoYiLpBjKDEs6Tq+i7pCHi/CHHn4Z9vBlszjOMrzK45LBGcWtZ3CJkDixRKUN5xroeYvwyXshJrW9XgZSAu3QsaAdR6aKeudT3T/Yclx3p7K/69Qq285urdKpuruVarfX3apt9aq1zs5CnEA7+aWYgX0tyg9YBR2sarpvV+1qpV6t1+zqjl3fqlQRbKK2CC8KzKObYEUisuqoZ94sXYcdISrSOqEEHx6nERuvEuYH3i0FZws2ZhLE5BdtjgGPxrOXNOxH8A9uxRcoby+SI1WXuhbvOOLiunB6og36F+P0dH0njr1+OhclwdzKLuP4uN1rdjOoi3oB9MQ92agliq5EW143DeUjIjBULdSOzFklrAfKT4zLMEtwHjAuBMa+DzCaEdNC6UCP3CQKJUiOmTjjDAZMHk1y1rPw+fjivGk1Li7+6/CfqTkZROF4ZINW4MSF5XqiJMcO1t1YWSrULzkIHEp8go8p+51yzpJoTGe+TG01Ex5pVTtc07h7w2x0UhfdIrFAla3E71D7e7Avg9+uKUcpTMwmMebCo/rJD+GYpgkzSpwU06gELQ9a0WKXssgRVumzM3C7GN3zEz29W7Jmx4vg2SjsGKGp0GfHs+aAGG9ybfE50Iw3251pDrxelvk/VavKTzsjpwu01EuT4E9veMVTZFqG35+OT3efx+6iEamI51EKluoZzE8tbnPVz8983WTOop+N9ylyspMg/Z5OAofVjT30kgirnQ826dfxJm2KzVmnSRt4TmzPbNZNu3sQfnnhkHd8AsHkY4q0e5WdL04/Tl7QH+sASvpaIcU1WqcbaM9D475RlTi2XAfUHqnuhzwvXJ3czap8tZ3a7sFcjDE9C0sWIcvPw/tiDDYfZfSnMBzA2jw5OXw+N7ph0McJKG5LaxX3sg3zedlmBJBK4I6TiLCF5Qgu21wf/rJNtwj8uLGnT8PA3TwJ7zY/uz1vPNzEaKXLdgwmJIbFMuY0bObGaAQ9ePdWQwoPATpR09pkCn7iVx6QvD80RmRR47FKTez1OKWwO47BsmVbNwYx0bwfEXSJ2WoKHxOxGxAyusV9wJPio0AAQwBZ/IJp49dIYEZg8APPn2ljVorybadyuaQt4xD2o8Q90Td6Qu2eJO/4zLq36X9p3JSe64PKHEkrsIHAaZHV/Kf9fE5Q2KgtwkaLN+ymQuymwlfT+Jka9Kb/GH7m6NqLr//vHHFKE4VMOKnW7S0QtLJ24ghDR0MJ4ATKpnNTg/E4xWwMfFoYUMBtd8SHNa8eSvfFdmfPZZukLklgWY4LxIdsEFAeDJfI1v0ZRDfUh8LNq9HMYUOMQJVBx6ht/QzKwtoarpogDCruPcKIUiS2L9zGLFcMM1foN66QQazPyAznDxamRhLQFmYuU6A0aC4KM6eMKCR4mwqfakccAWfBT649kHhBGXQYp8d/41FVFud5mSCo3Fz8PflsqWyV+OlnoO5NzB/e/rXV1bPd89CmLvwanNMRnZgcT04mBgnHwwEZjPYzPWnDuFvUF+gxo24ILBAEQ4RvEPtKJgARkpROamKZGaO+Sk9GFEYv8j5UQqjFvDFu59dyLoif4SR9fCZS+azTwM46Yei7TpDH4o/8FUH3M0QIxm04RpiBF0v4hmxwP2j97pKWFL6CKWoXZrivseGuAitmXUXwn3xU/JoXgjMa+TItbugEY0TDIoQVsm4k5pQAW7MnwGsUUAZQKbAPQGeCNffDlxbhueTl4gxt7NO170ddRF2+f1gS5xP4tzi5/DiQ8cR9Ow0lfwb6Y85h8sPBgIKIKQBlEDlwAnctN4oQ0k65YM1WKTTTLGuCtyARwlSI/qwT18Ggv0CDn3oK5o9+qn+SjRLQ/l84a8dB99pFIzc7gc3z8y/n7a+nF+dfWxfNo/b5ly8XS5pBvj4tqphES0RBBKliTD0pBjPasYSItA7DaBRGpgd3QUIT1xkWLCGwi2WKCWoPryhJDnDurxIO0BZq5LaWCmYM6XOkQ/OXn3//Y//zfuPXJXEaz74EGl9AHz3KAvinlg6fL7hrZDRxjwGv6RzOxeivVar430Wt/qFW/bD1DHz+J8jFvT2T8v3IWbrWQqwkYXoZ8iUvsqh7nc5s/hUFjZNI22qarOHfSWOYgHDo8x5n46VQylM5x5Tpl4J3Rn0GaBEwoI64DrFIfLGmw8JxrchTn+TrglzPV2EoKdgbeFg7KaXMoAVL+e7OAFPRk9RtVccL0BwQoM7G/OSeEE5qap4Q/4uxDa3XxYxDwuOldvD8JWtuVnuQMMB4KaZ+Pzc1+OslG7ra4kNpO3QTh+uQXTvBYIrpy98pCH9qpivh59E0HI+oBsnVELtSSP645+BXV0TFlXH7SxjtltP7D8kSWWCNDAa+fYwpxEg0RxHRgR63QlVADs3L2Z576xXmRzyixgWiGIs5UYPFHHrG2pm9DOAkMdJCLiwQVLTPIGd6EXixJfzstnWswtQFKgdLlsj0EHERzrKwJ3XVtQwvNq/DobuJ2MruvDzBjtvc4aJsyQeFpVQPUVb0Eb6kEdzoeJH6ilH4QBbfmMCFYstch/+rCH8+xlAGmdymXICsRD2ck4eu37fJJYOBA+OoqO3y2cGIGdcyu5I7pXnyKX+33O/vVna3FyAMy6O0YaiFBRN+fEBDTZSwQHqm0nLiJQliwMMgnWABkrqjcbvAAKDDs6/KU/7o3OA1mL8IHeLwbBvn2jzHX/M+oeyXHgucURjHHmJ7K3Q17HEtVocg4irTYITLS9sYjKzYGXs+RfmhPgk/ZamHfjOJZdx3xLXt0PHJcCFSQp1+Mw87MFMtmgnYvu9jblDg9h6L5eXmYJgghShmjX28pBZz3Zj5h3rtOlhmyul4bRGwW3ykrlyKDcPP9JHVU7qP7WPm2Xrj4/HGEiijcKuCaPqZuuCIrml7bIGh4wovSkGgqkGEm2+MW/S7+MhdTK4xqxYvDbVSsFx3UADfQ/Y5Fbsf1krV+1qJ/H4KAFfSEM+tJZtUxDAEr6i06Se3rpyXEy8Y31tfWkuYlwJPSrGsHjssFxj4i0vWxTcy42gv7Qjj5oo5wmK+wJ5lrIFr+MImvPmywJkAjaKLOWAqO4gJYgzjh0Rn2UWSsgKwMpMoQqNL3KWQwmNXNM2w5TLCBFHQxp0Kx37o+gEIfxi4iDZ8ZVJs/3C1kKRQ7YAaX59PUqfCwNLK57VXif8cC2dwR9b9wwtLcgsYXBTjWMYioKMnHD0sm5rWNQJBBpZoHnRIv4vuslQBiGXSAdtqUJTegqu2JRYy7VDqbCnDLjBmNjNqM15toUGPrh9i+NJvh/1+7CYvNX7ubbkUPB8QQq59s8bp4+OWnRF0xFKGX+DJnhk9NrWUQd96UTIGls8e6/UsTTczcNGfjOlaKg3zL5s5CFh82VAdk5c68Kmzb33gM8WLH/iinWfoTPNuOsFIyT+15JYx/ILlxcTQF5YYBInQZkQSr3ikVerOUt0ZkE9ebNC3CEkJ/IXhawWRIpu34odhJ6TKVte0JRc2yoouGfYpVX1rtqpf76JY1gSjYbJuioQ6WWvgerihq0Ydh0UXo04ch11PF3p2jBtftfR11daAXRRleA4ENt/6yaZViAxdE092lGldXw9xL1ZD3OD6dw6cD1SfLcFFIOaFupGViUWddQJ758si3Z+J6n3DGQMUHpmm4/TiU0tUv6YbWMcPB5g/w5ULGwJ42WXnXyuJMH5nvXHU2pAhFq6cZtUqDSrmRzk/Sl6XUTEkUAJ94ND/OWpcNGzrD6DJNgCesNyhhGIex0Y6McgiWWM3CUU4tqw2DXvjLvBDJ1VURaXpWHBqw4jpkvZhhEqoseblXS1oJh+sq8MPl1ji5zIJL3HMZCyo/fMBCz221SK9Yg5cTXxqhizpOnjmMS+DJqwr/Ssb3mQ6vJosSG78EveLOYrMjyYf1g9QfJVeHboQMj+Ir7GWGV7gm9eOtPBogJktb07i7GV+J3b/oLBItrPIG6K3kdPfjo+s9Z+OjzYevaZfq1WrtXm1JurnRWgxYxBz6Vjkcp0gLIa9naIuhI926Iy2Fxke6C21gsbX+rlRW8oAdXR7AUOExpcyyJ1avbhBQuNLGGTcc92itlWrddRsni00SC/QmHBLT97CtnU9QKka8rmqtT+R9JoNFd3Z3drfmlecDT044Aq0nj4ff27yLYoMLTIjhtlnYAo5vJsX6gV8arqgLEZ7TFU89ECnofRlRNAYEJZPvDl0e55ToVsE87V9f50M/X8dN04bhoLQ97qIAkpP/FuUgFRhBrb1G+rfQw4JRF9AIDINUZ3iG6+OKLyi2hxikSKV3WmSLoqqzbsGh8Utwc+4As1ZwFrZ3YQqF4tl6ZiObL36qrvb1bnX3oKxkTmhkSqmEY0kUU90Xn4XaKycThztQhdToEPaXJQYsRzjK4L47GmV0+Y3z0DHLiyMikxg6mCNLKgoN698EW1mxtKd3yyr/pPUw80A3PLErCsTPycSM2XG94y4xedFYm4uvlBG7ksEFGKgWiqYMHGigZso2zg/oHDuaMIRpTOPnOChsDOebG3fEt1klPuyBnpyEhmdVuHQ9QKTjkbuC2TqK+KNT+ek/czRIURz0Ir/FhzGo4i9TUdhPJPQXXvLPtitVu3a3nZtZ36KveGoQLfsWoM9sRJNj2NEqHC2ddbkXYoeGjEKq1Khkov0mGWMy8JvJurO9hHNLAKZBsc55aoQZAuizVF5D0YBBwbylTtGTTFQG6gyFaJTy8zICWKVj4luNxCfYbc7jghXhwtf3HG9QsqTEBpf5Cj3Ho2ec+HTmTKRUA9hPk3FtA9HtftAUgXhxAebDMpQQV0K5dpmvVrb3qzWNsnXBZRWRLhphZlTEangNuqsWZ9Mtbu7X93qbrsH9XoNX/S6zs7B7pbj9LZ2e73+/OtFhp61aasUqAKpfbKIJGydNY5PL+zm7835KRaJiUWTKbpZhNySkvwE+CQ8pvT6y8hlNBurxfAHc/JjwfIRfNtBBSSwUMRDGpTii5k5wXoLeyWxUUoSKuHbnOKMNTBx5z3bWXNov3YV8YIVHFISUcuJH4a+F9zMfT1aoE1Pk0yG7TqvYDBMCG9SjHkjFy91TjrGhXmO8ZoerRFyGn8lp3GkcdmMvOj11oRHmY2Z5fqVVzU4v10Nzryp+N6Lbz7KkzdWdfMRWlblNpe5Mt52nc1HCHv3BTZnpP17qaz5CDveaeGOPIrfXS3NaUS+nyKaeRS+t+qZT9D4/ZbNfIIx31e9zCeY8RYKZeaRsKqQ+YIVMnMnYFUa8+VKY+ZOwDuvifk4zW+rGOZjtKyqYC5rVbzt8pePUfau6l7OSugbLHj5GGnfkcH8JktcmoSAkdqOXTdYxBTm1JVJOFQPEaV8b8CFLkRWDRnFqjQaKDXXiam1cOaKGl4eSmq1UqtVqjsXtT0wlT9s79nV6lxIqQM3tLsg24oCFj6UcjN7iR0GGO/qzznmECOG4bhqdzPhoUsZ+MVdWBEQed1MnLLqfS3OJ+60sShZBc3HaQrgWXSWHT4szGurQUaKMy8lpEu1vTgsaooOhbp23PpCc5RVWxqLjb2oPSHGnbtyDp3A6c3Jc0JuzGgMmTHDo20Tkyp9moDh7yWYH4VyDKOM8E1WBv2PVQLbtPTBquxt2bu17f2tahk+chL4aHvH3qnuHNT2rf+dUyQVmTD5Fez7ikw7nwjN42K5IvMwEamq0D9+NwAFb+w7kQkzDw8gBArao4h2bwR9HEqrPkkXuPMiziXsuhghE5f5ct0PsQIvnZ5l5TPoyUo/+r5ZVDxWwBOsr5etrlJP0+m0OjmQPVcYoTZOwiElhQKjJbXZ0JNOGCdhUOl155vAEfwc9LmCdv7aGTXPknkyzZUmTdKVgt+mSlE6YlhE1SqQU8wmlXCIN0F4F1AFIcyKSKgjePqP4zPTMrQscVkvaszceT0E2KZ0TWlMImY1v8wy+WC7ul2dj8GRO0CNq0Dxek49PCZdK78cLjT4guSrGHiueP1l7HbcOdc0KnV/hUEh2sY1A0hb2L48n7Uoisfda1yMmMRjPJdLoTi1NxvRgLQVZ/Pj2A3CuN3wIgPXZmbKvVnS09RDzy7PpcNh0rW5gAk6+Xp6ba6aXbe37Dli5DBa5gVVfoIgeBUa/9CJMPDWTvxRYfVFIwcTzMAyBTKtsyhMwm7ok4cQtWcxAizpdy79tnwzqEv5mTX+rB+s334+vmhyub6fzpvNU37Z+Pyxec4vz5tHmRp+9KM5GCTyp9pO8oJrQ/ZqltAx0Q5econgKl3gjujpbU/wDqpQ6Rzbfnt7DjNfBBxHBd6RmlHOquO1WMY6Z6fNj/5qjyMf6889n6CiCwWfp2oEY4gr5WyFJgBIXsWnRxeqvGcReb1GkMqm+tEmLNxafWt7HpYMEFfxgTCDbTOPdul2xBeJVku9UJU+aJp10I4Tu7vbWPUvxDw3Qw3Fm0KJ+SEHa4Ucxh4GsT7rW25CKmvznkQIKDWgu0QP4rNyuhAMKCG4h8Kgp2pdCBgVFDh0e3nljxCV3LnSt9ThSEwiBqqKJWpUw2PvB16t3cLwBGwlVe/R0F6qVgqKqPPmT+2Px6eN8/9mypVQz0HX+OXjuHFYbfz6y8eLBvyh9/znx0VnnZHYnqqYJ0Nsc+f2UObToszGmcWFz+1KnFbFpzPFA9ZoGEwt75c0JWJ61JBpReClpa+tCvG8Wh8cibyO/G39USY+N38/a5wewdsNgXSjJ0WPwUuujUoNrmhXdCnw/WP2HVOHtHax9c9fTy6OqS9qWzZH5U9Ui2DyeISc7bvBILnmZsXVNtGqFzO2efTbl/MjXsvw7hd8lxq6sfCMdaVMup7b9YaZzGxr3bUH1lWpVrrayC2RefjhMkqcS8QdA8Fz2fGCy+EDGIEgeNy5imWm11o23WA59dgS4BMszvQSYDA+IVMUUFAmoohWyQKEXXu3RdDU6HQi95YNdTonZVIr9pc5Jn7+58nnBWiAARZAws8w0krk+pwxQDkrsHkQ8zB75//l08VvjfPmpU5ekmL/9OLyEHPwgkRcaVweD52By/klTaoKiyv7C3UaX955AQ4UF+wCDMnmAS2FI5800pLO6uZKuBQHFogjIG96LxfmkU6xyvLq8sjtjAeDeaCbFNPMoRflhefIIKE9ZJbR/ETEXScI3KiN14LxAto8eatxsI1fN5tH56LKoQT5HFPlaMTbeoCfJVwbeYhJ9x4irhkJUFSTE/TIrC4/B23Cfl6ErlO2PnAWsBDihNqaLosuyqozYgHZM1RMLy9Uoj6HP6/AnLXShUDyTxlg6gxl1fDwATnxhailA90LrNbF8e9W3a7api2eNdc/sPmNTuUgHMKUV1ivFx9jhAmwit8pHI+Med8Lh44XVJAv/CjlO1XQMcTvcR3xK290u218AW93xduJNodO13huOE7ce36Jtqd4xXU++Y2s2MnvwCaDF2aTP1DSXMXpkjeXn7pjaVWRAqMCU8Tf4A11xYiwyTgnFB3PXy4wNps5VpRpc0StSwkF/WlNrkSZBSrXrGQowseBhbiBFkYpopGPRiP61MlMFeCL6O4LepsYpYAuAF4SWOxchjw6Voo0SyKDiHLrXEGMoBC145Bz2Aeh1K2vuIkr9uubI2SCcGAiHQ/HCXYN2Dc+tHi7q9oENcsPRazz1b+uGJDw31fW+nHz4pN1/ulQNVrf26pv8JjMB3V4plTN5d2Cwh4VKFdyuOmynlltNs35+dZN4bivuvi14rBKH1Wda0BLVfIlQnPEKNVJi24W0NiLbOuyWl3sJniZ5iUMEgrGtRMT0isQDSo1dMFQpBO/n2hcdgs2uhdi7fiYS2h2JHwRVtNC08eVwVH6WKeH4cHSKBiUdFQwoc0iGE3p/aLf4mrrRw4hRxe12M4YJdYQVAIkg7bV368MsZWEo9LExMIDnIiD6eeRhmMTg54HYJyIBmVoBoIn/A/Lz6wHYUc4qCDtGGqdITZEtemHcEyVr7VEfTAWCOH8GpWqA+tKknZF0EuEOZKkCkSioyiAU3BMOiDFoZm474Rhoslg3/dU72Ba1n3Y3t7aZASRf/z5o/ic3/8dJna+eZKi5zXM1drXQF0AKJFIyxlOD5fuDTQPFe9yRAc8F7jJHcLaDsPAA1pBdLFEUtqsPJfRVaSWiMAAdGJz0h3S7kGFHohABfwpStc+ejoJ+dhUJdm1D8bgZOV0tUaGrlh+6meqWfixKFYoB1rm6tcuRzXCNs1KprmWC7Y25ev5VtLIiWNDeC0dHlc0LwWUOCrteQc7EyDIY+7RM+H4MAZjSFrB2tK843v25dPTFTjwnJg6YFgsy7lfwtH/iT76InUr6kBsKBUgRITxN8KTm0esDlqBWZpY+Jmz8R90NrICZiKQm73YeMY4aXU6CPG3JC0i18z7gy+MsdtCF+eC7Q71h7cQ8qmy0RkTyyqcUYsbMecDsBRHiR4PDZ2fvBK/noDXAiFLl1oJRQB1QFa6RrlU7BSkJ5sw8yoAbA1itmu7WDPtgpyng2uX5LDslM4N7rhMjBmNXCU74nGHv5q4kkrprkZb/DC5akv9MDSv60qEXGR+MCn2WQcWE4DeoWhIQXcg+rtejJFSonKAjyHsvneTSqiMx/2+d68rcOMz6yjwQd7zI/wE5hBswNRHD/J2EkO87r0hZ9/COLC2DNZ0f7AS5yYddiHUY5xz3+m4fsy3HKgT0iF658I7pP7i5CjWMq4b2uObHMCmhVLuce3E3Wu3uNDHFrU+XXTTcTppk/DN9tWHXGWZxzvlYJ6TBXKJFrltVCey0gRHf7Ij888xZkV4evmTCSaMPSMa0/clGzjTAqSlO2KN5joUddm4hNHEthJywSafiUMM9VIVHCZHQNkbHvfFgpG+F4VHVYgqmS+o0FHP6AAOE/NaTe/BssEB7QqZJAg2RHiXLxLy5Udaxpi8Zb8R7DV7+CBa4E3EUgS+UAqD8tOIVlK2L9Eai1wdJeXkAobP6riAailBVU5teD08PliE1SPxmXQbJfZK4ZmURI7naydAzsZ34mS+5Q4KaZuIeoHDwoWzj8NsUA3mZSN4se6ChEO0KXRSqehXPQvacCOhW5alQEh8mhLB2DI5bpHJflMhTsac0ap422cKnSfTjhM9E7MdLPT5fAtMIk8XlTQvml/cOllBwb0GKLgVCtwj7HizAHAr7LclYb+9R9i37xTxbQX2lseJd5+2/t4h3t41uts7B3ZbYbo9zZPvFc7tbSO5rUDcvh2I2wq/7dvht31n0G3vBLVtBdi27LXwXrDavgeYtveJ0PZ9gbO9XVw2GYhvA/VOXFhexDXqINDBuhsrCSu7Jr3Fofhh+IYSvihcO4nGRKrM/jDzAyj8zuH6ad0bVjadlF9bxPCpUjv4HS7uB/sy+O2aQn3DxGwSr1I8qtX2EI7pzgsDNh3r8/HFedNqXFz81+E/qQyWAYGjSDDItUvZxEir9HulgQghJWv2LEg1NYUVJ6J58XqZCdEmKt07Ug4OIetQYg4o3tfOrRdGJvfUdQsWEPZdoVpmmGcyP5/jZqM5zFdj9HpZRreqOzv1Z7O3QB2jNAlT8KZYTLfKGSY3ep9A8D2byyPY1iisCpUxqpOX5beZqfWrmanV+K2VSZVq/DWWeU/00mocCayVwzN+ceIFY5E+NXS6X1r88pQjjemN2eQXRH9xra3dHX6u5TjiF7J2X/DkipDLwGw3b0Uo/uYLOdHhXEKuaHgNWh9RCmPjeWvE5I1cLovvydSCm37mqDGmKMjOgrTLnSSBc9EeeknkUu162cAmycjNZ09PoVmT1+LeHlW3Z25YNQMmI5+1YdlRyDvmM9dH5TcXYeindm9gzbiNcmcOKcxOGHYy62QkqAl1X0YdoK7yJsG2/vtRglNaGrVSToUbYRKVPX2d8k/izYtGtVqtb1obWY7RN3mMKfIgN5PI5VqdmUkmTzILZHEmZXmUztmfYNMLS1oMkH5FzDKb35hdfk60kuar270mP/jLbE3Z28K7Uzb0PHbKXwEvatWdg5zVR59P4dBy9+hScsMekbyPqvPPnocp2lVh83AIZxxehOD/W0wFHIdULHoUufI6PjtH30hAzMzPJ+yXwvg5+2+nMDYed15KVlBgOgsMs9dF5a/Z1mLsrVZr00SHDd/NrBPlM/cVipnpkuSZE/S4qVbwBJ2Fd27UunZ9f8EZ+jZCZmZWm+ydptkXzOrn/f7x6VCT4bP/JfES2m4nfF03iMLx6IPFWvVEGXZc9Mori8H5+Cu0wwLGC6U8wFjgUOD1cXeMiBCkKcv2LfTEMy6tl8Su36czySNINbp3AAvauQ29HoICVXruiNINHf8h9mId6s5DuLd3qgeiVfOSDuEbxL2+QN5Hov6Ww5QkEjhT5o6WHOp6o+vCvPctzhcVFwcSaoO75OXYG0fqY4bkMlmdEZcnrXbz8OjnZvu81Wj/dnzxc7vRbLVr9f324cfDNl+lz7pRu76HQAzZePulp1g3P1ckZGWM2HsVx0fIDHM2Q0oc1UEkPLZMLNQ4HtPiGY4TelGhHNqYsW2tqyxJ7e41gdXEdC2kA02MPLM4FOmvfIfgJJS5ki2pcnxs2/b8zOWRFBVJRxiSwAKT10bnAlFs6NyAGBxNXnibATOETDR1LuaaA429I2cBhsLhPjq0hxFZKOLRDINkuULjsnMAKHlSSmVLvprdEynGee3E1/awt1PQxBymJBaGq45gwyfCVYW0fT7asXrewOWrzKPmuZo/ccGoId76s2yZiUArztgKCVIEaRX+r3IaHX1yNnSgFcOuqtgqaiMzE9VPe7uHe5/qhzs7Hz8d7R3tN/c/7n/a/vjp46fq4UHzcJ45ia+d2jebFJCktTc/KwfNrYOto4Ot2tY+/Dmq7+/Xd3cP60cHtZ16bfuodlQ7PGx+rDfmnB191HyT+YHu82dIQ+jqu/PFZ8gA5qWZWs6+2d3f+7S7u9uo7mw3P9X2GtX9Zv1TvbZbbzY+bsORXj2q7+40a0d7+3s7H5t7sKO2Dvdq9cPGQf2o8an6zJnjJISXi68yMgAlbDuNQL4j1S73IEohKBqzlHF56JSk8zBMrMMGpS4dB/3IYbQkvHG7cJ1h2To6/FFly8LrZ+RyiM7/42wVdXyzEGCQIQ3wz/3GBHjeQx37mhPGHzBTGpcaLrFW62RT690WtBT0YHfeZOGfetvuTqe239vt7Ox0YRXt1fcPtur1Wvdgt+PUt5+7mpaR5XEEn29SJoShIxNCG3cyS9KHuTNz8iOwdkOtUsX/LigvAv57Xu0Gg96Fsz6eS/BkEshTxNYO9qrLIJZAoqIi4zEbqHhj9SwUlrCMT4+FTMUaHrEI5qFMQs6QQdAHkipgk9InqaRwwT0MHwfxO2TXJ98fojEFL23rN0b+S8Wa3zqej6isRqC5anfgIudHHtvBVzB6rPhDhpkAlbSXgyIpec6y8lvK54xE1pJYQ108JZGHD/wdieKjsDseKkD5JUniGM5VKvbTZls6LtqsEt3k6w4pI54/QYdZmGewTLHgQZlp/3T4GS34rf1ttGf0g/D3Y4+qeSnNZf+scAG+HS6AOQXfOyhALi/eGCJADg0rOIBlrIS3jQWQQ9C7BwJ4gubvBQUghw3vNDnCpPTd5f9PEvd+kv9Nyt5b5v8U2r7ftP8pDPm+cv6nMOEtJPybQ19l+79gtn+K8atU/5dL9U8x/p3n+efT+raS/PNoWGX4L7oK3nZ6fx5F7yq3/ykC32Bifx5J34Hh+iZT+ou0Z6YEMGoLR5aZHYC2H4hrkjJfaDqjEajAeLmWPSvd7gg0gWhmy8WFxQAKNQr2GSjthKHvOkEeQR/5K6vvOymyBPw7hroG7iDEAhY4R1gxR5fhRMVT3zqCHIypULuIkw2wrB3qQ/h+HASuP/N2C4CMtgyZfdGpVHG6HZc+onFjzbUzgavPNpblpct4HDdOG7p88rpZKchzAofClp0YtVS8+4s3Ez+uqMJqSEOF2536hX1/nQz9v4N1EFTkGCse3sekQ6RERRZtNPgYsE4lRnLLX22C6jrrrERuDHZJkQsOCEgHV9OCE/1SWRh91RVwlCr+dmKVzrzM+D79dUb8irE9N+I3S9K3ividNpI3GPFrzsVcc/A6I37FON9NxK+cprcc8WvOyfuI+P2Ws7LsiN+J2XknEb8zztCbjvgVNBYa8dt6VmxvJqZXnxE81m8T2ys6/4+zFb9scC93vLTg3q2D7e3tmtPZ3dnb2Xbr9epep+bWOts7e52t3e1a75n8WNYVLmh/w1Em1lUEdr6G4F6D3qXc6j6H4BcP7hXEFhto2po5pHRCIOcIgEzQUWECYBUH+e3iIM0p+N7jIHN58cbiIHNoWMVBLmMlvO04yByC3n0c5BM0fy9xkDlseKfXSSal7y4OcpK49xMHaVL23uIgp9D2/cZBTmHI9xUHOYUJbyEO0hz6Kg7yBeMgU4xfxUG+XBxkivHvPA4yn9a3FQeZR8MqDnLRVfC24yDzKHpXcZBPEfgG4yDzSPoODNc3GQeZvqZf9mhPWTWzRk6krjbkdTN8Fot4LfocFJGBh4uPo9NyLnLs+tozySo6PPAUue+D5t7jEDq6wlbRgXSImGQ+RaIEHn0SoRSsgkBiI+fRlKVoCj0pataEyu5p1VFW/4D+SI+WBaO6IaP7o5jA6lKu/Tcx8gY/HLniworu9+HpSMSGciMOR4I6FL9XxmjRawoFoJIRGKtAsaEUVvA3fd/tdV3auQ66Rhy8A7bgadA9eV3o1d/vHzj7B/u1zl6329txTGxXGuwLsm6SO/SeYVdjxkxG5ygMBFnlezeuyRkRj9Zx0XIEngxc5AhbSPLmTrTsoPUcKf7hnbfPlpbqBKFko4qIm8QgQ2ZpPMm+7U7/oN7f2tnb62xt95xdZ6vrHtQPelW36m7vbe0+AhdrsFnS8MLMlt3OvFzN33gMoXTtDa6RiTRk/B3WFLKGrhOPI2FQ0hpWa1KsXzUV5iqWZ8QEk6vVfnV3z3GqHeegWu/szcDUceSbuMRfz0+ewCXG+j0CcZiOwR7qrgQCxDZhiEMRxyRIaLTT4Scx31qKJyVRyJdO5Do36PbuhXcBLqcQWHvtDoEDjO1UhnaSa/H70JJRtotADXPDBcnvtSNqXS4fYLCWRaU0LFVJLRkL9DugDmQ7insUWsjnofPASNoirB1vioPeJrIW+d3zwLpO/Ieyckc4adLYzLaxbfJxYNtlDhtXd86wDtG7MQixD/zqSkBqMefMETJBODBxdY3jBP0c5LAPLd7uqjbdoOuHwt949a8rmrurf19Z68fNi0/W+adD1Wh9b6u+wWMyH9SuE+l+oWDhDvJnlNCOEftQDle1yMNemwEQTOU0yLD3olYE1QXAYWnGccwtSmnZeY7WIra8Io3WEoX89mQ0nu86Pd49iTFVF9nWEc8bow6w2qOHUktEXpdxXWJhJSAaLG6Ea7+mUzP9+4nGZbdwBnthD44DEEzYSAdPBBwfZi6YJ4pOYeCH4cHSKBgYqFn485KNnxl9nYaJCFq+Y8w4QRepQThOfdrJkcYIAs9WbuJE9uAvWF5IeSptAheChYHw2rmnFtZ6afBXqczj4RZKG9n1NBLOLLmI+pEzGM7ms55rDZ3BjhdKuhArFt1o8Sb4+5UhZJJwVJqYL3iAr6iSlN4sBy3IU7SM/Vm0WxmT8m1qwoA4olobeLpQRVJviFLRCeiIfAjHBOyuZd6DMddxEppRXiBKroChNrZ3RUlTFHtKMpP3rReTJzPgaCcME4xk9JQURKRu6VBK6L+bn/ki83O0NPqwvb21GbtO1L3+x58/is/5/d9hMlNzI4XDq58f0KiGYQ9VqZ6WaLRsMeTSDVJ8U/zK2fnwXMAlGK1hGHgwdSB5WKCEHVKEeuq07GDBCLksaCZBvYjNiXYohwxMq0FcVucZFTsADlr/Qdmk7AwRS0wKSGpDmesCVDhecupnqln4MchZzCSSAy2nFCTYjlnBMtcSwdamfJ1aPSMnjg3Zs/R0I9G8Lh1BB5g9MYbk+vmrd6IfDBBP9WHIP8GI0kS3IByed5/GfpAPwrLOHUeoZWlmHDBjmf0Pn6UGRaZmkWoHdSAWK3/bcVn74G9E2l4eDTqoEXg6sagy58s/6Hxh3cT0wJi92CiznbQCGYT4W9qJkWveNsMXxthtoX1GfEVH/WEwpXyqbHTGxLJ2o1qklAJM3RuOEj0eGjo/eSV+jdV+Oq6+NvYoZSHADD8wVkAOgehKqRIgmVhpnzhEOSkTQyXaxdobF4Z1qTslUSstKKR3NHJ1welxh78ypjGjrRlt8cNk4JX6YWgGHpVwQkrmB5OSkrU+wdcelj8bepiqC9Ky68WuL/I9HMr9E+4KfWEdj/t97161SM9QmiuISH6En0AH9wbMaPQgAIedEdin996QQzhgHGCLxKAjQH8JWZxZhRCn0nc6rh+j9PFJXaJz586Fd0j9xclRrAVNN7THN6WsCJ+My1IuNjJsi1oHLWp9ulikg2VSueZAgasPueohj3fKEZWmTC6oIhe56oRkuVCG+bh/gN2LjnZPL1ZRjJ4EUmzknPqSOnbeg8hyR3xkI7A1/2wMg44mNoHYxTaZ6o50bhh2xeQIyK0o0tlZOtH3XXZaKj9QIovGUc+wTWAWNMSzuWPKBge0BT5JEDr27vI3cP5uT0sEk7fsroCdYQ8fRAu85HnPwxcle9I9IFpJ2WZEayyufZRMkusSPqvjnU4tJVbKqe2ph8fSXajyMoRet1FiZwgeDEnkeL42UnO2qRPPfAsKOlabyHgBYe7CkdOlFATU7HihCOrXXZBAmAKA3pCbAF1mTmzwXdsfJBTL0vtI4s3c2sYmyTHUJ/vVzhWj2BrMEq2Dty3zSd5PE/d6JmYT/PR5at2gA73ACIOvovkchdscQcrFK99P9/HSKiTXv/D0Ss0R5CkrxSggYGhjFpz0KNtqVLHOvXWUKSy8imTlqVUiitvh+rh2MHk9cDHyAdQvYLp26cAnHuhVrDZSJyRWwogsw4B+5vXKutIduaPhC4dy8oX1yCeAISiHYuIWqlYH/Q9gbMVKA7P4NXt7w+hBs5xU4aFLUXBhf5ouB/w4OWqcIWsbvJiPVFOmGJgdLV3QTjlIBS7sdJKT/dzh4aHafu31SJHOtVgrAGXUGFQxjIz92PBhrhOrCSd/4nrBc1lCa/2brVnq/VsvWmZBYTWAs9eICpiJqBf1OeMHmL/h5sh3EhSo9nxUFHiwmLPInT13iEbm/tLX2FdVR1ZgOFCBmYgrlaYOqT5d7bO0DPD6P3gYYmyFqdEMjUUI+xHxpWATXuGPYKFc4RrkN0jglVI64d8+z7Pjpw9GTP3M6PHoUXj+cp1cqF2d5rHMRSpvFeKc7N9WpVbZqdRrlTo0V98+qNX39vcq9d0DeHewXd2u1Ld2agc7u3v7u5Va9RmI14LE7Cqel8jli+fWNXr4WMOK0GlsXOzm8cqx3TlFcxT6buHVczicA3vCXB/S9D29z4WOloX6ufE6TuC0nR4o8Qj4E7lkJAaDNjb4DOCfd6ctqStkaSh8lwqhpv6VqoR6gCulMIcp37FaOMmEt6oYTtLxKlVDPciVcriIcqj5+I7VQ03k960gaj58Fyrit9AgzLin16gczB50swTNQY7uvSoFafpe5XmfHuLLH+Wy/9UpPfWUlix6qwewAjx/XWfr7JJuwYNXRel8D2dq4kQDN/kuXROC9FfqlxCjWzklJjnyHXskUhx4q+6IFBGv0hchRrhScRZxRAgmvmMvhKDw+3ZBCCa8Y13JDJZqOwOZyWOETFn60xkCp7gNGT4VUEo/Qf4OXY6Nd6xOFN4Z2dVqd19gqhJno8SIRI4nUWDduR2ZGky5K9gUBr2pQHuBCTBWQ5VB7ovHOvVc7PalxLjobXKOvbPrMHCfsF0KGZBmaVbqOH0n8ubNz/p2qlxgrJZ2arVk8WX/8nzf2dyxq9Y6z8H/bx2efRXzYX1pWbV6u8YhnJ+dLn7w+4bVGMGvf3M7//SSzd3qjl2zazsa0PqfP198Pinzb35yuzfhhsQg2azVoaPPYcfz3c3aTrO2vS+YDM1si4pRitWx3XeGnl9UAg2Qwu1b6zLyM3J7105Shuc6nhOUYfu5bifuYdBx0IP9vJFhID+ZGfdsGZav0/T+wsgbWO2L1ENpDgRmYrKqABIRghcrwZnVxQvmc/gf59ad5NGNGwVuUUZbhgbuTQ2bgUOcu2n7YtvetquVWq1eIZxQrzs5+jftRp4ywxJmwJjfaVP6+yQ/pAnxUvMp+xN7twsyLozL1rgzDpLxY/vVie68zH7FgRVmJsQc/H4l+hHIC2QtgKGAaIp/8RPhJJEIl6GrIQXqyALdwukRWqAbdVHxJzmGsdTahviiHo8R2dL3wztsWZQZ1LnSlAm3rqCINj6A3A7G92VYZF3iaODdl408O+JrFjYC1sRDOF5bi/CEdygvg1IARNqRSAbGUP+ySPM38jwYWkA1OQpHY7ShsDqi7yJkj+8i0itlRGDMPzAqwB6cgNFBuavmYauMXB1F4SiEH3lGfqDT61EJyWxMP5E5q6YMU1gs+lVmnc8qsGpVuzZ5gBY7VANW7Ak1Cg99Qwm/9cWBKdTvX08ap7Mo3vicVLkREEDmcAoT8sHar9bt2p9gzgzW4w1OHhs53Rs3UYBHMed+YOJ2MCAoEyq2wS+pfSeOw64nwPuwiUAmd5PtTsY9Uq02pqMQhUVnfCTKQpNqp5xyjruN1OdRgfgDUQ+bg6H5gloghdLMSDqMCQ6CqlsaADuEHwYD/bPiBZU/EZrFGcVjHiVsX3Y95I3MSuWtwzrwuka+m8i2IIgXRyXox24QQyPrrj2wrT9c96Zs/eYB866d6GaDss+9W8z+UeYZOZoip0+AyxOc8GAk0dRZ5SYsfkgQpyc4ttZlHoloVXyXpn9jCpGPk8f0iXafS+Uj5LG0+5sU5/6Dkr/oFRISCmkPctYKLnQuduRKdgAfBiQLRJNfOrIambG45eq1zVUuToGc9ScflxBqcm2briXCalG7QuCQSYdUz4N965IDbHKHiTZpBEZ70+alDzy/g09hFUe0+OMye0Dg7Os4Phb9iOIl2L+FOWGJ0OMjNixwqWgYazUrWTk+cy3j4szjLyMB6kkUkOvpOTTAZGEVgdlcMrdjH4H4wSKUgLPyWMh8Mf18wOMh1dAMmW1OTtdWJs1NVpzWjqmF0spY4SsUEoIqUWEuLisQKP+j7rWXuFzGiwhMMvxyKAwptk3YBzhYBOiK1LYrSh6s981bkiOygrGv1tdWcwNfcH0Fnx5UjeofSDBGePKT2OcbqUxVXewaE6sf4sHYiXo2vyaQ8D/v3M616482+2GbkIH8TdQPfbc3cLHpzRSBbalrY+pKMvzXL9SQLgSXYoZ+9t8bubgwEuNK5iJm1cq1f5UkXc+4ye36eLjIJPIia0akOlI4qykuxN0w0ppoanK0r8eEs6EaIlSSvHsbx5tZrNxfWzMDexsjfs2utgvyaKoP8hlJW06cbLE66GFv4Jlpdpv36ymbonvr2kMviVwuAY8SbbPv/EmL2/87fNumhNu2Mbi4DQczmlX/OiScedWtKWmxYiae2FhgA6ErYdKaJoX/zszqcYA2FNh/XKTGAjWsbu+WTTiXNDuELXh+dviMqt8ulWwoeltI2WncSpF+xJenmPg+dWqyWyJvinL2RHNWFhSmpyDlkmIhENaPjzYkuICow5EC5cg7Oi3O8batYzMtGx0I5kWf6EA0Km+ls3ydPDNmXfp3sNnaHqx12AJeb0Os9ck1rh0Dk2v9+OjfOXNU4cJH8Gfm4jeE7OkWB1vegB4YVm26gElp2ULaMNQqMNAbsJGUQn9IjLWAVaBS8zINyyw9I92BV+l4AX5KXmF4/w988aPi426t9gw24sJrF7r4ha0J5MeIWZC7VHNLYdWqtX37OYsC2weG2sCaXhgVSJKJGjF5rNMQLB5ChqwLmLSOP3t1I7wotzu6Ls5jxPTBdkumgBtBM4wYEWHCJF98Vu0q6t81+FfgvuBLOGjkLcQQIX1ihDY1sQU/omIZixZDtFFRT8M6qvGQrm1Jao/80EskU4ZuEnnd2Fp3ksTp3gBzMMRH+z0Z1u8eHi3DRvNuPd8duAL1WMR1IE4uOVY2yohVCa91q2aUBrZhIBaCphlRs9iUiLeiMYlKsAREPUUJyFG6pIJOS7fSC7tjJHkjo5/u2DvPm2I3uPWiMMDWZrr9fKG5bprDemrSnQBmWoJW0ioRM1S25pkhutsHJR/bj1/BFIGWDnLsNc3OhRjRUxNDV4hDLDBFjEaW9jwDSKucOq/lXHWXty9m5HCxHnUy309lQZWU/0MbzOunv4Jqpg57Qh1LqKK1BofCaaD16QSIikSO7NJJeIeRM5+Bs+NhiVdzCWvolmgK0DizbvF6vqPEp2qRVkI86abkkuKqr4S60m1tQVuMXvVAnkbgMYJEmWit2IJ+ODVHxiqiJxDD9Q4xpkh7cQJnwJ6oT8fnrQv7SzTgGjrWOn2AwtP62qogLXi/FFSAY33v/3V3bd1tG0f4vb8CR3mInZKQZFm+6Jy0R5bkRI0Uq5bc9I0ECUhEDQIsFpTMPPVv9O/1l3RnZi+zuBG86EgneYhMEpid2Z3dnZ2Z/YYdtVj1GgCShYDRNBYaS1tKB74FXPfR7y6iMSonWLa4ToD1NZPWk+2kIgqm0voa55kgw1kqQBI2qGh6H/pQTtG/y+7RU9FXSxGqa3UxoBBKxwIzNCSPaF2YUa+1MBD3CXoPFwq9CQZYCg7rryc21JfHUE1ODQRA2gU55hiwJWC9HqwY8dDM2DS9xCsJhXK4MxIL55yUKsK3xqug7iS0jJsDRWroJAITS7snYbIgaVYrTDilObnfMqZqH8kCUsTuVFUJ7+bi2oPFlOI9YXwX406oC/bZKnymR6QqF2DjedJKD8DL1fOudy/PL8/c1lKV9T6SO2ZEvnc57ZKFQDhlBGoXJpgBfv+vZs7+ptHceQ00SowVVOEC3u4hgreJBmNG4BB+wOJIQx/JKIqQYBsJrW+nZ5/7cv3PIFLgVISEAoU6Z12VHYA3h1j9BcHxnSAMuDZ1sNlEBym6RYzAy76YBK8O3wxfGvHO7tWgBoVNxOX1dCvOZh1hsuE32aEOK7orqEQT9QfHqVTuaBht5cDyhkUifFZOaqjKRyiK+PM4icFzTR26eawkSHACw3aDNxrEY9fkUnXzWLumKtfxry99yuSDdgCUMF/AjjAuTVM0G3SpUDIg2Fihy2eEtT5hemIWJ42oLaIB2n/667XHJfbkWSENDYy1UOa6c1EkqlYG/f4Hhvrd2fpQpbyfpBKlKUS5Xg13qMHpgZnhHcv3oZaf/I4ihyfHK8v/FNUpxfrlKRXfz6Ei5WqjRwUpTcFJMK3kxy8/lsrSYwnKlpE2c2XdEX82lSgvQSlgVfhHHD2sKMRTF59cb+LKId9AzmdQg3I1sUuavaLof9BalWlWDLAMTQdxQrvfuucFuN+BFX7i8aRiFFIpAKjKEKkK3iEiWt9LXsMan+urvf7e2/7+G2/v4Gj/8Ojg/Z/39o663/cBgShO9ZgSoe+hizQQU3iH0uwfvd47enW4mjSsnPxj1wY/1vRNwhAF+IuoVH6+IuUK1beZPPK0fR89ZgAc6JMsKp1Fmv7wwFj9ZCXiJc/ZycyjavK6W7TzoiI/nFFnh6/21+iE6NssS7sVnWJ1TRxZzxQJW/FCHtzvK4NGyQ3dBHpzeHjw1hxPw+hbKdM8Gw8ov6ycgd5dcBH/Hm0gNLooIKtaHRfZWIoZ1NKU+/goLkTNjHz9boU6vrHc5R61dK+6JElN6ZgpbjlGbet3N3SZ4AIkJCtj7s++VZFshHDHEZ9NgpSq7vagoJHNDadTbKE8DRkekhIwLDDsMZtRyrgNkpiqfpWOPTz8+OHD+5O3p2cfPu69f7f3/nT/1cnJceeVwbgzHn2hO3evTDtF3DUTfEX4LcLUyek0wlAQB6GnLVm7X7yfMu8ikPbISb6YyYmYxKM8gHD5dRSZSOqdJDofYX7TXZbIp+Wf3VGSjeTffX//9a7Ix7tjJLALZ3r8n3+XfXdxcPC2f3FwWK1JBGb54Zv+CsuwLsD9JMdNYc6bTTXTNy95b+R7iuPk+qdJzfdzOE6Wlx7tqIHJ03ievL750dqgPe/ix+sg9T7CSTEW44ydN8mXj6fLrY32szlKOkKvKsVTnyWbJqUzcJsI9QwOjiUZO4vxBz0EqqDn41o67DYRecDR9Kio2UEb031o+UgaLhjalhNMGkP0sT/WGY8qnvOBnnFY+AvSPtGVl9SeBK+b+IQOLWAkNElUcUt0PwOrtR5zvBIFtafYQk39JEfCFK+Eqon6YfZgDYPw3ynUohxj1KKPkQP7IoZp8FPs3o6CqPudrdKi+QP5fMBg+F3fv29mj7LgSw9P4zvKy1ShAxdyEXvEIZvhZNGBI/wwqNObBtHN+GDaDaYC3M1zHBRqrE6+Dl0PI8SfaxULia47pq2UoXPB3IfaAKmc4HYVWdpH6Jagdz39LiSNqmkxTrJ5aGfACXzUeQQ5RGkDiJHVT4pL9Sslg4ydVzHh0J5H5IcBPjDQJOFJKMpKyWZ8jpRy3+VLfjyV/WURZ5piUxzvZBr3g9E43H918Lpddc6BNlzI0YmOJIjuK6U433nHMIb4UCbPQUyFNasgmU/86l5Yym+TetSSaVUR1rpmfdChw9oZMJ1gKK3Mg7NsbchF1+nC+JgG40mcRgN2l3tdNhQpfi28Kxc8P2zAVsl1WWmi15UfqVi4wm6sIIrQ6voBZQqzdMPWHSK1LetlLszGX3EeqXXuVH+uWRToN7SjYL9PkgiLf+MiR7/BiiUAgGlAO421j7R5Qe31zRrXYAYYtrr0go3Nu8Sc5ZL2QUQHMz/WdSPryvpXaruzoSlYQVdvDdd0NqVXbLX0ZrdG129OlYiVG8HNp9NPR97PUG8z86bBjNAU/lrhxTFplpg1LfuT3aOIBV/rNFgaf2pSG6XnP+tnKqTP09uMa7fa/LAcql7pmELD97XqrHbHs5Nrfl9b1+wUfjQW/mKa+Oo5ukAY5ORrhhQ8+2YJhzhrKtTZaWY0D6WDsadJjLIsiYK043Dc2r7Cq0xWTartZsIfzeOk2mRVA4z1srP/7nR/7/1ON3bglhW0wDOM6hkBT1PtvGnjRRR5VIwn3ZnRrRBYaLowGvt1PgLAnQKTJ5SG/sK/q6FrfzfWqGtaWqIe18/29dm+tHSNdpje1jo9y0K/40C09DXrG0mQ7n7XNjWPw621dCVb+nJ+Wt9QPKu043zVvYnzq2oL6MiAUM3WhLEUq41JLd7uGKUaEquhsdLRcfMGNcG6e/rQ4v/+81+hMLCqLKnd5oeN9zX280BulzPAZ6Rnd37YWVkmtQ9LQlWWEdiUPJPPjm/GWz3zIkrwetHzY91wVs+41KwkhpBfsd3pYuk2TJpQPpAtpiVHyuYNW7oNDaOL9XaebF1kRrih6SX277oNG7IqnhPGt3hPtaCay7rQvEUezecpOKVe6q1d7aJ2X78yX9RwoH60O7pxp9TtwJb2trbf6FvXo4Nq27f58S3Hh4rF9ZBGeaUhzmBlhHTP4KuuQWffKItVex98mWK0euFreesEzlzdsbfJTx1WQ7lNBzzBbbX2JwDqLWWl1IrfEXK5YkAHCIr/Jz1T/pUl2dc46AfzIgNYpOyeOyb+Rr9CkBV/WXj8OY95BJc6ZGtIcbtZ8WFINoUq1HM+eazdu27L5mIn370O8qhEFtmlmjUGHFfPTWf/VSdGzgLAe0Vg3EnggBSopD64wjSKvCgGFAuLShXOCRGlCPICUPRJJ4hQjDjvU8JHMHEJvAMyC3IpElwjk+pEdyZxrKMCj+Q+HDPpC/jYU5fwkTW8aRUkQKIQlNl0fkVPqAVLPtnD6zF4idJhCa9cwUUV2TP1natuj0hS4XxcbLOLMcnO7DWqATgmGqnbGHoE5XMY+l4YZMUXjKeXS5hil/a3xhNRNTkVpsuYZgnYhBHxMk7rOZznybb5+vL5wgOAcswWI0bUrEAe24ZwPM+jrvPVdcc08PPbJMKJaPvkIRBmkimnFmS/wX6lMY1yyPY1HolyBNhg4NQ2Sc4r5eYy0HbokRhpiFx2Ix4h3HU2sBYvD1JBiADAy20i+xK5DmaQQxY2rcJO/KJxdDRSDSLos5ZcXK6fb27kYnG5uP77Rc/7HMnNBbMCPn+5BIwuQ3AHmNsBITSaJHxhsvjUNfewztFvFxA0npaHrsvgFHoVw8t0BNRYFspvbTLI70SXmT+dyuWwn8Tp9pqumIoNDByPRJbMiwgtTXtzOlf7PDJhabW3CdcI4ZBnStosl129wqrgqA5wWWhvF/e8DnoJQega8fB1UPpSzBdvJ25Je+I0LmJMimgfxVKrW1KgNVvfSIeI1nIdKrW5TR1yWWhvd1UdKonn6pCK00eiBBcUJAPjiGwKKmqAVblYPgS5tOzsK/UnvaaMAmceKQ3QkBh8WcbYgZTj26JHGyqu/AxeRiW1IK6mKjGhxbf3UH2nRhUt7s56X6B5qB+H7CxMyA51URKeLmNoAR1vEgXyZNCDI4Pasb3hP/sfdf/Av4a8QlGakOIbSET5D7m9AOEQs8iD5CFYCGVeY7J5T9mjhKvHLtLiNW0SVo7DEEVKwWyG/lK9UNYs7Fzwc3Yc6fLzKw3zjR5NhImSbBXZOEs4NJw74UEvANNcXaFQJr0NORVjKj8mxHyK+qxsFNx1B5hlZQ2VnY9gOeCV8516c6WLsYLmhzwLRMltk+EBj/i3rLjJCgbauYImFmDhU1YcoBUEotCfUqIPXYNt4D0pOIj3bOUfizeCLmyBAO8AGUFYEEoKlfaYJouSTmADcbiMU4KgIFrnpx5BhCAwg4HGBm+V6kHA/NGzr9qawTJfdiWFHYIR/pjdMtCONoVgfQtnRR9gFbUxFpAzpA94zziXuauFTcGHOEk4KinhQzjY4d+LSvuAyc9LkSFuTtsSC4LbfJKmiecOxpIkwbLONZDjD7ZSrAxLA0H2XCs9wtnDBF8BueeD0ULq+qDIiqWMq1fxhbWaIuz2lRpTr3RpLoTaVOvIBS/GKTksOwtXam0V0Xh7S+RTqymuyJXV9Ibtypsuqs4O37q2gszzDlbmBK7PY261eqXmjOeTQSbnNxYcRIAiXAYsmjHe7QqjWZRK5lWtR71t9RDtgkBFBOzztEVP5RrPLy/BzT54HxZva+4ihYZzoS7eIUQsrQB9B5C5yj/9wj6c5Tk7gfY1QkD56xOygejrcorZJAs7G+67kvpol16q7VRrUhXqLqQ9y3uqNRUz+enspuddfYI0+6svNwqnL/MQ+g/sAXnc5kTA1TSysCHXZxdnJ/KtL1enxzdnPe9Ufoa/lkppp9E4n8tlBVf1WO5tLjIossJ1FaFJAYinRmrHKgMfEJ435jN95MA9XSRQ7/LFrgvZ3aPLhfQaozTcBa+52N0f9rTeEXeyQfXbkAiFCuVMVB7k0MyqVgCOICZ+I8wodoCxRcH8upX7ofYNyZ2R9YAD9Fze2EHgNg1v6X86m5VWhrbe1t3lWvagP05X2Ge5wPCoXOn6NN1FARB7PXclUG/hvWxHyH/PI3PsW9ExiK/iDQhvMp8CTqA8O1ACPl3aYGJKax+tEjtqrM6PyGBWwXEJS8UN5bTylKoMRASo9YQbKzeXQimIcmXFBVeJMh2aYOBBR9sKKULRipw6TtErD7q0qtyLCk2Y325vqPqO1scv3GHmSXCwZID/DQRlzztjfzPJ49ui//nqpPy2fYMFZ53qkgzlIBLL7nLAiupPpV1rUzobxLykh1SzV7j54rUYtedxZPy5mKtSmOpEGzkL+tSQyhRm6iyPzIkZ6huB3uvr6AxGS7meAVbudp64kMV5NpfaJyZZVlA5FGUASHJ24/+MH8rXxKtbvOaDz2DkqWFnVyOwoubASMNTZk8tTXOtVRgBgmorxNhDzEAZXwQzTJsgmMxgAeEleShSazJiuy0sfUNerqj8nCXXDMBO5ej19UpVwjvcnqRE9jmJOgsWUGVmIDiyezWk37Qq5POU7mbBji53D3LBEDFRalk9o/pANSwY3ingEU8IuiFQNPAIOOZiMGXFlc5ucENXFh8QO4bQN5B4EruWoRu19JdlVgD+HrcY18pMucFAClEydiaxrHus5w3nxW3/HdXAnkTfhn5dahKfho15AEs4GUV3MQXaSqOkWKptuAQDurWWXZ2oabrQirZZjjMPrml5s7zCAAavFFAPpAo5NMpqNg2+0VkSMQnxiDGCyW1Y9kvHNHn8AAh+RMtkp7VL9rX3gp3dxMtVzm2cusKhDLVB63jqXXWv93/QGTlOVz/4twU1TEoEvlBen7xfs4Jb84huUjLWq2uk2m3lgTK9KyZugU/6TrdzfsXjgTcn2iFcuU2Jsksaa3oH1ukBUsOn7IL/A+r9mJg="
 }
@@ -24,6 +24,7 @@ type ProcsConfig struct {
 	MaxProcReadFreq time.Duration `config:"max_proc_read_freq"`
 	Monitored       []ProcConfig  `config:"monitored"`
 	RefreshPidsFreq time.Duration `config:"refresh_pids_freq"`
+	EBPF            bool          `config:"ebpf"`
 }
 
 type ProcConfig struct {
@@ -0,0 +1,105 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux
+// +build linux
+
+package procs
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/cilium/ebpf/link"
+	"github.com/cilium/ebpf/rlimit"
+	"golang.org/x/sys/unix"
+)
+
+//go:generate go run github.com/cilium/ebpf/cmd/bpf2go -cc clang -cflags "-O2 -g -Wall" -tags linux -target bpfel,bpfeb -type owner -type endpoint socketOwner socket_owner.c
+
+// ebpfSocketTracer looks up the owners of local TCP endpoints recorded by
+// the socket owner program, see socket_owner.c. This attributes outgoing
+// connections of processes that exit before the /proc file system is
+// scanned. The program is relocated against the BTF of the running kernel.
+type ebpfSocketTracer struct {
+	objs socketOwnerObjects
+	link link.Link
+}
+
+func newSocketTracer() (socketTracer, error) {
+	// Kernels before 5.11 account the memory of eBPF objects to the
+	// locked memory limit.
+	if err := rlimit.RemoveMemlock(); err != nil {
+		return nil, fmt.Errorf("failed to remove the locked memory limit: %w", err)
+	}
+
+	t := &ebpfSocketTracer{}
+	if err := loadSocketOwnerObjects(&t.objs, nil); err != nil {
+		return nil, fmt.Errorf("failed to load socket owner program: %w", err)
+	}
+	var err error
+	t.link, err = link.Tracepoint("sock", "inet_sock_set_state", t.objs.InetSockSetState, nil)
+	if err != nil {
+		t.objs.Close()
+		return nil, fmt.Errorf("failed to attach socket owner program: %w", err)
+	}
+	return t, nil
+}
+
+// lookup returns the PID and command name of the process that owns the
+// local TCP endpoint.
+func (t *ebpfSocketTracer) lookup(ip net.IP, port uint16) (pid int, name string, ok bool) {
+	var keys []socketOwnerEndpoint
+	if ip4 := ip.To4(); ip4 != nil {
+		k := socketOwnerEndpoint{Family: unix.AF_INET, Port: port}
+		copy(k.Addr[:], ip4)
+		keys = append(keys, k)
+		// IPv4 connections of dual stack sockets are reported
+		// with an IPv4-mapped IPv6 address.
+		k = socketOwnerEndpoint{Family: unix.AF_INET6, Port: port}
+		copy(k.Addr[:], ip4.To16())
+		keys = append(keys, k)
+	} else if ip16 := ip.To16(); ip16 != nil {
+		k := socketOwnerEndpoint{Family: unix.AF_INET6, Port: port}
+		copy(k.Addr[:], ip16)
+		keys = append(keys, k)
+	}
+
+	for i := range keys {
+		var owner socketOwnerOwner
+		if t.objs.Owners.Lookup(&keys[i], &owner) != nil {
+			continue
+		}
+		comm := make([]byte, 0, len(owner.Comm))
+		for _, c := range owner.Comm {
+			if c == 0 {
+				break
+			}
+			comm = append(comm, byte(c))
+		}
+		return int(owner.Pid), string(comm), true
+	}
+	return 0, "", false
+}
+
+func (t *ebpfSocketTracer) Close() error {
+	err := t.link.Close()
+	if cerr := t.objs.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,63 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux
+// +build linux
+
+package procs
+
+import (
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSocketTracer(t *testing.T) {
+	tracer, err := newSocketTracer()
+	if err != nil {
+		// Loading needs privileges, tracefs and the BTF of the kernel.
+		t.Skipf("socket tracer is not available: %v", err)
+	}
+	defer tracer.Close()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	conn, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	local := conn.LocalAddr().(*net.TCPAddr)
+	var (
+		pid int
+		ok  bool
+	)
+	// The owner is recorded when the tracepoint fires for the client
+	// socket, which may happen after Dial returns.
+	require.Eventually(t, func() bool {
+		pid, _, ok = tracer.lookup(local.IP, uint16(local.Port))
+		return ok
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, os.Getpid(), pid)
+
+	_, _, ok = tracer.lookup(net.ParseIP("192.0.2.1"), 1)
+	assert.False(t, ok)
+}
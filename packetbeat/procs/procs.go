@@ -51,6 +51,10 @@ type ProcessesWatcher struct {
 
 	// watcher is the OS-dependent engine for the ProcessWatcher.
 	watcher processWatcher
+
+	// tracer looks up the owners of TCP sockets traced by eBPF.
+	// It is nil if eBPF tracing is disabled or not available.
+	tracer socketTracer
 }
 
 // endpoint is a network address/port number complex.
@@ -97,6 +101,15 @@ type processWatcher interface {
 	GetLocalIPs() ([]net.IP, error)
 }
 
+// socketTracer looks up the owners of local TCP endpoints recorded in the
+// kernel when the connection was established.
+type socketTracer interface {
+	// lookup returns the PID and command name of the process
+	// owning the endpoint.
+	lookup(ip net.IP, port uint16) (pid int, name string, ok bool)
+	Close() error
+}
+
 // init sets up the necessary data structures for the ProcessWatcher.
 func (proc *ProcessesWatcher) init(config ProcsConfig, watcher processWatcher) error {
 	proc.watcher = watcher
@@ -123,9 +136,27 @@ func (proc *ProcessesWatcher) init(config ProcsConfig, watcher processWatcher) e
 
 	proc.monitored = config.Monitored
 
+	if proc.enabled && config.EBPF {
+		proc.tracer, err = newSocketTracer()
+		if err != nil {
+			logp.Warn("eBPF socket tracing is not available, falling back to /proc: %v", err)
+			proc.tracer = nil
+		} else {
+			logp.Info("eBPF socket tracing enabled")
+		}
+	}
+
 	return nil
 }
 
+// Close releases the resources of the ProcessWatcher.
+func (proc *ProcessesWatcher) Close() error {
+	if proc.tracer == nil {
+		return nil
+	}
+	return proc.tracer.Close()
+}
+
 // FindProcessesTupleTCP looks up local process information for the source and
 // destination addresses of TCP tuple
 func (proc *ProcessesWatcher) FindProcessesTupleTCP(tuple *common.IPPortTuple) (procTuple *common.ProcessTuple) {
@@ -184,6 +215,15 @@ func (proc *ProcessesWatcher) isLocalIP(ip net.IP) bool {
 }
 
 func (proc *ProcessesWatcher) findProc(address net.IP, port uint16, transport applayer.Transport) *process {
+	// The owners of TCP sockets traced by eBPF are recorded when the
+	// connection is established, so they take precedence over a
+	// possibly stale mapping obtained from the OS.
+	if proc.tracer != nil && transport == applayer.TransportTCP {
+		if p := proc.findTracedProc(address, port); p != nil {
+			return p
+		}
+	}
+
 	proc.mu.Lock()
 	procMap, ok := proc.portProcMap[transport]
 	proc.mu.Unlock()
@@ -206,6 +246,24 @@ func (proc *ProcessesWatcher) findProc(address net.IP, port uint16, transport ap
 	return nil
 }
 
+// findTracedProc returns the process owning the TCP endpoint according to
+// the socket tracer. If the process has already exited, the command name
+// recorded by the tracer is used.
+func (proc *ProcessesWatcher) findTracedProc(address net.IP, port uint16) *process {
+	pid, name, ok := proc.tracer.lookup(address, port)
+	if !ok {
+		return nil
+	}
+	proc.mu.Lock()
+	defer proc.mu.Unlock()
+	if p := proc.getProcessInfo(pid); p != nil {
+		return p
+	}
+	p := &process{pid: pid, name: name, expires: time.Now().Add(processCacheExpiration)}
+	proc.processCache[pid] = p
+	return p
+}
+
 func lookupMapping(address net.IP, port uint16, procMap map[endpoint]portProcMapping) (p portProcMapping, found bool) {
 	// Precedence when one socket is bound to a specific IP:port and another one
 	// to INADDR_ANY and same port is not clear. Seems that the last one to bind
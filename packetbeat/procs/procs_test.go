@@ -305,3 +305,65 @@ func TestFindProcessTuple(t *testing.T) {
 		})
 	}
 }
+
+type mockTracer map[endpoint]process
+
+func (t mockTracer) lookup(ip net.IP, port uint16) (pid int, name string, ok bool) {
+	p, ok := t[endpoint{address: ip.String(), port: port}]
+	return p.pid, p.name, ok
+}
+
+func (t mockTracer) Close() error { return nil }
+
+func TestFindProcessTracer(t *testing.T) {
+	_ = logp.TestingSetup()
+	w := newMockWatcher(
+		[]net.IP{net.ParseIP("192.168.1.1")},
+		[]runningProcess{
+			{
+				process: process{name: "nginx", pid: 10},
+				ports:   []endpoint{{address: anyIPv4, port: 80}},
+				proto:   applayer.TransportTCP,
+			},
+			{
+				process: process{name: "stale", pid: 11},
+				ports:   []endpoint{{address: anyIPv4, port: 40000}},
+				proto:   applayer.TransportTCP,
+			},
+			{
+				process: process{name: "curl", pid: 12},
+				proto:   applayer.TransportTCP,
+			},
+		},
+	)
+	procs := ProcessesWatcher{}
+	err := procs.init(ProcsConfig{Enabled: true}, w)
+	assert.NoError(t, err)
+	procs.tracer = mockTracer{
+		// curl is still running, wget has already exited.
+		{address: "192.168.1.1", port: 40000}: {pid: 12, name: "curl"},
+		{address: "192.168.1.1", port: 40001}: {pid: 13, name: "wget"},
+	}
+
+	for _, test := range []struct {
+		port uint16
+		pid  int
+		name string
+	}{
+		{port: 80, pid: 10, name: "nginx"},
+		{port: 40000, pid: 12, name: "curl"},
+		{port: 40001, pid: 13, name: "wget"},
+	} {
+		tuple := common.IPPortTuple{
+			BaseTuple: common.BaseTuple{
+				SrcIP:   net.ParseIP("192.168.1.1"),
+				SrcPort: test.port,
+				DstIP:   net.ParseIP("10.1.1.1"),
+				DstPort: 443,
+			},
+		}
+		result := procs.FindProcessesTuple(&tuple, applayer.TransportTCP)
+		assert.Equal(t, test.pid, result.Src.PID, "port %d", test.port)
+		assert.Equal(t, test.name, result.Src.Name, "port %d", test.port)
+	}
+}
@@ -0,0 +1,148 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build ignore
+
+// The socket owner program is attached to the sock:inet_sock_set_state
+// tracepoint. When a TCP socket enters SYN_SENT, which happens in the context
+// of the process calling connect, it records the PID and command name of the
+// process for the socket. When the socket becomes ESTABLISHED its local
+// address and port are known and the owner is moved to a map keyed by the
+// local endpoint, which is read by the process watcher.
+//
+// The fields of the tracepoint are relocated against the BTF of the running
+// kernel when the program is loaded (CO-RE), so no kernel headers are needed.
+// Regenerate the Go bindings and objects with go generate.
+
+typedef unsigned char __u8;
+typedef unsigned short __u16;
+typedef unsigned int __u32;
+typedef unsigned long long __u64;
+
+#define SEC(name) __attribute__((section(name), used))
+#define __uint(name, val) int(*name)[val]
+#define __type(name, val) typeof(val) *name
+
+#define BPF_MAP_TYPE_LRU_HASH 9
+#define BPF_ANY 0
+#define BPF_FIELD_BYTE_SIZE 1
+
+#define AF_INET 2
+#define IPPROTO_TCP 6
+
+// TCP states from include/net/tcp_states.h.
+#define TCP_ESTABLISHED 1
+#define TCP_SYN_SENT 2
+#define TCP_CLOSE 7
+
+#define TASK_COMM_LEN 16
+
+static void *(*bpf_map_lookup_elem)(void *map, const void *key) = (void *)1;
+static long (*bpf_map_update_elem)(void *map, const void *key, const void *value, __u64 flags) = (void *)2;
+static long (*bpf_map_delete_elem)(void *map, const void *key) = (void *)3;
+static __u64 (*bpf_get_current_pid_tgid)(void) = (void *)14;
+static long (*bpf_get_current_comm)(void *buf, __u32 size) = (void *)16;
+
+// The fields of the tracepoint used by the program. The protocol is a
+// single byte before Linux 5.6.
+struct trace_event_raw_inet_sock_set_state {
+	const void *skaddr;
+	int oldstate;
+	int newstate;
+	__u16 sport;
+	__u16 family;
+	__u16 protocol;
+	__u8 saddr[4];
+	__u8 saddr_v6[16];
+} __attribute__((preserve_access_index));
+
+// owner is the process that connected a socket.
+struct owner {
+	__u32 pid;
+	char comm[TASK_COMM_LEN];
+};
+
+// endpoint is the local address of a connected socket. The family and port
+// are in host byte order, the address in network byte order.
+struct endpoint {
+	__u16 family;
+	__u16 port;
+	__u8 addr[16];
+};
+
+// pending holds the owners of the sockets being connected, by socket address.
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 4096);
+	__type(key, __u64);
+	__type(value, struct owner);
+} pending SEC(".maps");
+
+// owners holds the owners of the connected sockets, by local endpoint.
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 16384);
+	__type(key, struct endpoint);
+	__type(value, struct owner);
+} owners SEC(".maps");
+
+SEC("tracepoint/sock/inet_sock_set_state")
+int inet_sock_set_state(struct trace_event_raw_inet_sock_set_state *ctx)
+{
+	__u16 protocol;
+	if (__builtin_preserve_field_info(ctx->protocol, BPF_FIELD_BYTE_SIZE) == 1)
+		protocol = *(__u8 *)&ctx->protocol;
+	else
+		protocol = ctx->protocol;
+	if (protocol != IPPROTO_TCP)
+		return 0;
+
+	__u64 sk = (__u64)ctx->skaddr;
+	int newstate = ctx->newstate;
+
+	if (newstate == TCP_SYN_SENT) {
+		struct owner owner = {.pid = bpf_get_current_pid_tgid() >> 32};
+		bpf_get_current_comm(&owner.comm, sizeof(owner.comm));
+		bpf_map_update_elem(&pending, &sk, &owner, BPF_ANY);
+		return 0;
+	}
+	if (newstate != TCP_ESTABLISHED && newstate != TCP_CLOSE)
+		return 0;
+
+	if (newstate == TCP_ESTABLISHED && ctx->oldstate == TCP_SYN_SENT) {
+		struct owner *owner = bpf_map_lookup_elem(&pending, &sk);
+		if (owner) {
+			struct endpoint local = {.family = ctx->family, .port = ctx->sport};
+			if (local.family == AF_INET) {
+#pragma unroll
+				for (int i = 0; i < sizeof(ctx->saddr); i++)
+					local.addr[i] = ctx->saddr[i];
+			} else {
+#pragma unroll
+				for (int i = 0; i < sizeof(ctx->saddr_v6); i++)
+					local.addr[i] = ctx->saddr_v6[i];
+			}
+			bpf_map_update_elem(&owners, &local, owner, BPF_ANY);
+		}
+	}
+
+	// Forget the sockets that are connected or closed.
+	bpf_map_delete_elem(&pending, &sk);
+	return 0;
+}
+
+char LICENSE[] SEC("license") = "Dual BSD/GPL";
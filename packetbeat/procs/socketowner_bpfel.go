@@ -0,0 +1,133 @@
+// Code generated by bpf2go; DO NOT EDIT.
+//go:build (386 || amd64 || amd64p32 || arm || arm64 || loong64 || mips64le || mips64p32le || mipsle || ppc64le || riscv64) && linux
+
+package procs
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io"
+
+	"github.com/cilium/ebpf"
+)
+
+type socketOwnerEndpoint struct {
+	Family uint16
+	Port   uint16
+	Addr   [16]uint8
+}
+
+type socketOwnerOwner struct {
+	Pid  uint32
+	Comm [16]int8
+}
+
+// loadSocketOwner returns the embedded CollectionSpec for socketOwner.
+func loadSocketOwner() (*ebpf.CollectionSpec, error) {
+	reader := bytes.NewReader(_SocketOwnerBytes)
+	spec, err := ebpf.LoadCollectionSpecFromReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("can't load socketOwner: %w", err)
+	}
+
+	return spec, err
+}
+
+// loadSocketOwnerObjects loads socketOwner and converts it into a struct.
+//
+// The following types are suitable as obj argument:
+//
+//	*socketOwnerObjects
+//	*socketOwnerPrograms
+//	*socketOwnerMaps
+//
+// See ebpf.CollectionSpec.LoadAndAssign documentation for details.
+func loadSocketOwnerObjects(obj interface{}, opts *ebpf.CollectionOptions) error {
+	spec, err := loadSocketOwner()
+	if err != nil {
+		return err
+	}
+
+	return spec.LoadAndAssign(obj, opts)
+}
+
+// socketOwnerSpecs contains maps and programs before they are loaded into the kernel.
+//
+// It can be passed ebpf.CollectionSpec.Assign.
+type socketOwnerSpecs struct {
+	socketOwnerProgramSpecs
+	socketOwnerMapSpecs
+}
+
+// socketOwnerSpecs contains programs before they are loaded into the kernel.
+//
+// It can be passed ebpf.CollectionSpec.Assign.
+type socketOwnerProgramSpecs struct {
+	InetSockSetState *ebpf.ProgramSpec `ebpf:"inet_sock_set_state"`
+}
+
+// socketOwnerMapSpecs contains maps before they are loaded into the kernel.
+//
+// It can be passed ebpf.CollectionSpec.Assign.
+type socketOwnerMapSpecs struct {
+	Owners  *ebpf.MapSpec `ebpf:"owners"`
+	Pending *ebpf.MapSpec `ebpf:"pending"`
+}
+
+// socketOwnerObjects contains all objects after they have been loaded into the kernel.
+//
+// It can be passed to loadSocketOwnerObjects or ebpf.CollectionSpec.LoadAndAssign.
+type socketOwnerObjects struct {
+	socketOwnerPrograms
+	socketOwnerMaps
+}
+
+func (o *socketOwnerObjects) Close() error {
+	return _SocketOwnerClose(
+		&o.socketOwnerPrograms,
+		&o.socketOwnerMaps,
+	)
+}
+
+// socketOwnerMaps contains all maps after they have been loaded into the kernel.
+//
+// It can be passed to loadSocketOwnerObjects or ebpf.CollectionSpec.LoadAndAssign.
+type socketOwnerMaps struct {
+	Owners  *ebpf.Map `ebpf:"owners"`
+	Pending *ebpf.Map `ebpf:"pending"`
+}
+
+func (m *socketOwnerMaps) Close() error {
+	return _SocketOwnerClose(
+		m.Owners,
+		m.Pending,
+	)
+}
+
+// socketOwnerPrograms contains all programs after they have been loaded into the kernel.
+//
+// It can be passed to loadSocketOwnerObjects or ebpf.CollectionSpec.LoadAndAssign.
+type socketOwnerPrograms struct {
+	InetSockSetState *ebpf.Program `ebpf:"inet_sock_set_state"`
+}
+
+func (p *socketOwnerPrograms) Close() error {
+	return _SocketOwnerClose(
+		p.InetSockSetState,
+	)
+}
+
+func _SocketOwnerClose(closers ...io.Closer) error {
+	for _, closer := range closers {
+		if err := closer.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Do not access this directly.
+//
+//go:embed socketowner_bpfel.o
+var _SocketOwnerBytes []byte
@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+)
+
+var detectMethods = [][]byte{
+	[]byte("GET "), []byte("POST "), []byte("PUT "), []byte("DELETE "),
+	[]byte("HEAD "), []byte("OPTIONS "), []byte("PATCH "), []byte("CONNECT "),
+	[]byte("TRACE "),
+}
+
+// Detect returns the confidence that payload starts an HTTP/1.x request or
+// response. A complete request or status line gives full confidence, a
+// method or version prefix alone gives partial confidence.
+func (http *httpPlugin) Detect(payload []byte) float64 {
+	line, complete := payload, false
+	if i := bytes.Index(payload, constCRLF); i >= 0 {
+		line, complete = payload[:i], true
+	}
+
+	if bytes.HasPrefix(line, constHTTPVersion) {
+		// Status line: HTTP/1.1 200 OK
+		if complete && len(line) >= 12 && line[8] == ' ' && isDigits(line[9:12]) {
+			return 1
+		}
+		return 0.5
+	}
+
+	for _, method := range detectMethods {
+		if !bytes.HasPrefix(line, method) {
+			continue
+		}
+		// Request line: GET /path HTTP/1.1
+		if complete {
+			i := bytes.LastIndexByte(line, ' ')
+			if i > len(method) && bytes.HasPrefix(line[i+1:], constHTTPVersion) {
+				return 1
+			}
+		}
+		return 0.5
+	}
+	return 0
+}
+
+func isDigits(b []byte) bool {
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return len(b) > 0
+}
@@ -0,0 +1,46 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	for _, test := range []struct {
+		payload    string
+		confidence float64
+	}{
+		{"GET /index.html HTTP/1.1\r\nHost: example.com\r\n\r\n", 1},
+		{"POST /api HTTP/1.0\r\n", 1},
+		{"HTTP/1.1 200 OK\r\nContent-Length: 0\r\n\r\n", 1},
+		{"GET /index.html HT", 0.5},
+		{"HTTP/1.1 2", 0.5},
+		{"GET /index.html\r\n", 0.5},
+		{"GETTING /index.html HTTP/1.1\r\n", 0},
+		{"*1\r\n$4\r\nPING\r\n", 0},
+		{"", 0},
+	} {
+		assert.Equal(t, test.confidence, (&httpPlugin{}).Detect([]byte(test.payload)), "%q", test.payload)
+	}
+}
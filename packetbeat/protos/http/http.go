@@ -35,6 +35,7 @@ import (
 	"github.com/elastic/beats/v7/packetbeat/pb"
 	"github.com/elastic/beats/v7/packetbeat/procs"
 	"github.com/elastic/beats/v7/packetbeat/protos"
+	"github.com/elastic/beats/v7/packetbeat/protos/sampling"
 	conf "github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/mapstr"
@@ -101,6 +102,8 @@ type httpPlugin struct {
 
 	transactionTimeout time.Duration
 
+	payloadSampler *sampling.PayloadSampler
+
 	results protos.Reporter
 	watcher *procs.ProcessesWatcher
 }
@@ -160,6 +163,7 @@ func (http *httpPlugin) setFromConfig(config *httpConfig) {
 	for i, header := range config.RedactHeaders {
 		http.redactHeaders[i] = strings.ToLower(header)
 	}
+	http.payloadSampler = sampling.NewPayloadSampler(config.PayloadSample, http.redactPayload)
 
 	for _, list := range [][]string{config.IncludeBodyFor, config.IncludeRequestBodyFor} {
 		http.parserConfig.includeRequestBodyFor = append(http.parserConfig.includeRequestBodyFor, list...)
@@ -630,6 +634,17 @@ func (http *httpPlugin) newTransaction(requ, resp *message) beat.Event {
 	}
 
 	pb.MarshalStruct(evt.Fields, "http", httpFields)
+
+	if http.payloadSampler.Sample() {
+		var request, response []byte
+		if requ != nil {
+			request = []byte(http.makeRawMessage(requ))
+		}
+		if resp != nil {
+			response = []byte(http.makeRawMessage(resp))
+		}
+		http.payloadSampler.Attach(&evt, request, response)
+	}
 	return evt
 }
 
@@ -813,6 +828,52 @@ func (http *httpPlugin) hideHeaders(m *message) {
 	}
 }
 
+// redactPayload masks the values of the headers listed in redact_headers
+// and of the cookie headers in a sampled payload. Authorization headers
+// are already masked in the raw message when redact_authorization is set.
+func (http *httpPlugin) redactPayload(payload []byte, isRequest bool) []byte {
+	headers := payload
+	if end := bytes.Index(payload, []byte("\r\n\r\n")); end >= 0 {
+		headers = payload[:end]
+	}
+
+	// Skip the request or status line.
+	offset := bytes.Index(headers, constCRLF)
+	for offset >= 0 && offset < len(headers) {
+		offset += len(constCRLF)
+		end := bytes.Index(headers[offset:], constCRLF)
+		if end < 0 {
+			end = len(headers)
+		} else {
+			end += offset
+		}
+
+		line := headers[offset:end]
+		colon := bytes.IndexByte(line, ':')
+		if colon > 0 && http.mustRedactPayloadHeader(string(bytes.ToLower(bytes.TrimSpace(line[:colon])))) {
+			for i := offset + colon + 1; i < end; i++ {
+				if payload[i] != ' ' && payload[i] != '\t' {
+					payload[i] = '*'
+				}
+			}
+		}
+		offset = end
+	}
+	return payload
+}
+
+func (http *httpPlugin) mustRedactPayloadHeader(name string) bool {
+	if name == "cookie" || name == "set-cookie" {
+		return true
+	}
+	for _, header := range http.redactHeaders {
+		if name == header {
+			return true
+		}
+	}
+	return false
+}
+
 func (http *httpPlugin) hideSecrets(values url.Values) url.Values {
 	params := url.Values{}
 	for key, array := range values {
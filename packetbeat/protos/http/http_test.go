@@ -1320,6 +1320,56 @@ func TestHttpParser_sendRequestResponse(t *testing.T) {
 	assert.Equal(t, resp, contents)
 }
 
+func TestHttpParser_payloadSample(t *testing.T) {
+	req := "POST /login HTTP/1.1\r\n" +
+		"Cookie: session=abc123\r\n" +
+		"X-Api-Key: secret\r\n" +
+		"Content-Length: 4\r\n" +
+		"\r\n" +
+		"user"
+	resp := "HTTP/1.1 200 OK\r\n" +
+		"Set-Cookie: session=def456\r\n" +
+		"Content-Length: 0\r\n" +
+		"\r\n"
+
+	var store eventStore
+	cfg := conf.MustNewConfigFrom(map[string]interface{}{
+		"payload_sample.rate":      1,
+		"payload_sample.max_bytes": 70,
+		"redact_headers":           []string{"X-Api-Key"},
+	})
+	plugin, err := New(false, store.publish, &procs.ProcessesWatcher{}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	http := plugin.(*httpPlugin)
+
+	tcptuple := testCreateTCPTuple()
+	packet := protos.Packet{Payload: []byte(req)}
+	private := protos.ProtocolData(&httpConnectionData{})
+	private = http.Parse(&packet, tcptuple, 0, private)
+
+	packet.Payload = []byte(resp)
+	private = http.Parse(&packet, tcptuple, 1, private)
+	http.ReceivedFin(tcptuple, 1, private)
+
+	trans := expectTransaction(t, &store)
+	assert.NotContains(t, trans, "request")
+	assert.NotContains(t, trans, "response")
+	assert.Equal(t, map[string]interface{}{
+		"encoding": "utf-8",
+		"request": "POST /login HTTP/1.1\r\n" +
+			"Cookie: **************\r\n" +
+			"X-Api-Key: ******\r\n" +
+			"Conte",
+		"response": "HTTP/1.1 200 OK\r\n" +
+			"Set-Cookie: **************\r\n" +
+			"Content-Length: 0\r\n" +
+			"\r\n",
+		"truncated": true,
+	}, trans["payload_sample"])
+}
+
 func testCreateTCPTuple() *common.TCPTuple {
 	t := &common.TCPTuple{
 		IPLength: 4,
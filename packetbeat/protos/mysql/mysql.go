@@ -18,9 +18,13 @@
 package mysql
 
 import (
+	"bytes"
+	"compress/zlib"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -34,6 +38,7 @@ import (
 	"github.com/elastic/beats/v7/packetbeat/pb"
 	"github.com/elastic/beats/v7/packetbeat/procs"
 	"github.com/elastic/beats/v7/packetbeat/protos"
+	"github.com/elastic/beats/v7/packetbeat/protos/sampling"
 	"github.com/elastic/beats/v7/packetbeat/protos/tcp"
 )
 
@@ -45,6 +50,18 @@ const (
 	mysqlCmdStmtClose   = 25
 )
 
+// Capability flags
+const (
+	clientCompress = 0x0020
+	clientSSL      = 0x0800
+)
+
+// Protocol version of the initial handshake packet sent by the server.
+const mysqlHandshakeV10 = 0x0a
+
+// Size of the header of the packets of the compressed protocol.
+const compressedHeaderLen = 7
+
 const maxPayloadSize = 100 * 1024
 
 var (
@@ -83,6 +100,9 @@ type mysqlMessage struct {
 
 	statementID    int
 	numberOfParams int
+
+	// isHandshake is set for the messages of the connection phase.
+	isHandshake bool
 }
 
 type mysqlTransaction struct {
@@ -104,6 +124,12 @@ type mysqlTransaction struct {
 	requestRaw  string
 	responseRaw string
 
+	// wire payloads, only kept for transactions selected by the
+	// payload sampler
+	sampled         bool
+	requestPayload  []byte
+	responsePayload []byte
+
 	statementID int      // for prepare statement
 	params      []string // for execute statement param
 }
@@ -116,6 +142,35 @@ type mysqlStream struct {
 	isClient    bool
 
 	message *mysqlMessage
+
+	conn *mysqlConnection
+
+	// compressed protocol packets not decompressed yet
+	isCompressed bool
+	compressed   []byte
+}
+
+type connectionPhase uint8
+
+const (
+	// Connections are assumed to be in the command phase unless the
+	// handshake has been seen, so that connections established before
+	// the capture started are parsed.
+	mysqlPhaseCommand connectionPhase = iota
+	mysqlPhaseHandshake
+	mysqlPhaseEncrypted
+)
+
+// mysqlConnection is the state of a connection shared by the streams of
+// both directions.
+type mysqlConnection struct {
+	phase connectionPhase
+
+	// compressRequested is set when the client asks for the compressed
+	// protocol, which is used for all the packets following a successful
+	// authentication.
+	compressRequested bool
+	compressed        bool
 }
 
 type parseState int
@@ -149,6 +204,8 @@ type mysqlPlugin struct {
 	sendRequest  bool
 	sendResponse bool
 
+	payloadSampler *sampling.PayloadSampler
+
 	transactions       *common.Cache
 	transactionTimeout time.Duration
 
@@ -217,6 +274,7 @@ func (mysql *mysqlPlugin) setFromConfig(config *mysqlConfig) {
 	mysql.sendResponse = config.SendResponse
 	mysql.transactionTimeout = config.TransactionTimeout
 	mysql.prepareStatementTimeout = config.StatementTimeout
+	mysql.payloadSampler = sampling.NewPayloadSampler(config.PayloadSample, redactPayload)
 }
 
 func (mysql *mysqlPlugin) getTransaction(k common.HashableTCPTuple) *mysqlTransaction {
@@ -290,6 +348,14 @@ func mysqlMessageParser(s *mysqlStream) (bool, bool) {
 
 			logp.Debug("mysqldetailed", "MySQL Header: Packet length %d, Seq %d, Type=%d isClient=%v", m.packetLength, m.seq, m.typ, s.isClient)
 
+			if s.conn != nil && s.conn.isHandshake(s.isClient, m) {
+				// messages of the connection phase are not part of any transaction
+				m.isHandshake = true
+				m.ignoreMessage = true
+				s.parseState = mysqlStateEatMessage
+				continue
+			}
+
 			if s.isClient {
 				// starts Command Phase
 
@@ -339,6 +405,10 @@ func mysqlMessageParser(s *mysqlStream) (bool, bool) {
 			s.parseOffset += 4 // header
 			s.parseOffset += int(m.packetLength)
 			m.end = s.parseOffset
+			if m.isHandshake {
+				s.conn.handshakeMessage(s.isClient, m, s.data[m.start+4:m.end])
+				return true, true
+			}
 			if m.isRequest {
 				// get the statement id
 				if m.typ == mysqlCmdStmtExecute || m.typ == mysqlCmdStmtClose {
@@ -529,8 +599,129 @@ func (mysql *mysqlPlugin) messageGap(s *mysqlStream, nbytes int) (complete bool)
 	return true
 }
 
+// isHandshake returns whether the message m belongs to the connection phase,
+// in which the client and the server negotiate the capabilities of the
+// connection and authenticate the client.
+func (conn *mysqlConnection) isHandshake(isClient bool, m *mysqlMessage) bool {
+	if !isClient && m.seq == 0 && m.typ == mysqlHandshakeV10 {
+		// initial handshake, sent by the server when the client connects
+		conn.phase = mysqlPhaseHandshake
+		conn.compressRequested = false
+		return true
+	}
+	return conn.phase == mysqlPhaseHandshake
+}
+
+// handshakeMessage updates the state of the connection with a message of the
+// connection phase. Authentication methods such as caching_sha2_password
+// exchange any number of messages (AuthSwitchRequest, AuthMoreData, public
+// key requests) before the server accepts or rejects the client, so only the
+// messages which change the state of the connection are looked at.
+func (conn *mysqlConnection) handshakeMessage(isClient bool, m *mysqlMessage, payload []byte) {
+	if isClient {
+		// The reply to the initial handshake is a HandshakeResponse, or an
+		// SSLRequest when the client switches to TLS. Both start with the
+		// capability flags.
+		if m.seq != 1 || len(payload) < 2 {
+			return
+		}
+		flags := binary.LittleEndian.Uint16(payload)
+		if flags&clientSSL != 0 {
+			logp.Debug("mysql", "TLS encrypted connection, ignoring it")
+			conn.phase = mysqlPhaseEncrypted
+			return
+		}
+		conn.compressRequested = flags&clientCompress != 0
+		return
+	}
+
+	switch m.typ {
+	case 0x00:
+		logp.Debug("mysqldetailed", "Authentication succeeded, compressed=%v", conn.compressRequested)
+		conn.phase = mysqlPhaseCommand
+		conn.compressed = conn.compressRequested
+	case 0xff:
+		// the server closes the connection
+		logp.Debug("mysqldetailed", "Authentication failed")
+		conn.phase = mysqlPhaseCommand
+	}
+}
+
+// appendData adds the payload of a packet to the stream, decompressing it
+// when the connection uses the compressed protocol.
+func (stream *mysqlStream) appendData(payload []byte) error {
+	if err := stream.updateCompression(); err != nil {
+		return err
+	}
+
+	if !stream.isCompressed {
+		stream.data = append(stream.data, payload...)
+	} else {
+		stream.compressed = append(stream.compressed, payload...)
+		if err := stream.inflate(); err != nil {
+			return err
+		}
+	}
+
+	if len(stream.data)+len(stream.compressed) > tcp.TCPMaxDataInStream {
+		return errors.New("stream data too large")
+	}
+	return nil
+}
+
+// updateCompression switches the stream to the compressed protocol once it
+// has been enabled for the connection. Must be called between messages, the
+// data not parsed yet is then compressed.
+func (stream *mysqlStream) updateCompression() error {
+	if stream.isCompressed || stream.conn == nil || !stream.conn.compressed {
+		return nil
+	}
+
+	stream.isCompressed = true
+	stream.compressed = stream.data
+	stream.data = nil
+	return stream.inflate()
+}
+
+// inflate moves the payload of the complete compressed protocol packets to
+// the stream data. Each compressed packet has a 7 bytes header, with the
+// length of the compressed payload, a sequence number and the length of the
+// payload before compression, which is 0 if the payload isn't compressed.
+// The payload contains one or more MySQL packets.
+func (stream *mysqlStream) inflate() error {
+	for len(stream.compressed) >= compressedHeaderLen {
+		compressedLength := int(leUint24(stream.compressed[0:3]))
+		uncompressedLength := int(leUint24(stream.compressed[4:7]))
+		if len(stream.compressed) < compressedHeaderLen+compressedLength {
+			// wait for more data
+			return nil
+		}
+
+		payload := stream.compressed[compressedHeaderLen : compressedHeaderLen+compressedLength]
+		if uncompressedLength == 0 {
+			stream.data = append(stream.data, payload...)
+		} else {
+			if len(stream.data)+uncompressedLength > tcp.TCPMaxDataInStream {
+				return errors.New("stream data too large")
+			}
+			r, err := zlib.NewReader(bytes.NewReader(payload))
+			if err != nil {
+				return fmt.Errorf("failed to decompress packet: %w", err)
+			}
+			off := len(stream.data)
+			stream.data = append(stream.data, make([]byte, uncompressedLength)...)
+			if _, err := io.ReadFull(r, stream.data[off:]); err != nil {
+				return fmt.Errorf("failed to decompress packet: %w", err)
+			}
+		}
+		stream.compressed = stream.compressed[compressedHeaderLen+compressedLength:]
+	}
+	return nil
+}
+
 type mysqlPrivateData struct {
 	data [2]*mysqlStream
+	conn *mysqlConnection
 }
 
 // Called when the parser has identified a full message.
@@ -562,26 +753,33 @@ func (mysql *mysqlPlugin) Parse(pkt *protos.Packet, tcptuple *common.TCPTuple,
 		}
 	}
 
+	if priv.conn == nil {
+		priv.conn = &mysqlConnection{}
+	}
+	if priv.conn.phase == mysqlPhaseEncrypted {
+		priv.data[dir] = nil
+		return priv
+	}
+
 	if priv.data[dir] == nil {
 		dstPort := tcptuple.DstPort
 		if dir == tcp.TCPDirectionReverse {
 			dstPort = tcptuple.SrcPort
 		}
 		priv.data[dir] = &mysqlStream{
-			data:     pkt.Payload,
 			message:  &mysqlMessage{ts: pkt.Ts},
 			isClient: mysql.isServerPort(dstPort),
-		}
-	} else {
-		// concatenate bytes
-		priv.data[dir].data = append(priv.data[dir].data, pkt.Payload...)
-		if len(priv.data[dir].data) > tcp.TCPMaxDataInStream {
-			logp.Debug("mysql", "Stream data too large, dropping TCP stream")
-			priv.data[dir] = nil
-			return priv
+			conn:     priv.conn,
 		}
 	}
 
+	// concatenate bytes
+	if err := priv.data[dir].appendData(pkt.Payload); err != nil {
+		logp.Debug("mysql", "%v, dropping TCP stream", err)
+		priv.data[dir] = nil
+		return priv
+	}
+
 	stream := priv.data[dir]
 	for len(stream.data) > 0 {
 		if stream.message == nil {
@@ -600,6 +798,16 @@ func (mysql *mysqlPlugin) Parse(pkt *protos.Packet, tcptuple *common.TCPTuple,
 
 		if complete {
 			mysql.messageComplete(tcptuple, dir, stream)
+
+			if priv.conn.phase == mysqlPhaseEncrypted {
+				priv.data[dir] = nil
+				return priv
+			}
+			if err := stream.updateCompression(); err != nil {
+				logp.Debug("mysql", "%v, dropping TCP stream", err)
+				priv.data[dir] = nil
+				return priv
+			}
 		} else {
 			// wait for more data
 			break
@@ -677,6 +885,13 @@ func (mysql *mysqlPlugin) receivedMysqlRequest(msg *mysqlMessage) {
 		trans.src, trans.dst = trans.dst, trans.src
 	}
 
+	// The message is backed by the stream buffer, copy it if the
+	// transaction is sampled.
+	trans.sampled = mysql.payloadSampler.Sample()
+	if trans.sampled {
+		trans.requestPayload = append([]byte(nil), msg.raw...)
+	}
+
 	// try to get query string for Execute statement from cache
 	// and delete statement id for Close statement from cache
 	if msg.statementID != 0 {
@@ -793,6 +1008,9 @@ func (mysql *mysqlPlugin) receivedMysqlResponse(msg *mysqlMessage) {
 
 		trans.responseRaw = common.DumpInCSVFormat(fields, rows)
 	}
+	if trans.sampled {
+		trans.responsePayload = append([]byte(nil), msg.raw...)
+	}
 
 	trans.notes = append(trans.notes, msg.notes...)
 
@@ -1192,10 +1410,33 @@ func (mysql *mysqlPlugin) publishTransaction(t *mysqlTransaction) {
 	if mysql.sendResponse {
 		fields["response"] = t.responseRaw
 	}
+	if t.sampled {
+		mysql.payloadSampler.Attach(&evt, t.requestPayload, t.responsePayload)
+	}
 
 	mysql.results(evt)
 }
 
+// passwordLiteral matches the string literals holding passwords in account
+// management statements, e.g. `CREATE USER u IDENTIFIED BY 'secret'` or
+// `SET PASSWORD FOR u = 'secret'`.
+var passwordLiteral = regexp.MustCompile(`(?i)(?:IDENTIFIED(?:\s+WITH\s+\S+)?\s+(?:BY|AS)|PASSWORD(?:\s+FOR\s+\S+)?\s*(?:=|\()?)\s*(?:'([^']*)'|"([^"]*)")`)
+
+// redactPayload masks the passwords in a sampled request.
+func redactPayload(payload []byte, isRequest bool) []byte {
+	if !isRequest {
+		return payload
+	}
+	for _, loc := range passwordLiteral.FindAllSubmatchIndex(payload, -1) {
+		for _, group := range [][]int{loc[2:4], loc[4:6]} {
+			for i := group[0]; i >= 0 && i < group[1]; i++ {
+				payload[i] = '*'
+			}
+		}
+	}
+	return payload
+}
+
 func readLstring(data []byte, offset int) ([]byte, int, bool, error) {
 	length, off, complete, err := readLinteger(data, offset)
 	if err != nil {
@@ -21,6 +21,8 @@
 package mysql
 
 import (
+	"bytes"
+	"compress/zlib"
 	"encoding/hex"
 	"net"
 	"testing"
@@ -33,8 +35,10 @@ import (
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/mapstr"
 
+	"github.com/elastic/beats/v7/packetbeat/config"
 	"github.com/elastic/beats/v7/packetbeat/procs"
 	"github.com/elastic/beats/v7/packetbeat/protos"
+	"github.com/elastic/beats/v7/packetbeat/protos/sampling"
 	"github.com/elastic/beats/v7/packetbeat/protos/tcp"
 	"github.com/elastic/beats/v7/packetbeat/publish"
 )
@@ -682,3 +686,191 @@ func Test_PreparedStatement(t *testing.T) {
 	send(tcp.TCPDirectionReverse, "01000001011e0000020364656600000008636f6c5f305f305f000c3f001500000008810000000005000003fe000001200a00000400000b0000000000000005000005fe00000120")
 	assert.Len(t, results.events, 2)
 }
+
+// mysqlPacket returns a MySQL packet with the given sequence number and payload.
+func mysqlPacket(seq uint8, payload []byte) []byte {
+	return append([]byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), seq}, payload...)
+}
+
+var (
+	greetingPacket = mysqlPacket(0, append([]byte("\x0a8.0.32\x00\x08\x00\x00\x00"), bytes.Repeat([]byte{0x41}, 21)...))
+	updatePacket   = mysqlPacket(0, []byte("\x03UPDATE test SET a = 1"))
+	updateOKPacket = mysqlPacket(1, []byte{0x00, 0x01, 0x00, 0x02, 0x00, 0x00, 0x00})
+)
+
+// handshakeResponsePacket returns the reply of the client to the initial
+// handshake with the given capability flags.
+func handshakeResponsePacket(flags uint16) []byte {
+	payload := []byte{byte(flags), byte(flags >> 8), 0x0f, 0x00}
+	payload = append(payload, "\x00\x00\x00\x01\x21"...)
+	payload = append(payload, make([]byte, 23)...)
+	payload = append(payload, "root\x00\x20"...)
+	payload = append(payload, bytes.Repeat([]byte{0x42}, 32)...)
+	payload = append(payload, "caching_sha2_password\x00"...)
+	return mysqlPacket(1, payload)
+}
+
+func Test_cachingSha2Handshake(t *testing.T) {
+	logp.TestingSetup(logp.WithSelectors("mysql", "mysqldetailed"))
+	tcpTuple := testTCPTuple()
+	results := &eventStore{}
+	mysql := mysqlModForTests(results)
+	unmatched := unmatchedResponses.Get()
+
+	var private protos.ProtocolData
+	send := func(dir uint8, data []byte) {
+		packet := protos.Packet{Payload: data}
+		private = mysql.Parse(&packet, tcpTuple, dir, private)
+	}
+
+	send(tcp.TCPDirectionReverse, greetingPacket)
+	send(tcp.TCPDirectionOriginal, handshakeResponsePacket(0x0200))
+	// AuthSwitchRequest to caching_sha2_password
+	send(tcp.TCPDirectionReverse, mysqlPacket(2, append([]byte("\xfecaching_sha2_password\x00"), bytes.Repeat([]byte{0x43}, 21)...)))
+	send(tcp.TCPDirectionOriginal, mysqlPacket(3, bytes.Repeat([]byte{0x44}, 32)))
+	// AuthMoreData, full authentication required
+	send(tcp.TCPDirectionReverse, mysqlPacket(4, []byte{0x01, 0x04}))
+	// request of the public key of the server, and encrypted password
+	send(tcp.TCPDirectionOriginal, mysqlPacket(5, []byte{0x02}))
+	send(tcp.TCPDirectionReverse, mysqlPacket(6, []byte("\x01-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA\n-----END PUBLIC KEY-----\n")))
+	send(tcp.TCPDirectionOriginal, mysqlPacket(7, bytes.Repeat([]byte{0x00}, 256)))
+	send(tcp.TCPDirectionReverse, mysqlPacket(8, []byte{0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00}))
+
+	send(tcp.TCPDirectionOriginal, updatePacket)
+	send(tcp.TCPDirectionReverse, updateOKPacket)
+
+	assert.Equal(t, unmatched, unmatchedResponses.Get(), "handshake messages must not be handled as responses")
+	if assert.Len(t, results.events, 1) {
+		query, _ := results.events[0].GetValue("query")
+		assert.Equal(t, "UPDATE test SET a = 1", query)
+		affectedRows, _ := results.events[0].GetValue("mysql.affected_rows")
+		assert.Equal(t, uint64(1), affectedRows)
+	}
+}
+
+// compressedPacket returns the payload in a packet of the compressed
+// protocol, compressing it when compress is set.
+func compressedPacket(t *testing.T, seq uint8, payload []byte, compress bool) []byte {
+	uncompressedLength := 0
+	if compress {
+		uncompressedLength = len(payload)
+		var buf bytes.Buffer
+		w := zlib.NewWriter(&buf)
+		_, err := w.Write(payload)
+		assert.NoError(t, err)
+		assert.NoError(t, w.Close())
+		payload = buf.Bytes()
+	}
+	header := []byte{
+		byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16),
+		seq,
+		byte(uncompressedLength), byte(uncompressedLength >> 8), byte(uncompressedLength >> 16),
+	}
+	return append(header, payload...)
+}
+
+func Test_compressedProtocol(t *testing.T) {
+	logp.TestingSetup(logp.WithSelectors("mysql", "mysqldetailed"))
+	tcpTuple := testTCPTuple()
+	results := &eventStore{}
+	mysql := mysqlModForTests(results)
+
+	var private protos.ProtocolData
+	send := func(dir uint8, data []byte) {
+		packet := protos.Packet{Payload: data}
+		private = mysql.Parse(&packet, tcpTuple, dir, private)
+	}
+
+	send(tcp.TCPDirectionReverse, greetingPacket)
+	send(tcp.TCPDirectionOriginal, handshakeResponsePacket(0x0200|clientCompress))
+	send(tcp.TCPDirectionReverse, mysqlPacket(2, []byte{0x01, 0x03}))
+	send(tcp.TCPDirectionReverse, mysqlPacket(3, []byte{0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00}))
+
+	// small payloads are sent without compression
+	send(tcp.TCPDirectionOriginal, compressedPacket(t, 0, updatePacket, false))
+	send(tcp.TCPDirectionReverse, compressedPacket(t, 0, updateOKPacket, true))
+
+	// a compressed packet split between two TCP segments
+	longUpdatePacket := mysqlPacket(0, append([]byte("\x03UPDATE test SET a = 2 WHERE b = '"), append(bytes.Repeat([]byte("x"), 200), '\'')...))
+	data := compressedPacket(t, 0, longUpdatePacket, true)
+	send(tcp.TCPDirectionOriginal, data[:10])
+	send(tcp.TCPDirectionOriginal, data[10:])
+	send(tcp.TCPDirectionReverse, compressedPacket(t, 1, updateOKPacket, true))
+
+	if assert.Len(t, results.events, 2) {
+		query, _ := results.events[0].GetValue("query")
+		assert.Equal(t, "UPDATE test SET a = 1", query)
+		method, _ := results.events[1].GetValue("method")
+		assert.Equal(t, "UPDATE", method)
+		status, _ := results.events[1].GetValue("status")
+		assert.Equal(t, common.OK_STATUS, status)
+	}
+}
+
+func Test_encryptedConnection(t *testing.T) {
+	logp.TestingSetup(logp.WithSelectors("mysql", "mysqldetailed"))
+	tcpTuple := testTCPTuple()
+	results := &eventStore{}
+	mysql := mysqlModForTests(results)
+
+	var private protos.ProtocolData
+	send := func(dir uint8, data []byte) {
+		packet := protos.Packet{Payload: data}
+		private = mysql.Parse(&packet, tcpTuple, dir, private)
+	}
+
+	send(tcp.TCPDirectionReverse, greetingPacket)
+	// SSLRequest
+	send(tcp.TCPDirectionOriginal, mysqlPacket(1, append([]byte{0x00, 0x0a, 0x0f, 0x00}, make([]byte, 28)...)))
+	// TLS records which may look like MySQL packets
+	send(tcp.TCPDirectionOriginal, updatePacket)
+	send(tcp.TCPDirectionReverse, updateOKPacket)
+
+	assert.Empty(t, results.events)
+}
+
+func Test_redactPayload(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    string
+	}{
+		{"SELECT password FROM users", "SELECT password FROM users"},
+		{"CREATE USER 'app'@'%' IDENTIFIED BY 'secret'", "CREATE USER 'app'@'%' IDENTIFIED BY '******'"},
+		{"alter user app identified with caching_sha2_password by \"secret\"", "alter user app identified with caching_sha2_password by \"******\""},
+		{"SET PASSWORD FOR app = 'secret'", "SET PASSWORD FOR app = '******'"},
+		{"SET PASSWORD = 'secret'", "SET PASSWORD = '******'"},
+		{"SELECT PASSWORD('secret')", "SELECT PASSWORD('******')"},
+	}
+
+	for _, test := range tests {
+		got := redactPayload([]byte(test.payload), true)
+		assert.Equal(t, test.want, string(got), test.payload)
+	}
+}
+
+func Test_payloadSample(t *testing.T) {
+	logp.TestingSetup(logp.WithSelectors("mysql", "mysqldetailed"))
+	tcpTuple := testTCPTuple()
+	results := &eventStore{}
+	mysql := mysqlModForTests(results)
+	mysql.payloadSampler = sampling.NewPayloadSampler(config.PayloadSample{Rate: 1, Encoding: "hex"}, redactPayload)
+
+	var private protos.ProtocolData
+	send := func(dir uint8, data []byte) {
+		packet := protos.Packet{Payload: data}
+		private = mysql.Parse(&packet, tcpTuple, dir, private)
+	}
+
+	send(tcp.TCPDirectionOriginal, mysqlPacket(0, []byte("\x03ALTER USER app IDENTIFIED BY 'secret'")))
+	send(tcp.TCPDirectionReverse, updateOKPacket)
+
+	if assert.Len(t, results.events, 1) {
+		sample, _ := results.events[0].GetValue("payload_sample")
+		assert.Equal(t, map[string]interface{}{
+			"encoding":  "hex",
+			"request":   hex.EncodeToString(mysqlPacket(0, []byte("\x03ALTER USER app IDENTIFIED BY '******'"))),
+			"response":  hex.EncodeToString(updateOKPacket),
+			"truncated": false,
+		}, sample)
+	}
+}
@@ -0,0 +1,76 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redis
+
+import (
+	"bytes"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// Detect returns the confidence that payload starts a Redis request. A RESP
+// array starting with a known command gives full confidence. A RESP array
+// of bulk strings alone, or an inline request starting with a known command,
+// gives partial confidence, as inline requests look like other text
+// protocols (GET is also an HTTP method).
+func (redis *redisPlugin) Detect(payload []byte) float64 {
+	line, rest, ok := detectLine(payload)
+	if !ok || len(line) < 2 {
+		return 0
+	}
+
+	if line[0] != '*' {
+		// Inline request: PING
+		if fields := bytes.Fields(line); len(fields) > 0 && isRedisCommand(common.NetString(fields[0])) {
+			return 0.5
+		}
+		return 0
+	}
+
+	// RESP array of bulk strings: *1\r\n$4\r\nPING\r\n
+	if !isDetectNumber(line[1:]) {
+		return 0
+	}
+	line, rest, ok = detectLine(rest)
+	if !ok || len(line) < 2 || line[0] != '$' || !isDetectNumber(line[1:]) {
+		return 0
+	}
+	if command, _, ok := detectLine(rest); ok && isRedisCommand(common.NetString(command)) {
+		return 1
+	}
+	return 0.5
+}
+
+// detectLine returns the first CRLF terminated line of b and the remaining
+// bytes.
+func detectLine(b []byte) (line, rest []byte, ok bool) {
+	i := bytes.Index(b, []byte("\r\n"))
+	if i < 0 {
+		return nil, nil, false
+	}
+	return b[:i], b[i+2:], true
+}
+
+func isDetectNumber(b []byte) bool {
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return len(b) > 0
+}
@@ -32,5 +32,5 @@ func init() {
 // AssetRedis returns asset data.
 // This is the base64 encoded zlib format compressed contents of protos/redis.
 func AssetRedis() string {
-	return "eJyMj71uwzAMhHc9xSFzkwfw0L1r0b1grHMs1JIMkg7gty/8kzZBM5STRPK7Ox7xxbmBMiYLgCcf2ODwvvwPAYi0VtPoqZYGrwEA1tnRRrapSy14ZXF0iUO0U8D+atbVI4pk/sov5fPIBhet07h37olHyictn1cZJv4Mn0a61UfPncJKoXbwnltktDVnKRGpQNBPWQqUEuU8EF3VLH4Kf0JQter/3N+emfViUNo0ODfnskm+wPtk2+1oa3FJxRb+QXJdRaaZXG6nMeI83zkZ9Uo9hfAdAAD//8E/imI="
+	return "eNqtU8tygzAMvOcrNDkn+QAOvfTUa6f34mAFPPGDyiYZ/r6SExII9DVTn4wsrXZXYgtH7Asg1CauAJJJFgtYv8r3mgMaY0WmTSb4Ap44AJDftrHFyhxMBXhCn+Bg0Oq444TLrcipW/DK4R1eTupbjtQUuvYaGVdMq1JH/v2kbIe3x0VKw3lr8FoFuQrCARLHMmWognPKazAeFDQd3zlZabW3CIdATqXdakYCiQL9rvvLUrNGRe4SO5vw0tlfIDecy2lZOyf7pIyPUj+BzKngMEZVD9IYZ9+POkWkE9ICdXGdsEojxLn5P2i6tHi2XUxIN0ROvJNh3oltHLwmbG2/gXODfiaH8KPDKE7w1sHAPetRPnA2gQ8ad6Oix+UYCxQ1k4dBIKOfA+mHt29kDssj5SJkJHQDpQtMsrxJkpk2EG1Ig2buN4OTwe+RK1rk1fL8k9geMhKkMJG7AZ5xqeKxXHZNTu5mBNH4GpypSYmNsmFXAuB4Rvw+Mjn4ijebgpvBSc3Y70V7peeivTb4+u/ezlybbMMXHJTW/PfE/53yFXTgIRbkTRRrJ/NlUp95DHhb"
 }
@@ -104,6 +104,14 @@ func (ml *MessageQueue) Pop() Message {
 	return msg.item
 }
 
+// Peek returns the oldest message in the queue without removing it, if any.
+func (ml *MessageQueue) Peek() Message {
+	if ml.head == nil {
+		return nil
+	}
+	return ml.head.item
+}
+
 func (ml *MessageQueue) adjust(msgSize int64) (evicted int) {
 	if ml.slotsAvail == 0 {
 		ml.Pop()
@@ -19,6 +19,7 @@ package redis
 
 import (
 	"bytes"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,12 +27,14 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common"
 	conf "github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
 	"github.com/elastic/elastic-agent-libs/monitoring"
 
 	"github.com/elastic/beats/v7/packetbeat/pb"
 	"github.com/elastic/beats/v7/packetbeat/procs"
 	"github.com/elastic/beats/v7/packetbeat/protos"
 	"github.com/elastic/beats/v7/packetbeat/protos/applayer"
+	"github.com/elastic/beats/v7/packetbeat/protos/sampling"
 	"github.com/elastic/beats/v7/packetbeat/protos/tcp"
 )
 
@@ -55,6 +58,7 @@ type redisPlugin struct {
 	sendResponse       bool
 	transactionTimeout time.Duration
 	queueConfig        MessageQueueConfig
+	payloadSampler     *sampling.PayloadSampler
 
 	watcher *procs.ProcessesWatcher
 	results protos.Reporter
@@ -68,8 +72,21 @@ var (
 var (
 	unmatchedResponses = monitoring.NewInt(nil, "redis.unmatched_responses")
 	unmatchedRequests  = monitoring.NewInt(nil, "redis.unmatched_requests")
+	pushMessages       = monitoring.NewInt(nil, "redis.push_messages")
+	movedRedirections  = monitoring.NewInt(nil, "redis.redirections.moved")
+	askRedirections    = monitoring.NewInt(nil, "redis.redirections.ask")
 )
 
+// Commands whose confirmations are sent as push messages in RESP3.
+var subscriptionCommands = map[string]struct{}{
+	"PSUBSCRIBE":   {},
+	"PUNSUBSCRIBE": {},
+	"SSUBSCRIBE":   {},
+	"SUBSCRIBE":    {},
+	"SUNSUBSCRIBE": {},
+	"UNSUBSCRIBE":  {},
+}
+
 func init() {
 	protos.Register("redis", New)
 }
@@ -110,6 +127,7 @@ func (redis *redisPlugin) setFromConfig(config *redisConfig) {
 	redis.sendResponse = config.SendResponse
 	redis.transactionTimeout = config.TransactionTimeout
 	redis.queueConfig = config.QueueLimits
+	redis.payloadSampler = sampling.NewPayloadSampler(config.PayloadSample, redactPayload)
 }
 
 func (redis *redisPlugin) GetPorts() []int {
@@ -251,6 +269,15 @@ func (redis *redisPlugin) handleRedis(
 	m.direction = dir
 	m.cmdlineTuple = redis.watcher.FindProcessesTupleTCP(tcptuple.IPPort())
 
+	if m.isPush && !isSubscriptionConfirmation(conn, m) {
+		// out-of-band push messages are not replies to any request
+		if isDebug {
+			debugf("Push message of kind %s. Ignoring", m.pushKind)
+		}
+		pushMessages.Add(1)
+		return
+	}
+
 	if m.isRequest {
 		// wait for response
 		if evicted := conn.requests.Append(m); evicted > 0 {
@@ -264,6 +291,23 @@ func (redis *redisPlugin) handleRedis(
 	}
 }
 
+// isSubscriptionConfirmation returns whether the push message m confirms the
+// oldest pending request of the connection. In RESP3 the replies to the
+// (un)subscribe commands are push messages whose kind is the command name.
+// Only the first confirmation of a command is matched to it, the following
+// ones, sent for each additional channel, are ignored as push messages.
+func isSubscriptionConfirmation(conn *redisConnectionData, m *redisMessage) bool {
+	requ, ok := conn.requests.Peek().(*redisMessage)
+	if !ok {
+		return false
+	}
+	method := strings.ToUpper(string(requ.method))
+	if _, found := subscriptionCommands[method]; !found {
+		return false
+	}
+	return strings.EqualFold(method, string(m.pushKind))
+}
+
 func (redis *redisPlugin) correlate(conn *redisConnectionData) {
 	// drop responses with missing requests
 	if conn.requests.IsEmpty() {
@@ -317,6 +361,14 @@ func (redis *redisPlugin) newTransaction(requ, resp *redisMessage) beat.Event {
 	if resp.isError {
 		evt.PutValue("status", common.ERROR_STATUS)
 		evt.PutValue("redis.error", resp.message)
+		if redirect, ok := parseRedirect(resp.message); ok {
+			evt.PutValue("redis.redirect", redirect)
+			if redirect["type"] == "moved" {
+				movedRedirections.Inc()
+			} else {
+				askRedirections.Inc()
+			}
+		}
 	} else {
 		evt.PutValue("status", common.OK_STATUS)
 		evt.PutValue("redis.return_value", resp.message)
@@ -334,9 +386,90 @@ func (redis *redisPlugin) newTransaction(requ, resp *redisMessage) beat.Event {
 		pbf.Event.Outcome = "failure"
 	}
 
+	if redis.payloadSampler.Sample() {
+		redis.payloadSampler.Attach(&evt, requ.message, resp.message)
+	}
+
 	return evt
 }
 
+// redactPayload masks the passwords of the commands that authenticate a
+// connection in a sampled request, e.g. `AUTH user password` or
+// `HELLO 3 AUTH user password`.
+func redactPayload(payload []byte, isRequest bool) []byte {
+	if !isRequest {
+		return payload
+	}
+
+	// Arguments share the payload's memory, so masking them in place
+	// masks the payload.
+	args := bytes.Split(payload, []byte(" "))
+	mask := func(i int) {
+		if i < len(args) {
+			for j := range args[i] {
+				args[i][j] = '*'
+			}
+		}
+	}
+
+	switch {
+	case bytes.EqualFold(args[0], []byte("AUTH")):
+		for i := 1; i < len(args); i++ {
+			mask(i)
+		}
+	case bytes.EqualFold(args[0], []byte("HELLO")):
+		for i, arg := range args {
+			if bytes.EqualFold(arg, []byte("AUTH")) {
+				mask(i + 2)
+			}
+		}
+	case bytes.EqualFold(args[0], []byte("MIGRATE")):
+		for i, arg := range args {
+			if bytes.EqualFold(arg, []byte("AUTH")) {
+				mask(i + 1)
+			} else if bytes.EqualFold(arg, []byte("AUTH2")) {
+				mask(i + 2)
+			}
+		}
+	case len(args) > 1 && bytes.EqualFold(args[0], []byte("CONFIG")) && bytes.EqualFold(args[1], []byte("SET")):
+		for i := 2; i+1 < len(args); i += 2 {
+			if bytes.EqualFold(args[i], []byte("requirepass")) || bytes.EqualFold(args[i], []byte("masterauth")) {
+				mask(i + 1)
+			}
+		}
+	}
+	return payload
+}
+
+// parseRedirect parses the MOVED and ASK errors returned by Redis Cluster
+// when a key is requested from a node that doesn't serve its hash slot,
+// e.g. `MOVED 3999 127.0.0.1:6381`.
+func parseRedirect(msg common.NetString) (mapstr.M, bool) {
+	parts := strings.Fields(string(msg))
+	if len(parts) != 3 {
+		return nil, false
+	}
+
+	var redirectType string
+	switch parts[0] {
+	case "MOVED":
+		redirectType = "moved"
+	case "ASK":
+		redirectType = "ask"
+	default:
+		return nil, false
+	}
+
+	redirect := mapstr.M{
+		"type":    redirectType,
+		"address": parts[2],
+	}
+	if slot, err := strconv.ParseInt(parts[1], 10, 64); err == nil {
+		redirect["slot"] = slot
+	}
+	return redirect, true
+}
+
 func (redis *redisPlugin) GapInStream(tcptuple *common.TCPTuple, dir uint8,
 	nbytes int, private protos.ProtocolData) (priv protos.ProtocolData, drop bool,
 ) {
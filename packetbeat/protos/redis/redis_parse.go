@@ -41,10 +41,15 @@ type redisMessage struct {
 
 	isRequest bool
 	isError   bool
+	isPush    bool
 	size      int
 	message   common.NetString
 	method    common.NetString
 	path      common.NetString
+
+	// pushKind is the kind of an out-of-band RESP3 push message, which is
+	// the first element of the push, e.g. `message` or `invalidate`.
+	pushKind common.NetString
 }
 
 func (msg *redisMessage) Size() int {
@@ -54,12 +59,16 @@ func (msg *redisMessage) Size() int {
 var (
 	empty    = common.NetString("")
 	emptyArr = common.NetString("[]")
+	emptyMap = common.NetString("{}")
 	nilStr   = common.NetString("nil")
+	trueStr  = common.NetString("true")
+	falseStr = common.NetString("false")
 )
 
 // Keep sorted for future command addition
 var redisCommands = map[string]struct{}{
 	"APPEND":           {},
+	"ASKING":           {},
 	"AUTH":             {},
 	"BGREWRITEAOF":     {},
 	"BGSAVE":           {},
@@ -74,6 +83,10 @@ var redisCommands = map[string]struct{}{
 	"CLIENT LIST":      {},
 	"CLIENT PAUSE":     {},
 	"CLIENT SETNAME":   {},
+	"CLIENT TRACKING":  {},
+	"CLUSTER NODES":    {},
+	"CLUSTER SHARDS":   {},
+	"CLUSTER SLOTS":    {},
 	"CONFIG GET":       {},
 	"CONFIG RESETSTAT": {},
 	"CONFIG REWRITE":   {},
@@ -100,6 +113,7 @@ var redisCommands = map[string]struct{}{
 	"GETRANGE":         {},
 	"GETSET":           {},
 	"HDEL":             {},
+	"HELLO":            {},
 	"HEXISTS":          {},
 	"HGET":             {},
 	"HGETALL":          {},
@@ -152,6 +166,8 @@ var redisCommands = map[string]struct{}{
 	"PUNSUBSCRIBE":     {},
 	"QUIT":             {},
 	"RANDOMKEY":        {},
+	"READONLY":         {},
+	"READWRITE":        {},
 	"RENAME":           {},
 	"RENAMENX":         {},
 	"RESTORE":          {},
@@ -185,13 +201,16 @@ var redisCommands = map[string]struct{}{
 	"SMOVE":            {},
 	"SORT":             {},
 	"SPOP":             {},
+	"SPUBLISH":         {},
 	"SRANDMEMBER":      {},
 	"SREM":             {},
 	"SSCAN":            {},
+	"SSUBSCRIBE":       {},
 	"STRLEN":           {},
 	"SUBSCRIBE":        {},
 	"SUNION":           {},
 	"SUNIONSTORE":      {},
+	"SUNSUBSCRIBE":     {},
 	"SYNC":             {},
 	"TIME":             {},
 	"TTL":              {},
@@ -300,6 +319,29 @@ func (p *parser) dispatch(depth int, buf *streambuf.Buffer) (common.NetString, b
 	case '-':
 		iserror = true
 		value, ok, complete = p.parseSimpleString(buf)
+
+	// RESP3 types
+	case '_':
+		_, ok, complete = p.parseSimpleString(buf)
+		value = nilStr
+	case '#':
+		value, ok, complete = p.parseBoolean(buf)
+	case ',', '(':
+		// doubles and big numbers are reported as sent
+		value, ok, complete = p.parseSimpleString(buf)
+	case '!':
+		iserror = true
+		value, ok, complete = p.parseString(buf)
+	case '=':
+		value, ok, complete = p.parseVerbatimString(buf)
+	case '~':
+		value, iserror, ok, complete = p.parseSet(depth, buf)
+	case '>':
+		value, iserror, ok, complete = p.parsePush(depth, buf)
+	case '%':
+		value, iserror, ok, complete = p.parseMap(depth, buf)
+	case '|':
+		value, iserror, ok, complete = p.parseAttribute(depth, buf)
 	default:
 		if isDebug {
 			debugf("Unexpected message starting with %s", buf.Bytes()[0])
@@ -359,40 +401,69 @@ func (p *parser) parseString(buf *streambuf.Buffer) (common.NetString, bool, boo
 	return common.NetString(content), true, true
 }
 
-func (p *parser) parseArray(depth int, buf *streambuf.Buffer) (common.NetString, bool, bool, bool) {
+func (p *parser) parseBoolean(buf *streambuf.Buffer) (common.NetString, bool, bool) {
+	value, ok, complete := p.parseSimpleString(buf)
+	if !ok || !complete {
+		return value, ok, complete
+	}
+
+	switch string(value) {
+	case "t":
+		return trueStr, true, true
+	case "f":
+		return falseStr, true, true
+	default:
+		logp.Err("Failed to read boolean reply: %s", value)
+		return empty, false, false
+	}
+}
+
+// parseVerbatimString parses a RESP3 verbatim string, dropping the three
+// characters encoding prefix (e.g. `txt:`) from the returned content.
+func (p *parser) parseVerbatimString(buf *streambuf.Buffer) (common.NetString, bool, bool) {
+	value, ok, complete := p.parseString(buf)
+	if ok && complete && len(value) >= 4 && value[3] == ':' {
+		value = value[4:]
+	}
+	return value, ok, complete
+}
+
+// parseLength parses the header of an aggregate type, returning the number
+// of elements, or -1 for a null aggregate.
+func (p *parser) parseLength(buf *streambuf.Buffer) (int64, bool, bool) {
 	line, err := buf.UntilCRLF()
 	if err != nil {
 		if isDebug {
 			debugf("End of line not found, waiting for more data")
 		}
-		return empty, false, false, false
+		return 0, false, false
 	}
 	if isDebug {
 		debugf("line %s: %d", line, buf.BufferConsumed())
 	}
 
 	if len(line) == 3 && line[1] == '-' && line[2] == '1' {
-		return nilStr, false, true, true
+		return -1, true, true
 	}
 
 	if len(line) == 2 && line[1] == '0' {
-		return emptyArr, false, true, true
+		return 0, true, true
 	}
 
 	count, err := parseInt(line[1:])
 	if err != nil {
 		logp.Err("Failed to read number of bulk messages: %s", err)
-		return empty, false, false, false
+		return 0, false, false
 	}
 	if count < 0 {
-		return nilStr, false, true, true
-	} else if count == 0 {
-		// should not happen, but handle just in case ParseInt did return 0
-		return emptyArr, false, true, true
+		return -1, true, true
 	}
+	return count, true, true
+}
 
-	// invariant: count > 0
-
+// parseElements parses the count elements of an aggregate type, returning
+// their values and total length.
+func (p *parser) parseElements(depth int, count int64, buf *streambuf.Buffer) ([][]byte, int, bool, bool, bool) {
 	// try to allocate content array right on stack
 	var content [][]byte
 	const arrayBufferSize = 32
@@ -406,22 +477,38 @@ func (p *parser) parseArray(depth int, buf *streambuf.Buffer) (common.NetString,
 	contentLen := 0
 	// read sub elements
 
-	iserror := false
 	for i := 0; i < int(count); i++ {
-		var value common.NetString
-		var ok, complete bool
-
 		value, iserror, ok, complete := p.dispatch(depth+1, buf)
 		if !ok || !complete {
 			if isDebug {
 				debugf("Array incomplete")
 			}
-			return empty, iserror, ok, complete
+			return nil, 0, iserror, ok, complete
 		}
 
 		content = append(content, []byte(value))
 		contentLen += len(value)
 	}
+	return content, contentLen, false, true, true
+}
+
+func (p *parser) parseArray(depth int, buf *streambuf.Buffer) (common.NetString, bool, bool, bool) {
+	count, ok, complete := p.parseLength(buf)
+	if !ok || !complete {
+		return empty, false, ok, complete
+	}
+	if count < 0 {
+		return nilStr, false, true, true
+	} else if count == 0 {
+		return emptyArr, false, true, true
+	}
+
+	// invariant: count > 0
+
+	content, contentLen, iserror, ok, complete := p.parseElements(depth, count, buf)
+	if !ok || !complete {
+		return empty, iserror, ok, complete
+	}
 
 	// handle top-level request command
 	var oneWordCommand, twoWordsCommand bool
@@ -453,13 +540,108 @@ func (p *parser) parseArray(depth int, buf *streambuf.Buffer) (common.NetString,
 		return value, iserror, true, true
 	}
 
-	// return redis array: [a, b, c]
+	return formatList(content, contentLen), iserror, true, true
+}
+
+func (p *parser) parseSet(depth int, buf *streambuf.Buffer) (common.NetString, bool, bool, bool) {
+	count, ok, complete := p.parseLength(buf)
+	if !ok || !complete {
+		return empty, false, ok, complete
+	}
+	if count < 0 {
+		return nilStr, false, true, true
+	} else if count == 0 {
+		return emptyArr, false, true, true
+	}
+
+	content, contentLen, iserror, ok, complete := p.parseElements(depth, count, buf)
+	if !ok || !complete {
+		return empty, iserror, ok, complete
+	}
+	return formatList(content, contentLen), iserror, true, true
+}
+
+// parsePush parses a RESP3 push message. Push messages are sent by the server
+// out of band, e.g. for Pub/Sub messages or client side caching invalidations,
+// so they are not replies to the requests sent on the connection.
+func (p *parser) parsePush(depth int, buf *streambuf.Buffer) (common.NetString, bool, bool, bool) {
+	count, ok, complete := p.parseLength(buf)
+	if !ok || !complete {
+		return empty, false, ok, complete
+	}
+	if count <= 0 {
+		logp.Err("Invalid push message with %d elements", count)
+		return empty, false, false, false
+	}
+
+	content, contentLen, iserror, ok, complete := p.parseElements(depth, count, buf)
+	if !ok || !complete {
+		return empty, iserror, ok, complete
+	}
+
+	if depth == 0 {
+		p.message.isPush = true
+		p.message.pushKind = content[0]
+	}
+	return formatList(content, contentLen), iserror, true, true
+}
+
+func (p *parser) parseMap(depth int, buf *streambuf.Buffer) (common.NetString, bool, bool, bool) {
+	count, ok, complete := p.parseLength(buf)
+	if !ok || !complete {
+		return empty, false, ok, complete
+	}
+	if count < 0 {
+		return nilStr, false, true, true
+	} else if count == 0 {
+		return emptyMap, false, true, true
+	}
+
+	content, contentLen, iserror, ok, complete := p.parseElements(depth, 2*count, buf)
+	if !ok || !complete {
+		return empty, iserror, ok, complete
+	}
+
+	// return redis map: {k1: v1, k2: v2}
+	tmp := make([]byte, 2+contentLen+int(count)*2+(int(count)-1)*2)
+	tmp[0] = '{'
+	off := 1
+	for i := 0; i < len(content); i += 2 {
+		if i > 0 {
+			off += copy(tmp[off:], ", ")
+		}
+		off += copy(tmp[off:], content[i])
+		off += copy(tmp[off:], ": ")
+		off += copy(tmp[off:], content[i+1])
+	}
+	tmp[off] = '}'
+	return common.NetString(tmp), iserror, true, true
+}
+
+// parseAttribute parses a RESP3 attribute, which carries auxiliary data
+// about the reply following it. The attribute is skipped and the value of
+// the reply is returned.
+func (p *parser) parseAttribute(depth int, buf *streambuf.Buffer) (common.NetString, bool, bool, bool) {
+	count, ok, complete := p.parseLength(buf)
+	if !ok || !complete {
+		return empty, false, ok, complete
+	}
+	if count > 0 {
+		_, _, _, ok, complete = p.parseElements(depth, 2*count, buf)
+		if !ok || !complete {
+			return empty, false, ok, complete
+		}
+	}
+	return p.dispatch(depth, buf)
+}
+
+// formatList returns the values of an aggregate type as a redis array: [a, b, c]
+func formatList(content [][]byte, contentLen int) common.NetString {
 	tmp := make([]byte, 2+contentLen+(len(content)-1)*2)
 	tmp[0] = '['
 	join(tmp[1:], content, []byte(", "))
 	tmp[len(tmp)-1] = ']'
-	value := common.NetString(tmp)
-	return value, iserror, true, true
+	return common.NetString(tmp)
 }
 
 func parseInt(line []byte) (int64, error) {
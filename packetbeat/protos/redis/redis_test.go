@@ -21,10 +21,21 @@
 package redis
 
 import (
+	"net"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/packetbeat/config"
+	"github.com/elastic/beats/v7/packetbeat/procs"
+	"github.com/elastic/beats/v7/packetbeat/protos"
+	"github.com/elastic/beats/v7/packetbeat/protos/sampling"
+	"github.com/elastic/beats/v7/packetbeat/protos/tcp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
 )
 
 func newTestStream(content []byte) *stream {
@@ -223,6 +234,206 @@ func TestRedisParser_Array2Passes(t *testing.T) {
 	assert.Equal(t, len(array2PassesPart1)+len(array2PassesPart2), msg.size)
 }
 
+func TestRedisParser_RESP3Types(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		expect  string
+		isError bool
+	}{
+		{name: "null", message: "_\r\n", expect: "nil"},
+		{name: "true", message: "#t\r\n", expect: "true"},
+		{name: "false", message: "#f\r\n", expect: "false"},
+		{name: "double", message: ",3.1415\r\n", expect: "3.1415"},
+		{name: "big number", message: "(3492890328409238509324850943850943825024385\r\n", expect: "3492890328409238509324850943850943825024385"},
+		{name: "bulk error", message: "!21\r\nSYNTAX invalid syntax\r\n", expect: "SYNTAX invalid syntax", isError: true},
+		{name: "verbatim string", message: "=15\r\ntxt:Some string\r\n", expect: "Some string"},
+		{name: "set", message: "~2\r\n+orange\r\n+apple\r\n", expect: "[orange, apple]"},
+		{name: "empty map", message: "%0\r\n", expect: "{}"},
+		{name: "map", message: "%2\r\n+first\r\n:1\r\n+second\r\n*2\r\n:2\r\n#t\r\n", expect: "{first: 1, second: [2, true]}"},
+		{name: "attribute", message: "|1\r\n+key-popularity\r\n%1\r\n$1\r\na\r\n,0.1923\r\n*1\r\n:2039123\r\n", expect: "[2039123]"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg, ok, complete := parse([]byte(test.message))
+
+			assert.True(t, ok)
+			assert.True(t, complete)
+			assert.False(t, msg.isRequest)
+			assert.False(t, msg.isPush)
+			assert.Equal(t, test.isError, msg.isError)
+			assert.Equal(t, test.expect, string(msg.message))
+			assert.Equal(t, len(test.message), msg.size)
+		})
+	}
+}
+
+func TestRedisParser_RESP3Incomplete(t *testing.T) {
+	for _, message := range []string{
+		"%2\r\n+first\r\n:1\r\n",
+		"|1\r\n+key\r\n+value\r\n",
+		">3\r\n+message\r\n",
+	} {
+		_, ok, complete := parse([]byte(message))
+		assert.True(t, ok, message)
+		assert.False(t, complete, message)
+	}
+}
+
+var pushMessage = []byte(">3\r\n" +
+	"$7\r\n" +
+	"message\r\n" +
+	"$4\r\n" +
+	"news\r\n" +
+	"$5\r\n" +
+	"hello\r\n")
+
+func TestRedisParser_Push(t *testing.T) {
+	msg, ok, complete := parse(pushMessage)
+
+	assert.True(t, ok)
+	assert.True(t, complete)
+	assert.False(t, msg.isRequest)
+	assert.True(t, msg.isPush)
+	assert.Equal(t, "message", string(msg.pushKind))
+	assert.Equal(t, "[message, news, hello]", string(msg.message))
+}
+
+func TestParseRedirect(t *testing.T) {
+	redirect, ok := parseRedirect(common.NetString("MOVED 3999 127.0.0.1:6381"))
+	require.True(t, ok)
+	assert.Equal(t, "moved", redirect["type"])
+	assert.Equal(t, int64(3999), redirect["slot"])
+	assert.Equal(t, "127.0.0.1:6381", redirect["address"])
+
+	redirect, ok = parseRedirect(common.NetString("ASK 12182 redis-2.example.com:6379"))
+	require.True(t, ok)
+	assert.Equal(t, "ask", redirect["type"])
+	assert.Equal(t, int64(12182), redirect["slot"])
+	assert.Equal(t, "redis-2.example.com:6379", redirect["address"])
+
+	for _, msg := range []string{
+		"ERR unknown command 'FOO'",
+		"MOVED 3999",
+		"CROSSSLOT Keys in request don't hash to the same slot",
+	} {
+		_, ok = parseRedirect(common.NetString(msg))
+		assert.False(t, ok, msg)
+	}
+}
+
+type eventStore struct {
+	events []beat.Event
+}
+
+func (e *eventStore) publish(event beat.Event) {
+	e.events = append(e.events, event)
+}
+
+func testTCPTuple() *common.TCPTuple {
+	t := &common.TCPTuple{
+		IPLength: 4,
+		BaseTuple: common.BaseTuple{
+			SrcIP: net.IPv4(192, 168, 0, 1), DstIP: net.IPv4(192, 168, 0, 2),
+			SrcPort: 6512, DstPort: 6379,
+		},
+	}
+	t.ComputeHashables()
+	return t
+}
+
+func testParse(redis *redisPlugin, private protos.ProtocolData, dir uint8, content string) protos.ProtocolData {
+	pkt := &protos.Packet{Ts: time.Now(), Payload: []byte(content)}
+	return redis.Parse(pkt, testTCPTuple(), dir, private)
+}
+
+func newTestPlugin(t *testing.T, store *eventStore) *redisPlugin {
+	p, err := New(true, store.publish, &procs.ProcessesWatcher{}, nil)
+	require.NoError(t, err)
+	return p.(*redisPlugin)
+}
+
+func TestRedis_PushMessagesNotCorrelated(t *testing.T) {
+	store := &eventStore{}
+	redis := newTestPlugin(t, store)
+
+	var private protos.ProtocolData
+	private = testParse(redis, private, tcp.TCPDirectionOriginal, "*2\r\n$9\r\nSUBSCRIBE\r\n$4\r\nnews\r\n")
+	private = testParse(redis, private, tcp.TCPDirectionReverse, ">3\r\n$9\r\nsubscribe\r\n$4\r\nnews\r\n:1\r\n")
+	private = testParse(redis, private, tcp.TCPDirectionOriginal, "*2\r\n$3\r\nGET\r\n$3\r\nfoo\r\n")
+	private = testParse(redis, private, tcp.TCPDirectionReverse, string(pushMessage))
+	testParse(redis, private, tcp.TCPDirectionReverse, "$3\r\nbar\r\n")
+
+	require.Len(t, store.events, 2)
+
+	method, _ := store.events[0].GetValue("method")
+	assert.Equal(t, common.NetString("SUBSCRIBE"), method)
+
+	method, _ = store.events[1].GetValue("method")
+	assert.Equal(t, common.NetString("GET"), method)
+	value, _ := store.events[1].GetValue("redis.return_value")
+	assert.Equal(t, common.NetString("bar"), value)
+}
+
+func TestRedis_Redirect(t *testing.T) {
+	store := &eventStore{}
+	redis := newTestPlugin(t, store)
+
+	private := testParse(redis, nil, tcp.TCPDirectionOriginal, "*2\r\n$3\r\nGET\r\n$3\r\nfoo\r\n")
+	testParse(redis, private, tcp.TCPDirectionReverse, "-MOVED 12182 10.0.0.3:6379\r\n")
+
+	require.Len(t, store.events, 1)
+	redirect, err := store.events[0].GetValue("redis.redirect")
+	require.NoError(t, err)
+	assert.Equal(t, mapstr.M{
+		"type":    "moved",
+		"slot":    int64(12182),
+		"address": "10.0.0.3:6379",
+	}, redirect)
+}
+
+func TestRedactPayload(t *testing.T) {
+	tests := []struct {
+		payload   string
+		isRequest bool
+		want      string
+	}{
+		{"GET foo", true, "GET foo"},
+		{"AUTH secret", true, "AUTH ******"},
+		{"auth default secret", true, "auth ******* ******"},
+		{"HELLO 3 AUTH default secret SETNAME app", true, "HELLO 3 AUTH default ****** SETNAME app"},
+		{"MIGRATE host 6379 key 0 5000 AUTH secret", true, "MIGRATE host 6379 key 0 5000 AUTH ******"},
+		{"MIGRATE host 6379 key 0 5000 AUTH2 default secret", true, "MIGRATE host 6379 key 0 5000 AUTH2 default ******"},
+		{"CONFIG SET maxmemory 1gb requirepass secret", true, "CONFIG SET maxmemory 1gb requirepass ******"},
+		{"AUTH secret", false, "AUTH secret"},
+	}
+
+	for _, test := range tests {
+		got := redactPayload([]byte(test.payload), test.isRequest)
+		assert.Equal(t, test.want, string(got), test.payload)
+	}
+}
+
+func TestRedis_PayloadSample(t *testing.T) {
+	store := &eventStore{}
+	redis := newTestPlugin(t, store)
+	redis.payloadSampler = sampling.NewPayloadSampler(config.PayloadSample{Rate: 1}, redactPayload)
+
+	private := testParse(redis, nil, tcp.TCPDirectionOriginal, "*2\r\n$4\r\nAUTH\r\n$6\r\nsecret\r\n")
+	testParse(redis, private, tcp.TCPDirectionReverse, "+OK\r\n")
+
+	require.Len(t, store.events, 1)
+	sample, err := store.events[0].GetValue("payload_sample")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"encoding":  "utf-8",
+		"request":   "AUTH ******",
+		"response":  "OK",
+		"truncated": false,
+	}, sample)
+}
+
 func BenchmarkParserNoArgsResult(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		parse(noArgsRequest)
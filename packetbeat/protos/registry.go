@@ -71,6 +71,18 @@ type UDPPlugin interface {
 	ParseUDP(pkt *Packet)
 }
 
+// DetectingTCPPlugin is a TCPPlugin that also provides the Detect() method.
+// It can then be selected by content for connections on ports that are not
+// configured for any protocol. No need to use this type directly, just
+// implement the method.
+type DetectingTCPPlugin interface {
+	TCPPlugin
+
+	// Detect returns the confidence, between 0 and 1, that payload is the
+	// first data seen on a connection using the plugin's protocol.
+	Detect(payload []byte) float64
+}
+
 // ExpirationAwareTCPPlugin is a TCPPlugin that also provides the Expired()
 // method. No need to use this type directly, just implement the method.
 type ExpirationAwareTCPPlugin interface {
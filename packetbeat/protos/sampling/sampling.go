@@ -0,0 +1,144 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package sampling attaches truncated request and response payloads to a
+// sampled fraction of the transactions published by a protocol analyzer.
+package sampling
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/packetbeat/config"
+)
+
+const (
+	defaultMaxBytes = 1024
+
+	encodingUTF8 = "utf-8"
+	encodingHex  = "hex"
+)
+
+// RedactFunc is called with a copy of each sampled payload before it is
+// truncated and encoded. Protocols use it to mask credentials and other
+// sensitive values.
+type RedactFunc func(payload []byte, isRequest bool) []byte
+
+// PayloadSampler decides which transactions get a payload sample and adds
+// the sample to the published event.
+type PayloadSampler struct {
+	rate     float64
+	maxBytes int
+	encoding string
+	redact   RedactFunc
+	random   func() float64
+}
+
+// NewPayloadSampler creates a sampler from the protocol configuration.
+// It returns nil if payload sampling is disabled, all methods are safe
+// to call on a nil sampler.
+func NewPayloadSampler(cfg config.PayloadSample, redact RedactFunc) *PayloadSampler {
+	if cfg.Rate <= 0 {
+		return nil
+	}
+	s := &PayloadSampler{
+		rate:     cfg.Rate,
+		maxBytes: cfg.MaxBytes,
+		encoding: cfg.Encoding,
+		redact:   redact,
+		random:   rand.Float64,
+	}
+	if s.maxBytes == 0 {
+		s.maxBytes = defaultMaxBytes
+	}
+	if s.encoding == "" {
+		s.encoding = encodingUTF8
+	}
+	return s
+}
+
+// Enabled returns true if payload sampling is configured.
+func (s *PayloadSampler) Enabled() bool {
+	return s != nil
+}
+
+// Sample returns true if the next transaction should get a payload sample.
+// Protocols call it before collecting the payloads to pass to Attach.
+func (s *PayloadSampler) Sample() bool {
+	if s == nil {
+		return false
+	}
+	return s.rate >= 1 || s.random() < s.rate
+}
+
+// Attach adds the redacted and truncated payloads under payload_sample in
+// the event.
+func (s *PayloadSampler) Attach(evt *beat.Event, request, response []byte) {
+	if s == nil {
+		return
+	}
+
+	truncated := false
+	sample := map[string]interface{}{
+		"encoding": s.encoding,
+	}
+	if len(request) > 0 {
+		value, cut := s.encode(request, true)
+		sample["request"] = value
+		truncated = truncated || cut
+	}
+	if len(response) > 0 {
+		value, cut := s.encode(response, false)
+		sample["response"] = value
+		truncated = truncated || cut
+	}
+	sample["truncated"] = truncated
+	evt.Fields["payload_sample"] = sample
+}
+
+func (s *PayloadSampler) encode(payload []byte, isRequest bool) (string, bool) {
+	// Redact a copy so the caller's buffers are never modified.
+	payload = append([]byte(nil), payload...)
+	if s.redact != nil {
+		payload = s.redact(payload, isRequest)
+	}
+
+	truncated := false
+	if len(payload) > s.maxBytes {
+		payload = payload[:s.maxBytes]
+		truncated = true
+	}
+
+	if s.encoding == encodingHex {
+		return hex.EncodeToString(payload), truncated
+	}
+	if truncated {
+		// Don't leave a partial multi-byte sequence at the cut.
+		for i := len(payload) - 1; i >= 0 && i >= len(payload)-utf8.UTFMax; i-- {
+			if utf8.RuneStart(payload[i]) {
+				if !utf8.FullRune(payload[i:]) {
+					payload = payload[:i]
+				}
+				break
+			}
+		}
+	}
+	return strings.ToValidUTF8(string(payload), string(utf8.RuneError)), truncated
+}
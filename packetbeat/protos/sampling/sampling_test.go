@@ -0,0 +1,148 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sampling
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/packetbeat/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestPayloadSamplerDisabled(t *testing.T) {
+	s := NewPayloadSampler(config.PayloadSample{}, nil)
+	assert.Nil(t, s)
+	assert.False(t, s.Enabled())
+	assert.False(t, s.Sample())
+
+	evt := beat.Event{Fields: mapstr.M{}}
+	s.Attach(&evt, []byte("request"), []byte("response"))
+	assert.NotContains(t, evt.Fields, "payload_sample")
+}
+
+func TestPayloadSamplerRate(t *testing.T) {
+	s := NewPayloadSampler(config.PayloadSample{Rate: 0.25}, nil)
+	s.random = func() float64 { return 0.2 }
+	assert.True(t, s.Sample())
+	s.random = func() float64 { return 0.25 }
+	assert.False(t, s.Sample())
+
+	s = NewPayloadSampler(config.PayloadSample{Rate: 1}, nil)
+	s.random = func() float64 { return 0.999 }
+	assert.True(t, s.Sample())
+}
+
+func TestPayloadSamplerAttach(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      config.PayloadSample
+		request  []byte
+		response []byte
+		want     map[string]interface{}
+	}{
+		{
+			name:     "utf-8",
+			cfg:      config.PayloadSample{Rate: 1},
+			request:  []byte("GET / HTTP/1.1\r\n"),
+			response: []byte("HTTP/1.1 200 OK\r\n"),
+			want: map[string]interface{}{
+				"encoding":  "utf-8",
+				"request":   "GET / HTTP/1.1\r\n",
+				"response":  "HTTP/1.1 200 OK\r\n",
+				"truncated": false,
+			},
+		},
+		{
+			name:    "truncated",
+			cfg:     config.PayloadSample{Rate: 1, MaxBytes: 4},
+			request: []byte("SELECT 1"),
+			want: map[string]interface{}{
+				"encoding":  "utf-8",
+				"request":   "SELE",
+				"truncated": true,
+			},
+		},
+		{
+			name:    "truncated inside multi-byte rune",
+			cfg:     config.PayloadSample{Rate: 1, MaxBytes: 4},
+			request: []byte("abcé"),
+			want: map[string]interface{}{
+				"encoding":  "utf-8",
+				"request":   "abc",
+				"truncated": true,
+			},
+		},
+		{
+			name:    "invalid utf-8",
+			cfg:     config.PayloadSample{Rate: 1},
+			request: []byte{'a', 0xff, 'b'},
+			want: map[string]interface{}{
+				"encoding":  "utf-8",
+				"request":   "a�b",
+				"truncated": false,
+			},
+		},
+		{
+			name:     "hex",
+			cfg:      config.PayloadSample{Rate: 1, MaxBytes: 2, Encoding: "hex"},
+			request:  []byte{0x03, 0x00, 0x00},
+			response: []byte{0xff},
+			want: map[string]interface{}{
+				"encoding":  "hex",
+				"request":   "0300",
+				"response":  "ff",
+				"truncated": true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			evt := beat.Event{Fields: mapstr.M{}}
+			NewPayloadSampler(test.cfg, nil).Attach(&evt, test.request, test.response)
+			assert.Equal(t, test.want, evt.Fields["payload_sample"])
+		})
+	}
+}
+
+func TestPayloadSamplerRedact(t *testing.T) {
+	redact := func(payload []byte, isRequest bool) []byte {
+		if !isRequest {
+			return payload
+		}
+		return bytes.ReplaceAll(payload, []byte("secret"), []byte("******"))
+	}
+	s := NewPayloadSampler(config.PayloadSample{Rate: 1, MaxBytes: 10}, redact)
+
+	request := []byte("AUTH secret")
+	evt := beat.Event{Fields: mapstr.M{}}
+	s.Attach(&evt, request, []byte("secret"))
+
+	// Redaction happens before truncation and never modifies the input.
+	assert.Equal(t, map[string]interface{}{
+		"encoding":  "utf-8",
+		"request":   "AUTH *****",
+		"response":  "secret",
+		"truncated": true,
+	}, evt.Fields["payload_sample"])
+	assert.Equal(t, "AUTH secret", string(request))
+}
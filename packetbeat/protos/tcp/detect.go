@@ -0,0 +1,126 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tcp
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/packetbeat/protos"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// detector selects the protocol of connections on ports that are not
+// configured for any protocol, based on the first payload seen.
+type detector struct {
+	protocols     []protos.Protocol
+	plugins       map[protos.Protocol]protos.DetectingTCPPlugin
+	minConfidence float64
+
+	// connections whose protocol could not be detected, so that detection
+	// is not attempted again for each of their packets.
+	undetected *common.Cache
+}
+
+// EnableDetection enables the detection by content of the protocol of
+// connections on ports that are not configured for any protocol. Only the
+// allowed protocols are tried, or all the configured protocols supporting
+// detection if allow is empty. A protocol is selected if its confidence is
+// at least minConfidence.
+func (tcp *TCP) EnableDetection(allow []protos.Protocol, minConfidence float64) error {
+	if minConfidence <= 0 || minConfidence > 1 {
+		return fmt.Errorf("invalid minimum confidence %v, must be in (0, 1]", minConfidence)
+	}
+
+	d := &detector{
+		plugins:       map[protos.Protocol]protos.DetectingTCPPlugin{},
+		minConfidence: minConfidence,
+		undetected: common.NewCache(
+			protos.DefaultTransactionExpiration,
+			protos.DefaultTransactionHashSize),
+	}
+	explicit := len(allow) != 0
+	if !explicit {
+		for proto := range tcp.protocols.GetAllTCP() {
+			allow = append(allow, proto)
+		}
+	}
+	for _, proto := range allow {
+		mod := tcp.protocols.GetTCP(proto)
+		if mod == nil {
+			return fmt.Errorf("protocol %v is not enabled", proto)
+		}
+		plugin, ok := mod.(protos.DetectingTCPPlugin)
+		if !ok {
+			if explicit {
+				return fmt.Errorf("protocol %v does not support detection", proto)
+			}
+			continue
+		}
+		if _, exists := d.plugins[proto]; !exists {
+			d.protocols = append(d.protocols, proto)
+		}
+		d.plugins[proto] = plugin
+	}
+	if len(d.protocols) == 0 {
+		return fmt.Errorf("no enabled protocol supports detection")
+	}
+	// Sort the protocols so that ties are resolved consistently.
+	sort.Slice(d.protocols, func(i, j int) bool { return d.protocols[i] < d.protocols[j] })
+
+	d.undetected.StartJanitor(protos.DefaultTransactionExpiration)
+	tcp.detector = d
+	return nil
+}
+
+// detect returns the protocol with the highest confidence for the payload
+// of pkt, or protos.UnknownProtocol if none reaches the minimum confidence.
+func (d *detector) detect(pkt *protos.Packet) protos.Protocol {
+	if len(pkt.Payload) == 0 {
+		// Wait for data.
+		return protos.UnknownProtocol
+	}
+	if d.undetected.Get(pkt.Tuple.Hashable()) != nil || d.undetected.Get(pkt.Tuple.RevHashable()) != nil {
+		return protos.UnknownProtocol
+	}
+
+	selected, best := protos.UnknownProtocol, 0.0
+	for _, proto := range d.protocols {
+		confidence := d.plugins[proto].Detect(pkt.Payload)
+		if confidence >= d.minConfidence && confidence > best {
+			selected, best = proto, confidence
+		}
+	}
+	if selected == protos.UnknownProtocol {
+		d.undetected.Put(pkt.Tuple.Hashable(), true)
+		return selected
+	}
+	if isDebug {
+		t := pkt.Tuple
+		logp.Debug("tcp", "Detected protocol %v (confidence %v) for connection src[%s:%d] dst[%s:%d]",
+			selected, best,
+			t.SrcIP.String(), t.SrcPort,
+			t.DstIP.String(), t.DstPort)
+	}
+	return selected
+}
+
+func (d *detector) close() {
+	d.undetected.StopJanitor()
+}
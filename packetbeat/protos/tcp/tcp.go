@@ -55,6 +55,7 @@ type TCP struct {
 	portMap      map[uint16]protos.Protocol
 	protocols    protos.Protocols
 	expiredConns expirationQueue
+	detector     *detector
 
 	metrics *inputMetrics
 }
@@ -190,6 +191,9 @@ func (tcp *TCP) getStream(pkt *protos.Packet) (stream TCPStream, created bool) {
 	}
 
 	protocol := tcp.decideProtocol(&pkt.Tuple)
+	if protocol == protos.UnknownProtocol && tcp.detector != nil {
+		protocol = tcp.detector.detect(pkt)
+	}
 	if protocol == protos.UnknownProtocol {
 		// don't follow
 		return TCPStream{}, false
@@ -269,6 +273,9 @@ func (tcp *TCP) findStream(k common.HashableIPPortTuple) *TCPConnection {
 }
 
 func (tcp *TCP) Close() {
+	if tcp.detector != nil {
+		tcp.detector.close()
+	}
 	if tcp.metrics == nil {
 		return
 	}
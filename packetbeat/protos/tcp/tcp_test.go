@@ -23,6 +23,7 @@ package tcp
 import (
 	"math/rand"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -399,3 +400,107 @@ func makeCollectPayload(
 		return *state
 	}
 }
+
+// detectingProtocol is a TestProtocol supporting detection by content.
+type detectingProtocol struct {
+	TestProtocol
+	prefix     string
+	confidence float64
+}
+
+func (proto detectingProtocol) Detect(payload []byte) float64 {
+	if strings.HasPrefix(string(payload), proto.prefix) {
+		return proto.confidence
+	}
+	return 0
+}
+
+func TestProtocolDetection(t *testing.T) {
+	var httpState, redisState []byte
+	p := protocols{tcp: map[protos.Protocol]protos.TCPPlugin{
+		httpProtocol: &detectingProtocol{
+			TestProtocol: TestProtocol{Ports: []int{80}, parse: makeCollectPayload(&httpState, true)},
+			prefix:       "GET ",
+			confidence:   1,
+		},
+		redisProtocol: &detectingProtocol{
+			TestProtocol: TestProtocol{Ports: []int{6379}, parse: makeCollectPayload(&redisState, true)},
+			prefix:       "*",
+			confidence:   0.5,
+		},
+		mysqlProtocol: &TestProtocol{Ports: []int{3306}},
+	}}
+
+	newTCP := func(t *testing.T) *TCP {
+		tcp, err := NewTCP(p, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(tcp.Close)
+		return tcp
+	}
+	process := func(tcp *TCP, port uint16, seq uint32, payload string) {
+		tcp.Process(nil, &layers.TCP{Seq: seq}, &protos.Packet{
+			Ts: time.Now(),
+			Tuple: common.NewIPPortTuple(4,
+				net.ParseIP(ClientIP), 40000,
+				net.ParseIP(ServerIP), port),
+			Payload: []byte(payload),
+		})
+	}
+
+	t.Run("invalid settings", func(t *testing.T) {
+		tcp := newTCP(t)
+		assert.Error(t, tcp.EnableDetection(nil, 0))
+		assert.Error(t, tcp.EnableDetection(nil, 1.5))
+		assert.Error(t, tcp.EnableDetection([]protos.Protocol{mysqlProtocol}, 0.8))
+		assert.Error(t, tcp.EnableDetection([]protos.Protocol{protos.Protocol(1000)}, 0.8))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		httpState = nil
+		tcp := newTCP(t)
+		process(tcp, 8080, 1, "GET / HTTP/1.1\r\n\r\n")
+		assert.Nil(t, httpState)
+	})
+
+	t.Run("detected", func(t *testing.T) {
+		httpState = nil
+		tcp := newTCP(t)
+		assert.NoError(t, tcp.EnableDetection(nil, 0.8))
+		process(tcp, 8080, 1, "")
+		process(tcp, 8080, 1, "GET / HTTP/1.1\r\n")
+		process(tcp, 8080, 17, "\r\n")
+		assert.Equal(t, "GET / HTTP/1.1\r\n\r\n", string(httpState))
+	})
+
+	t.Run("below minimum confidence", func(t *testing.T) {
+		redisState = nil
+		tcp := newTCP(t)
+		assert.NoError(t, tcp.EnableDetection(nil, 0.8))
+		process(tcp, 7000, 1, "*1\r\n$4\r\nPING\r\n")
+		assert.Nil(t, redisState)
+
+		tcp = newTCP(t)
+		assert.NoError(t, tcp.EnableDetection(nil, 0.5))
+		process(tcp, 7000, 1, "*1\r\n$4\r\nPING\r\n")
+		assert.Equal(t, "*1\r\n$4\r\nPING\r\n", string(redisState))
+	})
+
+	t.Run("not allowed", func(t *testing.T) {
+		httpState = nil
+		tcp := newTCP(t)
+		assert.NoError(t, tcp.EnableDetection([]protos.Protocol{redisProtocol}, 0.5))
+		process(tcp, 8080, 1, "GET / HTTP/1.1\r\n")
+		assert.Nil(t, httpState)
+	})
+
+	t.Run("undetected connection is not retried", func(t *testing.T) {
+		httpState = nil
+		tcp := newTCP(t)
+		assert.NoError(t, tcp.EnableDetection(nil, 0.8))
+		process(tcp, 8080, 1, "garbage")
+		process(tcp, 8080, 8, "GET / HTTP/1.1\r\n")
+		assert.Nil(t, httpState)
+	})
+}
@@ -0,0 +1,50 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package tls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		payload    []byte
+		confidence float64
+	}{
+		{"client hello", []byte{0x16, 0x03, 0x01, 0x02, 0x00, 0x01, 0x00, 0x01, 0xfc}, 1},
+		{"server hello", []byte{0x16, 0x03, 0x03, 0x00, 0x5a, 0x02, 0x00, 0x00, 0x56}, 1},
+		{"record header only", []byte{0x16, 0x03, 0x01, 0x02, 0x00}, 0.5},
+		{"other handshake", []byte{0x16, 0x03, 0x03, 0x00, 0x10, 0x0b}, 0.5},
+		{"application data", []byte{0x17, 0x03, 0x03, 0x00, 0x10, 0x01}, 0},
+		{"unknown version", []byte{0x16, 0x02, 0x00, 0x00, 0x10, 0x01}, 0},
+		{"empty record", []byte{0x16, 0x03, 0x01, 0x00, 0x00, 0x01}, 0},
+		{"oversized record", []byte{0x16, 0x03, 0x01, 0xff, 0xff, 0x01}, 0},
+		{"http", []byte("GET / HTTP/1.1\r\n"), 0},
+		{"short", []byte{0x16, 0x03}, 0},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.confidence, (&tlsPlugin{}).Detect(test.payload))
+		})
+	}
+}
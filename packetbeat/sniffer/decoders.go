@@ -18,6 +18,8 @@
 package sniffer
 
 import (
+	"fmt"
+
 	"github.com/google/gopacket/layers"
 
 	"github.com/elastic/beats/v7/packetbeat/config"
@@ -65,6 +67,19 @@ func DecodersFor(id string, publisher *publish.TransactionPublisher, protocols *
 		if err != nil {
 			return nil, nil, err
 		}
+		if detection := cfg.ProtocolDetection; detection.Enabled {
+			allow := make([]protos.Protocol, 0, len(detection.Protocols))
+			for _, name := range detection.Protocols {
+				proto := protos.Lookup(name)
+				if proto == protos.UnknownProtocol {
+					return nil, nil, fmt.Errorf("unknown protocol %q in protocol_detection.protocols", name)
+				}
+				allow = append(allow, proto)
+			}
+			if err := tcp.EnableDetection(allow, detection.MinConfidence); err != nil {
+				return nil, nil, fmt.Errorf("failed to enable protocol detection: %w", err)
+			}
+		}
 
 		udp, err := udp.NewUDP(protocols, id, device)
 		if err != nil {
@@ -0,0 +1,261 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+
+	"github.com/elastic/beats/v7/packetbeat/config"
+)
+
+// Reload applies a new set of interface configurations to the sniffers.
+// Sniffers whose configuration only differs in the BPF filter are kept
+// running and the new filter is attached to their live capture handle.
+// Sniffers of interfaces that are no longer configured are stopped and
+// sniffers for new interfaces are started. All configurations are
+// validated before any change is applied.
+func (s *Sniffer) Reload(interfaces []config.InterfaceConfig) error {
+	if len(interfaces) == 0 {
+		return errors.New("no interfaces configured")
+	}
+	next := make([]*sniffer, len(interfaces))
+	for i, iface := range interfaces {
+		c, err := newSniffer(s.testMode, s.decoders, i, iface)
+		if err != nil {
+			return err
+		}
+		next[i] = c
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := make([]*sniffer, len(s.sniffers))
+	copy(current, s.sniffers)
+	var started []*sniffer
+	for i, c := range next {
+		j := matchSniffer(current, c)
+		if j < 0 {
+			started = append(started, c)
+			continue
+		}
+		old := current[j]
+		current = append(current[:j], current[j+1:]...)
+		if f := c.currentFilter(); f != old.currentFilter() {
+			logp.Info("sniffer %s: changing BPF filter to %q", old.config.Device, f)
+			old.setFilter(f)
+		}
+		next[i] = old
+	}
+	s.sniffers = next
+
+	if s.group == nil {
+		// Not running yet, the sniffers are started by Run.
+		return nil
+	}
+
+	// Hold the errgroup open while sniffers are replaced, so
+	// that Run does not return when all old sniffers have
+	// stopped before the new ones are started.
+	release := make(chan struct{})
+	s.group.Go(func() error {
+		<-release
+		return nil
+	})
+	defer close(release)
+
+	// Removed sniffers are stopped before new sniffers are started,
+	// so the metrics of a device are not registered twice.
+	for _, c := range current {
+		logp.Info("sniffer %s: stopping removed interface", c.config.Device)
+		c.stop()
+	}
+	for _, c := range started {
+		logp.Info("sniffer %s: starting new interface", c.config.Device)
+		s.start(c)
+	}
+	return nil
+}
+
+// matchSniffer returns the index of the sniffer in list that captures with
+// the same configuration as c, ignoring the BPF filter, or -1.
+func matchSniffer(list []*sniffer, c *sniffer) int {
+	for i, candidate := range list {
+		a, b := candidate.config, c.config
+		a.BpfFilter, b.BpfFilter = "", ""
+		if reflect.DeepEqual(a, b) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *sniffer) currentFilter() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.filter
+}
+
+// setFilter sets the BPF filter of the sniffer. The filter is attached to
+// the live handle by the capture loop, or used when the next handle is
+// opened.
+func (s *sniffer) setFilter(filter string) {
+	s.mu.Lock()
+	s.filter = filter
+	s.mu.Unlock()
+	s.filterVersion.Inc()
+}
+
+// attachFilter attaches the current BPF filter to the live handle. If the
+// filter can not be attached the handle keeps its previous filter.
+func (s *sniffer) attachFilter(handle snifferHandle, device string) {
+	h, ok := handle.(interface{ SetBPFFilter(string) error })
+	if !ok {
+		// Filters are not applied to pcap files.
+		return
+	}
+	filter := s.currentFilter()
+	if err := h.SetBPFFilter(filter); err != nil {
+		logp.Warn("sniffer %s: failed to attach BPF filter %q: %v", s.config.Device, filter, err)
+		s.metrics.failed()
+		return
+	}
+	logp.Info("sniffer %s: attached BPF filter %q", s.config.Device, filter)
+	s.metrics.reloaded(device, filter)
+}
+
+// filterMetrics reports the BPF filter of a sniffer and estimates the number
+// of packets that were not captured due to the filter.
+type filterMetrics struct {
+	unregister func()
+
+	device        *monitoring.String // name of the device being captured
+	filter        *monitoring.String // BPF filter attached to the device
+	filterReloads *monitoring.Uint   // number of filters attached by a configuration reload
+	filterErrors  *monitoring.Uint   // number of filters that failed to be attached
+	received      *monitoring.Uint   // number of packets captured
+
+	// Interface and capture counters when the
+	// filter was attached.
+	mu           sync.Mutex
+	name         string
+	baseDevice   uint64
+	baseReceived uint64
+	haveBase     bool
+}
+
+// newFilterMetrics returns the filter metrics of a sniffer. If id or device
+// is empty a nil filterMetrics is returned.
+func newFilterMetrics(id, device, filter string) *filterMetrics {
+	if id == "" || device == "" {
+		// An empty id signals to not record metrics,
+		// while an empty device means we are reading
+		// from a pcap file and no metrics are needed.
+		return nil
+	}
+	reg, unreg := inputmon.NewInputRegistry("sniffer", fmt.Sprintf("%s-sniffer::%s", id, device), nil)
+	m := &filterMetrics{
+		unregister:    unreg,
+		device:        monitoring.NewString(reg, "device"),
+		filter:        monitoring.NewString(reg, "bpf_filter"),
+		filterReloads: monitoring.NewUint(reg, "bpf_filter_reloads_total"),
+		filterErrors:  monitoring.NewUint(reg, "bpf_filter_errors_total"),
+		received:      monitoring.NewUint(reg, "received_packets_total"),
+	}
+	monitoring.NewFunc(reg, "filtered_packets_estimate", func(_ monitoring.Mode, v monitoring.Visitor) {
+		v.OnInt(m.filteredEstimate())
+	})
+	m.device.Set(device)
+	m.filter.Set(filter)
+	return m
+}
+
+// attached records the interface and capture counters as the baseline of
+// the filtered packets estimate.
+func (m *filterMetrics) attached(device, filter string) {
+	if m == nil {
+		return
+	}
+	m.filter.Set(filter)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.name = device
+	m.baseReceived = m.received.Get()
+	m.baseDevice, m.haveBase = interfacePackets(device)
+}
+
+// reloaded records a filter attached by a configuration reload.
+func (m *filterMetrics) reloaded(device, filter string) {
+	if m == nil {
+		return
+	}
+	m.filterReloads.Inc()
+	m.attached(device, filter)
+}
+
+// failed records a filter that could not be attached.
+func (m *filterMetrics) failed() {
+	if m == nil {
+		return
+	}
+	m.filterErrors.Inc()
+}
+
+// packet records a captured packet.
+func (m *filterMetrics) packet() {
+	if m == nil {
+		return
+	}
+	m.received.Inc()
+}
+
+// filteredEstimate returns the number of packets seen by the interface that
+// were not captured since the filter was attached, or -1 if the interface
+// counters are not available. The estimate includes packets that were
+// dropped by the kernel.
+func (m *filterMetrics) filteredEstimate() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.haveBase {
+		return -1
+	}
+	total, ok := interfacePackets(m.name)
+	if !ok || total < m.baseDevice {
+		return -1
+	}
+	seen := total - m.baseDevice
+	captured := m.received.Get() - m.baseReceived
+	if captured >= seen {
+		return 0
+	}
+	return int64(seen - captured)
+}
+
+func (m *filterMetrics) close() {
+	if m == nil {
+		return
+	}
+	m.unregister()
+}
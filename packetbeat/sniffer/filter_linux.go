@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux
+// +build linux
+
+package sniffer
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// sysClassNet is the directory holding the statistics of the network
+// interfaces.
+var sysClassNet = "/sys/class/net"
+
+// interfacePackets returns the number of packets received and sent by the
+// device. The counters of all interfaces are summed for the any device.
+func interfacePackets(device string) (uint64, bool) {
+	if device != "any" {
+		return devicePackets(device)
+	}
+	entries, err := os.ReadDir(sysClassNet)
+	if err != nil {
+		return 0, false
+	}
+	var total uint64
+	for _, e := range entries {
+		n, ok := devicePackets(e.Name())
+		if !ok {
+			return 0, false
+		}
+		total += n
+	}
+	return total, true
+}
+
+func devicePackets(device string) (uint64, bool) {
+	var total uint64
+	for _, counter := range []string{"rx_packets", "tx_packets"} {
+		b, err := os.ReadFile(filepath.Join(sysClassNet, device, "statistics", counter))
+		if err != nil {
+			return 0, false
+		}
+		n, err := strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		total += n
+	}
+	return total, true
+}
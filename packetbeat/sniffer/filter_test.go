@@ -0,0 +1,124 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package sniffer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/monitoring"
+
+	"github.com/elastic/beats/v7/packetbeat/config"
+)
+
+func TestReload(t *testing.T) {
+	s, err := New(true, "", nil, []config.InterfaceConfig{
+		{Device: "eth0", BpfFilter: "tcp port 80"},
+		{Device: "eth1"},
+	})
+	require.NoError(t, err)
+	eth0, eth1 := s.sniffers[0], s.sniffers[1]
+
+	err = s.Reload([]config.InterfaceConfig{
+		{Device: "eth0", BpfFilter: "tcp port 443"},
+		{Device: "eth2"},
+	})
+	require.NoError(t, err)
+	require.Len(t, s.sniffers, 2)
+
+	// The sniffer of eth0 is kept with the new filter.
+	assert.Same(t, eth0, s.sniffers[0])
+	assert.Equal(t, "tcp port 443", eth0.currentFilter())
+	assert.Equal(t, uint32(1), eth0.filterVersion.Load())
+
+	// eth1 is replaced by a new sniffer for eth2.
+	assert.NotSame(t, eth1, s.sniffers[1])
+	assert.Equal(t, "eth2", s.sniffers[1].device)
+}
+
+func TestReloadInvalid(t *testing.T) {
+	s, err := New(true, "", nil, []config.InterfaceConfig{{Device: "eth0", BpfFilter: "tcp"}})
+	require.NoError(t, err)
+	eth0 := s.sniffers[0]
+
+	assert.Error(t, s.Reload(nil))
+	assert.Error(t, s.Reload([]config.InterfaceConfig{{Device: "eth0", BpfFilter: "not a filter"}}))
+
+	// Nothing is changed by an invalid configuration.
+	require.Len(t, s.sniffers, 1)
+	assert.Same(t, eth0, s.sniffers[0])
+	assert.Equal(t, "tcp", eth0.currentFilter())
+}
+
+func TestReloadRestartsChangedCapture(t *testing.T) {
+	s, err := New(true, "", nil, []config.InterfaceConfig{{Device: "eth0", Snaplen: 1514}})
+	require.NoError(t, err)
+	eth0 := s.sniffers[0]
+
+	err = s.Reload([]config.InterfaceConfig{{Device: "eth0", Snaplen: 9000}})
+	require.NoError(t, err)
+	require.Len(t, s.sniffers, 1)
+	assert.NotSame(t, eth0, s.sniffers[0])
+	assert.Equal(t, 9000, s.sniffers[0].config.Snaplen)
+}
+
+type filterHandle struct {
+	snifferHandle
+	filter string
+	err    error
+}
+
+func (h *filterHandle) SetBPFFilter(expr string) error {
+	if h.err != nil {
+		return h.err
+	}
+	h.filter = expr
+	return nil
+}
+
+func TestAttachFilter(t *testing.T) {
+	reg := monitoring.NewRegistry()
+	s := &sniffer{config: config.InterfaceConfig{Device: "eth0"}, device: "eth0"}
+	s.metrics = &filterMetrics{
+		filter:        monitoring.NewString(reg, "bpf_filter"),
+		filterReloads: monitoring.NewUint(reg, "bpf_filter_reloads_total"),
+		filterErrors:  monitoring.NewUint(reg, "bpf_filter_errors_total"),
+		received:      monitoring.NewUint(reg, "received_packets_total"),
+	}
+
+	h := &filterHandle{}
+	s.setFilter("udp")
+	s.attachFilter(h, "eth0")
+	assert.Equal(t, "udp", h.filter)
+	assert.Equal(t, "udp", s.metrics.filter.Get())
+	assert.Equal(t, uint64(1), s.metrics.filterReloads.Get())
+
+	// A filter that can not be attached leaves the previous filter in place.
+	h.err = errors.New("bad filter")
+	s.setFilter("icmp")
+	s.attachFilter(h, "eth0")
+	assert.Equal(t, "udp", h.filter)
+	assert.Equal(t, "udp", s.metrics.filter.Get())
+	assert.Equal(t, uint64(1), s.metrics.filterErrors.Get())
+}
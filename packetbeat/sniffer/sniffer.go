@@ -25,6 +25,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/gopacket"
@@ -43,7 +44,14 @@ import (
 // Sniffer provides packet sniffing capabilities, forwarding packets read
 // to a Worker.
 type Sniffer struct {
-	sniffers []sniffer
+	id       string
+	testMode bool
+	decoders Decoders
+
+	mu       sync.Mutex
+	sniffers []*sniffer
+	ctx      context.Context
+	group    *errgroup.Group
 	cancel   func()
 }
 
@@ -52,6 +60,12 @@ type sniffer struct {
 
 	state atomic.Int32 // store snifferState
 
+	// cancel stops the sniffer when its interface is
+	// removed by a configuration reload and done is
+	// closed once it has stopped.
+	cancel func()
+	done   chan struct{}
+
 	// device is the first active device after calling New.
 	// It is not updated by default route polling.
 	device string
@@ -61,9 +75,14 @@ type sniffer struct {
 	followDefault bool
 
 	// filter is the bpf filter program used by the sniffer.
-	filter string
+	// It is protected by mu and filterVersion is incremented
+	// each time it is changed by a configuration reload.
+	mu            sync.Mutex
+	filter        string
+	filterVersion atomic.Uint32
 
 	decoders Decoders
+	metrics  *filterMetrics
 }
 
 type snifferHandle interface {
@@ -81,70 +100,85 @@ const (
 
 // New create a new Sniffer instance. Settings are validated in a best effort
 // only, but no device is opened yet. Accessing and configuring the actual device
-// is done by the Run method.
-func New(testMode bool, _ string, decoders Decoders, interfaces []config.InterfaceConfig) (*Sniffer, error) {
-	s := &Sniffer{sniffers: make([]sniffer, len(interfaces))}
+// is done by the Run method. The id is used to register the metrics of the
+// sniffers, no metrics are collected if it is empty.
+func New(testMode bool, id string, decoders Decoders, interfaces []config.InterfaceConfig) (*Sniffer, error) {
+	s := &Sniffer{
+		id:       id,
+		testMode: testMode,
+		decoders: decoders,
+		sniffers: make([]*sniffer, len(interfaces)),
+	}
 
 	for i, iface := range interfaces {
-		child := sniffer{
-			state:         atomic.MakeInt32(snifferInactive),
-			followDefault: iface.PollDefaultRoute > 0 && strings.HasPrefix(iface.Device, "default_route"),
-			decoders:      decoders,
+		child, err := newSniffer(testMode, decoders, i, iface)
+		if err != nil {
+			return nil, err
 		}
+		s.sniffers[i] = child
+	}
 
-		logp.Debug("sniffer", "interface: %d, BPF filter: '%s'", i, iface.BpfFilter)
+	return s, nil
+}
 
-		// pre-check and normalize configuration:
-		// - resolve potential device name
-		// - check for file output
-		// - set some defaults
-		if iface.File != "" {
-			logp.Debug("sniffer", "Reading from file: %s", iface.File)
+func newSniffer(testMode bool, decoders Decoders, i int, iface config.InterfaceConfig) (*sniffer, error) {
+	child := &sniffer{
+		state:         atomic.MakeInt32(snifferInactive),
+		followDefault: iface.PollDefaultRoute > 0 && strings.HasPrefix(iface.Device, "default_route"),
+		filter:        iface.BpfFilter,
+		decoders:      decoders,
+	}
 
-			if iface.BpfFilter != "" {
-				logp.Warn("Packet filters are not applied to pcap files.")
-			}
+	logp.Debug("sniffer", "interface: %d, BPF filter: '%s'", i, iface.BpfFilter)
 
-			// we read file with the pcap provider
-			iface.Type = "pcap"
-			iface.Device = ""
-		} else {
-			// try to resolve device name (ignore error if testMode is enabled)
-			if name, err := resolveDeviceName(iface.Device); err != nil {
-				if !testMode {
-					return nil, err
-				}
-			} else {
-				child.device = name
-				if name == "any" && !deviceAnySupported {
-					return nil, fmt.Errorf("any interface is not supported on %s", runtime.GOOS)
-				}
+	// pre-check and normalize configuration:
+	// - resolve potential device name
+	// - check for file output
+	// - set some defaults
+	if iface.File != "" {
+		logp.Debug("sniffer", "Reading from file: %s", iface.File)
 
-				if iface.Snaplen == 0 {
-					iface.Snaplen = 65535
-				}
-				if iface.BufferSizeMb <= 0 {
-					iface.BufferSizeMb = 24
-				}
+		if iface.BpfFilter != "" {
+			logp.Warn("Packet filters are not applied to pcap files.")
+		}
 
-				if t := iface.Type; t == "autodetect" || t == "" {
-					iface.Type = "pcap"
-				}
-				logp.Debug("sniffer", "Sniffer type: %s device: %s", iface.Type, child.device)
+		// we read file with the pcap provider
+		iface.Type = "pcap"
+		iface.Device = ""
+	} else {
+		// try to resolve device name (ignore error if testMode is enabled)
+		if name, err := resolveDeviceName(iface.Device); err != nil {
+			if !testMode {
+				return nil, err
+			}
+		} else {
+			child.device = name
+			if name == "any" && !deviceAnySupported {
+				return nil, fmt.Errorf("any interface is not supported on %s", runtime.GOOS)
 			}
-		}
 
-		err := validateConfig(iface.BpfFilter, &iface) //nolint:gosec // Bad linter! validateConfig completes before the next iteration.
-		if err != nil {
-			cfg, _ := json.Marshal(iface)
-			return nil, fmt.Errorf("validate: %w: %s", err, cfg)
+			if iface.Snaplen == 0 {
+				iface.Snaplen = 65535
+			}
+			if iface.BufferSizeMb <= 0 {
+				iface.BufferSizeMb = 24
+			}
+
+			if t := iface.Type; t == "autodetect" || t == "" {
+				iface.Type = "pcap"
+			}
+			logp.Debug("sniffer", "Sniffer type: %s device: %s", iface.Type, child.device)
 		}
+	}
 
-		child.config = iface
-		s.sniffers[i] = child
+	err := validateConfig(iface.BpfFilter, &iface)
+	if err != nil {
+		cfg, _ := json.Marshal(iface)
+		return nil, fmt.Errorf("validate: %w: %s", err, cfg)
 	}
 
-	return s, nil
+	child.config = iface
+	return child, nil
 }
 
 func validateConfig(filter string, cfg *config.InterfaceConfig) error {
@@ -184,30 +218,58 @@ func validateAfPacketConfig(cfg *config.InterfaceConfig) error {
 // Run opens the sniffing device and processes packets being read from that device.
 // Worker instances are instantiated as needed.
 func (s *Sniffer) Run() error {
+	s.mu.Lock()
 	ctx, cancel := context.WithCancel(context.Background())
 	s.cancel = cancel
-	g, ctx := errgroup.WithContext(ctx)
-	for i := range s.sniffers {
-		c := &s.sniffers[i]
-		g.Go(func() error {
-			var (
-				defaultRoute chan string
-				refresh      chan struct{}
-			)
-			if c.followDefault {
-				defaultRoute = make(chan string)
-				refresh = make(chan struct{}, 1)
-				go c.pollDefaultRoute(ctx, defaultRoute, refresh)
-			}
-			if defaultRoute == nil {
-				return c.sniffStatic(ctx, c.device)
-			}
-			return c.sniffDynamic(ctx, defaultRoute, refresh)
-		})
+	s.group, s.ctx = errgroup.WithContext(ctx)
+	for _, c := range s.sniffers {
+		s.start(c)
 	}
+	g := s.group
+	s.mu.Unlock()
 	return g.Wait()
 }
 
+// start runs the sniffer c in the errgroup of s. s.mu must be held.
+func (s *Sniffer) start(c *sniffer) {
+	ctx, cancel := context.WithCancel(s.ctx)
+	c.cancel = cancel
+	c.done = make(chan struct{})
+	c.metrics = newFilterMetrics(s.id, c.config.Device, c.currentFilter())
+	s.group.Go(func() error {
+		defer close(c.done)
+		defer cancel()
+		defer c.metrics.close()
+		return c.run(ctx)
+	})
+}
+
+// stop stops the sniffer c and waits for it to finish.
+func (c *sniffer) stop() {
+	c.state.Store(snifferClosing)
+	if c.cancel == nil {
+		return
+	}
+	c.cancel()
+	<-c.done
+}
+
+func (c *sniffer) run(ctx context.Context) error {
+	var (
+		defaultRoute chan string
+		refresh      chan struct{}
+	)
+	if c.followDefault {
+		defaultRoute = make(chan string)
+		refresh = make(chan struct{}, 1)
+		go c.pollDefaultRoute(ctx, defaultRoute, refresh)
+	}
+	if defaultRoute == nil {
+		return c.sniffStatic(ctx, c.device)
+	}
+	return c.sniffDynamic(ctx, defaultRoute, refresh)
+}
+
 // pollDefaultRoute repeatedly polls the default route's device at intervals
 // specified in config.PollDefaultRoute. The poller is terminated by cancelling
 // the context and the device chan can be read for changes in the default route.
@@ -282,7 +344,7 @@ func (s *sniffer) sniffStatic(ctx context.Context, device string) error {
 	if cleanup != nil {
 		defer cleanup()
 	}
-	return s.sniffHandle(ctx, handle, dec, nil)
+	return s.sniffHandle(ctx, device, handle, dec, nil)
 }
 
 // sniffDynamic performs sniffing work on a stream of dynamic interfaces from
@@ -327,12 +389,12 @@ func (s *sniffer) sniffOneDynamic(ctx context.Context, device string, last layer
 		}
 	}
 
-	err = s.sniffHandle(ctx, handle, dec, refresh)
+	err = s.sniffHandle(ctx, device, handle, dec, refresh)
 	return linkType, dec, err
 }
 
 // sniff performs the sniffing work and writing dump files if requested.
-func (s *sniffer) sniffHandle(ctx context.Context, handle snifferHandle, dec *decoder.Decoder, refresh chan<- struct{}) error {
+func (s *sniffer) sniffHandle(ctx context.Context, device string, handle snifferHandle, dec *decoder.Decoder, refresh chan<- struct{}) error {
 	var w *pcapgo.Writer
 	if s.config.Dumpfile != "" {
 		const timeSuffixFormat = "20060102150405"
@@ -359,11 +421,21 @@ func (s *sniffer) sniffHandle(ctx context.Context, handle snifferHandle, dec *de
 	}
 	defer s.state.Store(snifferInactive)
 
+	version := s.filterVersion.Load()
+	s.metrics.attached(device, s.currentFilter())
+
 	var (
 		packets  int
 		timeouts int
 	)
 	for s.state.Load() == snifferActive {
+		// Attach the filter to the live handle if it was changed
+		// by a configuration reload.
+		if v := s.filterVersion.Load(); v != version {
+			version = v
+			s.attachFilter(handle, device)
+		}
+
 		select {
 		case <-ctx.Done():
 			logp.Info("sniffing cancelled: %q", s.config.Device)
@@ -428,6 +500,7 @@ func (s *sniffer) sniffHandle(ctx context.Context, handle snifferHandle, dec *de
 		}
 
 		packets++
+		s.metrics.packet()
 
 		if w != nil {
 			err = w.WritePacket(ci, data)
@@ -450,9 +523,9 @@ func (s *sniffer) open(device string) (snifferHandle, error) {
 
 	switch s.config.Type {
 	case "pcap":
-		return openPcap(device, s.filter, &s.config)
+		return openPcap(device, s.currentFilter(), &s.config)
 	case "af_packet":
-		return openAFPacket(device, s.filter, &s.config)
+		return openAFPacket(device, s.currentFilter(), &s.config)
 	default:
 		return nil, fmt.Errorf("unknown sniffer type for %s: %q", device, s.config.Type)
 	}
@@ -462,6 +535,8 @@ func (s *sniffer) open(device string) (snifferHandle, error) {
 // signal has been given.
 func (s *Sniffer) Stop() {
 	logp.Debug("sniffer", "sending stop to all sniffers")
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	for _, c := range s.sniffers {
 		logp.Debug("sniffer", "sending closing to %s", c.config.Device)
 		c.state.Store(snifferClosing)
@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/elastic/beats/v7/filebeat/fileset"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/esleg/eslegclient"
 	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
@@ -106,10 +107,14 @@ func (eb *Winlogbeat) init(b *beat.Beat) error {
 	}
 	b.OverwritePipelinesCallback = func(esConfig *conf.C) error {
 		overwritePipelines := config.OverwritePipelines
-		esClient, err := eslegclient.NewConnectedClient(esConfig, "Winlogbeat")
+		conn, err := eslegclient.NewConnectedClient(esConfig, "Winlogbeat")
 		if err != nil {
 			return err
 		}
+		var esClient fileset.PipelineLoader = conn
+		if b.SetupDryRun != nil {
+			esClient = eslegclient.NewDryRunConnection(conn, b.SetupDryRun)
+		}
 		_, err = module.UploadPipelines(b.Info, esClient, overwritePipelines)
 		return err
 	}
@@ -155,7 +160,7 @@ func (eb *Winlogbeat) Run(b *beat.Beat) error {
 
 	// Initialize metrics.
 	initMetrics("total")
-	if b.API != nil {
+	if b.API != nil && b.API.InputsEnabled() {
 		err := inputmon.AttachHandler(b.API.Router())
 		if err != nil {
 			return fmt.Errorf("failed attach inputs api to monitoring endpoint server: %w", err)
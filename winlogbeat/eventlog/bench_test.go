@@ -28,8 +28,7 @@ import (
 	"strconv"
 	"testing"
 
-	"golang.org/x/sys/windows/svc/eventlog"
-
+	"github.com/elastic/beats/v7/winlogbeat/eventlog/eventlogtest"
 	"github.com/elastic/elastic-agent-libs/mapstr"
 )
 
@@ -51,14 +50,16 @@ func TestBenchmarkRead(t *testing.T) {
 		t.Skip("-benchtest not enabled")
 	}
 
-	writer, teardown := createLog(t)
-	defer teardown()
-
-	setLogSize(t, providerName, gigabyte)
+	channel := eventlogtest.CreateChannel(t, providerName, sourceName)
+	channel.SetSize(t, gigabyte)
 
 	// Publish test messages:
 	for i := 0; i < *injectAmount; i++ {
-		safeWriteEvent(t, writer, eventlog.Info, uint32(rand.Int63()%1000), []string{strconv.Itoa(i) + " " + randomSentence(256)})
+		channel.Write(t, eventlogtest.Event{
+			Type:     eventlogtest.Info,
+			ID:       uint32(rand.Int63() % 1000),
+			Messages: []string{strconv.Itoa(i) + " " + randomSentence(256)},
+		})
 	}
 
 	for _, api := range []string{winEventLogAPIName, winEventLogExpAPIName} {
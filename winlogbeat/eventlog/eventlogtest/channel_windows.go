@@ -0,0 +1,145 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build windows
+// +build windows
+
+package eventlogtest
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andrewkroh/sys/windows/svc/eventlog"
+)
+
+// Event types that can be written to a Channel.
+const (
+	Error   = eventlog.Error
+	Warning = eventlog.Warning
+	Info    = eventlog.Info
+)
+
+// EventCreateMsgFile is the message file of EventCreate.exe. It has valid
+// event IDs in the range of 1-1000 where each event message requires a single
+// parameter.
+const EventCreateMsgFile = `%SystemRoot%\System32\EventCreate.exe`
+
+// Event is a synthetic event written to a Channel.
+type Event struct {
+	Type     uint16   // Type of the event. One of Error, Warning or Info.
+	ID       uint32   // Event ID. It must be defined in the message files of the Channel.
+	Messages []string // Insertion strings of the event message.
+}
+
+// Channel is an event log channel created for testing.
+type Channel struct {
+	Name   string // Name of the channel.
+	Source string // Name of the event source writing to the channel.
+
+	log *eventlog.Log
+}
+
+// CreateChannel registers source as an event source writing to the name
+// channel, creating the channel if it does not exist, and returns a Channel
+// for writing events to it. The source uses the given message files, or
+// EventCreateMsgFile if none is given. Events already in the channel are
+// cleared. The channel and source are removed when the test completes.
+func CreateChannel(t testing.TB, name, source string, messageFiles ...string) *Channel {
+	t.Helper()
+
+	messageFile := EventCreateMsgFile
+	if len(messageFiles) > 0 {
+		messageFile = strings.Join(messageFiles, ";")
+	}
+
+	existed, err := eventlog.Install(name, source, messageFile, true, Error|Warning|Info)
+	if err != nil {
+		t.Fatalf("failed to install event source %q for channel %q: %v", source, name, err)
+	}
+
+	c := &Channel{Name: name, Source: source}
+	if existed {
+		c.Clear(t)
+	}
+
+	c.log, err = eventlog.Open(source)
+	if err != nil {
+		_ = eventlog.RemoveSource(name, source)
+		_ = eventlog.RemoveProvider(name)
+		t.Fatalf("failed to open event source %q: %v", source, err)
+	}
+
+	t.Cleanup(func() {
+		c.log.Close()
+		_ = wevtutil("cl", c.Name)
+		_ = eventlog.RemoveSource(name, source)
+		_ = eventlog.RemoveProvider(name)
+	})
+
+	return c
+}
+
+// Write writes the events to the channel. Writes are retried for up to
+// 10 seconds because the event log service may reject writes right after the
+// channel is created.
+func (c *Channel) Write(t testing.TB, events ...Event) {
+	t.Helper()
+
+	for _, e := range events {
+		deadline := time.Now().Add(10 * time.Second)
+		for {
+			err := c.log.Report(e.Type, e.ID, e.Messages)
+			if err == nil {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("failed to write event %d to channel %q: %v", e.ID, c.Name, err)
+			}
+		}
+	}
+}
+
+// SetSize sets the maximum number of bytes that the channel can hold.
+func (c *Channel) SetSize(t testing.TB, sizeBytes int) {
+	t.Helper()
+
+	if err := wevtutil("sl", "/ms:"+strconv.Itoa(sizeBytes), c.Name); err != nil {
+		t.Fatalf("failed to set size of channel %q: %v", c.Name, err)
+	}
+}
+
+// Clear removes all the events from the channel.
+func (c *Channel) Clear(t testing.TB) {
+	t.Helper()
+
+	if err := wevtutil("cl", c.Name); err != nil {
+		t.Fatalf("failed to clear channel %q: %v", c.Name, err)
+	}
+}
+
+func wevtutil(args ...string) error {
+	output, err := exec.Command("wevtutil.exe", args...).CombinedOutput() //nolint:gosec // No possibility of command injection.
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, output)
+	}
+	return nil
+}
@@ -38,6 +38,7 @@ import (
 	"golang.org/x/sys/windows"
 
 	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/conditions"
 	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
 	"github.com/elastic/beats/v7/winlogbeat/checkpoint"
 	"github.com/elastic/beats/v7/winlogbeat/sys"
@@ -87,13 +88,14 @@ func init() {
 }
 
 type winEventLogConfig struct {
-	ConfigCommon  `config:",inline"`
-	BatchReadSize int                `config:"batch_read_size"` // Maximum number of events that Read will return.
-	IncludeXML    bool               `config:"include_xml"`
-	Forwarded     *bool              `config:"forwarded"`
-	SimpleQuery   query              `config:",inline"`
-	NoMoreEvents  NoMoreEventsAction `config:"no_more_events"` // Action to take when no more events are available - wait or stop.
-	EventLanguage uint32             `config:"language"`
+	ConfigCommon   `config:",inline"`
+	BatchReadSize  int                `config:"batch_read_size"` // Maximum number of events that Read will return.
+	IncludeXML     bool               `config:"include_xml"`
+	IncludeXMLWhen *conditions.Config `config:"include_xml_when"` // Only include the XML of the events matching the condition.
+	Forwarded      *bool              `config:"forwarded"`
+	SimpleQuery    query              `config:",inline"`
+	NoMoreEvents   NoMoreEventsAction `config:"no_more_events"` // Action to take when no more events are available - wait or stop.
+	EventLanguage  uint32             `config:"language"`
 }
 
 // query contains parameters used to customize the event log data that is
@@ -172,9 +174,45 @@ func (c *winEventLogConfig) Validate() error {
 		errs = append(errs, fmt.Errorf("event log is missing a 'name'"))
 	}
 
+	if c.IncludeXMLWhen != nil && !c.IncludeXML {
+		errs = append(errs, fmt.Errorf("include_xml_when cannot be used without include_xml"))
+	}
+
 	return errs.Err()
 }
 
+// xmlFilter selects the events including their XML representation, so that
+// the XML is only copied, or rendered, for the events publishing it.
+type xmlFilter struct {
+	enabled bool
+	when    conditions.Condition
+}
+
+func newXMLFilter(c winEventLogConfig) (xmlFilter, error) {
+	f := xmlFilter{enabled: c.IncludeXML}
+	if c.IncludeXMLWhen != nil {
+		cond, err := conditions.NewCondition(c.IncludeXMLWhen)
+		if err != nil {
+			return f, fmt.Errorf("invalid include_xml_when condition: %w", err)
+		}
+		f.when = cond
+	}
+	return f, nil
+}
+
+// match returns true if the XML of the record must be included. The
+// condition is evaluated on the fields of the event published for the
+// record.
+func (f xmlFilter) match(r *Record) bool {
+	if !f.enabled {
+		return false
+	}
+	if f.when == nil {
+		return true
+	}
+	return f.when.Check(r.ToEvent().Fields)
+}
+
 // Validate that winEventLog implements the EventLog interface.
 var _ EventLog = &winEventLog{}
 
@@ -195,6 +233,7 @@ type winEventLog struct {
 	renderBuf []byte                                         // Buffer used for rendering event.
 	outputBuf *sys.ByteBuffer                                // Buffer for receiving XML
 	cache     *messageFilesCache                             // Cached mapping of source name to event message file handles.
+	xmlFilter xmlFilter                                      // Selects the events including their XML.
 
 	winMetaCache // Cached WinMeta tables by provider.
 
@@ -259,6 +298,11 @@ func newWinEventLog(options *conf.C) (EventLog, error) {
 		c.Name = filepath.Clean(c.Name)
 	}
 
+	filter, err := newXMLFilter(c)
+	if err != nil {
+		return nil, err
+	}
+
 	l := &winEventLog{
 		id:           id,
 		config:       c,
@@ -270,6 +314,7 @@ func newWinEventLog(options *conf.C) (EventLog, error) {
 		outputBuf:    sys.NewByteBuffer(renderBufferSize),
 		cache:        newMessageFilesCache(id, eventMetadataHandle, freeHandle),
 		winMetaCache: newWinMetaCache(metaTTL),
+		xmlFilter:    filter,
 		logPrefix:    fmt.Sprintf("WinEventLog[%s]", id),
 		metrics:      newInputMetrics(c.Name, id),
 	}
@@ -512,8 +557,11 @@ func (l *winEventLog) eventHandles(maxRead int) ([]win.EvtHandle, int, error) {
 	}
 }
 
+// buildRecordFromXML builds the record from the rendered XML of the event,
+// which is only copied into the record when it is included, since the render
+// buffer is reused.
 func (l *winEventLog) buildRecordFromXML(x []byte, recoveredErr error) Record {
-	includeXML := l.config.IncludeXML
+	includeXML := false
 	e, err := winevent.UnmarshalXML(x)
 	if err != nil {
 		e.RenderErr = append(e.RenderErr, err.Error())
@@ -555,7 +603,7 @@ func (l *winEventLog) buildRecordFromXML(x []byte, recoveredErr error) Record {
 		r.File = l.id
 	}
 
-	if includeXML {
+	if includeXML || l.xmlFilter.match(&r) {
 		r.XML = string(x)
 	}
 
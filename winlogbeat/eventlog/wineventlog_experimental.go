@@ -21,6 +21,7 @@
 package eventlog
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -31,6 +32,7 @@ import (
 
 	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
 	"github.com/elastic/beats/v7/winlogbeat/checkpoint"
+	"github.com/elastic/beats/v7/winlogbeat/sys"
 	win "github.com/elastic/beats/v7/winlogbeat/sys/wineventlog"
 	conf "github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
@@ -65,6 +67,12 @@ type winEventLogExp struct {
 	iterator *win.EventIterator
 	renderer *win.Renderer
 
+	// The XML is rendered separately from the fields, only for the events
+	// selected by xmlFilter.
+	xmlFilter xmlFilter
+	renderBuf []byte
+	outputBuf *sys.ByteBuffer
+
 	metrics *inputMetrics
 }
 
@@ -116,6 +124,11 @@ func newWinEventLogExp(options *conf.C) (EventLog, error) {
 		log = logp.NewLogger("wineventlog").With("id", id).With("channel", c.Name)
 	}
 
+	filter, err := newXMLFilter(c)
+	if err != nil {
+		return nil, err
+	}
+
 	renderer, err := win.NewRenderer(win.NilHandle, log)
 	if err != nil {
 		return nil, err
@@ -129,6 +142,7 @@ func newWinEventLogExp(options *conf.C) (EventLog, error) {
 		file:        isFile,
 		maxRead:     c.BatchReadSize,
 		renderer:    renderer,
+		xmlFilter:   filter,
 		log:         log,
 		metrics:     newInputMetrics(c.Name, id),
 	}
@@ -305,9 +319,6 @@ func (l *winEventLogExp) processHandle(h win.EvtHandle) (*Record, error) {
 		evt.RenderErr = append(evt.RenderErr, err.Error())
 	}
 
-	//nolint:godox // Bad linter! Keep to have a record of feature disparity between non-experimental vs experimental.
-	// TODO: Need to add XML when configured.
-
 	r := &Record{
 		API:   winEventLogExpAPIName,
 		Event: *evt,
@@ -317,6 +328,13 @@ func (l *winEventLogExp) processHandle(h win.EvtHandle) (*Record, error) {
 		r.File = l.id
 	}
 
+	if l.xmlFilter.match(r) {
+		if r.XML, err = l.renderXML(h); err != nil {
+			l.metrics.logError(err)
+			l.log.Warnw("Failed rendering the event XML.", "error", err)
+		}
+	}
+
 	r.Offset = checkpoint.EventLogState{
 		Name:         l.id,
 		RecordNumber: r.RecordID,
@@ -330,6 +348,28 @@ func (l *winEventLogExp) processHandle(h win.EvtHandle) (*Record, error) {
 	return r, nil
 }
 
+// renderXML renders the XML representation of the event. The render buffers
+// are only allocated once an event includes its XML.
+func (l *winEventLogExp) renderXML(h win.EvtHandle) (string, error) {
+	if l.outputBuf == nil {
+		l.renderBuf = make([]byte, renderBufferSize)
+		l.outputBuf = sys.NewByteBuffer(renderBufferSize)
+	}
+
+	l.outputBuf.Reset()
+	err := win.RenderEventXML(h, l.renderBuf, l.outputBuf)
+	var bufErr sys.InsufficientBufferError
+	if errors.As(err, &bufErr) {
+		l.renderBuf = make([]byte, bufErr.RequiredSize)
+		l.outputBuf.Reset()
+		err = win.RenderEventXML(h, l.renderBuf, l.outputBuf)
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(l.outputBuf.Bytes()), nil
+}
+
 func (l *winEventLogExp) createBookmarkFromEvent(evtHandle win.EvtHandle) (string, error) {
 	bookmark, err := win.NewBookmarkFromEvent(evtHandle)
 	if err != nil {
@@ -22,20 +22,18 @@ package eventlog
 
 import (
 	"io"
-	"os/exec"
 	"path/filepath"
 	"strconv"
-	"strings"
 	"testing"
-	"time"
 
-	"github.com/andrewkroh/sys/windows/svc/eventlog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/elastic/beats/v7/libbeat/conditions"
 	"github.com/elastic/beats/v7/winlogbeat/checkpoint"
-	"github.com/elastic/beats/v7/winlogbeat/sys/wineventlog"
+	"github.com/elastic/beats/v7/winlogbeat/eventlog/eventlogtest"
 	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
 )
 
 const (
@@ -47,12 +45,6 @@ const (
         <Select Path="WinlogbeatTestGo">*</Select>
     </Query>
 </QueryList>`
-
-	// Event message files used when logging events.
-
-	// EventCreate.exe has valid event IDs in the range of 1-1000 where each
-	// event message requires a single parameter.
-	eventCreateMsgFile = "%SystemRoot%\\System32\\EventCreate.exe"
 )
 
 func TestWinEventLogConfig_Validate(t *testing.T) {
@@ -152,6 +144,23 @@ func TestWinEventLogConfig_Validate(t *testing.T) {
 			WantErr: true,
 			Desc:    "missing name",
 		},
+		{
+			In: winEventLogConfig{
+				ConfigCommon:   ConfigCommon{Name: "test"},
+				IncludeXMLWhen: &conditions.Config{},
+			},
+			WantErr: true,
+			Desc:    "include_xml_when without include_xml",
+		},
+		{
+			In: winEventLogConfig{
+				ConfigCommon:   ConfigCommon{Name: "test"},
+				IncludeXML:     true,
+				IncludeXMLWhen: &conditions.Config{},
+			},
+			WantErr: false,
+			Desc:    "include_xml_when with include_xml",
+		},
 	}
 
 	for _, tc := range tests {
@@ -174,16 +183,18 @@ func TestWindowsEventLogAPIExperimental(t *testing.T) {
 }
 
 func testWindowsEventLog(t *testing.T, api string) {
-	writer, teardown := createLog(t)
-	defer teardown()
-
-	setLogSize(t, providerName, gigabyte)
+	channel := eventlogtest.CreateChannel(t, providerName, sourceName)
+	channel.SetSize(t, gigabyte)
 
 	// Publish large test messages.
 	const messageSize = 256 // Originally 31800, such a large value resulted in an empty eventlog under Win10.
 	const totalEvents = 1000
 	for i := 0; i < totalEvents; i++ {
-		safeWriteEvent(t, writer, eventlog.Info, uint32(i%1000)+1, []string{strconv.Itoa(i) + " " + randomSentence(messageSize)})
+		channel.Write(t, eventlogtest.Event{
+			Type:     eventlogtest.Info,
+			ID:       uint32(i%1000) + 1,
+			Messages: []string{strconv.Itoa(i) + " " + randomSentence(messageSize)},
+		})
 	}
 
 	openLog := func(t testing.TB, config map[string]interface{}) EventLog {
@@ -204,6 +215,41 @@ func testWindowsEventLog(t *testing.T, api string) {
 		}
 	})
 
+	t.Run("include_xml", func(t *testing.T) {
+		log := openLog(t, map[string]interface{}{"name": providerName, "batch_read_size": 10, "include_xml": true})
+		defer log.Close()
+
+		records, err := log.Read()
+		require.NoError(t, err)
+		require.NotEmpty(t, records)
+		for _, r := range records {
+			assert.Contains(t, r.XML, "<EventID", "record:%#v", r)
+		}
+	})
+
+	t.Run("include_xml_when", func(t *testing.T) {
+		log := openLog(t, map[string]interface{}{
+			"name":            providerName,
+			"batch_read_size": 10,
+			"include_xml":     true,
+			"include_xml_when": map[string]interface{}{
+				"equals.winlog.event_id": "1",
+			},
+		})
+		defer log.Close()
+
+		records, err := log.Read()
+		require.NoError(t, err)
+		require.Len(t, records, 10)
+		for _, r := range records {
+			if r.EventIdentifier.ID == 1 {
+				assert.NotEmpty(t, r.XML)
+			} else {
+				assert.Empty(t, r.XML, "event %d must not include its XML", r.EventIdentifier.ID)
+			}
+		}
+	})
+
 	// Test reading from an event log using a custom XML query.
 	t.Run("custom_xml_query", func(t *testing.T) {
 		cfg := map[string]interface{}{
@@ -298,66 +344,50 @@ func testWindowsEventLog(t *testing.T, api string) {
 	})
 }
 
-// ---- Utility Functions -----
-
-// createLog creates a new event log and returns a handle for writing events
-// to the log.
-func createLog(t testing.TB, messageFiles ...string) (log *eventlog.Log, tearDown func()) {
-	const name = providerName
-	const source = sourceName
-
-	messageFile := eventCreateMsgFile
-	if len(messageFiles) > 0 {
-		messageFile = strings.Join(messageFiles, ";")
+func TestWindowsEventLogRoundTrip(t *testing.T) {
+	events := []eventlogtest.Event{
+		{Type: eventlogtest.Info, ID: 10, Messages: []string{"round trip information"}},
+		{Type: eventlogtest.Warning, ID: 20, Messages: []string{"round trip warning"}},
+		{Type: eventlogtest.Error, ID: 30, Messages: []string{"round trip error"}},
 	}
+	levels := []string{"information", "warning", "error"}
 
-	existed, err := eventlog.Install(name, source, messageFile, true, eventlog.Error|eventlog.Warning|eventlog.Info)
-	if err != nil {
-		t.Fatal(err)
-	}
+	for _, api := range []string{winEventLogAPIName, winEventLogExpAPIName} {
+		t.Run(api, func(t *testing.T) {
+			channel := eventlogtest.CreateChannel(t, providerName, sourceName)
+			channel.Write(t, events...)
 
-	if existed {
-		wineventlog.EvtClearLog(wineventlog.NilHandle, name, "") //nolint:errcheck // This is just a resource release.
-	}
+			log := openLog(t, api, nil, map[string]interface{}{"name": providerName})
+			defer log.Close()
 
-	log, err = eventlog.Open(source)
-	//nolint:errcheck // This is just a resource release.
-	if err != nil {
-		eventlog.RemoveSource(name, source)
-		eventlog.RemoveProvider(name)
-		t.Fatal(err)
-	}
+			records, err := log.Read()
+			require.NoError(t, err)
+			require.Len(t, records, len(events))
 
-	//nolint:errcheck // This is just a resource release.
-	tearDown = func() {
-		log.Close()
-		wineventlog.EvtClearLog(wineventlog.NilHandle, name, "")
-		eventlog.RemoveSource(name, source)
-		eventlog.RemoveProvider(name)
-	}
+			for i, r := range records {
+				fields := r.ToEvent().Fields
 
-	return log, tearDown
-}
+				assertFieldValue(t, fields, "winlog.channel", providerName)
+				assertFieldValue(t, fields, "winlog.provider_name", sourceName)
+				assertFieldValue(t, fields, "winlog.event_id", strconv.Itoa(int(events[i].ID)))
+				assertFieldValue(t, fields, "event.code", strconv.Itoa(int(events[i].ID)))
+				assertFieldValue(t, fields, "log.level", levels[i])
 
-func safeWriteEvent(t testing.TB, log *eventlog.Log, etype uint16, eid uint32, msgs []string) {
-	deadline := time.Now().Add(time.Second * 10)
-	for {
-		err := log.Report(etype, eid, msgs)
-		if err == nil {
-			return
-		}
-		if time.Now().After(deadline) {
-			t.Fatal("Failed to write event to event log", err)
-			return
-		}
+				message, err := fields.GetValue("message")
+				require.NoError(t, err)
+				assert.Contains(t, message, events[i].Messages[0])
+			}
+		})
 	}
 }
 
-// setLogSize set the maximum number of bytes that an event log can hold.
-func setLogSize(t testing.TB, provider string, sizeBytes int) {
-	output, err := exec.Command("wevtutil.exe", "sl", "/ms:"+strconv.Itoa(sizeBytes), provider).CombinedOutput() //nolint:gosec // No possibility of command injection.
-	if err != nil {
-		t.Fatal("Failed to set log size", err, string(output))
+// ---- Utility Functions -----
+
+func assertFieldValue(t *testing.T, fields mapstr.M, key string, want interface{}) {
+	t.Helper()
+	got, err := fields.GetValue(key)
+	if assert.NoError(t, err, key) {
+		assert.Equal(t, want, got, key)
 	}
 }
 
@@ -32,7 +32,6 @@ import (
 
 	"github.com/elastic/beats/v7/filebeat/fileset"
 	"github.com/elastic/beats/v7/libbeat/beat"
-	"github.com/elastic/beats/v7/libbeat/esleg/eslegclient"
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/version"
 )
@@ -54,7 +53,7 @@ type pipeline struct {
 // and adapts the pipeline for a given ES version, converts to JSON if
 // necessary and creates or updates ingest pipeline in ES. The IDs of pipelines
 // uploaded to ES are returned in loaded.
-func UploadPipelines(info beat.Info, esClient *eslegclient.Connection, overwritePipelines bool) (loaded []string, err error) {
+func UploadPipelines(info beat.Info, esClient fileset.PipelineLoader, overwritePipelines bool) (loaded []string, err error) {
 	pipelines, err := readAll(info)
 	if err != nil {
 		return nil, err
@@ -154,7 +153,7 @@ func readFile(filename string, info beat.Info) (p pipeline, err error) {
 // load will only overwrite existing pipelines if overwritePipelines is
 // true. An error in loading one of the pipelines will cause the
 // successfully loaded ones to be deleted.
-func load(esClient *eslegclient.Connection, pipelines []pipeline, overwritePipelines bool) (loaded []string, err error) {
+func load(esClient fileset.PipelineLoader, pipelines []pipeline, overwritePipelines bool) (loaded []string, err error) {
 	log := logp.NewLogger(logName)
 
 	for _, pipeline := range pipelines {
@@ -24,22 +24,25 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/andrewkroh/sys/windows/svc/eventlog"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sys/windows"
 
+	"github.com/elastic/beats/v7/winlogbeat/eventlog/eventlogtest"
 	"github.com/elastic/elastic-agent-libs/logp"
 )
 
 func TestEventIterator(t *testing.T) {
 	logp.TestingSetup() //nolint:errcheck // Not needed.
 
-	writer, tearDown := createLog(t)
-	defer tearDown()
+	channel := createLog(t)
 
 	const eventCount = 1500
 	for i := 0; i < eventCount; i++ {
-		safeWriteEvent(t, writer, eventlog.Info, 1, []string{"Test message " + strconv.Itoa(i+1)})
+		channel.Write(t, eventlogtest.Event{
+			Type:     eventlogtest.Info,
+			ID:       1,
+			Messages: []string{"Test message " + strconv.Itoa(i+1)},
+		})
 	}
 
 	// Validate the assumption that 1024 is the max number of handles supported
@@ -22,20 +22,17 @@ package wineventlog
 
 import (
 	"bytes"
-	"os/exec"
 	"path/filepath"
 	"runtime"
-	"strconv"
 	"strings"
 	"testing"
 	"text/template"
 	"time"
 
-	"github.com/andrewkroh/sys/windows/svc/eventlog"
-
 	"github.com/stretchr/testify/assert"
 
 	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/winlogbeat/eventlog/eventlogtest"
 	"github.com/elastic/beats/v7/winlogbeat/sys/winevent"
 	"github.com/elastic/elastic-agent-libs/logp"
 )
@@ -196,22 +193,13 @@ func renderAllEvents(t *testing.T, log EvtHandle, renderer *Renderer, ignoreMiss
 	return events
 }
 
-// setLogSize set the maximum number of bytes that an event log can hold.
-func setLogSize(t testing.TB, provider string, sizeBytes int) {
-	output, err := exec.Command("wevtutil.exe", "sl", "/ms:"+strconv.Itoa(sizeBytes), provider).CombinedOutput() //nolint:gosec // No possibility of command injection.
-	if err != nil {
-		t.Fatal("failed to set log size", err, string(output))
-	}
-}
-
 func BenchmarkRenderer(b *testing.B) {
-	writer, teardown := createLog(b)
-	defer teardown()
+	channel := createLog(b)
 
 	const totalEvents = 1000000
 	msg := []string{strings.Repeat("Hello world! ", 21)}
 	for i := 0; i < totalEvents; i++ {
-		safeWriteEvent(b, writer, eventlog.Info, 10, msg)
+		channel.Write(b, eventlogtest.Event{Type: eventlogtest.Info, ID: 10, Messages: msg})
 	}
 
 	setup := func() (*EventIterator, *Renderer) {
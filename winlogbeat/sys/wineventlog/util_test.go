@@ -26,11 +26,11 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
-	"time"
 
-	"github.com/andrewkroh/sys/windows/svc/eventlog"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sys/windows"
+
+	"github.com/elastic/beats/v7/winlogbeat/eventlog/eventlogtest"
 )
 
 const (
@@ -41,56 +41,13 @@ const (
 	winErrorReportingFile = "testdata/application-windows-error-reporting.evtx"
 )
 
-// createLog creates a new event log and returns a handle for writing events
-// to the log.
-//
-//nolint:errcheck // Errors are not checked since they always precede termination.
-func createLog(t testing.TB) (log *eventlog.Log, tearDown func()) {
-	t.Helper()
-	const name = winlogbeatTestLogName
-	const source = "wineventlog_test"
-
-	existed, err := eventlog.InstallAsEventCreate(name, source, eventlog.Error|eventlog.Warning|eventlog.Info)
-	if err != nil {
-		t.Fatalf("eventlog.InstallAsEventCreate failed: %v", err)
-	}
-
-	if existed {
-		EvtClearLog(NilHandle, name, "")
-	}
-
-	log, err = eventlog.Open(source)
-	if err != nil {
-		eventlog.RemoveSource(name, source)
-		eventlog.RemoveProvider(name)
-		t.Fatalf("eventlog.Open failed: %v", err)
-	}
-
-	setLogSize(t, winlogbeatTestLogName, 1024*1024*1024) // 1 GiB
-
-	tearDown = func() {
-		log.Close()
-		EvtClearLog(NilHandle, name, "")
-		eventlog.RemoveSource(name, source)
-		eventlog.RemoveProvider(name)
-	}
-
-	return log, tearDown
-}
-
-func safeWriteEvent(t testing.TB, log *eventlog.Log, etype uint16, eid uint32, msgs []string) {
+// createLog creates a new event log and returns a channel for writing events
+// to the log. The log is removed when the test completes.
+func createLog(t testing.TB) *eventlogtest.Channel {
 	t.Helper()
-	deadline := time.Now().Add(time.Second * 10)
-	for {
-		err := log.Report(etype, eid, msgs)
-		if err == nil {
-			return
-		}
-		if time.Now().After(deadline) {
-			t.Fatal("Failed to write event to event log", err)
-			return
-		}
-	}
+	channel := eventlogtest.CreateChannel(t, winlogbeatTestLogName, "wineventlog_test")
+	channel.SetSize(t, 1024*1024*1024) // 1 GiB
+	return channel
 }
 
 // openLog opens an event log or .evtx file for reading.
@@ -0,0 +1,135 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package process
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/elastic/beats/v7/auditbeat/helper/hasher"
+)
+
+// nsrlHashColumns are the columns of an NSRL RDS file that hold digests.
+var nsrlHashColumns = map[string]bool{
+	"SHA-1":   true,
+	"SHA1":    true,
+	"MD5":     true,
+	"SHA-256": true,
+	"SHA256":  true,
+}
+
+// allowlist is a set of digests of known-good executables.
+type allowlist map[string]struct{}
+
+// loadAllowlist reads the digests of all given files into a single allowlist.
+func loadAllowlist(paths []string) (allowlist, error) {
+	list := allowlist{}
+	for _, path := range paths {
+		if err := list.load(path); err != nil {
+			return nil, fmt.Errorf("failed to load allow-list %v: %w", path, err)
+		}
+	}
+	return list, nil
+}
+
+func (l allowlist) load(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return l.read(f)
+}
+
+// read adds the digests read from r. NSRL RDS files are recognized by their
+// quoted header line, other files are read as a set of hexadecimal digests,
+// one per line, optionally followed by a file name as written by sha1sum and
+// similar tools. Empty lines and lines starting with '#' are ignored.
+func (l allowlist) read(r io.Reader) error {
+	br := bufio.NewReader(r)
+	peek, err := br.Peek(1)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if len(peek) == 1 && peek[0] == '"' {
+		return l.readNSRL(br)
+	}
+	return l.readHashSet(br)
+}
+
+func (l allowlist) readNSRL(r io.Reader) error {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read NSRL header: %w", err)
+	}
+	var columns []int
+	for i, name := range header {
+		if nsrlHashColumns[strings.ToUpper(name)] {
+			columns = append(columns, i)
+		}
+	}
+	if len(columns) == 0 {
+		return errors.New("NSRL header has no hash columns")
+	}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, i := range columns {
+			if i >= len(record) || record[i] == "" {
+				continue
+			}
+			if err := l.add(record[i]); err != nil {
+				line, _ := cr.FieldPos(i)
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+	}
+}
+
+func (l allowlist) readHashSet(r io.Reader) error {
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if err := l.add(fields[0]); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	return s.Err()
+}
+
+func (l allowlist) add(digest string) error {
+	b, err := hex.DecodeString(digest)
+	if err != nil || len(b) == 0 {
+		return fmt.Errorf("invalid digest %q", digest)
+	}
+	l[string(b)] = struct{}{}
+	return nil
+}
+
+// contains returns whether any of the digests is in the allowlist.
+func (l allowlist) contains(hashes map[hasher.HashType]hasher.Digest) bool {
+	for _, digest := range hashes {
+		if _, found := l[string(digest)]; found {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package process
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/auditbeat/helper/hasher"
+)
+
+const (
+	zshSHA1   = "3de6a0a1cf514d15a61d3c873e2a710977c1103d"
+	bashMD5   = "d41d8cd98f00b204e9800998ecf8427e"
+	otherSHA1 = "0000000000000000000000000000000000000001"
+)
+
+func TestAllowlistHashSet(t *testing.T) {
+	list := allowlist{}
+	err := list.read(strings.NewReader(`# Known-good binaries.
+` + zshSHA1 + `  /bin/zsh
+
+` + strings.ToUpper(bashMD5) + `
+`))
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	assert.True(t, list.contains(digests(t, hasher.SHA1, zshSHA1)))
+	assert.True(t, list.contains(digests(t, hasher.MD5, bashMD5)))
+	assert.False(t, list.contains(digests(t, hasher.SHA1, otherSHA1)))
+	assert.False(t, list.contains(nil))
+}
+
+func TestAllowlistNSRL(t *testing.T) {
+	list := allowlist{}
+	err := list.read(strings.NewReader(`"SHA-1","MD5","CRC32","FileName","FileSize","ProductCode","OpSystemCode","SpecialCode"
+"` + strings.ToUpper(zshSHA1) + `","` + strings.ToUpper(bashMD5) + `","7CCD2C2F","zsh",878288,1234,"362",""
+`))
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	assert.True(t, list.contains(digests(t, hasher.SHA1, zshSHA1)))
+	assert.True(t, list.contains(digests(t, hasher.MD5, bashMD5)))
+}
+
+func TestAllowlistInvalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"hash set":       zshSHA1 + "\nnot-a-hash\n",
+		"nsrl":           `"SHA-1","FileName"` + "\n" + `"not-a-hash","zsh"` + "\n",
+		"nsrl no hashes": `"FileName","FileSize"` + "\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, allowlist{}.read(strings.NewReader(data)))
+		})
+	}
+}
+
+func TestLoadAllowlist(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.txt")
+	second := filepath.Join(dir, "second.txt")
+	require.NoError(t, os.WriteFile(first, []byte(zshSHA1+"\n"), 0o600))
+	require.NoError(t, os.WriteFile(second, []byte(bashMD5+"\n"), 0o600))
+
+	list, err := loadAllowlist([]string{first, second})
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	_, err = loadAllowlist([]string{filepath.Join(dir, "missing.txt")})
+	assert.Error(t, err)
+}
+
+func digests(t *testing.T, hashType hasher.HashType, digest string) map[hasher.HashType]hasher.Digest {
+	b, err := hex.DecodeString(digest)
+	require.NoError(t, err)
+	return map[hasher.HashType]hasher.Digest{hashType: b}
+}
@@ -5,6 +5,7 @@
 package process
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/elastic/beats/v7/auditbeat/helper/hasher"
@@ -15,11 +16,31 @@ type Config struct {
 	StatePeriod        time.Duration `config:"state.period"`
 	ProcessStatePeriod time.Duration `config:"process.state.period"`
 
-	HasherConfig hasher.Config `config:"process.hash"`
+	HasherConfig    hasher.Config   `config:"process.hash"`
+	AllowlistConfig AllowlistConfig `config:"process.hash.allowlist"`
 }
 
+// AllowlistConfig defines the handling of processes whose executable
+// hash is in a list of known-good hashes.
+type AllowlistConfig struct {
+	Paths  []string `config:"paths"`
+	Action string   `config:"action"`
+	Tag    string   `config:"tag"`
+}
+
+const (
+	allowlistActionTag      = "tag"
+	allowlistActionSuppress = "suppress"
+)
+
 // Validate validates the config.
 func (c *Config) Validate() error {
+	switch c.AllowlistConfig.Action {
+	case allowlistActionTag, allowlistActionSuppress:
+	default:
+		return fmt.Errorf("invalid process.hash.allowlist.action '%v', must be one of '%v' or '%v'",
+			c.AllowlistConfig.Action, allowlistActionTag, allowlistActionSuppress)
+	}
 	return c.HasherConfig.Validate()
 }
 
@@ -40,4 +61,8 @@ var defaultConfig = Config{
 		ScanRatePerSec:      "50 MiB",
 		ScanRateBytesPerSec: 50 * 1024 * 1024,
 	},
+	AllowlistConfig: AllowlistConfig{
+		Action: allowlistActionTag,
+		Tag:    "allowlisted",
+	},
 }
@@ -95,6 +95,7 @@ type MetricSet struct {
 	bucket    datastore.Bucket
 	lastState time.Time
 	hasher    *hasher.FileHasher
+	allowlist allowlist
 
 	suppressPermissionWarnings bool
 }
@@ -160,6 +161,11 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		return nil, err
 	}
 
+	allowlist, err := loadAllowlist(config.AllowlistConfig.Paths)
+	if err != nil {
+		return nil, err
+	}
+
 	ms := &MetricSet{
 		SystemMetricSet: system.NewSystemMetricSet(base),
 		config:          config,
@@ -167,6 +173,10 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		cache:           cache.New(),
 		bucket:          bucket,
 		hasher:          hasher,
+		allowlist:       allowlist,
+	}
+	if len(allowlist) != 0 {
+		ms.log.Debugf("Loaded %d allow-listed hashes", len(allowlist))
 	}
 
 	// Load from disk: Time when state was last sent
@@ -240,6 +250,9 @@ func (ms *MetricSet) reportState(report mb.ReporterV2) error {
 	}
 	for _, p := range processes {
 		ms.enrichProcess(p)
+		if ms.suppressed(p) {
+			continue
+		}
 
 		if p.Error == nil {
 			event := ms.processEvent(p, eventTypeState, eventActionExistingProcess)
@@ -282,6 +295,9 @@ func (ms *MetricSet) reportChanges(report mb.ReporterV2) error {
 	for _, cacheValue := range started {
 		p := cacheValue.(*Process)
 		ms.enrichProcess(p)
+		if ms.suppressed(p) {
+			continue
+		}
 
 		if p.Error == nil {
 			report.Event(ms.processEvent(p, eventTypeEvent, eventActionProcessStarted))
@@ -293,6 +309,9 @@ func (ms *MetricSet) reportChanges(report mb.ReporterV2) error {
 
 	for _, cacheValue := range stopped {
 		p := cacheValue.(*Process)
+		if ms.suppressed(p) {
+			continue
+		}
 
 		if p.Error == nil {
 			report.Event(ms.processEvent(p, eventTypeEvent, eventActionProcessStopped))
@@ -339,6 +358,12 @@ func (ms *MetricSet) enrichProcess(process *Process) {
 	}
 }
 
+// suppressed returns whether no events should be reported for a process
+// because its executable is allow-listed.
+func (ms *MetricSet) suppressed(process *Process) bool {
+	return ms.config.AllowlistConfig.Action == allowlistActionSuppress && ms.allowlist.contains(process.Hashes)
+}
+
 func (ms *MetricSet) processEvent(process *Process, eventType string, action eventAction) mb.Event {
 	event := mb.Event{
 		RootFields: mapstr.M{
@@ -383,6 +408,10 @@ func (ms *MetricSet) processEvent(process *Process, eventType string, action eve
 		}
 	}
 
+	if ms.config.AllowlistConfig.Action == allowlistActionTag && ms.allowlist.contains(process.Hashes) {
+		event.RootFields.Put("tags", []string{ms.config.AllowlistConfig.Tag})
+	}
+
 	if process.Error != nil {
 		event.RootFields.Put("error.message", process.Error.Error())
 	}
@@ -104,6 +104,25 @@ func TestProcessEvent(t *testing.T) {
 	}
 }
 
+func TestProcessEventAllowlisted(t *testing.T) {
+	ms := mbtest.NewReportingMetricSetV2(t, getConfig()).(*MetricSet)
+	p := testProcess()
+	ms.allowlist = allowlist{string(p.Hashes[hasher.SHA1]): {}}
+
+	event := ms.processEvent(p, eventTypeEvent, eventActionProcessStarted)
+	tags, err := event.RootFields.GetValue("tags")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"allowlisted"}, tags)
+	}
+	assert.False(t, ms.suppressed(p))
+
+	ms.config.AllowlistConfig.Action = allowlistActionSuppress
+	assert.True(t, ms.suppressed(p))
+
+	p.Hashes = nil
+	assert.False(t, ms.suppressed(p))
+}
+
 func testProcess() *Process {
 	return &Process{
 		Info: types.ProcessInfo{
@@ -65,6 +65,22 @@ type Config struct {
 	// EnableIPv6 allows to control IPv6 support. When unset (default) IPv6
 	// will be automatically detected on runtime.
 	EnableIPv6 *bool `config:"socket.enable_ipv6"`
+
+	// DNSHostCache configures the host-wide cache of DNS answers used to
+	// enrich flows whose process didn't perform the DNS query itself.
+	DNSHostCache dnsHostCacheConfig `config:"socket.dns.host_cache"`
+}
+
+type dnsHostCacheConfig struct {
+	// Enabled determines if the host-wide cache is used.
+	Enabled bool `config:"enabled"`
+
+	// MaxTTL is the maximum time an answer is kept in the cache, regardless
+	// of its TTL.
+	MaxTTL time.Duration `config:"max_ttl,positive"`
+
+	// Size is the maximum number of addresses kept in the cache.
+	Size int `config:"size,min=1"`
 }
 
 // Validate validates the socket metricset config.
@@ -99,4 +115,9 @@ var defaultConfig = Config{
 	ClockMaxDrift:          100 * time.Millisecond,
 	ClockSyncPeriod:        10 * time.Second,
 	GuessTimeout:           15 * time.Second,
+	DNSHostCache: dnsHostCacheConfig{
+		Enabled: true,
+		MaxTTL:  5 * time.Minute,
+		Size:    10000,
+	},
 }
@@ -196,16 +196,21 @@ func (c *dnsCapture) run(ctx context.Context, consumer parent.Consumer) {
 					tr.Addresses = append(tr.Addresses, a.A)
 				} else {
 					c.log.Debug("Unexpected type for DNS A response")
+					continue
 				}
 			case dns.TypeAAAA:
 				if a, ok := ans.(*dns.AAAA); ok {
 					tr.Addresses = append(tr.Addresses, a.AAAA)
 				} else {
 					c.log.Debug("Unexpected type for DNS AAAA response")
+					continue
 				}
 			default:
 				continue
 			}
+			if ttl := time.Duration(ans.Header().Ttl) * time.Second; len(tr.Addresses) == 1 || ttl < tr.TTL {
+				tr.TTL = ttl
+			}
 		}
 		if len(tr.Addresses) > 0 {
 			if c.log.IsDebug() {
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/elastic-agent-libs/logp"
@@ -29,6 +30,9 @@ type Transaction struct {
 
 	// Addresses is the list of A or AAAA addresses in the response.
 	Addresses []net.IP
+
+	// TTL is the lowest time to live of the addresses in the response.
+	TTL time.Duration
 }
 
 // Consumer is a function that consumes DNS transactions.
@@ -290,8 +290,23 @@ type dnsTracker struct {
 
 	// map[net.UDPAddr(string)]*process
 	processByClient *common.Cache
+
+	// map[net.IP(string)]string
+	// Host-wide cache of DNS answers, used to resolve the domain of flows
+	// whose process didn't send the DNS query itself (i.e. queries made
+	// through a local caching resolver) or whose query wasn't matched.
+	// Nil when disabled.
+	domainByIP *common.Cache
+
+	hostCacheMaxTTL time.Duration
+	hostCacheSize   int
 }
 
+// minHostCacheTTL is the minimum time an answer is kept in the host cache,
+// so that short-lived records can still be correlated with the flows
+// that follow them.
+const minHostCacheTTL = 30 * time.Second
+
 func newDNSTracker(timeout time.Duration) dnsTracker {
 	return dnsTracker{
 		transactionByClient: common.NewCache(timeout, 8),
@@ -299,8 +314,18 @@ func newDNSTracker(timeout time.Duration) dnsTracker {
 	}
 }
 
+// EnableHostCache enables the host-wide cache of DNS answers. Answers are
+// kept for their TTL, bounded by maxTTL, and at most size addresses are
+// cached at any time.
+func (dt *dnsTracker) EnableHostCache(maxTTL time.Duration, size int) {
+	dt.domainByIP = common.NewCacheWithExpireOnAdd(maxTTL, 8)
+	dt.hostCacheMaxTTL = maxTTL
+	dt.hostCacheSize = size
+}
+
 // AddTransaction registers a new DNS transaction.
 func (dt *dnsTracker) AddTransaction(tr dns.Transaction) {
+	dt.addToHostCache(tr)
 	clientAddr := tr.Client.String()
 	if procIf := dt.processByClient.Get(clientAddr); procIf != nil {
 		if proc, ok := procIf.(*process); ok {
@@ -318,13 +343,52 @@ func (dt *dnsTracker) AddTransaction(tr dns.Transaction) {
 
 // AddTransactionWithProcess registers a new DNS transaction for the given process.
 func (dt *dnsTracker) AddTransactionWithProcess(tr dns.Transaction, proc *process) {
+	dt.addToHostCache(tr)
 	proc.addTransaction(tr)
 }
 
+func (dt *dnsTracker) addToHostCache(tr dns.Transaction) {
+	if dt.domainByIP == nil {
+		return
+	}
+	ttl := tr.TTL
+	if ttl < minHostCacheTTL {
+		ttl = minHostCacheTTL
+	}
+	if ttl > dt.hostCacheMaxTTL {
+		ttl = dt.hostCacheMaxTTL
+	}
+	for _, addr := range tr.Addresses {
+		key := addr.String()
+		if dt.domainByIP.Size() >= dt.hostCacheSize && dt.domainByIP.Get(key) == nil {
+			// Make room by dropping expired answers. If the cache is still
+			// full, the answer is not cached.
+			dt.domainByIP.CleanUp()
+			if dt.domainByIP.Size() >= dt.hostCacheSize {
+				continue
+			}
+		}
+		dt.domainByIP.PutWithTimeout(key, tr.Domain, ttl)
+	}
+}
+
+// ResolveIP returns the domain associated with the given IP in the host-wide
+// cache of DNS answers.
+func (dt *dnsTracker) ResolveIP(ip net.IP) (domain string, found bool) {
+	if dt.domainByIP == nil {
+		return "", false
+	}
+	domain, found = dt.domainByIP.Get(ip.String()).(string)
+	return domain, found
+}
+
 // CleanUp removes expired entries from the maps.
 func (dt *dnsTracker) CleanUp() {
 	dt.transactionByClient.CleanUp()
 	dt.processByClient.CleanUp()
+	if dt.domainByIP != nil {
+		dt.domainByIP.CleanUp()
+	}
 }
 
 // RegisterEndpoint registers a new local endpoint used for DNS queries
@@ -397,8 +461,11 @@ var kernelProcess = process{
 	name: "[kernel_task]",
 }
 
-func NewState(r mb.PushReporterV2, log helper.Logger, inactiveTimeout, socketTimeout, closeTimeout, clockMaxDrift time.Duration) *state {
+func NewState(r mb.PushReporterV2, log helper.Logger, inactiveTimeout, socketTimeout, closeTimeout, clockMaxDrift time.Duration, dnsHostCache dnsHostCacheConfig) *state {
 	s := makeState(r, log, inactiveTimeout, socketTimeout, closeTimeout, clockMaxDrift)
+	if dnsHostCache.Enabled {
+		s.dns.EnableHostCache(dnsHostCache.MaxTTL, dnsHostCache.Size)
+	}
 	go s.expireLoop()
 	go s.logStateLoop()
 	return s
@@ -846,7 +913,7 @@ func (f *flow) updateWith(ref flow, s *state) {
 
 func (s *state) reportFlow(f *flow) (reported bool) {
 	if f != nil && f.isValid() && int(f.pid) != s.currentPID {
-		if ev, err := f.toEvent(true); err == nil {
+		if ev, err := f.toEvent(true, s.dns.ResolveIP); err == nil {
 			reported = s.reporter.Event(ev)
 		} else {
 			s.log.Errorf("Failed to convert flow=%v err=%v", f, err)
@@ -881,7 +948,9 @@ func (s *state) onFlowTerminated(f *flow) (toReport helper.LinkedList) {
 	return toReport
 }
 
-func (f *flow) toEvent(final bool) (ev mb.Event, err error) {
+// toEvent converts the flow into an event. hostDNS is used to resolve the
+// remote address when the flow's process has no answer for it.
+func (f *flow) toEvent(final bool, hostDNS func(net.IP) (string, bool)) (ev mb.Event, err error) {
 	localAddr := f.local.addr
 	remoteAddr := f.remote.addr
 
@@ -1024,6 +1093,11 @@ func (f *flow) toEvent(final bool) (ev mb.Event, err error) {
 		}
 		root["process"] = process
 	}
+	if _, found := remote["domain"]; !found && hostDNS != nil {
+		if domain, found := hostDNS(f.remote.addr.IP); found {
+			remote["domain"] = domain
+		}
+	}
 
 	return mb.Event{
 		RootFields:      root,
@@ -684,6 +684,36 @@ func TestDNSTracker(t *testing.T) {
 			{true, proc2, "192.0.2.12", "example.com"},
 		}.Run(t)
 	})
+	t.Run("host cache", func(t *testing.T) {
+		tracker := newDNSTracker(infiniteExpiration)
+		tracker.AddTransaction(trV4)
+		domain, found := tracker.ResolveIP(net.ParseIP("192.0.2.12"))
+		assert.False(t, found)
+		assert.Empty(t, domain)
+
+		tracker.EnableHostCache(time.Minute, 3)
+		tracker.AddTransaction(trV4)
+		tracker.AddTransaction(trV6)
+		for ip, expected := range map[string]string{
+			"192.0.2.12":     "example.net",
+			"192.0.2.13":     "example.net",
+			"2001:db8::1111": "example.com",
+			"2001:db8::2222": "",
+			"192.168.0.2":    "",
+		} {
+			domain, found := tracker.ResolveIP(net.ParseIP(ip))
+			assert.Equal(t, expected != "", found, ip)
+			assert.Equal(t, expected, domain, ip)
+		}
+	})
+	t.Run("host cache expiration", func(t *testing.T) {
+		tracker := newDNSTracker(infiniteExpiration)
+		tracker.EnableHostCache(10*time.Millisecond, 10)
+		tracker.AddTransaction(trV4)
+		time.Sleep(time.Millisecond * 50)
+		_, found := tracker.ResolveIP(net.ParseIP("192.0.2.12"))
+		assert.False(t, found)
+	})
 }
 
 func TestUDPSendMsgAltLogic(t *testing.T) {
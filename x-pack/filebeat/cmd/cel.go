@@ -0,0 +1,138 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cli"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/cel"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+func genCELCmd() *cobra.Command {
+	celCmd := cobra.Command{
+		Use:   "cel",
+		Short: "Develop and debug CEL input programs",
+	}
+	celCmd.AddCommand(genCELEvalCmd())
+
+	return &celCmd
+}
+
+func genCELEvalCmd() *cobra.Command {
+	evalCmd := &cobra.Command{
+		Use:   "eval",
+		Short: "Evaluate the program of a CEL input once and print the result",
+		Long: `Evaluate the program of a CEL input once and print the events, the cursor,
+the rate limit decision and the state for the next evaluation as JSON, without
+publishing events or storing the cursor. The state printed can be saved and passed
+back with --state to step through consecutive evaluations.
+
+The HTTP requests of the program are made to the live endpoint, or answered with
+the responses recorded by the request tracer of the input when --fixture is set.`,
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			configPath, _ := cmd.Flags().GetString("config")
+			statePath, _ := cmd.Flags().GetString("state")
+			fixturePath, _ := cmd.Flags().GetString("fixture")
+
+			if configPath == "" {
+				return errors.New("--config is required")
+			}
+			// Log warnings and errors of the evaluation to stderr, the result
+			// is the only output to stdout.
+			if err := logp.DevelopmentSetup(logp.WithLevel(logp.WarnLevel)); err != nil {
+				return err
+			}
+			cfg, err := celInputConfig(configPath)
+			if err != nil {
+				return err
+			}
+
+			var state map[string]interface{}
+			if statePath != "" {
+				b, err := os.ReadFile(statePath)
+				if err != nil {
+					return fmt.Errorf("failed to read state: %w", err)
+				}
+				if err := json.Unmarshal(b, &state); err != nil {
+					return fmt.Errorf("failed to decode state: %w", err)
+				}
+			}
+
+			// The replay transport is only set when a fixture is given, a nil
+			// *ReplayRoundTripper must not be passed as the http.RoundTripper.
+			var replay http.RoundTripper
+			remaining := func() int { return 0 }
+			if fixturePath != "" {
+				f, err := os.Open(fixturePath)
+				if err != nil {
+					return fmt.Errorf("failed to open fixture: %w", err)
+				}
+				rt, err := cel.NewReplay(f)
+				f.Close()
+				if err != nil {
+					return err
+				}
+				replay, remaining = rt, rt.Remaining
+			}
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer cancel()
+			res, err := cel.Eval(ctx, cfg, state, replay, logp.NewLogger("cel"))
+			if err != nil {
+				return err
+			}
+			if n := remaining(); n != 0 {
+				fmt.Fprintf(os.Stderr, "%d recorded responses were not used\n", n)
+			}
+
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(res)
+		}),
+	}
+
+	evalCmd.Flags().String("config", "", "Path to the configuration of the CEL input, or of a Filebeat configuration with a CEL input")
+	evalCmd.Flags().String("state", "", "Path to a JSON state to evaluate the program with, over the configured state")
+	evalCmd.Flags().String("fixture", "", "Path to a request tracer log to replay instead of making HTTP requests")
+
+	return evalCmd
+}
+
+// celInputConfig loads the configuration of a CEL input from path. The file is
+// either the configuration of the input, or a Filebeat configuration in which
+// case the first CEL input of filebeat.inputs is used.
+func celInputConfig(path string) (*conf.C, error) {
+	cfg, err := common.LoadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	var beat struct {
+		Inputs []*conf.C `config:"filebeat.inputs"`
+	}
+	if err := cfg.Unpack(&beat); err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	if len(beat.Inputs) == 0 {
+		return cfg, nil
+	}
+	for _, input := range beat.Inputs {
+		if typ, _ := input.String("type", -1); typ == "cel" {
+			return input, nil
+		}
+	}
+	return nil, fmt.Errorf("no cel input found in %s", path)
+}
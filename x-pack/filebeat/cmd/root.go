@@ -34,6 +34,7 @@ func Filebeat() *cmd.BeatsRootCmd {
 	settings.Processing = processing.MakeDefaultSupport(true, globalProcs, processing.WithECS, processing.WithHost, processing.WithAgentMeta())
 	settings.ElasticLicensed = true
 	command := fbcmd.Filebeat(inputs.Init, settings)
+	command.AddCommand(genCELCmd())
 	return command
 }
 
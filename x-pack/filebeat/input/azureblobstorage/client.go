@@ -7,6 +7,7 @@ package azureblobstorage
 import (
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 
 	"github.com/elastic/elastic-agent-libs/logp"
@@ -17,6 +18,8 @@ func fetchServiceClientAndCreds(cfg config, url string, log *logp.Logger) (*azbl
 		return fetchServiceClientWithSharedKeyCreds(url, cfg.AccountName, cfg.Auth.SharedCredentials, log)
 	} else if cfg.Auth.ConnectionString != nil {
 		return fetchServiceClientWithConnectionString(cfg.Auth.ConnectionString, log)
+	} else if cfg.Auth.SAS != nil {
+		return fetchServiceClientWithSASToken(url, cfg.Auth.SAS, log)
 	}
 
 	return nil, nil, fmt.Errorf("no valid auth specified")
@@ -49,6 +52,26 @@ func fetchServiceClientWithConnectionString(connectionString *connectionStringCo
 	return serviceClient, &serviceCredentials{connectionStrCreds: connectionString.URI, cType: connectionStringType}, nil
 }
 
+func fetchServiceClientWithSASToken(url string, cfg *sasConfig, log *logp.Logger) (*azblob.ServiceClient, *serviceCredentials, error) {
+	// Creates a request pipeline that adds the current sas token to every request.
+	token, err := newSASToken(cfg, log)
+	if err != nil {
+		log.Errorf("Invalid credentials with error: %v", err)
+		return nil, nil, err
+	}
+
+	client, err := azblob.NewServiceClientWithNoCredential(url, sasClientOptions(token))
+	if err != nil {
+		log.Errorf("Invalid credentials with error: %v", err)
+		return nil, nil, err
+	}
+	return client, &serviceCredentials{sasToken: token, cType: sasType}, nil
+}
+
+func sasClientOptions(token *sasToken) *azblob.ClientOptions {
+	return &azblob.ClientOptions{PerRetryPolicies: []policy.Policy{token}}
+}
+
 // fetchBlobClient, generic function that returns a BlobClient based on the credential type
 func fetchBlobClient(url string, credential *blobCredentials, log *logp.Logger) (*azblob.BlobClient, error) {
 	if credential == nil {
@@ -60,6 +83,8 @@ func fetchBlobClient(url string, credential *blobCredentials, log *logp.Logger)
 		return fetchBlobClientWithSharedKey(url, credential.serviceCreds.sharedKeyCreds, log)
 	case connectionStringType:
 		return fetchBlobClientWithConnectionString(credential.serviceCreds.connectionStrCreds, credential.containerName, credential.blobName, log)
+	case sasType:
+		return fetchBlobClientWithSASToken(url, credential.serviceCreds.sasToken, log)
 	default:
 		return nil, fmt.Errorf("no valid service credential 'type' found: %s", credential.serviceCreds.cType)
 	}
@@ -85,6 +110,16 @@ func fetchBlobClientWithConnectionString(connectionString string, containerName
 	return blobClient, nil
 }
 
+func fetchBlobClientWithSASToken(url string, token *sasToken, log *logp.Logger) (*azblob.BlobClient, error) {
+	blobClient, err := azblob.NewBlobClientWithNoCredential(url, sasClientOptions(token))
+	if err != nil {
+		log.Errorf("Error fetching blob client for url : %s, error : %v", url, err)
+		return nil, err
+	}
+
+	return blobClient, nil
+}
+
 func fetchContainerClient(serviceClient *azblob.ServiceClient, containerName string, log *logp.Logger) (*azblob.ContainerClient, error) {
 	containerClient, err := serviceClient.NewContainerClient(containerName)
 	if err != nil {
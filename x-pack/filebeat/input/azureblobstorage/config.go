@@ -5,10 +5,11 @@
 package azureblobstorage
 
 import (
+	"errors"
 	"time"
 )
 
-// MaxWorkers, Poll & PollInterval can be configured at a global level,
+// MaxWorkers, Poll, PollInterval & HierarchicalNamespace can be configured at a global level,
 // which applies to all containers, as well as at the container level.
 // Container level configurations will always override global level values.
 type config struct {
@@ -18,20 +19,25 @@ type config struct {
 	MaxWorkers   *int           `config:"max_workers,omitempty" validate:"max=5000"`
 	Poll         *bool          `config:"poll,omitempty"`
 	PollInterval *time.Duration `config:"poll_interval,omitempty"`
-	Containers   []container    `config:"containers" validate:"required"`
+	// HierarchicalNamespace lists the containers one directory at a time, as required
+	// by storage accounts with the ADLS Gen2 hierarchical namespace enabled.
+	HierarchicalNamespace *bool       `config:"hierarchical_namespace,omitempty"`
+	Containers            []container `config:"containers" validate:"required"`
 }
 
 // container contains the config for each specific blob storage container in the root account
 type container struct {
-	Name         string         `config:"name" validate:"required"`
-	MaxWorkers   *int           `config:"max_workers,omitempty" validate:"max=5000"`
-	Poll         *bool          `config:"poll,omitempty"`
-	PollInterval *time.Duration `config:"poll_interval,omitempty"`
+	Name                  string         `config:"name" validate:"required"`
+	MaxWorkers            *int           `config:"max_workers,omitempty" validate:"max=5000"`
+	Poll                  *bool          `config:"poll,omitempty"`
+	PollInterval          *time.Duration `config:"poll_interval,omitempty"`
+	HierarchicalNamespace *bool          `config:"hierarchical_namespace,omitempty"`
 }
 
 type authConfig struct {
 	SharedCredentials *sharedKeyConfig        `config:"shared_credentials,omitempty"`
 	ConnectionString  *connectionStringConfig `config:"connection_string,omitempty"`
+	SAS               *sasConfig              `config:"sas,omitempty"`
 }
 
 type connectionStringConfig struct {
@@ -41,6 +47,26 @@ type sharedKeyConfig struct {
 	AccountKey string `config:"account_key"`
 }
 
+// sasConfig holds a shared access signature token, either inline or in a file.
+// A token file is checked for changes every RefreshInterval, so the token can be
+// rotated without restarting the input.
+type sasConfig struct {
+	Token           string        `config:"token"`
+	File            string        `config:"file"`
+	RefreshInterval time.Duration `config:"refresh_interval" validate:"positive"`
+}
+
+func (c *sasConfig) InitDefaults() {
+	c.RefreshInterval = time.Minute
+}
+
+func (c *sasConfig) Validate() error {
+	if (c.Token == "") == (c.File == "") {
+		return errors.New("exactly one of token or file must be set for sas authentication")
+	}
+	return nil
+}
+
 func defaultConfig() config {
 	return config{
 		AccountName: "some_account",
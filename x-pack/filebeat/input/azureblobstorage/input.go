@@ -52,11 +52,12 @@ func configure(cfg *conf.C) ([]cursor.Source, cursor.Input, error) {
 	for _, c := range config.Containers {
 		container := tryOverrideOrDefault(config, c)
 		sources = append(sources, &Source{
-			AccountName:   config.AccountName,
-			ContainerName: c.Name,
-			MaxWorkers:    *container.MaxWorkers,
-			Poll:          *container.Poll,
-			PollInterval:  *container.PollInterval,
+			AccountName:           config.AccountName,
+			ContainerName:         c.Name,
+			MaxWorkers:            *container.MaxWorkers,
+			Poll:                  *container.Poll,
+			PollInterval:          *container.PollInterval,
+			HierarchicalNamespace: *container.HierarchicalNamespace,
 		})
 	}
 
@@ -99,6 +100,14 @@ func tryOverrideOrDefault(cfg config, c container) container {
 		}
 		c.PollInterval = &interval
 	}
+
+	if c.HierarchicalNamespace == nil {
+		var hns bool
+		if cfg.HierarchicalNamespace != nil {
+			hns = *cfg.HierarchicalNamespace
+		}
+		c.HierarchicalNamespace = &hns
+	}
 	return c
 }
 
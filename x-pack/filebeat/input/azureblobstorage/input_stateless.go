@@ -46,11 +46,12 @@ func (in *statelessInput) Run(inputCtx v2.Context, publisher stateless.Publisher
 	for _, c := range in.config.Containers {
 		container := tryOverrideOrDefault(in.config, c)
 		source = &Source{
-			AccountName:   in.config.AccountName,
-			ContainerName: c.Name,
-			MaxWorkers:    *container.MaxWorkers,
-			Poll:          *container.Poll,
-			PollInterval:  *container.PollInterval,
+			AccountName:           in.config.AccountName,
+			ContainerName:         c.Name,
+			MaxWorkers:            *container.MaxWorkers,
+			Poll:                  *container.Poll,
+			PollInterval:          *container.PollInterval,
+			HierarchicalNamespace: *container.HierarchicalNamespace,
 		}
 
 		st := newState()
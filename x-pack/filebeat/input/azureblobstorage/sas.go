@@ -0,0 +1,123 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package azureblobstorage
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// sasToken is a pipeline policy that signs every request with the current
+// shared access signature. When the token is read from a file, the file is
+// checked for changes at most once every refresh interval and a rotated token
+// is picked up by the next request, including the ones of already created clients.
+type sasToken struct {
+	path     string
+	interval time.Duration
+	log      *logp.Logger
+
+	mu      sync.Mutex
+	query   url.Values
+	modTime time.Time
+	checked time.Time
+}
+
+func newSASToken(cfg *sasConfig, log *logp.Logger) (*sasToken, error) {
+	s := &sasToken{path: cfg.File, interval: cfg.RefreshInterval, log: log}
+	if cfg.File == "" {
+		query, err := parseSASToken(cfg.Token)
+		if err != nil {
+			return nil, err
+		}
+		s.query = query
+		return s, nil
+	}
+
+	info, err := os.Stat(cfg.File)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sas token file: %w", err)
+	}
+	if err := s.load(info.ModTime(), time.Now()); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Do implements policy.Policy.
+func (s *sasToken) Do(req *policy.Request) (*http.Response, error) {
+	raw := req.Raw()
+	query := raw.URL.Query()
+	for k, v := range s.values(time.Now()) {
+		query[k] = v
+	}
+	raw.URL.RawQuery = query.Encode()
+	return req.Next()
+}
+
+// values returns the query parameters of the current token, reloading the
+// token file first if it has changed. A token that fails to load is logged and
+// the previous one is kept until the file is fixed.
+func (s *sasToken) values(now time.Time) url.Values {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.path == "" || now.Sub(s.checked) < s.interval {
+		return s.query
+	}
+	s.checked = now
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		s.log.Errorf("failed to check sas token file, keeping the current token: %v", err)
+		return s.query
+	}
+	if info.ModTime().Equal(s.modTime) {
+		return s.query
+	}
+	if err := s.load(info.ModTime(), now); err != nil {
+		s.log.Errorf("failed to reload sas token, keeping the current token: %v", err)
+		return s.query
+	}
+	s.log.Info("reloaded sas token")
+	return s.query
+}
+
+// load reads the token file, it must be called with s.mu held or before s is shared.
+func (s *sasToken) load(modTime, now time.Time) error {
+	b, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to read sas token file: %w", err)
+	}
+	query, err := parseSASToken(string(b))
+	if err != nil {
+		return err
+	}
+	s.query = query
+	s.modTime = modTime
+	s.checked = now
+	return nil
+}
+
+// parseSASToken parses a shared access signature, with or without its leading '?'.
+func parseSASToken(token string) (url.Values, error) {
+	query, err := url.ParseQuery(strings.TrimPrefix(strings.TrimSpace(token), "?"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sas token: %w", err)
+	}
+	if query.Get("sig") == "" {
+		return nil, errors.New("sas token is missing its signature")
+	}
+	return query, nil
+}
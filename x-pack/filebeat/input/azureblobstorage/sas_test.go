@@ -0,0 +1,53 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package azureblobstorage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+func TestParseSASToken(t *testing.T) {
+	query, err := parseSASToken("?sv=2021-08-06&ss=b&sig=abc%3D\n")
+	require.NoError(t, err)
+	assert.Equal(t, "2021-08-06", query.Get("sv"))
+	assert.Equal(t, "abc=", query.Get("sig"))
+
+	_, err = parseSASToken("sv=2021-08-06&ss=b")
+	assert.Error(t, err)
+}
+
+func TestSASConfigValidate(t *testing.T) {
+	assert.NoError(t, (&sasConfig{Token: "sig=a"}).Validate())
+	assert.NoError(t, (&sasConfig{File: "token"}).Validate())
+	assert.Error(t, (&sasConfig{}).Validate())
+	assert.Error(t, (&sasConfig{Token: "sig=a", File: "token"}).Validate())
+}
+
+func TestSASTokenRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sas")
+	require.NoError(t, os.WriteFile(path, []byte("sv=1&sig=first"), 0o600))
+
+	token, err := newSASToken(&sasConfig{File: path, RefreshInterval: time.Minute}, logp.NewLogger(inputName))
+	require.NoError(t, err)
+	now := time.Now()
+	assert.Equal(t, "first", token.values(now).Get("sig"))
+
+	require.NoError(t, os.WriteFile(path, []byte("sv=1&sig=second"), 0o600))
+	require.NoError(t, os.Chtimes(path, now, now.Add(time.Second)))
+	assert.Equal(t, "first", token.values(now.Add(time.Second)).Get("sig"), "token reloaded before the refresh interval")
+	assert.Equal(t, "second", token.values(now.Add(2*time.Minute)).Get("sig"))
+
+	require.NoError(t, os.WriteFile(path, []byte("sv=1"), 0o600))
+	require.NoError(t, os.Chtimes(path, now, now.Add(2*time.Second)))
+	assert.Equal(t, "second", token.values(now.Add(4*time.Minute)).Get("sig"), "invalid token replaced the current one")
+}
@@ -7,6 +7,7 @@ package azureblobstorage
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
@@ -91,34 +92,98 @@ func (s *scheduler) schedule(ctx context.Context) error {
 }
 
 func (s *scheduler) scheduleOnce(ctx context.Context) error {
+	if s.src.HierarchicalNamespace {
+		return s.walkHierarchy(ctx, "")
+	}
+
 	pager := s.fetchBlobPager(int32(s.src.MaxWorkers))
 	for pager.NextPage(ctx) {
-		jobs, err := s.createJobs(pager)
+		err := s.scheduleJobs(ctx, pager.PageResponse().Segment.BlobItems)
 		if err != nil {
-			s.log.Errorf("Job creation failed for container %s with error %v", s.src.ContainerName, err)
 			return err
 		}
+	}
 
-		// If previous checkpoint was saved then look up starting point for new jobs
-		if !s.state.checkpoint().LatestEntryTime.IsZero() {
-			jobs = s.moveToLastSeenJob(jobs)
-		}
+	return pager.Err()
+}
 
-		// distributes jobs among workers with the help of a limiter
-		for i, job := range jobs {
-			id := fetchJobID(i, s.src.ContainerName, job.name())
-			job := job
-			s.limiter.acquire()
-			go func() {
-				defer s.limiter.release()
-				job.do(ctx, id)
-			}()
+// walkHierarchy lists the blobs under prefix one directory at a time, descending into each
+// sub directory at its lexicographical position among the blobs, so the jobs are scheduled
+// in the same order as with a flat listing and the checkpoint logic applies unchanged.
+func (s *scheduler) walkHierarchy(ctx context.Context, prefix string) error {
+	pager := s.fetchBlobHierarchyPager(prefix, int32(s.src.MaxWorkers))
+	for pager.NextPage(ctx) {
+		segment := pager.PageResponse().Segment
+		for _, entry := range interleave(segment.BlobItems, segment.BlobPrefixes) {
+			var err error
+			if entry.dir != nil {
+				err = s.walkHierarchy(ctx, *entry.dir.Name)
+			} else {
+				err = s.scheduleJobs(ctx, entry.blobs)
+			}
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	return pager.Err()
 }
 
+// hierarchyEntry is either a run of blobs or a sub directory of a hierarchical listing.
+type hierarchyEntry struct {
+	blobs []*azblob.BlobItemInternal
+	dir   *azblob.BlobPrefix
+}
+
+// interleave merges the blobs and the sub directories of a listing page, both sorted
+// lexicographically, into runs of blobs separated by the directories that sort between them.
+func interleave(blobs []*azblob.BlobItemInternal, dirs []*azblob.BlobPrefix) []hierarchyEntry {
+	var entries []hierarchyEntry
+	for _, dir := range dirs {
+		n := 0
+		for n < len(blobs) && *blobs[n].Name < *dir.Name {
+			n++
+		}
+		if n != 0 {
+			entries = append(entries, hierarchyEntry{blobs: blobs[:n]})
+			blobs = blobs[n:]
+		}
+		entries = append(entries, hierarchyEntry{dir: dir})
+	}
+	if len(blobs) != 0 {
+		entries = append(entries, hierarchyEntry{blobs: blobs})
+	}
+	return entries
+}
+
+// scheduleJobs creates the jobs for a batch of blobs and distributes them among the workers.
+func (s *scheduler) scheduleJobs(ctx context.Context, blobs []*azblob.BlobItemInternal) error {
+	jobs, err := s.createJobs(blobs)
+	if err != nil {
+		s.log.Errorf("Job creation failed for container %s with error %v", s.src.ContainerName, err)
+		return err
+	}
+
+	// If previous checkpoint was saved then look up starting point for new jobs
+	if !s.state.checkpoint().LatestEntryTime.IsZero() {
+		jobs = s.moveToLastSeenJob(jobs)
+	}
+
+	// distributes jobs among workers with the help of a limiter
+	for i, job := range jobs {
+		id := fetchJobID(i, s.src.ContainerName, job.name())
+		job := job
+		s.limiter.acquire()
+		go func() {
+			defer s.limiter.release()
+			job.do(ctx, id)
+		}()
+	}
+
+	return nil
+}
+
 // fetchJobID returns a job id which is a combination of worker id, container name and blob name
 func fetchJobID(workerId int, containerName string, blobName string) string {
 	jobID := fmt.Sprintf("%s-%s-worker-%d", containerName, blobName, workerId)
@@ -126,10 +191,14 @@ func fetchJobID(workerId int, containerName string, blobName string) string {
 	return jobID
 }
 
-func (s *scheduler) createJobs(pager *azblob.ContainerListBlobFlatPager) ([]*job, error) {
+func (s *scheduler) createJobs(blobs []*azblob.BlobItemInternal) ([]*job, error) {
 	var jobs []*job
 
-	for _, v := range pager.PageResponse().Segment.BlobItems {
+	for _, v := range blobs {
+		// directories of a hierarchical namespace are listed as empty placeholder blobs
+		if isDirectory(v) {
+			continue
+		}
 		blobURL := s.serviceURL + s.src.ContainerName + "/" + *v.Name
 		blobCreds := &blobCredentials{
 			serviceCreds:  s.credential,
@@ -169,6 +238,33 @@ func (s *scheduler) fetchBlobPager(batchSize int32) *azblob.ContainerListBlobFla
 	return pager
 }
 
+// fetchBlobHierarchyPager fetches the blob pager of the directory designated by prefix.
+// The sub directories are returned as blob prefixes instead of being listed recursively.
+func (s *scheduler) fetchBlobHierarchyPager(prefix string, batchSize int32) *azblob.ContainerListBlobHierarchyPager {
+	options := &azblob.ContainerListBlobsHierarchyOptions{
+		Include: []azblob.ListBlobsIncludeItem{
+			azblob.ListBlobsIncludeItemMetadata,
+			azblob.ListBlobsIncludeItemTags,
+		},
+		MaxResults: &batchSize,
+	}
+	if prefix != "" {
+		options.Prefix = &prefix
+	}
+
+	return s.client.ListBlobsHierarchy("/", options)
+}
+
+// isDirectory reports whether the blob is the placeholder of a hierarchical namespace directory.
+func isDirectory(blob *azblob.BlobItemInternal) bool {
+	for k, v := range blob.Metadata {
+		if strings.EqualFold(k, hdiIsFolder) && v != nil && strings.EqualFold(*v, "true") {
+			return true
+		}
+	}
+	return false
+}
+
 // moveToLastSeenJob, moves to the latest job position past the last seen job
 // Jobs are stored in lexicographical order always, hence the latest position can be found either on the basis of job name or timestamp
 func (s *scheduler) moveToLastSeenJob(jobs []*job) []*job {
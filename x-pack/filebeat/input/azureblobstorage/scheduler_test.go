@@ -0,0 +1,48 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package azureblobstorage
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterleave(t *testing.T) {
+	blobs := []*azblob.BlobItemInternal{
+		{Name: toPtr("logs/a.json")},
+		{Name: toPtr("logs/b.json")},
+		{Name: toPtr("logs/c.json")},
+		{Name: toPtr("logs/z.json")},
+	}
+	dirs := []*azblob.BlobPrefix{
+		{Name: toPtr("logs/a/")},
+		{Name: toPtr("logs/d/")},
+	}
+
+	var got []string
+	for _, entry := range interleave(blobs, dirs) {
+		if entry.dir != nil {
+			got = append(got, *entry.dir.Name)
+			continue
+		}
+		for _, b := range entry.blobs {
+			got = append(got, *b.Name)
+		}
+		got = append(got, "|")
+	}
+	assert.Equal(t, []string{"logs/a.json", "|", "logs/a/", "logs/b.json", "logs/c.json", "|", "logs/d/", "logs/z.json", "|"}, got)
+}
+
+func TestIsDirectory(t *testing.T) {
+	assert.True(t, isDirectory(&azblob.BlobItemInternal{Metadata: map[string]*string{"Hdi_isfolder": toPtr("true")}}))
+	assert.False(t, isDirectory(&azblob.BlobItemInternal{Metadata: map[string]*string{"hdi_isfolder": toPtr("false")}}))
+	assert.False(t, isDirectory(&azblob.BlobItemInternal{}))
+}
+
+func toPtr(s string) *string {
+	return &s
+}
@@ -13,11 +13,12 @@ import (
 
 // Source, it is the cursor source
 type Source struct {
-	ContainerName string
-	AccountName   string
-	MaxWorkers    int
-	Poll          bool
-	PollInterval  time.Duration
+	ContainerName         string
+	AccountName           string
+	MaxWorkers            int
+	Poll                  bool
+	PollInterval          time.Duration
+	HierarchicalNamespace bool
 }
 
 func (s *Source) Name() string {
@@ -27,17 +28,20 @@ func (s *Source) Name() string {
 const (
 	sharedKeyType        = "sharedKeyType"
 	connectionStringType = "connectionStringType"
+	sasType              = "sasType"
 	jsonType             = "application/json"
 	octetType            = "application/octet-stream"
 	ndJsonType           = "application/x-ndjson"
 	gzType               = "application/x-gzip"
 	encodingGzip         = "gzip"
+	hdiIsFolder          = "hdi_isfolder"
 )
 
-// currently only shared key & connection string types of credentials are supported
+// currently only shared key, connection string & sas token types of credentials are supported
 type serviceCredentials struct {
 	sharedKeyCreds     *azblob.SharedKeyCredential
 	connectionStrCreds string
+	sasToken           *sasToken
 	cType              string
 }
 
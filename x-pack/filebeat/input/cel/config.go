@@ -13,8 +13,10 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"gopkg.in/natefinch/lumberjack.v2"
 
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
 	"github.com/elastic/elastic-agent-libs/transport/httpcommon"
 )
 
@@ -25,6 +27,10 @@ type config struct {
 
 	// Program is the CEL program to be run for each polling.
 	Program string `config:"program" validate:"required"`
+	// MaxExecutions is the maximum number of times the program is
+	// executed in a single interval when it requests more data. The
+	// state is kept, so the next interval resumes where it stopped.
+	MaxExecutions int `config:"max_executions"`
 	// Regexps is the set of regular expression to be made
 	// available to the program.
 	Regexps map[string]string `config:"regexp"`
@@ -58,6 +64,9 @@ func (c config) Validate() error {
 	if c.Interval <= 0 {
 		return errors.New("interval must be greater than 0")
 	}
+	if c.MaxExecutions <= 0 {
+		return errors.New("max_executions must be greater than 0")
+	}
 	_, err := regexpsFromConfig(c)
 	if err != nil {
 		return fmt.Errorf("failed to check regular expressions: %w", err)
@@ -86,7 +95,8 @@ func defaultConfig() config {
 	transport.Timeout = 30 * time.Second
 
 	return config{
-		Interval: time.Minute,
+		Interval:      time.Minute,
+		MaxExecutions: 1000,
 		Resource: &ResourceConfig{
 			Retry: retryConfig{
 				MaxAttempts: &maxAttempts,
@@ -95,6 +105,7 @@ func defaultConfig() config {
 			},
 			RedirectForwardHeaders: false,
 			RedirectMaxRedirects:   10,
+			MaxBodySize:            100 * humanize.MiByte,
 			Transport:              transport,
 		},
 	}
@@ -154,6 +165,28 @@ func (c rateLimitConfig) Validate() error {
 	return nil
 }
 
+type hostRateLimitConfig struct {
+	Limit *float64 `config:"limit" validate:"required"`
+	Burst *int     `config:"burst"`
+}
+
+func (c hostRateLimitConfig) Validate() error {
+	if c.Limit != nil && *c.Limit <= 0 {
+		return errors.New("limit must be greater than zero")
+	}
+	if c.Burst != nil && *c.Burst <= 0 {
+		return errors.New("burst must be greater than zero")
+	}
+	return nil
+}
+
+func (c hostRateLimitConfig) burst() int {
+	if c.Burst == nil {
+		return 1
+	}
+	return *c.Burst
+}
+
 type keepAlive struct {
 	Disable             *bool         `config:"disable"`
 	MaxIdleConns        int           `config:"max_idle_connections"`
@@ -195,6 +228,13 @@ type ResourceConfig struct {
 	RateLimit              *rateLimitConfig `config:"rate_limit"`
 	KeepAlive              keepAlive        `config:"keep_alive"`
 
+	// MaxBodySize is the maximum size of a response body, responses
+	// exceeding it fail. Zero disables the limit.
+	MaxBodySize cfgtype.ByteSize `config:"max_body_size"`
+	// HostRateLimit limits the rate of requests made to each host,
+	// independently of the rate limits of the program.
+	HostRateLimit *hostRateLimitConfig `config:"host_rate_limit"`
+
 	Transport httpcommon.HTTPTransportSettings `config:",inline"`
 
 	Tracer *lumberjack.Logger `config:"tracer"`
@@ -223,6 +263,9 @@ func (u *urlConfig) Unpack(in string) error {
 }
 
 func (c *ResourceConfig) Validate() error {
+	if c.MaxBodySize < 0 {
+		return errors.New("max_body_size must not be negative")
+	}
 	if c.Tracer == nil {
 		return nil
 	}
@@ -0,0 +1,189 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package cel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/internal/httplog"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/mito/lib"
+)
+
+// EvalResult is the outcome of a single evaluation of an input's CEL program,
+// as reported by Eval.
+type EvalResult struct {
+	// Events are the events that would be published.
+	Events []interface{} `json:"events"`
+	// Cursor is the cursor that would be stored once the events are
+	// published. It is nil if no cursor would be stored.
+	Cursor interface{} `json:"cursor,omitempty"`
+	// WantMore is whether the program would be evaluated again
+	// without waiting for the next interval.
+	WantMore bool `json:"want_more"`
+	// Retry is whether the response was rejected, for example with
+	// a 429 status, and the evaluation would be retried.
+	Retry bool `json:"retry,omitempty"`
+	// RateLimit is the rate limit in effect for the next evaluation.
+	RateLimit RateLimitDecision `json:"rate_limit"`
+	// State is the state the next evaluation would start from. It can
+	// be passed to Eval to step through a sequence of evaluations.
+	State map[string]interface{} `json:"state"`
+	// Error is the evaluation error, if any. In that case Events holds
+	// the error event that would be published.
+	Error string `json:"error,omitempty"`
+}
+
+// RateLimitDecision is the rate limit applied to the requests of the program.
+type RateLimitDecision struct {
+	// Limit is the number of requests per second, or "inf" if unlimited.
+	Limit interface{} `json:"limit"`
+	// Burst is the number of requests allowed in a burst.
+	Burst int `json:"burst"`
+	// WaitUntil is the time before which no request would be made.
+	WaitUntil *time.Time `json:"wait_until,omitempty"`
+}
+
+// Eval evaluates the CEL program of the input configured by cfg once and
+// returns what the input would do with the result, without publishing events
+// or storing the cursor. The evaluation starts from the configured state with
+// the fields of state, typically the State of a previous EvalResult, set over
+// it. If replay is not nil, it serves the HTTP requests of the program instead
+// of the network, see NewReplay.
+func Eval(ctx context.Context, cfg *conf.C, state map[string]interface{}, replay http.RoundTripper, log *logp.Logger) (*EvalResult, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+	if c.Resource.Tracer != nil {
+		c.Resource.Tracer.Filename = strings.ReplaceAll(c.Resource.Tracer.Filename, "*", "eval")
+	}
+
+	client, err := newClient(ctx, c, replay, nil, log)
+	if err != nil {
+		return nil, err
+	}
+	limiter := newRateLimiterFromConfig(c.Resource)
+	patterns, err := regexpsFromConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	var auth *lib.BasicAuth
+	if c.Auth.Basic.isEnabled() {
+		auth = &lib.BasicAuth{
+			Username: c.Auth.Basic.User,
+			Password: c.Auth.Basic.Password,
+		}
+	}
+	prg, err := newProgram(ctx, c.Program, root, client, limiter, auth, patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	in := make(map[string]interface{}, len(c.State)+len(state)+1)
+	for k, v := range c.State {
+		in[k] = v
+	}
+	for k, v := range state {
+		in[k] = v
+	}
+	if _, ok := in["url"]; !ok {
+		in["url"] = c.Resource.URL.String()
+	}
+	goodURL := in["url"]
+	goodCursor := in["cursor"]
+
+	var res EvalResult
+	out, err := evalWith(ctx, prg, in)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, err
+		}
+		res.Error = err.Error()
+	}
+
+	ok, waitUntil, err := handleResponse(log, out, limiter)
+	if err != nil {
+		return nil, err
+	}
+	res.Retry = !ok
+	res.RateLimit = rateLimitDecision(limiter, waitUntil)
+	if _, ok := out["url"]; !ok && goodURL != "" {
+		out["url"] = goodURL
+	}
+	res.WantMore, _ = out["want_more"].(bool)
+	res.State = out
+	if res.Retry {
+		return &res, nil
+	}
+
+	// Follow the handling of the events and cursors of input.run.
+	switch e := out["events"].(type) {
+	case nil:
+	case []interface{}:
+		res.Events = e
+	case map[string]interface{}:
+		// A single event is an error, the cursor is not advanced.
+		res.Events = []interface{}{e}
+		delete(out, "events")
+		out["cursor"] = goodCursor
+		return &res, nil
+	default:
+		return nil, fmt.Errorf("unexpected type returned for evaluation events: %T", e)
+	}
+	if len(res.Events) == 0 {
+		return &res, nil
+	}
+	for _, e := range res.Events {
+		if _, ok := e.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("unexpected type returned for evaluation events: %T", e)
+		}
+	}
+	delete(out, "events")
+
+	switch c := out["cursor"].(type) {
+	case nil:
+	case []interface{}:
+		if len(c) < len(res.Events) {
+			log.Errorw("unexpected cursor list length", "cursors", len(c), "events", len(res.Events))
+			break
+		}
+		goodCursor = c[len(c)-1]
+	default:
+		goodCursor = c
+	}
+	res.Cursor = goodCursor
+	out["cursor"] = goodCursor
+	return &res, nil
+}
+
+// NewReplay returns an http.RoundTripper for Eval that replays the responses
+// recorded in a request tracer log read from r.
+func NewReplay(r io.Reader) (*httplog.ReplayRoundTripper, error) {
+	return httplog.NewReplayRoundTripper(r)
+}
+
+func rateLimitDecision(limiter *rate.Limiter, waitUntil time.Time) RateLimitDecision {
+	d := RateLimitDecision{Burst: limiter.Burst()}
+	if l := float64(limiter.Limit()); math.IsInf(l, 1) {
+		d.Limit = "inf"
+	} else {
+		d.Limit = l
+	}
+	if !waitUntil.IsZero() {
+		d.WaitUntil = &waitUntil
+	}
+	return d
+}
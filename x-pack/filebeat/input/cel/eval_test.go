@@ -0,0 +1,139 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package cel
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+var evalTests = []struct {
+	name    string
+	program string
+	url     string
+	state   map[string]interface{}
+	replay  http.RoundTripper
+	want    *EvalResult
+}{
+	{
+		name:    "cursor_array",
+		program: `{"events":[{"message":"a"},{"message":"b"}], "cursor":[{"n":1},{"n":2}], "want_more":true}`,
+		want: &EvalResult{
+			Events:    []interface{}{map[string]interface{}{"message": "a"}, map[string]interface{}{"message": "b"}},
+			Cursor:    map[string]interface{}{"n": 2.0},
+			WantMore:  true,
+			RateLimit: RateLimitDecision{Limit: "inf", Burst: 1},
+			State: map[string]interface{}{
+				"cursor":    map[string]interface{}{"n": 2.0},
+				"want_more": true,
+			},
+		},
+	},
+	{
+		name:    "chained_state",
+		program: `{"events":[{"n":state.cursor.n+1.0}], "cursor":{"n":state.cursor.n+1.0}}`,
+		state:   map[string]interface{}{"cursor": map[string]interface{}{"n": 1.0}},
+		want: &EvalResult{
+			Events:    []interface{}{map[string]interface{}{"n": 2.0}},
+			Cursor:    map[string]interface{}{"n": 2.0},
+			RateLimit: RateLimitDecision{Limit: "inf", Burst: 1},
+			State: map[string]interface{}{
+				"cursor": map[string]interface{}{"n": 2.0},
+			},
+		},
+	},
+	{
+		name:    "rate_limit",
+		program: `{"events":[], "rate_limit":{"rate":2.0, "burst":3}}`,
+		want: &EvalResult{
+			Events:    []interface{}{},
+			RateLimit: RateLimitDecision{Limit: 2.0, Burst: 3},
+			State: map[string]interface{}{
+				"events": []interface{}{},
+			},
+		},
+	},
+	{
+		name:    "too_many_requests",
+		program: `{"events":[{"message":"a"}], "status_code":429}`,
+		want: &EvalResult{
+			Retry:     true,
+			RateLimit: RateLimitDecision{Limit: "inf", Burst: 1},
+			State: map[string]interface{}{
+				"events": []interface{}{map[string]interface{}{"message": "a"}},
+			},
+		},
+	},
+	{
+		name:    "error_event",
+		program: `{"events":{"error":{"message":"failed"}}, "cursor":{"n":2}}`,
+		state:   map[string]interface{}{"cursor": map[string]interface{}{"n": 1.0}},
+		want: &EvalResult{
+			Events:    []interface{}{map[string]interface{}{"error": map[string]interface{}{"message": "failed"}}},
+			RateLimit: RateLimitDecision{Limit: "inf", Burst: 1},
+			State: map[string]interface{}{
+				"cursor": map[string]interface{}{"n": 1.0},
+			},
+		},
+	},
+	{
+		name: "replay",
+		program: `
+bytes(get(state.url).Body).as(body, {
+	"events": [body.decode_json()]
+})
+`,
+		url: "http://example.invalid/",
+		replay: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"message":"replayed"}`)),
+				Request:    req,
+			}, nil
+		}),
+		want: &EvalResult{
+			Events:    []interface{}{map[string]interface{}{"message": "replayed"}},
+			RateLimit: RateLimitDecision{Limit: "inf", Burst: 1},
+			State: map[string]interface{}{
+				"url": "http://example.invalid/",
+			},
+		},
+	},
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return fn(req) }
+
+func TestEval(t *testing.T) {
+	logp.TestingSetup()
+	for _, test := range evalTests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := conf.MustNewConfigFrom(map[string]interface{}{
+				"interval": 1,
+				"program":  test.program,
+				"resource": map[string]interface{}{
+					"url": test.url,
+				},
+			})
+			got, err := Eval(context.Background(), cfg, test.state, test.replay, logp.NewLogger("cel_eval_test"))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !cmp.Equal(got, test.want) {
+				t.Errorf("unexpected result: got:- want:+\n%s", cmp.Diff(got, test.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package cel
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/elastic/beats/v7/libbeat/common/encoding/xml"
+)
+
+// extensions returns the CEL functions provided by the input in addition to
+// the mito libraries.
+//
+// # HMAC Sign
+//
+// hmac_sign returns the HMAC of the message using the hash algorithm and
+// the key. The algorithm is one of md5, sha1, sha256, sha384 and sha512.
+//
+//	hmac_sign(<bytes>, <string>, <bytes>) -> <bytes>
+//	hmac_sign(<string>, <string>, <string>) -> <bytes>
+//	<bytes>.hmac_sign(<string>, <bytes>) -> <bytes>
+//	<string>.hmac_sign(<string>, <string>) -> <bytes>
+//
+// Examples:
+//
+//	"message".hmac_sign("sha512", "key").hex()
+//
+// # Digest
+//
+// digest returns the hash of the data using one of the algorithms supported
+// by hmac_sign.
+//
+//	digest(<bytes>, <string>) -> <bytes>
+//	digest(<string>, <string>) -> <bytes>
+//	<bytes>.digest(<string>) -> <bytes>
+//	<string>.digest(<string>) -> <bytes>
+//
+// # JWT RS256
+//
+// jwt_rs256 returns a JWT holding the claims, signed with RS256 using the
+// PEM encoded PKCS #1 or PKCS #8 RSA private key. Additional header fields,
+// such as kid, can be given as a map.
+//
+//	jwt_rs256(<map<string,dyn>>, <string>) -> <string>
+//	jwt_rs256(<map<string,dyn>>, <map<string,dyn>>, <string>) -> <string>
+//
+// Examples:
+//
+//	jwt_rs256({"iss": "client", "exp": now.format_unix() + 300}, {"kid": "1"}, state.key)
+//
+// # Decode XML
+//
+// decode_xml returns the XML document as a map. The attributes of the elements
+// are merged with their children, and repeated elements are returned as lists.
+//
+//	decode_xml(<bytes>) -> <map<string,dyn>>
+//	decode_xml(<string>) -> <map<string,dyn>>
+//	<bytes>.decode_xml() -> <map<string,dyn>>
+//	<string>.decode_xml() -> <map<string,dyn>>
+func extensions() cel.EnvOption {
+	return cel.Lib(extLib{})
+}
+
+type extLib struct{}
+
+var mapStringDyn = cel.MapType(cel.StringType, cel.DynType)
+
+func (extLib) CompileOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function("hmac_sign",
+			cel.Overload("hmac_sign_bytes_string_bytes",
+				[]*cel.Type{cel.BytesType, cel.StringType, cel.BytesType}, cel.BytesType,
+				cel.FunctionBinding(hmacSign),
+			),
+			cel.Overload("hmac_sign_string_string_string",
+				[]*cel.Type{cel.StringType, cel.StringType, cel.StringType}, cel.BytesType,
+				cel.FunctionBinding(hmacSign),
+			),
+			cel.MemberOverload("bytes_hmac_sign_string_bytes",
+				[]*cel.Type{cel.BytesType, cel.StringType, cel.BytesType}, cel.BytesType,
+				cel.FunctionBinding(hmacSign),
+			),
+			cel.MemberOverload("string_hmac_sign_string_string",
+				[]*cel.Type{cel.StringType, cel.StringType, cel.StringType}, cel.BytesType,
+				cel.FunctionBinding(hmacSign),
+			),
+		),
+		cel.Function("digest",
+			cel.Overload("digest_bytes_string",
+				[]*cel.Type{cel.BytesType, cel.StringType}, cel.BytesType,
+				cel.BinaryBinding(digest),
+			),
+			cel.Overload("digest_string_string",
+				[]*cel.Type{cel.StringType, cel.StringType}, cel.BytesType,
+				cel.BinaryBinding(digest),
+			),
+			cel.MemberOverload("bytes_digest_string",
+				[]*cel.Type{cel.BytesType, cel.StringType}, cel.BytesType,
+				cel.BinaryBinding(digest),
+			),
+			cel.MemberOverload("string_digest_string",
+				[]*cel.Type{cel.StringType, cel.StringType}, cel.BytesType,
+				cel.BinaryBinding(digest),
+			),
+		),
+		cel.Function("jwt_rs256",
+			cel.Overload("jwt_rs256_map_string",
+				[]*cel.Type{mapStringDyn, cel.StringType}, cel.StringType,
+				cel.BinaryBinding(func(claims, key ref.Val) ref.Val {
+					return jwtRS256(claims, nil, key)
+				}),
+			),
+			cel.Overload("jwt_rs256_map_map_string",
+				[]*cel.Type{mapStringDyn, mapStringDyn, cel.StringType}, cel.StringType,
+				cel.FunctionBinding(func(args ...ref.Val) ref.Val {
+					return jwtRS256(args[0], args[1], args[2])
+				}),
+			),
+		),
+		cel.Function("decode_xml",
+			cel.Overload("decode_xml_bytes",
+				[]*cel.Type{cel.BytesType}, mapStringDyn,
+				cel.UnaryBinding(decodeXML),
+			),
+			cel.Overload("decode_xml_string",
+				[]*cel.Type{cel.StringType}, mapStringDyn,
+				cel.UnaryBinding(decodeXML),
+			),
+			cel.MemberOverload("bytes_decode_xml",
+				[]*cel.Type{cel.BytesType}, mapStringDyn,
+				cel.UnaryBinding(decodeXML),
+			),
+			cel.MemberOverload("string_decode_xml",
+				[]*cel.Type{cel.StringType}, mapStringDyn,
+				cel.UnaryBinding(decodeXML),
+			),
+		),
+	}
+}
+
+func (extLib) ProgramOptions() []cel.ProgramOption { return nil }
+
+var hashes = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+func hmacSign(args ...ref.Val) ref.Val {
+	if len(args) != 3 {
+		return types.NewErr("hmac_sign: invalid number of arguments")
+	}
+	msg, ok := asBytes(args[0])
+	if !ok {
+		return types.ValOrErr(args[0], "no such overload for hmac_sign")
+	}
+	algo, ok := args[1].(types.String)
+	if !ok {
+		return types.ValOrErr(args[1], "no such overload for hmac_sign")
+	}
+	key, ok := asBytes(args[2])
+	if !ok {
+		return types.ValOrErr(args[2], "no such overload for hmac_sign")
+	}
+	newHash, ok := hashes[string(algo)]
+	if !ok {
+		return types.NewErr("hmac_sign: unsupported hash algorithm: %s", algo)
+	}
+	mac := hmac.New(newHash, key)
+	mac.Write(msg)
+	return types.Bytes(mac.Sum(nil))
+}
+
+func digest(data, algo ref.Val) ref.Val {
+	b, ok := asBytes(data)
+	if !ok {
+		return types.ValOrErr(data, "no such overload for digest")
+	}
+	name, ok := algo.(types.String)
+	if !ok {
+		return types.ValOrErr(algo, "no such overload for digest")
+	}
+	newHash, ok := hashes[string(name)]
+	if !ok {
+		return types.NewErr("digest: unsupported hash algorithm: %s", name)
+	}
+	h := newHash()
+	h.Write(b)
+	return types.Bytes(h.Sum(nil))
+}
+
+func jwtRS256(claims, header, key ref.Val) ref.Val {
+	keyPEM, ok := key.(types.String)
+	if !ok {
+		return types.ValOrErr(key, "no such overload for jwt_rs256")
+	}
+	privKey, err := parseRSAPrivateKey([]byte(keyPEM))
+	if err != nil {
+		return types.NewErr("jwt_rs256: %v", err)
+	}
+
+	hdr := map[string]interface{}{}
+	if header != nil {
+		m, err := asMap(header)
+		if err != nil {
+			return types.NewErr("jwt_rs256: invalid header: %v", err)
+		}
+		hdr = m
+	}
+	hdr["alg"] = "RS256"
+	if _, ok := hdr["typ"]; !ok {
+		hdr["typ"] = "JWT"
+	}
+	payload, err := asMap(claims)
+	if err != nil {
+		return types.NewErr("jwt_rs256: invalid claims: %v", err)
+	}
+
+	hdrJSON, err := json.Marshal(hdr)
+	if err != nil {
+		return types.NewErr("jwt_rs256: %v", err)
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return types.NewErr("jwt_rs256: %v", err)
+	}
+	signed := base64.RawURLEncoding.EncodeToString(hdrJSON) + "." + base64.RawURLEncoding.EncodeToString(payloadJSON)
+	sum := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, privKey, crypto.SHA256, sum[:])
+	if err != nil {
+		return types.NewErr("jwt_rs256: %v", err)
+	}
+	return types.String(signed + "." + base64.RawURLEncoding.EncodeToString(sig))
+}
+
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM encoded key found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("key is a %T, not an RSA private key", key)
+	}
+	return rsaKey, nil
+}
+
+func decodeXML(val ref.Val) ref.Val {
+	b, ok := asBytes(val)
+	if !ok {
+		return types.ValOrErr(val, "no such overload for decode_xml")
+	}
+	m, err := xml.NewDecoder(bytes.NewReader(b)).Decode()
+	if err != nil {
+		return types.NewErr("decode_xml: %v", err)
+	}
+	return types.DefaultTypeAdapter.NativeToValue(m)
+}
+
+func asBytes(val ref.Val) ([]byte, bool) {
+	switch val := val.(type) {
+	case types.Bytes:
+		return []byte(val), true
+	case types.String:
+		return []byte(val), true
+	default:
+		return nil, false
+	}
+}
+
+// asMap returns the CEL map as a map of JSON compatible values.
+func asMap(val ref.Val) (map[string]interface{}, error) {
+	v, err := val.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, err
+	}
+	m, ok := v.(*structpb.Value).AsInterface().(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s is not a map", val.Type().TypeName())
+	}
+	return m, nil
+}
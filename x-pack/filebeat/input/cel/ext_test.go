@@ -0,0 +1,165 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package cel
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/go-cmp/cmp"
+)
+
+func evalExt(t *testing.T, src string, vars map[string]interface{}) (interface{}, error) {
+	t.Helper()
+	var opts []cel.EnvOption
+	for name := range vars {
+		opts = append(opts, cel.Variable(name, cel.DynType))
+	}
+	env, err := cel.NewEnv(append(opts, extensions())...)
+	if err != nil {
+		t.Fatalf("failed to create env: %v", err)
+	}
+	ast, iss := env.Compile(src)
+	if iss.Err() != nil {
+		t.Fatalf("failed compilation: %v", iss.Err())
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		t.Fatalf("failed program instantiation: %v", err)
+	}
+	if vars == nil {
+		vars = map[string]interface{}{}
+	}
+	out, _, err := prg.Eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	return out.Value(), nil
+}
+
+func TestExtensions(t *testing.T) {
+	tests := []struct {
+		src     string
+		want    interface{}
+		wantErr string
+	}{
+		{
+			// echo -n message | openssl dgst -sha256 -hmac key
+			src:  `hmac_sign("message", "sha256", "key")`,
+			want: mustHex("6e9ef29b75fffc5b7abae527d58fdadb2fe42e7219011976917343065f58ed4a"),
+		},
+		{
+			src:  `b"message".hmac_sign("sha256", b"key") == hmac_sign("message", "sha256", "key")`,
+			want: true,
+		},
+		{
+			src:     `hmac_sign("message", "sha3", "key")`,
+			wantErr: "unsupported hash algorithm: sha3",
+		},
+		{
+			// echo -n message | sha1sum
+			src:  `"message".digest("sha1")`,
+			want: mustHex("6f9b9af3cd6e8b8a73c2cdced37fe9f59226e27d"),
+		},
+		{
+			src:  `decode_xml("<a x=\"1\"><b>one</b><b>two</b></a>")`,
+			want: map[string]interface{}{"a": map[string]interface{}{"x": "1", "b": []interface{}{"one", "two"}}},
+		},
+		{
+			src:     `decode_xml("<a>")`,
+			wantErr: "decode_xml:",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.src, func(t *testing.T) {
+			got, err := evalExt(t, test.src, nil)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("unexpected error: got:%v want:%q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !cmp.Equal(test.want, got) {
+				t.Errorf("unexpected result:\n%s", cmp.Diff(test.want, got))
+			}
+		})
+	}
+}
+
+func TestJWTRS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	got, err := evalExt(t, `jwt_rs256({"iss": "client", "exp": 1700000000}, {"kid": "k1"}, key)`,
+		map[string]interface{}{"key": string(keyPEM)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parts := strings.Split(got.(string), ".")
+	if len(parts) != 3 {
+		t.Fatalf("unexpected token: %s", got)
+	}
+
+	var header, claims map[string]interface{}
+	decodeSegment(t, parts[0], &header)
+	decodeSegment(t, parts[1], &claims)
+	wantHeader := map[string]interface{}{"alg": "RS256", "typ": "JWT", "kid": "k1"}
+	if !cmp.Equal(wantHeader, header) {
+		t.Errorf("unexpected header:\n%s", cmp.Diff(wantHeader, header))
+	}
+	wantClaims := map[string]interface{}{"iss": "client", "exp": 1700000000.0}
+	if !cmp.Equal(wantClaims, claims) {
+		t.Errorf("unexpected claims:\n%s", cmp.Diff(wantClaims, claims))
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, sum[:], sig); err != nil {
+		t.Errorf("invalid signature: %v", err)
+	}
+
+	_, err = evalExt(t, `jwt_rs256({"iss": "client"}, "not a key")`, nil)
+	if err == nil {
+		t.Error("expected error for invalid key")
+	}
+}
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func decodeSegment(t *testing.T, seg string, dst interface{}) {
+	t.Helper()
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, dst); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,7 +5,7 @@
 // Package cel implements an input that uses the Common Expression Language to
 // perform requests and do endpoint processing of events. The cel package exposes
 // the github.com/elastic/mito/lib and github.com/google/cel-go/ext CEL extension
-// libraries.
+// libraries, and functions for HMAC signing, JWT creation and XML decoding.
 package cel
 
 import (
@@ -43,6 +43,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/feature"
 	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
 	"github.com/elastic/beats/v7/libbeat/version"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/internal/httplimit"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/internal/httplog"
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/mapstr"
@@ -114,7 +115,7 @@ func (input) run(env v2.Context, src *source, cursor map[string]interface{}, pub
 		cfg.Resource.Tracer.Filename = strings.ReplaceAll(cfg.Resource.Tracer.Filename, "*", env.ID)
 	}
 
-	client, err := newClient(ctx, cfg, log)
+	client, err := newClient(ctx, cfg, nil, metrics, log)
 	if err != nil {
 		return err
 	}
@@ -186,7 +187,10 @@ func (input) run(env v2.Context, src *source, cursor map[string]interface{}, pub
 	// in requests.
 	err = periodically(ctx, cfg.Interval, func() error {
 		log.Info("process repeated request")
-		var waitUntil time.Time
+		var (
+			waitUntil  time.Time
+			executions int
+		)
 		for {
 			if wait := time.Until(waitUntil); wait > 0 {
 				// We have a special-case wait for when we have a zero limit.
@@ -208,6 +212,7 @@ func (input) run(env v2.Context, src *source, cursor map[string]interface{}, pub
 			// Process a set of event requests.
 			log.Debugw("request state", logp.Namespace("cel"), "state", redactor{state: state, mask: cfg.Redact.Fields, delete: cfg.Redact.Delete})
 			metrics.executions.Add(1)
+			executions++
 			start := time.Now()
 			state, err = evalWith(ctx, prg, state)
 			log.Debugw("response state", logp.Namespace("cel"), "state", redactor{state: state, mask: cfg.Redact.Fields, delete: cfg.Redact.Delete})
@@ -439,6 +444,13 @@ func (input) run(env v2.Context, src *source, cursor map[string]interface{}, pub
 			if more, _ := state["want_more"].(bool); !more {
 				return nil
 			}
+			if executions >= cfg.MaxExecutions {
+				// The state is kept, so the next interval continues
+				// from the last cursor.
+				log.Warnw("stopping executions until next interval: max_executions reached", "max_executions", cfg.MaxExecutions)
+				metrics.maxExecutionsReached.Add(1)
+				return nil
+			}
 		}
 	})
 	switch {
@@ -652,7 +664,10 @@ func getLimit(which string, rateLimit map[string]interface{}, log *logp.Logger)
 	return limit, true
 }
 
-func newClient(ctx context.Context, cfg config, log *logp.Logger) (*http.Client, error) {
+// newClient returns the HTTP client of the program. If replay is not nil, it
+// is used as the transport instead of making network requests. Guardrail
+// metrics are reported to metrics if it is not nil.
+func newClient(ctx context.Context, cfg config, replay http.RoundTripper, metrics *inputMetrics, log *logp.Logger) (*http.Client, error) {
 	if !wantClient(cfg) {
 		return nil, nil
 	}
@@ -660,6 +675,9 @@ func newClient(ctx context.Context, cfg config, log *logp.Logger) (*http.Client,
 	if err != nil {
 		return nil, err
 	}
+	if replay != nil {
+		c.Transport = replay
+	}
 
 	if cfg.Resource.Tracer != nil {
 		w := zapcore.AddSync(cfg.Resource.Tracer)
@@ -673,6 +691,17 @@ func newClient(ctx context.Context, cfg config, log *logp.Logger) (*http.Client,
 		c.Transport = httplog.NewLoggingRoundTripper(c.Transport, traceLogger)
 	}
 
+	var tooLarge, hostLimited *monitoring.Uint
+	if metrics != nil {
+		tooLarge, hostLimited = metrics.responsesTooLarge, metrics.hostRateLimited
+	}
+	if cfg.Resource.MaxBodySize > 0 {
+		c.Transport = httplimit.NewBodyLimiter(c.Transport, int64(cfg.Resource.MaxBodySize), tooLarge)
+	}
+	if l := cfg.Resource.HostRateLimit; l != nil {
+		c.Transport = httplimit.NewHostRateLimiter(c.Transport, rate.Limit(*l.Limit), l.burst(), hostLimited)
+	}
+
 	c.CheckRedirect = checkRedirect(cfg.Resource, log)
 
 	client := &retryablehttp.Client{
@@ -681,7 +710,7 @@ func newClient(ctx context.Context, cfg config, log *logp.Logger) (*http.Client,
 		RetryWaitMin: cfg.Resource.Retry.getWaitMin(),
 		RetryWaitMax: cfg.Resource.Retry.getWaitMax(),
 		RetryMax:     cfg.Resource.Retry.getMaxAttempts(),
-		CheckRetry:   retryablehttp.DefaultRetryPolicy,
+		CheckRetry:   httplimit.CheckRetry(retryablehttp.DefaultRetryPolicy),
 		Backoff:      retryablehttp.DefaultBackoff,
 	}
 
@@ -837,6 +866,7 @@ func newProgram(ctx context.Context, src, root string, client *http.Client, limi
 		lib.Globals(map[string]interface{}{
 			"useragent": userAgent,
 		}),
+		extensions(),
 	}
 	if client != nil {
 		opts = append(opts, lib.HTTPWithContext(ctx, client, limiter, auth))
@@ -938,6 +968,10 @@ type inputMetrics struct {
 	eventsPublished     *monitoring.Uint   // number of events published
 	celProcessingTime   metrics.Sample     // histogram of the elapsed successful cel program processing times in nanoseconds
 	batchProcessingTime metrics.Sample     // histogram of the elapsed successful batch processing times in nanoseconds (time of receipt to time of ACK for non-empty batches).
+
+	maxExecutionsReached *monitoring.Uint // number of intervals stopped by max_executions
+	responsesTooLarge    *monitoring.Uint // number of responses that exceeded max_body_size
+	hostRateLimited      *monitoring.Uint // number of requests delayed by host_rate_limit
 }
 
 func newInputMetrics(id string) *inputMetrics {
@@ -952,6 +986,10 @@ func newInputMetrics(id string) *inputMetrics {
 		eventsPublished:     monitoring.NewUint(reg, "events_published_total"),
 		celProcessingTime:   metrics.NewUniformSample(1024),
 		batchProcessingTime: metrics.NewUniformSample(1024),
+
+		maxExecutionsReached: monitoring.NewUint(reg, "max_executions_reached_total"),
+		responsesTooLarge:    monitoring.NewUint(reg, "responses_too_large_total"),
+		hostRateLimited:      monitoring.NewUint(reg, "host_rate_limited_total"),
 	}
 	_ = adapter.NewGoMetrics(reg, "cel_processing_time", adapter.Accept).
 		Register("histogram", metrics.NewHistogram(out.celProcessingTime))
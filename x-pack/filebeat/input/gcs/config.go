@@ -15,7 +15,8 @@ import (
 // BucketTimeOut - Defines the maximum time that the sdk will wait for a bucket api response before timing out.
 // ParseJSON - Informs the publisher whether to parse & objectify json data or not. By default this is set to
 // false, since it can get expensive dealing with highly nested json data.
-// MaxWorkers, Poll, PollInterval, BucketTimeOut, ParseJSON can be configured at a global level,
+// Decoding - Defines the decoder used to split objects into events, objects are read as JSON by default.
+// MaxWorkers, Poll, PollInterval, BucketTimeOut, ParseJSON, Decoding can be configured at a global level,
 // which applies to all buckets, as well as at the bucket level.
 // Bucket level configurations will always override global level values.
 type config struct {
@@ -26,19 +27,35 @@ type config struct {
 	PollInterval  *time.Duration `config:"poll_interval,omitempty"`
 	ParseJSON     *bool          `config:"parse_json,omitempty"`
 	BucketTimeOut *time.Duration `config:"bucket_timeout,omitempty"`
+	Decoding      *decoderConfig `config:"decoding,omitempty"`
 	Buckets       []bucket       `config:"buckets" validate:"required"`
 	// This field is only used for system test purposes, to override the HTTP endpoint.
 	AlternativeHost string `config:"alternative_host,omitempty"`
 }
 
-// bucket contains the config for each specific object storage bucket in the root account
+// bucket contains the config for each specific object storage bucket in the root account.
+// Prefix - Only lists the objects whose name starts with the prefix, the filtering is done server side.
+// Suffix - Only processes the objects whose name ends with the suffix.
+// Notification - Processes the objects announced by Pub/Sub notifications instead of polling the bucket.
 type bucket struct {
-	Name          string         `config:"name" validate:"required"`
-	MaxWorkers    *int           `config:"max_workers,omitempty" validate:"max=5000"`
-	BucketTimeOut *time.Duration `config:"bucket_timeout,omitempty"`
-	Poll          *bool          `config:"poll,omitempty"`
-	PollInterval  *time.Duration `config:"poll_interval,omitempty"`
-	ParseJSON     *bool          `config:"parse_json,omitempty"`
+	Name          string              `config:"name" validate:"required"`
+	MaxWorkers    *int                `config:"max_workers,omitempty" validate:"max=5000"`
+	BucketTimeOut *time.Duration      `config:"bucket_timeout,omitempty"`
+	Poll          *bool               `config:"poll,omitempty"`
+	PollInterval  *time.Duration      `config:"poll_interval,omitempty"`
+	ParseJSON     *bool               `config:"parse_json,omitempty"`
+	Decoding      *decoderConfig      `config:"decoding,omitempty"`
+	Prefix        string              `config:"prefix,omitempty"`
+	Suffix        string              `config:"suffix,omitempty"`
+	Notification  *notificationConfig `config:"notification,omitempty"`
+}
+
+// notificationConfig is the Pub/Sub subscription that receives the object change
+// notifications of the bucket. The notifications must be configured on the bucket,
+// see https://cloud.google.com/storage/docs/pubsub-notifications.
+type notificationConfig struct {
+	// Subscription is the ID of the subscription in the project of the input.
+	Subscription string `config:"subscription" validate:"required"`
 }
 
 type authConfig struct {
@@ -52,3 +69,11 @@ type fileCredentialsConfig struct {
 type jsonCredentialsConfig struct {
 	AccountKey string `config:"account_key"`
 }
+
+// subscription returns the Pub/Sub subscription of the bucket notifications, if any.
+func (b bucket) subscription() string {
+	if b.Notification == nil {
+		return ""
+	}
+	return b.Notification.Subscription
+}
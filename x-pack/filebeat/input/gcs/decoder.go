@@ -0,0 +1,214 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package gcs
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"github.com/elastic/beats/v7/x-pack/libbeat/reader/parquet"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// decoderConfig contains the configuration options for decoding objects,
+// objects are decoded as JSON when no codec is enabled.
+type decoderConfig struct {
+	Codec *codecConfig `config:"codec"`
+}
+
+// codecConfig contains the configuration options for the different codecs.
+type codecConfig struct {
+	CSV     *csvCodecConfig     `config:"csv"`
+	Parquet *parquetCodecConfig `config:"parquet"`
+}
+
+func (c *codecConfig) Validate() error {
+	if c.CSV != nil && c.CSV.Enabled && c.Parquet != nil && c.Parquet.Enabled {
+		return errors.New("only one codec can be enabled")
+	}
+	return nil
+}
+
+// csvCodecConfig contains the configuration options for the CSV codec.
+type csvCodecConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// Fields of CSV record, if empty the first record is used as the header.
+	FieldNames []string `config:"fields_names"`
+
+	Comma            *configRune `config:"comma"`
+	Comment          configRune  `config:"comment"`
+	LazyQuotes       bool        `config:"lazy_quotes"`
+	TrimLeadingSpace bool        `config:"trim_leading_space"`
+}
+
+// parquetCodecConfig contains the configuration options for the parquet codec.
+type parquetCodecConfig struct {
+	Enabled bool `config:"enabled"`
+}
+
+func (c *decoderConfig) csv() *csvCodecConfig {
+	if c == nil || c.Codec == nil || c.Codec.CSV == nil || !c.Codec.CSV.Enabled {
+		return nil
+	}
+	return c.Codec.CSV
+}
+
+func (c *decoderConfig) parquet() *parquetCodecConfig {
+	if c == nil || c.Codec == nil || c.Codec.Parquet == nil || !c.Codec.Parquet.Enabled {
+		return nil
+	}
+	return c.Codec.Parquet
+}
+
+type configRune rune
+
+func (r *configRune) Unpack(s string) error {
+	if s == "" {
+		return nil
+	}
+	n := utf8.RuneCountInString(s)
+	if n != 1 {
+		return fmt.Errorf("single character option given more than one character: %q", s)
+	}
+	_r, _ := utf8.DecodeRuneInString(s)
+	*r = configRune(_r)
+	return nil
+}
+
+// csvDecoder decodes CSV records into JSON objects keyed by the field names.
+type csvDecoder struct {
+	r      *csv.Reader
+	header []string
+
+	// record and offset are the next record and its offset in the stream.
+	record []string
+	offset int64
+	err    error
+}
+
+// newCSVDecoder returns a decoder reading from r. The header is read from the
+// stream unless it is set in the configuration.
+func newCSVDecoder(config *csvCodecConfig, r io.Reader) (*csvDecoder, error) {
+	cr := csv.NewReader(r)
+	// Records with a different number of fields than the header are reported by decode.
+	cr.FieldsPerRecord = -1
+	if config.Comma != nil {
+		cr.Comma = rune(*config.Comma)
+	}
+	cr.Comment = rune(config.Comment)
+	cr.LazyQuotes = config.LazyQuotes
+	cr.TrimLeadingSpace = config.TrimLeadingSpace
+
+	d := &csvDecoder{r: cr, header: config.FieldNames}
+	if len(d.header) == 0 {
+		header, err := cr.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("csv header is missing")
+			}
+			return nil, fmt.Errorf("failed to read csv header: %w", err)
+		}
+		d.header = header
+	}
+	d.advance()
+	return d, nil
+}
+
+func (d *csvDecoder) advance() {
+	d.offset = d.r.InputOffset()
+	d.record, d.err = d.r.Read()
+}
+
+// more returns whether there is another record to decode.
+func (d *csvDecoder) more() bool {
+	return !errors.Is(d.err, io.EOF)
+}
+
+// decode returns the next record as a JSON object and as a map, along with
+// the offset of the record in the stream.
+func (d *csvDecoder) decode() ([]byte, mapstr.M, int64, error) {
+	if d.err != nil {
+		return nil, nil, d.offset, d.err
+	}
+	if len(d.record) != len(d.header) {
+		return nil, nil, d.offset, fmt.Errorf("csv record at offset %d has %d fields, the header has %d", d.offset, len(d.record), len(d.header))
+	}
+	obj := make(mapstr.M, len(d.header))
+	for i, name := range d.header {
+		obj[name] = d.record[i]
+	}
+	offset := d.offset
+	d.advance()
+
+	msg, err := json.Marshal(obj)
+	if err != nil {
+		return nil, nil, offset, err
+	}
+	return msg, obj, offset, nil
+}
+
+// nextOffset returns the offset of the record following the last decoded one.
+func (d *csvDecoder) nextOffset() int64 {
+	return d.offset
+}
+
+// parquetDecoder decodes the rows of a parquet object into JSON objects. The
+// offset of a row is its index in the object.
+type parquetDecoder struct {
+	r      *parquet.Reader
+	rows   int64
+	offset int64
+}
+
+// newParquetDecoder returns a decoder reading from r, starting at the row
+// at offset. The metadata of parquet files is stored at their end, so the
+// object is read into memory.
+func newParquetDecoder(r io.Reader, offset int64) (*parquetDecoder, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := parquet.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	if err := pr.SkipRows(offset); err != nil {
+		return nil, err
+	}
+	return &parquetDecoder{r: pr, rows: pr.NumRows(), offset: offset}, nil
+}
+
+// more returns whether there is another row to decode.
+func (d *parquetDecoder) more() bool {
+	return d.offset < d.rows
+}
+
+// decode returns the next row as a JSON object and as a map, along with the
+// offset of the row.
+func (d *parquetDecoder) decode() ([]byte, mapstr.M, int64, error) {
+	offset := d.offset
+	obj, err := d.r.Next()
+	if err != nil {
+		return nil, nil, offset, fmt.Errorf("failed to read parquet row %d: %w", offset, err)
+	}
+	d.offset++
+
+	msg, err := json.Marshal(obj)
+	if err != nil {
+		return nil, nil, offset, err
+	}
+	return msg, obj, offset, nil
+}
+
+// nextOffset returns the offset of the row following the last decoded one.
+func (d *parquetDecoder) nextOffset() int64 {
+	return d.offset
+}
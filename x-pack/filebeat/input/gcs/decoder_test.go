@@ -0,0 +1,181 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestCSVCodecConfig(t *testing.T) {
+	cfg := conf.MustNewConfigFrom(map[string]interface{}{
+		"codec.csv.enabled": true,
+		"codec.csv.comma":   ";",
+		"codec.csv.comment": "#",
+	})
+	var decoding decoderConfig
+	require.NoError(t, cfg.Unpack(&decoding))
+	csvCfg := decoding.csv()
+	require.NotNil(t, csvCfg)
+	assert.Equal(t, configRune(';'), *csvCfg.Comma)
+	assert.Equal(t, configRune('#'), csvCfg.Comment)
+
+	cfg = conf.MustNewConfigFrom(map[string]interface{}{"codec.csv.comma": ";;"})
+	assert.Error(t, cfg.Unpack(&decoding))
+
+	assert.Nil(t, (*decoderConfig)(nil).csv())
+	assert.Nil(t, (&decoderConfig{Codec: &codecConfig{CSV: &csvCodecConfig{}}}).csv(), "codec is not enabled")
+}
+
+func TestParquetCodecConfig(t *testing.T) {
+	cfg := conf.MustNewConfigFrom(map[string]interface{}{"codec.parquet.enabled": true})
+	var decoding decoderConfig
+	require.NoError(t, cfg.Unpack(&decoding))
+	assert.NotNil(t, decoding.parquet())
+	assert.Nil(t, decoding.csv())
+
+	cfg = conf.MustNewConfigFrom(map[string]interface{}{
+		"codec.csv.enabled":     true,
+		"codec.parquet.enabled": true,
+	})
+	assert.ErrorContains(t, cfg.Unpack(&decoderConfig{}), "only one codec can be enabled")
+}
+
+func TestCSVDecoder(t *testing.T) {
+	const data = "name,count\n# comment\nfoo,1\n\"bar, baz\",2\n"
+	comment := configRune('#')
+
+	dec, err := newCSVDecoder(&csvCodecConfig{Enabled: true, Comment: comment}, strings.NewReader(data))
+	require.NoError(t, err)
+
+	var got []string
+	var offsets []int64
+	for dec.more() {
+		msg, obj, offset, err := dec.decode()
+		require.NoError(t, err)
+		assert.Equal(t, mapstr.M{"name": obj["name"], "count": obj["count"]}, obj)
+		got = append(got, string(msg))
+		offsets = append(offsets, offset)
+	}
+	assert.Equal(t, []string{`{"count":"1","name":"foo"}`, `{"count":"2","name":"bar, baz"}`}, got)
+	assert.Equal(t, []int64{11, 27}, offsets)
+
+	dec, err = newCSVDecoder(&csvCodecConfig{Enabled: true, FieldNames: []string{"a", "b"}}, strings.NewReader("1,2\n3\n"))
+	require.NoError(t, err)
+	_, obj, _, err := dec.decode()
+	require.NoError(t, err)
+	assert.Equal(t, mapstr.M{"a": "1", "b": "2"}, obj)
+	_, _, _, err = dec.decode()
+	assert.Error(t, err, "record with missing fields")
+
+	_, err = newCSVDecoder(&csvCodecConfig{Enabled: true}, strings.NewReader(""))
+	assert.Error(t, err, "missing header")
+}
+
+type publishedEvents struct {
+	events      []beat.Event
+	checkpoints []Checkpoint
+}
+
+func (p *publishedEvents) Publish(event beat.Event, cursor interface{}) error {
+	p.events = append(p.events, event)
+	cp := *cursor.(*Checkpoint)
+	cp.LastProcessedOffset = make(map[string]int64)
+	for k, v := range cursor.(*Checkpoint).LastProcessedOffset {
+		cp.LastProcessedOffset[k] = v
+	}
+	p.checkpoints = append(p.checkpoints, cp)
+	return nil
+}
+
+func TestReadCSVAndPublish(t *testing.T) {
+	const data = "name,count\nfoo,1\nbar,2\nbaz,3\n"
+	updated := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	src := &Source{BucketName: "bucket", CSV: &csvCodecConfig{Enabled: true}}
+	object := &storage.ObjectAttrs{Name: "data.csv", ContentType: csvType, Updated: updated}
+
+	pub := &publishedEvents{}
+	st := newState()
+	j := newJob(nil, object, "gs://bucket/data.csv", st, src, pub, logp.NewLogger("gcs_test"), false)
+	require.NoError(t, j.readCSVAndPublish(context.Background(), strings.NewReader(data), "job"))
+
+	require.Len(t, pub.events, 3)
+	msg, err := pub.events[0].Fields.GetValue("message")
+	require.NoError(t, err)
+	assert.Equal(t, `{"count":"1","name":"foo"}`, msg)
+	// partial offsets point to the next record, the last event saves the object.
+	assert.Equal(t, int64(17), pub.checkpoints[0].LastProcessedOffset["data.csv"])
+	assert.Equal(t, int64(23), pub.checkpoints[1].LastProcessedOffset["data.csv"])
+	assert.Empty(t, pub.checkpoints[2].LastProcessedOffset)
+	assert.Equal(t, "data.csv", pub.checkpoints[2].ObjectName)
+	assert.Equal(t, updated, pub.checkpoints[2].LatestEntryTime)
+
+	// resuming after a partial read skips the records already published.
+	pub = &publishedEvents{}
+	j = newJob(nil, object, "gs://bucket/data.csv", newState(), src, pub, logp.NewLogger("gcs_test"), false)
+	j.offset = 23
+	require.NoError(t, j.readCSVAndPublish(context.Background(), strings.NewReader(data), "job"))
+	require.Len(t, pub.events, 1)
+	offset, err := pub.events[0].Fields.GetValue("log.offset")
+	require.NoError(t, err)
+	assert.Equal(t, int64(23), offset)
+}
+
+func TestReadParquetAndPublish(t *testing.T) {
+	// data.parquet holds the rows {name: foo, count: 1}, {name: bar, count: 2}
+	// and {name: baz, count: 3}, in two row groups.
+	data, err := os.ReadFile("testdata/data.parquet")
+	require.NoError(t, err)
+	updated := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	src := &Source{BucketName: "bucket", ParseJSON: true, Parquet: &parquetCodecConfig{Enabled: true}}
+	object := &storage.ObjectAttrs{Name: "data.parquet", ContentType: parquetType, Updated: updated}
+
+	pub := &publishedEvents{}
+	j := newJob(nil, object, "gs://bucket/data.parquet", newState(), src, pub, logp.NewLogger("gcs_test"), false)
+	require.True(t, j.isAllowedContentType())
+	require.NoError(t, j.readParquetAndPublish(context.Background(), bytes.NewReader(data), "job"))
+
+	require.Len(t, pub.events, 3)
+	msg, err := pub.events[0].Fields.GetValue("message")
+	require.NoError(t, err)
+	assert.Equal(t, `{"count":1,"name":"foo"}`, msg)
+	parsed, err := pub.events[2].Fields.GetValue("gcs.storage.object.json_data")
+	require.NoError(t, err)
+	assert.Equal(t, []mapstr.M{{"name": "baz", "count": int64(3)}}, parsed)
+	// partial offsets point to the next row, the last event saves the object.
+	assert.Equal(t, int64(1), pub.checkpoints[0].LastProcessedOffset["data.parquet"])
+	assert.Equal(t, int64(2), pub.checkpoints[1].LastProcessedOffset["data.parquet"])
+	assert.Empty(t, pub.checkpoints[2].LastProcessedOffset)
+	assert.Equal(t, "data.parquet", pub.checkpoints[2].ObjectName)
+
+	// resuming after a partial read skips the rows already published.
+	pub = &publishedEvents{}
+	j = newJob(nil, object, "gs://bucket/data.parquet", newState(), src, pub, logp.NewLogger("gcs_test"), false)
+	j.offset = 2
+	require.NoError(t, j.readParquetAndPublish(context.Background(), bytes.NewReader(data), "job"))
+	require.Len(t, pub.events, 1)
+	offset, err := pub.events[0].Fields.GetValue("log.offset")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), offset)
+	msg, err = pub.events[0].Fields.GetValue("message")
+	require.NoError(t, err)
+	assert.Equal(t, `{"count":3,"name":"baz"}`, msg)
+
+	j = newJob(nil, object, "gs://bucket/data.parquet", newState(), &Source{BucketName: "bucket"}, pub, logp.NewLogger("gcs_test"), false)
+	assert.False(t, j.isAllowedContentType(), "parquet objects are only read by the parquet codec")
+}
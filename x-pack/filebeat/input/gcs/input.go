@@ -59,6 +59,11 @@ func configure(cfg *conf.C) ([]cursor.Source, cursor.Input, error) {
 			Poll:          *bucket.Poll,
 			PollInterval:  *bucket.PollInterval,
 			ParseJSON:     *bucket.ParseJSON,
+			Prefix:        bucket.Prefix,
+			Suffix:        bucket.Suffix,
+			Subscription:  bucket.subscription(),
+			CSV:           bucket.Decoding.csv(),
+			Parquet:       bucket.Decoding.parquet(),
 		})
 	}
 
@@ -101,6 +106,10 @@ func tryOverrideOrDefault(cfg config, b bucket) bucket {
 		b.ParseJSON = &parse
 	}
 
+	if b.Decoding == nil {
+		b.Decoding = cfg.Decoding
+	}
+
 	if b.BucketTimeOut == nil {
 		timeOut := time.Second * 50
 		if cfg.BucketTimeOut != nil {
@@ -156,7 +165,14 @@ func (input *gcsInput) Run(inputCtx v2.Context, src cursor.Source,
 		// Since we are only reading, the operation is always idempotent
 		storage.WithPolicy(storage.RetryAlways),
 	)
-	scheduler := newScheduler(ctx, publisher, bucket, currentSource, &input.config, st, log)
+	notifications, pubsubClient, err := fetchNotificationSource(ctx, input.config, currentSource)
+	if err != nil {
+		return err
+	}
+	if pubsubClient != nil {
+		defer pubsubClient.Close()
+	}
+	scheduler := newScheduler(ctx, publisher, bucket, currentSource, &input.config, st, log, notifications)
 
 	return scheduler.schedule()
 }
@@ -57,6 +57,11 @@ func (in *statelessInput) Run(inputCtx v2.Context, publisher stateless.Publisher
 			Poll:          *bucket.Poll,
 			PollInterval:  *bucket.PollInterval,
 			ParseJSON:     *bucket.ParseJSON,
+			Prefix:        bucket.Prefix,
+			Suffix:        bucket.Suffix,
+			Subscription:  bucket.subscription(),
+			CSV:           bucket.Decoding.csv(),
+			Parquet:       bucket.Decoding.parquet(),
 		}
 
 		st := newState()
@@ -79,7 +84,14 @@ func (in *statelessInput) Run(inputCtx v2.Context, publisher stateless.Publisher
 			storage.WithPolicy(storage.RetryAlways),
 		)
 
-		scheduler := newScheduler(ctx, pub, bkt, currentSource, &in.config, st, log)
+		notifications, pubsubClient, err := fetchNotificationSource(ctx, in.config, currentSource)
+		if err != nil {
+			return err
+		}
+		if pubsubClient != nil {
+			defer pubsubClient.Close()
+		}
+		scheduler := newScheduler(ctx, pub, bkt, currentSource, &in.config, st, log, notifications)
 
 		return scheduler.schedule()
 	}
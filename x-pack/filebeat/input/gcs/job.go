@@ -82,21 +82,23 @@ func gcsObjectHash(src *Source, object *storage.ObjectAttrs) string {
 	return hex.EncodeToString(h.Sum(nil)[:5])
 }
 
-func (j *job) do(ctx context.Context, id string) {
+// do processes the object and publishes its events. Errors are logged, the
+// returned error is used to tell whether the object must be retried.
+func (j *job) do(ctx context.Context, id string) error {
 	var fields mapstr.M
 
-	if allowedContentTypes[j.object.ContentType] {
+	if j.isAllowedContentType() {
 		if j.object.ContentType == gzType || j.object.ContentEncoding == encodingGzip {
 			j.isCompressed = true
 		}
-		if result, ok := j.state.cp.IsRootArray[j.object.Name]; ok {
+		if result, ok := j.state.rootArray(j.object.Name); ok {
 			j.isRootArray = result
 		}
 		err := j.processAndPublishData(ctx, id)
 		if err != nil {
 			j.state.updateFailedJobs(j.object.Name)
 			j.log.Errorw("job encountered an error", "gcs.jobId", id, "error", err)
-			return
+			return err
 		}
 
 	} else {
@@ -117,6 +119,20 @@ func (j *job) do(ctx context.Context, id string) {
 		}
 		j.mu.Unlock()
 	}
+	return nil
+}
+
+// isAllowedContentType returns whether the object can be read, CSV and parquet
+// objects are only read when their codec is enabled.
+func (j *job) isAllowedContentType() bool {
+	switch j.object.ContentType {
+	case csvType:
+		return j.src.CSV != nil
+	case parquetType, xParquetType:
+		return j.src.Parquet != nil
+	default:
+		return allowedContentTypes[j.object.ContentType]
+	}
 }
 
 func (j *job) Name() string {
@@ -137,9 +153,9 @@ func (j *job) processAndPublishData(ctx context.Context, id string) error {
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, j.src.BucketTimeOut)
 	defer cancel()
 	obj := j.bucket.Object(j.object.Name)
-	// if object is compressed or object root element is an array, then we cannot use an
-	// offset to read as it will produce an erroneous data stream.
-	if !j.isCompressed && !j.isRootArray {
+	// if object is compressed, object root element is an array or the object is decoded
+	// by a codec, then we cannot use an offset to read as it will produce an erroneous data stream.
+	if !j.isCompressed && !j.isRootArray && j.src.CSV == nil && j.src.Parquet == nil {
 		offset = j.offset
 	}
 	reader, err := obj.NewRangeReader(ctxWithTimeout, offset, -1)
@@ -153,7 +169,14 @@ func (j *job) processAndPublishData(ctx context.Context, id string) error {
 		}
 	}()
 
-	err = j.readJsonAndPublish(ctx, reader, id)
+	switch {
+	case j.src.CSV != nil:
+		err = j.readCSVAndPublish(ctx, reader, id)
+	case j.src.Parquet != nil:
+		err = j.readParquetAndPublish(ctx, reader, id)
+	default:
+		err = j.readJsonAndPublish(ctx, reader, id)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to read data from object: %s, with error: %w", j.object.Name, err)
 	}
@@ -233,6 +256,99 @@ func (j *job) readJsonAndPublish(ctx context.Context, r io.Reader, id string) er
 	return nil
 }
 
+// readCSVAndPublish publishes an event for each record of a CSV object. The
+// stream is always read from the start so the header can be read, records
+// before the saved offset are skipped.
+func (j *job) readCSVAndPublish(ctx context.Context, r io.Reader, id string) error {
+	r, err := j.addGzipDecoderIfNeeded(bufio.NewReader(r))
+	if err != nil {
+		return fmt.Errorf("failed to add gzip decoder to object: %s, with error: %w", j.object.Name, err)
+	}
+	dec, err := newCSVDecoder(j.src.CSV, r)
+	if err != nil {
+		return fmt.Errorf("failed to read csv object: %s, with error: %w", j.object.Name, err)
+	}
+
+	if !dec.more() {
+		// the object only has a header, there is nothing to publish
+		j.state.save(j.object.Name, j.object.Updated)
+		return nil
+	}
+	for dec.more() && ctx.Err() == nil {
+		msg, obj, offset, err := dec.decode()
+		if err != nil {
+			return fmt.Errorf("failed to decode csv: %w", err)
+		}
+		if offset < j.offset {
+			continue
+		}
+		var parsedData []mapstr.M
+		if j.src.ParseJSON {
+			parsedData = []mapstr.M{obj}
+		}
+		evt := j.createEvent(msg, parsedData, offset)
+		if !dec.more() {
+			// if this is the last record, then peform a complete state save
+			j.state.save(j.object.Name, j.object.Updated)
+		} else {
+			// partially saves read state using offset
+			j.state.savePartial(j.object.Name, dec.nextOffset())
+		}
+		// locks while data is being published to avoid concurrent map read/writes
+		j.mu.Lock()
+		if err := j.publisher.Publish(evt, j.state.checkpoint()); err != nil {
+			j.log.Errorw("job encountered an error", "gcs.jobId", id, "error", err)
+		}
+		j.mu.Unlock()
+	}
+	return nil
+}
+
+// readParquetAndPublish publishes an event for each row of a parquet object.
+// The offset of an event is the index of its row, rows before the saved offset
+// are skipped.
+func (j *job) readParquetAndPublish(ctx context.Context, r io.Reader, id string) error {
+	r, err := j.addGzipDecoderIfNeeded(bufio.NewReader(r))
+	if err != nil {
+		return fmt.Errorf("failed to add gzip decoder to object: %s, with error: %w", j.object.Name, err)
+	}
+	dec, err := newParquetDecoder(r, j.offset)
+	if err != nil {
+		return fmt.Errorf("failed to read parquet object: %s, with error: %w", j.object.Name, err)
+	}
+
+	if !dec.more() {
+		// the object has no rows left to publish
+		j.state.save(j.object.Name, j.object.Updated)
+		return nil
+	}
+	for dec.more() && ctx.Err() == nil {
+		msg, obj, offset, err := dec.decode()
+		if err != nil {
+			return fmt.Errorf("failed to decode parquet: %w", err)
+		}
+		var parsedData []mapstr.M
+		if j.src.ParseJSON {
+			parsedData = []mapstr.M{obj}
+		}
+		evt := j.createEvent(msg, parsedData, offset)
+		if !dec.more() {
+			// if this is the last row, then peform a complete state save
+			j.state.save(j.object.Name, j.object.Updated)
+		} else {
+			// partially saves read state using offset
+			j.state.savePartial(j.object.Name, dec.nextOffset())
+		}
+		// locks while data is being published to avoid concurrent map read/writes
+		j.mu.Lock()
+		if err := j.publisher.Publish(evt, j.state.checkpoint()); err != nil {
+			j.log.Errorw("job encountered an error", "gcs.jobId", id, "error", err)
+		}
+		j.mu.Unlock()
+	}
+	return nil
+}
+
 // addGzipDecoderIfNeeded determines whether the given stream of bytes (encapsulated in a buffered reader)
 // represents gzipped content or not and adds gzipped decoder if needed. A bufio.Reader is used
 // so the function can peek into the byte  stream without consuming it. This makes it convenient for
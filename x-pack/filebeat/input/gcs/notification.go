@@ -0,0 +1,125 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package gcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+)
+
+// Attributes and event type of the Pub/Sub notifications for Cloud Storage,
+// see https://cloud.google.com/storage/docs/pubsub-notifications#attributes.
+const (
+	notificationEventType = "eventType"
+	notificationBucketID  = "bucketId"
+	notificationObjectID  = "objectId"
+
+	eventObjectFinalize = "OBJECT_FINALIZE"
+)
+
+// notificationSource receives the Pub/Sub notifications of a bucket, it is
+// implemented by *pubsub.Subscription.
+type notificationSource interface {
+	Receive(ctx context.Context, f func(context.Context, *pubsub.Message)) error
+}
+
+// notification is the subset of a Pub/Sub message needed to process an object
+// notification, it is implemented by *pubsub.Message.
+type notification interface {
+	Ack()
+	Nack()
+}
+
+// fetchNotificationSource returns the subscription receiving the notifications of the
+// source, or nil if the source lists the bucket. The returned client must be closed
+// once done. The Pub/Sub emulator is used if the PUBSUB_EMULATOR_HOST environment
+// variable is set.
+func fetchNotificationSource(ctx context.Context, cfg config, src *Source) (notificationSource, *pubsub.Client, error) {
+	if src.Subscription == "" {
+		return nil, nil, nil
+	}
+	var opts []option.ClientOption
+	switch {
+	case cfg.Auth.CredentialsJSON != nil:
+		opts = append(opts, option.WithCredentialsJSON([]byte(cfg.Auth.CredentialsJSON.AccountKey)))
+	case cfg.Auth.CredentialsFile != nil:
+		opts = append(opts, option.WithCredentialsFile(cfg.Auth.CredentialsFile.Path))
+	default:
+		return nil, nil, errors.New("no valid auth specified")
+	}
+	client, err := pubsub.NewClient(ctx, cfg.ProjectId, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create pub/sub client: %w", err)
+	}
+	sub := client.Subscription(src.Subscription)
+	// each notification is processed by a worker
+	sub.ReceiveSettings.MaxOutstandingMessages = src.MaxWorkers
+	return sub, client, nil
+}
+
+// receive processes the objects announced by the notifications of the bucket until
+// the context is cancelled. Notifications are acknowledged once the object has been
+// processed, failed objects are retried when Pub/Sub redelivers the notification.
+func (s *scheduler) receive() error {
+	err := s.notifications.Receive(s.parentCtx, func(ctx context.Context, msg *pubsub.Message) {
+		s.handleNotification(ctx, msg, msg.Attributes)
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("failed to receive notifications for bucket %s: %w", s.src.BucketName, err)
+	}
+	return s.parentCtx.Err()
+}
+
+func (s *scheduler) handleNotification(ctx context.Context, msg notification, attrs map[string]string) {
+	name, ok := s.notifiedObject(attrs)
+	if !ok {
+		msg.Ack()
+		return
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, s.src.BucketTimeOut)
+	defer cancel()
+	obj, err := s.bucket.Object(name).Attrs(ctxWithTimeout)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			// the object was deleted since the notification was sent
+			msg.Ack()
+			return
+		}
+		s.log.Errorw("failed to get attributes of notified object", "object", name, "error", err)
+		msg.Nack()
+		return
+	}
+
+	objectURI := "gs://" + s.src.BucketName + "/" + obj.Name
+	job := newJob(s.bucket, obj, objectURI, s.state, s.src, s.publisher, s.log, false)
+	if offset, ok := s.state.partialOffset(obj.Name); ok {
+		job.offset = offset
+	}
+	if err := job.do(s.parentCtx, fetchJobID(0, s.src.BucketName, obj.Name)); err != nil {
+		msg.Nack()
+		return
+	}
+	msg.Ack()
+}
+
+// notifiedObject returns the name of the object a notification is about, if
+// the notification announces a new object of the bucket matching the filters.
+func (s *scheduler) notifiedObject(attrs map[string]string) (string, bool) {
+	if attrs[notificationEventType] != eventObjectFinalize || attrs[notificationBucketID] != s.src.BucketName {
+		return "", false
+	}
+	name := attrs[notificationObjectID]
+	if name == "" || strings.HasSuffix(name, "/") {
+		return "", false
+	}
+	return name, s.src.matches(name)
+}
@@ -0,0 +1,89 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package gcs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+type fakeNotification struct {
+	acked, nacked bool
+}
+
+func (n *fakeNotification) Ack()  { n.acked = true }
+func (n *fakeNotification) Nack() { n.nacked = true }
+
+func TestNotifiedObject(t *testing.T) {
+	s := &scheduler{
+		src: &Source{BucketName: "bucket", Prefix: "logs/", Suffix: ".json"},
+		log: logp.NewLogger("gcs_test"),
+	}
+	for _, test := range []struct {
+		name   string
+		attrs  map[string]string
+		object string
+		ok     bool
+	}{
+		{
+			name:   "new object",
+			attrs:  map[string]string{"eventType": "OBJECT_FINALIZE", "bucketId": "bucket", "objectId": "logs/a.json"},
+			object: "logs/a.json",
+			ok:     true,
+		},
+		{
+			name:  "deleted object",
+			attrs: map[string]string{"eventType": "OBJECT_DELETE", "bucketId": "bucket", "objectId": "logs/a.json"},
+		},
+		{
+			name:  "other bucket",
+			attrs: map[string]string{"eventType": "OBJECT_FINALIZE", "bucketId": "other", "objectId": "logs/a.json"},
+		},
+		{
+			name:   "prefix mismatch",
+			attrs:  map[string]string{"eventType": "OBJECT_FINALIZE", "bucketId": "bucket", "objectId": "audit/a.json"},
+			object: "audit/a.json",
+		},
+		{
+			name:   "suffix mismatch",
+			attrs:  map[string]string{"eventType": "OBJECT_FINALIZE", "bucketId": "bucket", "objectId": "logs/a.csv"},
+			object: "logs/a.csv",
+		},
+		{
+			name:  "folder",
+			attrs: map[string]string{"eventType": "OBJECT_FINALIZE", "bucketId": "bucket", "objectId": "logs/"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			object, ok := s.notifiedObject(test.attrs)
+			assert.Equal(t, test.ok, ok)
+			if ok {
+				assert.Equal(t, test.object, object)
+			}
+
+			if !ok {
+				// notifications of objects that are not collected are acknowledged.
+				msg := &fakeNotification{}
+				s.handleNotification(context.Background(), msg, test.attrs)
+				assert.True(t, msg.acked)
+				assert.False(t, msg.nacked)
+			}
+		})
+	}
+}
+
+func TestSourceMatches(t *testing.T) {
+	src := &Source{}
+	assert.True(t, src.matches("any/object"))
+
+	src = &Source{Prefix: "logs/", Suffix: ".csv.gz"}
+	assert.True(t, src.matches("logs/2023/04/01.csv.gz"))
+	assert.False(t, src.matches("logs/2023/04/01.csv"))
+	assert.False(t, src.matches("other/01.csv.gz"))
+}
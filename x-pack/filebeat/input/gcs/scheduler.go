@@ -34,26 +34,34 @@ type scheduler struct {
 	state     *state
 	log       *logp.Logger
 	limiter   *limiter
+	// notifications is set when objects are processed as they are announced
+	// by Pub/Sub notifications instead of listing the bucket.
+	notifications notificationSource
 }
 
 // newScheduler, returns a new scheduler instance
 func newScheduler(ctx context.Context, publisher cursor.Publisher, bucket *storage.BucketHandle, src *Source, cfg *config,
-	state *state, log *logp.Logger,
+	state *state, log *logp.Logger, notifications notificationSource,
 ) *scheduler {
 	return &scheduler{
-		parentCtx: ctx,
-		publisher: publisher,
-		bucket:    bucket,
-		src:       src,
-		cfg:       cfg,
-		state:     state,
-		log:       log,
-		limiter:   &limiter{limit: make(chan struct{}, src.MaxWorkers)},
+		parentCtx:     ctx,
+		publisher:     publisher,
+		bucket:        bucket,
+		src:           src,
+		cfg:           cfg,
+		state:         state,
+		log:           log,
+		limiter:       &limiter{limit: make(chan struct{}, src.MaxWorkers)},
+		notifications: notifications,
 	}
 }
 
 // Schedule, is responsible for fetching & scheduling jobs using the workerpool model
 func (s *scheduler) schedule() error {
+	if s.notifications != nil {
+		return s.receive()
+	}
+
 	if !s.src.Poll {
 		ctxWithTimeout, cancel := context.WithTimeout(s.parentCtx, s.src.BucketTimeOut)
 		defer cancel()
@@ -118,7 +126,7 @@ func (s *scheduler) scheduleOnce(ctxWithTimeout context.Context) error {
 			s.limiter.acquire()
 			go func() {
 				defer s.limiter.release()
-				job.do(s.parentCtx, id)
+				_ = job.do(s.parentCtx, id)
 			}()
 		}
 
@@ -148,6 +156,9 @@ func (s *scheduler) createJobs(objects []*storage.ObjectAttrs, log *logp.Logger)
 		if len(file) > 1 && file[len(file)-1] == "" {
 			continue
 		}
+		if !s.src.matches(obj.Name) {
+			continue
+		}
 
 		objectURI := "gs://" + s.src.BucketName + "/" + obj.Name
 		job := newJob(s.bucket, obj, objectURI, s.state, s.src, s.publisher, log, false)
@@ -160,7 +171,7 @@ func (s *scheduler) createJobs(objects []*storage.ObjectAttrs, log *logp.Logger)
 // fetchObjectPager fetches the page handler for objects, given a batch size.
 // [NOTE] : There are no api's / sdk functions that list blobs via timestamp/latest entry, it's always lexicographical order
 func (s *scheduler) fetchObjectPager(ctx context.Context, pageSize int) *iterator.Pager {
-	bktIt := s.bucket.Objects(ctx, &storage.Query{})
+	bktIt := s.bucket.Objects(ctx, &storage.Query{Prefix: s.src.Prefix})
 	pager := iterator.NewPager(bktIt, pageSize, "")
 
 	return pager
@@ -92,6 +92,7 @@ func (s *state) savePartial(name string, offset int64) {
 // entry is removed from the map
 func (s *state) updateFailedJobs(jobName string) {
 	s.mu.Lock()
+	defer s.mu.Unlock()
 	// we do not store partially processed jobs as failed jobs
 	if _, ok := s.cp.LastProcessedOffset[jobName]; ok {
 		return
@@ -100,7 +101,22 @@ func (s *state) updateFailedJobs(jobName string) {
 	if s.cp.FailedJobs[jobName] > maxFailedJobRetries {
 		delete(s.cp.FailedJobs, jobName)
 	}
+}
+
+// rootArray, returns whether the object was found to have an array as its root element
+func (s *state) rootArray(name string) (isRootArray, ok bool) {
+	s.mu.Lock()
+	isRootArray, ok = s.cp.IsRootArray[name]
+	s.mu.Unlock()
+	return isRootArray, ok
+}
+
+// partialOffset, returns the offset to resume a partially processed object from
+func (s *state) partialOffset(name string) (offset int64, ok bool) {
+	s.mu.Lock()
+	offset, ok = s.cp.LastProcessedOffset[name]
 	s.mu.Unlock()
+	return offset, ok
 }
 
 // setCheckpoint, sets checkpoint from source to current state instance
@@ -6,6 +6,7 @@
 package gcs
 
 import (
+	"strings"
 	"time"
 )
 
@@ -18,17 +19,30 @@ type Source struct {
 	Poll          bool
 	PollInterval  time.Duration
 	ParseJSON     bool
+	Prefix        string
+	Suffix        string
+	Subscription  string
+	CSV           *csvCodecConfig
+	Parquet       *parquetCodecConfig
 }
 
 func (s *Source) Name() string {
 	return s.ProjectId + "::" + s.BucketName
 }
 
+// matches returns whether the object name matches the prefix and suffix filters of the source.
+func (s *Source) matches(name string) bool {
+	return strings.HasPrefix(name, s.Prefix) && strings.HasSuffix(name, s.Suffix)
+}
+
 const (
 	jsonType     = "application/json"
 	octetType    = "application/octet-stream"
 	ndJsonType   = "application/x-ndjson"
 	gzType       = "application/x-gzip"
+	csvType      = "text/csv"
+	parquetType  = "application/vnd.apache.parquet"
+	xParquetType = "application/x-parquet"
 	encodingGzip = "gzip"
 )
 
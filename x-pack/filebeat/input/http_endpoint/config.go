@@ -33,6 +33,7 @@ type config struct {
 	HMACPrefix            string                  `config:"hmac.prefix"`
 	IncludeHeaders        []string                `config:"include_headers"`
 	PreserveOriginalEvent bool                    `config:"preserve_original_event"`
+	TraceContext          bool                    `config:"trace_context"`
 }
 
 func defaultConfig() config {
@@ -53,6 +54,7 @@ func defaultConfig() config {
 		HMACKey:       "",
 		HMACType:      "",
 		HMACPrefix:    "",
+		TraceContext:  true,
 	}
 }
 
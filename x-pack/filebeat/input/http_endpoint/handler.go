@@ -17,6 +17,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/jsontransform"
+	"github.com/elastic/beats/v7/libbeat/common/tracecontext"
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/mapstr"
 )
@@ -37,6 +38,7 @@ type httpHandler struct {
 	responseBody          string
 	includeHeaders        []string
 	preserveOriginalEvent bool
+	traceContext          bool
 }
 
 // Triggers if middleware validation returns successful
@@ -59,8 +61,13 @@ func (h *httpHandler) apiResponse(w http.ResponseWriter, r *http.Request) {
 		headers = getIncludedHeaders(r, h.includeHeaders)
 	}
 
+	var trace mapstr.M
+	if h.traceContext {
+		trace = getTraceContext(r, h.log)
+	}
+
 	for _, obj := range objs {
-		if err = h.publishEvent(obj, headers); err != nil {
+		if err = h.publishEvent(obj, headers, trace); err != nil {
 			sendAPIErrorResponse(w, r, h.log, http.StatusInternalServerError, err)
 			return
 		}
@@ -77,7 +84,7 @@ func (h *httpHandler) sendResponse(w http.ResponseWriter, status int, message st
 	}
 }
 
-func (h *httpHandler) publishEvent(obj, headers mapstr.M) error {
+func (h *httpHandler) publishEvent(obj, headers, trace mapstr.M) error {
 	event := beat.Event{
 		Timestamp: time.Now().UTC(),
 		Fields:    mapstr.M{},
@@ -90,6 +97,9 @@ func (h *httpHandler) publishEvent(obj, headers mapstr.M) error {
 	if len(headers) > 0 {
 		event.Fields["headers"] = headers
 	}
+	if len(trace) > 0 {
+		event.Fields.DeepUpdate(trace.Clone())
+	}
 
 	if _, err := event.PutValue(h.messageField, obj); err != nil {
 		return fmt.Errorf("failed to put data into event key %q: %w", h.messageField, err)
@@ -192,6 +202,21 @@ func getIncludedHeaders(r *http.Request, headerConf []string) (includedHeaders m
 	return includedHeaders
 }
 
+// getTraceContext returns the trace.id and span.id fields of the W3C trace
+// context sent in the traceparent header of the request, if any.
+func getTraceContext(r *http.Request, log *logp.Logger) mapstr.M {
+	value := r.Header.Get(tracecontext.Header)
+	if value == "" {
+		return nil
+	}
+	tc, err := tracecontext.Parse(value)
+	if err != nil {
+		log.Debugw("Ignoring invalid traceparent header.", "error", err)
+		return nil
+	}
+	return tc.Fields()
+}
+
 func newJSONDecoder(r io.Reader) *json.Decoder {
 	dec := json.NewDecoder(r)
 	dec.UseNumber()
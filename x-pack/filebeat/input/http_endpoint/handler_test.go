@@ -230,6 +230,44 @@ func Test_apiResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "single event with trace context",
+			request: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"id":0}`))
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set("Traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+				return req
+			}(),
+			events: []mapstr.M{
+				{
+					"json": mapstr.M{
+						"id": int64(0),
+					},
+					"trace": mapstr.M{
+						"id": "4bf92f3577b34da6a3ce929d0e0e4736",
+					},
+					"span": mapstr.M{
+						"id": "00f067aa0ba902b7",
+					},
+				},
+			},
+		},
+		{
+			name: "single event with invalid trace context",
+			request: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"id":0}`))
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set("Traceparent", "00-00000000000000000000000000000000-00f067aa0ba902b7-01")
+				return req
+			}(),
+			events: []mapstr.M{
+				{
+					"json": mapstr.M{
+						"id": int64(0),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
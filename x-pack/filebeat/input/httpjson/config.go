@@ -10,6 +10,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dustin/go-humanize"
+
 	"github.com/elastic/elastic-agent-libs/transport/httpcommon"
 )
 
@@ -71,8 +73,11 @@ func defaultConfig() config {
 			},
 			RedirectForwardHeaders: false,
 			RedirectMaxRedirects:   10,
+			MaxBodySize:            100 * humanize.MiByte,
 			Transport:              transport,
 		},
-		Response: &responseConfig{},
+		Response: &responseConfig{
+			MaxPages: 1000,
+		},
 	}
 }
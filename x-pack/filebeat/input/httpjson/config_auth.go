@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
@@ -89,6 +90,14 @@ type oAuth2Config struct {
 	TokenURL       string              `config:"token_url"`
 	User           string              `config:"user"`
 
+	// client credentials token handling
+	Audience      string              `config:"audience"`
+	AuthStyle     string              `config:"auth_style"`
+	RefreshBefore time.Duration       `config:"refresh_before"`
+	TokenRequest  oAuth2TokenRequest  `config:"token_request"`
+	TokenResponse oAuth2TokenResponse `config:"token_response"`
+	Inject        oAuth2Inject        `config:"inject"`
+
 	// google specific
 	GoogleCredentialsFile  string          `config:"google.credentials_file"`
 	GoogleCredentialsJSON  common.JSONBlob `config:"google.credentials_json"`
@@ -108,15 +117,46 @@ func (o *oAuth2Config) isEnabled() bool {
 
 // clientCredentialsGrant creates http client from token_url and client credentials
 // held by the receiver.
-func (o *oAuth2Config) clientCredentialsGrant(ctx context.Context, _ *http.Client) *http.Client {
-	creds := clientcredentials.Config{
-		ClientID:       o.ClientID,
-		ClientSecret:   maybeString(o.ClientSecret),
-		TokenURL:       o.getTokenURL(),
-		Scopes:         o.Scopes,
-		EndpointParams: o.getEndpointParams(),
+// Tokens are cached and refreshed refresh_before ahead of their expiry, and are
+// added to the requests as configured by inject.
+func (o *oAuth2Config) clientCredentialsGrant(ctx context.Context, client *http.Client) *http.Client {
+	authStyle, _ := oAuth2AuthStyle(o.AuthStyle) // Checked by Validate.
+
+	var src oauth2.TokenSource
+	if o.TokenRequest.EncodeAs == "json" || o.TokenResponse.isCustom() {
+		src = &tokenEndpointSource{
+			ctx:      ctx,
+			client:   client,
+			tokenURL: o.getTokenURL(),
+			id:       o.ClientID,
+			secret:   maybeString(o.ClientSecret),
+			inHeader: authStyle == oauth2.AuthStyleInHeader,
+			scopes:   o.Scopes,
+			params:   o.getEndpointParams(),
+			asJSON:   o.TokenRequest.EncodeAs == "json",
+			response: o.TokenResponse,
+		}
+	} else {
+		creds := clientcredentials.Config{
+			ClientID:       o.ClientID,
+			ClientSecret:   maybeString(o.ClientSecret),
+			TokenURL:       o.getTokenURL(),
+			Scopes:         o.Scopes,
+			EndpointParams: o.getEndpointParams(),
+			AuthStyle:      authStyle,
+		}
+		// Fetch a new token on each call, the caching is done by
+		// the refreshing token source.
+		src = tokenSourceFunc(func() (*oauth2.Token, error) { return creds.Token(ctx) })
+	}
+
+	return &http.Client{
+		Transport: &oAuth2Transport{
+			base:   client.Transport,
+			source: newRefreshingTokenSource(src, o.RefreshBefore),
+			inject: o.Inject,
+		},
 	}
-	return creds.Client(ctx)
 }
 
 // Client wraps the given http.Client and returns a new one that will use the oauth authentication.
@@ -201,6 +241,15 @@ func (o oAuth2Config) getEndpointParams() map[string][]string {
 		}
 	}
 
+	if o.Audience != "" {
+		params := make(map[string][]string, len(o.EndpointParams)+1)
+		for k, v := range o.EndpointParams {
+			params[k] = v
+		}
+		params["audience"] = []string{o.Audience}
+		return params
+	}
+
 	return o.EndpointParams
 }
 
@@ -210,6 +259,13 @@ func (o *oAuth2Config) Validate() error {
 		return nil
 	}
 
+	if _, err := oAuth2AuthStyle(o.AuthStyle); err != nil {
+		return err
+	}
+	if o.RefreshBefore < 0 {
+		return errors.New("refresh_before must not be negative")
+	}
+
 	switch o.getProvider() {
 	case oAuth2ProviderAzure:
 		return o.validateAzureProvider()
@@ -234,7 +290,8 @@ var findDefaultGoogleCredentials = google.FindDefaultCredentials
 
 func (o *oAuth2Config) validateGoogleProvider() error {
 	if o.TokenURL != "" || o.ClientID != "" || o.ClientSecret != nil ||
-		o.AzureTenantID != "" || o.AzureResource != "" || len(o.EndpointParams) != 0 {
+		o.AzureTenantID != "" || o.AzureResource != "" || len(o.EndpointParams) != 0 ||
+		o.Audience != "" || o.TokenRequest.EncodeAs != "" || o.TokenResponse.isCustom() {
 		return errors.New("none of token_url and client credentials can be used, use google.credentials_file, google.jwt_file, google.credentials_json or ADC instead")
 	}
 
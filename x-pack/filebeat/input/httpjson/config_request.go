@@ -14,6 +14,7 @@ import (
 
 	"gopkg.in/natefinch/lumberjack.v2"
 
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
 	"github.com/elastic/elastic-agent-libs/mapstr"
 	"github.com/elastic/elastic-agent-libs/transport/httpcommon"
 )
@@ -74,6 +75,28 @@ func (c rateLimitConfig) Validate() error {
 	return nil
 }
 
+type hostRateLimitConfig struct {
+	Limit *float64 `config:"limit" validate:"required"`
+	Burst *int     `config:"burst"`
+}
+
+func (c hostRateLimitConfig) Validate() error {
+	if c.Limit != nil && *c.Limit <= 0 {
+		return errors.New("limit must be greater than 0")
+	}
+	if c.Burst != nil && *c.Burst <= 0 {
+		return errors.New("burst must be greater than 0")
+	}
+	return nil
+}
+
+func (c hostRateLimitConfig) burst() int {
+	if c.Burst == nil {
+		return 1
+	}
+	return *c.Burst
+}
+
 type keepAlive struct {
 	Disable             *bool         `config:"disable"`
 	MaxIdleConns        int           `config:"max_idle_connections"`
@@ -134,6 +157,9 @@ type requestConfig struct {
 	KeepAlive              keepAlive        `config:"keep_alive"`
 	Transforms             transformsConfig `config:"transforms"`
 
+	MaxBodySize   cfgtype.ByteSize     `config:"max_body_size"`
+	HostRateLimit *hostRateLimitConfig `config:"host_rate_limit"`
+
 	Transport httpcommon.HTTPTransportSettings `config:",inline"`
 
 	Tracer *lumberjack.Logger `config:"tracer"`
@@ -155,6 +181,10 @@ func (c *requestConfig) Validate() error {
 		return err
 	}
 
+	if c.MaxBodySize < 0 {
+		return errors.New("max_body_size must not be negative")
+	}
+
 	if c.EncodeAs != "" {
 		if _, found := registeredEncoders[c.EncodeAs]; !found {
 			return fmt.Errorf("encoder not found for contentType: %v", c.EncodeAs)
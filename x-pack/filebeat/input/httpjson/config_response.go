@@ -5,6 +5,7 @@
 package httpjson
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -22,6 +23,7 @@ type responseConfig struct {
 	Pagination              transformsConfig `config:"pagination"`
 	Split                   *splitConfig     `config:"split"`
 	SaveFirstResponse       bool             `config:"save_first_response"`
+	MaxPages                int              `config:"max_pages"`
 }
 
 type splitConfig struct {
@@ -36,6 +38,9 @@ type splitConfig struct {
 }
 
 func (c *responseConfig) Validate() error {
+	if c.MaxPages < 0 {
+		return errors.New("max_pages must not be negative")
+	}
 	if _, err := newBasicTransformsFromConfig(c.Transforms, responseNamespace, nil); err != nil {
 		return err
 	}
@@ -18,15 +18,18 @@ import (
 	"go.elastic.co/ecszap"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"golang.org/x/time/rate"
 
 	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
 	inputcursor "github.com/elastic/beats/v7/filebeat/input/v2/input-cursor"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/feature"
 	"github.com/elastic/beats/v7/libbeat/version"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/internal/httplimit"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/internal/httplog"
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
 	"github.com/elastic/elastic-agent-libs/transport"
 	"github.com/elastic/elastic-agent-libs/transport/httpcommon"
 	"github.com/elastic/elastic-agent-libs/useragent"
@@ -116,7 +119,10 @@ func run(
 		config.Request.Tracer.Filename = strings.ReplaceAll(config.Request.Tracer.Filename, "*", ctx.ID)
 	}
 
-	httpClient, err := newHTTPClient(stdCtx, config, log)
+	metrics := newInputMetrics(ctx.ID)
+	defer metrics.Close()
+
+	httpClient, err := newHTTPClient(stdCtx, config, metrics, log)
 	if err != nil {
 		return err
 	}
@@ -126,7 +132,7 @@ func run(
 		log.Errorf("Error while creating requestFactory: %v", err)
 		return err
 	}
-	pagination := newPagination(config, httpClient, log)
+	pagination := newPagination(config, httpClient, metrics, log)
 	responseProcessor := newResponseProcessor(config, pagination, log)
 	requester := newRequester(httpClient, requestFactory, responseProcessor, log)
 
@@ -159,7 +165,7 @@ func run(
 	return nil
 }
 
-func newHTTPClient(ctx context.Context, config config, log *logp.Logger) (*httpClient, error) {
+func newHTTPClient(ctx context.Context, config config, metrics *inputMetrics, log *logp.Logger) (*httpClient, error) {
 	// Make retryable HTTP client
 	netHTTPClient, err := config.Request.Transport.Client(clientOptions(config.Request.URL.URL, config.Request.KeepAlive.settings())...)
 	if err != nil {
@@ -178,6 +184,17 @@ func newHTTPClient(ctx context.Context, config config, log *logp.Logger) (*httpC
 		netHTTPClient.Transport = httplog.NewLoggingRoundTripper(netHTTPClient.Transport, traceLogger)
 	}
 
+	var tooLarge, hostLimited *monitoring.Uint
+	if metrics != nil {
+		tooLarge, hostLimited = metrics.responsesTooLarge, metrics.hostRateLimited
+	}
+	if config.Request.MaxBodySize > 0 {
+		netHTTPClient.Transport = httplimit.NewBodyLimiter(netHTTPClient.Transport, int64(config.Request.MaxBodySize), tooLarge)
+	}
+	if l := config.Request.HostRateLimit; l != nil {
+		netHTTPClient.Transport = httplimit.NewHostRateLimiter(netHTTPClient.Transport, rate.Limit(*l.Limit), l.burst(), hostLimited)
+	}
+
 	netHTTPClient.CheckRedirect = checkRedirect(config.Request, log)
 
 	client := &retryablehttp.Client{
@@ -186,7 +203,7 @@ func newHTTPClient(ctx context.Context, config config, log *logp.Logger) (*httpC
 		RetryWaitMin: config.Request.Retry.getWaitMin(),
 		RetryWaitMax: config.Request.Retry.getWaitMax(),
 		RetryMax:     config.Request.Retry.getMaxAttempts(),
-		CheckRetry:   retryablehttp.DefaultRetryPolicy,
+		CheckRetry:   httplimit.CheckRetry(retryablehttp.DefaultRetryPolicy),
 		Backoff:      retryablehttp.DefaultBackoff,
 	}
 
@@ -11,6 +11,7 @@ import (
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
@@ -332,6 +333,35 @@ func TestInput(t *testing.T) {
 				`{"foo":"a","page":"0"}`, `{"foo":"b","page":"1"}`, `{"foo":"c","page":"0"}`, `{"foo":"d","page":"0"}`,
 			},
 		},
+		{
+			name: "Test pagination stops at max_pages",
+			setupServer: func(t *testing.T, h http.HandlerFunc, config map[string]interface{}) {
+				registerPaginationTransforms()
+				t.Cleanup(func() { registeredTransforms = newRegistry() })
+				server := httptest.NewServer(h)
+				config["request.url"] = server.URL
+				t.Cleanup(server.Close)
+			},
+			baseConfig: map[string]interface{}{
+				"interval":           time.Millisecond,
+				"request.method":     http.MethodGet,
+				"response.max_pages": 2,
+				"response.pagination": []interface{}{
+					map[string]interface{}{
+						"set": map[string]interface{}{
+							"target":                 "url.params.page",
+							"value":                  "[[.last_response.body.next_page]]",
+							"fail_on_template_error": true,
+						},
+					},
+				},
+			},
+			handler: endlessPaginationHandler(),
+			expected: []string{
+				`{"page":"0","next_page":"1"}`, `{"page":"1","next_page":"2"}`,
+				`{"page":"0","next_page":"1"}`, `{"page":"1","next_page":"2"}`,
+			},
+		},
 		{
 			name: "Test first event",
 			setupServer: func(t *testing.T, h http.HandlerFunc, config map[string]interface{}) {
@@ -1426,6 +1456,18 @@ func paginationHandler() http.HandlerFunc {
 	}
 }
 
+func endlessPaginationHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "0"
+		}
+		n, _ := strconv.Atoi(page)
+		_, _ = fmt.Fprintf(w, `{"page":"%d","next_page":"%d"}`, n, n+1)
+	}
+}
+
 func paginationArrayHandler() http.HandlerFunc {
 	var count int
 	return func(w http.ResponseWriter, r *http.Request) {
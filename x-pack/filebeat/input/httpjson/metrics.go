@@ -0,0 +1,36 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httpjson
+
+import (
+	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// inputMetrics handles the input's metric reporting.
+type inputMetrics struct {
+	unregister func()
+
+	maxPagesReached   *monitoring.Uint // number of intervals stopped by max_pages
+	responsesTooLarge *monitoring.Uint // number of responses that exceeded max_body_size
+	hostRateLimited   *monitoring.Uint // number of requests delayed by host_rate_limit
+}
+
+func newInputMetrics(id string) *inputMetrics {
+	reg, unreg := inputmon.NewInputRegistry(inputName, id, nil)
+	return &inputMetrics{
+		unregister:        unreg,
+		maxPagesReached:   monitoring.NewUint(reg, "max_pages_reached_total"),
+		responsesTooLarge: monitoring.NewUint(reg, "responses_too_large_total"),
+		hostRateLimited:   monitoring.NewUint(reg, "host_rate_limited_total"),
+	}
+}
+
+func (m *inputMetrics) Close() {
+	if m == nil {
+		return
+	}
+	m.unregister()
+}
@@ -0,0 +1,313 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httpjson
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// defaultOAuth2RefreshBefore matches the expiry delta used by the oauth2 package.
+const defaultOAuth2RefreshBefore = 10 * time.Second
+
+// oAuth2TokenRequest configures how the client credentials are sent to a token endpoint.
+type oAuth2TokenRequest struct {
+	// EncodeAs is the body encoding of the token request, form (default) or json.
+	EncodeAs string `config:"encode_as"`
+}
+
+func (r oAuth2TokenRequest) Validate() error {
+	switch r.EncodeAs {
+	case "", "form", "json":
+		return nil
+	default:
+		return fmt.Errorf("unsupported token request encoding %q", r.EncodeAs)
+	}
+}
+
+// oAuth2TokenResponse names the fields holding the token in the responses of
+// token endpoints that do not follow RFC 6749. Dotted paths are supported.
+type oAuth2TokenResponse struct {
+	AccessTokenField string `config:"access_token_field"`
+	TokenTypeField   string `config:"token_type_field"`
+	ExpiresInField   string `config:"expires_in_field"`
+}
+
+func (r oAuth2TokenResponse) isCustom() bool {
+	return r.AccessTokenField != "" || r.TokenTypeField != "" || r.ExpiresInField != ""
+}
+
+// oAuth2Inject configures how the access token is added to the requests.
+type oAuth2Inject struct {
+	Header     string  `config:"header"`
+	Prefix     *string `config:"prefix"`
+	QueryParam string  `config:"query_param"`
+}
+
+func (i oAuth2Inject) Validate() error {
+	if i.QueryParam != "" && (i.Header != "" || i.Prefix != nil) {
+		return errors.New("only one of header and query_param can be used to inject the token")
+	}
+	return nil
+}
+
+// oAuth2AuthStyle returns the oauth2 style used to send the client credentials.
+func oAuth2AuthStyle(style string) (oauth2.AuthStyle, error) {
+	switch style {
+	case "", "auto":
+		return oauth2.AuthStyleAutoDetect, nil
+	case "header":
+		return oauth2.AuthStyleInHeader, nil
+	case "params":
+		return oauth2.AuthStyleInParams, nil
+	default:
+		return 0, fmt.Errorf("unsupported auth_style %q", style)
+	}
+}
+
+// tokenSourceFunc adapts a function to an oauth2.TokenSource.
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) { return f() }
+
+// tokenEndpointSource fetches client credentials tokens from token endpoints
+// that need a JSON encoded request or answer with non-standard fields.
+type tokenEndpointSource struct {
+	ctx      context.Context
+	client   *http.Client
+	tokenURL string
+	id       string
+	secret   string
+	inHeader bool
+	scopes   []string
+	params   map[string][]string
+	asJSON   bool
+	response oAuth2TokenResponse
+}
+
+func (s *tokenEndpointSource) Token() (*oauth2.Token, error) {
+	req, err := s.newRequest()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oauth2: cannot fetch token: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("oauth2: cannot fetch token: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("oauth2: cannot fetch token: %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	return s.parse(body)
+}
+
+func (s *tokenEndpointSource) newRequest() (*http.Request, error) {
+	params := url.Values{"grant_type": {"client_credentials"}}
+	if len(s.scopes) != 0 {
+		params.Set("scope", strings.Join(s.scopes, " "))
+	}
+	for k, v := range s.params {
+		params[k] = v
+	}
+	if !s.inHeader {
+		params.Set("client_id", s.id)
+		params.Set("client_secret", s.secret)
+	}
+
+	var (
+		body        []byte
+		contentType string
+	)
+	if s.asJSON {
+		m := make(map[string]interface{}, len(params))
+		for k, v := range params {
+			if len(v) == 1 {
+				m[k] = v[0]
+			} else {
+				m[k] = v
+			}
+		}
+		var err error
+		body, err = json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		contentType = "application/json"
+	} else {
+		body = []byte(params.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	}
+
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, s.tokenURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", "application/json")
+	if s.inHeader {
+		req.SetBasicAuth(url.QueryEscape(s.id), url.QueryEscape(s.secret))
+	}
+	return req, nil
+}
+
+func (s *tokenEndpointSource) parse(body []byte) (*oauth2.Token, error) {
+	var m mapstr.M
+	if err := json.Unmarshal(body, &m); err != nil {
+		return nil, fmt.Errorf("oauth2: cannot parse token response: %w", err)
+	}
+
+	accessToken, _ := getString(m, orDefault(s.response.AccessTokenField, "access_token"))
+	if accessToken == "" {
+		return nil, errors.New("oauth2: server response missing access_token")
+	}
+	tok := &oauth2.Token{AccessToken: accessToken}
+	tok.TokenType, _ = getString(m, orDefault(s.response.TokenTypeField, "token_type"))
+
+	v, err := m.GetValue(orDefault(s.response.ExpiresInField, "expires_in"))
+	if err == nil {
+		var secs float64
+		switch v := v.(type) {
+		case float64:
+			secs = v
+		case string:
+			secs, err = strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("oauth2: invalid token expiry %q", v)
+			}
+		default:
+			return nil, fmt.Errorf("oauth2: invalid token expiry %v", v)
+		}
+		if secs > 0 {
+			tok.Expiry = time.Now().Add(time.Duration(secs * float64(time.Second)))
+		}
+	}
+	return tok, nil
+}
+
+func getString(m mapstr.M, key string) (string, bool) {
+	v, err := m.GetValue(key)
+	if err != nil {
+		return "", false
+	}
+	s, ok := v.(string)
+	return s, ok
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+// refreshingTokenSource caches the tokens of its source and fetches a new
+// one refreshBefore ahead of the expiry of the cached token. While the
+// cached token has not expired, it is kept if the refresh fails.
+type refreshingTokenSource struct {
+	src           oauth2.TokenSource
+	refreshBefore time.Duration
+	now           func() time.Time
+
+	mu  sync.Mutex
+	tok *oauth2.Token
+}
+
+func newRefreshingTokenSource(src oauth2.TokenSource, refreshBefore time.Duration) *refreshingTokenSource {
+	if refreshBefore <= 0 {
+		refreshBefore = defaultOAuth2RefreshBefore
+	}
+	return &refreshingTokenSource{src: src, refreshBefore: refreshBefore, now: time.Now}
+}
+
+func (s *refreshingTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.tok != nil && (s.tok.Expiry.IsZero() || now.Add(s.refreshBefore).Before(s.tok.Expiry)) {
+		return s.tok, nil
+	}
+	tok, err := s.src.Token()
+	if err != nil {
+		if s.tok != nil && now.Before(s.tok.Expiry) {
+			return s.tok, nil
+		}
+		return nil, err
+	}
+	s.tok = tok
+	return tok, nil
+}
+
+// invalidate drops the cached token if it is still tok.
+func (s *refreshingTokenSource) invalidate(tok *oauth2.Token) {
+	s.mu.Lock()
+	if s.tok == tok {
+		s.tok = nil
+	}
+	s.mu.Unlock()
+}
+
+// oAuth2Transport adds the access token to each request and drops the
+// cached token when the server rejects it.
+type oAuth2Transport struct {
+	base   http.RoundTripper
+	source *refreshingTokenSource
+	inject oAuth2Inject
+}
+
+func (t *oAuth2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tok, err := t.source.Token()
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	req2 := req.Clone(req.Context())
+	if t.inject.QueryParam != "" {
+		q := req2.URL.Query()
+		q.Set(t.inject.QueryParam, tok.AccessToken)
+		req2.URL.RawQuery = q.Encode()
+	} else {
+		prefix := tok.Type()
+		if t.inject.Prefix != nil {
+			prefix = *t.inject.Prefix
+		}
+		value := tok.AccessToken
+		if prefix != "" {
+			value = prefix + " " + value
+		}
+		req2.Header.Set(orDefault(t.inject.Header, "Authorization"), value)
+	}
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req2)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		t.source.invalidate(tok)
+	}
+	return resp, err
+}
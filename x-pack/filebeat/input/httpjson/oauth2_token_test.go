@@ -0,0 +1,118 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httpjson
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestOAuth2CustomTokenEndpoint(t *testing.T) {
+	var tokenRequests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.URL.Path == "/token" {
+			tokenRequests++
+			var body map[string]interface{}
+			if r.Header.Get("content-type") != "application/json" || json.NewDecoder(r.Body).Decode(&body) != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			id, secret, ok := r.BasicAuth()
+			if !ok || id != "a_client_id" || secret != "a_client_secret" ||
+				body["grant_type"] != "client_credentials" || body["audience"] != "an_audience" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data": {"token": "abcd", "ttl": 3600}}`))
+			return
+		}
+		if r.Header.Get("X-Api-Token") != "abcd" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"hello":"world"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	secret := "a_client_secret"
+	noPrefix := ""
+	cfg := oAuth2Config{
+		ClientID:      "a_client_id",
+		ClientSecret:  &secret,
+		TokenURL:      server.URL + "/token",
+		Audience:      "an_audience",
+		AuthStyle:     "header",
+		TokenRequest:  oAuth2TokenRequest{EncodeAs: "json"},
+		TokenResponse: oAuth2TokenResponse{AccessTokenField: "data.token", ExpiresInField: "data.ttl"},
+		Inject:        oAuth2Inject{Header: "X-Api-Token", Prefix: &noPrefix},
+	}
+	require.NoError(t, cfg.Validate())
+
+	client, err := cfg.client(context.Background(), http.DefaultClient)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	assert.Equal(t, 1, tokenRequests, "token should be cached")
+}
+
+func TestRefreshingTokenSource(t *testing.T) {
+	now := time.Now()
+	var (
+		calls int
+		fail  bool
+	)
+	src := newRefreshingTokenSource(tokenSourceFunc(func() (*oauth2.Token, error) {
+		if fail {
+			return nil, context.DeadlineExceeded
+		}
+		calls++
+		return &oauth2.Token{AccessToken: "token", Expiry: now.Add(time.Minute)}, nil
+	}), 20*time.Second)
+	src.now = func() time.Time { return now }
+
+	_, err := src.Token()
+	require.NoError(t, err)
+	_, err = src.Token()
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	// Within refresh_before of the expiry a new token is fetched.
+	now = now.Add(45 * time.Second)
+	_, err = src.Token()
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	// A failed refresh keeps serving the token until it expires.
+	now = now.Add(45 * time.Second)
+	fail = true
+	_, err = src.Token()
+	assert.NoError(t, err)
+	now = now.Add(time.Minute)
+	_, err = src.Token()
+	assert.Error(t, err)
+
+	// Invalidated tokens are fetched again.
+	fail = false
+	tok, err := src.Token()
+	require.NoError(t, err)
+	src.invalidate(tok)
+	_, err = src.Token()
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+}
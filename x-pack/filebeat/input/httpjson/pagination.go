@@ -28,15 +28,18 @@ type pagination struct {
 	httpClient     *httpClient
 	requestFactory *requestFactory
 	decoder        decoderFunc
+	maxPages       int
+	metrics        *inputMetrics
 }
 
-func newPagination(config config, httpClient *httpClient, log *logp.Logger) *pagination {
-	pagination := &pagination{httpClient: httpClient, log: log}
+func newPagination(config config, httpClient *httpClient, metrics *inputMetrics, log *logp.Logger) *pagination {
+	pagination := &pagination{httpClient: httpClient, metrics: metrics, log: log}
 	if config.Response == nil {
 		return pagination
 	}
 
 	pagination.decoder = registeredDecoders[config.Response.DecodeAs]
+	pagination.maxPages = config.Response.MaxPages
 
 	if len(config.Response.Pagination) == 0 {
 		return pagination
@@ -123,6 +126,17 @@ func (iter *pageIterator) next() (*response, bool, error) {
 		return tr, true, nil
 	}
 
+	if max := iter.pagination.maxPages; max > 0 && iter.n >= int64(max) {
+		// The cursor is updated from the events of the pages already
+		// processed, so the next interval resumes from this point.
+		iter.pagination.log.Warnw("stopping pagination until next interval: max_pages reached", "max_pages", max)
+		if iter.pagination.metrics != nil {
+			iter.pagination.metrics.maxPagesReached.Inc()
+		}
+		iter.done = true
+		return nil, false, nil
+	}
+
 	httpReq, err := iter.pagination.requestFactory.newHTTPRequest(iter.stdCtx, iter.trCtx)
 	switch {
 	case err == nil:
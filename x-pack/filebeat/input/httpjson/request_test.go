@@ -59,12 +59,12 @@ func TestCtxAfterDoRequest(t *testing.T) {
 
 	log := logp.NewLogger("")
 	ctx := context.Background()
-	client, err := newHTTPClient(ctx, config, log)
+	client, err := newHTTPClient(ctx, config, nil, log)
 	assert.NoError(t, err)
 
 	requestFactory, err := newRequestFactory(ctx, config, log)
 	assert.NoError(t, err)
-	pagination := newPagination(config, client, log)
+	pagination := newPagination(config, client, nil, log)
 	responseProcessor := newResponseProcessor(config, pagination, log)
 
 	requester := newRequester(client, requestFactory, responseProcessor, log)
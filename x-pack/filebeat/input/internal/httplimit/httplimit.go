@@ -0,0 +1,155 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Package httplimit provides http.RoundTrippers that guard inputs against
+// misbehaving APIs by limiting the size of responses and the rate of requests
+// made to each host.
+package httplimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
+
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// ErrBodyTooLarge is returned when a response body exceeds the limit of a BodyLimiter.
+var ErrBodyTooLarge = errors.New("response body too large")
+
+var _ http.RoundTripper = (*BodyLimiter)(nil)
+
+// BodyLimiter is an http.RoundTripper that fails responses with a body larger
+// than a maximum size. Responses declaring a larger Content-Length fail before
+// their body is read, others fail with ErrBodyTooLarge once the body read
+// exceeds the maximum size.
+type BodyLimiter struct {
+	transport http.RoundTripper
+	max       int64
+	exceeded  *monitoring.Uint // Number of responses that exceeded the limit, may be nil.
+}
+
+// NewBodyLimiter returns a BodyLimiter limiting the response bodies of next
+// to max bytes. The exceeded metric is incremented for each response that
+// exceeds the limit if it is not nil.
+func NewBodyLimiter(next http.RoundTripper, max int64, exceeded *monitoring.Uint) *BodyLimiter {
+	return &BodyLimiter{transport: next, max: max, exceeded: exceeded}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (rt *BodyLimiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	if resp.ContentLength > rt.max {
+		resp.Body.Close()
+		rt.inc()
+		return nil, fmt.Errorf("%w: content length of %d bytes exceeds the limit of %d bytes", ErrBodyTooLarge, resp.ContentLength, rt.max)
+	}
+	resp.Body = &limitedBody{ReadCloser: resp.Body, remaining: rt.max, limiter: rt}
+	return resp, nil
+}
+
+func (rt *BodyLimiter) inc() {
+	if rt.exceeded != nil {
+		rt.exceeded.Inc()
+	}
+}
+
+// limitedBody fails reads once more than the remaining bytes have been read.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+	limiter   *BodyLimiter
+	err       error
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	// Read one byte more than remaining to detect an oversized body.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	if int64(n) > b.remaining {
+		b.limiter.inc()
+		b.err = fmt.Errorf("%w: body exceeds the limit of %d bytes", ErrBodyTooLarge, b.limiter.max)
+		return int(b.remaining), b.err
+	}
+	b.remaining -= int64(n)
+	return n, err
+}
+
+// CheckRetry returns a retryablehttp.CheckRetry that does not retry requests
+// failed by a BodyLimiter, and otherwise defers to policy.
+func CheckRetry(policy retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if errors.Is(err, ErrBodyTooLarge) {
+			return false, err
+		}
+		return policy(ctx, resp, err)
+	}
+}
+
+var _ http.RoundTripper = (*HostRateLimiter)(nil)
+
+// HostRateLimiter is an http.RoundTripper that limits the rate of requests
+// made to each host, independently of the other hosts.
+type HostRateLimiter struct {
+	transport http.RoundTripper
+	limit     rate.Limit
+	burst     int
+	delayed   *monitoring.Uint // Number of requests delayed by the limit, may be nil.
+
+	mu    sync.Mutex
+	hosts map[string]*rate.Limiter
+}
+
+// NewHostRateLimiter returns a HostRateLimiter allowing limit requests per
+// second with bursts of burst requests to each host of the requests made
+// with next. The delayed metric is incremented for each request that had to
+// wait if it is not nil.
+func NewHostRateLimiter(next http.RoundTripper, limit rate.Limit, burst int, delayed *monitoring.Uint) *HostRateLimiter {
+	return &HostRateLimiter{
+		transport: next,
+		limit:     limit,
+		burst:     burst,
+		delayed:   delayed,
+		hosts:     make(map[string]*rate.Limiter),
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (rt *HostRateLimiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	limiter := rt.limiter(req.URL.Host)
+	if !limiter.Allow() {
+		if rt.delayed != nil {
+			rt.delayed.Inc()
+		}
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return rt.transport.RoundTrip(req)
+}
+
+func (rt *HostRateLimiter) limiter(host string) *rate.Limiter {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	l, ok := rt.hosts[host]
+	if !ok {
+		l = rate.NewLimiter(rt.limit, rt.burst)
+		rt.hosts[host] = l
+	}
+	return l
+}
@@ -0,0 +1,97 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httplimit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+func TestBodyLimiter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := strings.Repeat("x", 10)
+		if r.URL.Query().Get("chunked") != "" {
+			// Flushing before writing the body prevents the Content-Length header.
+			w.(http.Flusher).Flush()
+		}
+		fmt.Fprint(w, body)
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name     string
+		max      int64
+		query    string
+		wantErr  bool
+		exceeded uint64
+	}{
+		{name: "within_limit", max: 10},
+		{name: "content_length_exceeds", max: 9, wantErr: true, exceeded: 1},
+		{name: "chunked_within_limit", max: 10, query: "?chunked=1"},
+		{name: "chunked_exceeds", max: 9, query: "?chunked=1", wantErr: true, exceeded: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exceeded := monitoring.NewUint(monitoring.NewRegistry(), "exceeded")
+			client := &http.Client{Transport: NewBodyLimiter(http.DefaultTransport, test.max, exceeded)}
+			resp, err := client.Get(srv.URL + test.query)
+			if err == nil {
+				_, err = io.ReadAll(resp.Body)
+				resp.Body.Close()
+			}
+			if test.wantErr != (err != nil) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if err != nil && !errors.Is(err, ErrBodyTooLarge) {
+				t.Errorf("unexpected error type: %v", err)
+			}
+			if got := exceeded.Get(); got != test.exceeded {
+				t.Errorf("unexpected exceeded count: got:%d want:%d", got, test.exceeded)
+			}
+		})
+	}
+}
+
+func TestHostRateLimiter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer other.Close()
+
+	delayed := monitoring.NewUint(monitoring.NewRegistry(), "delayed")
+	client := &http.Client{Transport: NewHostRateLimiter(http.DefaultTransport, rate.Every(100*time.Millisecond), 1, delayed)}
+	get := func(url string) {
+		t.Helper()
+		resp, err := client.Get(url)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	get(srv.URL)
+	get(other.URL)
+	if got := delayed.Get(); got != 0 {
+		t.Errorf("unexpected delayed count after one request per host: %d", got)
+	}
+	start := time.Now()
+	get(srv.URL)
+	if got := delayed.Get(); got != 1 {
+		t.Errorf("unexpected delayed count after second request to host: %d", got)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("second request to host was not delayed: %v", elapsed)
+	}
+}
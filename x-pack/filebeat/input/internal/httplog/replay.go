@@ -0,0 +1,86 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httplog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+var _ http.RoundTripper = (*ReplayRoundTripper)(nil)
+
+// ReplayRoundTripper is an http.RoundTripper that answers requests with the
+// responses recorded by a LoggingRoundTripper, in the order they were logged.
+// The requests are not compared with the recorded requests.
+type ReplayRoundTripper struct {
+	mu        sync.Mutex
+	responses [][]byte // Dumped responses from event.original.
+}
+
+// NewReplayRoundTripper returns a ReplayRoundTripper serving the responses
+// logged to r by a LoggingRoundTripper. Log lines that are not HTTP responses
+// are ignored.
+func NewReplayRoundTripper(r io.Reader) (*ReplayRoundTripper, error) {
+	var rt ReplayRoundTripper
+	dec := json.NewDecoder(r)
+	for {
+		var line struct {
+			Message  string `json:"message"`
+			Status   *int   `json:"http.response.status_code"`
+			Original string `json:"event.original"`
+		}
+		err := dec.Decode(&line)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to read recorded transactions: %w", err)
+		}
+		if line.Message != "HTTP response" || line.Status == nil {
+			continue
+		}
+		if line.Original == "" {
+			return nil, fmt.Errorf("recorded response %d has no event.original", len(rt.responses)+1)
+		}
+		rt.responses = append(rt.responses, []byte(line.Original))
+	}
+	if len(rt.responses) == 0 {
+		return nil, errors.New("no recorded responses found")
+	}
+	return &rt, nil
+}
+
+// RoundTrip implements the http.RoundTripper interface, returning the next
+// recorded response.
+func (rt *ReplayRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if len(rt.responses) == 0 {
+		return nil, fmt.Errorf("no recorded response left for %s %s", req.Method, req.URL)
+	}
+	dump := rt.responses[0]
+	rt.responses = rt.responses[1:]
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(dump)), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recorded response: %w", err)
+	}
+	return resp, nil
+}
+
+// Remaining returns the number of recorded responses that have not been replayed.
+func (rt *ReplayRoundTripper) Remaining() int {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	return len(rt.responses)
+}
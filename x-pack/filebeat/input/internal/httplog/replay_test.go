@@ -0,0 +1,70 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package httplog
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.elastic.co/ecszap"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestReplayRoundTripper(t *testing.T) {
+	var n int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request", fmt.Sprint(n))
+		fmt.Fprintf(w, `{"request":%d}`, n)
+	}))
+	defer srv.Close()
+
+	var log bytes.Buffer
+	core := ecszap.NewCore(ecszap.NewDefaultEncoderConfig(), zapcore.AddSync(&log), zap.DebugLevel)
+	client := &http.Client{Transport: NewLoggingRoundTripper(http.DefaultTransport, zap.New(core))}
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("unexpected error making request: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	rt, err := NewReplayRoundTripper(&log)
+	if err != nil {
+		t.Fatalf("unexpected error reading log: %v", err)
+	}
+	client = &http.Client{Transport: rt}
+	for i := 1; i <= 2; i++ {
+		resp, err := client.Get("http://example.com/")
+		if err != nil {
+			t.Fatalf("unexpected error replaying request %d: %v", i, err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("unexpected error reading replayed body %d: %v", i, err)
+		}
+		if want := fmt.Sprintf(`{"request":%d}`, i); string(body) != want {
+			t.Errorf("unexpected body for request %d: got:%s want:%s", i, body, want)
+		}
+		if got, want := resp.Header.Get("X-Request"), fmt.Sprint(i); got != want {
+			t.Errorf("unexpected header for request %d: got:%s want:%s", i, got, want)
+		}
+	}
+	if rt.Remaining() != 0 {
+		t.Errorf("unexpected remaining responses: %d", rt.Remaining())
+	}
+	_, err = client.Get("http://example.com/")
+	if err == nil {
+		t.Error("expected error after all responses were replayed")
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package lumberjack
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// aggregationConfig configures the aggregation mode, in which the input
+// receives events from the logstash output of edge Beats and publishes them
+// with their original fields and metadata.
+type aggregationConfig struct {
+	Enabled          bool          `config:"enabled"`
+	DedupWindow      time.Duration `config:"dedup_window"       validate:"min=0"` // Time an agent and event ID pair is remembered to detect duplicates. 0 disables deduplication.
+	DedupMaxEntries  int           `config:"dedup_max_entries"  validate:"min=1"` // Maximum number of remembered agent and event ID pairs.
+	MaxTrackedAgents int           `config:"max_tracked_agents" validate:"min=0"` // Maximum number of agents with individual throughput metrics.
+}
+
+func defaultAggregationConfig() aggregationConfig {
+	return aggregationConfig{
+		DedupWindow:      10 * time.Minute,
+		DedupMaxEntries:  100000,
+		MaxTrackedAgents: 1000,
+	}
+}
+
+// metadata keys added by the codec of the edge Beat, which are recreated by
+// the outputs of the aggregating Beat.
+var codecMetadataKeys = []string{"beat", "type", "version"}
+
+// aggregator converts the events of edge Beats and drops duplicates. It is
+// only used by the goroutine processing the batches.
+type aggregator struct {
+	dedup   *dedupCache
+	agents  *agentMetrics
+	metrics *inputMetrics
+}
+
+func newAggregator(c aggregationConfig, metrics *inputMetrics) *aggregator {
+	a := &aggregator{
+		agents:  newAgentMetrics(metrics.agentsRegistry, c.MaxTrackedAgents),
+		metrics: metrics,
+	}
+	if c.DedupWindow > 0 {
+		a.dedup = newDedupCache(c.DedupWindow, c.DedupMaxEntries)
+	}
+	return a
+}
+
+// makeEvent creates the event published for an event of an edge Beat. It
+// returns false if the event is a duplicate of a recently received event.
+func (a *aggregator) makeEvent(remoteAddr string, lumberjackEvent interface{}, acker *batchACKTracker, now time.Time) (beat.Event, bool) {
+	fields, ok := lumberjackEvent.(map[string]interface{})
+	if !ok {
+		return makeEvent(remoteAddr, nil, lumberjackEvent, acker), true
+	}
+
+	event := beat.Event{
+		Timestamp: now.UTC(),
+		Fields:    fields,
+		Private:   acker,
+	}
+	if ts, ok := fields["@timestamp"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			event.Timestamp = t
+		}
+	}
+	delete(fields, "@timestamp")
+	if meta, ok := fields["@metadata"].(map[string]interface{}); ok {
+		for _, key := range codecMetadataKeys {
+			delete(meta, key)
+		}
+		if len(meta) > 0 {
+			event.Meta = meta
+		}
+	}
+	delete(fields, "@metadata")
+
+	agentID, _ := event.Fields.GetValue("agent.id")
+	id, _ := agentID.(string)
+	agent := a.agents.get(id, event.Fields)
+
+	if a.dedup != nil && id != "" {
+		if eventID := eventID(event); eventID != "" && a.dedup.seen(id+"/"+eventID, now) {
+			a.metrics.eventsDeduplicatedTotal.Inc()
+			if agent != nil {
+				agent.deduplicated.Inc()
+			}
+			return beat.Event{}, false
+		}
+	}
+
+	if agent != nil {
+		agent.events.Inc()
+		agent.lastEvent.Set(now)
+	}
+	return event, true
+}
+
+// eventID returns the ID set by the edge Beat, which is either the document
+// ID in the metadata or the event.id field.
+func eventID(event beat.Event) string {
+	if id, ok := event.Meta["_id"].(string); ok && id != "" {
+		return id
+	}
+	if id, err := event.Fields.GetValue("event.id"); err == nil {
+		if s, ok := id.(string); ok {
+			return s
+		}
+	}
+	return ""
+}
+
+// dedupCache remembers keys for a fixed time window. As all entries have the
+// same lifetime, the insertion order is also the expiration order.
+type dedupCache struct {
+	window     time.Duration
+	maxEntries int
+
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type dedupEntry struct {
+	key     string
+	expires time.Time
+}
+
+func newDedupCache(window time.Duration, maxEntries int) *dedupCache {
+	return &dedupCache{
+		window:     window,
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		order:      list.New(),
+	}
+}
+
+// seen returns true if the key has been added within the window. Otherwise
+// the key is added.
+func (c *dedupCache) seen(key string, now time.Time) bool {
+	for front := c.order.Front(); front != nil; front = c.order.Front() {
+		if front.Value.(*dedupEntry).expires.After(now) {
+			break
+		}
+		c.remove(front)
+	}
+
+	if _, found := c.entries[key]; found {
+		return true
+	}
+	if c.order.Len() >= c.maxEntries {
+		c.remove(c.order.Front())
+	}
+	c.entries[key] = c.order.PushBack(&dedupEntry{key: key, expires: now.Add(c.window)})
+	return false
+}
+
+func (c *dedupCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*dedupEntry).key)
+}
+
+// agentMetrics reports the throughput of each edge agent. Agents beyond the
+// configured limit are only accounted in the total of untracked events.
+type agentMetrics struct {
+	reg       *monitoring.Registry
+	max       int
+	agents    map[string]*agentStats
+	untracked *monitoring.Uint
+}
+
+type agentStats struct {
+	events       *monitoring.Uint      // Number of events received from the agent.
+	deduplicated *monitoring.Uint      // Number of duplicate events dropped.
+	lastEvent    *monitoring.Timestamp // Time the last event was received.
+}
+
+func newAgentMetrics(reg *monitoring.Registry, max int) *agentMetrics {
+	return &agentMetrics{
+		reg:       reg,
+		max:       max,
+		agents:    map[string]*agentStats{},
+		untracked: monitoring.NewUint(reg, "untracked_events_total"),
+	}
+}
+
+// get returns the metrics of the agent, registering them on first use. It
+// returns nil if the agent has no ID or the limit of tracked agents has been
+// reached.
+func (m *agentMetrics) get(id string, fields mapstr.M) *agentStats {
+	if stats, found := m.agents[id]; found {
+		return stats
+	}
+	if id == "" || len(m.agents) >= m.max {
+		m.untracked.Inc()
+		return nil
+	}
+
+	reg := m.reg.NewRegistry(strings.ReplaceAll(id, ".", "_"))
+	for _, key := range []string{"name", "type", "version"} {
+		if v, err := fields.GetValue("agent." + key); err == nil {
+			monitoring.NewString(reg, key).Set(fmt.Sprint(v))
+		}
+	}
+	stats := &agentStats{
+		events:       monitoring.NewUint(reg, "events_total"),
+		deduplicated: monitoring.NewUint(reg, "events_deduplicated_total"),
+		lastEvent:    monitoring.NewTimestamp(reg, "last_event_time"),
+	}
+	m.agents[id] = stats
+	return stats
+}
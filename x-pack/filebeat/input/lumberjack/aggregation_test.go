@@ -0,0 +1,99 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package lumberjack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+func edgeEvent(agentID, id string) map[string]interface{} {
+	return map[string]interface{}{
+		"@timestamp": "2023-03-01T12:00:00.123Z",
+		"@metadata": map[string]interface{}{
+			"beat":    "filebeat",
+			"type":    "_doc",
+			"version": "8.7.0",
+			"_id":     id,
+		},
+		"agent": map[string]interface{}{
+			"id":      agentID,
+			"name":    "edge-1",
+			"type":    "filebeat",
+			"version": "8.7.0",
+		},
+		"message": "hello",
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	now := time.Date(2023, 3, 1, 12, 0, 1, 0, time.UTC)
+	metrics := newInputMetrics("", monitoring.NewRegistry())
+	agg := newAggregator(defaultAggregationConfig(), metrics)
+
+	event, ok := agg.makeEvent("10.0.0.1:5000", edgeEvent("a1", "e1"), nil, now)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2023, 3, 1, 12, 0, 0, 123000000, time.UTC), event.Timestamp)
+	assert.Equal(t, mapstr.M{"_id": "e1"}, event.Meta)
+	assert.Equal(t, mapstr.M{
+		"agent": map[string]interface{}{
+			"id":      "a1",
+			"name":    "edge-1",
+			"type":    "filebeat",
+			"version": "8.7.0",
+		},
+		"message": "hello",
+	}, event.Fields)
+
+	// same event ID from the same agent is a duplicate
+	_, ok = agg.makeEvent("10.0.0.1:5000", edgeEvent("a1", "e1"), nil, now)
+	assert.False(t, ok)
+	assert.Equal(t, uint64(1), metrics.eventsDeduplicatedTotal.Get())
+
+	// same event ID from another agent is not
+	_, ok = agg.makeEvent("10.0.0.2:5000", edgeEvent("a2", "e1"), nil, now)
+	assert.True(t, ok)
+
+	// duplicates are only detected within the window
+	_, ok = agg.makeEvent("10.0.0.1:5000", edgeEvent("a1", "e1"), nil, now.Add(time.Hour))
+	assert.True(t, ok)
+
+	agent := metrics.agentsRegistry.GetRegistry("a1")
+	require.NotNil(t, agent)
+	assert.Equal(t, uint64(2), agent.Get("events_total").(*monitoring.Uint).Get())
+	assert.Equal(t, uint64(1), agent.Get("events_deduplicated_total").(*monitoring.Uint).Get())
+	assert.Equal(t, "edge-1", agent.Get("name").(*monitoring.String).Get())
+}
+
+func TestAgentMetricsLimit(t *testing.T) {
+	reg := monitoring.NewRegistry()
+	m := newAgentMetrics(reg, 1)
+
+	require.NotNil(t, m.get("a1", mapstr.M{}))
+	require.NotNil(t, m.get("a1", mapstr.M{}))
+	assert.Nil(t, m.get("a2", mapstr.M{}))
+	assert.Nil(t, m.get("", mapstr.M{}))
+	assert.Equal(t, uint64(2), m.untracked.Get())
+}
+
+func TestDedupCache(t *testing.T) {
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	c := newDedupCache(time.Minute, 2)
+
+	assert.False(t, c.seen("a", now))
+	assert.True(t, c.seen("a", now.Add(time.Second)))
+	assert.False(t, c.seen("b", now))
+
+	// the oldest key is evicted once the cache is full
+	assert.False(t, c.seen("c", now))
+	assert.False(t, c.seen("a", now))
+	assert.Len(t, c.entries, 2)
+}
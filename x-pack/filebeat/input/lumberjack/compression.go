@@ -0,0 +1,210 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package lumberjack
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// Lumberjack frame types sent by clients.
+const (
+	frameWindowSize = 'W'
+	frameCompressed = 'C'
+	frameJSON       = 'J'
+	frameData       = 'D'
+)
+
+var errCompressionNotAccepted = errors.New("compressed frames are not accepted")
+
+// zlibLevelClass returns the zlib FLEVEL header value written for the
+// compression level by compress/zlib, which is used by the lumberjack
+// clients of the Beats. The header is the only place the compression level
+// of a frame can be observed.
+func zlibLevelClass(level int) int {
+	switch {
+	case level <= 1:
+		return 0
+	case level <= 5:
+		return 1
+	case level == 6:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// compressionLimitListener wraps the connections of a listener such that
+// connections sending frames with a higher compression level than accepted
+// are closed.
+type compressionLimitListener struct {
+	net.Listener
+	maxLevel int
+	rejected func()
+}
+
+func (l *compressionLimitListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &compressionLimitConn{
+		Conn:     conn,
+		rejected: l.rejected,
+		parser:   frameParser{maxLevel: l.maxLevel},
+	}, nil
+}
+
+// compressionLimitConn inspects the lumberjack frames read from the
+// connection.
+type compressionLimitConn struct {
+	net.Conn
+	rejected func()
+	parser   frameParser
+}
+
+func (c *compressionLimitConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		if perr := c.parser.feed(b[:n]); perr != nil {
+			if c.rejected != nil {
+				c.rejected()
+			}
+			c.Conn.Close()
+			return 0, perr
+		}
+	}
+	return n, err
+}
+
+// frameParser follows the framing of the lumberjack v1 and v2 protocols
+// without buffering the payloads, in order to check the zlib header of
+// compressed frames. Unknown frames stop the inspection and are left to the
+// lumberjack server to reject.
+type frameParser struct {
+	maxLevel int
+
+	skip     int // Number of payload bytes to discard.
+	want     int // Number of header bytes to collect before calling next.
+	header   [8]byte
+	have     int
+	next     func(header []byte) error
+	pairs    uint32 // Number of key/value pairs left in a data frame.
+	rest     int    // Number of compressed bytes following the zlib header.
+	disabled bool
+}
+
+func (p *frameParser) expect(n int, next func(header []byte) error) {
+	p.want = n
+	p.have = 0
+	p.next = next
+}
+
+func (p *frameParser) feed(b []byte) error {
+	for len(b) > 0 && !p.disabled {
+		if p.skip > 0 {
+			n := p.skip
+			if n > len(b) {
+				n = len(b)
+			}
+			p.skip -= n
+			b = b[n:]
+			continue
+		}
+
+		if p.next == nil {
+			p.expect(2, p.onFrame)
+		}
+		n := copy(p.header[p.have:p.want], b)
+		p.have += n
+		b = b[n:]
+		if p.have < p.want {
+			continue
+		}
+
+		next := p.next
+		p.next = nil
+		if err := next(p.header[:p.want]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *frameParser) onFrame(header []byte) error {
+	if version := header[0]; version != '1' && version != '2' {
+		p.disabled = true
+		return nil
+	}
+
+	switch header[1] {
+	case frameWindowSize:
+		p.skip = 4
+	case frameCompressed:
+		p.expect(4, p.onCompressedLength)
+	case frameJSON:
+		p.expect(8, p.onJSONHeader)
+	case frameData:
+		p.expect(8, p.onDataHeader)
+	default:
+		p.disabled = true
+	}
+	return nil
+}
+
+func (p *frameParser) onCompressedLength(header []byte) error {
+	if p.maxLevel <= 0 {
+		return errCompressionNotAccepted
+	}
+
+	length := int(binary.BigEndian.Uint32(header))
+	if length < 2 {
+		p.skip = length
+		return nil
+	}
+	p.rest = length - 2
+	p.expect(2, p.onZlibHeader)
+	return nil
+}
+
+func (p *frameParser) onZlibHeader(header []byte) error {
+	if class := int(header[1] >> 6); class > zlibLevelClass(p.maxLevel) {
+		return fmt.Errorf("compression level of frame exceeds the maximum accepted level %d", p.maxLevel)
+	}
+	p.skip = p.rest
+	return nil
+}
+
+func (p *frameParser) onJSONHeader(header []byte) error {
+	// sequence number followed by the payload length
+	p.skip = int(binary.BigEndian.Uint32(header[4:]))
+	return nil
+}
+
+func (p *frameParser) onDataHeader(header []byte) error {
+	// sequence number followed by the number of key/value pairs
+	p.pairs = binary.BigEndian.Uint32(header[4:])
+	if p.pairs > 0 {
+		p.expect(4, p.onKeyLength)
+	}
+	return nil
+}
+
+func (p *frameParser) onKeyLength(header []byte) error {
+	p.skip = int(binary.BigEndian.Uint32(header))
+	p.expect(4, p.onValueLength)
+	return nil
+}
+
+func (p *frameParser) onValueLength(header []byte) error {
+	p.skip = int(binary.BigEndian.Uint32(header))
+	p.pairs--
+	if p.pairs > 0 {
+		p.expect(4, p.onKeyLength)
+	}
+	return nil
+}
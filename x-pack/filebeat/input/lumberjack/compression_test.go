@@ -0,0 +1,114 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package lumberjack
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func windowFrame(size uint32) []byte {
+	b := []byte{'2', frameWindowSize, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(b[2:], size)
+	return b
+}
+
+func jsonFrame(seq uint32, payload string) []byte {
+	b := []byte{'2', frameJSON}
+	b = binary.BigEndian.AppendUint32(b, seq)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(payload)))
+	return append(b, payload...)
+}
+
+func dataFrame(seq uint32, pairs map[string]string) []byte {
+	b := []byte{'1', frameData}
+	b = binary.BigEndian.AppendUint32(b, seq)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(pairs)))
+	for k, v := range pairs {
+		b = binary.BigEndian.AppendUint32(b, uint32(len(k)))
+		b = append(b, k...)
+		b = binary.BigEndian.AppendUint32(b, uint32(len(v)))
+		b = append(b, v...)
+	}
+	return b
+}
+
+func compressedFrame(t *testing.T, level int, frames ...[]byte) []byte {
+	var buf bytes.Buffer
+	w, err := zlib.NewWriterLevel(&buf, level)
+	require.NoError(t, err)
+	for _, f := range frames {
+		_, err = w.Write(f)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	b := []byte{'2', frameCompressed}
+	b = binary.BigEndian.AppendUint32(b, uint32(buf.Len()))
+	return append(b, buf.Bytes()...)
+}
+
+func TestFrameParser(t *testing.T) {
+	events := [][]byte{
+		jsonFrame(1, `{"message":"hello"}`),
+		jsonFrame(2, `{"message":"world"}`),
+	}
+
+	testCases := []struct {
+		name     string
+		maxLevel int
+		stream   [][]byte
+		err      bool
+	}{
+		{
+			name:     "uncompressed",
+			maxLevel: 0,
+			stream:   [][]byte{windowFrame(2), events[0], events[1], dataFrame(3, map[string]string{"line": "x", "host": "y"})},
+		},
+		{
+			name:     "compression not accepted",
+			maxLevel: 0,
+			stream:   [][]byte{windowFrame(2), compressedFrame(t, 1, events...)},
+			err:      true,
+		},
+		{
+			name:     "accepted level",
+			maxLevel: 3,
+			stream:   [][]byte{windowFrame(2), compressedFrame(t, 3, events...), windowFrame(1), events[0]},
+		},
+		{
+			name:     "level above maximum",
+			maxLevel: 3,
+			stream:   [][]byte{windowFrame(2), compressedFrame(t, 9, events...)},
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			stream := bytes.Join(tc.stream, nil)
+
+			// feed the stream byte by byte to exercise partial headers
+			p := frameParser{maxLevel: tc.maxLevel}
+			var err error
+			for i := 0; i < len(stream) && err == nil; i++ {
+				err = p.feed(stream[i : i+1])
+			}
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, p.disabled)
+			require.Nil(t, p.next)
+			require.Zero(t, p.skip)
+		})
+	}
+}
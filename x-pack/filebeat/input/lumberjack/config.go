@@ -19,11 +19,19 @@ type config struct {
 	Keepalive      time.Duration           `config:"keepalive"       validate:"min=0"`  // Keepalive interval for notifying clients that batches that are not yet ACKed.
 	Timeout        time.Duration           `config:"timeout"         validate:"min=0"`  // Read / write timeouts for Lumberjack server.
 	MaxConnections int                     `config:"max_connections" validate:"min=0"`  // Maximum number of concurrent connections. Default is 0 which means no limit.
+
+	ACKWindowSize       int     `config:"ack_window_size"       validate:"min=0"`        // Maximum number of events awaiting ACK before reading new batches is paused. Default is 0 which means no limit.
+	MaxEventsPerSecond  float64 `config:"max_events_per_second" validate:"min=0"`        // Per-connection event rate limit enforced by delaying batch ACKs. Default is 0 which means no limit.
+	MaxCompressionLevel int     `config:"max_compression_level" validate:"min=0, max=9"` // Highest zlib compression level accepted from clients. 0 rejects compressed frames. Default is 9.
+
+	Aggregation aggregationConfig `config:"aggregation"` // Receive events from edge Beats, preserving their fields and metadata.
 }
 
 func (c *config) InitDefaults() {
 	c.ListenAddress = "localhost:5044"
 	c.Versions = []string{"v1", "v2"}
+	c.MaxCompressionLevel = 9
+	c.Aggregation = defaultAggregationConfig()
 }
 
 func (c *config) Validate() error {
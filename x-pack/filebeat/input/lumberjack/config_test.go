@@ -23,8 +23,10 @@ func TestConfig(t *testing.T) {
 			"defaults",
 			map[string]interface{}{},
 			&config{
-				ListenAddress: "localhost:5044",
-				Versions:      []string{"v1", "v2"},
+				ListenAddress:       "localhost:5044",
+				Versions:            []string{"v1", "v2"},
+				MaxCompressionLevel: 9,
+				Aggregation:         defaultAggregationConfig(),
 			},
 			"",
 		},
@@ -52,6 +54,22 @@ func TestConfig(t *testing.T) {
 			nil,
 			`requires value >= 0 accessing 'max_connections'`,
 		},
+		{
+			"validate ack_window_size",
+			map[string]interface{}{
+				"ack_window_size": -1,
+			},
+			nil,
+			`requires value >= 0 accessing 'ack_window_size'`,
+		},
+		{
+			"validate max_compression_level",
+			map[string]interface{}{
+				"max_compression_level": 10,
+			},
+			nil,
+			`requires value <= 9 accessing 'max_compression_level'`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,120 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package lumberjack
+
+import (
+	"sync"
+	"time"
+)
+
+// ackWindow limits the number of events that have been published but not yet
+// ACKed. Reading new batches is paused while the window is full, which
+// applies backpressure to the connected clients.
+type ackWindow struct {
+	size int
+
+	mutex   sync.Mutex
+	cond    *sync.Cond
+	pending int
+	closed  bool
+}
+
+func newACKWindow(size int) *ackWindow {
+	w := &ackWindow{size: size}
+	w.cond = sync.NewCond(&w.mutex)
+	return w
+}
+
+// acquire waits until n events fit into the window. A batch larger than the
+// window is admitted once no other events are pending. It returns false if
+// the window was closed while waiting.
+func (w *ackWindow) acquire(n int) (waited, ok bool) {
+	if w.size <= 0 {
+		return false, true
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for !w.closed && w.pending > 0 && w.pending+n > w.size {
+		waited = true
+		w.cond.Wait()
+	}
+	if w.closed {
+		return waited, false
+	}
+	w.pending += n
+	return waited, true
+}
+
+// release returns n events to the window.
+func (w *ackWindow) release(n int) {
+	if w.size <= 0 {
+		return
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.pending -= n
+	w.cond.Broadcast()
+}
+
+// close unblocks all waiting callers.
+func (w *ackWindow) close() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.closed = true
+	w.cond.Broadcast()
+}
+
+// connRateLimiter limits the rate of events per connection. Batches are not
+// rejected, instead the ACK of a batch is delayed until the connection has
+// earned enough budget for its events. Because clients wait for the ACK
+// before sending more data, this slows down fast senders without affecting
+// other connections.
+type connRateLimiter struct {
+	eventsPerSecond float64
+
+	mutex sync.Mutex
+	conns map[string]time.Time // Time at which each connection has paid for all events received.
+	sweep time.Time            // Time of the last removal of idle connections.
+}
+
+func newConnRateLimiter(eventsPerSecond float64) *connRateLimiter {
+	return &connRateLimiter{
+		eventsPerSecond: eventsPerSecond,
+		conns:           map[string]time.Time{},
+	}
+}
+
+// reserve accounts n events to the connection and returns the time at which
+// the batch may be ACKed.
+func (l *connRateLimiter) reserve(conn string, n int, now time.Time) time.Time {
+	if l == nil || l.eventsPerSecond <= 0 {
+		return now
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if now.Sub(l.sweep) > time.Minute {
+		for addr, paid := range l.conns {
+			if now.Sub(paid) > time.Minute {
+				delete(l.conns, addr)
+			}
+		}
+		l.sweep = now
+	}
+
+	paid, found := l.conns[conn]
+	if !found || paid.Before(now) {
+		paid = now
+	}
+	paid = paid.Add(time.Duration(float64(n) / l.eventsPerSecond * float64(time.Second)))
+	l.conns[conn] = paid
+	return paid
+}
@@ -0,0 +1,93 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package lumberjack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestACKWindow(t *testing.T) {
+	t.Run("unlimited", func(t *testing.T) {
+		w := newACKWindow(0)
+		waited, ok := w.acquire(1000)
+		require.False(t, waited)
+		require.True(t, ok)
+	})
+
+	t.Run("waits for release", func(t *testing.T) {
+		w := newACKWindow(10)
+		_, ok := w.acquire(8)
+		require.True(t, ok)
+
+		acquired := make(chan bool)
+		go func() {
+			waited, ok := w.acquire(5)
+			acquired <- waited && ok
+		}()
+
+		select {
+		case <-acquired:
+			t.Fatal("batch admitted while the window is full")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		w.release(8)
+		require.True(t, <-acquired)
+	})
+
+	t.Run("oversized batch admitted when empty", func(t *testing.T) {
+		w := newACKWindow(10)
+		waited, ok := w.acquire(20)
+		require.False(t, waited)
+		require.True(t, ok)
+	})
+
+	t.Run("close unblocks", func(t *testing.T) {
+		w := newACKWindow(10)
+		_, ok := w.acquire(10)
+		require.True(t, ok)
+
+		done := make(chan bool)
+		go func() {
+			_, ok := w.acquire(1)
+			done <- ok
+		}()
+		w.close()
+		require.False(t, <-done)
+	})
+}
+
+func TestConnRateLimiter(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("unlimited", func(t *testing.T) {
+		l := newConnRateLimiter(0)
+		require.Equal(t, now, l.reserve("a", 1000, now))
+	})
+
+	t.Run("per connection", func(t *testing.T) {
+		l := newConnRateLimiter(100)
+
+		require.Equal(t, now.Add(time.Second), l.reserve("a", 100, now))
+		require.Equal(t, now.Add(3*time.Second), l.reserve("a", 200, now))
+
+		// other connections have their own budget
+		require.Equal(t, now.Add(500*time.Millisecond), l.reserve("b", 50, now))
+
+		// budget is not accumulated while a connection is idle
+		later := now.Add(10 * time.Second)
+		require.Equal(t, later.Add(time.Second), l.reserve("a", 100, later))
+	})
+
+	t.Run("idle connections removed", func(t *testing.T) {
+		l := newConnRateLimiter(100)
+		l.reserve("a", 1, now)
+		l.reserve("b", 1, now.Add(2*time.Minute))
+		require.Len(t, l.conns, 1)
+	})
+}
@@ -20,6 +20,13 @@ type inputMetrics struct {
 	batchesACKedTotal     *monitoring.Uint   // Number of Lumberjack batches ACKed.
 	messagesReceivedTotal *monitoring.Uint   // Number of Lumberjack messages received (not necessarily processed fully).
 	batchProcessingTime   metrics.Sample     // Histogram of the elapsed batch processing times in nanoseconds (time of receipt to time of ACK for non-empty batches).
+
+	ackWindowWaitsTotal                 *monitoring.Uint // Number of batches that waited for room in the ACK window.
+	batchesRateLimitedTotal             *monitoring.Uint // Number of batches whose ACK was delayed by the per-connection rate limit.
+	connectionsCompressionRejectedTotal *monitoring.Uint // Number of connections closed for sending frames above the accepted compression level.
+
+	eventsDeduplicatedTotal *monitoring.Uint     // Number of duplicate events of edge agents dropped in aggregation mode.
+	agentsRegistry          *monitoring.Registry // Per edge agent metrics in aggregation mode.
 }
 
 // Close removes the metrics from the registry.
@@ -37,6 +44,13 @@ func newInputMetrics(id string, optionalParent *monitoring.Registry) *inputMetri
 		batchesACKedTotal:     monitoring.NewUint(reg, "batches_acked_total"),
 		messagesReceivedTotal: monitoring.NewUint(reg, "messages_received_total"),
 		batchProcessingTime:   metrics.NewUniformSample(1024),
+
+		ackWindowWaitsTotal:                 monitoring.NewUint(reg, "ack_window_waits_total"),
+		batchesRateLimitedTotal:             monitoring.NewUint(reg, "batches_rate_limited_total"),
+		connectionsCompressionRejectedTotal: monitoring.NewUint(reg, "connections_compression_rejected_total"),
+
+		eventsDeduplicatedTotal: monitoring.NewUint(reg, "events_deduplicated_total"),
+		agentsRegistry:          reg.NewRegistry("agents"),
 	}
 	adapter.NewGoMetrics(reg, "batch_processing_time", adapter.Accept).
 		Register("histogram", metrics.NewHistogram(out.batchProcessingTime)) //nolint:errcheck // A unique namespace is used so name collisions are impossible.
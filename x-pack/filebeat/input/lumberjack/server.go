@@ -29,18 +29,21 @@ type server struct {
 	ljSvr          lumber.Server
 	ljSvrCloseOnce sync.Once
 	bindAddress    string
+	window         *ackWindow
+	rateLimiter    *connRateLimiter
+	aggregator     *aggregator // Set in aggregation mode.
 }
 
 func newServer(c config, log *logp.Logger, pub func(beat.Event), metrics *inputMetrics) (*server, error) {
-	ljSvr, bindAddress, err := newLumberjack(c)
-	if err != nil {
-		return nil, err
-	}
-
 	if metrics == nil {
 		metrics = newInputMetrics("", monitoring.NewRegistry())
 	}
 
+	ljSvr, bindAddress, err := newLumberjack(c, metrics)
+	if err != nil {
+		return nil, err
+	}
+
 	bindURI := "tcp://" + bindAddress
 	if c.TLS.IsEnabled() {
 		bindURI = "tls://" + bindAddress
@@ -48,6 +51,11 @@ func newServer(c config, log *logp.Logger, pub func(beat.Event), metrics *inputM
 	log.Infof(inputName+" is listening at %v.", bindURI)
 	metrics.bindAddress.Set(bindURI)
 
+	var agg *aggregator
+	if c.Aggregation.Enabled {
+		agg = newAggregator(c.Aggregation, metrics)
+	}
+
 	return &server{
 		config:      c,
 		log:         log,
@@ -55,12 +63,16 @@ func newServer(c config, log *logp.Logger, pub func(beat.Event), metrics *inputM
 		metrics:     metrics,
 		ljSvr:       ljSvr,
 		bindAddress: bindAddress,
+		window:      newACKWindow(c.ACKWindowSize),
+		rateLimiter: newConnRateLimiter(c.MaxEventsPerSecond),
+		aggregator:  agg,
 	}, nil
 }
 
 func (s *server) Close() error {
 	var err error
 	s.ljSvrCloseOnce.Do(func() {
+		s.window.close()
 		err = s.ljSvr.Close()
 	})
 	return err
@@ -85,18 +97,53 @@ func (s *server) processBatch(batch *lj.Batch) {
 	}
 	s.metrics.messagesReceivedTotal.Add(uint64(len(batch.Events)))
 
+	// Wait for the ACK window to have room for the batch.
+	n := len(batch.Events)
+	waited, ok := s.window.acquire(n)
+	if waited {
+		s.metrics.ackWindowWaitsTotal.Inc()
+	}
+	if !ok {
+		return
+	}
+
 	// Track all the Beat events associated to the Lumberjack batch so that
 	// the batch can be ACKed after the Beat events are delivered successfully.
 	start := time.Now()
+	ackAt := s.rateLimiter.reserve(batch.RemoteAddr, n, start)
 	acker := newBatchACKTracker(func() {
-		batch.ACK()
-		s.metrics.batchesACKedTotal.Inc()
-		s.metrics.batchProcessingTime.Update(time.Since(start).Nanoseconds())
+		s.window.release(n)
+
+		ack := func() {
+			batch.ACK()
+			s.metrics.batchesACKedTotal.Inc()
+			s.metrics.batchProcessingTime.Update(time.Since(start).Nanoseconds())
+		}
+
+		// Delay the ACK of connections exceeding their event rate.
+		if delay := time.Until(ackAt); delay > 0 {
+			s.metrics.batchesRateLimitedTotal.Inc()
+			time.AfterFunc(delay, ack)
+			return
+		}
+		ack()
 	})
 
 	for _, ljEvent := range batch.Events {
+		var event beat.Event
+		if s.aggregator != nil {
+			var ok bool
+			if event, ok = s.aggregator.makeEvent(batch.RemoteAddr, ljEvent, acker, start); !ok {
+				// Duplicates are part of the lumberjack batch ACK, but are
+				// not published.
+				continue
+			}
+		} else {
+			event = makeEvent(batch.RemoteAddr, batch.TLS, ljEvent, acker)
+		}
+
 		acker.Add()
-		s.publish(makeEvent(batch.RemoteAddr, batch.TLS, ljEvent, acker))
+		s.publish(event)
 	}
 
 	// Mark the batch as "ready" after Beat events are generated for each
@@ -127,7 +174,7 @@ func makeEvent(remoteAddr string, tlsState *tls.ConnectionState, lumberjackEvent
 	return event
 }
 
-func newLumberjack(c config) (lj lumber.Server, bindAddress string, err error) {
+func newLumberjack(c config, metrics *inputMetrics) (lj lumber.Server, bindAddress string, err error) {
 	// Setup optional TLS.
 	var tlsConfig *tls.Config
 	if c.TLS.IsEnabled() {
@@ -149,6 +196,13 @@ func newLumberjack(c config) (lj lumber.Server, bindAddress string, err error) {
 	if tlsConfig != nil {
 		l = tls.NewListener(l, tlsConfig)
 	}
+	if c.MaxCompressionLevel < 9 {
+		l = &compressionLimitListener{
+			Listener: l,
+			maxLevel: c.MaxCompressionLevel,
+			rejected: metrics.connectionsCompressionRejectedTotal.Inc,
+		}
+	}
 	if c.MaxConnections > 0 {
 		l = netutil.LimitListener(l, c.MaxConnections)
 	}
@@ -0,0 +1,194 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package netflow
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/record"
+)
+
+// aggregatedFlowsField is the field counting the flows rolled up in an
+// aggregated record.
+const aggregatedFlowsField = "aggregatedFlows"
+
+var (
+	// sumFields are the counters of the flows added up in an aggregate.
+	sumFields = []string{
+		"octetDeltaCount", "packetDeltaCount",
+		"reverseOctetDeltaCount", "reversePacketDeltaCount",
+		"initiatorOctets", "initiatorPackets",
+		"responderOctets", "responderPackets",
+	}
+	// maxFields are the total counters and the end times of the flows, the
+	// highest value is kept in an aggregate.
+	maxFields = []string{
+		"octetTotalCount", "packetTotalCount",
+		"reverseOctetTotalCount", "reversePacketTotalCount",
+		"flowEndSysUpTime", "flowEndSeconds", "flowEndMilliseconds",
+		"flowEndMicroseconds", "flowEndNanoseconds",
+		"flowDurationMilliseconds",
+	}
+	// minFields are the start times of the flows, the lowest value is kept
+	// in an aggregate.
+	minFields = []string{
+		"flowStartSysUpTime", "flowStartSeconds", "flowStartMilliseconds",
+		"flowStartMicroseconds", "flowStartNanoseconds",
+	}
+	// orFields are the flags of the flows, they are combined in an aggregate.
+	orFields = []string{"tcpControlBits"}
+)
+
+// flowKey identifies the flows rolled up together: the flows of the same
+// exporter sharing the 5-tuple.
+type flowKey struct {
+	exporter         string
+	srcIP, dstIP     string
+	srcPort, dstPort uint64
+	protocol         uint64
+}
+
+// aggregator rolls up the flow records sharing a flowKey until they are
+// flushed, once per window. It is not safe for concurrent use.
+type aggregator struct {
+	window   time.Duration
+	maxFlows int
+
+	flows map[flowKey]*record.Record
+}
+
+func newAggregator(config aggregationConfig) *aggregator {
+	if !config.Enabled {
+		return nil
+	}
+	return &aggregator{
+		window:   config.Window,
+		maxFlows: config.MaxFlows,
+		flows:    map[flowKey]*record.Record{},
+	}
+}
+
+// add rolls up the flow records. It returns the records that must be published
+// right away: the options records, the flows without 5-tuple and the flows
+// that could not be aggregated because max_flows is reached. The number of
+// flows rolled up is returned too.
+func (a *aggregator) add(flows []record.Record) (publish []record.Record, aggregated int) {
+	for _, flow := range flows {
+		key, ok := aggregationKey(flow)
+		if !ok {
+			publish = append(publish, flow)
+			continue
+		}
+		if agg, found := a.flows[key]; found {
+			merge(agg, flow)
+			aggregated++
+			continue
+		}
+		if len(a.flows) >= a.maxFlows {
+			publish = append(publish, flow)
+			continue
+		}
+		agg := flow
+		agg.Fields[aggregatedFlowsField] = uint64(1)
+		a.flows[key] = &agg
+		aggregated++
+	}
+	return publish, aggregated
+}
+
+// flush returns the aggregated records and starts a new window.
+func (a *aggregator) flush() []record.Record {
+	if len(a.flows) == 0 {
+		return nil
+	}
+	flows := make([]record.Record, 0, len(a.flows))
+	for _, flow := range a.flows {
+		flows = append(flows, *flow)
+	}
+	a.flows = make(map[flowKey]*record.Record, len(a.flows))
+	return flows
+}
+
+// aggregationKey returns the key of a flow record, and false if it is not a
+// flow record or is missing its addresses.
+func aggregationKey(flow record.Record) (flowKey, bool) {
+	if flow.Type != record.Flow {
+		return flowKey{}, false
+	}
+	var key flowKey
+	key.exporter, _ = getKeyString(flow.Exporter, "address")
+	srcIP, found := getKeyIP(flow.Fields, "sourceIPv4Address")
+	if !found {
+		srcIP, found = getKeyIP(flow.Fields, "sourceIPv6Address")
+	}
+	if !found {
+		return flowKey{}, false
+	}
+	dstIP, found := getKeyIP(flow.Fields, "destinationIPv4Address")
+	if !found {
+		dstIP, found = getKeyIP(flow.Fields, "destinationIPv6Address")
+	}
+	if !found {
+		return flowKey{}, false
+	}
+	key.srcIP, key.dstIP = string(srcIP.To16()), string(dstIP.To16())
+	key.srcPort, _ = getKeyUint64(flow.Fields, "sourceTransportPort")
+	key.dstPort, _ = getKeyUint64(flow.Fields, "destinationTransportPort")
+	key.protocol, _ = getKeyUint64(flow.Fields, "protocolIdentifier")
+	return key, true
+}
+
+// merge rolls up flow into the aggregate agg. The export time and exporter
+// metadata of the latest flow are kept, they are the reference of the uptime
+// based start and end times.
+func merge(agg *record.Record, flow record.Record) {
+	for _, name := range sumFields {
+		if v, found := getKeyUint64(flow.Fields, name); found {
+			prev, _ := getKeyUint64(agg.Fields, name)
+			agg.Fields[name] = prev + v
+		}
+	}
+	for _, name := range orFields {
+		if v, found := getKeyUint64(flow.Fields, name); found {
+			prev, _ := getKeyUint64(agg.Fields, name)
+			agg.Fields[name] = prev | v
+		}
+	}
+	for _, name := range maxFields {
+		if v, found := flow.Fields[name]; found {
+			if prev, found := agg.Fields[name]; !found || less(prev, v) {
+				agg.Fields[name] = v
+			}
+		}
+	}
+	for _, name := range minFields {
+		if v, found := flow.Fields[name]; found {
+			if prev, found := agg.Fields[name]; !found || less(v, prev) {
+				agg.Fields[name] = v
+			}
+		}
+	}
+	if count, found := getKeyUint64(agg.Fields, aggregatedFlowsField); found {
+		agg.Fields[aggregatedFlowsField] = count + 1
+	}
+	if flow.Timestamp.After(agg.Timestamp) {
+		agg.Timestamp = flow.Timestamp
+		agg.Exporter = flow.Exporter
+	}
+}
+
+// less compares two field values of the same type. Values of different or
+// unsupported types are not ordered.
+func less(a, b interface{}) bool {
+	switch a := a.(type) {
+	case uint64:
+		b, ok := b.(uint64)
+		return ok && a < b
+	case time.Time:
+		b, ok := b.(time.Time)
+		return ok && a.Before(b)
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+//go:build !integration
+// +build !integration
+
+package netflow
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/record"
+)
+
+func testFlow(ts time.Time, srcPort, octets, start, end uint64) record.Record {
+	return record.Record{
+		Type:      record.Flow,
+		Timestamp: ts,
+		Exporter:  record.Map{"address": "10.0.0.1:2055"},
+		Fields: record.Map{
+			"sourceIPv4Address":        net.ParseIP("192.168.0.1").To4(),
+			"destinationIPv4Address":   net.ParseIP("192.168.0.2").To4(),
+			"sourceTransportPort":      srcPort,
+			"destinationTransportPort": uint64(443),
+			"protocolIdentifier":       uint64(6),
+			"octetDeltaCount":          octets,
+			"packetDeltaCount":         uint64(1),
+			"flowStartSysUpTime":       start,
+			"flowEndSysUpTime":         end,
+			"tcpControlBits":           uint64(0x02),
+		},
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	agg := newAggregator(aggregationConfig{Enabled: true, Window: time.Second, MaxFlows: 2})
+	require.NotNil(t, agg)
+
+	now := time.Now()
+	flows := []record.Record{
+		testFlow(now, 1000, 100, 10, 20),
+		testFlow(now.Add(time.Second), 1000, 50, 5, 15),
+		testFlow(now, 2000, 10, 10, 20),
+		// A third 5-tuple exceeds max_flows.
+		testFlow(now, 3000, 10, 10, 20),
+		{Type: record.Options, Fields: record.Map{}},
+	}
+	flows[1].Fields["tcpControlBits"] = uint64(0x10)
+
+	publish, aggregated := agg.add(flows)
+	assert.Equal(t, 3, aggregated)
+	require.Len(t, publish, 2)
+	assert.Equal(t, uint64(3000), publish[0].Fields["sourceTransportPort"])
+	assert.Equal(t, record.Options, publish[1].Type)
+
+	rolledUp := agg.flush()
+	require.Len(t, rolledUp, 2)
+	for _, flow := range rolledUp {
+		switch flow.Fields["sourceTransportPort"] {
+		case uint64(1000):
+			assert.Equal(t, uint64(150), flow.Fields["octetDeltaCount"])
+			assert.Equal(t, uint64(2), flow.Fields["packetDeltaCount"])
+			assert.Equal(t, uint64(5), flow.Fields["flowStartSysUpTime"])
+			assert.Equal(t, uint64(20), flow.Fields["flowEndSysUpTime"])
+			assert.Equal(t, uint64(0x12), flow.Fields["tcpControlBits"])
+			assert.Equal(t, uint64(2), flow.Fields[aggregatedFlowsField])
+			assert.Equal(t, now.Add(time.Second), flow.Timestamp)
+		case uint64(2000):
+			assert.Equal(t, uint64(10), flow.Fields["octetDeltaCount"])
+			assert.Equal(t, uint64(1), flow.Fields[aggregatedFlowsField])
+		default:
+			t.Errorf("unexpected flow %v", flow.Fields)
+		}
+	}
+
+	assert.Empty(t, agg.flush(), "a new window must start empty")
+}
+
+func TestAggregatorDisabled(t *testing.T) {
+	assert.Nil(t, newAggregator(aggregationConfig{Window: time.Second, MaxFlows: 1}))
+}
@@ -16,12 +16,26 @@ import (
 type config struct {
 	udp.Config                `config:",inline"`
 	harvester.ForwarderConfig `config:",inline"`
-	InternalNetworks          []string      `config:"internal_networks"`
-	Protocols                 []string      `config:"protocols"`
-	ExpirationTimeout         time.Duration `config:"expiration_timeout"`
-	PacketQueueSize           int           `config:"queue_size"`
-	CustomDefinitions         []string      `config:"custom_definitions"`
-	DetectSequenceReset       bool          `config:"detect_sequence_reset"`
+	ID                        string            `config:"id"`
+	InternalNetworks          []string          `config:"internal_networks"`
+	Protocols                 []string          `config:"protocols"`
+	ExpirationTimeout         time.Duration     `config:"expiration_timeout"`
+	PacketQueueSize           int               `config:"queue_size"`
+	CustomDefinitions         []string          `config:"custom_definitions"`
+	DetectSequenceReset       bool              `config:"detect_sequence_reset"`
+	Aggregation               aggregationConfig `config:"aggregation"`
+}
+
+// aggregationConfig configures the roll up of the flows sharing the exporter
+// and the 5-tuple before they are published.
+type aggregationConfig struct {
+	Enabled bool `config:"enabled"`
+	// Window is the period the flows are rolled up for.
+	Window time.Duration `config:"window" validate:"positive,nonzero"`
+	// MaxFlows is the maximum number of aggregates in a window, the
+	// flows of new 5-tuples are published without aggregation once
+	// it is reached.
+	MaxFlows int `config:"max_flows" validate:"min=1"`
 }
 
 var defaultConfig = config{
@@ -38,4 +52,8 @@ var defaultConfig = config{
 	ExpirationTimeout:   time.Minute * 30,
 	PacketQueueSize:     8192,
 	DetectSequenceReset: true,
+	Aggregation: aggregationConfig{
+		Window:   10 * time.Second,
+		MaxFlows: 100000,
+	},
 }
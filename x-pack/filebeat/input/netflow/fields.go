@@ -19,5 +19,5 @@ func init() {
 // AssetNetflow returns asset data.
 // This is the base64 encoded zlib format compressed contents of input/netflow.
 func AssetNetflow() string {
-	return "eJysXc2S2ziSvvspFDOHvWx32K6y2+7DnmYndg67O4c57A0BkSkKXSTAAkCp1E+/AfBHpAhQyAR96Imx9X34YSIBZCYy/5r459NfD/86C3M4iRoOwhwqkKC5hfLXw9/UQSp7aFQpTrdfPyUz/nJ4g9vvBwn2VKvrp8PBClvD74e//A/Yv9fq+pdPh0MJptCitULJ3w//8elwOBz+LqAuzeGkVXMYfnngsjz8459//8f/HRyV+fXT4XDyP/vdQ345SN7AvCn3x95a+P1QadW1w98EWnva4q/Dz+btzdt0rUx/OTb6Brer0uXs7yNNuz//OoOHHdRpal5DoXQ5oI5QHo63g3XfBy4g7a+fVt2Aj1ZpC3rVlfn4n3Tkv8Hyklt+0FC7T3+w6mDPMHEfSriIAg72zO1dQPp+9R3+dcb3OGHz3vKy1GDM4t/ic/ek2+7Pfw5d/DfjhOCq9NvYxkHIwz/++bv758NJ6YbPZ2/eJ6M6XQATjy33vaqVrHBd+t+jAX3h7p8PpWq468ff3JRez6I4z2ftcARHbyIds6IBY3nTBjtWcgu4jv1LNODl20Gd0PXfN9J617r2WSPqWoQ/GH5q/ktdPWopXa1WhftgZ24ORwB50J2UQlb/7j5h3z4USpaxebqANkLJYB+FtFAtVkdCN8fFOBAfOgNlYOnxo1F1Z4GB1mq9AEvVHWsIwHr5ZK1SNTuL6szsWYM5q7pccfgp3mao1TWDQFvW8LYVssrtyoxpty61oFlnQBP7dipYq5VVhapXkFEwNlHM/VVUya+hUslbI/70a5+dal4ZRLsLsIXiLMV7t249TtC2tSh6+LEzQoIxv2io4cJlseaJzNmMpOAWKqVv2FmYUcwWGo3A72IZHThb27JOC2Yst8JYUaw/iTkrbVNoDOhfeAXSUijEWmwTUPSRm+64xxe0mp9OovilqLlZT11EiLRlRS1AWjZsyqzfS/iHaLoml0XIHVgMgaHlxRtY1CyoTlrmDlVMg2mVNICHS7iyQkkJhfsieDy95QnJzsJYVWnesGPnJuHLjlxfd+R62ZHrdUeubztyfd+R6zcCl9VcmkYYQ5JGj+ZoUc5UJJkaJEN1jHNP7fkDHt31JR7Xd3eRAZ0790EW9DhCLKTR5H6QMA11PPmfB78rWWV5nT0PQRb0NIRYcLPQM8zUSt6A1kTEMa2IEMMyBppjDSU7aV417vDilWcqvCvFgAgYhiKYC2heAXPXC821Fhc3BBE4Nobxx6p1p30rZH9u5IbJrjkmt+/wEj4s4+UfvHAjJjOcVctEe3lla2vTcIVqn6O/o9Gthkte7wdLFAV64bUohb35e876m0VuGkdxcnf1Uuj+bJmOkyVhih3Krwp/yXf/Sb/d+vtH6BIVnpP+57hrj8eI03jzAVkJCYh7W6HqGhaK4266WnF4812MQmlWgLZ9X5K/5h2M/jQLKE7071Dyp1VNoyRrtWrdoAHzmZU8iRJkAayGC6zNPDEjnLtPUaZJyoxhjlc4txOwstPDRT0iIdERjyzzDQYzY9JqXrylQ0puOTveLKA2IY+qhXxze5jb/XAqZgUXfyJsYY/ooLNmG92b6A3TUAt+FLWwtxXDUakauAwwQG0588oVNWezDRQtnCtwq+EkPnKwrAZZ2XPyN1uy4PTICkzv/vf87je8iPY+uoHMCahaohQVGMvO3Jzdph4wAEdER7i2i/GWrU5sOSnR0dDoNmSTSBiXllTC0ZGXP9aJaYdh3rnQI5SG8c6elRaWW3FJPgg4YIE7+zhIYEOIi6k0rPlg8FGcuayQDTUffoGChpBjYrNNaUrkqc6jPljvicXMYGs1pa13AkRjNygH6kDfposzZmBaozdjaZhRnMFHK3SyRhpAuPvziNJw0mDWuvsZyur1Nr2NMaAFX58cN0ENQTCM4poC0xfsFjKitHCqYz0fT5GW6woILV5BVGckzlrM3NsPy9zxDDGHyn55Z0VnrGpAsxIE4hj3iM09ICz5oh8otgyX8GFn2aMnl5oH7xDxL+fxhMmkjdmAvgi3i0pjuXyM1tkUmjB+p25YXuEY8BOtVdtCyWp+A/2VqcKCZf31AnWzCNH0dkoKTW43ctvvbd4ZHRgICD0wdvSJMyFLWF9QwjiofHjLUSteFtxYeg8GJm+oPfHkyI5HWPjIsYltzzcjCl5T2+6k2GXoF31KXv8gC31rLZR9SI2qVbVe9FE1ibYBDoDg3MYg9gxagmVn4CVo5KV1Qrf8VitexuBRBTMR9J+DDkedYPuTJNG/0UcO4qWwh03BXeu4we2Z7uGmsC0zVgNvUIp8gM/sArHQtM323ZzV6jqYpyhraKRpwBheQQ4FVY2PoZ8UK/eERRvI5kicbWpCUq06PYEPaeyDXdMPMPBhQUtejx1mGni9vk1FZuskNFx5XTMfP45AGcv8YZ5JJRk0rb1Nm+7oLUqOpOvpVkQ4k25PMkCPXMqAfyyqx30sJuOyZIY3be1kIHX+/WrjhRWX3jutOsR378HWanHsbCAAaxuI9sv1qNGx0IhCK5x34ZFgw4G1QQCyHI5m1C44hk1s2H02w6JdbxNWckltVgM3yK/lYMTWzM2wrsU46T0UJ/mirKly/wY3d0R22j0QHr/RZs2PUHstjUH5le1UrHe++sPBJdmuE2AwLS+EXN/vtgighmLaovG3kyUJ9ZK1ZCFflWY0SjNeV+7CfF7vPNtCYCzXNlMb9BxUfTCiaRqhRxN1Qg+mA2kL3PJjDexUd+bcb/v4z95TtMDfcFilr1yXbgkZy22XfkIYrwHhRxPPUKIEaafIjdTOjmh1OhmMtfN0ZceaF2+q8982WZR9FPdJVJ27+GB8ew5pDTO6YKZK/hbX4eOHAyNSQIit4srgw0YOmVsTOaEYrzEjm2AlmAJxDryyM69PTLUgcZI9B55FleqYWOB06JoTxTX8gxlARVmfrkx3NcLBcLoy0zUN1zfWviH1xJX9qSSwlgvMaXqOCjpDwriqVsfZHSjrzVqlwZ1NEn/tPfGDV151tg0cg6Imb4/tzwJh92N0aXikkMIK7rYtjdIXPbidTEIRBZcGDt7SNqD9HDHNZeWDcOnYwHxtYKcDD6nlR3R629a2Tq9bp4yCNrDo4vPQ0QoTM0Zto/sLB2vPmgd8wM+w750PNVFmLRtp0AbsWa2XXRo44mTcBvfHClaoEmE6mF7vsfDrvWiTomha35Y3NqUeSxao9RVmC+V+sdFgdIRr8LrdBDB6lCMqfZTVgEoHSOXOS7OYPILBcWQZ3F9DUGAuDdX8OdLk4sl+FCH7jAg+zLkGfwr2E4z6MiESynvfEBHGsh7EB11zKIrg6QQ1iH4vOUKVvJvEWUCmHm9CHAYaLjGPn0MknRSBZ0Ybc7qby3Okwnp8VjiM03MEN11tM/2WIxXZgToSZHtQRyKMC3U4hjJbtLj7eQ+0SveaLvUWc4fhnrbdvzJRDY1w7Mq3oBsoBbeAd+94NNm9I1rWv3RRpzE4BQEtxamH9aeGVol0F5Foaf7qO270AuFcHCLnkZfIeeM1uxNFhhz5wnccecithgLKYLhsHGSgYKZdR2hFu7kZARBHBQL44p1COt39JxZnRAuXV6ZazOtyD9Gqs6BZwJYUFoXLdwYfFqTx2UG8QCe3N9tSkof1IbjbSK8m7LpMgSG1moP2eb+wDQ4oSnvSMC7NFePanYBeEQZ8rM+RPpSagBuvIx+YK6UHm/6BKGGGjGWFsNiMLnesOyvoW/gCnQ4ntl5zK2wXaPlUKx4VJwdUsqIhNVQ+lxFtvANa4tFD3BkrRHtGi/MIfoMbOiBrQYAzSHmoN5aclUFfgCZwp9d7TgqMYqBxaNEYwUx37FMmYue6/o3xtkUc2GYgwgzV3IIsUo3PHqLhQvYFTwRkN7BnMLogKiyHpCosj6UrrDmc2DpJYXkgSWE5JF1hzdCE4dqzBm4jdsfQGWgJCtgbn4BwuqnmuoJRijHyuzD54cU/02KYGbYfgJuu8be9947r5JRemQbLADynGwaqmJFvC7e8PYWfAUXOpUECrN8/SIILYqyFBFZwXSLGriqm2uSBqitoVghWi0asxxZLh9Bw/ZbYn4Z/sKM4MpBWi+Qv71ADYsrqiYEOsdeoSBoHzIkmfMCj44cWeHwE0QJOgA7hLW6+pQ/J0TDkFUo9fTgaUqzuLHSBICf3oxwCJZquYTQbxogetFMGA8IU0gCXQyR/elSIBw1fBAXrfcxN+Y0VZyjeQpmoov3ssaZQ6a6hBixoUrR7A1YrBpciBIkeDu4ojAOrEUdW8NZ2esw7hvWSeAYlrQ9Hwj4VmoNx5zWH9A4udHitQzaq7GqsecYB1fEPKIg+vhl+DNYLbAAbMzWAyb02N2kDISEpUB96w44h59fzDvdgXC62FbziXSDHQiJ4nQL9mfZeMcQzaoQO+St8f240VofCqVOnUOFW1gLrV7cVxVvyhvLI0EkjKgnJakwcfQLmDVmPASXxsCOG7YQcLL1iwB94lgyEI8+SAA3OOQQM6IxDwMCAOQSoo6jBG7AQmqkHNUaYEqOBlRRW+V15fBtxP9hipzrANZMaLFtbm+HNh7G8eGMltOmz/gjGfbNHNNI1F8N/+ZzLsE6KjSRYZ8JGEiRHgsUI1jmvkQTJUWUxgnV2ayTBj1yCnygCkn95gaR5mD2FVe0wAvhItjc8INEe+TUe55R/wJPyvj1w0BXBnQOzBzwgMRcYB720svdesz73WNUJE/I4RTlsMR3vMLeXFh0O1EPGaCIfb2jgvfPZO1EJb3ui8dI9WhORJgrPoaEAcQHNrHqD1CC9e0CYhnuhjBMv0u9hkscey0TmzgGENKIEZi4FJjpyQCLT5jiU0qLy6Y5kRYtO8iSdpfbalzdC9dgjcFdEB3vvlOUMPgqAEsrIh4m36g76kcvNZrPTuxV0ixglIcGagrtTZsHHSijoxwYjiWacc2+8Rc7yHY92m66xOIdtAC9kwfWQyAuleZZcFprW13bJGVAnhc0d1b3ojzuEd+30hH8XMsT70ztRwYszMA2l0KPUzVIXF0pjlFEiKykh8oy8J9Q2eSneobOhnbmQPsIQ42HboErfk4MklInotPaPQEUB0oBjM12TbPy4E5VHVtSWEk2y4HBHhSM39HVWHlmtKhGryZYwjO2KcE+/R3lkGt7xu8QjAUXxD2DT4q4Va3jkSTcOnjcHHT6Q8Y73liWaBPSv/Hr90FfIKIYaAWVYPSZ0J8bZG1L2YNUgS9C7dXKg26N/pPeSDyT9k1qyNIxvEccUy3+GN0wMU6HUm8jrzEnpKzsdaQI6EdQZBLjnoAEC7KPQAIUuLlmT4PA5c9Anp0ZEEgY4Or02AaDwhHDIAM1FIHLlBvAfQ04PKJ1oZVCZXNk2ubJtWK0iGUIww8gTTpMpnIYZHwCXqWnmNGtTczJPf6ggjiV+pIibBSYKd5t1F6bBm93ywMEmYRgjDTpQYUVBLNka57Ggm6maBOUKtkmIyta55CzOXEqfHSa1CmgU78vK4I9jS5bU+qFR/C69SK08GsXv0ovUmqVR/C69SK12GsXn9KI/rGbdOWc8Yu0KCfkhgtiad7II2eJTF1pP4+2n2qqIQYFAdhWyVFekxTxIl6VHPIXvUAk1T41KjJL8IWx6jE2UZXhzfK9zmznjw9fDG4IeKT6yF0VviN+hLzarL3fbFL5CxZJqeGnYV/BgUpH6k3mPdBQ0o4JDejVFl/76t7uuwbwrWrEM1WizWLy2Yw04PS4MIlHkkmes8Nu1JbcQt16k9OmBa8N0kcJGXTlj6eIMCRso9tkK5mQ7bAU9XaYen5Fk6PEZy356fEaaJwF5+vceUJ+xIEaSqqO4FeYMGgZFnuz/D/MYIPuY5jTDCvfJdpiB9Tt+Atnw8IJEleMjHf0uNGu/381aXgHZy3ZnIIE/Zo4jigesMeLu3AvaOZ7OgVadrzUsiJI1aEhrCYaeCUsw8pibqVU1JgWkyM7AgK1vtCJw02Ysb9bXn2djyPTCdvJNqqv8+ts6hDIZirdLTFC8MWGC4i0AExR/bZ+g+Lv2BE1Nrx6AriMrk6HrmMpk6DqaMhX6gy5NP+jS9IMuTT/o0vSDLk0/6NL0gy5NP+jS9IMuTT/o0vSTLk0/6dL0ky5NP+nS9JMuTT/p0vSTLk0/6dL0ky5NP8nS9PKZLE0vn8nS9PKZLE0vn8nS9PKZLE0vn8nS9PKZLE0vn8nS9PKZLE0vn+nSFHh6kgylS9MXujR9oUvTF7o0faFL05e1NCUczCc0XaC+0AXqy1qgEH3+upapBNvJhKaL1Ve6WH1dixWmz4jk2wH0WrgwaETu7gCaLl9f1/KFaXgtYgj0S5aIvaxFDIOmS9nLWsoQ6+plLWUYNF2FvdA3xBe6fL3Q9dcLfUN8pW+Ir3TN9UqXqVf6hvhK3xBf6dL0SpemV7o0vWZpq9esDfHbWqYw6LVYYdBryUKM+xtduL7RhesbXbi+0YXrG124vic/n15jA6abZCzdPPBCv7y+0m9lr/Rb2Sv9pPIaOGukTvFrYLdPx9I/7St95b1+T5/k6zy+Ap9wsE+P3idox9R1WRX3RTWqLDN99T91JaQnnPCZaQ4nnmwCcn0GdTSgL32M5hCYkuxDCWBxHsg5gS8GQGu7h2Kcl3O0z9VDSF8T4EAnsFlx4FPYrCgo8LGCLEF+iIlGszOMUpdMdk5R1fL3McdUcijC8LS9ZvRq8kEKXPKKicJ9bMMK1bQ12OR3lg/woWwKFd5qMOmv0CdwlsIy7CRkBZq1OlR6JK6osKmolcG/eW+/tsxCcZaqVhWiPAA50zZ502h56RN84tbAkDIGM7A+xwxIq1WbHFqwTGqDrE3XqloUN/auhvoOU5FfdhaguS7OqeFmM6b3DnxevFCJsDRwqVWbqqAesbh2NWIT9r+OlwvbmOQJJ7uGuf+LeJMzQ/v4SiISWmQQYtuHzho7vmlpeBFVv3Gh9izKfnlnRWesakCzS82DWuxJVzwJDZtRx2nE5xRzGjnwZYY8MjPJ+ZqDoAIdR+OT8uzRmwBTVp926MwOvaDvTCsOaj/Iicba/j1Pu1zyPtbMqxH3H+Si82xGdbqAXKJlr9BnyRgL7jg5sQxjonfjTkDoQZasZ0l5nnznSfYwZfQ9CL9xaHHpo5Qt6FYLg8yi5p+8as54izkUDyCfjiW2Uz1Fj/kfCm6hUsH08E854rXJtocLH0PBxVAc/sZk92h/LT/CmV8E5jX8CK8qQ5jsjJwZC4qTqAGXBGYBr7msOlRY8hKOfny/gKNzMjygaRXLgyT4q+SSxbRKGkA/fl+woNJLLJGExBIjQTBT8bboCFmoZuN2mojGlYJdwc2Zt+5/URe3GEnsldzTLzcVeyXpHreAhhSrwSQtwR35Efsdj41kmt6eNNXZSpE/+4SmffYJnvPZVyTkz95q1YIOPMZ4ut7eFdzFb9y5VjSRkmJBklEEKSTTjOT0ZCKh9kRzguLS0CgLxMVzBxNWj5aBmrLPJGZ4Q+Qawxk65wSko5khnVDuJXu2Sh48pSEm1BzhfmcbjxlsVsIeRdXn+cNXKWkNdKViV6F9xkSrVc18I4/4iNqZwfMukzOi9NPxDBQ8YUZFVgM3yYlG+x8zC5iSuRpqbsUFGGgdOHTHapr156wSkNXn7zDc3qPBHQuB8aNRdWfxvR3gUslbM2SjiyQW2PgWIRLvxBDvHeqjDkSz9EHDZQ1bSzNERSohFCKqtOqw+YRDPJhiUyF8fg9Md8ye4Qto/2jXJ5PUWlx4jXn+OvIcq3ahgbjBGRbmPD6ehJd/8MLdXbOZSFX/Yyw429acpdVw2WdUg9koh+LCa1EKe/P3yWTD/cjQux3SkwEscTRBffDkoSunTTyqrsfUz08jPrYncqBSmhWgbd83ysiUPAnvQGE1XGB9tn2m/mfBW6Zrpjze0SiSZ6PKy4098vhaDcebxb3oX6BrId+GKrqx0hpPZ3dFhPIXxliwx50Zi4ZC6dIwDbXgR1EjHtxPPN5Y7c2KpLnNOSlGSfoyKntwIEuxRNhomnpFkj+s7/sNi+QpCBFRXVcTl6jAWHbm5twXu8OKoC87Uwwhn0ydHiYtOso82g1ZzySOSxuWePKj7c+44/BTvH1POJfxEyWsjcTPVsYjw14LZcmLTYsSoclx9kUo0e6/JQ95yvNmZDAzEQoMPeHZuVuWIyoOLLnoH0artoUyNx5lkw7vrH6k26tbe/WH7MOPEGX0yFi3t55OokDFCo54qHzFrKNWvMwLnXlg9Pf7Ew9Yd3Hw8Mk3iaM934zwHqW8vnRS7Do1F31CayCQhb61FkpStO+dhXiJHYDBb/EMas+gJUwPdmiH0onlSfXJpwpvItosnougIV3MxurJhW2ZsRp4Q9LeU1R/lj2gZyFVwh8pTkLDldc1rl7gDG0sW73Oot2ce7KB4silxPh4JpKaV4ZxWTLDm9anS0RPibst88L7BKxoQHWEa09PYq0Wxy6UyjiNoN+nMgxPPYvQuHKjS/RoJNp8NZXSjTtR3nhAltPM5HWpr1C9B0f+iLYegyVSRJxlKV95SEaa07q5GYbLtbqgEGWdu97uVX4pHfAKwylTb+0dK4/nM5mWF6GaZClEUENhx/d69FPrkiz3UL5kyz5Sz+iUZryu3NXsjEjwvSTySbj3UQ9j0fl9WPJURM+SqSR6knwC+krvyz35uCdMvb8Jr3lfjhlVGnqFHsMvIiGjiX1Qp5MBgq6qNLA3SH1ZNqK8BXWwpqrOtgEt+WT4nqHXJP4pJb7nnmEosS0MaJJFtydppwtBZBpxJMEjZwJFP5PDM7GGY2/hAY7AvCZwTOo0qyePLOi+iKJp+xdeor2s03Y8EbAFOjm5wwLtfrnRgacSuiZBPAddk5BnYUTjZ6Ea0HigVBrKuesww+Ixsg32wMGXuRddrjlvFnzHoAavjf24SRMXIssJIQoRUowEQZ6gqZBERYuuCDH12sYXR9jhQ8afGOO5DDRcWlGgjwohsk4KzMvlO9XuptqRkmofXeEpxtqRpOlqu5OddaTMNgCPRLtZgEdCigl4ODAxW7S0c+uQe0OhY00f4bSY07uUZKrFkYaqeSzoBkrBLdANnhlv4WcUOc/hZzRZlvU7fjS7UiOfxB7xj2KP8MfZiR71kjCA32FS8AkLZmADBTNt6nu2GXDTv/Ecbdcmq+edvUTeHD5FXl6ZOBNavLwy5fUIWhk5qFadBc1M4O3LpnBdvvvHRdKXYeoXD7r92YaHHXbNdQWUFHZ3gtlpnG5x2+lQv1PoQYCGlKoryLfT6DIyiD3wGahiF4IU/FKr+UsPXrUFqajWrCAZzQNYCwms4LokzI66gmaFYLUI1eJ7Fhjd8I/JtUuyjjqCPVxKDzxkm/GCh241XtDQKbL8wg3/EE3XZG6NI8uwDHdgIuy0Q+U11pTfWHGG4s10az/H0+U7sphC4a0dDVjQWSEDDVitGFwK0rumO5piq2mEzFynQu7k21kx0dfqkiljtS6JyCR7LLeBZYflNjBRlpuSwio9PQe/8PquV6nTE+CcSQCVta3N4L42lhdvrIQWP1OPJLT5fmQh36BiTIEaQmSudbZtMhWi1sYzKkThjWdU69zcZCpEYaFnVOvc6mSqdV55MhWi6MKCKsvksGDINTrQM9hFGMjmnDUPzaLzwJP1wOmBaw/1dGej7C8PDJQDjaO4tLI3aQzPZ6pOmDMl+vFuitdwf/x94oUN5ArYFmvJLS0m1AGH/M0+NHUQGqaB1+vDbgJZq3zCDEL/PZJmbHZwyvfMLpFwJ6KVSpjwOSUT7iT7lD4Y+bJrCISIkLUE4hSU742rLZDeo9wX56haA0gqIk2mmXA3+2C2EO9lEdyuLfB8DeRXGdikou33mVUHIjTY6gMRGlwVghVJTjWCiYxalWBGQNSZhCoFI5ZarWDC5wYJZzvw0fUIHoDYugSPcFJ9gokElah/gSIk7A/gCYn7AyzIBP5BBnQi/zvLHgn9l2w7PbLOSfC/4NghuGGPhP+PXHQ/+h4FAOJcGdpkt4IAzxh36eOOnduxV/mbwg6FA5Zc2eaXfQsJrFkzCwo8EGYWFnjGRjtBZhca2CDK6NEua2iX1bPPutlnxeyRq4RWoGBCZxYquPOQc7NOFHk5WkM0+6xPSs7WEDhoIXn6hcgZVkc4MdPqI5wW/apPxcu3b5/ZH8K6i3GGfWfFRLbuPDDRbTva3gMmKeYvhzfw3vmchJFl91Q+Nl7qP2l+RDaqRHd9xNIu0yNac1mqhvpaeHooHH/1mjIKx9C/oc7sRh88QHqvPHHk9mHYl3aicYPBPh64c6i2qylPRO8MWh1jqRuf6b2JhPC2cZe37Otn7OhueKWUm9NjDCocjJNlbB94rmgyQx3755V9wtRB56G30z0euk8c9NbF+DiwN4yOhYF3ohvfoWbTtW92x855tty+EfcLMD7onBSzZxpe1zl+vNyb1BxPSHO6hhMDAHIvcnN81jgyM7XucWvayRJgdJGVh85YniFXlmdOguWy5Lr09drGwhx5+ekDlLmpy8zNWGi8EyvbsWuLlvHiLePePjBIda2hrIa3wJQbsyMar7lH0tNYxzC/4dJE2L+rFDJzSrLe4jkC2nsmh2zNObPz2uRYchzDs1tcAkOvkOjf0Nxyv2Gnq3wGtx68u4Nyl3UcVyFLd48qeE2wlswZSI6ueaw1MYvGfVPJtXrOtqdcq6XtpIQ6yw3dlXvoG8cyvsGgbgmOI2vBdNI7FqhPyru2zXkv5Wth0U7CPmWQD3T0uRG9JzZcNvPJEC5C266Pvr9v/P59OeVdSpyNOMwHvn1Yuo40Nqpt/aLZidbxq2uyOHO/ZPGGPQ83hjLYq22JZ8p8Q2++gTfbsEs16OYYcvEGXIrhlmawzTPU0g20WYbZHIMs1RCbaYDNMbzSDa5ZhlaKgTXLsEo3qOYZUrMNqFTDaY7BNMdQijeQ7mQY3ckguo8hdB8DKNHwORbJwKIIZlIj6jfG2yFqByElVLMq1ZxKNKNmm0+pZlOiuTTbTJpjHs00iwbhJnJLirMQjarG1L4iADOgBa9jB7H44Ce8qCS3ncYsvRE7VXyUyjJ+CplBkCRHOClaV3BpiUbcUFDB50RFtipa1MM7j6kFSFpfVdPqQePh7sAOrY5/QBF5tbTZ4QEYzt27iWy7Yy0K9ga3jV05lSGiEzbhQ+XyyGeKL6oBh9yMKK4NsktjR1cG3oUREzOU46LkNnDKpjsssh0VdAdFnmOC7pAgOyLwDgi644HucKA7GsgOBrpjge5QyHEk0B0IZMeBhaat3X6JMRHak3VCXgNOp3pY0OoTh4gGjOXNOhY/Mvvj7+/F8u9JW35BXtkznCnjARBjhst3vOzgcMlwtOQ5WHIcK2SHCtGRQnSgEBwnDoJDZLtYLqK1UHOfmCLZhILyx0Qb3scLk+d9SfK6xEaA9bVcmivXgfKJ3FrEtjGwrBN7k2gsSN5nwbcRpRc4+YaxgdeuaVjcKl4SjG85kj/aAj2ztxA6P7N+0NGkwV8+nOwN6fG0CpwdYsP3yxz76gXvHLxybtwhtRF/8sEq7JOhprZIdCoSnIlkJ+KHP0jNt8Ax/41oWaI8rTlw4tDjB0miNp8kiP8fAAD//4qByOE="
+	return "eJytXb+X5DaOzv1X9LsNLln7zUz32DMOLtrbdxvc3gUbXKbHklhVdEuihqKqu/3XH0BKKqlEVhGgJvDY7f4+/hAIkgAI/OUvaX9++svTv86qfzqqWj7B3yfZSiOsrH55+pt+arV9anSljh+//JRI+Jeffn56lR+/P7XSHmv99tPTk1W2lr8//ds/pf07/OTf4EeV7EujOqt0+/vTf8APnp7+rmRdQUeMbp7G33wSbfX0j//9+z/+7wmp+l/gF4/u1353kJ+fWtHIZVP4x3508LOT0UM3/iTQ2sMWfxl/bdnesk1sZf7h1CgM/E2bavHzSNP4519n6WBP+jg3b2QJ+BF1kNXT4ePJ4veRF9naX37adEO+d9pYaTZdWY7/QUf+W1pRCSug9Ro//ZPV0KicuQF7USV09izsVUB8v3yHf1nw3U7Ysreiqozs+9X/i8/dg27jn/8cu/jvPQoB4F+nNp5UCx/yd/zfT0dtGrGcvWWfej2YUhbqtmXfq1q3J1qX/ufQS3MR+L+fKt0I7MffcErfzqo8L2ft6SCRvo90zKpG9lY0XbBj8MEkrWP/Aj4n3whFofPfN9L60GH7RaPqWoU/GH1q/gskHFFr6eqMLvGDnUUPMyLbJzO0rWpPf8VP6NuH6Wqr2DxdpOmhwWAfVWvlabU6Ero5LcaR+GnoQR1ul544nYw84VIonHbaLMGbCbrT6j+H5gAzAd/EMT0ZXdewwoYOp2AhMX8FIZKtmz7/i8KsheDaJ685QKe33RBSHOLQ63qwspDG6K36qPRwqGUA5ldX0WldF2d1Ohf2DD8467oKj/8+Awwig8DYohFdB7KS25UF025d6qQpQHQMs2/HsoCVYXWp6w1kEuu7qAJ/FN2ittBWtx+N+tNpLpBocdpKdLzdFdjK8tyqH8O29ThB19Wq9PDD0KsWpvNn2I/kRbTllicyZwsS+EuetPmgzsKCYrFgeQRuD87owNnarhiMKmAbsKq3qtx+EpApY1NoQBJ/FrB7Ww6F2optAoo/8n447PEFrRHHoyp/LmvRR/TzFg9LuKwVTFQxHikKvxOKd9UMTS6Landg6RkMnShfpSXNgh6gYTwSFqC7Ot32kg5v5Rv8W9vKEr8IHc9veUaCAu6tPhnRgGrBSfi8I9eXHbmed+R62ZHr645cv+7I9RuDC1RC2zeq71nS6NCCLMqZiiRTg2SojmnuuT2/wZO7vsbT+o7XMDh9Zc59kIU8jhALazS5HyRMwx1P/ueh70pWW1Fnz0OQhTwNIRbaLHiGhVrJG9CWiDmmDRFhWH0vG7g8wrXYiFODhxenPFPhQ6VGRMCsFcGAJMHZtsDrhRHGqAsOQQWOjWH84dThad+q1p8bRV+07kpOwLfy3Rai+kOUOGI2w1l3heouL8XWVjZeobrH6F/J6M7IS17vRzsaBwpfS8FH/3D3nO03i9w0DgptIEWljD9bpuPaijHFiHKrwl3y8R/pt1t3/whdosJz4n+ddu1xGHWcbj6yPcEdmnBvK9HktFIcV8PbhsMZH2MU2hSlNNb3JflrXsHkT7OC0kT/CmV/Wt00MGed0R0OWlI+s26PqpItrJpaXuTWzBMzwuF9ijNNbZsxzOkKhztBUQ1mvKhHJCQ64ollucFQZqwFYPmaDkHHRnH4gDssZRNyqFq1r7iH4e5HUzEbuPqTYAu7RQddTffR3lzcw9+1EgdVg3LdMBy0rqVoAwyyBgqnXElztthAycK5AcOGdFTvOVhYVO3JnpO/2ZqFpkc2YH73f83vfiPKaO+jG8iSgKslKnUCluIs+jNu6gEDcER00LjZltMtWx+L9aRER8OjuyObTMK4tKQSTm7I/LHOTDsM88pFHmHbF2KwIKwKjdeX5IMAAkva2QchgQ0hLqbw+817Id/Ls2hPxIYAiAtUGhlyTNxts+0r4qnOod4L70emzGBnDaetHwyIoW5QCBqk+ZgvzpSBGUPejAHVawGfu1MmWSONINr9eUKBfKC3j4yyZrtN38f00iixPTneBTUMwQCY4cDMhbqFTCijUHVs5+Mh0gpzkowW36Q6nYk4aylzb+GGjsczwhxq+/lHUQ691Y00sOsowjHuFpt7QFjzRT9QbBmu4ePOskdPLrUI3iHiX87hGZPJGzNaOhXuom1v0ZtNuLyE8Tt1w4oTjYE+0XAr7mRV1OJDmi+FLq2EFeCuF6SbRYjG2yk5NLndyG3f27wzOjASMHrQ28knDmJQye0FJYyTJxfecjBaVKUAEnYPRiZnqD2K5MiOW1j4yHEX250/elVCd5ltD63aZegXc0xe/3DENB8dRpi5kBpd69N20UfVJNkGOAKCcxuD2LM0LczHWYoKdDHt0jqjO/FRg2zF4FEFMxP4z8GHk06w/iTJ9G/4uEe6FHrYHNy1jXq8P9Me3pe2K3prJBwCKYp8hC/sArHQtLvtj6GSo3mKs4YmmgaWEnp8Mii4anwKXOVYuWcs2UC2RNJsUzOSa9XxBC6k0Yfqph9g5Ds03MIETxGSIHn19jYVma0jrLI3UdeFi34noEBNu8N80aIrpOnsx7zpTt6i5Eg6T7chopl0PckIPYi2DfjHonrcxWIWoq2KXjRdjTKQOv9utaGV/eK903ogfHcPttaow2ADAVj3gWS/nEdNjoVGlUbTvAu3BHccWHcIJMyzP5pxu4AMd7Fh99kCS3a9zdhWtNxmYWn2xK+FMGZr/QccqTqKk95BaZKvqpor97Aa8YiM2j0QHn+nzVocZO20NAXlVjaqWOd8dYeDS7JdJ8DQg65R7fZ+d49Aoit02qLpt5M1CfeStWZhX5UWNNoUoj7hhfm83XnuCwFclI3N1Aaeg6sPJjRPI3g0Uyd4MB/IW+BWHGDNHuuhP/ttn/7ZPUUnxSsNq82bMBUuIYxDGdJPCNM1IPxo4hEKQwDsHLmR2tkJrY/HnmLtPL4VhxpWFWhE/LbJouyiuI/qNODFh+LbQ6RFC2tZ9Kfkb/E2fvxwYEQKiLBVAO7dRg6Z9yZyRoGCoYxshuELE8I58K04i/pY6E62NMleAvE1EgdnQtecKK4R7yBepChrQJmhJjgYANAPTSMMHMpfiXrirfhTt6AkhKKcppeooDMkjDvV+rC4A2W9WTsZiWeTxN92nvjRKw8rvgscg6Imb4f1Z4Gw+zG6NBxStcoqgduWIekLD+5mk1BEwaWBg7e0O1A/RyDr7ckF4fKxgfm6g50PPKyWb9HpbePLMNDrFpVR0AYWXXwOOllhYsao+2h/4Si6sxEBH/Aj7I/BhZrofisbadBG2rPeLrs0cMTJeB/sjxUw4RXBdDC/3ivCr/eiTaqy6VxbztiUeixZobZXmHso/I07DUZHuAVv200Ak0c5odJHeRpR6YBW43lpEZPHMDhOLKP7awwKzKXhmj8nmlw824+iWp/PwYU519Kdgt0Ek75MiITz3jdERLGsB/FB1xyJIng6IQ3C7yUHeUreTeIssk093oQ4etmIlvL4OUQywHGEYMKE89leLs+Jiurx2eAoTs8J3Ay1zfRbTlRsB+pEkO1BnYgoLtTxGFrYsqPdzz0QrUdO06XeYq4w2tO261dmqqEJTl35gGtkpfCVN9m949Bs9w6c0/1LFww09cEpBGiljh7mTw2dVukuIoCz/NVX3OQFork4VM4jL5XzxmtxJ4oMOfKFrzj2kDs4+cgqGC4bB0EzRd9tI7Si3bwbARBHBQL44p0iOt3dJ1ZnQgvw+7qjvC53EANXTwyp29qSwqIAsoOO2bZ32UGcQCe3t9hSkof1rgRupG992HWZAiNqNYT6rGXUBkcUpz2MeG/7N4prdwY6RRjwsT5GulBqBm66jrxTrpQO3PsHoowZAmSJ7zy5WDwrmI/wBTodzmy9hgOlHQItH0E9RsUJgbCSeEgDp2/MZcQb74hu6egx7gw+Vncmi/MERg8qNSBrRUAzSDmoM5agTYjzkad0SywYx0CDaNX0CtMd+YSP1LmufytE1xEObAsQY4YwF1BbphqfHQTflXN9wTMB2w3sGND1xFNYiOQqLIflK6wlnNk6S2E5IEthIZKvsBZoxnDRiyJsxO4YOgOtQQF74wMQTTfVaC2epJgivyuTH138My2GmWH7ATi+Hcfb3o9BmOSUXpkGywA8pxu9PMWMfPdw69tT+BlQ5FwaJKD6/YMktCDGGoPDSwGHxvSx6xNcaJIHqt8wrFYVtWrUdmyxdAiNMK+J/UG39EEd4IhhjUr+8ogaEXNWTwp0jL0mRdIgMCea8AZPjh9a4ekRRCs4AzqGt+B8ty4kB52FLq9Q6ukDaVixuovQBYacXI9yBBQ+MS14NowJPWqnDAaCKaSRoh0j+dOjQhxo/CIkmPcxN9XXojzL8jWUiSraT4/tS53uGmqklYYV7Q5Iowt5KUOQ6OHgiqI4sBqFGVI7O5gp7xjVS+IY0PeP4UjUp0JLMO28hkjn4CKH1yKy0dVQU80zCNSHP2DT4xnXF/gpWC+wAdyZqRHM7nX/0dpASEgK1IXewJ4XcEQ87rAH03KxbeAnMQRyLCSCtwncH2nvDUM8o0bokL/B+3Njb00onDp1CjVtZa2wbnXDwn5N3lBuGYa2V6dWJqsxwGMC5juyHgO2zMOOGrcTdrD0hoF+4FkzMI48awIyOOcQMKIzDgEjA+UQoA8KVDEasAiayYMA01cUDaxbBRsF7srT24jrwZY61QGuhdRQ2bq6H998AEn5CjtMlz7rt2DaN7tFE11zMfznT7kM26TYRIJtJmwiQXIkWIxgm/OaSJAcVRYj2Ga3JhJ8yyX4TiJg+ZdXSJ6H2VFY3Y0jgA2IiSR75Ld4mlP+Bs/K+3bDwVcEVw7KHnCDpFxgEHrpWu+9LnzusdOg+pDHKcqBYT1T8AnhjNWRw4E8ZIomcvGGPcYCYyIVUsJbTzRduidrItFE4TgwrEJhGm6rX2VqkN41IMzIa6GMoyjT72GtiD2WicwdAhScQSu4pV5KSnTkiCSmzUEUbPAnl+4ItnlWdJIjGSy31668EanHDkG7IiLsxwCnPcxlJ2Ulq8iHibeKB/3I5eZus/O7FXKLFCXRStuXAk+ZpZgqoZAfG0wkphBCOOMtcZaveLLbdIulOWwDeNWWwoyJvEiaZ81lJWhgjPrLGRBG1eaO6lr0Bw/hQzc/4d+FjPD+9EpUivKMRW8qZSapW6QuLrWhKKNEVlZC5AW5JzQ2eSleoYuhnQXcYjHCkOJhu0OVvicHSTgTMRjjHoGqUmJRBmDrhybZ+HElqg4wqZYTTbLiwKPCQfT8dQYctT6pWE22hGHcrwj38HsAg5E/6LvELQFH8Y/gvqNdK7bwyJNuGjxvDgZ6IOMV7yxLPAnwr/y8fvAVMsqxRkAVVo8J3YlxekPKHqywiDHQeq9OjnR79I/1XvKGxD+pZUvD9BZxSrH8Z3jDpDCVWr+qvM5gBoXieOAJ6ExQZxDQnoMGCKiPQgMUprxkTQLic+bAJ6cmRBIGOAazNQGQ8IxwyADNRRFy5Qbw72NOD0xqE7jeplP1ubLd58p2D4eASIYQyjDyhLPPFE7YQF0AXKamWdJsTc3JPP5QwRxL/EgRNwvMFHibxQvT6M3uROBgkzCMiYYcqLChYJZsjfPgq7K5mgTnCnaXMLTzJk47pvxvXXaY1CqgUbwrK0M/jq1ZUuuHRvG79CK18mgUv0svUmuWRvG79CK12mkUn9MLf1jNunMueNTWFRLyQwSxtRjaMmSLT11onsbZT43VEYMCg+xNtRV62UkW8yBdlh5xFK5DlaxFalRilOQPZdNjbKIs45vja53bzBkfvx7dEHRL8Z69KLwhfoe+2Ky+XG1T9AoVa6rxpaGv4FG0mtWfzHskUvCMCoh0aoov/fVvV11DeVe0YRmr0WaxOG0H1z/U46onJIpc80wVfocOw0Xi1ouUPt1w3TFdpLBxV85UujhDwkaKfbaCJdkOW4Gny9TjC5IMPb5g2U+PL0jzJCBP/14D6jMWxERyGjhuhSUDPn52ijzZ/x/mgXso18e0pBlXuEu2g8Ebe5CNDy9YVDk+0snvwrP2u92sw5h9rpftysACvy8cRxwPGD7nnZ17QTvHwzkw0DDWGlZMyRo1pLUMQ8+MZRh5+o8e33uNjy44sjMyUOsbbQhw2mC7bLbXn0djyPTCDu1rq9/aL79tQyiToXS7xAylGxNmKN0CMEPp1/YZSr9rz9DU9OoB6DayMhm6jalMhm6jKVOh3/jS9I0vTd/40vSNL03f+NL0jS9N3/jS9I0vTd/40vSNL03f+dL0nS9N3/nS9J0vTd/50vSdL03f+dL0nS9N3/nS9J0tTc+f2NL0/IktTc+f2NL0/IktTc+f2NL0/IktTc+f2NL0/IktTc+f2NL0/IkvTYGnJ8lQvjR95kvTZ740feZL02e+NH3eSlPCwXxG8wXqM1+gPm8FitDnL1uZSrCdzGi+WH3hi9WXrVhR+kxIvh1Ab4WLgibk7g6g+fL1ZStflIa3IkZAP2eJ2PNWxChovpQ9b6WMsK6et1JGQfNV2DN/Q3zmy9czX3898zfEF/6G+MLXXC98mXrhb4gv/A3xhS9NL3xpeuFL00uWtnrJ2hC/bmWKgt6KFQW9lSzCuL/yhesrX7i+8oXrK1+4vvKF69fk59NbbMB0k4zlmwee+ZfXF/6t7IV/K3vhn1ReAmeN1Cl+Cez26Vj+p33hr7yXX9Mn+W0ZX0FPOOjTo/sE7ZS6LpvivqRGNb5Yd9X/sPYgOT3hjM9MczjzZBOw6zPoA7qPfIzmGJiS7EMJYGkeyCWBKwbAa9tDKc7LJdrl6mGkrwlwkBPYbDjoKWw2FBz4VEGWIT/MRKPZGUa5SyY7p6juxI8px1RyKML4tL0u+NXkgxS05BUzBX5sLGqGpclt8jvLG/hYNoUL76Db6a/QZ3CWwuqLo2rh4Iruaso7F01NRQ0A8pv37gu+8C7Pra71iVAegJ1pm71pdCB0mKSBtgbGlDGUgfkcM5jRU3fJoQXrpDbE2nSdrlX5UfzQY32HuchvcVbSCFOeU8PNFkygL1xevFCJsDRwBROQqqBusbR2DWETdr8dLxd2Z5JnXAsqGP+T8CZngXbxlUyk7IhBiJ0PncWcN14iGlFG1W9cqB2Ltp9/FOXQW92ANrrUIqjFHnTFkfCwGXWcJnxOMaeJg15myCEzk5xvORgqEDkal5Rnj94EmLL6tENndugFf2facHD7wU401vn3PN16ybtYM6dG8B/ERefYej0YrAuYR7TuFfksGWOhHSdnlnFM/G5cCRg9yJL1LCnPk+88yR6njL8H0TcOoy4+ShkuH/AfPTGLmnvyavCJKOVQPIJcOpbYTvUQPeV/gKOcPOlgeviHHPHaZPeHi2XbXMHFUBz+ncn2aHctP8izuCjKa/gJfjr1jMnOyJmxojhi3lhSEpgVHAT0NJDCktdw8uP7FZyck+EGzatYHiShXyXXLLDRYHQ89fH7ioWUXmKNZCSWmAiCmYrvi45qS93cuZ0mommlYDfw/iw6/Jt0cYuRxF7JPfxyc7FXlu7BBTSmWA0maQnuyLfYX+nYSKbp+5OmB3vS7M8+o3mffYbnfPYNCfuzw9+dNIHHGA/X2w8tr+I37VwbmkhJsSDJJIIcknlGcnoyk3B7YgRDcRnZaCuZi+cKZqwe0wZqyj6SmPENETZGM3QuCVhHs551QrmW7LlX8uAhDTOh5gR3O9t0zCgWJexJVD7PH71KSdfLodLFmzIuY6I1wOIaucVH1M4CnneZXBCln44XoOAJMyqyWFUqOdGo/+UCa96kfxMjsQzjBW46xgQO3bGaZv6chY5s0hZ0hdH2HiPxWCgLceh1jem4qb0d4a1uP5oxG10kscCdbxEicU4MBUdXBtEifdB4WaPW0gxRsUoIhYhORg/UfMIhHkqxqRA+vweYRDp3huEv92jXJZM0aB6oKc9fJ57DqVtpICz8SjEsLHlcPImo/hAl3l2zmTCtLlkhxlhotq0lS4cVdXcZ1Wg2yqGAr6wqrLCL98lkw/3E4N0O6ckA1jieoN548siV02YeXddT6ueHER/3J3Kk0qaAk4T1feOMTLdH5RwoRQ0/2p5tH6n/RfAWRkNMebyjUSSPRpWXG3vicbUaMOMPyS66QteqfR2r6MZKazyc3Q0RyV8YY6EedxYsmAPDVJhjpFbioGrCg/uZxxmrnVmRNbc5J8UoiS+jsgcHsRRLhI2nqTck+cP6db9hsTwFISKu62rmUic0ap5Ff/bF7qgi6MrOlGPIJ3qw15MWHWUe7R1ZzySOSxuVePaj7c+44/BTvH0PONfxE5XcGokfrYxbhr0WypqXmhYlQpPj7ItQkt1/ax72lOfNyGhmYhQYesCzc7esIFQcWHPxP4zRXSer3HiUu3R0Z/Ut3V7d2qs/bB9+hCijR7Apwt56hLsAKVZwwsuTq5h1MFpUeaEzN4zufn8UAesuDR4++SZxdOePXjmPUl5fhlbtOjUXcyRrILitmY/Ogshwon2vLMxL7AgMfotHUHuWppXzgx3eoXRmeVB98qHCm4nuFs8l0LAuZlP15NJienkjRcPS3nNUf5Y9wLM4RyY1wfxEcVRGvom6ptULXKAxpuv2dRbv5uzJRooDJusm+HhmEjRpFwJTKwp872AYU4K3ZbRlXHxpbz0wrj2exFqjDkMolXEagd+nMgxPngW+Manc6Bo9GYnuvppK6caVKG88mDhzmpm8LvkK1Xtw5I/o3mOwRIqIsyzlK4/JSHNa7z9gxyVlxFxRqKrOXW/XKr+cDjiFgcrUWXunyuP5TD2otFBNshQiiWbj6b0e/9S6Jss9lK/Zso/UCzptClFjyKY9ExJ8r4lcEu591MNUdH4fljwV4VkylYQnySfgr3Rf7snFPVHq/c14I3w5ZlJp6A16Cr+IhIwm9kEfj71k6Cq4TBRwkCE26yyoozUVVGQX0JIPhu8YvCZxTynpPXcMY4ltheEwHIuuJ+nmC0FkGmkkwSNnAoWfyfGZWCOot/AAR2BeEzhmdZrVk1sWcl9U2XT+hRfagKkCtkInJ3dYofE373TgoYRuSQjPQbck7FmY0PRZOI1oOrDVBj79wnWYYfGY2EZ74OjL3Isu15y3CL4rQOSdNnbjZk1ciCwnhChEyDESBHmCpkIWFS+6IsTktY0rjrDDh4w/MaZz9bIRsNOX5KNCiAxrqTNu8nDY2dtUO1Fy7aMbPMdYO5E0Q213srNOlNkG4IloNwvwRMgxAY8HpsKWHe/cOube0ORY01s4L+b0KiWZanGi4WoeLDEpK8xCwjd4ZryFX1DkPIdf0GRZ1q/4yezKjXxSe8Q/qj3CHxcnetJLwgB+h0mhJyxYgKHhou9S37MtgHf9G4/RdmuyetxZ/BeGgdgJizozWgScdnqErIwQauDKhYESgbcvd4ULpBIfF7WuDJNfPOT2Fxseddi1MCfJSWF3JVicxvkWt50O9TuFHgRoWKm6gnw7jS4jg9gNXy9PsQtBCn6t1dylh67aglRca1aQjOcBrNFVXQq4uNJnR7+hN1UBR6gW36PAaCwrNrl2WdZRJNjDpXTDw7YZr3j4VuMVDZ8iyy8MBKqBlZe3NU4s4zLcgYmx046V14qm+lqUZ1m+gkahL9+JpS813drRSNg5s0IGgMHoQl5K1rumK5pjq2lUm7lOVbuTb2fDxF+ra6aM1bomYpPssdxGlh2W28jEWW4abr7azM/B8cXarFe50xPgXEgAlxUzV3n3NZCVr3AO6ugzdUvCm+9bFvYNKsYUqCHE5tpm22ZTEWptPKIiFN54RLXNzc2mIhQWekS1za3OptrmlWdTEYourKiyTA4rhlyjAz+DXYSBbc7Z8vAsOjc8WQ+cbrj2UE9XNs7+csPAOdAgxaVrvUljfD5zGlR/5kQ/Xk3xRl4ffx8FRtYQxRoT9UlOTCgCx/zNLjR1FBoMTqu3h90Esk67hBmM/jskz9iMcM73zC6RcCXilUqY8TklE64k+5Q+mPiyawiEiIi1BOIUnO9Nqy2Q3qPcF+ekWgNEKiZNpplwN/tgthDvZRG8X1vg8RrIrzJwl4q332dWHYjQUKsPRGhoVQg2JDnVCGYyblWCBQFTZzKqFExYbrWCGZ8bJJztwCfXI7gBUusS3MJZ9QlmElKi/hWKkbA/gGck7g+wEBP4BxnIifyvLHsk9F+z7fTIOifB/4pjh+CGPRL+33Lx/eh7FACIc2Vok90KAjxi3KWPO3Zux17lbwo7FA5Yc2WbX/YtJLBlzSwocEOYWVjgERvvBJldaOAOUUaPdllDu6yefdbNPitmj1wlvAIFMzqzUMGVh52bdabIy9EaotlnfXJytobAQQvJwy/EzrA6wZmZVm/hvOhXcyyfv379VPyhLF6MM+w7Gya2deeGiW/bMfYaMMkxfyG+x7oFmAYnsuweysedl/oPmp+QDRxRuVjeZXpCwz5c6Yb7Wnh+KBx/9ZoyCmTwb6gzu+GDB1jvlWeO3D6M+9JONDgY6uOBK4fuhprzRPTKYPQhlrrxkd6bSRhvG3d5y759xk7uhlNKuTk9pqDC0ThZxfaBx4omM9TRP6/0CVNHnUfeTvd46D5z8FtX0+NAbxidCgPvRDe9Q82m617tjp1zbLl9Y+4XcGZzgsOJ2esbzNCT4cfLvUkt8Yw0p1s4MwAg9yK3xGeNIzNT6x63pp0sAb0ps/LQ9XAH5csVJunImgQL5y8MGMd6bVNhjrz89AHK3NRl/UdvZeOcWNmOXXxEiGEv/Hv7yNDqt1pWp/EtMOfGjETTNffAehqLDMsbLk+E3btK1WZOSdZbPCTgvWdCZNefMzuPmdPyGB7d4hIYvELif8P+I/cbDuaUz4Drwbk7OHdZ5HgDPYr3qFLUDGvJkoHl6FrGWjOzaFw3lVyr52J7yrVa2gGuD3WWG3qo9tA3yDK9weBuCciRtWCG1jkWuE/Kh67LeS/lamHxTsIuZZALdHS5EZ0nNlw288EQLsrYwUffXzd+976c8y4lzsYc5g3fPizDwBob17Z+McWR1/E3bLI8C7dk6YY9B4cbE2Owb7ZjninzDb35Bt5swy7XoJtjyKUbcDmGW57BNs9QyzfQZhlmcwyyXENspgE2x/DKN7hmGVo5BtYswyrfoJpnSM02oHINpzkG0xxDKd1AupNhdCeD6D6G0H0MoEzD51Qkg4pimEl7Vb9itUMftUOQEq5ZlWtOZZpRs82nXLMp01yabSbNMY9mmkWD8D5yS4qzMI2qfV+7igAYeIkZwyIHsfjgZ7w6wRV3MJSlN2Hnio/4+kccQ2YQIslBHjWvK5KUlmjCjQUVXE5UYquqIz28c5haocmI01fd4IuEnn4HRrQ+/AGKOXzKv9vhERjO3XsX2cFBCkQafufOrpzKENEJd+Fj5fLIZ4ovqhFH3Iw4rg22S2NHVwbdhRETM5LjosIiJhsOvsMi21HBd1DkOSb4Dgm2I4LugOA7HvgOB76jge1g4DsW+A6FHEcC34HAdhzAEu9q3C8pJkJ7tCjkIKUknepgQatPHAKKBzRas43Fj8z+9PuFOPS6xmf116QtPxOv7BnOlOkASDHD5TtednC4ZDha8hwsOY4VtkOF6UhhOlAYjhOE0BDZLpaL6qyshUtMkWxCIfljog3v44XJ874keV1iI6D6Wi7NmzCB8olYPIzMsk3szaKxoNV9FnwbUXqBk28YG3jtmoalreI1wfSWI/mjrdALewuj8wvrBx/NGvzlHWVvTI8HZ97kXccvc+qrF7pz8E2IHg+pjfpTjFZhlww1tUWmU5HhTGQ7Ed/dQWq5BU75bzDLcpo8bTlo4uDxoyRxm08SxP8HHIQANg=="
 }
@@ -19,8 +19,10 @@ import (
 	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/record"
 	conf "github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/monitoring"
@@ -31,12 +33,13 @@ const (
 )
 
 var (
-	numPackets  = monitoring.NewUint(nil, "filebeat.input.netflow.packets.received")
-	numDropped  = monitoring.NewUint(nil, "filebeat.input.netflow.packets.dropped")
-	numFlows    = monitoring.NewUint(nil, "filebeat.input.netflow.flows")
-	aliveInputs atomic.Int
-	logger      *logp.Logger
-	initLogger  sync.Once
+	numPackets    = monitoring.NewUint(nil, "filebeat.input.netflow.packets.received")
+	numDropped    = monitoring.NewUint(nil, "filebeat.input.netflow.packets.dropped")
+	numFlows      = monitoring.NewUint(nil, "filebeat.input.netflow.flows")
+	numAggregated = monitoring.NewUint(nil, "filebeat.input.netflow.aggregated_flows")
+	aliveInputs   atomic.Int
+	logger        *logp.Logger
+	initLogger    sync.Once
 )
 
 type packet struct {
@@ -55,6 +58,9 @@ type netflowInput struct {
 	queueC           chan packet
 	queueSize        int
 	started          bool
+
+	config  config
+	metrics *inputMetrics
 }
 
 func init() {
@@ -129,9 +135,14 @@ func NewInput(
 		decoder:          decoder,
 		logger:           logger,
 		queueSize:        config.PacketQueueSize,
+		config:           config,
 	}
 
-	input.udp = udp.New(&config.Config, input.packetDispatch)
+	input.udp, err = udp.New(&config.Config, input.packetDispatch)
+	if err != nil {
+		out.Close()
+		return nil, errors.Wrapf(err, "error initializing udp server")
+	}
 	return input, nil
 }
 
@@ -166,7 +177,8 @@ func (p *netflowInput) Run() {
 			return
 		}
 
-		go p.recvRoutine()
+		p.metrics = newInputMetrics(p.config.ID, p.config.Host)
+		go p.recvRoutine(p.queueC, newAggregator(p.config.Aggregation), p.metrics)
 		// Only the first active input launches the stats thread
 		if aliveInputs.Inc() == 1 && logger.IsDebug() {
 			go p.statsLoop()
@@ -186,6 +198,7 @@ func (p *netflowInput) Stop() {
 
 		logger.Info("Stopping UDP input")
 		p.udp.Stop()
+		p.metrics.close()
 		p.started = false
 	}
 }
@@ -235,19 +248,126 @@ func (p *netflowInput) packetDispatch(data []byte, metadata inputsource.NetworkM
 	}
 }
 
-func (p *netflowInput) recvRoutine() {
-	for packet := range p.queueC {
-		flows, err := p.decoder.Read(bytes.NewBuffer(packet.data), packet.source)
-		if err != nil {
-			p.logger.Warnf("Error parsing NetFlow packet of length %d from %s: %v", len(packet.data), packet.source, err)
-		}
-		if n := len(flows); n > 0 {
-			evs := make([]beat.Event, n)
+// recvRoutine decodes the packets of queueC and publishes the flows, rolled
+// up by agg if it is not nil, until queueC is closed.
+func (p *netflowInput) recvRoutine(queueC <-chan packet, agg *aggregator, metrics *inputMetrics) {
+	var flushC <-chan time.Time
+	if agg != nil {
+		t := time.NewTicker(agg.window)
+		defer t.Stop()
+		flushC = t.C
+	}
+
+	for {
+		select {
+		case packet, ok := <-queueC:
+			if !ok {
+				if agg != nil {
+					flows := agg.flush()
+					metrics.aggregates(len(flows))
+					p.publishFlows(flows, metrics)
+				}
+				return
+			}
+			flows, err := p.decoder.Read(bytes.NewBuffer(packet.data), packet.source)
+			if err != nil {
+				p.logger.Warnf("Error parsing NetFlow packet of length %d from %s: %v", len(packet.data), packet.source, err)
+			}
+			n := len(flows)
 			numFlows.Add(uint64(n))
-			for i, flow := range flows {
-				evs[i] = toBeatEvent(flow, p.internalNetworks)
+			metrics.received(n)
+			if agg != nil && n > 0 {
+				var aggregated int
+				flows, aggregated = agg.add(flows)
+				numAggregated.Add(uint64(aggregated))
+				metrics.aggregated(aggregated)
 			}
-			p.Publish(evs)
+			p.publishFlows(flows, metrics)
+		case <-flushC:
+			flows := agg.flush()
+			metrics.aggregates(len(flows))
+			p.publishFlows(flows, metrics)
 		}
 	}
 }
+
+func (p *netflowInput) publishFlows(flows []record.Record, metrics *inputMetrics) {
+	if len(flows) == 0 {
+		return
+	}
+	evs := make([]beat.Event, len(flows))
+	for i, flow := range flows {
+		evs[i] = toBeatEvent(flow, p.internalNetworks)
+	}
+	p.Publish(evs)
+	metrics.published(len(evs))
+}
+
+// inputMetrics handles the input's metric reporting.
+type inputMetrics struct {
+	unregister func()
+
+	device        *monitoring.String // host/port of the UDP server
+	flows         *monitoring.Uint   // number of flows decoded from the packets
+	aggregatedIn  *monitoring.Uint   // number of flows rolled up in aggregates
+	aggregatesOut *monitoring.Uint   // number of aggregates published
+	events        *monitoring.Uint   // number of events published
+}
+
+// newInputMetrics returns an input metric for the NetFlow input. If id is
+// empty a nil inputMetric is returned.
+func newInputMetrics(id, device string) *inputMetrics {
+	if id == "" {
+		return nil
+	}
+	reg, unreg := inputmon.NewInputRegistry(inputName, id, nil)
+	out := &inputMetrics{
+		unregister:    unreg,
+		device:        monitoring.NewString(reg, "device"),
+		flows:         monitoring.NewUint(reg, "received_flows_total"),
+		aggregatedIn:  monitoring.NewUint(reg, "aggregated_flows_total"),
+		aggregatesOut: monitoring.NewUint(reg, "aggregates_total"),
+		events:        monitoring.NewUint(reg, "published_events_total"),
+	}
+	out.device.Set(device)
+	return out
+}
+
+// received logs metric for n decoded flows.
+func (m *inputMetrics) received(n int) {
+	if m == nil {
+		return
+	}
+	m.flows.Add(uint64(n))
+}
+
+// aggregated logs metric for n flows rolled up in aggregates.
+func (m *inputMetrics) aggregated(n int) {
+	if m == nil {
+		return
+	}
+	m.aggregatedIn.Add(uint64(n))
+}
+
+// aggregates logs metric for n published aggregates.
+func (m *inputMetrics) aggregates(n int) {
+	if m == nil {
+		return
+	}
+	m.aggregatesOut.Add(uint64(n))
+}
+
+// published logs metric for n published events.
+func (m *inputMetrics) published(n int) {
+	if m == nil {
+		return
+	}
+	m.events.Add(uint64(n))
+}
+
+func (m *inputMetrics) close() {
+	if m == nil {
+		return
+	}
+	m.unregister()
+}
@@ -51,6 +51,11 @@ type APIConfig struct {
 	// MaxRetention determines how far back the input will poll for events.
 	MaxRetention time.Duration `config:"max_retention" validate:"positive"`
 
+	// MaxLookback limits how far back the input backfills events for streams
+	// without saved state, like newly configured content types, and after
+	// outages. If not set, max_retention is used.
+	MaxLookback time.Duration `config:"max_lookback" validate:"min=0"`
+
 	// AdjustClock controls whether the input will adapt its internal clock
 	// to the server's clock to compensate for clock differences when the API
 	// returns an error indicating that the times requests are out of bounds.
@@ -99,6 +99,12 @@ func (c contentBlob) handleError(response *http.Response) (actions []poll.Action
 	readJSONBody(response, &msg)
 	c.env.Logger.Warnf("Got error %s: %+v", response.Status, msg)
 
+	if isThrottled(response, msg) {
+		return []poll.Action{
+			poll.Fetch(withDelay{contentBlob: c, delay: c.env.Throttled(response)}),
+		}
+	}
+
 	if _, found := fatalErrors[msg.Error.Code]; found {
 		return []poll.Action{
 			c.env.ReportAPIError(msg),
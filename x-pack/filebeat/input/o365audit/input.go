@@ -7,6 +7,7 @@ package o365audit
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest"
@@ -33,7 +34,8 @@ const (
 )
 
 type o365input struct {
-	config Config
+	config  Config
+	budgets *tenantBudgets
 }
 
 // Stream represents an event stream.
@@ -49,6 +51,8 @@ type apiEnvironment struct {
 	Callback    func(event beat.Event, cursor interface{}) error
 	Logger      *logp.Logger
 	Clock       func() time.Time
+	Budget      *tenantBudget
+	Metrics     *inputMetrics
 }
 
 func Plugin(log *logp.Logger, store cursor.StateStore) v2.Plugin {
@@ -83,7 +87,10 @@ func configure(cfg *conf.C) ([]cursor.Source, cursor.Input, error) {
 		}
 	}
 
-	return sources, &o365input{config: config}, nil
+	return sources, &o365input{
+		config:  config,
+		budgets: newTenantBudgets(config.API.MaxRequestsPerMinute),
+	}, nil
 }
 
 func (s *stream) Name() string {
@@ -112,8 +119,11 @@ func (inp *o365input) Run(
 	cursor cursor.Cursor,
 	publisher cursor.Publisher,
 ) error {
+	metrics := newInputMetrics(ctx.ID)
+	defer metrics.Close()
+
 	for ctx.Cancelation.Err() == nil {
-		err := inp.runOnce(ctx, src, cursor, publisher)
+		err := inp.runOnce(ctx, src, cursor, publisher, metrics)
 		if err == nil {
 			break
 		}
@@ -139,6 +149,7 @@ func (inp *o365input) runOnce(
 	src cursor.Source,
 	cursor cursor.Cursor,
 	publisher cursor.Publisher,
+	metrics *inputMetrics,
 ) error {
 	stream := src.(*stream)
 	tenantID, contentType := stream.tenantID, stream.contentType
@@ -155,12 +166,13 @@ func (inp *o365input) runOnce(
 
 	config := &inp.config
 
-	// MaxRequestsPerMinute limitation is per tenant.
-	delay := time.Duration(len(config.ContentType)) * time.Minute / time.Duration(config.API.MaxRequestsPerMinute)
+	// MaxRequestsPerMinute limitation is per tenant, the budget is shared
+	// by the streams of all content types of the tenant.
+	budget := inp.budgets.Get(tenantID)
 
 	poller, err := poll.New(
 		poll.WithTokenProvider(tokenProvider),
-		poll.WithMinRequestInterval(delay),
+		poll.WithRequestBudget(budget),
 		poll.WithLogger(log),
 		poll.WithContext(ctxtool.FromCanceller(ctx.Cancelation)),
 		poll.WithRequestDecorator(
@@ -174,7 +186,7 @@ func (inp *o365input) runOnce(
 		return errors.Wrap(err, "failed to create API poller")
 	}
 
-	start := initCheckpoint(log, cursor, config.API.MaxRetention)
+	start := initCheckpoint(log, cursor, config.API.MaxRetention, config.API.MaxLookback)
 	action := makeListBlob(start, apiEnvironment{
 		Logger:      log,
 		TenantID:    tenantID,
@@ -182,6 +194,8 @@ func (inp *o365input) runOnce(
 		Config:      inp.config.API,
 		Callback:    publisher.Publish,
 		Clock:       time.Now,
+		Budget:      budget,
+		Metrics:     metrics,
 	})
 	if start.Line > 0 {
 		action = action.WithStartTime(start.StartTime)
@@ -191,12 +205,19 @@ func (inp *o365input) runOnce(
 	return poller.Run(action)
 }
 
-func initCheckpoint(log *logp.Logger, c cursor.Cursor, maxRetention time.Duration) checkpoint {
+// initCheckpoint returns the checkpoint to resume from. Streams without a
+// saved state, like content types added to the configuration, and streams that
+// stopped longer ago than the lookback, are backfilled for the lookback, which
+// is maxLookback if set and smaller than maxRetention.
+func initCheckpoint(log *logp.Logger, c cursor.Cursor, maxRetention, maxLookback time.Duration) checkpoint {
 	var cp checkpoint
+	if maxLookback > 0 && maxLookback < maxRetention {
+		maxRetention = maxLookback
+	}
 	retentionLimit := time.Now().UTC().Add(-maxRetention)
 
 	if c.IsNew() {
-		log.Infof("No saved state found. Will fetch events for the last %v.", maxRetention.String())
+		log.Infof("No saved state found. Will backfill events for the last %v.", maxRetention.String())
 		cp.Timestamp = retentionLimit
 	} else {
 		err := c.Unpack(&cp)
@@ -210,11 +231,11 @@ func initCheckpoint(log *logp.Logger, c cursor.Cursor, maxRetention time.Duratio
 	}
 
 	if cp.Timestamp.Before(retentionLimit) {
-		log.Warnw("Last update exceeds the retention limit. "+
+		log.Warnw("Last update exceeds the retention or lookback limit. "+
 			"Probably some events have been lost.",
 			"resume_since", cp,
 			"retention_limit", retentionLimit,
-			"max_retention", maxRetention.String())
+			"lookback", maxRetention.String())
 		// Due to API limitations, it's necessary to perform a query for each
 		// day. These avoids performing a lot of queries that will return empty
 		// when the input hasn't run in a long time.
@@ -231,6 +252,19 @@ func (env apiEnvironment) Report(raw json.RawMessage, doc mapstr.M, private inte
 	}
 }
 
+// Throttled handles a request rejected by the API because the tenant exceeded
+// its request budget. The requests of all streams of the tenant are paused for
+// the time requested by the API, which is returned.
+func (env apiEnvironment) Throttled(response *http.Response) time.Duration {
+	d := retryAfter(response, env.Config.ErrorRetryInterval)
+	env.Logger.Warnf("Requests are throttled by the API. Pausing requests of the tenant for %v.", d)
+	env.Metrics.throttled(d)
+	if env.Budget != nil {
+		env.Budget.Throttle(d)
+	}
+	return d
+}
+
 // ReportAPIError returns an action that produces a beat.Event from an API error.
 func (env apiEnvironment) ReportAPIError(err apiError) poll.Action {
 	return func(poll.Enqueuer) error {
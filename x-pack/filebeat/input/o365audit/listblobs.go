@@ -181,6 +181,13 @@ func (l listBlob) handleError(response *http.Response) (actions []poll.Action) {
 	l.env.Logger.Warnf("Got error %s: %+v", response.Status, msg)
 	l.delay = l.env.Config.ErrorRetryInterval
 
+	if isThrottled(response, msg) {
+		l.delay = l.env.Throttled(response)
+		return []poll.Action{
+			poll.Fetch(l),
+		}
+	}
+
 	switch response.StatusCode {
 	case 401:
 		// Authentication error. Renew oauth token and repeat this op.
@@ -254,9 +261,6 @@ func (l listBlob) handleError(response *http.Response) (actions []poll.Action) {
 			poll.Fetch(l.adjustTimes(l.startTime)),
 		}
 
-	// Too many requests.
-	case "AF429":
-
 	// Internal server error. Retry the request.
 	case "AF50000":
 
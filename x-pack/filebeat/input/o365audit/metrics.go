@@ -0,0 +1,48 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package o365audit
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/monitoring/inputmon"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// inputMetrics handles the input's metric reporting.
+type inputMetrics struct {
+	unregister func()
+
+	throttledRequests *monitoring.Uint  // number of requests rejected by the API due to throttling
+	throttledTime     *monitoring.Float // total time in seconds requests of the tenant were paused due to throttling
+	lastRetryAfter    *monitoring.Float // pause in seconds requested by the last throttled response
+}
+
+func newInputMetrics(id string) *inputMetrics {
+	reg, unreg := inputmon.NewInputRegistry(pluginName, id, nil)
+	return &inputMetrics{
+		unregister:        unreg,
+		throttledRequests: monitoring.NewUint(reg, "throttled_requests_total"),
+		throttledTime:     monitoring.NewFloat(reg, "throttled_seconds_total"),
+		lastRetryAfter:    monitoring.NewFloat(reg, "last_retry_after_seconds"),
+	}
+}
+
+// throttled records a request that was throttled, pausing requests for d.
+func (m *inputMetrics) throttled(d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.throttledRequests.Inc()
+	m.throttledTime.Add(d.Seconds())
+	m.lastRetryAfter.Set(d.Seconds())
+}
+
+func (m *inputMetrics) Close() {
+	if m == nil {
+		return
+	}
+	m.unregister()
+}
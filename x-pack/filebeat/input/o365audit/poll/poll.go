@@ -15,6 +15,7 @@ import (
 
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/o365audit/auth"
 	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/go-concert/timed"
 )
 
 // Transaction is the interface that wraps a request-response transaction to be
@@ -42,9 +43,16 @@ type Poller struct {
 	tp         auth.TokenProvider
 	list       transactionList // List of pending transactions.
 	interval   time.Duration   // Minimum interval between transactions.
+	budget     Budget          // Request budget shared with other pollers.
 	ctx        context.Context
 }
 
+// Budget limits the rate of requests performed by one or more pollers.
+type Budget interface {
+	// Wait blocks until a request can be performed or the context is done.
+	Wait(ctx context.Context) error
+}
+
 // New creates a new Poller.
 func New(options ...PollerOption) (p *Poller, err error) {
 	p = &Poller{
@@ -102,9 +110,19 @@ func (r *Poller) fetchWithDelay(item Transaction, minDelay time.Duration) error
 	delay := max(item.Delay(), minDelay)
 	r.log.Debugf(" -- wait %s for %s", delay, request.URL.String())
 
-	response, err := autorest.Send(request,
-		autorest.DoCloseIfError(),
-		autorest.AfterDelay(delay))
+	sendDecorators := []autorest.SendDecorator{autorest.DoCloseIfError()}
+	if r.budget != nil {
+		if err := timed.Wait(r.ctx, delay); err != nil {
+			return err
+		}
+		if err := r.budget.Wait(r.ctx); err != nil {
+			return err
+		}
+	} else {
+		sendDecorators = append(sendDecorators, autorest.AfterDelay(delay))
+	}
+
+	response, err := autorest.Send(request, sendDecorators...)
 	if err != nil {
 		r.log.Warnf("-- error sending request: %v", err)
 		return r.fetchWithDelay(item, max(time.Minute, r.interval))
@@ -175,6 +193,14 @@ func WithMinRequestInterval(d time.Duration) PollerOption {
 	}
 }
 
+// WithRequestBudget sets a budget that is waited for before every request.
+func WithRequestBudget(b Budget) PollerOption {
+	return func(r *Poller) error {
+		r.budget = b
+		return nil
+	}
+}
+
 type listItem struct {
 	item Transaction
 	next *listItem
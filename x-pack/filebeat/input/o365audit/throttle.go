@@ -0,0 +1,113 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package o365audit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/elastic/go-concert/timed"
+)
+
+// tenantBudget is the request budget of a tenant, shared by the streams of
+// all its content types. The API limits the number of requests per tenant and
+// throttles all of them once the limit is exceeded.
+type tenantBudget struct {
+	limiter *rate.Limiter
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func newTenantBudget(requestsPerMinute int) *tenantBudget {
+	return &tenantBudget{
+		limiter: rate.NewLimiter(rate.Limit(float64(requestsPerMinute)/60), 1),
+	}
+}
+
+// Wait blocks until the tenant is not throttled and its budget allows
+// another request.
+func (b *tenantBudget) Wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		pause := time.Until(b.pausedUntil)
+		b.mu.Unlock()
+		if pause <= 0 {
+			break
+		}
+		// The pause may have been extended by another stream while waiting.
+		if err := timed.Wait(ctx, pause); err != nil {
+			return err
+		}
+	}
+	return b.limiter.Wait(ctx)
+}
+
+// Throttle pauses the requests of all streams of the tenant for d.
+func (b *tenantBudget) Throttle(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if until := time.Now().Add(d); until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
+
+// tenantBudgets holds the budgets of the tenants of an input.
+type tenantBudgets struct {
+	requestsPerMinute int
+
+	mu      sync.Mutex
+	budgets map[string]*tenantBudget
+}
+
+func newTenantBudgets(requestsPerMinute int) *tenantBudgets {
+	return &tenantBudgets{
+		requestsPerMinute: requestsPerMinute,
+		budgets:           map[string]*tenantBudget{},
+	}
+}
+
+// Get returns the budget of the tenant, creating it if necessary.
+func (t *tenantBudgets) Get(tenantID string) *tenantBudget {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	b, found := t.budgets[tenantID]
+	if !found {
+		b = newTenantBudget(t.requestsPerMinute)
+		t.budgets[tenantID] = b
+	}
+	return b
+}
+
+// isThrottled returns whether a response indicates that the request
+// exceeded the request budget of the tenant.
+func isThrottled(response *http.Response, msg apiError) bool {
+	return response.StatusCode == http.StatusTooManyRequests || msg.Error.Code == "AF429"
+}
+
+// retryAfter returns the delay requested in the Retry-After header of a
+// response, which is either a number of seconds or a date, or def if the
+// response doesn't have a valid Retry-After header.
+func retryAfter(response *http.Response, def time.Duration) time.Duration {
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return def
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d
+		}
+		return 0
+	}
+	return def
+}
@@ -0,0 +1,109 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package o365audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryAfter(t *testing.T) {
+	def := 5 * time.Minute
+	for name, test := range map[string]struct {
+		header string
+		want   time.Duration
+	}{
+		"missing": {header: "", want: def},
+		"seconds": {header: "30", want: 30 * time.Second},
+		"invalid": {header: "soon", want: def},
+		"past":    {header: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), want: 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if test.header != "" {
+				resp.Header.Set("Retry-After", test.header)
+			}
+			assert.Equal(t, test.want, retryAfter(resp, def))
+		})
+	}
+
+	t.Run("date", func(t *testing.T) {
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+		d := retryAfter(resp, def)
+		assert.True(t, d > 0 && d <= time.Minute, "unexpected delay %v", d)
+	})
+}
+
+func TestTenantBudget(t *testing.T) {
+	budgets := newTenantBudgets(6000)
+	assert.Same(t, budgets.Get("tenant-a"), budgets.Get("tenant-a"))
+	assert.NotSame(t, budgets.Get("tenant-a"), budgets.Get("tenant-b"))
+
+	b := budgets.Get("tenant-a")
+	b.Throttle(50 * time.Millisecond)
+	// A shorter pause doesn't shorten the current one.
+	b.Throttle(time.Millisecond)
+
+	start := time.Now()
+	require.NoError(t, b.Wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	b.Throttle(time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Error(t, b.Wait(ctx))
+}
+
+func TestListBlobThrottled(t *testing.T) {
+	for name, test := range map[string]struct {
+		statusCode int
+		code       string
+	}{
+		"status code": {statusCode: http.StatusTooManyRequests},
+		"error code":  {statusCode: http.StatusForbidden, code: "AF429"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			env := testConfig()
+			env.Budget = newTenantBudget(env.Config.MaxRequestsPerMinute)
+			env.Metrics = newInputMetrics("o365audit-throttle-test-" + name)
+			defer env.Metrics.Close()
+
+			var apiErr apiError
+			apiErr.Error.Code = test.code
+			js, err := json.Marshal(apiErr)
+			require.NoError(t, err)
+			resp := &http.Response{
+				StatusCode: test.statusCode,
+				Header:     http.Header{"Retry-After": []string{"42"}},
+				Body:       ioutil.NopCloser(bytes.NewReader(js)),
+			}
+
+			lb := makeListBlob(checkpoint{}, env)
+			var f fakePoll
+			_, next := f.finishQuery(t, lb, resp)
+			require.IsType(t, listBlob{}, next)
+			assert.Equal(t, 42*time.Second, next.Delay())
+			assert.Equal(t, lb.startTime, next.(listBlob).startTime)
+
+			env.Budget.mu.Lock()
+			pausedUntil := env.Budget.pausedUntil
+			env.Budget.mu.Unlock()
+			assert.WithinDuration(t, time.Now().Add(42*time.Second), pausedUntil, 5*time.Second)
+
+			assert.Equal(t, uint64(1), env.Metrics.throttledRequests.Get())
+			assert.Equal(t, 42.0, env.Metrics.throttledTime.Get())
+			assert.Equal(t, 42.0, env.Metrics.lastRetryAfter.Get())
+		})
+	}
+}
@@ -19,5 +19,5 @@ func init() {
 // AssetThreatintel returns asset data.
 // This is the base64 encoded zlib format compressed contents of module/threatintel.
 func AssetThreatintel() string {
-	return "eJzsXF+P27ayf8+nGOxLE8Ax2tzm4iIPF9gm7c0C26Z3//T0zaDJsTVnKVIlKXvdT39AUpJlm5btXXqbc9B9WknUzI/D+U/Kb+EBVx/AFQaZI+VQvgJw5CRu3zQokVn8AHP2CkCg5YYqR1p9gP99BQBwF4ZDGC9pjooj/EQSp/7uz1rUEsevAGaEUtgP4ZW3oFiJH+DiIlwCuFXlORhdV82d/vD+KxHcmJQgzpw24xlJHBfMFmMnbdGNb2k+4GqpjejdT0yh/bsrEDy9byxQWWnjwNMcAc2ALRhJNvVzOQWTLdh//c/32VBFcuBJH4tqhijG/s5eEINvCmaLqWZGTEgcnEZLgE1ri7wYl0wumdnl3F/oA5P/KegBzLSBS0/142f4OVJtFe+qUdT2b1tz1rC8ECcewQaHfSsyCAuCiod3YV6jtShguoL7m+uC1XbcjU2gsDRXzNUmC4pWFjNWkqTVIOPaSA9uIvRSSc1EDv7XmjP/BF7f31y/gWWBBmGla+BMQcsIGHBdrUDPwBVkwzoMIl2Qqa3TjsmxQVtLlwPq5W8g0CEPcE1AfSyICg1HlUIxk5q5J2IgBQ3hY3FIUg9ZVo3UAzgNrkD4rSMPBr3PG+8159rIr8WUKYv2es969SnqJXr7OMp+DM7Q+DCXcykazwGonDloxhPrmKttLhHw2hhUDiLVVhz3N9dj+FVbS1OJsGCyRgvM4AfQSpLCEejZzP8DTAmo1YPSy2GLinElF+pIDbg2Bm2llSA1j0pNFprIEyYxBGkqGX+QZJ0d29pMZQ5wt/c3P1yvKTdi3SNLPwJFEKHSbhIvj0VcsTJ69EzAG3rwKYV/CFT0HGhyre3dkpxDAwVTQmKrkS0XcAVzPglq74idRYfX2gBTWq1KXds3g+AlM32Zr6FPtZbI1PHQr2IGiNYHQlcEpLgBy4OeIqoe8uiGC22dV+DK6AUJNPDamRpBG5gxafHNoF2xeRZfcBm0MUibzS0wazUn5kEuyRUB5h81GkLRn9NuuFC6ZJLGkkqdK15Ekl9HnFjn+QmGW5n2s1h6WkcxrZi3FpWLb0Pu2CkvmCQxmRldJgAI5vB47v8oUG0yhCXzCaOxDma6VsJbBFngWllvJigi+xSuUguaUdKys6CSzLoUk7VfmaLMFqQjNV+Os57b6BCldD/x7FkQQtzaUYlRsFB8ZGUl0Rel/mkojMiC0CUjNYr+ealrKWCKYUQgllq2How8Tq33+Ch9Dm2QLDLzhJoh05CdHGLNtXLpQuNk5j8+OsPA4aPzJtMNXWDLZK8qJWWSqWq+27AiT2QUSsW+Ck0RLlq9wZKRHIVi+zOzxdvbz5fv3v/3RQqinv4TuZuUzDyQmvssPZvt3d5d/Q5d2t9wStRJTeCLmal1Bll5lgB4u016OwCmVEsya2lGsV7PIZndbIep3uKShcrQgrmYxRhdAoOqnkriMMMm5+0aWM0TuRpvcdnKmj+0REfNG5vjGx3qRg1KRKsZiT2FnC20OaGw91pSIrO16Rwk47w2jK/gdZj7t97Ovvv22zc+r6K5ig2j/np+4wWGgniwUqaYXDniFhzyQmmp5ytPohPwcEYu0DGS7xIz8y7h+Il9CnSiH/GKmPQSPblflU1eO11BbdHA9+8Gcb5QgpbiHJBOLFpLWk3yAOmBiA3khnwsWbhf7FCt9L2gVhtqMAw6lyO+2nDCB43uglVuEp3yxSheBdfcXlDV/lcbeTHaIncx1e0Q/q5Hhr9b3w3vwQW9q5p7JZPd0G16/lnH31+sXynF+/bfhmTFzAOKZkhVkC3ad7fJxof9gQ0Jy5lq7vuiuzcF27ywTcrWtiJOOvRb28FOGz84eD5/YVC5iTOMP6AJrAbXvmRyz+ovSQrOTlv+mTZlbN0anDMTuiesq+tCP3k1AgYff/sRrj6NINbT0c07x/iDvxMj3ShZKh5nhaV4n0OdfXIQ/NPxnA1aXRuOk9pQDgg3Pp3ynvv+5moXSuOQD2wN4AINuVUOOB8NOeJMklsll6dpn4VIHJyTratK0rZ3OugZpF6OoERBdTmCgubFCBZoVm/9v8OTDdLPMdXbQGlo+aEfoi59ZLXuMLaJF06+wLDG53BuglJEPsNictu16lOl5Ak1IMgelBILacgQMmdq61BMOBkucUIiS769jqANfYj0o5JS2TX8jrXzuvLVfqZlvA/E4OrToRZ9FmY31yf6tGCU2bY4PzHHYiU/VDZDyitQNepq/9rIrpArxfv9+0tN8JmyPxkzmQqndofph0D0a9wz3kSYqmxfZsf4q+gn2+B9UqD650sS4La1I7Wmveqo2Zm2W4gjLanVfOvB4DwBfqnLKRo/045yrHgbae+ubd8/nQdJQ/doHN5AkyB2RZsW7prU/6FCQ3wH3H49OmJma9W1iGH3fB7ZgE/JwRk2m223A9aQru7Ojebqbi+QXoMobkglFHhnqYejpEDlaEZo245rtJzQmLb1tCQXtxUbhjJp6FwLnHjvksCj0G7vig3nm1pg8FS+kKBeedFGsG3T7vSObJXL2V/d/vpX7RFdOmdoWm8lB732pJnzTEnIFzNniv6M4v2oy7JWPstfZ0642DhUsgHiHBiO4BzLjYnEBco0hNMMoFnlQC+6uPehwTZqziB9B2Tj7qu2DnhTDKUDy0xnUQAhyD9hsuu600aVvdFt3yuo0NW0RZ4N6W7nKrALzqGjn2Je1/n6cff3W2qx0zfvxeN0jXPaNp1nGrJzPfNKoHY4h/k3Pbike25NeMJ1ndyFOU1HP+sllEyt1oTDoQ8gxWUtUPiowcCSmsvDInJUonWsrLLIqaP2ZGEJsnFSmXYTPvXoxRy5ZzHesSeNxehK27YZOJGap87HnWw2t+gcqbmNWwTz2qAArWJ4CTtVnht4bhBau2QPLp8fnOmQSTSp9tBWALWxcGQbbt4FKT3kZSb5tOrSwdJX6J5iWplSnq0rcQpmSM0nIe5nClGbmwKBMooIy0LXfLEHQ5cgy6bSOwUTVDKt8E9Zxx7FsFkeGYmoVLi7tCl0+OhQCTt5CdcN5KCkeeEarntSjHE+JHorzUhF0QHheDA5T+RswAnHc54A6FwrtQHuCbik5izVt3qqh0o4JSZ7e8EGS+1wpwe6mTJn1KX9aTMpWBbEi7Tf6o7qedx7ceZUsz1Ig8LlwJpTA/dgDYqZA2turdyDNxzoahXUrhRvcB2jp116l1FZ12bdUT/Vptewcp7e6feDj4MGP/UO9zR9YKoW34981P8ubIbamhfDU+DM4VybLFtj4cx7Q+8ZU7n4Bd1Smwe45I4W5FbJY0m9VdB79kieqsprzLZoj9ax2mlfcXIm5apV5NBuZT6A3g5DPFdoGpbuMKYwJGNKGK08LmsehAdKodOLxk1A3Znxjg+KkfeeBi2q5qxN2FREAyr0f+0BTTxTLbnh79cT8L41nBkonuK8uC7LTGciP0ZSFkomsEWyxknOopwNozlXoRLo9fSx4dPc39JJWyGnGfEermEFRYm5PnHYNpfeFw2lXrRR87QlfpkKq0OKaqPMyhBgm7On5wj/X5r8OW1atLasoxAOiPdZ2UAn5F3l6Pb6tirJw7YWNpNzId04vN7xGIHACuO3Yw36MCVJDwgXYefaJynN/vVba3gyyIcj1Y9ufN5M0CLXSjCzerrK7gI9V254KthnZIm7kzp3tvisyR2XNyZWKnf+mJrF8zLJXdDnyiiPXYHjUL5MjpkX81myzhTEXPlnQqNfIg9NTekpGWnCy5w5M00h3x83d/F9XblqYjZHKnr27HVQy0/OY5Om+Rfms2dJE144w02q/nCuuxfzi+S8+1Tq1Ox3dxZfexbcdWvd46ttjE87xvPl7vd/gy+9//68Fv7+vPY//fPazeNbf2Qy8Gjc/98a+TVNDdso1vabeTzNLybJ35E5LWe8Xn+/3/yIlc8gU2oTT8Hk4NnEukBxgCM+VmTQ5uD4oyd1FDPOJK9lrpn2+LaEBzB0Z0MyCjl+HzzINt/vKDU8m59Q8lGfYiDdq+M7cWSSLdJ/MTQnxWTPF2zT7rptYoHGMkOY58j/mlz8YIcZn0aoh7UjjClQCkp3Si6BZCaZc6hOOZF8V6DF9gd1gK2PZzY5FrCprh0w1UL6VwAAAP//UJ8gKQ=="
+	return "eNrtXFtz2zYWfu+vwPilyYyiSbzJzk4edsbNZeOZtOnGTto3DURCItYgwQKgZfXX7zkAeBEFUpQNOdmd5qE1SfCcDwfnDojPyA3bviYmU4waXhgmfiDEcCNY/6ZiglENt9cUrlKmE8VLw2XxmvwTbhBybYcTO17wNSsSRt5zwZZ492eZVoLNYeCKM5Hq1/aVZ6SgOZA8O7OXwHlbIgclq9Lf6Q7vvuLAzXmR8oQaqeYrYDXPqM7mRuisGV/ThGlupEo79wNTqP9dZ4wgvR814XkplSFIc0b4itBbygVd4lyOwaQz+rd/vIyGypEjSHoqqhVj6RzvDIIYfTMFVktJVbrg6cFp1ATostIsyeY5FRuq9jl3F/rA5N9bPSArqcgFUn3zgfzsqNaKd+kVtf7X15wWFgpxgQh2OAytyCgsYlXcvkvWFdOapWS5JV8+f8xopefN2AAKzdcFNZWKgqKWxYrmXPDtKONKCQS3SOWmEJKmMfh/lKDx8IQ8gak/JZuMAZitrEhCC1IzIpQkstwSuQLV4tquwyjSW64qbaShYq6YroSJAfXiKzw3LLFwlUU9FUTJVMKKEIoVzM/cEwMviCc8FYfgxU2UVQM6xEhYDUa+NuTB1aPPmw+aMyjQ92LKPIr2ome9fOv0kqF9TLIfxVag5RDmYi6F9xwEtEEdNOOFNuBBdCwRJJWC+RjiqNbiAERz8qvUmkN8IbdUgJsjsFiviSxAEdkMBq7wD0KLlFTFTQHmPorcxZVYqB018CwAXpcSgm+xdkoNLsZHHjuJMUhLQZMbwbXRc12ppYgB7urL558+tpS9WAdkiSMgdqAIC2kW7nIq4pLmzqNHAu7pkbch/GOgnOdgKtbaXm+4AXKQ6BQpCMxrZM0FLmDhIQmq76R7i06egJehhSy2uaz001HwgqquzFvoSykh9y2mQ790GSCsLQRCQKws7C4sBL1krOggd244k9qgApdK3vIU3nxiVAUzVxDahWZPR+2KrqP4ggurjVbaQJFQrWXCKYKE5cgszD8qpjjc6MxpP1wUEh5ziFa5jBUvHMnvI060eX6AYS/TfhBLpDWJaUnRWopYfD25qVMGd8bTxUrJPAAgBf2Zzv23DExjhyHZUEwYFejlSlbgJeEWmHoiC41mAqpo2Q/jqgrDxSmAAYpCEiGLNVjrFDyK3cqbOI7mt457aRF1nIvlhBUJNxp9SlolLLh47K7k6pSgNtZNOzwoNcDTWRhiOOh4BjVCSW0RtUFBc2NfA3/IMByGcOuSJYtbpjRAiaX3V9eXv1vCfMV9WeM5TLKD5qGtMXVUVOfzFwQjy1qC+9XHOIVoBW/XMwharCu6hpzZF74H0eQyBakGFS2KixAUPESASRvkl0xEWxNHDXtjtBPDG0RjyhELgk0i9xRhZsMlu6N5CXkTd09tlwLcVQpBlBczlyxtZCVg6ZgdYYmFlq0DI06G0Xk8SYttTzKKzJCQH7K0pcIh1uDTTbjqP5r5uzujKDHszqAPbIbesprJoCoFZRLRolsrQiIz27fpqhAoyFmtNwz+J2a28/WB6uzZ1YeL81d/PwtBlMv/sMQscqpuQNZYMsf1h00N7jnNB7NQVyZqA//NT5KNXvVJ97PRkGqBu9JNlIkhmf3Sgxa7yUqp+C0MsCUF5GqEkrJaCp4Q7PPaArTpJvsnYjvvcemVsK9rojP/xu54r0PNqFGJyGIFCVS4q6IzqJWO05KcUSjoGwdJk6RSNNmSJ3buz9HOXjx//hSLHL4uXBDrruePKDAG4rNWSgsqtoYnGmw4yQop5HqLJBoBj5fHKTMg2fPAzNAlTJ/YW0vH+RFUxKCX6Mj9MvdFJkwOIrUiL89HcT5StRTibJEuNNOYbi3iAOmAcLs5nrzrHyS42LZ10PWC8LSrBuOgYzniyx0nfNDozmhpFs4pn83clXXN9QUv678qBTd75M6Wsh6SnHfIwEVz175Hzvh56e+Bv2uG9unhs4Y/XrSv5Omr+k9PsoSIwFI/pIREJKvf7ZN1D7sDPQkNMcrfxw5YZwrav9AnpStd8oRLu/lRD8ZEHQZbz4cX2AJdQJhObpiyrEbXHqY0sPobLtKEHrf8YMy5KzgUW8O7mJ/QpsliN3e2M7jz5us70OoZcc0t5+aNAch4x0W6WbBvM80KYbliqDMmB9Y/TeesmJaVShgInseA8BnTKfTcXz5f7kPxDvnAPh2D4g9MPAacN0AIWAsgF1we38u2kdg6J12VpeB973TQMwi5mUHoS3mVz0jG19kMK9jtM/xzfLJW+lGayJbS2PLvhKgLjKzaHMa2QOHECwwtPiiqlVUKx2dcTKZfq95XSkjIg+D6oJSoTUNGe8C4iwcCSrhKBAM56bgR1NMnjr5TUp433fepdl6VWO1HWsYvlhiAPLRfFoXZ549H+jRrlNHaL2+poa6SHyubg16Bl7Om9gdpNIUcuPv5obMbS/onpSpS4VRv9/5kiX6PBzh2Ec6/2fGN72JzR1vvE251toe9AuD62hFa00515I+J6B5iRwsb7L0Ho/Mk5JcqX0JiBDNtKLuK10t7f227/uk0SDzdyTjQQIMg9kUbFm5L6l+sgGVN9sAN69GEmbWqq3HDgRdk7dgQTMkhWtDVqt8OaCFdXp8azeX1IJBOg8jtDv8wYanHo2QKpQM2nnXdcXWWYxvTulrmuJmdtgzFNtxuTNkCvUsAT8F0f4t6PN8EWtZTYSHBO+VFHcH6pt3oHddlLGd/efXrt9qwvTBG8WXVSw467Um1TiIlIZ/Umhb8TyfeNzLPqwKz/DZzgjKie8JrB8QpMEzg7MqNhYABIgzhOAPwq2zpORf3yjbYZv5A4AvsP9qjEFJj48UVQ+HAspJRFCBNOT6houm6850qe6fbPigo29XUWbT90aJlZ51DQz/EvKri9eO+fOmpxV7fvBOPwzXOcdt0yNRm58B0sztxx9nO3/fggu65NuFFIqvgLsxxOvpBbiCqFtuWsD2BBWqRiCoFXYCoQcFnFmtxWES4aw21YF5GkVND7d7CSiG1s5OKtJvwtkPP5cgdi0HHHjQWJUup62bgQsjkJobZXDGDJ6O02yJYV3jGAlDZ8GJ3qpAbQW7EtnbB1RxaPhwc6cSXM6n6BKUFtbNwgMZxQxdUyDEvs4inVRegMVih29MVQWUKebamxMmowl07G/cXp9gUsJRBJhaWJk3zRR8MXaDpuEO1sMc+xdD22X3WsUPRbpY7RqlTKra/tOHTNIYVqV48huvGUzI5X2fGcx1IMebxkMhemhGKoiPCQTAxj8ftwLFn5e4B6FQrtQPuHrjAaVAR0UMFnBLkRu1esGK5hGjd74HupswRdWk4bYY4vMl4koX9VnNuFnEP4oypZgNIrcLFwBpTAwewWsWMgTW2Vg7gtQe6agXV2yLxuKboaZPezU8RuBrqx9p0Cyvm6Z1uP3gaNPK+c7jH94F5eftyhlH/hd0M1VWSjU/Bn0TcRvsBiqf3gKmc/cIMML4hF7hVAkp0dmAV5MAeyX1VucWss/poHa2MxIoTNFlsa0W27VaKAfRqHOKpQtO4dMcx2SERU0Jn5W5Z4yA8UAodXzTuAmrOWDd8WDpD76mYBmz+0DVuKjLI923/Vx/QxBPVkjv+vp0A+lZ7ZiC7j/NKwEdHOhP5xpHCn+2krEbS4uRGM7EaR3OqQsXS6+ij5+Pv93SyPjDewTWuoEwwE7X6DGgnhsnbOmoet8SPU2E1SFmxU2ZFCLD+7Okpwv8nnz+HTYu3ljUJ4Yh4H5QNNELeV45mr69XSR62NbuZfJLD6w2PGbxYMvdDTo/eTknwGwjwducakxS/f/1Mq+Rs8Nz2nZmfNhPUDPikVG3vr7L7QE+VGx4L9gFZ4v6kTp0tPmhy0/LGwErFzh9Ds3hYJrkP+lQZ5dQVmIbycXLMuJhPknWGIMbKPwMa/Rh5aGhK98lIA17mxJlpCPlw3NzH933lqoHZTFT06NnrqJYfnccGTfMb5rMnSRMeOcMNqv54rjuI+VFy3iGVOjb73Z/F954FN91acxfpGM+n69//Bz678NfPa//6ee3//c9rd49v/RHJwJ1x/7s28o98qehOsTZs5u40f7oIftTpuJzxY/v7ff/pBcwgQ2rjTsHE4OljnaU4wtF9IkPH4PgOSU1iBiVLUolYM+3wrQmPYGjOhkQUsvt98CjbeB818zz998ww6nMXSAd1fP8bHtEi/SfF1xwPIra+oE+76bal+L0Rip/4iHMIsiHnfrCD593wG4KtI3Qp0FjjTwe/eojfASnYcc5Qs/rrVjDR5nimz7EIXcrKYDfDQ/ov84MhVw=="
 }
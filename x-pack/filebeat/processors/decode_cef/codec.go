@@ -0,0 +1,41 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package decode_cef
+
+import (
+	"github.com/elastic/beats/v7/filebeat/inputsource/codec"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func init() {
+	codec.Register("cef", newCodec)
+}
+
+// cefCodec decodes the payloads of network inputs as CEF messages, like the
+// processor does with its default settings.
+type cefCodec struct {
+	p *processor
+}
+
+func newCodec(config codec.Config) (codec.Decoder, error) {
+	c := defaultConfig()
+	c.Timezone = config.Timezone
+	p, err := newDecodeCEF(c)
+	if err != nil {
+		return nil, err
+	}
+	return cefCodec{p: p}, nil
+}
+
+func (c cefCodec) Decode(data []byte) ([]beat.Event, error) {
+	evt := beat.Event{
+		Fields: mapstr.M{
+			"message": string(data),
+		},
+	}
+	_, err := c.p.Run(&evt)
+	return []beat.Event{evt}, err
+}
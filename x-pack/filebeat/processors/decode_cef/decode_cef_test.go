@@ -15,6 +15,7 @@ import (
 	"github.com/pmezard/go-difflib/difflib"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/elastic/beats/v7/filebeat/inputsource/codec"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/elastic-agent-libs/mapstr"
 )
@@ -326,6 +327,32 @@ func assertEqual(t testing.TB, expected, actual interface{}) bool {
 	return false
 }
 
+func TestCodec(t *testing.T) {
+	config := codec.DefaultConfig()
+	config.Codec = "cef"
+	dec, err := codec.New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := dec.Decode([]byte(`<13>Oct 11 22:14:15 host CEF:1|Trend Micro|Deep Security Manager|1.2.3|600|User Signed In|3|src=10.52.116.160 suser=admin`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, events, 1) {
+		fields := events[0].Fields
+		assert.Equal(t, "Trend Micro", fields.Flatten()["cef.device.vendor"])
+		assert.Equal(t, "600", fields.Flatten()["event.code"])
+		assert.Equal(t, "10.52.116.160", fields.Flatten()["source.ip"])
+	}
+
+	events, err = dec.Decode([]byte("not a CEF message"))
+	assert.Error(t, err)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "not a CEF message", events[0].Fields["message"])
+	}
+}
+
 func BenchmarkProcessorRun(b *testing.B) {
 	dec, err := newDecodeCEF(defaultConfig())
 	if err != nil {
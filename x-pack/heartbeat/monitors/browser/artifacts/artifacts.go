@@ -0,0 +1,115 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+//go:build linux || darwin
+// +build linux darwin
+
+// Package artifacts provides the stores browser monitors upload large blobs,
+// like screenshots and traces, to instead of inlining them in the events.
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/synthexec"
+	awscommon "github.com/elastic/beats/v7/x-pack/libbeat/common/aws"
+)
+
+// New returns the artifact store described by cfg.
+func New(cfg Config) (synthexec.ArtifactStore, error) {
+	switch {
+	case cfg.S3 != nil:
+		return newS3Store(*cfg.S3, int(cfg.MinSize))
+	case cfg.File != nil:
+		return newFileStore(*cfg.File, int(cfg.MinSize))
+	default:
+		return nil, errStoreRequired
+	}
+}
+
+type fileStore struct {
+	dir     string
+	minSize int
+}
+
+func newFileStore(cfg FileConfig, minSize int) (*fileStore, error) {
+	dir, err := filepath.Abs(cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("could not create artifacts directory: %w", err)
+	}
+	return &fileStore{dir: dir, minSize: minSize}, nil
+}
+
+func (s *fileStore) MinSize() int { return s.minSize }
+
+func (s *fileStore) Put(_ context.Context, key, _ string, data []byte) (string, error) {
+	p := filepath.Join(s.dir, key)
+	url := "file://" + filepath.ToSlash(p)
+
+	// Keys are content addressed, an existing artifact is identical.
+	if _, err := os.Stat(p); err == nil {
+		return url, nil
+	}
+
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, p); err != nil {
+		_ = os.Remove(tmp)
+		return "", err
+	}
+	return url, nil
+}
+
+type s3Store struct {
+	client  *s3.Client
+	bucket  string
+	prefix  string
+	minSize int
+}
+
+func newS3Store(cfg S3Config, minSize int) (*s3Store, error) {
+	awsConfig, err := awscommon.InitializeAWSConfig(cfg.AWSConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize AWS configuration: %w", err)
+	}
+
+	client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+		if cfg.AWSConfig.FIPSEnabled {
+			o.EndpointOptions.UseFIPSEndpoint = awssdk.FIPSEndpointStateEnabled
+		}
+		o.UsePathStyle = cfg.PathStyle
+	})
+	return &s3Store{client: client, bucket: cfg.Bucket, prefix: cfg.Prefix, minSize: minSize}, nil
+}
+
+func (s *s3Store) MinSize() int { return s.minSize }
+
+func (s *s3Store) Put(ctx context.Context, key, contentType string, data []byte) (string, error) {
+	key = path.Join(s.prefix, key)
+	input := &s3.PutObjectInput{
+		Bucket: awssdk.String(s.bucket),
+		Key:    awssdk.String(key),
+		Body:   bytes.NewReader(data),
+	}
+	if contentType != "" {
+		input.ContentType = awssdk.String(contentType)
+	}
+	if _, err := s.client.PutObject(ctx, input); err != nil {
+		return "", err
+	}
+	return "s3://" + s.bucket + "/" + key, nil
+}
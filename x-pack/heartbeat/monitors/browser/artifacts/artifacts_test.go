@@ -0,0 +1,46 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+//go:build linux || darwin
+// +build linux darwin
+
+package artifacts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	require.ErrorIs(t, (&Config{}).Validate(), errStoreRequired)
+	require.ErrorIs(t, (&Config{S3: &S3Config{}, File: &FileConfig{}}).Validate(), errStoreRequired)
+	require.NoError(t, (&Config{File: &FileConfig{Path: "artifacts"}}).Validate())
+}
+
+func TestFileStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "artifacts")
+	store, err := New(Config{MinSize: 10, File: &FileConfig{Path: dir}})
+	require.NoError(t, err)
+	require.Equal(t, 10, store.MinSize())
+
+	url, err := store.Put(context.Background(), "abc", "image/jpeg", []byte("data"))
+	require.NoError(t, err)
+	require.Equal(t, "file://"+filepath.ToSlash(filepath.Join(dir, "abc")), url)
+
+	data, err := os.ReadFile(filepath.Join(dir, "abc"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), data)
+
+	// Artifacts are content addressed, existing ones are kept.
+	url2, err := store.Put(context.Background(), "abc", "image/jpeg", []byte("other"))
+	require.NoError(t, err)
+	require.Equal(t, url, url2)
+	data, err = os.ReadFile(filepath.Join(dir, "abc"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), data)
+}
@@ -0,0 +1,47 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+//go:build linux || darwin
+// +build linux darwin
+
+package artifacts
+
+import (
+	"errors"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	awscommon "github.com/elastic/beats/v7/x-pack/libbeat/common/aws"
+)
+
+// Config configures where the artifacts of browser monitors are uploaded.
+// Exactly one store must be configured.
+type Config struct {
+	// MinSize is the size from which blobs are uploaded instead of being
+	// inlined in the event.
+	MinSize cfgtype.ByteSize `config:"min_size"`
+	S3      *S3Config        `config:"s3"`
+	File    *FileConfig      `config:"file"`
+}
+
+// S3Config configures an S3 bucket as artifact store.
+type S3Config struct {
+	Bucket    string              `config:"bucket" validate:"required"`
+	Prefix    string              `config:"prefix"`
+	PathStyle bool                `config:"path_style"`
+	AWSConfig awscommon.ConfigAWS `config:",inline"`
+}
+
+// FileConfig configures a local directory as artifact store.
+type FileConfig struct {
+	Path string `config:"path" validate:"required"`
+}
+
+var errStoreRequired = errors.New("exactly one of 'artifacts.s3' or 'artifacts.file' must be set")
+
+func (c *Config) Validate() error {
+	if (c.S3 == nil) == (c.File == nil) {
+		return errStoreRequired
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/artifacts"
 	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/source"
 	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/synthexec"
 	"github.com/elastic/elastic-agent-libs/config"
@@ -41,6 +42,8 @@ type Config struct {
 	FilterJourneys    synthexec.FilterJourneyConfig `config:"filter_journeys"`
 	IgnoreHTTPSErrors bool                          `config:"ignore_https_errors"`
 	Timeout           time.Duration                 `config:"timeout"`
+	Budget            synthexec.BudgetConfig        `config:"budget"`
+	Artifacts         *artifacts.Config             `config:"artifacts"`
 }
 
 var ErrNameRequired = fmt.Errorf("config 'name' must be specified for this monitor")
@@ -16,6 +16,7 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/artifacts"
 	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/synthexec"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
@@ -27,6 +28,7 @@ type JourneyLister func(ctx context.Context, projectPath string, params mapstr.M
 type Project struct {
 	rawCfg     *config.C
 	projectCfg *Config
+	artifacts  synthexec.ArtifactStore
 	ctx        context.Context
 	cancel     context.CancelFunc
 }
@@ -47,6 +49,13 @@ func NewProject(rawCfg *config.C) (*Project, error) {
 		return nil, ErrBadConfig(err)
 	}
 
+	if s.projectCfg.Artifacts != nil {
+		s.artifacts, err = artifacts.New(*s.projectCfg.Artifacts)
+		if err != nil {
+			return nil, fmt.Errorf("could not create artifacts store: %w", err)
+		}
+	}
+
 	return s, nil
 }
 
@@ -74,6 +83,13 @@ func (p *Project) FilterJourneys() synthexec.FilterJourneyConfig {
 	return p.projectCfg.FilterJourneys
 }
 
+func (p *Project) Options() synthexec.Options {
+	return synthexec.Options{
+		Budget:    p.projectCfg.Budget,
+		Artifacts: p.artifacts,
+	}
+}
+
 func (p *Project) StdFields() stdfields.StdMonitorFields {
 	sFields, err := stdfields.ConfigToStdMonitorFields(p.rawCfg)
 	// Should be impossible since outer monitor.go should run this same code elsewhere
@@ -144,14 +160,14 @@ func (p *Project) jobs() []jobs.Job {
 
 	if isScript {
 		src := p.projectCfg.Source.Inline.Script
-		j = synthexec.InlineJourneyJob(ctx, src, p.Params(), p.StdFields(), p.extraArgs()...)
+		j = synthexec.InlineJourneyJob(ctx, src, p.Params(), p.StdFields(), p.Options(), p.extraArgs()...)
 	} else {
 		j = func(event *beat.Event) ([]jobs.Job, error) {
 			err := p.Fetch()
 			if err != nil {
 				return nil, fmt.Errorf("could not fetch for project job: %w", err)
 			}
-			sj, err := synthexec.ProjectJob(ctx, p.Workdir(), p.Params(), p.FilterJourneys(), p.StdFields(), p.extraArgs()...)
+			sj, err := synthexec.ProjectJob(ctx, p.Workdir(), p.Params(), p.FilterJourneys(), p.StdFields(), p.Options(), p.extraArgs()...)
 			if err != nil {
 				return nil, err
 			}
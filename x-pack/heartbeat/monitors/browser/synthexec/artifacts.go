@@ -0,0 +1,73 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+//go:build linux || darwin
+// +build linux darwin
+
+package synthexec
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const artifactUploadTimeout = time.Minute
+
+// ArtifactStore persists the blobs of synthetics events, such as screenshots
+// and traces, outside of the events.
+type ArtifactStore interface {
+	// MinSize is the size in bytes from which blobs are uploaded. Smaller
+	// blobs are kept inline in the event.
+	MinSize() int
+	// Put stores data under key and returns the URL referencing it.
+	Put(ctx context.Context, key, contentType string, data []byte) (url string, err error)
+}
+
+// BlobRef references a blob uploaded to an ArtifactStore.
+type BlobRef struct {
+	URL    string
+	Size   int
+	SHA256 string
+}
+
+func (r *BlobRef) ToMap() mapstr.M {
+	return mapstr.M{
+		"url":    r.URL,
+		"size":   r.Size,
+		"sha256": r.SHA256,
+	}
+}
+
+// uploadBlob uploads the blob of se to store and replaces it with a reference
+// to the uploaded artifact. The blob is kept inline when it is smaller than
+// the minimum size of the store. Blobs are stored under their SHA256 hash so
+// identical blobs, like repeated screenshot blocks, are only kept once.
+func uploadBlob(ctx context.Context, store ArtifactStore, se *SynthEvent) error {
+	data, err := base64.StdEncoding.DecodeString(se.Blob)
+	if err != nil {
+		data = []byte(se.Blob)
+	}
+	if len(data) < store.MinSize() {
+		return nil
+	}
+
+	sum := sha256.Sum256(data)
+	key := hex.EncodeToString(sum[:])
+
+	ctx, cancel := context.WithTimeout(ctx, artifactUploadTimeout)
+	defer cancel()
+	url, err := store.Put(ctx, key, se.BlobMime, data)
+	if err != nil {
+		return fmt.Errorf("could not upload %s artifact: %w", se.Type, err)
+	}
+
+	se.Blob = ""
+	se.BlobRef = &BlobRef{URL: url, Size: len(data), SHA256: key}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+//go:build linux || darwin
+// +build linux darwin
+
+package synthexec
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type memArtifactStore struct {
+	minSize int
+	err     error
+	puts    map[string][]byte
+}
+
+func (s *memArtifactStore) MinSize() int { return s.minSize }
+
+func (s *memArtifactStore) Put(_ context.Context, key, _ string, data []byte) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	if s.puts == nil {
+		s.puts = map[string][]byte{}
+	}
+	s.puts[key] = data
+	return "mem://" + key, nil
+}
+
+func TestUploadBlob(t *testing.T) {
+	data := []byte("a large screenshot")
+	blob := base64.StdEncoding.EncodeToString(data)
+	const sum = "68388c142b577e1d608108ce6dc24e6340cbe5edb134d2e35922d401bb638369"
+
+	t.Run("uploaded", func(t *testing.T) {
+		store := &memArtifactStore{}
+		se := &SynthEvent{Type: ScreenshotBlock, Blob: blob, BlobMime: "image/jpeg"}
+
+		require.NoError(t, uploadBlob(context.Background(), store, se))
+		require.Empty(t, se.Blob)
+		require.NotNil(t, se.BlobRef)
+		require.Equal(t, &BlobRef{URL: "mem://" + sum, Size: len(data), SHA256: sum}, se.BlobRef)
+		require.Equal(t, data, store.puts[sum])
+
+		m := se.ToMap()
+		_, err := m.GetValue("synthetics.blob")
+		require.Error(t, err)
+		url, err := m.GetValue("synthetics.blob_ref.url")
+		require.NoError(t, err)
+		require.Equal(t, se.BlobRef.URL, url)
+	})
+
+	t.Run("below min size", func(t *testing.T) {
+		store := &memArtifactStore{minSize: len(data) + 1}
+		se := &SynthEvent{Type: ScreenshotBlock, Blob: blob}
+
+		require.NoError(t, uploadBlob(context.Background(), store, se))
+		require.Equal(t, blob, se.Blob)
+		require.Nil(t, se.BlobRef)
+		require.Empty(t, store.puts)
+	})
+
+	t.Run("upload failure keeps blob", func(t *testing.T) {
+		store := &memArtifactStore{err: errors.New("bucket not found")}
+		se := &SynthEvent{Type: ScreenshotBlock, Blob: blob}
+
+		require.Error(t, uploadBlob(context.Background(), store, se))
+		require.Equal(t, blob, se.Blob)
+		require.Nil(t, se.BlobRef)
+	})
+}
@@ -0,0 +1,178 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+//go:build linux || darwin
+// +build linux darwin
+
+package synthexec
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/elastic/go-sysinfo"
+
+	"github.com/elastic/beats/v7/heartbeat/ecserr"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+const defaultBudgetCheckInterval = time.Second
+
+// BudgetConfig limits the resources a single journey may use. The synthetics
+// command is killed as soon as any of the limits is exceeded. A zero value
+// disables the corresponding limit.
+type BudgetConfig struct {
+	// CPU is the CPU time the synthetics process and its children may use
+	// while running a journey.
+	CPU time.Duration `config:"cpu"`
+	// Memory is the resident memory the synthetics process and its children
+	// may use.
+	Memory cfgtype.ByteSize `config:"memory"`
+	// Time is the wall clock time a journey may run for.
+	Time time.Duration `config:"time"`
+	// CheckInterval is how often the resource usage is sampled.
+	CheckInterval time.Duration `config:"check_interval" validate:"min=0"`
+}
+
+func (b BudgetConfig) enabled() bool {
+	return b.CPU > 0 || b.Memory > 0 || b.Time > 0
+}
+
+// usageFunc returns the CPU time and resident memory used by the process
+// with the given pid and all of its descendants.
+type usageFunc func(pid int) (cpu time.Duration, rss uint64, err error)
+
+// budgetWatcher samples the resource usage of a synthetics command and kills
+// it once the budget of the running journey is exceeded.
+type budgetWatcher struct {
+	budget BudgetConfig
+	usage  usageFunc
+
+	mu           sync.Mutex
+	journeyStart time.Time
+	cpuAtStart   time.Duration
+	resetCPU     bool
+	exceeded     *ecserr.ECSErr
+}
+
+func newBudgetWatcher(budget BudgetConfig, usage usageFunc) *budgetWatcher {
+	return &budgetWatcher{
+		budget:       budget,
+		usage:        usage,
+		journeyStart: time.Now(),
+	}
+}
+
+// journeyStarted resets the budgets for a new journey.
+func (w *budgetWatcher) journeyStarted(now time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.journeyStart = now
+	w.resetCPU = true
+}
+
+// run samples the usage of the process pid until ctx is done, calling kill
+// when the budget is exceeded.
+func (w *budgetWatcher) run(ctx context.Context, pid int, kill func() error) {
+	interval := w.budget.CheckInterval
+	if interval <= 0 {
+		interval = defaultBudgetCheckInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if !w.check(pid, now) {
+				continue
+			}
+			logp.L().Warnf("killing synthetics process: %s", w.err().Message)
+			if err := kill(); err != nil {
+				logp.L().Warnf("could not kill synthetics process: %s", err)
+			}
+			return
+		}
+	}
+}
+
+// check samples the usage of the process pid and returns true if the budget
+// is exceeded.
+func (w *budgetWatcher) check(pid int, now time.Time) bool {
+	cpu, rss, err := w.usage(pid)
+	if err != nil {
+		logp.L().Debugf("could not read resource usage of synthetics process %d: %s", pid, err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err == nil && w.resetCPU {
+		w.cpuAtStart = cpu
+		w.resetCPU = false
+	}
+
+	elapsed := now.Sub(w.journeyStart)
+	switch {
+	case w.budget.Time > 0 && elapsed > w.budget.Time:
+		w.exceeded = ecserr.NewCmdBudgetExceededErr("time", w.budget.Time.String(), elapsed.Round(time.Millisecond).String())
+	case err != nil:
+	case w.budget.CPU > 0 && cpu-w.cpuAtStart > w.budget.CPU:
+		w.exceeded = ecserr.NewCmdBudgetExceededErr("cpu", w.budget.CPU.String(), (cpu - w.cpuAtStart).Round(time.Millisecond).String())
+	case w.budget.Memory > 0 && rss > uint64(w.budget.Memory):
+		w.exceeded = ecserr.NewCmdBudgetExceededErr("memory", humanize.IBytes(uint64(w.budget.Memory)), humanize.IBytes(rss))
+	}
+	return w.exceeded != nil
+}
+
+// err returns the error describing the exceeded budget, if any.
+func (w *budgetWatcher) err() *ecserr.ECSErr {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.exceeded
+}
+
+// processTreeUsage is a usageFunc summing the usage of the process and all of
+// its descendants, which includes the browser processes started by the
+// synthetics agent.
+func processTreeUsage(pid int) (cpu time.Duration, rss uint64, err error) {
+	procs, err := sysinfo.Processes()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	children := make(map[int][]int, len(procs))
+	byPID := make(map[int]int, len(procs))
+	for i, p := range procs {
+		byPID[p.PID()] = i
+		info, err := p.Info()
+		if err != nil {
+			continue
+		}
+		children[info.PPID] = append(children[info.PPID], info.PID)
+	}
+
+	pending := []int{pid}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+		pending = append(pending, children[current]...)
+
+		i, found := byPID[current]
+		if !found {
+			continue
+		}
+		if times, err := procs[i].CPUTime(); err == nil {
+			cpu += times.User + times.System
+		}
+		if mem, err := procs[i].Memory(); err == nil {
+			rss += mem.Resident
+		}
+	}
+	return cpu, rss, nil
+}
@@ -0,0 +1,136 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+//go:build linux || darwin
+// +build linux darwin
+
+package synthexec
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUsage struct {
+	cpu time.Duration
+	rss uint64
+	err error
+}
+
+func (u *fakeUsage) usage(int) (time.Duration, uint64, error) {
+	return u.cpu, u.rss, u.err
+}
+
+func TestBudgetWatcherCheck(t *testing.T) {
+	start := time.Now()
+
+	tests := []struct {
+		name     string
+		budget   BudgetConfig
+		usage    fakeUsage
+		at       time.Duration
+		exceeded string
+	}{
+		{
+			name:   "within budget",
+			budget: BudgetConfig{CPU: time.Minute, Memory: 1 << 30, Time: time.Minute},
+			usage:  fakeUsage{cpu: time.Second, rss: 1 << 20},
+			at:     time.Second,
+		},
+		{
+			name:     "time exceeded",
+			budget:   BudgetConfig{Time: time.Minute},
+			at:       2 * time.Minute,
+			exceeded: "journey exceeded its time budget of 1m0s (used 2m0s)",
+		},
+		{
+			name:     "cpu exceeded",
+			budget:   BudgetConfig{CPU: time.Second},
+			usage:    fakeUsage{cpu: 3 * time.Second},
+			at:       time.Second,
+			exceeded: "journey exceeded its cpu budget of 1s (used 3s)",
+		},
+		{
+			name:     "memory exceeded",
+			budget:   BudgetConfig{Memory: 1 << 20},
+			usage:    fakeUsage{rss: 2 << 20},
+			at:       time.Second,
+			exceeded: "journey exceeded its memory budget of 1.0 MiB (used 2.0 MiB)",
+		},
+		{
+			name:   "usage not available",
+			budget: BudgetConfig{CPU: time.Second, Memory: 1 << 20},
+			usage:  fakeUsage{cpu: time.Hour, rss: 1 << 30, err: errors.New("no such process")},
+			at:     time.Second,
+		},
+		{
+			name:     "time checked without usage",
+			budget:   BudgetConfig{Time: time.Second},
+			usage:    fakeUsage{err: errors.New("no such process")},
+			at:       2 * time.Second,
+			exceeded: "journey exceeded its time budget of 1s (used 2s)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newBudgetWatcher(tt.budget, tt.usage.usage)
+			w.journeyStart = start
+
+			exceeded := w.check(1, start.Add(tt.at))
+			if tt.exceeded == "" {
+				require.False(t, exceeded)
+				require.Nil(t, w.err())
+				return
+			}
+			require.True(t, exceeded)
+			require.NotNil(t, w.err())
+			require.Equal(t, "CMD_BUDGET_EXCEEDED", string(w.err().Code))
+			require.Equal(t, tt.exceeded, w.err().Message)
+		})
+	}
+}
+
+func TestBudgetWatcherJourneyStart(t *testing.T) {
+	usage := &fakeUsage{cpu: 10 * time.Second}
+	w := newBudgetWatcher(BudgetConfig{CPU: 5 * time.Second, Time: time.Minute}, usage.usage)
+
+	// A new journey resets the CPU and time budgets.
+	start := time.Now()
+	w.journeyStarted(start)
+	require.False(t, w.check(1, start.Add(time.Second)))
+
+	usage.cpu = 14 * time.Second
+	require.False(t, w.check(1, start.Add(50*time.Second)))
+
+	usage.cpu = 16 * time.Second
+	require.True(t, w.check(1, start.Add(55*time.Second)))
+	require.Equal(t, "journey exceeded its cpu budget of 5s (used 6s)", w.err().Message)
+}
+
+func TestBudgetWatcherRunKills(t *testing.T) {
+	usage := &fakeUsage{rss: 2 << 20}
+	w := newBudgetWatcher(BudgetConfig{Memory: 1 << 20, CheckInterval: time.Millisecond}, usage.usage)
+
+	killed := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		w.run(context.Background(), 1, func() error {
+			close(killed)
+			return nil
+		})
+	}()
+
+	select {
+	case <-killed:
+	case <-time.After(10 * time.Second):
+		t.Fatal("process was not killed")
+	}
+	<-done
+	require.NotNil(t, w.err())
+}
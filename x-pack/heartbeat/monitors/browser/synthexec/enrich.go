@@ -7,11 +7,13 @@
 package synthexec
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/elastic/beats/v7/libbeat/beat/events"
 	"github.com/elastic/beats/v7/libbeat/processors/add_data_stream"
+	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/mapstr"
 
 	"github.com/gofrs/uuid"
@@ -29,6 +31,9 @@ type streamEnricher struct {
 	journeyCount int
 	sFields      stdfields.StdMonitorFields
 	checkGroup   string
+	// artifacts, when set, receives the blobs of the events instead of
+	// inlining them.
+	artifacts ArtifactStore
 }
 
 func newStreamEnricher(sFields stdfields.StdMonitorFields) *streamEnricher {
@@ -158,6 +163,13 @@ func (je *journeyEnricher) enrichSynthEvent(event *beat.Event, se *SynthEvent) e
 		eventext.SetMeta(event, events.FieldMetaOpType, events.OpTypeCreate)
 	}
 
+	if se.Blob != "" && je.streamEnricher != nil && je.streamEnricher.artifacts != nil {
+		// Keep the blob inline if it can't be uploaded, so it isn't lost.
+		if err := uploadBlob(context.Background(), je.streamEnricher.artifacts, se); err != nil {
+			logp.L().Warn(err)
+		}
+	}
+
 	eventext.MergeEventFields(event, se.ToMap())
 
 	if len(je.urlFields) == 0 {
@@ -46,8 +46,17 @@ var platformCmdMutate func(*exec.Cmd) = func(*exec.Cmd) {}
 
 var SynthexecTimeout struct{}
 
+// Options configures how the synthetics command of a job is run.
+type Options struct {
+	// Budget limits the resources each journey may use.
+	Budget BudgetConfig
+	// Artifacts, when set, receives the blobs of the events instead of
+	// inlining them.
+	Artifacts ArtifactStore
+}
+
 // ProjectJob will run a single journey by name from the given project.
-func ProjectJob(ctx context.Context, projectPath string, params mapstr.M, filterJourneys FilterJourneyConfig, fields stdfields.StdMonitorFields, extraArgs ...string) (jobs.Job, error) {
+func ProjectJob(ctx context.Context, projectPath string, params mapstr.M, filterJourneys FilterJourneyConfig, fields stdfields.StdMonitorFields, opts Options, extraArgs ...string) (jobs.Job, error) {
 	// Run the command in the given projectPath, use '.' as the first arg since the command runs
 	// in the correct dir
 	cmdFactory, err := projectCommandFactory(projectPath, extraArgs...)
@@ -55,7 +64,7 @@ func ProjectJob(ctx context.Context, projectPath string, params mapstr.M, filter
 		return nil, err
 	}
 
-	return startCmdJob(ctx, cmdFactory, nil, params, filterJourneys, fields), nil
+	return startCmdJob(ctx, cmdFactory, nil, params, filterJourneys, fields, opts), nil
 }
 
 func projectCommandFactory(projectPath string, args ...string) (func() *exec.Cmd, error) {
@@ -79,21 +88,22 @@ func projectCommandFactory(projectPath string, args ...string) (func() *exec.Cmd
 }
 
 // InlineJourneyJob returns a job that runs the given source as a single journey.
-func InlineJourneyJob(ctx context.Context, script string, params mapstr.M, fields stdfields.StdMonitorFields, extraArgs ...string) jobs.Job {
+func InlineJourneyJob(ctx context.Context, script string, params mapstr.M, fields stdfields.StdMonitorFields, opts Options, extraArgs ...string) jobs.Job {
 	newCmd := func() *exec.Cmd {
 		return exec.Command("elastic-synthetics", append(extraArgs, "--inline")...) //nolint:gosec // we are safely building a command here, users can add args at their own risk
 	}
 
-	return startCmdJob(ctx, newCmd, &script, params, FilterJourneyConfig{}, fields)
+	return startCmdJob(ctx, newCmd, &script, params, FilterJourneyConfig{}, fields, opts)
 }
 
 // startCmdJob adapts commands into a heartbeat job. This is a little awkward given that the command's output is
 // available via a sequence of events in the multiplexer, while heartbeat jobs are tail recursive continuations.
 // Here, we adapt one to the other, where each recursive job pulls another item off the chan until none are left.
-func startCmdJob(ctx context.Context, newCmd func() *exec.Cmd, stdinStr *string, params mapstr.M, filterJourneys FilterJourneyConfig, sFields stdfields.StdMonitorFields) jobs.Job {
+func startCmdJob(ctx context.Context, newCmd func() *exec.Cmd, stdinStr *string, params mapstr.M, filterJourneys FilterJourneyConfig, sFields stdfields.StdMonitorFields, opts Options) jobs.Job {
 	return func(event *beat.Event) ([]jobs.Job, error) {
 		senr := newStreamEnricher(sFields)
-		mpx, err := runCmd(ctx, newCmd(), stdinStr, params, filterJourneys)
+		senr.artifacts = opts.Artifacts
+		mpx, err := runCmd(ctx, newCmd(), stdinStr, params, filterJourneys, opts.Budget)
 		if err != nil {
 			err := senr.enrich(event, &SynthEvent{
 				Type:  "cmd/could_not_start",
@@ -122,13 +132,14 @@ func readResultsJob(ctx context.Context, synthEvents <-chan *SynthEvent, enrich
 }
 
 // runCmd runs the given command, piping stdinStr if present to the command's stdin, and supplying
-// the params var as a CLI argument.
+// the params var as a CLI argument. The command is killed if a journey exceeds the budget.
 func runCmd(
 	ctx context.Context,
 	cmd *exec.Cmd,
 	stdinStr *string,
 	params mapstr.M,
 	filterJourneys FilterJourneyConfig,
+	budget BudgetConfig,
 ) (mpx *ExecMultiplexer, err error) {
 	// Attach sysproc attrs to ensure subprocesses are properly killed
 	platformCmdMutate(cmd)
@@ -174,6 +185,11 @@ func runCmd(
 		cmd.Stdin = strings.NewReader(*stdinStr)
 	}
 
+	var budgetW *budgetWatcher
+	if budget.enabled() {
+		budgetW = newBudgetWatcher(budget, processTreeUsage)
+	}
+
 	wg := sync.WaitGroup{}
 
 	// Send stdout into the output
@@ -222,6 +238,9 @@ func runCmd(
 			if err != nil {
 				logp.L().Warn("error decoding json for test json results: %w", err)
 			}
+			if budgetW != nil && se.Type == JourneyStart {
+				budgetW.journeyStarted(time.Now())
+			}
 
 			mpx.writeSynthEvent(&se)
 		}
@@ -269,6 +288,10 @@ func runCmd(
 		}
 	}()
 
+	if budgetW != nil {
+		go budgetW.run(ctx, cmd.Process.Pid, cmd.Process.Kill)
+	}
+
 	// Close mpx after the process is done and all events have been sent / consumed
 	go func() {
 		err := <-cmdDone
@@ -281,7 +304,9 @@ func runCmd(
 			// to decide which error to stream
 			logp.Warn("Error executing command '%s' (%d): %s", loggableCmd.String(), cmd.ProcessState.ExitCode(), err)
 
-			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			if budgetW != nil && budgetW.err() != nil {
+				cmdError = ECSErrToSynthError(budgetW.err())
+			} else if errors.Is(ctx.Err(), context.DeadlineExceeded) {
 				timeout, _ := ctx.Value(SynthexecTimeout).(time.Duration)
 				cmdError = ECSErrToSynthError(ecserr.NewCmdTimeoutStatusErr(timeout, loggableCmd.String()))
 			} else {
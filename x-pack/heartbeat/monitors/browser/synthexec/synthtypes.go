@@ -44,6 +44,7 @@ type SynthEvent struct {
 	Payload              mapstr.M    `json:"payload"`
 	Blob                 string      `json:"blob"`
 	BlobMime             string      `json:"blob_mime"`
+	BlobRef              *BlobRef    `json:"-"`
 	Error                *SynthError `json:"error"`
 	URL                  string      `json:"url"`
 	Status               string      `json:"status"`
@@ -83,6 +84,9 @@ func (se SynthEvent) ToMap() (m mapstr.M) {
 	if se.BlobMime != "" {
 		_, _ = m.Put("synthetics.blob_mime", se.BlobMime)
 	}
+	if se.BlobRef != nil {
+		_, _ = m.Put("synthetics.blob_ref", se.BlobRef.ToMap())
+	}
 	if se.Step != nil {
 		_, _ = m.Put("synthetics.step", se.Step.ToMap())
 	}
@@ -0,0 +1,459 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Encodings of the parquet format.
+const (
+	encodingPlain           = 0
+	encodingPlainDictionary = 2
+	encodingRLE             = 3
+	encodingRLEDictionary   = 8
+)
+
+// Compression codecs of the parquet format.
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecZstd         = 6
+	codecLZ4Raw       = 7
+)
+
+// Page types of the parquet format.
+const (
+	pageData       = 0
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+// Limits of the sizes read from the metadata and the page headers, so that
+// a corrupted file can't make the reader allocate unbounded amounts of memory.
+const (
+	// maxColumnChunkSize is the maximum compressed and uncompressed size of a
+	// column chunk, and so of the sum of the sizes of its pages.
+	maxColumnChunkSize = 1 << 30
+	// maxPageSize is the maximum uncompressed size of a page.
+	maxPageSize = 256 << 20
+	// maxColumnChunkValues is the maximum number of values of a column chunk.
+	maxColumnChunkValues = 1 << 24
+)
+
+// columnChunk holds the values of a column in a row group. Values are nil
+// when the column is null, defs holds the definition level of each value
+// when the column is optional.
+type columnChunk struct {
+	values []interface{}
+	defs   []uint32
+}
+
+// readColumnChunk reads and decodes all pages of a column chunk.
+func readColumnChunk(r io.ReaderAt, size int64, c *column, chunk thriftFields) (*columnChunk, error) {
+	if chunk.has(1) {
+		return nil, fmt.Errorf("column %v is stored in the external file %s", c, chunk.string(1))
+	}
+	meta := chunk.strct(3)
+	if meta == nil {
+		return nil, fmt.Errorf("column %v has no metadata", c)
+	}
+
+	start := meta.int(9)
+	if dict := meta.int(11); meta.has(11) && dict > 0 && dict < start {
+		start = dict
+	}
+	length := meta.int(7)
+	if start < 0 || length < 0 || start > size || length > size-start {
+		return nil, fmt.Errorf("column %v is outside of the file", c)
+	}
+	// uncompressed is what is left of the uncompressed size of the chunk
+	// for the pages not read yet.
+	uncompressed := meta.int(6)
+	if length > maxColumnChunkSize || uncompressed < 0 || uncompressed > maxColumnChunkSize {
+		return nil, fmt.Errorf("column %v is larger than %d bytes", c, maxColumnChunkSize)
+	}
+	numValues := meta.int(5)
+	if numValues < 0 || numValues > maxColumnChunkValues {
+		return nil, fmt.Errorf("column %v has %d values, more than the maximum of %d", c, numValues, maxColumnChunkValues)
+	}
+	buf := make([]byte, length)
+	if _, err := r.ReadAt(buf, start); err != nil {
+		return nil, fmt.Errorf("failed to read column %v: %w", c, err)
+	}
+
+	codec := meta.int(4)
+	out := &columnChunk{}
+	var dict []interface{}
+	for read := int64(0); read < numValues; {
+		header, n, err := decodeThriftStruct(buf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read page header of column %v: %w", c, err)
+		}
+		buf = buf[n:]
+		compressedSize, uncompressedSize := int(header.int(3)), int(header.int(2))
+		if compressedSize < 0 || compressedSize > len(buf) || uncompressedSize < 0 {
+			return nil, fmt.Errorf("page of column %v is truncated", c)
+		}
+		if uncompressedSize > maxPageSize || int64(uncompressedSize) > uncompressed {
+			return nil, fmt.Errorf("page of column %v is larger than its column chunk or than %d bytes", c, maxPageSize)
+		}
+		uncompressed -= int64(uncompressedSize)
+		data := buf[:compressedSize]
+		buf = buf[compressedSize:]
+
+		switch header.int(1) {
+		case pageDictionary:
+			page, err := decompress(codec, data, uncompressedSize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decompress dictionary page of column %v: %w", c, err)
+			}
+			if dict, err = decodePlain(c, page, int(header.strct(7).int(1))); err != nil {
+				return nil, fmt.Errorf("failed to decode dictionary page of column %v: %w", c, err)
+			}
+		case pageData:
+			page, err := decompress(codec, data, uncompressedSize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decompress data page of column %v: %w", c, err)
+			}
+			h := header.strct(5)
+			count := int(h.int(1))
+			if count < 0 || int64(count) > numValues-read {
+				return nil, fmt.Errorf("data page of column %v has %d values, more than its column chunk", c, count)
+			}
+			var defs []uint32
+			if c.maxDef > 0 {
+				if h.int(3) != encodingRLE {
+					return nil, fmt.Errorf("definition level encoding %d of column %v is not supported", h.int(3), c)
+				}
+				if len(page) < 4 {
+					return nil, fmt.Errorf("data page of column %v is truncated", c)
+				}
+				levels := binary.LittleEndian.Uint32(page)
+				if uint64(levels) > uint64(len(page)-4) {
+					return nil, fmt.Errorf("data page of column %v is truncated", c)
+				}
+				if defs, err = decodeHybrid(page[4:4+levels], bitWidth(c.maxDef), count); err != nil {
+					return nil, fmt.Errorf("failed to decode definition levels of column %v: %w", c, err)
+				}
+				page = page[4+levels:]
+			}
+			if err := out.append(c, h.int(2), page, count, defs, dict); err != nil {
+				return nil, err
+			}
+			read += int64(count)
+		case pageDataV2:
+			h := header.strct(8)
+			count := int(h.int(1))
+			if count < 0 || int64(count) > numValues-read {
+				return nil, fmt.Errorf("data page of column %v has %d values, more than its column chunk", c, count)
+			}
+			defsLength, repsLength := int(h.int(5)), int(h.int(6))
+			if repsLength != 0 {
+				return nil, fmt.Errorf("repetition levels of column %v are not supported", c)
+			}
+			if defsLength < 0 || defsLength > len(data) || defsLength > uncompressedSize {
+				return nil, fmt.Errorf("data page of column %v is truncated", c)
+			}
+			var defs []uint32
+			if c.maxDef > 0 {
+				if defs, err = decodeHybrid(data[:defsLength], bitWidth(c.maxDef), count); err != nil {
+					return nil, fmt.Errorf("failed to decode definition levels of column %v: %w", c, err)
+				}
+			}
+			page := data[defsLength:]
+			// the values are compressed unless is_compressed is false
+			if !h.has(7) || h.bool(7) {
+				if page, err = decompress(codec, page, uncompressedSize-defsLength); err != nil {
+					return nil, fmt.Errorf("failed to decompress data page of column %v: %w", c, err)
+				}
+			}
+			if err := out.append(c, h.int(4), page, count, defs, dict); err != nil {
+				return nil, err
+			}
+			read += int64(count)
+		}
+		if len(buf) == 0 && read < numValues {
+			return nil, fmt.Errorf("column %v has %d values, %d were read", c, numValues, read)
+		}
+	}
+	return out, nil
+}
+
+// append decodes the values of a data page and appends them to the chunk.
+func (out *columnChunk) append(c *column, encoding int64, page []byte, count int, defs []uint32, dict []interface{}) error {
+	present := count
+	if defs != nil {
+		present = 0
+		for _, d := range defs {
+			if int(d) == c.maxDef {
+				present++
+			}
+		}
+	}
+
+	var values []interface{}
+	var err error
+	switch encoding {
+	case encodingPlain:
+		values, err = decodePlain(c, page, present)
+	case encodingPlainDictionary, encodingRLEDictionary:
+		values, err = decodeDictionary(page, present, dict)
+	case encodingRLE:
+		values, err = decodeBooleans(c, page, present)
+	default:
+		err = fmt.Errorf("encoding %d is not supported", encoding)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode values of column %v: %w", c, err)
+	}
+
+	if defs == nil {
+		out.values = append(out.values, values...)
+		return nil
+	}
+	for _, d := range defs {
+		var v interface{}
+		if int(d) == c.maxDef {
+			v, values = values[0], values[1:]
+		}
+		out.values = append(out.values, v)
+	}
+	out.defs = append(out.defs, defs...)
+	return nil
+}
+
+// decodePlain decodes n values of the physical type of the column.
+func decodePlain(c *column, data []byte, n int) ([]interface{}, error) {
+	if n < 0 {
+		return nil, errors.New("negative number of values")
+	}
+	width := 0
+	switch c.typ {
+	case typeBoolean:
+		if len(data) < (n+7)/8 {
+			return nil, io.ErrUnexpectedEOF
+		}
+	case typeInt32, typeFloat:
+		width = 4
+	case typeInt64, typeDouble:
+		width = 8
+	case typeInt96:
+		width = 12
+	case typeFixedLenByteArray:
+		if width = c.typeLength; width <= 0 {
+			return nil, fmt.Errorf("invalid fixed length %d", width)
+		}
+	case typeByteArray:
+		// each value is prefixed by its length
+		if len(data)/4 < n {
+			return nil, io.ErrUnexpectedEOF
+		}
+	default:
+		return nil, fmt.Errorf("physical type %d is not supported", c.typ)
+	}
+	if width > 0 && len(data)/width < n {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	values := make([]interface{}, n)
+	for i := range values {
+		var v interface{}
+		switch c.typ {
+		case typeBoolean:
+			v = data[i/8]>>(i%8)&1 == 1
+		case typeInt32:
+			v = int32(binary.LittleEndian.Uint32(data[i*4:]))
+		case typeInt64:
+			v = int64(binary.LittleEndian.Uint64(data[i*8:]))
+		case typeFloat:
+			v = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+		case typeDouble:
+			v = math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:]))
+		case typeInt96, typeFixedLenByteArray:
+			v = data[i*width : (i+1)*width]
+		case typeByteArray:
+			if len(data) < 4 {
+				return nil, io.ErrUnexpectedEOF
+			}
+			length := binary.LittleEndian.Uint32(data)
+			if uint64(length) > uint64(len(data)-4) {
+				return nil, io.ErrUnexpectedEOF
+			}
+			v, data = data[4:4+length], data[4+length:]
+		}
+		values[i] = c.convert(v)
+	}
+	return values, nil
+}
+
+// decodeDictionary decodes n dictionary indexes and returns the values they refer to.
+func decodeDictionary(data []byte, n int, dict []interface{}) ([]interface{}, error) {
+	if n == 0 {
+		return nil, nil
+	}
+	if dict == nil {
+		return nil, errors.New("dictionary page is missing")
+	}
+	if len(data) == 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	indexes, err := decodeHybrid(data[1:], int(data[0]), n)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, n)
+	for i, idx := range indexes {
+		if int(idx) >= len(dict) {
+			return nil, fmt.Errorf("dictionary index %d is out of range", idx)
+		}
+		values[i] = dict[idx]
+	}
+	return values, nil
+}
+
+// decodeBooleans decodes n run length encoded booleans.
+func decodeBooleans(c *column, data []byte, n int) ([]interface{}, error) {
+	if c.typ != typeBoolean {
+		return nil, fmt.Errorf("run length encoding of physical type %d is not supported", c.typ)
+	}
+	if len(data) < 4 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	length := binary.LittleEndian.Uint32(data)
+	if uint64(length) > uint64(len(data)-4) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	bits, err := decodeHybrid(data[4:4+length], 1, n)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, n)
+	for i, b := range bits {
+		values[i] = b == 1
+	}
+	return values, nil
+}
+
+// decodeHybrid decodes n values of the run length encoding and bit-packing
+// hybrid used for levels and dictionary indexes.
+func decodeHybrid(data []byte, width, n int) ([]uint32, error) {
+	if width < 0 || width > 32 {
+		return nil, fmt.Errorf("invalid bit width %d", width)
+	}
+	byteWidth := (width + 7) / 8
+	var values []uint32
+	for len(values) < n {
+		header, k := binary.Uvarint(data)
+		if k <= 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		data = data[k:]
+
+		if header&1 == 0 {
+			// run of a repeated value
+			if len(data) < byteWidth {
+				return nil, io.ErrUnexpectedEOF
+			}
+			var v uint32
+			for i := 0; i < byteWidth; i++ {
+				v |= uint32(data[i]) << (8 * i)
+			}
+			data = data[byteWidth:]
+			for count := header >> 1; count > 0 && len(values) < n; count-- {
+				values = append(values, v)
+			}
+			continue
+		}
+
+		// groups of 8 bit-packed values
+		groups := header >> 1
+		if groups > uint64(len(data)) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		size := int(groups) * width
+		if size > len(data) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		for i := 0; i < int(groups)*8 && len(values) < n; i++ {
+			var v uint32
+			for b := 0; b < width; b++ {
+				bit := i*width + b
+				v |= uint32(data[bit/8]>>(bit%8)&1) << b
+			}
+			values = append(values, v)
+		}
+		data = data[size:]
+	}
+	return values, nil
+}
+
+// decompress decompresses a page with the codec of its column chunk. The
+// page must decompress to size bytes, which is bounded by the caller.
+func decompress(codec int64, data []byte, size int) ([]byte, error) {
+	if size < 0 {
+		return nil, fmt.Errorf("invalid uncompressed size %d", size)
+	}
+	var out []byte
+	switch codec {
+	case codecUncompressed:
+		out = data
+	case codecSnappy:
+		n, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, err
+		}
+		if n != size {
+			return nil, fmt.Errorf("page decompresses to %d bytes instead of %d", n, size)
+		}
+		if out, err = snappy.Decode(nil, data); err != nil {
+			return nil, err
+		}
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		// one byte more than the page size is read to detect larger pages
+		if out, err = io.ReadAll(io.LimitReader(r, int64(size)+1)); err != nil {
+			return nil, err
+		}
+	case codecZstd:
+		// the memory of the decoder is bounded by the maximum page size
+		dec, err := zstd.NewReader(bytes.NewReader(data), zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(maxPageSize))
+		if err != nil {
+			return nil, err
+		}
+		defer dec.Close()
+		if out, err = io.ReadAll(io.LimitReader(dec, int64(size)+1)); err != nil {
+			return nil, err
+		}
+	case codecLZ4Raw:
+		out = make([]byte, size)
+		n, err := lz4.UncompressBlock(data, out)
+		if err != nil {
+			return nil, err
+		}
+		out = out[:n]
+	default:
+		return nil, fmt.Errorf("compression codec %d is not supported", codec)
+	}
+	if len(out) != size {
+		return nil, fmt.Errorf("page decompresses to %d bytes instead of %d", len(out), size)
+	}
+	return out, nil
+}
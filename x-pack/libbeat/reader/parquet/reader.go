@@ -0,0 +1,174 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Package parquet reads the rows of Apache Parquet files.
+//
+// The reader supports flat and nested schemas without repeated fields, the
+// PLAIN, dictionary and RLE encodings, version 1 and 2 data pages and the
+// snappy, gzip, zstd and lz4_raw compression codecs. Values are converted
+// from their logical type: strings, dates, timestamps and decimals. NaN and
+// infinite floating point values, which cannot be represented in JSON, are
+// read as null. Column chunks larger than 1 GiB or with more than 16M values
+// and pages larger than 256 MiB are rejected.
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const (
+	magic          = "PAR1"
+	encryptedMagic = "PARE"
+)
+
+// Reader reads the rows of a parquet file, one row group at a time.
+type Reader struct {
+	r         io.ReaderAt
+	size      int64
+	columns   []*column
+	rowGroups []thriftFields
+	numRows   int64
+
+	// group is the index of the next row group to load.
+	group int
+	// chunks holds the column chunks of the loaded row group.
+	chunks []*columnChunk
+	// row is the index of the next row in the loaded row group.
+	row, rows int
+}
+
+// NewReader returns a reader of the parquet file of the given size. The
+// metadata of the file is read from its footer.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	if size < int64(2*len(magic)+4) {
+		return nil, errors.New("file is too small to be a parquet file")
+	}
+	head := make([]byte, len(magic))
+	if _, err := r.ReadAt(head, 0); err != nil {
+		return nil, fmt.Errorf("failed to read parquet header: %w", err)
+	}
+	tail := make([]byte, 4+len(magic))
+	if _, err := r.ReadAt(tail, size-int64(len(tail))); err != nil {
+		return nil, fmt.Errorf("failed to read parquet footer: %w", err)
+	}
+	switch {
+	case string(tail[4:]) == encryptedMagic:
+		return nil, errors.New("encrypted parquet files are not supported")
+	case string(head) != magic || string(tail[4:]) != magic:
+		return nil, errors.New("not a parquet file")
+	}
+
+	length := int64(binary.LittleEndian.Uint32(tail))
+	if length > size-int64(len(head)+len(tail)) {
+		return nil, errors.New("parquet metadata is truncated")
+	}
+	buf := make([]byte, length)
+	if _, err := r.ReadAt(buf, size-int64(len(tail))-length); err != nil {
+		return nil, fmt.Errorf("failed to read parquet metadata: %w", err)
+	}
+	meta, _, err := decodeThriftStruct(buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode parquet metadata: %w", err)
+	}
+
+	columns, err := readSchema(meta.list(2))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read parquet schema: %w", err)
+	}
+	reader := &Reader{r: r, size: size, columns: columns, numRows: meta.int(3)}
+	for _, g := range meta.list(4) {
+		group, _ := g.(thriftFields)
+		if n := len(group.list(1)); n != len(columns) {
+			return nil, fmt.Errorf("row group has %d columns, the schema has %d", n, len(columns))
+		}
+		reader.rowGroups = append(reader.rowGroups, group)
+	}
+	return reader, nil
+}
+
+// NumRows returns the number of rows of the file.
+func (r *Reader) NumRows() int64 {
+	return r.numRows
+}
+
+// SkipRows skips the next n rows. Row groups are skipped without being read
+// when all their rows are skipped.
+func (r *Reader) SkipRows(n int64) error {
+	for n > 0 {
+		if r.row < r.rows {
+			skip := int64(r.rows - r.row)
+			if skip > n {
+				skip = n
+			}
+			r.row += int(skip)
+			n -= skip
+			continue
+		}
+		if r.group >= len(r.rowGroups) {
+			return nil
+		}
+		if rows := r.rowGroups[r.group].int(3); rows <= n {
+			r.group++
+			n -= rows
+			continue
+		}
+		if err := r.loadRowGroup(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Next returns the next row, or io.EOF when all rows have been read.
+func (r *Reader) Next() (mapstr.M, error) {
+	for r.row >= r.rows {
+		if r.group >= len(r.rowGroups) {
+			return nil, io.EOF
+		}
+		if err := r.loadRowGroup(); err != nil {
+			return nil, err
+		}
+	}
+
+	row := make(mapstr.M, len(r.columns))
+	for i, c := range r.columns {
+		chunk := r.chunks[i]
+		def := c.maxDef
+		if chunk.defs != nil {
+			def = int(chunk.defs[r.row])
+		}
+		c.put(row, chunk.values[r.row], def)
+	}
+	r.row++
+	return row, nil
+}
+
+// loadRowGroup reads the column chunks of the next row group.
+func (r *Reader) loadRowGroup() error {
+	group := r.rowGroups[r.group]
+	r.group++
+
+	rows := group.int(3)
+	chunks := make([]*columnChunk, len(r.columns))
+	for i, c := range group.list(1) {
+		chunk, _ := c.(thriftFields)
+		var err error
+		if chunks[i], err = readColumnChunk(r.r, r.size, r.columns[i], chunk); err != nil {
+			return err
+		}
+		if n := int64(len(chunks[i].values)); n != rows {
+			return fmt.Errorf("column %v has %d values, the row group has %d rows", r.columns[i], n, rows)
+		}
+		if r.columns[i].maxDef > 0 && int64(len(chunks[i].defs)) != rows {
+			return fmt.Errorf("column %v has %d definition levels, the row group has %d rows", r.columns[i], len(chunks[i].defs), rows)
+		}
+	}
+	r.chunks, r.row, r.rows = chunks, 0, int(rows)
+	return nil
+}
@@ -0,0 +1,547 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestDecodeThriftStruct(t *testing.T) {
+	// field 1 of type i32 with a short field header, field 16 with a long one
+	s, n, err := decodeThriftStruct([]byte{0x15, 0x02, 0x05, 0x20, 0x03, 0x00, 0xff})
+	require.NoError(t, err)
+	assert.Equal(t, 6, n)
+	assert.Equal(t, thriftFields{1: int64(1), 16: int64(-2)}, s)
+
+	_, _, err = decodeThriftStruct([]byte{0x15})
+	assert.ErrorIs(t, err, errThriftTruncated)
+}
+
+func TestDecodeHybrid(t *testing.T) {
+	// the bit-packing example of the parquet format specification
+	values, err := decodeHybrid([]byte{0x03, 0x88, 0xc6, 0xfa}, 3, 8)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{0, 1, 2, 3, 4, 5, 6, 7}, values)
+
+	// a run of 4 and a run of 2
+	values, err = decodeHybrid([]byte{0x08, 0x05, 0x04, 0x02}, 3, 6)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{5, 5, 5, 5, 2, 2}, values)
+
+	_, err = decodeHybrid([]byte{0x08}, 3, 4)
+	assert.Error(t, err)
+}
+
+func TestReader(t *testing.T) {
+	ts := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	want := []mapstr.M{
+		{"id": int64(1), "message": "a", "@timestamp": ts, "ok": true, "day": "2023-04-01", "ratio": 0.5},
+		{"id": int64(2), "message": nil, "@timestamp": ts.Add(time.Second), "ok": false, "day": nil, "ratio": nil},
+		{"id": int64(3), "message": "c", "@timestamp": ts.Add(2 * time.Second), "ok": true, "day": "1970-01-01", "ratio": 1.25},
+	}
+
+	for name, codec := range map[string]int32{
+		"uncompressed": codecUncompressed,
+		"snappy":       codecSnappy,
+		"gzip":         codecGzip,
+		"zstd":         codecZstd,
+	} {
+		t.Run(name, func(t *testing.T) {
+			data := flatTestFile(t, codec)
+			r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), r.NumRows())
+
+			for _, w := range want {
+				row, err := r.Next()
+				require.NoError(t, err)
+				assert.Equal(t, w, row)
+			}
+			_, err = r.Next()
+			assert.ErrorIs(t, err, io.EOF)
+		})
+	}
+
+	t.Run("skip_rows", func(t *testing.T) {
+		data := flatTestFile(t, codecUncompressed)
+		for skip, w := range want {
+			r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+			require.NoError(t, err)
+			require.NoError(t, r.SkipRows(int64(skip)))
+			row, err := r.Next()
+			require.NoError(t, err)
+			assert.Equal(t, w, row)
+		}
+
+		r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		require.NoError(t, r.SkipRows(5))
+		_, err = r.Next()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReaderDictionaryAndNestedGroups(t *testing.T) {
+	f := newTestFile(
+		tstruct{{4, "schema"}, {5, int32(2)}},
+		tstruct{{3, int32(repetitionOptional)}, {4, "host"}, {5, int32(2)}},
+		tstruct{{1, int32(typeByteArray)}, {3, int32(0)}, {4, "name"}, {10, tstruct{{1, tstruct{}}}}},
+		tstruct{{1, int32(typeInt32)}, {3, int32(repetitionOptional)}, {4, "port"}, {10, tstruct{{10, tstruct{{1, int8(16)}, {2, false}}}}}},
+		tstruct{{1, int32(typeByteArray)}, {3, int32(0)}, {4, "level"}, {6, int32(convertedEnum)}},
+	)
+	f.addRowGroup(3, codecSnappy,
+		testChunk{typ: typeByteArray, path: []string{"host", "name"}, pages: []testPage{
+			dictionaryPage(2, plainByteArrays("a", "b")),
+			dataPageV2(3, encodingRLEDictionary, bitPacked(1, 1, 0, 1), append([]byte{1}, bitPacked(1, 0, 1)...)),
+		}},
+		testChunk{typ: typeInt32, path: []string{"host", "port"}, pages: []testPage{
+			dataPageV2(3, encodingPlain, bitPacked(2, 2, 0, 1), plainInt32s(80)),
+		}},
+		testChunk{typ: typeByteArray, path: []string{"level"}, pages: []testPage{
+			dictionaryPage(2, plainByteArrays("info", "warn")),
+			dataPage(3, encodingPlainDictionary, nil, append([]byte{1}, bitPacked(1, 0, 1, 0)...)),
+		}},
+	)
+	data := f.bytes()
+
+	r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	for _, want := range []mapstr.M{
+		{"host": mapstr.M{"name": "a", "port": uint32(80)}, "level": "info"},
+		{"host": nil, "level": "warn"},
+		{"host": mapstr.M{"name": "b", "port": nil}, "level": "info"},
+	} {
+		row, err := r.Next()
+		require.NoError(t, err)
+		assert.Equal(t, want, row)
+	}
+	_, err = r.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestReaderErrors(t *testing.T) {
+	_, err := NewReader(bytes.NewReader([]byte("PAR1 not a parquet file")), 23)
+	assert.EqualError(t, err, "not a parquet file")
+
+	f := newTestFile(
+		tstruct{{4, "schema"}, {5, int32(1)}},
+		tstruct{{1, int32(typeInt32)}, {3, int32(repetitionRepeated)}, {4, "values"}},
+	)
+	data := f.bytes()
+	_, err = NewReader(bytes.NewReader(data), int64(len(data)))
+	assert.ErrorContains(t, err, "repeated field values is not supported")
+}
+
+func TestReaderLimits(t *testing.T) {
+	read := func(chunk testChunk) error {
+		f := newTestFile(
+			tstruct{{4, "schema"}, {5, int32(1)}},
+			tstruct{{1, int32(typeInt32)}, {3, int32(0)}, {4, "id"}},
+		)
+		f.addRowGroup(1, codecUncompressed, chunk)
+		data := f.bytes()
+		r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		_, err = r.Next()
+		return err
+	}
+
+	page := dataPage(1<<20, encodingPlain, nil, plainInt32s(1))
+	page.values = 1
+	err := read(testChunk{typ: typeInt32, path: []string{"id"}, pages: []testPage{page}})
+	assert.ErrorContains(t, err, "data page of column id has 1048576 values, more than its column chunk")
+
+	err = read(testChunk{typ: typeInt32, path: []string{"id"}, uncompressed: 2, pages: []testPage{
+		dataPage(1, encodingPlain, nil, plainInt32s(1)),
+	}})
+	assert.ErrorContains(t, err, "page of column id is larger than its column chunk")
+}
+
+func TestDecompressLimits(t *testing.T) {
+	page := bytes.Repeat([]byte("parquet"), 1000)
+	for name, codec := range map[string]int32{
+		"snappy": codecSnappy,
+		"gzip":   codecGzip,
+		"zstd":   codecZstd,
+	} {
+		t.Run(name, func(t *testing.T) {
+			data := compress(codec, page)
+			out, err := decompress(int64(codec), data, len(page))
+			require.NoError(t, err)
+			assert.Equal(t, page, out)
+
+			_, err = decompress(int64(codec), data, 100)
+			assert.Error(t, err, "a page larger than its uncompressed size should be rejected")
+			_, err = decompress(int64(codec), data, 2*len(page))
+			assert.Error(t, err, "a page smaller than its uncompressed size should be rejected")
+		})
+	}
+}
+
+// flatTestFile returns a file with two row groups of a flat schema, in PLAIN
+// encoded version 1 data pages.
+func flatTestFile(t *testing.T, codec int32) []byte {
+	t.Helper()
+
+	micros := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC).UnixMicro()
+	f := newTestFile(
+		tstruct{{4, "schema"}, {5, int32(6)}},
+		tstruct{{1, int32(typeInt64)}, {3, int32(0)}, {4, "id"}},
+		tstruct{{1, int32(typeByteArray)}, {3, int32(repetitionOptional)}, {4, "message"}, {6, int32(convertedUTF8)}},
+		tstruct{{1, int32(typeInt64)}, {3, int32(0)}, {4, "@timestamp"}, {10, tstruct{{8, tstruct{{1, true}, {2, tstruct{{2, tstruct{}}}}}}}}},
+		tstruct{{1, int32(typeBoolean)}, {3, int32(0)}, {4, "ok"}},
+		tstruct{{1, int32(typeInt32)}, {3, int32(repetitionOptional)}, {4, "day"}, {10, tstruct{{6, tstruct{}}}}},
+		tstruct{{1, int32(typeDouble)}, {3, int32(repetitionOptional)}, {4, "ratio"}},
+	)
+	f.addRowGroup(2, codec,
+		testChunk{typ: typeInt64, path: []string{"id"}, pages: []testPage{dataPage(2, encodingPlain, nil, plainInt64s(1, 2))}},
+		testChunk{typ: typeByteArray, path: []string{"message"}, pages: []testPage{dataPage(2, encodingPlain, rleRun(1, 1, 1, 1, 0), plainByteArrays("a"))}},
+		testChunk{typ: typeInt64, path: []string{"@timestamp"}, pages: []testPage{dataPage(2, encodingPlain, nil, plainInt64s(micros, micros+1e6))}},
+		testChunk{typ: typeBoolean, path: []string{"ok"}, pages: []testPage{dataPage(2, encodingPlain, nil, []byte{0x01})}},
+		testChunk{typ: typeInt32, path: []string{"day"}, pages: []testPage{dataPage(2, encodingPlain, bitPacked(1, 1, 0), plainInt32s(19448))}},
+		// NaN is read as null
+		testChunk{typ: typeDouble, path: []string{"ratio"}, pages: []testPage{dataPage(2, encodingPlain, bitPacked(1, 1, 1), plainDoubles(0.5, math.NaN()))}},
+	)
+	f.addRowGroup(1, codec,
+		testChunk{typ: typeInt64, path: []string{"id"}, pages: []testPage{dataPage(1, encodingPlain, nil, plainInt64s(3))}},
+		testChunk{typ: typeByteArray, path: []string{"message"}, pages: []testPage{dataPage(1, encodingPlain, rleRun(1, 1, 1), plainByteArrays("c"))}},
+		testChunk{typ: typeInt64, path: []string{"@timestamp"}, pages: []testPage{dataPage(1, encodingPlain, nil, plainInt64s(micros+2e6))}},
+		// booleans of version 2 encodings are run length encoded
+		testChunk{typ: typeBoolean, path: []string{"ok"}, pages: []testPage{dataPage(1, encodingRLE, nil, lengthPrefixed(rleRun(1, 1, 1)))}},
+		testChunk{typ: typeInt32, path: []string{"day"}, pages: []testPage{dataPage(1, encodingPlain, rleRun(1, 1, 1), plainInt32s(0))}},
+		testChunk{typ: typeDouble, path: []string{"ratio"}, pages: []testPage{dataPage(1, encodingPlain, rleRun(1, 1, 1), plainDoubles(1.25))}},
+	)
+	return f.bytes()
+}
+
+// tstruct is a thrift struct encoded with the compact protocol by the tests.
+type tstruct []tfield
+
+type tfield struct {
+	id    int16
+	value interface{}
+}
+
+func (s tstruct) encode(buf *bytes.Buffer) {
+	var last int16
+	for _, f := range s {
+		var typ byte
+		switch v := f.value.(type) {
+		case bool:
+			typ = thriftFalse
+			if v {
+				typ = thriftTrue
+			}
+		default:
+			typ = thriftType(v)
+		}
+		if delta := f.id - last; delta > 0 && delta <= 15 {
+			buf.WriteByte(byte(delta)<<4 | typ)
+		} else {
+			buf.WriteByte(typ)
+			writeVarint(buf, int64(f.id))
+		}
+		last = f.id
+		if _, ok := f.value.(bool); !ok {
+			encodeThriftValue(buf, f.value)
+		}
+	}
+	buf.WriteByte(thriftStop)
+}
+
+func thriftType(v interface{}) byte {
+	switch v.(type) {
+	case int8:
+		return thriftByte
+	case int32:
+		return thriftI32
+	case int64:
+		return thriftI64
+	case string:
+		return thriftBinary
+	case tstruct:
+		return thriftStruct
+	case []tstruct, []string, []int32:
+		return thriftList
+	default:
+		panic("unsupported thrift value")
+	}
+}
+
+func encodeThriftValue(buf *bytes.Buffer, v interface{}) {
+	switch v := v.(type) {
+	case int8:
+		buf.WriteByte(byte(v))
+	case int32:
+		writeVarint(buf, int64(v))
+	case int64:
+		writeVarint(buf, v)
+	case string:
+		buf.Write(binary.AppendUvarint(nil, uint64(len(v))))
+		buf.WriteString(v)
+	case tstruct:
+		v.encode(buf)
+	case []tstruct:
+		writeListHeader(buf, len(v), thriftStruct)
+		for _, e := range v {
+			e.encode(buf)
+		}
+	case []string:
+		writeListHeader(buf, len(v), thriftBinary)
+		for _, e := range v {
+			encodeThriftValue(buf, e)
+		}
+	case []int32:
+		writeListHeader(buf, len(v), thriftI32)
+		for _, e := range v {
+			encodeThriftValue(buf, e)
+		}
+	}
+}
+
+func writeVarint(buf *bytes.Buffer, v int64) {
+	buf.Write(binary.AppendUvarint(nil, uint64(v<<1^v>>63)))
+}
+
+func writeListHeader(buf *bytes.Buffer, n int, typ byte) {
+	if n < 15 {
+		buf.WriteByte(byte(n)<<4 | typ)
+		return
+	}
+	buf.WriteByte(0xf0 | typ)
+	buf.Write(binary.AppendUvarint(nil, uint64(n)))
+}
+
+// testFile builds parquet files.
+type testFile struct {
+	buf    bytes.Buffer
+	schema []tstruct
+	groups []tstruct
+	rows   int64
+}
+
+type testChunk struct {
+	typ   int32
+	path  []string
+	pages []testPage
+	// uncompressed overrides the uncompressed size of the chunk if set.
+	uncompressed int
+}
+
+// testPage is a page, levels are not compressed in version 2 data pages.
+type testPage struct {
+	header tstruct
+	values int
+	levels []byte
+	body   []byte
+}
+
+func newTestFile(schema ...tstruct) *testFile {
+	f := &testFile{schema: schema}
+	f.buf.WriteString(magic)
+	return f
+}
+
+func (f *testFile) addRowGroup(rows int64, codec int32, chunks ...testChunk) {
+	var columns []tstruct
+	for _, c := range chunks {
+		start := int64(f.buf.Len())
+		var dictOffset, dataOffset int64 = -1, -1
+		var numValues int64
+		var uncompressed, compressed int
+		for _, p := range c.pages {
+			body := compress(codec, p.body)
+			header := append(tstruct{
+				{1, p.header[0].value},
+				{2, int32(len(p.levels) + len(p.body))},
+				{3, int32(len(p.levels) + len(body))},
+			}, p.header[1:]...)
+			if p.header[0].value == int32(pageDictionary) {
+				dictOffset = int64(f.buf.Len())
+			} else if dataOffset < 0 {
+				dataOffset = int64(f.buf.Len())
+			}
+			numValues += int64(p.values)
+
+			var hdr bytes.Buffer
+			header.encode(&hdr)
+			uncompressed += hdr.Len() + len(p.levels) + len(p.body)
+			compressed += hdr.Len() + len(p.levels) + len(body)
+			f.buf.Write(hdr.Bytes())
+			f.buf.Write(p.levels)
+			f.buf.Write(body)
+		}
+		if c.uncompressed > 0 {
+			uncompressed = c.uncompressed
+		}
+		meta := tstruct{
+			{1, c.typ},
+			{2, []int32{encodingPlain, encodingRLE}},
+			{3, c.path},
+			{4, codec},
+			{5, numValues},
+			{6, int64(uncompressed)},
+			{7, int64(compressed)},
+			{9, dataOffset},
+		}
+		if dictOffset >= 0 {
+			meta = append(meta, tfield{11, dictOffset})
+		}
+		columns = append(columns, tstruct{{2, start}, {3, meta}})
+	}
+	f.groups = append(f.groups, tstruct{{1, columns}, {2, int64(0)}, {3, rows}})
+	f.rows += rows
+}
+
+func (f *testFile) bytes() []byte {
+	var meta bytes.Buffer
+	tstruct{
+		{1, int32(1)},
+		{2, f.schema},
+		{3, f.rows},
+		{4, f.groups},
+	}.encode(&meta)
+	f.buf.Write(meta.Bytes())
+	_ = binary.Write(&f.buf, binary.LittleEndian, uint32(meta.Len()))
+	f.buf.WriteString(magic)
+	return f.buf.Bytes()
+}
+
+func compress(codec int32, data []byte) []byte {
+	switch codec {
+	case codecSnappy:
+		return snappy.Encode(nil, data)
+	case codecGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		_, _ = w.Write(data)
+		_ = w.Close()
+		return buf.Bytes()
+	case codecZstd:
+		enc, _ := zstd.NewWriter(nil)
+		defer enc.Close()
+		return enc.EncodeAll(data, nil)
+	default:
+		return data
+	}
+}
+
+// dataPage returns a version 1 data page of n values, with the run length
+// encoded definition levels if any.
+func dataPage(n int, encoding int32, defs, values []byte) testPage {
+	body := values
+	if defs != nil {
+		body = append(lengthPrefixed(defs), values...)
+	}
+	return testPage{
+		header: tstruct{
+			{1, int32(pageData)},
+			{5, tstruct{{1, int32(n)}, {2, encoding}, {3, int32(encodingRLE)}, {4, int32(encodingRLE)}}},
+		},
+		values: n,
+		body:   body,
+	}
+}
+
+func dataPageV2(n int, encoding int32, defs, values []byte) testPage {
+	return testPage{
+		header: tstruct{
+			{1, int32(pageDataV2)},
+			{8, tstruct{{1, int32(n)}, {2, int32(0)}, {3, int32(n)}, {4, encoding}, {5, int32(len(defs))}, {6, int32(0)}}},
+		},
+		values: n,
+		levels: defs,
+		body:   values,
+	}
+}
+
+func dictionaryPage(n int, values []byte) testPage {
+	return testPage{
+		header: tstruct{
+			{1, int32(pageDictionary)},
+			{7, tstruct{{1, int32(n)}, {2, int32(encodingPlain)}}},
+		},
+		body: values,
+	}
+}
+
+// rleRun returns runs of the run length encoding hybrid, given as pairs of
+// counts and values of the given bit width.
+func rleRun(width int, runs ...int) []byte {
+	var buf []byte
+	for i := 0; i < len(runs); i += 2 {
+		buf = binary.AppendUvarint(buf, uint64(runs[i])<<1)
+		for b := 0; b < (width+7)/8; b++ {
+			buf = append(buf, byte(runs[i+1]>>(8*b)))
+		}
+	}
+	return buf
+}
+
+// bitPacked returns the values bit-packed by the run length encoding hybrid.
+func bitPacked(width int, values ...uint32) []byte {
+	groups := (len(values) + 7) / 8
+	buf := binary.AppendUvarint(nil, uint64(groups)<<1|1)
+	packed := make([]byte, groups*width)
+	for i, v := range values {
+		for b := 0; b < width; b++ {
+			if v>>b&1 == 1 {
+				bit := i*width + b
+				packed[bit/8] |= 1 << (bit % 8)
+			}
+		}
+	}
+	return append(buf, packed...)
+}
+
+func lengthPrefixed(data []byte) []byte {
+	return append(binary.LittleEndian.AppendUint32(nil, uint32(len(data))), data...)
+}
+
+func plainInt32s(values ...int32) []byte {
+	var buf []byte
+	for _, v := range values {
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(v))
+	}
+	return buf
+}
+
+func plainInt64s(values ...int64) []byte {
+	var buf []byte
+	for _, v := range values {
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(v))
+	}
+	return buf
+}
+
+func plainDoubles(values ...float64) []byte {
+	var buf []byte
+	for _, v := range values {
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+	}
+	return buf
+}
+
+func plainByteArrays(values ...string) []byte {
+	var buf []byte
+	for _, v := range values {
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(v)))
+		buf = append(buf, v...)
+	}
+	return buf
+}
@@ -0,0 +1,303 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strings"
+	"time"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// Physical types of the parquet format.
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeInt96             = 3
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+)
+
+// Converted types, the legacy logical type annotations of the parquet format.
+const (
+	convertedUTF8            = 0
+	convertedEnum            = 4
+	convertedDecimal         = 5
+	convertedDate            = 6
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+	convertedUint8           = 11
+	convertedUint16          = 12
+	convertedUint32          = 13
+	convertedUint64          = 14
+	convertedJSON            = 19
+)
+
+// Repetition types of the parquet format.
+const (
+	repetitionOptional = 1
+	repetitionRepeated = 2
+)
+
+// julianUnixEpoch is the julian day of the unix epoch, used by the legacy
+// INT96 timestamps.
+const julianUnixEpoch = 2440588
+
+// kind tells how the values of a column are converted from their physical type.
+type kind int
+
+const (
+	kindPlain kind = iota
+	kindString
+	kindDecimal
+	kindDate
+	kindTimestamp
+	kindUnsigned
+	kindUUID
+)
+
+// column is a leaf column of the schema.
+type column struct {
+	path []string
+	// defs holds the definition level at which each element of the path is defined.
+	defs       []int
+	maxDef     int
+	typ        int64
+	typeLength int
+	kind       kind
+	scale      int
+	unit       time.Duration
+}
+
+func (c *column) String() string {
+	return strings.Join(c.path, ".")
+}
+
+// readSchema returns the leaf columns of the flattened schema elements.
+func readSchema(elements []interface{}) ([]*column, error) {
+	if len(elements) == 0 {
+		return nil, fmt.Errorf("schema is empty")
+	}
+	root, _ := elements[0].(thriftFields)
+	var columns []*column
+	next, err := walkSchema(elements, 1, int(root.int(5)), nil, nil, &columns)
+	if err != nil {
+		return nil, err
+	}
+	if next != len(elements) {
+		return nil, fmt.Errorf("schema has %d elements, %d are reachable from the root", len(elements), next)
+	}
+	return columns, nil
+}
+
+// walkSchema walks the n children of a group starting at element i and
+// returns the index of the element following them.
+func walkSchema(elements []interface{}, i, n int, path []string, defs []int, columns *[]*column) (int, error) {
+	for ; n > 0; n-- {
+		if i >= len(elements) {
+			return 0, fmt.Errorf("schema is truncated")
+		}
+		e, _ := elements[i].(thriftFields)
+		i++
+
+		name := e.string(4)
+		def := 0
+		if len(defs) > 0 {
+			def = defs[len(defs)-1]
+		}
+		switch e.int(3) {
+		case repetitionOptional:
+			def++
+		case repetitionRepeated:
+			return 0, fmt.Errorf("repeated field %s is not supported", strings.Join(append(path, name), "."))
+		}
+		childPath := append(append([]string(nil), path...), name)
+		childDefs := append(append([]int(nil), defs...), def)
+
+		if children := int(e.int(5)); children > 0 || !e.has(1) {
+			var err error
+			if i, err = walkSchema(elements, i, children, childPath, childDefs, columns); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		c := &column{
+			path:       childPath,
+			defs:       childDefs,
+			maxDef:     def,
+			typ:        e.int(1),
+			typeLength: int(e.int(2)),
+		}
+		c.setKind(e)
+		*columns = append(*columns, c)
+	}
+	return i, nil
+}
+
+// setKind sets the conversion of the column values from the logical type of
+// the schema element, or from its converted type in older files.
+func (c *column) setKind(e thriftFields) {
+	if logical := e.strct(10); logical != nil {
+		switch {
+		case logical.has(1), logical.has(4), logical.has(12):
+			c.kind = kindString
+		case logical.has(5):
+			c.kind, c.scale = kindDecimal, int(logical.strct(5).int(1))
+		case logical.has(6):
+			c.kind = kindDate
+		case logical.has(8):
+			if unit := logical.strct(8).strct(2); unit != nil {
+				c.kind, c.unit = kindTimestamp, timeUnit(unit)
+			}
+		case logical.has(10):
+			if !logical.strct(10).bool(2) {
+				c.kind = kindUnsigned
+			}
+		case logical.has(14):
+			c.kind = kindUUID
+		}
+		return
+	}
+	if !e.has(6) {
+		return
+	}
+	switch e.int(6) {
+	case convertedUTF8, convertedEnum, convertedJSON:
+		c.kind = kindString
+	case convertedDecimal:
+		c.kind, c.scale = kindDecimal, int(e.int(7))
+	case convertedDate:
+		c.kind = kindDate
+	case convertedTimestampMillis:
+		c.kind, c.unit = kindTimestamp, time.Millisecond
+	case convertedTimestampMicros:
+		c.kind, c.unit = kindTimestamp, time.Microsecond
+	case convertedUint8, convertedUint16, convertedUint32, convertedUint64:
+		c.kind = kindUnsigned
+	}
+}
+
+func timeUnit(unit thriftFields) time.Duration {
+	switch {
+	case unit.has(1):
+		return time.Millisecond
+	case unit.has(2):
+		return time.Microsecond
+	default:
+		return time.Nanosecond
+	}
+}
+
+// convert converts a value decoded from its physical type.
+func (c *column) convert(v interface{}) interface{} {
+	switch v := v.(type) {
+	case int32:
+		switch c.kind {
+		case kindDecimal:
+			return scaleDecimal(big.NewInt(int64(v)), c.scale)
+		case kindDate:
+			return time.Unix(int64(v)*24*60*60, 0).UTC().Format("2006-01-02")
+		case kindUnsigned:
+			return uint32(v)
+		}
+	case int64:
+		switch c.kind {
+		case kindDecimal:
+			return scaleDecimal(big.NewInt(v), c.scale)
+		case kindTimestamp:
+			switch c.unit {
+			case time.Millisecond:
+				return time.UnixMilli(v).UTC()
+			case time.Microsecond:
+				return time.UnixMicro(v).UTC()
+			default:
+				return time.Unix(0, v).UTC()
+			}
+		case kindUnsigned:
+			return uint64(v)
+		}
+	case float32:
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return nil
+		}
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil
+		}
+	case []byte:
+		if c.typ == typeInt96 {
+			nanos := binary.LittleEndian.Uint64(v[:8])
+			days := int64(binary.LittleEndian.Uint32(v[8:]))
+			return time.Unix((days-julianUnixEpoch)*24*60*60, int64(nanos)).UTC()
+		}
+		switch c.kind {
+		case kindString:
+			return string(v)
+		case kindDecimal:
+			return scaleDecimal(twosComplement(v), c.scale)
+		case kindUUID:
+			if len(v) == 16 {
+				s := hex.EncodeToString(v)
+				return s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+			}
+		}
+	}
+	return v
+}
+
+// twosComplement returns the big-endian two's complement integer of b.
+func twosComplement(b []byte) *big.Int {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return v
+}
+
+func scaleDecimal(unscaled *big.Int, scale int) float64 {
+	v, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(unscaled),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)),
+	).Float64()
+	return v
+}
+
+// put sets the value of the column in the row, creating the groups of its
+// path. A null value is set at the outermost undefined element of the path.
+func (c *column) put(row mapstr.M, v interface{}, def int) {
+	m := row
+	for i, name := range c.path {
+		if def < c.defs[i] {
+			if _, ok := m[name]; !ok {
+				m[name] = nil
+			}
+			return
+		}
+		if i == len(c.path)-1 {
+			m[name] = v
+			return
+		}
+		group, ok := m[name].(mapstr.M)
+		if !ok {
+			group = mapstr.M{}
+			m[name] = group
+		}
+		m = group
+	}
+}
+
+// bitWidth returns the number of bits used to encode levels up to max.
+func bitWidth(max int) int {
+	return bits.Len(uint(max))
+}
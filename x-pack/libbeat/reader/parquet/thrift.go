@@ -0,0 +1,242 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Types of the thrift compact protocol, used to encode the parquet metadata.
+const (
+	thriftStop   = 0
+	thriftTrue   = 1
+	thriftFalse  = 2
+	thriftByte   = 3
+	thriftI16    = 4
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftDouble = 7
+	thriftBinary = 8
+	thriftList   = 9
+	thriftSet    = 10
+	thriftMap    = 11
+	thriftStruct = 12
+)
+
+// maxThriftDepth limits the nesting of structs and collections, the parquet
+// metadata is only a few levels deep.
+const maxThriftDepth = 64
+
+var errThriftTruncated = errors.New("truncated thrift data")
+
+// thriftFields holds the fields of a decoded thrift struct by field id.
+// Integers are decoded as int64, binaries as []byte, lists and sets as
+// []interface{} and structs as thriftFields. Maps are skipped.
+type thriftFields map[int16]interface{}
+
+func (s thriftFields) has(id int16) bool {
+	_, ok := s[id]
+	return ok
+}
+
+func (s thriftFields) int(id int16) int64 {
+	v, _ := s[id].(int64)
+	return v
+}
+
+func (s thriftFields) bool(id int16) bool {
+	v, _ := s[id].(bool)
+	return v
+}
+
+func (s thriftFields) string(id int16) string {
+	v, _ := s[id].([]byte)
+	return string(v)
+}
+
+func (s thriftFields) strct(id int16) thriftFields {
+	v, _ := s[id].(thriftFields)
+	return v
+}
+
+func (s thriftFields) list(id int16) []interface{} {
+	v, _ := s[id].([]interface{})
+	return v
+}
+
+// thriftDecoder decodes the thrift compact protocol.
+type thriftDecoder struct {
+	buf   []byte
+	pos   int
+	depth int
+}
+
+// decodeThriftStruct decodes the struct at the start of buf and returns it
+// along with its encoded length.
+func decodeThriftStruct(buf []byte) (thriftFields, int, error) {
+	d := &thriftDecoder{buf: buf}
+	s, err := d.readStruct()
+	return s, d.pos, err
+}
+
+func (d *thriftDecoder) readByte() (byte, error) {
+	if d.pos >= len(d.buf) {
+		return 0, errThriftTruncated
+	}
+	b := d.buf[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *thriftDecoder) readUvarint() (uint64, error) {
+	v, n := binary.Uvarint(d.buf[d.pos:])
+	if n <= 0 {
+		return 0, errThriftTruncated
+	}
+	d.pos += n
+	return v, nil
+}
+
+// readVarint reads a zigzag encoded integer.
+func (d *thriftDecoder) readVarint() (int64, error) {
+	v, err := d.readUvarint()
+	return int64(v>>1) ^ -int64(v&1), err
+}
+
+func (d *thriftDecoder) readStruct() (thriftFields, error) {
+	if d.depth++; d.depth > maxThriftDepth {
+		return nil, errors.New("thrift data is nested too deeply")
+	}
+	defer func() { d.depth-- }()
+
+	s := thriftFields{}
+	var id int16
+	for {
+		b, err := d.readByte()
+		if err != nil {
+			return nil, err
+		}
+		typ := b & 0x0f
+		if typ == thriftStop {
+			return s, nil
+		}
+		if delta := b >> 4; delta != 0 {
+			id += int16(delta)
+		} else {
+			v, err := d.readVarint()
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		// booleans are encoded in the type of struct fields
+		if typ == thriftTrue || typ == thriftFalse {
+			s[id] = typ == thriftTrue
+			continue
+		}
+		v, err := d.readValue(typ)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			s[id] = v
+		}
+	}
+}
+
+func (d *thriftDecoder) readValue(typ byte) (interface{}, error) {
+	switch typ {
+	case thriftTrue, thriftFalse:
+		// booleans are encoded as a byte in collections
+		b, err := d.readByte()
+		return b == thriftTrue, err
+	case thriftByte:
+		b, err := d.readByte()
+		return int64(int8(b)), err
+	case thriftI16, thriftI32, thriftI64:
+		return d.readVarint()
+	case thriftDouble:
+		if len(d.buf)-d.pos < 8 {
+			return nil, errThriftTruncated
+		}
+		v := math.Float64frombits(binary.LittleEndian.Uint64(d.buf[d.pos:]))
+		d.pos += 8
+		return v, nil
+	case thriftBinary:
+		n, err := d.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		if n > uint64(len(d.buf)-d.pos) {
+			return nil, errThriftTruncated
+		}
+		v := d.buf[d.pos : d.pos+int(n)]
+		d.pos += int(n)
+		return v, nil
+	case thriftList, thriftSet:
+		return d.readList()
+	case thriftMap:
+		return nil, d.skipMap()
+	case thriftStruct:
+		return d.readStruct()
+	default:
+		return nil, fmt.Errorf("unknown thrift type %d", typ)
+	}
+}
+
+func (d *thriftDecoder) readList() ([]interface{}, error) {
+	if d.depth++; d.depth > maxThriftDepth {
+		return nil, errors.New("thrift data is nested too deeply")
+	}
+	defer func() { d.depth-- }()
+
+	b, err := d.readByte()
+	if err != nil {
+		return nil, err
+	}
+	size, typ := uint64(b>>4), b&0x0f
+	if size == 15 {
+		if size, err = d.readUvarint(); err != nil {
+			return nil, err
+		}
+	}
+	// each element is encoded in at least one byte
+	if size > uint64(len(d.buf)-d.pos) {
+		return nil, errThriftTruncated
+	}
+	list := make([]interface{}, size)
+	for i := range list {
+		if list[i], err = d.readValue(typ); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func (d *thriftDecoder) skipMap() error {
+	size, err := d.readUvarint()
+	if err != nil || size == 0 {
+		return err
+	}
+	if size > uint64(len(d.buf)-d.pos) {
+		return errThriftTruncated
+	}
+	types, err := d.readByte()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < size; i++ {
+		if _, err := d.readValue(types >> 4); err != nil {
+			return err
+		}
+		if _, err := d.readValue(types & 0x0f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -38,6 +38,7 @@ import (
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/gcp/billing"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/gcp/carbon"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/gcp/metrics"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/gcp/query"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/ibmmq"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/iis"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/iis/application_pool"
@@ -48,12 +49,18 @@ import (
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/istio/mixer"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/istio/pilot"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql/availability_group"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql/performance"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql/query_store"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql/tempdb"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql/transaction_log"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle/asm"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle/performance"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle/rac"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle/sysmetric"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle/tablespace"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle/wait_events"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/prometheus"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/prometheus/collector"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/prometheus/remote_write"
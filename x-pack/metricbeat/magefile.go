@@ -31,6 +31,8 @@ import (
 	"github.com/elastic/beats/v7/dev-tools/mage/target/test"
 	//mage:import
 	_ "github.com/elastic/beats/v7/metricbeat/scripts/mage/target/metricset"
+	//mage:import generate
+	_ "github.com/elastic/beats/v7/metricbeat/scripts/mage/target/generate"
 	//mage:import
 	_ "github.com/elastic/beats/v7/dev-tools/mage/target/integtest/docker"
 )
@@ -34,6 +34,7 @@ var (
 	labelSeparator         = "|"
 	dimensionSeparator     = ","
 	dimensionValueWildcard = "*"
+	expressionStatistic    = "Expression"
 )
 
 // init registers the MetricSet with the central registry as soon as the program
@@ -64,11 +65,38 @@ type Dimension struct {
 
 // Config holds a configuration specific for cloudwatch metricset.
 type Config struct {
-	Namespace    string      `config:"namespace" validate:"nonzero,required"`
-	MetricName   []string    `config:"name"`
-	Dimensions   []Dimension `config:"dimensions"`
-	ResourceType string      `config:"resource_type"`
-	Statistic    []string    `config:"statistic"`
+	Namespace    string       `config:"namespace" validate:"nonzero,required"`
+	MetricName   []string     `config:"name"`
+	Dimensions   []Dimension  `config:"dimensions"`
+	ResourceType string       `config:"resource_type"`
+	Statistic    []string     `config:"statistic"`
+	Expressions  []Expression `config:"expressions"`
+}
+
+// Expression holds a metric math or search expression evaluated by GetMetricData.
+type Expression struct {
+	Name       string `config:"name" validate:"nonzero,required"`
+	Expression string `config:"expression" validate:"nonzero,required"`
+	// Dimension is the name of the dimension identifying each time series
+	// returned by the expression, if it returns more than one.
+	Dimension string `config:"dimension"`
+}
+
+// Validate checks that the expression name and dimension can be encoded in
+// the label of the query.
+func (e Expression) Validate() error {
+	if strings.Contains(e.Name, labelSeparator) {
+		return fmt.Errorf("expression name %q must not contain %q", e.Name, labelSeparator)
+	}
+	if strings.ContainsAny(e.Dimension, labelSeparator+dimensionSeparator) {
+		return fmt.Errorf("expression dimension %q must not contain %q or %q", e.Dimension, labelSeparator, dimensionSeparator)
+	}
+	return nil
+}
+
+type expressionWithNamespace struct {
+	namespace  string
+	expression Expression
 }
 
 type metricsWithStatistics struct {
@@ -78,6 +106,7 @@ type metricsWithStatistics struct {
 
 type listMetricWithDetail struct {
 	metricsWithStats    []metricsWithStatistics
+	expressions         []expressionWithNamespace
 	resourceTypeFilters map[string][]aws.Tag
 }
 
@@ -145,8 +174,14 @@ func (m *MetricSet) Fetch(report mb.ReporterV2) error {
 		return err
 	}
 
+	var usage aws.MetricDataUsage
+	defer func() {
+		m.logger.Infof("GetMetricData made %d API calls requesting %d metrics, estimated cost $%.4f",
+			usage.APICalls, usage.MetricsRequested, usage.EstimatedCost())
+	}()
+
 	// Create events based on listMetricDetailTotal from configuration
-	if len(listMetricDetailTotal.metricsWithStats) != 0 {
+	if len(listMetricDetailTotal.metricsWithStats) != 0 || len(listMetricDetailTotal.expressions) != 0 {
 		for _, regionName := range m.MetricSet.RegionsList {
 			//m.logger.Debugf("Collecting metrics from AWS region %s", regionName)
 			beatsConfig := m.MetricSet.AwsConfig.Copy()
@@ -157,7 +192,8 @@ func (m *MetricSet) Fetch(report mb.ReporterV2) error {
 				m.Logger().Warn("skipping metrics list from region '%s'", regionName)
 			}
 
-			eventsWithIdentifier, err := m.createEvents(svcCloudwatch, svcResourceAPI, listMetricDetailTotal.metricsWithStats, listMetricDetailTotal.resourceTypeFilters, regionName, startTime, endTime)
+			eventsWithIdentifier, regionUsage, err := m.createEvents(svcCloudwatch, svcResourceAPI, listMetricDetailTotal.metricsWithStats, listMetricDetailTotal.expressions, listMetricDetailTotal.resourceTypeFilters, regionName, startTime, endTime)
+			usage.Add(regionUsage)
 			if err != nil {
 				return fmt.Errorf("createEvents failed for region %s: %w", regionName, err)
 			}
@@ -201,7 +237,8 @@ func (m *MetricSet) Fetch(report mb.ReporterV2) error {
 			// get resource type filters and tags filters for each namespace
 			resourceTypeTagFilters := constructTagsFilters(namespaceDetails)
 
-			eventsWithIdentifier, err := m.createEvents(svcCloudwatch, svcResourceAPI, filteredMetricWithStatsTotal, resourceTypeTagFilters, regionName, startTime, endTime)
+			eventsWithIdentifier, namespaceUsage, err := m.createEvents(svcCloudwatch, svcResourceAPI, filteredMetricWithStatsTotal, nil, resourceTypeTagFilters, regionName, startTime, endTime)
+			usage.Add(namespaceUsage)
 			if err != nil {
 				return fmt.Errorf("createEvents failed for region %s: %w", regionName, err)
 			}
@@ -301,9 +338,25 @@ func (m *MetricSet) readCloudwatchConfig() (listMetricWithDetail, map[string][]n
 	var listMetricDetailTotal listMetricWithDetail
 	namespaceDetailTotal := map[string][]namespaceDetail{}
 	var metricsWithStatsTotal []metricsWithStatistics
+	var expressionsTotal []expressionWithNamespace
 	resourceTypesWithTags := map[string][]aws.Tag{}
 
 	for _, config := range m.CloudwatchConfigs {
+		for _, expression := range config.Expressions {
+			expressionsTotal = append(expressionsTotal, expressionWithNamespace{
+				namespace:  config.Namespace,
+				expression: expression,
+			})
+		}
+		// A configuration with only expressions does not collect any metric
+		// of its namespace.
+		if len(config.Expressions) != 0 && config.MetricName == nil {
+			if config.ResourceType != "" {
+				resourceTypesWithTags[config.ResourceType] = m.MetricSet.TagsFilter
+			}
+			continue
+		}
+
 		// If there is no statistic method specified, then use the default.
 		if config.Statistic == nil {
 			config.Statistic = defaultStatistics
@@ -354,6 +407,7 @@ func (m *MetricSet) readCloudwatchConfig() (listMetricWithDetail, map[string][]n
 
 	listMetricDetailTotal.resourceTypeFilters = resourceTypesWithTags
 	listMetricDetailTotal.metricsWithStats = metricsWithStatsTotal
+	listMetricDetailTotal.expressions = expressionsTotal
 	return listMetricDetailTotal, namespaceDetailTotal
 }
 
@@ -381,6 +435,38 @@ func createMetricDataQueries(listMetricsTotal []metricsWithStatistics, dataGranu
 	return metricDataQueries
 }
 
+func createExpressionQueries(expressions []expressionWithNamespace, dataGranularity time.Duration) []types.MetricDataQuery {
+	var metricDataQueries []types.MetricDataQuery
+	for i, e := range expressions {
+		expression := e.expression.Expression
+		label := constructExpressionLabel(e.namespace, e.expression)
+		dataGranularityInSec := int32(dataGranularity.Seconds())
+
+		id := "expr" + strconv.Itoa(i)
+		metricDataQueries = append(metricDataQueries, types.MetricDataQuery{
+			Id:         &id,
+			Expression: &expression,
+			Period:     &dataGranularityInSec,
+			Label:      &label,
+			ReturnData: awssdk.Bool(true),
+		})
+	}
+	return metricDataQueries
+}
+
+// constructExpressionLabel builds a label with the same layout as constructLabel.
+// When a dimension is configured, a dynamic label is used so CloudWatch fills
+// in the value of the dimension for each time series returned.
+func constructExpressionLabel(namespace string, expression Expression) string {
+	// label = expressionName + namespace + expressionStatistic + dimKey + dimValue
+	label := expression.Name + labelSeparator + namespace + labelSeparator + expressionStatistic
+	if expression.Dimension != "" {
+		label += labelSeparator + expression.Dimension
+		label += labelSeparator + "${PROP('Dim." + expression.Dimension + "')}"
+	}
+	return label
+}
+
 func constructLabel(metric types.Metric, statistic string) string {
 	// label = metricName + namespace + statistic + dimKeys + dimValues
 	label := *metric.MetricName + labelSeparator + *metric.Namespace + labelSeparator + statistic
@@ -420,6 +506,9 @@ func statisticLookup(stat string) (string, bool) {
 
 func generateFieldName(namespace string, labels []string) string {
 	stat := labels[statisticIdx]
+	if stat == expressionStatistic {
+		return "aws." + stripNamespace(namespace) + ".metrics." + common.DeDot(labels[metricNameIdx]) + ".value"
+	}
 	// Check if statistic method is one of Sum, SampleCount, Minimum, Maximum, Average
 	// With checkStatistics function, no need to check bool return value here
 	statMethod, _ := statisticLookup(stat)
@@ -450,22 +539,23 @@ func insertRootFields(event mb.Event, metricValue float64, labels []string) mb.E
 	return event
 }
 
-func (m *MetricSet) createEvents(svcCloudwatch cloudwatch.GetMetricDataAPIClient, svcResourceAPI resourcegroupstaggingapi.GetResourcesAPIClient, listMetricWithStatsTotal []metricsWithStatistics, resourceTypeTagFilters map[string][]aws.Tag, regionName string, startTime time.Time, endTime time.Time) (map[string]mb.Event, error) {
+func (m *MetricSet) createEvents(svcCloudwatch cloudwatch.GetMetricDataAPIClient, svcResourceAPI resourcegroupstaggingapi.GetResourcesAPIClient, listMetricWithStatsTotal []metricsWithStatistics, expressions []expressionWithNamespace, resourceTypeTagFilters map[string][]aws.Tag, regionName string, startTime time.Time, endTime time.Time) (map[string]mb.Event, aws.MetricDataUsage, error) {
 	// Initialize events for each identifier.
 	events := make(map[string]mb.Event)
 
 	// Construct metricDataQueries
 	metricDataQueries := createMetricDataQueries(listMetricWithStatsTotal, m.DataGranularity)
+	metricDataQueries = append(metricDataQueries, createExpressionQueries(expressions, m.DataGranularity)...)
 	m.logger.Debugf("Number of MetricDataQueries = %d", len(metricDataQueries))
 	if len(metricDataQueries) == 0 {
-		return events, nil
+		return events, aws.MetricDataUsage{}, nil
 	}
 
 	// Use metricDataQueries to make GetMetricData API calls
-	metricDataResults, err := aws.GetMetricDataResults(metricDataQueries, svcCloudwatch, startTime, endTime)
+	metricDataResults, usage, err := aws.GetMetricDataResultsWithUsage(metricDataQueries, svcCloudwatch, startTime, endTime)
 	m.logger.Debugf("Number of metricDataResults = %d", len(metricDataResults))
 	if err != nil {
-		return events, fmt.Errorf("getMetricDataResults failed: %w", err)
+		return events, usage, fmt.Errorf("getMetricDataResults failed: %w", err)
 	}
 
 	// Create events when there is no tags_filter or resource_type specified.
@@ -493,7 +583,7 @@ func (m *MetricSet) createEvents(svcCloudwatch cloudwatch.GetMetricDataAPIClient
 				events[identifierValue] = insertRootFields(events[identifierValue], metricDataResultValue, labels)
 			}
 		}
-		return events, nil
+		return events, usage, nil
 	}
 
 	// Create events with tags
@@ -567,7 +657,7 @@ func (m *MetricSet) createEvents(svcCloudwatch cloudwatch.GetMetricDataAPIClient
 			}
 		}
 	}
-	return events, nil
+	return events, usage, nil
 }
 
 func configDimensionValueContainsWildcard(dim []Dimension) bool {
@@ -211,6 +211,33 @@ func TestConstructLabel(t *testing.T) {
 	}
 }
 
+func TestConstructExpressionLabel(t *testing.T) {
+	cases := []struct {
+		expression    Expression
+		expectedLabel string
+	}{
+		{
+			Expression{Name: "cpu_total", Expression: "SUM(SEARCH('{AWS/EC2,InstanceId} MetricName=\"CPUUtilization\"', 'Average'))"},
+			"cpu_total|AWS/EC2|Expression",
+		},
+		{
+			Expression{Name: "cpu_max", Expression: "SEARCH('{AWS/EC2,InstanceId} MetricName=\"CPUUtilization\"', 'Maximum')", Dimension: "InstanceId"},
+			"cpu_max|AWS/EC2|Expression|InstanceId|${PROP('Dim.InstanceId')}",
+		},
+	}
+
+	for _, c := range cases {
+		label := constructExpressionLabel("AWS/EC2", c.expression)
+		assert.Equal(t, c.expectedLabel, label)
+	}
+}
+
+func TestExpressionValidate(t *testing.T) {
+	assert.NoError(t, Expression{Name: "cpu_max", Expression: "x", Dimension: "InstanceId"}.Validate())
+	assert.Error(t, Expression{Name: "cpu|max", Expression: "x"}.Validate())
+	assert.Error(t, Expression{Name: "cpu_max", Expression: "x", Dimension: "InstanceId,Role"}.Validate())
+}
+
 func TestReadCloudwatchConfig(t *testing.T) {
 	m := MetricSet{}
 	m.MetricSet = &aws.MetricSet{Period: 5}
@@ -279,6 +306,21 @@ func TestReadCloudwatchConfig(t *testing.T) {
 		},
 	}
 
+	cpuExpression := Expression{
+		Name:       "cpu_max",
+		Expression: "SEARCH('{AWS/EC2,InstanceId} MetricName=\"CPUUtilization\"', 'Maximum')",
+		Dimension:  "InstanceId",
+	}
+	expectedListMetricWithDetailExpression := listMetricWithDetail{
+		expressions: []expressionWithNamespace{
+			{
+				namespace:  "AWS/EC2",
+				expression: cpuExpression,
+			},
+		},
+		resourceTypeFilters: map[string][]aws.Tag{},
+	}
+
 	expectedNamespaceWithDetailEC2S3 := map[string][]namespaceDetail{}
 	expectedNamespaceWithDetailEC2S3["AWS/EC2"] = []namespaceDetail{
 		{
@@ -432,6 +474,18 @@ func TestReadCloudwatchConfig(t *testing.T) {
 			expectedListMetricWithDetailEC2,
 			expectedNamespaceWithDetailS3,
 		},
+		{
+			"test with only an expression",
+			[]Config{
+				{
+					Namespace:   "AWS/EC2",
+					Expressions: []Expression{cpuExpression},
+				},
+			},
+			nil,
+			expectedListMetricWithDetailExpression,
+			map[string][]namespaceDetail{},
+		},
 		{
 			"test with two specific metrics and a namespace",
 			[]Config{
@@ -741,6 +795,12 @@ func TestGenerateFieldName(t *testing.T) {
 			[]string{"CPUUtilization", "AWS/EC2", "p10", "InstanceId", "i-1"},
 			"aws.ec2.metrics.CPUUtilization.p10",
 		},
+		{
+			"test expression",
+			"cloudwatch",
+			[]string{"cpu_max", "AWS/EC2", "Expression", "InstanceId", "i-1"},
+			"aws.ec2.metrics.cpu_max.value",
+		},
 		{
 			"test metric name with dot",
 			"cloudwatch",
@@ -1202,6 +1262,26 @@ func (m *MockCloudWatchClientWithDataGranularity) GetMetricData(context.Context,
 }
 
 // MockResourceGroupsTaggingClient is used for unit tests.
+// MockCloudWatchClientWithExpression struct is used for unit tests.
+type MockCloudWatchClientWithExpression struct{}
+
+// GetMetricData implements cloudwatch.GetMetricDataAPIClient, resolving the
+// dynamic label of each query the way CloudWatch does.
+func (m *MockCloudWatchClientWithExpression) GetMetricData(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	emptyString := ""
+	output := &cloudwatch.GetMetricDataOutput{NextToken: &emptyString}
+	for _, query := range input.MetricDataQueries {
+		label := strings.ReplaceAll(*query.Label, "${PROP('Dim.InstanceId')}", instanceID1)
+		output.MetricDataResults = append(output.MetricDataResults, cloudwatchtypes.MetricDataResult{
+			Id:         query.Id,
+			Label:      &label,
+			Values:     []float64{value1},
+			Timestamps: []time.Time{timestamp},
+		})
+	}
+	return output, nil
+}
+
 type MockResourceGroupsTaggingClient struct{}
 
 // GetResources implements resourcegroupstaggingapi.GetResourcesAPIClient.
@@ -1239,7 +1319,7 @@ func TestCreateEventsWithIdentifier(t *testing.T) {
 	resourceTypeTagFilters["ec2:instance"] = nameTestEC2Tag
 	startTime, endTime := aws.GetStartTimeEndTime(time.Now(), m.MetricSet.Period, m.MetricSet.Latency)
 
-	events, err := m.createEvents(mockCloudwatchSvc, mockTaggingSvc, listMetricWithStatsTotal, resourceTypeTagFilters, regionName, startTime, endTime)
+	events, _, err := m.createEvents(mockCloudwatchSvc, mockTaggingSvc, listMetricWithStatsTotal, nil, resourceTypeTagFilters, regionName, startTime, endTime)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(events))
 
@@ -1252,6 +1332,37 @@ func TestCreateEventsWithIdentifier(t *testing.T) {
 	assert.Equal(t, instanceID1, dimension)
 }
 
+func TestCreateEventsWithExpression(t *testing.T) {
+	m := MetricSet{}
+	m.MetricSet = &aws.MetricSet{Period: 5}
+	m.logger = logp.NewLogger("test")
+
+	mockTaggingSvc := &MockResourceGroupsTaggingClient{}
+	mockCloudwatchSvc := &MockCloudWatchClientWithExpression{}
+	expressions := []expressionWithNamespace{{
+		namespace: "AWS/EC2",
+		expression: Expression{
+			Name:       "cpu_max",
+			Expression: "SEARCH('{AWS/EC2,InstanceId} MetricName=\"CPUUtilization\"', 'Maximum')",
+			Dimension:  "InstanceId",
+		},
+	}}
+	startTime, endTime := aws.GetStartTimeEndTime(time.Now(), m.MetricSet.Period, m.MetricSet.Latency)
+
+	events, usage, err := m.createEvents(mockCloudwatchSvc, mockTaggingSvc, nil, expressions, map[string][]aws.Tag{}, regionName, startTime, endTime)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, aws.MetricDataUsage{APICalls: 1, MetricsRequested: 1}, usage)
+
+	metricValue, err := events["i-1-0"].RootFields.GetValue("aws.ec2.metrics.cpu_max.value")
+	assert.NoError(t, err)
+	assert.Equal(t, value1, metricValue)
+
+	dimension, err := events["i-1-0"].RootFields.GetValue("aws.dimensions.InstanceId")
+	assert.NoError(t, err)
+	assert.Equal(t, instanceID1, dimension)
+}
+
 func TestCreateEventsWithoutIdentifier(t *testing.T) {
 	m := MetricSet{}
 	m.CloudwatchConfigs = []Config{{Statistic: []string{"Average"}}}
@@ -1280,7 +1391,7 @@ func TestCreateEventsWithoutIdentifier(t *testing.T) {
 	resourceTypeTagFilters := map[string][]aws.Tag{}
 	startTime, endTime := aws.GetStartTimeEndTime(time.Now(), m.MetricSet.Period, m.MetricSet.Latency)
 
-	events, err := m.createEvents(mockCloudwatchSvc, mockTaggingSvc, listMetricWithStatsTotal, resourceTypeTagFilters, regionName, startTime, endTime)
+	events, _, err := m.createEvents(mockCloudwatchSvc, mockTaggingSvc, listMetricWithStatsTotal, nil, resourceTypeTagFilters, regionName, startTime, endTime)
 	assert.NoError(t, err)
 
 	expectedID := regionName + accountID + namespace
@@ -1315,7 +1426,7 @@ func TestCreateEventsWithDataGranularity(t *testing.T) {
 	resourceTypeTagFilters := map[string][]aws.Tag{}
 	startTime, endTime := aws.GetStartTimeEndTime(time.Now(), m.MetricSet.Period, m.MetricSet.Latency)
 
-	events, err := m.createEvents(mockCloudwatchSvc, mockTaggingSvc, listMetricWithStatsTotal, resourceTypeTagFilters, regionName, startTime, endTime)
+	events, _, err := m.createEvents(mockCloudwatchSvc, mockTaggingSvc, listMetricWithStatsTotal, nil, resourceTypeTagFilters, regionName, startTime, endTime)
 	assert.NoError(t, err)
 
 	expectedID := regionName + accountID
@@ -1358,7 +1469,7 @@ func TestCreateEventsWithTagsFilter(t *testing.T) {
 	resourceTypeTagFilters["ec2:instance"] = nameTestEC2Tag
 
 	startTime, endTime := aws.GetStartTimeEndTime(time.Now(), m.MetricSet.Period, m.MetricSet.Latency)
-	events, err := m.createEvents(mockCloudwatchSvc, mockTaggingSvc, listMetricWithStatsTotal, resourceTypeTagFilters, regionName, startTime, endTime)
+	events, _, err := m.createEvents(mockCloudwatchSvc, mockTaggingSvc, listMetricWithStatsTotal, nil, resourceTypeTagFilters, regionName, startTime, endTime)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(events))
 
@@ -1370,7 +1481,7 @@ func TestCreateEventsWithTagsFilter(t *testing.T) {
 		},
 	}
 
-	events, err = m.createEvents(mockCloudwatchSvc, mockTaggingSvc, listMetricWithStatsTotal, resourceTypeTagFilters, regionName, startTime, endTime)
+	events, _, err = m.createEvents(mockCloudwatchSvc, mockTaggingSvc, listMetricWithStatsTotal, nil, resourceTypeTagFilters, regionName, startTime, endTime)
 	assert.NoError(t, err)
 	assert.Equal(t, 0, len(events))
 }
@@ -1520,7 +1631,7 @@ func TestCreateEventsTimestamp(t *testing.T) {
 
 	cloudwatchMock := &MockCloudWatchClientWithoutDim{}
 	resGroupTaggingClientMock := &MockResourceGroupsTaggingClient{}
-	events, err := m.createEvents(cloudwatchMock, resGroupTaggingClientMock, listMetricWithStatsTotal, resourceTypeTagFilters, regionName, startTime, endTime)
+	events, _, err := m.createEvents(cloudwatchMock, resGroupTaggingClientMock, listMetricWithStatsTotal, nil, resourceTypeTagFilters, regionName, startTime, endTime)
 	assert.NoError(t, err)
 	assert.Equal(t, timestamp, events[regionName+accountID+namespace+"-0"].Timestamp)
 }
@@ -74,10 +74,43 @@ func GetListMetricsOutput(namespace string, regionName string, period time.Durat
 	return metricsTotal, nil
 }
 
+// getMetricDataPricePerMetric is the on-demand price in USD of one metric
+// requested through GetMetricData, see https://aws.amazon.com/cloudwatch/pricing/.
+const getMetricDataPricePerMetric = 0.01 / 1000
+
+// MetricDataUsage counts the GetMetricData API calls made and the metrics
+// requested by them, which is what CloudWatch bills GetMetricData on.
+type MetricDataUsage struct {
+	APICalls         int
+	MetricsRequested int
+}
+
+// Add adds the usage in other to u.
+func (u *MetricDataUsage) Add(other MetricDataUsage) {
+	u.APICalls += other.APICalls
+	u.MetricsRequested += other.MetricsRequested
+}
+
+// EstimatedCost returns the estimated cost in USD of the metrics requested,
+// based on the on-demand GetMetricData price.
+func (u MetricDataUsage) EstimatedCost() float64 {
+	return float64(u.MetricsRequested) * getMetricDataPricePerMetric
+}
+
 // GetMetricDataResults function uses MetricDataQueries to get metric data output.
 func GetMetricDataResults(metricDataQueries []types.MetricDataQuery, svc cloudwatch.GetMetricDataAPIClient, startTime time.Time, endTime time.Time) ([]types.MetricDataResult, error) {
+	results, _, err := GetMetricDataResultsWithUsage(metricDataQueries, svc, startTime, endTime)
+	return results, err
+}
+
+// GetMetricDataResultsWithUsage uses MetricDataQueries to get metric data output
+// and reports the GetMetricData usage of the requests made. A query using a
+// metric counts as one metric requested, a query using an expression counts
+// as one metric per time series it returns.
+func GetMetricDataResultsWithUsage(metricDataQueries []types.MetricDataQuery, svc cloudwatch.GetMetricDataAPIClient, startTime time.Time, endTime time.Time) ([]types.MetricDataResult, MetricDataUsage, error) {
 	maxNumberOfMetricsRetrieved := 500
 	getMetricDataOutput := &cloudwatch.GetMetricDataOutput{NextToken: nil}
+	var usage MetricDataUsage
 
 	// Split metricDataQueries into smaller slices that length no longer than 500.
 	// 500 is defined in maxNumberOfMetricsRetrieved.
@@ -85,7 +118,16 @@ func GetMetricDataResults(metricDataQueries []types.MetricDataQuery, svc cloudwa
 	for i := 0; i < len(metricDataQueries); i += maxNumberOfMetricsRetrieved {
 		metricDataQueriesPartial := metricDataQueries[i:int(math.Min(float64(i+maxNumberOfMetricsRetrieved), float64(len(metricDataQueries))))]
 		if len(metricDataQueriesPartial) == 0 {
-			return getMetricDataOutput.MetricDataResults, nil
+			return getMetricDataOutput.MetricDataResults, usage, nil
+		}
+
+		expressionIDs := map[string]bool{}
+		for _, query := range metricDataQueriesPartial {
+			if query.Expression != nil && query.Id != nil {
+				expressionIDs[*query.Id] = true
+				continue
+			}
+			usage.MetricsRequested++
 		}
 
 		getMetricDataInput := &cloudwatch.GetMetricDataInput{
@@ -97,15 +139,32 @@ func GetMetricDataResults(metricDataQueries []types.MetricDataQuery, svc cloudwa
 		paginator := cloudwatch.NewGetMetricDataPaginator(svc, getMetricDataInput)
 		var err error
 		var page *cloudwatch.GetMetricDataOutput
+		expressionSeries := map[string]bool{}
 		for paginator.HasMorePages() {
+			usage.APICalls++
 			if page, err = paginator.NextPage(context.TODO()); err != nil {
-				return getMetricDataOutput.MetricDataResults, fmt.Errorf("error GetMetricData with Paginator: %w", err)
+				return getMetricDataOutput.MetricDataResults, usage, fmt.Errorf("error GetMetricData with Paginator: %w", err)
 			}
 			getMetricDataOutput.MetricDataResults = append(getMetricDataOutput.MetricDataResults, page.MetricDataResults...)
+
+			// Results of the same time series can be split across pages.
+			for _, result := range page.MetricDataResults {
+				if result.Id == nil || !expressionIDs[*result.Id] {
+					continue
+				}
+				series := *result.Id
+				if result.Label != nil {
+					series += "/" + *result.Label
+				}
+				if !expressionSeries[series] {
+					expressionSeries[series] = true
+					usage.MetricsRequested++
+				}
+			}
 		}
 	}
 
-	return getMetricDataOutput.MetricDataResults, nil
+	return getMetricDataOutput.MetricDataResults, usage, nil
 }
 
 // CheckTimestampInArray checks if input timestamp exists in timestampArray and if it exists, return the position.
@@ -230,6 +230,49 @@ func TestGetMetricDataResults(t *testing.T) {
 	assert.Equal(t, 0.0, getMetricDataResults[3].Values[0])
 }
 
+func TestGetMetricDataResultsWithUsage(t *testing.T) {
+	startTime, endTime := GetStartTimeEndTime(time.Now(), 10*time.Minute, 0)
+
+	mockSvc := &MockCloudWatchClient{}
+	metricInfo := cloudwatchtypes.Metric{
+		MetricName: &metricName,
+		Namespace:  &namespace,
+	}
+	metricStat := cloudwatchtypes.MetricStat{Metric: &metricInfo}
+	expression := "SEARCH('{AWS/EC2,InstanceId} MetricName=\"StatusCheckFailed\"', 'Maximum')"
+
+	t.Run("metrics and expressions", func(t *testing.T) {
+		metricDataQueries := []cloudwatchtypes.MetricDataQuery{
+			{
+				Id:         &id1,
+				Label:      &label1,
+				MetricStat: &metricStat,
+			},
+			{
+				Id:         &id2,
+				Expression: &expression,
+			},
+		}
+		_, usage, err := GetMetricDataResultsWithUsage(metricDataQueries, mockSvc, startTime, endTime)
+		assert.NoError(t, err)
+		assert.Equal(t, MetricDataUsage{APICalls: 1, MetricsRequested: 2}, usage)
+		assert.InDelta(t, 0.00002, usage.EstimatedCost(), 1e-12)
+	})
+
+	t.Run("batched by 500 queries", func(t *testing.T) {
+		metricDataQueries := make([]cloudwatchtypes.MetricDataQuery, 501)
+		for i := range metricDataQueries {
+			metricDataQueries[i] = cloudwatchtypes.MetricDataQuery{
+				Id:         &id1,
+				MetricStat: &metricStat,
+			}
+		}
+		_, usage, err := GetMetricDataResultsWithUsage(metricDataQueries, mockSvc, startTime, endTime)
+		assert.NoError(t, err)
+		assert.Equal(t, MetricDataUsage{APICalls: 2, MetricsRequested: 501}, usage)
+	})
+}
+
 func TestCheckTimestampInArray(t *testing.T) {
 	timestamp1 := time.Now()
 	timestamp2 := timestamp1.Add(5 * time.Minute)
@@ -0,0 +1,73 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package azure
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+)
+
+// Authentication types supported by the azure module.
+const (
+	AuthTypeClientSecret     = "client_secret"
+	AuthTypeManagedIdentity  = "managed_identity"
+	AuthTypeWorkloadIdentity = "workload_identity"
+)
+
+// federatedTokenFileEnv is the environment variable set by the Azure AD
+// workload identity webhook with the path of the projected service account token.
+const federatedTokenFileEnv = "AZURE_FEDERATED_TOKEN_FILE"
+
+// NewAuthorizer returns the authorizer used by the Azure clients, based on the
+// authentication type configured.
+func NewAuthorizer(config Config) (autorest.Authorizer, error) {
+	switch config.AuthType {
+	case AuthTypeManagedIdentity:
+		msiConfig := auth.NewMSIConfig()
+		msiConfig.Resource = config.ResourceManagerEndpoint
+		// a client ID selects a user-assigned identity, the system-assigned one is used otherwise
+		msiConfig.ClientID = config.ClientId
+		return msiConfig.Authorizer()
+	case AuthTypeWorkloadIdentity:
+		oauthConfig, err := adal.NewOAuthConfig(config.ActiveDirectoryEndpoint, config.TenantId)
+		if err != nil {
+			return nil, err
+		}
+		secret := &federatedTokenSecret{path: config.FederatedTokenFile}
+		token, err := adal.NewServicePrincipalTokenWithSecret(*oauthConfig, config.ClientId, config.ResourceManagerEndpoint, secret)
+		if err != nil {
+			return nil, err
+		}
+		return autorest.NewBearerAuthorizer(token), nil
+	default:
+		clientConfig := auth.NewClientCredentialsConfig(config.ClientId, config.ClientSecret, config.TenantId)
+		clientConfig.AADEndpoint = config.ActiveDirectoryEndpoint
+		clientConfig.Resource = config.ResourceManagerEndpoint
+		return clientConfig.Authorizer()
+	}
+}
+
+// federatedTokenSecret authenticates with a federated token read from a file,
+// the token is read on each refresh as it is rotated by Kubernetes.
+type federatedTokenSecret struct {
+	path string
+}
+
+// SetAuthenticationValues implements adal.ServicePrincipalSecret.
+func (s *federatedTokenSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, values *url.Values) error {
+	token, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to read federated token: %w", err)
+	}
+	values.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	values.Set("client_assertion", strings.TrimSpace(string(token)))
+	return nil
+}
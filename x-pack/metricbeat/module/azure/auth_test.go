@@ -0,0 +1,31 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package azure
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFederatedTokenSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	secret := &federatedTokenSecret{path: path}
+
+	values := url.Values{}
+	assert.Error(t, secret.SetAuthenticationValues(nil, &values))
+
+	// the token is read again on each refresh to pick up the rotated token
+	for _, token := range []string{"first-token", "rotated-token"} {
+		assert.NoError(t, os.WriteFile(path, []byte(token+"\n"), 0o600))
+		values := url.Values{}
+		assert.NoError(t, secret.SetAuthenticationValues(nil, &values))
+		assert.Equal(t, "urn:ietf:params:oauth:client-assertion-type:jwt-bearer", values.Get("client_assertion_type"))
+		assert.Equal(t, token, values.Get("client_assertion"))
+	}
+}
@@ -58,10 +58,10 @@ func NewMetricSet(base mb.BaseMetricSet) (*MetricSet, error) {
 				return nil, errors.Errorf("error initializing the monitor client: module azure - %s metricset. No queries allowed, please select one of the allowed options", metricsetName)
 			}
 		}
-		// check for lightweight resources if no groups or ids have been entered, if not a new resource is created to check the entire subscription
+		// check for lightweight resources if no groups, ids or resource graph queries have been entered, if not a new resource is created to check the entire subscription
 		var resources []ResourceConfig
 		for _, resource := range config.Resources {
-			if hasConfigOptions(resource.Group) || hasConfigOptions(resource.Id) {
+			if hasConfigOptions(resource.Group) || hasConfigOptions(resource.Id) || resource.GraphQuery != "" {
 				resources = append(resources, resource)
 			}
 		}
@@ -59,14 +59,20 @@ func (client *Client) InitResources(fn mapResourceMetrics) error {
 	client.Resources = []Resource{}
 	for _, resource := range client.Config.Resources {
 		// retrieve azure resources information
-		resourceList, err := client.AzureMonitorService.GetResourceDefinitions(resource.Id, resource.Group, resource.Type, resource.Query)
+		var resourceList []resources.GenericResourceExpanded
+		var err error
+		if resource.GraphQuery != "" {
+			resourceList, err = client.AzureMonitorService.GetResourceDefinitionsByGraphQuery(resource.GraphQuery)
+		} else {
+			resourceList, err = client.AzureMonitorService.GetResourceDefinitions(resource.Id, resource.Group, resource.Type, resource.Query)
+		}
 		if err != nil {
 			err = fmt.Errorf("failed to retrieve resources: %w", err)
 			return err
 		}
 		if len(resourceList) == 0 {
-			err = fmt.Errorf("failed to retrieve resources: No resources returned using the configuration options resource ID %s, resource group %s, resource type %s, resource query %s",
-				resource.Id, resource.Group, resource.Type, resource.Query)
+			err = fmt.Errorf("failed to retrieve resources: No resources returned using the configuration options resource ID %s, resource group %s, resource type %s, resource query %s, resource graph query %s",
+				resource.Id, resource.Group, resource.Type, resource.Query, resource.GraphQuery)
 			client.Log.Error(err)
 			continue
 		}
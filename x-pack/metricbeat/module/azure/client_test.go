@@ -34,6 +34,16 @@ var (
 					},
 				}}},
 	}
+	resourceGraphQueryConfig = Config{
+		Resources: []ResourceConfig{
+			{
+				GraphQuery: "Resources | where type =~ 'microsoft.compute/virtualmachines'",
+				Metrics: []MetricConfig{
+					{
+						Name: []string{"hello", "test"},
+					},
+				}}},
+	}
 )
 
 func mockMapResourceMetrics(client *Client, resources []resources.GenericResourceExpanded, resourceConfig ResourceConfig) ([]Metric, error) {
@@ -59,6 +69,21 @@ func TestInitResources(t *testing.T) {
 		assert.Equal(t, len(client.ResourceConfigurations.Metrics), 0)
 		m.AssertExpectations(t)
 	})
+	t.Run("retrieve resources using a resource graph query", func(t *testing.T) {
+		client := NewMockClient()
+		client.Config = resourceGraphQueryConfig
+		id, name, location, rType := "/subscriptions/123/resourceGroups/group/providers/Microsoft.Compute/virtualMachines/vm", "vm", "westeurope", "microsoft.compute/virtualmachines"
+		m := &MockService{}
+		m.On("GetResourceDefinitionsByGraphQuery", resourceGraphQueryConfig.Resources[0].GraphQuery).Return([]resources.GenericResourceExpanded{
+			{ID: &id, Name: &name, Location: &location, Type: &rType},
+		}, nil)
+		client.AzureMonitorService = m
+		err := client.InitResources(mockMapResourceMetrics)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(client.Resources))
+		assert.Equal(t, "group", client.Resources[0].Group)
+		m.AssertExpectations(t)
+	})
 }
 
 func TestGetMetricValues(t *testing.T) {
@@ -5,6 +5,7 @@
 package azure
 
 import (
+	"os"
 	"time"
 
 	"github.com/pkg/errors"
@@ -29,9 +30,11 @@ var (
 // Config options
 type Config struct {
 	// shared config options
-	ClientId                string        `config:"client_id"  validate:"required"`
-	ClientSecret            string        `config:"client_secret"  validate:"required"`
-	TenantId                string        `config:"tenant_id"  validate:"required"`
+	AuthType                string        `config:"auth_type"`
+	ClientId                string        `config:"client_id"`
+	ClientSecret            string        `config:"client_secret"`
+	TenantId                string        `config:"tenant_id"`
+	FederatedTokenFile      string        `config:"federated_token_file"`
 	SubscriptionId          string        `config:"subscription_id"  validate:"required"`
 	Period                  time.Duration `config:"period" validate:"nonzero,required"`
 	ResourceManagerEndpoint string        `config:"resource_manager_endpoint"`
@@ -53,9 +56,18 @@ type ResourceConfig struct {
 	Metrics     []MetricConfig `config:"metrics"`
 	Type        string         `config:"resource_type"`
 	Query       string         `config:"resource_query"`
+	GraphQuery  string         `config:"resource_graph_query"`
 	ServiceType []string       `config:"service_type"`
 }
 
+// Validate checks that a resource graph query is not combined with the other resource selection options.
+func (conf ResourceConfig) Validate() error {
+	if conf.GraphQuery != "" && (len(conf.Id) > 0 || len(conf.Group) > 0 || conf.Query != "") {
+		return errors.New("resource_graph_query cannot be used together with resource_id, resource_group or resource_query")
+	}
+	return nil
+}
+
 // MetricConfig contains metric specific configuration.
 type MetricConfig struct {
 	Name         []string          `config:"name"`
@@ -75,6 +87,9 @@ type DimensionConfig struct {
 }
 
 func (conf *Config) Validate() error {
+	if err := conf.validateAuth(); err != nil {
+		return err
+	}
 	if conf.ResourceManagerEndpoint == "" {
 		conf.ResourceManagerEndpoint = DefaultBaseURI
 	}
@@ -96,3 +111,26 @@ func (conf *Config) Validate() error {
 	}
 	return nil
 }
+
+// validateAuth checks the credentials required by the authentication type configured.
+func (conf *Config) validateAuth() error {
+	switch conf.AuthType {
+	case "", AuthTypeClientSecret:
+		conf.AuthType = AuthTypeClientSecret
+		if conf.ClientId == "" || conf.ClientSecret == "" || conf.TenantId == "" {
+			return errors.New("client_id, client_secret and tenant_id are required when using the client_secret auth_type")
+		}
+	case AuthTypeManagedIdentity:
+	case AuthTypeWorkloadIdentity:
+		if conf.FederatedTokenFile == "" {
+			conf.FederatedTokenFile = os.Getenv(federatedTokenFileEnv)
+		}
+		if conf.ClientId == "" || conf.TenantId == "" || conf.FederatedTokenFile == "" {
+			return errors.New("client_id, tenant_id and federated_token_file are required when using the workload_identity auth_type")
+		}
+	default:
+		return errors.Errorf("unsupported auth_type %q, supported types are %s, %s and %s",
+			conf.AuthType, AuthTypeClientSecret, AuthTypeManagedIdentity, AuthTypeWorkloadIdentity)
+	}
+	return nil
+}
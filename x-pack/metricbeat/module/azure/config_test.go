@@ -0,0 +1,49 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAuth(t *testing.T) {
+	t.Run("client secret is the default auth type", func(t *testing.T) {
+		conf := Config{ClientId: "id", ClientSecret: "secret", TenantId: "tenant"}
+		assert.NoError(t, conf.validateAuth())
+		assert.Equal(t, AuthTypeClientSecret, conf.AuthType)
+	})
+	t.Run("client secret requires the client secret", func(t *testing.T) {
+		conf := Config{ClientId: "id", TenantId: "tenant"}
+		assert.Error(t, conf.validateAuth())
+	})
+	t.Run("managed identity requires no credentials", func(t *testing.T) {
+		conf := Config{AuthType: AuthTypeManagedIdentity}
+		assert.NoError(t, conf.validateAuth())
+	})
+	t.Run("workload identity reads the token file from the environment", func(t *testing.T) {
+		t.Setenv(federatedTokenFileEnv, "/var/run/secrets/azure/tokens/azure-identity-token")
+		conf := Config{AuthType: AuthTypeWorkloadIdentity, ClientId: "id", TenantId: "tenant"}
+		assert.NoError(t, conf.validateAuth())
+		assert.Equal(t, "/var/run/secrets/azure/tokens/azure-identity-token", conf.FederatedTokenFile)
+	})
+	t.Run("workload identity requires a token file", func(t *testing.T) {
+		t.Setenv(federatedTokenFileEnv, "")
+		conf := Config{AuthType: AuthTypeWorkloadIdentity, ClientId: "id", TenantId: "tenant"}
+		assert.Error(t, conf.validateAuth())
+	})
+	t.Run("unsupported auth type", func(t *testing.T) {
+		conf := Config{AuthType: "certificate"}
+		assert.Error(t, conf.validateAuth())
+	})
+}
+
+func TestResourceConfigValidate(t *testing.T) {
+	assert.NoError(t, ResourceConfig{GraphQuery: "Resources | where type =~ 'microsoft.compute/virtualmachines'"}.Validate())
+	assert.NoError(t, ResourceConfig{Group: []string{"group"}}.Validate())
+	assert.Error(t, ResourceConfig{GraphQuery: "Resources", Group: []string{"group"}}.Validate())
+	assert.Error(t, ResourceConfig{GraphQuery: "Resources", Query: "resourceType eq 'Microsoft.Compute/virtualMachines'"}.Validate())
+}
@@ -29,6 +29,12 @@ func (client *MockService) GetResourceDefinitions(id []string, group []string, r
 	return args.Get(0).([]resources.GenericResourceExpanded), args.Error(1)
 }
 
+// GetResourceDefinitionsByGraphQuery is a mock function for the azure service
+func (client *MockService) GetResourceDefinitionsByGraphQuery(query string) ([]resources.GenericResourceExpanded, error) {
+	args := client.Called(query)
+	return args.Get(0).([]resources.GenericResourceExpanded), args.Error(1)
+}
+
 // GetMetricDefinitions is a mock function for the azure service
 func (client *MockService) GetMetricDefinitions(resourceId string, namespace string) (insights.MetricDefinitionCollection, error) {
 	args := client.Called(resourceId, namespace)
@@ -12,8 +12,8 @@ import (
 	"github.com/elastic/elastic-agent-libs/logp"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
+	"github.com/Azure/azure-sdk-for-go/services/resourcegraph/mgmt/2021-03-01/resourcegraph"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-10-01/resources"
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 )
 
 // MonitorService service wrapper to the azure sdk for go
@@ -22,6 +22,8 @@ type MonitorService struct {
 	metricDefinitionClient *insights.MetricDefinitionsClient
 	metricNamespaceClient  *insights.MetricNamespacesClient
 	resourceClient         *resources.Client
+	resourceGraphClient    *resourcegraph.BaseClient
+	subscriptionId         string
 	context                context.Context
 	log                    *logp.Logger
 }
@@ -33,10 +35,7 @@ const (
 
 // NewService instantiates the Azure monitoring service
 func NewService(config Config) (*MonitorService, error) {
-	clientConfig := auth.NewClientCredentialsConfig(config.ClientId, config.ClientSecret, config.TenantId)
-	clientConfig.AADEndpoint = config.ActiveDirectoryEndpoint
-	clientConfig.Resource = config.ResourceManagerEndpoint
-	authorizer, err := clientConfig.Authorizer()
+	authorizer, err := NewAuthorizer(config)
 	if err != nil {
 		return nil, err
 	}
@@ -44,15 +43,19 @@ func NewService(config Config) (*MonitorService, error) {
 	metricsDefinitionClient := insights.NewMetricDefinitionsClientWithBaseURI(config.ResourceManagerEndpoint, config.SubscriptionId)
 	resourceClient := resources.NewClientWithBaseURI(config.ResourceManagerEndpoint, config.SubscriptionId)
 	metricNamespaceClient := insights.NewMetricNamespacesClientWithBaseURI(config.ResourceManagerEndpoint, config.SubscriptionId)
+	resourceGraphClient := resourcegraph.NewWithBaseURI(config.ResourceManagerEndpoint)
 	metricsClient.Authorizer = authorizer
 	metricsDefinitionClient.Authorizer = authorizer
 	resourceClient.Authorizer = authorizer
 	metricNamespaceClient.Authorizer = authorizer
+	resourceGraphClient.Authorizer = authorizer
 	service := &MonitorService{
 		metricDefinitionClient: &metricsDefinitionClient,
 		metricsClient:          &metricsClient,
 		metricNamespaceClient:  &metricNamespaceClient,
 		resourceClient:         &resourceClient,
+		resourceGraphClient:    &resourceGraphClient,
+		subscriptionId:         config.SubscriptionId,
 		context:                context.Background(),
 		log:                    logp.NewLogger("azure monitor service"),
 	}
@@ -96,6 +99,40 @@ func (service MonitorService) GetResourceDefinitions(id []string, group []string
 	return resourceList, err
 }
 
+// GetResourceDefinitionsByGraphQuery will retrieve the azure resources returned by an Azure Resource Graph query
+func (service MonitorService) GetResourceDefinitionsByGraphQuery(query string) ([]resources.GenericResourceExpanded, error) {
+	var resourceList []resources.GenericResourceExpanded
+	request := resourcegraph.QueryRequest{
+		Subscriptions: &[]string{service.subscriptionId},
+		Query:         &query,
+		Options: &resourcegraph.QueryRequestOptions{
+			ResultFormat: resourcegraph.ResultFormatObjectArray,
+		},
+	}
+	for {
+		response, err := service.resourceGraphClient.Resources(service.context, request)
+		if err != nil {
+			return nil, err
+		}
+		rows, ok := response.Data.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected resource graph query result of type %T", response.Data)
+		}
+		for _, row := range rows {
+			resource, err := mapResourceGraphRow(row)
+			if err != nil {
+				return nil, err
+			}
+			resourceList = append(resourceList, resource)
+		}
+		// the results are paged, the skip token is returned as long as there are more results
+		if response.SkipToken == nil || *response.SkipToken == "" {
+			return resourceList, nil
+		}
+		request.Options.SkipToken = response.SkipToken
+	}
+}
+
 // GetResourceDefinitionById will retrieve the azure resource based on the resource Id
 func (service MonitorService) GetResourceDefinitionById(id string) (resources.GenericResource, error) {
 	return service.resourceClient.GetByID(service.context, id, ApiVersion)
@@ -162,3 +199,39 @@ func getResourceTypeFromId(path string) string {
 	}
 	return ""
 }
+
+// mapResourceGraphRow maps a resource graph query result row to a resource, the id column is required
+// while the name, type, location and tags columns are used when the query returns them
+func mapResourceGraphRow(row interface{}) (resources.GenericResourceExpanded, error) {
+	var resource resources.GenericResourceExpanded
+	columns, ok := row.(map[string]interface{})
+	if !ok {
+		return resource, fmt.Errorf("unexpected resource graph query row of type %T", row)
+	}
+	id, _ := columns["id"].(string)
+	if id == "" {
+		return resource, fmt.Errorf("resource graph query results must contain the id column")
+	}
+	name, _ := columns["name"].(string)
+	if name == "" {
+		name = getResourceNameFromId(id)
+	}
+	rType, _ := columns["type"].(string)
+	if rType == "" {
+		rType = getResourceTypeFromId(id)
+	}
+	location, _ := columns["location"].(string)
+	resource.ID = &id
+	resource.Name = &name
+	resource.Type = &rType
+	resource.Location = &location
+	if tags, ok := columns["tags"].(map[string]interface{}); ok {
+		resource.Tags = make(map[string]*string, len(tags))
+		for key, value := range tags {
+			if value, ok := value.(string); ok {
+				resource.Tags[key] = &value
+			}
+		}
+	}
+	return resource, nil
+}
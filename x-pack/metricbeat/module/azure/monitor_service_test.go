@@ -21,3 +21,34 @@ func TestGetResourceNameFromID(t *testing.T) {
 	name := getResourceNameFromId(path)
 	assert.Equal(t, name, "obstestmemleak")
 }
+
+func TestMapResourceGraphRow(t *testing.T) {
+	id := "/subscriptions/qw3e45r6t-23ws-1234-6587-1234ed4532/resourceGroups/obs-infrastructure/providers/Microsoft.Compute/virtualMachines/obstestmemleak"
+	t.Run("map all columns", func(t *testing.T) {
+		resource, err := mapResourceGraphRow(map[string]interface{}{
+			"id":       id,
+			"name":     "obstestmemleak",
+			"type":     "microsoft.compute/virtualmachines",
+			"location": "westeurope",
+			"tags":     map[string]interface{}{"env": "test"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, id, *resource.ID)
+		assert.Equal(t, "obstestmemleak", *resource.Name)
+		assert.Equal(t, "microsoft.compute/virtualmachines", *resource.Type)
+		assert.Equal(t, "westeurope", *resource.Location)
+		assert.Equal(t, "test", *resource.Tags["env"])
+	})
+	t.Run("map the id column only", func(t *testing.T) {
+		resource, err := mapResourceGraphRow(map[string]interface{}{"id": id})
+		assert.NoError(t, err)
+		assert.Equal(t, "obstestmemleak", *resource.Name)
+		assert.Equal(t, "Microsoft.Compute/virtualMachines", *resource.Type)
+		assert.Equal(t, "", *resource.Location)
+		assert.Nil(t, resource.Tags)
+	})
+	t.Run("return error when the id column is missing", func(t *testing.T) {
+		_, err := mapResourceGraphRow(map[string]interface{}{"name": "obstestmemleak"})
+		assert.Error(t, err)
+	})
+}
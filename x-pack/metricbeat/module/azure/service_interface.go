@@ -13,6 +13,7 @@ import (
 type Service interface {
 	GetResourceDefinitionById(id string) (resources.GenericResource, error)
 	GetResourceDefinitions(id []string, group []string, rType string, query string) ([]resources.GenericResourceExpanded, error)
+	GetResourceDefinitionsByGraphQuery(query string) ([]resources.GenericResourceExpanded, error)
 	GetMetricDefinitions(resourceId string, namespace string) (insights.MetricDefinitionCollection, error)
 	GetMetricNamespaces(resourceId string) (insights.MetricNamespaceCollection, error)
 	GetMetricValues(resourceId string, namespace string, timegrain string, timespan string, metricNames []string, aggregations string, filter string) ([]insights.Metric, string, error)
@@ -19,5 +19,5 @@ func init() {
 // AssetGcp returns asset data.
 // This is the base64 encoded zlib format compressed contents of module/gcp.
 func AssetGcp() string {
-	return "eJzsXVtz3LaSfvevQOXF8ZYy2Thb++DaOlWKfJzjOlaOKpJTtU88INgzAw8IMLhInvz6LdxIcIYzw+FlFKXWeYkkEv31Bd2NRgP8Dm1g+w6tSPUKIU01g3fo9c9CrBigGyZMge4Y1kshy9evEJLAACt4h1b4FUIFKCJppang79DfXiGE0M83d6gUhWHwCqElBVaod+4P3yGOS4iE7D+9rezPUpj4m/T59B2Gc2Cq/nV8VeRfgOjk1x144j+Pi1MtJOUrVIKWlKj9kXchpDCMArn4j9afDkKx//wvM//EBrZPQhadA5egcYE1nmtwy+osY6ut0lDOMrQEJYwkMNngceBvaoH4/745bVetcQthcmfdHX/NSlxVlK/Co9+0Bj9inbfBHPUaayRBG8mhQEspStSaitd3H9HvBuR2scdWThmjfHWIXmuYn/yz0TSSd9rzuy2WdKaizqkSsRChvDxe7SuuS+ctpDdCafesQpQTZgpAElaGYXmFNP56hXDxxShdAtdXCPMCSWF4YYUOUgq56MBD+aOgBLJScL0egikKTEIlpEZunC5ClRTOFmgxhMqdfxt9fI/EEuk1RKVGujkwwVcKadFFXAuNWQfdJRNYH6b6YF+rKeFSGK73zYtgmQvey7pu3KPogxC6kpTrY2aWgx5jaJPJ2wrbs4iWNe5eUo8Q7E9jQNj3R8BQIB+tiQ+TxL1/20piKWQ3DKPwCo6RTkYcgyH55QAwElYD6f/q3uwgeYJiDWqhiKjgh/Nn4L19D/2wx2UPam8XTBB8QOS9yL7tEq71BHHg73KsoLCTeC0KwcRq2wtXieUG9OSo/LCDMP04FMyPZ2pGLJcKtBrqigOxMEqHKxZlZTT088X+2dki/ZJKeMKMLQopqgqKRb7V0MW59V2HGf/IiSitet3rKAyG8q2bipFID/pZhckGtMqIC2OPmJkur9wTTRjsDDyUK405gQWpzEKCdY1QZERIUAfB7CWUO3B+MWUO0qYEbhwUh0WCOzhrmzCFhCHSPwXNebNM0xIWCsgAUJ/tAM5ZYsYCMMqRAiJ4oXqRX1Skyz+covxgNSAxcQEisI2Z81ZQoJu7zz6LpgoRIyVwzbYWmVEQBdZHSAVVm4UEPNSib6z9WXjeou1IPp+3A/cinIlqnBnXEOyQHsHHfyFRgXR+/aiSHIonSTVMw78dSgNHWvQTgCM9sQTcmP1FUEIp5HaRW9sSfCFxmSn6BwyEYq3WJeghw7aoPAVrnNYqf7tdoIc1VcFXWwMWnG0RfsSU4Zz52fbbbVgl+nTQCtS+DG/REpeUdcbBYywZBcVAlm49/GaW2bGejxv1hKuM8oH2avWzpxk3ZygPqFYGlPaTmGqFxBNHliZSFSbwLNwKo6dkN05Sx2LDsRbPwS8H/STkZkH5SoJSU7lhAvQxllYsmEDmHCQhK1g4zzQcUUwuRmGCCYWjwP70CHIgiInlcjYcUx3JZ44Tb1KskMAEH4bWWKEcgCNpOKd8ddRkPYDMuflBMP7OcGV9qB0GKcoJRBxPWCGlsdRQXCV51gLd47JiUCB4BLlF//2fzV+ulxokUvbvlK+uUIE1thOVC40eqaJxlprKTswf3jav7q047KuVFKTXkuN9eHjyNUeyAmJGaZCLdbFUCwuOiwK6DO+g2Pcy/oLa/NHrndfW8I/3H+4dQ79YAt6ZYQnRGqzqaQEI14hOw1VaSJt7E1xhQvW2I/geyYQP4o7D1ah9ab5GKniEYc3n55/OQGo0ZfQPlzCNAmtjTgWSANd27VAD9WR28oge+AxfA2Z6vc1yJshmDv3XJJAnERXuCkR9VP5F5IslpgyKGdB9EXmwyTV+BOTpWE33tEcLLvq1OdGlM+Y8dMrkpc1JLiA95+VretYl9sRZrybmU3SzYBmj7gbofErfRTpM9Q3SOQ2gU6qDzWCLJV/UdYjMp9RZ4+8mdp3/e/3rL/UCUjUFkD4gq2oOX4mJpo/ggeGqYtSXj1UPRERwjSkHOQcuByihcBpO0N2B1f6Q6GwRpRqzQ/eJww6OTW5KzPFqRvnY/OY20NjNbvqbfwV+I3YqAVqzD2lBsyT/3S5Kr7z0rupaXyBtJ2wOaGnYkjLWlGwVWUNhWC8uHqnUBrNQtZ1e4GH8pmhqNXA6FhJhM307tK/bHhbs6d6INVVarCQuT0nfrUR8pBRiY6UbYECzXHXP2P8xyi44nO9UCNvX4pLfPVIvDPyGpkLUV2nDgCdclxVBYeSpNHQW3l1kUJU1Q2qD2Io+2iihsYa+gez5tJeGubN1WL88oSYboTyDPhNp9NJqutFkVyr9tt0+xKdnXAQXgpgSuF4UYIU+wlE9tNyTMoSAUkvDahLIkzgQO2sgbrtkThiWgPJG+7sBSUEhIRETYmOqU+D8Zsac6ByFjurJanPQaF7/e7WBf8fsxAeKMu0Fe7KhS+Kq7ga7uUP3GpNNIekjSNcQFt52u+P7HY5LId1bP//z768ns8JmKzqkVW5Xzwa0bPTO4o0pDcMul7y5++z7Ltzaod5ndPsMIbDXCPpVxU7ywGhJ9cgNWwvbI3WjxU3KhtBofGlJZrpdVIvbI+67gxp2AZygEMGcC43gKwEo0A8Iq6C89h/s+47KdMXLt/91hgRDBjnZpnyj7TByh3HOXKc9xeo85tKwe86m+67J2HR9JQFrV+jHfMdwUqsJBJ/BbqBaQwkSsyxUK/08HLjv8kkQzFA9Zl0B9XOPcr8fM9RL7GONcpsWbRh1Bryu/jstWG9PY6GGPdgxug+b5t2BYVKAM836MmXghcWJ3s4zyLGyqcwSG6YHbmo2UaJy7VJ2KHWFcik2wFEhnrgLFdsKrlCJvwjpGtpLyrv72PcAjpvZt62SyiBrnFkBlwpgwaj/0jEsiHSEcw32Mo0vtQtkLMdPLLvSUDuGu8auS9Lvlz+D9R7tRDhpqbSEZDHjjXKfv1ZXwlk64KLwXZhh0wDnDCbLhpMxk8w4zB9HeTzWebzBLvL+zuBCU7mWxUXX2YnqBi+xa+SuNWakrfkmed6x/hpnZft5aGIRE6ejvuEqtbipsVPXEpMtJQztWP0gIa14+QEtTtbN1JSwfQvVwE7bHfu4DHCPeGAzpkN8yEqmtowZVlceaFqWG4g25CjjJ17i3EJuNyPGCwSkkQlqn/VWB7nLZv+pdMdMpyYZCROobnCezuGOT6ZntNDYlxs7izOvx4F9uQ1OviPYunM5gbvXsTsCvwKu58KuJeYqNPlMDL+iReYLF8OPipT4K7rz58D/dT/SVi2eEcc82htfsTekkoKAUvG4x1iQWYGhFPzZtpGc7hk8AouNsx7QqJQ3MjXxyju2tOziHLAcr0Sx5y3G+7NTfqISxch5luK2XmJ6zB3+YRrYj4KZcop8sUHsTreFJUV9ACf0a1iSlwrgCXsjbP2XLr7SuXqcpRO4ZsnU/OAd2VkO1lem4I9laMeriDul8XCYsNZ3wBAOZ13kWAgTuMgxw5z0vXjmk8AF+im+Mtup9LXWlVrkmGyAF9m4Ink7/CUnpXC94RtaUv7x8HD3/b2TCvJisYoUKODoNM1upMOynZ1AHbGFw+L5tgbijl50gO0DUFWCq6GrsuOy9EMHYdZYvxUSEUzW8MbKEr5qkBwzh//b+zd9GbiUDRBGgWtloZ4n4ZlVfy6YS6n50JwJcuyCyH5cRCsYd/zxIEyHrLa0h5u77z+/v4sRfwdrsNMGc4wJSyaeFuiDkHYA95NCVL9WyGm4uevCpqRNtzxSWgIu3Wnafsxn445dtoXQOns5nRhOMDLuiO9xNQY0qevoxcm8qgscz6y7wbx3o6d8/ln38dNPHbb07XKELt70Y2dmXXQzdnqS1CgvM0lSmPNK/dJTIOGs8/I8UmVKsayS4ut2QZhQ7t4ezoH4U0zDiifp3T31WLG1VwLSIEvK3c01bmlp5+f9/SfkYZzEOWom7tZJG6n9dpvYqL93qyegcVZ6GFGjx99uz0PE4ekCeiRu3TZMiaICPgHEm3BQKjngaLRdbLpzUW3YUpjV2rmek1jbCwCGNXBCj2zfdhzY6HFco8XJtV35a0lzky6yPektIpgRw5y0nWk8rYGnrTX+rgQriVgvsKzFekh0btaNseTP+7dw1N7a0Vba2aRPr0N2fFBadZz3txFi9v/yGyk/3zjwUqS3V/B0ItoV2UpodH3zz5aDE9zLKsrICe2woJZScG3tynoUqQ/vOMwml18fHlAJWBlpJSIkAkzWibdBOegnAB4ZxLw45WvqFOElT5rIRPcKd7YphU5L9WVPpT5ynWGivWyhtapmlxBautaVWj+n4Nzt4EhLWvkDoEGQV43XijlbK0eK1wDXoutcMZ9a5Dwj7y3HfJDFejrFwkDbNNzK73QG+/xhSJVC6DUUju1vKUeletOwn4bh18oJQmlMNlc+WpWUGw2tlSzDW5BhFVJhFaqVtdv28yHdEeneuHCf1tjf5ejezxhyi++hL2ZUJlcm7zX4ncnvTT7bXoziuFJroV00Z2I1as/TxTt3eUZ9GkUpvHJ7zK43uvBHuWuiPQD5DpMs32Y+27wowL2zIF4nAckx9ETwJV1lpirwJA0yJN6/5wcOJ/MRWWO+AnXlNe7nUnIpwLYC/w0GUIYdFzc3ZRalMXoVPkbvKZBE7TNDGq5pwQpQOkLO8GrYHYfXK3CeMWznvokW6oeP8IcIdB9gItaLQz1f0CavaS8w2URGJpxTtW1gsuHiiUGx8lPpuvm53rZrzbUCGHV7+Jb0SfSz+FjDW6hrXr7Fi80CL1AgWv/hTdBHCuwk8K2GjIjBO7QtofuzbM1NH0mu17RJufYLGjpLtIiPoN+N0BilfSKnsF/WEzeL7BTECP+cslIALjIGWoOccxZUJmdUrb3gLU3kaSItKkpavPQEXoois1PXDsYohznRP62FAhQpuQWX170DfCsKutxek837+MAE8/oQe1l6wdBUjO5zEB1uOqUm0NG4nod+0DuF3xOwTRSSGvoM2UuwgnjRYEr9tZ0mao2AF5Wg3IY1o12X1hZ0K4704sPwmtZ0fFwiMoTkIsZ6a0ENC3MlQ11s7aYbkzIxOg+dlqFxOVTg1lnH/LrqNsJDNjjCBDv4mVttB3ibWF+VYSxLEt9ZokrCSM944jpwMCpgSTmNxZ6uVxUktYzvm2JGi8vvT8fQ8K2R3uKaPn7hU8uBnpHLQZxTm5bAX0GN06vQSWac7tR6FlxqjbDWUFbduNBnzugGHAPqyhdM7TuuiVQiWlYMSuDarywKAb5nPMearP21kXVege6FX6LUV4twtm1uuRMcWi8s/P3GCTFpFe/7i9xHPa11iPqKxvRdd78GrirAEpWGaVoxf9NjZ9m6Jeh2NJ52zdydF43IIHZSh1lrlueCHxd9FMAcrtQOe9TeT+Pies5lZNwtbrs8LSIKm2OHNugRK0ffkmdXLxeJ8veR3J11g3+dmN8hxhlMNhJpBxH0RPUaccG/s7a8bUmVFsNsu83OnBaxw9SlzOB/iCjgb4OM4VzhXbI2055f++WOcRWaU5w9m8nvM+o3HCdgsm6dmZGpcORlCNq46Jw14B+q44yP9K6gO766P66knxTzXfXclfi72xoc3JeyterRRndjFXq48XqCzov3O30XoYpf+2UHwFUzHIg3BxG/hGpY2IkYZO5/znrRGI7+bKuPMbwo4MXMtZH2vHgxGW8inznqD14qZ5ZGAqY/e8z2MJ8/WA+bGHsdEBfpWdyNJ5hsUE3YslNSxmh9ccGD9w11JyxVzQdJYpO564ZtTxLlTpHjekqlG23NEttF2o63QyetQph39WqENlFc96ScVd17HjG7OlvE38jbNU1SIkUMLUctv+bN3wD36gDtVqPhfbgtbq5OQ1zRkcfo3wPTuPEJ7uMa2FVDE1FYo7N/KUGvReFoxzzNN0XblWeXHWCj138sMGFZjhUUWVAkdl8SmQZxvX5yNumV5hwDD2YTbiRZScw1FMjTRkowYFtUGDc9wpPXN586c+SGjSY6DUT/WYWv2V3ffEq/zNNxP/ZhJEGMqgJCl5RkFllp9JioviPV2HlT4iIVUKR4UFIT3ay0g2bnTqXdG4mmsdUJLlfqhN35UedpIMf7Lb12hp4n3rlayQ+mUAUS5YZsQLfA1t+uZVip1pU+7tvosfueE/f5RlTg7ZVjxl0aFJ+TUPnDo1iHxq5wOY/vzn/ELJ7YFMbfBF3gzuNTrbtIXRKSxej5cixvugtVY9KEGav1GK6r+5Op8v8CAAD//8Sus/0="
+	return "eNrtXd1z2zYSf+9fgelLko6rXtObe8jcdCZ1mjbTOPXVTmfuiYVISEINEiwB2lH/+tvFBwlKlEjxQ647l5vp2ZYI/PYTu4sF+CW5Y9tXZB3nnxGiuRbsFXn2g5RrwcilkGVCrgXVK1mkz+ALBROMKvjKmsJvCVNxwXPNZfaKfAt/IOSHy2uSyqQUDH5dcSYS9cp88CXJaMr8RPhPb3P8vZCl/0v4/fAZQZdMqOrP/lG5/J3FOvhzCx7/z+LKuJYFz9YkZbrgsdofeRdCCKNUrFh80fjoIBT8Z/8Y2W8Alx9kkbQODGhoQjWda3AkdZax1VZpls4ydMGULIuYTTa4H/jziiH2f59361Vj3ESWS6PdLZ9GKc1z0C/31c8bgx/RziunjnpDNVCuyyJjCVkVMiUNU3x9/Y78UbJiu9gja8mFgJkPzdcY5jv7Xa8awTNN+26yJbTUdlPxWGKpLD8+2xdcm8wbSC/hYfNdRXgWizJhgGpdClpcEE0/XRCa/F4qnbJMw89ZQgBWliDTWVHIYtGCh2f3kscgHZnpzRBMnmEFy2WhiRmnbaK8kEYXeDJklmv7NHn3hsgV6ALzQvXzgq+S2RrURLZNrqWmomXelZBUH571Fh+rZqIpcFPvq1dMi6XMemnXpfkqeSulzsHZ6mNqtgTPN0LRJuM3MtuSSFYV7l5c9xDwtzEg8PkRMGBpukcVH8aJG/s0cgJW+nYYpaJrdmzqYMQxGII/DgADrmLg/L+YJ1um7JixArVQsczZ16db4A0+R77eo7LHbC8XQsb0AMt7TfuyjbnoCfzAXy7BUhM04o1MpJDrbS9cKS3umJ4clR12EKZvhoL55kTJyNVKMa2GumI3mRulxRXLNC816+eL7XdnW+lXvGAPVIhFUsg8Z8liudWsjXL0XYcJf5cBUShe8zhxg8FvxhT9JD3mj3Iag36oKDbL2D0VJRuOxg12Ah6eKU0ziFrjvFxACAs+DUDFEn48CGYvoNyB86FMl6zAkMCMQ/ywBJQE4WwwYHIBg5+/C5rxZpHmKVsoFg8A9REHMM4SGOGA8QzWglhmieo1/SKP9YCZb1ECBY3NAuHIBgzorYAnl9cfbRTNFYnLooAoUWwRGSRvnmF9mJRwdQcCpEM1+hL1D+FZjcaRbDyPA/eaOJL5ODWuIOCQFsG7nwk4tML4ddWJ4qHgmk1DPw6lGbBf9mOAmXpiDpgx+7MgZamERGuJuiWzRUHTSPE/2UAoqLUmQHcRNqKyM6Byolb+erWAb4HaWl+NCiwz0F16T7mgYBLG2n69clmiDQeRofgwe0lWNOViuziRJDCLZCBJVxZ+bWU41uNRox5oHvFsoL6ifPYkY2wGVnSLal0ycLTGiDksCvIB3B3MSRQsE+xRqJWlnpJcb6SGxJpigPkI9GZMQ7x+t4B1GNYWNZUbjhm/96UVBOOmOQWJiwoWxjMNR+SDi1GY2ITMUQx/u2fFQBAT8+VkOGV+JJ45PnkdYrkAxvkwsqEKtBdsoiizDBRg0Q0gMm5+EIzvBc3Rh+IwBNKdmHkcD4ADZikgxLkI4qwFuaFpLuARBozakn/9o/7k9UoDSQo/B+AXBAvMaKiZ1OSeK+6ttMzRML9+WT+6l3Hgo3kh414pxxv35clzjiADEqUC4habZKUWCC6TgKdF8Q6yfS/iTzjGj1buWaUNP755e2MI+oATWGdGC+a1AUXPE/CkFaJuuErLAmPvmIKic71tWXyPRMIHcfvhKtS2NF8hhdDXwUD1+eG7E5CWmgv+pwmYRoHFNQcirxjsGnOHCqidZieO6IGvzDaMCr3ZRkuI/u/mkH81BbFTeIGbAlEfkf8ul4sVrIlA0PToYHCnkxt6D8Zs5kFJ0/7gvF+bE11oMaehU+UyxZjkDNwzXr6aD11iT5xVNjGfoOuEZYy4a6DzCX0X6TDR10jnVIBWrg5Wgy0tskVVh4hsSB3V/m5i1/nf1798qBJIVRdA+oDM8zl8JVZkgIEGGEwhuC0fqx6IIOzQlGesmAOXARTM0A3Hye5Atj9kdUZEocRw6D7rsIGDwU1KMxD8fPzB+ObKzbEb3fRX/5zZjdipGIhq78KCOiX/A5PSC8u9i6rW56ZGg12CbyzFigtRl2xVvGHYlNKHinte6JIKV7WdnuFu/LpoihLoXgux7C8YDm3rtocZ290bAam7luuCpl3cN5mIXSmlvEPuOhisTlfNd/AH7I9xvhM8Ej7mU37zlSoxsBuaQLqt0roBk24WJGXRFYbOQrtZGVSOashxEVuDo8swH9Os70L2eNILl7mTZVg9PKEka6Y8gjwDbvSSarjRhJlKv223t/7bMybBiYxLbIJZJAyZPsJR3TbckyrjmCkFLrSagtgpDqydFRCzXTInDJxAWaXFLigO7lMWMK68K/MucHYzY050ZoaW6sn67qDSPPsNPvzNRyd2oUjDXrAHXLoKmlfdYJfX5EbT+C4pOJbGsCHMPW12x/c7HLHEg0/98NP3z2Zo9HJhldnVwwUtGr2zeFmmpaAmlsTNPDOeyR2qfUazz+AW9gpBv6pYJw2Cg+cbuWGLsC1SM5rfpKwnGo0vLMlMt4uKuC3ivjuobhfAMIrENMPaIvsUMyDta0KVE17zA3zezDJd8fLlP0/goIsgJ9uUr6XtRm5RzpnrtF2kzqMuNbmnbLrvqgyG62tw7NoU+uH3puKEWuMmfAS9YfmGpRA4iMhVK60dDtx3eQ+puiDVmFUF1NoesM0MO9RL7GP1fJsWrRt1Brym/jstWKtPY6G6Pdgxsneb5u0Lw6QAZ7L6NCTgia0TvZ2n42OOocyKlkIP3NSsV4nctEvhUOqCLAt5B6lGglvquFTASBckpb9jN1WWkJRn7X3sewDHWfZVo6QySBtnFsC5FjCn1H/rNcyxdIRzdfoyjS/FBJkW4w0LMw21o7i4a+/GB2LPr71HOxE6NRXLFHUyY5Vyn75GV8JJMsB6sgkP3aYB9utMFg0HYwaRsbMfM/N4rPN4g13k/Z3BmUy54sVZ8+xAdINT7Aq5aY0ZqWu2ST5ryb/Gadl+HBpoxMThqG24CjVuauzctMREq4IN7Vh9C48GbLYDIk7RTtSUsG0L1cBO2x39OA9wi3hgM6ZBfEhLptaMGbIrCzQsyw1E62KU8YYXODcX282I8QwL0sgAtU++1TLdeaP/kLtjzKkORpwBVQ3O0znc8cH0jBrq+3J9Z3Fk5TiwL7fGme0wtupcDuDudeyOwI8dwHNh1wXNlGvymRh+zpPIFi6GHxVJ6Sdybc+B/3wzUlcRz4hjHs2NL98bgtu/TCl/3GMsyCihYATZo20jGdkLQCt846wFNCrk9URNnHn7lpZdnAPS8Vwme95ivD/r8hMw60g7C3Gjl5gec4t/mAb2vRRlOkW8WCM2p9tcSlEdwHH9GjjluRbwgLwRuv6hja7QVo+T1IFrlkjNDt4SnS0Z+soQ/LEI7XgVcac07g4TVvJ2GNzhrLMcCxGSJksqgJy+F8+8hyfId/6R2U6lb7TO1WKJB4myJBpXJG8uf8FJKVpt+LqWlB9vb6+/ujFcIZYtKEhJHI5W1WxHOiza2VmoPTZ3WBz0zwMxRy9awPYBqHKZKTYLL+3QjpkV1uegizGNN+wF8pJ9Au3NQPER//ObF30JOJcOxILD37HT7kQOzyz6U8GcS8yHbMbxsQ2i+GbhtWDc8ceDMA2yStNuL6+/+vjm2q/4O1idntaY/ZqwEvJhQd6C9sIA5jdsk3yGXZIg4fquCwxJ6255ojSsAKk5TduP+GjcscsmExpnL6djQwch4474HhejQxO6jl6UzCs6R/HMshtMezt6ns1vde/ef9eiS89XI2Txoh85M8uinbBuI6lQnsdIQpjzcv3cJhBQ1np5XpxHSokoL+Sn7SIWUpl7e7KMxfYU07DiSXh3TzWWb+0tIIpnRcozc3ONSS3RPm9u3hMLoxPnKEv8cFAffr0KdNTeu9UT0Dgt/dBDQwHbSYgy9nAGOcYmbxsmRJmzbAKIl+6gVHDAsdSYbJpzUU3YkEmtN8b1dGJtJgACqITc7cj2bcuBjR7HNRqUvMbMHzLDZRkm2XbqLSQDIsaqjW+Jf9iwLGytsXclICd8vQBJ8/UQ79zQjYng4/1bOCpvbeZW2uikDa9ddHyQW9U6b28jxELP//k3in+2ceCpcG+v4GlYtMuytdTk9eVPDQeHu9TIK88jw7TDjIJHYT0FvUKPUmh9fr78cntLUkZVWSBHIGBgkKsH3gZveH3AViVHIPY3dviaKkR4ykbjiWjPcGczKdLN1adtSn34OoOhPW2mNapm52BamOuCW3pMxpnbwQn8ObcHQB0jL2qv5WO2RozkrwGuWNeaMXclOY9Ie8MxHySxMidfGGiqhsn8uiPYx1+GVCol/H9iyH7OM5KqFzX54TIMSSwyQuEhygu7WkEGhnfkhpmsoFush5gsJKfKVSsrt23tIdwRad+4MK/W2N/laN/PGHKL76E3ZuTlUpXLXoNfl8ubcjnbXozKaK42UpvVXMj1qD1Ps96ZyzOq0ygK98fQ5Zne6MQe5a4m7QHIdpjAfyMbbZ4V4N5ZECsTh+QYerDkFV9HZZ7QSRpkYn//nh3Yncwn8YZmAP/CStzaUnApAMxh38HAFJ6SOQYY8tLIc2N0Fj5G7iGQQOwzQxouaSkSPAPjxo+wh3PI5YKv18x4Rred+8JrqB3ewx/C0H2AAVvPDvV0RoOv9HMvwCd4Qia0qUo3YPhMPgiWrK0pva5/r7btGraWMMHNHj5O3Yl+Fh9bZg3UFS3P6eJuQRfETVp98MLJIwTWCRwmjfC1MeM7eWJ3HXp900cQ69VtUqb9grvOEljS3VfIHyVwgYR9Il3Yz+uJ6yQ7BDHCP4ekJHjvuGAagsI5rQCiE8HVxjIe5yR2Tvg153GDlp7AU5lEaLo4mACfMCf6h41UjPiZTMJlZW8AX8mEr7Zg12/8Fyaw60PkReEFQ1MRuk+Bd7ihSU0go3E9D/2gtzK/J2AMFIIa+gzRi9MCf9FgOPszNBO1IZBW5ZLju64g4zFdWlumG+tILzogCfZzTUfHOVYGF1z4tR41qCZhrmCojazdcGNSIkbHodMSNC6GctQa7ZhfVu1KeEgHR6hgCz1zi+0AbRPLKy+FiILAd5ZVJSCk53piOnAojLziGffFnrZHFQtqGV/VxYwGlV91r6HuXSO92TX9+kW70oGeK5eBOKc0cYK/gxinF6HhzDjZqc0suCCYoBBlp3k7LvIxE/yOGQLUhS2Y4jOmibQgHBvK8Z47m1kkktme8SXVkIuYayOruILcSJuiVFeL4MtBqlvuZMYaDyzs/cbBZAUK3vYXmZd6onbI6orG8FlzvwbNc0bhT0AGz4W96VF1Mrq5Gk+bM7fHRSMiiJ3QYdaa5angx60+YPZzuFIc9qi+d+PK9JxppN8tbro8yAMcCoyxXRv0iMzRtuRh9nKWVf7GT3eNbvDvs+a3sHEGlfWTNBcR8sD1Bnxt9iXq8rbBVZ4M0+0mOXNqxA5R51KDf8cyYd8OUoZTmXfO2kzTvvbLHeMqNF2UPZrK7xNqNxwnILJqnZmRKHfkZQhan3TOuuAfquOMX+lNQXd8dX9cST8o5pvquSnxq8Nwn8rWqkXr3Q0K9HDj9QSdF292+i5cFb/yywaAqWYYEC8OIn4K1TC3EzFI3f+a9aIxFP3Vso8xtChsVJq3NtK0iycT8Qb8maP+YLlyYmnEYfqrr9kW5uMv1sMMY68D4iw9i7vrCUxMqomRnJQLUBl/ccGt9Q1VJyxX9QtJfJO56YZtGokyp8hpZVLhRludYpuVtuVp10kLA2RtvRquTZRWPSknVfceh82mzubx1/w2TZM8LqRfWo5qvqcNX8OxPdRm+It5yFw3cPWf94bF14VM4Uf/9o6iBKVdY1FQN5sSr6oXWbQ1JIK4x7Qk4n9beH3Htg+ySI5sfNKUBbckm1AO2GN4YLUgL2RSxu6GDnbP2v2s9dtDEFxZj585INTz04oHq6ccXZamd/41zvgan9+iCJ+Iot9A3ksmWruW8QO1+GKADnaifm/GJjwBfkAaZ17T5S1XOUVguiyyulXX8LQNpzGU3jgbMH41j4YN9aB1Zm/d/+0ERJVx2ysQe3Xa3rjrEudqtaU5H3mPxBsmNK0XRfN2GWq2AwJfgNzAT4CMjUysMrpExZ4KwNJLm+xoqTd/wvoioiXF47VOi6h5lc40iKsCgjFHZxa4MmZOPdyVPOAyM2zYsXMTJQUTW5KUZn1w33x9+V4dJ6MOzwai/6jc6xxhrvDVVC0XxB9G4tiochbzFY8jRJaWekxYu8NV33qW0iRkkJ/xIKcmulpsB83OpWK7V3JNo6sT3C7WCrv1rebTQPYXvFrpDD1Qv3O3mB0M369YkGWJx+sbYKuXNwuqVONOK/ihPn6CbzLHERK6vTDEmFuz/PcKltvT02CytrPR3U5lj6fA9/yRZVnaq9BhnGMcsKe1TNXJh49PR/Omu1HYZw145aGXo7uv8S8myv8B0bqZDw=="
 }
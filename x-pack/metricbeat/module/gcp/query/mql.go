@@ -0,0 +1,122 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"google.golang.org/api/iterator"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/gcp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// queryMQL runs a Monitoring Query Language query and creates an event for each time series returned.
+func (m *MetricSet) queryMQL(ctx context.Context, q queryConfig) ([]mb.Event, error) {
+	req := &monitoringpb.QueryTimeSeriesRequest{
+		Name:  "projects/" + m.config.ProjectID,
+		Query: q.MQL,
+	}
+
+	var events []mb.Event
+	it := m.queryClient.QueryTimeSeries(ctx, req)
+	for {
+		series, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return events, fmt.Errorf("could not read time series: %w", err)
+		}
+
+		// the descriptor of the columns is part of each page of the response
+		resp, ok := it.Response.(*monitoringpb.QueryTimeSeriesResponse)
+		if !ok || resp.TimeSeriesDescriptor == nil {
+			return events, fmt.Errorf("query response contains no time series descriptor")
+		}
+		for _, partialErr := range resp.PartialErrors {
+			m.logger.Warnf("query %s returned partial results: %s", q.Name, partialErr.GetMessage())
+		}
+
+		if event, ok := mqlEvent(m.config.ProjectID, q.Name, resp.TimeSeriesDescriptor, series); ok {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// mqlEvent maps the most recent point of a time series to an event, the labels
+// and values are named after the columns of the descriptor.
+func mqlEvent(projectID, queryName string, descriptor *monitoringpb.TimeSeriesDescriptor, series *monitoringpb.TimeSeriesData) (mb.Event, bool) {
+	var latest *monitoringpb.TimeSeriesData_PointData
+	for _, point := range series.PointData {
+		if point.TimeInterval == nil {
+			continue
+		}
+		if latest == nil || point.TimeInterval.EndTime.AsTime().After(latest.TimeInterval.EndTime.AsTime()) {
+			latest = point
+		}
+	}
+	if latest == nil {
+		return mb.Event{}, false
+	}
+
+	labels := mapstr.M{}
+	for i, label := range series.LabelValues {
+		if i >= len(descriptor.LabelDescriptors) {
+			break
+		}
+		if value := labelValue(label); value != nil {
+			labels[descriptor.LabelDescriptors[i].Key] = value
+		}
+	}
+
+	values := mapstr.M{}
+	for i, value := range latest.Values {
+		if i >= len(descriptor.PointDescriptors) {
+			break
+		}
+		if v := typedValue(value); v != nil {
+			values[descriptor.PointDescriptors[i].Key] = v
+		}
+	}
+
+	event := newEvent(projectID, queryName, labels, values)
+	event.Timestamp = latest.TimeInterval.EndTime.AsTime()
+	return event, true
+}
+
+func labelValue(label *monitoringpb.LabelValue) interface{} {
+	switch v := label.GetValue().(type) {
+	case *monitoringpb.LabelValue_BoolValue:
+		return v.BoolValue
+	case *monitoringpb.LabelValue_Int64Value:
+		return v.Int64Value
+	case *monitoringpb.LabelValue_StringValue:
+		return v.StringValue
+	}
+	return nil
+}
+
+func typedValue(value *monitoringpb.TypedValue) interface{} {
+	switch v := value.GetValue().(type) {
+	case *monitoringpb.TypedValue_DoubleValue:
+		return v.DoubleValue
+	case *monitoringpb.TypedValue_BoolValue:
+		return v.BoolValue
+	case *monitoringpb.TypedValue_Int64Value:
+		return v.Int64Value
+	case *monitoringpb.TypedValue_StringValue:
+		return v.StringValue
+	case *monitoringpb.TypedValue_DistributionValue:
+		return mapstr.M{
+			"histogram": gcp.DistributionHistogramToES(v.DistributionValue),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// promResponse is the response of the Prometheus HTTP API instant query endpoint.
+type promResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// promSeries is a single time series of a vector or matrix result.
+type promSeries struct {
+	Metric map[string]string `json:"metric"`
+	Value  promSample        `json:"value"`
+	Values []promSample      `json:"values"`
+}
+
+// promSample is a [timestamp, "value"] pair.
+type promSample []interface{}
+
+// queryPromQL runs a PromQL instant query and creates an event for each time series returned.
+func (m *MetricSet) queryPromQL(ctx context.Context, q queryConfig) ([]mb.Event, error) {
+	endpoint := strings.TrimSuffix(m.config.PrometheusEndpoint, "/") +
+		"/projects/" + url.PathEscape(m.config.ProjectID) + "/location/global/prometheus/api/v1/query"
+	form := url.Values{"query": {q.PromQL}}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response: %w", err)
+	}
+	return promEvents(m.config.ProjectID, q.Name, resp.StatusCode, body)
+}
+
+// promEvents maps a Prometheus HTTP API response to events.
+func promEvents(projectID, queryName string, statusCode int, body []byte) ([]mb.Event, error) {
+	var resp promResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("could not decode response with status %d: %w", statusCode, err)
+	}
+	if resp.Status != "success" {
+		return nil, fmt.Errorf("query returned status %d: %s: %s", statusCode, resp.ErrorType, resp.Error)
+	}
+
+	var series []promSeries
+	switch resp.Data.ResultType {
+	case "vector", "matrix":
+		if err := json.Unmarshal(resp.Data.Result, &series); err != nil {
+			return nil, fmt.Errorf("could not decode %s result: %w", resp.Data.ResultType, err)
+		}
+	case "scalar":
+		var sample promSample
+		if err := json.Unmarshal(resp.Data.Result, &sample); err != nil {
+			return nil, fmt.Errorf("could not decode scalar result: %w", err)
+		}
+		series = []promSeries{{Value: sample}}
+	default:
+		return nil, fmt.Errorf("unsupported result type %q", resp.Data.ResultType)
+	}
+
+	var events []mb.Event
+	for _, s := range series {
+		sample := s.Value
+		// matrix results contain all the samples of the range, only the most recent one is reported
+		if len(s.Values) > 0 {
+			sample = s.Values[len(s.Values)-1]
+		}
+		ts, value, ok := sample.parse()
+		if !ok {
+			continue
+		}
+
+		labels := mapstr.M{}
+		var metricName string
+		for k, v := range s.Metric {
+			// the metric name is reported on its own, it is dropped by most aggregations
+			if k == "__name__" {
+				metricName = v
+				continue
+			}
+			labels[k] = v
+		}
+		event := newEvent(projectID, queryName, labels, mapstr.M{"value": value})
+		if metricName != "" {
+			event.MetricSetFields["metric"] = metricName
+		}
+		event.Timestamp = ts
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// parse returns the timestamp and value of the sample, samples with values
+// that cannot be indexed such as NaN or infinity are ignored.
+func (s promSample) parse() (time.Time, float64, bool) {
+	if len(s) != 2 {
+		return time.Time{}, 0, false
+	}
+	seconds, ok := s[0].(float64)
+	if !ok {
+		return time.Time{}, 0, false
+	}
+	str, ok := s[1].(string)
+	if !ok {
+		return time.Time{}, 0, false
+	}
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return time.Time{}, 0, false
+	}
+	sec, frac := math.Modf(seconds)
+	return time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC(), value, true
+}
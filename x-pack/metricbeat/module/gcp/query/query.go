@@ -0,0 +1,198 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	monitoring "cloud.google.com/go/monitoring/apiv3/v2"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/gcp"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const (
+	// metricsetName is the name of this metricset
+	metricsetName = "query"
+
+	// defaultPrometheusEndpoint is the Cloud Monitoring API endpoint serving the Prometheus HTTP API
+	defaultPrometheusEndpoint = "https://monitoring.googleapis.com/v1/"
+
+	// monitoringReadScope is the OAuth scope required to read Cloud Monitoring data
+	monitoringReadScope = "https://www.googleapis.com/auth/monitoring.read"
+)
+
+// init registers the MetricSet with the central registry as soon as the program
+// starts. The New function will be called later to instantiate an instance of
+// the MetricSet for each host defined in the module's configuration. After the
+// MetricSet has been created then Fetch will begin to be called periodically.
+func init() {
+	mb.Registry.MustAddMetricSet(gcp.ModuleName, metricsetName, New)
+}
+
+// MetricSet holds any configuration or state information. It must implement
+// the mb.MetricSet interface. And this is best achieved by embedding
+// mb.BaseMetricSet because it implements all of the required mb.MetricSet
+// interface methods except for Fetch.
+type MetricSet struct {
+	mb.BaseMetricSet
+	config      config
+	queryClient *monitoring.QueryClient
+	httpClient  *http.Client
+	logger      *logp.Logger
+}
+
+type config struct {
+	ProjectID           string        `config:"project_id" validate:"required"`
+	CredentialsFilePath string        `config:"credentials_file_path"`
+	CredentialsJSON     string        `config:"credentials_json"`
+	PrometheusEndpoint  string        `config:"prometheus_endpoint"`
+	Queries             []queryConfig `config:"queries" validate:"required"`
+}
+
+// queryConfig holds a single MQL or PromQL query.
+type queryConfig struct {
+	Name   string `config:"name" validate:"required"`
+	MQL    string `config:"mql"`
+	PromQL string `config:"promql"`
+}
+
+// Validate checks that the credentials are configured.
+func (c config) Validate() error {
+	if c.CredentialsFilePath != "" && c.CredentialsJSON != "" {
+		return errors.New("both credentials_file_path and credentials_json specified, you must use only one of them")
+	}
+	if c.CredentialsFilePath == "" && c.CredentialsJSON == "" {
+		return errors.New("no credentials_file_path or credentials_json specified")
+	}
+	return nil
+}
+
+// Validate checks that exactly one query language is used.
+func (c queryConfig) Validate() error {
+	if (c.MQL == "") == (c.PromQL == "") {
+		return fmt.Errorf("query %s must contain either an mql or a promql query", c.Name)
+	}
+	return nil
+}
+
+// New creates a new instance of the MetricSet. New is responsible for unpacking
+// any MetricSet specific configuration options if there are any.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The gcp '%s' metricset is beta.", metricsetName)
+
+	m := &MetricSet{
+		BaseMetricSet: base,
+		config:        config{PrometheusEndpoint: defaultPrometheusEndpoint},
+		logger:        logp.NewLogger(metricsetName),
+	}
+
+	if err := base.Module().UnpackConfig(&m.config); err != nil {
+		return nil, fmt.Errorf("unpack query config failed: %w", err)
+	}
+
+	ctx := context.Background()
+	var hasMQL, hasPromQL bool
+	for _, q := range m.config.Queries {
+		hasMQL = hasMQL || q.MQL != ""
+		hasPromQL = hasPromQL || q.PromQL != ""
+	}
+
+	if hasMQL {
+		var opt []option.ClientOption
+		if m.config.CredentialsFilePath != "" {
+			opt = append(opt, option.WithCredentialsFile(m.config.CredentialsFilePath))
+		} else {
+			opt = append(opt, option.WithCredentialsJSON([]byte(m.config.CredentialsJSON)))
+		}
+		client, err := monitoring.NewQueryClient(ctx, opt...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating Cloud Monitoring query client: %w", err)
+		}
+		m.queryClient = client
+	}
+
+	if hasPromQL {
+		credentialsJSON := []byte(m.config.CredentialsJSON)
+		if m.config.CredentialsFilePath != "" {
+			var err error
+			if credentialsJSON, err = os.ReadFile(m.config.CredentialsFilePath); err != nil {
+				return nil, fmt.Errorf("error reading credentials file: %w", err)
+			}
+		}
+		credentials, err := google.CredentialsFromJSON(ctx, credentialsJSON, monitoringReadScope)
+		if err != nil {
+			return nil, fmt.Errorf("error loading credentials: %w", err)
+		}
+		m.httpClient = oauth2.NewClient(ctx, credentials.TokenSource)
+	}
+
+	m.Logger().Warn("extra charges on Google Cloud API requests will be generated by this metricset")
+	return m, nil
+}
+
+// Fetch methods implements the data gathering and data conversion to the right
+// format. It publishes the event which is then forwarded to the output. In case
+// of an error set the Error field of mb.Event or simply call report.Error().
+func (m *MetricSet) Fetch(ctx context.Context, reporter mb.ReporterV2) error {
+	for _, q := range m.config.Queries {
+		var events []mb.Event
+		var err error
+		if q.MQL != "" {
+			events, err = m.queryMQL(ctx, q)
+		} else {
+			events, err = m.queryPromQL(ctx, q)
+		}
+		if err != nil {
+			// a failing query should not prevent the other queries from being reported
+			err = fmt.Errorf("query %s failed: %w", q.Name, err)
+			m.logger.Error(err)
+			reporter.Error(err)
+			continue
+		}
+
+		m.logger.Debugf("Total %d of events are created for query %s", len(events), q.Name)
+		for _, event := range events {
+			reporter.Event(event)
+		}
+	}
+	return nil
+}
+
+// Close closes the Cloud Monitoring query client.
+func (m *MetricSet) Close() error {
+	if m.queryClient != nil {
+		return m.queryClient.Close()
+	}
+	return nil
+}
+
+// newEvent creates the event reporting a single time series returned by a query.
+func newEvent(projectID, queryName string, labels, values mapstr.M) mb.Event {
+	event := mb.Event{
+		MetricSetFields: mapstr.M{
+			"name":   queryName,
+			"values": values,
+		},
+		RootFields: mapstr.M{
+			"cloud.provider":   "gcp",
+			"cloud.account.id": projectID,
+		},
+	}
+	if len(labels) > 0 {
+		event.MetricSetFields["labels"] = labels
+	}
+	return event
+}
@@ -0,0 +1,177 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/label"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		config        config
+		expectedError error
+	}{
+		{
+			name:          "with an empty config",
+			config:        config{},
+			expectedError: errors.New("no credentials_file_path or credentials_json specified"),
+		},
+		{
+			name:          "with both credentials",
+			config:        config{CredentialsFilePath: "credentials.json", CredentialsJSON: "{}"},
+			expectedError: errors.New("both credentials_file_path and credentials_json specified, you must use only one of them"),
+		},
+		{
+			name:   "with credentials",
+			config: config{CredentialsJSON: "{}"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+func TestQueryConfigValidate(t *testing.T) {
+	assert.NoError(t, queryConfig{Name: "cpu", MQL: "fetch gce_instance::compute.googleapis.com/instance/cpu/utilization"}.Validate())
+	assert.NoError(t, queryConfig{Name: "requests", PromQL: "sum(rate(http_requests_total[5m]))"}.Validate())
+	assert.EqualError(t, queryConfig{Name: "empty"}.Validate(), "query empty must contain either an mql or a promql query")
+	assert.Error(t, queryConfig{Name: "both", MQL: "fetch", PromQL: "up"}.Validate())
+}
+
+func TestMQLEvent(t *testing.T) {
+	descriptor := &monitoringpb.TimeSeriesDescriptor{
+		LabelDescriptors: []*label.LabelDescriptor{
+			{Key: "resource.instance_id"},
+			{Key: "resource.zone"},
+		},
+		PointDescriptors: []*monitoringpb.TimeSeriesDescriptor_ValueDescriptor{
+			{Key: "value.utilization"},
+		},
+	}
+	older := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Minute)
+	series := &monitoringpb.TimeSeriesData{
+		LabelValues: []*monitoringpb.LabelValue{
+			{Value: &monitoringpb.LabelValue_StringValue{StringValue: "1234"}},
+			{Value: &monitoringpb.LabelValue_StringValue{StringValue: "us-central1-a"}},
+		},
+		PointData: []*monitoringpb.TimeSeriesData_PointData{
+			{
+				Values:       []*monitoringpb.TypedValue{{Value: &monitoringpb.TypedValue_DoubleValue{DoubleValue: 0.5}}},
+				TimeInterval: &monitoringpb.TimeInterval{EndTime: timestamppb.New(older)},
+			},
+			{
+				Values:       []*monitoringpb.TypedValue{{Value: &monitoringpb.TypedValue_DoubleValue{DoubleValue: 0.75}}},
+				TimeInterval: &monitoringpb.TimeInterval{EndTime: timestamppb.New(newer)},
+			},
+		},
+	}
+
+	event, ok := mqlEvent("project", "cpu", descriptor, series)
+	require.True(t, ok)
+	assert.Equal(t, newer, event.Timestamp)
+	assert.Equal(t, mapstr.M{
+		"name": "cpu",
+		"labels": mapstr.M{
+			"resource.instance_id": "1234",
+			"resource.zone":        "us-central1-a",
+		},
+		"values": mapstr.M{
+			"value.utilization": 0.75,
+		},
+	}, event.MetricSetFields)
+	assert.Equal(t, mapstr.M{"cloud.provider": "gcp", "cloud.account.id": "project"}, event.RootFields)
+
+	_, ok = mqlEvent("project", "cpu", descriptor, &monitoringpb.TimeSeriesData{})
+	assert.False(t, ok)
+}
+
+func TestPromEvents(t *testing.T) {
+	t.Run("vector", func(t *testing.T) {
+		body := `{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"__name__":"up","job":"api"},"value":[1677664800.5,"1"]},
+			{"metric":{"job":"web"},"value":[1677664800,"NaN"]}
+		]}}`
+		events, err := promEvents("project", "up", http.StatusOK, []byte(body))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, time.Date(2023, 3, 1, 10, 0, 0, int(500*time.Millisecond), time.UTC), events[0].Timestamp)
+		assert.Equal(t, mapstr.M{
+			"name":   "up",
+			"metric": "up",
+			"labels": mapstr.M{"job": "api"},
+			"values": mapstr.M{"value": 1.0},
+		}, events[0].MetricSetFields)
+	})
+	t.Run("matrix reports the most recent sample", func(t *testing.T) {
+		body := `{"status":"success","data":{"resultType":"matrix","result":[
+			{"metric":{"job":"api"},"values":[[1677664740,"1"],[1677664800,"2"]]}
+		]}}`
+		events, err := promEvents("project", "requests", http.StatusOK, []byte(body))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, mapstr.M{"value": 2.0}, events[0].MetricSetFields["values"])
+	})
+	t.Run("scalar", func(t *testing.T) {
+		body := `{"status":"success","data":{"resultType":"scalar","result":[1677664800,"42"]}}`
+		events, err := promEvents("project", "answer", http.StatusOK, []byte(body))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, mapstr.M{
+			"name":   "answer",
+			"values": mapstr.M{"value": 42.0},
+		}, events[0].MetricSetFields)
+	})
+	t.Run("error", func(t *testing.T) {
+		body := `{"status":"error","errorType":"bad_data","error":"parse error"}`
+		_, err := promEvents("project", "invalid", http.StatusBadRequest, []byte(body))
+		assert.EqualError(t, err, "query returned status 400: bad_data: parse error")
+	})
+}
+
+func TestQueryPromQL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/projects/project/location/global/prometheus/api/v1/query", r.URL.Path)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "sum(up)", r.PostForm.Get("query"))
+		_, _ = io.WriteString(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1677664800,"3"]}]}}`)
+	}))
+	defer server.Close()
+
+	m := &MetricSet{
+		config: config{
+			ProjectID:          "project",
+			PrometheusEndpoint: server.URL + "/v1/",
+		},
+		httpClient: server.Client(),
+		logger:     logp.NewLogger("test"),
+	}
+	events, err := m.queryPromQL(context.Background(), queryConfig{Name: "up", PromQL: "sum(up)"})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, mapstr.M{"value": 3.0}, events[0].MetricSetFields["values"])
+}
@@ -0,0 +1,190 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package availability_group
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// replicaStatesQuery returns one row for each database replica of the
+// availability groups known to the instance. On a primary replica this
+// includes the databases of the secondary replicas, on a secondary replica
+// only the local databases are returned.
+const replicaStatesQuery = `SELECT ag.name,
+       ar.replica_server_name,
+       adc.database_name,
+       drs.is_local,
+       ars.role_desc,
+       ar.availability_mode_desc,
+       ar.failover_mode_desc,
+       ars.operational_state_desc,
+       ars.connected_state_desc,
+       ars.synchronization_health_desc,
+       drs.synchronization_state_desc,
+       drs.synchronization_health_desc,
+       drs.is_suspended,
+       drs.log_send_queue_size,
+       drs.log_send_rate,
+       drs.redo_queue_size,
+       drs.redo_rate,
+       drs.last_commit_time
+FROM   sys.dm_hadr_database_replica_states drs
+       JOIN sys.availability_groups ag
+         ON ag.group_id = drs.group_id
+       JOIN sys.availability_replicas ar
+         ON ar.replica_id = drs.replica_id
+       JOIN sys.availability_databases_cluster adc
+         ON adc.group_database_id = drs.group_database_id
+       LEFT JOIN sys.dm_hadr_availability_replica_states ars
+         ON ars.replica_id = drs.replica_id`
+
+type replicaState struct {
+	groupName         string
+	replicaServerName string
+	databaseName      string
+	isLocal           bool
+	role              sql.NullString
+	availabilityMode  string
+	failoverMode      string
+	operationalState  sql.NullString
+	connectedState    sql.NullString
+	replicaHealth     sql.NullString
+	syncState         sql.NullString
+	syncHealth        sql.NullString
+	isSuspended       sql.NullBool
+	logSendQueueKB    sql.NullInt64
+	logSendRateKB     sql.NullInt64
+	redoQueueKB       sql.NullInt64
+	redoRateKB        sql.NullInt64
+	lastCommitTime    sql.NullTime
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("mssql", "availability_group", New,
+		mb.WithHostParser(mssql.HostParser))
+}
+
+// MetricSet type defines all fields of the MetricSet
+type MetricSet struct {
+	mb.BaseMetricSet
+	log *logp.Logger
+	db  *sql.DB
+}
+
+// New create a new instance of the MetricSet
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	logger := logp.NewLogger("mssql.availability_group").With("host", base.HostData().SanitizedURI)
+
+	db, err := mssql.NewConnection(base.HostData().URI)
+	if err != nil {
+		return nil, fmt.Errorf("could not create connection to db: %w", err)
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		log:           logger,
+		db:            db,
+	}, nil
+}
+
+// Fetch reports one event for each database replica of the availability
+// groups of the instance. Nothing is reported by instances that are not
+// part of an availability group.
+func (m *MetricSet) Fetch(reporter mb.ReporterV2) {
+	rows, err := m.db.Query(replicaStatesQuery)
+	if err != nil {
+		reporter.Error(fmt.Errorf("error querying availability group replica states: %w", err))
+		return
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			m.log.Errorf("error closing rows: %s", err)
+		}
+	}()
+
+	for rows.Next() {
+		var r replicaState
+		if err = rows.Scan(&r.groupName, &r.replicaServerName, &r.databaseName, &r.isLocal, &r.role,
+			&r.availabilityMode, &r.failoverMode, &r.operationalState, &r.connectedState, &r.replicaHealth,
+			&r.syncState, &r.syncHealth, &r.isSuspended, &r.logSendQueueKB, &r.logSendRateKB,
+			&r.redoQueueKB, &r.redoRateKB, &r.lastCommitTime); err != nil {
+			reporter.Error(fmt.Errorf("error scanning row results: %w", err))
+			continue
+		}
+
+		if isReported := reporter.Event(mb.Event{
+			ModuleFields: mapstr.M{
+				"database": mapstr.M{
+					"name": r.databaseName,
+				},
+			},
+			MetricSetFields: r.toMapStr(),
+		}); !isReported {
+			m.log.Debug("event not reported")
+			return
+		}
+	}
+	if err = rows.Err(); err != nil {
+		reporter.Error(fmt.Errorf("error reading row results: %w", err))
+	}
+}
+
+// Close the connection to the server at the engine level
+func (m *MetricSet) Close() error {
+	return m.db.Close()
+}
+
+func (r replicaState) toMapStr() mapstr.M {
+	event := mapstr.M{
+		"name": r.groupName,
+		"replica": mapstr.M{
+			"server_name":       r.replicaServerName,
+			"is_local":          r.isLocal,
+			"availability_mode": r.availabilityMode,
+			"failover_mode":     r.failoverMode,
+		},
+	}
+
+	putString(event, "replica.role", r.role)
+	putString(event, "replica.operational_state", r.operationalState)
+	putString(event, "replica.connected_state", r.connectedState)
+	putString(event, "replica.synchronization_health", r.replicaHealth)
+	putString(event, "database_replica.synchronization_state", r.syncState)
+	putString(event, "database_replica.synchronization_health", r.syncHealth)
+	if r.isSuspended.Valid {
+		_, _ = event.Put("database_replica.suspended", r.isSuspended.Bool)
+	}
+	putKilobytes(event, "database_replica.log_send_queue.bytes", r.logSendQueueKB)
+	putKilobytes(event, "database_replica.log_send_rate.bytes_per_sec", r.logSendRateKB)
+	putKilobytes(event, "database_replica.redo_queue.bytes", r.redoQueueKB)
+	putKilobytes(event, "database_replica.redo_rate.bytes_per_sec", r.redoRateKB)
+	if r.redoQueueKB.Valid && r.redoRateKB.Valid && r.redoRateKB.Int64 > 0 {
+		_, _ = event.Put("database_replica.redo_queue.estimated_time.sec", float64(r.redoQueueKB.Int64)/float64(r.redoRateKB.Int64))
+	}
+	if r.lastCommitTime.Valid {
+		_, _ = event.Put("database_replica.last_commit_time", r.lastCommitTime.Time)
+	}
+
+	return event
+}
+
+func putString(event mapstr.M, key string, v sql.NullString) {
+	if v.Valid {
+		_, _ = event.Put(key, v.String)
+	}
+}
+
+// putKilobytes puts a size or rate reported in kilobytes by SQL Server in bytes.
+func putKilobytes(event mapstr.M, key string, v sql.NullInt64) {
+	if v.Valid {
+		_, _ = event.Put(key, v.Int64*1024)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+//go:build integration
+// +build integration
+
+package availability_group
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/tests/compose"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+	mtest "github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql/testing"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+func TestFetch(t *testing.T) {
+	logp.TestingSetup()
+	service := compose.EnsureUp(t, "mssql")
+
+	f := mbtest.NewReportingMetricSetV2(t, mtest.GetConfig(service.Host(), "availability_group"))
+	events, errs := mbtest.ReportingFetchV2(f)
+	if len(errs) > 0 {
+		t.Fatalf("Expected 0 error, had %d. %v\n", len(errs), errs)
+	}
+
+	// The test instance is not part of an availability group.
+	assert.Empty(t, events)
+}
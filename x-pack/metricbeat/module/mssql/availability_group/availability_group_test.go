@@ -0,0 +1,97 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package availability_group
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestReplicaStateToMapStr(t *testing.T) {
+	lastCommit := time.Date(2023, 5, 4, 10, 30, 0, 0, time.UTC)
+
+	t.Run("local secondary", func(t *testing.T) {
+		r := replicaState{
+			groupName:         "ag1",
+			replicaServerName: "sql2",
+			databaseName:      "sales",
+			isLocal:           true,
+			role:              sql.NullString{String: "SECONDARY", Valid: true},
+			availabilityMode:  "SYNCHRONOUS_COMMIT",
+			failoverMode:      "AUTOMATIC",
+			operationalState:  sql.NullString{String: "ONLINE", Valid: true},
+			connectedState:    sql.NullString{String: "CONNECTED", Valid: true},
+			replicaHealth:     sql.NullString{String: "HEALTHY", Valid: true},
+			syncState:         sql.NullString{String: "SYNCHRONIZED", Valid: true},
+			syncHealth:        sql.NullString{String: "HEALTHY", Valid: true},
+			isSuspended:       sql.NullBool{Bool: false, Valid: true},
+			logSendQueueKB:    sql.NullInt64{Int64: 10, Valid: true},
+			logSendRateKB:     sql.NullInt64{Int64: 100, Valid: true},
+			redoQueueKB:       sql.NullInt64{Int64: 300, Valid: true},
+			redoRateKB:        sql.NullInt64{Int64: 60, Valid: true},
+			lastCommitTime:    sql.NullTime{Time: lastCommit, Valid: true},
+		}
+
+		assert.Equal(t, mapstr.M{
+			"name": "ag1",
+			"replica": mapstr.M{
+				"server_name":            "sql2",
+				"is_local":               true,
+				"availability_mode":      "SYNCHRONOUS_COMMIT",
+				"failover_mode":          "AUTOMATIC",
+				"role":                   "SECONDARY",
+				"operational_state":      "ONLINE",
+				"connected_state":        "CONNECTED",
+				"synchronization_health": "HEALTHY",
+			},
+			"database_replica": mapstr.M{
+				"synchronization_state":  "SYNCHRONIZED",
+				"synchronization_health": "HEALTHY",
+				"suspended":              false,
+				"log_send_queue":         mapstr.M{"bytes": int64(10240)},
+				"log_send_rate":          mapstr.M{"bytes_per_sec": int64(102400)},
+				"redo_queue": mapstr.M{
+					"bytes":          int64(307200),
+					"estimated_time": mapstr.M{"sec": float64(5)},
+				},
+				"redo_rate":        mapstr.M{"bytes_per_sec": int64(61440)},
+				"last_commit_time": lastCommit,
+			},
+		}, r.toMapStr())
+	})
+
+	t.Run("remote replica without state", func(t *testing.T) {
+		r := replicaState{
+			groupName:         "ag1",
+			replicaServerName: "sql3",
+			databaseName:      "sales",
+			availabilityMode:  "ASYNCHRONOUS_COMMIT",
+			failoverMode:      "MANUAL",
+			syncState:         sql.NullString{String: "NOT SYNCHRONIZING", Valid: true},
+			redoQueueKB:       sql.NullInt64{Int64: 300, Valid: true},
+			redoRateKB:        sql.NullInt64{Int64: 0, Valid: true},
+		}
+
+		assert.Equal(t, mapstr.M{
+			"name": "ag1",
+			"replica": mapstr.M{
+				"server_name":       "sql3",
+				"is_local":          false,
+				"availability_mode": "ASYNCHRONOUS_COMMIT",
+				"failover_mode":     "MANUAL",
+			},
+			"database_replica": mapstr.M{
+				"synchronization_state": "NOT SYNCHRONIZING",
+				"redo_queue":            mapstr.M{"bytes": int64(307200)},
+				"redo_rate":             mapstr.M{"bytes_per_sec": int64(0)},
+			},
+		}, r.toMapStr())
+	})
+}
@@ -19,5 +19,5 @@ func init() {
 // AssetMssql returns asset data.
 // This is the base64 encoded zlib format compressed contents of module/mssql.
 func AssetMssql() string {
-	return "eJzMWU9z2z4OvedTYHpqZxL3nlt3d2a3M2l3u0nPGpqCJE4oUiEgu95PvwNStiVZ8p/Y7fx6aCaSCDw8gA8g8wCvuHmEmujN3gGwYYuP8OHb8/OPpw93ADmSDqZh490jfHuG5x9PUPu8tXgHENCiInyEUt0BFAZtTo93AAAP4FSNe7vyjzeNfBp823RPBsZfKoTgPSc7oL1jZZxxJShrIQLqXHSr+/76PnPFaqkIdy+mXE+6364ErhQDVwg1cjCawBAsUbAELDAEzIF9z9IYSh+OyQePt2Csd+XoxQDPT2feWoSv/wBfRCg7cMaRyTExMulR/p/0+YqbtQ9jPAO331WNY48HDDcYCh9q5fSFJPcWbqlFhgJZV0hgXHwpn4Ja+jal4D+9NX/3rWMM1LM/rMFzEtKoEjNqrGHKGgwZoX5Xhr639RKDkCUWIVmUEIFQe5enKvJatwEUxVgCUmtZlvgVhsL6tdSUcTn+ikZosXM4Cd16/Zqt1e2Qi0EI+NYiTWCXFyYWe4WglbUYgD0IgBNAW8KQae8canFJ74L54llZcDuwYhSmjE5C4KAcqUn3R70cWTcBe9K1rF5hxlg3GaulxaMA9tmQBT6osIG06nP8ASsVTPwdjBMSFu+E1aMuC0jIVxXRmDfrS+MIiFVgzKEIvk51s/MKjff2ZIWLlRlgl/jf1/ICXipDkHskcJ7BOG3bHCMazPusvJdY60vfzu7JU6hF6HyDIQrfZADvhBVQ+7oxNhm+kWIQK8YaHe/MIw3Ijhqd5K63qUyNNL1WBQQOpiwxYL6Af6LDoKzd3MPGt7BWjjvp3K1gD0sE69cnaumM6GeClFmjv3oQ4FeXG60Yp2NMJR+RgvZSZoormR9QOhfG9bH+CHYBadUS5rDc7Bl6sLhCO0qh7H+B9oxhhWEBXwrG0HuSFDKqj+GNOKWoIPfAsgNWyrZCpIrdVgExqrzz+d4aWyrWVbbtIJex/NIp7UNHd61cngwiSeRoVlMbWfAaYqMlQFUUqDmRJ3Oi9o44KCMl+JFaXUnr/fr53/ejPkL3oIUHIPM/vI+psPhLWPPFriHegyAiD959WsC/TFklePuOWaNyUHovLTL4tqyalt9NZVsUGCYXj4cqmBlxBtUv4WWV4YMvjlk9SNew2hsMGh3LvNPNPQSFb10upSkfpCA6bteGK1G3Sq1kzmEvxde1htzQ65ipY2H1Q2v0VFAH0OOJQnaOFIqA45H4JpSVjG25WZk8ldH8l9b717ahOLzFz6wiGV/XIHGSFEscBZXWSIS03aEqZl/IMz7fSsV9krWIr/YrJFhh2IA1zBYX8DeMqhAZE/L2/TRCMQS1VLdFIsBfDToyq3hwccMlwvNASg11TpOMVqasZshMVZL7dmlxunRhPFZbU2AmcDQrpze3LL19MpIaiIJFttfGWhGFzagGpb/vSjCe29BpPGjy5xbdoaz9QdDw0bitiU9TEcAZQtOPRleoXxtvHGdxF88o99nhdVJgW6ri4TjWXf9AIfrc8wo+gOdKFm9Hn8GZI4p5bgJvOtOpWjsHUwxcEv32ZJtivy7mmfztz+vaO0Z30BbgaN1dEg2rUCKfGUuOA2Gbi+FqtBPHsMz68rL7gtHi3p1B3D/x4mDm2mBfViLLPUMyckvkMgbtkxS1UnXXTMYRjy43Lr9moEZpzFpS5fRlzJQEDoJ/FgMQDQxiLHxqPqOYLhwPyDiNmTSwbKn062k1lm6qahnuo5x14DBPlvbtUOidtXhc/c+R4eWGJ6v8BnhlGxwzP3tU6lW7zA3Xdb2Xbijd3sIdpva3UDVyeQMuhOzrqfBat42JafvjnMz5voIcuHyM/TKauvkYMhUnjPT99nkaZuWtfH0Uc2G94mk5Y8XT92Enhey/yG1wBNTWtZIRNx5tFXMwy1baqornh73CySwwEuwiHpR9sVNsWsDPeFNv6EAda+8M+xD/duByyI0qnZcTI40u+KLpCpXlatzwTulnd8c3w+jEnU9acOBdDMyPM39GKU/hu4EYJIXN5OAzG2yu+CSXTyLZY4ydfIvxQyr7t2FywppL2QW69LTlJbUSgbC1njjDt1bG9u2c9fHp+fvk1H7TND6Ns3U1PDgvt71RYj/g35ZhyXrv8PAXJPkahGfyHHX8BuWb9vu5QvQbpOaoxpxB0P8DAAD//z7KxVU="
+	return "eNrNW0tz2zgSvs+vQM0pqVI0tdfctLaTqEqWPJa8M9kLCyIhCWuS0BCgbc2v3+4G+BQoUY84k0Msi0Tj60bj6wfgT+xZ7D6zROu/4l8YM9LE4jP79X4+/33yK3wRCR1mcmukSj+z+zmDr1miojwW8DATseAa3l9z+G0lRRzpz/CJsU8s5Ymo5OI/s9viq5nKt+6bhvDFRrBMKWPlsFClhstUpmvG45gRIDeFG12frz5nxA1fAqzygW9q7/TFSGY23MB/giXCZDLUTGq2FIglEyuRZSJiRtUktaHU4cio8XUBJlbpuvWggecplX/lgo1vmVoRlBKcTLWMhLWId0b83zsnLPWryqJD005hbHvGPQvzFy5jvpSxNLugbdUett4fXxhawPILE26EJgQbwWOzYTyN6Fe9S8NNplL5N0dBDAyUw5ttA2ViG8uQlw9G8SvfaTZLGxNbiPQSd+4FljU8DevGK118KQzvueLXsv8+2qF3Pqevd8r2OuxNOKpP4iSxjdIGvd1n1mFLms8SdXRaZC8iCzxGOWaYg8axYssla0DuQFrbkzqIVcjjTkBLpWDd02OA/tgImC+rT4pUgViAIpY7yyHgsEYhZxRgu4FlKr7YSjc5EFRqSFZhLQduwB4ex/ejx+8DNr+7mU1v4SNTGXu8m88m/xlPv3Yja+xZiAAXw2w4Hgrcwzr/Pr359jibzp7mwc3s/n68GLDR/peoAOjyZfz16XG0GM+mwWw6+d6tyQrmVeiS19DiixPm12D0tJjdA6abAbsfTZ9GE8R69+fi7nE6mnQjVFuREcPxOACPMRejnFUCGQncQ7oCYOKNJ1vwGTDfeHqHUL+MxpO72yFQZ7wrPABewJdp/5RU260LxPFUhLAdrqNJseM4EACIjni2q++8cjaIz6TgNpNJ7Z0Besr07mZxd4v63Y7n5e/dKrTCTmCj0qWazFvBzMW69sJMZ4vg291osvgGm/Zh9LgYjyaT78VXqIT76I8NBXsHFwWJed1nyoAAoA+w7dG40LLqVdzjFrDBVnwRCTKg9sL2+nxBK+P/3t0Oar8BJ6KNYRWaX/6zvGVftzPdpqFIrrcijUR0rSgZNRYHdms5Ay1Fn2SjQherdaBhcEBJ4HC5M0J3AvUk2+SiKku4AT06BjfjVaJygA0Wh6kBXwirVqaYBc00K4gNfxEshZJmKUQKhAXDHS1V5FWMGEB2YIH00BnI3KkcALPDd+GpqrdCMYSHNRgdtwvgft3IcNNQk2eiN34GkNwLBxIdEamfvHTS0hd+tZJxVUfsBxbPYiL+VLCdMH0WrqatgDQVkEM8hJ9ieGjpIpUvvelgQ7m7QiBDgSwVIqpyT48uitAwabT9QLCYq3fDInfEJ+gOpJ2Vcky/n+KUbh1cKLrYLWOuTRCqJJGG1qd7bfzBqtlSkFW5goKZFezwd3H3cK/cBuDk7s3KtEedXRvoKbBlajcRhhO+VLn1gIfamBvcNiLTvnp43bca3sIiBhpUM11+0a8hMs2TJawgWA0lMiuxtqh2l6oQXJhx7TITnce07zFFX8XqFStECRHnjYTUPPoXP9+Gz8Ervx5yFAioYMtpD3Z8IKm3hDuRxzFWloohgCNAc6iGA5f7wpT6LJgLZSCjT0uwKJT5hHohmIynmnunPzjLgXEe2N6pcfSLCIxItoHBAkX3Ww0coDJkCzvqN/rBXngm6XekDjDC8ExYNdNB9g077yInatsNiFCmGlPcDLl/lanE+k05K9tCenbUw1FKB7BT5q+RK1tsILeLFBgQw6VMwziH2hjRiKhulXMNC1MDW52LGomuLLC9CpwJC2k92UKJbJf8OoxBJUxiQ7IV34xklqMt3dU2FUQe7R+LQRMiwXotgGmG7KtIwRRxvBuwncqBa1LjqLMcARS0xDTpdXjM349q36Ek9l7roxsKjtMIoqIRfh2tyxNS+IluxqEsAv8TGLkEjSf/06xUKOSwqylFKi30KRYvIm4tIe5/hDanVuOQjVYgsvaNZUhiH+xhSbI4EMcAUMEvLzzO0ZCcoi2Hh4JHbs5zfWzJIXYHRQQ5zcoLx7SfnLkTbKuTQIGpYCiARD0bGfFKyDFDVJCvVra9AsbDYxl4TwODS3TBDzqHBA1C7/i32aAVR/QArB5ihib/BvuglWPxhlaDFwp1BtTo1wqyuY9D9k2uNxZeFTETqDbZWikMkZD4rDfb3Jxtyhx0yXr3Q461M0i9YCNNZ8rok7q3XE1vh8UIwTsx33F5j4bKJ0+jonixSjjbvkqzQXaDSoU64ZjpcxcaIqmfh746qkOtRgYXGu9z3wEe7hx0FARnWuRrUW4wbYtgy9TKFP+bsVLPdELz4lrslESvxCtDPTU6C6WCPAyF1pDNuR3KafXReFJFBVUMLK0RPuxBwP4UEPkhiTSxGLJ/C2IFshgar4qnBAU0StC7gT6AWt62ItWwWzBtS5tD0M4NKoWRdlJLoxvw6g5jeou+Tn+jtDqWKxEgnBDPFXbXdL1qMVz1B2Yla7/KmJrIu5YPYnwvXZCOSQXUEPpcp/OXj+8Emn2oqt6Pw4Pr1U00DXbYiPB5q4AnA9rFByrkXuo5KohzvbG9bvS7ekGB/FybFRt+ippwZerTqDmIzCOZASFb0dZb3QTDXy7TvmxCk+zLdO5Yv6qMxosDwJmn+d0p2kDCuBampy6RaBBblw4Xoy3AQaDMdoHG88bTegW1gR2H8fiGpM/cUODPE1erJkqjJ2mVZ7h5Su78HWWyOckE5TETqp0eXP3cHfKb57PS7QelJW0J15IhWxSrZNS2VL3VshnY6oHuqBj2L/8JDAk779jF2o9jxro1eVbFy5phTz12kdFFXbn6kkIET42ECbOG4bq7a0a8mYsPQsBfUM6BKYvpxJsIc1tphlglXViKVeK8foLZypKHz0BHaVQvlhpxYJvbvm/ub3Z4W76eivbm4cm2e4tK5hgc6oMmMsyUt5m7h4+/rM/HWLRu91BCtlRasWHEnvCi3MauS81XyCkwnLa0p4K9ii3rkC81I/ZuQh4HmLnqoS+QnWbOdnyryo52qO7hqX7I281OvxNmSqVOAlrxW7KNlqfFXTvGE3JdHoNLLG1GpDJtK+QtD3FPubKQgpSV8gODq603qP1AHfHzItqC62dNHW0MmnjwYPNzElz0ZEERdxxlaicooYpj2zgcsj8QAQ2P8R6K7XbSMn54+DIfsK+je7LUHD58tC2vDVosx/svcm03UTW0MvWp0dQXV04LqLV2dD/bdIfXknASfREku0HI9oimCjAFNkLquCaO5dHjwe3KH4GvaKkHtNSXTAg2p92BfvCR7NcNa82THw3ra6yWYMtR5Wv3fMs+kFseBqffAd18wzGp7AA570BZIkRDn3mfaJ/B6HJIdQ7vdfVju5GOwdTyf0AT+h2uFVgt8Ggne3F3WKgj7BBAdeAaou2Dpj5XBiT2r/EC4E/Vp0CxrxPk6s9OHXpTq8wU4QkifS8dIbXR9uoXFjI/RUF0PQeDEYw+uBP5JqIAihAIGu+3MMWtcLtNRVQ1EwgPc3j6KLAConwH3E9pBZbYogtbvVEUEAlcpWI7zCmek+sAMuMT07fm4FoeRy1HSuY6blpUnTj6+4ZKEF1y2euXUG7aJ6nrfTODliQgFr6cxus6FhurpdOpF0QlKBjQjRzMuo83sPEAgpdXvYosGX0PJVUnCGjeTomHG+Z9OtddW+MKeMvt078lveftmNxddlCwcOd45d0mtX4PU7WmvIIt0NiXmwKvHG0lLdu726Rr7guMc8bJ36h1UGkOIeN0KGPfL8tXqjjwKb59EPMqVtz46czwc8vSR2HyDC+D5Ald47W3AbgBLl/meBLB6ci1Yjg8PmkRdhlnCsbWQ/ZEN4Gl3mNH9zdB1DkG0ZHk6xT/gCnUrTtRJNpe9j41G3bXojos6qn47IC92VHA8Ccz5TF8VyADy7CX3/qcIGW3MTr6ptbqgVu0IR5177qW7ARemhR2saGkuDVrj7zxGd6owCeuDfZhMp9+HP7oZZy0V+tieD3XtpZKVGei17Uw3e+tzlv/gUa+BOEpycUV3Nd1oHoS0Q+gmoMc08NA/wdb7ysy"
 }
@@ -0,0 +1,41 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query_store
+
+import (
+	"fmt"
+	"time"
+)
+
+// orderByColumns maps the values of the order_by setting to the aggregated
+// column the top queries are sorted by.
+var orderByColumns = map[string]string{
+	"cpu_time":       "total_cpu_time",
+	"duration":       "total_duration",
+	"logical_reads":  "total_logical_io_reads",
+	"physical_reads": "total_physical_io_reads",
+	"executions":     "count_executions",
+}
+
+type config struct {
+	TopN     int           `config:"query_store.top_n" validate:"min=1"`
+	OrderBy  string        `config:"query_store.order_by"`
+	Lookback time.Duration `config:"query_store.lookback" validate:"positive,nonzero"`
+}
+
+func defaultConfig() config {
+	return config{
+		TopN:     10,
+		OrderBy:  "cpu_time",
+		Lookback: time.Hour,
+	}
+}
+
+func (c *config) Validate() error {
+	if _, found := orderByColumns[c.OrderBy]; !found {
+		return fmt.Errorf("invalid query_store.order_by value %q, it must be one of cpu_time, duration, logical_reads, physical_reads or executions", c.OrderBy)
+	}
+	return nil
+}
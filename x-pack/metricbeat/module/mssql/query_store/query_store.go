@@ -0,0 +1,202 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query_store
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// topQueriesQuery aggregates the runtime statistics of all the plans of each
+// query over the intervals that ended within the lookback window. Averages are
+// weighted by the number of executions of each interval to obtain totals.
+const topQueriesQuery = `USE [%s];
+SELECT TOP (@p1) q.query_id,
+       qt.query_sql_text,
+       SUM(rs.count_executions) AS count_executions,
+       SUM(rs.avg_cpu_time * rs.count_executions) AS total_cpu_time,
+       SUM(rs.avg_duration * rs.count_executions) AS total_duration,
+       SUM(rs.avg_logical_io_reads * rs.count_executions) AS total_logical_io_reads,
+       SUM(rs.avg_physical_io_reads * rs.count_executions) AS total_physical_io_reads
+FROM   sys.query_store_runtime_stats rs
+       JOIN sys.query_store_runtime_stats_interval rsi
+         ON rsi.runtime_stats_interval_id = rs.runtime_stats_interval_id
+       JOIN sys.query_store_plan p
+         ON p.plan_id = rs.plan_id
+       JOIN sys.query_store_query q
+         ON q.query_id = p.query_id
+       JOIN sys.query_store_query_text qt
+         ON qt.query_text_id = q.query_text_id
+WHERE  rsi.end_time > DATEADD(second, -@p2, SYSUTCDATETIME())
+GROUP  BY q.query_id, qt.query_sql_text
+ORDER  BY %s DESC`
+
+type dbInfo struct {
+	id   int
+	name string
+}
+
+type queryStats struct {
+	queryID            int64
+	text               string
+	executions         int64
+	cpuTimeMicros      float64
+	durationMicros     float64
+	logicalReadsPages  float64
+	physicalReadsPages float64
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("mssql", "query_store", New,
+		mb.WithHostParser(mssql.HostParser))
+}
+
+// MetricSet type defines all fields of the MetricSet
+type MetricSet struct {
+	mb.BaseMetricSet
+	log    *logp.Logger
+	db     *sql.DB
+	config config
+}
+
+// New create a new instance of the MetricSet
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	config := defaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	logger := logp.NewLogger("mssql.query_store").With("host", base.HostData().SanitizedURI)
+
+	db, err := mssql.NewConnection(base.HostData().URI)
+	if err != nil {
+		return nil, fmt.Errorf("could not create connection to db: %w", err)
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		log:           logger,
+		db:            db,
+		config:        config,
+	}, nil
+}
+
+// Fetch reports the top queries of each database that has the Query Store
+// enabled, one event per query.
+func (m *MetricSet) Fetch(reporter mb.ReporterV2) {
+	dbs, err := m.getQueryStoreDbs()
+	if err != nil {
+		reporter.Error(err)
+		return
+	}
+
+	for _, db := range dbs {
+		queries, err := m.getTopQueries(db.name)
+		if err != nil {
+			reporter.Error(fmt.Errorf("error getting top queries of database %s: %w", db.name, err))
+			continue
+		}
+
+		for i, q := range queries {
+			if isReported := reporter.Event(mb.Event{
+				ModuleFields: mapstr.M{
+					"database": mapstr.M{
+						"id":   db.id,
+						"name": db.name,
+					},
+				},
+				MetricSetFields: q.toMapStr(i + 1),
+			}); !isReported {
+				m.log.Debug("event not reported")
+				return
+			}
+		}
+	}
+}
+
+// Close the connection to the server at the engine level
+func (m *MetricSet) Close() error {
+	return m.db.Close()
+}
+
+func (m *MetricSet) getQueryStoreDbs() ([]dbInfo, error) {
+	const query = "SELECT name, database_id FROM sys.databases WHERE is_query_store_on = 1 AND state = 0"
+	rows, err := m.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("error doing query '%s': %w", query, err)
+	}
+	defer m.closeRows(rows)
+
+	res := make([]dbInfo, 0)
+	for rows.Next() {
+		var row dbInfo
+		if err = rows.Scan(&row.name, &row.id); err != nil {
+			return nil, fmt.Errorf("error scanning row results: %w", err)
+		}
+		res = append(res, row)
+	}
+	return res, rows.Err()
+}
+
+func (m *MetricSet) getTopQueries(dbName string) ([]queryStats, error) {
+	query := fmt.Sprintf(topQueriesQuery, dbName, orderByColumns[m.config.OrderBy])
+	rows, err := m.db.Query(query, m.config.TopN, int64(m.config.Lookback.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	defer m.closeRows(rows)
+
+	res := make([]queryStats, 0, m.config.TopN)
+	for rows.Next() {
+		var row queryStats
+		if err = rows.Scan(&row.queryID, &row.text, &row.executions, &row.cpuTimeMicros, &row.durationMicros,
+			&row.logicalReadsPages, &row.physicalReadsPages); err != nil {
+			return nil, fmt.Errorf("error scanning row results: %w", err)
+		}
+		res = append(res, row)
+	}
+	return res, rows.Err()
+}
+
+func (m *MetricSet) closeRows(rows *sql.Rows) {
+	if err := rows.Close(); err != nil {
+		m.log.Errorf("error closing rows: %s", err)
+	}
+}
+
+func (q queryStats) toMapStr(rank int) mapstr.M {
+	event := mapstr.M{
+		"rank": rank,
+		"query": mapstr.M{
+			"id":   q.queryID,
+			"text": q.text,
+		},
+		"executions": mapstr.M{
+			"count": q.executions,
+		},
+		"cpu_time": mapstr.M{
+			"us": q.cpuTimeMicros,
+		},
+		"duration": mapstr.M{
+			"us": q.durationMicros,
+		},
+		"logical_reads": mapstr.M{
+			"pages": q.logicalReadsPages,
+		},
+		"physical_reads": mapstr.M{
+			"pages": q.physicalReadsPages,
+		},
+	}
+	if q.executions > 0 {
+		_, _ = event.Put("cpu_time.avg.us", q.cpuTimeMicros/float64(q.executions))
+		_, _ = event.Put("duration.avg.us", q.durationMicros/float64(q.executions))
+	}
+	return event
+}
@@ -0,0 +1,56 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query_store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestConfigValidate(t *testing.T) {
+	c := defaultConfig()
+	assert.NoError(t, c.Validate())
+
+	c.OrderBy = "memory"
+	assert.Error(t, c.Validate())
+}
+
+func TestQueryStatsToMapStr(t *testing.T) {
+	q := queryStats{
+		queryID:            42,
+		text:               "SELECT * FROM orders WHERE id = @id",
+		executions:         4,
+		cpuTimeMicros:      2000,
+		durationMicros:     3000,
+		logicalReadsPages:  100,
+		physicalReadsPages: 8,
+	}
+
+	assert.Equal(t, mapstr.M{
+		"rank": 1,
+		"query": mapstr.M{
+			"id":   int64(42),
+			"text": "SELECT * FROM orders WHERE id = @id",
+		},
+		"executions": mapstr.M{"count": int64(4)},
+		"cpu_time": mapstr.M{
+			"us":  float64(2000),
+			"avg": mapstr.M{"us": float64(500)},
+		},
+		"duration": mapstr.M{
+			"us":  float64(3000),
+			"avg": mapstr.M{"us": float64(750)},
+		},
+		"logical_reads":  mapstr.M{"pages": float64(100)},
+		"physical_reads": mapstr.M{"pages": float64(8)},
+	}, q.toMapStr(1))
+
+	q.executions = 0
+	_, err := q.toMapStr(1).GetValue("cpu_time.avg.us")
+	assert.Error(t, err)
+}
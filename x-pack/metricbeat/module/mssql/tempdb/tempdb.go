@@ -0,0 +1,254 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package tempdb
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const (
+	// pageSize is the size of a SQL Server data page.
+	pageSize = 8192
+
+	// tempdbID is the database_id of tempdb.
+	tempdbID = 2
+
+	// pfsInterval and gamInterval are the number of pages covered by a
+	// PFS and a GAM or SGAM page respectively.
+	pfsInterval = 8088
+	gamInterval = 511232
+)
+
+// latchWaitsQuery returns the tasks currently waiting on a page latch of
+// tempdb. The resource description has the form dbid:fileid:pageid.
+const latchWaitsQuery = `SELECT resource_description,
+       wait_duration_ms
+FROM   sys.dm_os_waiting_tasks
+WHERE  wait_type LIKE 'PAGELATCH_%'
+       AND resource_description LIKE '2:%'`
+
+const spaceUsageQuery = `SELECT SUM(user_object_reserved_page_count),
+       SUM(internal_object_reserved_page_count),
+       SUM(version_store_reserved_page_count),
+       SUM(mixed_extent_page_count),
+       SUM(unallocated_extent_page_count)
+FROM   tempdb.sys.dm_db_file_space_usage`
+
+const dataFilesQuery = `SELECT COUNT(*)
+FROM   tempdb.sys.database_files
+WHERE  type = 0`
+
+type spaceUsage struct {
+	userObjectPages     int64
+	internalObjectPages int64
+	versionStorePages   int64
+	mixedExtentPages    int64
+	unallocatedPages    int64
+}
+
+// latchWaits summarizes the tasks waiting on page latches of tempdb, by type
+// of page. Waits on allocation pages (PFS, GAM and SGAM) are the usual sign of
+// tempdb contention, which is relieved by adding data files.
+type latchWaits struct {
+	count      int64
+	durationMS int64
+	pfs        int64
+	gam        int64
+	sgam       int64
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("mssql", "tempdb", New,
+		mb.WithHostParser(mssql.HostParser))
+}
+
+// MetricSet type defines all fields of the MetricSet
+type MetricSet struct {
+	mb.BaseMetricSet
+	log *logp.Logger
+	db  *sql.DB
+}
+
+// New create a new instance of the MetricSet
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	logger := logp.NewLogger("mssql.tempdb").With("host", base.HostData().SanitizedURI)
+
+	db, err := mssql.NewConnection(base.HostData().URI)
+	if err != nil {
+		return nil, fmt.Errorf("could not create connection to db: %w", err)
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		log:           logger,
+		db:            db,
+	}, nil
+}
+
+// Fetch reports a single event with the contention indicators and the space
+// usage of tempdb.
+func (m *MetricSet) Fetch(reporter mb.ReporterV2) {
+	metricsetFields := mapstr.M{}
+
+	waits, err := m.getLatchWaits()
+	if err != nil {
+		reporter.Error(err)
+	} else {
+		metricsetFields["page_latch_waits"] = waits.toMapStr()
+	}
+
+	space, err := m.getSpaceUsage()
+	if err != nil {
+		reporter.Error(err)
+	} else {
+		metricsetFields["space"] = space.toMapStr()
+	}
+
+	var dataFiles int64
+	if err = m.db.QueryRow(dataFilesQuery).Scan(&dataFiles); err != nil {
+		reporter.Error(fmt.Errorf("error getting tempdb data files: %w", err))
+	} else {
+		metricsetFields["data_files"] = mapstr.M{"count": dataFiles}
+	}
+
+	if len(metricsetFields) == 0 {
+		m.log.Debug("no data to report")
+		return
+	}
+
+	if isReported := reporter.Event(mb.Event{
+		ModuleFields: mapstr.M{
+			"database": mapstr.M{
+				"id":   tempdbID,
+				"name": "tempdb",
+			},
+		},
+		MetricSetFields: metricsetFields,
+	}); !isReported {
+		m.log.Debug("event not reported")
+	}
+}
+
+// Close the connection to the server at the engine level
+func (m *MetricSet) Close() error {
+	return m.db.Close()
+}
+
+func (m *MetricSet) getLatchWaits() (latchWaits, error) {
+	var waits latchWaits
+
+	rows, err := m.db.Query(latchWaitsQuery)
+	if err != nil {
+		return waits, fmt.Errorf("error getting tempdb page latch waits: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			m.log.Errorf("error closing rows: %s", err)
+		}
+	}()
+
+	for rows.Next() {
+		var resource string
+		var durationMS sql.NullInt64
+		if err = rows.Scan(&resource, &durationMS); err != nil {
+			return waits, fmt.Errorf("error scanning row results: %w", err)
+		}
+		waits.add(resource, durationMS.Int64)
+	}
+	return waits, rows.Err()
+}
+
+func (m *MetricSet) getSpaceUsage() (spaceUsage, error) {
+	var res spaceUsage
+	if err := m.db.QueryRow(spaceUsageQuery).Scan(&res.userObjectPages, &res.internalObjectPages,
+		&res.versionStorePages, &res.mixedExtentPages, &res.unallocatedPages); err != nil {
+		return res, fmt.Errorf("error getting tempdb space usage: %w", err)
+	}
+	return res, nil
+}
+
+func (w *latchWaits) add(resource string, durationMS int64) {
+	w.count++
+	w.durationMS += durationMS
+
+	switch allocationPageType(resource) {
+	case "PFS":
+		w.pfs++
+	case "GAM":
+		w.gam++
+	case "SGAM":
+		w.sgam++
+	}
+}
+
+func (w latchWaits) toMapStr() mapstr.M {
+	return mapstr.M{
+		"count": w.count,
+		"duration": mapstr.M{
+			"ms": w.durationMS,
+		},
+		"pfs": mapstr.M{
+			"count": w.pfs,
+		},
+		"gam": mapstr.M{
+			"count": w.gam,
+		},
+		"sgam": mapstr.M{
+			"count": w.sgam,
+		},
+	}
+}
+
+func (s spaceUsage) toMapStr() mapstr.M {
+	return mapstr.M{
+		"user_objects": mapstr.M{
+			"bytes": s.userObjectPages * pageSize,
+		},
+		"internal_objects": mapstr.M{
+			"bytes": s.internalObjectPages * pageSize,
+		},
+		"version_store": mapstr.M{
+			"bytes": s.versionStorePages * pageSize,
+		},
+		"mixed_extents": mapstr.M{
+			"bytes": s.mixedExtentPages * pageSize,
+		},
+		"free": mapstr.M{
+			"bytes": s.unallocatedPages * pageSize,
+		},
+	}
+}
+
+// allocationPageType returns the type of allocation page of a page latch
+// resource description in the form dbid:fileid:pageid, or an empty string
+// if the page is not an allocation page.
+func allocationPageType(resource string) string {
+	parts := strings.Split(resource, ":")
+	if len(parts) != 3 {
+		return ""
+	}
+	page, err := strconv.ParseInt(strings.TrimSpace(parts[2]), 10, 64)
+	if err != nil {
+		return ""
+	}
+
+	switch {
+	case page == 1 || (page > 0 && page%pfsInterval == 0):
+		return "PFS"
+	case page == 2 || (page > 2 && (page-2)%gamInterval == 0):
+		return "GAM"
+	case page == 3 || (page > 3 && (page-3)%gamInterval == 0):
+		return "SGAM"
+	}
+	return ""
+}
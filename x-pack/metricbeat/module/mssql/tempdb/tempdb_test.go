@@ -0,0 +1,39 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package tempdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocationPageType(t *testing.T) {
+	for resource, want := range map[string]string{
+		"2:1:1":      "PFS",
+		"2:3:8088":   "PFS",
+		"2:1:16176":  "PFS",
+		"2:1:2":      "GAM",
+		"2:4:511234": "GAM",
+		"2:1:3":      "SGAM",
+		"2:1:511235": "SGAM",
+		"2:1:0":      "",
+		"2:1:112":    "",
+		"2:1":        "",
+		"2:1:x":      "",
+	} {
+		assert.Equal(t, want, allocationPageType(resource), resource)
+	}
+}
+
+func TestLatchWaits(t *testing.T) {
+	var waits latchWaits
+	waits.add("2:1:1", 10)
+	waits.add("2:5:8088", 20)
+	waits.add("2:1:3", 5)
+	waits.add("2:1:240", 1)
+
+	assert.Equal(t, latchWaits{count: 4, durationMS: 36, pfs: 2, sgam: 1}, waits)
+}
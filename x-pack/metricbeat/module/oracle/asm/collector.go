@@ -0,0 +1,26 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package asm
+
+import (
+	"context"
+	"database/sql"
+)
+
+// asmCollectMethod contains the methods needed to collect the usage of the ASM disk groups.
+type asmCollectMethod interface {
+	diskGroups(context.Context) ([]diskGroup, error)
+}
+
+// collectedData contains the necessary ASM disk group information.
+type collectedData struct {
+	diskGroups []diskGroup
+}
+
+// asmCollector is the implementor of asmCollectMethod. It's implementation are on different Go files
+// which refers to the origin of the data for organization purposes.
+type asmCollector struct {
+	db *sql.DB
+}
@@ -0,0 +1,71 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package asm
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+type mockCollector struct {
+	diskGroupsData []diskGroup
+	err            error
+}
+
+func (c mockCollector) diskGroups(_ context.Context) ([]diskGroup, error) {
+	return c.diskGroupsData, c.err
+}
+
+func newTestMetricSet(t *testing.T, collector asmCollectMethod) *MetricSet {
+	m := mbtest.NewMetricSet(t, map[string]interface{}{
+		"module":     "oracle",
+		"metricsets": []string{"asm"},
+		"hosts":      []string{"user/pass@localhost:1521/ORCLCDB"},
+	}).(*MetricSet)
+	m.collector = collector
+	return m
+}
+
+func TestMetricSetTransform(t *testing.T) {
+	m := newTestMetricSet(t, mockCollector{diskGroupsData: []diskGroup{
+		{
+			name:                 sql.NullString{String: "DATA", Valid: true},
+			state:                sql.NullString{String: "MOUNTED", Valid: true},
+			redundancy:           sql.NullString{String: "NORMAL", Valid: true},
+			totalMB:              sql.NullInt64{Int64: 1000, Valid: true},
+			freeMB:               sql.NullInt64{Int64: 250, Valid: true},
+			usableFileMB:         sql.NullInt64{Int64: 100, Valid: true},
+			requiredMirrorFreeMB: sql.NullInt64{Int64: 50, Valid: true},
+			offlineDisks:         sql.NullInt64{Int64: 0, Valid: true},
+		},
+		{
+			name:       sql.NullString{String: "FRA", Valid: true},
+			state:      sql.NullString{String: "DISMOUNTED", Valid: true},
+			redundancy: sql.NullString{String: "EXTERN", Valid: true},
+			totalMB:    sql.NullInt64{Int64: 0, Valid: true},
+			freeMB:     sql.NullInt64{Int64: 0, Valid: true},
+		},
+	}})
+
+	events, err := m.collectAndTransform(context.Background())
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, `{"free":{"bytes":262144000},"name":"DATA","offline_disks":{"count":0},"redundancy":"NORMAL","required_mirror_free":{"bytes":52428800},"state":"MOUNTED","total":{"bytes":1048576000},"usable_file":{"bytes":104857600},"used":{"pct":0.75}}`, events[0].MetricSetFields.String())
+	assert.Equal(t, `{"free":{"bytes":0},"name":"FRA","redundancy":"EXTERN","state":"DISMOUNTED","total":{"bytes":0}}`, events[1].MetricSetFields.String())
+}
+
+func TestMetricSetTransformError(t *testing.T) {
+	m := newTestMetricSet(t, mockCollector{err: errors.New("disk groups error")})
+
+	_, err := m.collectAndTransform(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package asm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+type diskGroup struct {
+	name                 sql.NullString
+	state                sql.NullString
+	redundancy           sql.NullString
+	totalMB              sql.NullInt64
+	freeMB               sql.NullInt64
+	usableFileMB         sql.NullInt64
+	requiredMirrorFreeMB sql.NullInt64
+	offlineDisks         sql.NullInt64
+}
+
+// V$ASM_DISKGROUP_STAT is used instead of V$ASM_DISKGROUP because it does
+// not trigger a discovery of new disks, which is expensive on large estates.
+const diskGroupQuery = "SELECT NAME, STATE, TYPE, TOTAL_MB, FREE_MB, USABLE_FILE_MB, REQUIRED_MIRROR_FREE_MB, OFFLINE_DISKS FROM V$ASM_DISKGROUP_STAT"
+
+func (e *asmCollector) diskGroups(ctx context.Context) ([]diskGroup, error) {
+	rows, err := e.db.QueryContext(ctx, diskGroupQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error executing query %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]diskGroup, 0)
+
+	for rows.Next() {
+		dest := diskGroup{}
+		if err = rows.Scan(&dest.name, &dest.state, &dest.redundancy, &dest.totalMB, &dest.freeMB, &dest.usableFileMB, &dest.requiredMirrorFreeMB, &dest.offlineDisks); err != nil {
+			return nil, err
+		}
+		results = append(results, dest)
+	}
+	return results, rows.Err()
+}
+
+func (m *MetricSet) addDiskGroupData(dgs []diskGroup) []mapstr.M {
+	out := make([]mapstr.M, 0, len(dgs))
+
+	for _, dg := range dgs {
+		ms := mapstr.M{}
+		oracle.SetSqlValue(m.Logger(), ms, "name", &oracle.StringValue{NullString: dg.name})
+		oracle.SetSqlValue(m.Logger(), ms, "state", &oracle.StringValue{NullString: dg.state})
+		oracle.SetSqlValue(m.Logger(), ms, "redundancy", &oracle.StringValue{NullString: dg.redundancy})
+		oracle.SetSqlValue(m.Logger(), ms, "total.bytes", &oracle.Int64Value{NullInt64: megabytes(dg.totalMB)})
+		oracle.SetSqlValue(m.Logger(), ms, "free.bytes", &oracle.Int64Value{NullInt64: megabytes(dg.freeMB)})
+		oracle.SetSqlValue(m.Logger(), ms, "usable_file.bytes", &oracle.Int64Value{NullInt64: megabytes(dg.usableFileMB)})
+		oracle.SetSqlValue(m.Logger(), ms, "required_mirror_free.bytes", &oracle.Int64Value{NullInt64: megabytes(dg.requiredMirrorFreeMB)})
+		oracle.SetSqlValue(m.Logger(), ms, "used.pct", &oracle.Float64Value{NullFloat64: usedPct(dg.totalMB, dg.freeMB)})
+		oracle.SetSqlValue(m.Logger(), ms, "offline_disks.count", &oracle.Int64Value{NullInt64: dg.offlineDisks})
+		out = append(out, ms)
+	}
+
+	return out
+}
+
+// megabytes converts a size in megabytes, as reported by ASM, to bytes.
+func megabytes(v sql.NullInt64) sql.NullInt64 {
+	v.Int64 *= 1024 * 1024
+	return v
+}
+
+// usedPct returns the used fraction of a disk group. Dismounted disk groups
+// report a total size of zero and have no usage.
+func usedPct(total, free sql.NullInt64) sql.NullFloat64 {
+	if !total.Valid || !free.Valid || total.Int64 == 0 {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: float64(total.Int64-free.Int64) / float64(total.Int64), Valid: true}
+}
@@ -19,5 +19,5 @@ func init() {
 // AssetOracle returns asset data.
 // This is the base64 encoded zlib format compressed contents of module/oracle.
 func AssetOracle() string {
-	return "eJzEWd1u2zoSvu9TDAos0gKpz70vDpCkyZ4AaZKN0yz2ShhLI4sbidQhKTvu0x8MRcmyRfkvbuubppI48803P5whv8ArLcegNMY5fQCwwuY0ho8P7sHHDwAJmViL0golx1A/hgQtTtEQFCqp3DqTKW2jWMlUzMaQYm74qaac0NAYZvgBIBWUJ2b8AQDgC0gsqKOYf3ZZ8rdaVaV/ElLe6uRfV2ZXbkk6VbpAGVP7LqSgp+RxtZDho6UECrJaxAaUBAQj5KxLgZDGbqhZN7v5bULtwi0wzoSktXcN3FdaLpRONt6tM1OSRivkDMzSWCoaeU56UOG0SlPSUalUfqzSeywIVAo2Iy8OWBwI6R4FeFmprwzpHrZDDK5DISim0SFUpClXmJiglkRV03wTwJqSp3o1/AGPQhpghtUILvxzEAZQLuHx9p5ZQAlq+n+KLdgMLb+UyjoiUqGNdZ/5oKSEgygm99YvylAmOcECDcSaOOrOAWUCi0zEGWj6uxKaDLBidnNnZapVAYkwr6MaJupasKyKKWnnIFGQAXQQWAFLI8OBnSrtxZjakwwbCzIlDjguV/Fr5AWEec2VnG1j9WJOGmdt5DhWYUp2QSThbEY2E9acOeP5f+YMpsQmn/27eRW0rqH/zHS5TFUlE47Iggqll12pYY7YvkNIGgVZKoXcTtLu4OvQhHnOEtn4P8o2Ek3Dy6OQfw3zkucN0wVZ5KoFpaCYTPM4F1ONetmE04I0hWlj1UO0nSK0YoyzcEHYLNg9siYWrTCWazROVWWd2Z2aZADnKHKc5uSADdancJWGXuEcZcKOytj2Ptvq4B7u54xqsyET1qeCd4spKRapoKRrx2gQVpktjYgxjzT1Cx5sS84eqEcvCsKiGo0zGrY+5LCensvOnsE5eQamdWNg7ZBjuphiJY0wlmQI2k4WegivWnFsrVnh2woimUZTLiMhIw7H8LXpM2qZMFPWkgwnUKWN0uGCszOFrurFIKRrf/jhgZmB85BBh6RDU/O8IaBKkpwAy3ajd5Uo3Cx1m6m3dwL5hm/vBWGVxc3W6lAYzyyjAdDgORRIvfx9qfqwDmFbHuyVppXWp8rRmqPVjuRlO7MHMmIdzJCjTgKlG0Grnq/ZephIbQPOaas6ahOKkgNc98giOi7rJnjdqqo4rjQlTd/eEGjImH4RgD1drOlEpH58ojVSy9qeFXJNybgB6ylf7akGvtQrIFaVtPDJefvzx98UER4K5rmBTxnqxGWwUan9zIMF/+G/4eEC4oziVzd1tsMF5rwrLxtnmQzZdbyHnoNVMCct0mXNDr8vSRfCUeOmV35UyYR0vuTWsZ095uSmlThDOaNkuMvwNI8cvxHz+65O474lxrmqsSngS2aHW6SCUJqVeZP/3LnIpoIjNhGJM8PZY3moAE2Ozi0mtaacoJ17ahq44XB005oNF4reIYZZmvroYecRRnviMCWLQ0cbf67hnzTCN485RnueWXgjI5dZR42Cf/YInXjirlhmeLbCukmIMLZiTpFHceyY1YfQNCEXTn6DyITB+GIZKRPxaH4yEFe+CD9M4E5hMjBlrvX8UUk6MrS5Jx+PoZ0Dnlg8PJKGCcVKDqDhpiSyGqVhzyh5cjzfDWl4XinYicilWWR56otMjLKmyL5t7mnHQ6rr+7ObKyeswWHqYNzhuIUWln6i5/7r5O9JlJAJvf1Uom5ZwyFEZcrYKC6rqLIiFz+wjqtenT4e11/KWLh6/A7fVwrg078+h+FIsgulXznK01TE0VzlVUEnd999rYbpYTXw4tTsl4Ba5fkU49ftQXV4YXbJ99QI34nF+cxwmT41Oc5ZLHknhmYOj+q25vRp9vUSLt154VWtYCeitYId1Vk3Xf7UCsC122ff5XKfWqDJlEoaiqzwoX3KOvDkpcOz8BEdrAFtYeLKunliuMclTnDd4bcy77mheG4hrIsJhypajFLRY3PPs5v2jIhFvOcIRwyfE+zTz3dsrqT4uyIQCUkrUkF6UGeAZNhBdE/xjchJdq6j3DF3gM9O8yp+vHOwnogftDZM1yfP9Y1PcFbYZ24u8G3kSsLAeFmrG8O2bw6bUb/hmyiqwoF2tPAcNiy+PQf/pSAd2d65DuheGFNN9IvZ7Je85veceXq7ZqxfS/AO7mJ35L7G2FaY9xcVQlamvlGZY165w4LKUMIzr7FK+886Y52799ky1lu01fAov2cShk0/u3Fx5TSM4eLl4vbu4vLuGpSG2/uXi7vbr/Cp+WNjundW+CnZ36cKyZaeO7boDYsyp3PAJiSCALCzG9SyF2gg0aosKfl8NkiKkrmQFJ2Gmzs0Fl6lWkgv1zPSK1gjeJDO25P/TR5ubs753+frb+fwcHNzd3t/fQ4P9/wv8/d0ffXwcv002r639C/c9t5XOvXcV3XXeB2/v2xNyF3JeNQ+VB+1sF5vQptx520NGU4Mzqpfi9flsftzH3zOut9BKNekLsB/AgAA//+tbOzU"
+	return "eNrNW1tz2koSfj+/Yiq1W06qCHn3Q6oIxgm1NngBOyf7ohqkAWYtaTgzI2POr9/uHkkIawTYgLM8BKxL99f3np7JZ/Yo1pdMaR7G4g/GrLSxuGQfhnThA1yJhAm1XFqp0kvmLrOIWz7lRrBERRm9ZxZK2yBU6UzOL9mMxwavahELeOySzTn8NZMijswl/GLsM0t5IiqM8WPXS3xWq2yZX/ExL3nip0qzSpebpLzmI4yfEt1UWF65vsX0a+UGY53xLYukeXS0WGb4HAAJq2Vo2pUnX8KqQsN/t24U+MASK6WjF/d2oMHPAKgxNWN2ISrI2l7OxnJ7QtZjJFfnjXrlVj4JZhXdkikwTkPRYjOlmXjmyRLMeDu8H0x6Vy3WHQ4GvS78BGdgV/1xfsMvghZRlkZAbX06OUYlTaLi0SYbpnS59+ekNxq02GA4uu3ctNiP/vcfLXZ90/sTsePdQQNuqyyP29O1FcYLPFbp/MUNUFbCLXin56U9Ek2QGwv5kofSrusCtcAmjq4f7UwL8W5g79PMiOgItBCF01gEMxm/H+gxgAUHX3ALwAGdYIbPRLxmJAv6eSpWDCEZtlrAF7imWMp0TrJtvPj10mrxVyaBQJBIrZUO3tVWTuwCAiJ9EfsQ8loYq/SWkHxmhaZHITqNhfog40xv68+qWGhIKNF+c4uovQytV1wT8hh0M4sVtw1iL4UORWpfJ/g11B68V9ir2VudUNKgFADVL4OazWKZigBfM+1QZak91Hr76kGWTEHXAIto+0yE+LgWBYh2rXiChkhZkLP3FtEtOHebF10VAB/JqyMD3XFmIAKq/UNRGnxVec4PLKgJDxcgx1sLwnCJfoehadbGiqSgVy/UBcNpNpsJHSyVit/KtFq2HTmG5ApzefSyFQD6mCYi76O8ZAoeUgVgCMUjf1aJVDaNxS4mI/c2+8LuQBiGGlZt1smvY3zwdM3u+gPUAmQANf2vCG0ZPKmypIiZxHyBj+VOCR4FTkS5VxQvLXgagUArbliohcshcAkTb7gospVhyLjIwPmbM60Sio22g8m1I5yWUWRlAq9ygoAMkBokuDzJOzLGWRJhg/IM5kivUmMVPgY5AfOmeO88ga/OS88hrWL7uhIiZRdzASCsuSDh8S/4ORUo8sX34pZXukL9F6aqyxmkJcrxiUiUXlep+nWE8r1GSf7cCFVyt5L2O19FTTyOkSIK/2VZeqIp9AJW/9GsF3g31zRkMY5ZC2iJUJjiciynmoNqcndaCfAfr9qQ9cUZXSuEnOVPCC8Tdk1Z2MZLYzFH86nKLIldyUlw+QnqNfZYBKwxP/mzdD1xtsEYngK+x8A13BMqwyA2A3p5KORmMUsRSgATVeVoN8JaLtZGQuMAbldPeDuDs14Bc1LMT6rgCFHUyMZnsBqfb5WagTF5QYs7Z0bPu02G2XIhlRogUG+NDtJCDWG3JIfSmg2+nSCiaTDFNGJOguGq6DMcTTZXFgD5AyjTBnrTt4VQ170MYeGaTLj4ysjgT/Mjw6HIebkgTC1FigGwLgs9ZSJ/s1Rtpp6PBHLLn48FQavlY9MDrYFzAAWe1wJxrx8XqsNtCLvi4KAwzbQ+VYw6HW0qUk6bxG6IiMMMdRIoVQ/a9HxF6UFFausxTpnVuTbiONPdIYmKyaoB7lpVFQLQzUq4UCC8ZepJ4FATQ+04jVI/jMSWUpdOng1ygH5ZgM1VvqmpBgDRG4wWqOwjWfvTh9/kETkU6E4M+7jgOqIINmpmP+HCAn/kz+DigoEQ0I3iqrNcXPAYq/K6MJYBImA6rKEtHFxAApWztdMO3ocVRyJJNbR6xUvQ2Qkdr7F1LNceT4JWKyF0znMRNXcZuZrbpN8A9XtUp7FZ6JOpCpk8tkTtYIuUCJ6ajXjjf9+4iXCCHhvJiMQgeSwuKsANSZ07RCpFOUE7NyoauGZ3pNWa9SeK2hADFrnn2AHAbjkr+/8iGdEFzijcOstlDC0g5YhunEELBGns46jT/VTOPQ7dKmhosw/sSurDoX6RO4sploPXfmWzIqOjPPerN/pLbM60sII3bpiae3Z/MJ4E/SvIRXGWlHO47w//YE9SrEyzk3pGJfvGJQdCrg5xClM141goY4P3AYOsmM7StBh37EdnyK/PA80fM+WuynjSGdFOULklNLzrDXBHhb5v+99HnUmvGXoRVcH7yVAOMFXql6nTnfQfei02vh+DDFducwtduDPo9tio1x0+9Ea/mmXSKj6Tn4yA8ktTtNjdqH/bGf0KCoiAHDAOrqrXUIT7wb8Gw5+DZuB2gTX2DPlhJCKVU68sKw52bm2zZYATl+YyVd8cPRDaBMiy1UJs+wLNdPImtT5jN2vjJuNnKVIF8ZdT+EMLT16Dg1PuTIzzut5Fmv7RH3dr2AD3Wp4gnt0bb50C1iEUa+QO0S8QNewz5b18oEyAk+OTgejma4ThmN0A3YYh6NZIKoBWFLQRngxDOaYaIXnctwFlhCqNGrfcdGA1tI9uF+zkeO6BAZtsGOxFRF1gYGnj14TwHkGyz+nJILnlx4TGnmPkQJgqGPcYbqWlFWe03E+if6CiJKxbns+qqD5yeI2iqB0Kl1mQWRnLv7nzq4Zt3bfg+oFNUPfunt1vGLCP//zkh5MKCzX1Eb18NpNh8IRtpji5+QaODaoH2bAHYnNYAEJPEE95+LjbqV6fmCn4RgXxvVjIZnjw6eTKIWPRkap9GIoxceBW3acPs6tv7BttZ3Udg72IthJ24KKODjGcN3fn0fdtfUgu0MIsodwJ6oNOngdGOXXXDjXmgDIxYWZ9uaF1wBkD73uvPzRwzAb6pISwTeaPptUJnU162yK+3MJwZ4nevsNw5KK9InOWyr8y6HMj6GJwl02fdel9DXKn1UOOuAvr0WeleZV/Hzn3HQOFrVmv2xh1BxK8C7NDxroJf/Ye1DrkXNbbRqi3/FkmWUKgSS3l0aqdQN8XJCk7Ny4BPQhj47G38wH92vAQo61o81KM7V1zrODku216GrJiBnm79lIi08y4Df8nHmc0y6YpmFXMHaujxyrLOjqW0N6pqwUUCq1U8n+jr83smrhtxMezgAbatRjjdIULfJ5ZJZ6tSPHoTou5I4Y4DE5y30YVttl/hFakZ5cmacgdKZpobyj8nunXxTVFH3G4ZJ2HTv+m8+2m5wZCD52b/hX7WPx4MaInneSj7vxQlDtVuHWUusV4ETheALxSMx1tnEyARyyXIvp00bz5mdIZxdPo5oZDN/6YqlWa0801Ukvrm7Hgr/Hw+rqF35PebYvBHzf9QQ9+DPAb9ZfP0ZrtWtreyOmOgdpUKegf0n0y/FwIgKq38ZKnGuKExQL3p9ZuGCTpJNssiytHT/zTltqxnoPbg0pZzosz9c9vbxN25tV9OeJN7YTb0EG+uQhl4mw6CVw7DfyueCkd089D8DUf/D+zQjEv+gAWwFZc2kA8QQ9nzjGCHKj0s3QnCSEOHB+2knbhNijkfCEgIVianMITO7f4f///rNkI0bAmjrkx52FNpOs4tv8zDS3h+1+GmBS7KnWjy3DtB4tETnvo/OVWPXEoDwvu0BvaHzrr86IpmBwKKHAO2c7MSQFVfX0LCUVpIkOtDC3eze7hOJJownbMUPw1+P4HevE0EA=="
 }
@@ -0,0 +1,26 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package rac
+
+import (
+	"context"
+	"database/sql"
+)
+
+// racCollectMethod contains the methods needed to collect the status of the instances of the cluster.
+type racCollectMethod interface {
+	instances(context.Context) ([]instance, error)
+}
+
+// collectedData contains the necessary cluster instance information.
+type collectedData struct {
+	instances []instance
+}
+
+// racCollector is the implementor of racCollectMethod. It's implementation are on different Go files
+// which refers to the origin of the data for organization purposes.
+type racCollector struct {
+	db *sql.DB
+}
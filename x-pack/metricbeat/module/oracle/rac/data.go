@@ -0,0 +1,41 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package rac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
+
+// collect function collects the status of all the cluster instances into an instance of collectedData
+func (m *MetricSet) collect(ctx context.Context, collector racCollectMethod) (out *collectedData, err error) {
+	out = &collectedData{}
+	if out.instances, err = collector.instances(ctx); err != nil {
+		return nil, fmt.Errorf("error getting instances %w", err)
+	}
+	return out, nil
+}
+
+// collectAndTransform is called by the Fetch method, which is the one
+// that "loads" the data into Elasticsearch.
+func (m *MetricSet) collectAndTransform(ctx context.Context) ([]mb.Event, error) {
+	collectedMetricsData, err := m.collect(ctx, m.collector)
+	if err != nil {
+		return nil, fmt.Errorf("error collecting data %w", err)
+	}
+	return m.transform(collectedMetricsData), nil
+}
+
+// transform function transforms the data to create a Kibana/Elasticsearch friendly JSON.
+// One event is created for each instance of the cluster.
+func (m *MetricSet) transform(in *collectedData) []mb.Event {
+	events := make([]mb.Event, 0, len(in.instances))
+	for _, v := range m.addInstanceData(in.instances) {
+		events = append(events, mb.Event{MetricSetFields: v})
+	}
+	return events
+}
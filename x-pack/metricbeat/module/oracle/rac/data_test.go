@@ -0,0 +1,72 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package rac
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+type mockCollector struct {
+	instancesData []instance
+	err           error
+}
+
+func (c mockCollector) instances(_ context.Context) ([]instance, error) {
+	return c.instancesData, c.err
+}
+
+func newTestMetricSet(t *testing.T, collector racCollectMethod) *MetricSet {
+	m := mbtest.NewMetricSet(t, map[string]interface{}{
+		"module":     "oracle",
+		"metricsets": []string{"rac"},
+		"hosts":      []string{"user/pass@localhost:1521/ORCLCDB"},
+	}).(*MetricSet)
+	m.collector = collector
+	return m
+}
+
+func TestMetricSetTransform(t *testing.T) {
+	m := newTestMetricSet(t, mockCollector{instancesData: []instance{
+		{
+			id:             sql.NullInt64{Int64: 1, Valid: true},
+			name:           sql.NullString{String: "ORCL1", Valid: true},
+			hostName:       sql.NullString{String: "racnode1", Valid: true},
+			status:         sql.NullString{String: "OPEN", Valid: true},
+			databaseStatus: sql.NullString{String: "ACTIVE", Valid: true},
+			role:           sql.NullString{String: "PRIMARY_INSTANCE", Valid: true},
+			thread:         sql.NullInt64{Int64: 1, Valid: true},
+			startupTime:    sql.NullTime{Time: time.Date(2023, 5, 4, 10, 30, 0, 0, time.UTC), Valid: true},
+		},
+		{
+			id:             sql.NullInt64{Int64: 2, Valid: true},
+			name:           sql.NullString{String: "ORCL2", Valid: true},
+			hostName:       sql.NullString{String: "racnode2", Valid: true},
+			status:         sql.NullString{String: "MOUNTED", Valid: true},
+			databaseStatus: sql.NullString{String: "SUSPENDED", Valid: true},
+		},
+	}})
+
+	events, err := m.collectAndTransform(context.Background())
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, `{"instance":{"database_status":"ACTIVE","host_name":"racnode1","id":1,"name":"ORCL1","role":"PRIMARY_INSTANCE","startup_time":"2023-05-04T10:30:00Z","status":"OPEN","thread":1}}`, events[0].MetricSetFields.String())
+	assert.Equal(t, `{"instance":{"database_status":"SUSPENDED","host_name":"racnode2","id":2,"name":"ORCL2","status":"MOUNTED"}}`, events[1].MetricSetFields.String())
+}
+
+func TestMetricSetTransformError(t *testing.T) {
+	m := newTestMetricSet(t, mockCollector{err: errors.New("instances error")})
+
+	_, err := m.collectAndTransform(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,67 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package rac
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+type instance struct {
+	id             sql.NullInt64
+	name           sql.NullString
+	hostName       sql.NullString
+	status         sql.NullString
+	databaseStatus sql.NullString
+	role           sql.NullString
+	thread         sql.NullInt64
+	startupTime    sql.NullTime
+}
+
+// GV$INSTANCE returns one row per running instance of a RAC database, and a
+// single row for databases that are not clustered.
+const instanceQuery = "SELECT INST_ID, INSTANCE_NAME, HOST_NAME, STATUS, DATABASE_STATUS, INSTANCE_ROLE, THREAD#, STARTUP_TIME FROM GV$INSTANCE"
+
+func (e *racCollector) instances(ctx context.Context) ([]instance, error) {
+	rows, err := e.db.QueryContext(ctx, instanceQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error executing query %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]instance, 0)
+
+	for rows.Next() {
+		dest := instance{}
+		if err = rows.Scan(&dest.id, &dest.name, &dest.hostName, &dest.status, &dest.databaseStatus, &dest.role, &dest.thread, &dest.startupTime); err != nil {
+			return nil, err
+		}
+		results = append(results, dest)
+	}
+	return results, rows.Err()
+}
+
+func (m *MetricSet) addInstanceData(instances []instance) []mapstr.M {
+	out := make([]mapstr.M, 0, len(instances))
+
+	for _, inst := range instances {
+		ms := mapstr.M{}
+		oracle.SetSqlValue(m.Logger(), ms, "instance.id", &oracle.Int64Value{NullInt64: inst.id})
+		oracle.SetSqlValue(m.Logger(), ms, "instance.name", &oracle.StringValue{NullString: inst.name})
+		oracle.SetSqlValue(m.Logger(), ms, "instance.host_name", &oracle.StringValue{NullString: inst.hostName})
+		oracle.SetSqlValue(m.Logger(), ms, "instance.status", &oracle.StringValue{NullString: inst.status})
+		oracle.SetSqlValue(m.Logger(), ms, "instance.database_status", &oracle.StringValue{NullString: inst.databaseStatus})
+		oracle.SetSqlValue(m.Logger(), ms, "instance.role", &oracle.StringValue{NullString: inst.role})
+		oracle.SetSqlValue(m.Logger(), ms, "instance.thread", &oracle.Int64Value{NullInt64: inst.thread})
+		oracle.SetSqlValue(m.Logger(), ms, "instance.startup_time", &oracle.TimeValue{NullTime: inst.startupTime})
+		out = append(out, ms)
+	}
+
+	return out
+}
@@ -124,5 +124,9 @@ func (m *MetricSet) addDataFileData(d *dataFile, output map[string]mapstr.M) {
 	oracle.SetSqlValueWithParentKey(m.Logger(), output, d.hash(), "data_file.size.bytes", &oracle.Int64Value{NullInt64: d.FileSizeBytes})
 	oracle.SetSqlValueWithParentKey(m.Logger(), output, d.hash(), "data_file.size.max.bytes", &oracle.Int64Value{NullInt64: d.MaxFileSizeBytes})
 	oracle.SetSqlValueWithParentKey(m.Logger(), output, d.hash(), "data_file.size.free.bytes", &oracle.Int64Value{NullInt64: d.AvailableForUserBytes})
+	oracle.SetSqlValueWithParentKey(m.Logger(), output, d.hash(), "data_file.size.headroom.bytes", &oracle.Int64Value{NullInt64: d.headroom()})
+	if d.Autoextensible.Valid {
+		_, _ = output[d.hash()].Put("data_file.autoextensible", d.autoextensible())
+	}
 
 }
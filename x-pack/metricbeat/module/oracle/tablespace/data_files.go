@@ -21,6 +21,7 @@ type dataFile struct {
 	MaxFileSizeBytes      sql.NullInt64
 	AvailableForUserBytes sql.NullInt64
 	OnlineStatus          sql.NullString
+	Autoextensible        sql.NullString
 }
 
 func (d *dataFile) hash() string {
@@ -31,8 +32,24 @@ func (d *dataFile) eventKey() string {
 	return d.TablespaceName.String
 }
 
+func (d *dataFile) autoextensible() bool {
+	return d.Autoextensible.String == "YES"
+}
+
+// headroom returns the number of bytes the data file can still grow by
+// autoextending. Files that do not autoextend have no headroom.
+func (d *dataFile) headroom() sql.NullInt64 {
+	if !d.Autoextensible.Valid || !d.FileSizeBytes.Valid || !d.MaxFileSizeBytes.Valid {
+		return sql.NullInt64{}
+	}
+	if !d.autoextensible() || d.MaxFileSizeBytes.Int64 < d.FileSizeBytes.Int64 {
+		return sql.NullInt64{Valid: true}
+	}
+	return sql.NullInt64{Int64: d.MaxFileSizeBytes.Int64 - d.FileSizeBytes.Int64, Valid: true}
+}
+
 func (e *tablespaceExtractor) dataFilesData(ctx context.Context) ([]dataFile, error) {
-	rows, err := e.db.QueryContext(ctx, "SELECT FILE_NAME, FILE_ID, TABLESPACE_NAME, BYTES, STATUS, MAXBYTES, USER_BYTES, ONLINE_STATUS FROM SYS.DBA_DATA_FILES UNION SELECT FILE_NAME, FILE_ID, TABLESPACE_NAME, BYTES, STATUS, MAXBYTES, USER_BYTES, STATUS AS ONLINE_STATUS FROM SYS.DBA_TEMP_FILES")
+	rows, err := e.db.QueryContext(ctx, "SELECT FILE_NAME, FILE_ID, TABLESPACE_NAME, BYTES, STATUS, MAXBYTES, USER_BYTES, ONLINE_STATUS, AUTOEXTENSIBLE FROM SYS.DBA_DATA_FILES UNION SELECT FILE_NAME, FILE_ID, TABLESPACE_NAME, BYTES, STATUS, MAXBYTES, USER_BYTES, STATUS AS ONLINE_STATUS, AUTOEXTENSIBLE FROM SYS.DBA_TEMP_FILES")
 	if err != nil {
 		return nil, errors.Wrap(err, "error executing query")
 	}
@@ -41,7 +58,7 @@ func (e *tablespaceExtractor) dataFilesData(ctx context.Context) ([]dataFile, er
 
 	for rows.Next() {
 		dest := dataFile{}
-		if err = rows.Scan(&dest.FileName, &dest.FileID, &dest.TablespaceName, &dest.FileSizeBytes, &dest.Status, &dest.MaxFileSizeBytes, &dest.AvailableForUserBytes, &dest.OnlineStatus); err != nil {
+		if err = rows.Scan(&dest.FileName, &dest.FileID, &dest.TablespaceName, &dest.FileSizeBytes, &dest.Status, &dest.MaxFileSizeBytes, &dest.AvailableForUserBytes, &dest.OnlineStatus, &dest.Autoextensible); err != nil {
 			return nil, err
 		}
 		results = append(results, dest)
@@ -0,0 +1,27 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package wait_events
+
+import (
+	"context"
+	"database/sql"
+)
+
+// waitEventsCollectMethod contains the methods needed to collect the top wait events of the database.
+type waitEventsCollectMethod interface {
+	waitEvents(context.Context) ([]waitEvent, error)
+}
+
+// collectedData contains the necessary wait event information.
+type collectedData struct {
+	waitEvents []waitEvent
+}
+
+// waitEventsCollector is the implementor of waitEventsCollectMethod. It's implementation are on different Go files
+// which refers to the origin of the data for organization purposes.
+type waitEventsCollector struct {
+	db   *sql.DB
+	topN int
+}
@@ -0,0 +1,41 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package wait_events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
+
+// collect function collects the top wait events into an instance of collectedData
+func (m *MetricSet) collect(ctx context.Context, collector waitEventsCollectMethod) (out *collectedData, err error) {
+	out = &collectedData{}
+	if out.waitEvents, err = collector.waitEvents(ctx); err != nil {
+		return nil, fmt.Errorf("error getting wait events %w", err)
+	}
+	return out, nil
+}
+
+// collectAndTransform is called by the Fetch method, which is the one
+// that "loads" the data into Elasticsearch.
+func (m *MetricSet) collectAndTransform(ctx context.Context) ([]mb.Event, error) {
+	collectedMetricsData, err := m.collect(ctx, m.collector)
+	if err != nil {
+		return nil, fmt.Errorf("error collecting data %w", err)
+	}
+	return m.transform(collectedMetricsData), nil
+}
+
+// transform function transforms the data to create a Kibana/Elasticsearch friendly JSON.
+// One event is created for each wait event.
+func (m *MetricSet) transform(in *collectedData) []mb.Event {
+	events := make([]mb.Event, 0, len(in.waitEvents))
+	for _, v := range m.addWaitEventData(in.waitEvents) {
+		events = append(events, mb.Event{MetricSetFields: v})
+	}
+	return events
+}
@@ -0,0 +1,76 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package wait_events
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+type mockCollector struct {
+	waitEventsData []waitEvent
+	err            error
+}
+
+func (c mockCollector) waitEvents(_ context.Context) ([]waitEvent, error) {
+	return c.waitEventsData, c.err
+}
+
+func newTestMetricSet(t *testing.T, config map[string]interface{}) *MetricSet {
+	c := map[string]interface{}{
+		"module":     "oracle",
+		"metricsets": []string{"wait_events"},
+		"hosts":      []string{"user/pass@localhost:1521/ORCLCDB"},
+	}
+	for k, v := range config {
+		c[k] = v
+	}
+	return mbtest.NewMetricSet(t, c).(*MetricSet)
+}
+
+func TestNew(t *testing.T) {
+	assert.Equal(t, defaultTopN, newTestMetricSet(t, nil).topN)
+	assert.Equal(t, 3, newTestMetricSet(t, map[string]interface{}{"wait_events.top_n": 3}).topN)
+}
+
+func TestMetricSetTransform(t *testing.T) {
+	m := newTestMetricSet(t, nil)
+	m.collector = mockCollector{waitEventsData: []waitEvent{
+		{
+			name:             sql.NullString{String: "db file sequential read", Valid: true},
+			class:            sql.NullString{String: "User I/O", Valid: true},
+			totalWaits:       sql.NullInt64{Int64: 1000, Valid: true},
+			totalTimeouts:    sql.NullInt64{Int64: 0, Valid: true},
+			timeWaitedMicros: sql.NullInt64{Int64: 2500000, Valid: true},
+		},
+		{
+			name:             sql.NullString{String: "log file sync", Valid: true},
+			class:            sql.NullString{String: "Commit", Valid: true},
+			totalWaits:       sql.NullInt64{Int64: 0, Valid: true},
+			timeWaitedMicros: sql.NullInt64{Int64: 0, Valid: true},
+		},
+	}}
+
+	events, err := m.collectAndTransform(context.Background())
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, `{"average_wait":{"us":2500},"class":"User I/O","name":"db file sequential read","time_waited":{"us":2500000},"timeouts":{"count":0},"waits":{"count":1000}}`, events[0].MetricSetFields.String())
+	assert.Equal(t, `{"class":"Commit","name":"log file sync","time_waited":{"us":0},"waits":{"count":0}}`, events[1].MetricSetFields.String())
+}
+
+func TestMetricSetTransformError(t *testing.T) {
+	m := newTestMetricSet(t, nil)
+	m.collector = mockCollector{err: errors.New("wait events error")}
+
+	_, err := m.collectAndTransform(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,77 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package wait_events
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+type waitEvent struct {
+	name             sql.NullString
+	class            sql.NullString
+	totalWaits       sql.NullInt64
+	totalTimeouts    sql.NullInt64
+	timeWaitedMicros sql.NullInt64
+}
+
+/*
+ * The following query returns the non-idle wait events of the instance
+ * with the highest cumulative time waited since instance startup
+ *
+ *	EVENT					WAIT_CLASS	TOTAL_WAITS	TOTAL_TIMEOUTS	TIME_WAITED_MICRO
+ *	db file sequential read	User I/O	1393		0				1806573
+ *
+ * Which is parsed into waitEvent instances
+ */
+const waitEventQuery = "SELECT EVENT, WAIT_CLASS, TOTAL_WAITS, TOTAL_TIMEOUTS, TIME_WAITED_MICRO FROM (SELECT EVENT, WAIT_CLASS, TOTAL_WAITS, TOTAL_TIMEOUTS, TIME_WAITED_MICRO FROM V$SYSTEM_EVENT WHERE WAIT_CLASS <> 'Idle' ORDER BY TIME_WAITED_MICRO DESC) WHERE ROWNUM <= :top_n"
+
+func (e *waitEventsCollector) waitEvents(ctx context.Context) ([]waitEvent, error) {
+	rows, err := e.db.QueryContext(ctx, waitEventQuery, e.topN)
+	if err != nil {
+		return nil, fmt.Errorf("error executing query %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]waitEvent, 0)
+
+	for rows.Next() {
+		dest := waitEvent{}
+		if err = rows.Scan(&dest.name, &dest.class, &dest.totalWaits, &dest.totalTimeouts, &dest.timeWaitedMicros); err != nil {
+			return nil, err
+		}
+		results = append(results, dest)
+	}
+	return results, rows.Err()
+}
+
+func (m *MetricSet) addWaitEventData(wes []waitEvent) []mapstr.M {
+	out := make([]mapstr.M, 0, len(wes))
+
+	for _, we := range wes {
+		ms := mapstr.M{}
+		oracle.SetSqlValue(m.Logger(), ms, "name", &oracle.StringValue{NullString: we.name})
+		oracle.SetSqlValue(m.Logger(), ms, "class", &oracle.StringValue{NullString: we.class})
+		oracle.SetSqlValue(m.Logger(), ms, "waits.count", &oracle.Int64Value{NullInt64: we.totalWaits})
+		oracle.SetSqlValue(m.Logger(), ms, "timeouts.count", &oracle.Int64Value{NullInt64: we.totalTimeouts})
+		oracle.SetSqlValue(m.Logger(), ms, "time_waited.us", &oracle.Int64Value{NullInt64: we.timeWaitedMicros})
+		oracle.SetSqlValue(m.Logger(), ms, "average_wait.us", &oracle.Float64Value{NullFloat64: averageWait(we.timeWaitedMicros, we.totalWaits)})
+		out = append(out, ms)
+	}
+
+	return out
+}
+
+// averageWait returns the average time waited per wait, in microseconds.
+func averageWait(timeWaited, waits sql.NullInt64) sql.NullFloat64 {
+	if !timeWaited.Valid || !waits.Valid || waits.Int64 == 0 {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: float64(timeWaited.Int64) / float64(waits.Int64), Valid: true}
+}